@@ -0,0 +1,133 @@
+package deck
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func noTwos(c Card) bool {
+	return c.Rank == Two
+}
+
+func TestAllocs(t *testing.T) {
+	cards := New()
+	r := rand.New(rand.NewSource(1))
+	shuffle := ShuffleWith(r)
+	sortFunc := SortFunc(RankFirst)
+	filter := Filter(noTwos)
+
+	tests := []struct {
+		name string
+		max  float64
+		f    func()
+	}{
+		{"New", 1, func() { New() }},
+		{"New with jokers", 1, func() { New(Jokers(2)) }},
+		{"New filtered", 2, func() { New(filter) }},
+		{"New of 6 decks", 2, func() { New(Deck(6)) }},
+		{"Shuffle", 0, func() { Shuffle(cards) }},
+		{"ShuffleWith", 0, func() { shuffle(cards) }},
+		{"DefaultSort", 1, func() { DefaultSort(cards) }},
+		{"SortFunc", 1, func() { sortFunc(cards) }},
+	}
+	for _, tt := range tests {
+		if n := testing.AllocsPerRun(100, tt.f); n > tt.max {
+			t.Error("Expected at most", tt.max, "allocations in", tt.name, "received:", n)
+		}
+	}
+}
+
+func TestShuffleWith(t *testing.T) {
+	a := New(ShuffleWith(rand.New(rand.NewSource(7))))
+	b := New(ShuffleWith(rand.New(rand.NewSource(7))))
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatal("Expected the same seed to shuffle the same way, received:", a, b)
+		}
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	a := New(Shuffle, SortFunc(RankFirst))
+	b := New(Sort(ByRankThenBySuit))
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatal("Expected SortFunc to sort as Sort, received:", a, b)
+		}
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		New()
+	}
+}
+
+func BenchmarkNewShuffled(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		New(Shuffle)
+	}
+}
+
+func BenchmarkNewFiltered(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		New(Filter(noTwos), Jokers(2))
+	}
+}
+
+func BenchmarkNewShoe(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		New(Deck(6), Shuffle)
+	}
+}
+
+func BenchmarkShuffle(b *testing.B) {
+	cards := New()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Shuffle(cards)
+	}
+}
+
+func BenchmarkShuffleWith(b *testing.B) {
+	cards := New()
+	shuffle := ShuffleWith(rand.New(rand.NewSource(1)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		shuffle(cards)
+	}
+}
+
+func BenchmarkDefaultSort(b *testing.B) {
+	cards := New()
+	r := rand.New(rand.NewSource(1))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ShuffleWith(r)(cards)
+		DefaultSort(cards)
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	cards := New()
+	r := rand.New(rand.NewSource(1))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ShuffleWith(r)(cards)
+		Sort(ByRankThenBySuit)(cards)
+	}
+}
+
+func BenchmarkSortFunc(b *testing.B) {
+	cards := New()
+	r := rand.New(rand.NewSource(1))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ShuffleWith(r)(cards)
+		SortFunc(RankFirst)(cards)
+	}
+}
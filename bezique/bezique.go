@@ -0,0 +1,117 @@
+// Package bezique implements Bezique, the two player game played with a double piquet deck, with its declarations
+// and the counting of brisques.
+package bezique
+
+import (
+	"github.com/euller88/deck"
+	"github.com/euller88/deck/piquet"
+)
+
+// Deck returns the 64 cards bezique deck, two piquet decks, with the given options applied after them.
+func Deck(opts ...func([]deck.Card) []deck.Card) []deck.Card {
+	return deck.New(append([]func([]deck.Card) []deck.Card{deck.Filter(piquet.Short), deck.Deck(2)}, opts...)...)
+}
+
+// Power returns the strength of a rank in Bezique, where the Ten sits between the Ace and the King.
+func Power(r deck.Rank) int {
+	switch r {
+	case deck.Ace:
+		return 15
+	case deck.Ten:
+		return 14
+	default:
+		return int(r)
+	}
+}
+
+// Brisque reports if a card is an Ace or a Ten, each worth 10 points when taken in a trick.
+func Brisque(c deck.Card) bool {
+	return c.Rank == deck.Ace || c.Rank == deck.Ten
+}
+
+// Meld is a kind of declaration.
+type Meld uint8
+
+// The declarations of Bezique
+const (
+	// Marriage is the King and Queen of a plain suit
+	Marriage Meld = iota
+
+	// RoyalMarriage is the King and Queen of trumps
+	RoyalMarriage
+
+	// Bezique is the Queen of Spades and the Jack of Diamonds
+	Bezique
+
+	// DoubleBezique is both Queens of Spades and both Jacks of Diamonds
+	DoubleBezique
+
+	// FourJacks is any four Jacks
+	FourJacks
+
+	// FourQueens is any four Queens
+	FourQueens
+
+	// FourKings is any four Kings
+	FourKings
+
+	// FourAces is any four Aces
+	FourAces
+
+	// Sequence is the Ace, Ten, King, Queen and Jack of trumps
+	Sequence
+)
+
+var meldPoints = [...]int{20, 40, 40, 500, 40, 60, 80, 100, 250}
+
+// Points returns the points a declaration is worth.
+func (m Meld) Points() int {
+	return meldPoints[m]
+}
+
+// Check reports if cards form the declaration m under the given trump suit.
+func Check(m Meld, cards []deck.Card, trump deck.Suit) bool {
+	count := func(r deck.Rank, s deck.Suit) int {
+		n := 0
+		for _, c := range cards {
+			if c.Rank == r && (s == deck.Joker || c.Suit == s) {
+				n++
+			}
+		}
+		return n
+	}
+	four := func(r deck.Rank) bool {
+		return len(cards) == 4 && count(r, deck.Joker) == 4
+	}
+
+	switch m {
+	case Marriage, RoyalMarriage:
+		if len(cards) != 2 || cards[0].Suit != cards[1].Suit || (cards[0].Suit == trump) != (m == RoyalMarriage) {
+			return false
+		}
+		return count(deck.King, cards[0].Suit) == 1 && count(deck.Queen, cards[0].Suit) == 1
+	case Bezique:
+		return len(cards) == 2 && count(deck.Queen, deck.Spade) == 1 && count(deck.Jack, deck.Diamond) == 1
+	case DoubleBezique:
+		return len(cards) == 4 && count(deck.Queen, deck.Spade) == 2 && count(deck.Jack, deck.Diamond) == 2
+	case FourJacks:
+		return four(deck.Jack)
+	case FourQueens:
+		return four(deck.Queen)
+	case FourKings:
+		return four(deck.King)
+	case FourAces:
+		return four(deck.Ace)
+	case Sequence:
+		if len(cards) != 5 {
+			return false
+		}
+		for _, r := range []deck.Rank{deck.Ace, deck.Ten, deck.King, deck.Queen, deck.Jack} {
+			if count(r, trump) != 1 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
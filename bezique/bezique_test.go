@@ -0,0 +1,42 @@
+package bezique
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+func TestDeck(t *testing.T) {
+	if n := len(Deck()); n != 64 {
+		t.Errorf("Expected %d cards, received %d cards.", 64, n)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	cases := []struct {
+		m     Meld
+		cards []deck.Card
+		exp   bool
+	}{
+		{Marriage, []deck.Card{c(deck.King, deck.Club), c(deck.Queen, deck.Club)}, true},
+		{Marriage, []deck.Card{c(deck.King, deck.Heart), c(deck.Queen, deck.Heart)}, false},
+		{RoyalMarriage, []deck.Card{c(deck.King, deck.Heart), c(deck.Queen, deck.Heart)}, true},
+		{Bezique, []deck.Card{c(deck.Queen, deck.Spade), c(deck.Jack, deck.Diamond)}, true},
+		{DoubleBezique, []deck.Card{c(deck.Queen, deck.Spade), c(deck.Jack, deck.Diamond), c(deck.Queen, deck.Spade), c(deck.Jack, deck.Diamond)}, true},
+		{FourAces, []deck.Card{c(deck.Ace, deck.Spade), c(deck.Ace, deck.Spade), c(deck.Ace, deck.Club), c(deck.Ace, deck.Heart)}, true},
+		{FourKings, []deck.Card{c(deck.King, deck.Spade), c(deck.King, deck.Club), c(deck.Queen, deck.Heart), c(deck.King, deck.Heart)}, false},
+		{Sequence, []deck.Card{c(deck.Ace, deck.Heart), c(deck.Ten, deck.Heart), c(deck.King, deck.Heart), c(deck.Queen, deck.Heart), c(deck.Jack, deck.Heart)}, true},
+	}
+	for _, tc := range cases {
+		if ok := Check(tc.m, tc.cards, deck.Heart); ok != tc.exp {
+			t.Error("Expected", tc.exp, "checking", tc.cards, "received:", ok)
+		}
+	}
+	if DoubleBezique.Points() != 500 || Sequence.Points() != 250 {
+		t.Error("Expected double bezique to be worth 500 and the sequence 250.")
+	}
+}
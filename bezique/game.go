@@ -0,0 +1,289 @@
+package bezique
+
+import (
+	"errors"
+
+	"github.com/euller88/deck"
+)
+
+// The number of cards in every hand
+const HandSize = 8
+
+// The errors returned when a move breaks the rules of the game
+var (
+	ErrDeckSize  = errors.New("bezique: not enough cards to deal")
+	ErrTurn      = errors.New("bezique: it's not the player's turn")
+	ErrNotInHand = errors.New("bezique: card is not in the player's hand")
+	ErrFollow    = errors.New("bezique: player must follow suit and win the trick if possible")
+	ErrDeclare   = errors.New("bezique: player can't declare now")
+	ErrMeld      = errors.New("bezique: cards don't form the declaration")
+	ErrShown     = errors.New("bezique: a declaration needs at least one card not declared before")
+	ErrSeven     = errors.New("bezique: player has no seven of trumps to declare")
+	ErrGameOver  = errors.New("bezique: the game is over")
+)
+
+// Game holds the state of a deal of Bezique. Player 0, the non-dealer, leads the first trick.
+type Game struct {
+	// Trump is the turned card that sets the trump suit
+	Trump deck.Card
+
+	// Stock holds the cards still to be drawn, the last one being the trump card
+	Stock []deck.Card
+
+	// Hands holds the cards of both players, including the ones declared
+	Hands [2][]deck.Card
+
+	// Shown holds the declared cards of both players, which stay on the table but are still part of the hand
+	Shown [2][]deck.Card
+
+	// Trick holds the cards played in the current trick, and Won the cards taken by every player
+	Trick []deck.Card
+	Won   [2][]deck.Card
+
+	// Scores holds the points of both players, without the brisques
+	Scores [2]int
+
+	// Leader is the player who led the current trick and Turn is the player who must play now
+	Leader, Turn int
+
+	declarer int
+	draw     bool
+	sevens   int
+}
+
+// New deals a deal of Bezique from cards, which should come already shuffled. The card after the hands is turned
+// to set the trump, and scores 10 for the dealer, player 1, when it is a Seven. The stock left, with the trump, must
+// hold an even number of cards, so that both players draw after every trick.
+func New(cards []deck.Card) (*Game, error) {
+	if n := len(cards) - 2*HandSize; n < 2 || n%2 != 0 {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{declarer: -1}
+	for i := 0; i < HandSize; i++ {
+		for p := range g.Hands {
+			g.Hands[p] = append(g.Hands[p], cards[2*i+p])
+		}
+	}
+	rest := cards[2*HandSize:]
+	g.Trump = rest[0]
+	g.Stock = append(append([]deck.Card(nil), rest[1:]...), rest[0])
+	if g.Trump.Rank == deck.Seven {
+		g.Scores[1] += 10
+		g.sevens++
+	}
+	return g, nil
+}
+
+// Legal returns the cards player may play into the current trick. While there is stock any card goes, and after it
+// players must follow suit and win the trick if they can, or else trump it.
+func (g *Game) Legal(player int) []deck.Card {
+	if player != g.Turn || g.Over() {
+		return nil
+	}
+	hand := g.hand(player)
+	if len(g.Stock) > 0 || len(g.Trick) == 0 {
+		return hand
+	}
+
+	led := g.Trick[0]
+	if same := suited(hand, led.Suit); len(same) > 0 {
+		var higher []deck.Card
+		for _, c := range same {
+			if Power(c.Rank) > Power(led.Rank) {
+				higher = append(higher, c)
+			}
+		}
+		if len(higher) > 0 {
+			return higher
+		}
+		return same
+	}
+	if trumps := suited(hand, g.Trump.Suit); len(trumps) > 0 {
+		return trumps
+	}
+	return hand
+}
+
+// Play puts card from the hand of player into the trick. The winner of a trick may make a single declaration before
+// leading the next one, and both players draw a card, the winner first, before the next lead.
+// The winner of the last trick scores 10.
+func (g *Game) Play(player int, card deck.Card) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if player != g.Turn {
+		return ErrTurn
+	}
+	if index(g.hand(player), card) < 0 {
+		return ErrNotInHand
+	}
+	if index(g.Legal(player), card) < 0 {
+		return ErrFollow
+	}
+	g.drawCards()
+
+	g.Hands[player] = remove(g.Hands[player], card)
+	if index(g.Shown[player], card) >= 0 {
+		g.Shown[player] = remove(g.Shown[player], card)
+	}
+	g.Trick = append(g.Trick, card)
+	g.declarer = -1
+	if len(g.Trick) == 1 {
+		g.Turn = 1 - player
+		return nil
+	}
+
+	winner := g.Leader
+	if g.beats(card, g.Trick[0]) {
+		winner = player
+	}
+	g.Won[winner] = append(g.Won[winner], g.Trick...)
+	g.Trick = nil
+	g.Leader, g.Turn = winner, winner
+
+	if len(g.Stock) > 0 {
+		g.declarer = winner
+		g.draw = true
+	}
+	if g.Over() {
+		g.Scores[winner] += 10
+	}
+	return nil
+}
+
+// Declare scores a declaration made by the winner of the last trick, while there is stock.
+// Every declaration needs a card that was not declared before.
+func (g *Game) Declare(player int, m Meld, cards []deck.Card) error {
+	if player != g.declarer {
+		return ErrDeclare
+	}
+	hand := append([]deck.Card(nil), g.Hands[player]...)
+	shown := append([]deck.Card(nil), g.Shown[player]...)
+	fresh := 0
+	for _, c := range cards {
+		if index(hand, c) < 0 {
+			return ErrNotInHand
+		}
+		hand = remove(hand, c)
+		if index(shown, c) >= 0 {
+			shown = remove(shown, c)
+		} else {
+			fresh++
+		}
+	}
+	if !Check(m, cards, g.Trump.Suit) {
+		return ErrMeld
+	}
+	if fresh == 0 {
+		return ErrShown
+	}
+
+	shown = append([]deck.Card(nil), g.Shown[player]...)
+	for _, c := range cards {
+		if index(shown, c) >= 0 {
+			shown = remove(shown, c)
+		} else {
+			g.Shown[player] = append(g.Shown[player], c)
+		}
+	}
+	g.Scores[player] += m.Points()
+	g.declarer = -1
+	return nil
+}
+
+// Seven scores 10 for the seven of trumps held by the winner of the last trick. While the turned card is still under
+// the stock, the seven is exchanged for it.
+func (g *Game) Seven(player int) error {
+	if player != g.declarer {
+		return ErrDeclare
+	}
+	seven := deck.Card{Suit: g.Trump.Suit, Rank: deck.Seven}
+	i := index(g.Hands[player], seven)
+	if i < 0 || g.sevens >= 2 {
+		return ErrSeven
+	}
+
+	last := len(g.Stock) - 1
+	if g.Stock[last] == g.Trump && g.Trump != seven {
+		g.Hands[player][i], g.Stock[last] = g.Trump, seven
+		g.Trump = seven
+	}
+	g.Scores[player] += 10
+	g.sevens++
+	g.declarer = -1
+	return nil
+}
+
+// Over reports if every card was played.
+func (g *Game) Over() bool {
+	return len(g.Hands[0]) == 0 && len(g.Hands[1]) == 0 && len(g.Stock) == 0 && len(g.Trick) == 0
+}
+
+// Score returns the points of both players, adding 10 for every brisque taken in tricks.
+func (g *Game) Score() [2]int {
+	score := g.Scores
+	for p := range g.Won {
+		for _, c := range g.Won[p] {
+			if Brisque(c) {
+				score[p] += 10
+			}
+		}
+	}
+	return score
+}
+
+// hand returns the hand of player as it will be when it's time to play, with the card still to be drawn.
+func (g *Game) hand(player int) []deck.Card {
+	if !g.draw {
+		return g.Hands[player]
+	}
+	hand := append([]deck.Card(nil), g.Hands[player]...)
+	if player == g.Leader {
+		return append(hand, g.Stock[0])
+	}
+	return append(hand, g.Stock[1])
+}
+
+// drawCards gives both players a card from the stock after a trick, the winner first.
+func (g *Game) drawCards() {
+	if !g.draw {
+		return
+	}
+	g.Hands[g.Leader] = append(g.Hands[g.Leader], g.Stock[0])
+	g.Hands[1-g.Leader] = append(g.Hands[1-g.Leader], g.Stock[1])
+	g.Stock = g.Stock[2:]
+	g.draw = false
+}
+
+// beats reports if card, played second, takes the trick led with led. Between equal cards the one led wins.
+func (g *Game) beats(card, led deck.Card) bool {
+	if card.Suit == led.Suit {
+		return Power(card.Rank) > Power(led.Rank)
+	}
+	return card.Suit == g.Trump.Suit
+}
+
+func suited(cards []deck.Card, s deck.Suit) []deck.Card {
+	var ret []deck.Card
+	for _, c := range cards {
+		if c.Suit == s {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func remove(cards []deck.Card, c deck.Card) []deck.Card {
+	i := index(cards, c)
+	return append(cards[:i], cards[i+1:]...)
+}
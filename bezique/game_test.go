@@ -0,0 +1,95 @@
+package bezique
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestGame(t *testing.T) {
+	g, err := New(Deck())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Stock) != 48 || g.Stock[len(g.Stock)-1] != g.Trump {
+		t.Fatal("Expected 48 cards in the stock, with the trump at the bottom.")
+	}
+
+	for !g.Over() {
+		if err := g.Play(g.Turn, g.Legal(g.Turn)[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := len(g.Won[0]) + len(g.Won[1]); n != 64 {
+		t.Error("Expected all 64 cards to be taken, received:", n)
+	}
+	if s := g.Score(); s[0]+s[1] < 160+10 {
+		t.Error("Expected at least the brisques and the last trick to be scored, received:", s)
+	}
+}
+
+func TestShortStock(t *testing.T) {
+	if _, err := New(deck.New()[:2*HandSize+1]); err != ErrDeckSize {
+		t.Error("Expected", ErrDeckSize, "for an odd stock, received:", err)
+	}
+
+	g, err := New(Deck()[:2*HandSize+4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(1-g.Turn, g.Hands[1-g.Turn][0]); err != ErrTurn {
+		t.Error("Expected", ErrTurn, "received:", err)
+	}
+	for i := 0; i < 2; i++ {
+		g.Play(g.Turn, g.Legal(g.Turn)[0])
+	}
+	// A rejected play doesn't draw
+	if err := g.Play(g.Turn, c(deck.Two, deck.Club)); err != ErrNotInHand || len(g.Stock) != 4 {
+		t.Error("Expected", ErrNotInHand, "and no card drawn, received:", err, len(g.Stock))
+	}
+	for !g.Over() {
+		if err := g.Play(g.Turn, g.Legal(g.Turn)[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := len(g.Won[0]) + len(g.Won[1]); n != 2*HandSize+4 {
+		t.Error("Expected every card to be taken, received:", n)
+	}
+}
+
+func TestDeclare(t *testing.T) {
+	g := &Game{
+		Trump: c(deck.Nine, deck.Heart),
+		Stock: []deck.Card{c(deck.Eight, deck.Club), c(deck.Eight, deck.Spade), c(deck.Nine, deck.Heart)},
+		Hands: [2][]deck.Card{
+			{c(deck.Queen, deck.Spade), c(deck.Jack, deck.Diamond), c(deck.King, deck.Spade), c(deck.Seven, deck.Heart)},
+			{c(deck.Ace, deck.Club), c(deck.Ten, deck.Club), c(deck.King, deck.Club), c(deck.Queen, deck.Club)},
+		},
+		declarer: 0,
+	}
+	if err := g.Declare(1, Marriage, []deck.Card{c(deck.King, deck.Club), c(deck.Queen, deck.Club)}); err != ErrDeclare {
+		t.Error("Expected", ErrDeclare, "received:", err)
+	}
+	if err := g.Declare(0, Bezique, []deck.Card{c(deck.Queen, deck.Spade), c(deck.Jack, deck.Diamond)}); err != nil {
+		t.Fatal(err)
+	}
+	if g.Scores[0] != 40 || len(g.Shown[0]) != 2 {
+		t.Error("Expected bezique to score 40 and show two cards, received:", g.Scores[0], g.Shown[0])
+	}
+
+	g.declarer = 0
+	if err := g.Declare(0, Bezique, []deck.Card{c(deck.Queen, deck.Spade), c(deck.Jack, deck.Diamond)}); err != ErrShown {
+		t.Error("Expected", ErrShown, "received:", err)
+	}
+	if err := g.Declare(0, Marriage, []deck.Card{c(deck.King, deck.Spade), c(deck.Queen, deck.Spade)}); err != nil {
+		t.Fatal(err)
+	}
+
+	g.declarer = 0
+	if err := g.Seven(0); err != nil {
+		t.Fatal(err)
+	}
+	if g.Trump != c(deck.Seven, deck.Heart) || index(g.Hands[0], c(deck.Nine, deck.Heart)) < 0 || g.Scores[0] != 70 {
+		t.Error("Expected the seven of trumps to be exchanged for the turned card, scoring 10.")
+	}
+}
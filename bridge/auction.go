@@ -0,0 +1,225 @@
+package bridge
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when a call is not legal
+var (
+	ErrCall         = errors.New("bridge: malformed call")
+	ErrInsufficient = errors.New("bridge: bid is not higher than the last bid")
+	ErrDouble       = errors.New("bridge: only the last bid of the opponents can be doubled")
+	ErrRedouble     = errors.New("bridge: only a double of the opponents can be redoubled")
+	ErrOver         = errors.New("bridge: the auction is over")
+)
+
+// Strain is the denomination of a bid, from the lowest to the highest
+type Strain uint8
+
+// The strains, in bidding order
+const (
+	Clubs Strain = iota
+	Diamonds
+	Hearts
+	Spades
+	NoTrump
+)
+
+var strainNames = [...]string{"C", "D", "H", "S", "NT"}
+
+func (s Strain) String() string {
+	return strainNames[s]
+}
+
+// Suit returns the suit of a strain. NoTrump has none, and returns the Joker suit.
+func (s Strain) Suit() deck.Suit {
+	switch s {
+	case Clubs:
+		return deck.Club
+	case Diamonds:
+		return deck.Diamond
+	case Hearts:
+		return deck.Heart
+	case Spades:
+		return deck.Spade
+	}
+	return deck.Joker
+}
+
+// Kind tells the calls apart
+type Kind uint8
+
+// The kinds of call
+const (
+	Pass Kind = iota
+	Bid
+	Double
+	Redouble
+)
+
+// Call is a call of the auction. Level and Strain are meaningful only for bids.
+type Call struct {
+	Kind   Kind
+	Level  int
+	Strain Strain
+}
+
+func (c Call) String() string {
+	switch c.Kind {
+	case Bid:
+		return string(rune('0'+c.Level)) + c.Strain.String()
+	case Double:
+		return "X"
+	case Redouble:
+		return "XX"
+	}
+	return "P"
+}
+
+// ParseCall reads a call written as in the String method: "P", "X", "XX" or a bid such as "1NT" or "4S".
+func ParseCall(s string) (Call, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	switch s {
+	case "P", "PASS":
+		return Call{}, nil
+	case "X":
+		return Call{Kind: Double}, nil
+	case "XX":
+		return Call{Kind: Redouble}, nil
+	}
+	if len(s) < 2 || s[0] < '1' || s[0] > '7' {
+		return Call{}, ErrCall
+	}
+	for i, name := range strainNames {
+		if s[1:] == name || i == int(NoTrump) && s[1:] == "N" {
+			return Call{Kind: Bid, Level: int(s[0] - '0'), Strain: Strain(i)}, nil
+		}
+	}
+	return Call{}, ErrCall
+}
+
+// MustParseCall works as ParseCall, but panics when the call is malformed. It's meant for tables of known calls.
+func MustParseCall(s string) Call {
+	c, err := ParseCall(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// higher reports if bid a is higher than bid b
+func higher(a, b Call) bool {
+	return a.Level > b.Level || a.Level == b.Level && a.Strain > b.Strain
+}
+
+// Auction holds the calls made, starting with the dealer.
+type Auction struct {
+	Dealer Seat
+	Calls  []Call
+}
+
+// Turn returns the seat that must call now.
+func (a *Auction) Turn() Seat {
+	return (a.Dealer + Seat(len(a.Calls))) % 4
+}
+
+// Seat returns the seat that made the i-th call.
+func (a *Auction) Seat(i int) Seat {
+	return (a.Dealer + Seat(i)) % 4
+}
+
+// LastBid returns the position of the last bid, or -1 if there is none.
+func (a *Auction) LastBid() int {
+	for i := len(a.Calls) - 1; i >= 0; i-- {
+		if a.Calls[i].Kind == Bid {
+			return i
+		}
+	}
+	return -1
+}
+
+// Legal checks if a call can be made now.
+func (a *Auction) Legal(c Call) error {
+	if a.Over() {
+		return ErrOver
+	}
+	// The last call other than a pass, and if it was made by the opponents
+	last, byOpponents := Call{}, false
+	for i := len(a.Calls) - 1; i >= 0; i-- {
+		if a.Calls[i].Kind != Pass {
+			last, byOpponents = a.Calls[i], (len(a.Calls)-i)%2 == 1
+			break
+		}
+	}
+
+	switch c.Kind {
+	case Bid:
+		if c.Level < 1 || c.Level > 7 || c.Strain > NoTrump {
+			return ErrCall
+		}
+		if i := a.LastBid(); i >= 0 && !higher(c, a.Calls[i]) {
+			return ErrInsufficient
+		}
+	case Double:
+		if last.Kind != Bid || !byOpponents {
+			return ErrDouble
+		}
+	case Redouble:
+		if last.Kind != Double || !byOpponents {
+			return ErrRedouble
+		}
+	case Pass:
+	default:
+		return ErrCall
+	}
+	return nil
+}
+
+// Add makes a call, if it's legal.
+func (a *Auction) Add(c Call) error {
+	if err := a.Legal(c); err != nil {
+		return err
+	}
+	a.Calls = append(a.Calls, c)
+	return nil
+}
+
+// Over reports if the auction ended: with three passes after a bid, or with four passes.
+func (a *Auction) Over() bool {
+	n := len(a.Calls)
+	if n < 4 {
+		return false
+	}
+	for _, c := range a.Calls[n-3:] {
+		if c.Kind != Pass {
+			return false
+		}
+	}
+	return true
+}
+
+// Contract returns the final contract, the declarer and if it was doubled or redoubled. ok is false when the auction
+// is not over or every player passed.
+func (a *Auction) Contract() (contract Call, declarer Seat, doubled Kind, ok bool) {
+	last := a.LastBid()
+	if !a.Over() || last < 0 {
+		return Call{}, 0, Pass, false
+	}
+	contract, doubled = a.Calls[last], Pass
+	for _, c := range a.Calls[last+1:] {
+		if c.Kind == Double || c.Kind == Redouble {
+			doubled = c.Kind
+		}
+	}
+	// The declarer is the first player of the winning side to bid the strain
+	side := a.Seat(last) % 2
+	for i, c := range a.Calls {
+		if c.Kind == Bid && c.Strain == contract.Strain && a.Seat(i)%2 == side {
+			return contract, a.Seat(i), doubled, true
+		}
+	}
+	return contract, a.Seat(last), doubled, true
+}
@@ -0,0 +1,71 @@
+package bridge
+
+import (
+	"testing"
+)
+
+func auction(t *testing.T, dealer Seat, calls ...string) *Auction {
+	a := &Auction{Dealer: dealer}
+	for _, s := range calls {
+		if err := a.Add(MustParseCall(s)); err != nil {
+			t.Fatal(s, err)
+		}
+	}
+	return a
+}
+
+func TestParseCall(t *testing.T) {
+	for _, s := range []string{"P", "X", "XX", "1C", "3NT", "7S"} {
+		if c, err := ParseCall(s); err != nil || c.String() != s {
+			t.Error("Expected", s, "received:", c, err)
+		}
+	}
+	if c, _ := ParseCall("4n"); c != (Call{Kind: Bid, Level: 4, Strain: NoTrump}) {
+		t.Error("Expected 4NT, received:", c)
+	}
+	for _, s := range []string{"8C", "0S", "1Z", ""} {
+		if _, err := ParseCall(s); err != ErrCall {
+			t.Error("Expected", ErrCall, "for", s, "received:", err)
+		}
+	}
+}
+
+func TestAuction(t *testing.T) {
+	a := auction(t, East, "1H", "P")
+	if a.Turn() != West {
+		t.Error("Expected West to call, received:", a.Turn())
+	}
+	tests := []struct {
+		call string
+		err  error
+	}{
+		{"1D", ErrInsufficient},
+		{"1H", ErrInsufficient},
+		{"X", ErrDouble},
+		{"XX", ErrRedouble},
+		{"1S", nil},
+	}
+	for _, tt := range tests {
+		if err := a.Legal(MustParseCall(tt.call)); err != tt.err {
+			t.Error("Expected", tt.err, "for", tt.call, "received:", err)
+		}
+	}
+
+	a = auction(t, East, "1H", "P", "2H", "X", "XX", "P", "P", "P")
+	contract, declarer, doubled, ok := a.Contract()
+	if !ok || contract.String() != "2H" || declarer != East || doubled != Redouble {
+		t.Error("Expected 2H redoubled by East, received:", contract, declarer, doubled, ok)
+	}
+	if err := a.Add(Call{}); err != ErrOver {
+		t.Error("Expected", ErrOver, "received:", err)
+	}
+
+	a = auction(t, North, "P", "P", "P")
+	if a.Over() {
+		t.Error("Expected the auction to go on until the fourth pass.")
+	}
+	a.Add(Call{})
+	if _, _, _, ok := a.Contract(); !a.Over() || ok {
+		t.Error("Expected the deal to be passed out.")
+	}
+}
@@ -0,0 +1,201 @@
+// Package bridge evaluates bridge hands dealt from a standard deck, generates deals from scripts describing the
+// hands wanted, and writes them in the Portable Bridge Notation. It also validates auctions, and bids them with bots
+// following a bidding system given as a table of rules, such as Standard American Yellow Card.
+package bridge
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// Seat is a position at the table
+type Seat uint8
+
+// The seats, clockwise
+const (
+	North Seat = iota
+	East
+	South
+	West
+)
+
+var seatNames = [...]string{"North", "East", "South", "West"}
+
+func (s Seat) String() string {
+	return seatNames[s]
+}
+
+// Suits holds the suits in bridge order, from the highest to the lowest: spades, hearts, diamonds and clubs.
+// Shapes and suit lengths are given in this order.
+var Suits = [4]deck.Suit{deck.Spade, deck.Heart, deck.Diamond, deck.Club}
+
+// Deal holds the hands of the four seats.
+type Deal [4][]deck.Card
+
+// Power returns the strength of a rank in bridge, the Ace high.
+func Power(r deck.Rank) int {
+	if r == deck.Ace {
+		return int(deck.King) + 1
+	}
+	return int(r)
+}
+
+// HCP returns the high card points of a hand: 4 for an Ace, 3 for a King, 2 for a Queen and 1 for a Jack.
+func HCP(hand []deck.Card) int {
+	points := 0
+	for _, c := range hand {
+		points += hcp(c.Rank)
+	}
+	return points
+}
+
+func hcp(r deck.Rank) int {
+	switch r {
+	case deck.Ace:
+		return 4
+	case deck.King:
+		return 3
+	case deck.Queen:
+		return 2
+	case deck.Jack:
+		return 1
+	}
+	return 0
+}
+
+// SuitHCP returns the high card points of a hand in a single suit.
+func SuitHCP(hand []deck.Card, s deck.Suit) int {
+	return HCP(Suited(hand, s))
+}
+
+// Controls returns the controls of a hand: 2 for an Ace and 1 for a King.
+func Controls(hand []deck.Card) int {
+	controls := 0
+	for _, c := range hand {
+		switch c.Rank {
+		case deck.Ace:
+			controls += 2
+		case deck.King:
+			controls++
+		}
+	}
+	return controls
+}
+
+// Length returns the number of cards of a suit in a hand.
+func Length(hand []deck.Card, s deck.Suit) int {
+	n := 0
+	for _, c := range hand {
+		if c.Suit == s {
+			n++
+		}
+	}
+	return n
+}
+
+// Shape returns the suit lengths of a hand, in bridge order.
+func Shape(hand []deck.Card) [4]int {
+	var shape [4]int
+	for i, s := range Suits {
+		shape[i] = Length(hand, s)
+	}
+	return shape
+}
+
+// Balanced reports if a hand has one of the balanced shapes: 4-3-3-3, 4-4-3-2 or 5-3-3-2.
+func Balanced(hand []deck.Card) bool {
+	shape := Shape(hand)
+	sorted := shape[:]
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+	return sorted[2] >= 3 && sorted[3] >= 2
+}
+
+// Losers returns the losing trick count of a hand: in every suit, the missing Ace, King and Queen among as many top
+// cards as the suit has, up to three.
+func Losers(hand []deck.Card) int {
+	losers := 0
+	for _, s := range Suits {
+		cards := Suited(hand, s)
+		n := len(cards)
+		if n > 3 {
+			n = 3
+		}
+		losers += n
+		for _, c := range cards {
+			if Power(c.Rank) > Power(deck.Ace)-n {
+				losers--
+			}
+		}
+	}
+	return losers
+}
+
+// Suited returns the cards of a suit in a hand, from the highest to the lowest.
+func Suited(hand []deck.Card, s deck.Suit) []deck.Card {
+	var ret []deck.Card
+	for _, c := range hand {
+		if c.Suit == s {
+			ret = append(ret, c)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return Power(ret[i].Rank) > Power(ret[j].Rank)
+	})
+	return ret
+}
+
+// Pattern reports if a shape matches a pattern such as "5431", where x stands for any length, or "any 4333", which
+// matches the lengths in any order.
+func Pattern(shape [4]int, pattern string) bool {
+	p := strings.ToLower(strings.Join(strings.Fields(pattern), " "))
+	any := strings.HasPrefix(p, "any ")
+	p = strings.TrimPrefix(p, "any ")
+	if len(p) != 4 {
+		return false
+	}
+
+	if !any {
+		return matchShape(shape[:], p)
+	}
+	// Try every order of the lengths
+	lengths := shape[:]
+	sort.Ints(lengths)
+	for {
+		if matchShape(lengths, p) {
+			return true
+		}
+		if !nextPermutation(lengths) {
+			return false
+		}
+	}
+}
+
+func matchShape(lengths []int, pattern string) bool {
+	for i := range lengths {
+		if pattern[i] != 'x' && int(pattern[i]-'0') != lengths[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func nextPermutation(a []int) bool {
+	i := len(a) - 2
+	for i >= 0 && a[i] >= a[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(a) - 1
+	for a[j] <= a[i] {
+		j--
+	}
+	a[i], a[j] = a[j], a[i]
+	for l, r := i+1, len(a)-1; l < r; l, r = l+1, r-1 {
+		a[l], a[r] = a[r], a[l]
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package bridge
+
+import (
+	"testing"
+)
+
+func hand(t *testing.T, pbn string) Deal {
+	d, err := ParseDeal(pbn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+const sample = "N:AKQ2.K32.J432.32 JT98.AQ4.AK5.T98 7654.J876.Q6.AKQ 3.T95.T987.J7654"
+
+func TestFeatures(t *testing.T) {
+	d := hand(t, sample)
+	tests := []struct {
+		seat                  Seat
+		hcp, controls, losers int
+		shape                 [4]int
+		balanced              bool
+	}{
+		{North, 13, 4, 7, [4]int{4, 3, 4, 2}, true},
+		{East, 14, 5, 8, [4]int{4, 3, 3, 3}, true},
+		{South, 12, 3, 8, [4]int{4, 4, 2, 3}, true},
+		{West, 1, 0, 10, [4]int{1, 3, 4, 5}, false},
+	}
+	for _, tt := range tests {
+		h := d[tt.seat]
+		if HCP(h) != tt.hcp || Controls(h) != tt.controls || Losers(h) != tt.losers {
+			t.Error("Expected", tt.hcp, tt.controls, tt.losers, "for", tt.seat, "received:", HCP(h), Controls(h), Losers(h))
+		}
+		if Shape(h) != tt.shape || Balanced(h) != tt.balanced {
+			t.Error("Expected", tt.shape, tt.balanced, "for", tt.seat, "received:", Shape(h), Balanced(h))
+		}
+	}
+}
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		shape   [4]int
+		pattern string
+		ok      bool
+	}{
+		{[4]int{4, 3, 4, 2}, "4342", true},
+		{[4]int{4, 3, 4, 2}, "any 4432", true},
+		{[4]int{4, 3, 4, 2}, "4432", false},
+		{[4]int{5, 5, 2, 1}, "55xx", true},
+		{[4]int{5, 2, 5, 1}, "55xx", false},
+		{[4]int{5, 2, 5, 1}, "any 55xx", true},
+		{[4]int{4, 3, 3, 3}, "any 5332", false},
+	}
+	for _, tt := range tests {
+		if Pattern(tt.shape, tt.pattern) != tt.ok {
+			t.Error("Expected", tt.shape, "matching", tt.pattern, "to be", tt.ok)
+		}
+	}
+}
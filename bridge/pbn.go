@@ -0,0 +1,104 @@
+package bridge
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// ErrPBN is returned when a deal in PBN can't be parsed
+var ErrPBN = errors.New("bridge: malformed PBN deal")
+
+const rankChars = "AKQJT98765432"
+
+var vulnerability = [16]string{
+	"None", "NS", "EW", "All", "NS", "EW", "All", "None",
+	"EW", "All", "None", "NS", "All", "None", "NS", "EW",
+}
+
+// PBN returns the deal in the notation of the PBN Deal tag, starting from North:
+// "N:AKQ2.K32.5432.32 ..." with the suits of every hand in bridge order.
+func (d Deal) PBN() string {
+	var b strings.Builder
+	b.WriteString("N:")
+	for seat, hand := range d {
+		if seat > 0 {
+			b.WriteByte(' ')
+		}
+		for i, s := range Suits {
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			for _, c := range Suited(hand, s) {
+				b.WriteByte(rankChar(c.Rank))
+			}
+		}
+	}
+	return b.String()
+}
+
+// ParseDeal reads a deal in the notation of the PBN Deal tag. The first hand belongs to the seat before the colon.
+func ParseDeal(s string) (Deal, error) {
+	var d Deal
+	parts := strings.SplitN(strings.TrimSpace(s), ":", 2)
+	if len(parts) != 2 || len(parts[0]) != 1 {
+		return d, ErrPBN
+	}
+	first := strings.Index("NESW", strings.ToUpper(parts[0]))
+	hands := strings.Fields(parts[1])
+	if first < 0 || len(hands) != 4 {
+		return d, ErrPBN
+	}
+
+	for i, hand := range hands {
+		seat := (first + i) % 4
+		suits := strings.Split(hand, ".")
+		if len(suits) != 4 {
+			return d, ErrPBN
+		}
+		for j, ranks := range suits {
+			for _, r := range strings.ToUpper(ranks) {
+				rank, ok := parseRank(byte(r))
+				if !ok {
+					return d, ErrPBN
+				}
+				d[seat] = append(d[seat], deck.Card{Suit: Suits[j], Rank: rank})
+			}
+		}
+	}
+	return d, nil
+}
+
+// WritePBN writes deals as the boards of a PBN file, numbered from 1, with the dealer and the vulnerability
+// following the usual rotation.
+func WritePBN(w io.Writer, deals []Deal) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("% PBN 2.1\n% EXPORT\n")
+	for i, d := range deals {
+		board := i + 1
+		fmt.Fprintf(bw, "\n[Board \"%d\"]\n", board)
+		fmt.Fprintf(bw, "[Dealer \"%c\"]\n", "NESW"[(board-1)%4])
+		fmt.Fprintf(bw, "[Vulnerable \"%s\"]\n", vulnerability[(board-1)%16])
+		fmt.Fprintf(bw, "[Deal \"%s\"]\n", d.PBN())
+	}
+	return bw.Flush()
+}
+
+func rankChar(r deck.Rank) byte {
+	return rankChars[Power(deck.Ace)-Power(r)]
+}
+
+func parseRank(c byte) (deck.Rank, bool) {
+	i := strings.IndexByte(rankChars, c)
+	switch {
+	case i < 0:
+		return 0, false
+	case i == 0:
+		return deck.Ace, true
+	}
+	return deck.King - deck.Rank(i-1), true
+}
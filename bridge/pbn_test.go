@@ -0,0 +1,38 @@
+package bridge
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDeal(t *testing.T) {
+	d := hand(t, sample)
+	if d.PBN() != sample {
+		t.Error("Expected", sample, "received:", d.PBN())
+	}
+
+	rotated := hand(t, "E:JT98.AQ4.AK5.T98 7654.J876.Q6.AKQ 3.T95.T987.J7654 AKQ2.K32.J432.32")
+	if rotated.PBN() != sample {
+		t.Error("Expected the hands to start from East, received:", rotated.PBN())
+	}
+
+	for _, bad := range []string{"AKQ2.K32.J432.32", "X:AKQ2.K32.J432.32 . . .", "N:AKQ2.K32 a b c", "N:AKQ1.K32.J432.32 ... ... ..."} {
+		if _, err := ParseDeal(bad); err != ErrPBN {
+			t.Error("Expected", ErrPBN, "for", bad, "received:", err)
+		}
+	}
+}
+
+func TestWritePBN(t *testing.T) {
+	d := hand(t, sample)
+	var b strings.Builder
+	if err := WritePBN(&b, []Deal{d, d, d}); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, tag := range []string{`[Board "3"]`, `[Dealer "S"]`, `[Vulnerable "EW"]`, `[Deal "` + sample + `"]`} {
+		if !strings.Contains(out, tag) {
+			t.Error("Expected", tag, "in", out)
+		}
+	}
+}
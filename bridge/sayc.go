@@ -0,0 +1,253 @@
+package bridge
+
+// SAYC returns the Standard American Yellow Card system, with its usual conventions: Stayman, Jacoby transfers and
+// Blackwood. Only the common auctions are covered, and the bots pass when no rule fits.
+func SAYC() *System {
+	return Natural().Use(Blackwood()).Use(Jacoby()).Use(Stayman())
+}
+
+// Natural returns the natural core of SAYC: five-card majors, a 15-17 notrump, strong 2C, weak twos and the
+// natural responses, with no conventions.
+func Natural() *System {
+	s := &System{Name: "SAYC"}
+	s.Rules = append(s.Rules, openings()...)
+	s.Rules = append(s.Rules, notrumpResponses()...)
+	s.Rules = append(s.Rules, majorResponses()...)
+	s.Rules = append(s.Rules, minorResponses()...)
+	s.Rules = append(s.Rules, overcalls()...)
+	return s
+}
+
+func openings() []Rule {
+	rules := []Rule{
+		{nil, "2C", "22+ HCP, artificial and forcing", points(22, 40)},
+		{nil, "2NT", "20-21 HCP, balanced", all(points(20, 21), balanced)},
+		{nil, "1NT", "15-17 HCP, balanced", all(points(15, 17), balanced)},
+		{nil, "1S", "12-21 HCP, 5+ spades", all(points(12, 21), length(Spades, 5), func(f Features) bool {
+			return f.Len(Spades) >= f.Len(Hearts)
+		})},
+		{nil, "1H", "12-21 HCP, 5+ hearts", all(points(12, 21), length(Hearts, 5))},
+		{nil, "1D", "12-21 HCP, 3+ diamonds, usually 4+", all(points(12, 21), func(f Features) bool {
+			d, c := f.Len(Diamonds), f.Len(Clubs)
+			return d >= 3 && (d > c || d == c && d >= 4)
+		})},
+		{nil, "1C", "12-21 HCP, 3+ clubs", points(12, 21)},
+	}
+	for _, s := range []Strain{Diamonds, Hearts, Spades} {
+		rules = append(rules, Rule{nil, bid(2, s), "5-11 HCP, weak two, 6 " + suitName(s), all(points(5, 11), length(s, 6), most(s, 6))})
+	}
+	for _, s := range []Strain{Clubs, Diamonds, Hearts, Spades} {
+		rules = append(rules, Rule{nil, bid(3, s), "5-10 HCP, preempt, 7+ " + suitName(s), all(points(5, 10), length(s, 7))})
+	}
+	return append(rules, Rule{nil, "P", "no opening bid", always})
+}
+
+func notrumpResponses() []Rule {
+	nt := []string{"1NT", "P"}
+	rules := []Rule{
+		{nt, "4NT", "16-17 HCP, quantitative, invites 6NT", all(points(16, 17), balanced)},
+		{nt, "3NT", "10-15 HCP, to play", points(10, 15)},
+		{nt, "2NT", "8-9 HCP, invites 3NT", points(8, 9)},
+		{nt, "2S", "0-7 HCP, 5+ spades, to play", all(points(0, 7), length(Spades, 5))},
+		{nt, "2H", "0-7 HCP, 5+ hearts, to play", all(points(0, 7), length(Hearts, 5))},
+		{nt, "P", "0-7 HCP", points(0, 7)},
+		{after(nt, "2NT", "P"), "3NT", "maximum, accepts the invitation", points(16, 17)},
+		{after(nt, "2NT", "P"), "P", "minimum, declines the invitation", always},
+		{after(nt, "4NT", "P"), "6NT", "maximum, accepts the invitation", points(17, 17)},
+		{after(nt, "4NT", "P"), "P", "minimum, declines the invitation", always},
+	}
+	return rules
+}
+
+func majorResponses() []Rule {
+	var rules []Rule
+	for _, m := range []Strain{Hearts, Spades} {
+		open := []string{bid(1, m), "P"}
+		fit := length(m, 3)
+		rules = append(rules,
+			Rule{open, bid(4, m), "weak, 5+ " + suitName(m), all(points(0, 9), length(m, 5))},
+			Rule{open, bid(3, m), "10-12 HCP, 3+ " + suitName(m) + ", limit raise", all(points(10, 12), fit)},
+			Rule{open, bid(2, m), "6-10 HCP, 3+ " + suitName(m), all(points(6, 10), fit)},
+		)
+		if m == Hearts {
+			rules = append(rules, Rule{open, "1S", "6+ HCP, 4+ spades", all(points(6, 40), length(Spades, 4))})
+		}
+		rules = append(rules,
+			Rule{open, "2D", "11+ HCP, 4+ diamonds", all(points(11, 40), length(Diamonds, 4), func(f Features) bool {
+				return f.Len(Diamonds) > f.Len(Clubs)
+			})},
+			Rule{open, "2C", "11+ HCP, 3+ clubs", all(points(11, 40), length(Clubs, 3))},
+			Rule{open, "1NT", "6-10 HCP, no fit", points(6, 10)},
+			Rule{open, "P", "0-5 HCP", always},
+
+			Rule{after(open, bid(2, m), "P"), bid(4, m), "19-21 HCP, to play", points(19, 21)},
+			Rule{after(open, bid(2, m), "P"), bid(3, m), "16-18 HCP, invites game", points(16, 18)},
+			Rule{after(open, bid(2, m), "P"), "P", "minimum", always},
+			Rule{after(open, bid(3, m), "P"), bid(4, m), "accepts the invitation", points(14, 40)},
+			Rule{after(open, bid(3, m), "P"), "P", "minimum, declines the invitation", always},
+		)
+	}
+	return rules
+}
+
+func minorResponses() []Rule {
+	var rules []Rule
+	for _, m := range []Strain{Clubs, Diamonds} {
+		open := []string{bid(1, m), "P"}
+		rules = append(rules,
+			Rule{open, "1H", "6+ HCP, 4+ hearts", all(points(6, 40), length(Hearts, 4), func(f Features) bool {
+				return f.Len(Hearts) >= f.Len(Spades)
+			})},
+			Rule{open, "1S", "6+ HCP, 4+ spades", all(points(6, 40), length(Spades, 4))},
+		)
+		if m == Clubs {
+			rules = append(rules, Rule{open, "1D", "6+ HCP, 4+ diamonds", all(points(6, 40), length(Diamonds, 4))})
+		}
+		rules = append(rules,
+			Rule{open, "3NT", "16-17 HCP, balanced, stoppers in the majors", all(points(16, 17), balanced, stopped(Hearts, Spades))},
+			Rule{open, "2NT", "13-15 HCP, balanced, stoppers in the majors", all(points(13, 15), balanced, stopped(Hearts, Spades))},
+			Rule{open, "1NT", "6-10 HCP", points(6, 10)},
+			Rule{open, "P", "0-5 HCP", always},
+		)
+	}
+	return rules
+}
+
+func overcalls() []Rule {
+	var rules []Rule
+	for _, o := range []Strain{Clubs, Diamonds, Hearts, Spades} {
+		open := []string{bid(1, o)}
+		rules = append(rules, Rule{open, "1NT", "15-18 HCP, balanced, stopper in " + suitName(o), all(points(15, 18), balanced, stopped(o))})
+		for s := Spades; s > o; s-- {
+			rules = append(rules, Rule{open, bid(1, s), "8-16 HCP, 5+ " + suitName(s), all(points(8, 16), length(s, 5))})
+		}
+		rules = append(rules, Rule{open, "P", "no overcall", always})
+	}
+	return rules
+}
+
+// Stayman returns the rules of the Stayman convention: over 1NT, 2C asks opener for a four-card major.
+func Stayman() []Rule {
+	nt := []string{"1NT", "P"}
+	ask := after(nt, "2C", "P")
+	fourCardMajor := func(f Features) bool {
+		return (f.Len(Hearts) == 4 || f.Len(Spades) == 4) && f.Len(Hearts) < 5 && f.Len(Spades) < 5
+	}
+	rules := []Rule{
+		{nt, "2C", "Stayman, 8+ HCP, asks for a four-card major", all(points(8, 40), fourCardMajor)},
+		{ask, "2H", "4+ hearts, may hold four spades", length(Hearts, 4)},
+		{ask, "2S", "4+ spades, denies four hearts", length(Spades, 4)},
+		{ask, "2D", "denies a four-card major", always},
+	}
+	for _, m := range []Strain{Hearts, Spades} {
+		shown := after(ask, bid(2, m), "P")
+		rules = append(rules,
+			Rule{shown, bid(4, m), "10-15 HCP, fit in " + suitName(m), all(points(10, 15), length(m, 4))},
+			Rule{shown, bid(3, m), "8-9 HCP, fit in " + suitName(m) + ", invites game", all(points(8, 9), length(m, 4))},
+			Rule{shown, "3NT", "10-15 HCP, no fit", points(10, 15)},
+			Rule{shown, "2NT", "8-9 HCP, no fit, invites 3NT", points(8, 9)},
+			Rule{after(shown, bid(3, m), "P"), bid(4, m), "maximum, accepts the invitation", points(16, 17)},
+			Rule{after(shown, bid(3, m), "P"), "P", "minimum, declines the invitation", always},
+			Rule{after(shown, "2NT", "P"), "3NT", "maximum, accepts the invitation", points(16, 17)},
+			Rule{after(shown, "2NT", "P"), "P", "minimum, declines the invitation", always},
+		)
+	}
+	denied := after(ask, "2D", "P")
+	return append(rules,
+		Rule{denied, "3NT", "10-15 HCP", points(10, 15)},
+		Rule{denied, "2NT", "8-9 HCP, invites 3NT", points(8, 9)},
+		Rule{after(denied, "2NT", "P"), "3NT", "maximum, accepts the invitation", points(16, 17)},
+		Rule{after(denied, "2NT", "P"), "P", "minimum, declines the invitation", always},
+	)
+}
+
+// Jacoby returns the rules of Jacoby transfers over 1NT: 2D shows hearts and 2H shows spades, opener bids the suit
+// shown and responder describes the hand.
+func Jacoby() []Rule {
+	nt := []string{"1NT", "P"}
+	rules := []Rule{
+		{nt, "2D", "transfer, 5+ hearts", all(length(Hearts, 5), func(f Features) bool { return f.Len(Hearts) >= f.Len(Spades) })},
+		{nt, "2H", "transfer, 5+ spades", length(Spades, 5)},
+	}
+	for _, m := range []Strain{Hearts, Spades} {
+		transfer := after(nt, bid(2, m-1), "P")
+		done := after(transfer, bid(2, m), "P")
+		rules = append(rules,
+			Rule{transfer, bid(2, m), "completes the transfer", always},
+			Rule{done, bid(4, m), "10-15 HCP, 6+ " + suitName(m) + ", to play", all(points(10, 15), length(m, 6))},
+			Rule{done, "3NT", "10-15 HCP, 5 " + suitName(m) + ", choice of games", points(10, 15)},
+			Rule{done, bid(3, m), "8-9 HCP, 6+ " + suitName(m) + ", invites game", all(points(8, 9), length(m, 6))},
+			Rule{done, "2NT", "8-9 HCP, 5 " + suitName(m) + ", invites game", points(8, 9)},
+			Rule{done, "P", "0-7 HCP, to play", always},
+			Rule{after(done, "3NT", "P"), bid(4, m), "3+ " + suitName(m), length(m, 3)},
+			Rule{after(done, "3NT", "P"), "P", "2 " + suitName(m), always},
+		)
+	}
+	return rules
+}
+
+// Blackwood returns the rules of the Blackwood convention: 4NT asks partner how many aces they hold, answered in
+// steps from 5C, and the asker settles the level of the contract. Opener asks after a limit raise of a major.
+func Blackwood() []Rule {
+	responses := []struct {
+		call, meaning string
+		aces          []int
+	}{
+		{"5C", "0 or 4 aces", []int{0, 4}},
+		{"5D", "1 ace", []int{1}},
+		{"5H", "2 aces", []int{2}},
+		{"5S", "3 aces", []int{3}},
+	}
+
+	var rules []Rule
+	for _, r := range responses {
+		aces := r.aces
+		rules = append(rules, Rule{[]string{"*", "4NT", "P"}, r.call, r.meaning, func(f Features) bool {
+			for _, n := range aces {
+				if f.Aces == n {
+					return true
+				}
+			}
+			return false
+		}})
+	}
+
+	for _, m := range []Strain{Hearts, Spades} {
+		ask := []string{bid(1, m), "P", bid(3, m), "P"}
+		rules = append(rules, Rule{ask, "4NT", "Blackwood, asks for aces", points(18, 40)})
+		for _, r := range responses {
+			shown := r.aces[0]
+			answered := after(ask, "4NT", "P", r.call, "P")
+			rules = append(rules,
+				Rule{answered, bid(6, m), "small slam, one ace missing at most", func(f Features) bool {
+					if f.Aces == 0 && shown == 0 {
+						return true
+					}
+					return f.Aces+shown >= 3
+				}},
+				Rule{answered, bid(5, m), "two aces missing, signs off", always},
+			)
+		}
+	}
+	return rules
+}
+
+// after returns the auction of a rule that continues another one.
+func after(auction []string, calls ...string) []string {
+	return append(append([]string(nil), auction...), calls...)
+}
+
+func stopped(strains ...Strain) func(Features) bool {
+	return func(f Features) bool {
+		for _, s := range strains {
+			if !f.Stopper(s) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func suitName(s Strain) string {
+	return [...]string{"clubs", "diamonds", "hearts", "spades", "notrump"}[s]
+}
@@ -0,0 +1,470 @@
+package bridge
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/euller88/deck"
+	"github.com/euller88/deck/deal"
+)
+
+// DefaultProduce is the number of deals generated by a script without a produce statement
+const DefaultProduce = 40
+
+// Script is a compiled description of the deals wanted, in a language close to the one of the dealer program:
+//
+//	# 1NT opening facing a heart suit
+//	produce 10
+//	predeal north SAK, HQ
+//	condition hcp(north) >= 15 && hcp(north) <= 17 && balanced(north)
+//	condition hearts(south) >= 5 && shape(south, any 5422 + any 5431 + 55xx)
+//
+// Statements are produce, the number of deals, predeal, cards given to a seat, and condition, an expression every
+// deal must satisfy. Several conditions must all hold. Expressions combine numbers and the functions below with
+// the arithmetic, comparison and logical operators, "and", "or" and "not" standing for "&&", "||" and "!".
+// As in C, comparisons are 1 when true and 0 when false, and any number but 0 is true.
+//
+//	hcp(seat), hcp(seat, suit)  high card points
+//	spades(seat) ... clubs(seat) suit lengths
+//	controls(seat)              2 for every Ace and 1 for every King
+//	losers(seat)                losing trick count
+//	balanced(seat)              1 for the 4333, 4432 and 5332 shapes
+//	shape(seat, patterns)       1 when the shape matches any of the patterns joined by +
+//	hascard(seat, card)         1 when the seat holds the card, written as rank and suit, such as TC
+type Script struct {
+	Produce int
+
+	predeal    [4][]deck.Card
+	conditions []expr
+}
+
+type expr func(d *Deal) int
+
+// Compile parses a script.
+func Compile(src string) (*Script, error) {
+	p := &parser{tokens: lex(src)}
+	s := &Script{Produce: DefaultProduce}
+	for !p.done() {
+		t := p.next()
+		switch strings.ToLower(t.text) {
+		case "produce":
+			n, err := p.number()
+			if err != nil {
+				return nil, err
+			}
+			s.Produce = n
+		case "predeal":
+			seat, err := p.seat()
+			if err != nil {
+				return nil, err
+			}
+			for {
+				cards, err := p.holding()
+				if err != nil {
+					return nil, err
+				}
+				s.predeal[seat] = append(s.predeal[seat], cards...)
+				if !p.accept(",") {
+					break
+				}
+			}
+		case "condition":
+			e, err := p.or()
+			if err != nil {
+				return nil, err
+			}
+			s.conditions = append(s.conditions, e)
+		default:
+			return nil, t.errorf("unknown statement %q", t.text)
+		}
+	}
+	return s, nil
+}
+
+// Match reports if a deal satisfies every condition of the script.
+func (s *Script) Match(d Deal) bool {
+	for _, c := range s.conditions {
+		if c(&d) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Generate deals at random, from a deck seeded by seed, as many deals as the script produces.
+func (s *Script) Generate(seed int64) ([]Deal, error) {
+	g := deal.New(deck.New(), []int{13, 13, 13, 13}, seed).Where(func(hands [][]deck.Card) bool {
+		return s.Match(Deal{hands[0], hands[1], hands[2], hands[3]})
+	})
+	for seat, cards := range s.predeal {
+		g.Holds(seat, cards...)
+	}
+
+	deals := make([]Deal, 0, s.Produce)
+	for len(deals) < s.Produce {
+		hands, _, err := g.Deal()
+		if err != nil {
+			return deals, err
+		}
+		deals = append(deals, Deal{hands[0], hands[1], hands[2], hands[3]})
+	}
+	return deals, nil
+}
+
+type token struct {
+	text string
+	line int
+}
+
+func (t token) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("bridge: line %d: %s", t.line, fmt.Sprintf(format, args...))
+}
+
+// lex splits a script into words, numbers and operators, dropping the comments.
+func lex(src string) []token {
+	var tokens []token
+	for n, line := range strings.Split(src, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		for i := 0; i < len(line); {
+			c := rune(line[i])
+			j := i + 1
+			switch {
+			case unicode.IsSpace(c):
+				i++
+				continue
+			case unicode.IsLetter(c) || unicode.IsDigit(c):
+				for j < len(line) && (unicode.IsLetter(rune(line[j])) || unicode.IsDigit(rune(line[j]))) {
+					j++
+				}
+			case strings.ContainsRune("&|=!<>", c) && j < len(line) && strings.ContainsRune("&|=", rune(line[j])):
+				j++
+			}
+			tokens = append(tokens, token{text: line[i:j], line: n + 1})
+			i = j
+		}
+	}
+	return tokens
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		line := 0
+		if len(p.tokens) > 0 {
+			line = p.tokens[len(p.tokens)-1].line
+		}
+		return token{line: line}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) accept(texts ...string) bool {
+	t := strings.ToLower(p.peek().text)
+	for _, text := range texts {
+		if t == text {
+			p.pos++
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if t := p.peek(); !p.accept(text) {
+		return t.errorf("expected %q, found %q", text, t.text)
+	}
+	return nil
+}
+
+func (p *parser) number() (int, error) {
+	t := p.next()
+	n, err := strconv.Atoi(t.text)
+	if err != nil {
+		return 0, t.errorf("expected a number, found %q", t.text)
+	}
+	return n, nil
+}
+
+func (p *parser) seat() (Seat, error) {
+	t := p.next()
+	for s, name := range seatNames {
+		if strings.EqualFold(t.text, name) {
+			return Seat(s), nil
+		}
+	}
+	return 0, t.errorf("expected a seat, found %q", t.text)
+}
+
+func (p *parser) suit() (deck.Suit, error) {
+	t := p.next()
+	for _, s := range Suits {
+		if strings.EqualFold(t.text, s.String()+"s") {
+			return s, nil
+		}
+	}
+	return 0, t.errorf("expected a suit, found %q", t.text)
+}
+
+// holding parses the cards of a suit, such as SAK2.
+func (p *parser) holding() ([]deck.Card, error) {
+	t := p.next()
+	w := strings.ToUpper(t.text)
+	if len(w) < 2 || strings.IndexByte("SHDC", w[0]) < 0 {
+		return nil, t.errorf("expected a suit holding, found %q", t.text)
+	}
+	i := strings.IndexByte("SHDC", w[0])
+	var cards []deck.Card
+	for j := 1; j < len(w); j++ {
+		r, ok := parseRank(w[j])
+		if !ok {
+			return nil, t.errorf("unknown rank %q", w[j])
+		}
+		cards = append(cards, deck.Card{Suit: Suits[i], Rank: r})
+	}
+	return cards, nil
+}
+
+func (p *parser) or() (expr, error) {
+	left, err := p.and()
+	for err == nil && p.accept("||", "or") {
+		var right expr
+		l := left
+		if right, err = p.and(); err == nil {
+			left = func(d *Deal) int { return truth(l(d) != 0 || right(d) != 0) }
+		}
+	}
+	return left, err
+}
+
+func (p *parser) and() (expr, error) {
+	left, err := p.not()
+	for err == nil && p.accept("&&", "and") {
+		var right expr
+		l := left
+		if right, err = p.not(); err == nil {
+			left = func(d *Deal) int { return truth(l(d) != 0 && right(d) != 0) }
+		}
+	}
+	return left, err
+}
+
+func (p *parser) not() (expr, error) {
+	if p.accept("!", "not") {
+		e, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return func(d *Deal) int { return truth(e(d) == 0) }, nil
+	}
+	return p.comparison()
+}
+
+var comparisons = map[string]func(a, b int) bool{
+	"==": func(a, b int) bool { return a == b },
+	"!=": func(a, b int) bool { return a != b },
+	"<":  func(a, b int) bool { return a < b },
+	"<=": func(a, b int) bool { return a <= b },
+	">":  func(a, b int) bool { return a > b },
+	">=": func(a, b int) bool { return a >= b },
+}
+
+func (p *parser) comparison() (expr, error) {
+	left, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	cmp, ok := comparisons[p.peek().text]
+	if !ok {
+		return left, nil
+	}
+	p.pos++
+	right, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	return func(d *Deal) int { return truth(cmp(left(d), right(d))) }, nil
+}
+
+func (p *parser) sum() (expr, error) {
+	left, err := p.product()
+	for err == nil {
+		op := p.peek().text
+		if op != "+" && op != "-" {
+			break
+		}
+		p.pos++
+		var right expr
+		l := left
+		if right, err = p.product(); err == nil && op == "+" {
+			left = func(d *Deal) int { return l(d) + right(d) }
+		} else if err == nil {
+			left = func(d *Deal) int { return l(d) - right(d) }
+		}
+	}
+	return left, err
+}
+
+func (p *parser) product() (expr, error) {
+	left, err := p.unary()
+	for err == nil && p.accept("*") {
+		var right expr
+		l := left
+		if right, err = p.unary(); err == nil {
+			left = func(d *Deal) int { return l(d) * right(d) }
+		}
+	}
+	return left, err
+}
+
+func (p *parser) unary() (expr, error) {
+	if p.accept("-") {
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(d *Deal) int { return -e(d) }, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (expr, error) {
+	t := p.peek()
+	if p.accept("(") {
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	}
+	if n, err := strconv.Atoi(t.text); err == nil {
+		p.pos++
+		return func(*Deal) int { return n }, nil
+	}
+
+	name := strings.ToLower(p.next().text)
+	if name == "" {
+		return nil, t.errorf("unexpected end of script")
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	seat, err := p.seat()
+	if err != nil {
+		return nil, err
+	}
+	e, err := p.function(t, name, seat)
+	if err != nil {
+		return nil, err
+	}
+	return e, p.expect(")")
+}
+
+// function parses the arguments after the seat of a function call.
+func (p *parser) function(t token, name string, seat Seat) (expr, error) {
+	hand := func(f func([]deck.Card) int) expr {
+		return func(d *Deal) int { return f(d[seat]) }
+	}
+	switch name {
+	case "hcp":
+		if !p.accept(",") {
+			return hand(HCP), nil
+		}
+		s, err := p.suit()
+		return hand(func(h []deck.Card) int { return SuitHCP(h, s) }), err
+	case "spades", "hearts", "diamonds", "clubs":
+		s := Suits[strings.Index("shdc", name[:1])]
+		return hand(func(h []deck.Card) int { return Length(h, s) }), nil
+	case "controls":
+		return hand(Controls), nil
+	case "losers":
+		return hand(Losers), nil
+	case "balanced":
+		return hand(func(h []deck.Card) int { return truth(Balanced(h)) }), nil
+	case "shape":
+		patterns, err := p.patterns()
+		return hand(func(h []deck.Card) int {
+			shape := Shape(h)
+			for _, pattern := range patterns {
+				if Pattern(shape, pattern) {
+					return 1
+				}
+			}
+			return 0
+		}), err
+	case "hascard":
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		c, err := p.card()
+		return hand(func(h []deck.Card) int {
+			for _, hc := range h {
+				if hc == c {
+					return 1
+				}
+			}
+			return 0
+		}), err
+	}
+	return nil, t.errorf("unknown function %q", name)
+}
+
+// patterns parses shape patterns joined by +, such as any 4333 + 5xxx.
+func (p *parser) patterns() ([]string, error) {
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+	var patterns []string
+	for {
+		prefix := ""
+		if p.accept("any") {
+			prefix = "any "
+		}
+		t := p.next()
+		pattern := prefix + t.text
+		if len(t.text) != 4 || strings.Trim(strings.ToLower(t.text), "0123456789x") != "" {
+			return nil, t.errorf("bad shape pattern %q", t.text)
+		}
+		patterns = append(patterns, pattern)
+		if !p.accept("+") {
+			return patterns, nil
+		}
+	}
+}
+
+// card parses a card written as rank and suit, such as AS or TC.
+func (p *parser) card() (deck.Card, error) {
+	t := p.next()
+	w := strings.ToUpper(t.text)
+	if len(w) == 2 {
+		r, ok := parseRank(w[0])
+		if i := strings.IndexByte("SHDC", w[1]); ok && i >= 0 {
+			return deck.Card{Suit: Suits[i], Rank: r}, nil
+		}
+	}
+	return deck.Card{}, t.errorf("expected a card, found %q", t.text)
+}
+
+func truth(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
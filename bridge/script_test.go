@@ -0,0 +1,91 @@
+package bridge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestCompile(t *testing.T) {
+	d := hand(t, sample)
+	tests := []struct {
+		cond string
+		ok   bool
+	}{
+		{"hcp(north) == 13 and hcp(east, spades) == 1", true},
+		{"hcp(north) + hcp(south) >= 25", true},
+		{"hearts(south) >= 4 && !balanced(west)", true},
+		{"shape(west, any 5431 + 4333)", true},
+		{"shape(north, 4432)", false},
+		{"controls(east) * 2 - losers(east) == 2", true},
+		{"hascard(west, JC) && not hascard(west, AS)", true},
+		{"(spades(north) > 4 or clubs(south) == 3) and -1", true},
+		{"diamonds(north) < 4", false},
+	}
+	for _, tt := range tests {
+		s, err := Compile("condition " + tt.cond)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Match(d) != tt.ok {
+			t.Error("Expected", tt.cond, "to be", tt.ok)
+		}
+	}
+
+	for _, bad := range []string{
+		"produce many",
+		"condition hcp(north",
+		"condition hcp(nowhere) > 10",
+		"condition length(north) > 5",
+		"condition shape(north, 44)",
+		"condition hascard(north, 1S)",
+		"predeal north XAK",
+		"predeal north",
+		"deal 5",
+	} {
+		if _, err := Compile(bad); err == nil || !strings.HasPrefix(err.Error(), "bridge: line 1") {
+			t.Error("Expected a syntax error for", bad, "received:", err)
+		}
+	}
+}
+
+const notrump = `
+# A 15-17 notrump opening facing five hearts
+produce 20
+predeal north SAK
+condition hcp(north) >= 15 && hcp(north) <= 17
+	&& balanced(north)
+condition hearts(south) >= 5
+`
+
+func TestGenerate(t *testing.T) {
+	s, err := Compile(notrump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deals, err := s.Generate(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deals) != 20 {
+		t.Fatal("Expected 20 deals, received:", len(deals))
+	}
+	for _, d := range deals {
+		n := d[North]
+		if HCP(n) < 15 || HCP(n) > 17 || !Balanced(n) || Length(d[South], deck.Heart) < 5 || Suited(n, deck.Spade)[0].Rank != deck.Ace {
+			t.Error("Expected the deal to satisfy the script, received:", d.PBN())
+		}
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	s, err := Compile("produce 1\ncondition hcp(north) >= 15 && hcp(north) <= 17 && balanced(north)")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Generate(int64(i))
+	}
+}
@@ -0,0 +1,241 @@
+package bridge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// Features holds what a bidding system looks at in a hand.
+type Features struct {
+	HCP      int
+	Shape    [4]int
+	Balanced bool
+	Aces     int
+	Kings    int
+
+	stoppers [4]bool
+}
+
+// Evaluate computes the features of a hand.
+func Evaluate(hand []deck.Card) Features {
+	f := Features{HCP: HCP(hand), Shape: Shape(hand), Balanced: Balanced(hand)}
+	for _, c := range hand {
+		switch c.Rank {
+		case deck.Ace:
+			f.Aces++
+		case deck.King:
+			f.Kings++
+		}
+	}
+	for i, s := range Suits {
+		f.stoppers[i] = stopper(Suited(hand, s))
+	}
+	return f
+}
+
+// stopper reports if the cards of a suit stop it in notrump: an Ace, a guarded King, Queen or Jack.
+func stopper(cards []deck.Card) bool {
+	for i, c := range cards {
+		need := 0
+		switch c.Rank {
+		case deck.Ace:
+		case deck.King:
+			need = 1
+		case deck.Queen:
+			need = 2
+		case deck.Jack:
+			need = 3
+		default:
+			continue
+		}
+		if len(cards)-i-1 >= need {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the length of the suit of a strain.
+func (f Features) Len(s Strain) int {
+	return f.Shape[index(s)]
+}
+
+// Stopper reports if the suit of a strain is stopped.
+func (f Features) Stopper(s Strain) bool {
+	return f.stoppers[index(s)]
+}
+
+// index returns the position of a suit strain in bridge order.
+func index(s Strain) int {
+	return int(Spades - s)
+}
+
+// Rule is an entry of the table of a bidding system: the call to make in a given auction with a given hand.
+type Rule struct {
+	// Auction holds the calls since the opening bid, the first call not being a pass, or nothing for an opening.
+	// The caller is the player after the last one. A leading "*" matches any calls before the ones that follow,
+	// and rules starting with it are tried only when no rule matches the whole auction.
+	Auction []string
+
+	// Call is the call made, and Meaning what it tells partner
+	Call    string
+	Meaning string
+
+	// Hand reports if a hand fits the rule
+	Hand func(f Features) bool
+}
+
+// matches reports if the rule applies to the calls of an auction since the opening bid.
+func (r Rule) matches(calls []string) bool {
+	pattern := r.Auction
+	if len(pattern) > 0 && pattern[0] == "*" {
+		pattern = pattern[1:]
+		if len(calls) < len(pattern) {
+			return false
+		}
+		calls = calls[len(calls)-len(pattern):]
+	}
+	if len(calls) != len(pattern) {
+		return false
+	}
+	for i := range calls {
+		if !strings.EqualFold(calls[i], pattern[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Rule) wild() bool {
+	return len(r.Auction) > 0 && r.Auction[0] == "*"
+}
+
+// System is a bidding system, a table of rules tried in order.
+type System struct {
+	Name  string
+	Rules []Rule
+}
+
+// Use adds the rules of a convention to the system, ahead of the rules it already has.
+func (s *System) Use(convention []Rule) *System {
+	s.Rules = append(append([]Rule(nil), convention...), s.Rules...)
+	return s
+}
+
+// Choose returns the call the system makes with hand in the auction, and the rule that led to it.
+// When no rule fits, the system passes, with an empty rule.
+func (s *System) Choose(a *Auction, hand []deck.Card) (Call, Rule) {
+	f := Evaluate(hand)
+	for _, r := range s.candidates(a.Calls) {
+		c := MustParseCall(r.Call)
+		if r.Hand(f) && a.Legal(c) == nil {
+			return c, r
+		}
+	}
+	return Call{}, Rule{}
+}
+
+// Explain returns the meaning of every call of an auction, according to the system, or "" when it has no rule for a call.
+func (s *System) Explain(a *Auction) []string {
+	meanings := make([]string, len(a.Calls))
+	for i, c := range a.Calls {
+		for _, r := range s.candidates(a.Calls[:i]) {
+			if MustParseCall(r.Call) == c {
+				meanings[i] = r.Meaning
+				break
+			}
+		}
+	}
+	return meanings
+}
+
+// candidates returns the rules matching the calls, the exact ones before the ones with a leading "*".
+func (s *System) candidates(calls []Call) []Rule {
+	// Leading passes don't change the meaning of the calls
+	start := 0
+	for start < len(calls) && calls[start].Kind == Pass {
+		start++
+	}
+	names := make([]string, 0, len(calls)-start)
+	for _, c := range calls[start:] {
+		names = append(names, c.String())
+	}
+
+	var exact, wild []Rule
+	for _, r := range s.Rules {
+		switch {
+		case !r.matches(names):
+		case r.wild():
+			wild = append(wild, r)
+		default:
+			exact = append(exact, r)
+		}
+	}
+	return append(exact, wild...)
+}
+
+// Bot bids a hand with a system.
+type Bot struct {
+	System *System
+	Hand   []deck.Card
+}
+
+// Bid makes the call of the bot in the auction, and returns its meaning.
+func (b Bot) Bid(a *Auction) (string, error) {
+	c, r := b.System.Choose(a, b.Hand)
+	meaning := r.Meaning
+	if r.Call == "" {
+		meaning = "no rule fits the hand"
+	}
+	return meaning, a.Add(c)
+}
+
+// BidDeal runs a whole auction with a bot for every seat, all using the same system.
+func BidDeal(s *System, d Deal, dealer Seat) (*Auction, error) {
+	a := &Auction{Dealer: dealer}
+	for !a.Over() {
+		if _, err := (Bot{System: s, Hand: d[a.Turn()]}).Bid(a); err != nil {
+			return a, err
+		}
+	}
+	return a, nil
+}
+
+// Rule helpers, keeping the tables short
+
+func points(min, max int) func(Features) bool {
+	return func(f Features) bool { return f.HCP >= min && f.HCP <= max }
+}
+
+func all(preds ...func(Features) bool) func(Features) bool {
+	return func(f Features) bool {
+		for _, p := range preds {
+			if !p(f) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func length(s Strain, min int) func(Features) bool {
+	return func(f Features) bool { return f.Len(s) >= min }
+}
+
+func most(s Strain, max int) func(Features) bool {
+	return func(f Features) bool { return f.Len(s) <= max }
+}
+
+func balanced(f Features) bool {
+	return f.Balanced
+}
+
+func always(Features) bool {
+	return true
+}
+
+func bid(level int, s Strain) string {
+	return fmt.Sprintf("%d%v", level, s)
+}
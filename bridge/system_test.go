@@ -0,0 +1,103 @@
+package bridge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestEvaluate(t *testing.T) {
+	d := hand(t, "N:AKQ2.K32.J432.32 JT98.AQ4.AK5.T98 7654.J876.Q6.AKQ 3.T95.T987.QJ765")
+	f := Evaluate(d[North])
+	if f.HCP != 13 || f.Aces != 1 || f.Kings != 2 || f.Len(Diamonds) != 4 || !f.Balanced {
+		t.Error("Expected the features of the North hand, received:", f)
+	}
+	if !f.Stopper(Spades) || !f.Stopper(Hearts) || !f.Stopper(Diamonds) || f.Stopper(Clubs) {
+		t.Error("Expected only clubs not to be stopped, received:", f.stoppers)
+	}
+	if f := Evaluate(d[West]); !f.Stopper(Clubs) || f.Stopper(Hearts) {
+		t.Error("Expected QJxxx to stop clubs, received:", f.stoppers)
+	}
+}
+
+func TestChoose(t *testing.T) {
+	tests := []struct {
+		hand  string
+		calls []string
+		call  string
+	}{
+		// Openings
+		{"AK2.KQ3.A432.J32", nil, "1NT"},
+		{"AK2.KQ3.A432.AJ2", nil, "2NT"},
+		{"AKQ32.K3.A432.32", nil, "1S"},
+		{"A2.K3.A432.Q8432", nil, "1C"},
+		{"A2.K3.A432.Q832", nil, "1D"},
+		{"32.KQJ932.432.32", nil, "2H"},
+		{"32.2.KQJ9832.432", nil, "3D"},
+		{"32.Q32.J432.5432", nil, "P"},
+		// Conventions over 1NT
+		{"K432.QJ32.Q32.32", []string{"1NT", "P"}, "2C"},
+		{"K4.QJ832.Q32.432", []string{"1NT", "P"}, "2D"},
+		{"QJ832.K4.Q32.432", []string{"1NT", "P"}, "2H"},
+		{"AK2.KQ32.A32.J32", []string{"1NT", "P", "2C", "P"}, "2H"},
+		{"AK2.KQ3.A432.J32", []string{"1NT", "P", "2C", "P"}, "2D"},
+		{"AK2.K3.A432.J432", []string{"1NT", "P", "2D", "P"}, "2H"},
+		{"K432.QJ32.Q32.A2", []string{"1NT", "P", "2C", "P", "2H", "P"}, "4H"},
+		// Responses and competition
+		{"K32.Q432.J432.32", []string{"1S", "P"}, "2S"},
+		{"32.A432.KQ32.K32", []string{"P", "1D", "P"}, "1H"},
+		{"AQ3.K32.AJ32.K32", []string{"1H"}, "1NT"},
+		{"32.A2.KQJ32.5432", []string{"1C"}, "1D"},
+		// Blackwood
+		{"AKQ32.K3.AK2.432", []string{"1S", "P", "3S", "P"}, "4NT"},
+		{"K432.AQ2.A32.432", []string{"1S", "P", "3S", "P", "4NT", "P"}, "5H"},
+		{"AKQ32.K3.AK2.432", []string{"1S", "P", "3S", "P", "4NT", "P", "5H", "P"}, "6S"},
+		{"AKQ32.K3.KQ2.K32", []string{"1S", "P", "3S", "P", "4NT", "P", "5D", "P"}, "5S"},
+	}
+	s := SAYC()
+	for _, tt := range tests {
+		d := hand(t, "N:"+tt.hand+" ... ... ...")
+		c, r := s.Choose(auction(t, North, tt.calls...), d[North])
+		if c.String() != tt.call {
+			t.Error("Expected", tt.call, "after", tt.calls, "with", tt.hand, "received:", c, r.Meaning)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	a := auction(t, North, "1NT", "P", "2D", "P", "2H", "P", "P", "P")
+	got := SAYC().Explain(a)
+	exp := []string{"15-17 HCP, balanced", "", "transfer, 5+ hearts", "", "completes the transfer", "", "0-7 HCP, to play", ""}
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Error("Expected", exp[i], "for", a.Calls[i], "received:", got[i])
+		}
+	}
+
+	// Without the convention, 2D has no meaning in the natural system
+	if got := Natural().Explain(a); got[2] != "" || got[0] != exp[0] {
+		t.Error("Expected no meaning for 2D without Jacoby, received:", got)
+	}
+}
+
+func TestBidDeal(t *testing.T) {
+	d := hand(t, "N:AK2.KQ3.A432.J32 QJ3.J54.KQJ5.654 T54.A862.T9.KQ87 9876.T97.876.AT9")
+	a, err := BidDeal(SAYC(), d, North)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls []string
+	for _, c := range a.Calls {
+		calls = append(calls, c.String())
+	}
+	// 1NT, Stayman, no major, 9 HCP invite and opener accepts with 17
+	if got := strings.Join(calls, " "); got != "1NT P 2C P 2D P 2NT P 3NT P P P" {
+		t.Error("Expected Stayman to find no fit, received:", got)
+	}
+
+	b := Bot{System: SAYC(), Hand: []deck.Card{{Suit: deck.Club, Rank: deck.Two}}}
+	if meaning, err := b.Bid(&Auction{Calls: []Call{MustParseCall("1NT"), {}, MustParseCall("2C"), {}, MustParseCall("2D"), {}, MustParseCall("3D"), {}}}); err != nil || meaning != "no rule fits the hand" {
+		t.Error("Expected the bot to pass without a rule, received:", meaning, err)
+	}
+}
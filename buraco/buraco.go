@@ -0,0 +1,308 @@
+// Package buraco implements Buraco, the brazilian rummy played with two decks, wild twos and the morto piles.
+package buraco
+
+import (
+	"errors"
+
+	"github.com/euller88/deck"
+)
+
+// The number of cards dealt to every hand and to every morto pile
+const HandSize = 11
+
+// The errors returned when a move breaks the rules of the game
+var (
+	ErrPlayers   = errors.New("buraco: a game needs 2 or 4 players")
+	ErrDeckSize  = errors.New("buraco: not enough cards to deal")
+	ErrDrawn     = errors.New("buraco: player already drew this turn")
+	ErrNotDrawn  = errors.New("buraco: player must draw before playing")
+	ErrNotInHand = errors.New("buraco: card is not in the player's hand")
+	ErrNoMeld    = errors.New("buraco: the team has no such meld")
+	ErrKind      = errors.New("buraco: cards would change the kind of the meld")
+	ErrGoOut     = errors.New("buraco: the team needs a clean canasta to go out")
+	ErrEmpty     = errors.New("buraco: the pile is empty")
+	ErrGameOver  = errors.New("buraco: the hand is over")
+)
+
+// Deck returns the 108 cards used in Buraco: two decks and four jokers, with the given options applied after them.
+func Deck(opts ...func([]deck.Card) []deck.Card) []deck.Card {
+	return deck.New(append([]func([]deck.Card) []deck.Card{deck.Deck(2), deck.Jokers(4)}, opts...)...)
+}
+
+// Game holds the state of a single hand of Buraco.
+type Game struct {
+	// Hands holds the cards of every player
+	Hands [][]deck.Card
+
+	// Stock holds the face down cards to be drawn
+	Stock []deck.Card
+
+	// DiscardPile holds the face up pile, the last card being on top
+	DiscardPile []deck.Card
+
+	// Mortos holds the dead piles not taken yet
+	Mortos [][]deck.Card
+
+	// Melds holds the melds laid down by every team
+	Melds [][]Meld
+
+	// Turn is the player who must play now
+	Turn int
+
+	sets   bool
+	drawn  bool
+	took   []bool
+	batida int
+}
+
+// STBL restricts the game to runs only, as in the "sem trinca" rules.
+func STBL(g *Game) {
+	g.sets = false
+}
+
+// New deals a hand of Buraco from cards, which should come already shuffled, to 2 or 4 players.
+// With 4 players, the players sitting across from each other, 0 and 2, 1 and 3, form a team.
+func New(cards []deck.Card, players int, opts ...func(*Game)) (*Game, error) {
+	if players != 2 && players != 4 {
+		return nil, ErrPlayers
+	}
+	if len(cards) < (players+2)*HandSize+1 {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{
+		Hands:  make([][]deck.Card, players),
+		Mortos: make([][]deck.Card, 2),
+		Melds:  make([][]Meld, 2),
+		sets:   true,
+		took:   make([]bool, 2),
+		batida: -1,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	n := 0
+	for i := 0; i < HandSize; i++ {
+		for p := range g.Hands {
+			g.Hands[p] = append(g.Hands[p], cards[n])
+			n++
+		}
+	}
+	for i := range g.Mortos {
+		g.Mortos[i] = append([]deck.Card(nil), cards[n:n+HandSize]...)
+		n += HandSize
+	}
+	g.DiscardPile = []deck.Card{cards[n]}
+	g.Stock = append([]deck.Card(nil), cards[n+1:]...)
+
+	return g, nil
+}
+
+// Team returns the team a player belongs to.
+func (g *Game) Team(player int) int {
+	return player % 2
+}
+
+// Draw takes the top card of the stock into the hand of the current player.
+func (g *Game) Draw() error {
+	if err := g.canDraw(); err != nil {
+		return err
+	}
+	if len(g.Stock) == 0 {
+		return ErrEmpty
+	}
+
+	g.Hands[g.Turn] = append(g.Hands[g.Turn], g.Stock[0])
+	g.Stock = g.Stock[1:]
+	g.drawn = true
+	g.refill()
+	return nil
+}
+
+// PickUp takes the whole discard pile into the hand of the current player.
+func (g *Game) PickUp() error {
+	if err := g.canDraw(); err != nil {
+		return err
+	}
+	if len(g.DiscardPile) == 0 {
+		return ErrEmpty
+	}
+
+	g.Hands[g.Turn] = append(g.Hands[g.Turn], g.DiscardPile...)
+	g.DiscardPile = nil
+	g.drawn = true
+	return nil
+}
+
+// Meld lays down cards from the hand of the current player as a new meld of the team.
+func (g *Game) Meld(cards []deck.Card) error {
+	m, err := Validate(cards, g.sets)
+	if err != nil {
+		return err
+	}
+	if err := g.canPlay(cards, m.Bonus()); err != nil {
+		return err
+	}
+
+	team := g.Team(g.Turn)
+	g.Melds[team] = append(g.Melds[team], m)
+	g.play(cards)
+	return nil
+}
+
+// Add lays down cards from the hand of the current player on the meld at position n of the team.
+func (g *Game) Add(n int, cards []deck.Card) error {
+	team := g.Team(g.Turn)
+	if n < 0 || n >= len(g.Melds[team]) {
+		return ErrNoMeld
+	}
+	old := g.Melds[team][n]
+	m, err := Validate(append(append([]deck.Card(nil), old.Cards...), cards...), g.sets)
+	if err != nil {
+		return err
+	}
+	if m.Kind != old.Kind {
+		return ErrKind
+	}
+	if err := g.canPlay(cards, m.Bonus()); err != nil {
+		return err
+	}
+
+	g.Melds[team][n] = m
+	g.play(cards)
+	return nil
+}
+
+// Discard puts card on top of the discard pile and ends the turn of the current player.
+func (g *Game) Discard(card deck.Card) error {
+	if err := g.canPlay([]deck.Card{card}, 0); err != nil {
+		return err
+	}
+
+	g.play([]deck.Card{card})
+	g.DiscardPile = append(g.DiscardPile, card)
+	if g.Over() {
+		return nil
+	}
+
+	g.drawn = false
+	g.Turn = (g.Turn + 1) % len(g.Hands)
+	return nil
+}
+
+// Over reports if the hand has ended, either because a player went out or because the stock and mortos ran out.
+func (g *Game) Over() bool {
+	if g.batida >= 0 {
+		return true
+	}
+	return len(g.Stock) == 0 && len(g.Mortos) == 0 && !g.drawn
+}
+
+// Score returns the points each team made in the hand: the melded cards and canasta bonuses,
+// 100 for going out, minus the cards left in hand and 100 if the team never took its morto.
+func (g *Game) Score() []int {
+	score := make([]int, 2)
+	for team, melds := range g.Melds {
+		for _, m := range melds {
+			score[team] += m.Points() + m.Bonus()
+		}
+		if !g.took[team] {
+			score[team] -= 100
+		}
+	}
+	for p, hand := range g.Hands {
+		score[g.Team(p)] -= Points(hand)
+	}
+	if g.batida >= 0 {
+		score[g.batida] += 100
+	}
+	return score
+}
+
+func (g *Game) canDraw() error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if g.drawn {
+		return ErrDrawn
+	}
+	return nil
+}
+
+// canPlay checks if cards can leave the hand of the current player, making sure that a team that already took
+// its morto only empties a hand when it owns a clean canasta. bonus is the bonus of the meld the cards go to.
+func (g *Game) canPlay(cards []deck.Card, bonus int) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if !g.drawn {
+		return ErrNotDrawn
+	}
+
+	hand := append([]deck.Card(nil), g.Hands[g.Turn]...)
+	for _, c := range cards {
+		i := index(hand, c)
+		if i < 0 {
+			return ErrNotInHand
+		}
+		hand = append(hand[:i], hand[i+1:]...)
+	}
+
+	team := g.Team(g.Turn)
+	if len(hand) > 0 || !g.noMorto(team) {
+		return nil
+	}
+	if bonus == 200 {
+		return nil
+	}
+	for _, m := range g.Melds[team] {
+		if m.Bonus() == 200 {
+			return nil
+		}
+	}
+	return ErrGoOut
+}
+
+// noMorto reports if a team that empties its hand has no morto left to take.
+func (g *Game) noMorto(team int) bool {
+	return g.took[team] || len(g.Mortos) == 0
+}
+
+// play removes cards from the hand of the current player, handing out the morto or ending the game when it empties.
+func (g *Game) play(cards []deck.Card) {
+	for _, c := range cards {
+		i := index(g.Hands[g.Turn], c)
+		g.Hands[g.Turn] = append(g.Hands[g.Turn][:i], g.Hands[g.Turn][i+1:]...)
+	}
+	if len(g.Hands[g.Turn]) > 0 {
+		return
+	}
+
+	team := g.Team(g.Turn)
+	if g.noMorto(team) {
+		g.batida = team
+		return
+	}
+	g.Hands[g.Turn] = g.Mortos[0]
+	g.Mortos = g.Mortos[1:]
+	g.took[team] = true
+}
+
+// refill turns a morto into the stock once the stock runs out.
+func (g *Game) refill() {
+	if len(g.Stock) > 0 || len(g.Mortos) == 0 {
+		return
+	}
+	g.Stock = g.Mortos[0]
+	g.Mortos = g.Mortos[1:]
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
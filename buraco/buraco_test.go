@@ -0,0 +1,99 @@
+package buraco
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestDeck(t *testing.T) {
+	if n := len(Deck()); n != 108 {
+		t.Errorf("Expected %d cards, received %d cards.", 108, n)
+	}
+}
+
+func TestTurn(t *testing.T) {
+	g, err := New(Deck(), 2, STBL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Hands[0]) != HandSize || len(g.Mortos[1]) != HandSize {
+		t.Fatal("Expected 11 cards in every hand and morto.")
+	}
+
+	if err := g.Discard(g.Hands[0][0]); err != ErrNotDrawn {
+		t.Error("Expected", ErrNotDrawn, "received:", err)
+	}
+	if err := g.PickUp(); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Draw(); err != ErrDrawn {
+		t.Error("Expected", ErrDrawn, "received:", err)
+	}
+	// Unshuffled, the first hand holds alternate cards of the spades: Ace, Three, Five, ...
+	run := []deck.Card{c(deck.Three, deck.Spade), c(deck.Five, deck.Spade), c(deck.Two, deck.Diamond)}
+	if err := g.Meld(run); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Add(0, []deck.Card{c(deck.Ace, deck.Spade)}); err != ErrMeld {
+		t.Error("Expected", ErrMeld, "received:", err)
+	}
+	if err := g.Discard(g.Hands[0][0]); err != nil {
+		t.Fatal(err)
+	}
+	if g.Turn != 1 {
+		t.Error("Expected the turn to pass to player 1.")
+	}
+}
+
+func TestGoOut(t *testing.T) {
+	g := &Game{
+		Hands:  [][]deck.Card{{c(deck.Nine, deck.Club)}, {c(deck.Four, deck.Club)}},
+		Stock:  []deck.Card{c(deck.Ten, deck.Club), c(deck.Jack, deck.Club)},
+		Mortos: [][]deck.Card{{c(deck.Ace, deck.Heart)}},
+		Melds:  make([][]Meld, 2),
+		took:   []bool{true, false},
+		batida: -1,
+	}
+	if err := g.Draw(); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Discard(c(deck.Ten, deck.Club)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Discard(c(deck.Nine, deck.Club)); err != ErrNotDrawn {
+		t.Error("Expected", ErrNotDrawn, "received:", err)
+	}
+
+	g.Turn, g.drawn = 0, true
+	if err := g.Discard(c(deck.Nine, deck.Club)); err != ErrGoOut {
+		t.Error("Expected", ErrGoOut, "without a clean canasta, received:", err)
+	}
+
+	var canasta []deck.Card
+	for r := deck.Three; r <= deck.Nine; r++ {
+		canasta = append(canasta, c(r, deck.Diamond))
+	}
+	m, _ := Validate(canasta, false)
+	g.Melds[0] = []Meld{m}
+	if err := g.Discard(c(deck.Nine, deck.Club)); err != nil {
+		t.Fatal(err)
+	}
+	if !g.Over() {
+		t.Fatal("Expected the hand to be over.")
+	}
+
+	score := g.Score()
+	if exp := m.Points() + 200 + 100; score[0] != exp {
+		t.Errorf("Expected team 0 to score %d, received %d.", exp, score[0])
+	}
+	if exp := -100 - 5; score[1] != exp {
+		t.Errorf("Expected team 1 to score %d, received %d.", exp, score[1])
+	}
+
+	// Going out with a new clean canasta
+	g = &Game{Hands: [][]deck.Card{append([]deck.Card(nil), canasta...), nil}, Melds: make([][]Meld, 2), took: []bool{true, false}, batida: -1, drawn: true}
+	if err := g.Meld(canasta); err != nil || !g.Over() {
+		t.Error("Expected to go out with a clean canasta, received:", err)
+	}
+}
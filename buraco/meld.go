@@ -0,0 +1,232 @@
+package buraco
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when a group of cards is not a valid meld
+var (
+	ErrShortMeld = errors.New("buraco: a meld needs at least three cards")
+	ErrWilds     = errors.New("buraco: a meld can't hold more than one wild card")
+	ErrMeld      = errors.New("buraco: cards don't form a run or a set")
+	ErrNoSets    = errors.New("buraco: sets are not allowed by the rules")
+)
+
+// Kind tells apart the two shapes a meld can have
+type Kind uint8
+
+const (
+	// Run is a sequence of the same suit
+	Run Kind = iota
+
+	// Set is a group of cards of the same rank
+	Set
+)
+
+// Meld is a validated group of cards laid down on the table by a team
+type Meld struct {
+	Kind  Kind
+	Cards []deck.Card
+
+	// Wild is the index in Cards of the card standing in for another one, or -1 if the meld is clean
+	Wild int
+}
+
+// Clean reports if the meld has no wild card in it.
+func (m Meld) Clean() bool {
+	return m.Wild < 0
+}
+
+// Canasta reports if the meld has seven or more cards.
+func (m Meld) Canasta() bool {
+	return len(m.Cards) >= 7
+}
+
+// Bonus returns the points a canasta is worth: 200 for a clean one and 100 for a dirty one.
+func (m Meld) Bonus() int {
+	switch {
+	case !m.Canasta():
+		return 0
+	case m.Clean():
+		return 200
+	default:
+		return 100
+	}
+}
+
+// Points returns the sum of the card values in the meld, without the canasta bonus.
+func (m Meld) Points() int {
+	return Points(m.Cards)
+}
+
+// Wild reports if a card can stand in for another one, which is true for jokers and twos.
+func Wild(c deck.Card) bool {
+	return c.Suit == deck.Joker || c.Rank == deck.Two
+}
+
+// Value returns the points a single card is worth.
+func Value(c deck.Card) int {
+	switch {
+	case c.Suit == deck.Joker:
+		return 50
+	case c.Rank == deck.Ace:
+		return 15
+	case c.Rank == deck.Two || c.Rank >= deck.Eight:
+		return 10
+	default:
+		return 5
+	}
+}
+
+// Points returns the sum of the values of cards.
+func Points(cards []deck.Card) int {
+	total := 0
+	for _, c := range cards {
+		total += Value(c)
+	}
+	return total
+}
+
+// Validate checks if cards form a meld, trying every card that can be wild as the single wild of the meld.
+// Sets are only accepted if sets is true.
+func Validate(cards []deck.Card, sets bool) (Meld, error) {
+	if len(cards) < 3 {
+		return Meld{}, ErrShortMeld
+	}
+
+	wilds := countWild(cards)
+	// A natural two may sit in its own run, so up to two twos or jokers may show up in a meld
+	if wilds > 2 {
+		return Meld{}, ErrWilds
+	}
+
+	if m, ok := run(cards, -1); ok {
+		return m, nil
+	}
+	for i, c := range cards {
+		if Wild(c) {
+			if m, ok := run(cards, i); ok {
+				return m, nil
+			}
+		}
+	}
+
+	if m, ok := set(cards); ok {
+		if !sets {
+			return Meld{}, ErrNoSets
+		}
+		return m, nil
+	}
+	if wilds == 2 {
+		return Meld{}, ErrWilds
+	}
+	return Meld{}, ErrMeld
+}
+
+// run tries to build a run out of cards with the card at wild, if any, used as the wild.
+func run(cards []deck.Card, wild int) (Meld, bool) {
+	var naturals []deck.Card
+	for i, c := range cards {
+		if i == wild {
+			continue
+		}
+		if c.Suit == deck.Joker {
+			return Meld{}, false
+		}
+		naturals = append(naturals, c)
+	}
+	for _, c := range naturals {
+		if c.Suit != naturals[0].Suit {
+			return Meld{}, false
+		}
+	}
+
+	// The Ace may be played either below the Two or above the King
+	for _, high := range []bool{false, true} {
+		pos := make([]int, len(naturals))
+		for i, c := range naturals {
+			pos[i] = int(c.Rank)
+			if high && c.Rank == deck.Ace {
+				pos[i] = int(deck.King) + 1
+			}
+		}
+		sorted := append([]deck.Card(nil), naturals...)
+		sort.Sort(byPos{sorted, pos})
+
+		gaps, gapAt := 0, -1
+		for i := 1; i < len(pos); i++ {
+			d := pos[i] - pos[i-1]
+			if d < 1 {
+				gaps = 2
+				break
+			}
+			if d > 1 {
+				gaps += d - 1
+				gapAt = i
+			}
+		}
+
+		switch {
+		case wild < 0 && gaps == 0:
+			return Meld{Kind: Run, Cards: sorted, Wild: -1}, true
+		case wild >= 0 && gaps == 1:
+			out := append(append(append([]deck.Card(nil), sorted[:gapAt]...), cards[wild]), sorted[gapAt:]...)
+			return Meld{Kind: Run, Cards: out, Wild: gapAt}, true
+		case wild >= 0 && gaps == 0 && pos[len(pos)-1] < int(deck.King)+1:
+			return Meld{Kind: Run, Cards: append(sorted, cards[wild]), Wild: len(sorted)}, true
+		case wild >= 0 && gaps == 0 && pos[0] > int(deck.Ace):
+			return Meld{Kind: Run, Cards: append([]deck.Card{cards[wild]}, sorted...), Wild: 0}, true
+		}
+	}
+	return Meld{}, false
+}
+
+// set tries to build a set of same ranked cards, with at most one wild.
+func set(cards []deck.Card) (Meld, bool) {
+	if countWild(cards) > 1 {
+		return Meld{}, false
+	}
+
+	m := Meld{Kind: Set, Wild: -1}
+	var wild []deck.Card
+	for _, c := range cards {
+		if Wild(c) {
+			wild = append(wild, c)
+			continue
+		}
+		if len(m.Cards) > 0 && c.Rank != m.Cards[0].Rank {
+			return Meld{}, false
+		}
+		m.Cards = append(m.Cards, c)
+	}
+	if len(wild) > 0 {
+		m.Wild = len(m.Cards)
+		m.Cards = append(m.Cards, wild...)
+	}
+	return m, true
+}
+
+func countWild(cards []deck.Card) int {
+	n := 0
+	for _, c := range cards {
+		if Wild(c) {
+			n++
+		}
+	}
+	return n
+}
+
+type byPos struct {
+	cards []deck.Card
+	pos   []int
+}
+
+func (b byPos) Len() int           { return len(b.cards) }
+func (b byPos) Less(i, j int) bool { return b.pos[i] < b.pos[j] }
+func (b byPos) Swap(i, j int) {
+	b.cards[i], b.cards[j] = b.cards[j], b.cards[i]
+	b.pos[i], b.pos[j] = b.pos[j], b.pos[i]
+}
@@ -0,0 +1,73 @@
+package buraco
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+var joker = deck.Card{Suit: deck.Joker}
+
+func TestValidateRun(t *testing.T) {
+	m, err := Validate([]deck.Card{c(deck.Five, deck.Heart), c(deck.Three, deck.Heart), c(deck.Four, deck.Heart)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Kind != Run || !m.Clean() || m.Cards[0].Rank != deck.Three {
+		t.Error("Expected a clean run starting at the Three, received:", m)
+	}
+
+	m, err = Validate([]deck.Card{c(deck.Queen, deck.Club), c(deck.Ace, deck.Club), joker}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Clean() || m.Cards[1] != joker {
+		t.Error("Expected the joker to stand in for the King, received:", m)
+	}
+
+	m, err = Validate([]deck.Card{c(deck.Ace, deck.Spade), c(deck.Two, deck.Spade), c(deck.Three, deck.Spade), c(deck.Two, deck.Heart), c(deck.Five, deck.Spade)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Clean() || m.Cards[m.Wild] != c(deck.Two, deck.Heart) {
+		t.Error("Expected the Two of Spades to be natural and the Two of Hearts to be wild, received:", m)
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	cases := []struct {
+		cards []deck.Card
+		sets  bool
+		err   error
+	}{
+		{[]deck.Card{c(deck.Five, deck.Heart), joker}, true, ErrShortMeld},
+		{[]deck.Card{c(deck.Five, deck.Heart), joker, joker}, true, ErrWilds},
+		{[]deck.Card{c(deck.Five, deck.Heart), c(deck.Five, deck.Club), joker}, false, ErrNoSets},
+		{[]deck.Card{c(deck.Five, deck.Heart), c(deck.Eight, deck.Heart), joker}, true, ErrMeld},
+		{[]deck.Card{c(deck.Five, deck.Heart), c(deck.Six, deck.Club), c(deck.Seven, deck.Heart)}, true, ErrMeld},
+	}
+	for _, tc := range cases {
+		if _, err := Validate(tc.cards, tc.sets); err != tc.err {
+			t.Error("Expected", tc.err, "for", tc.cards, "received:", err)
+		}
+	}
+}
+
+func TestBonus(t *testing.T) {
+	var cards []deck.Card
+	for r := deck.Three; r <= deck.Nine; r++ {
+		cards = append(cards, c(r, deck.Diamond))
+	}
+	m, _ := Validate(cards, false)
+	if m.Bonus() != 200 {
+		t.Error("Expected a clean canasta to be worth 200, received:", m.Bonus())
+	}
+	m, _ = Validate(append(cards[1:], joker), false)
+	if m.Bonus() != 100 {
+		t.Error("Expected a dirty canasta to be worth 100, received:", m.Bonus())
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+	"sync"
 	"time"
 )
 
@@ -98,15 +99,24 @@ func (c Card) String() string {
 	return fmt.Sprintf("%s of %ss", c.Rank.String(), c.Suit.String())
 }
 
-// New returns a deck of cards with the specified number of joker and with an option to include knights
-func New(opts ...func([]Card) []Card) []Card {
-	var cards []Card
-
+// canonical is the standard deck, built once and copied by every call to New
+var canonical = func() []Card {
+	cards := make([]Card, 0, len(suits)*int(maxRank))
 	for _, suit := range suits {
 		for rank := minRank; rank <= maxRank; rank++ {
 			cards = append(cards, Card{Suit: suit, Rank: rank})
 		}
 	}
+	return cards
+}()
+
+// spare is the room left at the end of a new deck, so a few jokers can be added without growing it
+const spare = 4
+
+// New returns a deck of cards with the specified number of joker and with an option to include knights
+func New(opts ...func([]Card) []Card) []Card {
+	cards := make([]Card, len(canonical), len(canonical)+spare)
+	copy(cards, canonical)
 
 	for _, opt := range opts {
 		cards = opt(cards)
@@ -117,12 +127,13 @@ func New(opts ...func([]Card) []Card) []Card {
 
 // DefaultSort is the default sorting of a newly created deck, first by rank, then by suit.
 func DefaultSort(cards []Card) []Card {
-	sort.Slice(cards, BySuitThenByRank(cards))
+	sort.Sort(sorter{cards, SuitFirst})
 
 	return cards
 }
 
 // Sort is a funtion that receives a comparator of cards and returns a function that sorts a deck based on said comparation.
+// SortFunc does the same without going through reflection, and should be preferred.
 func Sort(less func(cards []Card) func(i, j int) bool) func([]Card) []Card {
 	return func(cards []Card) []Card {
 		sort.Slice(cards, less(cards))
@@ -130,6 +141,24 @@ func Sort(less func(cards []Card) func(i, j int) bool) func([]Card) []Card {
 	}
 }
 
+// SortFunc receives a comparator of two cards and returns a function that sorts a deck based on said comparation.
+func SortFunc(less func(a, b Card) bool) func([]Card) []Card {
+	return func(cards []Card) []Card {
+		sort.Sort(sorter{cards, less})
+		return cards
+	}
+}
+
+// sorter sorts a deck with a typed comparator
+type sorter struct {
+	cards []Card
+	less  func(a, b Card) bool
+}
+
+func (s sorter) Len() int           { return len(s.cards) }
+func (s sorter) Less(i, j int) bool { return s.less(s.cards[i], s.cards[j]) }
+func (s sorter) Swap(i, j int)      { s.cards[i], s.cards[j] = s.cards[j], s.cards[i] }
+
 // ByRankThenBySuit receives a slice of Card and returns a function that compares two cards first by rank, then by suit.
 func ByRankThenBySuit(cards []Card) func(i, j int) bool {
 	return func(i, j int) bool {
@@ -144,6 +173,16 @@ func BySuitThenByRank(cards []Card) func(i, j int) bool {
 	}
 }
 
+// RankFirst compares two cards first by rank, then by suit.
+func RankFirst(a, b Card) bool {
+	return rankThenSuit(a) < rankThenSuit(b)
+}
+
+// SuitFirst compares two cards first by suit, then by rank.
+func SuitFirst(a, b Card) bool {
+	return absRank(a) < absRank(b)
+}
+
 func absRank(c Card) int {
 	return int(c.Suit)*int(maxRank) + int(c.Rank)
 }
@@ -152,15 +191,30 @@ func rankThenSuit(c Card) int {
 	return ((int(c.Rank) - 1) * len(suits)) + int(c.Suit)
 }
 
+// source is the random source shared by every call to Shuffle, seeded once from the clock
+var source = struct {
+	sync.Mutex
+	*rand.Rand
+}{Rand: rand.New(rand.NewSource(time.Now().UTC().UnixNano()))}
+
 // Shuffle  distribute the elements of the slice in a random order
 func Shuffle(cards []Card) []Card {
-	r := rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+	source.Lock()
+	defer source.Unlock()
 
-	r.Shuffle(len(cards), func(i, j int) {
-		cards[i], cards[j] = cards[j], cards[i]
-	})
+	return ShuffleWith(source.Rand)(cards)
+}
 
-	return cards
+// ShuffleWith returns a function that shuffles a deck with the given random source, for reproducible shuffles.
+// The source must not be used by other goroutines at the same time.
+func ShuffleWith(r *rand.Rand) func([]Card) []Card {
+	return func(cards []Card) []Card {
+		r.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+
+		return cards
+	}
 }
 
 // Jokers inserts n Joker cards in our deck
@@ -174,9 +228,10 @@ func Jokers(n int) func([]Card) []Card {
 }
 
 // Filter takes a filter function and returns a function that receives a slice of cards and returns a slice with all the elements that weren't excluded by the filter
+// The slice received is left untouched.
 func Filter(f func(card Card) bool) func([]Card) []Card {
 	return func(cards []Card) []Card {
-		ret := []Card{}
+		ret := make([]Card, 0, len(cards))
 
 		for _, card := range cards {
 			if !f(card) {
@@ -191,7 +246,7 @@ func Filter(f func(card Card) bool) func([]Card) []Card {
 // Deck creates n slices of Card that are identical copies of the deck that would be generated by other option functions
 func Deck(n int) func([]Card) []Card {
 	return func(cards []Card) []Card {
-		var ret []Card
+		ret := make([]Card, 0, n*len(cards))
 		for i := 0; i < n; i++ {
 			ret = append(ret, cards...)
 		}
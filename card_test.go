@@ -67,6 +67,13 @@ func TestFilter(t *testing.T) {
 			t.Error("Expected all twos and threes to be filtered out.")
 		}
 	}
+
+	// Filtering a deck directly doesn't change it
+	deck := New()
+	Filter(filter)(deck)
+	if deck[0] != (Card{Suit: Spade, Rank: Ace}) || deck[1] != (Card{Suit: Spade, Rank: Two}) || deck[2] != (Card{Suit: Spade, Rank: Three}) {
+		t.Error("Expected the deck to be left untouched, received:", deck[:3])
+	}
 }
 
 func TestDeck(t *testing.T) {
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"strconv"
+
+	"github.com/euller88/deck/env"
+	"github.com/euller88/deck/rules"
+)
+
+// agents collects the repeated -agent flags
+type agents []int
+
+func (a *agents) String() string {
+	return ""
+}
+
+func (a *agents) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err == nil {
+		*a = append(*a, n)
+	}
+	return err
+}
+
+func envCmd(args []string) error {
+	fs := flag.NewFlagSet("env", flag.ExitOnError)
+	path := fs.String("spec", "", "JSON rules spec of the game")
+	var players agents
+	fs.Var(&players, "agent", "player acting through the requests, repeatable; every player when absent")
+	fs.Parse(args)
+
+	f, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	spec, err := rules.Parse(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	e, err := env.NewRules(spec, players...)
+	if err != nil {
+		return err
+	}
+	return env.Serve(e, os.Stdin, os.Stdout)
+}
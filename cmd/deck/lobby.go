@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"net"
+	"os"
+	"time"
+
+	"github.com/euller88/deck/lobby"
+	"github.com/euller88/deck/rules"
+)
+
+func lobbyCmd(args []string) error {
+	fs := flag.NewFlagSet("lobby", flag.ExitOnError)
+	addr := fs.String("addr", ":2323", "address to listen on")
+	fs.Parse(args)
+
+	var specs []*rules.Spec
+	for _, path := range fs.Args() {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		spec, err := rules.Parse(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		specs = append(specs, spec)
+	}
+
+	l, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	return lobby.New(time.Now().UnixNano(), specs...).Serve(l)
+}
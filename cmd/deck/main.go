@@ -0,0 +1,38 @@
+// Command deck runs the tools built on the deck packages.
+//
+// Usage:
+//
+//	deck env -spec game.json [-agent n]...
+//	deck repl [script]
+//	deck lobby [-addr :2323] game.json...
+//
+// env serves a game described by a rules spec as a reinforcement-learning environment, reading JSON requests from
+// the standard input and writing the responses to the standard output, as documented in package env.
+//
+// repl starts the interactive shell of package repl, or runs the commands of a script.
+//
+// lobby serves the games described by rules specs to players connecting with telnet or netcat, as in package lobby.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+var commands = map[string]func(args []string) error{
+	"env":   envCmd,
+	"lobby": lobbyCmd,
+	"repl":  replCmd,
+}
+
+func main() {
+	if len(os.Args) < 2 || commands[os.Args[1]] == nil {
+		fmt.Fprintln(os.Stderr, "usage: deck <command> [arguments]")
+		fmt.Fprintln(os.Stderr, "commands: env, lobby, repl")
+		os.Exit(2)
+	}
+	if err := commands[os.Args[1]](os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, "deck:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"os"
+
+	"github.com/euller88/deck/repl"
+)
+
+func replCmd(args []string) error {
+	s := repl.New(os.Stdout)
+	if len(args) > 0 {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return s.Run(f, false)
+	}
+	return s.Run(os.Stdin, true)
+}
@@ -0,0 +1,63 @@
+package deck
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrCode is returned when a card code can't be parsed
+var ErrCode = errors.New("deck: malformed card code")
+
+const (
+	rankCodes = "A23456789TJQK"
+	suitCodes = "SDCH"
+)
+
+// Code returns the short code of a card: its rank and suit, such as "AS" or "TD", or "X" and the rank for a joker,
+// such as "X0".
+func (c Card) Code() string {
+	if c.Suit == Joker {
+		return "X" + strconv.Itoa(int(c.Rank))
+	}
+	if c.Rank < minRank || c.Rank > maxRank || int(c.Suit) >= len(suitCodes) {
+		return "??"
+	}
+	return string([]byte{rankCodes[c.Rank-minRank], suitCodes[c.Suit]})
+}
+
+// ParseCard reads a card code, as returned by Code. Lower case and "10" for the Ten are accepted.
+func ParseCard(code string) (Card, error) {
+	s := strings.ToUpper(strings.TrimSpace(code))
+	if strings.HasPrefix(s, "X") {
+		n, err := strconv.Atoi(s[1:])
+		if err != nil || n < 0 || n > 255 {
+			return Card{}, ErrCode
+		}
+		return Card{Suit: Joker, Rank: Rank(n)}, nil
+	}
+	if strings.HasPrefix(s, "10") {
+		s = "T" + s[2:]
+	}
+	if len(s) != 2 {
+		return Card{}, ErrCode
+	}
+	r, su := strings.IndexByte(rankCodes, s[0]), strings.IndexByte(suitCodes, s[1])
+	if r < 0 || su < 0 {
+		return Card{}, ErrCode
+	}
+	return Card{Suit: Suit(su), Rank: minRank + Rank(r)}, nil
+}
+
+// ParseCards reads card codes separated by spaces or commas.
+func ParseCards(codes string) ([]Card, error) {
+	var cards []Card
+	for _, code := range strings.FieldsFunc(codes, func(r rune) bool { return r == ' ' || r == ',' || r == '\t' }) {
+		c, err := ParseCard(code)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
@@ -0,0 +1,38 @@
+package deck
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleCard_Code() {
+	fmt.Println(Card{Rank: Ace, Suit: Spade}.Code())
+	fmt.Println(Card{Rank: Ten, Suit: Diamond}.Code())
+	fmt.Println(Card{Suit: Joker, Rank: 1}.Code())
+
+	// Output:
+	// AS
+	// TD
+	// X1
+}
+
+func TestParseCard(t *testing.T) {
+	for _, c := range New(Jokers(3)) {
+		got, err := ParseCard(c.Code())
+		if err != nil || got != c {
+			t.Error("Expected", c, "received:", got, err)
+		}
+	}
+	if c, err := ParseCard("10h"); err != nil || c != (Card{Rank: Ten, Suit: Heart}) {
+		t.Error("Expected the Ten of Hearts, received:", c, err)
+	}
+	for _, code := range []string{"", "A", "1S", "AZ", "XX", "ASS"} {
+		if _, err := ParseCard(code); err != ErrCode {
+			t.Error("Expected", ErrCode, "for", code, "received:", err)
+		}
+	}
+	cards, err := ParseCards("AS, KD  2c")
+	if err != nil || len(cards) != 3 || cards[2] != (Card{Rank: Two, Suit: Club}) {
+		t.Error("Expected three cards, received:", cards, err)
+	}
+}
@@ -0,0 +1,284 @@
+// Package deal generates random deals that satisfy constraints on the hands, for tests, puzzles and teaching
+// material: "the second hand holds at least two aces", "the dealer shows a Six", "the board has a flush draw".
+//
+// Every deal satisfying the constraints is equally likely. Cards required in a hand are placed first, the tightest
+// count constraint is met directly by sampling from its exact conditional distribution, and only the remaining
+// constraints fall back to rejection, so unlikely requirements don't make the generator crawl.
+package deal
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/euller88/deck"
+)
+
+// MaxTries is the default number of deals tried before the generator gives up
+const MaxTries = 1000000
+
+// The errors returned when no deal can be generated
+var (
+	ErrSizes       = errors.New("deal: the hands need more cards than the deck has")
+	ErrHand        = errors.New("deal: no such hand")
+	ErrUnavailable = errors.New("deal: card is not in the deck or is already required elsewhere")
+	ErrImpossible  = errors.New("deal: no deal satisfies the constraints")
+	ErrTries       = errors.New("deal: gave up before finding a deal satisfying the constraints")
+)
+
+// count requires hand to hold between min and max cards for which match is true
+type count struct {
+	hand     int
+	match    func(deck.Card) bool
+	min, max int
+}
+
+// Generator deals hands of fixed sizes from a deck, at random, under constraints.
+type Generator struct {
+	// MaxTries is the number of deals tried before giving up
+	MaxTries int
+
+	cards  []deck.Card
+	sizes  []int
+	fixed  [][]deck.Card
+	counts []count
+	preds  []func(hands [][]deck.Card) bool
+	r      *rand.Rand
+	err    error
+}
+
+// New returns a generator dealing hands of the given sizes from cards, such as New() of the deck package, with a
+// random source seeded by seed. The cards not dealt are the rest.
+func New(cards []deck.Card, sizes []int, seed int64) *Generator {
+	g := &Generator{
+		MaxTries: MaxTries,
+		cards:    append([]deck.Card(nil), cards...),
+		sizes:    append([]int(nil), sizes...),
+		fixed:    make([][]deck.Card, len(sizes)),
+		r:        rand.New(rand.NewSource(seed)),
+	}
+	total := 0
+	for _, n := range sizes {
+		total += n
+	}
+	if total > len(cards) {
+		g.err = ErrSizes
+	}
+	return g
+}
+
+// Holds requires a hand to hold the given cards.
+func (g *Generator) Holds(hand int, cards ...deck.Card) *Generator {
+	if !g.valid(hand) {
+		return g
+	}
+	for _, c := range cards {
+		i := index(g.cards, c)
+		if i < 0 {
+			g.err = ErrUnavailable
+			return g
+		}
+		g.cards = append(g.cards[:i], g.cards[i+1:]...)
+		g.fixed[hand] = append(g.fixed[hand], c)
+	}
+	if len(g.fixed[hand]) > g.sizes[hand] {
+		g.err = ErrImpossible
+	}
+	return g
+}
+
+// Count requires a hand to hold at least min and at most max cards for which match is true.
+func (g *Generator) Count(hand int, match func(deck.Card) bool, min, max int) *Generator {
+	if g.valid(hand) {
+		g.counts = append(g.counts, count{hand: hand, match: match, min: min, max: max})
+	}
+	return g
+}
+
+// Where requires the hands to satisfy any predicate.
+func (g *Generator) Where(pred func(hands [][]deck.Card) bool) *Generator {
+	g.preds = append(g.preds, pred)
+	return g
+}
+
+// Deal returns a random deal satisfying every constraint, and the cards left undealt.
+func (g *Generator) Deal() ([][]deck.Card, []deck.Card, error) {
+	if g.err != nil {
+		return nil, nil, g.err
+	}
+
+	direct := -1
+	tightest := 2.0
+	for i, c := range g.counts {
+		p := g.probability(c)
+		if p == 0 {
+			return nil, nil, ErrImpossible
+		}
+		if p < tightest {
+			direct, tightest = i, p
+		}
+	}
+
+	for try := 0; try < g.MaxTries; try++ {
+		hands, rest := g.sample(direct)
+		if g.satisfied(hands, direct) {
+			return hands, rest, nil
+		}
+	}
+	return nil, nil, ErrTries
+}
+
+// sample deals the hands at random, meeting the count constraint at position direct, if any, by construction.
+func (g *Generator) sample(direct int) ([][]deck.Card, []deck.Card) {
+	pool := append([]deck.Card(nil), g.cards...)
+	g.shuffle(pool)
+
+	hands := make([][]deck.Card, len(g.sizes))
+	for h := range hands {
+		hands[h] = append(make([]deck.Card, 0, g.sizes[h]), g.fixed[h]...)
+	}
+
+	if direct >= 0 {
+		c := g.counts[direct]
+		var in, out []deck.Card
+		for _, card := range pool {
+			if c.match(card) {
+				in = append(in, card)
+			} else {
+				out = append(out, card)
+			}
+		}
+		free := g.sizes[c.hand] - len(g.fixed[c.hand])
+		k := g.hypergeometric(len(pool), len(in), free, c.min-g.fixedMatches(c), c.max-g.fixedMatches(c))
+		hands[c.hand] = append(hands[c.hand], in[:k]...)
+		hands[c.hand] = append(hands[c.hand], out[:free-k]...)
+
+		// The cards left are still in random order, and shuffling them again keeps in and out cards mixed
+		pool = append(in[k:], out[free-k:]...)
+		g.shuffle(pool)
+	}
+
+	for h := range hands {
+		n := g.sizes[h] - len(hands[h])
+		hands[h] = append(hands[h], pool[:n]...)
+		pool = pool[n:]
+	}
+	return hands, pool
+}
+
+// satisfied checks every constraint but the one at position direct, already met by construction.
+func (g *Generator) satisfied(hands [][]deck.Card, direct int) bool {
+	for i, c := range g.counts {
+		if i == direct {
+			continue
+		}
+		n := 0
+		for _, card := range hands[c.hand] {
+			if c.match(card) {
+				n++
+			}
+		}
+		if n < c.min || n > c.max {
+			return false
+		}
+	}
+	for _, pred := range g.preds {
+		if !pred(hands) {
+			return false
+		}
+	}
+	return true
+}
+
+// probability returns the chance of a random deal meeting a count constraint on its own.
+func (g *Generator) probability(c count) float64 {
+	matching := 0
+	for _, card := range g.cards {
+		if c.match(card) {
+			matching++
+		}
+	}
+	fixed := g.fixedMatches(c)
+	free := g.sizes[c.hand] - len(g.fixed[c.hand])
+
+	p := 0.0
+	for k := c.min - fixed; k <= c.max-fixed; k++ {
+		p += math.Exp(logPMF(len(g.cards), matching, free, k))
+	}
+	return p
+}
+
+// hypergeometric draws the number of matching cards among n cards taken from a pool of size total holding matching
+// ones, conditioned on being between min and max.
+func (g *Generator) hypergeometric(total, matching, n, min, max int) int {
+	weights := make([]float64, 0, max-min+1)
+	sum := 0.0
+	for k := min; k <= max; k++ {
+		w := math.Exp(logPMF(total, matching, n, k))
+		weights = append(weights, w)
+		sum += w
+	}
+	x := g.r.Float64() * sum
+	for i, w := range weights {
+		if x < w {
+			return min + i
+		}
+		x -= w
+	}
+	// Rounding left x past the last weight, which is then the one drawn
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return min + i
+		}
+	}
+	return min
+}
+
+// logPMF returns the logarithm of the probability of k matching cards among n taken from a pool of size total
+// holding matching ones, or -Inf when it's impossible.
+func logPMF(total, matching, n, k int) float64 {
+	if k < 0 || k > n || k > matching || n-k > total-matching {
+		return math.Inf(-1)
+	}
+	return logChoose(matching, k) + logChoose(total-matching, n-k) - logChoose(total, n)
+}
+
+func logChoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
+
+func (g *Generator) fixedMatches(c count) int {
+	n := 0
+	for _, card := range g.fixed[c.hand] {
+		if c.match(card) {
+			n++
+		}
+	}
+	return n
+}
+
+func (g *Generator) valid(hand int) bool {
+	if hand < 0 || hand >= len(g.sizes) {
+		g.err = ErrHand
+		return false
+	}
+	return true
+}
+
+func (g *Generator) shuffle(cards []deck.Card) {
+	g.r.Shuffle(len(cards), func(i, j int) {
+		cards[i], cards[j] = cards[j], cards[i]
+	})
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
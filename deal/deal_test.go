@@ -0,0 +1,114 @@
+package deal
+
+import (
+	"math"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func rank(r deck.Rank) func(deck.Card) bool {
+	return func(c deck.Card) bool { return c.Rank == r }
+}
+
+func matching(cards []deck.Card, f func(deck.Card) bool) int {
+	n := 0
+	for _, c := range cards {
+		if f(c) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDeal(t *testing.T) {
+	g := New(deck.New(), []int{13, 13, 13, 13}, 1).Count(1, rank(deck.Ace), 2, 13)
+	for i := 0; i < 100; i++ {
+		hands, rest, err := g.Deal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rest) != 0 || len(hands[3]) != 13 || matching(hands[1], rank(deck.Ace)) < 2 {
+			t.Fatal("Expected four hands, the second with two aces, received:", hands)
+		}
+	}
+
+	a, _, _ := New(deck.New(), []int{5}, 7).Deal()
+	b, _, _ := New(deck.New(), []int{5}, 7).Deal()
+	for i := range a[0] {
+		if a[0][i] != b[0][i] {
+			t.Fatal("Expected the same seed to deal the same hands, received:", a, b)
+		}
+	}
+}
+
+func TestHolds(t *testing.T) {
+	six := deck.Card{Suit: deck.Club, Rank: deck.Six}
+	// Blackjack: the dealer's up card, the dealer's hole card and the player's hand
+	hands, rest, err := New(deck.New(deck.Deck(6)), []int{1, 1, 2}, 3).Holds(0, six).Deal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hands[0][0] != six || len(rest) != 6*52-4 || matching(rest, func(c deck.Card) bool { return c == six }) != 5 {
+		t.Error("Expected the dealer to show the Six, received:", hands)
+	}
+
+	if _, _, err := New(deck.New(), []int{1}, 1).Holds(0, deck.Card{Suit: deck.Joker}).Deal(); err != ErrUnavailable {
+		t.Error("Expected", ErrUnavailable, "received:", err)
+	}
+	if _, _, err := New(deck.New(), []int{1}, 1).Holds(2).Deal(); err != ErrHand {
+		t.Error("Expected", ErrHand, "received:", err)
+	}
+	if _, _, err := New(deck.New(), []int{30, 30}, 1).Deal(); err != ErrSizes {
+		t.Error("Expected", ErrSizes, "received:", err)
+	}
+	if _, _, err := New(deck.New(), []int{5}, 1).Count(0, rank(deck.Ace), 5, 5).Deal(); err != ErrImpossible {
+		t.Error("Expected", ErrImpossible, "received:", err)
+	}
+}
+
+func TestWhere(t *testing.T) {
+	// Hold'em: two hole cards for two players and a flop with a flush draw, four cards of a suit with the first hand
+	flushDraw := func(hands [][]deck.Card) bool {
+		for _, s := range []deck.Suit{deck.Spade, deck.Diamond, deck.Club, deck.Heart} {
+			n := 0
+			for _, c := range append(append([]deck.Card(nil), hands[0]...), hands[2]...) {
+				if c.Suit == s {
+					n++
+				}
+			}
+			if n == 4 {
+				return true
+			}
+		}
+		return false
+	}
+	g := New(deck.New(), []int{2, 2, 3}, 5).Where(flushDraw)
+	hands, _, err := g.Deal()
+	if err != nil || !flushDraw(hands) {
+		t.Error("Expected a flush draw, received:", hands, err)
+	}
+
+	g = New(deck.New(), []int{13}, 5).Count(0, rank(deck.Ace), 4, 4).Count(0, rank(deck.King), 4, 4)
+	g.MaxTries = 10
+	if _, _, err := g.Deal(); err != ErrTries {
+		t.Error("Expected", ErrTries, "received:", err)
+	}
+}
+
+func TestUniform(t *testing.T) {
+	// Among two card hands with at least an ace, 6 of the 198 hold two
+	g := New(deck.New(), []int{2}, 9).Count(0, rank(deck.Ace), 1, 2)
+	const n = 20000
+	two := 0
+	for i := 0; i < n; i++ {
+		hands, _, _ := g.Deal()
+		if matching(hands[0], rank(deck.Ace)) == 2 {
+			two++
+		}
+	}
+	exp := 6.0 / 198
+	if p := float64(two) / n; math.Abs(p-exp) > 0.005 {
+		t.Error("Expected a frequency close to", exp, "received:", p)
+	}
+}
@@ -0,0 +1,189 @@
+// Package deckbuilding gives the pieces of Dominion-style games, where every player builds a personal deck during
+// the game: card definitions, personal decks with a draw pile, a hand, the cards in play and a discard pile that is
+// shuffled back when the draw pile runs out, and a supply where cards are bought.
+//
+// Every personal deck shuffles with its own random source, so a game is reproduced exactly from a single seed.
+package deckbuilding
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// The errors returned when a card can't be moved
+var (
+	ErrNotInHand = errors.New("deckbuilding: card is not in the player's hand")
+	ErrNotFound  = errors.New("deckbuilding: card is not in the player's deck")
+)
+
+// Def is the definition of a kind of card, shared by all its copies.
+type Def struct {
+	// Name identifies the card in the supply
+	Name string
+
+	// Cost is the price of the card in the supply
+	Cost int
+
+	// Types holds the types of the card, such as "Action", "Treasure" or "Victory"
+	Types []string
+
+	// Values holds any number the game needs, such as the coins a treasure makes or the points of a victory card
+	Values map[string]int
+}
+
+// Is reports if the definition has the type t.
+func (d *Def) Is(t string) bool {
+	for _, dt := range d.Types {
+		if dt == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Card is a single copy of a definition. ID tells apart the copies of the same definition.
+type Card struct {
+	ID  int
+	Def *Def
+}
+
+// String returns the name of the card.
+func (c Card) String() string {
+	if c.Def == nil {
+		return ""
+	}
+	return c.Def.Name
+}
+
+// Deck is the personal deck of a player, split into the zones a card goes through during a turn.
+type Deck struct {
+	// Draw holds the cards still to be drawn, the top one first
+	Draw []Card
+
+	// Hand holds the cards drawn
+	Hand []Card
+
+	// InPlay holds the cards played this turn
+	InPlay []Card
+
+	// Discard holds the cards played or gained in earlier turns
+	Discard []Card
+
+	// Shuffles counts the times the discard pile was shuffled into the draw pile
+	Shuffles int
+
+	r *rand.Rand
+}
+
+// NewDeck returns a deck made of the starting cards, shuffled with a source seeded by seed.
+func NewDeck(start []Card, seed int64) *Deck {
+	d := &Deck{
+		Draw: append([]Card(nil), start...),
+		r:    rand.New(rand.NewSource(seed)),
+	}
+	d.shuffle(d.Draw)
+	return d
+}
+
+// Seeds returns the seeds of the decks of n players, derived from the seed of a game.
+func Seeds(seed int64, n int) []int64 {
+	r := rand.New(rand.NewSource(seed))
+	seeds := make([]int64, n)
+	for i := range seeds {
+		seeds[i] = r.Int63()
+	}
+	return seeds
+}
+
+// DrawN moves up to n cards from the draw pile to the hand, shuffling the discard pile into the draw pile whenever it
+// runs out. It returns the number of cards drawn, less than n only when both piles are empty.
+func (d *Deck) DrawN(n int) int {
+	drawn := 0
+	for ; drawn < n; drawn++ {
+		if len(d.Draw) == 0 {
+			if len(d.Discard) == 0 {
+				break
+			}
+			d.Draw, d.Discard = d.Discard, nil
+			d.shuffle(d.Draw)
+			d.Shuffles++
+		}
+		d.Hand = append(d.Hand, d.Draw[0])
+		d.Draw = d.Draw[1:]
+	}
+	return drawn
+}
+
+// Play moves a card from the hand to the cards in play.
+func (d *Deck) Play(c Card) error {
+	i := index(d.Hand, c)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	d.Hand = remove(d.Hand, i)
+	d.InPlay = append(d.InPlay, c)
+	return nil
+}
+
+// Gain puts a new card on the discard pile.
+func (d *Deck) Gain(c Card) {
+	d.Discard = append(d.Discard, c)
+}
+
+// Trash removes a card from the hand, or from the cards in play, for good.
+func (d *Deck) Trash(c Card) error {
+	if i := index(d.Hand, c); i >= 0 {
+		d.Hand = remove(d.Hand, i)
+		return nil
+	}
+	if i := index(d.InPlay, c); i >= 0 {
+		d.InPlay = remove(d.InPlay, i)
+		return nil
+	}
+	return ErrNotFound
+}
+
+// Cleanup ends a turn: the hand and the cards in play go to the discard pile and a new hand of n cards is drawn.
+func (d *Deck) Cleanup(n int) {
+	d.Discard = append(d.Discard, d.InPlay...)
+	d.Discard = append(d.Discard, d.Hand...)
+	d.InPlay, d.Hand = nil, nil
+	d.DrawN(n)
+}
+
+// Cards returns every card the player owns, in all zones.
+func (d *Deck) Cards() []Card {
+	var ret []Card
+	for _, zone := range [][]Card{d.Draw, d.Hand, d.InPlay, d.Discard} {
+		ret = append(ret, zone...)
+	}
+	return ret
+}
+
+// Total returns the sum of a value over every card the player owns, such as the victory points of the deck.
+func (d *Deck) Total(value string) int {
+	total := 0
+	for _, c := range d.Cards() {
+		total += c.Def.Values[value]
+	}
+	return total
+}
+
+func (d *Deck) shuffle(cards []Card) {
+	d.r.Shuffle(len(cards), func(i, j int) {
+		cards[i], cards[j] = cards[j], cards[i]
+	})
+}
+
+func index(cards []Card, c Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func remove(cards []Card, i int) []Card {
+	return append(cards[:i], cards[i+1:]...)
+}
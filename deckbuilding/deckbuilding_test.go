@@ -0,0 +1,68 @@
+package deckbuilding
+
+import (
+	"testing"
+)
+
+var (
+	copper = &Def{Name: "Copper", Types: []string{"Treasure"}, Values: map[string]int{"coins": 1}}
+	estate = &Def{Name: "Estate", Cost: 2, Types: []string{"Victory"}, Values: map[string]int{"points": 1}}
+)
+
+func starting(s *Supply) []Card {
+	return append(s.Make(copper, 7), s.Make(estate, 3)...)
+}
+
+func TestDeck(t *testing.T) {
+	d := NewDeck(starting(NewSupply()), 1)
+	if n := d.DrawN(5); n != 5 || len(d.Hand) != 5 || len(d.Draw) != 5 {
+		t.Fatal("Expected a hand of 5 cards, received:", d.Hand)
+	}
+	if err := d.Play(d.Hand[0]); err != nil || len(d.InPlay) != 1 {
+		t.Error("Expected the card to be in play, received:", err)
+	}
+	if err := d.Play(d.InPlay[0]); err != ErrNotInHand {
+		t.Error("Expected", ErrNotInHand, "received:", err)
+	}
+
+	d.Cleanup(5)
+	d.Cleanup(5)
+	if d.Shuffles != 1 || len(d.Hand) != 5 || len(d.Draw) != 5 || len(d.Discard) != 0 {
+		t.Error("Expected the discard pile to be shuffled into the draw pile, received:", d.Shuffles, d.Draw, d.Discard)
+	}
+	if err := d.Trash(d.Hand[0]); err != nil || len(d.Cards()) != 9 {
+		t.Error("Expected the card to be trashed, received:", err, d.Cards())
+	}
+	if d.Total("points")+d.Total("coins") != 9 {
+		t.Error("Expected a card to leave the deck, received:", d.Total("points"), d.Total("coins"))
+	}
+	if d.DrawN(10) != 5 {
+		t.Error("Expected to draw only the 5 cards left, received:", d.Hand)
+	}
+}
+
+func TestSeeds(t *testing.T) {
+	s := NewSupply()
+	start := starting(s)
+	seeds := Seeds(42, 2)
+	if seeds[0] == seeds[1] || Seeds(42, 2)[1] != seeds[1] {
+		t.Fatal("Expected distinct seeds, reproduced from the game seed, received:", seeds)
+	}
+
+	a, b := NewDeck(start, seeds[0]), NewDeck(start, seeds[0])
+	for i := 0; i < 5; i++ {
+		a.Cleanup(5)
+		b.Cleanup(5)
+	}
+	for i := range a.Hand {
+		if a.Hand[i] != b.Hand[i] {
+			t.Fatal("Expected the same seed to draw the same hands, received:", a.Hand, b.Hand)
+		}
+	}
+}
+
+func TestIs(t *testing.T) {
+	if !copper.Is("Treasure") || copper.Is("Victory") {
+		t.Error("Expected the Copper to be only a treasure.")
+	}
+}
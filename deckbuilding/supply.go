@@ -0,0 +1,115 @@
+package deckbuilding
+
+import (
+	"errors"
+	"sort"
+)
+
+// The errors returned when a card can't be bought
+var (
+	ErrUnknown = errors.New("deckbuilding: no such pile in the supply")
+	ErrEmpty   = errors.New("deckbuilding: the pile is empty")
+	ErrCoins   = errors.New("deckbuilding: not enough coins to buy the card")
+)
+
+// Pile is a stack of copies of a single card in the supply.
+type Pile struct {
+	Def   *Def
+	Count int
+}
+
+// Supply is the market where the players buy and gain cards.
+type Supply struct {
+	piles map[string]*Pile
+	next  int
+}
+
+// NewSupply returns a supply with the given piles.
+func NewSupply(piles ...Pile) *Supply {
+	s := &Supply{piles: map[string]*Pile{}}
+	for _, p := range piles {
+		p := p
+		s.piles[p.Def.Name] = &p
+	}
+	return s
+}
+
+// Make creates n new copies of a definition that are not in the supply, such as the starting cards of the players.
+func (s *Supply) Make(def *Def, n int) []Card {
+	cards := make([]Card, n)
+	for i := range cards {
+		cards[i] = s.card(def)
+	}
+	return cards
+}
+
+// Pile returns the pile of the named card, or nil if there is none.
+func (s *Supply) Pile(name string) *Pile {
+	return s.piles[name]
+}
+
+// Names returns the names of every pile, sorted.
+func (s *Supply) Names() []string {
+	names := make([]string, 0, len(s.piles))
+	for name := range s.piles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Gain takes a card from the named pile, for free.
+func (s *Supply) Gain(name string) (Card, error) {
+	p, ok := s.piles[name]
+	switch {
+	case !ok:
+		return Card{}, ErrUnknown
+	case p.Count == 0:
+		return Card{}, ErrEmpty
+	}
+	p.Count--
+	return s.card(p.Def), nil
+}
+
+// Buy takes a card from the named pile, as long as it doesn't cost more than coins, and returns the coins left.
+func (s *Supply) Buy(name string, coins int) (Card, int, error) {
+	p, ok := s.piles[name]
+	if !ok {
+		return Card{}, coins, ErrUnknown
+	}
+	if p.Def.Cost > coins {
+		return Card{}, coins, ErrCoins
+	}
+	c, err := s.Gain(name)
+	if err != nil {
+		return Card{}, coins, err
+	}
+	return c, coins - p.Def.Cost, nil
+}
+
+// Affordable returns the names of the non empty piles whose cards cost at most coins, sorted.
+func (s *Supply) Affordable(coins int) []string {
+	var names []string
+	for _, name := range s.Names() {
+		if p := s.piles[name]; p.Count > 0 && p.Def.Cost <= coins {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Empty returns the number of empty piles.
+func (s *Supply) Empty() int {
+	n := 0
+	for _, p := range s.piles {
+		if p.Count == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Supply) card(def *Def) Card {
+	s.next++
+	return Card{ID: s.next, Def: def}
+}
@@ -0,0 +1,33 @@
+package deckbuilding
+
+import (
+	"testing"
+)
+
+func TestSupply(t *testing.T) {
+	silver := &Def{Name: "Silver", Cost: 3, Types: []string{"Treasure"}, Values: map[string]int{"coins": 2}}
+	s := NewSupply(Pile{copper, 2}, Pile{silver, 1}, Pile{estate, 8})
+
+	if names := s.Affordable(2); len(names) != 2 || names[0] != "Copper" || names[1] != "Estate" {
+		t.Error("Expected Copper and Estate to be affordable, received:", names)
+	}
+	if _, coins, err := s.Buy("Silver", 2); err != ErrCoins || coins != 2 {
+		t.Error("Expected", ErrCoins, "received:", err)
+	}
+	c, coins, err := s.Buy("Silver", 5)
+	if err != nil || c.Def != silver || coins != 2 {
+		t.Error("Expected to buy a Silver and keep 2 coins, received:", c, coins, err)
+	}
+	if _, _, err := s.Buy("Silver", 5); err != ErrEmpty {
+		t.Error("Expected", ErrEmpty, "received:", err)
+	}
+	if _, err := s.Gain("Gold"); err != ErrUnknown {
+		t.Error("Expected", ErrUnknown, "received:", err)
+	}
+
+	a, _ := s.Gain("Copper")
+	b, _ := s.Gain("Copper")
+	if a == b || a.ID == c.ID || s.Empty() != 2 || s.Pile("Copper").Count != 0 {
+		t.Error("Expected distinct copies and two empty piles, received:", a, b, s.Empty())
+	}
+}
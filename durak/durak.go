@@ -0,0 +1,403 @@
+// Package durak implements the rules of Durak, the russian shedding game played with a 36 card deck.
+package durak
+
+import (
+	"errors"
+
+	"github.com/euller88/deck"
+)
+
+// The maximum number of cards a player holds after drawing, and the maximum number of attacks in a single bout
+const (
+	HandSize = 6
+	MaxBout  = 6
+)
+
+// The errors returned when a move breaks the rules of the game
+var (
+	ErrPlayers     = errors.New("durak: a game needs between 2 and 6 players")
+	ErrDeckSize    = errors.New("durak: not enough cards to deal")
+	ErrNotInHand   = errors.New("durak: card is not in the player's hand")
+	ErrNotAttacker = errors.New("durak: player can't attack in this bout")
+	ErrRank        = errors.New("durak: card rank is not on the table")
+	ErrBoutFull    = errors.New("durak: no more attacks allowed in this bout")
+	ErrBeaten      = errors.New("durak: attack is already beaten")
+	ErrCantBeat    = errors.New("durak: card doesn't beat the attack")
+	ErrNoTransfer  = errors.New("durak: transfer is not allowed now")
+	ErrUnbeaten    = errors.New("durak: there are unbeaten attacks on the table")
+	ErrEmptyTable  = errors.New("durak: there are no cards on the table")
+	ErrGameOver    = errors.New("durak: the game is over")
+)
+
+// Short reports if a card is left out of a Durak deck, that is, every card from Two to Five and the jokers.
+func Short(c deck.Card) bool {
+	return c.Suit == deck.Joker || (c.Rank >= deck.Two && c.Rank <= deck.Five)
+}
+
+// Deck returns the 36 cards Durak deck, built with the given options applied after the short cards were filtered out.
+func Deck(opts ...func([]deck.Card) []deck.Card) []deck.Card {
+	return deck.New(append([]func([]deck.Card) []deck.Card{deck.Filter(Short)}, opts...)...)
+}
+
+// Power returns the strength of a rank in Durak, where the Ace is the highest card.
+func Power(r deck.Rank) int {
+	if r == deck.Ace {
+		return int(deck.King) + 1
+	}
+	return int(r)
+}
+
+// Beats reports if the defense card beats the attack card under the given trump suit.
+func Beats(defense, attack deck.Card, trump deck.Suit) bool {
+	if defense.Suit == attack.Suit {
+		return Power(defense.Rank) > Power(attack.Rank)
+	}
+	return defense.Suit == trump
+}
+
+// Pair is a single attack on the table, along with the card that beat it, if any.
+type Pair struct {
+	Attack  deck.Card
+	Defense deck.Card
+	Beaten  bool
+}
+
+// Game holds the state of a Durak game.
+type Game struct {
+	// Trump is the card turned up under the stock, which sets the trump suit
+	Trump deck.Card
+
+	// Stock holds the cards still to be drawn, the last one being the trump card, unless every card was dealt
+	Stock []deck.Card
+
+	// Hands holds the cards of every player
+	Hands [][]deck.Card
+
+	// Table holds the attacks of the current bout
+	Table []Pair
+
+	// Discard holds every card beaten in a previous bout
+	Discard []deck.Card
+
+	// Attacker is the player who opened the current bout
+	Attacker int
+
+	// Defender is the player who must beat the attacks
+	Defender int
+
+	transfer  bool
+	attackers []int
+	limit     int
+}
+
+// Perevodnoy enables the transfer variant, where the defender can pass the attack to the next player.
+func Perevodnoy(g *Game) {
+	g.transfer = true
+}
+
+// New deals a game of Durak from cards, which should come already shuffled, to the given number of players.
+// When every card is dealt, as with six players and a 36 card deck, the last card dealt sets the trump.
+func New(cards []deck.Card, players int, opts ...func(*Game)) (*Game, error) {
+	if players < 2 || players > 6 {
+		return nil, ErrPlayers
+	}
+	if len(cards) < players*HandSize {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{Hands: make([][]deck.Card, players)}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	for i := 0; i < HandSize; i++ {
+		for p := 0; p < players; p++ {
+			g.Hands[p] = append(g.Hands[p], cards[i*players+p])
+		}
+	}
+	g.Stock = append([]deck.Card(nil), cards[players*HandSize:]...)
+	if len(g.Stock) > 0 {
+		g.Trump = g.Stock[len(g.Stock)-1]
+	} else {
+		g.Trump = cards[players*HandSize-1]
+	}
+
+	g.Attacker = g.lowestTrump()
+	g.startBout(g.Attacker)
+
+	return g, nil
+}
+
+// lowestTrump returns the player holding the lowest trump, who opens the game, or the first player if nobody holds one.
+func (g *Game) lowestTrump() int {
+	player, low := 0, 0
+	for p, hand := range g.Hands {
+		for _, c := range hand {
+			if c.Suit == g.Trump.Suit && (low == 0 || Power(c.Rank) < low) {
+				player, low = p, Power(c.Rank)
+			}
+		}
+	}
+	return player
+}
+
+// startBout opens a new bout led by attacker against the next player still in the game.
+func (g *Game) startBout(attacker int) {
+	g.Table = nil
+	g.Attacker = attacker
+	g.Defender = g.next(attacker)
+	g.attackers = []int{attacker}
+	g.setLimit()
+}
+
+// setLimit caps the attacks of the bout to the cards of the defender.
+func (g *Game) setLimit() {
+	g.limit = len(g.Hands[g.Defender])
+	if g.limit > MaxBout {
+		g.limit = MaxBout
+	}
+}
+
+// next returns the first player after p that still has cards in hand.
+func (g *Game) next(p int) int {
+	for i := 1; i < len(g.Hands); i++ {
+		q := (p + i) % len(g.Hands)
+		if len(g.Hands[q]) > 0 {
+			return q
+		}
+	}
+	return p
+}
+
+// Attack plays card from the player's hand against the defender. The first attack of a bout must come from the attacker,
+// any later one is a throw-in and must match the rank of a card already on the table.
+func (g *Game) Attack(player int, card deck.Card) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if player < 0 || player >= len(g.Hands) || player == g.Defender || (len(g.Table) == 0 && player != g.Attacker) {
+		return ErrNotAttacker
+	}
+	i := index(g.Hands[player], card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if len(g.Table) > 0 && !g.onTable(card.Rank) {
+		return ErrRank
+	}
+	if len(g.Table) >= g.limit || g.unbeaten() >= len(g.Hands[g.Defender]) {
+		return ErrBoutFull
+	}
+
+	g.Hands[player] = remove(g.Hands[player], i)
+	g.Table = append(g.Table, Pair{Attack: card})
+	g.addAttacker(player)
+	return nil
+}
+
+// Defend beats the attack at position n of the table with card from the defender's hand.
+func (g *Game) Defend(n int, card deck.Card) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if n < 0 || n >= len(g.Table) {
+		return ErrEmptyTable
+	}
+	if g.Table[n].Beaten {
+		return ErrBeaten
+	}
+	i := index(g.Hands[g.Defender], card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if !Beats(card, g.Table[n].Attack, g.Trump.Suit) {
+		return ErrCantBeat
+	}
+
+	g.Hands[g.Defender] = remove(g.Hands[g.Defender], i)
+	g.Table[n].Defense = card
+	g.Table[n].Beaten = true
+	return nil
+}
+
+// CanTransfer reports if the defender may transfer the current attack with card.
+func (g *Game) CanTransfer(card deck.Card) bool {
+	if !g.transfer || g.Over() || len(g.Table) == 0 {
+		return false
+	}
+	for _, p := range g.Table {
+		if p.Beaten || p.Attack.Rank != card.Rank {
+			return false
+		}
+	}
+	next := g.next(g.Defender)
+	return next != g.Defender && len(g.Hands[next]) > len(g.Table) && index(g.Hands[g.Defender], card) >= 0
+}
+
+// Transfer adds card to the attack and passes the defense to the next player, as allowed by the Perevodnoy variant.
+func (g *Game) Transfer(card deck.Card) error {
+	if !g.CanTransfer(card) {
+		return ErrNoTransfer
+	}
+
+	i := index(g.Hands[g.Defender], card)
+	g.Hands[g.Defender] = remove(g.Hands[g.Defender], i)
+	g.Table = append(g.Table, Pair{Attack: card})
+	g.addAttacker(g.Defender)
+
+	g.Defender = g.next(g.Defender)
+	g.setLimit()
+	return nil
+}
+
+// Take makes the defender pick up every card on the table. The bout ends and the defender loses the turn to attack.
+func (g *Game) Take() error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if len(g.Table) == 0 {
+		return ErrEmptyTable
+	}
+
+	for _, p := range g.Table {
+		g.Hands[g.Defender] = append(g.Hands[g.Defender], p.Attack)
+		if p.Beaten {
+			g.Hands[g.Defender] = append(g.Hands[g.Defender], p.Defense)
+		}
+	}
+
+	defender := g.Defender
+	g.drawUp()
+	g.startBout(g.next(defender))
+	return nil
+}
+
+// Done ends a bout where every attack was beaten. The cards go to the discard pile and the defender attacks next.
+func (g *Game) Done() error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if len(g.Table) == 0 {
+		return ErrEmptyTable
+	}
+	if g.unbeaten() > 0 {
+		return ErrUnbeaten
+	}
+
+	for _, p := range g.Table {
+		g.Discard = append(g.Discard, p.Attack, p.Defense)
+	}
+
+	defender := g.Defender
+	g.drawUp()
+	if len(g.Hands[defender]) > 0 {
+		g.startBout(defender)
+	} else {
+		g.startBout(g.next(defender))
+	}
+	return nil
+}
+
+// DrawOrder returns the order in which players refill their hands after the bout:
+// the attacker first, then the other players who threw in, and the defender last.
+func (g *Game) DrawOrder() []int {
+	order := append([]int(nil), g.attackers...)
+	for i := 1; i < len(g.Hands); i++ {
+		p := (g.Attacker + i) % len(g.Hands)
+		if p != g.Defender && !contains(order, p) {
+			order = append(order, p)
+		}
+	}
+	for i, p := range order {
+		if p == g.Defender {
+			order = append(order[:i], order[i+1:]...)
+			break
+		}
+	}
+	return append(order, g.Defender)
+}
+
+func (g *Game) drawUp() {
+	for _, p := range g.DrawOrder() {
+		for len(g.Hands[p]) < HandSize && len(g.Stock) > 0 {
+			g.Hands[p] = append(g.Hands[p], g.Stock[0])
+			g.Stock = g.Stock[1:]
+		}
+	}
+}
+
+// Over reports if the game has ended, which happens when the stock is empty, at most one player holds cards and no
+// attack is left to beat.
+func (g *Game) Over() bool {
+	if len(g.Stock) > 0 || g.unbeaten() > 0 {
+		return false
+	}
+	left := 0
+	for _, hand := range g.Hands {
+		if len(hand) > 0 {
+			left++
+		}
+	}
+	return left <= 1
+}
+
+// Loser returns the durak, the last player holding cards. It returns false while the game is running or if it ended in a draw,
+// when the last attacker and defender ran out of cards in the same bout.
+func (g *Game) Loser() (int, bool) {
+	if !g.Over() {
+		return 0, false
+	}
+	for p, hand := range g.Hands {
+		if len(hand) > 0 {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+func (g *Game) unbeaten() int {
+	n := 0
+	for _, p := range g.Table {
+		if !p.Beaten {
+			n++
+		}
+	}
+	return n
+}
+
+func (g *Game) onTable(r deck.Rank) bool {
+	for _, p := range g.Table {
+		if p.Attack.Rank == r || (p.Beaten && p.Defense.Rank == r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Game) addAttacker(p int) {
+	if !contains(g.attackers, p) {
+		g.attackers = append(g.attackers, p)
+	}
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func remove(cards []deck.Card, i int) []deck.Card {
+	return append(cards[:i], cards[i+1:]...)
+}
+
+func contains(ps []int, p int) bool {
+	for _, q := range ps {
+		if q == p {
+			return true
+		}
+	}
+	return false
+}
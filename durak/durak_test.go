@@ -0,0 +1,200 @@
+package durak
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func deal(hands [][]deck.Card, stock ...deck.Card) []deck.Card {
+	var cards []deck.Card
+	for i := 0; i < HandSize; i++ {
+		for _, hand := range hands {
+			cards = append(cards, hand[i])
+		}
+	}
+	return append(cards, stock...)
+}
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+func TestDeck(t *testing.T) {
+	cards := Deck()
+	if len(cards) != 36 {
+		t.Errorf("Expected %d cards, received %d cards.", 36, len(cards))
+	}
+	for _, card := range cards {
+		if Short(card) {
+			t.Error("Expected no short cards, received:", card)
+		}
+	}
+}
+
+func TestSixPlayers(t *testing.T) {
+	cards := Deck()
+	if _, err := New(cards[:35], 6); err != ErrDeckSize {
+		t.Error("Expected", ErrDeckSize, "received:", err)
+	}
+	g, err := New(cards, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Stock) != 0 || g.Trump != cards[35] || index(g.Hands[5], g.Trump) < 0 {
+		t.Error("Expected the last card dealt to set the trump, received:", g.Trump, g.Stock)
+	}
+	if g.Over() {
+		t.Error("Expected the game to start with an empty stock.")
+	}
+}
+
+func TestBeats(t *testing.T) {
+	if !Beats(c(deck.Ace, deck.Spade), c(deck.King, deck.Spade), deck.Heart) {
+		t.Error("Expected the Ace to beat the King.")
+	}
+	if !Beats(c(deck.Six, deck.Heart), c(deck.Ace, deck.Spade), deck.Heart) {
+		t.Error("Expected a trump to beat a plain card.")
+	}
+	if Beats(c(deck.Ace, deck.Club), c(deck.Six, deck.Spade), deck.Heart) {
+		t.Error("Expected a card of another suit not to beat.")
+	}
+}
+
+func TestBout(t *testing.T) {
+	cards := deal([][]deck.Card{
+		{c(deck.Six, deck.Spade), c(deck.Six, deck.Club), c(deck.Seven, deck.Spade), c(deck.Eight, deck.Club), c(deck.Nine, deck.Club), c(deck.Ten, deck.Club)},
+		{c(deck.Ten, deck.Spade), c(deck.Seven, deck.Heart), c(deck.King, deck.Club), c(deck.Queen, deck.Diamond), c(deck.Jack, deck.Diamond), c(deck.Nine, deck.Diamond)},
+	}, c(deck.Ace, deck.Diamond), c(deck.Eight, deck.Heart))
+
+	g, err := New(cards, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Attacker != 1 {
+		t.Fatal("Expected player 1, holding the lowest trump, to attack first. Received:", g.Attacker)
+	}
+	if err := g.Attack(0, c(deck.Six, deck.Club)); err != ErrNotAttacker {
+		t.Error("Expected", ErrNotAttacker, "received:", err)
+	}
+	if err := g.Attack(1, c(deck.Nine, deck.Diamond)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Defend(0, c(deck.Six, deck.Club)); err != ErrCantBeat {
+		t.Error("Expected", ErrCantBeat, "received:", err)
+	}
+	if err := g.Defend(0, c(deck.Six, deck.Spade)); err != ErrCantBeat {
+		t.Error("Expected", ErrCantBeat, "received:", err)
+	}
+	if err := g.Attack(1, c(deck.King, deck.Club)); err != ErrRank {
+		t.Error("Expected", ErrRank, "received:", err)
+	}
+	if err := g.Take(); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Hands[0]) != 7 || len(g.Hands[1]) != 6 {
+		t.Error("Expected the defender to pick up and the attacker to draw. Received hands:", g.Hands)
+	}
+	if g.Attacker != 1 || g.Defender != 0 {
+		t.Error("Expected player 1 to attack again after player 0 took the cards.")
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	cards := deal([][]deck.Card{
+		{c(deck.Six, deck.Heart), c(deck.Six, deck.Club), c(deck.Seven, deck.Spade), c(deck.Eight, deck.Club), c(deck.Nine, deck.Club), c(deck.Ten, deck.Club)},
+		{c(deck.Six, deck.Spade), c(deck.Seven, deck.Heart), c(deck.King, deck.Club), c(deck.Queen, deck.Diamond), c(deck.Jack, deck.Diamond), c(deck.Nine, deck.Diamond)},
+		{c(deck.Ace, deck.Spade), c(deck.King, deck.Spade), c(deck.Queen, deck.Spade), c(deck.Jack, deck.Spade), c(deck.Ten, deck.Spade), c(deck.Nine, deck.Spade)},
+	}, c(deck.Eight, deck.Heart))
+
+	g, err := New(cards, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Transfer(c(deck.Six, deck.Spade)); err != ErrNoTransfer {
+		t.Error("Expected", ErrNoTransfer, "without the variant, received:", err)
+	}
+
+	g, _ = New(cards, 3, Perevodnoy)
+	if err := g.Attack(0, c(deck.Six, deck.Club)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Transfer(c(deck.Six, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if g.Defender != 2 || len(g.Table) != 2 {
+		t.Fatal("Expected player 2 to defend against two cards.")
+	}
+	if err := g.Defend(0, c(deck.Seven, deck.Spade)); err != ErrNotInHand {
+		t.Error("Expected", ErrNotInHand, "received:", err)
+	}
+	if err := g.Defend(1, c(deck.Nine, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Done(); err != ErrUnbeaten {
+		t.Error("Expected", ErrUnbeaten, "received:", err)
+	}
+	if err := g.Take(); err != nil {
+		t.Fatal(err)
+	}
+	if g.Attacker != 0 || g.Defender != 1 {
+		t.Error("Expected player 0 to attack player 1 after player 2 took the cards.")
+	}
+}
+
+func TestLoser(t *testing.T) {
+	g := &Game{Hands: [][]deck.Card{nil, {c(deck.Six, deck.Club)}, nil}}
+	if p, ok := g.Loser(); !ok || p != 1 {
+		t.Error("Expected player 1 to be the durak, received:", p, ok)
+	}
+}
+
+func TestLastBout(t *testing.T) {
+	g := &Game{Trump: c(deck.Six, deck.Heart), Hands: [][]deck.Card{{c(deck.Seven, deck.Spade)}, {c(deck.Eight, deck.Spade), c(deck.Nine, deck.Club)}}}
+	g.startBout(0)
+	if err := g.Attack(2, c(deck.Seven, deck.Spade)); err != ErrNotAttacker {
+		t.Error("Expected", ErrNotAttacker, "received:", err)
+	}
+	if err := g.Attack(0, c(deck.Seven, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if g.Over() {
+		t.Error("Expected the game to go on while an attack is unbeaten.")
+	}
+	if err := g.Defend(0, c(deck.Eight, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := g.Loser(); !ok || p != 1 {
+		t.Error("Expected player 1 to be the durak, received:", p, ok)
+	}
+
+	g = &Game{Trump: c(deck.Six, deck.Heart), Hands: [][]deck.Card{{c(deck.Seven, deck.Spade)}, {c(deck.Eight, deck.Spade)}}}
+	g.startBout(0)
+	if err := g.Attack(0, c(deck.Seven, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Defend(0, c(deck.Eight, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := g.Loser(); ok || !g.Over() {
+		t.Error("Expected a draw, received:", p, ok)
+	}
+}
+
+func TestTransferLimit(t *testing.T) {
+	g := &Game{Trump: c(deck.Six, deck.Heart), transfer: true, Hands: [][]deck.Card{
+		{c(deck.Six, deck.Club), c(deck.Six, deck.Diamond)},
+		{c(deck.Six, deck.Spade), c(deck.Seven, deck.Club), c(deck.Eight, deck.Club), c(deck.Nine, deck.Club), c(deck.Ten, deck.Club), c(deck.Jack, deck.Club)},
+		{c(deck.Seven, deck.Spade), c(deck.King, deck.Club)},
+	}}
+	g.startBout(0)
+	if err := g.Attack(0, c(deck.Six, deck.Club)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Transfer(c(deck.Six, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if g.limit != 2 {
+		t.Error("Expected the bout to be capped at the 2 cards of the new defender, received:", g.limit)
+	}
+}
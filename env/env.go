@@ -0,0 +1,83 @@
+// Package env exposes card games as reinforcement-learning environments, in the style of Gym: an episode starts
+// with Reset and goes on with Step, which takes the index of an action and returns the new observation, the mask
+// of the legal actions, the rewards and whether the episode is done.
+//
+// Cards are encoded as one-hot vectors by their canonical index, deck.Card.Index, so the same card has the same
+// position in every observation and the action of playing it has the same number.
+// Serve drives an environment with JSON messages, so trainers written in other languages can use it.
+package env
+
+import (
+	"errors"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned by Step
+var (
+	ErrAction = errors.New("env: action is not legal")
+	ErrDone   = errors.New("env: the episode is over, it must be reset")
+)
+
+// Step is the outcome of Reset or Step.
+type Step struct {
+	// Observation encodes what the player to act can see
+	Observation []float32 `json:"observation"`
+
+	// Mask tells which actions are legal
+	Mask []bool `json:"mask"`
+
+	// Rewards holds the reward of every player for the last step
+	Rewards []float64 `json:"rewards"`
+
+	// Done is true when the episode is over
+	Done bool `json:"done"`
+
+	// Player is the player to act
+	Player int `json:"player"`
+}
+
+// Env is a card game seen as an environment.
+type Env interface {
+	// Reset starts a new episode, dealt with a random source seeded by seed.
+	Reset(seed int64) (Step, error)
+
+	// Step makes the player to act take an action.
+	Step(action int) (Step, error)
+
+	// Actions returns the number of actions, the length of every mask.
+	Actions() int
+
+	// Observations returns the length of every observation.
+	Observations() int
+
+	// Players returns the number of players.
+	Players() int
+}
+
+// Encode returns the one-hot encoding of cards as a vector of length n, the position of every card given by its
+// index. With several decks, a card held twice counts 2.
+func Encode(cards []deck.Card, n int) []float32 {
+	v := make([]float32, n)
+	encode(v, cards)
+	return v
+}
+
+func encode(v []float32, cards []deck.Card) {
+	for _, c := range cards {
+		if i := c.Index(); i < len(v) {
+			v[i]++
+		}
+	}
+}
+
+// Decode returns the cards of a one-hot encoding, the inverse of Encode.
+func Decode(v []float32) []deck.Card {
+	var cards []deck.Card
+	for i, n := range v {
+		for ; n > 0; n-- {
+			cards = append(cards, deck.CardAt(i))
+		}
+	}
+	return cards
+}
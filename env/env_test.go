@@ -0,0 +1,19 @@
+package env
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestEncode(t *testing.T) {
+	cards := []deck.Card{{Suit: deck.Heart, Rank: deck.King}, {Suit: deck.Spade, Rank: deck.Ace}, {Suit: deck.Spade, Rank: deck.Ace}}
+	v := Encode(cards, 54)
+	if len(v) != 54 || v[51] != 1 || v[0] != 2 {
+		t.Error("Expected the cards at their index, received:", v)
+	}
+	got := Decode(v)
+	if len(got) != 3 || got[0] != cards[1] || got[2] != cards[0] {
+		t.Error("Expected the cards back, received:", got)
+	}
+}
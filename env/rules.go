@@ -0,0 +1,135 @@
+package env
+
+import (
+	"math/rand"
+
+	"github.com/euller88/deck"
+	"github.com/euller88/deck/rules"
+)
+
+// Rules is an environment for any trick-taking game described by a rules.Spec. Playing a card is the action
+// numbered by its index.
+//
+// The observation is made of five blocks: the hand of the player to act, the cards of the current trick, the cards
+// taken in earlier tricks, all encoded one-hot, then the trump suit, one-hot over the four suits and a last position
+// for no trump, and the player to act, one-hot over the players.
+//
+// The reward of a player is the change of their score, negated in games where low scores win, so the sum of the
+// rewards of an episode is always to be maximized.
+type Rules struct {
+	spec   *rules.Spec
+	agents map[int]bool
+	game   *rules.Game
+	bot    rules.Bot
+	scores []int
+}
+
+// NewRules returns an environment for the game described by spec. The players listed as agents are the ones acting
+// through Step, and the others are played by bots choosing legal cards at random. With no agents listed, every
+// player acts through Step.
+func NewRules(spec *rules.Spec, agents ...int) (*Rules, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	e := &Rules{spec: spec, agents: map[int]bool{}}
+	for _, p := range agents {
+		e.agents[p] = true
+	}
+	if len(agents) == 0 {
+		for p := 0; p < spec.Players; p++ {
+			e.agents[p] = true
+		}
+	}
+	return e, nil
+}
+
+// Actions returns the number of distinct cards in the deck of the game, the standard ones and the jokers.
+func (e *Rules) Actions() int {
+	return len(deck.New()) + e.spec.Deck.Jokers
+}
+
+// Observations returns the length of the observations.
+func (e *Rules) Observations() int {
+	return 3*e.Actions() + 5 + e.spec.Players
+}
+
+// Players returns the number of players.
+func (e *Rules) Players() int {
+	return e.spec.Players
+}
+
+// Reset deals a new game and lets the bots play until an agent must act.
+func (e *Rules) Reset(seed int64) (Step, error) {
+	r := rand.New(rand.NewSource(seed))
+	g, err := rules.New(e.spec, r)
+	if err != nil {
+		return Step{}, err
+	}
+	e.game, e.bot = g, rules.Random{R: r}
+	e.scores = g.Scores()
+	return e.advance()
+}
+
+// Step plays the card with index action.
+func (e *Rules) Step(action int) (Step, error) {
+	if e.game == nil || e.game.Over() {
+		return Step{}, ErrDone
+	}
+	for _, c := range e.game.Legal() {
+		if c.Index() == action {
+			if err := e.game.Play(c); err != nil {
+				return Step{}, err
+			}
+			return e.advance()
+		}
+	}
+	return Step{}, ErrAction
+}
+
+// advance lets the bots play, and returns the step seen by the next agent to act.
+func (e *Rules) advance() (Step, error) {
+	g := e.game
+	for !g.Over() && !e.agents[g.Turn] {
+		v := g.View()
+		if err := g.Play(v.Legal[e.bot.Choose(v)]); err != nil {
+			return Step{}, err
+		}
+	}
+
+	scores := g.Scores()
+	rewards := make([]float64, len(scores))
+	for p := range scores {
+		rewards[p] = float64(scores[p] - e.scores[p])
+		if e.spec.Scoring.Low {
+			rewards[p] = -rewards[p]
+		}
+	}
+	e.scores = scores
+
+	s := Step{Rewards: rewards, Done: g.Over(), Player: g.Turn}
+	s.Observation = e.observe()
+	s.Mask = make([]bool, e.Actions())
+	for _, c := range g.Legal() {
+		if i := c.Index(); i < len(s.Mask) {
+			s.Mask[i] = true
+		}
+	}
+	return s, nil
+}
+
+func (e *Rules) observe() []float32 {
+	g, n := e.game, e.Actions()
+	v := make([]float32, e.Observations())
+	encode(v[:n], g.Zone("hand", g.Turn))
+	encode(v[n:2*n], g.Trick)
+	for p := 0; p < e.spec.Players; p++ {
+		encode(v[2*n:3*n], g.Zone("won", p))
+	}
+	if g.Trumps {
+		v[3*n+int(g.Trump)] = 1
+	} else {
+		v[3*n+4] = 1
+	}
+	v[3*n+5+g.Turn] = 1
+	return v
+}
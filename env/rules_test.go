@@ -0,0 +1,151 @@
+package env
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/euller88/deck"
+	"github.com/euller88/deck/rules"
+)
+
+const hearts = `{
+	"name": "Hearts",
+	"players": 4,
+	"deal": [{"from": "stock", "to": "hand"}],
+	"play": {"conditions": ["follow_suit"]},
+	"scoring": {"cards": {"Hearts": 1, "Queen of Spades": 13}, "low": true}
+}`
+
+func newHearts(t *testing.T, agents ...int) *Rules {
+	spec, err := rules.Parse(strings.NewReader(hearts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := NewRules(spec, agents...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+// first returns the first legal action of a step
+func first(s Step) int {
+	for i, ok := range s.Mask {
+		if ok {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestRules(t *testing.T) {
+	e := newHearts(t)
+	if e.Actions() != 52 || e.Observations() != 3*52+5+4 || e.Players() != 4 {
+		t.Error("Expected the sizes of Hearts, received:", e.Actions(), e.Observations())
+	}
+	if _, err := e.Step(0); err != ErrDone {
+		t.Error("Expected", ErrDone, "received:", err)
+	}
+
+	s, err := e.Reset(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hand := Decode(s.Observation[:52])
+	if len(hand) != 13 || s.Player != 0 || s.Observation[3*52+4] != 1 || s.Observation[3*52+5] != 1 {
+		t.Fatal("Expected player 0 to act with 13 cards and no trump, received:", s)
+	}
+	for i, ok := range s.Mask {
+		if ok && index(hand, deck.CardAt(i)) < 0 {
+			t.Error("Expected only cards in hand to be legal, received:", deck.CardAt(i))
+		}
+	}
+	if _, err := e.Step(e.Actions()); err != ErrAction {
+		t.Error("Expected", ErrAction, "received:", err)
+	}
+	if s, err = e.Step(first(s)); err != nil {
+		t.Fatal(err)
+	}
+	// Player 1 must follow the suit led
+	illegal := -1
+	for i, ok := range s.Mask {
+		if !ok && index(Decode(s.Observation[:52]), deck.CardAt(i)) >= 0 {
+			illegal = i
+		}
+	}
+	if _, err := e.Step(illegal); illegal < 0 || err != ErrAction {
+		t.Error("Expected", ErrAction, "for a card off suit, received:", err)
+	}
+
+	total := 0.0
+	for steps := 2; !s.Done; steps++ {
+		if s, err = e.Step(first(s)); err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range s.Rewards {
+			total += r
+		}
+		if steps > 52 {
+			t.Fatal("Expected the game to end after 52 cards.")
+		}
+	}
+	if total != -26 {
+		t.Error("Expected every point of Hearts to be given as a penalty, received:", total)
+	}
+}
+
+func TestRulesAgents(t *testing.T) {
+	e := newHearts(t, 2)
+	s, err := e.Reset(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for !s.Done {
+		if s.Player != 2 {
+			t.Fatal("Expected only player 2 to act, received:", s.Player)
+		}
+		if s, err = e.Step(first(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRulesThreePlayers(t *testing.T) {
+	// 52 cards can't be dealt evenly to three players, without the Two of Clubs every hand runs out together
+	spec := &rules.Spec{Players: 3, Deal: []rules.DealSpec{{From: "stock", To: "hand"}}, Scoring: rules.ScoringSpec{PerTrick: 1}}
+	if _, err := NewRules(spec, 0); err == nil || !strings.HasPrefix(err.Error(), rules.ErrDeal.Error()) {
+		t.Error("Expected", rules.ErrDeal, "received:", err)
+	}
+	spec.Deck.Exclude = []string{"Two of Clubs"}
+	e, err := NewRules(spec, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		s, err := e.Reset(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total := 0.0
+		for !s.Done {
+			if s, err = e.Step(first(s)); err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range s.Rewards {
+				total += r
+			}
+		}
+		if total != 17 {
+			t.Error("Expected the 17 tricks to be played, received:", total)
+		}
+	}
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,72 @@
+package env
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Request is a message sent to Serve, one JSON object per line. Cmd is "info", "reset", with a Seed, or "step",
+// with an Action.
+type Request struct {
+	Cmd    string `json:"cmd"`
+	Seed   int64  `json:"seed"`
+	Action int    `json:"action"`
+}
+
+// Response is the answer to a request, one JSON object per line: a step, the sizes of the environment for "info",
+// or an error.
+type Response struct {
+	*Step
+	Actions      int    `json:"actions,omitempty"`
+	Observations int    `json:"observations,omitempty"`
+	Players      int    `json:"players,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// Serve answers the requests read from r, writing the responses to w, until r ends. An error answering a request
+// is sent back as a response, only failing to read or write stops Serve.
+func Serve(e Env, r io.Reader, w io.Writer) error {
+	in := bufio.NewScanner(r)
+	in.Buffer(make([]byte, 64*1024), 1024*1024)
+	out := json.NewEncoder(w)
+
+	for in.Scan() {
+		if len(in.Bytes()) == 0 {
+			continue
+		}
+		var resp Response
+		var req Request
+		if err := json.Unmarshal(in.Bytes(), &req); err != nil {
+			resp.Error = err.Error()
+		} else {
+			resp = handle(e, req)
+		}
+		if err := out.Encode(resp); err != nil {
+			return err
+		}
+	}
+	return in.Err()
+}
+
+func handle(e Env, req Request) Response {
+	var (
+		s   Step
+		err error
+	)
+	switch req.Cmd {
+	case "info":
+		return Response{Actions: e.Actions(), Observations: e.Observations(), Players: e.Players()}
+	case "reset":
+		s, err = e.Reset(req.Seed)
+	case "step":
+		s, err = e.Step(req.Action)
+	default:
+		err = fmt.Errorf("env: unknown command %q", req.Cmd)
+	}
+	if err != nil {
+		return Response{Error: err.Error()}
+	}
+	return Response{Step: &s}
+}
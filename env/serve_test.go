@@ -0,0 +1,49 @@
+package env
+
+import (
+	"bufio"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestServe(t *testing.T) {
+	in := strings.Join([]string{
+		`{"cmd": "info"}`,
+		`{"cmd": "reset", "seed": 1}`,
+		`{"cmd": "step", "action": 99}`,
+		`{"cmd": "jump"}`,
+		`not json`,
+	}, "\n")
+	var out strings.Builder
+	if err := Serve(newHearts(t), strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+
+	var resps []Response
+	sc := bufio.NewScanner(strings.NewReader(out.String()))
+	for sc.Scan() {
+		var r Response
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		resps = append(resps, r)
+	}
+	if len(resps) != 5 {
+		t.Fatal("Expected a response for every request, received:", out.String())
+	}
+	if resps[0].Actions != 52 || resps[0].Players != 4 {
+		t.Error("Expected the sizes, received:", resps[0])
+	}
+	if resps[1].Step == nil || len(resps[1].Observation) != 3*52+9 || len(resps[1].Mask) != 52 {
+		t.Error("Expected the first step, received:", resps[1])
+	}
+	for _, r := range resps[2:] {
+		if r.Error == "" {
+			t.Error("Expected an error, received:", r)
+		}
+	}
+	if resps[2].Error != ErrAction.Error() {
+		t.Error("Expected", ErrAction, "received:", resps[2].Error)
+	}
+}
@@ -0,0 +1,237 @@
+// Package fivehundred implements Five Hundred, the trick-taking game with a bidding table, a kitty and a joker
+// that is the highest trump.
+package fivehundred
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/euller88/deck"
+)
+
+// The extra ranks found in the 63 cards deck used by six players, which sit between the Ten and the Jack
+const (
+	Eleven deck.Rank = deck.King + 1 + iota
+	Twelve
+	Thirteen
+)
+
+// The number of cards dealt to every player and to the kitty
+const (
+	HandSize  = 10
+	KittySize = 3
+)
+
+// The errors returned when a move breaks the rules of the game
+var (
+	ErrPlayers   = errors.New("fivehundred: a game needs between 3 and 6 players")
+	ErrDeckSize  = errors.New("fivehundred: not enough cards to deal")
+	ErrTurn      = errors.New("fivehundred: it's not the player's turn")
+	ErrPhase     = errors.New("fivehundred: move not allowed in this phase")
+	ErrLowBid    = errors.New("fivehundred: bid doesn't beat the current one")
+	ErrBid       = errors.New("fivehundred: not a valid bid")
+	ErrMisere    = errors.New("fivehundred: misère needs a previous bid of seven or more")
+	ErrDiscard   = errors.New("fivehundred: must discard three cards from the hand")
+	ErrNotInHand = errors.New("fivehundred: card is not in the player's hand")
+	ErrFollow    = errors.New("fivehundred: player must follow suit")
+	ErrNominate  = errors.New("fivehundred: the joker needs a nominated suit when led without trumps")
+)
+
+// Deck returns the deck used by the given number of players, which always has room for ten cards each plus the kitty:
+// 33 cards from the Sevens up for 3 players, 43 cards without the Twos, Threes and black Fours for 4,
+// the full 53 for 5 and 63 cards with Elevens, Twelves and red Thirteens for 6. Every deck has a single joker.
+func Deck(players int, opts ...func([]deck.Card) []deck.Card) ([]deck.Card, error) {
+	var base []func([]deck.Card) []deck.Card
+	switch players {
+	case 3:
+		base = append(base, deck.Filter(func(c deck.Card) bool {
+			return c.Rank >= deck.Two && c.Rank <= deck.Six
+		}))
+	case 4:
+		base = append(base, deck.Filter(func(c deck.Card) bool {
+			return c.Rank == deck.Two || c.Rank == deck.Three || c.Rank == deck.Four && black(c.Suit)
+		}))
+	case 5:
+	case 6:
+		base = append(base, extra)
+	default:
+		return nil, ErrPlayers
+	}
+	base = append(base, deck.Jokers(1))
+
+	return deck.New(append(base, opts...)...), nil
+}
+
+// extra adds the Elevens and Twelves of every suit and the red Thirteens.
+func extra(cards []deck.Card) []deck.Card {
+	for _, s := range []deck.Suit{deck.Spade, deck.Diamond, deck.Club, deck.Heart} {
+		cards = append(cards, deck.Card{Suit: s, Rank: Eleven}, deck.Card{Suit: s, Rank: Twelve})
+		if !black(s) {
+			cards = append(cards, deck.Card{Suit: s, Rank: Thirteen})
+		}
+	}
+	return cards
+}
+
+func black(s deck.Suit) bool {
+	return s == deck.Spade || s == deck.Club
+}
+
+// partner returns the other suit of the same color.
+func partner(s deck.Suit) deck.Suit {
+	switch s {
+	case deck.Spade:
+		return deck.Club
+	case deck.Club:
+		return deck.Spade
+	case deck.Diamond:
+		return deck.Heart
+	default:
+		return deck.Diamond
+	}
+}
+
+// Strain is what a bid is made in: one of the four suits, no trumps or one of the misère bids.
+type Strain uint8
+
+// The possible strains, from the lowest to the highest
+const (
+	Spades Strain = iota
+	Clubs
+	Diamonds
+	Hearts
+	NoTrumps
+	Misere
+	OpenMisere
+)
+
+var strainNames = [...]string{"♠", "♣", "♦", "♥", "NT", "Misère", "Open Misère"}
+
+// Trump returns the trump suit for a strain, and false when there are no trumps other than the joker.
+func (s Strain) Trump() (deck.Suit, bool) {
+	switch s {
+	case Spades:
+		return deck.Spade, true
+	case Clubs:
+		return deck.Club, true
+	case Diamonds:
+		return deck.Diamond, true
+	case Hearts:
+		return deck.Heart, true
+	default:
+		return deck.Joker, false
+	}
+}
+
+// Bid is a contract offered in the auction. Misère bids have no number of tricks.
+type Bid struct {
+	Tricks int
+	Strain Strain
+}
+
+// Pass is the bid of a player that drops out of the auction
+var Pass = Bid{}
+
+// Valid reports if the bid is in the table, from six to ten tricks or one of the misère bids.
+func (b Bid) Valid() bool {
+	if b.Strain >= Misere {
+		return b.Strain <= OpenMisere && b.Tricks == 0
+	}
+	return b.Tricks >= 6 && b.Tricks <= 10
+}
+
+// Value returns the points of the bid in the Avondale table: 6♠ is worth 40, every strain is worth 20 more than
+// the one below it, every extra trick 100 more, misère 250 and open misère 500.
+func (b Bid) Value() int {
+	switch b.Strain {
+	case Misere:
+		return 250
+	case OpenMisere:
+		return 500
+	}
+	return 40 + 20*int(b.Strain) + 100*(b.Tricks-6)
+}
+
+// Beats reports if the bid outranks o. Bids are ranked by their value, and 10♥ outranks open misère.
+func (b Bid) Beats(o Bid) bool {
+	if o == Pass {
+		return b != Pass
+	}
+	return b.rank() > o.rank()
+}
+
+func (b Bid) rank() int {
+	r := 2 * b.Value()
+	if b.Strain < Misere {
+		r++
+	}
+	return r
+}
+
+func (b Bid) String() string {
+	switch {
+	case b == Pass:
+		return "Pass"
+	case b.Strain >= Misere:
+		return strainNames[b.Strain]
+	default:
+		return fmt.Sprintf("%d%s", b.Tricks, strainNames[b.Strain])
+	}
+}
+
+// Power returns the strength of a card in a trick under the given trump suit, or without trumps if trumps is false.
+// The joker is always the strongest card, followed in a trump contract by the Jack of trumps, the right bower,
+// and the Jack of the same color, the left bower. Cards that neither follow the suit led nor are trumps score 0.
+func Power(c deck.Card, led, trump deck.Suit, trumps bool) int {
+	const trumpBase = 100
+
+	switch {
+	case c.Suit == deck.Joker:
+		return 2 * trumpBase
+	case trumps && c.Rank == deck.Jack && c.Suit == trump:
+		return trumpBase + 51
+	case trumps && c.Rank == deck.Jack && c.Suit == partner(trump):
+		return trumpBase + 50
+	case trumps && c.Suit == trump:
+		return trumpBase + order(c.Rank)
+	case c.Suit == led:
+		return order(c.Rank)
+	default:
+		return 0
+	}
+}
+
+// order ranks A, K, Q, J, 13, 12, 11, 10 and down to the Four.
+func order(r deck.Rank) int {
+	switch r {
+	case deck.Ace:
+		return 20
+	case deck.King:
+		return 19
+	case deck.Queen:
+		return 18
+	case deck.Jack:
+		return 17
+	case Thirteen:
+		return 16
+	case Twelve:
+		return 15
+	case Eleven:
+		return 14
+	default:
+		return int(r)
+	}
+}
+
+// SuitOf returns the suit a card belongs to for following purposes: the left bower is a trump, and the joker is
+// a trump in a trump contract and suitless, returned as deck.Joker, without trumps.
+func SuitOf(c deck.Card, trump deck.Suit, trumps bool) deck.Suit {
+	switch {
+	case c.Suit == deck.Joker && trumps:
+		return trump
+	case trumps && c.Rank == deck.Jack && c.Suit == partner(trump):
+		return trump
+	default:
+		return c.Suit
+	}
+}
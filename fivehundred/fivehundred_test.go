@@ -0,0 +1,64 @@
+package fivehundred
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestDeck(t *testing.T) {
+	for players, size := range map[int]int{3: 33, 4: 43, 5: 53, 6: 63} {
+		cards, err := Deck(players)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cards) != size {
+			t.Errorf("Expected %d cards for %d players, received %d cards.", size, players, len(cards))
+		}
+	}
+	if _, err := Deck(7); err != ErrPlayers {
+		t.Error("Expected", ErrPlayers, "received:", err)
+	}
+}
+
+func TestBidValue(t *testing.T) {
+	cases := []struct {
+		bid Bid
+		exp int
+	}{
+		{Bid{6, Spades}, 40},
+		{Bid{6, Hearts}, 100},
+		{Bid{7, Clubs}, 160},
+		{Bid{8, NoTrumps}, 320},
+		{Bid{10, NoTrumps}, 520},
+		{Bid{Strain: Misere}, 250},
+		{Bid{Strain: OpenMisere}, 500},
+	}
+	for _, tc := range cases {
+		if v := tc.bid.Value(); v != tc.exp {
+			t.Errorf("Expected %s to be worth %d, received %d.", tc.bid, tc.exp, v)
+		}
+	}
+	if !(Bid{10, Hearts}).Beats(Bid{Strain: OpenMisere}) || (Bid{10, Diamonds}).Beats(Bid{Strain: OpenMisere}) {
+		t.Error("Expected open misère to sit between 10♦ and 10♥.")
+	}
+}
+
+func TestPower(t *testing.T) {
+	joker := deck.Card{Suit: deck.Joker}
+	right := deck.Card{Suit: deck.Heart, Rank: deck.Jack}
+	left := deck.Card{Suit: deck.Diamond, Rank: deck.Jack}
+	ace := deck.Card{Suit: deck.Heart, Rank: deck.Ace}
+
+	if !(Power(joker, deck.Heart, deck.Heart, true) > Power(right, deck.Heart, deck.Heart, true) &&
+		Power(right, deck.Heart, deck.Heart, true) > Power(left, deck.Heart, deck.Heart, true) &&
+		Power(left, deck.Heart, deck.Heart, true) > Power(ace, deck.Heart, deck.Heart, true)) {
+		t.Error("Expected joker > right bower > left bower > ace of trumps.")
+	}
+	if SuitOf(left, deck.Heart, true) != deck.Heart {
+		t.Error("Expected the left bower to be a trump.")
+	}
+	if SuitOf(joker, deck.Heart, false) != deck.Joker {
+		t.Error("Expected the joker to be suitless without trumps.")
+	}
+}
@@ -0,0 +1,330 @@
+package fivehundred
+
+import (
+	"github.com/euller88/deck"
+)
+
+// Phase is the part of the hand being played
+type Phase uint8
+
+// The phases of a hand of Five Hundred
+const (
+	// Bidding is the auction for the contract
+	Bidding Phase = iota
+
+	// Exchange is the declarer taking the kitty and discarding three cards
+	Exchange
+
+	// Playing is the play of the ten tricks
+	Playing
+
+	// Done is the end of the hand, either scored or passed out
+	Done
+)
+
+// Game holds the state of a hand of Five Hundred.
+type Game struct {
+	// Hands holds the cards of every player
+	Hands [][]deck.Card
+
+	// Kitty holds the three extra cards, and later the cards discarded by the declarer
+	Kitty []deck.Card
+
+	// Phase is the current part of the hand
+	Phase Phase
+
+	// Contract is the winning bid and Declarer the player who made it
+	Contract Bid
+	Declarer int
+
+	// Trick holds the cards played in the current trick, in playing order, and Nominated the suit named for a
+	// joker led without trumps
+	Trick     []deck.Card
+	Nominated deck.Suit
+
+	// Tricks holds the number of tricks taken by every player
+	Tricks []int
+
+	// Leader is the player who led the current trick and Turn is the player who must play now
+	Leader, Turn int
+
+	passed  []bool
+	out     int
+	players []int
+}
+
+// New deals a hand from cards, which should come already shuffled, to the given number of players.
+// Player 0 bids first and the last three cards form the kitty.
+func New(cards []deck.Card, players int) (*Game, error) {
+	if players < 3 || players > 6 {
+		return nil, ErrPlayers
+	}
+	if len(cards) < players*HandSize+KittySize {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{
+		Hands:  make([][]deck.Card, players),
+		Tricks: make([]int, players),
+		passed: make([]bool, players),
+		out:    -1,
+	}
+	for i := 0; i < HandSize; i++ {
+		for p := range g.Hands {
+			g.Hands[p] = append(g.Hands[p], cards[i*players+p])
+		}
+	}
+	g.Kitty = append([]deck.Card(nil), cards[players*HandSize:players*HandSize+KittySize]...)
+	return g, nil
+}
+
+// Bid makes a bid for player, or drops them from the auction when b is Pass.
+// The auction ends when a single bidder is left, or with no contract when everybody passes.
+func (g *Game) Bid(player int, b Bid) error {
+	if g.Phase != Bidding {
+		return ErrPhase
+	}
+	if player != g.Turn {
+		return ErrTurn
+	}
+	if b != Pass {
+		if !b.Valid() {
+			return ErrBid
+		}
+		if !b.Beats(g.Contract) {
+			return ErrLowBid
+		}
+		if b.Strain == Misere && g.Contract.Tricks < 7 {
+			return ErrMisere
+		}
+		g.Contract, g.Declarer = b, player
+	} else {
+		g.passed[player] = true
+	}
+
+	left := 0
+	for _, p := range g.passed {
+		if !p {
+			left++
+		}
+	}
+	switch {
+	case left == 0:
+		g.Phase = Done
+		return nil
+	case left == 1 && g.Contract != Pass:
+		g.Phase = Exchange
+		g.Turn = g.Declarer
+		g.Hands[g.Declarer] = append(g.Hands[g.Declarer], g.Kitty...)
+		g.Kitty = nil
+		return nil
+	}
+
+	for {
+		g.Turn = (g.Turn + 1) % len(g.Hands)
+		if !g.passed[g.Turn] {
+			return nil
+		}
+	}
+}
+
+// Discard puts three cards from the declarer's hand, which holds the kitty, back into the kitty and starts the play.
+// The declarer leads the first trick. In misère with four players the partner of the declarer sits out.
+func (g *Game) Discard(cards []deck.Card) error {
+	if g.Phase != Exchange {
+		return ErrPhase
+	}
+	if len(cards) != KittySize {
+		return ErrDiscard
+	}
+	hand := append([]deck.Card(nil), g.Hands[g.Declarer]...)
+	for _, c := range cards {
+		i := index(hand, c)
+		if i < 0 {
+			return ErrNotInHand
+		}
+		hand = append(hand[:i], hand[i+1:]...)
+	}
+
+	g.Hands[g.Declarer] = hand
+	g.Kitty = append([]deck.Card(nil), cards...)
+	g.Phase = Playing
+	g.Leader, g.Turn = g.Declarer, g.Declarer
+	if g.Contract.Strain >= Misere && len(g.Hands) == 4 {
+		g.out = (g.Declarer + 2) % len(g.Hands)
+	}
+	return nil
+}
+
+// Exposed returns the declarer's hand when it is played face up in open misère, or nil otherwise.
+func (g *Game) Exposed() []deck.Card {
+	if g.Phase != Playing || g.Contract.Strain != OpenMisere {
+		return nil
+	}
+	return g.Hands[g.Declarer]
+}
+
+// Legal returns the cards player may play into the current trick.
+func (g *Game) Legal(player int) []deck.Card {
+	if g.Phase != Playing || player != g.Turn {
+		return nil
+	}
+	hand := g.Hands[player]
+	if len(g.Trick) == 0 {
+		return hand
+	}
+
+	trump, trumps := g.Contract.Strain.Trump()
+	led := g.led()
+	var follow []deck.Card
+	for _, c := range hand {
+		if SuitOf(c, trump, trumps) == led {
+			follow = append(follow, c)
+		}
+	}
+	if len(follow) > 0 {
+		return follow
+	}
+	return hand
+}
+
+// Play puts card from the hand of player into the trick. A joker led without trumps must be played with LeadJoker.
+func (g *Game) Play(player int, card deck.Card) error {
+	if _, trumps := g.Contract.Strain.Trump(); card.Suit == deck.Joker && !trumps && len(g.Trick) == 0 {
+		return ErrNominate
+	}
+	return g.play(player, card)
+}
+
+// LeadJoker leads the joker in a contract without trumps, naming the suit the other players must follow.
+func (g *Game) LeadJoker(player int, suit deck.Suit) error {
+	if len(g.Trick) > 0 || suit == deck.Joker {
+		return ErrNominate
+	}
+	if err := g.play(player, deck.Card{Suit: deck.Joker}); err != nil {
+		return err
+	}
+	g.Nominated = suit
+	return nil
+}
+
+func (g *Game) play(player int, card deck.Card) error {
+	if g.Phase != Playing {
+		return ErrPhase
+	}
+	if player != g.Turn {
+		return ErrTurn
+	}
+	i := index(g.Hands[player], card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if index(g.Legal(player), card) < 0 {
+		return ErrFollow
+	}
+
+	g.Hands[player] = append(g.Hands[player][:i], g.Hands[player][i+1:]...)
+	g.Trick = append(g.Trick, card)
+	g.players = append(g.players, player)
+	g.Turn = g.next(player)
+	if g.Turn != g.Leader {
+		return nil
+	}
+
+	trump, trumps := g.Contract.Strain.Trump()
+	led := g.led()
+	best := 0
+	for i := range g.Trick {
+		if Power(g.Trick[i], led, trump, trumps) > Power(g.Trick[best], led, trump, trumps) {
+			best = i
+		}
+	}
+	winner := g.players[best]
+	g.Tricks[winner]++
+	g.Trick, g.players = nil, nil
+	g.Leader, g.Turn = winner, winner
+
+	if len(g.Hands[winner]) == 0 || (g.Contract.Strain >= Misere && g.Tricks[g.Declarer] > 0) {
+		g.Phase = Done
+	}
+	return nil
+}
+
+// led returns the suit led in the current trick.
+func (g *Game) led() deck.Suit {
+	trump, trumps := g.Contract.Strain.Trump()
+	if g.Trick[0].Suit == deck.Joker && !trumps {
+		return g.Nominated
+	}
+	return SuitOf(g.Trick[0], trump, trumps)
+}
+
+// next returns the player seated after p, skipping the one sitting out.
+func (g *Game) next(p int) int {
+	p = (p + 1) % len(g.Hands)
+	if p == g.out {
+		p = (p + 1) % len(g.Hands)
+	}
+	return p
+}
+
+// Side returns the side a player belongs to: teams of players sitting alternately with 4 or 6 players,
+// and every player on their own otherwise.
+func (g *Game) Side(player int) int {
+	if len(g.Hands)%2 == 0 {
+		return player % 2
+	}
+	return player
+}
+
+// Score returns the points every player scored in the hand, partners scoring the same. The declarer's side scores
+// the value of the contract when it is made, 250 if it took all tricks with a lower contract, and loses it otherwise.
+// Out of misère, the other players score 10 for every trick they took.
+func (g *Game) Score() []int {
+	score := make([]int, len(g.Hands))
+	if g.Phase != Done || g.Contract == Pass {
+		return score
+	}
+
+	side := g.Side(g.Declarer)
+	taken := 0
+	for p, n := range g.Tricks {
+		if g.Side(p) == side {
+			taken += n
+		}
+	}
+
+	value := g.Contract.Value()
+	switch {
+	case g.Contract.Strain >= Misere && g.Tricks[g.Declarer] > 0:
+		value = -value
+	case g.Contract.Strain >= Misere:
+	case taken < g.Contract.Tricks:
+		value = -value
+	case taken == HandSize && value < 250:
+		value = 250
+	}
+
+	for p := range score {
+		switch {
+		case g.Side(p) == side:
+			score[p] = value
+		case g.Contract.Strain < Misere:
+			for q, n := range g.Tricks {
+				if g.Side(q) == g.Side(p) {
+					score[p] += 10 * n
+				}
+			}
+		}
+	}
+	return score
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
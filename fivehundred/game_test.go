@@ -0,0 +1,87 @@
+package fivehundred
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestAuction(t *testing.T) {
+	cards, _ := Deck(4)
+	g, err := New(cards, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Bid(1, Bid{6, Spades}); err != ErrTurn {
+		t.Error("Expected", ErrTurn, "received:", err)
+	}
+	if err := g.Bid(0, Bid{Strain: Misere}); err != ErrMisere {
+		t.Error("Expected", ErrMisere, "received:", err)
+	}
+	if err := g.Bid(0, Bid{6, Hearts}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Bid(1, Bid{6, Clubs}); err != ErrLowBid {
+		t.Error("Expected", ErrLowBid, "received:", err)
+	}
+	for _, b := range []struct {
+		p int
+		b Bid
+	}{{1, Bid{7, Spades}}, {2, Pass}, {3, Pass}, {0, Pass}} {
+		if err := g.Bid(b.p, b.b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if g.Phase != Exchange || g.Declarer != 1 || len(g.Hands[1]) != HandSize+KittySize {
+		t.Fatal("Expected player 1 to win the auction and take the kitty.")
+	}
+	if err := g.Discard(g.Hands[1][:KittySize]); err != nil {
+		t.Fatal(err)
+	}
+	if g.Phase != Playing || g.Turn != 1 {
+		t.Error("Expected player 1 to lead the first trick.")
+	}
+}
+
+func TestJoker(t *testing.T) {
+	g := &Game{
+		Hands: [][]deck.Card{
+			{{Suit: deck.Joker}, {Suit: deck.Spade, Rank: deck.Four}},
+			{{Suit: deck.Club, Rank: deck.Ace}, {Suit: deck.Spade, Rank: deck.Ace}},
+			{{Suit: deck.Heart, Rank: deck.Ace}, {Suit: deck.Heart, Rank: deck.King}},
+		},
+		Phase:    Playing,
+		Contract: Bid{6, NoTrumps},
+		Tricks:   make([]int, 3),
+		out:      -1,
+	}
+	if err := g.Play(0, deck.Card{Suit: deck.Joker}); err != ErrNominate {
+		t.Error("Expected", ErrNominate, "received:", err)
+	}
+	if err := g.LeadJoker(0, deck.Club); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(1, deck.Card{Suit: deck.Spade, Rank: deck.Ace}); err != ErrFollow {
+		t.Error("Expected", ErrFollow, "received:", err)
+	}
+	if err := g.Play(1, deck.Card{Suit: deck.Club, Rank: deck.Ace}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(2, deck.Card{Suit: deck.Heart, Rank: deck.Ace}); err != nil {
+		t.Fatal(err)
+	}
+	if g.Tricks[0] != 1 || g.Turn != 0 {
+		t.Error("Expected the joker to take the trick.")
+	}
+}
+
+func TestScore(t *testing.T) {
+	g := &Game{Hands: make([][]deck.Card, 4), Phase: Done, Contract: Bid{7, Hearts}, Declarer: 0, Tricks: []int{4, 2, 2, 2}}
+	if s := g.Score(); s[0] != -200 || s[2] != -200 || s[1] != 40 {
+		t.Error("Expected a failed 7♥ to cost 200 and the defenders to score 40, received:", s)
+	}
+	g.Tricks = []int{5, 0, 5, 0}
+	if s := g.Score(); s[0] != 250 || s[1] != 0 {
+		t.Error("Expected a slam to score 250, received:", s)
+	}
+}
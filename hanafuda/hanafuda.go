@@ -0,0 +1,166 @@
+// Package hanafuda implements the japanese flower cards, a deck of 48 cards with 4 cards for each of the 12 months,
+// and the game of Koi-Koi played with them.
+package hanafuda
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Month is the flower a card belongs to, and plays the part a suit plays in a traditional deck
+type Month uint8
+
+// The twelve months of the deck
+const (
+	_ Month = iota
+	Pine
+	Plum
+	Cherry
+	Wisteria
+	Iris
+	Peony
+	BushClover
+	Pampas
+	Chrysanthemum
+	Maple
+	Willow
+	Paulownia
+)
+
+var monthNames = [...]string{"", "Pine", "Plum", "Cherry", "Wisteria", "Iris", "Peony", "Bush Clover", "Pampas",
+	"Chrysanthemum", "Maple", "Willow", "Paulownia"}
+
+func (m Month) String() string {
+	if m < Pine || m > Paulownia {
+		return fmt.Sprintf("Month(%d)", m)
+	}
+	return monthNames[m]
+}
+
+// Category is the kind of a card, which tells how much it's worth
+type Category uint8
+
+// The four categories of cards, from the most to the least valuable
+const (
+	// Bright is one of the five special cards, such as the Crane or the Moon
+	Bright Category = iota
+
+	// Animal is a card showing an animal or an object, such as the Deer or the Sake Cup
+	Animal
+
+	// Ribbon is a card showing a poetry, blue or plain red ribbon
+	Ribbon
+
+	// Chaff is a plain card
+	Chaff
+)
+
+var categoryNames = [...]string{"Bright", "Animal", "Ribbon", "Chaff"}
+
+func (c Category) String() string {
+	if c > Chaff {
+		return fmt.Sprintf("Category(%d)", c)
+	}
+	return categoryNames[c]
+}
+
+// Card is a single hanafuda card, the Nth card of its month. The cards of a month are numbered from the most to the
+// least valuable, starting from 0.
+type Card struct {
+	Month
+	N uint8
+}
+
+type info struct {
+	category Category
+	name     string
+}
+
+// table describes every card in the deck, by month, from the most to the least valuable
+var table = [...][4]info{
+	Pine:          {{Bright, "Crane and Sun"}, {Ribbon, "Pine Poetry Ribbon"}, {Chaff, "Pine"}, {Chaff, "Pine"}},
+	Plum:          {{Animal, "Bush Warbler"}, {Ribbon, "Plum Poetry Ribbon"}, {Chaff, "Plum"}, {Chaff, "Plum"}},
+	Cherry:        {{Bright, "Curtain"}, {Ribbon, "Cherry Poetry Ribbon"}, {Chaff, "Cherry"}, {Chaff, "Cherry"}},
+	Wisteria:      {{Animal, "Cuckoo"}, {Ribbon, "Wisteria Red Ribbon"}, {Chaff, "Wisteria"}, {Chaff, "Wisteria"}},
+	Iris:          {{Animal, "Eight-plank Bridge"}, {Ribbon, "Iris Red Ribbon"}, {Chaff, "Iris"}, {Chaff, "Iris"}},
+	Peony:         {{Animal, "Butterflies"}, {Ribbon, "Peony Blue Ribbon"}, {Chaff, "Peony"}, {Chaff, "Peony"}},
+	BushClover:    {{Animal, "Boar"}, {Ribbon, "Bush Clover Red Ribbon"}, {Chaff, "Bush Clover"}, {Chaff, "Bush Clover"}},
+	Pampas:        {{Bright, "Moon"}, {Animal, "Geese"}, {Chaff, "Pampas"}, {Chaff, "Pampas"}},
+	Chrysanthemum: {{Animal, "Sake Cup"}, {Ribbon, "Chrysanthemum Blue Ribbon"}, {Chaff, "Chrysanthemum"}, {Chaff, "Chrysanthemum"}},
+	Maple:         {{Animal, "Deer"}, {Ribbon, "Maple Blue Ribbon"}, {Chaff, "Maple"}, {Chaff, "Maple"}},
+	Willow:        {{Bright, "Rain Man"}, {Animal, "Swallow"}, {Ribbon, "Willow Red Ribbon"}, {Chaff, "Lightning"}},
+	Paulownia:     {{Bright, "Phoenix"}, {Chaff, "Paulownia"}, {Chaff, "Paulownia"}, {Chaff, "Paulownia"}},
+}
+
+// The cards that take part in special yaku
+var (
+	Crane       = Card{Pine, 0}
+	Curtain     = Card{Cherry, 0}
+	Moon        = Card{Pampas, 0}
+	RainMan     = Card{Willow, 0}
+	Phoenix     = Card{Paulownia, 0}
+	Boar        = Card{BushClover, 0}
+	Deer        = Card{Maple, 0}
+	Butterflies = Card{Peony, 0}
+	SakeCup     = Card{Chrysanthemum, 0}
+)
+
+// Valid reports if the card is one of the 48 cards in the deck.
+func (c Card) Valid() bool {
+	return c.Month >= Pine && c.Month <= Paulownia && c.N < 4
+}
+
+// Category returns the kind of the card.
+func (c Card) Category() Category {
+	return table[c.Month][c.N].category
+}
+
+// Name returns the picture on the card, such as "Crane and Sun".
+func (c Card) Name() string {
+	return table[c.Month][c.N].name
+}
+
+// Poetry reports if the card is one of the three red ribbons with poetry, from January to March.
+func (c Card) Poetry() bool {
+	return c.Category() == Ribbon && c.Month <= Cherry
+}
+
+// Blue reports if the card is one of the three blue ribbons.
+func (c Card) Blue() bool {
+	return c.Category() == Ribbon && (c.Month == Peony || c.Month == Chrysanthemum || c.Month == Maple)
+}
+
+func (c Card) String() string {
+	if !c.Valid() {
+		return fmt.Sprintf("Card(%d, %d)", c.Month, c.N)
+	}
+	return fmt.Sprintf("%s (%s, %s)", c.Name(), c.Month, c.Category())
+}
+
+// New returns the 48 cards deck, ordered by month, with the given options applied.
+func New(opts ...func([]Card) []Card) []Card {
+	deck := make([]Card, 0, 48)
+	for m := Pine; m <= Paulownia; m++ {
+		for n := uint8(0); n < 4; n++ {
+			deck = append(deck, Card{Month: m, N: n})
+		}
+	}
+
+	for _, opt := range opts {
+		deck = opt(deck)
+	}
+
+	return deck
+}
+
+// ShuffleWith returns a function that shuffles a deck with the given random source, as deck.ShuffleWith does for
+// traditional cards, so a deal can be reproduced from its seed.
+func ShuffleWith(r *rand.Rand) func([]Card) []Card {
+	return func(cards []Card) []Card {
+		r.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+
+		return cards
+	}
+}
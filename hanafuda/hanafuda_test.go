@@ -0,0 +1,44 @@
+package hanafuda
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func ExampleCard() {
+	fmt.Println(Crane)
+	fmt.Println(Card{Month: Willow, N: 3})
+
+	// Output:
+	// Crane and Sun (Pine, Bright)
+	// Lightning (Willow, Chaff)
+}
+
+func TestNew(t *testing.T) {
+	cards := New()
+	if len(cards) != 48 {
+		t.Errorf("Expected %d cards, received %d cards.", 48, len(cards))
+	}
+
+	count := map[Category]int{}
+	for _, c := range cards {
+		count[c.Category()]++
+	}
+	if count[Bright] != 5 || count[Animal] != 9 || count[Ribbon] != 10 || count[Chaff] != 24 {
+		t.Error("Expected 5 brights, 9 animals, 10 ribbons and 24 chaff, received:", count)
+	}
+}
+
+func TestShuffleWith(t *testing.T) {
+	a := New(ShuffleWith(rand.New(rand.NewSource(7))))
+	b := New(ShuffleWith(rand.New(rand.NewSource(7))))
+	if len(a) != 48 {
+		t.Errorf("Expected %d cards, received %d cards.", 48, len(a))
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatal("Expected the same seed to deal the same cards, received:", a, b)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package hanafuda
+
+import (
+	"errors"
+)
+
+// The number of cards in every hand and on the field at the start of a round
+const HandSize = 8
+
+// The errors returned when a move breaks the rules of Koi-Koi
+var (
+	ErrDeckSize  = errors.New("hanafuda: not enough cards to deal")
+	ErrPhase     = errors.New("hanafuda: move not allowed in this phase")
+	ErrNotInHand = errors.New("hanafuda: card is not in the player's hand")
+	ErrMatch     = errors.New("hanafuda: card can't be matched with that field card")
+)
+
+// Phase is the part of the turn being played
+type Phase uint8
+
+// The phases of a turn of Koi-Koi
+const (
+	// Playing is the player matching a card from the hand with the field
+	Playing Phase = iota
+
+	// Drawing is the player matching the top card of the stock with the field
+	Drawing
+
+	// Deciding is the player that just made a new yaku choosing between stopping and calling koi-koi
+	Deciding
+
+	// Done is the end of the round
+	Done
+)
+
+// Game holds the state of a round of Koi-Koi between two players. Player 0 is the dealer, who plays first.
+type Game struct {
+	// Hands holds the cards of both players
+	Hands [2][]Card
+
+	// Field holds the face up cards on the table
+	Field []Card
+
+	// Stock holds the cards still to be drawn
+	Stock []Card
+
+	// Captured holds the cards taken by every player
+	Captured [2][]Card
+
+	// Turn is the player who must play now and Phase the part of the turn
+	Turn  int
+	Phase Phase
+
+	// Calls holds the number of times every player called koi-koi
+	Calls [2]int
+
+	// Winner is the player who stopped, or -1 if the round ended without one, and Score the points they made
+	Winner int
+	Score  int
+
+	scored [2]int
+}
+
+// NewKoiKoi deals a round from cards, which should come already shuffled: eight cards to every player and to the field.
+func NewKoiKoi(cards []Card) (*Game, error) {
+	if len(cards) < 3*HandSize {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{Winner: -1}
+	g.Hands[0] = append([]Card(nil), cards[:HandSize]...)
+	g.Hands[1] = append([]Card(nil), cards[HandSize:2*HandSize]...)
+	g.Field = append([]Card(nil), cards[2*HandSize:3*HandSize]...)
+	g.Stock = append([]Card(nil), cards[3*HandSize:]...)
+	return g, nil
+}
+
+// Matches returns the field cards of the same month as card.
+func (g *Game) Matches(card Card) []Card {
+	var ret []Card
+	for _, f := range g.Field {
+		if f.Month == card.Month {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
+
+// Play matches card from the hand of the current player with the field card match. When there is no field card of
+// the same month, card stays on the field and match must be the zero Card. When a single card or three cards match,
+// they are all taken, and only when two cards match the player must pick one.
+func (g *Game) Play(card, match Card) error {
+	if g.Phase != Playing {
+		return ErrPhase
+	}
+	i := index(g.Hands[g.Turn], card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if err := g.take(card, match); err != nil {
+		return err
+	}
+
+	g.Hands[g.Turn] = append(g.Hands[g.Turn][:i], g.Hands[g.Turn][i+1:]...)
+	g.Phase = Drawing
+	return nil
+}
+
+// Draw turns the top card of the stock and matches it with the field card match, following the same rules as Play.
+// If the captured cards of the player make new yaku or improve the ones they had, the player must decide between
+// Stop and KoiKoi, otherwise the turn passes.
+func (g *Game) Draw(match Card) error {
+	if g.Phase != Drawing {
+		return ErrPhase
+	}
+	if len(g.Stock) == 0 {
+		g.end()
+		return nil
+	}
+	if err := g.take(g.Stock[0], match); err != nil {
+		return err
+	}
+	g.Stock = g.Stock[1:]
+
+	if Points(Yakus(g.Captured[g.Turn])) > g.scored[g.Turn] {
+		g.Phase = Deciding
+		return nil
+	}
+	g.end()
+	return nil
+}
+
+// Stop ends the round with the current player as the winner. The points of the yaku are doubled when they reach 7,
+// and doubled again when the other player had called koi-koi.
+func (g *Game) Stop() error {
+	if g.Phase != Deciding {
+		return ErrPhase
+	}
+
+	g.Winner = g.Turn
+	g.Score = Points(Yakus(g.Captured[g.Turn]))
+	if g.Score >= 7 {
+		g.Score *= 2
+	}
+	if g.Calls[1-g.Turn] > 0 {
+		g.Score *= 2
+	}
+	g.Phase = Done
+	return nil
+}
+
+// KoiKoi keeps the round going in the hope of making more yaku, at the risk of the other player stopping first.
+func (g *Game) KoiKoi() error {
+	if g.Phase != Deciding {
+		return ErrPhase
+	}
+
+	g.scored[g.Turn] = Points(Yakus(g.Captured[g.Turn]))
+	g.Calls[g.Turn]++
+	g.end()
+	return nil
+}
+
+// end passes the turn, ending the round without a winner when both hands are empty.
+func (g *Game) end() {
+	g.Turn = 1 - g.Turn
+	g.Phase = Playing
+	if len(g.Hands[g.Turn]) == 0 {
+		g.Phase = Done
+	}
+}
+
+// take moves card and the field cards matching it to the captured cards of the current player.
+func (g *Game) take(card, match Card) error {
+	matches := g.Matches(card)
+	switch len(matches) {
+	case 0:
+		if match != (Card{}) {
+			return ErrMatch
+		}
+		g.Field = append(g.Field, card)
+		return nil
+	case 2:
+		if match.Month != card.Month || index(matches, match) < 0 {
+			return ErrMatch
+		}
+		matches = []Card{match}
+	}
+
+	g.Captured[g.Turn] = append(g.Captured[g.Turn], card)
+	for _, m := range matches {
+		i := index(g.Field, m)
+		g.Field = append(g.Field[:i], g.Field[i+1:]...)
+		g.Captured[g.Turn] = append(g.Captured[g.Turn], m)
+	}
+	return nil
+}
+
+func index(cards []Card, c Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,65 @@
+package hanafuda
+
+import (
+	"testing"
+)
+
+func TestKoiKoi(t *testing.T) {
+	g, err := NewKoiKoi(New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unshuffled, player 0 holds the Pine and Plum cards and the field holds Wisteria and Iris
+	if err := g.Play(Crane, Card{Pine, 1}); err != ErrMatch {
+		t.Error("Expected", ErrMatch, "received:", err)
+	}
+	if err := g.Play(Crane, Card{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(Card{Pine, 1}, Card{}); err != ErrPhase {
+		t.Error("Expected", ErrPhase, "received:", err)
+	}
+	if err := g.Draw(Card{}); err != nil {
+		t.Fatal(err)
+	}
+	if g.Turn != 1 || len(g.Field) != 10 {
+		t.Error("Expected both cards on the field and the turn to pass.")
+	}
+
+	// Player 1 holds the Cherry cards and draws the Bush Clover ribbon, matching the Boar drawn before
+	if err := g.Play(Card{Cherry, 2}, Card{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Draw(Card{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Captured[1]) != 2 || index(g.Captured[1], Boar) < 0 || len(g.Field) != 10 {
+		t.Error("Expected the Boar to be captured, received:", g.Captured[1])
+	}
+}
+
+func TestStop(t *testing.T) {
+	g := &Game{
+		Hands:    [2][]Card{{Moon}, {{Plum, 3}}},
+		Field:    []Card{{Pampas, 2}},
+		Stock:    []Card{{Iris, 3}},
+		Captured: [2][]Card{{Crane, Curtain}, nil},
+		Calls:    [2]int{0, 1},
+		Winner:   -1,
+	}
+	if err := g.Play(Moon, Card{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Draw(Card{}); err != nil {
+		t.Fatal(err)
+	}
+	if g.Phase != Deciding {
+		t.Fatal("Expected the player to decide after making Sanko.")
+	}
+	if err := g.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if g.Winner != 0 || g.Score != 10 {
+		t.Error("Expected Sanko to be doubled after the other player's koi-koi, received:", g.Score)
+	}
+}
@@ -0,0 +1,106 @@
+package hanafuda
+
+// Yaku is a scoring combination of captured cards
+type Yaku struct {
+	Name   string
+	Points int
+}
+
+// Yakus returns every yaku made by the captured cards, with the values usually played in Koi-Koi.
+// The Sake Cup counts both as an animal and as a chaff.
+func Yakus(captured []Card) []Yaku {
+	var (
+		yakus                     []Yaku
+		brights, animals, ribbons int
+		chaff, poetry, blue       int
+		rain, curtain, moon, sake bool
+		boar, deer, butterflies   bool
+	)
+	for _, c := range captured {
+		switch c.Category() {
+		case Bright:
+			brights++
+		case Animal:
+			animals++
+		case Ribbon:
+			ribbons++
+		case Chaff:
+			chaff++
+		}
+		switch {
+		case c.Poetry():
+			poetry++
+		case c.Blue():
+			blue++
+		}
+		switch c {
+		case RainMan:
+			rain = true
+		case Curtain:
+			curtain = true
+		case Moon:
+			moon = true
+		case SakeCup:
+			sake = true
+			chaff++
+		case Boar:
+			boar = true
+		case Deer:
+			deer = true
+		case Butterflies:
+			butterflies = true
+		}
+	}
+
+	switch {
+	case brights == 5:
+		yakus = append(yakus, Yaku{"Goko", 10})
+	case brights == 4 && !rain:
+		yakus = append(yakus, Yaku{"Shiko", 8})
+	case brights == 4:
+		yakus = append(yakus, Yaku{"Ame-shiko", 7})
+	case brights == 3 && !rain:
+		yakus = append(yakus, Yaku{"Sanko", 5})
+	}
+
+	if boar && deer && butterflies {
+		yakus = append(yakus, Yaku{"Inoshikacho", 5 + animals - 3})
+	}
+	if animals >= 5 {
+		yakus = append(yakus, Yaku{"Tane", 1 + animals - 5})
+	}
+
+	switch {
+	case poetry == 3 && blue == 3:
+		yakus = append(yakus, Yaku{"Akatan Aotan", 10 + ribbons - 6})
+	case poetry == 3:
+		yakus = append(yakus, Yaku{"Akatan", 5 + ribbons - 3})
+	case blue == 3:
+		yakus = append(yakus, Yaku{"Aotan", 5 + ribbons - 3})
+	}
+	if ribbons >= 5 {
+		yakus = append(yakus, Yaku{"Tanzaku", 1 + ribbons - 5})
+	}
+
+	if chaff >= 10 {
+		yakus = append(yakus, Yaku{"Kasu", 1 + chaff - 10})
+	}
+
+	if sake && curtain {
+		yakus = append(yakus, Yaku{"Hanami-zake", 5})
+	}
+	if sake && moon {
+		yakus = append(yakus, Yaku{"Tsukimi-zake", 5})
+	}
+
+	return yakus
+}
+
+// Points returns the sum of the points of yakus.
+func Points(yakus []Yaku) int {
+	total := 0
+	for _, y := range yakus {
+		total += y.Points
+	}
+	return total
+}
@@ -0,0 +1,41 @@
+package hanafuda
+
+import (
+	"testing"
+)
+
+func TestYakus(t *testing.T) {
+	cases := []struct {
+		captured []Card
+		exp      []Yaku
+	}{
+		{[]Card{Crane, Curtain, Moon}, []Yaku{{"Sanko", 5}}},
+		{[]Card{Crane, Curtain, RainMan}, nil},
+		{[]Card{Crane, Curtain, Moon, RainMan}, []Yaku{{"Ame-shiko", 7}}},
+		{[]Card{Boar, Deer, Butterflies, {Plum, 0}}, []Yaku{{"Inoshikacho", 6}}},
+		{[]Card{{Pine, 1}, {Plum, 1}, {Cherry, 1}, {Iris, 1}}, []Yaku{{"Akatan", 6}}},
+		{[]Card{SakeCup, Curtain, Moon}, []Yaku{{"Hanami-zake", 5}, {"Tsukimi-zake", 5}}},
+	}
+	for _, tc := range cases {
+		yakus := Yakus(tc.captured)
+		if len(yakus) != len(tc.exp) {
+			t.Error("Expected", tc.exp, "for", tc.captured, "received:", yakus)
+			continue
+		}
+		for i := range yakus {
+			if yakus[i] != tc.exp[i] {
+				t.Error("Expected", tc.exp, "for", tc.captured, "received:", yakus)
+			}
+		}
+	}
+}
+
+func TestKasu(t *testing.T) {
+	var captured []Card
+	for m := Pine; m <= Paulownia; m++ {
+		captured = append(captured, Card{m, 3})
+	}
+	if p := Points(Yakus(captured)); p != 3 {
+		t.Error("Expected twelve chaff to be worth 3, received:", p)
+	}
+}
@@ -0,0 +1,303 @@
+// Package hud aggregates the statistics shown by poker trackers, such as VPIP and PFR, from the histories of played
+// hands.
+//
+// Hands are recorded as Hand values, or read from text histories, one line per event:
+//
+//	hand 42 stakes 1/2
+//	seat BTN alice 200
+//	seat SB bob 200
+//	seat BB carol 200
+//	bob posts 1
+//	carol posts 2
+//	alice raises 6
+//	bob folds
+//	carol calls 4
+//	flop AS 7D 2C
+//	carol checks
+//	alice bets 8
+//	carol calls 8
+//	turn 9H
+//	carol checks
+//	alice checks
+//	river 3S
+//	carol checks
+//	alice checks
+//	shows carol QS QD
+//	shows alice AS KD
+//	wins alice 29
+//
+// Hands are separated by blank lines, and lines starting with # are comments.
+package hud
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// ErrHistory is returned when a text history can't be parsed
+var ErrHistory = errors.New("hud: malformed hand history")
+
+// Street is a betting round
+type Street uint8
+
+// The streets of Hold'em
+const (
+	Preflop Street = iota
+	Flop
+	Turn
+	River
+)
+
+var streetNames = [...]string{"preflop", "flop", "turn", "river"}
+
+func (s Street) String() string {
+	return streetNames[s]
+}
+
+// Kind is the kind of an action
+type Kind uint8
+
+// The actions of a player
+const (
+	Post Kind = iota
+	Fold
+	Check
+	Call
+	Bet
+	Raise
+)
+
+var kindNames = [...]string{"posts", "folds", "checks", "calls", "bets", "raises"}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// Action is an action of a player. Amount is the number of chips put in the pot by it.
+type Action struct {
+	Player string
+	Street Street
+	Kind   Kind
+	Amount int
+}
+
+// Seat is a player dealt in a hand, with their position, such as "BTN" or "BB", and the cards they showed, if any.
+type Seat struct {
+	Player   string
+	Position string
+	Stack    int
+	Shown    []deck.Card
+}
+
+// Hand is the history of a hand.
+type Hand struct {
+	// ID identifies the hand and Stakes are its blinds, such as "1/2"
+	ID     string
+	Stakes string
+
+	// Seats holds the players dealt in
+	Seats []Seat
+
+	// Board holds the community cards
+	Board []deck.Card
+
+	// Actions holds the actions of the players in order
+	Actions []Action
+
+	// Won holds the chips won by every player who won some
+	Won map[string]int
+}
+
+// Seat returns the seat of a player, or nil if they weren't dealt in.
+func (h *Hand) Seat(player string) *Seat {
+	for i := range h.Seats {
+		if h.Seats[i].Player == player {
+			return &h.Seats[i]
+		}
+	}
+	return nil
+}
+
+// ParseHistory reads the hands of a text history.
+func ParseHistory(r io.Reader) ([]Hand, error) {
+	var (
+		hands  []Hand
+		h      *Hand
+		street Street
+	)
+	in := bufio.NewScanner(r)
+	for n := 1; in.Scan(); n++ {
+		line := strings.TrimSpace(in.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			if line == "" {
+				h = nil
+			}
+			continue
+		}
+		fail := fmt.Errorf("%v: line %d: %q", ErrHistory, n, line)
+
+		f := strings.Fields(line)
+		if f[0] == "hand" {
+			if len(f) != 4 || f[2] != "stakes" {
+				return nil, fail
+			}
+			hands = append(hands, Hand{ID: f[1], Stakes: f[3], Won: map[string]int{}})
+			h, street = &hands[len(hands)-1], Preflop
+			continue
+		}
+		if h == nil {
+			return nil, fail
+		}
+
+		switch f[0] {
+		case "seat":
+			if len(f) != 4 {
+				return nil, fail
+			}
+			stack, err := strconv.Atoi(f[3])
+			if err != nil {
+				return nil, fail
+			}
+			h.Seats = append(h.Seats, Seat{Player: f[2], Position: f[1], Stack: stack})
+		case "flop", "turn", "river":
+			cards, err := deck.ParseCards(strings.Join(f[1:], " "))
+			want := 1
+			if f[0] == "flop" {
+				want = 3
+			}
+			if err != nil || len(cards) != want || street == River || streetNames[street+1] != f[0] {
+				return nil, fail
+			}
+			street++
+			h.Board = append(h.Board, cards...)
+		case "shows":
+			if len(f) != 4 {
+				return nil, fail
+			}
+			cards, err := deck.ParseCards(strings.Join(f[2:], " "))
+			s := h.Seat(f[1])
+			if err != nil || s == nil || len(cards) != 2 {
+				return nil, fail
+			}
+			s.Shown = cards
+		case "wins":
+			if len(f) != 3 || h.Seat(f[1]) == nil {
+				return nil, fail
+			}
+			amount, err := strconv.Atoi(f[2])
+			if err != nil {
+				return nil, fail
+			}
+			h.Won[f[1]] += amount
+		default:
+			a, ok := parseAction(f)
+			if !ok || h.Seat(a.Player) == nil {
+				return nil, fail
+			}
+			a.Street = street
+			h.Actions = append(h.Actions, a)
+		}
+	}
+	return hands, in.Err()
+}
+
+// parseAction reads an action such as "alice raises 6" or "bob folds".
+func parseAction(f []string) (Action, bool) {
+	if len(f) < 2 {
+		return Action{}, false
+	}
+	for k, name := range kindNames {
+		if f[1] != name {
+			continue
+		}
+		a := Action{Player: f[0], Kind: Kind(k)}
+		switch a.Kind {
+		case Fold, Check:
+			return a, len(f) == 2
+		}
+		if len(f) != 3 {
+			return Action{}, false
+		}
+		n, err := strconv.Atoi(f[2])
+		a.Amount = n
+		return a, err == nil && n > 0
+	}
+	return Action{}, false
+}
+
+// WriteHistory writes hands as a text history, as read by ParseHistory. It fails with ErrHistory, writing nothing,
+// when a hand has actions on a street its board doesn't reach.
+func WriteHistory(w io.Writer, hands []Hand) error {
+	for _, h := range hands {
+		for _, a := range h.Actions {
+			if dealt(a.Street) > len(h.Board) {
+				return fmt.Errorf("%v: hand %s: %s action with %d board cards", ErrHistory, h.ID, a.Street, len(h.Board))
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for i, h := range hands {
+		if i > 0 {
+			fmt.Fprintln(bw)
+		}
+		fmt.Fprintf(bw, "hand %s stakes %s\n", h.ID, h.Stakes)
+		for _, s := range h.Seats {
+			fmt.Fprintf(bw, "seat %s %s %d\n", s.Position, s.Player, s.Stack)
+		}
+		street := Preflop
+		for _, a := range h.Actions {
+			for street < a.Street {
+				street++
+				writeStreet(bw, h.Board, street)
+			}
+			fmt.Fprintf(bw, "%s %s", a.Player, a.Kind)
+			if a.Amount > 0 {
+				fmt.Fprintf(bw, " %d", a.Amount)
+			}
+			fmt.Fprintln(bw)
+		}
+		for street < River && dealt(street+1) <= len(h.Board) {
+			street++
+			writeStreet(bw, h.Board, street)
+		}
+		for _, s := range h.Seats {
+			if len(s.Shown) > 0 {
+				fmt.Fprintf(bw, "shows %s %s\n", s.Player, codes(s.Shown))
+			}
+		}
+		for _, s := range h.Seats {
+			if n, ok := h.Won[s.Player]; ok {
+				fmt.Fprintf(bw, "wins %s %d\n", s.Player, n)
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// writeStreet writes the cards of the board dealt on a street.
+func writeStreet(w io.Writer, board []deck.Card, s Street) {
+	fmt.Fprintf(w, "%s %s\n", s, codes(board[dealt(s-1):dealt(s)]))
+}
+
+// dealt returns the number of cards on the board once a street is dealt.
+func dealt(s Street) int {
+	if s == Preflop {
+		return 0
+	}
+	return int(s) + 2
+}
+
+func codes(cards []deck.Card) string {
+	var s []string
+	for _, c := range cards {
+		s = append(s, c.Code())
+	}
+	return strings.Join(s, " ")
+}
@@ -0,0 +1,133 @@
+package hud
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const history = `# two hands at 1/2
+hand 42 stakes 1/2
+seat BTN alice 200
+seat SB bob 200
+seat BB carol 200
+bob posts 1
+carol posts 2
+alice raises 6
+bob folds
+carol calls 4
+flop AS 7D 2C
+carol checks
+alice bets 8
+carol calls 8
+turn 9H
+carol checks
+alice checks
+river 3S
+carol checks
+alice checks
+shows alice AS KD
+shows carol QS QD
+wins alice 29
+
+hand 43 stakes 1/2
+seat BTN bob 201
+seat SB carol 186
+seat BB alice 213
+carol posts 1
+alice posts 2
+bob raises 6
+carol raises 18
+alice folds
+bob calls 12
+flop KH 8S 4D
+carol bets 20
+bob folds
+wins carol 58
+
+hand 44 stakes 5/10
+seat BTN carol 1000
+seat BB alice 1000
+carol posts 5
+alice posts 10
+carol raises 25
+alice folds
+wins carol 20
+`
+
+func parse(t *testing.T, s string) []Hand {
+	hands, err := ParseHistory(strings.NewReader(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hands
+}
+
+func TestParseHistory(t *testing.T) {
+	hands := parse(t, history)
+	if len(hands) != 3 {
+		t.Fatal("Expected 3 hands, received:", len(hands))
+	}
+	h := hands[0]
+	if h.ID != "42" || h.Stakes != "1/2" || len(h.Seats) != 3 || len(h.Board) != 5 || len(h.Actions) != 12 {
+		t.Error("Expected the first hand, received:", h)
+	}
+	if a := h.Actions[6]; a.Player != "alice" || a.Street != Flop || a.Kind != Bet || a.Amount != 8 {
+		t.Error("Expected alice betting 8 on the flop, received:", a)
+	}
+	if s := h.Seat("carol"); s == nil || len(s.Shown) != 2 || h.Won["alice"] != 29 || h.Seat("dave") != nil {
+		t.Error("Expected the showdown, received:", h.Seats, h.Won)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteHistory(&buf, hands); err != nil {
+		t.Fatal(err)
+	}
+	expected := history[strings.Index(history, "\n")+1:]
+	if buf.String() != expected {
+		t.Error("Expected", expected, "received:", buf.String())
+	}
+
+	for _, bad := range []string{
+		"seat BTN alice 200",
+		"hand 1 stakes 1/2\nseat BTN alice lots",
+		"hand 1 stakes 1/2\nseat BTN alice 200\nbob folds",
+		"hand 1 stakes 1/2\nseat BTN alice 200\nalice sings",
+		"hand 1 stakes 1/2\nseat BTN alice 200\nalice raises",
+		"hand 1 stakes 1/2\nturn AS",
+		"hand 1 stakes 1/2\nflop AS KS",
+		"hand 1 stakes 1/2\nseat BTN alice 200\nshows",
+		"hand 1 stakes 1/2\nseat BTN alice 200\nshows alice AS",
+	} {
+		if _, err := ParseHistory(strings.NewReader(bad)); err == nil || !strings.HasPrefix(err.Error(), ErrHistory.Error()) {
+			t.Error("Expected", ErrHistory, "for", bad, "received:", err)
+		}
+	}
+}
+
+func TestWriteHistory(t *testing.T) {
+	h := parse(t, history)[0]
+	h.Board = h.Board[:4]
+
+	// The river actions have no river card to follow
+	var buf bytes.Buffer
+	if err := WriteHistory(&buf, []Hand{h}); err == nil || !strings.HasPrefix(err.Error(), ErrHistory.Error()) || buf.Len() > 0 {
+		t.Error("Expected", ErrHistory, "and nothing written, received:", err, buf.String())
+	}
+
+	// Without them, the hand goes through with the turn dealt
+	var actions []Action
+	for _, a := range h.Actions {
+		if a.Street < River {
+			actions = append(actions, a)
+		}
+	}
+	h.Actions = actions
+	if err := WriteHistory(&buf, []Hand{h}); err != nil {
+		t.Fatal(err)
+	}
+	back, err := ParseHistory(&buf)
+	if err != nil || len(back) != 1 || len(back[0].Board) != 4 || len(back[0].Actions) != len(actions) {
+		t.Error("Expected the hand back, received:", back, err)
+	}
+}
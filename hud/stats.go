@@ -0,0 +1,294 @@
+package hud
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Stats holds the counts behind the statistics of a player. The statistics are ratios of them, and can be combined
+// by adding the counts.
+type Stats struct {
+	// Hands is the number of hands dealt
+	Hands int
+
+	// Voluntary is the number of hands where the player put chips in the pot preflop without being forced to,
+	// and Raised the number of hands where they raised preflop
+	Voluntary, Raised int
+
+	// ThreeBets is the number of reraises of a single preflop raise, out of ThreeBetChances
+	ThreeBets, ThreeBetChances int
+
+	// Flops is the number of flops seen, Showdowns the number of showdowns reached and ShowdownWins the number of
+	// showdowns won
+	Flops, Showdowns, ShowdownWins int
+
+	// Aggressive is the number of bets and raises after the flop, and Passive the number of calls
+	Aggressive, Passive int
+}
+
+func ratio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+// VPIP returns the share of the hands where the player voluntarily put chips in the pot preflop.
+func (s Stats) VPIP() float64 {
+	return ratio(s.Voluntary, s.Hands)
+}
+
+// PFR returns the share of the hands where the player raised preflop.
+func (s Stats) PFR() float64 {
+	return ratio(s.Raised, s.Hands)
+}
+
+// ThreeBet returns the share of the chances to reraise a single preflop raise taken.
+func (s Stats) ThreeBet() float64 {
+	return ratio(s.ThreeBets, s.ThreeBetChances)
+}
+
+// WTSD returns the share of the flops seen that went to showdown.
+func (s Stats) WTSD() float64 {
+	return ratio(s.Showdowns, s.Flops)
+}
+
+// WSD returns the share of the showdowns won.
+func (s Stats) WSD() float64 {
+	return ratio(s.ShowdownWins, s.Showdowns)
+}
+
+// AF returns the aggression factor: the bets and raises after the flop for every call.
+func (s Stats) AF() float64 {
+	return ratio(s.Aggressive, s.Passive)
+}
+
+// Add adds the counts of t to s.
+func (s *Stats) Add(t Stats) {
+	s.Hands += t.Hands
+	s.Voluntary += t.Voluntary
+	s.Raised += t.Raised
+	s.ThreeBets += t.ThreeBets
+	s.ThreeBetChances += t.ThreeBetChances
+	s.Flops += t.Flops
+	s.Showdowns += t.Showdowns
+	s.ShowdownWins += t.ShowdownWins
+	s.Aggressive += t.Aggressive
+	s.Passive += t.Passive
+}
+
+// Filter selects the hands counted: the ones played at Stakes and, for every player, the ones they played from a
+// position in Positions. Empty fields select everything.
+type Filter struct {
+	Stakes    string
+	Positions []string
+}
+
+func (f Filter) position(p string) bool {
+	if len(f.Positions) == 0 {
+		return true
+	}
+	for _, q := range f.Positions {
+		if q == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Aggregator counts the stats of every player over the hands added to it, one at a time. It's safe for concurrent
+// use.
+type Aggregator struct {
+	// Filter selects the hands counted
+	Filter Filter
+
+	mu    sync.Mutex
+	stats map[string]*Stats
+}
+
+// NewAggregator returns an aggregator counting the hands selected by f.
+func NewAggregator(f Filter) *Aggregator {
+	return &Aggregator{Filter: f, stats: map[string]*Stats{}}
+}
+
+// Add counts a hand, unless the filter leaves it out.
+func (a *Aggregator) Add(h Hand) {
+	if a.Filter.Stakes != "" && h.Stakes != a.Filter.Stakes {
+		return
+	}
+	count := Count(h)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, seat := range h.Seats {
+		if !a.Filter.position(seat.Position) {
+			continue
+		}
+		s := a.stats[seat.Player]
+		if s == nil {
+			s = &Stats{}
+			a.stats[seat.Player] = s
+		}
+		s.Add(count[seat.Player])
+	}
+}
+
+// Players returns the players seen, sorted by name.
+func (a *Aggregator) Players() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var players []string
+	for p := range a.stats {
+		players = append(players, p)
+	}
+	sort.Strings(players)
+	return players
+}
+
+// Stats returns the stats of a player.
+func (a *Aggregator) Stats(player string) Stats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if s := a.stats[player]; s != nil {
+		return *s
+	}
+	return Stats{}
+}
+
+// Count returns the counts of a single hand for every player dealt in.
+func Count(h Hand) map[string]Stats {
+	stats := map[string]Stats{}
+	folded := map[string]bool{}
+	raises := 0
+	for _, seat := range h.Seats {
+		stats[seat.Player] = Stats{Hands: 1}
+	}
+
+	// Preflop, a player counts once for every stat however many times they act
+	voluntary, raised, chance, threeBet := map[string]bool{}, map[string]bool{}, map[string]bool{}, map[string]bool{}
+	for _, a := range h.Actions {
+		if a.Kind == Fold {
+			folded[a.Player] = true
+		}
+		if a.Street != Preflop {
+			s := stats[a.Player]
+			switch a.Kind {
+			case Bet, Raise:
+				s.Aggressive++
+			case Call:
+				s.Passive++
+			}
+			stats[a.Player] = s
+			continue
+		}
+
+		if raises == 1 && a.Kind != Post {
+			chance[a.Player] = true
+		}
+		switch a.Kind {
+		case Call:
+			voluntary[a.Player] = true
+		case Bet, Raise:
+			voluntary[a.Player], raised[a.Player] = true, true
+			if raises == 1 {
+				threeBet[a.Player] = true
+			}
+			raises++
+		}
+	}
+
+	left := 0
+	for _, seat := range h.Seats {
+		if !folded[seat.Player] {
+			left++
+		}
+	}
+	for _, seat := range h.Seats {
+		p := seat.Player
+		s := stats[p]
+		s.Voluntary, s.Raised = b2i(voluntary[p]), b2i(raised[p])
+		s.ThreeBetChances, s.ThreeBets = b2i(chance[p]), b2i(threeBet[p])
+		if len(h.Board) >= 3 && !foldedBefore(h, p, Flop) {
+			s.Flops = 1
+		}
+		if left > 1 && !folded[p] {
+			s.Showdowns = 1
+			s.ShowdownWins = b2i(h.Won[p] > 0)
+		}
+		stats[p] = s
+	}
+	return stats
+}
+
+// foldedBefore reports if a player folded before a street.
+func foldedBefore(h Hand, player string, s Street) bool {
+	for _, a := range h.Actions {
+		if a.Player == player && a.Kind == Fold && a.Street < s {
+			return true
+		}
+	}
+	return false
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Row is the stats of a player, as exported.
+type Row struct {
+	Player   string  `json:"player"`
+	Hands    int     `json:"hands"`
+	VPIP     float64 `json:"vpip"`
+	PFR      float64 `json:"pfr"`
+	ThreeBet float64 `json:"three_bet"`
+	WTSD     float64 `json:"wtsd"`
+	WSD      float64 `json:"wsd"`
+	AF       float64 `json:"af"`
+}
+
+// Rows returns the stats of every player, sorted by name.
+func (a *Aggregator) Rows() []Row {
+	var rows []Row
+	for _, p := range a.Players() {
+		s := a.Stats(p)
+		rows = append(rows, Row{
+			Player: p, Hands: s.Hands, VPIP: s.VPIP(), PFR: s.PFR(), ThreeBet: s.ThreeBet(),
+			WTSD: s.WTSD(), WSD: s.WSD(), AF: s.AF(),
+		})
+	}
+	return rows
+}
+
+// WriteJSON writes the rows of every player as a JSON array.
+func (a *Aggregator) WriteJSON(w io.Writer) error {
+	rows := a.Rows()
+	if rows == nil {
+		rows = []Row{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(rows)
+}
+
+// WriteCSV writes the rows of every player as CSV, after a header line.
+func (a *Aggregator) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"player", "hands", "vpip", "pfr", "three_bet", "wtsd", "wsd", "af"})
+	f := func(x float64) string {
+		return strconv.FormatFloat(x, 'f', 4, 64)
+	}
+	for _, r := range a.Rows() {
+		cw.Write([]string{r.Player, strconv.Itoa(r.Hands), f(r.VPIP), f(r.PFR), f(r.ThreeBet), f(r.WTSD), f(r.WSD), f(r.AF)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
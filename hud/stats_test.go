@@ -0,0 +1,75 @@
+package hud
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	hands := parse(t, history)
+	stats := Count(hands[0])
+	alice, bob, carol := stats["alice"], stats["bob"], stats["carol"]
+	if alice != (Stats{Hands: 1, Voluntary: 1, Raised: 1, Flops: 1, Showdowns: 1, ShowdownWins: 1, Aggressive: 1}) {
+		t.Error("Expected alice to raise, bet and win, received:", alice)
+	}
+	if bob != (Stats{Hands: 1, ThreeBetChances: 1}) {
+		t.Error("Expected bob to fold to the raise, received:", bob)
+	}
+	if carol != (Stats{Hands: 1, Voluntary: 1, ThreeBetChances: 1, Flops: 1, Showdowns: 1, Passive: 1}) {
+		t.Error("Expected carol to call down, received:", carol)
+	}
+
+	stats = Count(hands[1])
+	if s := stats["carol"]; s.ThreeBets != 1 || s.Showdowns != 0 || s.Aggressive != 1 {
+		t.Error("Expected carol to 3-bet, received:", s)
+	}
+	if s := stats["bob"]; s.ThreeBetChances != 0 || s.Flops != 1 || s.Showdowns != 0 {
+		t.Error("Expected bob to open and fold on the flop, received:", s)
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	hands := parse(t, history)
+	a := NewAggregator(Filter{})
+	for _, h := range hands {
+		a.Add(h)
+	}
+	if p := a.Players(); len(p) != 3 || p[0] != "alice" || p[2] != "carol" {
+		t.Error("Expected 3 players, received:", p)
+	}
+	carol := a.Stats("carol")
+	if carol.Hands != 3 || carol.PFR() != 2.0/3 || carol.ThreeBet() != 0.5 || carol.AF() != 1 || carol.WTSD() != 0.5 {
+		t.Error("Expected carol's stats, received:", carol)
+	}
+	if s := a.Stats("dave"); s != (Stats{}) {
+		t.Error("Expected no stats, received:", s)
+	}
+
+	a = NewAggregator(Filter{Stakes: "1/2", Positions: []string{"BTN"}})
+	for _, h := range hands {
+		a.Add(h)
+	}
+	if p := a.Players(); len(p) != 2 || a.Stats("alice").Hands != 1 || a.Stats("bob").VPIP() != 1 {
+		t.Error("Expected the button at 1/2 only, received:", p, a.Stats("alice"), a.Stats("bob"))
+	}
+
+	var buf bytes.Buffer
+	if err := NewAggregator(Filter{}).WriteJSON(&buf); err != nil || buf.String() != "[]\n" {
+		t.Error("Expected an empty array, received:", buf.String(), err)
+	}
+}
+
+func ExampleAggregator_WriteCSV() {
+	hands, _ := ParseHistory(bytes.NewBufferString(history))
+	a := NewAggregator(Filter{Stakes: "1/2"})
+	for _, h := range hands {
+		a.Add(h)
+	}
+	a.WriteCSV(os.Stdout)
+	// Output:
+	// player,hands,vpip,pfr,three_bet,wtsd,wsd,af
+	// alice,2,0.5000,0.5000,0.0000,1.0000,1.0000,0.0000
+	// bob,2,0.5000,0.5000,0.0000,0.0000,0.0000,0.0000
+	// carol,2,1.0000,0.5000,0.5000,0.5000,0.0000,1.0000
+}
@@ -0,0 +1,29 @@
+package lobby
+
+import (
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// Render draws cards side by side as ASCII art, three lines high:
+//
+//	.---. .---.
+//	|A S| |T H|
+//	'---' '---'
+func Render(cards []deck.Card) string {
+	if len(cards) == 0 {
+		return "(no cards)"
+	}
+	var top, mid, bottom []string
+	for _, c := range cards {
+		code := c.Code()
+		if c.Suit == deck.Joker {
+			code = "JK"
+		}
+		top = append(top, ".---.")
+		mid = append(mid, "|"+code[:1]+" "+code[1:2]+"|")
+		bottom = append(bottom, "'---'")
+	}
+	return strings.Join(top, " ") + "\r\n" + strings.Join(mid, " ") + "\r\n" + strings.Join(bottom, " ")
+}
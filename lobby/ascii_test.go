@@ -0,0 +1,18 @@
+package lobby
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestRender(t *testing.T) {
+	cards := []deck.Card{{Rank: deck.Ace, Suit: deck.Spade}, {Rank: deck.Ten, Suit: deck.Heart}, {Suit: deck.Joker}}
+	expected := ".---. .---. .---.\r\n|A S| |T H| |J K|\r\n'---' '---' '---'"
+	if s := Render(cards); s != expected {
+		t.Error("Expected", expected, "received:", s)
+	}
+	if s := Render(nil); s != "(no cards)" {
+		t.Error("Expected (no cards), received:", s)
+	}
+}
@@ -0,0 +1,393 @@
+// Package lobby is a multiplayer server for terminal card games. Players connect with telnet or netcat, pick a name,
+// create or join tables and play the games described by rules specs, with their cards drawn as ASCII art.
+// Every player sees only their own hand; the cards played are shown to the whole table.
+//
+// The server only needs connections from package net, so it can be tested with in-process connections such as the
+// ones of net.Pipe.
+package lobby
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/euller88/deck"
+	"github.com/euller88/deck/rules"
+)
+
+// outbox is the number of messages queued for a client before it's dropped as too slow
+const outbox = 256
+
+const help = "commands: games, tables, create GAME TABLE, join TABLE, leave, hand, play CARD, again, say TEXT, quit"
+
+// Server is the lobby, holding the games that can be played and the tables open.
+type Server struct {
+	mu     sync.Mutex
+	games  map[string]*rules.Spec
+	tables map[string]*table
+	names  map[string]bool
+	r      *rand.Rand
+}
+
+type client struct {
+	name  string
+	out   chan string
+	table *table
+	conn  net.Conn
+}
+
+type table struct {
+	name  string
+	spec  *rules.Spec
+	seats []*client
+	game  *rules.Game
+	again map[*client]bool
+}
+
+// New returns a lobby offering the games described by specs. The games are dealt with a random source seeded by seed.
+func New(seed int64, specs ...*rules.Spec) *Server {
+	s := &Server{
+		games:  map[string]*rules.Spec{},
+		tables: map[string]*table{},
+		names:  map[string]bool{},
+		r:      rand.New(rand.NewSource(seed)),
+	}
+	for _, spec := range specs {
+		s.games[strings.ToLower(spec.Name)] = spec
+	}
+	return s
+}
+
+// Serve accepts connections from l until it fails, handling every one in its own goroutine.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.Handle(conn)
+	}
+}
+
+// Handle talks with a player over conn until they quit or the connection fails, and closes it.
+func (s *Server) Handle(conn net.Conn) {
+	c := &client{out: make(chan string, outbox), conn: conn}
+	done := make(chan struct{})
+	go func() {
+		for msg := range c.out {
+			if _, err := conn.Write([]byte(msg + "\r\n")); err != nil {
+				break
+			}
+		}
+		conn.Close()
+		close(done)
+	}()
+
+	in := bufio.NewScanner(conn)
+	c.send("Welcome to the card room. What's your name?")
+	for in.Scan() {
+		line := strings.TrimSpace(in.Text())
+		if line == "" {
+			continue
+		}
+		s.mu.Lock()
+		quit := s.command(c, line)
+		s.mu.Unlock()
+		if quit {
+			break
+		}
+	}
+
+	s.mu.Lock()
+	s.leave(c)
+	delete(s.names, c.name)
+	close(c.out)
+	s.mu.Unlock()
+	<-done
+}
+
+// send queues a message to the client, dropping the connection of a client that doesn't keep up.
+func (c *client) send(format string, args ...interface{}) {
+	select {
+	case c.out <- fmt.Sprintf(format, args...):
+	default:
+		c.conn.Close()
+	}
+}
+
+// command runs a line typed by a client, and reports if the client quit. The server lock is held.
+func (s *Server) command(c *client, line string) bool {
+	if c.name == "" {
+		name := strings.Fields(line)[0]
+		if s.names[name] {
+			c.send("The name %s is taken, pick another one.", name)
+			return false
+		}
+		c.name, s.names[name] = name, true
+		c.send("Hello, %s. %s", name, help)
+		return false
+	}
+
+	words := strings.Fields(line)
+	args := words[1:]
+	switch strings.ToLower(words[0]) {
+	case "games":
+		var names []string
+		for _, spec := range s.games {
+			names = append(names, fmt.Sprintf("%s (%d players)", spec.Name, spec.Players))
+		}
+		sort.Strings(names)
+		c.send("Games: %s", strings.Join(names, ", "))
+	case "tables":
+		s.listTables(c)
+	case "create":
+		s.create(c, args)
+	case "join":
+		if len(args) != 1 {
+			c.send("Usage: join TABLE")
+			return false
+		}
+		s.join(c, args[0])
+	case "leave":
+		s.leave(c)
+	case "hand":
+		s.showHand(c)
+	case "play":
+		s.play(c, args)
+	case "again":
+		s.rematch(c)
+	case "say":
+		if t := c.table; t != nil {
+			t.broadcast("%s says: %s", c.name, strings.Join(args, " "))
+		} else {
+			c.send("You are not at a table.")
+		}
+	case "help":
+		c.send(help)
+	case "quit":
+		c.send("Bye, %s.", c.name)
+		return true
+	default:
+		c.send("Unknown command. %s", help)
+	}
+	return false
+}
+
+func (s *Server) listTables(c *client) {
+	if len(s.tables) == 0 {
+		c.send("No tables, create one.")
+		return
+	}
+	var lines []string
+	for _, t := range s.tables {
+		state := "waiting"
+		if t.game != nil {
+			state = "playing"
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s, %d/%d players, %s", t.name, t.spec.Name, len(t.seats), t.spec.Players, state))
+	}
+	sort.Strings(lines)
+	c.send("Tables:\r\n%s", strings.Join(lines, "\r\n"))
+}
+
+func (s *Server) create(c *client, args []string) {
+	if len(args) != 2 {
+		c.send("Usage: create GAME TABLE")
+		return
+	}
+	spec, ok := s.games[strings.ToLower(args[0])]
+	if !ok {
+		c.send("No such game, try games.")
+		return
+	}
+	if _, ok := s.tables[args[1]]; ok {
+		c.send("The table %s already exists.", args[1])
+		return
+	}
+	s.tables[args[1]] = &table{name: args[1], spec: spec, again: map[*client]bool{}}
+	s.join(c, args[1])
+}
+
+func (s *Server) join(c *client, name string) {
+	t, ok := s.tables[name]
+	switch {
+	case !ok:
+		c.send("No such table, try tables.")
+		return
+	case c.table == t:
+		c.send("You are already at %s.", name)
+		return
+	case len(t.seats) == t.spec.Players:
+		c.send("The table %s is full.", name)
+		return
+	}
+	s.leave(c)
+	c.table = t
+	t.seats = append(t.seats, c)
+	t.broadcast("%s sits at %s (%d/%d).", c.name, t.name, len(t.seats), t.spec.Players)
+
+	if len(t.seats) == t.spec.Players {
+		s.start(t)
+	}
+}
+
+// leave takes the client away from their table, ending any game being played there.
+func (s *Server) leave(c *client) {
+	t := c.table
+	if t == nil {
+		return
+	}
+	for i, seat := range t.seats {
+		if seat == c {
+			t.seats = append(t.seats[:i], t.seats[i+1:]...)
+			break
+		}
+	}
+	c.table = nil
+	delete(t.again, c)
+	c.send("You left %s.", t.name)
+	if t.game != nil {
+		t.game = nil
+		t.broadcast("%s left, the game is over.", c.name)
+	} else {
+		t.broadcast("%s left the table.", c.name)
+	}
+	if len(t.seats) == 0 {
+		delete(s.tables, t.name)
+	}
+}
+
+// rematch records that the client wants to play again at their table, and deals a new game once every player does.
+func (s *Server) rematch(c *client) {
+	t := c.table
+	switch {
+	case t == nil:
+		c.send("You are not at a table.")
+		return
+	case t.game != nil:
+		c.send("The game is still being played.")
+		return
+	case len(t.seats) < t.spec.Players:
+		c.send("Waiting for %d more players.", t.spec.Players-len(t.seats))
+		return
+	}
+	t.again[c] = true
+	if len(t.again) < len(t.seats) {
+		t.broadcast("%s wants a rematch (%d/%d).", c.name, len(t.again), len(t.seats))
+		return
+	}
+	s.start(t)
+}
+
+func (s *Server) start(t *table) {
+	g, err := rules.New(t.spec, rand.New(rand.NewSource(s.r.Int63())))
+	if err != nil {
+		t.broadcast("The game can't start: %v", err)
+		return
+	}
+	t.game, t.again = g, map[*client]bool{}
+	var names []string
+	for i, c := range t.seats {
+		names = append(names, fmt.Sprintf("%d. %s", i+1, c.name))
+	}
+	t.broadcast("The game of %s starts: %s.", t.spec.Name, strings.Join(names, ", "))
+	if g.Trumps {
+		t.broadcast("Trumps are %ss.", g.Trump)
+	}
+	for _, c := range t.seats {
+		s.showHand(c)
+	}
+	t.prompt()
+}
+
+func (s *Server) showHand(c *client) {
+	t := c.table
+	if t == nil || t.game == nil {
+		c.send("You are not playing.")
+		return
+	}
+	c.send("Your hand:\r\n%s", Render(t.game.Zone("hand", t.seat(c))))
+}
+
+func (s *Server) play(c *client, args []string) {
+	t := c.table
+	if t == nil || t.game == nil {
+		c.send("You are not playing.")
+		return
+	}
+	g := t.game
+	if t.seats[g.Turn] != c {
+		c.send("It's not your turn, %s is playing.", t.seats[g.Turn].name)
+		return
+	}
+	if len(args) != 1 {
+		c.send("Usage: play CARD, such as play QS")
+		return
+	}
+	card, err := deck.ParseCard(args[0])
+	if err != nil {
+		c.send("%s is not a card, write it as QS or TD.", args[0])
+		return
+	}
+	tricks := append([]int(nil), g.Tricks...)
+	if err := g.Play(card); err != nil {
+		c.send("You can't play %s: %v", card.Code(), err)
+		return
+	}
+
+	t.broadcast("%s plays %s.", c.name, card)
+	if len(g.Trick) > 0 {
+		t.broadcast("Trick:\r\n%s", Render(g.Trick))
+	} else {
+		for p := range tricks {
+			if g.Tricks[p] > tricks[p] {
+				t.broadcast("%s takes the trick.", t.seats[p].name)
+			}
+		}
+	}
+
+	if g.Over() {
+		var scores []string
+		for p, score := range g.Scores() {
+			scores = append(scores, fmt.Sprintf("%s %d", t.seats[p].name, score))
+		}
+		var winners []string
+		for _, p := range g.Winners() {
+			winners = append(winners, t.seats[p].name)
+		}
+		t.broadcast("Game over. Scores: %s. Winner: %s.", strings.Join(scores, ", "), strings.Join(winners, " and "))
+		t.broadcast("Type again for a rematch, or leave the table.")
+		t.game = nil
+		return
+	}
+	t.prompt()
+}
+
+// prompt tells the player in turn to play, showing their hand and legal cards.
+func (t *table) prompt() {
+	c := t.seats[t.game.Turn]
+	var legal []string
+	for _, card := range t.game.Legal() {
+		legal = append(legal, card.Code())
+	}
+	c.send("Your turn. Your hand:\r\n%s\r\nYou may play: %s", Render(t.game.Zone("hand", t.game.Turn)), strings.Join(legal, " "))
+}
+
+func (t *table) seat(c *client) int {
+	for i, seat := range t.seats {
+		if seat == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *table) broadcast(format string, args ...interface{}) {
+	for _, c := range t.seats {
+		c.send(format, args...)
+	}
+}
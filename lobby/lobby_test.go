@@ -0,0 +1,179 @@
+package lobby
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/euller88/deck"
+	"github.com/euller88/deck/rules"
+)
+
+// duel is a two player game with the eight aces and kings, two cards each
+const duel = `{
+	"name": "Duel",
+	"players": 2,
+	"deck": {"exclude": ["Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine", "Ten", "Jack", "Queen"]},
+	"deal": [{"from": "stock", "to": "hand", "count": 2}],
+	"play": {"conditions": ["follow_suit"]},
+	"scoring": {"per_trick": 1}
+}`
+
+// player is a fake connection to the lobby, collecting every line it receives
+type player struct {
+	conn  net.Conn
+	lines chan string
+	seen  []string
+}
+
+func connect(t *testing.T, s *Server, name string) *player {
+	server, conn := net.Pipe()
+	go s.Handle(server)
+
+	p := &player{conn: conn, lines: make(chan string, 1024)}
+	go func() {
+		in := bufio.NewScanner(conn)
+		for in.Scan() {
+			p.lines <- strings.TrimRight(in.Text(), "\r")
+		}
+		close(p.lines)
+	}()
+	p.expect(t, "What's your name?")
+	p.say(name)
+	p.expect(t, "Hello, "+name)
+	return p
+}
+
+func (p *player) say(line string) {
+	p.conn.Write([]byte(line + "\r\n"))
+}
+
+// expect reads lines until one contains text, and returns it.
+func (p *player) expect(t *testing.T, text string) string {
+	t.Helper()
+	for {
+		select {
+		case line, ok := <-p.lines:
+			if !ok {
+				t.Fatal("Expected", text, "received: closed connection")
+			}
+			p.seen = append(p.seen, line)
+			if strings.Contains(line, text) {
+				return line
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected", text, "received:", p.seen)
+		}
+	}
+}
+
+// hand reads the cards rendered after the next "Your hand:" line.
+func (p *player) hand(t *testing.T) []deck.Card {
+	t.Helper()
+	p.expect(t, "Your hand:")
+	p.expect(t, ".---.")
+	line := p.expect(t, "|")
+	var cards []deck.Card
+	for _, box := range strings.Split(strings.Trim(line, "|"), "| |") {
+		c, err := deck.ParseCard(strings.Replace(box, " ", "", 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cards = append(cards, c)
+	}
+	return cards
+}
+
+func TestLobby(t *testing.T) {
+	spec, err := rules.Parse(strings.NewReader(duel))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New(1, spec)
+	alice := connect(t, s, "alice")
+	bob := connect(t, s, "bob")
+
+	alice.say("create nope t1")
+	alice.expect(t, "No such game")
+	alice.say("create duel t1")
+	alice.expect(t, "alice sits at t1 (1/2)")
+	bob.say("tables")
+	bob.expect(t, "t1: Duel, 1/2 players, waiting")
+
+	bob.say("join t1")
+	for _, p := range []*player{alice, bob} {
+		p.expect(t, "The game of Duel starts: 1. alice, 2. bob.")
+	}
+
+	// Every player sees only their own cards
+	mine, theirs := alice.hand(t), bob.hand(t)
+	if len(mine) != 2 || len(theirs) != 2 {
+		t.Fatal("Expected 2 cards each, received:", mine, theirs)
+	}
+	for _, c := range mine {
+		for _, o := range theirs {
+			if c == o {
+				t.Error("Expected different hands, received:", mine, theirs)
+			}
+		}
+	}
+
+	bob.say("play " + theirs[0].Code())
+	bob.expect(t, "It's not your turn, alice is playing.")
+
+	names := map[*player]string{alice: "alice", bob: "bob"}
+	turn := alice
+	for i := 0; i < 4; i++ {
+		line := turn.expect(t, "You may play: ")
+		legal := strings.Fields(strings.TrimPrefix(line, "You may play: "))
+		turn.say("play " + legal[0])
+
+		card, _ := deck.ParseCard(legal[0])
+		if line := alice.expect(t, " plays "); line != names[turn]+" plays "+card.String()+"." {
+			t.Error("Expected a play by", names[turn], "received:", line)
+		}
+		if i%2 == 0 {
+			alice.expect(t, "Trick:")
+			if turn == alice {
+				turn = bob
+			} else {
+				turn = alice
+			}
+			continue
+		}
+		line = alice.expect(t, " takes the trick.")
+		if strings.HasPrefix(line, "bob") {
+			turn = bob
+		} else {
+			turn = alice
+		}
+	}
+
+	for _, p := range []*player{alice, bob} {
+		if line := p.expect(t, "Game over"); !strings.Contains(line, "Winner:") {
+			t.Error("Expected the winners, received:", line)
+		}
+	}
+	bob.say("join t1")
+	bob.expect(t, "You are already at t1.")
+
+	// Both players must agree to a rematch
+	alice.say("again")
+	bob.expect(t, "alice wants a rematch (1/2).")
+	bob.say("again")
+	for _, p := range []*player{alice, bob} {
+		p.expect(t, "The game of Duel starts: 1. alice, 2. bob.")
+	}
+
+	alice.say("quit")
+	alice.expect(t, "Bye, alice.")
+	bob.expect(t, "alice left, the game is over.")
+	bob.say("tables")
+	bob.expect(t, "t1: Duel, 1/2 players, waiting")
+
+	carol := connect(t, s, "carol")
+	carol.say("join t1")
+	bob.expect(t, "The game of Duel starts: 1. bob, 2. carol.")
+}
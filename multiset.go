@@ -0,0 +1,234 @@
+package deck
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// The number of ranks a Rank can hold, and how many of them fall outside of Ace to King
+const (
+	ranks = 1 << 8
+	extra = ranks - int(maxRank-minRank+1)
+)
+
+// Index returns the position of a card in a count vector: the standard cards first, sorted by suit then by rank,
+// after them the jokers, by rank, and last the cards of the standard suits with ranks outside of Ace to King, such
+// as the Elevens of Five Hundred, sorted by suit then by rank.
+func (c Card) Index() int {
+	switch {
+	case c.Suit == Joker:
+		return len(canonical) + int(c.Rank)
+	case c.Rank < minRank:
+		return len(canonical) + ranks + int(c.Suit)*extra
+	case c.Rank > maxRank:
+		return len(canonical) + ranks + int(c.Suit)*extra + int(c.Rank-maxRank)
+	}
+	return int(c.Suit)*int(maxRank) + int(c.Rank-minRank)
+}
+
+// CardAt returns the card found at position i of a count vector, the inverse of Index.
+func CardAt(i int) Card {
+	switch {
+	case i >= len(canonical)+ranks:
+		i -= len(canonical) + ranks
+		if r := i % extra; r > 0 {
+			return Card{Suit: suits[i/extra], Rank: maxRank + Rank(r)}
+		}
+		return Card{Suit: suits[i/extra]}
+	case i >= len(canonical):
+		return Card{Suit: Joker, Rank: Rank(i - len(canonical))}
+	}
+	return Card{Suit: suits[i/int(maxRank)], Rank: minRank + Rank(i%int(maxRank))}
+}
+
+// Multiset is a deck where only the number of copies of every card matters, not their order.
+// It fits the analysis of large shoes, where most questions are about the composition of what is left.
+type Multiset struct {
+	counts []int
+	total  int
+}
+
+// NewMultiset counts the cards of an ordered deck, such as the one returned by New(Deck(6)).
+func NewMultiset(cards []Card) *Multiset {
+	m := &Multiset{counts: make([]int, len(canonical))}
+	for _, c := range cards {
+		m.Add(c, 1)
+	}
+	return m
+}
+
+// Cards returns the cards of the multiset as an ordered deck, sorted by Index.
+func (m *Multiset) Cards() []Card {
+	cards := make([]Card, 0, m.total)
+	for i, n := range m.counts {
+		for ; n > 0; n-- {
+			cards = append(cards, CardAt(i))
+		}
+	}
+	return cards
+}
+
+// Clone returns a copy of the multiset.
+func (m *Multiset) Clone() *Multiset {
+	return &Multiset{counts: append([]int(nil), m.counts...), total: m.total}
+}
+
+// Len returns the number of cards in the multiset.
+func (m *Multiset) Len() int {
+	return m.total
+}
+
+// Count returns the number of copies of a card.
+func (m *Multiset) Count(c Card) int {
+	if i := c.Index(); i < len(m.counts) {
+		return m.counts[i]
+	}
+	return 0
+}
+
+// CountFunc returns the number of cards for which f is true.
+func (m *Multiset) CountFunc(f func(Card) bool) int {
+	total := 0
+	for i, n := range m.counts {
+		if n > 0 && f(CardAt(i)) {
+			total += n
+		}
+	}
+	return total
+}
+
+// Add puts n copies of a card in the multiset.
+func (m *Multiset) Add(c Card, n int) {
+	i := c.Index()
+	for len(m.counts) <= i {
+		m.counts = append(m.counts, 0)
+	}
+	m.counts[i] += n
+	m.total += n
+}
+
+// Remove takes a copy of a card out of the multiset, and reports if there was one.
+func (m *Multiset) Remove(c Card) bool {
+	if m.Count(c) == 0 {
+		return false
+	}
+	m.counts[c.Index()]--
+	m.total--
+	return true
+}
+
+// Draw takes a random card out of the multiset, every card left being equally likely.
+// It returns false when the multiset is empty.
+func (m *Multiset) Draw(r *rand.Rand) (Card, bool) {
+	if m.total == 0 {
+		return Card{}, false
+	}
+	k := r.Intn(m.total)
+	for i, n := range m.counts {
+		if k < n {
+			m.counts[i]--
+			m.total--
+			return CardAt(i), true
+		}
+		k -= n
+	}
+	panic("deck: multiset count out of sync")
+}
+
+// Prob returns the probability of the next card drawn being c.
+func (m *Multiset) Prob(c Card) float64 {
+	if m.total == 0 {
+		return 0
+	}
+	return float64(m.Count(c)) / float64(m.total)
+}
+
+// ProbFunc returns the probability of f being true for the next card drawn, such as the probability of a ten-valued card.
+func (m *Multiset) ProbFunc(f func(Card) bool) float64 {
+	if m.total == 0 {
+		return 0
+	}
+	return float64(m.CountFunc(f)) / float64(m.total)
+}
+
+// Expect returns the expected value of drawing the next card: the sum, over every card left, of its probability times
+// value(m, c), where m no longer holds c. value may call Expect again to look further ahead, so exact expectations
+// are computed recursively. The multiset is changed during the calls, but restored before Expect returns.
+func (m *Multiset) Expect(value func(m *Multiset, c Card) float64) float64 {
+	if m.total == 0 {
+		return 0
+	}
+	total := float64(m.total)
+	ev := 0.0
+	for i, n := range m.counts {
+		if n == 0 {
+			continue
+		}
+		c := CardAt(i)
+		m.counts[i]--
+		m.total--
+		ev += float64(n) / total * value(m, c)
+		m.counts[i]++
+		m.total++
+	}
+	return ev
+}
+
+// ExpectRank works as Expect, but visits the cards of a rank only once, as a group, which is much faster when suits
+// don't matter, ranks outside of Ace to King included. The jokers form a group of their own. The card passed to value
+// is one of its group.
+func (m *Multiset) ExpectRank(value func(m *Multiset, c Card) float64) float64 {
+	if m.total == 0 {
+		return 0
+	}
+	var groups [ranks + 1]int
+	var first [ranks + 1]int
+	for i, n := range m.counts {
+		if n == 0 {
+			continue
+		}
+		g := group(i)
+		if groups[g] == 0 {
+			first[g] = i
+		}
+		groups[g] += n
+	}
+
+	total := float64(m.total)
+	ev := 0.0
+	for g, n := range groups {
+		if n == 0 {
+			continue
+		}
+		i := first[g]
+		m.counts[i]--
+		m.total--
+		ev += float64(n) / total * value(m, CardAt(i))
+		m.counts[i]++
+		m.total++
+	}
+	return ev
+}
+
+// group returns 0 for the jokers, and one more than the rank for the other cards at position i.
+func group(i int) int {
+	c := CardAt(i)
+	if c.Suit == Joker {
+		return 0
+	}
+	return int(c.Rank) + 1
+}
+
+// Key returns a string that is the same for multisets with the same cards, to memoize recursive computations.
+func (m *Multiset) Key() string {
+	last := len(m.counts)
+	for last > 0 && m.counts[last-1] == 0 {
+		last--
+	}
+	parts := make([]string, last)
+	for i := range parts {
+		parts[i] = strconv.Itoa(m.counts[i])
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,121 @@
+package deck
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestIndex(t *testing.T) {
+	for i := 0; i < len(canonical)+ranks+len(suits)*extra; i++ {
+		if CardAt(i).Index() != i {
+			t.Error("Expected", i, "received:", CardAt(i).Index())
+		}
+	}
+	if (Card{Suit: Heart, Rank: King}).Index() != 51 || CardAt(0) != (Card{Suit: Spade, Rank: Ace}) {
+		t.Error("Expected the King of Hearts to be the last standard card.")
+	}
+
+	eleven := Card{Suit: Spade, Rank: King + 1}
+	if m := NewMultiset([]Card{eleven}); m.Count(Card{Suit: Diamond, Rank: Ace}) != 0 || m.Cards()[0] != eleven {
+		t.Error("Expected ranks above the King to have their own index, received:", m.Cards())
+	}
+	if c := (Card{Suit: Heart}); CardAt(c.Index()) != c || c.Index() == (Card{Suit: Spade, Rank: Ace}).Index() {
+		t.Error("Expected a rankless card to have its own index, received:", CardAt(c.Index()))
+	}
+}
+
+func TestMultiset(t *testing.T) {
+	m := NewMultiset(New(Jokers(2), Deck(6)))
+	if m.Len() != 54*6 || m.Count(Card{Suit: Club, Rank: Nine}) != 6 || m.Count(Card{Suit: Joker, Rank: 1}) != 6 {
+		t.Fatal("Expected 6 copies of every card, received:", m.Len())
+	}
+
+	tens := func(c Card) bool { return c.Suit != Joker && c.Rank >= Ten }
+	if p := m.ProbFunc(tens); math.Abs(p-96.0/324) > 1e-9 {
+		t.Error("Expected", 96.0/324, "received:", p)
+	}
+	if !m.Remove(Card{Suit: Joker, Rank: 1}) || m.Count(Card{Suit: Joker, Rank: 1}) != 5 {
+		t.Error("Expected a joker to be removed.")
+	}
+	if m.Remove(Card{Suit: Joker, Rank: 7}) {
+		t.Error("Expected no such joker to be removed.")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for m.Len() > 0 {
+		if _, ok := m.Draw(r); !ok {
+			t.Fatal("Expected to draw while there are cards left.")
+		}
+	}
+	if _, ok := m.Draw(r); ok || m.Prob(Card{Suit: Spade, Rank: Ace}) != 0 {
+		t.Error("Expected nothing to draw from an empty multiset.")
+	}
+}
+
+func TestMultisetCards(t *testing.T) {
+	cards := New(Jokers(1), Deck(2))
+	m := NewMultiset(New(Shuffle, Jokers(1), Deck(2), Shuffle))
+	got := m.Cards()
+	SortFunc(func(a, b Card) bool { return a.Index() < b.Index() })(cards)
+	if len(got) != len(cards) {
+		t.Fatal("Expected", len(cards), "cards, received:", len(got))
+	}
+	for i := range got {
+		if got[i] != cards[i] {
+			t.Fatal("Expected the deck sorted by index, received:", got)
+		}
+	}
+	if c := m.Clone(); c.Key() != m.Key() || !c.Remove(got[0]) || c.Key() == m.Key() {
+		t.Error("Expected clones to share keys only while equal.")
+	}
+}
+
+func TestExpect(t *testing.T) {
+	value := func(c Card) int {
+		switch {
+		case c.Rank == Ace:
+			return 11
+		case c.Rank >= Ten:
+			return 10
+		}
+		return int(c.Rank)
+	}
+	natural := func(m *Multiset, first Card) float64 {
+		return m.ExpectRank(func(m *Multiset, second Card) float64 {
+			if value(first)+value(second) == 21 {
+				return 1
+			}
+			return 0
+		})
+	}
+
+	// Two ways to get a blackjack, ace first or ten first
+	exp := 2 * 4.0 / 52 * 16.0 / 51
+	m := NewMultiset(New())
+	if p := m.ExpectRank(natural); math.Abs(p-exp) > 1e-12 {
+		t.Error("Expected", exp, "received:", p)
+	}
+	if p := m.Expect(natural); math.Abs(p-exp) > 1e-12 {
+		t.Error("Expected", exp, "received:", p)
+	}
+	if m.Len() != 52 {
+		t.Error("Expected Expect to restore the multiset, received:", m.Len())
+	}
+
+	// Elevens and Twelves, as in Five Hundred, rankless cards and jokers each count on their own
+	cards := New(Jokers(2))
+	for s := Spade; s < Joker; s++ {
+		cards = append(cards, Card{Suit: s, Rank: King + 1}, Card{Suit: s, Rank: King + 2}, Card{Suit: s})
+	}
+	points := func(m *Multiset, c Card) float64 {
+		if c.Suit == Joker {
+			return 50
+		}
+		return float64(c.Rank)
+	}
+	m = NewMultiset(cards)
+	if a, b := m.ExpectRank(points), m.Expect(points); math.Abs(a-b) > 1e-12 {
+		t.Error("Expected", b, "received:", a)
+	}
+}
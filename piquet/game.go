@@ -0,0 +1,271 @@
+package piquet
+
+import (
+	"errors"
+
+	"github.com/euller88/deck"
+)
+
+// The number of cards in every hand and in the talon
+const (
+	HandSize  = 12
+	TalonSize = 8
+)
+
+// The errors returned when a move breaks the rules of the game
+var (
+	ErrDeckSize  = errors.New("piquet: not enough cards to deal")
+	ErrPhase     = errors.New("piquet: move not allowed in this phase")
+	ErrTurn      = errors.New("piquet: it's not the player's turn")
+	ErrExchange  = errors.New("piquet: wrong number of cards to exchange")
+	ErrNotInHand = errors.New("piquet: card is not in the player's hand")
+	ErrFollow    = errors.New("piquet: player must follow suit")
+)
+
+// Phase is the part of the deal being played
+type Phase uint8
+
+// The phases of a deal of Piquet
+const (
+	// Exchange is the elder, then the younger hand, exchanging cards with the talon
+	Exchange Phase = iota
+
+	// Playing is the play of the twelve tricks, after the declarations were scored
+	Playing
+
+	// Done is the end of the deal
+	Done
+)
+
+// Declarations holds what both players declared, the winner of each declaration scoring it alone.
+type Declarations struct {
+	Point    [2]Point
+	Sequence [2]Sequence
+	Set      [2]Set
+}
+
+// Game holds the state of a deal of Piquet. Player 0 is the elder hand, who leads the first trick.
+type Game struct {
+	// Hands holds the cards of both players
+	Hands [2][]deck.Card
+
+	// Talon holds the cards available to exchange, the elder drawing first
+	Talon []deck.Card
+
+	// Discards holds the cards every player put aside when exchanging
+	Discards [2][]deck.Card
+
+	// Declarations holds the best declarations of both players, known once the exchange is over
+	Declarations Declarations
+
+	// Scores holds the points of both players in the deal
+	Scores [2]int
+
+	// Trick holds the cards played in the current trick and Tricks the number of tricks taken by every player
+	Trick  []deck.Card
+	Tricks [2]int
+
+	// Phase is the current part of the deal
+	Phase Phase
+
+	// Leader is the player who led the current trick and Turn is the player who must play now
+	Leader, Turn int
+
+	declared [2]int
+	piqued   bool
+}
+
+// New deals a deal of Piquet from cards, which should come already shuffled. A hand without courts scores
+// carte blanche at once.
+func New(cards []deck.Card) (*Game, error) {
+	if len(cards) < 2*HandSize+TalonSize {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{}
+	for i := 0; i < HandSize; i++ {
+		for p := range g.Hands {
+			g.Hands[p] = append(g.Hands[p], cards[2*i+p])
+		}
+	}
+	g.Talon = append([]deck.Card(nil), cards[2*HandSize:2*HandSize+TalonSize]...)
+
+	for p := range g.Hands {
+		if CarteBlanche(g.Hands[p]) {
+			g.declare(p, 10)
+		}
+	}
+	return g, nil
+}
+
+// Exchange discards cards from the hand of player and replaces them with cards from the talon.
+// The elder exchanges first, from one up to five cards, and the younger takes up to the rest of the talon.
+func (g *Game) Exchange(player int, discards []deck.Card) error {
+	if g.Phase != Exchange {
+		return ErrPhase
+	}
+	if player != g.Turn {
+		return ErrTurn
+	}
+	limit := len(g.Talon)
+	if player == 0 {
+		limit = 5
+	}
+	if len(discards) > limit || (player == 0 && len(discards) == 0) {
+		return ErrExchange
+	}
+
+	hand := append([]deck.Card(nil), g.Hands[player]...)
+	for _, c := range discards {
+		i := index(hand, c)
+		if i < 0 {
+			return ErrNotInHand
+		}
+		hand = append(hand[:i], hand[i+1:]...)
+	}
+	g.Hands[player] = append(hand, g.Talon[:len(discards)]...)
+	g.Talon = g.Talon[len(discards):]
+	g.Discards[player] = append([]deck.Card(nil), discards...)
+
+	if player == 0 {
+		g.Turn = 1
+		return nil
+	}
+	g.declarations()
+	g.Phase = Playing
+	g.Leader, g.Turn = 0, 0
+	return nil
+}
+
+// declarations scores the point, the sequences and the sets. Only the winner of each declaration scores, and for
+// sequences and sets, the winner scores every other sequence or set held too. A player who scores 30 in declarations
+// while the other scores nothing makes a repique, worth 60 more.
+func (g *Game) declarations() {
+	d := &g.Declarations
+	for p := range g.Hands {
+		d.Point[p] = BestPoint(g.Hands[p])
+		d.Sequence[p] = bestSequence(Sequences(g.Hands[p]))
+		d.Set[p] = bestSet(Sets(g.Hands[p]))
+	}
+
+	if w, ok := winner(d.Point[0].Beats(d.Point[1]), d.Point[1].Beats(d.Point[0])); ok {
+		g.declare(w, d.Point[w].Score())
+	}
+	if w, ok := winner(d.Sequence[0].Beats(d.Sequence[1]), d.Sequence[1].Beats(d.Sequence[0])); ok {
+		for _, s := range Sequences(g.Hands[w]) {
+			g.declare(w, s.Score())
+		}
+	}
+	if w, ok := winner(d.Set[0].Beats(d.Set[1]), d.Set[1].Beats(d.Set[0])); ok {
+		for _, s := range Sets(g.Hands[w]) {
+			g.declare(w, s.Score())
+		}
+	}
+
+	for p := range g.declared {
+		if g.declared[p] >= 30 && g.declared[1-p] == 0 {
+			g.Scores[p] += 60
+			g.piqued = true
+		}
+	}
+}
+
+// Play puts card from the hand of player into the trick. Leading a Ten or higher scores 1, and so does taking
+// a trick led by the other player with a Ten or higher. The last trick scores 1 more, the majority of the tricks 10,
+// and all of them, the capot, 40.
+func (g *Game) Play(player int, card deck.Card) error {
+	if g.Phase != Playing {
+		return ErrPhase
+	}
+	if player != g.Turn {
+		return ErrTurn
+	}
+	i := index(g.Hands[player], card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if len(g.Trick) == 1 && card.Suit != g.Trick[0].Suit && has(g.Hands[player], g.Trick[0].Suit) {
+		return ErrFollow
+	}
+
+	g.Hands[player] = append(g.Hands[player][:i], g.Hands[player][i+1:]...)
+	g.Trick = append(g.Trick, card)
+	if len(g.Trick) == 1 {
+		if Power(card.Rank) >= Power(deck.Ten) {
+			g.score(player, 1)
+		}
+		g.Turn = 1 - player
+		return nil
+	}
+
+	winner := g.Leader
+	if card.Suit == g.Trick[0].Suit && Power(card.Rank) > Power(g.Trick[0].Rank) {
+		winner = player
+		if Power(card.Rank) >= Power(deck.Ten) {
+			g.score(player, 1)
+		}
+	}
+	g.Tricks[winner]++
+	g.Trick = nil
+	g.Leader, g.Turn = winner, winner
+
+	if len(g.Hands[winner]) > 0 {
+		return nil
+	}
+	g.score(winner, 1)
+	switch {
+	case g.Tricks[winner] == HandSize:
+		g.score(winner, 40)
+	case g.Tricks[winner] > g.Tricks[1-winner]:
+		g.score(winner, 10)
+	case g.Tricks[winner] < g.Tricks[1-winner]:
+		g.score(1-winner, 10)
+	}
+	g.Phase = Done
+	return nil
+}
+
+// declare scores points made in declarations.
+func (g *Game) declare(player, points int) {
+	g.declared[player] += points
+	g.Scores[player] += points
+}
+
+// score adds points to player. When the elder reaches 30 before the younger scored anything, it makes a pique,
+// worth 30 more.
+func (g *Game) score(player, points int) {
+	g.Scores[player] += points
+	if !g.piqued && player == 0 && g.Scores[0] >= 30 && g.Scores[1] == 0 {
+		g.Scores[0] += 30
+		g.piqued = true
+	}
+}
+
+func winner(first, second bool) (int, bool) {
+	switch {
+	case first:
+		return 0, true
+	case second:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+func has(cards []deck.Card, s deck.Suit) bool {
+	for _, c := range cards {
+		if c.Suit == s {
+			return true
+		}
+	}
+	return false
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
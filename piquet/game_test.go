@@ -0,0 +1,69 @@
+package piquet
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestGame(t *testing.T) {
+	g, err := New(Deck())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Exchange(1, nil); err != ErrTurn {
+		t.Error("Expected", ErrTurn, "received:", err)
+	}
+	if err := g.Exchange(0, nil); err != ErrExchange {
+		t.Error("Expected", ErrExchange, "received:", err)
+	}
+	if err := g.Exchange(0, g.Hands[0][:5]); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Exchange(1, g.Hands[1][:3]); err != nil {
+		t.Fatal(err)
+	}
+	if g.Phase != Playing || len(g.Talon) != 0 || len(g.Hands[0]) != HandSize || len(g.Hands[1]) != HandSize {
+		t.Fatal("Expected both players to hold twelve cards and the play to start.")
+	}
+
+	for g.Phase == Playing {
+		hand := g.Hands[g.Turn]
+		card := hand[0]
+		if len(g.Trick) == 1 {
+			for _, h := range hand {
+				if h.Suit == g.Trick[0].Suit {
+					card = h
+					break
+				}
+			}
+		}
+		if err := g.Play(g.Turn, card); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if g.Tricks[0]+g.Tricks[1] != HandSize {
+		t.Error("Expected twelve tricks to be played, received:", g.Tricks)
+	}
+}
+
+func TestRepique(t *testing.T) {
+	g := &Game{Hands: [2][]deck.Card{
+		{
+			c(deck.Ace, deck.Heart), c(deck.King, deck.Heart), c(deck.Queen, deck.Heart), c(deck.Jack, deck.Heart),
+			c(deck.Ten, deck.Heart), c(deck.Nine, deck.Heart), c(deck.Ace, deck.Spade), c(deck.Ace, deck.Club),
+			c(deck.Ace, deck.Diamond), c(deck.King, deck.Club), c(deck.King, deck.Spade), c(deck.Seven, deck.Club),
+		},
+		{
+			c(deck.Seven, deck.Heart), c(deck.Eight, deck.Heart), c(deck.Seven, deck.Spade), c(deck.Nine, deck.Spade),
+			c(deck.Jack, deck.Spade), c(deck.Seven, deck.Diamond), c(deck.Nine, deck.Diamond), c(deck.Jack, deck.Diamond),
+			c(deck.Eight, deck.Club), c(deck.Ten, deck.Club), c(deck.Queen, deck.Club), c(deck.Eight, deck.Diamond),
+		},
+	}}
+	g.declarations()
+
+	// A point of 6, a sixième of 16 and a quatorze and a trio of 17, plus 60 for the repique
+	if exp := 6 + 16 + 17 + 60; g.Scores[0] != exp || g.Scores[1] != 0 {
+		t.Errorf("Expected the elder to score %d with a repique, received %v.", exp, g.Scores)
+	}
+}
@@ -0,0 +1,200 @@
+// Package piquet implements Piquet, the classic two player game played with a 32 card deck, with its declarations
+// of point, sequence and sets, the pique, repique and capot.
+package piquet
+
+import (
+	"github.com/euller88/deck"
+)
+
+// Short reports if a card is left out of a piquet deck, that is, every card from Two to Six and the jokers.
+func Short(c deck.Card) bool {
+	return c.Suit == deck.Joker || (c.Rank >= deck.Two && c.Rank <= deck.Six)
+}
+
+// Deck returns the 32 cards piquet deck, built with the given options applied after the short cards were filtered out.
+func Deck(opts ...func([]deck.Card) []deck.Card) []deck.Card {
+	return deck.New(append([]func([]deck.Card) []deck.Card{deck.Filter(Short)}, opts...)...)
+}
+
+// Power returns the strength of a rank in Piquet, where the Ace is the highest card.
+func Power(r deck.Rank) int {
+	if r == deck.Ace {
+		return int(deck.King) + 1
+	}
+	return int(r)
+}
+
+// Pips returns the value of a card when counting the point: 11 for the Ace, 10 for courts and the face value otherwise.
+func Pips(c deck.Card) int {
+	switch {
+	case c.Rank == deck.Ace:
+		return 11
+	case c.Rank >= deck.Ten:
+		return 10
+	default:
+		return int(c.Rank)
+	}
+}
+
+// Point is the declaration of the longest suit in a hand.
+type Point struct {
+	Suit  deck.Suit
+	Cards int
+	Pips  int
+}
+
+// Beats reports if the point is better than o, having more cards, or more pips on equal cards.
+func (p Point) Beats(o Point) bool {
+	return p.Cards > o.Cards || (p.Cards == o.Cards && p.Pips > o.Pips)
+}
+
+// Score returns the points the declaration is worth, one for every card.
+func (p Point) Score() int {
+	return p.Cards
+}
+
+// Sequence is a run of three or more cards of consecutive ranks in the same suit.
+type Sequence struct {
+	Suit   deck.Suit
+	Length int
+	Top    deck.Rank
+}
+
+// Beats reports if the sequence is better than o, being longer, or headed by a higher card on equal length.
+func (s Sequence) Beats(o Sequence) bool {
+	return s.Length > o.Length || (s.Length == o.Length && Power(s.Top) > Power(o.Top))
+}
+
+// Score returns the points the sequence is worth: 3 for a tierce, 4 for a quart and 10 more than the length from
+// the quint up.
+func (s Sequence) Score() int {
+	switch {
+	case s.Length < 3:
+		return 0
+	case s.Length < 5:
+		return s.Length
+	default:
+		return s.Length + 10
+	}
+}
+
+// Set is a trio or a quatorze, three or four cards of the same rank, from the Tens up.
+type Set struct {
+	Rank  deck.Rank
+	Count int
+}
+
+// Beats reports if the set is better than o, a quatorze beating any trio, and higher ranks winning otherwise.
+func (s Set) Beats(o Set) bool {
+	return s.Count > o.Count || (s.Count == o.Count && Power(s.Rank) > Power(o.Rank))
+}
+
+// Score returns the points the set is worth: 14 for a quatorze and 3 for a trio.
+func (s Set) Score() int {
+	switch s.Count {
+	case 4:
+		return 14
+	case 3:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// BestPoint returns the point of a hand.
+func BestPoint(hand []deck.Card) Point {
+	var best Point
+	for _, s := range []deck.Suit{deck.Spade, deck.Diamond, deck.Club, deck.Heart} {
+		p := Point{Suit: s}
+		for _, c := range hand {
+			if c.Suit == s {
+				p.Cards++
+				p.Pips += Pips(c)
+			}
+		}
+		if p.Beats(best) {
+			best = p
+		}
+	}
+	return best
+}
+
+// Sequences returns every sequence of three or more cards in a hand.
+func Sequences(hand []deck.Card) []Sequence {
+	var seqs []Sequence
+	for _, s := range []deck.Suit{deck.Spade, deck.Diamond, deck.Club, deck.Heart} {
+		held := map[int]bool{}
+		for _, c := range hand {
+			if c.Suit == s {
+				held[Power(c.Rank)] = true
+			}
+		}
+
+		length := 0
+		for p := Power(deck.Seven); p <= Power(deck.Ace)+1; p++ {
+			if held[p] {
+				length++
+				continue
+			}
+			if length >= 3 {
+				seqs = append(seqs, Sequence{Suit: s, Length: length, Top: rank(p - 1)})
+			}
+			length = 0
+		}
+	}
+	return seqs
+}
+
+// Sets returns every trio and quatorze in a hand.
+func Sets(hand []deck.Card) []Set {
+	var sets []Set
+	for _, r := range []deck.Rank{deck.Ten, deck.Jack, deck.Queen, deck.King, deck.Ace} {
+		n := 0
+		for _, c := range hand {
+			if c.Rank == r {
+				n++
+			}
+		}
+		if n >= 3 {
+			sets = append(sets, Set{Rank: r, Count: n})
+		}
+	}
+	return sets
+}
+
+// CarteBlanche reports if a hand holds no King, Queen or Jack.
+func CarteBlanche(hand []deck.Card) bool {
+	for _, c := range hand {
+		if c.Rank >= deck.Jack {
+			return false
+		}
+	}
+	return true
+}
+
+func rank(power int) deck.Rank {
+	if power == Power(deck.Ace) {
+		return deck.Ace
+	}
+	return deck.Rank(power)
+}
+
+func bestSequence(seqs []Sequence) Sequence {
+	var best Sequence
+	for _, s := range seqs {
+		if s.Beats(best) {
+			best = s
+		}
+	}
+	return best
+}
+
+func bestSet(sets []Set) Set {
+	var best Set
+	for _, s := range sets {
+		if s.Beats(best) {
+			best = s
+		}
+	}
+	return best
+}
@@ -0,0 +1,46 @@
+package piquet
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+func TestDeck(t *testing.T) {
+	if n := len(Deck()); n != 32 {
+		t.Errorf("Expected %d cards, received %d cards.", 32, n)
+	}
+}
+
+func TestDeclarations(t *testing.T) {
+	hand := []deck.Card{
+		c(deck.Ace, deck.Heart), c(deck.King, deck.Heart), c(deck.Queen, deck.Heart), c(deck.Jack, deck.Heart), c(deck.Ten, deck.Heart),
+		c(deck.Seven, deck.Club), c(deck.Eight, deck.Club), c(deck.Nine, deck.Club),
+		c(deck.Ace, deck.Spade), c(deck.Ace, deck.Club), c(deck.Ace, deck.Diamond), c(deck.King, deck.Club),
+	}
+
+	if p := BestPoint(hand); p.Suit != deck.Heart || p.Cards != 5 || p.Pips != 51 {
+		t.Error("Expected a point of five hearts worth 51, received:", p)
+	}
+
+	seqs := Sequences(hand)
+	if len(seqs) != 2 {
+		t.Fatal("Expected a quint and a tierce, received:", seqs)
+	}
+	if best := bestSequence(seqs); best.Length != 5 || best.Top != deck.Ace || best.Score() != 15 {
+		t.Error("Expected a quint major worth 15, received:", best)
+	}
+
+	sets := Sets(hand)
+	if len(sets) != 1 || sets[0] != (Set{Rank: deck.Ace, Count: 4}) || sets[0].Score() != 14 {
+		t.Error("Expected a quatorze of Aces, received:", sets)
+	}
+
+	if CarteBlanche(hand) {
+		t.Error("Expected a hand with courts not to be a carte blanche.")
+	}
+}
@@ -0,0 +1,36 @@
+package pointtrick
+
+import (
+	"github.com/euller88/deck"
+)
+
+// Bisca switches a game to the portuguese ranking, where the Seven is the second strongest card.
+func Bisca(g *Game) {
+	g.Ranking = Portuguese
+}
+
+// NewBrisca deals a game of Brisca from cards, which should come already shuffled, to 2, 3 or 4 players.
+// Everybody gets three cards, the next one is turned as trump and players never have to follow suit.
+// With 3 players the first Two is left out, so that the cards split evenly.
+func NewBrisca(cards []deck.Card, players int, opts ...func(*Game)) (*Game, error) {
+	if players < 2 || players > 4 {
+		return nil, ErrPlayers
+	}
+	if players == 3 {
+		for i, c := range cards {
+			if c.Rank == deck.Two {
+				cards = append(append([]deck.Card(nil), cards[:i]...), cards[i+1:]...)
+				break
+			}
+		}
+	}
+
+	g, err := newGame(cards, players, 3, Spanish)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, nil
+}
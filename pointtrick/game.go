@@ -0,0 +1,216 @@
+package pointtrick
+
+import (
+	"github.com/euller88/deck"
+)
+
+// Game holds the state of a point-trick game. It is created by NewBrisca, NewSueca or NewTute, which set the rules.
+type Game struct {
+	Ranking
+
+	// Trump is the turned card that sets the trump suit
+	Trump deck.Card
+
+	// Stock holds the cards still to be drawn, the last one being the trump card
+	Stock []deck.Card
+
+	// Hands holds the cards of every player
+	Hands [][]deck.Card
+
+	// Trick holds the cards played in the current trick, in playing order
+	Trick []deck.Card
+
+	// Won holds the cards taken in tricks by every player
+	Won [][]deck.Card
+
+	// Cantes holds the points every player sang in cantes
+	Cantes []int
+
+	// Leader is the player who led the current trick and Turn is the player who must play now
+	Leader, Turn int
+
+	// Last is the player who took the last trick, or -1 before the first one is over
+	Last int
+
+	legal     func(g *Game, player int) []deck.Card
+	lastBonus int
+	cantes    bool
+	sung      map[deck.Suit]bool
+}
+
+// newGame deals hand cards to each player from cards and turns the next one as trump, at the bottom of the stock.
+func newGame(cards []deck.Card, players, hand int, r Ranking) (*Game, error) {
+	if len(cards) < players*hand {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{
+		Ranking: r,
+		Hands:   make([][]deck.Card, players),
+		Won:     make([][]deck.Card, players),
+		Cantes:  make([]int, players),
+		Last:    -1,
+		legal:   free,
+		sung:    map[deck.Suit]bool{},
+	}
+	for i := 0; i < hand; i++ {
+		for p := range g.Hands {
+			g.Hands[p] = append(g.Hands[p], cards[i*players+p])
+		}
+	}
+
+	rest := cards[players*hand:]
+	if len(rest) == 0 {
+		// Every card was dealt, so the last one shows the trump
+		g.Trump = cards[len(cards)-1]
+		return g, nil
+	}
+	g.Trump = rest[0]
+	g.Stock = append(append([]deck.Card(nil), rest[1:]...), rest[0])
+	return g, nil
+}
+
+// Legal returns the cards player may play into the current trick.
+func (g *Game) Legal(player int) []deck.Card {
+	if player != g.Turn || g.Over() {
+		return nil
+	}
+	return g.legal(g, player)
+}
+
+// Play puts card from the hand of player into the trick. When the trick is complete, its winner takes it,
+// everybody draws from the stock, the winner first, and the winner leads the next trick.
+func (g *Game) Play(player int, card deck.Card) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if player != g.Turn {
+		return ErrTurn
+	}
+	i := index(g.Hands[player], card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if index(g.legal(g, player), card) < 0 {
+		return ErrIllegal
+	}
+
+	g.Hands[player] = append(g.Hands[player][:i], g.Hands[player][i+1:]...)
+	g.Trick = append(g.Trick, card)
+	g.Turn = (g.Turn + 1) % len(g.Hands)
+	if len(g.Trick) < len(g.Hands) {
+		return nil
+	}
+
+	winner := (g.Leader + g.Winner(g.Trick, g.Trump.Suit)) % len(g.Hands)
+	g.Won[winner] = append(g.Won[winner], g.Trick...)
+	g.Trick = nil
+	g.Leader, g.Turn, g.Last = winner, winner, winner
+
+	for i := range g.Hands {
+		p := (winner + i) % len(g.Hands)
+		if len(g.Stock) > 0 {
+			g.Hands[p] = append(g.Hands[p], g.Stock[0])
+			g.Stock = g.Stock[1:]
+		}
+	}
+	return nil
+}
+
+// Over reports if every card was played.
+func (g *Game) Over() bool {
+	for _, hand := range g.Hands {
+		if len(hand) > 0 {
+			return false
+		}
+	}
+	return len(g.Trick) == 0
+}
+
+// Score returns the points of every side: a team of partners sitting across each other with four players,
+// or every player on their own otherwise.
+func (g *Game) Score() []int {
+	sides := len(g.Hands)
+	if sides == 4 {
+		sides = 2
+	}
+
+	score := make([]int, sides)
+	for p := range g.Hands {
+		score[p%sides] += g.Points(g.Won[p]) + g.Cantes[p]
+	}
+	if g.Over() && g.Last >= 0 {
+		score[g.Last%sides] += g.lastBonus
+	}
+	return score
+}
+
+// free lets a player play any card in hand.
+func free(g *Game, player int) []deck.Card {
+	return g.Hands[player]
+}
+
+// follow makes a player follow the suit led if possible.
+func follow(g *Game, player int) []deck.Card {
+	hand := g.Hands[player]
+	if len(g.Trick) == 0 {
+		return hand
+	}
+	if same := suited(hand, g.Trick[0].Suit); len(same) > 0 {
+		return same
+	}
+	return hand
+}
+
+// strict makes a player follow suit and beat the trick if possible, or else trump it, overtrumping if possible.
+func strict(g *Game, player int) []deck.Card {
+	hand := g.Hands[player]
+	if len(g.Trick) == 0 {
+		return hand
+	}
+
+	led, trump := g.Trick[0].Suit, g.Trump.Suit
+	best := g.Trick[g.Winner(g.Trick, trump)]
+	options := suited(hand, led)
+	if len(options) == 0 {
+		options = suited(hand, trump)
+	}
+	if len(options) == 0 {
+		return hand
+	}
+
+	var higher []deck.Card
+	for _, c := range options {
+		if g.Beats(c, best, led, trump) {
+			higher = append(higher, c)
+		}
+	}
+	switch {
+	case len(higher) > 0:
+		return higher
+	case options[0].Suit == led:
+		return options
+	default:
+		// A player that can't overtrump may discard anything
+		return hand
+	}
+}
+
+func suited(cards []deck.Card, s deck.Suit) []deck.Card {
+	var ret []deck.Card
+	for _, c := range cards {
+		if c.Suit == s {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,106 @@
+package pointtrick
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestBrisca(t *testing.T) {
+	g, err := NewBrisca(Deck(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unshuffled, the first six cards are the spades from the Ace to the Six, and the Seven is turned
+	if g.Trump != c(deck.Seven, deck.Spade) || g.Stock[len(g.Stock)-1] != g.Trump {
+		t.Fatal("Expected the Seven of Spades to be the trump at the bottom of the stock, received:", g.Trump)
+	}
+	if err := g.Play(1, g.Hands[1][0]); err != ErrTurn {
+		t.Error("Expected", ErrTurn, "received:", err)
+	}
+	if err := g.Play(0, c(deck.Ace, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(1, c(deck.Four, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if g.Last != 0 || g.Turn != 0 || len(g.Hands[0]) != 3 || len(g.Hands[1]) != 3 {
+		t.Error("Expected player 0 to take the trick and both players to draw.")
+	}
+
+	for !g.Over() {
+		if err := g.Play(g.Turn, g.Legal(g.Turn)[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := g.Score(); s[0]+s[1] != 120 {
+		t.Error("Expected the scores to add up to 120, received:", s)
+	}
+}
+
+func TestSueca(t *testing.T) {
+	g, err := NewSueca(Deck())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Trump != g.Hands[3][9] {
+		t.Error("Expected the trump to be the last card of the dealer.")
+	}
+	// Player 0 leads a spade, player 1 holds spades and must follow
+	if err := g.Play(0, c(deck.Ace, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(1, suited(g.Hands[1], deck.Diamond)[0]); err != ErrIllegal {
+		t.Error("Expected", ErrIllegal, "received:", err)
+	}
+
+	for !g.Over() {
+		if err := g.Play(g.Turn, g.Legal(g.Turn)[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := g.Score(); len(s) != 2 || s[0]+s[1] != 120 {
+		t.Error("Expected two team scores adding up to 120, received:", s)
+	}
+}
+
+func TestTute(t *testing.T) {
+	g := &Game{
+		Ranking: Spanish,
+		Trump:   c(deck.Two, deck.Club),
+		Hands: [][]deck.Card{
+			{c(deck.King, deck.Club), c(deck.Queen, deck.Club), c(deck.Four, deck.Spade)},
+			{c(deck.Ace, deck.Spade), c(deck.Five, deck.Club), c(deck.Two, deck.Heart)},
+		},
+		Won:       make([][]deck.Card, 2),
+		Cantes:    make([]int, 2),
+		Last:      -1,
+		legal:     arrastre,
+		lastBonus: 10,
+		cantes:    true,
+		sung:      map[deck.Suit]bool{},
+	}
+	if err := g.Cante(0, deck.Club); err != ErrCante {
+		t.Error("Expected", ErrCante, "before taking a trick, received:", err)
+	}
+	if err := g.Play(0, c(deck.Four, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+	if legal := g.Legal(1); len(legal) != 1 || legal[0] != c(deck.Ace, deck.Spade) {
+		t.Error("Expected player 1 to be forced to beat with the Ace, received:", legal)
+	}
+	if err := g.Play(1, c(deck.Ace, deck.Spade)); err != nil {
+		t.Fatal(err)
+	}
+
+	g.Last, g.Turn, g.Leader = 0, 0, 0
+	if err := g.Cante(0, deck.Club); err != nil {
+		t.Fatal(err)
+	}
+	if g.Cantes[0] != 40 {
+		t.Error("Expected a trump cante to be worth 40, received:", g.Cantes[0])
+	}
+	if err := g.Cante(0, deck.Club); err != ErrCante {
+		t.Error("Expected", ErrCante, "when singing a suit twice, received:", err)
+	}
+}
@@ -0,0 +1,101 @@
+// Package pointtrick implements the iberian and brazilian point-trick games played with a 40 card deck:
+// Brisca, Sueca and Tute. They share a core where cards have a power order and a point value, and the trump
+// comes from a turned card.
+package pointtrick
+
+import (
+	"errors"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when a move breaks the rules of the game
+var (
+	ErrPlayers   = errors.New("pointtrick: wrong number of players for the game")
+	ErrDeckSize  = errors.New("pointtrick: not enough cards to deal")
+	ErrTurn      = errors.New("pointtrick: it's not the player's turn")
+	ErrNotInHand = errors.New("pointtrick: card is not in the player's hand")
+	ErrIllegal   = errors.New("pointtrick: card can't be played in this trick")
+	ErrCante     = errors.New("pointtrick: player can't sing this cante")
+	ErrGameOver  = errors.New("pointtrick: the game is over")
+)
+
+// Short reports if a card is left out of a 40 card deck, that is, the Eights, Nines, Tens and the jokers.
+func Short(c deck.Card) bool {
+	return c.Suit == deck.Joker || (c.Rank >= deck.Eight && c.Rank <= deck.Ten)
+}
+
+// Deck returns the 40 cards deck, built with the given options applied after the short cards were filtered out.
+func Deck(opts ...func([]deck.Card) []deck.Card) []deck.Card {
+	return deck.New(append([]func([]deck.Card) []deck.Card{deck.Filter(Short)}, opts...)...)
+}
+
+// Ranking is the power order and the point values of the cards in a game.
+type Ranking struct {
+	order  []deck.Rank
+	points map[deck.Rank]int
+}
+
+// The two rankings used by these games. In the spanish one the Queen stands for the Caballo and the Jack for the Sota.
+var (
+	// Spanish is the A-3-K-Q-J-7-6-5-4-2 order of Brisca and Tute
+	Spanish = Ranking{
+		order:  []deck.Rank{deck.Ace, deck.Three, deck.King, deck.Queen, deck.Jack, deck.Seven, deck.Six, deck.Five, deck.Four, deck.Two},
+		points: map[deck.Rank]int{deck.Ace: 11, deck.Three: 10, deck.King: 4, deck.Queen: 3, deck.Jack: 2},
+	}
+
+	// Portuguese is the A-7-K-J-Q-6-5-4-3-2 order of Sueca and the brazilian Bisca
+	Portuguese = Ranking{
+		order:  []deck.Rank{deck.Ace, deck.Seven, deck.King, deck.Jack, deck.Queen, deck.Six, deck.Five, deck.Four, deck.Three, deck.Two},
+		points: map[deck.Rank]int{deck.Ace: 11, deck.Seven: 10, deck.King: 4, deck.Jack: 3, deck.Queen: 2},
+	}
+)
+
+// Power returns the strength of a rank, higher being stronger.
+func (r Ranking) Power(rank deck.Rank) int {
+	for i, o := range r.order {
+		if o == rank {
+			return len(r.order) - i
+		}
+	}
+	return 0
+}
+
+// Value returns the points a card is worth.
+func (r Ranking) Value(c deck.Card) int {
+	return r.points[c.Rank]
+}
+
+// Points returns the sum of the values of cards.
+func (r Ranking) Points(cards []deck.Card) int {
+	total := 0
+	for _, c := range cards {
+		total += r.Value(c)
+	}
+	return total
+}
+
+// Beats reports if card a beats card b, the stronger of the two so far, in a trick led in suit led.
+func (r Ranking) Beats(a, b deck.Card, led, trump deck.Suit) bool {
+	switch {
+	case a.Suit == b.Suit:
+		return r.Power(a.Rank) > r.Power(b.Rank)
+	case a.Suit == trump:
+		return true
+	case b.Suit == trump:
+		return false
+	default:
+		return b.Suit != led && a.Suit == led
+	}
+}
+
+// Winner returns the position in trick of the card that takes it. The first card leads the trick.
+func (r Ranking) Winner(trick []deck.Card, trump deck.Suit) int {
+	best := 0
+	for i := 1; i < len(trick); i++ {
+		if r.Beats(trick[i], trick[best], trick[0].Suit, trump) {
+			best = i
+		}
+	}
+	return best
+}
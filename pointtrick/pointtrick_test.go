@@ -0,0 +1,42 @@
+package pointtrick
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+func TestDeck(t *testing.T) {
+	cards := Deck()
+	if len(cards) != 40 {
+		t.Errorf("Expected %d cards, received %d cards.", 40, len(cards))
+	}
+	if total := Spanish.Points(cards); total != 120 {
+		t.Error("Expected the deck to be worth 120 points, received:", total)
+	}
+	if total := Portuguese.Points(cards); total != 120 {
+		t.Error("Expected the deck to be worth 120 points, received:", total)
+	}
+}
+
+func TestWinner(t *testing.T) {
+	cases := []struct {
+		r     Ranking
+		trick []deck.Card
+		exp   int
+	}{
+		{Spanish, []deck.Card{c(deck.King, deck.Club), c(deck.Three, deck.Club), c(deck.Ace, deck.Spade)}, 1},
+		{Portuguese, []deck.Card{c(deck.King, deck.Club), c(deck.Seven, deck.Club), c(deck.Three, deck.Club)}, 1},
+		{Spanish, []deck.Card{c(deck.Two, deck.Club), c(deck.Ace, deck.Spade), c(deck.Two, deck.Heart)}, 2},
+		{Spanish, []deck.Card{c(deck.Two, deck.Club), c(deck.Ace, deck.Spade), c(deck.Four, deck.Club)}, 2},
+	}
+	for _, tc := range cases {
+		if w := tc.r.Winner(tc.trick, deck.Heart); w != tc.exp {
+			t.Error("Expected card", tc.exp, "to take", tc.trick, "received:", w)
+		}
+	}
+}
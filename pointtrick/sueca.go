@@ -0,0 +1,20 @@
+package pointtrick
+
+import (
+	"github.com/euller88/deck"
+)
+
+// NewSueca deals a game of Sueca from cards, which should come already shuffled, to 4 players in two partnerships.
+// All the cards are dealt, the last one, held by the dealer in the fourth seat, showing the trump, and players
+// must follow suit when they can. A team needs 61 of the 120 points to win.
+func NewSueca(cards []deck.Card, opts ...func(*Game)) (*Game, error) {
+	g, err := newGame(cards, 4, 10, Portuguese)
+	if err != nil {
+		return nil, err
+	}
+	g.legal = follow
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, nil
+}
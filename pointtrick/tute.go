@@ -0,0 +1,75 @@
+package pointtrick
+
+import (
+	"github.com/euller88/deck"
+)
+
+// NewTute deals a game of Tute from cards, which should come already shuffled, to 2 or 4 players.
+// With 2 players each gets eight cards and the next one is turned as trump, and play is free while there is stock.
+// With 4 players, in two partnerships, all the cards are dealt, the last one showing the trump.
+// Once the stock is gone, players must follow suit and beat the trick, or trump it, when they can.
+// The winner of the last trick scores 10 more points.
+func NewTute(cards []deck.Card, players int, opts ...func(*Game)) (*Game, error) {
+	hand := 10
+	switch players {
+	case 2:
+		hand = 8
+	case 4:
+	default:
+		return nil, ErrPlayers
+	}
+
+	g, err := newGame(cards, players, hand, Spanish)
+	if err != nil {
+		return nil, err
+	}
+	g.legal = arrastre
+	g.lastBonus = 10
+	g.cantes = true
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, nil
+}
+
+// arrastre lets players play freely while there is stock, and enforces the strict rules after it.
+func arrastre(g *Game, player int) []deck.Card {
+	if len(g.Stock) > 0 {
+		return free(g, player)
+	}
+	return strict(g, player)
+}
+
+// Cante sings the marriage of the King and the Caballo of suit held by player, worth 40 in trumps and 20 otherwise.
+// Only the player who took the last trick may sing, before leading the next one, and every suit is sung once.
+func (g *Game) Cante(player int, suit deck.Suit) error {
+	if !g.cantes || g.Last != player || len(g.Trick) > 0 || g.sung[suit] {
+		return ErrCante
+	}
+	hand := g.Hands[player]
+	if index(hand, deck.Card{Suit: suit, Rank: deck.King}) < 0 || index(hand, deck.Card{Suit: suit, Rank: deck.Queen}) < 0 {
+		return ErrCante
+	}
+
+	g.sung[suit] = true
+	if suit == g.Trump.Suit {
+		g.Cantes[player] += 40
+	} else {
+		g.Cantes[player] += 20
+	}
+	return nil
+}
+
+// HasTute reports if player holds the four Kings or the four Caballos, which wins the game outright.
+func (g *Game) HasTute(player int) bool {
+	kings, queens := 0, 0
+	for _, c := range g.Hands[player] {
+		switch c.Rank {
+		case deck.King:
+			kings++
+		case deck.Queen:
+			queens++
+		}
+	}
+	return kings == 4 || queens == 4
+}
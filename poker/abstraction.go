@@ -0,0 +1,455 @@
+package poker
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when a hand can't be analyzed
+var (
+	ErrBoard   = errors.New("poker: the board must hold 3 to 5 standard cards, all different from the hole cards")
+	ErrBuckets = errors.New("poker: the abstraction has no buckets, fit it first")
+	ErrBins    = errors.New("poker: a histogram needs at least one bin")
+)
+
+// Strength measures a hole on a board against a single opponent holding any of the unseen cards, all the holes being
+// equally likely.
+type Strength struct {
+	// HS is the hand strength: the share of the opponent holes beaten now, the ties counting as half
+	HS float64
+
+	// PPot is the positive potential: the chance of being ahead at the river when behind now
+	// and NPot the negative potential: the chance of being behind at the river when ahead now
+	PPot, NPot float64
+
+	// EHS is the effective hand strength, HS*(1-NPot) + (1-HS)*PPot
+	EHS float64
+}
+
+// unseen returns the cards of New that are neither in the hole nor on the board, or ErrBoard.
+func unseen(hole Hole, board []deck.Card) ([]deck.Card, error) {
+	if len(board) < 3 || len(board) > 5 {
+		return nil, ErrBoard
+	}
+	known := map[deck.Card]bool{}
+	for _, c := range append([]deck.Card{hole[0], hole[1]}, board...) {
+		if c.Suit >= deck.Joker || c.Rank < deck.Ace || c.Rank > deck.King || known[c] {
+			return nil, ErrBoard
+		}
+		known[c] = true
+	}
+	var rest []deck.Card
+	for _, c := range deck.New() {
+		if !known[c] {
+			rest = append(rest, c)
+		}
+	}
+	return rest, nil
+}
+
+// compare returns 0 when a is ahead of b, 1 when they are tied and 2 when a is behind.
+func compare(a, b Value) int {
+	switch {
+	case a > b:
+		return 0
+	case a == b:
+		return 1
+	}
+	return 2
+}
+
+// Evaluate returns the strength of a hole on a board of 3 to 5 cards. The potentials count every way to deal the
+// rest of the board, and are zero at the river.
+func Evaluate(hole Hole, board []deck.Card) (Strength, error) {
+	rest, err := unseen(hole, board)
+	if err != nil {
+		return Strength{}, err
+	}
+	var now hand
+	for _, c := range board {
+		now.add(c)
+	}
+	mine := now
+	mine.add(hole[0])
+	mine.add(hole[1])
+	ours := mine.value()
+
+	// The values of the hole for every way to complete the board, and the cards completing it
+	var runs [][]int
+	var rivers []hand
+	var values []Value
+	completions(len(rest), 5-len(board), func(idx []int) {
+		h, b := mine, now
+		for _, i := range idx {
+			h.add(rest[i])
+			b.add(rest[i])
+		}
+		runs = append(runs, append([]int(nil), idx...))
+		rivers = append(rivers, b)
+		values = append(values, h.value())
+	})
+
+	// counts[i][j] is the number of deals ahead, tied or behind now, then at the river
+	var counts [3][3]float64
+	var now3 [3]float64
+	for i := range rest {
+		for j := 0; j < i; j++ {
+			opp := now
+			opp.add(rest[i])
+			opp.add(rest[j])
+			before := compare(ours, opp.value())
+			now3[before]++
+			if len(board) == 5 {
+				continue
+			}
+			for n, run := range runs {
+				if contains(run, i) || contains(run, j) {
+					continue
+				}
+				opp := rivers[n]
+				opp.add(rest[i])
+				opp.add(rest[j])
+				counts[before][compare(values[n], opp.value())]++
+			}
+		}
+	}
+
+	total := now3[0] + now3[1] + now3[2]
+	s := Strength{HS: (now3[0] + now3[1]/2) / total}
+	sum := func(row [3]float64) float64 { return row[0] + row[1] + row[2] }
+	if behind := sum(counts[2]) + sum(counts[1])/2; behind > 0 {
+		s.PPot = (counts[2][0] + counts[2][1]/2 + counts[1][0]/2) / behind
+	}
+	if ahead := sum(counts[0]) + sum(counts[1])/2; ahead > 0 {
+		s.NPot = (counts[0][2] + counts[1][2]/2 + counts[0][1]/2) / ahead
+	}
+	s.EHS = s.HS*(1-s.NPot) + (1-s.HS)*s.PPot
+	return s, nil
+}
+
+func contains(idx []int, i int) bool {
+	for _, j := range idx {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
+
+// completions calls f with every set of k positions below n, in increasing order.
+func completions(n, k int, f func(idx []int)) {
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		f(idx)
+		i := k - 1
+		for i >= 0 && idx[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+	}
+}
+
+// rivers calls f with the hand strength of the hole at the river for every way to complete the board.
+func rivers(hole Hole, board []deck.Card, f func(hs float64)) error {
+	rest, err := unseen(hole, board)
+	if err != nil {
+		return err
+	}
+	var now hand
+	for _, c := range board {
+		now.add(c)
+	}
+	completions(len(rest), 5-len(board), func(idx []int) {
+		b := now
+		for _, i := range idx {
+			b.add(rest[i])
+		}
+		mine := b
+		mine.add(hole[0])
+		mine.add(hole[1])
+		ours := mine.value()
+
+		var score, total float64
+		for i := range rest {
+			if contains(idx, i) {
+				continue
+			}
+			for j := 0; j < i; j++ {
+				if contains(idx, j) {
+					continue
+				}
+				opp := b
+				opp.add(rest[i])
+				opp.add(rest[j])
+				switch theirs := opp.value(); {
+				case ours > theirs:
+					score++
+				case ours == theirs:
+					score += 0.5
+				}
+				total++
+			}
+		}
+		f(score / total)
+	})
+	return nil
+}
+
+// Histogram returns the distribution of the hand strength of the hole at the river, over every way to complete the
+// board, in bins of equal width between 0 and 1.
+func Histogram(hole Hole, board []deck.Card, bins int) ([]float64, error) {
+	if bins <= 0 {
+		return nil, ErrBins
+	}
+	hist := make([]float64, bins)
+	n := 0
+	err := rivers(hole, board, func(hs float64) {
+		b := int(hs * float64(bins))
+		if b == bins {
+			b--
+		}
+		hist[b]++
+		n++
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range hist {
+		hist[i] /= float64(n)
+	}
+	return hist, nil
+}
+
+// EHS2 returns the mean of the square of the hand strength of the hole at the river, over every way to complete the
+// board. Unlike the mean, it rewards the hands whose strength may end up very high.
+func EHS2(hole Hole, board []deck.Card) (float64, error) {
+	var sum float64
+	n := 0
+	err := rivers(hole, board, func(hs float64) {
+		sum += hs * hs
+		n++
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sum / float64(n), nil
+}
+
+// EMD returns the earth mover's distance between two histograms of the same bins between 0 and 1: the least amount
+// of mass times the distance it moves to turn one into the other.
+func EMD(a, b []float64) float64 {
+	var d, carry float64
+	for i := range a {
+		carry += a[i] - b[i]
+		d += math.Abs(carry)
+	}
+	return d / float64(len(a))
+}
+
+// KMeans groups histograms into k clusters by their earth mover's distance, returning the center of every cluster,
+// the mean of its histograms, and the cluster of every histogram. The first centers are picked with k-means++ using
+// r, so the same source gives the same clusters.
+func KMeans(hists [][]float64, k int, r *rand.Rand) ([][]float64, []int) {
+	if k > len(hists) {
+		k = len(hists)
+	}
+	if k == 0 {
+		return nil, make([]int, len(hists))
+	}
+
+	centers := [][]float64{hists[r.Intn(len(hists))]}
+	dist := make([]float64, len(hists))
+	for len(centers) < k {
+		var total float64
+		for i, h := range hists {
+			_, d := nearest(centers, h)
+			dist[i] = d * d
+			total += dist[i]
+		}
+		next := hists[r.Intn(len(hists))]
+		if total > 0 {
+			x := r.Float64() * total
+			for i := range hists {
+				if x -= dist[i]; x <= 0 || i == len(hists)-1 {
+					next = hists[i]
+					break
+				}
+			}
+		}
+		centers = append(centers, next)
+	}
+
+	assign := make([]int, len(hists))
+	for i := range assign {
+		assign[i] = -1
+	}
+	const iterations = 100
+	for it := 0; it < iterations; it++ {
+		changed := false
+		for i, h := range hists {
+			if c, _ := nearest(centers, h); c != assign[i] {
+				assign[i], changed = c, true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([][]float64, k)
+		counts := make([]int, k)
+		for i, h := range hists {
+			c := assign[i]
+			if sums[c] == nil {
+				sums[c] = make([]float64, len(h))
+			}
+			for j := range h {
+				sums[c][j] += h[j]
+			}
+			counts[c]++
+		}
+		for c := range centers {
+			// An empty cluster keeps its center
+			if counts[c] == 0 {
+				continue
+			}
+			for j := range sums[c] {
+				sums[c][j] /= float64(counts[c])
+			}
+			centers[c] = sums[c]
+		}
+	}
+	return centers, assign
+}
+
+// nearest returns the center closest to h and its distance.
+func nearest(centers [][]float64, h []float64) (int, float64) {
+	best, dist := 0, math.Inf(1)
+	for c, center := range centers {
+		if d := EMD(center, h); d < dist {
+			best, dist = c, d
+		}
+	}
+	return best, dist
+}
+
+// Spot is a hole on a board.
+type Spot struct {
+	Hole  Hole
+	Board []deck.Card
+}
+
+// Abstraction groups the spots of a street into buckets of hands that play alike: the ones with close distributions
+// of hand strength at the river. The histograms are cached, spots equal up to a renaming of the suits sharing an
+// entry. It's safe for concurrent use.
+type Abstraction struct {
+	// Bins is the number of bins of the histograms
+	Bins int
+
+	mu      sync.Mutex
+	centers [][]float64
+	cache   map[string][]float64
+}
+
+// NewAbstraction returns an abstraction with histograms of bins bins and no buckets.
+func NewAbstraction(bins int) *Abstraction {
+	return &Abstraction{Bins: bins, cache: map[string][]float64{}}
+}
+
+// Histogram returns the histogram of a spot, as Histogram, computing it only once.
+func (a *Abstraction) Histogram(s Spot) ([]float64, error) {
+	if _, err := unseen(s.Hole, s.Board); err != nil {
+		return nil, err
+	}
+	k := spotKey(s)
+	a.mu.Lock()
+	h, ok := a.cache[k]
+	a.mu.Unlock()
+	if ok {
+		return h, nil
+	}
+
+	h, err := Histogram(s.Hole, s.Board, a.Bins)
+	if err != nil {
+		return nil, err
+	}
+	a.mu.Lock()
+	a.cache[k] = h
+	a.mu.Unlock()
+	return h, nil
+}
+
+// Fit computes k buckets from the histograms of spots with KMeans, seeded with seed, and returns the bucket of every
+// spot.
+func (a *Abstraction) Fit(spots []Spot, k int, seed int64) ([]int, error) {
+	hists := make([][]float64, len(spots))
+	for i, s := range spots {
+		h, err := a.Histogram(s)
+		if err != nil {
+			return nil, err
+		}
+		hists[i] = h
+	}
+	centers, assign := KMeans(hists, k, rand.New(rand.NewSource(seed)))
+
+	a.mu.Lock()
+	a.centers = centers
+	a.mu.Unlock()
+	return assign, nil
+}
+
+// Bucket returns the bucket of a spot: the one whose center is the closest to its histogram.
+func (a *Abstraction) Bucket(s Spot) (int, error) {
+	a.mu.Lock()
+	centers := a.centers
+	a.mu.Unlock()
+	if len(centers) == 0 {
+		return 0, ErrBuckets
+	}
+
+	h, err := a.Histogram(s)
+	if err != nil {
+		return 0, err
+	}
+	b, _ := nearest(centers, h)
+	return b, nil
+}
+
+// spotKey returns the cards of a spot, the hole and the board sorted, with the suits renamed by the permutation giving
+// the smallest key, so that spots equal up to a renaming of the suits get the same key.
+func spotKey(s Spot) string {
+	codes := func(cards []deck.Card, p [4]deck.Suit) string {
+		renamed := make([]deck.Card, len(cards))
+		for i, c := range cards {
+			renamed[i] = deck.Card{Suit: p[c.Suit], Rank: c.Rank}
+		}
+		sort.Slice(renamed, func(i, j int) bool { return renamed[i].Index() > renamed[j].Index() })
+		var codes []string
+		for _, c := range renamed {
+			codes = append(codes, c.Code())
+		}
+		return strings.Join(codes, " ")
+	}
+
+	var key string
+	for _, p := range perms {
+		if k := codes(s.Hole[:], p) + " | " + codes(s.Board, p); key == "" || k < key {
+			key = k
+		}
+	}
+	return key
+}
@@ -0,0 +1,155 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func spot(t *testing.T, holeCodes, board string) Spot {
+	return Spot{Hole: hole(t, holeCodes), Board: cards(t, board)}
+}
+
+func TestEvaluate(t *testing.T) {
+	s := spot(t, "AS KS", "QS JS TS")
+	nuts, err := Evaluate(s.Hole, s.Board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nuts.HS != 1 || nuts.NPot != 0 || nuts.EHS != 1 {
+		t.Error("Expected the nuts, received:", nuts)
+	}
+
+	s = spot(t, "7H 2C", "AS KD QC")
+	trash, _ := Evaluate(s.Hole, s.Board)
+	s = spot(t, "AH 9H", "KH 7H 2C")
+	draw, _ := Evaluate(s.Hole, s.Board)
+	if trash.HS > 0.2 || draw.PPot < 0.3 || draw.EHS < draw.HS || trash.EHS > draw.EHS {
+		t.Error("Expected a weak hand and a flush draw, received:", trash, draw)
+	}
+
+	s = spot(t, "AH 9H", "KH 7H 2C 3D 4S")
+	river, _ := Evaluate(s.Hole, s.Board)
+	if river.PPot != 0 || river.NPot != 0 || river.EHS != river.HS {
+		t.Error("Expected no potential at the river, received:", river)
+	}
+
+	for _, board := range []string{"", "KH 7H", "AH 7H 2C", "KH 7H 2C 3D 4S 5S"} {
+		s.Board = cards(t, board)
+		if _, err := Evaluate(s.Hole, s.Board); err != ErrBoard {
+			t.Error("Expected", ErrBoard, "for", board, "received:", err)
+		}
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	s := spot(t, "AH 9H", "KH 7H 2C 3D")
+	hist, err := Histogram(s.Hole, s.Board, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sum, mean float64
+	for i, p := range hist {
+		sum += p
+		mean += p * (float64(i) + 0.5) / 10
+	}
+	if math.Abs(sum-1) > 1e-9 || hist[9] < 0.15 {
+		t.Error("Expected a histogram with the flushes in the top bin, received:", hist)
+	}
+
+	ehs2, _ := EHS2(s.Hole, s.Board)
+	if ehs2 <= 0 || ehs2 >= 1 || ehs2 < mean*mean-0.05 {
+		t.Error("Expected EHS2 above the squared mean", mean*mean, "received:", ehs2)
+	}
+
+	s = spot(t, "AH 9H", "KH 7H 2C 3D 4S")
+	hist, _ = Histogram(s.Hole, s.Board, 4)
+	str, _ := Evaluate(s.Hole, s.Board)
+	if hist[int(str.HS*4)] != 1 {
+		t.Error("Expected a single bin at the river, received:", hist)
+	}
+
+	if _, err := Histogram(s.Hole, s.Board, 0); err != ErrBins {
+		t.Error("Expected", ErrBins, "received:", err)
+	}
+	if _, err := NewAbstraction(0).Histogram(s); err != ErrBins {
+		t.Error("Expected", ErrBins, "received:", err)
+	}
+}
+
+func TestEMD(t *testing.T) {
+	if d := EMD([]float64{1, 0}, []float64{0, 1}); d != 0.5 {
+		t.Error("Expected 0.5, received:", d)
+	}
+	if d := EMD([]float64{0.5, 0, 0.5}, []float64{0.5, 0, 0.5}); d != 0 {
+		t.Error("Expected 0, received:", d)
+	}
+	if d := EMD([]float64{1, 0, 0, 0}, []float64{0, 0, 0, 1}); d != 0.75 {
+		t.Error("Expected 0.75, received:", d)
+	}
+}
+
+func TestKMeans(t *testing.T) {
+	hists := [][]float64{{1, 0, 0}, {0.9, 0.1, 0}, {0, 0.1, 0.9}, {0, 0, 1}, {0.8, 0.2, 0}}
+	centers, assign := KMeans(hists, 2, rand.New(rand.NewSource(1)))
+	if len(centers) != 2 || assign[0] != assign[1] || assign[0] != assign[4] || assign[2] != assign[3] || assign[0] == assign[2] {
+		t.Error("Expected the low and the high histograms apart, received:", assign, centers)
+	}
+	_, again := KMeans(hists, 2, rand.New(rand.NewSource(1)))
+	for i := range assign {
+		if assign[i] != again[i] {
+			t.Error("Expected the same clusters from the same seed, received:", assign, again)
+		}
+	}
+}
+
+func TestAbstraction(t *testing.T) {
+	a := NewAbstraction(8)
+	if _, err := a.Bucket(spot(t, "AS KS", "QS JS TS 2D")); err != ErrBuckets {
+		t.Error("Expected", ErrBuckets, "received:", err)
+	}
+
+	spots := []Spot{
+		spot(t, "AS KS", "QS JS TS 2D"),
+		spot(t, "AH AD", "AC 7S 7D 2C"),
+		spot(t, "7H 2C", "AS KD QC 9S"),
+		spot(t, "3H 2D", "AS KD QC 9S"),
+	}
+	buckets, err := a.Fit(spots, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buckets[0] != buckets[1] || buckets[2] != buckets[3] || buckets[0] == buckets[2] {
+		t.Error("Expected the monsters and the trash apart, received:", buckets)
+	}
+
+	// The same spot with the suits renamed comes from the cache
+	b, err := a.Bucket(spot(t, "AD KD", "QD JD TD 2S"))
+	if err != nil || b != buckets[0] || len(a.cache) != 4 {
+		t.Error("Expected bucket", buckets[0], "from the cache, received:", b, len(a.cache), err)
+	}
+}
+
+func TestSpotKey(t *testing.T) {
+	// Spades and hearts swapped, then every suit moved along
+	k := spotKey(spot(t, "AS AH", "KS 7H 2C"))
+	for _, s := range []Spot{spot(t, "AH AS", "KH 7S 2C"), spot(t, "AD AC", "KD 7C 2H")} {
+		if spotKey(s) != k {
+			t.Error("Expected", k, "received:", spotKey(s))
+		}
+	}
+	if spotKey(spot(t, "AS AH", "KS 7S 2C")) == k {
+		t.Error("Expected a different key for a board with two spades.")
+	}
+
+	joker := Spot{Hole: Hole{{Suit: deck.Joker}, {Suit: deck.Spade, Rank: deck.Ace}}, Board: spot(t, "AS AH", "KS 7S 2C").Board}
+	a := NewAbstraction(4)
+	if _, err := a.Histogram(joker); err != ErrBoard {
+		t.Error("Expected", ErrBoard, "received:", err)
+	}
+	if _, err := a.Fit([]Spot{joker}, 1, 1); err != ErrBoard {
+		t.Error("Expected", ErrBoard, "received:", err)
+	}
+}
@@ -0,0 +1,25 @@
+//go:build ignore
+// +build ignore
+
+// Gen writes preflop_table.go, the preflop equity tables of package poker.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/euller88/deck/poker"
+)
+
+func main() {
+	f, err := os.Create("preflop_table.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := poker.GeneratePreflop(f); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
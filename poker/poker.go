@@ -0,0 +1,209 @@
+// Package poker evaluates poker hands of five to seven cards, keeping the best five.
+package poker
+
+import (
+	"math/bits"
+
+	"github.com/euller88/deck"
+)
+
+// Category is the kind of a poker hand
+type Category uint8
+
+// The categories, from the weakest to the strongest
+const (
+	HighCard Category = iota
+	Pair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+)
+
+var categoryNames = [...]string{
+	"High Card", "Pair", "Two Pair", "Three of a Kind", "Straight", "Flush", "Full House", "Four of a Kind", "Straight Flush",
+}
+
+func (c Category) String() string {
+	return categoryNames[c]
+}
+
+// Value is the strength of a hand: a higher value beats a lower one, and equal values split the pot.
+type Value uint32
+
+// Category returns the category of the hand.
+func (v Value) Category() Category {
+	return Category(v >> 20)
+}
+
+// String returns the name of the category of the hand.
+func (v Value) String() string {
+	return v.Category().String()
+}
+
+// pos returns the position of a rank in the rank masks, from 0 for the Two to 12 for the Ace.
+func pos(r deck.Rank) uint {
+	if r == deck.Ace {
+		return 12
+	}
+	return uint(r) - 2
+}
+
+// Eval returns the value of the best five cards among cards. Jokers are ignored.
+func Eval(cards []deck.Card) Value {
+	var h hand
+	for _, c := range cards {
+		h.add(c)
+	}
+	return h.value()
+}
+
+// hand holds the rank masks of a hand, for every suit and for all of them, and the number of cards of every rank.
+type hand struct {
+	suits  [4]uint16
+	counts [13]uint8
+	all    uint16
+}
+
+func (h *hand) add(c deck.Card) {
+	if c.Suit == deck.Joker {
+		return
+	}
+	p := pos(c.Rank)
+	h.suits[c.Suit] |= 1 << p
+	h.counts[p]++
+	h.all |= 1 << p
+}
+
+// value returns the value of the best five cards of the hand.
+func (h *hand) value() Value {
+	suits, counts, all := h.suits, h.counts, h.all
+	for _, m := range suits {
+		if bits.OnesCount16(m) >= 5 {
+			if top, ok := straight(m); ok {
+				return cat(StraightFlush) | Value(top)<<16
+			}
+			return cat(Flush) | kick(m, 5, 16)
+		}
+	}
+
+	// The highest quads, the two highest trips and the two highest pairs, or -1
+	quad, trips, pairs := -1, [2]int{-1, -1}, [2]int{-1, -1}
+	for p := 12; p >= 0; p-- {
+		switch counts[p] {
+		case 4:
+			if quad < 0 {
+				quad = p
+			}
+		case 3:
+			trips[0], trips[1] = insert(trips, p)
+		case 2:
+			pairs[0], pairs[1] = insert(pairs, p)
+		}
+	}
+
+	switch {
+	case quad >= 0:
+		return cat(FourOfAKind) | Value(quad)<<16 | kick(all&^bit(quad), 1, 12)
+	case trips[0] >= 0 && (trips[1] >= 0 || pairs[0] >= 0):
+		// With two trips, the lower one makes the pair
+		pair := pairs[0]
+		if trips[1] > pair {
+			pair = trips[1]
+		}
+		return cat(FullHouse) | Value(trips[0])<<16 | Value(pair)<<12
+	}
+	if top, ok := straight(all); ok {
+		return cat(Straight) | Value(top)<<16
+	}
+	switch {
+	case trips[0] >= 0:
+		return cat(ThreeOfAKind) | Value(trips[0])<<16 | kick(all&^bit(trips[0]), 2, 12)
+	case pairs[1] >= 0:
+		return cat(TwoPair) | Value(pairs[0])<<16 | Value(pairs[1])<<12 | kick(all&^bit(pairs[0])&^bit(pairs[1]), 1, 8)
+	case pairs[0] >= 0:
+		return cat(Pair) | Value(pairs[0])<<16 | kick(all&^bit(pairs[0]), 3, 12)
+	}
+	return cat(HighCard) | kick(all, 5, 16)
+}
+
+// insert keeps a rank among the two highest ones, the ranks coming from the highest down.
+func insert(top [2]int, p int) (int, int) {
+	switch {
+	case top[0] < 0:
+		return p, -1
+	case top[1] < 0:
+		return top[0], p
+	}
+	return top[0], top[1]
+}
+
+// straight returns the position of the top card of the highest straight in a rank mask, the wheel topped by the Five.
+func straight(m uint16) (uint, bool) {
+	for top := 12; top >= 4; top-- {
+		run := uint16(0x1f) << uint(top-4)
+		if m&run == run {
+			return uint(top), true
+		}
+	}
+	const wheel = 1<<12 | 0xf
+	if m&wheel == wheel {
+		return 3, true
+	}
+	return 0, false
+}
+
+// kick packs the positions of the n highest ranks of a mask into a value, four bits each, the first at shift.
+func kick(m uint16, n int, shift uint) Value {
+	var v Value
+	for p := 12; p >= 0 && n > 0; p-- {
+		if m&bit(p) != 0 {
+			v |= Value(p) << shift
+			shift -= 4
+			n--
+		}
+	}
+	return v
+}
+
+func bit(p int) uint16 {
+	return 1 << uint(p)
+}
+
+func cat(c Category) Value {
+	return Value(c) << 20
+}
+
+// Best returns the value of the best five cards among cards, and those cards.
+func Best(cards []deck.Card) (Value, []deck.Card) {
+	best := Eval(cards)
+	if len(cards) <= 5 {
+		return best, append([]deck.Card(nil), cards...)
+	}
+
+	// Find the five cards worth as much as the whole hand
+	idx := []int{0, 1, 2, 3, 4}
+	hand := make([]deck.Card, 5)
+	for {
+		for i, j := range idx {
+			hand[i] = cards[j]
+		}
+		if Eval(hand) == best {
+			return best, hand
+		}
+		i := 4
+		for i >= 0 && idx[i] == len(cards)-5+i {
+			i--
+		}
+		if i < 0 {
+			return best, nil
+		}
+		idx[i]++
+		for j := i + 1; j < 5; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+	}
+}
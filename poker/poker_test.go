@@ -0,0 +1,68 @@
+package poker
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func cards(t *testing.T, codes string) []deck.Card {
+	cards, err := deck.ParseCards(codes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cards
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		hand string
+		cat  Category
+	}{
+		{"AS KS QS JS TS 2D 3C", StraightFlush},
+		{"5H 4H 3H 2H AH KD", StraightFlush},
+		{"9C 9D 9H 9S 2D", FourOfAKind},
+		{"9C 9D 9H 2S 2D 2H", FullHouse},
+		{"9C 9D 9H 2S 2D", FullHouse},
+		{"AD 8D 7D 3D 2D KS", Flush},
+		{"5C 4D 3H 2S AD", Straight},
+		{"7C 7D 7H KS 2D", ThreeOfAKind},
+		{"7C 7D KH KS 2D 2C", TwoPair},
+		{"7C 7D KH QS 2D", Pair},
+		{"7C 8D KH QS 2D", HighCard},
+	}
+	for _, tt := range tests {
+		if v := Eval(cards(t, tt.hand)); v.Category() != tt.cat {
+			t.Error("Expected", tt.cat, "for", tt.hand, "received:", v)
+		}
+	}
+
+	order := []string{
+		"AS KD 9C 7H 5S", "AS KD 9C 7H 6S", "2S 2D 5C 4H 3S", "2S 2D AC 4H 3S", "3S 3D 5C 4H 2S",
+		"5S 5D 3C 3H 2S", "5S 5D 4C 4H AS", "AS 2D 3C 4H 5S", "2S 3D 4C 5H 6S", "TS JD QC KH AS",
+		"2S 3S 4S 5S 7S", "8S 8D 8C 2H 2S", "8S 8D 8C 8H 2S", "AS 2S 3S 4S 5S", "TH JH QH KH AH",
+	}
+	for i := 1; i < len(order); i++ {
+		if Eval(cards(t, order[i-1])) >= Eval(cards(t, order[i])) {
+			t.Error("Expected", order[i-1], "to lose to", order[i])
+		}
+	}
+	if Eval(cards(t, "AS KS 5D 5C 2H 3H 9C")) != Eval(cards(t, "AD KH 5S 5H 2C 3D 9S")) {
+		t.Error("Expected equal hands to split.")
+	}
+}
+
+func TestBest(t *testing.T) {
+	v, best := Best(cards(t, "KS 2D 7C 7H 7S KD 9C"))
+	if v.Category() != FullHouse || len(best) != 5 || Eval(best) != v {
+		t.Error("Expected a full house of five cards, received:", best)
+	}
+}
+
+func BenchmarkEval(b *testing.B) {
+	hand := deck.New()[:7]
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Eval(hand)
+	}
+}
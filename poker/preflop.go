@@ -0,0 +1,431 @@
+package poker
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+//go:generate go run gen.go
+
+// ErrHole is returned when two holes can't be dealt together: they share a card, or hold a joker.
+var ErrHole = errors.New("poker: holes must be four distinct standard cards")
+
+// ErrClass is returned when the name of a starting hand class can't be parsed
+var ErrClass = errors.New("poker: malformed starting hand class")
+
+// Boards is the number of boards of five cards dealt from the 48 cards left after two holes.
+const Boards = 1712304
+
+// Hole is a hand of two hole cards.
+type Hole [2]deck.Card
+
+// ParseHole reads a hole from two card codes, such as "AS KD".
+func ParseHole(codes string) (Hole, error) {
+	cards, err := deck.ParseCards(codes)
+	if err != nil {
+		return Hole{}, err
+	}
+	if len(cards) != 2 || cards[0] == cards[1] || cards[0].Suit == deck.Joker || cards[1].Suit == deck.Joker {
+		return Hole{}, ErrHole
+	}
+	return Hole{cards[0], cards[1]}, nil
+}
+
+// Class is one of the 169 starting hands of Hold'em, where only the ranks and whether the cards are suited matter,
+// such as "AKs", "AKo" or "AA". The classes are numbered by the positions of the ranks in a 13 by 13 grid, the
+// suited hands above its diagonal and the offsuit hands below it.
+type Class uint8
+
+// Classes is the number of starting hand classes.
+const Classes = 169
+
+// ClassOf returns the class of a hole.
+func ClassOf(h Hole) Class {
+	hi, lo := pos(h[0].Rank), pos(h[1].Rank)
+	if hi < lo {
+		hi, lo = lo, hi
+	}
+	if h[0].Suit != h[1].Suit {
+		hi, lo = lo, hi
+	}
+	return Class(hi*13 + lo)
+}
+
+// ParseClass reads the name of a class, such as "AKs", "AKo" or "AA".
+func ParseClass(s string) (Class, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if len(s) < 2 || len(s) > 3 {
+		return 0, ErrClass
+	}
+	hi, err1 := deck.ParseCard(s[:1] + "S")
+	lo, err2 := deck.ParseCard(s[1:2] + "H")
+	switch {
+	case err1 != nil || err2 != nil:
+		return 0, ErrClass
+	case len(s) == 2 && hi.Rank == lo.Rank:
+		return ClassOf(Hole{hi, lo}), nil
+	case len(s) == 3 && hi.Rank != lo.Rank && s[2] == 'O':
+		return ClassOf(Hole{hi, lo}), nil
+	case len(s) == 3 && hi.Rank != lo.Rank && s[2] == 'S':
+		lo.Suit = hi.Suit
+		return ClassOf(Hole{hi, lo}), nil
+	}
+	return 0, ErrClass
+}
+
+// ranks returns the positions of the ranks of the class, the highest first, and whether it's suited.
+func (c Class) ranks() (hi, lo uint, suited bool) {
+	a, b := uint(c)/13, uint(c)%13
+	if a < b {
+		return b, a, false
+	}
+	return a, b, a > b
+}
+
+func (c Class) String() string {
+	hi, lo, suited := c.ranks()
+	name := rankCode(hi) + rankCode(lo)
+	switch {
+	case hi == lo:
+		return name
+	case suited:
+		return name + "s"
+	}
+	return name + "o"
+}
+
+// rankCode returns the code of the rank at a position of the rank masks.
+func rankCode(p uint) string {
+	return deck.Card{Rank: deck.Rank(p + 2 - 13*(p/12)), Suit: deck.Spade}.Code()[:1]
+}
+
+// Combos returns the holes of the class: 6 for a pair, 4 for a suited hand and 12 for an offsuit one.
+func (c Class) Combos() []Hole {
+	var holes []Hole
+	for _, h := range holes1326() {
+		if ClassOf(h) == c {
+			holes = append(holes, h)
+		}
+	}
+	return holes
+}
+
+// holes1326 returns every hole, the higher card of the standard deck order first.
+func holes1326() []Hole {
+	cards := deck.New()
+	holes := make([]Hole, 0, 1326)
+	for i := range cards {
+		for j := 0; j < i; j++ {
+			holes = append(holes, Hole{cards[i], cards[j]})
+		}
+	}
+	return holes
+}
+
+// valid reports if two holes are made of four distinct standard cards.
+func valid(hero, villain Hole) bool {
+	seen := map[deck.Card]bool{}
+	for _, c := range []deck.Card{hero[0], hero[1], villain[0], villain[1]} {
+		if c.Suit >= deck.Joker || c.Rank < deck.Ace || c.Rank > deck.King || seen[c] {
+			return false
+		}
+		seen[c] = true
+	}
+	return true
+}
+
+// ExactEquity returns the share of the pot won by hero against villain, the ties counting as half a win, over all
+// the boards that can be dealt.
+func ExactEquity(hero, villain Hole) (float64, error) {
+	if !valid(hero, villain) {
+		return 0, ErrHole
+	}
+
+	var rest []deck.Card
+	for _, c := range deck.New() {
+		if c != hero[0] && c != hero[1] && c != villain[0] && c != villain[1] {
+			rest = append(rest, c)
+		}
+	}
+	h := [7]deck.Card{hero[0], hero[1]}
+	v := [7]deck.Card{villain[0], villain[1]}
+	var wins, ties int
+	boards(len(rest), func(b *[5]int) {
+		for i, p := range b {
+			h[2+i], v[2+i] = rest[p], rest[p]
+		}
+		a, b2 := Eval(h[:]), Eval(v[:])
+		switch {
+		case a > b2:
+			wins++
+		case a == b2:
+			ties++
+		}
+	})
+	return (float64(wins) + float64(ties)/2) / Boards, nil
+}
+
+// boards calls f with every set of five positions below n, in increasing order.
+func boards(n int, f func(b *[5]int)) {
+	var b [5]int
+	for b[4] = 4; b[4] < n; b[4]++ {
+		for b[3] = 3; b[3] < b[4]; b[3]++ {
+			for b[2] = 2; b[2] < b[3]; b[2]++ {
+				for b[1] = 1; b[1] < b[2]; b[1]++ {
+					for b[0] = 0; b[0] < b[1]; b[0]++ {
+						f(&b)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Equity returns the share of the pot won by hero against villain before the flop, looked up in the precomputed
+// tables. The equities are exact up to the 16 bits they're stored with.
+func Equity(hero, villain Hole) (float64, error) {
+	if !valid(hero, villain) {
+		return 0, ErrHole
+	}
+	k, swapped := canon(hero, villain)
+	n := len(preflopCombos) / 5
+	i := sort.Search(n, func(i int) bool {
+		return record(preflopCombos, i*5, 3) >= k
+	})
+	if i == n || record(preflopCombos, i*5, 3) != k {
+		return 0, ErrHole
+	}
+	e := float64(record(preflopCombos, i*5+3, 2)) / 65535
+	if swapped {
+		e = 1 - e
+	}
+	return e, nil
+}
+
+// ClassEquity returns the equity of a class against another one, averaged over all their holes that can be dealt
+// together.
+func ClassEquity(hero, villain Class) float64 {
+	i := (int(hero)*Classes + int(villain)) * 2
+	return float64(record(preflopClasses, i, 2)) / 65535
+}
+
+// record reads an n-byte big-endian number at position i of a table.
+func record(table string, i, n int) uint32 {
+	var x uint32
+	for j := 0; j < n; j++ {
+		x = x<<8 | uint32(table[i+j])
+	}
+	return x
+}
+
+// perms holds the 24 permutations of the four standard suits
+var perms [][4]deck.Suit
+
+func init() {
+	for a := deck.Spade; a < deck.Joker; a++ {
+		for b := deck.Spade; b < deck.Joker; b++ {
+			for c := deck.Spade; c < deck.Joker; c++ {
+				if a != b && b != c && a != c {
+					perms = append(perms, [4]deck.Suit{a, b, c, 6 - a - b - c})
+				}
+			}
+		}
+	}
+}
+
+// key packs the indices of the cards of two holes, renamed by a permutation of the suits, into 24 bits, the holes in
+// order and the higher index first in each hole.
+func key(hero, villain Hole, p [4]deck.Suit) uint32 {
+	var k uint32
+	for _, h := range []Hole{hero, villain} {
+		a := deck.Card{Suit: p[h[0].Suit], Rank: h[0].Rank}.Index()
+		b := deck.Card{Suit: p[h[1].Suit], Rank: h[1].Rank}.Index()
+		if a < b {
+			a, b = b, a
+		}
+		k = k<<12 | uint32(a)<<6 | uint32(b)
+	}
+	return k
+}
+
+// canon returns the smallest key of a matchup among all the renamings of the suits and both orders of the holes,
+// which is the same for every matchup of the same equity by symmetry, and whether the holes were swapped to get it.
+func canon(hero, villain Hole) (uint32, bool) {
+	k, swapped := ^uint32(0), false
+	for _, p := range perms {
+		if x := key(hero, villain, p); x < k {
+			k, swapped = x, false
+		}
+		if x := key(villain, hero, p); x < k {
+			k, swapped = x, true
+		}
+	}
+	return k, swapped
+}
+
+// unkey returns the holes packed in a key.
+func unkey(k uint32) (Hole, Hole) {
+	card := func(shift uint) deck.Card {
+		return deck.CardAt(int(k >> shift & 63))
+	}
+	return Hole{card(18), card(12)}, Hole{card(6), card(0)}
+}
+
+// GeneratePreflop computes the exact equity of every preflop matchup and writes them as the Go source of the tables
+// used by Equity and ClassEquity. The 47008 matchups distinct up to a renaming of the suits are stored once, and
+// the 1.7 million boards of each are dealt, which takes about two hours of CPU time.
+func GeneratePreflop(w io.Writer) error {
+	holes := holes1326()
+	seen := map[uint32]bool{}
+	for _, h := range holes {
+		for _, v := range holes {
+			if valid(h, v) {
+				k, _ := canon(h, v)
+				seen[k] = true
+			}
+		}
+	}
+	sorted := make([]uint32, 0, len(seen))
+	for k := range seen {
+		sorted = append(sorted, k)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	keys := sorted
+
+	// The matchups of the same hero are next to each other, and computed together
+	equity := make(map[uint32]float64, len(keys))
+	for len(keys) > 0 {
+		n := 1
+		for n < len(keys) && keys[n]>>12 == keys[0]>>12 {
+			n++
+		}
+		group := keys[:n]
+		keys = keys[n:]
+
+		hero, _ := unkey(group[0])
+		villains := make([]Hole, n)
+		for i, k := range group {
+			_, villains[i] = unkey(k)
+		}
+		for i, e := range equities(hero, villains) {
+			equity[group[i]] = e
+		}
+	}
+
+	var sums [Classes * Classes]float64
+	var counts [Classes * Classes]int
+	for _, h := range holes {
+		for _, v := range holes {
+			if !valid(h, v) {
+				continue
+			}
+			k, swapped := canon(h, v)
+			e := equity[k]
+			if swapped {
+				e = 1 - e
+			}
+			i := int(ClassOf(h))*Classes + int(ClassOf(v))
+			sums[i] += e
+			counts[i]++
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "package poker")
+
+	var classes []byte
+	for i := range sums {
+		e := quantize(sums[i] / float64(counts[i]))
+		classes = append(classes, byte(e>>8), byte(e))
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "// preflopClasses holds the equity of every class against every class, hero major, in 16-bit big-endian")
+	fmt.Fprintln(bw, "// fractions of 65535.")
+	writeConst(bw, "preflopClasses", classes)
+
+	var combos []byte
+	for _, k := range sorted {
+		e := quantize(equity[k])
+		combos = append(combos, byte(k>>16), byte(k>>8), byte(k), byte(e>>8), byte(e))
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "// preflopCombos holds the equity of the first hole of every matchup key, sorted by key, in records of the")
+	fmt.Fprintln(bw, "// 24-bit key followed by the 16-bit equity, both big-endian.")
+	writeConst(bw, "preflopCombos", combos)
+	return bw.Flush()
+}
+
+// equities returns the exact equity of hero against every villain. Every board of the 50 cards left by the hero is
+// dealt once, and played for all the villains that can face it.
+func equities(hero Hole, villains []Hole) []float64 {
+	masks := make([]uint64, len(villains))
+	for i, v := range villains {
+		masks[i] = 1<<uint(v[0].Index()) | 1<<uint(v[1].Index())
+	}
+	var rest []deck.Card
+	for _, c := range deck.New() {
+		if c != hero[0] && c != hero[1] {
+			rest = append(rest, c)
+		}
+	}
+
+	wins, ties := make([]int, len(villains)), make([]int, len(villains))
+	boards(len(rest), func(b *[5]int) {
+		var board hand
+		var mask uint64
+		for _, p := range b {
+			board.add(rest[p])
+			mask |= 1 << uint(rest[p].Index())
+		}
+		h := board
+		h.add(hero[0])
+		h.add(hero[1])
+		hv := h.value()
+		for i, v := range villains {
+			if masks[i]&mask != 0 {
+				continue
+			}
+			h := board
+			h.add(v[0])
+			h.add(v[1])
+			switch vv := h.value(); {
+			case hv > vv:
+				wins[i]++
+			case hv == vv:
+				ties[i]++
+			}
+		}
+	})
+
+	eq := make([]float64, len(villains))
+	for i := range eq {
+		eq[i] = (float64(wins[i]) + float64(ties[i])/2) / Boards
+	}
+	return eq
+}
+
+func quantize(e float64) uint16 {
+	return uint16(e*65535 + 0.5)
+}
+
+// writeConst writes data as a string constant, split over lines.
+func writeConst(w io.Writer, name string, data []byte) {
+	fmt.Fprintf(w, "const %s = \"\" +\n", name)
+	for i := 0; i < len(data); i += 32 {
+		j := i + 32
+		sep := " +"
+		if j >= len(data) {
+			j, sep = len(data), ""
+		}
+		fmt.Fprintf(w, "\t%s%s\n", strconv.Quote(string(data[i:j])), sep)
+	}
+}
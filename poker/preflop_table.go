@@ -0,0 +1,9142 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package poker
+
+// preflopClasses holds the equity of every class against every class, hero major, in 16-bit big-endian
+// fractions of 65535.
+const preflopClasses = "" +
+	"\x80\x00\xaao\xa8ާP\xaa4\xac\x92\xab\x99\xab\x11\xaag\xaa\xe5\xabe\xab\xe7\xa8\x7f\xa0\xbe2\x1a\x87>" +
+	"\x85\xe1\x87\r\x86\xf9\x8a\r\x88\xb8\x88&\x88\xa2\x89 \x89\xa0\x88\x0e\x9fK\x80\xae0|\x82\u0083\xf0\x83\xdf" +
+	"\x86\xf6\x88\xb4\x87<\x87\xb8\x886\x88\xb6\x87$\x9d\xdb\x7fk|\x8d/,\x80ӀŃޅ\x9f\x879" +
+	"\x86χM\x87͆;\xa0{\x80\x8b}\xb0z\xd3/7}K\x80\x83\x82F\x83䆌\x86#\x86\xa3" +
+	"\x87٢\xa2\x80}}\xa5z\xcaw\x8c.k}\x1b~ဂ\x83,\x85ׅp\x86\"\xa1\xb9\x83Y" +
+	"\x80\x84}\xabz\x8cwa.\xfb|\xef~\x91\x81?\x83솘\x86c\xa1:\x82\x1e\x82\x1e\x7fH|," +
+	"y\x03w7.\xa3|]\x7f\r\x81\xbd\x84l\x87I\xa0\x9a\x81\x96\x80À\xc3}\xa9z\x83x\xbav\xaf" +
+	".l{\xd1~\x84\x816\x84\x16\xa1\n\x82\x05\x812\x80_\x80\x1a|\xf7{0y(v,.j\x7fj" +
+	"\x82\x1c\x84\xfc\xa1{\x82u\x81\xa2\x80\xcf\x7f\xb8\x7fj}\xa6{\xa1x\xa7yz.x\x83\x02\x85\xe2\xa1\xef" +
+	"\x82\xe8\x82\x15\x81B\x80+\x7f\n\x80\x1c~\x19{\"{\xf4|\xc7.\x8f\x86Ȟρe\x80\x92\x7f\xbf" +
+	"\x81L\x7f\xb1\x7f\xf0\x80\xc2}\xce~\xa1\x7fs\x80E-\x86U\x90\x7f\xffw\xdbf\xc8_\x0fY\x1bR\xc8" +
+	"N\xf8KuI0G\x96FV@\xd2y\x8f\x1b\xa4s\xc8d?[\xceR\x9fP\xcaLQH\xb5FX" +
+	"D\xa5CL=\xbaq\x9fn\\ 5d\xfc`\r[\xc7Z\xc9Y\xb5W\xfeWhW\x1eW\x02S:" +
+	"a\xea`\x11_\xee\x1e\xf9]\xd3ZXY{XjW\xfbW)V\xdeV\xc2R\xfbZ\x99X\x17[q" +
+	"Yg\"xX\x18W\xd5W\xc8W\\W\xd2WKW/W4T\xebOjW\x81V1S\xf3#\xcd" +
+	"SGV\x16U\xbcV4V\xf6V\x9dU\xe3N\xfbM\xa4V\x9bUjS\xbbO_$$UmUC" +
+	"U\xc7V\x8cW|V\x84K]IhU\xa2TtS\xb5R\x03Qi$\xecT\xf5U\x9bVcWV" +
+	"W\xa7H\aE\xf9T\nT\nSNQ\xadQ@P\xf9$\xb6T\x02T\xf3U\xeaVcE\xdbC\xb5" +
+	"S}SES\xb7R\x19Q\xb6Q\x8fP\x15$\xa1U0V&V\xa0DOB\x11S5R\xfeS8" +
+	"R\xc8RhRDP\xf1Q($\x9dVcV\xdcC\x18@\xc2S\x19R\xe2S\x1cRuSCS\"" +
+	"Q\xd2R\tR@$\xa2W\x19>\x0f;\xacO\x8bOTSIQ\xe8R\x7fS\x8cRaR\x98R\xcf" +
+	"S\x06 \xbbW!\x88$\x7f\xffg\x12_qZcT\x10O!L\xa0J[H\xc1G\x81A\xf9\x81`" +
+	"Lev\x97q=j\xb5f\xfbe\xfab\x8aa\xf6aaa\x16`\xfa]\xbcy\x90p~\x1b\"bW" +
+	"[\x10R>PsLPI$F\xc8E\x15C\xbc>*b7k9^U\x1f\\]\x06Z\x11Y5" +
+	"X\x8dXiW\x96WLW0ShZ\xfde?WoX\xb3#\x01X\xbbXzW\xecW\xefXe" +
+	"W\xdeW\xc2W\xbeV\"a\xd7O\x18V\x00T\x9a%@VvV9W\x14W\x8dXNW\xf6W;" +
+	"P2`\xefM\\U:TcRf%\x99U\x91V\x9cW W\xe4X\xd4W\xddK\x84]\xb8Il" +
+	"T\x96S\xd7R%Q\x8c$\xeeU\x19U\xbfV\x87WzW\xcbI\x1c]-FeTzS\xe0R\xf6" +
+	"R\x89Q\x1b%\xd7U\fU\xfdV\xf4WmF\xf0\\\xa0D!S\xb6TJSbR\xffQ\xb1Q\x0e" +
+	"%\xc3V:W1W\xaaEd\\XB}SnS\xcbT\x11S\xb1RgQ\xe9R %\xbfWm" +
+	"W\xe7D-\\<A.SRS\xafS\xbeT\x8cSDR\xcaS\x01S8%\xc3X#? Y2" +
+	"<\x18O\xc4S\xd4S1S\xc8S\xaeSYS\x90S\xc7S\xfe!\xddX\xaf\x997\x98\xed\x7f\xff_\xbe" +
+	"Z\xc4TqPdL\xc3K\x81I\xe7H\xa7C\x1e\x91\x15M\xe1}\xf7v\x97k\x10gjfnc\xcd" +
+	"b\x19bjb b\x04^Ő\xd6wXKvs\xd9g\xcdd(c,c\xa9a(aya." +
+	"a\x13]\xd4y\x90p\x7fm\xf7\x1a\xb8Y\x88Q\x91P\x11K\xf9I$G8E\x85D,>\x9a[M" +
+	"e\x9db\x96V\n#dX\aW\xa5W\xc6X\rX\xceXGX+X&V\x84bI_BN~" +
+	"S\xfd%\xc8VgV\xd9W2X\x1bX\xdcX\x84W\xc9P\x95ad^^M\tS\xa8Rb&!" +
+	"V1V\xbbW\xaeXrYbXkL\xb6^\xec^\xd5I\"S\xc4R\xc9R0&bU8W\x13" +
+	"W\xdbX\xceY\x1fI=]O\\{FhS\xfdS\x13R\xa6Q8%\xd9U+V\x1cW\x13W\x8c" +
+	"H\x00]\x97\\\xc3D\x8cT\xb5S\xefS\x8dR\xf5Q+&\xe4W?X6X\xafFu]O\\{" +
+	"B\xe8T6T\x9eT?S\xabR\aS\x14&\xe0XsX\xecE=]3\\_A\x99T\x1aTK" +
+	"U\x19T\x88R\xe8S\xf5T,&\xe4Y)@1Z)YU<\x82T?S\xbeTUT\xf2Sw" +
+	"T\x84T\xbaT\xf1\"\xfeUˠ𠎠A\x7f\xffVtP\x10L(IiG\aFpE/" +
+	"C\xe7\x98\x1bM\x9d\x85!\x84\xc2v@c\xbab\xb7`<_M^~_\x1b^\xff\\q\x97\xc5}\xd0" +
+	"K\x1d\x80\xe2sz`t_p`\x0e^\\]\x8d^)^\r[d\x97\x83}|y\xddH\xb9l\r" +
+	"]2\\/\\\xcc^8\\\x9d]9]\x1dZsy\x90p\amwf\x98\x15\x03K\xc0J\xdfG\x01" +
+	"D6B\xa0A_@\x06>\xa5R\x88^\xc0[\xb5X\xafI\x18 {Q\x1aQ\x8eR\x96S\xc3T\xcf" +
+	"TvTdL\x89]\xd5Z\xcbW\xc4H7Mt \xd4P\xe6R\x1fSVTeUUU\x06H\xcc" +
+	"[~[`XYD\x87M\xdeME!;QaR\xbbS\xf3T\xe5U\xe0F$Z\x98Y\xc4Y\xad" +
+	"A\xd6N\xd0NdM\xad!\x9dP\xf9S\x1fT\x16U\x13C\xd6Y\xceX\xfaX&@FO\xddOz" +
+	"N\xe3M;!6S\rT\x04U\x01C9Z\\Y\x88X\xb4?\vP\xdaPzP\bO=O#" +
+	"\"RU'V$B\x02Z@YlX\x98=\xbbP\x86QUP\xe6P\x1eP\x04Q\x11\"VVa" +
+	"@\xc4W\xe3V\xf6V\"<dPtQ\vQ\xcaQ\x06P\xecQ\xf9R0\"ZSm\xa6䥜" +
+	"\xa5;\xa9\x8b\x7f\xffL5H<E\xa2D\"BkB-?\xfd\x9d\x8cMȉe\x88\xf1\x8c\x11u\xf2" +
+	"_\xdd]w\\\xae\\\xa5\\!\\\xecZ*\x9cb\x81\xa6K1\x85\x0f\x88/s%\\\x97]-[\xa0" +
+	"[\x97[\x14[\xdeY\x1c\x9c\r\x81@}\x9fH\xba\x84Uk\xb3YQY\xe7[sZ\xa7Z#Z\xee" +
+	"X,\xa0\r\x84(\x80\x87|\xecG\x13a\xc6W\x17W\xadY9[\x8aZ:[\x05Z\xb3y\x90o\xa1" +
+	"m\vf&\\\xe7\x10\x99FRB\xe9@H>\xbd=\xd1<\xe9:\x7fH\xf9[\x1aX\x10U\x06R\xe7" +
+	"C\xfc\x1c\xb8L^M\xa0O\x87P\xffR9Q\x11E,X\xd7X\x9eU\x94Sv@\xb9I\x0e\x1d\x1f" +
+	"M\bO\x11P\x8dQ\xcaQ\xebB\xa6X\x12W%W\x06T\xe8>0J7I\xa2\x1d\xa7N\x14O\xb9" +
+	"Q\x1fQCA(W\xffW\x12V>W\x0e<\xa9K\xf5K\x80J\x8f\x1e+O\xa7Q\xd3Q\xf7?z" +
+	"W{V\x8dU\xb9U\xcd;\xbaMHL\xd6L\vK\xf1\x1d\xd5Q\xc1Q\xe5?2X5WGVs" +
+	"V\x87:\xd3NpN\x01M\\M\xf9M\xdf\x1e\xf8S\b=\"U\xa7T\xb9S\xe5VB8\x8fMh" +
+	"N'M\x84N!N\aO\x14\x1e5Tf\xad7\xab龜\xaf\xef\xb3ʀ\x00G\xe9E>C\xe3" +
+	"C\x0eA\xb0@\x82\xa3xP\v\x8e\xbc\x8dy\x90_\x92\vxv^{]\xab]\xc8^\n]\xb5[\xd9" +
+	"\xa2O\x86\xa4M]\x89\x97\x8c}\x8e(u\xa8^1\\\x9d\\\xba\\\xfc\\\xa7Zˡ\xf9\x85\x7f\x81\xde" +
+	"Jӈ\xa0\x8aLl\x8aZ\xeb\\p[\xc9\\\v[\xb6Yۦ\t\x881\x84\x90\x80\xf3H\xf6\x86l" +
+	"cCX\x88Z\r\\~[\xfd[\xa8\\[\xa9\x9e\x89\xbb\x86\x1a\x82}~\xd3FeY\x12UKV\xd0" +
+	"YB[\xdfZ\xbdZ\xb9y\x90q\xf9obf\xf2^MT\xcb\x11\x0eB\xa3@_>\xed>\f=z" +
+	";\x81D\xe8Y\xd0Y\x98V\x8eTIQ>@z\x1d\x80K\xefN\tP3Q\xdaREBRY\x05" +
+	"X\x18W\xf9U\xb4R\xa9>IH\xa6\x1e\aM1O\x85Q/Q\x9d@\xf6Y\x14X'WSW\xfa" +
+	"T\xee<\xdbJ\x95I\xc6\x1e\xb1P9Q\xe3Rw@\x18YGXYW\x85WwWZ;\xf5L\x93" +
+	"K\xeaL\x87\x1fFQ\xd2S+>\xbfX\xeeX\x00W-W\x1eVE;ZN\x11MkN\bM\xee" +
+	"\x1e\xf8S\x19=\x9eW6VIUuW\xcbVJ9~N\x84M\xe2N\xa1O>O$\x1fTT\xee" +
+	"\xb1\a\xb0ޯ\x9b\xb3\u05f7ø\x16\x80\x00DvC\vB[A\xdf?\x91\xa7\x17PS\x90\x83\x8f@" +
+	"\x92'\x93ٗ\xddw\xe4\\\xd4\\\xe9]Q]\xc1ZƦ\xef\x88DO\x04\x8c\xa7\x8eȐU\x94X" +
+	"w\x8f\\\xd1\\\xe6]N]\xbeZåʇ \x84\xb9Lf\x8a\xeb\x8cx\x90{np\\\x88[\xd9" +
+	"\\A\\\xb1Y\xb6\xa9\xb8\x89ц\xb5\x83\x18Jx\x88\x96\x8c\xb6c\xf3Z$\\\x8e\\2\\\xa3\\7" +
+	"\xad]\x8bb\x88$\x84\x87\x80\xdbGو\xe9YpV\xe3YN\\\v[\xb8Z\x94\xad\xae\x8f$\x8b\xe6" +
+	"\x88I\x84\xb8\x81!F\xcfQwT\xc7W2Y\xef\\\xbaZ\xc9y\x90qtq!h\xb1^\xecU " +
+	"M\xb0\x10\x7f?->\x13=;<\xb3;\x10A\xa2XHXHX\x0fU\xcbR\xbbP\xc2=0\x1dJ" +
+	"K'M\x93O\xecP\xc3@6XPXPWbX\tT\xfaS\x01<\x15G\xea\x1d\xf4NlP\xc5" +
+	"Q\x9c?{X\xa5X\xa5W\xb7W\xa9W\x85U\x8c;7J%J\xe5\x1e\xaeQyRP>\xf1Y\x03" +
+	"Y\x03X\x15X\aW.X$:\xa6LOM\x0eM\xab\x1fKR?<\xbfV9V9UKW\xa1" +
+	"V VY9\x16M\x17M\xd7NsNY\x1e6U\x98\xb4\x8a\xb3_\xb3<\xb6\x96\xba]\xba\xc1\xbb\x89" +
+	"\x80\x00B?A~A'?\xe1\xaamP\xe5\x91\x18\x90\xf5\x93\x15\x94\xa2\x98\xad\x98aw\xe4\\K\\\xac" +
+	"]B[2\xa9X\x88\xd2OZ\x8d\x80\x8f\xa0\x91-\x958\x98<v\xfd\\\v\\k]\x01Z\xf1\xa95" +
+	"\x88\xaf\x85}N\x1c\x8d\f\x8dԑ\xb9\x94\xbdpW\\\t\\i\\\xffZ\xef\xacR\x8a\xab\x87y\x85\x17" +
+	"L\x1d\x89\xf2\x8d\xf3\x90\xf7ew\\\xa1\\>\\\xd4]S\xafՌ\x19\x88\xe8\x85ς$Iq\x8a$" +
+	"\x8d(Z\x11Ya\\\x17[\xe9[\xb0\xb06\x8f⌰\x89v\x85\xe5\x82LH\\\x8a\x7fQ\xd5WA" +
+	"Y\xf6\\\xe2[\xe5\xb0\U0008f80f\x7f\x8cE\x88\xb5\x85\x1b\x82\x9aFiJ\x8aT>V\xf4Y\xdf[\xf8" +
+	"y\x90qtp\x9cjq`RU\xb2N\x05G3\x10\x1f<\xc2<S;\xd4:;?\x84W\xc8W\x90" +
+	"W\x90X\x1dU\x0eS\x11PB:\xdf\x1dIK\xc5N6O\xd9>\xb8X\x16W\xdeW\xdeW\xb6W\x92" +
+	"U\x95R\xc6:eHz\x1e\x03O\x10P\xb2>QX\x96X_X^X6W]XLU}9\xdc" +
+	"J\xbbKz\x1e\xc6Q\x8b=\x11V\xa5VmVmX\xaaW(WbWz8VLGM\x06M\xc5" +
+	"\x1e\xc1U\x1a\xb6ϵ\xa4\xb4~\xb8\xf8\xbbݼ\x1c\xbc\xf4\xbd\xc0\x80\x00@\x85@.>笗PM" +
+	"\x91\xad\x90\xa4\x93䔫\x98\x91\x98L\x98\xeaw\xe4[\xcd\\dZT\xab\x82\x89\\N\xac\x8d/\x90o" +
+	"\x916\x95\x1c\x98'\x97\xdfv\xfd[\x8d\\#Z\x13\xaaq\x88f\x854M5\x8c\xff\x8dǑ\xac\x94\xb7" +
+	"\x97\xbfo\xc5[N[\xe4YԮ\x91\x8bd\x882\x85\x04L\x99\x8b.\x8ej\x91O\x94XgK\\." +
+	"\\\xc4]B\xb1D\x8c\x1d\x88녽\x83LIފ\x9a\x8d\x80\x90\x88[F[\xe9[\xbc[\x83\xb1\x82" +
+	"\x8fÌ\x91\x89d\x86X\x82\xbeH\xbf\x8aՍ\xddRhY\xc9\\\xb5[\xb8\xb2O\x8f\x88\x8fg\x8c9" +
+	"\x89\v\x85r\x82\xefFĊ\xfcJ\xe8V\xc3Y\xae[ʳ\x11\x90\x1f\x8f+\x8f\x0e\x8b\xe0\x88F\x85\xc3" +
+	"\x83\fD\xc3D\xb2R\xdbU\xc6W\xe3y\x90qsp\x9ci\xebb\x00V\xceN\x89G\x87A\xb8\x0f:" +
+	";\xe1;c9\xca=\xd2WHW\x10V\xd9W\x9eW`UdR\x91N\xee9\xfc\x1c\xe4N)O\xcb" +
+	"=jW\xc8W\x91WYX\x1eW+X\x1aUGQ\xa49sJ\xa4\x1d\xa7P\xa5<*U\xd7U\x9f" +
+	"UhX\x92V\xf7W0WIS\xa67\xedL0L\xef\x1d\xa2T\x9a\xb8i\xb7>\xb6\x18\xb9\x8f\xbd\x94" +
+	"\xbc\U0007d93e\x81\xbfz\x80\x00?0=\xea\xae!O\xb3\x91\xf8\x90\xee\x93H\x95/\x98O\x97䘉" +
+	"\x99gw\xe4[\x81Yq\xad\f\x89\xa0M\xfc\x8dy\x8fӑ\xba\x94ڗ\xbf\x97~\x98]v\xfd[A" +
+	"Y1\xab\xfb\x88\xaa\x85xLr\x8cc\x8eJ\x91j\x94P\x97_\x97Wo\xc5[\x02X\xf2\xaf-\x8a\xd5" +
+	"\x87\xa3\x84vK\x9f\x8a֎\x12\x90\xf7\x94\a\x97Of\xba[\xa4\\#\xb2⌐\x89^\x860\x82\xf4" +
+	"JK\x8b\x8b\x8d\xab\x90\x95\x93\xdd\\\xf5[\xa7[o\xb2P\x8f\x80\x8cO\x89!\x86\x00\x83\xa1I\x1f\x8b\x00" +
+	"\x8d\xea\x912S`\\\x83[\x87\xb2\xfb\x8f#\x8f\x02\x8bՈ\xb3\x85\x9f\x83\x1cG\x1c\x8b\x06\x8eNKm" +
+	"Y}[\x99\xb3̏\xc1\x8e͎\xb0\x8b\x8e\x88W\x85Ճ\x1bE\x16\x8avE\x10U\x91W\xad\xb4\xb1" +
+	"\x90\x8c\x8f\x97\x8e\xa7\x8e\x96\x8b_\x88݆#\x82\x88D\xdcA\xf1U\xceW\xeay\x90qrp\x9bi\xeb" +
+	"azXYOlG\xffB\f?\x17\x0ep:\xf29Y<\x80V\xf6V\xbfV\x87W\x15W\x10W\xe5" +
+	"U\x12QkQ\xa29\v\x1c\x88O\xbe;@U\x05T\xcdT\x96W\x89V\xdbV\xfaW\x13SlS\xa3" +
+	"7\x85L\x19\x1c\x84T\x18\xb9\xa9\xb8~\xb7X\xbaнҾO\xbe \xbeؿ\xd1\xc0π\x00<\xe8" +
+	"\xafVO\x17\x92\x13\x91\n\x93d\x94d\x98\xa4\x97s\x97\xf3\x98љ\xb4w\xe4X\x8b\xaeA\x89\xb9MJ" +
+	"\x8d\x95\x8f\xef\x90\xef\x95/\x97O\x96\xe8\x97ǘ\xa9v\xfdXK\xad1\x88Å\x91K\xac\x8c\x7f\x8d\x80" +
+	"\x91\xbf\x93ߖɖ\xc1\x97\xa3o\xc5X\v\xb0c\x8a\ue1fc\x84\x8fJɊ\v\x8eg\x90\x87\x93q" +
+	"\x96\xb9\x96\xb5f\xba[=\xb3*\x8bֈ\xa4\x85v\x82:IA\x8b\x04\x8d$\x90\x0e\x93W\x96\xa3\\c" +
+	"ZK\xb3\x9a\x8fȌ\x97\x89i\x86H\x83\x1dI\x7f\x8b\u008d\xc1\x91\t\x94VT\xd7[n\xb3u\x8e\xb6" +
+	"\x8e\x94\x8bg\x88F\x85\x1b\x83\xd3Gs\x8aݎ%\x91rL:[d\xb4$\x8f1\x8e=\x8e \x8a\xfe" +
+	"\x87ԅ\xb4\x82\xfbEg\x8aK\x8d\x98E\x87Wx\xb5\t\x8f\xfc\x8f\a\x8e\x17\x8e\x06\x8a܈\xbc\x86\x03" +
+	"\x82fE*\x8ezBOW\xb5\xb5\xf1\x90ʏ֎\xe6\x8e\x02\x8d\xe8\x8bȉ\x0f\x85r\x86@E\x1b" +
+	"?\xddW\xf2y\x90qrp\x9bi\xeaayW\xd4P\xc5H\xbaBv?k=\x16\r\xc18\xe7:R" +
+	"T/S\xf7S\xc0V\xb3U\xceV\xdbV\xdaS3SjS\xa17\x1d\x1beW\x80\xbf-\xbe\x06\xbc\xe1" +
+	"\xbc\x18\xc0\x02\xbf}\xc0n\xc0\x1e\xc1\x18\xc2\x15\xc3\x17\x80\x00\xb4\x9cOÔÓ\xb9\x95Y\x97\xa0\x9a\xf9" +
+	"\x9a\xe9\x9a}\x9b[\x9c>\x9d$x1\xb3\x8b\x8cMM\xe0\x90D\x91\xe9\x94/\x97\x88\x9așw\x9aU" +
+	"\x9b7\x9c\x1dwQ\xb2{\x8bW\x88%L/\x8ey\x90\xc0\x94\x18\x97X\x99W\x99O\x9a1\x9b\x18p\x1f" +
+	"\xb1\x9f\x8c҉\xa4\x86vL\x96\x8a\x1c\x8e)\x91i\x93h\x96\xb0\x96\xac\x97\x93iҵE\x8e\xf4\x8b\xc6" +
+	"\x88\x99\x82IL+\x8b\xa2\x8e\xe2\x90\xe1\x94)\x97u\x97v_\xe7\xb4ǒ\x13\x8e拸\x86\x16\x83\xbf" +
+	"L8\x8c\xa4\x8e\xa3\x91\xeb\x957\x98\x88Wɵ\xa4\x92\x03\x91厸\x89\x16\x86\xbf\x84\xabK\x98\x8c\x1d" +
+	"\x8fe\x92\xb1\x96\x02P\xeb\xb5a\x91\xa6\x90\xb6\x90\x99\x8a\xf7\x88\xa0\x86\x8c\x846I\x85\x8b\x8a\x8eג'" +
+	"I\xc0\xb6F\x92q\x91\x81\x90\x90\x8d\xff\x8b\xa8\x89\x94\x87>\x83\xa1IF\x8f\xb9\x93\tF;\xb7.\x93@" +
+	"\x92O\x91_\x8d\xfa\x8e\xb4\x8c\xa0\x8aJ\x86\xad\x87{I7\x93\xf0C\xbf\xb8\x1b\x94\x12\x93\"\x922\x8e\xcd" +
+	"\x8e\xb4\x8f\xb1\x8dZ\x89\xbd\x8a\x8c\x8b^IXBZy\x90q\xd7q\bj\\dW[\x1cS\x89M\x18" +
+	"FdC\x11@\xb2?T\x11^_A\x86p~\x9fn\xeag\xe4bs\\\x87X\xe8U\x92ShQ\xde" +
+	"P\xa9Kc\x80\x00(\x9f{Vm\teZ\\\xea[#V\xe6SxQ5O\x93NEH\xf1x\xaf" +
+	"u\x9f+\bmci\x06e2dhcma\xd3aHa\x04`\xeb]IjNh\x85f\x81)\xdf" +
+	"f\xeac\xd2c&b-a\xd0a\f`\xc7`\xae]\fc\xacaJbk`t- a\xada\x90" +
+	"a\x87a,a\xa5a&a\ra\x1f^zYR^\xcc]\x85[h.Z]L_\xe6_\x9c`\x17" +
+	"`\xd6`\x83_\xd9X\xecW\x98^\x0e\\\xe4[MW+.\xb2_E_(_\xae`oaY`u" +
+	"U{S\x94]&[\xfe[JY\xb0Y\x1b/i^\xd2_w`<a(a\x82ROPQ[\xa3" +
+	"[\xa2Z\xf0YgX\xfcX\xae/6]\xf0^\xdb_\xcb`IP;N%[\x1eZ\xe8[^Y\xd6" +
+	"YuYEW\xd7/\"_\x14`\x05`\x83N\xbfL\x91Z\xdbZ\xa5Z\xe5Z\x85Z'Y\xfaX\xb0" +
+	"X\xe6/\x1e`?`\xbdM\x92KLZ\xc2Z\x8cZ\xccZ6Z\xffZ\xd5Y\x8eY\xc4Y\xf9/\"" +
+	"`\xf7H\xc5FsWOW\x19Z\xf4Y\xa9Z<[:Z\x15ZKZ\x80Z\xb6+}\xcd\xe5\xe4[" +
+	"\xb3\x9a\xb2\x1e\xb2b\xb27\xaf\xf4\xaf\xac\xaf\x1a\xaf\xb2\xb0L\xb0\xe8\xb0<\xd7`\x80\x00\xa6s\xa4\xfc\xa8\x01" +
+	"\xaa\x86\xac\xfe\xac \xab\x8f\xac&\xac\xbe\xadY\xa9\xff\xaa\x80\x9d%0\x9d\x85\xf1\x87O\x88\x06\x88\n\x8b7" +
+	"\x89Պg\x8a\xfb\x8b\x91\x8a\x15\xa9\x1f\x9b\xca\x7f}/M\x842\x84\xeb\x84\xf3\x88\"\x89҉~\x8a\x12" +
+	"\x8a\xa8\x89-\xa9a\x9e\x89\x80\xcd}\xf0/}\x81\x8d\x81\x97\x84Ɇ}\x89;\x88\xe9\x89\x7f\x8a\xe6\xa9<" +
+	"\xa0Ӂ\x82~\xa7{\x85/\xa7~1\x81\x81\x837\x85\xf8\x88\xb9\x88i\x890\xa7\x1c\xa3\x13\x81\x8b~\xb3" +
+	"{\x93xh/\r~\x1a\x7fӂ\x97\x85Z\x88\x1c\x87\xfd\xa6עE\x84~\x81\xa8~\x8c{\x7fxS" +
+	"/\xc7}\xe0\x80\xa7\x83m\x862\x89%\xa6O\xa1\xbc\x838\x837\x80\x1d}\x13y\xeax\x1f/\x90}k" +
+	"\x804\x82\xfc\x85\xf2\xa6٢C\x83\xbb\x82肣\x7f\x9c|uz\xacw\xb0/\x8e\x81\x1a\x83\xe2\x86\xd8" +
+	"\xa7d\xa2̄@\x83m\x82V\x82$\x7f\x00}:z@{\x13/\x9c\x84Ǉ\xbe\xa7\xf2\xa3W\x84\xc8" +
+	"\x83\xf5\x82݁\u0601\x8a\x7f\xc6|\xd0}\xa2~t/\xb3\x88\xa4\xa7K\xa0D\x83Y\x82\x86\x84.\x82\x94" +
+	"\x81s\x82\x85\x7f\x90\x80c\x815\x82\b.\xaax\xc1\x8c7\x89h\x82\bz\xdev\x9aqCo|n\xe7" +
+	"nRn\am\xeck<\x84\xa9Y\x8c\x80\x00ga_\xc2Z\xb5W1R\xafP-M\xe9LOK\x0e" +
+	"E\x86\x82#y\x95\x1f\xdfc\xc4]#WwP\xbcP\xbaM\x92K8I\x88H1B\x99z\xe4b\xa5" +
+	"_\xd1 \xba_\xfb]\x86[\xbc\\[\\6[c[\x19Z\xfdW5tN[mY\x80[\x8c${" +
+	"\\F[\x01[\xb9[\xbc\\2[\xab[\x8f[\xa0paV\x92T\x15YTX\x03'gX\xfdZ\x1c" +
+	"Z\xf7[p\\1[\xd8[\x1ekFS\x1eM\xa4W\xacV\xd6T\xd8(\xeeU\xb6YKY\xd0Z\x94" +
+	"[\x84Z\x8ci\x9fN\xdbM\x8eXCW\x84U\xe7Q\xaf)oX\xb9Y_Z'[\x1a[ki\x15" +
+	"LsJ\x8aX'W\x8dV\xb7U\vT\x86*XX\xacY\x9dZ\x94[\rh\x87JGHIWb" +
+	"W\xf6W#U\x82U\x1bTx*DY\xdaZ\xd1[Jh?H\xbbF\xa8W\x1aWwW\xd2V4" +
+	"U\xd1UTU\x8b*@[\r[\x86h#G\x84E[V\xfeW[W\x7fW\x0eV\xaeV5Vl" +
+	"V\xa3*D[\xc3e\xa4Bw@>SpW\x95V\xf2VJW\x19V\xc4V\xfbW2Wi&^" +
+	"z\x1e\x9b\xc0\x8e\u0089h{=w\x0er\x86p\xbfo\no[o\x11n\xf5lF\x92\xf6[\x03\x98\x9e" +
+	"\x80\x00`\v[\x12XtS\xf1PQO\x0fMuL4F\xac\x87\x03\x90\xacM\x8dv\xcdk6g\x97" +
+	"f_f\xfcd{d\xccd\x82dfa'\x82#y\x96p\xd9\x1fz[\x9bV\xcbP[PcM\x91" +
+	"K\xa8I\xf8H\xa2C\tt\xaf[\xb9e\xdfX\x1c$\xdd[\x92Z\xd9[\x93[\xdb\\\x9b\\\x14[\xf8" +
+	"\\\tp\xd6V\xf0b\x91SzWf'\xefY\x9bZ\xbc[\x15[\xfe\\\xbf\\f[\xaclyTP" +
+	"awMQV\xc1U{*`X\xe0Yj[#[\xe7\\\xd7[\xe0j\xd3P\rb\vMDWq" +
+	"V\x8aT\xb2*\xe3X\xd8Z\xb3[{\\m\\\xbfi6L\x94_\xb1J\x8dW\xaaV\xd4U)T\xa3" +
+	"*ZX\xcbY\xbcZ\xb3[,i~KW_\xfaH\xb3XaW\xb0V\xc6V`T\x95+eZ\xdf" +
+	"[\xd6\\Oi7I\xcc_\xb2G\x12W\xe2X_WwW\x15UqV~+a\\\x12\\\x8ci\x1a" +
+	"H\x94_\x96E\xc6W\xc6X\fXRW\xf3VRW_W\x96+e\\\xc8f\x9bC\x88\\\x8c@\xa9" +
+	"X\x00W\x7fW\x8eX]V\xe1W\xeeX%X\\'\x7fx\xf2\xa41\x95J\x94\uf27fs\xeeo\xa0" +
+	"m\xd8l\xeal\x1bl\xb7l\x9bj\xa6\x9a\xa5W\xfe\xa0=\x9f\xf4\x80\x00V\xc1T8O\xb6L\xf6J\x95" +
+	"I\xfdH\xbdGt\x8cޗ\x99M3\x84\xa1vsc\xe3b\xc0caa\xaf`\xe0a|aa^\xb3" +
+	"\x8c\x8e\x97Z}yJ\xcfmb`\xa2_\x7f`\x1fa\x8b_\xf0`\x8c`p]\u0082Ty\x96p^" +
+	"g\xfb\x19\xc9P\xfaK(KkH\xa3G\x10E\xd1D{C\x1dm\xd7R\xf5_\x04[\xfdN\x15\"\xa3" +
+	"TjUqVyW\xa6X\xb2XYXGi\xafPf]\xffZ\xf8H\x7fP\xa8%9T[U\x94" +
+	"V\xcbW\xffX\xefX\xa0h\tL$^\x96[\x90H\xa9Q\x9fP~%\xbcU\x01V[W\x93X\x85" +
+	"Y\x7fg#I{\\\xfb\\\xe3E\xfbR\x92Q\x9dQ\x17&\x1eT\x99V\xbfW\xb6X\xb3fYG-" +
+	"\\1[]DnS\x9eR\xb3RMP\xa5%\xb7V\xadW\xa4X\xa1f\xe7F\x90\\\xbf[\xebC5" +
+	"T\x9bS\xd5SsR\xa8R\x8e&\xd3X\xc7Y\xc4f\xcbEY\\\xa3[\xcfA\xe8THT\xb0TP" +
+	"S\x89SoT|&\xd7Z\x00d\xfaD\x1bZ)YU@\x94T5TfU5TpTVUc" +
+	"U\x9a&\xdby\x06\xad`\x99\x04\x98\x95\x9cE\x8a\rm\xf4l&k]kTj\xd0k\x9bh_\xa3\x15" +
+	"Uy\xa5J\xa4\xed\xa9>\x80\x00P\\K\xc9I/G\xafE\xf8E\xbaC\x8a\x900\x9c5MH\x88\xce" +
+	"\x8b\xefv#_\xe6`\x80^\xf3^\xea^g_1\\k\x8f͛\xe5\x81:Jш\x14m\x10\\\xa1" +
+	"]:^\xc7]\xfa]v^A[{\x93@\x9f\xe5\x84#\x80\x88I)c\xd2Zg[\x00\\\x8c^\xde" +
+	"]\x8d^X^\x1c\x82\x83y\x96o\xf6g\x90^\xf3\x15_F\x9cGTD\xb6C-BDA_>\xf7" +
+	"h\x10L\xd6[DX:V\x1bDD!\x1dO\xf8Q:S!T\x99U\xd3T\xabfcH\x83[\xd5" +
+	"X\xcbV\xacD\xdcLi!\xa0P\xa8R\xb1T,UiU\x8ae\x9fE\xfdZ[Z=X\x1eBU" +
+	"M\x92M\f\"(Q\xb4SYT\xbfT\xe3e\x8cD\x7fZHYtZE@\xd2OQN\xebM\xf9" +
+	"\"\xacSGUsU\x97e\bB\xd1Y\xc4X\xf0Y\x04?\xe5P\xa3P@OuO[\"VUa" +
+	"U\x85e\xc1B\x89Z~Y\xaaY\xbe?\x00Q\xcbQlP\xc6QcQI#yV\xa8b\xbe@y" +
+	"W\xecW\x18Y\x8d<\xbfP\xc3Q\x91P\xefQ\x8cQrR\x7f\"\xb6u\xf2\xaf5\x9a\x05\x99\x91\x9dH" +
+	"\xa0\"\x87\x89h\"gRgng\xb0g[e\x06\xa4\xdcS\x01\xa8Χ\x8b\xabǯ\xa3\x80\x00G\xe9" +
+	"E>C\xe4C\x0eA\xb1@\x83\x90\xfc\x9flMZ\x8a\x8f\x8d\x9b\x8f\xfbu\xd6]\xa2\\*\\F\\\x89" +
+	"\\3ZT\x90\x96\x9eG\x82\xc2JЉ\xbe\x8c\x1el\xbbZ\\[\xe1[:[|['YH\x94\x0f" +
+	"\xa25\x85\x98\x81\xfbI\x18\x88[cwX\x1eY\xa3\\\x14[\x93[>[薼\xa5ʇτ2" +
+	"\x80\xa4G|YIU\xcdWRY\xc3\\`[>[:\x801y\x96o\x90g#^\x80U\x02\x11\x10" +
+	"B\xf0@\xae??>a=\xd2;\xdbb\xb2D\xefY\x16V\fS\xeaQ\xb7@\xc5\x1d\x84L\x14N." +
+	"PYQ\xffRja\xe7BXW\xb0WwUUS\">\x96H\xca\x1e\fMWO\xaaQUQ\xc3" +
+	"a\xf6@\xfcW\xbfV\xd1W\x9aUh=+J\xb9I\xea\x1e\xb6P^R\bR\x9cb(@\x1fW\xf1" +
+	"W\x04W\x18W\xd4<HL\xb7L\x0eL\xab\x1fKQ\xf7SPa\xd0>\xc5W\x99V\xabV\xbfV\xbe" +
+	";\xafN5M\x90N-N\x13\x1e\xfdS>_\xa2=\xa4U\xddT\xefWdV\xc39\xd6N\xa8N\x06" +
+	"N\xc5ObOH\x1fYwG\xb3\xae\x9du\x9c2\x9fâ\x88\xa1\x84\x88\x1bg\x9eg\xb3h\x1bh\x8c" +
+	"e\x16\xa9\x19S߭P\xac\x0e\xb0I\xb46\xb8\x16\x80\x00D\xe7C|B\xcbBO@\x02\x94-\xa3\xac" +
+	"O\x85\x8eI\x90\x86\x92\xac\x94\\xY]*]@]\xa7^\x18[\x18\x93\b\xa2\x87\x86DL挩" +
+	"\x8eϐ\x7fn\xc9\\\xe1\\3\\\x9a]\vZ\f\x96_\xa6u\x88[\x84\xbeK\x1e\x8b\n\x8c\xb9de" +
+	"Z\xa3]\r\\\xb1]\"\\\xac\x98\xf8\xaa\x1a\x8a\\\x86\xbf\x83/IN\x88\xedZ\xc6X(Z\x93]O" +
+	"\\\xfd[٘\x05\xad\xb4\x8b\xea\x88N\x84\xbd\x81&F\xd3Q\xb1T\xecWWZ\x13\\\xdfZ\ue038" +
+	"y\x96q\xe7i\x0e_aVgM\xe9\x11\xa0@~?g>\x92>\r<lb6B\x11X\xa5Xm" +
+	"VJS\xf2P\xe6>l\x1elL2N\x9eP\xf7Q\xceb?@\xa5X\xaeW\xc0X\x89V0S%" +
+	"=SH\xe4\x1f\x16OwQ\xd0R\xa8b\x94?\xe9Y\x02X\x15X)X\xbbU\xb0<yK\x1fK\xde" +
+	"\x1f\xd1R\x84S\\b\xf2?`Y`XsX\x87XdXG;\xeaMIN\bN\xa5 nSJ" +
+	"_\xb1=.V\x92U\xa4X\x1aWVV}:]N\x11N\xd0OmOS\x1fXwٷJ\x9e\t" +
+	"\x9d栲\xa3Q\xa2T\xa3+\x88\x1bg\x15gvh\fe\x82\xac\x87Tp\xafү\xae\xb3\t\xb6\xd0" +
+	"\xba\xc1\xbb\x18\x80\x00B\xafA\xeeA\x98@Q\x94\xbb\xa6\x15Oڏ\"\x91_\x93\x84\x95;\x99Cw\xc8" +
+	"\\d\\\xc5][[G\x94\x98\xa5\xf2\x87\bN\x9d\x8eː+\x91\xbc\x95\xc4p\xb0\\b\\\xc3]Y" +
+	"[E\x979\xa9\x0f\x89\x1f\x86\xbcLÌe\x8d\xf7\x91\xfee\xe9] \\\xbd]S]ș\xb0\xac\x92" +
+	"\x8b \x88\b\x84wJ\xe6\x8a(\x8eL[gZ\xa6][].\\\xf5\x98ð<\x8c\xb5\x89{\x85\xea" +
+	"\x82QH_\x8a\x83R\x0fWeZ\x1b]\x06\\\n\x99\x8f\xb0\x91\x90{\x8dA\x89\xb1\x862\x82\x9fGp" +
+	"K\xc9UJW\xffZ\xeb]\x03\x80\xb8y\x96qbj\xce`\xc6V\xfaN>H^\x11?>\x16=\xa9" +
+	"=.;\x97a\xb6?\xf2W\xeeW\xedX\x9dVDS5Q<<\x1e\x1ekL\xd0OBP\xe4b\x04" +
+	"?'X<X<X6X\xc9U\xb9S\xc0;\xa6It\x1f&P\x1bQ\xbdb\x84>\xbfX\xbcX\xbc" +
+	"X\xb6X\x93XpVv; K\xb5Lt\x1f\xe8R\x97`\x1d=\x7fV\xc7V\xc7Y\"X_W\x85" +
+	"Xt9\x9cMAN\x00N\xbf\x1f\xe4w]\xb9\xa7\x9e\x9e\x9d\x95\xa1\x81\xa3Z\xa27\xa3\x16\xa3\xb4\x88\x1b" +
+	"f\x98g.d\xa4\xae\xcaSٲ\x16\xb0\xf0\xb5j\xb8P\xbc\x1b\xbc\x83\xbdP\x80\x00@\xf5@\x9e?X" +
+	"\x95E\xa8?O,\x8eђ.\x93\x8e\x95\x1f\x99.\x98\xe6w\xc8[\xe7\\}Zh\x94O\xa7/\x86\xbf" +
+	"M\xb6\x8e\xbe\x90\x1e\x91\xaf\x95\xbe\x98\xc7p\x1e[\xa7\\=Z)\x97\xf2\xabN\x89؆\xaaM?\x8d\xa1" +
+	"\x8em\x92W\x95_g\xbe\\\xac]B]\xb8\x99\xb3\xae\x01\x8b#\x87\xf6\x85\xa0KT\x8a\x9e\x8e\xa4\x91\xac" +
+	"\\\x9c].]\x01\\Ș\xa4\xb1\x88\x8c\x96\x89h\x86]\x82\xc3H\u008aٍ\xe1R\xa2Y\xee\\\xd9" +
+	"[ݙw\xb1\xed\x90c\x8d5\x8a\b\x86\x89\x82\xf4Gˋ=L'W\xceZ\xb9\\֚\x0e\xb2\xaf" +
+	"\x90'\x90\n\x8c܉^\x85ȃLE\xc9E\xf4S\xe6V\xd2X\ue038y\x96qbjHbt" +
+	"X\x15N\xc2H\xb2B\xe5\x10[=8<\xbc;&a6>@WnW6X\x1eX\x97U\x87S\x8b" +
+	"O\xe8;>\x1e\aO4P\xd7a\xb6=\xd9W\xeeW\xb7X\x9eXbX>VAR\x9e:\xb8K\x9e" +
+	"\x1e\xcaQ\xb0_O<\x99U\xf9U\xc1Y\nX-WTXBT\xa094M*M\xe9\x1e\xc5v\xdf" +
+	"\xbbZ\x9e\xe9\x9dߠ\xe4\xa3ޡ\xf5\xa2\xae\xa3S\xa42\x88\x1bfKc\xc1\xb0lSA\xb3\xb0\xb2\x8a" +
+	"\xb6\x02\xba\a\xbc\xf1\xbd4\xbe\x11\xbf\n\x80\x00?\xa1>[\x95\x89\xa9\xc9N|\x8f\x1c\x91\x92\x94\x11\x94\xdd" +
+	"\x98ǘ\x86\x99dw\xc8[\x9aY\x86\x94\x93\xa8\xb8\x87\x03L\xf2\x8e\"\x90\xa2\x91m\x95W\x98f\x98^" +
+	"p\x1e[[YG\x97c\xab\xea\x89I\x86\x1bLE\x8dI\x8e\x15\x91\xfe\x95\x0e\x98Vg,\\#\\\x98" +
+	"\x9a&\xaf\x9f\x8b\x96\x88i\x85HK\xc0\x8b\x8e\x8eϑ\xb9\x95\x01^K\\\xec\\\xb3\x98b\xb2W\x8cT" +
+	"\x89&\x86\x05\x83\xa6I#\x8b\x04\x8d\xee\x916S\x9a\\\xa8[\xac\x99\x12\xb2\x99\x8f\xfe\x8cщ\xb0\x86\xb6" +
+	"\x83!H#\x8bG\x8e\x8fL\xacZ\x88\\\xa5\x99\xb0\xb3k\x8fɏ\xac\x8c\x8b\x89o\x85ك[F\x1c" +
+	"\x8a\xb7FRV\x9cX\xb9\x9az\xb4O\x90\x93\x8f\xa3\x8f\x92\x8cw\x88\xe1\x86c\x82\xc8E\xe2C6V\xd9" +
+	"X\xf6\x80\xb7y\x96qajHa\xefY\xa1O\xa5I)C9@G\x0f\x91<K:\xb5`\xe4<\xee" +
+	"W\x1cV\xe5W\x95XFX\bV\fReR\x9c:O\x1d\xabP\xc9^};\xaeU'T\xefX\x01" +
+	"X\x11W\x1eX\rTfT\x9d8\xccM\x13\x1d\xa6v_\xbc\xb3\x9f\x05\x9d\xfb\xa1\x00\xa3\x13\xa2J\xa2>" +
+	"\xa2\xbd\xa3\x9b\xa4~\x88\x1bb۱\xbaR\xa6\xb4\xf1\xb3˷B\xbaE\xbeN\xbd\xb0\xbeg\xbfa\xc0^" +
+	"\x80\x00=Y\x95\xa2\xaa\xfeMʏ8\x91\xad\x93F\x952\x98V\x97\xf0\x98Ι\xb0w\xc8X\xa0\x94\xac" +
+	"\xa9\xee\x87\x1cL-\x8e>\x8fב\u0094\xe6\x97ЗȘ\xaap\x1eXa\x97|\xad \x89b\x865" +
+	"Ko\x8c~\x8ej\x91\x8e\x94x\x97\xc0\x97\xbcg,[\xb2\x99l\xaf\xe7\x8a܇\xaf\x84\x8eJ\xb6\x8b\b" +
+	"\x8eH\x912\x94z\x97\xc7]\xba[\x90\x98\xa9\xb3\xa0\x8c\x9b\x89n\x86M\x83\"I\x82\x8bƍő\r" +
+	"\x94YU\x11[\x93\x98\xa4\xb3\x13\x8f\x90\x8cc\x89B\x863\x83\xd8Hy\x8b\x1e\x8ef\x91\xb3My\\o" +
+	"\x99 \xb3Ï9\x8f\x1c\x8b\xfb\x88셹\x83:Fm\x8a\x8c\x8d\xd9F\xc8X\x83\x99괧\x90\x03" +
+	"\x8f\x13\x8f\x02\x8b\xf4\x88\xc1\x86B\x82\xa5F1\x8e\xbbC\x94X\xc0\x9a\xb9\xb5\x90\x90ҏ\xe2\x8e\xfe\x8f\x00" +
+	"\x8b͉N\x85\xb1\x86\x80F!A$X\xfd\x80\xb7y\x96qajGa\xeeY\x1bP\xfeI\xe5C\xa3" +
+	"@\x9b>H\x0e\xe2:D]\xa7:\xc0TQT\x19W+W\x04V\xffW\xd4T-TdT\x9b8d" +
+	"\x1c\x88w\xf1\xc2E\xa2C\xa1:\xa3\x8e\xa5դ&\xa59\xa4ͥ\xab\xa6\x8e\xa7t\x87η\x0eV\x00" +
+	"\xbay\xb9S\xb8\x8b\xbcu\xbf|\xbf\xfd\xbf\xae\xc0\xa7\xc1\xa4¦\x80\x00\x98\xc0\xb0HNa\x91\xe7\x94?" +
+	"\x96\x86\x97\x8b\x9bϚ~\x9b\\\x9c>\x9d$x\x17\x97ʯ8\x89\xb0L\xb0\x90Г\x17\x94\x1c\x98`" +
+	"\x9a^\x9aV\x9b9\x9c\x1fpp\x99\xf2\xae]\x8b܈\xafN\v\x8d(\x8e-\x92\x8c\x94\x8b\x97ӗ\xd0" +
+	"\x98\xb6k&\x9c\x14\xb2\x02\x8d\xff\x8aх/M\xa0\x8b\xa6\x90\x05\x92\x04\x95M\x98\x99\x98\x99a>\x9a\x7f" +
+	"\xb4Ύ닽\x86\x1b\x83\xc4L;\x8c\xa8\x8e\xa7\x91\xef\x95;\x98\x8cX\x03\x9b|\xb5B\x92Ᏼ" +
+	"\x8a-\x87ք\xb0L\x9e\x8c^\x8f\xa6\x92\xf2\x96CR*\x9b\x1f\xb5\x00\x91\xb2\x91\x95\x8c\x0e\x89\xb7\x86\x91" +
+	"\x84uJ\x8c\x8bˏ\x18\x92hK\x02\x9b\xea\xb5\xe4\x92}\x91\x8c\x8f\x16\x8c\xbf\x89\x99\x87}\x83\xe0JL" +
+	"\x8f\xfa\x93JG\x7f\x9c\xb9\xb6͓K\x92[\x8f\x12\x8fˌ\xa5\x8a\x89\x86쇻J=\x941E\x06" +
+	"\x9d\x8b\xb7\xba\x94\x1e\x93.\x8f\xe4\x8fˏ\xb5\x8d\x99\x89\xfc\x8aˋ\x9eJ^C\xa4\x80\x88y\x96q\xc9" +
+	"j\xb2e\x9b\\dS\xc2NCG\x92DAA\xe5@\x8a\x12\x7f`\xb4\x8e`\x86oo)h:c\x9d" +
+	"]\xb0Y\x10V\xa7T}R\xf3Q\xbeLt\x87PU\x7f}\xdcx\xfcs!o\xcfo\x03k\xd2kD" +
+	"j\xbajvj]g?\x80\x00w\xac(!k=d\xa1\\\x88Z\xcbV\xe4S\xddQ\x9bO\xf9N\xab" +
+	"IWj\x91qnf\xe6*9f\x18c{b\xd1bPb2ama)a\x10]nd\x03k\xee" +
+	"`\xa7_\xb9-\x9db.b\x13a\xaaa\xb0b)a\xaaa\x91a\x9a_\x93h\xcfY\x01]D[\xf2" +
+	"/\xb1`'`\t`\xd5aQb\x0fa\xbca\x13Z\x05h\x0fWP\\\xa4[\xd9Y\xf80\b_h" +
+	"`a`\xe7a\xa8b\x92a\xafU\xa0e\x06S\x97\\ [kY\xd2Y</k^\xf5_\x9b`_" +
+	"aLa\xa5SOd\x81P\xb3\\\b[vZ\x97Z,X\xd00A^\xe6_\xd1`\xc1a?Q;" +
+	"c\xfcN\x88[M[\xe3[\aZ\xa6YgX\xc10.`\v`\xfbayO\xbfc\xb9L\xf3[\n" +
+	"[j[\xb6[WZ\x1cY\x9aY\xcf0*a5a\xb3N\x91c\xa0K\xaeZ\xf1[Q[g\\0" +
+	"Z\xf7ZxZ\xadZ\xe30-a\xedI\xc1`\xadF\xd5W~[rZ\xd9[m[[Z\xff[5" +
+	"[j[\xa0,\x88\x7fQ\x91\xa3\x8f\x81\x88\xa7\x82/~Yy[w\xbbw-v\xa3v_vFs\xb2" +
+	"\x8a`bچjoShfc\xca`\x93\\SY\xeaW\xc0V6U\x01O\xb7\x88S\x80\x00,\x7f" +
+	"lzfuaG[\x14Z\xfcW\xf8U\xb8T\x19R\xceMs\x80\x95j\xd9h.+\x80h\xdaf\xb8" +
+	"e-e\xdfe\xc0d\xfcd\xb7d\x9e`\xfcz}dMbubf.\xffe\x80doe8e>" +
+	"e\xb7e9e\x1fe=v\xdd_\xde]\x81`i_+1\xb9b\x83c\xacdxd\xf4e\xb2e_" +
+	"d\xb6r\x0f\\\xb2W\x92^\xef^%\\D3\"_\x8eb\xdfcdd%e\x0fd,p\x85X\xac" +
+	"Wc_\x97^\xe2]\\Y\\3\xa1bRb\xf7c\xbcd\xa8e\x02p\x00V[T\x82_~^\xec" +
+	"^\"\\\x8e\\\f4xbBc-d\x1ed\x9co{TGRY^\xc4_Y^\x91]\b\\\xa3" +
+	"[\xfd4dcgdXd\xd6o9R\xcbP\xc7^\x81^\xe1_A]\xb9]X\\\xd6]\v4`" +
+	"d\x91e\x10o\x1fQ\x9eO\x85^h^\xc7^\xf1^\x91^3]\xb4]\xea^\x1f4ceJl\xb3" +
+	"L\xcdJ\xa5Z\xf5^\xfd^d]\xcf^\x97^;^q^\xa6^\xdc0\xbfσ\xdf\xca\xe4ݴ\x89" +
+	"\xb4\xe2\xb4β\xa2\xb0\xfb\xb0\xa5\xb1S\xb2\x03\xb2\xb5\xb2\x1f\xd4\xf7\xcfb\xe0 \xb2r\xb2̲\xb7\xb2\xa5" +
+	"\xb0z\xb0%\xb0ӱ\x83\xb25\xb1\x9e\xd7\xdeӀ\x80\x00\xa2\x8b\xa5\xab\xa8L\xaa\xe9\xadz\xac\x8f\xad<" +
+	"\xad뮜\xabR\xabi\xa9p\x99\x9a/k\x87_\x88E\x89\x12\x89,\x8cH\x8c\a\x8c\xae\x8dX\x8b\xef" +
+	"\xab\xbf\xa9Ɯs\x80\xdd/\x9b\x84煶\x85ӈ\xf2\x8bċ\x85\x8c.\x8d\xa9\xab\xaf\xa9\xb7\x9e\xd7" +
+	"\x81\xbe~\x9c/\xeb\x82l\x82\x8b\x85\xad\x88\x81\x8bU\x8b\x18\x8b\U000e9929\xa9\xa19\x82\x87\x7fh|X" +
+	"0G\x7f%\x82f\x85=\x88\x13\x8a\xe9\x8aݨ\x14\xa7\xa0\xa3\x90\x82\xa4\x7f\x88|{yP/\xd7~\xff" +
+	"\x81ل\xb2\x87\x8a\x8a\x91\xa7ŧP\xa2\xb6\x85\x8a\x82p\x7fe|Xy-0\xb2~߁\xbb\x84\x96" +
+	"\x87\xa0\xa8c\xa7\xee\xa3Q\x85L\x85\b\x82\x00~\xf6{\xcdy\x100\xb0\x82\xa1\x85|\x88\x85\xa9\x03\xa8\x8f" +
+	"\xa3\xef\x85\xe4\x84̄\x9a\x81\x92~l{\xb2|\x840\xbe\x86b\x89k\xa9\xa6\xa91\xa4\x8f\x86}\x85f" +
+	"\x84a\x84/\x81\v~S\x7f&\x7f\xf80ՊQ\xa9\x14\xa8\x9f\xa1\x8b\x85!\x86Ʌ.\x84)\x83\xdb" +
+	"\x81&\x81\xf9\x82˃\x9e/\xcc}=\x9b\x03\x9d\xa8\x8c&\x7f\x1dz\xf0vhsXr\x7fr\xd0r\x86" +
+	"rjo\xbb\x92\x9cz\x0e\x9c;\x892{^w1upq\xb6p\xddq.p\xe3p\xc7n\x18\x94\xc2" +
+	"\x93\x85]t\x80\x00`Z[cX\xc5W\x13S\xdeR\x9cQ\x02O\xc2J9\x84\xb9\x82\x15y\x9a$6" +
+	"]\x1cX\xdbU\x92P\xaaQ\xf9P\x13NfM\x12Gvxlt\xea\\%Y\xa8&:^\x82^J" +
+	"^\x15_\xa3`c_\xdc_\xc0_\xd1t\x95q\x13W_U\x89Z:)h] ]=^\xdd_\xc6" +
+	"`\x87`._tp9ouT\xbeRKZ\x10X\xde,\x87[b]G_\x01_\xc5`\xb5_\xbd" +
+	"m@k\xd6R\xf6M\x8aY\xddX\xf7W\x1f.7X\xf7]]^%_\x18_ilvk\rO\xe9" +
+	"N\xac[QZ{X\xe4T\xc1.\xda\\e]W^M^\xc7l\xbekUN\xacL\xd5\\\b[W" +
+	"Z\x81X\xddW\xfa/\xe5^z_p_\xealwk\x0eM!K7[\x89\\\x06[3Y\x92X\xd6" +
+	"Y\xe3/\xe1_\xad`&lZj\xf1K\xe9I\xed[m[\xb3\\\x0eZoY\xb7Z\xc4Z\xfb/\xe5" +
+	"`ci\xdbhrF\xddD\xce[\xa7[&[JZ\xdaZF[S[\x8a[\xc1+\xff|\x0f\x9f\xf2" +
+	"\xa4\xef\x982\x8c\x85w\xd0s\x82q7p_o\x90p,p\x10n\x16\x96\xf9x\xb0\xa2ܔɉ\x8c" +
+	"t\x10rdoyn\xa0m\xd1nmnRk\xc0\x9b^\x99\x8aZT\x9f\xa5\x80\x00W\x0eT\x85S\xb8" +
+	"P\x84N\"M\x8aLJK\x02\x8f\xb5\x8c\x84\x97.L\xe4o\xe1d\x06b\xe9cNd\xd9c>c\xda" +
+	"c\xbea\x10\x84\xf1\x82Iy\x9ajo\x1e\x8aS\nP_K\xb2M\vK{J?H\xebG\x90q\x96" +
+	"n\x14S__AP$$\x1bW\xefX\x9fZA[n\\z\\!\\\x0fmol\x8aP\xd1^A" +
+	"MxT\v'`W\x8aYqZ\xa9[\xdd\\\xcc\\~k,i\xa9O\xdc^\xa4H\xefT\xb3S\x91" +
+	")\xfbW\xabY\x05[\x02[\xf5\\\xefjch\xe0L\xd0`\x14J\x1bV9UXS\x94*\x9eX3" +
+	"ZZ[P\\Mi\x99h\x16J\x82^\x8eH\x90WEVoT\xcaT\n*7ZH[>\\;" +
+	"j'h\xa5I\xe5_\x1cGZXBW\x91V\xa6V\rU\xf3+S\\b]^j\vh\x88H\xae" +
+	"_\x00F\x10W\xefXlW\x84V\xeeV\xd4W\xe1+W]\x9bh6f(Go\\\x86D\xbeW\xdc" +
+	"X\"XhW\xd5W\xbbX\xc8X\xff+[| \xa48\xad\xc1\x9bן\x8b\x8c\xdaq\xd7o\xaan\xd2" +
+	"n\xc9nEo\x10kК\xcdw\xf9\xa8\x88\x98h\x9c\x1c\x89\xdcp\x04mSl{lqk\xeel\xb9" +
+	"iy\xa3w\x9e\xb8W\xb3\xa4\x9c\xa8\xf1\x80\x00P\xa9O\xf1L\xbcK<I\x85IGG\x17\x92\xf3\x8f\xbd" +
+	"\x9b\xb6L\xe6\x8b\xd2o\x94`\v`\x85b\x15aH`\xc4a\x8f^ɖj\x934\x9f\xba\x84\"K>" +
+	"e=]\xd1^K_\xdab,`\xdba\xa6aj\x85'\x82zy\x9aj\n`j\x1a$K\xd3G\x9b" +
+	"I\x1eG\x98F\xb2E\xd0Cjk\xd0jEM@[\x82YdI>#DSCU\x18V\xffXv" +
+	"Y\xb1X\x89i\xa8g\x9aL^[\xfaY\xdbE!O\x97&\x04T\x18V W\x9cX\xfeY\x1fh\xdf" +
+	"f\xd1IR]n[PFuQMP@&\xa8UOV\xf3XZX~h\xccf\xbdG\xd3\\\xa6" +
+	"]vD\xf4S\fR\x1eQ^',V\xe2Y\x0eY1hHf9F&\\!\\5D\nT^" +
+	"StR\xdaR\xc0&\xd6X\xfcY i\x02f\xf3E\xde\\\xdb\\\xefC(U\x87T\xc1T+T\xc8" +
+	"T\xae'\xf9ZCe\xfac\xebC\xceZI\\\xbf@\xeaT\x7fT\xe7TTT\xf1T\xd7U\xe4'6" +
+	"y\t\xa56\xaf\x8c\x9cӠ\x8f\xa3h\x8aWk\xa7j\xc7j\xe3k%j\xd0hw\x9b\x97w\xf5\xafC" +
+	"\x99\xa0\x9d?\xa0\x19\x8a)k\xa3j\xc4j\xe0k\"j\xcdht\xa54\xa4\xebU\x16\xa7:\xabz\xafV" +
+	"\x80\x00L\x10H\xcbGqF\x9bE>D\x10\x93\xbc\x90\xc0\x9e\x18L\xe5\x8d|\x8f\xdcoD]\xa7_/" +
+	"^\x88^\xca^u\\\x96\x979\x94#\xa2\n\x85\x95K-\x8c\x19d\xeb[h\\\xf1_b^\xe1^\x8c" +
+	"_6\x99\xe7\x96ѥ\xa0\x87̄>I\x91[TY\x17Z\xa0]\x11_\xae^\x8c^\x88\x82ւ\xaa" +
+	"y\x9ai\xa2_\xffW\f\x15\xd6C7E\x17C\xabB\xcfBC@Oe\xf7e\xf6H\xcaY;W\x18" +
+	"T\xe6A\n!\xe8O\xa9Q\xc3S\xedU\x94U\xffe'e&E\xadZ\xa9X\x86VTB\xb7L " +
+	"\"\x8cP\xf1SET\xefU]e6e5DQZ\x02Z\xcbX\x99ANN\x0fMO#6S\xf9" +
+	"U\xa3V7eiehCsZ5ZI[\x05@mP\rOsP\x10#\xcbU\x92V\xeae\x10" +
+	"e\x0fB\x1aY\xdcY\xf0Y\xf0?\xd7Q\x8bP\xf5Q\x92Qx#}V\xd9b\xdeb\xde@\xf9X " +
+	"Z\x96Y\xf4>\x01Q\xfeQkR*R\xc7R\xad#\xd9wK\xa6J\xb3\xaf\x9cV\x9f\xf1\xa2ҡ\xce" +
+	"\x88pg\xc3g\xd8h@h\xb0e7\x9c\x92tȯE\x99\x03\x9c\x9e\x9f\x7f\xa2]\x87\xa6f\xbcf\xd1" +
+	"g8g\xa9d0\xa9\x1b\xa5\x03R\x85\xa8\xec\xacG\xb0\x0e\xb3\xef\x80\x00D\xe7C|B\xccBP@\x02" +
+	"\x93)\x90\x10\x9f\xa3L⍡\x8f\xec\x92Pn\xf7\\M[\xba\\\"\\\x93Y\x93\x96\x89\x93p\xa2\xc0" +
+	"\x85\xa1K\x19\x8c&\x8e\x8ad\x96Z\x0e\\y\\\x1d\\\x8d\\\x18\x99=\x96$\xa6C\x87ń5Io" +
+	"\x8a\xdaZ\xfaW\xb9Z#\\\xe0\\\x8e[i\x98J\x98֩݊\x01\x86q\x82\xf4G\xe8Q\xe8Uh" +
+	"W\xd3Z\x8f][[j\x81\f\x80Xy\x9ai<_\x92V\x9aN\x1f\x11\xa2@\xc8?\xb4>\xe1>_" +
+	"<\xc1bYaqB\x15W\xe6U\xc3S\x8dQZ>\xb4\x1epLRN\xbeQ\x17Q\xeebaay" +
+	"@\xa9WRX\x02U\xcbS\x99=\x9eI\x03\x1f\x1aO\x97Q\xf0R\xc8b\xb6a\xce?\xedW\xa7W\xa1" +
+	"XVV$<\xc6K>K\xfd\x1f\xd4R\xa4S|c\x14b,?dX\x05W\xffW\xffX\xbc<:" +
+	"MhN'N\xc4 qSj_\xd0^\xe8=2U7W\x93V\xf1V\xf1:\xb0N0N\xefO\x8c" +
+	"Or\x1f\\xè\x01\xb6۞ס\xa3\xa4_\xa3b\xa3.\x89\x02h h\x81i\x17f\x88\x9e," +
+	"v*\xb2m\x9b\x84\x9eP\xa1\f\xa3բՈ7g\x19gyh\x0fe\x81\xac\"\xa8\aSp\xac!" +
+	"\xaf{\xb3C\xb74\xbb\x18\x80\x00C B_B\b@\u0095~\x92e\xa2\xadO\x1c\x90l\x91\xe8\x94\x12" +
+	"\x95\xc6qh\\\xb7]\x17]\xad[\x99\x98\x1f\x95\x06\xa5ʈFMB\x8e#\x90M\x92\x01fB]t" +
+	"]\x11]\xa7^\x1d\x9a\xb1\x97\x98\xa9M\x89\xac\x86\x1cK\x8b\x8c\x9a\x8eN[\xd9[\x1f]\xd5]\xa7]o" +
+	"\x99Ě5\xac\xf7\x8b\xb1\x88!\x84\xa3IԊ\x86SfX\xa5[[^F]J\x99\x92\x99F\xb0\x95" +
+	"\x8dD\x89\xb4\x866\x82\xa2GrL\x03UiX\x1f[\n]\"\x81\x93\x80\xdfy\x9ak\x82a#Wn" +
+	"O\x84H\x96\x12_?e>\xfb>\x82<\xefb\xaea\xc6@^XEX\xf5V\xbeTfQZ=W" +
+	"\x1f\x8dM\xd7PHQ\xeab\xfcb\x14?\x93X\x93X\x8dYCV\xeaS\xde<\xe2Ji GQ!" +
+	"R\xc4c}b\x94?,Y\x14Y\x0eY\x0eY\xa1V\x95<_L\xa9Mh!\nS\x9da\x12`)" +
+	"=\xebW\x1eYzX\xd9X\xb6X\x92:\xdeN5N\xf4O\xb3!\x05xG\xa8\x97\xb97\x9e\x86\xa2r" +
+	"\xa4h\xa3E\xa3\x19\xa3\xf4\x89\x02g\xa2h8e\xaa\x9e\xb7u\x98\xb4Ǜ3\x9f\x1f\xa1\x15\xa3\xb9\xa2\xbf" +
+	"\xa3\x9b\x887f\x9bg1d\xa3\xaed\xaaGRíc\xb1ݴø\x8e\xbc\x83\xbc߀\x00Af" +
+	"A\x0f?ɕ5\x92\x1c\xa3\xeaN5\x90`\x91ܔ\x05\x95\xc1\x99\xcdp\xd6[\xfc\\\x92Z~\x98\xd8" +
+	"\x95\xbf\xa8\t\x883M\xbf\x8f_\x90ÒY\x96eh\x16]\x01]\x97^\f\x9a\xb4\x97\x9b\xaa\xbc\x89\x9a" +
+	"\x87DK\xf8\x8d\x10\x8e\xa6\x92\xb2]\x0e]\xa8]z]B\x99\xa6\x9a\x16\xaeC\x8b\x9f\x88\x94\x85\x15J6" +
+	"\x8aۏ\x04S\xf8[.^\x19]\x1d\x99z\x99.\xb1\xf1\x8d9\x8a\v\x86\x8d\x82\xf7G͋?La" +
+	"W\xeeZ\xd9\\\xf5\x9aL\x9a\x00\xb2K\x91\x04\x8d֊X\x86ރOF\xcfG2T\xecW\xd8Y\xf4" +
+	"\x81\x93\x80\xdey\x9aj\xfdb\xd1X\x89P\tH\xeaD\x0f\x11z>\x8a>\x11<}b.aF>\xac" +
+	"W\x8eXvY\x11V\xb8S\xa9P\xdc<z\x1f)P:Q\xddb\xafa\xc6>EX\x0eX\xf6X\xdc" +
+	"YoV_S\x93;\xf6L\x93\x1f\xebR\xb6`D_[=\x05V\x19YbX\xa7X\x85XaU\x94" +
+	":vN\x1eN\xdd\x1f\xe7wɨ\xe1\xba\xea\x9eѡ֤\xeb\xa3\x03\xa2\xb1\xa3\x94\xa4r\x89\x02gV" +
+	"dȞ\xfbu\x04\xb6w\x9b}\x9e\x83\xa1\x98\xa3v\xa2X\xa3:\xa4\x18\x887fOc\xc1\xb0\x06\xab\xe6" +
+	"R\x14\xae\xfd\xb2u\xb6z\xb9d\xbd3\xbd\xa0\xbe\x99\x80\x00@\x11>˕y\x92`\xa5sMr\x8f\xc4" +
+	"\x92_\x93ÕY\x99l\x99dp\xd6[\xafY\x9b\x98I\x950\xa8\xa5\x87\xa5Lŏ\a\x90k\x92\x01" +
+	"\x96\x14\x99\\g\x84\\w\\\xed\x9b'\x98\x0e\xacZ\x8a\r\x86\xecLe\x8e\x01\x8eђ\xbf\x96\a^\xbd" +
+	"]f]-\x99c\x99ԯ\x12\x8b]\x88<\x85\xf8J\x97\x8b\x06\x8f\x10\x92XT\xf0]\xe8\\\xeb\x99\x15" +
+	"\x98ɲ\x9d\x8cԉ\xb3\x86\xb9\x83$H%\x8bJ\x8e\x92L\xe5Z\xa8\\ę\ue662\xb3\a\x90\xa6" +
+	"\x8d\x85\x8ai\x86\xef\x83^G\"\x8a\xf7G\x91W\xa2Y\xbf\x9a\xb8\x9al\xb3쐞\x90\x8d\x8dq\x89\xf7" +
+	"\x86f\x83\x05F\xe8DwW\xdfY\xfc\x81\x92\x80\xdey\x9aj\xfcbKZ\x15P\xecIbDcAt" +
+	"\x10\xb1=\xa0<\fa\xdd`\xf4=[W<W\xedX\xc0Y9V*SYS\x90;\x8e\x1e\xcdQ\xcf" +
+	"_r^\x89<\x1bUGXYX\x8bXOX+U[U\x92:\x0eN\a\x1e\xc8wI\xa8\xfd\xbcC" +
+	"\x9e\xec\xa1\xf2\xa4!\xa3X\xa2A\xa2\xfe\xa3ܤ\xbe\x89\x02c\xe2\x9f\x14tn\xb7Λ\x99\x9e\x9e\xa0\xce" +
+	"\xa3̡碤\xa3\x82\xa4e\x887b۱T\xad1Qc\xb0=\xb3\xb5\xb6\xb8\xba\xc1\xbd\xaf\xbd\xf7" +
+	"\xbe\xf0\xbf\xee\x80\x00=ɕ\x92\x92y\xa6\xa9L\xac\x8fߑ\x94\x94\x18\x94\xe8\x98֘Ι\xb0p\xd6" +
+	"X\xb5\x98b\x95I\xa9ۇ\xbeK\xef\x8e<\x90\xc0\x91\x90\x95~\x98Ƙ\xc2g\x84\\\x06\x9am\x97T" +
+	"\xac\xa2\x89S\x862K[\x8dz\x8eJ\x928\x95\x80\x98\xcd^,\\\t\x99\xab\x9a\x1c\xb0[\x8b\xa5\x88\x83" +
+	"\x85tJ\xf7\x8bȎ\xe7\x920\x95|Vg\\Ә\xa8\x98\\\xb3\x17\x8cf\x89E\x866\x83\xdbH|" +
+	"\x8b!\x8ei\x91\xb5M\xb2\\\x8f\x99^\x99\x12\xb3_\x90\x16\x8c\xf5\x89\xe6\x86σ=Gs\x8a̎\x18" +
+	"H\aY\x8a\x9a(\x99ܴD\x90\x0e\x8f\xfd\x8c\xee\x89׆E\x82\xe3G6\x8e\xfbD\xd5Yǚ\xf7" +
+	"\x9a\xab\xb5,\x90\u070f\xf9\x8f\xfa\x8c\xe3\x89Q\x85\uf1beG'BhZ\x04\x81\x92\x80\xddy\x9aj\xfc" +
+	"bKY\x8fREJ\x1dD\xcdA\xc8?w\x10\x02;\x9b^\x9c]\xb3;,TqW\x83W~X0" +
+	"W\xf2U\"UXU\x8f9\xa5\x1d\xa9x۬\xc5\xc1բ+\xa4\x9b\xa6\xe3\xa54\xa5<\xa5\x0e\xa5\xec" +
+	"\xa6Χ\xb4\x88\xae\xa2\xb6u\xea\xbdf\x9eءL\xa3\x94\xa5\xab\xa4礸\xa5\x97\xa6y\xa7_\x87\xe8" +
+	"\xb6\xa8\xb2\x8cT\xad\xb5ƴ\xfd\xb8\xe8\xbb\xef\xbf\xfd\xbf=\xc06\xc14\xc26\x80\x00\x98\xb0\x95\x98\xab\xf3" +
+	"M/\x92\x8d\x94Ԗr\x98b\x9bd\x9b\\\x9c?\x9d%q#\x9a\xf3\x97߫\x18\x8aSN\xaf\x8f\x01" +
+	"\x90\x9f\x92\x8f\x95\x91\x98٘֙\xbck\xa3\x9d\x16\x9a\x01\xae\xbd\x8cu\x86\xeeNE\x8e\x18\x90\b\x93\n" +
+	"\x96S\x99\x9f\x99\x9fa\xb0\x9b\x80\x9b\xf5\xb1\x89\x8d\xf4\x88m\x86\x16M\xaf\x8c\xaa\x8fɓ\x11\x96^\x99\xae" +
+	"YZ\x9b\x7f\x9b7\xb5F\x8f\xb7\x8a0\x87ڄ\xb3L\xa1\x8c`\x8f\xa8\x92\xf5\x96ERc\x9b]\x9b\x15" +
+	"\xb4\x9c\x92\x8f\x8d\b\x8a\xb2\x87\xa7\x84xK\x91\x8c\v\x8fX\x92\xa8L@\x9c(\x9bു\x92\x87\x90\x10" +
+	"\x8d\xba\x8a\xaf\x87\x80\x84\x1eKR\x90:\x93\x8aH\xc1\x9c\xf6\x9c\xae\xb6i\x93V\x90\f\x90ƍ\xbb\x8a\x8c" +
+	"\x87*\x87\xf9KC\x94qFJ\x9dɝ\x81\xb7V\x94(\x90ߐƐˍ\x9c\x8a:\x8b\t\x8b\xdc" +
+	"KdD\xea\x81[\x80\xacy\x9akaf\x19\\\xd8U\bN{H\xbcEnC\x14A\xbc\x13\x9fb$" +
+	"\x9e\x15\x9dȆoh|c\xf2^\x06Z5V\xcaU\x8eT\x04R\xceM\x84\x95\xb1V\xe0\x85\x1b}\xdc" +
+	"sqp2oil\xf7kgk\xb0klkSh5\x95n\x7fjT\x96{FpJm\flC" +
+	"l\xd6j\x81j\xcaj\x86jmgO\x80\x00w\xacuN'\xb9c.[\xdfZiV\x8dS\xddR\x02" +
+	"P`O\x12I\xbedIlAiL_W-\xf7ata:asa\xceb\x86b\aa\xeea\xf7" +
+	"_\xe9i5f@Xk[L0-`\x03`\x87`\xf4a\xd0b\x8fb;a\x92Z[hxe\x83" +
+	"V\xfd[\x17Y\xe20\x85_\xe7`\x80agb(c\x11b.V\xb5f\"f\fSN[GZY" +
+	"Y\xc40\xc1_\x14`\xd0a\x94b\x81b\xdaSpd\xa2c\xd3P\xb6[\x93Z\xb4ZJX\xed0C" +
+	"_\x05_\xf0`\xe0a^R6d\xe5d\x17N\xe9\\C[\x87[&Z\x93X\xde19`\xfda\xed" +
+	"bkP\xbad\xa2c\xd4MU[\xcb\\7[\xd8[HY\xb7Z\xb515b'b\xa5O\x8dd\x89" +
+	"c\xbaL\x10[\xb1[\xe7\\\xb0\\#Z\x95[\x93[\xc818b\xdfJ\xbca\x96`\xc8G6[\xd3" +
+	"[Z[\xed\\\x87[\x1c\\\x1a\\O\\\x85-\x93\x80\x94\x9f\xee\x94Ə\x80\x82\x83~\xbfz\x80x\xdf" +
+	"wPw\x99wUw<t\xa8\x97zd5\x9dZ\x86ih\xa5d\x1aa\xb8]xZ\rX\xd0WG" +
+	"V\x11Pǎ\x91\x95&V\x8f}\xeas{pBo?o\xefm\x9am\xe3m\x9fm\x86jg\x88S" +
+	"\x80\x00w\xdb,\x1ce\x02`\x9fZ\xb3Z\xa5W\xf7V\x1fT\x7fS4M\xdaz\xd3d\x8flfa%" +
+	"/Yd\xc5d6e\x01e]f\x14e\x96e|e\x9awF`/i_\\\xeb^\x8525c\x00" +
+	"d*d\x97esf2e\xdee5s+]\xc6hkW@]\xff\\\xca4xbdb\xfdd\x99" +
+	"eZfDeaq\xa1Y\xc0i\x10W\x1a^\xbd]\xe3\\%4\xf7bqd,d\xf1e\xddf7" +
+	"p!V|f\xd7T\x84_\t^?\\\xab\\)4ybacLd<d\xbbpdUBg\x1b" +
+	"R\xba_\xba_\x12^4]\xcf\\\x1a5odYeIe\xc8p\"S\xc5f\xd8Q(_A_\xc1" +
+	"^\xe5^\x84\\\xf3]\xf05ke\x83f\x02p\bR\x98f\xbeO\xe6_(_r_\xbd__]\xd1" +
+	"^\xcf_\x045nf<m\x9cM\xc8c\xccK\x06_]^\xe4^\xfa_\xc3^X_V_\x8b_\xc1" +
+	"1Ƀr\xa0\x11\xa1\xaa\x92\b\x86\"\x82`~!{Fz\x82z\xcbz\x87znwڙ~\x80\x82" +
+	"\xa0.\x8f&\x82\x86~\xc5}=y\xbbx\xf7y@x\xfcx\xe3vO\x99\x19\x97\xd1fe\x86eh\xd1" +
+	"dIa\xe7`\\]R\\\x15Z\x8cYVT\f\x8a\xb1\x88$\x80\x000yfRbq_qZ\xec" +
+	"\\\rZ8X\x9bWSQ\xf5~\\z\xf2d\xd8b|0\xa0g\x82gogYh\xe7i\x9fi " +
+	"i\ai%z\xd1wg`z^\xb8a/3\x97fMf\x82h!h\xfdi\xbciih\xbfv\xb6" +
+	"u\xf2^\x10[\xbfa _\xff6\x81d\xbbf\x9ch8h\xf9i\xe3h\xffs\xefr\x8a\\nW[" +
+	"a\x05`+^m8\x11b\x92f\xa5gjhVh\xb0s5q\xd0Y\x88XNb|a\xb2`2" +
+	"\\D8\xafe\xb9f\xa4g\x95h\x13syr\x14XNV\x87c-b\x85a\xba`-_R9\xa5" +
+	"g\xb1h\xa2i s6q\xd1V\xd1T\xf8b\xb4c4bl`\xe2`+a)9\xa1h\xdciZ" +
+	"s\x1cq\xb7U\xa4S\xb8b\x9bb\xe4cDa\xbda\tb\ab<9\xa5i\x94p\xb0oKP\xd4" +
+	"N\xd6b\xd0bWb\x81b!a\x90b\x8eb\xc3b\xf96\x00\xd0\xd3\xe1\x06\xe0\xa3\xe5G\xb7F\xb7E" +
+	"\xb5,\xb3\x99\xb1\xe3\xb2ʳ\x8d\xb4S\xb3\xd0\xd6 в\xdfE\xe0\x85\xb50\xb5.\xb5/\xb3\x19\xb1b" +
+	"\xb2I\xb3\r\xb3ҳO\xd5\xc6\xd4\x7fД\xdbɳ\x1b\xb3\x19\xb3\x1a\xb3\x1d\xb0\xe3\xb1ʲ\x8d\xb3S" +
+	"\xb2\xd0\xd8F\xd3\xe3φ\x80\x00\xa3:\xa5\uea24\xabW\xadخ)\xae믯\xacy\xae\x01\xac\t" +
+	"\xaa\x11\x9a@/\xb8\x88\r\x89\x06\x89\xe6\x89\xed\x8e'\x8d\xf9\x8e\xb3\x90>\xae\x03\xac\v\xaa\x13\x9c\xb7\x81\x82" +
+	"0\a\x85\xbc\x86\x9e\x86\xa8\x8a\xe5\x8dɍ\x9d\x8e\x88\xac\n\xac\x10\xaa\x18\x9f/\x82u\x7fe0\x89\x83T" +
+	"\x83`\x87\xa0\x8a\x87\x8dm\x8dr\xaa\x8e\xaa\x19\xaa\x1f\xa1\xa5\x83O\x80C}31\x0f\x7f\xfa\x84Y\x87C" +
+	"\x8a,\x8dC\xa8\xf1\xa8|\xa8\a\xa3\xef\x83\\\x80R}Ez\x1a0\xc1\x7f\xeb\x82\u0605Èݩ\xc5" +
+	"\xa9Q\xa8ܤ6\x87H\x84@\x816~)z\f1Є\x00\x86\xeb\x8a\x05\xaax\xaa\x03\xa9\x8e\xa4\xe5" +
+	"\x87\x1c\x86\xea\x83\xe2\x80\xd8|\xbe}\xcf1߇ъ\xeb\xab,\xaa\xb7\xaaC\xa5\x97\x87ņ\xc0\x86\x8e" +
+	"\x83\x86\x7fo\x80\x80\x81S1\xf6\x8bѪ\xac\xaa8\xa9â\xa6\x898\x87\x9d\x86\x98\x86f\x82Q\x83c" +
+	"\x845\x85\b0\xed\x7f,\xa2,\xa2\xf9\xa6w\x93\xf2{\xaaw_u\x14r\xf3s\x00s\x9cs\x80q\x86" +
+	"\x99\x15{͠\x04\xa4d\x92\x9dw\xebvAsVq4qAq\xddq\xc1o/\x99\xe7\x97%x\xa0" +
+	"\xa2\xe3\x90\x1et-r\x83r^o\x93o\x9fp;p mr\x9cњ\xfd\x99\xad\\ŀ\x00W]" +
+	"T\xd6T\nS\xa5Q\xb0Q\x18O\xd7N\x8f\x8b݊\xb8\x88\x81y\x9c#FTxRnP\xe7MP" +
+	"O\xe1N\xa8MWK\xfeuNq\xccnJS\xc9Q\x9e%wZ\xdb\\\v\\\xbe_1`=_\xe4" +
+	"_\xd2q)pEl\xc2Q=O\x85V\xda(\xd8[\n[\xee^l_\xa0`\x90`An\xe7md" +
+	"l\xa0PIM\xe6W\xfdV\xf0, Z(\\\xde^\xdb_\xcd`\xc8l\xcakHi\xdeO\xb8J^" +
+	"X\xa0W\xc0U\xfc-\xf1XN\\\xff]\xf5^\xf2l\xd4kQi\xe8M\xd5L\xadZ\xe7Z\x11X\x81" +
+	"T#.\xb6]\xdd^\xd4_\xd1mbk\xe0jvM8Kz[\xe4[3Z]X\x85YS/\xd2" +
+	"_\xf7`\xf4mFk\xc3jZL\x01J3[\x90\\\x0e[:YfZ4[A/\xd6a1kq" +
+	"icg\xe0J\xc3H\xe4[~[\xc4\\\x1fZM[\x1b\\(\\_/\xda\x7f:\xa5\xa7\xa5\xee\xaen" +
+	"\xa2͔Lu\xb3s\x87r+r8q\xb5r\x7fo?\x9c-{\x14\xa2y\xa94\x9f]\x92\xefs\xe1" +
+	"q0o\xd4o\xe1o]p(l蜄\x99Gw\xba\xa7$\x9b\xf9\x90kp#p\x13n\x17n#" +
+	"m\xa0nkk+\xa4 \x9f`\x9d\x8eZ\x11\xa8\xa2\x80\x00P\xf6P>P\xbfN\xcaM\x13L\xd4J\xa5" +
+	"\x99\x8f\x96Y\x93,\x9f\x8cMSgZa0a\xb0c\x04eud$d\xefd\xb3\x8c\x18\x8a툰" +
+	"y\x9cb\x84\x1e\xe5M\xe2L\xd0IcK\xfeK\x1bJ;G\xd9o\x8an\x00j}M\xa9\\\xa2KK" +
+	"$\xbcV\xc4XBZ\xc2\\9]t\\LmckTjpL\xc7]\x1fJ\x18R\xf6(*WB" +
+	"Y\xf9[u\\\xd7\\\xf8k\xfdi\xeehlM\t^^F\xb8T\\SN*\xe6W\xf4Y\x99[\xc4" +
+	"[\xe8l\ai\xf8hvK'`\xa2I\x11V\xaeU\xd5S\xd6+\xabZx\\\xa3\\\xc7k\x83it" +
+	"g\xf1Iy_aH*X\x00W+URV +U\\\x91\\\xb5l=j.h\xabI1`\x1b" +
+	"GKY)XxWZX(X\x0e,y]\xd8i5g&e\xa4G!_\xebE\x0fX X\x9e" +
+	"W\x82XQX7YD+\xb5|!\xa6\x84\xa6ʯ\xee\xa3Ц\xae\x93uo\x84nFnSn\x95" +
+	"n@k\xe7\x9c\xd9{\f\xa4C\xaf\xa4\xa0\x80\xa3^\x93Do\x80nCnPn\x92n=k\xe3\x9d." +
+	"\x9a\xd2v\xed\xaam\x9d\x16\x9f\xf4\x90\xbbm\xafk\xedk\xfal<k\xe7i\x8d\xa5\x96\xa5L\xa0\x8eW[" +
+	"\xab)\xaf\t\x80\x00L^L\xf3J\xfeJ(H\xcbG\x9d\x9a^\x97H\x94\x16\xa1\xdaMA\x8f\xd6g\r" +
+	"^\xce`6b\xabb*a\xd5b\x7f\x9d\x10\x99\xfa\x96ȥt\x87\xd7K\xa5\\\xb2\\}]\xe5`Z" +
+	"b\xf7a\xd5aыR\x8b#\x88\xe1y\x9cb\x1eXv\x1a\x9aHlE\\H\x11G8F\xaeD\xbd" +
+	"i\xb1i\xb1h'I2Z\\X)F\x03$\x0eR\xf0U\x9bW\xc6YlY\xd8hghffX" +
+	"I\x87[\xb0Y}B\xfaOI&\xefT\\V\xafXZX\xedhqhpfbG\xa4]\xf8[\xc5" +
+	"EkQ\xc6P~'\xb5W\x8eY9Y\xcch\xa4h\xa3f\x94F\xc7]u^1D\x8dS\xc4R\xa2" +
+	"Sp(JY'Z\x80hKhJf<En]\x1c]\x1cC\xfaUAT#T\xf2T\xd8'\xfc" +
+	"Znf\x19f\x19d\nDL]\xc2]!B&U\xb5T\xbbU\x8aV'V\r(Xz`\xa7\x95" +
+	"\xa7r\xb4\x06\xa33\xa6\x18\xa5\x14\x91\x8fkBkHk\xafl h\xa7\x9d\xd2wݣ\xa4\xaf\x9c\x9f\xe0" +
+	"\xa2ť\xa3\x916j;jAj\xa8k\x19g\x9f\x9d\xaf\x9a vӯU\x9c\xb1\x9fz\xa2X\x91\b" +
+	"j9j>j\xa6k\x17g\x9d\xa9r\xa5Z\xa5\x13T\xa8\xab\xf5\xaf\xc1\xb3\xa1\x80\x00I\x0eG\tFY" +
+	"E\xdcC\x8f\x99\xae\x96\x95\x93\x9d\xa2\x90M-\x8f\xe4\x92Hf\xbd]T_\xc2_f_\xd6_a\x9cf" +
+	"\x99M\x96;\xa6\x17\x87\xceK\x84\x8e\x97\\_Z\xff]l`)_\xd7^\xb3\x9bs\x9b\xff\x98\xed\xa9\xb1" +
+	"\x8a\n\x86\x8eI\xfdS\xf4X\xae[\x1c]\xd8`\xa4^\xb3\x89\x89\x89=\x89\x10y\x9ca\xb6X\vP)" +
+	"\x16gA\x0eD\x1bCKB\xcbA0e\x99d\xb1d\xb0E\xeeX\xedV\xb6T\x84>\xf7\"\xd3O\xe2" +
+	"RNT\xa7U~e\x9cd\xb4d\xb3C\xfc[.X\xf8V\xc5A\xbcLS#\x99S-U\x86V]" +
+	"e\xf1e\te\bC@Z\xce[\x83YP@\xe7N\x8fO]$SV:W\x11fOegef" +
+	"B\xb7[,[+[\xe8@]P\xb8Q\x87R$$\xf0W\x00c\vb#b\"@\x85Z\xbfZ\x1e" +
+	"Z\x1d>\xd6Q\x81ROR\xecR\xd2#\xdbxƨ\x04\xa7\x96\xb6ۡǤ\x8c\xa3\x8f\xa3w\x8f\xa8" +
+	"h@h\xa0i6f\xa8\x9e/v-\xa3ɲn\x9et\xa18\xa4\x1e\xa3\x1e\x8fOg8g\x99h/" +
+	"e\xa1\x9d͚?s\xb7\xae\x06\x9b&\x9d\xea\xa0У\xb2\x8e\x97f2f\x93g)d\x9b\xac\"\xa8\b" +
+	"\xa3\xf2R'\xacZ\xaf@\xb3\f\xb6\xf1\x80\x00C!B`B\t@Ø>\x95&\x92\x11\xa2\xe4M=" +
+	"\x8f\x19\x91h\x93\xd1fp\\\xdb\\\x94]*]\x9f\x9aٗ\xc0\x94\xab\xa5\x97\x86\xfdK\x85\x8d\xb6\x90\x1e" +
+	"\\\nZ\x86];]\x0e\\ՙ\xec\x9ax\x97d\xa9\x1f\x89&\x85\xa8I\xf4\x8crS\x99X1Z\xe7" +
+	"]\xd2\\֙֙\x8a\x9a\x1a\xac\xbd\x8bf\x87\xe8\x84pH\x87L9U\xe0X\x96[\x81]\x9a\x87\xc0" +
+	"\x87t\x86\xd0y\x9caPW\x9eO\xb7H\xcc\x12`?\xad?F>\xcf=?b\xcca\xe3`\xfb@`" +
+	"XhV2S\xfbQ\xc9=\x9d\x1f\x8fM\xf2PcR\x05c\x1ab2aI?\x95X\x1bX\xb6V\x80" +
+	"TM=+J\x82 JQ<R\xdfc\x9ab\xb2a\xca?.X\x9bX\x81Y6W\x04<\xaaL\xc3" +
+	"M\x82!\rS\xb8a/`G__=\xeeY\aXMXLY\x01;,NOO\x0eO\xcd!\b" +
+	"y0\xa8֨i\xb8ǣb\xa5X\xa46\xa4&\xa3\xf6\x90:h\xa8i>f\xb0\x9e\xf3v\x81\xa4\x9c" +
+	"\xb4W\xa0\x0f\xa2\x05\xa4ţ̣\x9d\x8f\xe1g\xa1h7e\xa9\x9e\x92\x9b\x03s\xf8\xaf\xec\x9c\xc1\x9e\xb7" +
+	"\xa1w\xa4E\xa3H\x8f)f\x9bg1d\xa3\xad\xfd\xa9\xe0\xa5\xc7Q֮O\xb15\xb5\x01\xb8\xf6\xbc\xde" +
+	"\x80\x00A\xd6A\x80@9\x99\xbd\x96\xa4\x93\x8f\xa4\xc2N=\x90\xff\x92\x80\x94\xae\x96fh\xbb]P]\xe6" +
+	"^\\\x9b\x99\x98\x80\x95k\xa7u\x88\xccLw\x8e͐\xfb\x92\xb3]f]\xf7]\xca]\x91\x9a\x8a\x9b\x16" +
+	"\x98\x02\xaa\xfd\x8a7\x86\xb8JڍM\x8f\x05Tj[\xa2^\x8e]\x91\x9az\x9a.\x9a\xa3\xae\xaa\x8c@" +
+	"\x88\u0085HI@\x8bAM\xb7Y(\\\x13^0\x9aN\x9a\x02\x99\xba\xb2N\x8d؊Z\x86\xe0\x83Q" +
+	"F\xd1GlU\aW\xf2Z\x0f\x88F\x87\xfa\x87Vy\x9cctX\xe5P}J0DG\x12\x9a?\xd7" +
+	"?a=\xd0c\"b9aQ?\x17X\xc9YdW-T\xd5P\xf6=\xb1 IQ<R\xdec\xa2" +
+	"b\xbaa\xd1>\xb0YIY/Y\xe4W\x8bS\xac=0M\x82!\fS\xb7a7`N_f=p" +
+	"Y\xb5X\xfaX\xfaY\x8dU\xad;\xb2O\x0eO\xcd!\ax\xb2\xa9!\xa8\xb3\xbaz\xa2ƥܣ\xf4" +
+	"\xa3\xbe\xa3\x96\xa4\xb1\x90:h\\eΟ8u\xed\xa4\xe6\xb6\a\x9fs\xa2\x89\xa4\x83\xa3e\xa3<\xa4X" +
+	"\x8f\xe1gUdƞ֛HsQ\xb1\x99\x9c%\x9f;\xa15\xa3ݢ\xe8\xa4\x03\x8f)fOc\xc0" +
+	"\xaf\x9f\xab\x80\xa7dQ\x14\xae\xe7\xb2\xec\xb5\u05f9\xa6\xbd\x9f\xbe)\x80\x00@\x82?<\x99.\x96\x15\x93\x01" +
+	"\xa5_MC\x90\xa8\x92(\x94V\x96\x15\x9aah*\\\xc7]<\x9c\f\x98\xf3\x95ߩ\x14\x88tL\xe4" +
+	"\x8f\xbd\x91&\x92\xc0\x97\f_\x16]\xb5]|\x9aH\x9aԗ\xbf\xabˉ߇\x9bK;\x8dx\x8f\x12" +
+	"\x93^Ub^\\]`\x9a\x16\x99ʚ?\xafW\x8b\xe8\x88\xef\x85uI\x99\x8bL\x8f\xb4N;[\xe2" +
+	"]\xff\x99\U00019919\\\xb3\n\x8d\x87\x8ak\x86\xf1\x83`G#\x8a\xf9G\xcaW\xbdYښ\xf5\x9a\xa9" +
+	"\x9aa\xb3\x86\x91\x86\x8ek\x8a\xf1\x87{\x83\aG\xedE\xb5X\xe1Z\xfd\x88F\x87\xfa\x87Vy\x9cb\xee" +
+	"ZqQ`J\xa8D\x9bB\x9e\x11\xd0>\xf0=_b\xd0a\xe8`\xff=\xc5X@Y\x13Y\xaeWV" +
+	"SsT\x80<\xc8\x1f\xedR\xd1`e_|^\x94<\x85X\xacX\xdeX\xc4YWUtV\x81;J" +
+	"N\xf7\x1f\xe9x2\xa9=\xa8ϻӢ\xe2\xa5\x11\xa4I\xa3N\xa3\x00\xa4\x1b\xa4\xfd\x90:d\xe7\x9fQ" +
+	"uW\xa5\x02\xb7]\x9f\x8f\xa1\xbe\xa4آ\xf4\xa2\xa6\xa3¤\xa4\x8f\xe1c\xe0\x9e\xef\x9bar\xa7\xb2\xed" +
+	"\x9cA\x9ep\xa1\x8a\xa3l\xa2R\xa3m\xa4P\x8f)bڰ\xed\xac˨\xacPP\xb0(\xb3+\xb74" +
+	"\xba#\xbd\xf6\xbe\x7f\xbf}\x80\x00>:\x99G\x96.\x93\x1a\xa6\x94Lm\x8fݒ}\x93\xe5\x95\x7f\x99\xcb" +
+	"\x99\xc7h*\\V\x9bR\x989\x95%\xa9\\\x87\xbaKڏ7\x90\xa0\x92:\x96\x86\x99\xd2^\x84\\Y" +
+	"\x9a\x90\x9b\x1c\x98\a\xad\x15\x8a&\x87\x17K\x9a\x8e:\x8e\xe9\x935\x96\x81V\xd9]G\x99\xa8\x99\\\x99\xd1" +
+	"\xafыz\x88l\x86,I\xef\x8b\"\x8f\x8b\x92\xd7O\t]ə`\x99\x14\x98̳b\x8c\xf7\x89\xe8" +
+	"\x86у?Gt\x8aΎ\x1aH@Y\xa4\x9ae\x9a\x19\x99ѳސ\xf6\x8d\xe7\x8aЇZ\x82\xe5" +
+	"H;\x8f:F\x13Zț4\x9a皠\xb4ǐ\xf2\x90\xf3\x8d܊f\x85\xf1\x86\xfaH,C\xa9" +
+	"[\x05\x88E\x87\xf9\x87Uy\x9cb\xeeY\xebR\xb8KcE\x05B\xf1@\xa4\x11!<\xee_\x8f^\xa7" +
+	"]\xbe;\x97W\xd6W\xd1X\xa5Y\x1eU;VHV\x7f:\xe2\x1e\xcayĭ\x04\xac\x97\xc1e\xa5\x8c" +
+	"\xa7Ӧ$\xa6I\xa5\x10\xa6+\xa7\r\xa7\xf4\x8f\xe0\xa2\xf3vҨʼ\xf6\xa2=\xa4\x84\xa6\xb7\xa5\xf3" +
+	"\xa4\xba\xa5֦\xb8\xa7\x9e\x8f\x8f\xa2\x91\x9f\x03t\x10\xb8\x89\x9e\xef\xa16\xa3i\xa6l\xa4f\xa5\x81\xa6d" +
+	"\xa7J\x8eܶA\xb2%\xae\nS\x86\xb1p\xb5Z\xb8b\xbcp\xbf<\xbf\xc6\xc0\xc3\xc1ŀ\x00\x9b\xd8" +
+	"\x98Õ\xaf\xa7\xd1O.\x90\xa2\x92\\\x94䕓\x99ߙۚ\xc1lI\x9d\xfa\x9a\xe6\x97ѫv" +
+	"\x88vNďՒ]\x93\f\x97X\x9a\xa4\x9a\xa4b\b\x9ce\x9c\xf5\x99\xe0\xaeB\x8a\x10\x87\xb9NS" +
+	"\x8f\x1c\x8f˔\x17\x97c\x9a\xb3Y̜\x7f\x9c7\x9c\xac\xb2\x00\x8cf\x8a\x0f\x87\x04N\x14\x8cb\x90\xca" +
+	"\x94\x16\x97gS\xba\x9b_\x9b\x17\x9aϴ\x9e\x8d\n\x8a\xb4\x87\xa9\x84zK\x93\x8c\r\x8fY\x92\xaaLz" +
+	"\x9cd\x9c\x1c\x9bԵ\x1b\x91\n\x8e\xb3\x8b\xa8\x88\x95\x84 LW\x90y\x93\xc9I\xff\x9d3\x9c뜣" +
+	"\xb6\x04\x91\x05\x91\xbf\x8e\xb4\x8b\xa1\x87,\x885LH\x94\xb0G\x8b\x9e\x06\x9d\xbe\x9dv\xb6\xf1\x91ؑ\xbf" +
+	"\x91Ŏ\xb1\x8a<\x8bE\x8c\x18LiF.\x88\t\x87Ň&y\x9cf\xbc]4U|O\xc1H\xf4" +
+	"F\x98DAB\xeb\x14\xbe_\x84\xa5f\xa5\x02\xa4\xb2\x86o_\xf2Y\xf6VGS\xadQnP\xd2O\x9c" +
+	"N`\x9cSV\x9e\x8b\xb1\x8bP}\xabl\xbfk\xf0i\xa0h\xc6h\rh\x9ch\x83f\r\x9b\xfc\x85\x82" +
+	"T@\x87\x93{\x0ei\x95h\xc6ivg\xe0g'g\xb6g\x9de\f\x9b\xb6\x85,\x81\xa3Q\xfet\"" +
+	"fpe\xa1fQg\xc1fBf\xd1f\xb8d'\x80\x00w\\t\xf7n\x95\"pVvU\x93Q\xee" +
+	"OHM\xc4L\x89K;I\xe6\\>e\xdeb\xe5_\xf0Sp+6[\x06[\x8c\\\x9b]\xcd^\xca" +
+	"^w^hV\xa1e\x1bb\"_-R\x8aU%+\x8eZ\xec\\']c^c_M_\x04S\x1a" +
+	"b\xe4b\xc7_\xd3O\x0fU\x9fU\n+\xed[q\\\xcc]\xf2^\xde_\xd2P\x94b\x0faAa+" +
+	"L\x80V\x99V.U},H[$]%^\x15_\fNgaX`\x89_\xbbJ\xffW\xaeWM" +
+	"V\xb9U$+\xea]\x1c^\f_\x02M\xc4a\xdda\x0f`@I\xc9X\xa0XBW\xd2W\fV\xfb" +
+	",\xef_\x19`\x0fL\x97a\xc3`\xf5`'H\x85XQY\x1aX\xacW\xeaW\xd9X\xd6,\xf3`I" +
+	"Kc_u^\x8e]\xbfG8XAX\xd4Y\x8eX\xcfX\xbdY\xbbY\xf0,\xf6\x7ft\xa7\xe8\x9a\xc0" +
+	"\x9ab\x8f\xf8{\xd7w\xcev.uTt\x9bu*u\x11s-\x9e\xb5av\xa4\x92\xa4F\x86i`\x1a" +
+	"]\xcaY\x8aV\xf0T\xb1T\x15R\xdfQ\xa2\x94\x11\x9b\xb2V9\x8b\x15}\xb6l\xcbk\xdcl\x8fj\xf9" +
+	"j@j\xcfj\xb6h \x93\xbe\x9bp\x85\rS\xf6uGi\xa6h\xb7ijj\xd9i[i\xeai\xd1" +
+	"g<\x88\xa3\x80\x00w\x88o\xc2&\xd5[5U\xddV\x06ScQ\xe1P\xa9O^N\vtu\\\x85" +
+	"f\x04c\x0fW\xf0-?^\x1c_/`>apbmb\x1ab\vp\x8dZ+e#b.R\xcc" +
+	"X'/\xa4^\x1e_Z`\x96a\xb8b\xa2bXo\x03V&e\xcbb\xd7R\xdbY*X\x170#" +
+	"^\xcd`)aNb;c/n.S\xa0dEd/PNZ#Y;X\xb90~^\x80`\x81" +
+	"arbhmvQsc\x8db\xbfN\xcf[8ZZY\xf5X`0 `xahb_m\xfc" +
+	"P\xd0d\x13cDM\x9d\\+[o[\x0eZHZ71%buclm\xe2O\xa3c\xf9c+" +
+	"L[[\xdb\\G[\xe8[&[\x15\\\x121)c\xa6l\x1dNna\x8e`\xbfK\x11[\xcb\\\x01" +
+	"\\\xca\\\v[\xf9\\\xf7],1,\x82O\xa4\x8e\xa8\x90\x9di\x92\x88\x7fx{oyJx\x86w\xcd" +
+	"x\\xCv[\x9d\x94\x7f2\xa6\x7f\x9a \x8f\xa1{\xdczgw\xa4v\xe0v'v\xb6v\x9dt#" +
+	"\x9fX\x9d\x8ac\x8c\xa3چe`E]\xf5]?Z5W\xf6WZV$T疳\x93\x99\x9b'" +
+	"U\xeew~l\xd3k\xe9lbm\xeelpl\xfel\xe5jP\x8b\b\x88w\x80\x00q\xec+7]\b" +
+	"Z\x9aVNWyU\xfaT\xc5S|R,x\x00t\x96\\\xcbf%Y\xbc.\xa1aib'c\xc8" +
+	"d\xfae\xf7e\xa4e\x95t\x17s4ZreIWK[[1\xaca\x16b\xf8d4eVf@" +
+	"e\xf7q\xfdp~Y\x91e\xbbS\x1c\\\x0eZ\xfb4\x16aFb\xa2d}eif]qBo\xc4" +
+	"V\xabg/T\x18]\x96\\\xc2[\x174\xb4a\xd9c\xdad\xcae\xc0p\x8bo\fT~e\xbfR\x9c" +
+	"^\xab]\xe0\\S[\x984Vc\xd1d\xc1e\xb7q\x10o\x92S\xdcfDQm_\x9d^\xf5^\x17" +
+	"]\x80]o5[e\xcef\xc4p\xf7oxR\xaff+P-_N_\xcd^\xf2^^^M_J" +
+	"5_f\xfeo-m&Qzc\xbfN\xe6_>_\x88_\xd4_C_1`/`d5b\x85," +
+	"\xa6\x98\xa7L\xa9\xf5\x99g\x83\x13\x7f\f|\xe7z\xe8z\xfb{\x89{py\x89\x9f\x8b\x82\x0f\xa4s\xa7\xe3" +
+	"\x98\x04\x7fw~\x04{AyCyUy\xe4y\xcawP\xa0F\x9d\x99\x7f\"\xa6W\x95\x90{\xddzj" +
+	"z^w\xb9w\xccxZxAu\xac\xa0\xa8\x9eڝ\x83e\xbf\x86c`s^%]o]\x1b[=" +
+	"Z\xa0YkX.\x91j\x90=\x8e\x13\x80\x00/\x95^G\\l[\nW\xbfZ\x0fX\xdcW\x97VI" +
+	"{\x84x\x1bt\xb1]\x14[\x04/\xe8d#e\\f\x1ch\x80i~i*i\x1bw\x9ev\xbbsQ" +
+	"Z\xbcY\x16^\x013\x0ed_eLg\xbbh\xddi\xc7i}u\x83t\x05sBY\xdcW\x9a_+" +
+	"^,6\x1fc\x9af<h\x17i\x04i\xf8s\x8cr\x0ep\xa9YYTY_\xda_\x05]Z7\xce" +
+	"a\xf5fNg?h5s\x9br\x1dp\xb8W\x8aVfb\x1aaO_\xd5[\xb08\x8cg%h\x15" +
+	"i\vt!r\xa2q=V\xe8U8c\fbda\x9a_\xda`\xa39\x91i\"j\x18t\ar\x89" +
+	"q$U\xbaS\xfcb\xbdc<bt`\xb8a\x81b\x7f9\x95jRr>p7n\xb8T\x86R\xb7" +
+	"b\xadb\xf7cVa\x9dbfccc\x999\x99\xd0\xc8݇\xdc\xfeܛ\xea\xfc\xb8\xec\xb7\t\xb5\x87" +
+	"\xb3\xe2\xb3f\xb4`\xb56\xb3i\xd2\xdfЂۄ\xdb\"\xe66\xb6ֶ\xe7\xb4\xe1\xb3<\xb2\xc0\xb3\xba" +
+	"\xb4\x90\xb1\xf4\xd2b\xd1\x00\xd0d\xd9\xc5\xe1u\xb4\xc1\xb4Ҵ沽\xb2@\xb3:\xb4\x10\xb1P\xd2\b" +
+	"Ц\xcf_\xd0Gܹ\xb2\xac\xb2\xbe\xb2Ҳ±²\xbc\xb3\x92\xb0\xd1ݏ\xd9*\xd4\xc8\xd0j" +
+	"\x80\x00\xa3\U000a6e69\x82\xac#\xafݰ@\xb1\x15\xb1믚\xad\xa2\xab\xaa\xa9\xb2\x9a\xef.\xff\x88\xa5" +
+	"\x89\xae\x8ax\x8b\x9b\x8f\xe5\x8fǐ\x90\xadӭ\xb7\xab\xbe\xa9Ɲw\x82\x11/\x80\x86d\x871\x88V" +
+	"\x8c\xa3\x8f\x97\x8f{\xacf\xabϫթݠ\x01\x83\x13\x80\x040,\x83\xe7\x85\x0f\x89_\x8cU\x8fK" +
+	"\xaa٪B\xa9ΩӢg\x83ۀ\xce}\xbe0Ӏ\xa2\x85\x11\x88\n\x8b\x03\xaa^\xa9ǩR" +
+	"\xa8ݥτ\xeb\x81\xe1~\xd4z\xb70\xb9\x84Ň\xbe\x8a\xb7\xabD\xaa\xad\xaa8\xa9æ(\x88\xe6" +
+	"\x85ނ\xd4~\xd6~\x871ڈ\xe6\x8b߬\b\xabq\xaa\xfc\xaa\x87\xa6\xe9\x88Ɉ\x97\x85\x8f\x81\x93" +
+	"\x81E\x82W1\xf0\x8cŪ^\xa9\n\xa8s\xa7\xfe\xa7\xac\x89\x81\x88|\x88J\x84Q\x84\x03\x85\x14\x85\xe7" +
+	"2\a\x82\xb4\xa7\xe7\xa7D\xa7\xf8\xb4?\x9e9y\x93wiv\rt\xd1u)u\xf4u\xe3\x9eR~r" +
+	"\xa3\xb9\xa4m\xaf\x05\x9c-w\xa4t\xf5s\x9ar^r\xb6s\x81rםњ\x7f{\x18\xa1}\xac\xf5" +
+	"\x9a\xc2s\xe6s\xd9q\xdcp\xa0p\xf8q\xc3p\xfe\x9e\x8b\x9b:\x98}w\U000ab1d8\xa5p)p\x1b" +
+	"p\xe6o\x00oWp\"o]\xa9\x89\xa4ʢ\xf7\xa1\xb8\\\r\x7f\xffP\xc2P\fP\x8dQhP\x1d" +
+	"O\xdfN\x97\x95Q\x93\x85\x92K\x90my\x9d\"pN(M\xc7M\x80K,NiM\x8cL7s>" +
+	"q\x9an\x18j\x96M\x99K\xb3$\xe5X\xb1Z\xaf\\@^\xfe`8_\xeaq\x19n\xf1n\fj\x8a" +
+	"L\xb9K#T\xd9(oY\xc4[w^9_\x9c`\x96o\xb4m\x8bl\tkEL\xfbJ\xaeV\xba" +
+	"U\xc1+\xd9Yr\\s^\x9f_\x9cnklBj\xbfiVM\x94HRX*WPUR-\xcc" +
+	"Y\x94^J_Gn\xbal\x92k\x0fi\xa6LRKEZ\xb6Y\xe1X\x1dUN.\xa2_)`&" +
+	"otmKk\xc9j`L\nJi[\xdf[.Z%Y\xb4Z\x82/\xc5aIobl\xaek\x11" +
+	"i\xa8J\xcbI\x1d[\x96\\\x13[\fZ\x9c[j\\w/\xca\x7f|\xa8*\xa7\x85\xa8Z\xb5 \xa8\xe8" +
+	"\x9c\xbcsIr\fq\x95q\xedq\x98q֞o~h\xa4\xfe\xa5&\xb4ץ\x98\x9c\x88sFr\b" +
+	"q\x92q\xeaq\x95qҝ원zI\xa1\xb5\xaf\xa0\xa2.\x9b\x14quo\xb2o<o\x94o?" +
+	"o`\x9eśɘ\x90v\xf9\xad\x91\x9eϘ\xf2m\xb7n\x97m\x7fm\xd7m\x82m\xa3\xaal\xaa\"" +
+	"\xa5e\xa3\x93YF\xaf=\x7f\xffL'L\xbdN}M3K\xd5K\x90\x9f;\x9c&\x98\xf3\x95ʥ\xbd" +
+	"L\xa3]i^\xde`Lb\x86eBd d\xf5\x93\xf2\x93\xc1\x92\x80\x90\x9cy\x9dYG\x1e*Ic" +
+	"IzG>J\x87I\xffI\x1bmNmMk\xc3hAI Z|G\r$SUrW\xc7Z\x8b" +
+	"\\1]vl\x04l\x03i\xf5i\x10Iu[\xd5F\xefQ\xbc'\xe2V\x88Y\x8a[4\\\xa1kq" +
+	"kqibg\xdfJ\xe1]\xe8D\xacS\xe8R\xa0*\xc0Y5Z\xe0]\x12k\xc1k\xc0i\xb2h/" +
+	"I\xa0`qG\xa8VzUmT\xfc+\x97[\xbf]\xf1khkhiYg\xd6HF_\\G\x18" +
+	"W\xf7V\xeeV~WL+H]\xdfk\xa1k\xa0ixg\xf5G\xf7` F4Y*XFX\x8c" +
+	"YZY@,l}\xb9\xa87\xa8\x13\xa89\xb8\xfe\xa8R\xa7w\x9c\fo\bn\xb0o\boxn\x96" +
+	"\x9ex{6\xa4F\xa4l\xb4\x94\xa4\xff\xa7ᛚn\x01m\xa8n\x01nqms\x9eU\x9a\xc7z," +
+	"\xa1\xea\xb4M\xa1\xb4\xa4\x97\x9bim\xfem\xa6m\xfenomp\x9e\x8c\x9a\xfe\x98\xa6v\x19\xaf\x18\x9eO" +
+	"\xa11\x99Bl.kQk\xa9l\x1ak\x1b\xae\x11\xa9\xf9\xa9\xb1\xa4\xf5V}\xaf\xf3\xb3\u0600\x00H\xd9" +
+	"J\xadIcH\xe7G\x82\x9e\x91\x9by\x98g\x959\xa6_L\x82\x92)]\x1c]f_\xb4btb!" +
+	"aםŞ/\x9b\x1d\x97\xef\xa9\xfd\x89\xfcJ\xfbT-[\x15]c`#b\xeeaדI\x92\xe6" +
+	"\x92\xb6\x90\xcey\x9dX\xe1P\x85\x19\xfaE,CHF\x99F\x1dE\x8ei6hNhMf\xc3E\xdc" +
+	"Y\x0eV\xdcB\xed#\xc6R*U(W\x81Y2h\xbfg\xd7g\xd6e\xc7G[[5Y\x03@\xfd" +
+	"N\x91&\xcaU\x99W\xf2Y\xa3i\x0fh&h&f\x17F\x19]\xc3[\x90D\x02QZQ\xa0'\xa0" +
+	"X\xd1Z\x82imh\x85h\x84fuE\x90]l^(C{S\x83S\xc9T\x98(=Zqh\x93" +
+	"g\x90g\x90e\x81D0]\x1d]\x1dB\xe4U\vUQV\x1fV\x05'\xef|\x1b\xa8\xa3\xa8\x10\xa7\xf2" +
+	"\xbbɦƥ\xf2\xa5ۚ\x88k\xa7k\xf8l\x8el\x97\x9e\xd3y\x82\xa4C\xa4$\xb7\\\xa3s\xa6\\" +
+	"\xa5\\\x9a\x16j\xa0j\xf1k\x87kt\x9eO\x9a\xc1w\r\xa0\\\xb2\xf4\xa0%\xa3\x0e\xa5\xf1\x99\xbdi\x9a" +
+	"i\xebj\x81jn\x9e1\x9a\xa2\x97\x18v\x12\xb2\xaf\x9c\xfb\x9fɢ\xab\x99\x8fi\x99i\xeaj\x80jl" +
+	"\xb0\xb7\xac\x9c\xa8\x86\xa8@Sܯr\xb3B\xb7&\x80\x00F\xc5EiE\x12D\xb4\x9d\x04\x99\xec\x96\xd7" +
+	"\x93\xe3\xa5\xdfL\x83\x91H\x93\xb0\\\xcd\\\xcd_\x86_Y_\xf9\x9c>\x9c\xa8\x99\x93\x96\x86\xa9j\x89\x16" +
+	"J\xf2\x90\x04S\xdbZx]1`\x1d_\xfa\x9c(\x9b\xb9\x9cI\x99<\xad\b\x8bW\x87\xdfI\x85M0" +
+	"X(Z\xe1]\xcc`\xbe\x91ۑw\x91+\x90\xfdy\x9dXyP\x1aI\xd6\x15\xf4>\xdbB\x95B!" +
+	"A\x9ee\xeee\x06d\x1ed\x1dC\xbfXpV9T\x06<\xde\"\xc0P\x83R\xf5Upf7eO" +
+	"dgdfBnZ\xf7X\xc0V\x8e@GL\xe7#\x97S\xd4VOf\xb8e\xcfd\xe7d\xe6B\a" +
+	"Z\xc2[wYD?\xc8O(O\xf6$YW)f\xb6e\xb4d\xccd\xcbA\x98[L[L\\\x01" +
+	"?:QsRAS\x00%\x1cys\xa8-\xa7\x9a\xa71\xbd_\xa4u\xa3\x81\xa3q\xa3^\x98\xb4h\xb0" +
+	"iFiO\x9eZvģͣd\xb8\xef\xa1!\xa3\xeb\xa2\xf2\xa2ߘAg\xa9h?h,\x9d\xd6" +
+	"\x9aHt;\x9f\x9c\xb4\x84\x9dӠ\x9d\xa3\x86\xa2\x8b\x97\xe9f\xa3g9g&\x9dy\x99\xeb\x96`q\xd8" +
+	"\xb0\x1e\x9a\x8a\x9dT\xa0=\xa3$\x97De\x9ef4f \xb2;\xae\x1e\xaa\x05\xa5\xf0P\"\xae\x97\xb1\x82" +
+	"\xb5R\xb9:\x80\x00AT@\xfd@\x9f\x9a\xbf\x97\xa6\x94\x92\x91\x81\xa5\aK[\x8f\x98\x91\xeb\x94X\\\x7f" +
+	"\\_\\N\\\xef\x99ۚE\x970\x94 \xa7\xbe\x87oI\xbf\x8e=\x90\xaaS\x86Z\v\\\xf6\\\xd3" +
+	"\x99˙]\x99\xed\x96ܫJ\x89\x9d\x86#HJ\x8d\x02L\xd5W\xb6Z\xa1]\x97\x99\xba\x99L\x99\x04" +
+	"\x99\x98\xae\xed\x8b\xe2\x88h\x84\xf4F\xcfF\x8eT\x80WkZa\x90\"\x8f\xbe\x8fr\x8e\xdey\x9dX\x12" +
+	"O\xadIcC}\x11j?\b>\x94>\x10c\"b9aQ`i>\x9fW\xecU\xb5S\x7fPy" +
+	"<\xf5\x1f\x1aPXR\xd4c\xa2b\xb9a\xd1`\xe9>8W\xd0XlV5S/<wL\xb0\x1f\xdc" +
+	"S\xadc\xa1b\x9fa\xb6`\xce=\xc9X[XAX\xf6U\xf0;\xe9N\xfbO\xba \x9fyܨ\xb4" +
+	"\xa8!\xa7\xb8\xbe\xa0\xa5Ť\x02\xa3ͣ\xc1\xa3љEiJiS\x9e\xd9w\x16\xa4T\xa3\xeb\xba." +
+	"\xa2r\xa4l\xa3N\xa3B\xa3S\x98\xd3hCh/\x9eU\x9a\xc6tz\xa0#\xb5\xc0\x9f$\xa1\x1e\xa3\xe2" +
+	"\xa2\xee\xa2\xfe\x98{g=g)\x9d\xf8\x9ai\x96\xdfr\x06\xb1W\x9b\u06ddՠ\x99\xa3k\xa2\xaf\x97\xd5" +
+	"f8f$\xb3v\xafV\xab:\xa7#O\xbf\xaf\xe2\xb2̶\x9c\xba\x96\xbe\xab\x80\x00@o@\x11\x9b\xf7" +
+	"\x98ߕʒ\xb9\xa6ELL\x913\x92\xb8\x94\xea\x96\xe2^\x93]\x06]\xa7\x9aU\x9a\xbf\x97\xaa\x94\x9a" +
+	"\xa8\xfc\x88\xf8J\xa3\x8f\t\x91;\x934T\xa5]\xad]\x8b\x9a#\x99\xb5\x9aE\x974\xac\x88\x8ag\x86\xed" +
+	"I'\x8d\x91\x8f\x8aM\x98[Y^O\x9a\x19\x99\xaa\x99b\x99ܰ;\x8cw\x88\xfd\x85\x87G\x81\x8a\xcf" +
+	"H\vW\xf9Z\xef\x9a\x1f\x99\xb1\x99i\x99%\xb4\x00\x8eB\x8aȇR\x82\xdfF\xd8D\xdaW\xfdZ\xf3" +
+	"\x90\xa8\x90E\x8f\xf8\x8fdy\x9dZ\x03P\xbcJ\x1cD\xe1A\xd6\x11\xbe?%>\xa1c\xa5b\xbda\xd5" +
+	"`\xed=\xb5XzY\x15V\xdfS\xb3S\xad<\xfe\x1f\xdcS\xadc\xa4b\xa2a\xba`\xd2=GY\x05" +
+	"X\xebY\xa0VsVn<pO\xba \x9fy\\\xa8Ш=\xa7Կ\xf9\xa4\xfa\xa4W\xa3\\\xa3+" +
+	"\xa3;\xa4[\x99Ehl\x9e\xf2v\x80\xa4p\xa4\a\xbb\x84\xa1\xa7\xa4\xc1\xa2ݢ\xac\xa2\xbd\xa3ܘ\xd3" +
+	"gI\x9en\x9a\xe0sѠ?\xb7\x14\x9eY\xa1s\xa3r\xa2X\xa2h\xa3\x88\x98{fC\x9e\x11\x9a\x83" +
+	"\x96\xf8qL\xb2\xa8\x9b\x10\x9e*\xa0)\xa2բ\x19\xa38\x97\xd5e>\xb4İ\xa1\xac\x83\xa8hN\xea" +
+	"\xb0 \xb4*\xb7\x18\xba\xed\xbf\x02\xbf\x90\x80\x00?\x10\x9b=\x98%\x95\x10\x91\xff\xa6\x8dKB\x90\xad\x921" +
+	"\x94c\x96\\\x9a\xac^\x01\\\x83\x9a\x9d\x9b\a\x97\xf2\x94\xe2\xaaF\x88uK\x03\x8fˑ\x13\x93\v\x97[" +
+	"V\x1c]r\x99\xb5\x99G\x99זǭ\x02\x89䇥I}\x8dh\x8f`\x93\xb1Nf^\x19\x99\x89" +
+	"\x99\x1a\x98ҙL\xb0\x93\x8b\xf3\x88܅fGҊ\xa4\x8f\x10H\x81Z\xba\x99\x90\x99!\x98٘\x95" +
+	"\xb4X\x8d\xbf\x8a\xa8\x872\x82\xbcG&\x8f\x10E8Z\xbe\x9a\x99\x9a+\x99㙟\xb4ّ\u008e\xab" +
+	"\x8b5\x86ۆ\xd2H\x1aC\xd2[ᐨ\x90D\x8f\xf8\x8fdy\x9dY}R\x15J\xd7ELB)" +
+	"@\xcd\x11\x0f>0b\xcea\xcc`\xe4_\xfc<YW\xf8X\xcbYfV:V5WB<\a\x1f\x80" +
+	"x&\xa8˨A\xa7\xd9\xc1Z\xa5L\xa3\xa4\xa3Ȣ\xac\xa2\xbd\xa3ܤ\u0096-\x9e\xe0u\x19\xa4_" +
+	"\xa3\xf6\xbc\xe2\xa1\xe3\xa4\x17\xa3S\xa27\xa2G\xa3g\xa4M\x94ٞe\x9a\xc2rV\xa0.\xb8o\x9e\x95" +
+	"\xa0ɣ\xe7\xa1\xe2\xa1\xf3\xa3\x12\xa3\xf9\x94\\\x9e\b\x9ae\x96\xdao\xc1\xb4\x01\x9bL\x9d\x80\xa0\x9e\xa2`" +
+	"\xa1\xa3\xa2ã\xa9\x93\xb6\xb6\x19\xb1\xf4\xadө\xb6N\x14\xb1h\xb4o\xb8}\xbbK\xbf`\xbf\xee\xc0\xef" +
+	"\x80\x00\x9b\x87\x98Z\x95E\x925\xa7\xcaJ\\\x8fג|\x93Õ\xbb\x9a\v\x9a\v^\x01\x99\xf7\x9ai" +
+	"\x97U\x94D\xaa\x95\x87\xb1I\xec\x8f:\x90\x81\x92z\x96ʚ\x1aU\x8b\x9a\x11\x99\xab\x9a;\x97+\xaeS" +
+	"\x8a\"\x87\x17Iҍ5\x8f-\x93}\x96\xceO\x92\x99\f\x98\xa7\x98_\x98ذ\xf2\x8bY\x88N\x85;" +
+	"H \x8ap\x8eݒ-I7\x99\x13\x98\xae\x98f\x98\"\xb4\xb8\x8d%\x8a\x1a\x87\a\x82\x92Gr\x8e\xdc" +
+	"\x92-E\xa0\x9a\x1d\x99\xb7\x99o\x99+\xb58\x91(\x8e\x1d\x8b\n\x86\xb0\x86\xa7Hf\x93PD1\x9a\xf0" +
+	"\x9a\x8a\x9aB\x99\xfe\xb6%\x91(\x91.\x8e\x1a\x89\xc1\x89\xb7\x8a\xc5H\x87B֍Ɍ\x96\x8c(\x8b\x94" +
+	"y\x9dY}Q\x8fK\xecE\xf1B\x87A!?\xce\x10{]]\xab\x14\xa9ݩ{\xadw\x86oVa" +
+	"R\xa2P*N\xbbM\x1dL\xd5J\xba\xa1\x85VÏ\x9e\x8f)\x92(}|iCg\afOfL" +
+	"e\xd9f\x93c\xeb\xa0l\x89\"TP\x8bj\x8eiz\xd8f\x18f\xc2eNeKd\xd8e\x92b\xe9" +
+	"\xa0\x16\x88\xb9\x85.Q\xfc\x8a\xb1s\xe7b\xefc\x99e&dfc\xf3d\xadb\x05\xa3\xc1\x8b\x8a\x87\xff" +
+	"\x84{Pej\xae`\xc4anb\xfbe@d\bd\xc2dx\x80\x00w\x16t\xa9nBe\xb4\x1eW" +
+	"QUN\x1dK\x9eJ$I@HZF\bSWb\x84_\x8b\\\x92Z\x7fN\x9f'\xb2V\xa3W\xe7" +
+	"Y\xc6[=\\f[NO\xc1```*]1[\x1eK\x8aP\xf9(\x10WSYQZ\xcc[\xf7" +
+	"\\\x1cMZ_\xab^\xc4^\xa7\\\x93I R&Q\x95(\x8eX^Y\xff[P[xK\xeb_\x9f" +
+	"^\xb8]\xe9^\xb5G\xa8S\xe2SoR\x85)\tY\xf6[\xfd\\$JV_)^B]s]\x87" +
+	"F\xbeU9T\xc9T\x03S\xf2(\xba[\xf4\\\x1bJ\x02_\xd8^\xf0^\"^5E\xd9VUU\xe7" +
+	"UEU\xdfU\xce)\xc7](H\b]Z\\r[\xa4]\xf5C\xadUUV\x0fUoV\tU\xf8" +
+	"V\xf5)\x11\x7f\x82\xb0\x95\x9e(\x9d\xb6\xa1?\x90^vDt\x9ds\xe6s\xe2sot)q\v\xa6\xad" +
+	"_,\xa9m\xa9\x0f\xad\n\x86iZ5U\xe5SmQ\xfeP`P\x18M\xfd\x970\xa0!VH\x8e\xec" +
+	"\x91\xeb}\x85i.i\xdbhghdg\xf1h\xabe\xfe\x96ʟЈ\x98S\xf4\x8e3u\x12f\x05" +
+	"f\xb2h?g\x7fg\fg\xc6e\x19\x9a!\xa3z\x8bi\x87\xe4R]lzc\xd9d\x86f\x13hY" +
+	"g g\xdag\xa5\x88\xe9\x80\x00w?ovg{\"\xbcQ\x9eR4O\xb9NAM`L|J-" +
+	"o\nV\xe1b\x8c_\x93]\x80N\xe1+\xc8Y\xf8[<]\x1a^\x92_\xba^\xa2mzR\xcdc." +
+	"`5^\"OUT&,GZ\xb0\\\xad^(_T_yl\xc5Pfa\xc8a\xab_\x97L\xee" +
+	"UST\xd1,\xc4[\xba]\\^\xad^\xd4l\xb9N\xf7a\xbc`\xeda\xb9KyW\x0fV\xabU\xc1" +
+	"-?]S_Y_\x81lCMaaF`w`\x8bJ\x92XfX\x05W?W.,\xf0_P" +
+	"_xl\xf2M\x0ea\xf4a&a9I\xafY\x82Y#X\x81Y\x1bY\n-\xfd`\x85j\x01K\x14" +
+	"_r^\xa4a\fG\x85X\x82YKX\xabYEY4Z1-H\x82Z\xa8~\xb0砽\xa4J" +
+	"\x92\xf4y\xe5w\xdbw\x17w\x14v\xa1w[t9\xa13~}\xab\xea\x9dn\xa0\xfb\x90\tx0u\xa3" +
+	"t\xdft\xdctiu#r\x00\xa6\xfe\xa2~a(\xa8\xa0\xac\xa0\x86eZ_Y\xbcV\xb2UCS\xa5" +
+	"S]QB\x99\xbf\x96\xa0\x9f\x85U쑵wOi7i\xc4kTj\x94j j\xdah.\x9d\x1a" +
+	"\x99\xfb\xa34\x8bNTUm\xb4g\fg\x98i(kmj5j\xefj\xba\x8bV\x88\xc0\x80\x00q\xa6" +
+	"h\xbb'!V\\R|S\xcfRZQ{P\x9bNNr\x95q\x10W(b\xae`\x9bS`-\xd0" +
+	"]\n^\xdb`\xb9b1cYbAp\x94n\x8dVWc3a\x1fO\x96W#0\\]\xde_\xdc" +
+	"aWb\xa4b\xc9o\xdam\xd3Srd\xabb\x98P\xb9X\xdaW\xda0\xfa_\x12`\xb4b\x05b," +
+	"o\xcdm\xc6R\x03c\xedd\xb9OFZ\x96Y\xb4X\xf91u`\xabb\xb1b\xd9oXmQPm" +
+	"cxc\x8bNa[\xed[\x0eZwZf1&b\xa8b\xd0p\x06m\xffP\x1ad&d9M\x81" +
+	"]\b\\L[\xb9\\S\\B23c\xddm\x12k\vN\x1fa\xa4d\rKZ\\\b\\t[\xe3" +
+	"\\}\\l]i1~\x855\xa9Ω\xff\xb1\x81\xa7P\x99\xd9}\x82{xz0zBy\xcfz\x89" +
+	"wf\xa2z\x81X\xa6\xab\xac\x85\xa4\x02\x98o{\xcdy@w\xf7x\tw\x96xPu.\xa2\xbb\x9f\x96" +
+	"~A\xaav\xa0\xbe\x95\xf5x3x:vSveu\xf2v\xacs\x8a\xa7\x94\xa3\x14\xa1GcH\xac6" +
+	"\x86cZ\x8bY\xe8ZhX\x8aV\xebV\xa3T\x89\xa0\x0f\x9c\xf0\x99ڢ\xebVMo\x92j5j\xc6" +
+	"l\x1cn~mFn\x00mʑ\xbd\x90\x89\x8eY\x80\x00j\x94+\x84X-W9T\x16VoU\x93" +
+	"T\xb5Rkv\x1bt\x96q-Wnc\xadU,/2`Sa\xcfd?e\xb7f\xdfe\xc7t\x1a" +
+	"r\x13q0V\x9ed7T\x14ZT2d`\xd2cvd\xf1f?fdr\xcfp\xc8oJV\xdd" +
+	"exP\xf9[\xbaZ\xba4\xeea\x88c)e/eWr\xdep\xd7oYU\x0fg\xb5S\x0f^\x05" +
+	"]7[S5\xabc\xfff\x06f-rhpan\xe3Syf\x87R-_[^\x91\\\xd1]\x9a" +
+	"5\\e\xfcf$s\x17q\x10o\x91S&g6QO`w_\xcf^\xbe_\x87_v6jg1" +
+	"p\"n\x1bl\x9dQ+g\tO+_w_\xf7^\xe8_\xb1_\xa0`\x9e5\xb4\x88s\xac\f\xabe" +
+	"\xac\x02\xb6\xe7\xa3\x18\x81,\x7f$}\xdb|\xb3}\v}\xc5}\xb6\xa4\x97\x84z\xa7\xfc\xa8\x99\xb1\xea\xa1\f" +
+	"\x7f[|\xd0{\x88z_z\xb7{qzФ\r\xa0ԁc\xa5ů۟\x95{\xc1{\xcay\xe3" +
+	"x\xbby\x13y\xcdy\x11\xa4\xb3\xa1z\x9e\xd0~}\xaea\x9d{x(x1y\x02w3w\x8bxE" +
+	"w\x89\xac\x8f\xa8\x0f\xa6C\xa4\xfbe\x10\x86bZBY\xa0Z Z\xf8Y\xbaYrX5\x9aK\x98\x84" +
+	"\x97D\x95k\x80\x00.\xcbX\\X\nW\xd2U\xb5X\xa8W\xcdV\x83y\xa6x\bt\x9eq5WH" +
+	"Uy/^b#d\x1ae\xaahTi}i4w\xa7u\x87t\xa3q:VzT\xf5\\\x1e2\xac" +
+	"c2d\xe1g\x8fh\xdci\xd0v\\t<r\xbdq\xfaV\xb9T\x8c]\xfb]\x0f5\xdbb\xf2e\xdb" +
+	"g\xe1h\xd8u.s\x0eq\x90p+WMRe_m^\x9e\\\xbb7\xabc3g\x91h\x88u\x7f" +
+	"s_q\xe0p{V\x16U\fa\xeea$_x\\\xd68xhhi^v-t\rr\x8fq*" +
+	"U\xc3T1c\nbbaea\x05a\xce9\x85jkv\x1dsuq\xddpxT\x8eR\xefb\xc4" +
+	"cDbJa\xeab\xb3c\xb09\x89є\xdc2ڿ\xda7߄\xeff\xb9\x9a\xb8&\xb6\x8e\xb6!" +
+	"\xb5\xb4\xb6\xbe\xb3\xd4ѥ\xd0Xؘ\xd8\x10\xdd\\꠸\x83\xb6\xb1\xb5\x19\xb4\xab\xb4?\xb5I\xb2_" +
+	"\xd0N\xceF\xd0\x14֗\xdb\xe4\xe5۶n\xb6\x90\xb4t\xb4\a\xb3\x9a\xb4\xa4\xb1\xba\xcf\xd2\xcd\xca\xcch" +
+	"\xcf\xf8ڈ\xe1\x1a\xb4Z\xb4{\xb4z\xb3\x88\xb3\x1b\xb4%\xb1;\xd4\xc9\xd2\xc0\xd1^\xd0\x17\xd1\x00ݏ" +
+	"\xb3\\\xb3}\xb3|\xb4\xa4\xb3\xb3\xb4\xbd\xb5\xa3\xe1\xa8\xddC\xd8\xde\xd4{\xd14\x80\x00\xa4\x9b\xa7r\xaa%" +
+	"\xad\xfc\xb1Ʋ9\xb2\x1c\xb0=\xafA\xadI\xabQ\xaaX\x9b\x8d.\x8a\x89*\x8a\x1b\x8b\xfd\x8d-\x91\x85" +
+	"\x90\x8d\xaeޭ\x89\xadm\xabt\xaa{\x9e%\x82\x8d/5\x86ш\xb6\x89\xe9\x8eC\x90^\xad^\xac\t" +
+	"\xabr\xabx\xaa~\xa0\x9c\x83z\x80j0\x02\x84e\x85\x9a\x89\xf7\x8c\x15\xac𫛫\x04\xaa\x8f\xab\x94" +
+	"\xa4\x1e\x85C\x826~50ޅO\x89ȋ欃\xab.\xaa\x97\xaa#\xaa\xac\xa7\x94\x86`\x83U" +
+	"\x7fW\x7f\t0Չ|\x8b\x9a\xadx\xac#\xab\x8c\xab\x18\xab\xa1\xa7\xfc\x8ag\x87_\x83c\x831\x82\xe3" +
+	"1\xfd\x8cª\xc0\xa9k\xa8Ԩ_\xact\xa7ቄ\x89Q\x85X\x85&\x84\u0605\xea1\xf8\x82\xe4" +
+	"\xac\xb8\xa9\x89\xa9\x98\xae幭\xa6\xedw\x16u\xdbuettt\xfbt]\xa2\xb3\x81Χ\x02\xa6d" +
+	"\xab\x95\xb9c\xa6\xb6w\x12u\xd7uatqt\xf7tY\x9f؝|}\x93\xa2ߨ\x10\xb4,\xa4\xab" +
+	"u%ser\xefq\xfer\x85q\xe7\x9f\xfc\x9c\xff\x99\xb2zC\xa5$\xb2\x1d\xa3MqhrIq2" +
+	"pBp\xc8p*\xa4\xf9\xa1㞖\x9b\xdcwZ\xb1ע\x96m\xd6n\xb7pgn\xccoRp(" +
+	"\xae\xaa\xaea\xa9\xa3\xa7ҧ\xa3[d\x80\x00L\x06L\x9eN^O\xe3N\xf2N\x8c\x9dp\x9d;\x9bn" +
+	"\x9aA\x99\xaay\x9e!\xc7I\xaaJ\x82KmI\xc5M_L]p\xf2p\xf1oMk\xcbhoI!" +
+	"Gv$\x8fWmZB\\\x17_\x04`\fo\xaao\xa9m\x81l\x9ci@IwH\tS\xab(:" +
+	"Y\x18[\x16^\a_7o\x17o\x17l\xeekkj\xceJ\xe4H\xb1VSU +\xc6Z\xc2]\xc8" +
+	"_\xben\x14n\x13k\xebjhi%L\x1eF\xf9X\x02V\xf5V\x85-\xcaZ\xe9_in\x8fn\x8e" +
+	"lfj\xe3i\xa0K0JCZ\xbaY\xb1YUV\x86.\xa8`Hm\xfcm\xfbk\xd3jPjd" +
+	"J\xc3IE[\xb6Z\xd1[,Z\xbc[\x8a/\u0081\x1e\xa9\xe9\xa9Ʃ&\xaeq\xbd\x16\xaa\xb4\xa6\x8f" +
+	"r\xd7r\x7frTr\xdbq\x1d\xa0\x19~~\xa5\xe3\xa5C\xaa\x8e\xb8\xab\xaa2\xa59q\xb3q[q0" +
+	"q\xb7o\xfa\x9f\xf6\x9cS}t\xa2§`\xb8d\xa6\xe8\xa5\x05q\xb1qYq.q\xb5o\xf7\x9fx" +
+	"\x9bՙ}ya\xa3\xf4\xb3/\xa3\x82\xa3\xa0o\xe1o\x04n\xd9o_m\xa2\xa4s\xa0Нؚ\xa3" +
+	"vQ\xb28\xa1!\xa2\xe3lOn\x14mGm\xcem\x83\xb1\xe2\xad˭\x83\xa8Ƨ\xf5X\x8d\xb3\xf9" +
+	"\x80\x00H\xb5J\x8aL\xf5L\x03J~\xa0ݠp\x9d^\x9a0\x97\xef\xaa4L\fT\xbb]\x84_\xd8" +
+	"b]eGc\xf3\x9d\x12\x9bݛ\xaa\x9av\x99\xd9y\x9eQ/\x1d\x9cF4GwE\xd8I|H\xd0" +
+	"l\xdck\xdak\xd9jOf\xf3E\xdbY;D\a$\x1eT\xbaWbZT[\xc8lekckb" +
+	"iTh\x95GZ[gE\x02Q\x10'\xcfW\xd3Z\xdb\\Ol\x18k\x16k\x15i\ag\xaaIg" +
+	"]\xc0CRS\x89S\xcf*\xbeZ\x86[\xfal\x93k\x91k\x91i\x82h%Hy`uF\xa6VF" +
+	"V\xa1V0+\x9c\\\xd9j\xeei\xeci\xebg\xddg\xd7F\xfc_3E\xf4W\x96W\xf1W\x81XO" +
+	"+E\x7f}\xaaC\xa8\xeb\xa8ͭi\xbf\xb7\xa9/\xa9\x1c\xa5\xeeowojo\xf1o\x1e\xa0c|\xc8" +
+	"\xa5\b\xa4ꩆ\xbbI\xa8\xad\xa7פ\x98nSnFn\xcdm\xfb\x9f*\x9b\x87zR\xa1\"\xa5\xbe" +
+	"\xb6\xe1\xa5_\xa8F\xa4&mMm@m\xc7l\xf5\x9f\v\x9bh\x97\xdeyW\xa3A\xb6\x9c\xa2\x1a\xa5\x00" +
+	"\xa3\xf5mLm?m\xc5l\xf3\xa3d\x9f\xc1\x9c7\x99\xe3u\x87\xb2\x7f\x9f\xb3\xa2\x99\xa32k\xa7k\x15" +
+	"k\x9cl<\xb4a\xb0F\xac0\xab\xe9\xa8-Uڳa\xb7J\x80\x00F\xa1I H/G\xb0\x9fW" +
+	"\x9e\xe9\x9bԘǖ\x81\xa9\xa0L\x02\x93\x83Tn\\\xed_\x87bub\x16\x9fD\x9e!\x9e\x8f\x9b\x81" +
+	"\x99;\xadB\x8b<J\x96MnZ\x9d]6`%bڜv\x9b@\x9aܚ\xac\x9a\vy\x9eP\xc9" +
+	"J6\x19\x9aC\nA\xd4E\x81D\xdfi\x94h\x92g\xaag\xa9fEC\xbeX\x9eVk@\xe4#\xc6" +
+	"R\xd9U\xddX\x1cichagygxe\x8fE\xdd[\vX\xd8?\x97O1&\xdaVsX\xb2" +
+	"i\xdeh\xdcg\xf4g\xf3f\vD\xf0]\xc4[\x91B\xf3Q\xffRh'\xb8Y\x91i\x12h\x10g(" +
+	"g'f\x95Dd]b^\x17BJT\x13T{UJ(r|ө˨r\xa7\xe4\xac<\xc1B" +
+	"\xa6\xbd\xa6\xb1\xa6\x9e\xa4\xb9l\"l\xa8k֟\xe8z\a\xa4\x8f\xa4\x01\xa8Y\xbcҦ<\xa5l\xa5Y" +
+	"\xa3cj\xfek\x85j\xb2\x9e\xae\x9b\vw~\xa09\xa4\x91\xb8g\xa2\xee\xa5ܤ\xe0\xa2\xf1i\xf8j\x7f" +
+	"i\xac\x9e/\x9a\x8c\x97\x02u\x1a\xa0δ\x01\x9f\xa5\xa2\x93\xa5y\xa2\x99h\xf3iyh\xa7\xa22\x9e\x8f" +
+	"\x9b\x05\x97\x7ftd\xb4ӝy\xa0K\xa32\xa3\x80i\x1di\xa3jD\xb5۱\xbe\xad\xa5\xa9\x90\xaaJ" +
+	"R\x03\xb1\xa1\xb5u\xb9^\x80\x00E\nD\x19C\x9a\x9c\U000dc199q\x96a\x94U\xa7\xf4Jϑ\xbc" +
+	"\x94)T\x1e\\`_O^\xef\x9c\xe8\x9bĜ2\x99!\x96\xfc\xab\x84\x89\x80I[\x90\x81M\x1cZ\v" +
+	"\\\xfa_\xb3\x9c֛\xb3\x9bI\x9bݙ\xb7\xaf'\x8bňQG\xe0G\x97V\xd5Y\xc4\\}\x9bP" +
+	"\x9a\x1b\x99\xb7\x99j\x9a9y\x9ePaI\xcbD\x98\x15\x10>GA\xf4ARfMeKdccz" +
+	"c\xa0B\x0eX\x00U\xc9R\xc3<F\"]R\xf8U7f\xc8e\xc6d\xdec\xf6d\x1bA!Z\xb9" +
+	"X\x82U|?\xa2O!#;V\x16e\xfcd\xfad\x12c)d\xa6@\x95ZW[\fX\x06>\xfa" +
+	"Q5R\x03#\xf5z(\xa9\t\xa7\xb1\xa7#\xab0\xc2.\xa4 \xa3\xf4\xa3\xe8\xa4\x16\xa3\ni\\h\x8a" +
+	"\x9f)wF\xa3Σ@\xa7M\xbd\xbb\xa3\x9f\xa2\xb0\xa2\xa4\xa2ѡ\xb4h8gf\x9d\xf0\x9aMt\xaa" +
+	"\x9fx\xa3\x85\xb9M\xa0Q\xa3\x1f\xa2*\xa2W\xa1Bg2f`\x9dp\x99͖Cr6\x9f´\xe4" +
+	"\x9d\b\x9f֢Ģ\b\xa0\xe9f-e[\xa15\x9d\x92\x9a\b\x96\x81p\x1a\xb1\x96\x9a\xbd\x9d\x8b\xa0y" +
+	"\xa3\xa0\xa1leSe\xf4\xb6\xbf\xb2\x9f\xae\x84\xaal\xa7WN9\xb0\x1c\xb3\n\xb6ߺ\xf5\x80\x00?\xff" +
+	"?\x81\x9ah\x99\xfa\x96\xe6\x93Ց\xad\xa6|I\x9a\x8f\xc1\x92\x19\x94\xc5S\xd1\\$[\xe0\x9a>\x99\x1b" +
+	"\x99\x89\x96x\x94P\xa98\x87\x93H\x1d\x8eo\x91\x1bL\xc7Y\xcf\\\x88\x9a4\x99\x10\x98\xa6\x99:\x97\x12" +
+	"\xacȉņPF\x9d\x8c}G=V\x95YN\x9aV\x993\x98Ș\x85\x9a\x01\xb0\x8e\x8c?\x88\xc9" +
+	"\x84qF\xe9D\x0eW\x84Z=\x99\xb9\x98\x84\x98 \x97ӘMy\x9eO\xfbI]D%A\x1c\x10\xa1" +
+	">g=\xc5c\xafb\xaca\xc4`\xdc`\x1a=NW\xaaUtRjS=<Q\x1e\xbeR\x9bb\xe2" +
+	"a\xe0`\xf8`\x10`\xa4<\xc2WbW\xfdT\xf3U\xc7;\xa8N\xbd\x1fxz\x8f\xa9b\xa8\t\xa7{" +
+	"\xab\x89\xc3\x16\xa5B\xa4G\xa4\x16\xa4C\xa4X\xa3\x9ch\x89\x9f|w\x96\xa4'\xa3\x99\xa7\xa6\xbe\xa0\xa4\xc1" +
+	"\xa3\x02\xa2Ѣ\xfe\xa3\x13\xa2Egf\x9eC\x9a\xa0t\xe7\x9fѣ\u07ba/\xa1s\xa3q\xa2X\xa2\x85" +
+	"\xa2\x99\xa1\xd3f`\x9dĚ!\x96\x96rb\xa0\x1b\xb5Ğ*\xa0(\xa2\xf1\xa25\xa2J\xa1{e[" +
+	"\xa1\x88\x9d\xe5\x9a[\x96\xd5p8\xb2s\x9bߝޠ\xa6\xa3\xb1\xa2\xf9\xa1\xfee\xf4\xb7\xa5\xb3\x83\xafd" +
+	"\xabJ\xa82MƱ\r\xb3\xfc\xb7л\xe6\xc0\x00\x80\x00>\xef\x9bu\x9b\b\x97\xf3\x94㒺\xa7e" +
+	"J~\x91-\x92\x91\x95\"\x97\x1eU\x9a\\\x94\x9a\x8e\x99j\x99ؖǔ\x9f\xaa!\x88\xf1H\xf8\x8e\xe7" +
+	"\x91w\x93tM\xbc]<\x9aa\x99=\x98әg\x97?\xad\xb2\x8aC\x86\xcdGr\x8c\u05ce\xd3G\xf1" +
+	"Z\x02\x9a\x83\x99`\x98\xf5\x98\xb2\x9a\x13\xb1x\x8c\xa1\x89+\x84\xd2G\x96\x8e\xd3E\x8cZ˚\x8e\x99k" +
+	"\x99\x00\x98\xbd\x99a\xb5B\x8eq\x8a\xfb\x86\xa1\x86\x98G\x18C\nZϚ@\x99\v\x98\xa6\x98Y\x98\xd4" +
+	"y\x9eQ\xa7JED\xd1B\x81@\x17\x11\x10>Vb\xe2a\xe0`\xf8`\x0f`\xa4<<X\bX\xa3" +
+	"U\x99VJVE</\x1fwyݪ\x1c\xa8Ĩ6\xab\x9bŀ\xa5F\xa5k\xa4O\xa4|\xa4\x90" +
+	"\xa5\xb4\xa0\x18\xa0&vϤ\xe1\xa4S\xa7\xb8\xc1\b\xa4Ť&\xa3\n\xa37\xa3L\xa4o\x9e\xc1\x9e\xec" +
+	"\x9bIt\f\xa0\x8b\xa3\U0003c561w\xa4\x96\xa2\x90\xa2\xbd\xa2ң\xf6\x9eO\x9em\x9aʗ@qw" +
+	"\xa0-\xb8&\x9e.\xa1L\xa3*\xa2n\xa2\x83\xa3\xa6\x9d\xf7\xa1\x97\x9d\xf4\x9aj\x96\xe4oo\xb3ț\n" +
+	"\x9e(\xa0\x06\xa3\x10\xa2X\xa3|\xa1\xfe\xb9\xf7\xb5ұ\xb1\xad\x94\xa9|M\xe3\xb1s\xb5\x81\xb8O\xbce" +
+	"\xc0~\xc1\x10\x80\x00\x9b\x80\x9b\x12\x97\xfe\x94\xed\x91\xf6\xa7\xd4Ji\x90ْ=\x94͖ɛ\x1eUy" +
+	"\x9b\x9a\x9aw\x9a\xe4\x97Ԕݫ\x91\x88\x9dJO\x8e𑁓}\x97\xd1OY\x9a\x96\x99r\x99\b" +
+	"\x99\x9c\x96\xa5\xae0\x89\xf0\x86\xdcHÌ\xe0\x8eܓ1I\x17\x9a\xb8\x99\x94\x99*\x98\xe6\x99y\xb1\xf6" +
+	"\x8cN\x89;\x84\xe1H\xe4\x8eܓMFd\x9aÙ\x9f\x995\x98\xf1\x98ǵ\xc0\x8e\x1e\x8b\n\x86\xb1" +
+	"\x86\xa7Hf\x93PCٛК\xad\x9aC\x99\xff\x99ԶE\x92%\x8f\x12\x8a\xb8\x8aʊ\xc5I\x8a" +
+	"C\x83\x97\x01\x95˕g\x95\x1a\x98\xd3y\x9eQ\x89K\xc2E\xdeCF@\xd3@q\x11\b^F\xb1\x04" +
+	"\xafͯj\xb3v\xb7\x06\x86oRQO\xc9N}M\xafLeK8\xa7\x13X㔹\x93\x86\x96P" +
+	"\x97\xef\x7f\xceg\xfag<g[g\x9dgVe\x80\xa5\xfa\x8d\xf0V[\x8fƒ\x90\x94/})g\xb5" +
+	"f;fYf\x9cfTd\x7f\xa5\xa4\x8cԉIS\xf5\x8e\u0590ut\xadd\x8cf\x13eue\xb7" +
+	"eoc\x9a\xa9^\x8fr\x8b\xe8\x88aR,\x8c\xc1l\rb:c\xc1f$e\xaaebf\b\xac\xa8" +
+	"\x90\xf5\x8dj\x89\xe4\x86YO\xc2b\x8f_\"`\xa8c\fe\x97d\x8ad\x7f\x80\x00y<v\xcfn\xfc" +
+	"f\xf9^'\x1e\xbcM\xd2K\xabJIIoH\xdfF\xf5O\x7faLa\x16^\x1d[\xe6X\xf3KG" +
+	"(jV;XHZe\\\b\\lM\t`\x91_\xa9_\x8c]UZbI0P\x96(\xe7Ww" +
+	"Y\xbb[a[\xc7K\xb9`\xa4_\xbd^\xee_\x93\\\xa1G\xcfR\x7fQ\xb6)\x84Zg\\\x0e\\\x96" +
+	"J\xe2`\xda_\xf3_$_\x17_\x04F\xeeTwS\xd2Tl*\x0f\\\x05]CI\x9b`\x8e_\xa6" +
+	"^\xd7^\xcb]\xffFTU\xf7UUU\xefU\xde)\xc8]9H{^\xd7]\xf0]!_m]\xfd" +
+	"D\x87VbU\xc2V}W\x17W\x05*\x1b|\xa6\xb2[\x9f\x10\x9e\x9b\xa2*\xa4\xe5\x8e\x06p\xdbp\x1d" +
+	"p<p\x7fp7m\xec\xa8g\\\xec\xac\u1aef\xaf\x99\xb3)\x86iRKO\xc3NwM\xa8L_" +
+	"K1\x97\xf0\xa3MVV\x90\x8a\x93u\x95\xba}Ug)e\xcae\xe9f+e\xe3d\n\x97\x87\xa29" +
+	"\x8a\rS\uf3fa\x91\xfft\xdcd\x00e\x87d\xe9e+d\xe3c\n\x9a\xe4\xa5ԌˉDRH" +
+	"\x8eel>a\xd0cWe\xbae@d\xf8e\x96\x9d{\xa9\x1e\x8e\xef\x8bi\x87\xf7P\xbeb\xc4_\x8f" +
+	"a\x16cyf\x05d\xf7d\xed\x86À\x00v\xf9o(g(^Y\x1e\xbdN\x1bK\xf7J\x97I\xc0" +
+	"I3GLi\xedO\x7f`\x93]\x9a[\x83Y\\K\x8c(jV\\XiZ\x87\\)\\\x8di2" +
+	"M\t_@_\t\\\xf2Z\xcbIwP\xb5(\xe8W\x98Y\xdc[\x82[\xe9iEK\xb9_S^l" +
+	"_0]\nH\x19R\x9fQ\xd5)\x85Z\x88\\/\\\xb7i{J\xe2_\x89^\xa2^\xb5_mG;" +
+	"T\x97S\xf1T\x8b*\x0f\\&]di/I\x9b_<^U^h^hF\xa3V\x16UtV\x0e" +
+	"U\xfd)\xc8][g\x06H{]\x82\\\x9b_\x04^fD\xd9V\x81U\xe2V\x9cW6W%*\x1c" +
+	"\x7f{\xa9d\xb2\xa3\xa1\xa1\xa54\xa7\uf41dt\x19sOsns\xb0shq\x19\xa1\xf1~t\xb2[" +
+	"\x9e\x88\xa2\x00\xa4\xbb\x90ot\x15sJsis\xabsdq\x14\xa8\xaf\xa8m^ƫA\xaf.\xb2\xbf" +
+	"\x86eV\"S\bQ\xbcP\xedO\xa4Nv\x9a|\x97\x94\xa1\xefU\xe7\x93=\x95\x82w\x1eg\x12h\x9b" +
+	"g\xfdh@g\xf8f\x1f\x9dݚܥ\x8d\x8c\xaeTA\x91\xe7m\x7fd\xe2flh\xcfhUh\r" +
+	"h\xaa\xa0t\x9ds\xa8\u05ceҋaR\xb6d\x91b\xa1d+f\x8ei\x19h\fh\x01\x890\x89\x06" +
+	"\x80\x00q]ho`!#\"NcP\rN\xb0M\xdcMRKmm\x06m\x06S\t`\x98^\x80" +
+	"\\ZK\xcd,\x80Y\xad[\xba]\xd7_z_\xdelFlFP\x15b\n_\xf2]\xccMBS\xde" +
+	"-\x1eZ\xf1]4^\xdb_AlZlYN\xc5alb1`\nK\xe6U\xc8U\r-\xbb]\xe1" +
+	"_\x87`\x10l\x90l\x8fM\xeda\xa2a\xb5bmK\vW\xc0W)W\xc3.E_~`\xbclC" +
+	"lCL\xa7aUahahJuY@X\xacYFY5-\xfe`\xb3j\x16j\x16K\x87_\x9b" +
+	"b\x04afH\xaeY\xabY\x1aY\xd4ZnZ].R\x82T\xaa\x95\xaaŲ\xf6\xa8;\xaa\xf9\x99\r" +
+	"w\xb6v\x89v\x9bv\xdev\x96tG\xa3\x1b\x81L\xa8S\xb2\xae\xa5\a\xa7Ř\xdcw\xb1v\x84v\x97" +
+	"v\xd9v\x91tB\xa3[\xa1\x10}x\xad\xb4\xa1\xbe\xa4}\x96]u\xfetNt`t\xa2tZr\v" +
+	"\xa9\x02\xa8\xbf\xa4@`Ю²V\x86cVNV\xe0U\x02T4R\xeaQ\xbd\xa0ҝњ\xb6" +
+	"\xa5CV9\x95ioch\x10iyk\xdfkek\x1dk\xbb\xa3m\xa0l\x9dQ\xa8\x91\x8e\xcaT\xae" +
+	"e\xbee\xcfg8i\x9el*k\x1ck\x12\x91\x03\x90\u05ce\xa2\x80\x00jMaV'\x87S\x1fPT" +
+	"R\xc5Q\xf4QlO\x8ap\x8dp\x8do\aSPa\x98_qPK.\x88\\\xbb_Tarc\x15" +
+	"cxo[o[mTS\x9fb\xec`\xc5M\x82V\xd813^\x1b`^b\x05b\x8eojoj" +
+	"mcQ\xd1e-c\x06O\xafYJX\x121\xf1a5b\xdbcdo\xa0o\xa0m\x99P\xf9d\xb1" +
+	"eiN\xd7[CZ.Z\xf72|b\xd3d\x10oSoSmLO\xb3deddND\\\xc2" +
+	"[\xb2\\{\\i24d\am'm'k N\x92e\x00dbL\x7f]-\\?]\b]\xa2" +
+	"]\x912\x88\x85s\xacD\xab\x9c\xacW\xb7ȭ\x18\xa1\xb2{Gz\x1ay\xa7y\xffy\xb7y餲" +
+	"\x84l\xa9)\xa9>\xb7\x80\xa9\xe4\xa1\x7f{Bz\x15y\xa2y\xfay\xb2y\xe4\xa4&\xa1ڀ\x97\xa5\xef" +
+	"\xb2\x87\xa6\x9b\xa0\x00y\x8ew\xdewkw\xc3w|w\x92\xa4\xe8\xa2\x00\x9e\xdf}\x8a\xb0z\xa3]\x9d\xe1" +
+	"u\xf5v\xd9u\xc8v u\xd9u\xef\xadu\xad3\xa8\xb4\xa6\xe9b\x88\xb2f\x86bV\x02V\x95XA" +
+	"W\x03U\xb9Uj\xa5\x80\xa2\x7f\x9fd\x9cR\xa8\xb7U\xa7fUh\x10i~k\xaanRmEn\t" +
+	"\x99\x06\x98ח\x90\x95\xb2\x80\x00b\x02*\xd3S\xf1T\x11R\fU\tT\x84S\xa1t\x00t\x00r{" +
+	"o\x11S(a\xa7Q,.\xcf_\x1ba`d\x0fe\xb2f\xe5r\xcfr\xcfp\xc8o\xe4Swc\x00" +
+	"Q\x15Y-2!`&c\x10d\xb7f\x0erMrLpEn\xc7T\xcde\nO\x05[OZ\x17" +
+	"4\xcab\xc1dhftr\x9dr\x9dp\x96o\x18S\x96g\x8aQ\xb5]\xd5\\\xd5\\u5\x97e>" +
+	"gKrQrPpIn\xcbRPf\x85Q%_U^X]\xf9^\xc15PgAr\x80r\x80" +
+	"p`n\xe2Q\xf5g=PC`z_\xa0_\xec`\xb5`\xa36]\x88\x9e\xb0\xa0\xad\x99\xad\x9d\xb2\x8b" +
+	"\xbc\x03\xab4~\xde}\xb3}A|^|\xe2|@\xa8ԇ\x97\xab'\xaa\x84\xafW\xbb\xbb\xaa\xfd~\xd9" +
+	"}\xae}<|Y|\xdd|;\xa6\a\xa3\xbb\x83\xa7\xa7!\xab\xf4\xb6\xc1\xa8\xf3}\v{\\z\xeaz\a" +
+	"z\x8by\xe9\xa6\x1d\xa35\xa0\x00\x80\x9a\xa9%\xb4\xb4\xa7\x89yqzWyGxdx\xe8xF\xaa\xda" +
+	"\xa7ؤ\xa4\xa1\xfe}\xee\xb4L\xa6\xb8v\x03v\xe9x\x90w\aw\x8axN\xb1`\xb1\x1e\xac\x9f\xaa\xd3" +
+	"\xaa\x86dr\x86aU\xcbV_X\vY\x83X\x9aX+\xa1ء\xa6\x9fޞ\xa9\x9d\xfd\x80\x00.+" +
+	"T T\xf1U\xd9T_W\xa9V\xaawzwzu\xdcrro/S\x11Qy/\r`\xf8c\xb8" +
+	"e\x87h]iUvKvKt+sGp\x04SbR\x04[\x022zb\x93d\x88gah\x7f" +
+	"u\xc9u\xc8s\xa8r*q\x8cT\xb9R\xa6]\x9c\\x5\xc9d9g&h\xf9t\xddt\xddr\xbd" +
+	"q>o\xffU\xe4Q\x19_I^I]\xe97\xa8d\x7fh\xa9uWuVs6q\xb8pyT\xfd" +
+	"T\x12a\xf4`\xf7`\xab^\t8}i\x80t\xc0t\xc0r\x9fq!q1T\x86S\x17b\xe4b\t" +
+	"bib\tb\xd29\x81\xd1\x04\xdb\xdb\xdaf\xd9\xde\xdf+\xe3G\xee\xf1\xb90\xb7\xa3\xb7@\xb6\u0d80" +
+	"\xb3\xc7\xd1M\xd0\xf2\xd7\x11՟\xda\xc6\xde\xe2\xee\xef\xb9,\xb7\xa0\xb7=\xb6ܶ}\xb3\xc4\xcf\xf7\xcc\xdd" +
+	"ϸ\xd3x؟ܻ\xea)\xb8\x17\xb6+\xb5ɵh\xb5\b\xb2P\xcfzˇ\xc9~\xcfv\xd7'" +
+	"\xdbC\xe5e\xb6\x02\xb6\v\xb5%\xb4Ĵe\xb1\xac\xd4q\xd0[\xceS\xcc\xf1\xd0\x7f\xdb\x1a\xe1յ\x04" +
+	"\xb5\r\xb6@\xb5\\\xb4\xfc\xb6\x13\xd8M\xd47\xd2/\xd0\xcdС\xd1u\xde8\xb3\xf3\xb3\xfd\xb50\xb6e" +
+	"\xb5\x81\xb5\x96\xe1C\xe1B\xdc\xdd\xd8x\xd5,\xd1Ԁ\x00\xa5=\xa7\xfc\xab\xe3\xafƳ\x9d\xb3\x1c\xaf\xe0" +
+	"\xaf߮\xe3\xac\xeb\xab\xf2\xaa\xe7\x9c%.>\x89\x80\x8b\x87\x8dt\x8e\xae\x92)\xaej\xaej\xad\x15\xac\xf9" +
+	"\xab\xff\xaa\xf5\x9e\xa7\x82\xdf/\v\x876\x89&\x8ab\x8d\xe1\xae\a\xae\x06\xac\xb1\xac\x1a\xad\x1f\xac\x14\xa28" +
+	"\x84ʀ\xc90\f\x88\xf6\x8a3\x8d\xb2\xad\xa5\xad\xa5\xacP\xab\xb9\xacC\xad6\xa5Ɔ\x9d\x82\x9f\x82l" +
+	"0\xf9\x89荂\xadE\xadD\xab\xf0\xabY\xab\xe2\xac[\xa9I\x87ăȃ\x96\x83H0\xf8\x8d6" +
+	"\xaa\xbb\xaa\xba\xa9f\xa8Ϭ\xe4\xaco\xa8ҋ\x02\x87\t\x86ֆ\xa4\x86V2\x04\x83\x10\xaa\x92\xaan" +
+	"\xa9ί\x19\xb3\xa1\xbd\\\xae\x88u\x80u*t\xfft=s[\xa0\xba\x81\xe5\xaaI\xa7\x1f\xab\xa4\xb0\a" +
+	"\xbd\x0f\xaeNu|u&t\xfbt9sW\xa0\x97\xa0q\x80ڤ\x9d\xa8u\xac\xbc\xbcȮ\x17uy" +
+	"u$t\xf9t7sU\xa0\x18\x9d\x9b\x9bD|\xab\xa4\xf5\xa9;\xb7\x93\xac\vs\x8dr\xb2r\x87q\xc5" +
+	"p\xe3\xa5\x13\xa1\xe1\x9e雠y\x9b\xa7N\xb6\x9c\xab\xd2o\xfbq\xc2p\xf6p4pũ\\\xa6\a" +
+	"\xa2\xf5\x9f\xac\x9d\xdcvնU\xabDl|nCp>n\xd2o&\xb2-\xb1䱜\xac\xe0\xac\x0e" +
+	"\xab\xdfZ\u0080\x00H\x93JkL\xd6N\xb4M\x9b\xa4\x8d\xa4V\xa4!\xa2T\xa21\xa1\xc0y\x9e!9" +
+	"FhH}J\x05H\xb9L.oyoyoxm\xd5jxg-E\xdbD^$ZV\xb1Y\xd9" +
+	"[\xdc^\x97o\x05o\x04o\x04l\xdbl\x1dh\xd2G\\F\x1bR\xfb('Z_\\c_\x1en\xb8" +
+	"n\xb8n\xb7l\x8fk2j\xa6IiGVU\xefVJ+\xc3\\\x0f^\xdfm\xe0m\xe0m\xdfk\xb7" +
+	"jZi(J\xf7E\xf5W\xcaX%W\xb5-\xcf\\\x00m\x0fm\x0em\x0ej\xe5j\xdfivI\xe5" +
+	"I\x1dZUZ\xb0ZTW\x85.\xa4\x81n\xaa\xbc\xa9c\xa9D\xad\xe0\xb2_\xbf\xa0\xab8\xae*r\"" +
+	"r\x15r>q\\\xa0׀,\xa6\xb4\xa6\x95\xaak\xaeſQ\xab\x13\xad\xf0r\x1er\x11r:qX" +
+	"\x9f\x9e\x9d }\x99\xa2\xb8\xa6\x8e\xaa\xe7\xba誗\xac\x99p\xfbp\xefq\x18p6\x9f\x7f\x9d\x02\x99c" +
+	"|\x9f\xa4\x11\xa7\xbd\xba\xa3\xa7Q\xacfp\xfap\xedq\x16p4\xa3ؠ\xa5\x9d\a\x9a\xb3xΥP" +
+	"\xb6\x85\xa4\xea\xac$oUn\xc4n\xeco~\xa8\x18\xa4á$\x9e0\x9b\xe5u\xe4\xb5}\xa2{\xab\x91" +
+	"k\xd6m\xe6mnm´T\xb4\b\xaf\U000afaeb\xee\xab\x0eX\x03\xb7l\x80\x00F~H\xfdK\xe6" +
+	"J͡[\xa19\xa0ϝ\u009b|\x99.\xadzK\xa6M\xd2]\b_\xa7bve.\xa4/\xa3\xf8" +
+	"\xa2¢\x8f\xa2e\xa1\xefy\x9eJ\xb9\x1d<D\x11F\x01D\xbeH=l4l4k2k1i\xcd" +
+	"f\x82C\xbcX\xc6A\xfc$\x1eUeX.Z\xebl\x03l\x03k\x01k\x00i\x18hjE\xdc[8" +
+	"C\x9bQ\xac'\xdfX\xc5[\x97l\x04l\x04k\x02k\x01i\x18g\xcdH_]\xd7BBTET\xae" +
+	"*\xfc\\-k2k2j0j0i\x9eh\x1bGM``EtV\xd2WOW\xb7+\xd1~\xc0" +
+	"\xaa8\xa8ߨQ\xac\xa9\xb0x\xc1\x12\xa8ͨ\xbe\xad\x97n\xcdn\xf6n\x14\xa0Q}h\xa6/\xa4\xdc" +
+	"\xa94\xac\xde\xc0\xc0\xa8\xa8\xa8\x9a\xad]n\xc9n\xf2n\x10\x9f\x18\x9c\x9bz\u00a0\xfe\xa5V\xa9\x00\xbcT" +
+	"\xa8,\xa7Z\xac\am\xa7m\xcfl\ue798\x9bf\x97\xc7x_\xa1\x93\xa5=\xb7\xee\xa4\xe3\xa7Ϋ\x95" +
+	"l\xa1l\xcak袜\x9fi\x9b˘Dw\xa9\xa3\xbf\xb8\xc1\xa2\x9c\xa5\x87\xacyl\xcbl\xf4m\x85" +
+	"\xa69\xa2\xe5\x9fF\x9b\xc0\x9aUt\x02\xb4\x92\xa0'\xa3\x12\xab\xe1k:j\xddk2\xb5\xb6\xb5h\xb1O" +
+	"\xad:\xad\xf3\xaa&T\x1c\xb5\x94\xb9\x81\x80\x00D\xe7G\xd0F\xb7\x9e\xfe\x9eܞs\x9bb\x99=\x96\xea" +
+	"\xab\xb9Jk\x94\x00M\x85\\|_Kb\b\x9e\xf1\x9eϝ\xb0\x9e\"\x9b\xfc\x99\xa9\xafa\x8b\xaeH\xf0" +
+	"G\xc8YF\\\x15^ң\xa0\xa3i\xa23\xa1\u03a2\x9b\xa2 y\x9eJSD\xec\x18\xb6BtA1" +
+	"D\xb1h\xedh\xedg\xebg\x03g(e\xd5B\fX)U#@J#bUJX\x1bh\xeeh\xee" +
+	"g\xecg\x03g)eQD\x90Z\xc9W\xc2>\xf1Qg&~X\xb2h\x1dh\x1cg\x1af2g\xae" +
+	"e\xa0C~]UZOB#T\bTq'T|\x13\xa9s\xa8\x1b\xa7\x8d\xab\x9a\xaf\x00\xc1\xf3\xa6\x10" +
+	"\xa6\t\xa66\xac\x9fk\xa9jȟ\x90z\xa5\xa5k\xa4\x18\xa8\x00\xabf\xc1\x9e\xa5\xec\xa5\xe4\xa6\x11\xace" +
+	"k\xa6jĞW\x9b\xdaw\xec\xa0:\xa4\"\xa7\x89\xbd0\xa5p\xa4\xa4\xa4ѫ\x0fj\x83i\xa1\x9d\xd7" +
+	"\x9a\xa5\x97\x06ux\xa0_\xa3ƸǢ'\xa5\x18\xa4]\xaa\x9di~h\x9c\xa1\x9c\x9eG\x9a\xa9\x97\"" +
+	"s\\\xa1\x01\xb5x\x9fܢΥ\xf4\xabZh\xa4i5\xa4¡m\x9dΚH\x97\xabrҶ:" +
+	"\x9d\xa2\xa0x\xa3\x9f\xac.h\xe1i6\xb6\x90\xb6?\xb2#\xae\v\xaa\xf6\xab\xa0P9\xb3)\xb7\x02\xbb\x18" +
+	"\x80\x00C\xb6B\x9d\x9cU\x9c3\x9bɘ\xb9\x96\x91\x94x\xa9mI.\x91\ue51aM5\\ ^\xdd" +
+	"\x9cN\x9c-\x9b\r\x9b\x7f\x99W\x97%\xad\x02\x89\xacG\xad\x8f\xfcGuX\xe6[\xa3\x9cq\x9cO\x9b/" +
+	"\x9aɜF\x9a\x14\xb0Ȍ&\x87\xceG\xf9E\x18Y\xd5\\\x92\xa2\xb2\xa2|\xa1F\xa0ᡑ\xa2O" +
+	"y\x9eI\xeaD\x80B9\x14G=\xa4A$e\xd4e\xd4d\xd2c\xeac'c^@\xbdW\xbaT\xb0" +
+	"U\x83;\xa0\"\x01U6e\x03e\x03d\x00c\x18c\xadc\xac?\xabZFW<X\x0f>\xd2Q*" +
+	"\"\xd7yg\xa8\x83\xa7+\xa6\x9d\xaa\xaa\xad\xc6\u0085\xa3E\xa3\x1d\xa3J\xa3|\xab(gw\x9e\xa6w\xe3" +
+	"\xa4{\xa3(\xa7\x10\xaa,\xc2-\xa3 \xa2\xf9\xa3&\xa3W\xaa\xeegs\x9dm\x9a\xf0u\x16\x9fJ\xa33" +
+	"\xa6N\xbd\xbc\xa2\xa4\xa1\xb9\xa1\xe6\xa2\x17\xa9\x98fQ\x9c\ue67b\x96\x1cr\x92\x9fo\xa2\x8b\xb9Q\x9f[" +
+	"\xa2-\xa1q\xa1\xa3\xa9&eL\xa0\xb2\x9d]\x99\xbf\x968ph\x9fǶ\x00\x9d\x11\x9f\xe2\xa3\t\xa2R" +
+	"\xa9\xe3e壙\xa0E\x9c\xa6\x99 \x96\x82nz\xb2\xa0\x9a\xb8\x9d\x8a\xa0\xb0\xa3۪ed\xe1\xb7 " +
+	"\xb6̲\xad\xae\x93\xab{\xa8VLb\xb1K\xb4\x19\xb8/\xbcI\x80\x00>\x7f\x99\x9e\x99}\x99\x13\x96\x02" +
+	"\x93ڑ\xa5\xa7\xa0G\ue3e8\x92T\x95\x05L\xe8[\xae\x99z\x99X\x989\x98\xab\x96\x83\x94M\xaa?" +
+	"\x87{Fh\x8d\xb4\x90eG Xt\x99\x9c\x99z\x98[\x97\xf5\x99q\x97<\xae\x05\x89\xf4\x85\x9aF\xb2" +
+	"\x90\x81D\xbeYc\x99Ù\xa1\x98\x81\x98\x1c\x98\xc0\x9a/\xb1όq\x88\x17\x88)G)B?ZQ" +
+	"\xa1N\xa1\x18\x9f\xe2\x9f}\xa0-\xa0\xa7y\x9eI\x84D\x12A\xc6?^\x0f\xf3=\x96a\xe5a\xe5`\xe3" +
+	"_\xfa`\x8f_\xa7;\xd4W3T)T\xfdU\xd0;\x80\x1eZy\x9c\xa9{\xa8\"\xa7\x94\xaa\xf9\xae\xee" +
+	"\xc4~\xa56\xa4\x1a\xa4G\xa4x\xa4\x91\xa86\x9f\x8ax\x02\xa5s\xa4\x1f\xa7_\xabT\xc4$\xa5\x11\xa3\xf5" +
+	"\xa4\"\xa4S\xa4l\xa7\xfc\x9eP\x9b\xd3u\"\xa0B\xa3\x81\xa7v\xbf\xb0\xa4\x95\xa2\xb5\xa2\xe2\xa3\x14\xa3," +
+	"\xa6\xa5\x9dњ\x9e\x97\x00r\x8d\x9f\xbe\xa3\xb3\xbbB\xa1L\xa3)\xa2n\xa2\x9f\xa2\xb8\xa63\xa0\xfb\x9d\xa7" +
+	"\x9a\b\x96\x82p\x84\xa0\x15\xb6\xe4\x9e(\xa0\x05\xa3,\xa2t\xa2\x8d\xaat\xa4\xb1\xa1]\x9d\xbe\x9a8\x96\xcb" +
+	"or\xb3\xa2\x9c\f\x9d\xe9\xa1\x10\xa4\x1f\xa3k\xaa\x86\xb9\n\xb8\xb3\xb4\x92\xb0u\xac^\xa9UL\xe3\xb2d" +
+	"\xb52\xb9H\xbdb\xc1\x80\x80\x00\x9bp\x9bO\x9a\xe5\x97Ԕݒ⨰IʐR\x92\xff\x95\x94" +
+	"\x97\x94NŚl\x9aJ\x99*\x99\x9c\x96\xa5\x94\xaa\xabO\x88(H=\x8e^\x90\xf3\x92\xf3Hn\x9a\x8e" +
+	"\x9al\x99M\x98癔\x97\x99\xaf\x15\x8a\xa1\x86HH\x84\x91\x0f\x93\x0fE՚\xb4\x9a\x93\x99s\x99\r" +
+	"\x98\xe3\x9aq\xb2ߍ\x04\x88\xaa\x88\xbcH֓\x14D-\x9aÚ\xa2\x99\x82\x99\x1c\x98\xf2\x99Ķ\xad" +
+	"\x8e؊~\x8a\x90\x8a\x8bH\x88B\xbb\x9e\x96\x9e`\x9d*\x9cŠ\xb4\xa0\xc6y\x9eKCELB\xcc" +
+	"A+?\xbb\x11\t^Ŵ\xa2\xb4{\xb3I\xb73\xbaӻ\x17\x86oO\rM\xb0M\x04L\x8aJ[" +
+	"\xaa\x84Y(\x96`\x95,\x97\xf6\x99\x9c\x9dM\x7fGfpf\x88f\xedg[d\x83\xaa_\x8fzW\xeb" +
+	"\x92\xbf\x94ӖW\x9a\b~\xf3fmf\x85f\xeagWd\x80\xa9J\x8e^\x8c\x10Uq\x91\x18\x92\x9c" +
+	"\x96Nvvf)e\x85e\xe9fWc\x80\xac\xe5\x90\xfc\x8e\x02\x8a|S\x99\x8e撱l\xb6c\xd7" +
+	"f4e\xdcfJe\xee\xb0>\x92\x85\x8fk\x8b\xe5\x88XQ!\x8f\rb\xed`\xbbc\x17e\xc1eq" +
+	"de\xb0\x80\x96\x12\x92\xf9\x8fr\x8b\xff\x88\x85P\x1f[r^\xa4a\x01c\xaafad\x8f\x80\x00x\xc1" +
+	"xqp\x9eg\x92^zW\x84\x1e6J\x87IxH\xa7H!F\x8dLc_\xd8_\xd8_\xa2]k" +
+	"ZuX}H%(7U\x81W\xdaZ#[\x01K\x05_\xe6_\xe6^\xfe_\xa3\\\xadZ\xb5G\x13" +
+	"O\xe4(\xd4X\xa9Z\xf1[\xcfJL`<`<_T_G_-]6F:R\x16R\xd0)\x81" +
+	"[\x9e\\|I\xc4`\x9b`\x9a_\xb3_\xa6^\xda_\xc2E\xa9T7T\xf1U\x8c*\x13\\sG\xb1" +
+	"]\xe9]\xe9]\x02_M]\xdd^\fD(U\x06U\xc0V[VI)\x13}ᶗ\xa2G\xa1\x13" +
+	"\xa4\x81\xa7(\xa6/\x8e\x8bp_pwp\xdcqIm\xfc\xack]\xba\xb1$\xaf\xf2\xb3۷|\xbb\x10" +
+	"\x86iOnN\x12MfL\xecJ\xbd\x9a\xf9\xa7SX_\x94)\x96V\x98e\x9a\t\x7f\xa7f\xb9f\xd1" +
+	"g6g\xa3dșݦ?\x8duU撛\x94\xab\x96Nv\xc2fue\xd1f5f\xa3c\xc8" +
+	"\x9d\x1b\xa9ُ\x81\x8b\xfaT0\x91\x0e\x92\xb2m\x19dFf\xa2fJf\xb8fT\x9f\x9f\xad2\x91r" +
+	"\x8d\xec\x8axRv\x8f\x0ed\"a\xded;f\xe4f\x95e\x88\x9e\xb3\xb0\x80\x92\xf9\x8fr\x8b\xff\x88\x85" +
+	"P [\xa9^\xc6a#c\xccf\x82d\xb0\x87>\x80\x00y\x1fp\xecg\xf5_\x9eW\xb8\x1f<K\xc0" +
+	"J\xb4I\xe5IbG\xd1iwL\xc3`'_\xf1]\xda[\x8fX\x9dIG)AVuX\xcf[\x17" +
+	"[\xf5i\x85Kd`5_M`\x12]\xc7Z\xd5H7P\xcc)\xdeY\x9d[\xe6\\\xc4i\xdbJ\xab" +
+	"`\x8a_\xa3_\xb6`G]UGaR\xfdS\xb8*\x8b\\\x93]qj9J#`\xe9`\x02`\x15" +
+	"_\xf5_\xe1F\xd3U\x1fU\xd9Vs+\x1d]hg\x16H\x10^4]M_\xb5^\xf7^,ET" +
+	"U\xeeV\xa8WBW1*\x1c}\xe1\xaa]\xb6\x93\xa1*\xa4\x9f\xa7a\xa6g\x8e\xdep\x80p\x98p\xfd" +
+	"qkn\x1a\xa2\xd9{\x81\xb2q\x9d\xf4\xa1i\xa4*\xa6\xe9\x8e\x18o\x84o\x9cp\x01pom\x1e\xach" +
+	"\xa8\x9c\\o\xad\t\xb0#\xb3\xa1\xb75\x86eOjN\x0eMbL\xe8J\xb9\x99\xf3\x96\uf8d1U\xe0" +
+	"\x93_\x95\x8f\x97\xd8v\xefe\xe5e\\e\xc0f.cS\x9d8\x9a4\xa6n\x8c\xbdT*\x91\xf3\x94<" +
+	"mIc\xb6f\x12e\xbaf(eğ՜ѩ\xa8\x8eϋ\\R\x92\x90\xb2dUapc\xcd" +
+	"fvf'e\x1b\x9e\xe9\x9fl\xac\xf6\x90\xf8\x8d\x84\x8a#Q\x1c[\xde_0a\x8cd6f\xece\x1a" +
+	"\x87\x8e\x86\xe0\x80\x00q\x16h\"_\xceW\xea\x1f=L\bJ\xfeJ2I\xb2H$i\x96h\xb4L\xc3" +
+	"_j]S[)Y\x02I\x8a)AV\x93X\xec[5\\\x13i\xa4h\xc2Kd^\xe0_\x8b]a" +
+	"[:H~P\xe7)\xdeY\xbb\\\x03\\\xe1i\xfai\x18J\xab_6_0_\xe1]\xbaG\xaaS\x19" +
+	"S\xd3*\x8b\\\xb0]\x8ejYivJ#_\x94_\x8e_\x8e`FG\x1eU:U\xf4V\x8e+\x1d" +
+	"]\x85g1fOH\x10\\\xdf_/^\x91^\x91E\xa2V\tV\xc3W]WL*\x1d\x80\xb7\xab\x8b" +
+	"\xabi\xb6ݧ\xa6\xaak\xa9q\x97Ns\xbas\xc6t*t\x98qG\xa4\x01~W\xa7\xbc\xb2\xbb\xa4o" +
+	"\xa74\xa9\xf3\x96\xf1r\xber\xcas.s\x9cpK\xa3ߠh}[\xb2u\xa1[\xa4\x05\xa6Ė\xc3" +
+	"r\xbbr\xc6s+s\x99pH\xac\xb1\xa8娤^Z\xaf\xb6\xb38\xb6͆cSBQUP\xa8" +
+	"P.M\xff\xa0,\x9d(\x9aD\xa6#V\"\x95u\x97\xbeo1f\xc3i#h\xcbi8hԢ\xce" +
+	"\x9fʜ̩a\x8e\xc5T\x8b\x944e\x89d~f\xdei\x87i8h+\xa1\xe2\xa2e\x9fg\xac\xaf" +
+	"\x90\ue34dS\x14]\xabb=d\x9dgFi\xfdh+\x8fa\x8f\x13\x8e\xe9\x80\x00j\x04a\nY\xb3" +
+	"#\xa2LNO\x13NJM\xcdLAl\xack\xcak\xc9PM`M^\"[\xfbI\xca-WY\xdf" +
+	"\\8^\x81__l\xb4k\xd2k\xd2Npb\x87`]^6LGT\r.\x14]\x0f_W`5" +
+	"m\nl(l(M\xb7b,b\xdd`\xb6KvV>W\a.\xc1`\x04`\xe2mil\x87l\x87" +
+	"M/b\x8bb\x8acCJ\xedX_Y(Y\xc2/S`\xd9jAi_i_K\x1cb+a\x8d" +
+	"a\x8dIsY.Y\xf7Z\x91Z\x80.S\x83ӬJ\xac(\xac;\xbbx\xac\x89\xab\xb6\xa1\x13wJ" +
+	"v\xf4wLw\xb9v餵\x81s\xa8{\xa8\x8e\xb7V\xa9S\xac\x15\xa0\x9evNu\xf7vOv\xbd" +
+	"uҤ\x94\xa1\x1d\x80w\xa6\"\xb7\x10\xa6$\xa8\xe7\xa0mvKu\xf4vLv\xbauϤ\xb8\xa1B" +
+	"\x9e\xfa|\xb0\xb2\x19\xa2ॣ\x9eIt\x99s\xbft\x17t\x85s\x99\xb0\xf0\xad$\xac\xe3\xa8e_\xfe" +
+	"\xb3F\xb6߆bR\xf7T\xb5SwR\xfdQ\xac\xa4\xe1\xa1ݞ\xe0\x9bȩ\x85U\x84\x97\x90f&" +
+	"f\xc4i\x03k\xafk`k\"\xa4\x19\xa4|\xa1\x7f\x9eg\xacא\xd0T\r]\xced\x83f\xc2in" +
+	"l%k\"\x98m\x98\n\x97ݕ\xfb\x80\x00a\xbcY\xf3&\xf0P\vNZQ_P\xe4PXp\x1f" +
+	"o=o=m\xb8P%`]^6M^.E\\\x04^\xeaa3b\xe0o\xb7n\xd5n\xd4l\xcd" +
+	"Q\x8bbz`SK\x9cV*1\x10_Pa\x99cFp\bo%o%m\x1ePTd\xfdb\xd7" +
+	"NUX\xe5Y11\xddbod\x1cpfo\x84o\x84m}O\xccd\xabecM\xce[\x06[R" +
+	"\\\x1b2od\x13o\x9bn\xa0n\x9fl\x98N\x7fdhdhM7\\\x8f\\\xdb]\xa4]\x932(" +
+	"\x86\xfc\xad\xfc\xadڭ6\xb2!\xbfF\xae\xc1\xaa\xdfz\xe4z\x8dz`z\xe4y@\xa6O\x84\x80\xaa\x18" +
+	"\xa9u\xae`\xbb#\xaeH\xa9\x98y\xcdyvyIy\xccx)\xa6-\xa2\xa3\x83\x84\xa7\b\xabL\xba\xde" +
+	"\xab\x19\xa9ey\xc9yryFy\xc9x%\xa5\xa6\xa2\x1c\x9f\xd4\x7f\xbc\xa8\x02\xb5\xe7\xa7֧\xf4x\x17" +
+	"w=w\x10w\x93u\xf0\xaa`\xa6գ\xf1\xa0\xd4|\xec\xb4ܥ\x83\xa7\x1et\xa8vbu\x98v\x1b" +
+	"uݴu\xb0\xaa\xb0i\xab\xeb\xab\naڶކaR\xbdT{V\xc7U\xdeTn\xa7\f\xa6\xa3" +
+	"\xa3\xa5\xa0\x8e\x9eX\xac\xeeU\x18^?f\xd1i\x15k\x87nZm\x1d\xa1\x85\xa0a\xa01\x9e\xf5\x9eC" +
+	"\x80\x00Z|*MP\xecR&P\xb6T\tSas\x9br\xa0r\xa0q\x1bm\xd7P\f`yNM" +
+	".\x9d^qa\x03c\xddePs3r8r7p0osQsb\x9bO>X\x8b2\x0eai" +
+	"dXe\xcbr\xf3q\xf7q\xf7o\xf0n\x98Sad\xe7M\xb9Z\xf5[A4\xc7d\te{sl" +
+	"rqrqpjo\x11Ryg\x8fP\xbb]\xa5^\x04]\xa55\x9cfRq\xdap\xdfp\xdfn\xd8" +
+	"n\xcfQ\x10f^P\v^\xf9_X^\xf8_\xc15L\x88Ȯ\x96\xaes\xadϲ\xba\xb6\xf1\xbf\x85" +
+	"\xb2O}e}\x10|\xe3|,{T\xa6䇬\xaeP\xabM\xaf\x81\xb3\x96\xbf:\xb2\x16}`}\v" +
+	"|\xde|'{O\xa6æ\xa3\x86\xaf\xa8\xe0\xacn\xb0h\xbe\xf5\xb1\xe0}]}\b|\xdb|${L" +
+	"\xa6;\xa3ڡ\x92\x82̩\x0f\xad\t\xb9\xfc\xaf\xd6{\x8fz\xb7z\x8ay\xd3x\xfb\xaa\xf5\xa7\xe8\xa5\x04" +
+	"\xa1\xd3\x7f\xfb\xab&\xb8\xf2\xafzx y\xdcy\x12xZx\xe8\xaf\x06\xab٨ܥ\xab\xa3\xe1}r" +
+	"\xb8\x89\xae\xd0t\xc3v\x7fxlw\x0ewb\xb4\xb8\xb4s\xb42\xaf\xb4\xaeӮ\x86cچaR\x85" +
+	"TFV\x92X_WO\xa8{\xa8G\xa8\x15\xa6L\xa6\f\xa5\x83\x80\x00-\xa5Q\nS\x06T\x80S^" +
+	"V\x84v\x16v\x16v\x16txq4n\x00O\xf5N\x8a.\xda`JcWeQg\xf6u\xb0u\xb0" +
+	"u\xafs\x8fr\xd2o\x9eQ^P,Z]2gc\xd1e\xcbhqupuouosOq\xf7" +
+	"qiSLQY]?]\x9e5\xc6e|h6t\xadt\xadt\xacr\x8cq4p\x05T\xc7P\x1e" +
+	"_\x15_t_\x157\xace\x8fs\xe1s\xe1s\xe1q\xc1q\xb8pSS\xbcR\xf7a\x94a\xf3a\xa7" +
+	"_\x058y\xd1\\\xdb\x13\xdb\x11ٝ\xde\xc4\xe2\xe0\xe2\x7f\uf039\x96\xb9;\xb8㸌\xb4gЖ" +
+	"\xd08\u0590\xd5\x1c\xdaC\xde_\xe2{\xee_\xb8\x8f\xb84\xb7ܷ\x86\xb3aД\xcc^\xd0(\xd1\xc8" +
+	"\xd6\x04\xd9\xfb\xde\x17\xee]\xb8\x8d\xb82\xb7ڷ\x83\xb3^\xcf>\xcb\b\xc7\xee\xce\xf0\xd3\xdf\xd7\xd5\xdb\xf1" +
+	"阷x\xb6\xbf\xb6f\xb6\x10\xb1\xeb\xd4\x12\xcf\xdc\xcb\xe9\xc9\xe0\xcf\xd3א۬\xe6\x05\xb6z\xb7\xb5" +
+	"\xb6ض\x82\xb6-\xd7\xefӸϣ͛\xcdS\xd0\xca\xdbp\xe2c\xb5i\xb6\xa4\xb7\xe2\xb7\a\xb5\xb0" +
+	"וו\xd3\x7f\xd1w\xd10\xd0\xf2\xd1\xc1\xdeƴY\xb5\x94\xb6Ѹ\x11\xb65\xe1\xc9\xe0\xc3\xe0\xc2" +
+	"\xdc]\xd9\x0fղ\xd2Z\x80\x00\xa5\xab\xa9\x9b\xad\x8c\xb1z\xb4Z\xb0A\xafS\xafR\xaeV\xad]\xacR" +
+	"\xabH\x9c\x8e.\x14\x89ɋ؍̎(\xaf\xe5\xae\xf7\xae\xf6\xad\xa2\xae\x84\xadz\xaco\xa0'\x83$" +
+	"/\x15\x8b\xa9\x8d\x9d\x8d\xf9\xaf\x8b\xae\x9d\xae\x9d\xadH\xad\xb0\xae\xa3\xad\x99\xa3\xc1\x85\x16\x84\xe40'\x8dn" +
+	"\x8dɯ3\xaeE\xaeE\xac\xf0\xadX\xadѮħY\x86\xf0\x86\xbe\x86\x8b1\x1c\x8d~\xabR\xaac" +
+	"\xaac\xa9\x0e\xad\x01\xac\x8d\xad\x05\xa9\xfb\x87L\x87\x19\x86熙0\xff\x83\xa2\xab\n\xaa\xe6\xaaǮ\x9e" +
+	"\xb2\xf7\xb4\x10\xc0ҵuu\x03t\xf9u\"s\xe2\xa1-\x82\x1f\xa7F\xa7'\xaa\xfe\xafW\xb3뿁" +
+	"\xb46t\xc2t\xb8t\xe1s\xa1\xa1\n\x9d\xad\x81\x00\xa7\b\xa8T\xab\xe7\xb0V\xbf7\xb3\xfct\xc0t\xb5" +
+	"t\xdes\x9f\xa0띎\x9dm\x80\x05\xa5ר\xbd\xad\x0f\xbe\xf1\xb3\xc6t\xbet\xb3t\xdds\x9d\xa4\x8e" +
+	"\xa12\x9e\xb9\x9ce|\x18\xa6;\xaa\x8d\xbaӲ\xcfr\xfdrnr\x97rʨ\xac\xa5O\xa2!\x9f-" +
+	"\x9c\xcdy.\xa8\x92\xb9˲\x91o~q\x90q\x18q\x0f\xa9ĩ\xa3\xa6R\xa3D\xa0\xe4\x9f\av\x7f" +
+	"\xb9\x97\xb2-k\xffn\x11pWo\xad\xb5x\xb4?\xb3\xf7\xb3\xb1\xaf\xf4\xaf\x13\xae\xf5ZT\x80\x00F\\" +
+	"H\xdeK\xc7N\x84\xab\t\xa9㩬\xa9v\xa8\xa6\xa8\x7f\xa85y\x9e \xd9D3G\x06H\xeaGy" +
+	"o\x05n\xcdn\xcdn\xccmOj\x04f\xb9C\xbcBC$YWXZ\xa1]8n\xd6n\x9fn\x9e" +
+	"n\x9el\x9bk\xeeh\xa3E\xdeD\xb2S\x94(7[M]\xe4n\xd7n\xa0n\x9fn\x9fl\x9ckQ" +
+	"j\xbdHaFDV\xa8W%,\x01^zm\x8bmTmTmSl\xa7k%i\xf3J\xbcD\xc1" +
+	"Y\x14Y\x91Y\xf9/\x14\x80\xf2\xaad\xaa@\xa8\xec\xadD\xb0\xee\xb1\xf6\xc1\xec\xab\xc1\xb5\x17q\xb1q\xda" +
+	"p\x9a\xa0\x88\x7fX\xa6\xa0\xa5L\xa9\xa4\xadN\xb1\xd1\xc0\x98\xaa\xb5\xb3\xd8qpq\x99pY\xa0d\x9d\b" +
+	"~&\xa2\xa2\xa6\xfa\xa9߮<\xc0K\xaa\x96\xb3\x9eqmq\x96pW\x9f/\x9bәZ{\xa6\xa3!" +
+	"\xa6\x06\xaad\xbb\xe4\xaa\x1f\xb2HpKpto5\xa33\x9f֝]\x99\xc3z𤈨8\xbc\xb7" +
+	"\xa7׳*pup\x9fpҦ\xae\xa3R\xa0#\x9c\x89\x9b\x1ewI\xa5\xbd\xb8\x88\xa5b\xb2\xe3n\xe4" +
+	"n\x88n~\xa7\xb7\xa7\x96\xa4E\xa0\xab\x9e\x9f\x9cGtx\xb7\x82\xa2\xf7\xb2zkem\xabm\x00\xb6\x87" +
+	"\xb5K\xb5\x01\xb0챥\xad٬\xf9Vd\xb9\xa3\x80\x00D\xc3G\xadJn\xa1ڠ\xe5\xa0Ƞb" +
+	"\x9e=\x9bꙠ\xaf\x98J\x00H\x14[\xad^\x82a\x1f\xaa\xab\xa9\x85\xa9N\xa8\x17\xa8ᨳ\xa8c" +
+	"y\x9eEY\x1cXCyE]C\xeck\xc0k\x89k\x88j\x86j\xaciYf\x0eB\vWzAa" +
+	"#\xbaW\xd2Zik\xc1k\x8ak\x8aj\x87j\xadh\xd5h(D\x8eZ\x1fB\xf3S\xde'\x84Z\xff" +
+	"juj>j>i<j\xb8h\xa9g^F\xed\\\x91ApVJV\xb3*\x97~B\xa9\x9c\xa9x" +
+	"\xa8$\xac\f\xafr\xaf\xcc\xc2ĩ\v\xa9<\xb4\x92n\x8dmN\x9f\xc3|\x92\xa5ؤ\x84\xa8l\xab\xd3" +
+	"\xaf\xa6\xc1m\xa8\x00\xa81\xb3SnLm\r\x9f\xa0\x9cC{M\xa1ڤ\xfd\xa8c\xac\x12\xc1\x1e\xa7\xe0" +
+	"\xa8\x11\xb3\x1anJm\n\x9ek\x9b\x0e\x98\x95x\xbc\xa1$\xa4\x8a\xa89\xbc\xb4\xa7i\xa6ױ\xc4m(" +
+	"k\xe9\xa2\r\x9e\xb1\x9b\x82\x97\xe8v\xa0\xa1ƥt\xb9f\xa5\x1e\xa8I\xb2glNl\x82\xa53\xa1\xd7" +
+	"\x9e\xa8\x9b\x0e\x98pv\x16\xa3\xe8\xba'\xa2ɥ\xf4\xb38l\x8bl\x82\xa5\x9a\xa5x\xa2(\x9e\x8d\x9b\xf0" +
+	"\x9axr\x8b\xb5\xfa\xa0X\xa3\x83\xb2\xcaj\xfajk\xb7X\xb6\x1a\xb5ͱ\xb5\xae\xa0\xafI\xab\x7fRs" +
+	"\xb7!\xbb<\x80\x00C\x93FT\x9f7\x9eC\x9e%\x9d\xc0\x9b\x97\x99e\x97\x16\xad7H\xbd\x93{G\xc7" +
+	"[S]\xf0\x9f^\x9ei\x9eL\x9d0\x9e\x8b\x9cX\x9a\t\xb1\x01\x8b*I\tE;\\B^ߪ)" +
+	"\xa9\x03\xa8̧\x95\xa8-\xa8騔y\x9eD\xf3B\x7f\x17\xecA\xd0@_h\xa7hphpgn" +
+	"f\xabf\xe2e\x8f@\xbbW\fW\xdf?\xa3#\aW\x84g[g$g$f\"f\xb6f\xb6d\xde" +
+	"C\x1aY~ZR> Sl&\x1a{\x93\xa8\xa9\xa8\x85\xa71\xab\x1a\xae5\xae%\xc3L\xa6 \xa6Q" +
+	"\xa6\x82\xb3\xc5i\xfd\x9e\xd7yͤ壒\xa7z\xaa\x96\xae\x00\xc1\xf2\xa5\x14\xa5F\xa5w\xb2\x86i\xbc" +
+	"\x9e\xb3\x9bWxu\xa0\xe7\xa4\n\xa7\x01\xaak\xc1\xa0\xa4\xf5\xa5&\xa5W\xb2Mi\xba\x9d~\x9a\"\x97\xa9" +
+	"uӠ2\xa3(\xa6\x93\xbd4\xa4~\xa3\xec\xa4\x1d\xb0\xf6h\x98\xa1!\x9dĚ\x96\x96\xfbs\xaa\xa0c" +
+	"\xa3ι\xe2\xa23\xa5^\xa4\xa6\xb1\x99i1\xa4\b\xa0\xab\x9d[\x99\xc0\x97#q\xbc\xa0\xfb\xb6\x83\x9f\xda" +
+	"\xa3\x05\xa60\xb2Ch.\xa3\xf7\xa3֠\x85\x9c\xea\x9aM\x97\xa2qQ\xb7F\x9d\x89\xa0\xb4\xa3߳\x17" +
+	"hk\xb7\u07b6\x9d\xb6M\xb22\xaf\x1b\xab\xf6\xac\xa1N\x85\xb48\xb8R\xbcl\x80\x00B6\x9cc\x9bn" +
+	"\x9bQ\x9a\xeb\x98Ö\x8e\x94`\xaatGx\x913\x93\xe4GxZ\xc1\x9c\x89\x9b\x95\x9bw\x9a\\\x9b\xb6" +
+	"\x99\x81\x97S\xae>\x88\xf7G\u0094\x00D\xe9[\xb0\x9c\xb0\x9b\xbb\x9b\x9e\x9a\x82\x9b\x05\x9ct\x9aF\xb2\b" +
+	"\x8bt\x8b\x86H9CI\\\x9f\xa9c\xa8=\xa8\x06\xa6ϧg\xa8\x06\xa8\xc3y\x9eD\x8aB\x14@{" +
+	"\x13\x99<\xd2d>d\x06d\x06c\x04c\x99b\xb0b\xe7?CVkW?X\x12:\xce!\x9dx\xb6" +
+	"\xa8X\xa84\xa6\xe0\xaa\x1f\xae\x14\xad\xba\xc4\xef\xa4\a\xa45\xa4f\xa4\x9b\xaf\x14\x9e}vڤ\x94\xa3@" +
+	"\xa6\x80\xaau\xad\x95Ó\xa2\xfc\xa3)\xa3Z\xa3\x90\xad՞Z\x9a\xfdun\xa0\x96\xa3\x10\xa6\xe0\xaa\x00" +
+	"\xc3>\xa2ݣ\n\xa3;\xa3p\xad\x9c\x9d%\x99ȗOr\xbc\x9f7\xa3\a\xa6'\xbeϢe\xa1\xcf" +
+	"\xa2\x00\xa26\xacE\xa0-\x9cЙ\xa2\x96\ap\xb4\x9fi\xa2\x89\xbaq\x9fA\xa2h\xa1\xb0\xa1\xe6\xb0m" +
+	"\xa3㠆\x9d6\x99\x9b\x96/o\xa1\x9f\xf3\xb7/\x9d%\xa0L\xa3w\xa2ð\xa6\xa3\x93\xa3r\xa0!" +
+	"\x9c\x87\x99\x1a\x96\xaamֳњѝ\xf7\xa1\"\xa4Q\xb1:\xb9r\xb8.\xb7۳\xbe\xaf\xa7\xac\x9e" +
+	"\xa9{K\xa5\xb1{\xb5\x91\xb9\xab\xbdɀ\x00\x9aW\x99c\x99E\x98\xe0\x95\xe9\x93\ue47d\xa7\xdcG4" +
+	"\x8f\x1f\x91Д\x85G\x9b\x9az\x99\x85\x99h\x98L\x98ؖݔ\xac\xab\xa2\x86\xf5Gz\x91씡" +
+	"E\x04\x9a\xa0\x99\xac\x99\x8e\x98s\x98&\x99З\x9f\xafl\x89r\x89\x84G\xf1\x94\xc1C`\x9a˙\xd6" +
+	"\x99\xb9\x98\x9d\x98Q\x99\"\x9a\x96\xb3:\x8b\xf4\x8c\x06\x8c\x1cH\x98A\xf1\xa5\x04\xa3ޣ\xa7\xa2q\xa6H" +
+	"\xa6\x7f\xa7\ty\x9eD\x8cB\x0e@p?\x03\x0f\xeb_e\xb7\xf8\xb6\xe3\xb6¹۽Y\xbd\xad\xbe]" +
+	"\x86oL\xeeL3K\xdbJ\x9e\xad\xb0Y\xb0\x96\xea\x96ɘܚ`\x9e\x18\x9d\xc9\x7fGe\xf1fO" +
+	"f\xdfdର\x8f\xffX:\x93\x89\x95\x9c\x97 \x9a؝\xa6~le\xb3f\x11f\xa1d\xa2\xac\x8f" +
+	"\x8fތ\xcaW\x0e\x935\x94\x02\x97\x98\x9afx?e\xb1f\x0ff\x9fd\xa0\xafk\x91ю\xbd\x8cs" +
+	"U&\x90K\x93\xfb\x96\xc9n$fEe\xe6fvf\U000f2953:\x90%\x8d0\x89\xa3R\xa1\x90U" +
+	"\x93#c\x89c)e\xcbe\x9eei\xb2\xf6\x96͓\xb9\x90\xa4\x8d0\x89\xb4Q\x95\x90\x86[\xd0a\x0e" +
+	"c\xb1f\x85e\x93\xb3\x9c\x96\x88\x96i\x93S\x8f\xe0\x8cd\x89\xe9O\xbeT\xf6^%`\xc8c\x9ce\xa8" +
+	"\x80\x00x\xc1w\xf7r@h\xe1_\x06W\xd7Qt\x1d\xdaH6G\xc8GKE\xc1J[_`_*" +
+	"_)_\xb5\\\xbfZ\xc4X\x04E\xed(5V\x1aXyZ\x13I\x94_\xaf_y_y_S_9" +
+	"]>Z~ErPr(\xe2YGZ\xe1I+`._\xf8_\xf8_\xd2_\x06_\xe7](D\xe9" +
+	"R\xa9Sc)\x96[\xb0G\xf5^H^\x12^\x12`E^\xd4_\x04_\x1cCqT/T\xe9U\xa4" +
+	")\x91~i\xba\x06\xa2Ң\xb0\xa5g\xa7\xed\xa6\xfa\xa7\xb7\x8e\x8bo\xe0p>p\xcenY\xaf\xae^C" +
+	"\xb3\x8c\xb3k\xb6\x84\xba\x02\xbd\xa7\xbd\xee\x86iMPL\x94L<J\xff\x9b~\xa9\xa4X\xaf\x94\xf2\x97\x1f" +
+	"\x99.\x9aٞ\x8e\x7f e\xfff]f\xedd\xea\x9b]\xa9\x83\x8e/W\x83\x94\xb7\x96\x11\x97\x99\x9bN" +
+	"x\x8be\xfdf[f\xebd\xe8\x9d\xf0\xac_\x90;\x8d\xf1U\xbd\x92t\x93\xfc\x97\xb1n\x88f\xb3fU" +
+	"f\xe5gX\xa0T\xaf\x99\x92,\x8f7\x8b\xc3S\xf6\x90V\x94%d\xbfdLf\xeff\xc2f\x8d\x9fn" +
+	"\xb2\xf6\x93\xb9\x90\xa4\x8d0\x89\xb4Q\x95\x90\x86\\\aa0c\xd2f\xa7e\xb5\xa0'\xb3<\x97K\x945" +
+	"\x90\u008d_\x89\xe9P\xacV\x1c_\x1aa\xbcd\x91f\x9c\x87>\x80\x00x\xa4r\x8eiD`+X\v" +
+	"R\x84\x1e\xe0IrI\x06H\x8cG\x05h\xfeJ\xbb_x_x`$]\xd9Z\xe3X\xecG\x11)?" +
+	"W\x0fYn[\aiNI\xf3_\xc8_\xc8_\xc2`S]][fF\x99QZ)\xecZ<[\xd6" +
+	"i\xcdI\x8a`G`G`A` `\x06^\x0fF\x13S\x90TK*\xa0\\\xa5guHT^]" +
+	"^]`\xad_\xef_#`\x04D\x9dU\x17U\xd1V\x8b*\x9b\x7f<\xab\xf5\xb9\x9a\xa3\x84\xa6;\xa8\xda" +
+	"\xa7秷\x8fcp\xd4q2q\xc2oI\xa4\\|ǵu\xa0N\xa3\x04\xa5\xa4\xa8O\xa7Z\x8e\x9d" +
+	"o\xd8p6p\xc6nM\xafL\xab}]I\xb0\x16\xb3/\xb6\xad\xbaR\xbd\xea\x86eM\xb4L\xf8L\xa0" +
+	"Kc\x9c,\x99(\xa6wW\xf8\x96!\x97\x93\x99\xa7\x9bOy/fEf\xa3g3e0\x9e\xbe\x9b\xba" +
+	"\xa9T\x8fVV1\x93\xf6\x96\n\x97\xb2n\xd4f\xfbf\x9dg-g\xa0\xa1;\x9e7\xac\x8d\x90\xb5\x8dB" +
+	"T\x8d\x92~\x94&e#d\xb6gYg,f\xf7\xa0V\xa0\xbf\xaf꒫\x8f7\x8b\xd4Rꐇ" +
+	"]=bOd\xf2g\xc6fՠ'\x9fس<\x946\x90\u008d_\x89\xe9P\xadVS_7a\xda" +
+	"d\xaef\xba\x88\b\x87[\x80\x00s-i\x93`\x8fY0R\xb9\x1f\xe6J\xaaJAI\xc9HEi\xe6" +
+	"i\x04K\x1a_\xc3`o^D[\xf9Y\aH2*IX\x00Z^[\xf8j5iSJR`\x13" +
+	"`\r`\xbe^s[\x81G\xbcR=*\xf5[-\\\xc7j\xb4i\xd2I\xe9`\x92`\x8c`\x8ba\x1d" +
+	"^*G9TtU.+\xaa]\x95hXgvH\xb3^\xa8`\xf8`Z`9`\x1fE\xc6U\xfa" +
+	"V\xb4Wn+\xa5\x7f<\xab\xf5\xab\x85\xb9\x97\xa6R\xa8\xf9\xa8\x06\xa7\xf0\x95\x8ep\xf1qOq\xdfof" +
+	"\xa4]|ȧصr\xa3\x1c\xa5¨\x88\xa7\x92\x951o\xf5pSp\xe3nj\xa3\xf7\xa0\x81zu" +
+	"\xb1S\x9f뢑\xa5V\xa8\x19\x94}n\xfboYo\xe9mp\xafI\xab{\xa7\xb1\\\x10\xb0G\xb2\xf6" +
+	"\xb6x\xba\x11\x86cM\xb1L\xf5L\x9dKa\x9eԛИЦ\xa6V,\x94\xba\x96\xef\x99<o\x02" +
+	"fgf#f\xb3g'\xa1X\x9eT\x9bT\xa9\"\x8e\x05T\x87\x93c\x95\xb0eSd\"f\xc5f\x98" +
+	"fc\xa0s\xa0\xf6\x9d\xf5\xac`\x90\x1b\x8c\xb8S\x06\x92*]pa\xddd\x80gTfc\xa0]\xa0\x0e" +
+	"\xa0\x95\xaf\xb2\x92G\x8e䋇Q\xa9V\x89_\x9db?e\x14g\x1f\x8d\xbf\x8dq\x8cҀ\x00i\xbe" +
+	"`\xbcY`R\xec\x1f\xe6J\xf0J\x8aJ\x15H\x94j\x01i\x1fh=K\x1a_\xe4]\xba[\x8fYh" +
+	"Ht*IX\x19Zw\\\x11jQinh\x8cJR_\x9c`4^\t[\xe2H\x02RU*\xf6" +
+	"[F\\\xe0j\xd0i\xedi\vI\xe9`\x1b`\x01`\xb2^\x8cG\x81T\x8bUF+\xaa]\xaehs" +
+	"g\x91f\xafH\xb3`\x86_\xcf_\xcf`\x80F\x10V\x11V\xccW\x86+\xa6\x82V\xac\xb1\xac\x1f\xac\x02" +
+	"\xbe)\xab\x17\xaaK\xaa4\x9f\xadt\x1ftqu\x01u\b\xa5\x0f\x7f\xe2\xa8r\xa8U\xba\x04\xa7᪪" +
+	"\xa9\xb5\x9f9s#stt\x04s\U00064261\x13}\x8f\xa4\xae\xb5䤯\xa7y\xaa<\x9e\xdcr)" +
+	"rzs\nr\xf7\xa4l\xa0\xf6\x9d\x83|\xa3\xb5\xa1\xa1\xa1\xa4O\xa7\x12\x9e\xafr'rxs\br\xf5" +
+	"\xb3\x7f\xaf\xb1\xab竦]\x98\xb3\x04\xb6\x8a\xba#\x86bQ\x12O\xc4OlO\r\xa3l\xa0h\x9dg" +
+	"\x9a\x87\xa9FU\x81\x96\xbf\x99\fe\xf4fHh\xedh\xc0iZ\xa2\xaa\xa3\r\xa0\r\x9d\x13\xac\x88\x8f\xfb" +
+	"T\x00\x95\x87]\x9ad\x03f\xa8i|iZ\xa2\x94\xa2%\xa2\xac\x9f\xb2\xafڒ(\x8e\xcbR\xa2WY" +
+	"a\xc2dhg<j\x16\x97\x1e\x96\xbb\x96l\x96A\x80\x00arY\xa7S\xcc#5J7M\x9fM-" +
+	"L\xabm\x03l!k?k?N6_\xd7]\xac[\x86G\xca-DZ|\\\xdb_DmNll" +
+	"k\x8ak\x89L\xf0bT`)^\x03J\xe0T\x9e.\x12]\xb1`\x1am\xcdl\xebl\bl\bL\x86" +
+	"b!b\xd3`\xacJbV\xd5W\x9e.\xc6`\xe9m\xcdl\xd1k\xefk\xefL\x16b\xaab\xaac[" +
+	"I\xd4Y\x15Y\xdeZ\x98/z\x85|\xaeR\xad\t\xac\xec\xb1/\xc1ϭV\xadD\xaaMw\xb9w\xa8" +
+	"x+w_\xa6\x98\x82\xec\xa9H\xa9+\xadm\xbd\xaa\xacݬ\r\xa9\x05v\xa1v\x90w\x13vH\xa5h" +
+	"\xa1݀\x9a\xa5\x84\xa9ƹ\x8a\xa9\xab\xacr\xa8\x91u\xa7u\x96v\x19uM\xa5K\xa1\xc0\x9eM\x7f\xad" +
+	"\xa7_\xb9G\xa6\x82\xa9I\xa8au\xa5u\x94v\x17uK\xa9f\xa5ܢi\xa0%|$\xb5Q\xa4*" +
+	"\xa6\xf1\xa7\x86t\x1ds\x88t\ft\xa6\xb6߳\x11\xafF\xaf\x06\xabs_c\xb6\x88\xba$\x86aP\xd8" +
+	"S7RMQϥ\x9d\xa54\xa23\x9f:\x9c\xff\xac\x9dU\n\x98\xd2^\x10fVh\xdak\xb2kU" +
+	"\xa5\x8a\xa4p\xa4֡ݟ\xa2\xaf\xf3\x91\xffS\xadW\x80d\x15f\x9aiql\x11\xa0\xf9\x9fԟp" +
+	"\x9fC\x9e\x8d\x80\x00Z5T\x10&\x95N\x03L\xf5PQO\xb3p\x80o\x84n\xa2n\xa2mBN\x1d" +
+	"_\xf4]\xcdKl.C\\\xae_\x9aa\xc8pYo^n{n{l\x9aP\x1bbS`,JD" +
+	"V\xc81\x1e`\"bQp\xd3o\xd7n\xf5n\xf5m\x14O3d\xffb\xd8MOY\x88Y\xf31\xf3" +
+	"c'p\fo\x11n/n.m\x9cN\xa7d\xa1eRL\xa8[\x92[\xfe\\\xc72\x9f\x87E\xae\xbf" +
+	"\xadv\xadY\xb1\x9b\xb5\xc8\xc1\xb6\xaf=\xb1\xfaz<z*zKys\xa7\x03\x86\x15\xaa\xf4\xaa֮b" +
+	"\xb2m\xc1i\xaf\x19\xb1\xc1z7z&zFyn\xa5ӣq\x83\xa7\xa7\x1b\xaa\xa7\xae\xb2\xbdH\xae\xa5" +
+	"\xb0{y!y\x10y0xX\xa5\xb5\xa3T\x9f͂\xba\xa8?\xab\xa3\xbd\x05\xab{\xb0Hy\x1fy\x0e" +
+	"y.xV\xa9Ѧģ=\xa0\xfa\x7f1\xa9E\xb9\x10\xa9#\xaf\xe5w\x97w\x02w#w\xb1\xad\xd9" +
+	"\xaa\xac\xa7%\xa4E\xa2\x04|\x85\xb7\xf6\xa6į6t:v:u\xbcv\x0f\xb6϶\x88\xb2\xbd\xb2}" +
+	"\xae\xea\xad\xfbaX\xba$\x86aP\x9eR\xfdU\xc0T\xb0\xa7\x82\xa7b\xa6\xfd\xa4\x04\xa1ɟ\x86\xb0\n" +
+	"T\xb7W\xcaf_h\xe9k\x9fnB\xa8(\xa7\xf4\xa6Ϧ\x9f\xa6X\xa5ʀ\x00Tu)\xf2N\xe3" +
+	"P\xc0O\xa7R\xd6r\xfdr\xfcr\x01r\x01p\xa1mnN\x04`\vLZ.\x9c_\x17a\xc8do" +
+	"r\xd6r\xd6q\xdaq\xdao\xf9oKP\x02bpM\xe5Y%2\x1dbPe\vr\xdfr\xdfq\xe3" +
+	"q\xe3p\x02n\xb9R^d\xfeL\xb2[\xad\\\x194\xffe\x94r\x13r\x13q\x18q\x17p\x85o\a" +
+	"QTgzO\x94^-^\xad_\x195ˉP\xaf\x06\xae\xe4\xaeǲR\xb6]\xb7Y\xc2ϸ\xcc" +
+	"|\xf3|\xe4}\x04{ɧQ\x87\xe0\xaby\xab\\\xae\xe8\xb2\xf3\xb75\xc1\x93\xb7\xa1|\xb3|\xa4|\xc5" +
+	"{\x8a\xa7/\xa3\xf3\x86ҫ>\xack\xaf\xbf\xb3\xdf\xc1K\xb7i|\xb0|\xa1|\xc2{\x87\xa7\x12\xa3\xd6" +
+	"\xa3\xbb\x85\xe5\xaa\x03\xac\xb1\xb0\xb6\xc1\b\xb73|\xae|\x9f|\xc0{\x85\xaa\x82\xa7F\xa4袥\x82A" +
+	"\xaa>\xaeC\xbd\x12\xb6){\vzxz\x99zĮj\xab.\xa8%\xa5E\xa2\xf0\x7f\x95\xacT\xbb\xf8" +
+	"\xb5\xc9w\xaey\xafy2y#\xafi\xafJ\xac!\xa9'\xa6Ҥ\xfd} \xbb\xa1\xb5FtQvS" +
+	"x\x84w\u05f7ڶ\xb8\xb6u\xb65\xb2\xa1\xb1\xb2\xb1ucq\x86aPgR\xc8U\x8bX#\xae\x8b" +
+	"\xad{\xadF\xad\x13\xac3\xab響\x80\x00-IN\xf0Q\x9eSpR*u\xaautusus" +
+	"s\xfap\xc7m\x93M\xedL\x87.\xd9`\xecd\x18f\x98u\x85uOuOuOsTr\xa7os" +
+	"O\xedN\xd2Z\xf32ud\xb5g5u\x8euXuXuWs]r\x15q\x80RJPQ]\xf3" +
+	"^r5\xfeg\xbdtQt\x1bt\x1bt\x1bspq\xf1p\xc2T\x7fN\xf5`O`\xcfa:8\xd8" +
+	"ѓ\xdbI\xda(\xda&\xdeb\xe2X\xe1\xf8\xe2\xb5\xef\xe0\xbb<\xba麘\xb6z\xd0\xc9\xd0oէ" +
+	"ե\xd9\xe1\xdd\xd7\xe1\xf3\xe1\x93\xee\xc0\xba6\xb9㹒\xb5sϾˇ\xcfM\xd1%\xd5a\xd9W" +
+	"\xdds\xe1\x8f\xed\xa0\xb90\xb8ݸ\x8c\xb4nϼˆ\xc7P\xcf>\xd2\x0e\xd5\x19\xd9\x10\xdd,\xed\x9f" +
+	"\xb9.\xb8ܸ\x8b\xb4lӷρ\xcbK\xc81\xcf,\xd4&\xd8\x1d\xdc9\xea\v\xb90\xb8~\xb8-" +
+	"\xb7\xdf\xd7q\xd3;\xcf\x05\xcb\x11\xca$\xcf\xfd\xd7\xc5\xdb\xe1\xe6e\xb8\x1f\xb9b\xb8\x8d\xb7<\xd7\x18\xd7\x17" +
+	"\xd2\xe1\xce\xcc\xcd\xdeͅ\xd0\xf4ۥ\xe2÷\x0f\xb8R\xb9\x97\xb7\xc2\xd7\xc8־־ҨѺ" +
+	"\xd1b\xd1%\xd1\xeb\xdf&\xb5\xff\xb7B\xb8\x87\xb8\xcb\xe2%\xe1\x1f\xe0\x19\xe0\x19\xdc\xca\xd9j\xd6\rҶ" +
+	"\x80\x00\xa6\x16\xaa\r\xae\x06\xb0\xfa\xb1ɰۯ\xed\xaf\xed\xaf\xef\xae\xe5\xadڬМ\xf2.\x1e\x8e\xbc" +
+	"\x90̑\xf9\xb1u\xb0\x87\xaf\x99\xaf\x98\xafB\xb0\x13\xaf\t\xad\xfe\xa0\x92\x87\xb2/0\x90\x9d\x91ɱ\"" +
+	"\xb04\xafF\xafE\xae\xf0\xafF\xb0:\xaf/\xa44\x89\xa7\x89t0J\x91\x9a\xadF\xacX\xabj\xabj" +
+	"\xae\x9f\xae\b\xae\x81\xaft\xa6\xe8\x8aˊ\x98\x8af1H\x84.\xab\xfd\xaa\xf3\xaaԯ\x06\xb1\xeb\xb2\xce" +
+	"\xb4\xd8\xc3=\xbbMu\x89u\xb4tu\xa2\x0f\x82\x94\xa7S\xa74\xabf\xaeK\xb2\xa8\xb3\xcd\xc1\xe9\xba\v" +
+	"uHust4\xa1\x19\x9d\xbd\x81 \xa3\x9a\xa7̪\xb0\xaf\x0e\xb3\xad\xc0\x9a\xb8\xcdu\bu3s\xf4" +
+	"\xa0\xfa\x9d\x9e\x9aF\x80\x14\xa7\xb1\xa8\v\xab\xa3\xb0\x1d\xc0R\xb8\x93u\x06u1s\xf2\xa4ۡ\x7f\x9e&" +
+	"\x9e\n\x7f]\xa6\x8d\xa9w\xad\xd5\xc1$\xb9qu0u[u\x8f\xa7\xa1\xa4E\xa0\xed\x9ew\x9d\r{\x9a" +
+	"\xa6\xe7\xabE\xbc\xf5\xb8hs\x82s(s\x1f\xa8\x88\xa8g\xa5\x0e\xa1\xe4\x9fٝlxɩN\xbb\xee" +
+	"\xb87p\x03rKq\xa1\xaa~\xa9\x8a\xa9l\xa6 \xa3\xfb\xa1\x8e\x9f\xb5v6\xbb̷\xebl\x84n\xcc" +
+	"p\xe0\xb7ɶ\x8d\xb5U\xb5\x0f\xb5ȱ\xfc\xb1\x1c\xb1\x0fY\xe9\x80\x00D\xacG\x98JZ\xb0\x85\xaf\\" +
+	"\xae5\xad\xfe\xaeŭ\xe3\xadɭ\x94y\x9e!7C\xb2F\x9eI[o~oGo\x0fo\x0fo5" +
+	"m\xc8j}g2B\x1dA\xc6$nZ\x91]\xbco\x81oJo\x13o\x12o8mGl\x99iN" +
+	"D\xa3DKV\x8a(T^hn6m\xffm\xc7m\xc7oCm\x1bk\xcfk;G\x01F\xa5Y\x86" +
+	"Z\x03,1\x81{\xab\f\xaa\x02\xa9\xe3\xac\xe0\xb0F\xb0z\xb2lì\xad$\xba\xefrgq(\xa1$" +
+	"\x7f˦b\xa6C\xa9@\xac\xa6\xb0U\xb1a\xc2V\xac\x19\xb9\xadr&p\xe7\xa0.\x9c\xd2~D\xa2\xa8" +
+	"\xa5\xa5\xa9\f\xac\xba\xb1A\xc1\x04\xab\x13\xb8nq\xe7p\xa7\xa0\x0f\x9c\xb3\x99[}'\xa3\x00\xa6f\xa9P" +
+	"\xad\xb1\xc0\xb9\xaa\xf8\xb85q\xe5p\xa6\xa2ڟ~\x9c%\x99\xb1z\ue8cc\xa6u\xaa\u05fdj\xab\x7f" +
+	"\xb7\xf4p\xefq\"\xa6\x00\xa2\xa3\x9fK\x9c֚$ze\xa4騝\xbe+\xa9*\xb8\xc2q,q#" +
+	"\xa6D\xa6#\xa2˟\xa1\x9c\xef\x9bwv٦&\xb9\xfe\xa6\xb9\xb8\x8ao\x9ao\f\xa8%\xa70\xa7\x13" +
+	"\xa3ǡ\x15\x9e\xfc\x9c\xa8t'\xb8\xf9\xa4R\xb88l\x1bn/\xb87\xb6\xf9\xb5\xbe\xb5u\xb2`\xb3\n" +
+	"\xaf?\xaeaU\xf2\xbbS\x80\x00CzF;\xa3#\xa2/\xa1?\xa1%\xa1\xa8\x9fv\x9d'\x9a\xe2\xb16" +
+	"J\xaeE\xaf_\x8ab,\xb0'\xae\xfe\xad\u05ed\x9f\xadf\xae\x1e\xad\xfc\xad\xc3y\x9eC\x1a\x1c\xd1C\x11" +
+	"E\xcelhl0k\xf9k\xf9k\x1dkSj\x00f\xb5@\xcb[\x0f@\xfb#\xd7Z\xedk\x1cj\xe5" +
+	"j\xadj\xadk(k'iPh\xa2C*]\x87CTV\xbc'\xb3~ɪ\x15\xa9\v\xa8\xec\xab\xe9" +
+	"\xae\xe0\xaeа\x13\xc4+\xaa9\xaan\xbaxmؠ4}\x03\xa5k\xa5L\xa8I\xab@\xae\xaa\xaf\b" +
+	"\xc2ѩ-\xa9c\xb97m\x97\x9f>\x9b\xe1{i\xa1\xb2\xa4\xaf\xa7\xa5\xab\x10\xae\xe8\xc1}\xa8'\xa8]" +
+	"\xb7\xf8mW\x9f\x1f\x9bØjz<\xa2\n\xa4;\xa7\xa5\xabX\xc10\xa8\r\xa8B\xb7\xbfmU\xa1\xea" +
+	"\x9e\x8d\x9b5\x98\xc0w\xf5\xa1`\xa4˨~\xbdި\x94\xa8\x06\xb7~mҤ\xaf\xa1R\x9d\xfa\x9a\xd0" +
+	"\x98\x1ev\b\xa1\xf8\xa5\xab\xba~\xa6;\xa9j\xb8\x0elΤ\x9e\xa4}\xa1$\x9d\xfa\x9bH\x98\x9eu\x9d" +
+	"\xa4#\xbbA\xa3\xea\xa7\x19\xb8\xdfm\f\xa5ܤ\xe8\xa4ʡ~\x9e̜\"\x9a\xaer3\xb7\x15\xa1}" +
+	"\xa4\xac\xb8\x87kz\xb8\xb4\xb7s\xb66\xb5\xea\xb2ү\xae\xb0X\xac\x8fQ\xf9\xb8g\xbc\x85\x80\x00B\x1d" +
+	"\xa0O\x9f[\x9ej\x9eQ\x9eԜ\x9e\x9ap\x98&\xaerIf\x98yEb^\xfd\xa0y\x9f\x85\x9e\x95" +
+	"\x9e{\x9eH\x9f\x96\x9dh\x9b\x1d\xb2@\x8f\xd4I\xddC\x85_쯲\xae\x89\xadb\xad+\xac\xf1\xadx" +
+	"\xae2\xad\xf4y\x9eB\xb4@\xe2\x18\x80BAg\xfeg\xc7g\x90g\x8fh\ng\"gXf\x06?S" +
+	"Zt[G@\x03#6{驜\xa8\x92\xa8s\xaa쮼\xaeb\xaf<\xc5Ĩ\x1c\xa8R\xa8\x87" +
+	"\xb6?\x9f\xb6z\r\xa4\xf2\xa4ӧL\xab\x1c\xae<\xae1\xc4h\xa7\x11\xa7F\xa7|\xb4\xfd\x9e\xc0\x9bc" +
+	"x_\xa18\xa3\xb2\xa7\x81\xaa\xa2\xae\x11\xc3\x10\xa6\v\xa6@\xa6u\xb3\xbf\x9e\xa1\x9bD\x97\xecw\"\xa1\r" +
+	"\xa4\x17\xa7\x12\xaa\x81\xc2\xc0\xa5\xf0\xa6%\xa6[\xb3\x85\xa0\U000dd5da?\x97\xcat\xfc\xa0c\xa3^\xa6\xcd" +
+	"\xbea\xa5\x9e\xa5\x10\xa5E\xb6Ȥ\x87\xa1+\x9dӚ\xa8\x97's\xea\xa0Ȥ7\xbb \xa3\x82\xa6\xb1" +
+	"\xa5\xfd\xb6\xe8\xa48\xa4\x16\xa0\xbe\x9dq\x99\xf1\x97\x80r\x1e\xa1h\xb7¡-\xa4\\\xa7\x8b\xb7\x91\xa4\xfe" +
+	"\xa4\n\xa3젠\x9d\x1f\x9a\xaf\x98\tq\u05f8\x86\x9e\xe0\xa2\x0f\xa5>\xb8d\xba>\xb8\xfa\xb7\xba\xb7k" +
+	"\xb3T\xb0L\xad)\xad\xd5O\x05\xb5\xa5\xb9Ľ\xe2\x80\x00\x9e?\x9dK\x9c[\x9cA\x9b\xf5\x99\xfa\x97\xc9" +
+	"\x95\x9f\xab\xd5I\x1f\x96e\x99\x1aE\x83\x9ej\x9du\x9c\x85\x9cl\x9bj\x9c\xf1\x9a\xc0\x98\x96\xaf\xa3\x8d\xd2" +
+	"I\x96\x99:C\xa4\x9e\x94\x9d\xa0\x9c\xb0\x9c\x96\x9b\x94\x9cD\x9d\xb8\x9b\x8e\xb3q\x90T\x90jJ=C:" +
+	"\xabê\x9b\xa9t\xa9<\xacA\xaca\xac\xfe\xad\xbby\x9eB\xb4@\xdf@b\x14\xd3^\xf5\xba$\xb9\x0f" +
+	"\xb7\xff\xbc)\xbe\u05ff\t\xbf\xc9\xc0{\x86oKNJ\xf6I\xb9\xaf\xc4Y&\x97x\x96\x81\x99\xa6\x9as" +
+	"\x9e\t\x9d\xc0\x9eI\x7fGe\x85f\x15d\x15\xaeĐ\x84W\x9c\x93A\x96f\x973\x9aɝ\x9e\x9dQ" +
+	"~leGe\xd7c\u05edɏ\x9b\x8c\x86V:\x93+\x93\xf8\x97\x8e\x9ac\x9d3w\xb9e\ne\x9a" +
+	"c\x9b\xb1\x98\x92\x89\x8ft\x8cdU\xa1\x91\x94\x94\x8e\x97@\x9a\x11o\xdde\xe0fof\xec\xb4\x14\x93F" +
+	"\x902\x8d!\x8a\xd1S\x0f\x90蓚\x96kd\xafe\xa9e|eG\xb4F\x96\xba\x93\xa5\x90\x95\x8d\xb2" +
+	"\x8a6Q\xf8\x90\xfa\x93\xcb\\_c\x8efceq\xb4\xfa\x96z\x96[\x93K\x90H\x8c̊OP\x1a" +
+	"\x90\xfaUT`\xa1cve\x85\xb5\xa4\x97\x01\x96\x19\x95\xfe\x92\xfc\x8f\x7f\x8d\x02\x8aUN6Oz\\\xdc" +
+	"_\xb0a\xc0\x80\x00x\xc1w\xf7q\xc5js`\x12XWQ\xc6LR\x1d\x05G`F\xe3EZH\xc2" +
+	"^\xef^\xb9^\x83_D_\x11]\x16ZSV\xc5E\x13'\xd9XtZ\x0eHY_n_8_\x02" +
+	"_\xc3^\xde_\xbf\\\xfcYnD\x8aR\x9b(\x8dZ\xddG#]\x88]R]\x1c`6^\xad^\xdc" +
+	"^\xf5[gC\x12T!T\xdc(\x88}\xfa\xbcJ\xa3_\xa2h\xa61\xa8\x00\xa6맯\xa87\x8e\x8c" +
+	"osp\x03m\x8e\xb1\xda]\xbc\xb5\xb8\xb4\xa8\xb8һ\x80\xbf\x03\xbfZ\xc0\r\x86iK\xb0KXJ\x1b" +
+	"\x9c\x03\xab\xb8X\x11\x94\xaa\x97\xe9\x99B\x9aʞ\x86\x9e9\x7f!e\x93f#d\x1f\x9b\x1a\xaa\xbd\x8d\xeb" +
+	"V\xae\x94\xae\x96\a\x97\x8f\x9bK\x9e\x1cx\x05eVe\xe6c㞧\xae\x8c\x90\xf3\x8d\xe2V8\x93\xbd" +
+	"\x94\x8e\x98(\x9a\xf9pAfNf\xdegQ\xa0`\xb1\b\x929\x8f(\x8c\xf1Td\x90蔜\x97m" +
+	"e\xe4f\xccf\x9ffk\x9f[\xb4F\x93\xa6\x90\x95\x8d\xb3\x8a7Q\xf9\x90\xfb\x93\xcb\\\x96c\xb0f\x85" +
+	"e\x93\xa0\x19\xb4\x9b\x97=\x94-\x91*\x8dǊOQ\b\x912Vza\x96djfz\xa0\x9f\xb5D" +
+	"\x96\xfb\x96\xe0\x93ސ{\x8d\x03\x8a\x8bO$P\xa3]\xd0`\xa4b\xb4\x87>\x80\x00x\xa4r\x13j\xd6" +
+	"a6X\x8bR\xd7Me\x1e\vH\x9fH$F\x9eh\x8eI\"_\b^\xd2_\xb3`+]5[:" +
+	"W\xacF:(\xe3Yi[\x03i\rH\xb9_\x87_Q`2_\xf9_\xde]\xe3ZVE\xb4S\x83" +
+	")\x97[\xd2f\xb4G\x82]\x9d]g`\x9e_\xc7^\xfb_\xdc\\ND>U\tU\xc3)\x92~\xcd" +
+	"\xac\x82\xbbޣ<\xa7\x05\xa8\xed\xa7ا\xaf\xa8o\x8fcphp\xf8n~\xa4\xe1|D\xb7\xb6\xa0\x05" +
+	"\xa3Υ\xb7\xa8@\xa7Q\xa8\x11\x8e\x9dolo\xfcm\x82\xb1w\xad\xa6\\\xae\xb1S\xb5}\xb8,\xbb\xae" +
+	"\xbfV\xbf\xa1\x86eL\x13K\xbbJ~\x9b\xe8\x98䧱W#\x96\x17\x97\x89\x99\x9d\x9bL\x9f\x04x\xa9" +
+	"e\x9ef.d+\x9fv\x9cr\xab\x81\x8fGV\xad\x95@\x96\x9d\x98)\x9b\xe1p\x8df\x96g&g\x99" +
+	"\xa1G\x9eC\xad\xfc\x90\xa6\x8eoT\xfb\x93\x11\x94\x9d\x98UfHg7g\nfՠB\xa0\xac\xb1:" +
+	"\x92\x9c\x8f\xba\x8cWSN\x90\xfb\x94\xcd]\xccd\xd0g\xa4f\xb2\xa0\x19\x9fʴ\x9b\x94-\x91+\x8d\xc8" +
+	"\x8aPQ\t\x912V\xb1a\xb3d\x88f\x97\xa0ՠ\x87\xb4\xe5\x97\u0094\xc0\x91]\x8d\xfe\x8a\x8cP\x12" +
+	"Q\xca^\xc0a\x95c\xa4\x88\b\x87[\x80\x00r\xb2k%a\x9aY\xb0S\fNv\x1f\x11I\xd9Ib" +
+	"G\xdeiuh\x93I\x81_\x1d_\xfe`\x96^L[VX\x90G])\xecZZ[\xf3i\xf4i\x12" +
+	"I\x18_\x9c`}`d`\xf5]\xff[9F\xd9Tf*\xa1\\\xc2g\x98f\xb6G\xe1]\xb2`\xe9" +
+	"`2`\x12_\xf7]2EfU\xecV\xa6*\x9c\x7f\xa0\xac\xba\xacI\xbbs\xa7٩\xc1\xa8\xac\xa8\x9d" +
+	"\xa8o\x96\x14qXq\xe8oo\xa5\x17}\x17\xa8\x9d\xb7L\xa4\xa2\xa6\x8b\xa9.\xa8?\xa8\x12\x95\xb7p\\" +
+	"p\xecns\xa4\xb2\xa1;z\xb3\xb3*\xa1q\xa3Y\xa5\xfd\xa8\xad\xa7\xba\x95\x02oao\xf1mx\xb1\x16" +
+	"\xadE\xa9x[ɲ*\xb4ظ[\xbc\x03\xbf\x9f\x86cLyL!J\xe4\xa0D\x9d@\x9a@\xa8u" +
+	"W\"\x96\xa9\x98 \x9a8\x9b\xe2q!f\xdagjgݢ\x16\x9f\x12\x9c\x12\xaa\xf0\x8f\xd4Up\x94\x94" +
+	"\x96\xab\x98Vf\x95gzgMg\x19\xa1\x11\xa1\x93\x9e\x93\xae.\x918\x8d\xd5S\xe5\x93$\x94\xce^1" +
+	"e6h\ng\x18\xa1\x01\xa0\xb2\xa1\x1f\xb1\x8f\x932\x8fόpR]\x913W\xe8b\xcfe\xa3g\xb3" +
+	"\xa0֠\x87\xa0<\xb4\xe5\x94\xc0\x91]\x8d\xfe\x8a\x8cP\x12R\x01^\xdaa\xaec\xbe\x8e:\x8d\xec\x8dM" +
+	"\x80\x00k\xb5a\xeaZ\x15T1N\xab \x17K\x0fJ\x9bI\x19jYivh\x94I\xe0`E`\xde" +
+	"^\xb3\\hX\xa7H|*\xf6[F\\\xe0j\xd8i\xf5i\x13Iw`\xc4`\xaba\\_\x11[P" +
+	"G\xfbUF+\xab]\xafh{g\x99f\xb7H@a0`y`ya\n]IF\x8bV\xccW\x86" +
+	"+\xa6\x7f\xe5\xacH\xab\xb5\xabJ\xbf\xb9\xa8\xf1\xa8\x05\xa7\xf6\xa7\xe2\x9d\xffqiq\xf9r\x00\xa4\xa1}\\" +
+	"\xa8\t\xa7\x9e\xbb\x91\xa5\xba\xa8e\xa7v\xa7b\x9d\x8bpmp\xfdp\xe9\xa4\x1c\xa0\xa6z\xf7\xa3\xf7\xb7o" +
+	"\xa2\x89\xa54\xa7\xfd\xa7\n\x9d.orp\x02o\uf8fc\xa0E\x9c\xd2x\xb7\xb3R\x9f]\xa2\a\xa4\xd1" +
+	"\xa7\x97\x9c\x8bnyo\tn\xf5\xb5\x00\xb10\xadc\xa9\x99Z0\xb2k\xb5\x1e\xb8\xa4\xbc@\x86bK\xff" +
+	"K\xa7KG\xa1J\x9eF\x9bF\x98J\xa8\xb2Tk\x951\x97j\x99\xbae\xc6e\xfee\xecf\x86\xa0l" +
+	"\xa0ϝΚҫ2\x8esR\xe0\x93\xe2\x962]dc\xb9f\x8efk\xa0\\\x9f\xed\xa0t\x9dx" +
+	"\xaet\x90\x8c\x8d-Q{\x92\xb0W\x1eatdIg'\xa0J\x9f۟\x90\xa0\x1b\xb1ɒ\xbd\x8f^" +
+	"\x8c\x05P\x10Q:^Wa+d\n\x95\x8c\x95)\x94ڔJ\x80\x00a,YZSyM\xf6\x1f\x01" +
+	"JVI\xe4Ibjai\x7fh\x9cg\xbaIq_w]M[\"X,G\xd5)\xdcZv\\\xdf" +
+	"j\xe0i\xfei\x1bh9I\b_^_\xf6]\xcbZ\xd6GVT\x81*\x91]\xaej\xe0i\xe4i\x02" +
+	"h H\x98_\xe6_\xcd`~]\x89F\xc8V\xc2W|+E\x83\b\xad欝\xac\x10\xb0\"\xc3V" +
+	"\xab\x11\xab\x05\xaa\xf1\xa91t\xa0u#tW\xa6)\x80c\xa8ܨO\xaca\xbf-\xaa\x97\xa9ϩ\xbb" +
+	"\xa7\xeas\x88t\vs@\xa4\xf8\xa1n}\xff\xa4\xa8\xa8\xba\xbb\v\xa7f\xaa4\xa9A\xa7vr\x8es\x11" +
+	"rE\xa4x\xa0\xed\x9dz{\xbf\xa5\x18\xb6\xee\xa4:\xa7\a\xa9ͧ\x1aq\x94r\x18qL\xa8Q\xa4\xc7" +
+	"\xa1T\x9d\xe5{\x14\xb7\xad\xa2\x17\xa4ʧ\x8f\xa7\xedq\xbdr@rڸW\xb4\x86\xb0\xb9\xac\xf0\xad\x9a" +
+	"[\xe1\xb5\x1b\xb8\xa5\xbcA\x86aOqN\x87N\t\xa3^\xa2\xf5\x9f\xf5\x9c\xf9\x9a\xf5\xabFS\xeb\x97-" +
+	"\x99}]\xdfe\xebh\xc3hf\xa3R\xa28\xa2\x9e\x9f\xa2\x9d\x85\xae\x8c\x90aR\x85\x95\xfbWLc\xa7" +
+	"f~i\"\xa3@\xa2&\xa1\xbb\xa2E\xa0(\xb1⒑\x8f8Q\x1aR\x1c`\x89caf\x05\x9f\xed" +
+	"\x9eɞe\x9e\x15\x9eӀ\x00Y\xecS\xc4N\xe6\"aI\xadM\tLjmllqk\x8ej\xac" +
+	"j\xd2L\x9d_v]KZVG9,\xe8\\\xe7_\x16m\xe6l\xeal\bk&kKK\xb5b\"" +
+	"_\xf8]\x02JCV\xd7-\xbd_\xecm\x1fl$kBj_k\xd4K)a\xc4bu_\x7fI\x9c" +
+	"X\xe2Y\xab.j\x84ϮI\xad\x00\xacr\xb0\x85\xb4\n\xc3$\xac\xfe\xac\xee\xb1vw\"wCvk" +
+	"\xa6\x8a\x83\x8a\xaa}\xa99\xadL\xb0\xae\xc2Ԭڬʱ=w\x1ew>vf\xa5Y\xa2\xf7\x81\t" +
+	"\xa5~\xa9\x90\xac\U000fec6cf\xab\x99\xaf\xf6v\bv(uP\xa4١˞E~ɥ\xee\xa9Q" +
+	"\xba\x94\xa9:\xac\x04\xaf\x82u\x0eu/tW\xa8\xb2\xa5\xa5\xa2\x1f\x9e\xb0~\x1e\xa7ջS\xa6\xfc\xa9\xc6" +
+	"\xb0Ru7uWu\xe5\xac\x1d\xa8\xf0\xa5i\xa1\xfa\xa0\x92z\xbc\xb7N\xa4\x98\xa7a\xaf\x9es\xc0s^" +
+	"s\xb1\xb80\xb7\xe6\xb4\x19\xb0P\xb0\xfa\xadY]Ǹ\xa3\xbcC\x86aO7Q\xfaP\xea\xa5J\xa5*" +
+	"\xa4šɟ\xac\x9dd\xae\xa1S\x90\x99FW\x9ce\xf6h\xackS\xa5;\xa5\x1c\xa4\x06\xa4p\xa2S" +
+	"\xa0\v\xb1\xfb\x92lR%R6b\xd8e\x8fh6\xa7\xa8\xa7t\xa6O\xa5ꦥ\xa6\x13\x80\x00T/" +
+	"O\x1f%\xc0MwL^O\x8do\xe9o\xe9n\xedn\vn1l\xe1L\x84_\x8f\\\x99J\xd9-\xe7" +
+	"_\x15a\xd1o\xf2o\xf2n\xf6n\x14n9lhN\xe0b\x1d_(I\xa6X\xfc0\xcabYo&" +
+	"o&n+mHn\xbdl\xb6M\xd5d\x9da\xa8L\x88[\x90[\xfc1\x96\x86\u05eep\xaeN\xad\n" +
+	"\xb1\x1c\xb4\x7f\xb5j\xc3\uabfd\xb8xy\xdcy\xfcx\xc1\xa6\xba\x85S\xaa䩟\xad\xb2\xb1\x14\xb5F" +
+	"¬\xae÷My\x9cy\xbdx\x82\xa6\x98\xa3\\\x842\xa7\"\xab5\xad\xe1\xb1\xf0\xc2a\xae\xa5\xb7\x15" +
+	"y\x99y\xbax\x7f\xa5l\xa20\x9fҁ֧~\xaa*\xae9\xbeC\xae6\xb5\xcfx\x84x\xa5wj" +
+	"\xa9F\xa6\n\xa3\xac\xa0*\x81+\xa8\xaf\xac\x17\xbf\x02\xab\xf9\xb6\x9cx\xadx\xcdx\xf8\xac\x90\xa9T\xa6K" +
+	"\xa2ȡa}ɩ\xac\xba\xfd\xa9\x94\xb64w6v\xd4vĭ\x80\xad`\xaa7\xa6\xb5\xa4\xb0\xa2c" +
+	"{5\xb9\xe4\xa79\xb5\xacs\xd9v\vu^\xb8\xec\xb7ȷ\x81\xb3\xb8\xb4c\xb0\xc1\xaf\xd3_ؼC" +
+	"\x86aN\xfeQ\xc1T]\xa7\xfb\xa7\x13\xa6\xf8\xa6\x97\xa4y\xa22\x9f\xf4\xb2\x12S/Rke\x1dg\xd9" +
+	"j`\xae9\xad(\xac\xf3\xabά\x86\xac;\xabЀ\x00Op)\x1dNVP'N\xe1r\x98rb" +
+	"rbqfq\x8cp<m\tLk^\xd4K\xc6.@azc\xfar\xa1rkrjqoq\x95" +
+	"o\xc3o\x16N\xc7agME[V1\xc9d\x82qdq.q.p3q\xa7o\xa0nXQ\x01" +
+	"c\xcaK\xea]\xb2^\x1e4\xa2\x89ӯ\xea\xae\xf1\xaeԲĵp\xb69\xb8\x15\xc5 \xbeG}w" +
+	"}\x99|^\xa8(\x88O\xab\x87\xabj\xafZ\xb2\x06\xb6\x15\xb7\x1b\xc3\xe1\xbd\x1a}7}Z|\x1f\xa7>" +
+	"\xa4\x02\x86\xef\xa8\x05\xab\xf5\xae\xa1\xb2\xb0\xb6\xfc¨\xbb\xf0|\xfa}\x1c{\xe1\xa7!\xa3堭\x85\xf3" +
+	"\xabܬ)\xaf\x81\xb3\xac\xc2b\xbb\xb8|\xf8}\x1a{ߪۧ\x9f\xa4g\xa4O\x85H\xaa\xad\xad_" +
+	"\xb1n\xc3!\xbc\x82} }C}m\xadz\xaa>\xa7\x06\xa4\xac\xa3D\x81ʪ߮\xef\xbf\x1b\xbbg" +
+	"{\x8e{-{\x1e\xaeI\xae*\xaa\xf2\xa7\xed\xa5裇\x7f6\xad\x04\xbe\x03\xbb\x13x1zey\xb7" +
+	"\xb0\x1b\xaf3\xaf\x18\xab\xf2\xa9էt\xa5\xa2|۽\xbc\xba\xa6t\xd5w\by\n\xba\x12\xb8\xee\xb7\xcd" +
+	"\xb7\x8b\xb85\xb4\x93\xb3\xa5\xb3xc\r\x86aN\xc9Q\x8dT*\xb3\xad\xb2\x9a\xb1\x89\xb1T\xb2\t\xb1\x19" +
+	"\xb0ఏ\x80\x00-\x9cNpQ4S\xcbv$u\xeeu\xb8u\xb7u\xddttqAn\rL^" +
+	"L\b.\xedd\ng\x1av.u\xf8u\xc2u\xc2u\xe8s\xfdsPp\x1cN\xbcNf]\xdb2\x90" +
+	"g\xb7t\xf2t\xbct\x86t\x86u\xfas\xdar\x92q\xfdP\xf6P\x9a`\xc3aB6,ѕ\xdb^" +
+	"\xda<\xd9\x1b\xde\xc9\xe1\xd4\xe1N\xe2\v\xe2\xb6\xf0Ż#\xbaն\xb9\xd0\xdd\xd0qջԚ\xdaH" +
+	"\xddS\xe1I\xe0\xe9\xe1\x94鷺\x1d\xb9ε\xb3\xcf\xd1˛\xcfO\xd0\x1a\xd5\xc8\xd8\xd3\xdc\xc9\xe0\xe5" +
+	"\xe0r\ue179\x17\xb8ɴ\xad\xce\xc6ʐ\xc6Z\xce/\xd1I\xd4T\xd8J\xdcf\xe0p\xede\xb8\x12" +
+	"\xb7ĳ\xa8\xd4\x15\xcf\xdf˩\xc7s\xcfF\xd23\xd5?\xd95\xdd?\ue579'\xb8ٸ\x8d\xd6\xf6" +
+	"\xd2\xc0Ί\xcaT\xc8T\xcf!\xd49\xd80\xdc9\xea\xef\xb9\x16\xb8i\xb7\x1b\xd6{\xd6z\xd2D\xce\x0e" +
+	"\xcb5\xca6\xcf\xf3\xd7\xd8\xdb\xe1\xe7I\xb8\x06\xb9M\xb7{\xd7+\xd6!\xd6!\xd1\xeb\xce\xef\xcd\xf1͘" +
+	"\xd0\xeaۦ㧶\xf6\xb8=\xb8\x85\xd7\xca\xd6\xc0ննһѼ\xd1c\xd1&\xd1\xe1\xde\xc8" +
+	"\xb5Q\xb6\x99\xb6\xe0\xe2\xfa\xe1\xf4\xe0\xee\xdf\xe8\xe0\xfeݞ\xda?\xd6\xe2\xd2c\x80\x00\xaa\u05eeб\xca" +
+	"\xb1\xab\xb0\xbd\xafϮ\xe1\xaf߯Ѯƭ\xbc\xac'\xa1N.*\x90\x8c\x91\xb8\xb1[\xb0m\xaf\x7f" +
+	"\xae\x91\xaf\x8f\xaf(\xaf\xfa\xae\xef\xadZ\xa4\xf0\x89d/E\x91\x89\xad\x82\xac\x94\xab\xa6\xaa\xb8\xafA\xad\xed" +
+	"\xaeC\xaf7\xad\xa2\xa7\xa9\x8a\x89\x8aV0C\x80\x95\xaaϩŨ\xc0\xac\xf2\xb0X\xafƱ\x93\xb4:" +
+	"\xc4\x1e\xbe\x0eq\x85pF\xa0\xeb~\xe5\xa6%\xa5 \xa9R\xac\xb8\xaf\xa1\xb0\x88\xb3/\xc2Ǽ\xc9qD" +
+	"p\x05\x9f\xf4\x9c\x98}^\xa1\x85\xa5\xb7\xa9\x1d\xac\x06\xb0h\xb2(\xc1u\xbb\x88q\x04oş\x02\x9b\xa6" +
+	"\x98N{\xff\xa2\"\xa5\x87\xa8q\xacҲ\r\xc0(\xbaJp\xc5o\x86\xa2㟇\x9c.\x98\xda{:" +
+	"\xa6k\xa6ʪf\xaf|\xc0\xf7\xbb%p\xefq#\xa6\t\xa2\xac\x9fT\x9c\x00\x9c\xccz\xb0\xa5=\xa8," +
+	"\xad&\xc1\xb8\xbb\xf1q,q#\xa5\x98\xa5w\xa2\x1e\x9eʝ>\x9b\xc6w\t\xa5\xa0\xaa\x9a\xbd\x8b\xba\xe7" +
+	"o~n\xf0\xa7V\xa6b\xa6D\xa2𠯞\x96\x9c.tV\xa8\xa7\xbc\x86\xba\xc4k\xffn\x13\xa9\xe5" +
+	"\xa8\xf0\xa7\xff\xa7楃\xa3Q\xa0\xe8\x9f\x13qC\xbcM\xbaPg\x86i\x9a\xb8\x9f\xb7`\xb6&\xb4\xf0" +
+	"\xb5\xa9\xb6R\xb2\x88\xb1\xa9\xb1\x8fU(\x80\x00C\tE˳\x18\xb1\xed\xb0į\x9c\xb0b\xb1\x17\xb05" +
+	"\xb0'\xaf\xd6y\x9e\x1b\xb3B5D\xf7k\x96k^k'j\xefk\x15kLi\xdff\x94ba@c" +
+	"@2\"\xb8Z\x06jJj\x13i\xdbi\xa4k!k i/h\x81dNB\xc2B\x91U\xe6&\x95" +
+	"}\xe3\xa9٨Χɫ\xfb\xae,\xae\x1c\xaf:\xb1\xc9Ĝ\xaa1\xbd\xb0l\xf6\x9f\xfa|\x1d\xa5." +
+	"\xa4)\xa8[\xaa\x8c\xad\xf7\xae/\xb0\xbd\xc3C\xa9&\xbckl\xb5\x9f\x04\x9b\xa7z\x83\xa0\x8f\xa4\xc1\xa6\xf1" +
+	"\xaa\\\xae\x0f\xaf\xb7\xc1\xee\xa8 \xbb*lu\x9e\x12\x9a\xb6\x97]y\x14\xa1+\xa3\\\xa6ƪy\xaf\x9c" +
+	"\xc0\x9e\xa7\x1e\xb9\xecl6\xa1\U000de5db>\x97\xeaxA\xa1\xb5\xa5\x1f\xa8\r\xad\n\xc1k\xa8\x02\xba\xc7" +
+	"mҤ\x02\xa0\xa6\x9dN\x99\xf9\x98nv7\xa27\xa5$\xaa\"\xbe\v\xa8{\xbasl\xb2\xa3\xf1\xa3\xd0" +
+	"\xa0x\x9d$\x9b\x97\x98\xd9ụ\x9c\xa7ѾΦ*\xbbAl\xf0\xa5\x0e\xa4\x19\xa3\xfc\xa0\xa8\x9ef" +
+	"\x9b\xa7\x9a4rb\xa5^\xba\xa2\xa3\xbd\xbb\x16k^\xa7\x86\xa6\x91\xa5\xa1\xa5\x88\xa3$\xa0e\x9e7\x9b\xe7" +
+	"o3\xb9a\xa0u\xba\x9df\xe5\xb9\x1c\xb7۶\x9d\xb5d\xb6\x1b\xb2\xf6\xb3\xa1\xafخ\xcbQ/\xbc\xf6" +
+	"\x80\x00A\xac\xa0?\x9fK\x9eZ\x9dn\x9e=\x9e\xb3\x9c\x85\x9a:\x97%\xb2\xa7H\xdbB\xaa_\x06\xb2\xba" +
+	"\xb1\x8f\xb0e\xaf>\xb0\x03\xaf\xb8\xb0p\xb0[\xb0\x04y\x9e@\x19\x17bAjg,f\xf5f\xbef\x86" +
+	"h\x03g\x01g7e\xe4a\xb1>\xebZr?@\"\x18{\x03\xa9_\xa8U\xa7P\xaa\xfe\xae\b\xad\x88" +
+	"\xaec\xb0&\xc65\xa8\x15\xa8J\xb9ğ|y'\xa4\xb5\xa3\xb0\xa7^\xaah\xadc\xadW\xaf\x1b\xc4\xd9" +
+	"\xa7\t\xa7?\xb8\x80\x9e\x86\x9b)wz\xa0\x15\xa3ĦΩȭ7\xae\x15Â\xa6\x03\xa68\xb7>" +
+	"\x9d\x94\x9a7\x96\xdfu\xfa\xa0.\xa38\xa63\xa9\xa2\xad\xfa\xc2/\xa5\x02\xa57\xb6\x00\xa0\xfd\x9d\xa0\x9aH" +
+	"\x96\xf4uH\xa0\xb8\xa2\xed\xa6\\\xaa\x8f\xc1\xef\xa5\f\xa5A\xba_\xa3۠~\x9d&\x99җwt\x19" +
+	"\xa0A\xa3\xb0\xa7㾭\xa5¥4\xb9\x9b\xa3i\xa3H\x9f\uf71b\x99\x8b\x97\x06rM\xa0\xe1\xa5\x14" +
+	"\xbbN\xa3m\xa6\x9c\xba*\xa40\xa3;\xa3\x1e\x9fʜ\xb9\x9a4\x97\x8er\x06\xa2Ǽ\x13\xa1 \xa4O" +
+	"\xba\xfb\xa5\xec\xa4\xf8\xa4\a\xa3\ue83b\x9e7\x9b\x90\x99gn\x06\xb6\xa4\x9dӡ\x02\xba|\xba\xa5\xb9a" +
+	"\xb8!\xb6涝\xb3\x95\xb0r\xb1\x1e\xac4N5\xba4\xbeS\x80\x00\x9e0\x9d;\x9cK\x9b_\x9b_" +
+	"\x9c\x0f\x99ޗ\xb4\x94\x99\xb0\vH\x93\x98\xfdB͞Z\x9df\x9cu\x9b\x89\x9b\x89\x9b\x84\x9c՚\xab" +
+	"\x97\x91\xb3ِ/I;Bc\xaf\x14\xad謿\xab\x98\xaf\x9c\xae\xe9\xafo\xb0)\xaf\xcey\x9e@\x1b" +
+	"?\x9f\x13\xb6^\x84\xbb\xb0\xba\x9b\xb9\x8a\xbc\xc6\xc0\x85\xbf\xe7\xc0\x84\xc1G\xc2-\x86oJ\x0eHѱ@" +
+	"X\x9b\x97\xc0\x96ș\x18\x9a\xf7\x9dםk\x9d\xfb\x9e\xc9\x7fHeFcF\xb0@\x90\xc6V\xfc\x93\x88" +
+	"\x95ؗ\xb7\x9a\x96\x9dI\x9d\x03\x9d\xd1~me\bc\t\xafE\x8f\u074c\xc9U\x87\x92\x9d\x94|\x97[" +
+	"\x9a\x0e\x9c\xe5\x9c\xddw\xb9d\xcbb̲;\x92\x03\x8e\xef\x8b\xdeT\xbb\x91E\x94>\x96\xf0\x99Ȝ\xdd" +
+	"oWefe\u2d69\x93\xbc\x90\xa7\x8d\x97\x8a\x80S|\x91\xeb\x93疜\x99\xb2fFeqe<" +
+	"\xb5\x1d\x96\x84\x93o\x90_\x8db\x8b\"RZ\x91G\x93\xfc\x97\x12]Mf<eK\xb5\xb3\x96$\x96\x05" +
+	"\x92\xf5\x8f\xf7\x8d\f\x8a\x8fPt\x91)\x94?U\xd6cOe_\xb6k\x96\xb1\x95ʕ\xae\x92\xb1\x8f\xa6" +
+	"\x8d)\x8azN\x8a\x90\x81O\xd8_\x86a\x95\xb7=\x97q\x96\x8a\x95\xa6\x95\x9e\x92\x93\x90\x16\x8dg\x89\xdb" +
+	"NTL\xe7_\xc0aπ\x00x\xc1w\xf7q\xc5i\xf8a\x85Y/R:L\xa4I\xdc\x1cIF{" +
+	"D\xf2G\x84^\xaa^t^>^\xca^\xcc_\x94\\\xd1Y?YtD+'\x84Z\tFN\\\xc4" +
+	"\\\x8e\\X_<^\x9a^\xb1^\xc9[7[mB\xb3T\x14'\x7f}\x8a\xbd\ue8e7\xa2\xb0\xa5\xa3" +
+	"\xa8\x84\xa6\xb8\xa7Z\xa7騷\x8e\x8do5l\xbf\xb3n]3\xb7D\xb63\xb9o\xbd.\xbf\xe0\xc0\x16" +
+	"\xc0\xd8\xc1\xbf\x86iJoI2\x9cF\xad4Wp\x94\xf1\x97Z\x99ƚ\x97\x9e1\x9d랹\x7f!" +
+	"eTcQ\x9b]\xac:\x8e.U\xfc\x94\x1f\x96\x8b\x97\\\x9a\xf6\x9d͝\xc6x\x05e\x18c\x14\x9e\"" +
+	"\xaf/\x90m\x8d]UR\x93m\x94?\x97ٚ\xb0\x9d\xc6o\xbae\xd4fH\xa0ֲ\x9e\x92\xae\x8f\x9e" +
+	"\x8c\xa0Tё\xec\x94闞\x9a\xb4g{f\x94f`\x9f%\xb5\x1d\x93p\x90_\x8db\x8b\"RZ" +
+	"\x91G\x93\xfc\x97\x12]\x83f^el\x9fõT\x96\xe7\x93אڎ\b\x8a\x90Qb\x91`\x94v" +
+	"V\xfcdDfS\xa0P\xb6\f\x96\xac\x96\x91\x93\x93\x90\xa1\x8d)\x8a\xb0Ox\x90\xb8Q\x01`zb\x8a" +
+	"\xa1\x10\xb6ݗl\x96\x89\x96\x80\x93\x8e\x90\x16\x8d\x9d\x8a\x11OBN\x12`\xb4bć>\x80\x00x\xa4" +
+	"r\x13j\\b\xa9YcSKM\xb7J\xf2\x1dOG\xbdF6hIG\xe3^\xc3^\x8d_8_\xe6" +
+	"_\xb3]\xb8Z&Z\\EU(\x8eZ\xfee\xf0F\xad\\\xd9\\\xa3_\xa4_\xb5^\xd0_\xb1\\\x1f" +
+	"\\TC\xdfT\xfb(\x89~]\xacʽ\x82\xa3\x84\xa6w\xa9r\xa7\xa6\xa7Z\xa8!\xa8\xef\x8fdp)" +
+	"m\xb0\xa5${\xbf\xb9W\xa0M\xa3@\xa6;\xa8\x0e\xa6\xfd\xa7è\x91\x8e\x9eo-l\xb4\xb3\f\xaf8" +
+	"\\\x10\xb2\u07b6\x1a\xb9ټ\x8c\xc0\x12\xc0l\xc1S\x86eJ\xd3I\x96\x9c+\x99'\xa9.Vq\x95\x89" +
+	"\x98\x0e\x99k\x9a\xf7\x9e\xb6\x9e\xaex\xa9e_c\\\x9e\xf0\x9b\xec\xac#\x8e\xc2Uǔ\xf0\x96M\x97\xd9" +
+	"\x9b\x98\x9e\xaep\af\x1cf\x90\xa1\xbd\x9e\xb9\xaf\x92\x91\x1c\x8e\x1fUh\x94\x14\x94ꘆ\x9b\x9cg\xdf" +
+	"f\xfffʠ\f\xa0v\xb2\x12\x92f\x8fi\x8dBS\xaf\x91H\x94\xfe\x98\x14^\xb9g~f\x8c\x9f\xc3" +
+	"\x9fu\xb5T\x93אڎ\b\x8a\x90Qb\x91a\x94wW3dafq\xa0\x86\xa07\xb5\xad\x97s" +
+	"\x94u\x91\x84\x8e%\x8a\xb0Pf\x90\xf0R(ajcz\xa1F\xa0\xf7\xb6~\x97k\x97c\x94q\x91\x12" +
+	"\x8d\x9d\x8aGP0O;a\xa5c\xb4\x88\b\x87[\x80\x00r\xb2j\xaac\x0eZ\x88S\x80N\xc9L\x06" +
+	"\x1eVH\xfaGvi0hNHB^\xd8_\x83`Q`\xc9]\xd3[\n[?Fz)\x98[\xef" +
+	"f\xd4e\xf2G\f\\\xee_\xef` _\xe6_\xcc]\x02]8E\aU\xdf)\x93\x7f0\xad\x01\xac\x91" +
+	"\xbd\x17\xa7K\xaaF\xa8z\xa8H\xa8!\xa9&\x96\x14q\x19n\xa0\xa5Z|\x92\xa8\xe5\xb8\xed\xa4\x14\xa7\x0f" +
+	"\xa8\xfb\xa7\xea\xa7èɕ\xb7p\x1dm\xa4\xa4\xf5\xa1~z\x1b\xb4Ƞ\xe3\xa3ޥʨX\xa7l" +
+	"\xa8q\x95\x03o#l\xa9\xb2\xaa\xae\u05eb\a[\x1a\xb2Ƕ\x86\xb98\xbc\xbf\xc0j\xc0\xe8\x86cK8" +
+	"I\xfb\x9f\xbf\x9c\xbb\x99\xbb\xa9\x17V<\x96Y\x97Й蛙\x9f\x96p\x9bf`fԢ\x8c\x9f\x88" +
+	"\x9c\x87\xac\x86\x8f\x84Uܕ\x97\x96\xf8\x98\x87\x9c\x85h,gBg\x0e\xa0ۡ]\x9e]\xaf\x06\x90\xe7" +
+	"\x8e\xc1TF\x93p\x94\xff\x98\xfc_\x1eg\xe3f\U000a0ae0\\\xa0ɲH\x92\xe1\x90\x0f\x8c\xb0R\xb7" +
+	"\x91a\x95yXje}g\x8c\xa0\x86\xa07\x9f쵭\x94v\x91\x84\x8e%\x8a\xb0Pg\x90\xf0R`" +
+	"a\x84c\x93\xa1|\xa1-\xa0\xe2\xb6\x1f\x98E\x95S\x91\U0010e64aHQ\x1ePcb\x91d\xa0\x8e:" +
+	"\x8d\xec\x8dM\x80\x00k;c]Z\xecT\xa5N\xfeM\x18\x1f\\J3H\xb2j\x14i1hOH\xa1" +
+	"_\xcb`\x99a1^\xe6[!\\\x1fG\x9c*\xa2\\\xdbg\xb7f\xd5e\xf3Gk`6`g`M" +
+	"`\xdf]\x1a^\x17F,V\xbe*\x9d\x80G\xacǬ4\xab\xc9\xc0\xf4\xaa2\xa8\x88\xa8V\xa8I\xa8a" +
+	"\x9e\x85q\xfdr\x05\xa5\x1a}\xa9\xa8\x88\xa8\x1d\xbcʦ\xfb\xa8\xe7\xa7֧ɧ\xe1\x9e\x10q\x01p\xed" +
+	"\xa4\x95\xa1\x1e{3\xa4v\xb8\xa5\xa3ʥ\xb6\xa8^\xa7r\xa7\x89\x9d\xb4p\x06o\xf3\xa44\xa0\xbe\x9dK" +
+	"x㴅\xa0\x9e\xa2\x8a\xa51\xa7\xe4\xa76\x9d\x11o\rn\xfa\xb66\xb2b\xae\x92\xaa\xc7Y׳\xad" +
+	"\xb6_\xb9潑\xc1`\x86bK&JǢx\x9ft\x9ct\x99x\xa9\xe9US\x96ژU\x9ap" +
+	"\x9c_g\xb2f\x9eg8\xa0\xe8\xa1J\x9eJ\x9bN\xaci\x90\x00S\xbc\x94͖\xe7\x98\xd7^ng?" +
+	"g\x1c\xa0\xb8\xa0I\xa0ϝӯ\xab\x91g\x8e\bRO\x93d\x95SW\xd2d\xfag٠\xac\xa0=" +
+	"\x9f\xf2\xa0c\xb3\x0f\x93e\x90\x06\x8c\xabP\xbd\x90\xcaR\x99a\xb7d\x95\xa0\xbb\xa0L\xa0\x01\x9f\xba\xb6\x8e" +
+	"\x95-\x91Ύs\x8a\"P O\x9da\xc2d\xa0\x96\a\x95\xa3\x95U\x94Ā\x00b\xf5ZST\"" +
+	"O;Le\x1fKJiI\xe7j\xe4j\x02i\x1fh=H\x92`\x04`\x9c^q[X[ZG\xd1" +
+	"*\x91]\xaej\xe4i\xe8i\x06h$H!`\x8c`sa$^\v^\rGCW|+F\x80\x95" +
+	"\xad7\xab\xee\xaba\xaf%\xc4E\xa8\xa5\xa8z\xa8m\xa8\x9f\xa7\xa6r\x17qK\xa5z}ۨ-\xa7\xa0" +
+	"\xabd\xc0\x1a\xa8+\xa7D\xa76\xa7h\xa6^p\xffp4\xa4I\xa0\xbe{e\xa3\xf9\xa7\xbd\xbb\xf5\xa4\xfa" +
+	"\xa7\xa9\xa6\xbc\xa6\xee\xa5\xeap\x05o9\xa3Ƞ>\x9c\xcby\x15\xa4\x1b\xb7աΤ|\xa7I\xa6\x9b" +
+	"\xa5\x8eo\fn@\xa7_\xa3Ԡb\x9c\xf3w\x19\xb4\xba\x9f\x8e\xa2<\xa5\b\xa8\x13\xa5\xfcn=n\xd7" +
+	"\xb9A\xb5m\xb1\x9e\xadҪ\xf3Xk\xb3ᶘ\xba\"\xbd\xf1\x86aJ\xbdJ?\xa0\xfb\xa0\x92\x9d\x92" +
+	"\x9a\x96\x98u\xaa\x1bRɕY\x97\x95\x9a*]\xb0e\xd0e\x8e\xa0ҟ\xb8\xa0\x1e\x9d\"\x9b\x02\xac\x9e" +
+	"\x8e\x96Q\\\x94\x11\x96\xa6W\x16c\x8bf/\xa0Ɵ\xac\x9fA\x9f˝\xab\xaf䐴\x8dXO\xec" +
+	"\x927Q\xe1`ic\x0e\xa0\xee\x9fԟi\x9f!\xa0\x88\xb3b\x93\x1e\x8fË\x8bP.N\xe8aL" +
+	"c\xf0\x9ez\x9dV\x9c\U0005c89d\n\x80\x00Y\xa6SwN\x93K\xbf\x1eFI\xc0I!j\xf5i\xf9" +
+	"i\x17h5gxH3_B]\x17Z\x1eZ\xecG7)\x88\\\xb1j.i3hQgnh\x01" +
+	"G\xa7^\xfc_\x94\\\x9b]iF\x90V\x8e*4\x82Y\xad\x97\xacN\xab\xc0\xaf\x85\xb2\xb7\xc4\n\xaas" +
+	"\xaaj\xaa\x9b\xb0\x93t7s_\xa5\u0600\xff\xa9˨\x88\xac*\xaf\\÷\xaaO\xaaF\xaax\xb0Z" +
+	"t2sZ\xa4\xa8\xa2F~m\xa4̨n\xab\xa1\xbf\x92\xa9۩\x15\xa9G\xaf\x13s\x1crD\xa4'" +
+	"\xa1\x1a\x9d\x93|\x1d\xa4̧\xff\xbbr\xa6\xaf\xa9\x7f\xa8Ү\x9fr#qK\xa7\xbd\xa4\x90\xa1\n\x9d\x9b" +
+	"z!\xa5I\xb8W\xa4o\xa7?\xaaJ\xafCqTq\xe2\xaaƧ\x99\xa4\x12\xa0\xa4\x9e\x11y\x9f\xb9\x06" +
+	"\xa2?\xa4\xf4\xa7\xff\xb0\x04q\x8eq\xe1\xb9\x11\xb8Ĵ\xf4\xb1(\xaeJ\xae\xe6Z9\xb6\x96\xba#\xbd\xf3" +
+	"\x86aN0M \xa2ɢ\xaa\xa2E\x9fI\x9d(\x9b\x18\xac\xb3Rf\x97\\\x99\xf1Wke\xb9h`" +
+	"\xa2\xc1\xa2\xa2\xa1\x8c\xa1\xf6\x9f֝\xac\xaf\xfd\x90\x8cP\xf6\x95\x82R\x03b\x97e?\xa2\xe9\xa2ʡ\xb3" +
+	"\xa1L\xa2\xb2\xa0\x89\xb3{\x92\xf6\x8e\xbeQ9O\xcaczf!\xa6Ц\x9c\xa5w\xa5\x13\xa5\xac\xa6Y" +
+	"\x80\x00S\xe6N\xd3L\xb0!\xa6I\x15LEm\x01m\x01l\x05k#jfj\x9cK^_=\\C" +
+	"]\x11F\x9a,\x94_\x1el5l5k:jWj\xeaj\xeaJSa\xbd^\xc3_\x91I|X\xe0" +
+	"-a\x84^\xad\xbb\xad\x98\xacT\xaf\xf7\xb3)\xb3l\xc4ȭ9\xadn\xb8\x00v\xf0u\xb5\xa6\x05\x82\xc5" +
+	"\xaa.\xa8ꬌ\xaf\xbf\xb3HÆ\xac?\xact\xb6\xd6v\xb1uv\xa5㢧\x81\x93\xa6m\xa9Y" +
+	"\xac\x8b\xaf\xf2\xc39\xac!\xacV\xb6\x9dv\xaeus\xa4\xb7\xa1{\x9f\x1d\x7f'\xa5\xa2\xa8Ԭ;\xbf\x18" +
+	"\xab\xb2\xab*\xb5Wu\x99t^\xa8-\xa4\xf1\xa1\xe8\x9ee}+\xa6\x1e\xa9\x85\xbb\xfd\xa9q\xac\x80\xb5\xe3" +
+	"t\xcat\xf5\xab6\xa7\xfa\xa4\xf1\xa1n\x9e\xdb|\xaa\xa7\xfd\xbc\xad\xa7'\xaa6\xb6\xa2u\x04t\xf5\xab\x88" +
+	"\xabh\xa8?\xa4\xbc\xa2*\xa0\xb6yb\xb8\xa9\xa4ǧֶ\x15s\x8er\xfb\xb9Ÿ\x9e\xb8U\xb4\x89" +
+	"\xb1\xaa\xb2F\xae\xa6\\>\xba!\xbd\xf4\x86aM\xf7P\x93\xa5\x81\xa4\x99\xa4~\xa4\x1d\xa1\xfc\x9fӝ\x8f" +
+	"\xb0\x12R\x01\x98\xcdR<d\xe2gi\xa5\xac\xa4Ť\xa9\xa3\x97\xa4ݢ\xb4\xa0p\xb3\x94\x91\xf2RC" +
+	"O\xd8e\xc5hK\xadŬ\xb4\xac\x7f\xabZ\xabݬ\x88\xac\x19\x80\x00O)L\xde%\x05L\xdeK\x98" +
+	"o\xb0ozoyn~m\xc2m\xf7l\xa8KE^\x83_QJ9-\x93aGnsn=n=" +
+	"mBm\xd4m\xd4l\x03M\x7f`\xe5a\xb3H\xde[\x010m\x87X\xaf\x0e\xae\x16\xad\xf8\xb0³\xf4" +
+	"\xb4\x15\xb5\xdaŚ\xb1\x11\xbd\xf3z\x8dyR\xa7O\x85\xbf\xaa\xab\xaa\x8e\xadW\xb0\x8a\xb3\xf1\xb4\xe0\xc4Y" +
+	"\xb0\x17\xbc\xc6zNy\x13\xa6e\xa3)\x84M\xa7)\xa9\xf2\xad%\xb0\x8c\xb4\xc1\xc3\x1d\xaf#\xbb\x9cz\x10" +
+	"xզH\xa3\f\x9fԃA\xa7z\xaa\xad\xad]\xb1p\xc2ԯ\t\xbbdz\x0exӨ\xf3\xa5\xb7" +
+	"\xa2\x7f\xa0%\x81)\xa7⪒\xae\xa5\xbf\xb8\xaf\x86\xbb\x1ey$yO\xab\xfc\xa8\xc0\xa5\x88\xa3.\xa0\x87" +
+	"\x80\xa8\xa9\n\xacv\xc0h\xad<\xbb\xday^yN\xac-\xac\x0e\xa8֥ѣ*\xa1\xb6}`\xaa\x10" +
+	"\xbcd\xaaܻ\x7fw\xe8wU\xad\xe9\xad\x02\xac\xe6\xa9\xc1\xa7\x1a\xa5\n\xa2\xc0z\xe9\xbbM\xa8\x85\xbb\f" +
+	"t\x8bv\x8d\xba\x8d\xb9f\xb8C\xb7\xfd\xb5\x1f\xb5\xbb\xb2\x1a\xb1-_m\xbd\xe2\x86aM\xbfP\\\xa9:" +
+	"\xa8S\xa7o\xa7X\xa7ӥ\xa9\xa3f\xa1+\xb3\xa1S\xd8P)h\xdakf\xb3[\xb2H\xb16\xb1\x01" +
+	"\xb0ıl\xb1,\xb0ր\x00MQ)\x8aM\xecP\x82s=s\ar\xd1r\xd1q\xfbr1p\xe2" +
+	"m\xaeK6bVKZ.[d|r\x01q\xcbq\x95q\x95r\x0er\x0ep=o\x8fMpd\xbe" +
+	"M\x8e^&1\xf6\x86\x85\xae\u05ed߬\xeb\xb0ܴ\x0e\xb3x\xb5\x1a\xb7\x85\xc6\x03\xc0\xe8y\xbfx\x84" +
+	"\xa7\x19\x84\xec\xaat\xa9\x81\xadq\xb0\xa4\xb3T\xb4!\xb6\x8b\xc4\xc1\xbf\xb8y\x7fxD\xa60\xa2\xf4\x83{" +
+	"\xa6\x1c\xaa\f\xad?\xaf\xef\xb4\x02\xb5\x96Å\xbe\x8byAx\x06\xa5J\xa2\x0f\x9eւ0\xa6\xac\xa9\xdf" +
+	"\xac\x8f\xb0\xa2\xb5}\xc2N\xbday\x05wʩ\x04\xa5Ȣ\x90\x9f\\\x81x\xaa\xb1\xab\x03\xae_\xb3\x18" +
+	"\xc3\n\xbe)y-yX\xac\r\xa8ѥ\x99\xa2e\xa3)\x80\xf6\xa9z\xac0\xb0\xceù\xbe\xe3yg" +
+	"yX\xab\x93\xabt\xa8<\xa5\b\xa3\x8a\xa2\x16}\x93\xa9\xb5\xaeS\xbf\xb5\xbd\xc6w\xd6wC\xad/\xacG" +
+	"\xac,\xa8\xf8\xa6Τ\xbe\xa2a{\x1b\xack\xbe\x9e\xbd\x80tyv{\xaf\x8d\xae\xa5\xad\u00ad\xaa\xaba" +
+	"\xa97\xa6ڥ\fxM\xbe9\xbc\xe5p+r-\xba\xec\xb9Ÿ\xa2\xb7\x83\xb8*\xb8Ƶ&\xb49" +
+	"\xb3\xf7^\xb1\x86aMXO\xf4\xb6#\xb5\r\xb3\xf9\xb2糚\xb4@\xb3O\xb3!\xb2\xae\x80\x00(\x84" +
+	"M%O\xc1ryrCr\rq\xd7q\xfdr2p\xcam\x96i\x80J\xd7J\xa6-Rc\xa9q=" +
+	"q\ap\xd1p\x9br\x0fr\x0fp%owkaM\x11L\xdf]^0\xedч\xdbb\xda@\xd9\x1f" +
+	"ݭ\xe2*\xe0\xb9\xe1Q\xe1\xfc\xe3\x1b\xf1\x8f\xba\xe4\xb6\xc8\xd0\xe1\xd0cտԞ\xd9,ݩ\xe0\xb4" +
+	"\xe0.\xe0\xd9\xe1\xf8\xf0n\xb9\u07b5\xc2\xcf\xd5˟\xcfA\xd0\x1eԬ\xd9)\xdc4\xe0+߸\xe0\xd6" +
+	"\xefN\xb8ش\xbc\xce\xcaʔ\xc6^\xce \xd0-Ԫ\u05f5۬ߵ߶\xee/\xb7ӳ\xb7" +
+	"\xd3\x10\xce\xdaʤ\xc6n\xce%\xd1]\xd4h\xd8_\xdch\xe0\xe5\xeeA\xb7巙\xd7E\xd3\x0f\xce\xd9" +
+	"ʣǇ\xcf*\xd25\xd5A\xd9%ݢ\xef^\xb8緙\xd5\xf0\xd5\xf0Ѻ̓\xcah\xc8W" +
+	"\xcf\x06\xd4<\xd8 ܝ븸ַ)\xd6~\xd5t\xd5t\xd1>\xce\"\xcb8\xca9\xcf\xd8\xd7\xc8" +
+	"\xdcE\xe8\x13\xb7Ƹ\x0e\xd7\x1d\xd6\x13\xd5\n\xd5\t\xd1\xed\xce\xe1\xcd\xe2͊\xd0\xcfۑ\xe3.\xb6\"" +
+	"\xb6i\xd8&\xd7\x1c\xd6\x13\xd5\t\xd6#\xd3\x17\xd2\x18ѿ\xd1\x12\xd1\xd5\xe4L\xb7$\xb7l\xe3\xb6\xe2\xb0" +
+	"\xe1\xa9\xe0\xa3\xe0\xb4\xe1\xb9\xdeY\xda\xfa\xd6u\xd7{\x80\x00\xafs\xb2m\xb1j\xb0|\xaf\x8d\xae\x9f\xae\xb0" +
+	"\xaf\x9d\xaf\x8f\xae\x84\xac\xef\xadݥ\x88.?\x91L\xad\x90\xac\xa2\xab\xb4\xaaƮb\xaeb\xad\xfa\xae\xcc" +
+	"\xad7\xae$\xa8A\x8a\x1d/=|\xfd\xa9\x9c\xa8\x92\xa7\x8c\xaaخ>\xae-\xae\x86\xb0\xef\xb1\xd6\xc5\r" +
+	"\xc0\"l\x0f\x9f\xc0{8\xa4\xf2\xa3\xed\xa78\xaa\x9e\xae\b\xad{\xaf\xe4\xb0\xcbô\xbe\xdbkΞ\xca" +
+	"\x9bny\x9d\xa0R\xa3\x9d\xa7\x03\xaam\xad[\xaeޯ\xc5\xc2_\xbd\x97k\x8e\x9dؚ|\x97#x." +
+	"\xa0\b\xa3n\xa6ةŮî\xc3\xc1\x0f\xbcVkO\xa0杊\x9a1\x96\xddw\x19\xa0֤@" +
+	"\xa7.\xac+\xaf\xa7\xc0ڼ-l\xaf\xa4\v\xa0\xaf\x9dW\x9a\x03\x97\x97v\x83\xa5\x16\xa5y\xa9\x8c\xad\a" +
+	"\xc1\x98\xbc\xf5l\xaf\xa3\xfa\xa3٠\x81\x9d,\x9a\xc1\x9b\x80v\x17\xa3\xf0\xa7:\xaa\xb5\xc2[\xbd\xc0l\xeb" +
+	"\xa4a\xa3l\xa3O\x9f\xfb\x9d\x90\x9b\xf6\x9a\x83r\x91\xa4\xb2\xa8-\xbe/\xbc\xb6k>\xa6\xb8\xa5ä\xd2" +
+	"\xa4\xb9\xa2N\x9fݝ\xaf\x9bJob\xa5n\xbc\xee\xbczfŧ\x8b\xa6\x96\xa5\xa5\xa4\xb9\xa5\x89\xa3\x18" +
+	"\xa0ꞅ\x9b\xf5os\xbdʽUg\x02\xb9\x84\xb8B\xb7\x05\xb5̵\x96\xb6?\xb6\xea\xb3!\xb2\x13" +
+	"\xb2\xdaP\x8c\x80\x00A<\xb5\x0e\xb3߲\xb3\xb1\x89\xb1_\xb2\x13\xb2ȱ\xe5\xb1ǲ\x8ey\x9e\x16E" +
+	"@\x94fWf\x1fe\xe8e\xb0f\xf6f\xf6g,e\xc0a\x8ca\xc3>\x83>| \xf9z\x1d\xa9#" +
+	"\xa8\x18\xa7\x13\xa9ۮ\x1a\xacԭ\xaf\xafM\xb04Ʀ\xa8\r\xbc@\x9fBxA\xa4y\xa3s\xa6;" +
+	"\xaaz\xac\xaf\xac\xa3\xaeB\xaf(\xc5J\xa7\x02\xba\xf9\x9eL\x9a\xefv\x94\x9f٢\xa1\xa6ߩ\x15\xac\x83" +
+	"\xad;\xae\"\xc3\xf3\xa5\xfb\xb9\xb5\x9dZ\x99\xfd\x96\xa5u\x14\x9f\v\xa3J\xa5\x7f\xa8\xee\xad \xad!\u00a0" +
+	"\xa4\xfa\xb8t\x9f\U0001c4d9;\x95\xe7t \x9f٢\x0e\xa5}\xa9\xb0\xad+\xc1^\xa4\x1e\xbbΣ\xe4" +
+	"\xa0\x87\x9d/\x99ۖ\xa1te\xa0\x96\xa4\x05\xa7M\xaa\xc8\xc2:\xa50\xbc&\xa2\xbc\xa2\x9b\x9fC\x9b\xef" +
+	"\x98\xb4\x97Vr}\xa1 \xa4h\xa7\xe4\xbeۥ\xae\xbbң\x83\xa2\x8e\xa2q\x9f\x1d\x9b㚄\x97\xc9" +
+	"r6\xa2\x1b\xa5\x96\xbf\xa0\xa3`\xbc\x9f\xa5\x1e\xa4)\xa38\xa3\x1f\x9f坮\x9a\xf4\x98\xcan6\xa2C" +
+	"\xba1\xa0\x13\xbc[\xa5\xf1\xa4\xfc\xa4\f\xa3\x1f\xa3 \xa0\xe9\x9e.\x9c\x05\x98\xe5nG\xbb\b\xa0\xf9\xbd2" +
+	"\xbb\r\xb9ɸ\x89\xb7M\xb6\x18\xb6\u07b3\xba\xb4g\xaf}\xb0>M\x92\xbeÀ\x00\x9e \x9d+\x9c;" +
+	"\x9bO\x9a|\x9bx\x9b\xf2\x99Ȗ\xae\x97\x81\xb4AH8A\x8d\xb1q\xb0C\xaf\x16\xad\xec\xb1\x01\xb1M" +
+	"\xb1\x02\xb1\xb9\xb1\x94\xb2Uy\x9e>\xdb\x12\x98^\x10\xbc\xe7\xbbҺ½\xfd\xc0\xcd\xc1@\xc1\x0e\xc1\xae" +
+	"\u0095\xc3\x7f\x86oG\xe4\xb2mX\r\x97ܖ\xe5\x994\x9a>\x9e/\x9d\r\x9d{\x9eI\x9f\x1b\x7fH" +
+	"bt\xb1n\x90\xe0VY\x93\xa5\x95\xf4\x96\xfd\x9a\xef\x9c뜂\x9dP\x9e\"~mb6\xb0r\x8f\xf7" +
+	"\x8c\xe3TӒ\xb9\x93\u0097\xb4\x99\xb0\x9ce\x9c]\x9d/w\xbaa\xf9\xb3h\x92\x1d\x8f\b\x8b\xf8S\xf7" +
+	"\x90\x8b\x94\x97\x96\x92\x99G\x9c]\x9cZoWe\x0f\xb5\xfd\x93\r\x8f\xf9\x8c\xe8\x89\xd2R\x87\x91p\x93l" +
+	"\x96!\x997\x9cPe\xbfd/\xb6d\x96Г\xbc\x90\xac\x8d\xae\x8a\xa8R\xba\x92\x1f\x93\xfb\x97\x11\x9a+" +
+	"^\xb1e<\xb6;\x95ƕ\xa6\x92\x96\x8f\x99\x8c\x93\x8bRP̑(\x94>\x97XV\x9ce4\xb6\xd4" +
+	"\x962\x95K\x95/\x922\x8f,\x8d \x8aqNݐ~\x93\x97PMak\xb7\xa6\x96\xf2\x96\v\x95'" +
+	"\x95\x1f\x92\x19\x90\r\x8d^\x89\xd1N\xa4\x94iMEa\xa5\xb8{\x97\xb6\x96ϕ\xeb\x95\x1b\x95\n\x92\xfe" +
+	"\x90O\x8c\u008d\x86N\x95J\xf1a߀\x00x\xc1w\xf7q\xc5i\xf8a\nZsR\xedM\fJ/" +
+	"G\xf7\x1b\xa7D\x8aEt[\xfc[\xc6[\x90^t]\x9d^\x9a^\x9a[\b[>[sBT&v" +
+	"}\x17\xbf=\xa3ḁ̂\xbf\xa7ʧ\x11\xa6\xfc\xa7i\xa87\xa9\t\x8e\x8dk\xed\xb4\xb3\\\xa8\xb8{" +
+	"\xb7k\xba\xa6\xbdv\xc1:\xc0\x9f\xc1@\xc2&\xc3\x11\x86iHE\x9c_\xaeaVΕ\x0e\x97w\x99\f" +
+	"\x9a\xf0\x9dӝk\x9e9\x9f\v\x7f\"b~\x9bv\xadg\x8eHUH\x94<\x95ї\xb5\x9a\x98\x9dM" +
+	"\x9dE\x9e\x17x\x06bA\x9e<\xb0\\\x90\x87\x8dvT\x8e\x92\xb4\x94\x97\x97z\x9a0\x9dF\x9dBo\xbb" +
+	"eu\xa0'\xb2\xf1\x92\x00\x8e\xef\x8b\xf2Sܑq\x94n\x97#\x9a9\x9dSf\xf4eR\x9fq\xb6d" +
+	"\x93\xbc\x90\xac\x8d\xaf\x8a\xa9R\xbb\x92\x1f\x93\xfb\x97\x11\x9a+^\xe7e]\x9fd\xb5ܖ\x89\x93x\x90{" +
+	"\x8d\x8e\x8bRQ\xba\x91_\x94u\x97\x8fW\xc2f)\x9fѶu\x96-\x96\x12\x93\x14\x90(\x8d \x8a\xa7" +
+	"Oː\xb5\x93\xcfQvb_\xa0\x91\xb7F\x96\xed\x96\n\x96\x01\x93\x15\x90\r\x8d\x94\x8a\aO\x92\x94\xa1" +
+	"Npb\x99\xa1U\xb8\x1c\x97\xb1\x96Ε\xfd\x96\x06\x92\xfe\x90\x85\x8c\xf8\x8d\xbcO\x83L bԇ>" +
+	"\x80\x00x\xa4r\x13j\\b/Z\xa7S\xfeN\x1fKEI\x10\x1c\xadE\xcee)E\xd3\\\x11[\xdb" +
+	"^\xdc^\xb7^\xba_\x81[\xef\\%\\ZC\x80'\x80}\xea\xac\xe6\xbeѣ\xa0\xa6\x93\xa8\xb8\xa7\xff" +
+	"\xa6\xfc\xa7\xa0\xa8n\xa9@\x8fdlݥ={7\xba\xa4\xa0i\xa3\\\xa5\x81\xa8f\xa6\x9f\xa7C\xa8\x11" +
+	"\xa8㎞k\xe1\xb4Q\xb0z[p\xb4\x16\xb7Q\xba!\xbd\xe5\xc0\x9b\xc0\xd3\xc1\xba¤\x86eH\xa9" +
+	"\x9cE\x99A\xaa[U\xbc\x95\xa5\x97T\x99Ú\x99\x9e5\x9e.\x9f\x00x\xaab\x89\x9f\n\x9c\x06\xadP" +
+	"\x8e\xdbU\x03\x946\x96\xa6\x97{\x9b\x18\x9e.\x9e*p\ae\xbd\xa1\x0f\x9e\v\xaf\xe5\x90n\x8dpTs" +
+	"\x93\x99\x94n\x98\v\x9b!\x9e;gYe\xbc\xa0Y\xa0óX\x92\xb3\x8f\xb6\x8c\xc9T\x0f\x92 \x94\xfd" +
+	"\x98\x13\x9b-`\x1df}\x9fe\x9f\x16\xb5ܓx\x90{\x8d\x8e\x8bSQ\xba\x91`\x94u\x97\x8fW\xf9" +
+	"fF\xa0\a\x9f\xb8\xb6\x16\x96\xf4\x93\xf7\x91\n\x8e\x1c\x8a\xa7P\xb9\x90\xec\x94\x06R\x9dcO\xa0Ǡx" +
+	"\xb6\xe7\x96\xec\x96\xe4\x93\xf7\x91\t\x8d\x95\x8a=P\x80\x94\xd8O\x9ac\x8a\xa1\x8b\xa1<\xb7\xbd\x97\xb0\x96\xe0" +
+	"\x96\xe8\x93\xfa\x90\x86\x8d.\x8d\xf2PrMLcĈ\b\x87[\x80\x00r\xb2j\xaab\x93[\xccT3" +
+	"O0LXJ&\x1d\xb3G\x0ef\fe*F3\\&_'_\"_\xd0_\x9d\\\xd3]\b]>" +
+	"D\xa8(\x8a~\xbd\xad\x1d\xac\xad\xbef\xa7g\xa9\x8c\xa8ҧꧡ\xa8\xa6\xa9x\x96\x15mͥs" +
+	"|\n\xa9\x01\xba9\xa40\xa6U\xa9T\xa7\x8c\xa7C\xa8H\xa9\x1a\x95\xb8lѥ\x0e\xa1\x97y\x82\xb6\x12" +
+	"\xa0\xff\xa3$\xa6#\xa7\xfa\xa6\xeb\xa7\xf1\xa8Õ\x03k׳\xef\xb0\x19\xacGZh\xb3\xfe\xb6κ\x91" +
+	"\xbdH\xc0\xd1\xc1O\xc2:\x86cI\x0e\x9f\u061cԙԪEUx\x95\x9f\x98)\x99\x8a\x9b\x19\x9f\x16" +
+	"\x9f\x12p\x9bf\x01\xa1ݞٛ٬َ\xd5T\xe7\x95\x1c\x96}\x98\f\x9c\t\x9f#g\xa6f\x00" +
+	"\xa1(\xa1\xaa\x9e\xaa\xb0L\x914\x8eGT\xa6\x94H\x94\xfe\x98\xfc\x9c\x15`\x82f\xe3\xa0L\x9f\xfd\xa0k" +
+	"\xb2В\x82\x8f\x95\x8dsS\x0f\x91`\x95x\x98\x91Y0gb\xa0\a\x9f\xb8\x9fm\xb6\x16\x93\xf7\x91\n" +
+	"\x8e\x1c\x8a\xa8P\xba\x90\xed\x94\x06R\xd4ci\xa0\xfd\xa0\xae\xa0c\xb6\x88\x97Ɣّ뎐\x8a=" +
+	"Qn\x95\x10P\xc1dv\xa1\xc1\xa1r\xa1'\xb7^\x97\u0097ʔܑ\x81\x8d.\x8e(Q`Nv" +
+	"d\xb0\x8e:\x8d\xec\x8dM\x80\x00k;b\xe2\\1UXOfMjK;\x1e\xbaHJf\xf0f\r" +
+	"e+F\x92_o_i`7`\xaf\\\xea]\xe8^\x1dE\xcd)\x94\x7fԬ\xe3\xacP\xab\xe5\xc2D" +
+	"\xa9x\xa8\xe1\xa7\xf8\xa7ɧ\xe1\xa8Ꞇq2\xa53}\"\xa8\xa4\xa89\xbe\x17\xa6A\xa9@\xa7x" +
+	"\xa7I\xa7a\xa8j\x9e\x11p\x1b\xa4\xae\xa18z\x99\xa4\x92\xb9\xef\xa3\x10\xa6\x0f\xa8\x00\xa6\xf1\xa7\t\xa8\x12" +
+	"\x9d\xb4o \xa4N\xa0םdx:\xb5̟\xe4\xa2\xe3\xa4ӧd\xa6\xb6\xa7\xbf\x9d\x11n'\xb7z" +
+	"\xb3\xa4\xafҬ\x03Y\x16\xb3\xf5\xb7\xb9\xbao\xbd\xf8\xc1\xc7\xc2J\x86bIڡʞƛƘ\xc9" +
+	"\xaa<T^\x96_\x97ڙ\xf4\x9b\xe4\x9f\xe5g+f+\xa14\xa1\x97\x9e\x97\x9b\x9a\xad\xaf\x8f\x86T\x1d" +
+	"\x95\xa5\x96\xe7\x98֜\xd8_\xd2g\r\xa0Y\x9f\xea\xa0q\x9dt\xb03\x90\xee\x8e\xcbR\xa7\x93c\x95R" +
+	"\x99TX\x98g\xae\xa0-\x9f\xbe\x9fs\x9f\xe4\xb3y\x92\xeb\x8f\xfd\x8c\xa2Q\x0f\x90ǔ\xe2S\x0edk" +
+	"\xa0<\x9f͟\x82\x9f;\xb6\xf7\x94\xb4\x91Ǝj\x8a\x17Pp\x94\xeaO\xfcdv\xa15\xa0Ǡ|" +
+	"\xa04\xb7m\x98\x87\x95\x99\x92=\x8e\x04\x8e\x02QON\xa0e\x83\x96\a\x95\xa3\x95U\x94Ā\x00bz" +
+	"[\x98T\xd5O\xa3L\xb7Kd\x1e\xa9I\x80j\x1ci!h>g\\GH_\x8f`]`\xf5]\xdb" +
+	"]\xde^\xdbF\xe4*=\x80\xf5\xad\x8a\xacA\xab\xb4\xafy\xc5,\xa9\xba\xa8Ѩ\xa2\xa8Ԩ\xef\xa8+" +
+	"qK\xa5\xc9~'\xa8\x80\xa7\xf3\xab\xb7\xc0\xff\xa9A\xa7\x9b\xa7l\xa7\x9d\xa7\xb9\xa6\xe4p4\xa4\x98\xa1\x0e" +
+	"{\x9e\xa4L\xa8\x10\xbcצ\x0f\xa8\x00\xa6\xf1\xa7#\xa7?\xa6po9\xa4\x18\xa0\x8d\x9d\x1by?\xa4o" +
+	"\xb8\xb4\xa2\xe3\xa4ԧ~\xa6Ц\xec\xa6\x14n@\xa7\xae\xa4$\xa0\xb1\x9dBw6\xb5\x96\xa0\xa3\xa2\x93" +
+	"\xa5=\xa8/\xa7\x85\xa6\x82n\u05fa&\xb6P\xb2~\xae\xb0\xab\xceX\x03\xb4Ϸ\x86\xbb\x0f\xbe\xde±" +
+	"\x86aI\xba\xa2\x00\xa1\x97\x9e\x97\x9b\x9b\x99z\xab\x02S\xa4\x96ט2\x9a\xae\x9c\xa1_Xf;\xa1%" +
+	"\xa0\n\xa0q\x9du\x9bT\xad\x86\x8f\xfaR.\x94\xae\x97*\x99\x1dW\xf9fݠ\xf9\x9fߟt\x9f\xfe" +
+	"\x9dް̑F\x8d\xeaP\xb9\x92\xb8\x94\xacR\x87c\xbb\xa1!\xa0\x06\x9f\x9b\x9fT\xa0\xa1\xb4J\x93\x97" +
+	"\x90<\x8c\x02Pה\xb3PGd{\xa13\xa0\x19\x9f\xae\x9ff\x9f\xfb\xb7̕c\x92\b\x8d\u038d\xcd" +
+	"PbM\xecd\x87\x9e\xf5\x9dНl\x9d\x1d\x9d\x85\x80\x00[1TLO0M\x05J\xc1\x1e\xaaI\xa7" +
+	"j/i3hQgoh\x01G-_\x9e`6]=]\xeb]\xedG\v*5\x7f㬼\xabs" +
+	"\xaa殪\xb1\x8fĥ\xa7ۧ\xb3\xa7\xe5\xa8\x1a\xaf:pN\xa5\x00~u\xa8\U00067b6bO\xae4" +
+	"\xc4P\xa7\xb8\xa7\x8f\xa7\xc1\xa7\xf7\xaf\x01pJ\xa3ϡn{У\U000674eax\xc0(\xa7C\xa6^" +
+	"\xa6\x90\xa6ƭ\xbao4\xa3O\xa0B\x9c\xbbyq\xa3\xf1\xa6ּ\x05\xa4\x17\xa6ɦ\x1b\xa6Q\xadG" +
+	"n:\xa6壸\xa02\x9c\xc3wh\xa4 \xb8\xe7\xa1פ\x88\xa7\x93\xa6\xea\xad\xeanѩ\xaa\xa6}" +
+	"\xa2\xf7\x9f\x88\x9c\xf5u\x98\xb5\xbc\x9f\x8a\xa2;\xa5F\xa8U\xaeXmڹ\xab\xb9\\\xb5\x8a\xb1\xbb\xae\xda" +
+	"\xab\xedV\xb6\xb5\b\xb7\xa0\xbbo\xbfB\x86aIR\xa0=\xa0\x1e\x9f\xb9\x9c\xbc\x9a\x9c\x98p\xab8Q;" +
+	"\x95B\x97ךpW<ei\xa0\x18\x9f\xf9\x9e\xe2\x9fM\x9d,\x9b\x00\xad\xa1\x8e\x7fOœf\x95\xff" +
+	"Q\xcdbG\xa0@\xa0!\x9f\n\x9e\xa3\xa0\t\x9dݱ\x1f\x90錯P\x05\x96 O\x8fc*\xa0k" +
+	"\xa0L\x9f6\x9eΟc\xa0\xbd\xb4\xa1\x93W\x8f\x1d\x8f5PpM7d\r\xa5\x8c\xa5X\xa43\xa3\xce" +
+	"\xa4g\xa4\u0380\x00S\x9fN\x85L]J\x1c\x1d\xa4H\xfci@i@hEgcg\xf5g\x13F\xcd" +
+	"^\xd8[\xdf\\\xad]{Fq),\x81\xe6\xacݬ\xbb\xabw\xaf\x19\xb1\xfe\xb1\xee\xc5Y\xaa\x82\xaa\xb8" +
+	"\xaa\xed\xb7Er\xa5\xa5*\x809\xa9Q\xa8\f\xab\xaf\xae\x93\xb1\xca\xc4\x15\xa9\x89\xa9\xbe\xa9\xf3\xb6\x1arf" +
+	"\xa5\b\xa1\xcc~\xf4\xa5\x90\xa8{\xab>\xaet\xc3ũj\xa9\xa0\xa9յ\xe2rc\xa3ܠ\xa0\x9eB" +
+	"|y\xa4ŧ\x87\xaa\xbe\xbf\xa2\xa8\xfb\xa8t\xa8\xa9\xb4\x9cqN\xa7S\xa4\x17\xa1\r\x9d\x8bzp\xa4\xd1" +
+	"\xa8\b\xbc\x84\xa6\xbb\xa9ʩ \xb5(q\xe5\xaa\x18\xa6ܣ\xb3\xa00\x9d\x9dx\xa0\xa5E\xb9Y\xa4n" +
+	"\xa7}\xaa\x8b\xb5\xbap\xed\xaa\b\xa9馿\xa3=\xa0\xaa\x9e\vx;\xba\n\xa2(\xa56\xa8E\xb6{" +
+	"q'\xbaV\xb9,\xb8\xe1\xb5\x12\xb21\xafD\xaf\xe1X\xa6\xb7\x9e\xbbq\xbfD\x86aLŢס\xf0" +
+	"\xa1աs\x9fS\x9d'\x9b\x01\xad\xb5PЖ\xb1\x99JR\vdq\xa3\x03\xa2\x1c\xa2\x00\xa0\xee\xa24" +
+	"\xa0\a\x9d\xe2\xb18\x8f\xe3Q\x10\x99kO\xa4eT\xa3.\xa2G\xa2,\xa1\x19\xa1\x8e\xa2\xe8\xa0´\xba" +
+	"\x92Q\x92iQ{N\x1af7\xad\x12\xac\x01\xab̪\xa7\xab*\xab\xb3\xac`\x80\x00N\xe0L\x92K\x0e" +
+	"!\x04HOk~kHkHjMj\xdfi\xfdj3I\xf9^\x01^\xcf_\x9cE\xd2,8\x84\xdd" +
+	"\xae-\xad5\xad\x17\xafᲣ\xb2\x94\xb3\xb0\xc6#\xae[\xae\x94\xbd\x89vB\xa6q\x83/\xa9ʩ\xad" +
+	"\xacv\xaf9\xb2o\xb2\xb6\xc4߭a\xad\x9a\xbc\\v\x03\xa5\x87\xa2K\x81\xac\xa6H\xa9\x11\xabԯ\n" +
+	"\xb2\x97à\xacl\xac\xa6\xbb2uťj\xa2.\x9e\xf6\x80\x90\xa6\x99\xa8\xa5\xabܯG\xc3U\xacS" +
+	"\xac\x8c\xba\xfauè\x15\xa4١\xa1\x9fG~l\xa5ک\x11\xac|\xc07\xacЬL\xba\xb3v>" +
+	"\xaa\xba\xa7~\xa4F\xa1A\x9e\x9a|\x9c\xa6N\xa9\xb9\xbd\f\xaa\x83\xad\x95\xbb.uG\xaa\xaa\xaa\x8b\xa7S" +
+	"\xa4M\xa1\xa7\x9f\b|7\xa85\xbd\xbd\xa8=\xabO\xbb\xedu\x81\xabȪ\xe0\xaaŧ\xa0\xa4\xf9\xa2Z" +
+	"\xa0\xeay\x0f\xb9\xbb\xa5\xe0\xa8\xf3\xbbut\v\xbb\x16\xb9\xec\xb8Ƹ~\xb5\x9d\xb2\xb0\xb3M\xaf\xae[\xcb" +
+	"\xbb\\\xbf4\x86aL\x8e\xa6\x91\xa5\xa9\xa4Ƥ\xaf\xa5)\xa2\xfd\xa0מ\x98\xb1CR\xa5\x9d\x9eO\xfb" +
+	"hn\xa6\xc0\xa5٤\xf5\xa4ޤ\xa7\xa5\u18fc\xa1|\xb4ɖ\x7fS\x10N8iQ\xb2\xf3\xb1\xe0" +
+	"\xb0ϰ\x99\xb0\\\xb0ϱz\xb1\x1f\x80\x00M\vKR%\x8bM9o\fn\xd6n\xa0n\xa0o\x19" +
+	"n7nlm\x1dI\xeaa\xd9b\xa7J\x82-\xc1\x84\v\xad\xf6\xac\xfe\xac\n\xaf\xfb\xb2\x06\xb1\xf7\xb2\xf1" +
+	"\xb5Dƌ\xae]\xc0\x94us\xa6;\x82]\xa9\x93\xa8\xa0\xac\x90\xae\x9c\xb1ұ\xf7\xb4J\xc5G\xadc" +
+	"\xbfdu4\xa5R\xa2\x15\x80٥;\xa9+\xab7\xaem\xb1سV\xc4\t\xaco\xbe7t\xf6\xa4l" +
+	"\xa10\x9d\xf8\x7f\x7f\xa5˧\u05eb\r\xaex\xb3<\xc2ϫ\x7f\xbd\x0et\xba\xa8&\xa4ꡲ\x9e~" +
+	"~\xba\xa6K\xa9\x81\xac6\xb0\xd7È\xacR\xbd\xd6vH\xaa \xa6䣬\xa0x\x9e\xfa|Φ\xaa" +
+	"\xa9^\xae\x00\xc0]\xac½~u5\xaa\x10\xa9\U00066e63\x84\xa2\x06\x9fT|i\xa7ګ\xba\xc1\x0e" +
+	"\xaa|\xbe9uo\xab\x0e\xaa&\xaa\v\xa6פ\xad\xa1\xfb\xa0\x8byA\xa9W\xbd\f\xa8 \xbd\xebs\xf9" +
+	"\xadV\xaco\xab\x8b\xabt\xa9*\xa6w\xa4R\xa2\fvX\xbb\xb4\xa4\xf0\xbdKo\xab\xbbu\xbaK\xb9&" +
+	"\xb8\x04\xb8\xa9\xb5\xbc\xb6Y\xb2\xba\xb1\x99[\x0f\xbf\x9c\x86aL&\xa6\x8b\xa5\xa3\xa4\xc0\xa3थ\xa5\x13" +
+	"\xa2\ue82e\x9d\xa9\xb5(R\"Mqh~\xb5д\xba\xb3\xa7\xb2\x95\xb3H\xb2\xfa\xb3\xa2\xb3m\xb2\xf4" +
+	"\x80\x00J\x9e$\x86LxnHn\x12m\xdcm\xa6o\x1bn\x1fnUm\x05h\xefI\x8ba\xdfI\xd3" +
+	",\xb8\x838\xad\xbf\xacǫӮ\xee\xb2 \xb2\x11\xb2T\xb4\x85\xb5[\xc6\xf4\xc2\xe9t\xa1\xa6\x06\x81\x8b" +
+	"\xa9\\\xa8i\xab\x83\xae\xb6\xb1\xec\xb1Z\xb3\x8b\xb4aŰ\xc1\xb7ta\xa5\x1c\xa1\xe0\x80\a\xa5\x04\xa8\x1e" +
+	"\xabQ\xae\x87\xb1;\xb2\x97\xb3l\xc4q\xc0\x88t#\xa47\xa0\xfb\x9d\xc3~\xac\xa4\xbe\xa7\xf1\xab'\xad\xdb" +
+	"\xb2}\xb2}\xc37\xbf[s\xe7\xa7)\xa3\xed\xa0\xb5\x9d\x80}\xa8\xa5}\xa8\xb3\xabg\xb0\t\xb3O\xc3\x01" +
+	"\xbf2u:\xaa1\xa6\xf5\xa3\xbd\xa0\x89\x9e1}\x1c\xa9y\xa9ϭ\x97\xb0\xdeî\xbf\xe8u:\xaa!" +
+	"\xaa\x02\xa6ʣ\x96\xa1>\xa1\xf6|\xb7\xa8J\xabQ\xae\x98\xc4_\xc0\xa1ut\xaas\xa9\x8c\xa9q\xa6=" +
+	"\xa3\xe4\xa2Z\xa0\xeayt\xa8ڬ!\xc0\\\xbf\x84s㬜\xab\xb4\xaaѪ\xb9\xa8a\xa6\f\xa3\xe6" +
+	"\xa1\x8dv\x8b\xa9u\xbf\x04\xbf\x1do\x95\xadd\xac|\xab\x99\xaa\xb9\xab~\xa9(\xa7\x03\xa4\xa9\xa2$v\x9b" +
+	"\xbfͿ\xe6oлԺ\xaa\xb9\x85\xb8c\xb8.\xb8ȹe\xb5ƴ\xa5\xb5YZw\x86aK\xbe" +
+	"\xb8\b\xb6\xef\xb5ٴĴ\x9b\xb5>\xb5\xe3\xb4\U00074b75a\x80\x00#\x80K\xb7m\x80mJm\x14" +
+	"l\xden\x1dn\x1dnRl\xeah\xd3i\tI+I$+\xaf\xd1p\xdb]\xda<\xd9\x1bݩ\xe1\a" +
+	"\xe1\a\xe0\xb4\xe19\xe2X\xe3w\xf2>\xb6\xa7\xd0\xdd\xd0LջԚ\xd9(܆\xe1\x02ߑ\xe0\x17" +
+	"\xe15\xe2T\xf1\x1d\xb5\xa1\xcf\xd2˜\xcf*\xd0\x1aԨ\xd8\x06܂ߎ\xde\xf5\xe0\x14\xe12\xef\xfd" +
+	"\xb4\x9b\xce\xc7ʑ\xc6Z\xce\t\xd0)ӆ\xd8\x03\xdb\x0f\xde\xf2\xde\xf3\xe0\x12\xee\u07b3\x96\xd3\f\xce\xd6" +
+	"ʠ\xc6j\xce\x0f\xd0:Է\xd7\xc2ۦ\xe0#\xe0#\xee\xf0\xb7x\xd68\xd2\x02\xcd\xccɕ\xc6z" +
+	"\xce\x02\xd1W\xd4c\xd8G\xdc\xc4\xe1A\xee\xef\xb6u\xd67\xd67\xd2\x01\xcd\xcbʯǂ\xcf\a\xd20" +
+	"\xd5\x03ف\xdd\xfe\xf0\f\xb7w\xd5\xec\xd4\xe2\xd4\xe2Ь͐\xcac\xc8S\xce\xe3\xd3\xfe\xd8{\xdc\xf8" +
+	"\xecf\xb7g\xd6i\xd5_\xd4U\xd4U\xd19\xce\f\xcb\x00\xca\x01ϵ\u05eb\xdc(\xe7\x7f\xb5\xc2\xd7r" +
+	"\xd6h\xd5^\xd4T\xd5n\xd2B\xcf5\xce6\xcdoк\xddG蝶\xc4\xd8{\xd7q\xd6g\xd5]" +
+	"\xd5n\xd6w\xd3k\xd2lѤҭ\xd1\xc0麷\xc7\xe4X\xe3R\xe2L\xe1E\xe1V\xe1U\xe2[" +
+	"\xde\xfb\xdat\xdby\xdc\x7f\x80\x00\xb2\xe4\xadq\xac\x83\xab\x95\xaa\xa7\xaeC\xadU\xaeB\xae3\xac\x9f\xad\x8c" +
+	"\xaey\xa8\xaf.8y7\xa8\xe6\xa7ܦ֩\x9e\xac\xf7\xac\xe6\xad\xc0\xaet\xafZ\xb0A\xc7\x18\xbd\xa5" +
+	"\x9f\bw[\xa4<\xa37\xa5\xff\xa9W\xac\xc1\xac\xb5\xadh\xaeO\xaf6Ż\xbc[\x9e\x12\x9a\xb5u\xae" +
+	"\x9f\x9c\xa2d\xa5\xbc\xa9&\xac\x95\xacb\xadI\xae0\xc4d\xbb\x15\x9d \x99Ökt.\x9e\u03a2'" +
+	"\xa5\x91\xa8\xff\xacG\xacH\xad.\xc3\x11\xb9џ\xb6\x9cY\x99\x01\x95\xads:\x9e\xb6\xa2 \xa5\x8e\xa8\xd6" +
+	"\xacR\xacR\xc1Ͻ)\xa2ןz\x9c\"\x98Ε\x94s=\x9f\xb7\xa3&\xa6n\xa9\xe9\xadd\xc1\xa9" +
+	"\xbc|\xa2Ƣ\xa4\x9fL\x9b\xf8\x98\xbe\x96\x7frɣ\xff\xa3ҧM\xaa\xc9\xc2i\xbdD\xa3\x8c\xa2\x97" +
+	"\xa2z\x9f&\x9b왭\x9apr\x81\xa1\x85\xa5\x00\xa8{\xc3-\xbe\x0e\xa4O\xa3Z\xa2j\xa2Q\x9f\x16" +
+	"\x9cؚk\x98Bne\xa1\x97\xa5\x12\xbd\xbe\xbcť\"\xa4-\xa3=\xa2P\xa2Q\xa0\x13\x9d\xa6\x9b}" +
+	"\x98Hnv\xa5\xf9\xbe\x95\xbd\x9c\xa5\xf6\xa5\x01\xa4\x10\xa3$\xa2Q\xa3N\xa0ី\x9b\x83\x9cVn\xb3" +
+	"\xbfk\xber\xbbu\xba1\xb8\U00077d76\x7f\xb6X\xb7\x03\xb7\xb0\xb2Ƴ\x87\xb4HM\x1b\x80\x00\xb2\xcd" +
+	"\xb1\x9c\xb0m\xaf@\xb2R\xb1\xaf\xb2b\xb3\x16\xb2\x02\xb2³\x83y\x9e\x11{a0\xc1\xf0\xc0߿\xce" +
+	"\xbf;\xc2\xdd\xc2a\xc3@\xc2\xee\xc3\xd5Ŀŭ\x86o\xb7:X\xb4\x9a[\x99d\x9b\x05\x9dA\xa0]" +
+	"\xa0N\x9f⠰\xa1\x82\xa2X\x7fw\xb6>\x93LV\xeb\x96$\x97ɚ\x05\x9d!\xa0/\x9eퟻ" +
+	"\xa0\x8d\xa1c~\xa4\xb5C\x92c\x8fOUS\x94\x8e\x96ʙ\xe6\x9c\xf4\x9eОȟ\x9a\xa0pw\xf6" +
+	"\xb4\x9c\x93\xe2\x90ҍ\xc1U\xa1\x90\x9d\x94^\x97l\x99I\x9c^\x9c[\x9d1r6\xb7\xf7\x95\xfe\x92\xed" +
+	"\x8f݉\xe2U?\x92\x03\x95\x11\x96\xed\x9a\x03\x9d\x1d\x9d\x1dh\xfe\xb7\x84\x98\xf9\x95\xe9\x92؍\x7f\x8b?" +
+	"UD\x92\xf0\x94͗\xe2\x9a\xfc\x9e\x1aai\xb8N\x98\xe9\x98Ε\xbe\x90d\x8e%\x8c\x1eT\xad\x92t" +
+	"\x95\x8a\x98\xa4\x9b\xc1Z\xfb\xb8\n\x98\x8a\x97\xa7\x97\x8c\x922\x8f\xf3\x8d싮R\xb9\x91ɔ\xe3\x98\x01" +
+	"T<\xb8ܙK\x98g\x97\x84\x95\x1f\x92\xe0\x90َ\x9b\x8b\rR}\x95\xb5\x98\xd3P빱\x9a\x0f" +
+	"\x99+\x98H\x95\x1b\x95ѓʑ\x8c\x8d\xfe\x8e\xc2Ro\x99\xa8N\x8e\xba\x8b\x9a֙\xf3\x99\x0f\x95\xe3" +
+	"\x95Ж\xbf\x94\x81\x90\U000d1dd2\x7fR\x8eM2\x80\x00y\axDr\x18l\x9ed\x0f\\\xfdV\xf9" +
+	"P\xafM\x8fKNI\xf9\x1e\xfe~\x9a\xc4S\xa6ͥ֨\x1c\xaaX\xa8ɩƩZ\xaa(\xaa\xfa" +
+	"\xabЎ(\xb9\x8c_\xbb\xbd\x88\xbcw\xbb俆\xc2[\xc2\xd1\u0080\xc3f\xc4Q\xc5?\x86i\x9fR" +
+	"\xb32W`\x97\x8d\x99ל\x14\x9d!\xa1\x17\x9f֠\xa4\xa1v\xa2L\x7fS\x9ei\xb27\x90\xb4U\xc7" +
+	"\x96\x9c\x98ٙ\xe6\x9dܟ\xb8\x9f\xb1\xa0\x83\xa1Xx:\xa0\x8a\xb1\x91\x92ُ\xc8V\xf5\x93Q\x94_" +
+	"\x98o\x9aK\x9d`\x9d]\x9e3si\xa2\xa5\xb4\xeb\x94\xf4\x91䌊V\x94\x92\x04\x96\x13\x97\xef\x9b\x05" +
+	"\x9e\x1f\x9e\x1fj4\xa1(\xb7\x84\x95\xe9\x92؍\x7f\x8b?UE\x92\xf1\x94͗\xe3\x9a\xfc\x9e\x1aa\x9f" +
+	"\xa2\x16\xb7\uf670\x96\xa0\x91_\x8f \x8c\x1eU\x9c\x92\xab\x95\xc1\x98ۛ\xf9\\!\xa1\xb7\xb7\xab\x98\x89" +
+	"\x98n\x93.\x90\xee\x8d\xec\x8b\xe4S\xa7\x92\x00\x95\x1a\x988Ud\xa2w\xb8}\x99I\x98f\x96\x1b\x93\xdb" +
+	"\x90َыCSk\x95\xec\x99\nR\x17\xa3;\xb9R\x9a\r\x99*\x96\x17\x96̓ʑ\u008e4" +
+	"\x8e\xf8S]\x99\xe0O\xbc\xa4\x03\xba,\x9aՙ\xf2\x96ޖ̖\xbf\x94\xb7\x91)\x91풵S|" +
+	"Nc\x86\xf8\x80\x00x\xedr_m\xc0e3]1X\tQ\xc2N\xa5LgK\x14 \x04\x7fm\xb0t" +
+	"\xc3禪\xa9\t\xabF\xa9\xb6\xa9Ʃ\x92\xaa`\xab2\xac\b\x8e\xf7\xa8\xb0|\xa6\xbf\xc1\xa3s\xa5\xd6" +
+	"\xa8\x13\xaa\"\xa9m\xa98\xaa\x06\xaaث\xae\x8e6\xb9*\xb5Z^t\xb9\"\xb8\x90\xbc1\xbf\x06\xc2\xcd" +
+	"\xc2\x14\xc2\xfa\xc3\xe4\xc4ӆe\x9f7\x9c3\xaf+V<\x98\x1f\x9a[\x9b\xf5\x9dݠ\xa0\xa0\x99\xa1k" +
+	"\xa2Ax١q\x9eq\xae\x85\x91GW\x8c\x94\ue587\x98o\x9b3\x9eI\x9eE\x9f\x1bsף\x8d" +
+	"\xa0\x8d\xb1\xe0\x93b\x8e\"W+\x94,\x96\x14\x98כ\xed\x9f\a\x9f\aj\x98\xa2\x0f\xa2}\xb4x\x94\xdf" +
+	"\x8f\x9f\x8d`V\x99\x92\xf1\x95Ϙ\xe5\x9b\xff\x9f\x1cbբ\x16\xa1˷\uf5a0\x91`\x8f \x8c\x1e" +
+	"U\x9c\x92\xab\x95\xc1\x98ۛ\xf9\\X\xa1\xed\xa1\xa2\xb7L\x99P\x94\x10\x91Ў\xe7\x8b\xe4T\x95\x928" +
+	"\x95R\x98oV\x8b\xa2\xad\xa2b\xb8\x1e\x99H\x96\xfd\x94\xbd\x91ԎыyTY\x96$\x99AS@" +
+	"\xa3q\xa3&\xb8\xf3\x9a\f\x96\xf9\x97\xae\x94ő\u008ej\x8f.TK\x9a\x17P\xe9\xa49\xa3\xee\xb9\xcc" +
+	"\x9aԗ\xc1\x97\xae\x97\xba\x94\xb7\x91_\x92#\x92\xebTjO\x92\x87\xbb\x87\x12\x80\x00r\xf9n.e\x97" +
+	"^VX>R\xd3O\xb9M}L-!\v\x80@\xb0\xab\xb0;\xc3}\xa9ݬ\x1a\xaa\x8a\xaa\xb4\xa9\x92" +
+	"\xaa\x97\xabi\xac?\x95\xa3\xa8\xe6}y\xac\x8f\xbfV\xa6\xaa\xa8\xe7\xab\x10\xaa[\xa98\xaa>\xab\x10\xab\xe6" +
+	"\x95M\xa8\x81\xa5\nz\u07bb1\xa3y\xa5\xb6\xa7ߪȨ\xe1\xa9檸\xab\x8e\x94\x9e\xb8ɴ\xf9" +
+	"\xb1)]Y\xb5<\xb8\u07bb\xb3\xbfz\xc2\x11\u008f\xc3z\xc4h\x86c\xa2@\x9f@\x9c@\xabyX\x01" +
+	"\x96W\x98\n\x9a~\x9b4\x9f1\x9f-\xa0\x03tk\xa4[\xa1[\x9e[\xaeԏ\x87W\xa0\x95\xaf\x98\"" +
+	"\x98\u061c֟\xef\x9f\xf0j\xe5\xa2ޣd\xa0d\xb1m\x91\x1d\x8e\xdeW0\x95\x1a\x95ϙ͜\xe7" +
+	"\xa0\x05c:\xa2\xfd\xa2\xb2\xa3 \xb4\xe3\x93g\x91'\x8e>V\U00052b16Ùݜ\xfb]\x8e\xa1\xed" +
+	"\xa1\xa2\xa1W\xb7L\x94\x10\x91ю\xe8\x8b\xe4T\x95\x928\x95R\x98pVâ㢘\xa2M\xb7\xbf" +
+	"\x97ߕ\xa0\x92\xb7\x8f̋yUG\x96[\x99yTg\xa3\xa7\xa3\\\xa3\x11\xb8\x94\x97ۘ\x91\x95\xa8" +
+	"\x92\xbd\x8ej\x8fdU9\x9aOR\x13\xa4o\xa4$\xa3ٹm\x98\xa3\x98\x90\x98\x9c\x95\xb2\x91_\x92Y" +
+	"\x93!UXP\xbf\x8d獠\x8d\x06\x80\x00n\xbee\xe7^\xbbYdS\tP\xcbN\x92MD\"\x11" +
+	"~\xb3\xac\xb6\xac+\xab\xc0Û\xa9\xbd\xa84\xa8^\xa7U\xa7m\xa8v\xa9L\x9b\xa8\xa5\v{Ѩj" +
+	"\xa7\xff\xbfk\xa6r\xa8\x9b\xa7\xe5\xa6ݦ\xf5\xa7\xfe\xa8Ԛd\xa4\x8d\xa1\x02y6\xa4X\xbbA\xa3@" +
+	"\xa5i\xa8l\xa6\x85\xa6\x9d\xa7\xa6\xa8|\x99\xe6\xa4,\xa0\xa2\x9d/vǷ\x1b\xa0\x14\xa2=\xa5@\xa6\xf8" +
+	"\xa6J\xa7S\xa8)\x99C\xb8Ǵ\xee\xb1\x19\xadHXS\xb54\xb8\b\xbbϾg\xc26¸æ" +
+	"\x86b\xa2\n\x9e\xf3\x9b\xf2\x98\xf6\xabqS\x8b\x95\x9b\x98(\x99j\x9bY\x9f[\x9f[g,\xa0\x92\xa0\xfb" +
+	"\x9d\xfb\x9a\xff\xae\n\x8e\xceS\x1b\x95 \x96a\x98Q\x9cR\x9fp_K\xa0\xb2\xa0J\xa0НԱ\x80" +
+	"\x910\x8eGR\xfe\x93X\x95G\x99I\x9cfY\xb7\x9f\xba\x9fR\x9f\a\x9fy\xb3\xe9\x92c\x8fz\x8c\x8f" +
+	"Q`\x90\xbc\x94ח\xf5S\xbe\x9fɟa\x9f\x16\x9eϷg\x94+\x91B\x8eX\x8a\x05P\xbe\x94\xde" +
+	"\x97\xfcPc\xa0à[\xa0\x10\x9fɷޗ\xfe\x95\x15\x92+\x8d\xf1\x8d\xf0Q\x9d\x99\tN\xfe\xa1\x8b" +
+	"\xa1#\xa0ؠ\x90\xb8\xb7\x97\xfe\x98\n\x95 \x90\xe6\x90\xe4\x91\xe2Q\xbcM\xad\x93a\x92?\x91ёA" +
+	"\x80\x00bz[\x1dU\xdcPAM\x13K\xb7Jl\x1e \x80N\xae\x17\xacάA\xaf\x8b\xc7p\xa9\xb5" +
+	"\xa9ߨש\b\xa9$\xaa1\xa4\xe3\xa6V}k\xa9\r\xa8\x80\xab\xca\xc3@\xa9<\xa8\xa9\xa7\xa0\xa7\xd2" +
+	"\xa7\xee\xa8\xfb\xa3\x9b\xa5&\xa1\x9bzѤ٨#\xbf\x15\xa6\v\xa9\x0e\xa7&\xa7X\xa7t\xa8\x81\xa3'" +
+	"\xa4\xa5\xa1\x1b\x9d\xa8xb\xa4\x81\xba\xf0\xa2ޥ᧲\xa7\x05\xa7!\xa8.\xa2˧\xbe\xa44\xa0\xc1" +
+	"\x9dRv~\xb6\xe2\x9fߢ⤳\xa7\xa4\xa6\xfa\xa8\a\xa6\x82\xbcR\xb8z\xb4\xa5\xb0ԭ\x10X\x1e" +
+	"\xb5<\xb9\x03\xbb\x9b\xbfj\xc3=\xc3Æa\xa2\x02\xa1\x99\x9e\x99\x9b\x9d\x98«yS\x90\x96\x89\x97\xe4" +
+	"\x9a_\x9cS\xa0X_9\xa2\"\xa1\b\xa1n\x9er\x9b\x97\xae\uf3ecSr\x94ڗV\x99I\x9dO" +
+	"Y\x80\xa1+\xa0\x10\x9f\xa5\xa00\x9dU\xb1X\x90\xf8\x8e\x0eQ\xf7\x92\xe4\x94ؘ\xddS\x9e\xa1R\xa08" +
+	"\x9f͟\x86\xa0\x19\xb4֓I\x90_\x8c%R\x12\x94ߘ\xfeQ\x16\xa1e\xa0J\x9fߟ\x98\x9fs" +
+	"\xb8X\x95\x15\x92+\x8d\xf1\x8d\xf0Q\x9d\x99\tN\xb2\xa2b\xa1H\xa0ݠ\x96\xa0p\xb8Ҙ\xec\x96\x02" +
+	"\x91ȑ\xe0\x91\xe2R\xaaNP\x9b\xf0\x9a̚h\x9a\x18\x9d\x85\x80\x00[\x15U\xb2P-M\xbfKs" +
+	"K\x05\x1e\xa2\x80\x0f\xad\x80\xac7\xab\xaa\xae\xf4\xb2\x97Ɓ\xa9\xa6\xa8\x9d\xa8ϩ\x05\xa9$\xacv\xa5\xc3" +
+	"~\x8c\xa9\xb5\xa8q\xab\x99\xaf<\xc6)\xa9\x82\xa8z\xa8\xab\xa8\xe1\xa9\x00\xac=\xa4\x92\xa20{֤\xb5" +
+	"\xa7ݫ\x80\xc1\xfe\xa9\x0e\xa7I\xa7z\xa7\xb0\xa7Ϫ\xf7\xa4\x12\xa1\x05\x9d~yg\xa4;\xa7߽\xd9" +
+	"\xa5⧳\xa7\x05\xa7;\xa7Z\xaa\x83\xa7+\xa3\xfe\xa0w\x9d\bw\x83\xa4i\xb9ˢ⤳\xa7\xbe" +
+	"\xa7\x14\xa74\xaep\xaa\xaa\xa7}\xa3\xf7\xa0\x88\x9d;v{\xb6\xba\xa0̢\x9d\xa5\xa8\xa8\x9d\xa7\xf7\xaev" +
+	"\xbbx\xbb&\xb7Q\xb3\x80\xaf\xbc\xac\xe8W-\xb6\x1a\xb8\xb2\xbc\x81\xc0T\xc4+\x86a\xa1\xf3\xa1ӡn" +
+	"\x9er\x9b\x97\x99\xa1\xacCR\xfa\x96\f\x98\xa1\x9b!\x9d\x18X\xf6\xa0\xfb\xa0ܟƠ0\x9dU\x9b^" +
+	"\xae\xab\x8f=Q~\x940\x96\xaf\x98\xa7S\x01\xa1#\xa1\x04\x9fퟆ\xa02\x9e;\xb2*\x91\xa7\x8dm" +
+	"Q\xbc\x96И\xc8P\x90\xa1N\xa1/\xa0\x19\x9f\xb2\x9f\x8c\xa1\x03\xb5\xac\x93\xfc\x8f\u008f\xdaR\x05\x98\xd3" +
+	"N\xfd\xa1e\xa1E\xa0/\x9fȟ\xa2\xa0a\xb92\x95̑\x93\x91\xaa\x91\xadQ\xbdM\x9d\xa3\x02\xa2\xce" +
+	"\xa1\xa9\xa1D\xa4\xe2\xa4\xea\x80\x00U<O\xa5MMK\xc1Jb\x1e\xa2\x7f=\xacs\xacQ\xab\r\xae5" +
+	"\xb1ر{\xc6騅\xa8\xb6\xa8\xec\xa9%\xb2\xe7\xa4\xc5}z\xa8槢\xaaʮn\xb1WŢ" +
+	"\xa7\x8b\xa7\xbd\xa7\xf2\xa8+\xb1\xbc\xa4\xa4\xa1h|$\xa5%\xa7\x97\xab\x18\xae\x01\xc5O\xa7m\xa7\x9e\xa7\xd4" +
+	"\xa8\r\xb1\x84\xa3x\xa0<\x9d\xdey\x99\xa3\xe0\xa7a\xaaJ\xc1)\xa6\xfe\xa6r\xa6\xa8\xa6\xe1\xb0>\xa6q" +
+	"\xa35\xa0+\x9c\xa9w\xb5\xa3\xec\xa6ս\x1b\xa3\xfe\xa7\t\xa6_\xa6\x99\xb4\x13\xa9𦴣\x8b\xa0\b" +
+	"\x9c\xbbv\xae\xa4I\xba\v\xa1\xe8\xa4\xf3\xa8\x02\xa7\\\xb4=\xa9\x9d\xa9~\xa6T\xa2ҟ\x85\x9d\x1bt\xfd" +
+	"\xb6⟟\xa2\xaa\xa5\xb9\xa8̴\xbc\xbb\u05fa\xab\xba]\xb6\x8c\xb2ȯ\xf4\xad\bV\x04\xb5C\xb9\x12" +
+	"\xbc\xe5\xc0\xbc\x86a\xa0\xe3\x9f\xfb\x9f\xe0\x9f\x7f\x9c\xa4\x9a\xad\x98\x85\xab\x80P\x8b\x94ė]\x99\xf9RD" +
+	"\xa1\n\xa0#\xa0\b\x9e\xf5\x9f\x81\x9d\x8a\x9bb\xae\xfe\x8e\x02Pȗ~\x9a\x1bO֡6\xa0N\xa03" +
+	"\x9f \x9e۠k\x9eB\xb2\x80\x90p\x90\x88Q3\x9a?NF\xa1e\xa0~\xa0b\x9fP\x9f\n\x9f\xc9" +
+	"\xa1'\xb6\x06\x92\xe2\x92\xfa\x93\x15Q\xccL\xe9\xa9\x06\xa7\xf6\xa7\xc1\xa6\x9b\xaa#\xaaM\xaaÀ\x00N\xf9" +
+	"L\xa3K\x1aI\xbe\x1d\x9d\x821\xad\x9e\xac\xa6\xac\x88\xae\xf9\xb2{\xb2\x1d\xb2\xe8ǩ\xacY\xac\x93\xac\xcc" +
+	"\xb9\x9b\xa5\xea\x80o\xa9;\xa9\x1e\xab\x8f\xaf\x10\xb1\xf9\xb1\xee\xc6c\xab_\xab\x99\xabҸm\xa5\x00\xa1\xc4" +
+	"~٥\xb9\xa8*\xab\xab\xae\x94\xb1\xcf\xc5!\xaak\xaa\xa4\xaaݷC\xa4㡧\x9eo}\xae\xa5\xb2" +
+	"\xa8}\xabD\xae~\xc4ӪR\xaa\x8b\xaaķ\v\xa70\xa3\xf4\xa0\xbc\x9eb{\xae\xa4\U000e7e6a\xf4" +
+	"\xc0Ū\x0f\xa9\x8c\xa9ź\x0e\xaa\x90\xa7T\xa4\x1c\xa1\x17\x9d\xb5z\xa7\xa5.\xa8i\xbd\xb5\xa7\xf9\xab\f" +
+	"\xaaf\xba!\xaa=\xaa\x1d\xa6\xe5\xa3\xc0\xa0_\x9d\xf6x\xf6\xa5\xaa\xba\x8b\xa5\xb0\xa8ëֺ\xb3\xaa\xf9" +
+	"\xaa\x11\xa9\xf6\xa6ѣp\xa1\x06\x9ekx\xb3\xbb>\xa3n\xa6\x81\xa9\x94\xbbs\xbc\x8e\xbbb\xba9\xb9\xef" +
+	"\xb6+\xb3W\xb0k\xb1\nY\x17\xb8\xfe\xbc\xd5\xc0\xac\x86a\xa4\x98\xa3\xb1\xa2͢\xb6\xa2v\xa0\x80\x9eW" +
+	"\x9c5\xaf\tR]\x9b\xb1\x9eNP1\xa4ȣ\xe0\xa2\xfd\xa2\xe5\xa1\xf4\xa3d\xa1<\x9f\x1a\xb2\x8f\x94\x9e" +
+	"RȞsNk\xa4\xf7\xa4\x10\xa3,\xa3\x15\xa2$\xa2¤ \xa1\xfe\xb6\x15\x97\x10\x97,S`M\xfd" +
+	"\xafP\xae=\xad,\xac\xf6\xaf\xbe\xafҰZ\xb1\x06\x80\x00M!KfJ\xe6\"$\x81^\xadg\xaco" +
+	"\xab{\xaf\x13\xb1ޱ^\xb2(\xb3\xb8\xc8\x12\xac\\\xac\x95\xbc\ue974\x7f\x9c\xa9\x04\xa8\x11\xab\xa9\xaes" +
+	"\xb1:\xb1/\xb2\xbe\xc6˫b\xab\x9b\xbb\xbe\xa4ʡ\x8e~\x06\xa4\xac\xa8D\xab\x0e\xadձ\x10\xb1\xca" +
+	"ŉ\xaam\xaa\xa7\xba\x91\xa3堩\x9dq|\x9c\xa4䧮\xaau\xad\xb0\xb1\xb0\xc4M\xa9~\xa9\xb7" +
+	"\xb9g\xa7B\xa4\x06\xa0Ν\x99{\xfc\xa5c\xa7s\xaa\xae\xae\x8c\xc4\x16\xa9\x91\xa9ʽx\xa9\xf6\xa6\xba" +
+	"\xa3\x82\xa0N\x9e\x15z٤Ө\x0e\xab\xec\xc1\x05\xaa8\xa9\xb5\xbc\xb9\xa9\x82\xa9c\xa6+\xa2\xf7\xa0\x13" +
+	"\x9d\x96y)\xa5O\xa9-\xbdܧ\xf0\xab\x02\xbd3\xaa?\xa9W\xa9<\xa6\b\xa3$\xa0\xa7\x9e\fx\xe6" +
+	"\xa6뾏\xa5\xad\xa8\xc0\xbd\xf1\xabЪ\xe8\xaa\x05\xa9\xee\xa6\xea\xa4m\xa1ҟ\xb0u4\xb9Z\xa2x" +
+	"\xa5\x8b\xbdK\xbc\xed\xbb\xc1\xba\x99\xb9t\xb97\xb6c\xb3w\xb4\x15\xafeXV\xbd=\xc1\x14\x86a\xa4\x92" +
+	"\xa3\xab\xa2ǡ\xe8\xa1\U000a25a0n\x9eL\x9bA\xb2\xeeQ۞<M\xaa\xa4\xc1\xa3ڢ\xf7\xa2\x17" +
+	"\xa2!\xa2\x14\xa3R\xa10\x9e&\xb6t\x96\xf6RsM=\xb2p\xb1Z\xb0F\xaf4\xb2\xec\xb2@\xb2\xb2" +
+	"\xb3\\\xb2ހ\x00J\xb6J7!\x1f\x80\x8c\xad0\xac8\xabE\xae\x06\xb1\xf8\xb0\xc1\xb1\x8c\xb2\xf9\xb3\xcf" +
+	"\xc8z\xac^\xbfM\xa5\x7f~ʨͧڪ\x9c\xae\x8d\xb0\x9d\xb0\x92\xb1\xff\xb2\xd5\xc73\xabd\xbe\x1a" +
+	"\xa4\x95\xa1Y}4\xa4u\xa77\xab(\xad8\xb0s\xb1\v\xb1\xe1\xc5\xf1\xaap\xbc룰\xa0t\x9d<" +
+	"{ʣקȩح\x13\xb0\xf1\xb0\xf1ĵ\xa9\x80\xbb\xbe\xa6D\xa3\b\x9fМ\x9cz뤕" +
+	"\xa6\xa5\xa9\u0b7e\xb1\x04Ï\xa8\xbd\xbeު\a\xa6ˣ\x93\xa0_\x9dL{'\xa5C\xa8~\xab\x84" +
+	"\xae\xca\xc4W\xa9\xba\xbf,\xa8\xe8\xa8ɥ\x91\xa2]\x9fJ\x9d\xf6y[\xa5\xab\xa8\xb0\xab\xf7\xc1-\xaa/" +
+	"\xbeԩ\xa4\xa8\xbd\xa8\xa2\xa5n\xa2[\xa1\a\x9eXy\x18\xa6n\xa9\xb5\xc1ߧ\xed\xbf\x8f\xab\x16\xaa." +
+	"\xa9K\xa93\xa6!\xa4\x01\xa1R\x9f0ug\xa6y\xbc\xab\xa4\xb8\xbf \xabު\xf6\xaa\x13\xa93\xa9=" +
+	"\xa7\x1d\xa4o\xa2M\x9f<uv\xbdn\xa5\x8d\xbf\xe4\xbdL\xbc \xba\xf8\xb9Ӹ\xbc\xb9o\xb6\x83\xb7!" +
+	"\xb2q\xb3 W\xbe\xc1|\x86a\xa4\x8c\xa3\xa5\xa2\xc1\xa1\xe2\xa1$\xa2\x12\xa2\x84\xa0c\x9dX\x9e \xb6\xd4" +
+	"Q\x86L|\xb4\xb1\xb3\x98\xb2\x82\xb1m\xb4H\xb4\x8c\xb4>\xb4崙\xb5I\x80\x00I\x88 \x19\x7f\xba" +
+	"\xac\xf9\xac\x01\xab\x0e\xadϰ\xeb\xb0۱\xa6\xb2:\xb3\x10\xb3\xe6\xc8\xe2\xc0\xab\xa5I}\xf7\xa8\x96\xa7\xa3" +
+	"\xaae\xad\x80\xb0\xb7\xb0\xac\xb1@\xb2\x16\xb2\xecǛ\xbfu\xa4_\xa1#|a\xa4>\xa7\x00\xaa\x1b\xadR" +
+	"\xb0\x8d\xb0L\xb1\"\xb1\xf8\xc6Z\xbeC\xa3z\xa0>\x9d\x06z\xf7\xa3\xa0\xa6\xbb\xa9\xf2\xad-\xb02\xb02" +
+	"\xb1\b\xc5\x1d\xbd\x14\xa6\x0f\xa2ӟ\x9b\x9cfz\x18\xa3\x88\xa6\xbf\xa9\xfa\xac\xff\xb0E\xb0E\xc3\xf8\xc01" +
+	"\xa9\n\xa5\u03a2\x96\x9fa\x9cOz\x15\xa4u\xa7\xb0\xaa\xb5\xad\xfc\xb1B\xc3п\x8e\xa8\xfa\xa8ڥ\xa2" +
+	"\xa2n\x9f\\\x9d-y\xa9\xa8z\xa8H\xab\x8e\xae\xd5\xc4\x7f\xc0D\xa9\xb6\xa8Ψ\xb3\xa5\x7f\xa2l\xa0>" +
+	"\xa0\xfayf\xa6\x06\xa9L\xac\x93\xc51\xc0\xfc\xaa[\xa9t\xa8\x91\xa8y\xa5g\xa38\xa0\xe6\x9e\xc5u\x99" +
+	"\xa5\xfc\xa9C\xbf\xfc\xbf\x9d\xab#\xaa<\xa9Y\xa8y\xa8\x83\xa6T\xa4\x03\xa1ួu\xa9\xaa\x19\xc0\xbf" +
+	"\xc0`\xab\xeb\xab\x04\xaa \xa9A\xa8\x83\xa9q\xa7\x1f\xa4\xfe\xa1٢\xa1u\xe2\xc1\x83\xc1$\xbd\xab\xbc\x7f" +
+	"\xbbW\xba2\xb9\x1b\xb8\xf4\xb9\x8e\xba-\xb5}\xb6,\xb6\xdbWP\x86a\xb6\x06\xb4\xeb\xb3Ҳ\xbb\xb5\x93" +
+	"\xb4\xfa\xb5\x9d\xb6A\xb5\x19\xb5ȶw\x80\x00\x1f\x14\xd2y\xdfD\xde\"\xdd\x01ݥ\xe1\xca\xe0\xab\xe1\xc9" +
+	"\xe1>\xe2]\xe3{\xe4\x9a\xee\xa1Ԃ\xd1U١\u0600\xd9$\xddI\xe0\xa6\xe0\xa7\xe0\x1b\xe1:\xe2Y" +
+	"\xe3w\xed\x80\xd3w\xcf@\xd03\xd4\x00Ԥ\xd8\xc9\xdc&\xe0\xa3\xde\xfa\xe0\x18\xe17\xe2V\xec`\xd2l" +
+	"\xce6\xc9\xff\xcf\x12\xd0%\xd4Jק\xdc$\xde\xf7\xde\xf8\xe0\x16\xe15\xebA\xd3\t\xce\xd3ʝ\xc6f" +
+	"\xcd\xf8\xd05ӓ\xd8\x10\xda\xe3\xdf`\xdf`\xe0\x7f\xef\x84\xd6\xeeҷ\u0381\xcaK\xc6v\xce\a\xd0=" +
+	"Ժ\u05cd\xdc\n\xe0\x87\xe0\x88\xee\xf7\xd5\xe4\xd5\xe3ѭ\xcdwɢ\xc6~\xcd\xfb\xd1[\xd4.ث" +
+	"\xdd(\xe1\xa5\xee\xf6\xd6\xec\xd5\xe3\xd5\xe2Ѭ\xcd\xd7ʳǆ\xcf\x00\xd0\xeb\xd5h\xd9\xe5\xdeb\xf0\x14" +
+	"\xd6n\xd5d\xd4Z\xd4YЅ\xcd`\xca4\xc7'η\xd3\xce\xd8L\xdc\xc9\xeb,\xd7w\xd6m\xd5c" +
+	"\xd4YԺѕ\xcei\xcb]\xc9\xd3ϼ\xd9j\xdd\xe7\xecI\u0600\xd7v\xd6l\xd5bԹ\xd5\xcb" +
+	"Ҟϒ\xce\t\xcf\x12\xd0\xc2\xdf\x06\xedgى\xd8\x7f\xd7u\xd6k\xd5\xc2\xd5\xca\xd6\xd3\xd3\xc7\xd2>" +
+	"\xd3G\xd4P\xd1\xc7\xee\x84\xe1\x01\xdf\xfb\xde\xf4\xdd\xee\xe1\xdf\xe1]\xe1]\xe2b\xdd\xdb\xde\xe0\xdf\xe6\xe0\xeb" +
+	"\x80\x00"
+
+// preflopCombos holds the equity of the first hole of every matchup key, sorted by key, in records of the
+// 24-bit key followed by the 16-bit equity, both big-endian.
+const preflopCombos = "" +
+	"\x04\x00\u00988\x04\x01\x02\x97;\x04\x01\x03\x93\xc7\x04\x01B\x98\xe1\x04\x01C\x95q\x04\x01D\x92\x01\x04\x01" +
+	"\x82\x9b0\x04\x01\x83\x97\xc0\x04\x01\x84\x94P\x04\x01\x85\x8d\xae\x04\x01\u009e\x8a\x04\x01Û\x1a\x04\x01ė" +
+	"\xaa\x04\x01ő\xba\x04\x01Ə0\x04\x02\x02\x9ey\x04\x02\x03\x9e\\\x04\x02\x04\x9a\xec\x04\x02\x05\x94\xfc\x04" +
+	"\x02\x06\x92r\x04\x02\a\x90%\x04\x02B\x9e\x11\x04\x02C\x9d\x18\x04\x02D\x9c\xfb\x04\x02E\x97\f\x04\x02F" +
+	"\x94\x81\x04\x02G\x925\x04\x02H\x8f\xac\x04\x02\x82\x9e\xe4\x04\x02\x83\x9d\xec\x04\x02\x84\x9c\xf3\x04\x02\x85\x9aV" +
+	"\x04\x02\x86\x97\xcc\x04\x02\x87\x95\x7f\x04\x02\x88\x92\xf6\x04\x02\x89\x8f\x13\x04\x02\u009f\xbc\x04\x02Þ\xc3\x04\x02" +
+	"ĝ\xca\x04\x02ŚR\x04\x02ƛ\x1a\x04\x02ǘ\xcd\x04\x02ȖE\x04\x02ɒb\x04\x02ʓ" +
+	"9\x04\x03\x02\xa0\x97\x04\x03\x03\x9f\x9e\x04\x03\x04\x9e\xa5\x04\x03\x05\x9b-\x04\x03\x06\x9b\x1a\x04\x03\a\x9c \x04" +
+	"\x03\b\x99\x97\x04\x03\t\x95\xb4\x04\x03\n\x96\x8b\x04\x03\v\x97g\x04\x03B}\xf6\x04\x03C}!\x04\x03D" +
+	"v`\x04\x03Epi\x04\x03Fg\x18\x04\x03G_o\x04\x03HX\xf2\x04\x03IR(\x04\x03JN\xd2" +
+	"\x04\x03KLp\x04\x03LK\x12\x04\x03\x82\xc1R\x04\x03\x83\xc0>\x04\x03\x84\xbf+\x04\x03\x85\xbes\x04\x03" +
+	"\x86\xc2!\x04\x03\x87\xc1\xa3\x04\x03\x88\u0084\x04\x03\x89\xc29\x04\x03\x8a\xc3\"\x04\x03\x8b\xc4\x0f\x04\x03\x8c\xc5" +
+	"\x01\x04\x03\x8d\x80\x00\x04\x03\xc2W\xf7\x04\x03Ù\x90\x04\x03Ę\x97\x04\x03Ś\x1d\x04\x03Ɯ\\\x04" +
+	"\x03ǟ|\x04\x03ȟl\x04\x03ɟ\a\x04\x03ʟ\xd7\x04\x03ˠ\xab\x04\x03̡\x83\x04\x03\xcd" +
+	"y\a\x04\x03η:\x04\x04\x02\x99\x8d\x04\x04\x03V0\x04\x04\x04\x95P\x04\x04\x05\x96\xda\x04\x04\x06\x99\x1a" +
+	"\x04\x04\a\x9c9\x04\x04\b\x9fM\x04\x04\t\x9e\x11\x04\x04\n\x9e\xe1\x04\x04\v\x9f\xb5\x04\x04\f\xa0\x8d\x04\x04" +
+	"\rxD\x04\x04\x0e\xb6>\x04\x04\x0f\x91\xa8\x04\x04B\x98\x95\x04\x04C\x95N\x04\x04DT\x98\x04\x04E\x93" +
+	"\x99\x04\x04F\x95\xd9\x04\x04G\x98\xf8\x04\x04H\x9c\f\x04\x04I\x9d\xf4\x04\x04J\x9d\xec\x04\x04K\x9e\xc0\x04" +
+	"\x04L\x9f\x98\x04\x04Mr\x18\x04\x04N\xb5C\x04\x04O\x90\xc6\x04\x04P\x8d\xd2\x04\x04\x82\x9aD\x04\x04\x83" +
+	"\x97\x02\x04\x04\x84\x93\xc0\x04\x04\x85T\xd6\x04\x04\x86\x8f\xa5\x04\x04\x87\x93s\x04\x04\x88\x96\x87\x04\x04\x89\x98o" +
+	"\x04\x04\x8a\x9b\x8b\x04\x04\x8b\x9b\x88\x04\x04\x8c\x9c`\x04\x04\x8dl\x9e\x04\x04\x8e\xb4\x9c\x04\x04\x8f\x928\x04\x04" +
+	"\x90\x8fH\x04\x04\x91\x8cW\x04\x04\u009c\x8a\x04\x04ÙH\x04\x04Ė\x06\x04\x04ŏ\xa5\x04\x04\xc6T" +
+	"w\x04\x04Ǒ\x14\x04\x04Ȕ(\x04\x04ɖ\x10\x04\x04ʙ-\x04\x04˜M\x04\x04̜N\x04" +
+	"\x04\xcdd\x0f\x04\x04η\xf7\x04\x04ϔC\x04\x04БR\x04\x04юb\x04\x04҈\x9e\x04\x05\x02" +
+	"\x9f\xb5\x04\x05\x03\x9cs\x04\x05\x04\x991\x04\x05\x05\x93t\x04\x05\x06\x91\x13\x04\x05\aT\x7f\x04\x05\b\x92\x05" +
+	"\x04\x05\t\x93\xed\x04\x05\n\x97\t\x04\x05\v\x9a)\x04\x05\f\x9dN\x04\x05\r\\\xfd\x04\x05\x0e\xb7\x84\x04\x05" +
+	"\x0f\x97\x1e\x04\x05\x10\x94.\x04\x05\x11\x91=\x04\x05\x12\x8c\x16\x04\x05\x13\x89\xef\x04\x05B\x9f\xa7\x04\x05C\x9f" +
+	"\x88\x04\x05D\x9cF\x04\x05E\x96\x89\x04\x05F\x94(\x04\x05G\x92\x03\x04\x05HS\xe9\x04\x05I\x91\x90\x04" +
+	"\x05J\x94\xac\x04\x05K\x97\xcd\x04\x05L\x9a\xf1\x04\x05MV\xf9\x04\x05N\xb8N\x04\x05O\x97\x0f\x04\x05P" +
+	"\x96\xf4\x04\x05Q\x94\x03\x04\x05R\x8e\xdc\x04\x05S\x8c\xb5\x04\x05T\x8a\xc6\x04\x05\x82\x9f7\x04\x05\x83\x9eA" +
+	"\x04\x05\x84\x9e\"\x04\x05\x85\x98e\x04\x05\x86\x96\x04\x04\x05\x87\x93\xe0\x04\x05\x88\x91\x83\x04\x05\x89Q\xf6\x04\x05" +
+	"\x8a\x90\xdc\x04\x05\x8b\x93\xfc\x04\x05\x8c\x97!\x04\x05\x8dP\xaf\x04\x05\x8e\xb8\n\x04\x05\x8f\x96\xb3\x04\x05\x90\x95" +
+	"\xd6\x04\x05\x91\x95\xbc\x04\x05\x92\x90\x94\x04\x05\x93\x8en\x04\x05\x94\x8c~\x04\x05\x95\x8aY\x04\x05\u00a0\x06\x04" +
+	"\x05ß\x11\x04\x05Ğ\x1b\x04\x05ś\x82\x04\x05ƙ!\x04\x05ǖ\xfc\x04\x05Ȕ\x9f\x04\x05\xc9" +
+	"\x90\xda\x04\x05\xcaQ\xbb\x04\x05˔\xd0\x04\x05̗\xf4\x04\x05\xcdM\x8f\x04\x05θ\xdc\x04\x05ϗm" +
+	"\x04\x05Ж\x90\x04\x05ѕ\xb4\x04\x05ғb\x04\x05ӑ<\x04\x05ԏL\x04\x05Ս'\x04\x05" +
+	"։\xb6\x04\x06\x02\xa0\xda\x04\x06\x03\x9f\xe4\x04\x06\x04\x9e\xef\x04\x06\x05\x9b~\x04\x06\x06\x9cA\x04\x06\a\x9a" +
+	"\x1c\x04\x06\b\x97\xbf\x04\x06\t\x93\xfa\x04\x06\n\x94\xce\x04\x06\vQ\xae\x04\x06\f\x98\xcc\x04\x06\rKN\x04" +
+	"\x06\x0e\xb9\xb1\x04\x06\x0f\x98*\x04\x06\x10\x97N\x04\x06\x11\x96r\x04\x06\x12\x93^\x04\x06\x13\x94\x0e\x04\x06\x14" +
+	"\x92\x1e\x04\x06\x15\x8f\xf9\x04\x06\x16\x8c\x88\x04\x06\x17\x8dE\x04\x06B\xa1\xb1\x04\x06C\xa0\xbc\x04\x06D\x9f\xc6" +
+	"\x04\x06E\x9cV\x04\x06F\x9cA\x04\x06G\x9d@\x04\x06H\x9a\xe3\x04\x06I\x97\x1e\x04\x06J\x97\xf2\x04\x06" +
+	"K\x98\xca\x04\x06LQ\xce\x04\x06MI\xf9\x04\x06N\xba\x8b\x04\x06O\x98\xec\x04\x06P\x98\x0f\x04\x06Q\x97" +
+	"3\x04\x06R\x94 \x04\x06S\x94\r\x04\x06T\x94\xf3\x04\x06U\x92\xcf\x04\x06V\x8f]\x04\x06W\x90\x1b\x04" +
+	"\x06X\x90\xdc\x04\x06\x8d\x1e\xfe\x04\x06\x8e\x86o\x04\x06\x8f\x808\x04\x06\x90\x7fe\x04\x06\x91x\xc0\x04\x06\x92" +
+	"s\x1d\x04\x06\x93i\xf1\x04\x06\x94be\x04\x06\x95[\xff\x04\x06\x96UE\x04\x06\x97Q\xf8\x04\x06\x98O\x9d" +
+	"\x04\x06\x99NC\x04\x06\xcea0\x04\x06\xcf\xc2?\x04\x06\xd0\xc1/\x04\x06\xd1\xc0 \x04\x06ҿ\xa0\x04\x06" +
+	"\xd3\xc3;\x04\x06\xd4\xc2\xc0\x04\x06\xd5Ý\x04\x06\xd6\xc3I\x04\x06\xd7\xc4.\x04\x06\xd8\xc5\x17\x04\x06\xd9\xc6" +
+	"\x04\x04\a\x0fYp\x04\a\x10\x9b(\x04\a\x11\x9a3\x04\a\x12\x9b\xd9\x04\a\x13\x9e\x0f\x04\a\x14\xa1!\x04" +
+	"\a\x15\xa1\x12\x04\a\x16\xa0\xa5\x04\a\x17\xa1q\x04\a\x18\xa2A\x04\a\x19\xa3\x15\x04\aPW\xa6\x04\aQ" +
+	"\x96\xf9\x04\aR\x98\xa3\x04\aS\x9a\xd9\x04\aT\x9d\xeb\x04\aU\xa0\xf3\x04\aV\x9f\xb2\x04\aW\xa0~" +
+	"\x04\aX\xa1N\x04\aY\xa2\"\x04\a\x91V\r\x04\a\x92\x95n\x04\a\x93\x97\xa5\x04\a\x94\x9a\xb7\x04\a" +
+	"\x95\x9d\xbf\x04\a\x96\x9f\x94\x04\a\x97\x9f\x8d\x04\a\x98\xa0]\x04\a\x99\xa11\x04\a\xd2Vk\x04\aӑ" +
+	"\x96\x04\aԕI\x04\a\u0558Q\x04\a֚'\x04\aם6\x04\a؝2\x04\aٞ\x06\x04" +
+	"\b\x13V\b\x04\b\x14\x92\xf2\x04\b\x15\x95\xfa\x04\b\x16\x97\xd0\x04\b\x17\x9a\xdf\x04\b\x18\x9d\xf3\x04\b\x19" +
+	"\x9d\xf3\x04\bTV\n\x04\bU\x93\xdd\x04\bV\x95\xb3\x04\bW\x98\xc3\x04\bX\x9b\xd6\x04\bY\x9e\xed" +
+	"\x04\b\x95Ur\x04\b\x96\x93^\x04\b\x97\x96n\x04\b\x98\x99\x81\x04\b\x99\x9c\x98\x04\b\xd6S{\x04\b" +
+	"ג\xb7\x04\bؕ\xcb\x04\b٘\xe2\x04\t\x17S?\x04\t\x18\x96\x9b\x04\t\x19\x99\xb2\x04\tXS" +
+	"0\x04\tY\x9a\x86\x04\t\x99SN\b\x00\xc1\xa5^\b\x01\x01\xa4b\b\x01\x03\x95c\b\x01A\xa6\xb9\b" +
+	"\x01C\x97\xbf\b\x01D\x94O\b\x01\x81\xa9\b\b\x01\x83\x9a\x0e\b\x01\x84\x96\x9e\b\x01\x85\x90\xaf\b\x01\xc1" +
+	"\xa7e\b\x01Û\x1a\b\x01ė\xaa\b\x01ő\xbb\b\x01Ə0\b\x02\x01\xa8k\b\x02\x03\x9fT" +
+	"\b\x02\x04\x9b\xe4\b\x02\x05\x96\x10\b\x02\x06\x93\x86\b\x02\a\x90&\b\x02A\xa8\x04\b\x02C\x9e\x11\b\x02" +
+	"D\x9d\xf3\b\x02E\x98 \b\x02F\x95\x95\b\x02G\x925\b\x02H\x8f\xe7\b\x02\x81\xa8\xd7\b\x02\x83\x9e" +
+	"\xe4\b\x02\x84\x9d\xeb\b\x02\x85\x9bj\b\x02\x86\x98\xe0\b\x02\x87\x95\x7f\b\x02\x88\x932\b\x02\x89\x8fO\b" +
+	"\x02\xc1\xa9\xae\b\x02ß\xbb\b\x02Ğ\xc2\b\x02śf\b\x02Ɯ.\b\x02ǘ\xce\b\x02\xc8" +
+	"\x96\x80\b\x02ɒ\x9d\b\x02ʓt\b\x03\x01\xaa\x89\b\x03\x03\xa0\x96\b\x03\x04\x9f\x9e\b\x03\x05\x9cA" +
+	"\b\x03\x06\x9c.\b\x03\a\x9c \b\x03\b\x99\xd3\b\x03\t\x95\xf0\b\x03\n\x96\xc7\b\x03\v\x97\xa2\b\x03" +
+	"A\x8d\x17\b\x03C}\xdc\b\x03Dv\xaf\b\x03Eq\xa8\b\x03FhZ\b\x03G_\xa6\b\x03HZ" +
+	"\x1d\b\x03ISU\b\x03JP\x01\b\x03KM\xa3\b\x03LLG\b\x03\x81~)\b\x03\x83\xa6\x1c\b" +
+	"\x03\x84\xa5\"\b\x03\x85\xa7M\b\x03\x86\xa9\x8d\b\x03\x87\xa7\xf0\b\x03\x88\xa8\xf0\b\x03\x89\xa8\x8c\b\x03\x8a" +
+	"\xa9[\b\x03\x8b\xaa/\b\x03\x8c\xab\a\b\x03\xc1\xc3\xc1\b\x03ü\xe3\b\x03Ļ\xd0\b\x03Ż\x18" +
+	"\b\x03ƾ\xc6\b\x03\xc7\xc1\x9d\b\x03\xc8\xc2\x15\b\x03\xc9\xc1\xca\b\x03\xca³\b\x03\xcbà\b\x03" +
+	"\xccĒ\b\x03̀\x00\b\x03ι\x8c\b\x04\x01\xa6G\b\x04\x03V\xac\b\x04\x04\x96\xc1\b\x04\x05\x98" +
+	"\xf0\b\x04\x06\x9b/\b\x04\a\x9c9\b\x04\b\xa05\b\x04\t\x9e\xf9\b\x04\n\x9f\xc9\b\x04\v\xa0\x9d\b" +
+	"\x04\f\xa1u\b\x04\rx\xed\b\x04\x0e\x9dN\b\x04\x0f\xb32\b\x04A\xa5N\b\x04C\x96\xbf\b\x04D" +
+	"U\x14\b\x04E\x95\xae\b\x04F\x97\xee\b\x04G\x98\xf8\b\x04H\x9c\xf4\b\x04I\x9e\xdc\b\x04J\x9e\xd4" +
+	"\b\x04K\x9f\xa8\b\x04L\xa0\x80\b\x04Mr_\b\x04N\x9ck\b\x04O\xb27\b\x04P\x8f$\b\x04" +
+	"\x81\xa7\xad\b\x04\x83\x99\"\b\x04\x84\x95\xe0\b\x04\x85V4\b\x04\x86\x92i\b\x04\x87\x93s\b\x04\x88\x97" +
+	"\x8a\b\x04\x89\x99r\b\x04\x8a\x9c\x8f\b\x04\x8b\x9c\x8b\b\x04\x8c\x9dc\b\x04\x8dm\xc0\b\x04\x8e\x9ez\b" +
+	"\x04\x8f\xb1\x91\b\x04\x90\x917\b\x04\x91\x8eF\b\x04\xc1\xa9\xf3\b\x04Ûh\b\x04Ę&\b\x04\xc5" +
+	"\x92i\b\x04\xc6U\xd5\b\x04Ǒ\x14\b\x04ȕ+\b\x04ɗ\x13\b\x04ʚ0\b\x04˝P" +
+	"\b\x04̝Q\b\x04\xcde3\b\x04Π\x84\b\x04ϴ\xeb\b\x04ГA\b\x04ѐQ\b\x04" +
+	"ҋ)\b\x05\x01\xa8d\b\x05\x03\x9cu\b\x05\x04\x993\b\x05\x05\x93v\b\x05\x06\x91\x15\b\x05\aT" +
+	"\x7f\b\x05\b\x92\x05\b\x05\t\x93\xed\b\x05\n\x97\t\b\x05\v\x9a*\b\x05\f\x9dN\b\x05\r]1\b" +
+	"\x05\x0e\x9f\x13\b\x05\x0f\xb7\x84\b\x05\x10\x94.\b\x05\x11\x91=\b\x05\x12\x8c\x16\b\x05\x13\x89\xef\b\x05A" +
+	"\xa9e\b\x05C\xa0|\b\x05D\x9d:\b\x05E\x97\x96\b\x05F\x955\b\x05G\x92\x03\b\x05HT\xda" +
+	"\b\x05I\x91\xc9\b\x05J\x94\xe5\b\x05K\x98\x06\b\x05L\x9b*\b\x05MX\t\b\x05N\x9f\xf9\b\x05" +
+	"O\xb7\xef\b\x05P\x97\xcf\b\x05Q\x94\xde\b\x05R\x8f\xcf\b\x05S\x8d\xa9\b\x05T\x8a\xc6\b\x05\x81\xa8" +
+	"\xf5\b\x05\x83\x9f5\b\x05\x84\x9f\x16\b\x05\x85\x99s\b\x05\x86\x97\x12\b\x05\x87\x93\xe0\b\x05\x88\x91\xbc\b" +
+	"\x05\x89R\xe7\b\x05\x8a\x91\x14\b\x05\x8b\x945\b\x05\x8c\x97Y\b\x05\x8dQ\xc2\b\x05\x8e\x9f\x9d\b\x05\x8f" +
+	"\xb7\xab\b\x05\x90\x96\xb1\b\x05\x91\x96\x97\b\x05\x92\x91\x87\b\x05\x93\x8fa\b\x05\x94\x8c~\b\x05\x95\x8a\x8d" +
+	"\b\x05\xc1\xa9\xc5\b\x05à\x05\b\x05ğ\x0f\b\x05Ŝ\x8f\b\x05ƚ.\b\x05ǖ\xfc\b\x05" +
+	"Ȕ\xd8\b\x05ɑ\x13\b\x05\xcaR\xac\b\x05˕\b\b\x05̘-\b\x05\xcdN\xa5\b\x05Π" +
+	"W\b\x05ϸ}\b\x05Зk\b\x05і\x8f\b\x05ҔV\b\x05Ӓ/\b\x05ԏL\b" +
+	"\x05Ս[\b\x05։\xea\b\x06\x01\xaa\x98\b\x06\x03\xa0\xd8\b\x06\x04\x9f\xe3\b\x06\x05\x9c\x8c\b\x06\x06" +
+	"\x9dN\b\x06\a\x9a\x1c\b\x06\b\x97\xf8\b\x06\t\x943\b\x06\n\x95\a\b\x06\vR\x9f\b\x06\f\x99\x04" +
+	"\b\x06\rLg\b\x06\x0e\xa1\x14\b\x06\x0f\xb9R\b\x06\x10\x98)\b\x06\x11\x97L\b\x06\x12\x94Q\b\x06" +
+	"\x13\x95\x01\b\x06\x14\x92\x1e\b\x06\x15\x90-\b\x06\x16\x8c\xbc\b\x06\x17\x8dz\b\x06A\xabo\b\x06C\xa1" +
+	"\xb0\b\x06D\xa0\xba\b\x06E\x9dc\b\x06F\x9dO\b\x06G\x9d@\b\x06H\x9b\x1c\b\x06I\x97W\b" +
+	"\x06J\x98+\b\x06K\x99\x03\b\x06LR\xbe\b\x06MK\x14\b\x06N\xa1\xd6\b\x06O\xba,\b\x06P" +
+	"\x98\xea\b\x06Q\x98\x0e\b\x06R\x95\x13\b\x06S\x95\x01\b\x06T\x94\xf4\b\x06U\x93\x03\b\x06V\x8f\x92" +
+	"\b\x06W\x90O\b\x06X\x91\x11\b\x06\x8d \x04\b\x06\x8e\x8e\xb0\b\x06\x8f\x86i\b\x06\x90\x80\x0f\b\x06" +
+	"\x91y\x00\b\x06\x92tI\b\x06\x93k!\b\x06\x94b\x9b\b\x06\x95]#\b\x06\x96Vl\b\x06\x97S" +
+	"!\b\x06\x98P\xc9\b\x06\x99Or\b\x06\xce\x7f\v\b\x06\xcfĜ\b\x06Чh\b\x06Ѧs\b" +
+	"\x06Ҩ\xba\b\x06Ӫ\xf0\b\x06ԩg\b\x06ժb\b\x06֩\xf5\b\x06ת\xc1\b\x06\xd8" +
+	"\xab\x91\b\x06٬e\b\a\x0f_\xbb\b\a\x10\xbd\xda\b\a\x11\xbc\xcb\b\a\x12\xbcK\b\a\x13\xbf\xe6" +
+	"\b\a\x14¹\b\a\x15\xc3/\b\a\x16\xc2\xdb\b\a\x17\xc3\xc0\b\a\x18ĩ\b\a\x19Ŗ\b\a" +
+	"PX\x14\b\aQ\x98[\b\aR\x9a\xa5\b\aS\x9c\xdc\b\aT\x9d\xeb\b\aU\xa1\xd6\b\aV\xa0" +
+	"\x94\b\aW\xa1a\b\aX\xa21\b\aY\xa3\x05\b\a\x91V{\b\a\x92\x97q\b\a\x93\x99\xa7\b" +
+	"\a\x94\x9a\xb7\b\a\x95\x9e\xa1\b\a\x96\xa0w\b\a\x97\xa0o\b\a\x98\xa1@\b\a\x99\xa2\x14\b\a\xd2" +
+	"W\xb7\b\aӔ9\b\aԕI\b\aՙM\b\a֛#\b\aמ2\b\a؞." +
+	"\b\aٟ\x02\b\b\x13WT\b\b\x14\x92\xf2\b\b\x15\x96\xf6\b\b\x16\x98\xcc\b\b\x17\x9b\xdb\b\b" +
+	"\x18\x9e\xef\b\b\x19\x9e\xee\b\bTV\n\b\bU\x93\xdd\b\bV\x95\xb3\b\bW\x98\xc3\b\bX\x9b" +
+	"\xd6\b\bY\x9e\xed\b\b\x95V^\b\b\x96\x93\x94\b\b\x97\x96\xa4\b\b\x98\x99\xb7\b\b\x99\x9c\xce\b" +
+	"\b\xd6Tg\b\bג\xed\b\bؖ\x01\b\bٙ\x18\b\t\x17T*\b\t\x18\x96\xd1\b\t\x19" +
+	"\x99\xe8\b\tXT\x1b\b\tY\x9a\xbc\b\t\x99T:\b\x10\xc0P|\b\x11\x00PA\b\x11\x03`\xb7" +
+	"\b\x11@Tz\b\x11C\\W\b\x11DZ2\b\x11\x80S%\b\x11\x83Xv\b\x11\x84W\x0f\b\x11" +
+	"\x85T\xd5\b\x11\xc0S\xc7\b\x11\xc3W\xb5\b\x11\xc4Vm\b\x11\xc5T\xc5\b\x11\xc6P@\b\x12\x00T" +
+	"\xdd\b\x12\x03V\xc6\b\x12\x04U\x80\b\x12\x05T\xd0\b\x12\x06S$\b\x12\aR\x82\b\x12@S\x9b\b" +
+	"\x12CU'\b\x12DU&\b\x12ETx\b\x12FR\xdf\b\x12GRi\b\x12HR\x14\b\x12\x80" +
+	"S\xd6\b\x12\x83T\x9c\b\x12\x84Ta\b\x12\x85T\xf8\b\x12\x86Sa\b\x12\x87R\xf6\b\x12\x88R\xc1" +
+	"\b\x12\x89Q/\b\x12\xc0T\x11\b\x12\xc3TX\b\x12\xc4T\x1d\b\x12\xc5Ty\b\x12\xc6T'\b\x12" +
+	"\xc7S\xbf\b\x12\xc8S\x8d\b\x12\xc9R\"\b\x12\xcaR]\b\x13\x00TL\b\x13\x03T@\b\x13\x04T" +
+	"\x04\b\x13\x05Ta\b\x13\x06S\xd4\b\x13\aT\xb1\b\x13\bT\x82\b\x13\tS\x1a\b\x13\nSU\b" +
+	"\x13\vS\x90\b\x13@)\xea\b\x13C[\x8a\b\x13D[N\b\x13E_R\b\x13F]\xe9\b\x13G" +
+	"^\x87\b\x13H_\x98\b\x13I^\\\b\x13J^\x96\b\x13K^\xcf\b\x13L_\t\b\x13\x80H\xa8" +
+	"\b\x13\x83|{\b\x13\x84l\xa4\b\x13\x85ep\b\x13\x86_\xda\b\x13\x87Y\xdf\b\x13\x88V9\b\x13" +
+	"\x89R\xda\b\x13\x8aP\xaa\b\x13\x8bO\x1d\b\x13\x8cM\xe4\b\x13\xc0F.\b\x13\xc3y$\b\x13\xc4j" +
+	"\xb8\b\x13\xc5b\xda\b\x13\xc6Z=\b\x13\xc7Xw\b\x13\xc8T0\b\x13\xc9P\xb9\b\x13\xcaNp\b" +
+	"\x13\xcbL\xc9\b\x13\xccKx\b\x13\u0380\x00\b\x14\x00[Y\b\x14\x03)x\b\x14\x04k\x9e\b\x14\x05" +
+	"g$\b\x14\x06c<\b\x14\abs\b\x14\baw\b\x14\t_\xdb\b\x14\n_P\b\x14\v_\v" +
+	"\b\x14\f^\xf1\b\x14\rY\x95\b\x14\x0ex\xaf\b\x14\x0fu\x9f\b\x14@[\x1c\b\x14Ck\x97\b\x14" +
+	"D(O\b\x14Ee\x04\b\x14Fa\xd8\b\x14Ga.\b\x14H`5\b\x14I_\xd8\b\x14J_" +
+	"\x12\b\x14K^\xcd\b\x14L^\xb4\b\x14MYa\b\x14NjN\b\x14Oh\x85\b\x14Pho\b" +
+	"\x14\x80_P\b\x14\x83gN\b\x14\x84e/\b\x14\x85+\x8c\b\x14\x86_\xba\b\x14\x87_\x9f\b\x14\x88" +
+	"_\x98\b\x14\x89_>\b\x14\x8a_\xb9\b\x14\x8b_9\b\x14\x8c_ \b\x14\x8d]\x1c\b\x14\x8ec\xac" +
+	"\b\x14\x8faJ\b\x14\x90dq\b\x14\x91b\x8a\b\x14\xc0^\x0f\b\x14\xc3c\x80\b\x14\xc4b\x1d\b\x14" +
+	"\xc5_\xce\b\x14\xc6,\xc2\b\x14\xc7[T\b\x14\xc8]\xfc\b\x14\xc9]\xb3\b\x14\xca^0\b\x14\xcb^" +
+	"\xf0\b\x14\xcc^\x9c\b\x14\xcd[\xd5\b\x14\xce^z\b\x14\xcfYR\b\x14\xd0`\xe9\b\x14\xd1_\xad\b" +
+	"\x14\xd2]\x99\b\x15\x00^\xac\b\x15\x03b\xbb\b\x15\x04av\b\x15\x05_\xb8\b\x15\x06[Q\b\x15\a" +
+	"-\x1c\b\x15\b]Z\b\x15\t]>\b\x15\n]\xc6\b\x15\v^\x89\b\x15\f_u\b\x15\r\\c" +
+	"\b\x15\x0eX\xec\b\x15\x0fW\x98\b\x15\x10`,\b\x15\x11_\v\b\x15\x12]{\b\x15\x13Yy\b\x15" +
+	"@_\xbc\b\x15Ca\xc9\b\x15D`\x87\b\x15E_\xb9\b\x15F]\xf7\b\x15G]V\b\x15H-" +
+	"\xd7\b\x15I\\\xe8\b\x15J]\x8f\b\x15K^V\b\x15L_D\b\x15M]X\b\x15NU{\b" +
+	"\x15OS\x94\b\x15P_F\b\x15Q^(\b\x15R]r\b\x15S[\xde\b\x15T[N\b\x15\x80" +
+	"^\x80\b\x15\x83`.\b\x15\x84`+\b\x15\x85_`\b\x15\x86]\xaf\b\x15\x87];\b\x15\x88\\\xe6" +
+	"\b\x15\x89-\xa4\b\x15\x8a\\\x04\b\x15\x8b\\\xf1\b\x15\x8c]\xe3\b\x15\x8d\\>\b\x15\x8eRO\b\x15" +
+	"\x8fPQ\b\x15\x90]\xcc\b\x15\x91]\xcc\b\x15\x92]\x18\b\x15\x93[\x94\b\x15\x94[-\b\x15\x95Z" +
+	"\xe1\b\x15\xc0^\xbb\b\x15\xc3_\xa4\b\x15\xc4_h\b\x15\xc5_\xdb\b\x15\xc6^-\b\x15\xc7]\xc3\b" +
+	"\x15\xc8]\x8e\b\x15\xc9\\\x03\b\x15\xca-\x90\b\x15\xcb]+\b\x15\xcc^\x1e\b\x15\xcd\\r\b\x15\xce" +
+	"P;\b\x15\xcfN%\b\x15\xd0]I\b\x15\xd1]\x15\b\x15\xd2]\x7f\b\x15\xd3[\xfd\b\x15\xd4[\xa0" +
+	"\b\x15\xd5[q\b\x15\xd6Z\x0f\b\x16\x00^\xf5\b\x16\x03_a\b\x16\x04_$\b\x16\x05_^\b\x16" +
+	"\x06^\xef\b\x16\a^\x88\b\x16\b^V\b\x16\t\\\xf1\b\x16\n]+\b\x16\v-\x8b\b\x16\f^" +
+	"X\b\x16\r\\\xa5\b\x16\x0eN\xbf\b\x16\x0fL\x91\b\x16\x10]\a\b\x16\x11\\\xd3\b\x16\x12]\t\b" +
+	"\x16\x13\\\xa5\b\x16\x14\\J\b\x16\x15\\\x1f\b\x16\x16Z\xe0\b\x16\x17[\x13\b\x16@_0\b\x16C" +
+	"_H\b\x16D_\f\b\x16E_E\b\x16F^\x9c\b\x16G_u\b\x16H_F\b\x16I]\xe5" +
+	"\b\x16J^\x1f\b\x16K^X\b\x16L-\x8e\b\x16M\\\xd9\b\x16NM\x92\b\x16OKL\b\x16" +
+	"P\\\xed\b\x16Q\\\xb9\b\x16R\\\xef\b\x16S\\W\b\x16T]\x19\b\x16U\\\xf1\b\x16V[" +
+	"\xb5\b\x16W[\xe9\b\x16X\\\x1c\b\x16\x8d-\x10\b\x16\x8eL\xc0\b\x16\x8fJS\b\x16\x90_\x1f\b" +
+	"\x16\x91^\xe3\b\x16\x92b\xed\b\x16\x93a\xb7\b\x16\x94bQ\b\x16\x95cZ\b\x16\x96b$\b\x16\x97" +
+	"b^\b\x16\x98b\x97\b\x16\x99b\xd1\b\x16\xce_A\b\x16φp\b\x16\xd0\x7f\xb1\b\x16\xd1p\r" +
+	"\b\x16\xd2i\x1e\b\x16\xd3c\xc0\b\x16\xd4]\xdb\b\x16\xd5Z?\b\x16\xd6V\xee\b\x16\xd7T\xc7\b\x16" +
+	"\xd8S?\b\x16\xd9R\v\b\x17\x0f(\x9f\b\x17\x10|n\b\x17\x11n1\b\x17\x12f\x9a\b\x17\x13^" +
+	"@\b\x17\x14\\y\b\x17\x15XA\b\x17\x16T\xd7\b\x17\x17R\x98\b\x17\x18P\xf8\b\x17\x19O\xab\b" +
+	"\x17P,\x97\b\x17Qo,\b\x17Rj\xd3\b\x17Sg\a\b\x17Tf9\b\x17Ue:\b\x17V" +
+	"c\xa1\b\x17Wc\x17\b\x17Xb\xd2\b\x17Yb\xb9\b\x17\x91+n\b\x17\x92h\xbb\b\x17\x93e\xa9" +
+	"\b\x17\x94d\xfa\b\x17\x95c\xfd\b\x17\x96c\x9e\b\x17\x97b\xda\b\x17\x98b\x96\b\x17\x99b|\b\x17" +
+	"\xd2.\xb4\b\x17\xd3c\x97\b\x17\xd4cu\b\x17\xd5ce\b\x17\xd6c\t\b\x17\xd7c\x7f\b\x17\xd8c" +
+	"\x01\b\x17\xd9b\xe8\b\x18\x13/\xf3\b\x18\x14_F\b\x18\x15a\xd2\b\x18\x16a\x87\b\x18\x17b\x00\b" +
+	"\x18\x18b\xbc\b\x18\x19bi\b\x18T0H\b\x18Ua2\b\x18Va\x13\b\x18Wa\x97\b\x18X" +
+	"bV\b\x18Yc=\b\x18\x950\xfb\b\x18\x96`\xbd\b\x18\x97a`\b\x18\x98b\"\b\x18\x99c\f" +
+	"\b\x18\xd60\xc8\b\x18\xd7_\xdc\b\x18\xd8`\xc4\b\x18\xd9a\xb1\b\x19\x170\xb5\b\x19\x18`\xfe\b\x19" +
+	"\x19a\xeb\b\x19X0\xb1\b\x19Yb%\b\x19\x990\xb5\f\x01\x01\xa5D\f\x01\x02\xa1\xf8\f\x01A\xa7" +
+	"\xb8\f\x01B\xa4o\f\x01D\x96\a\f\x01\x81\xaa\x06\f\x01\x82\xa6\xbe\f\x01\x84\x98V\f\x01\x85\x92\x83\f" +
+	"\x01\xc1\xa8d\f\x01¨\xdd\f\x01ę\xf9\f\x01Ŕ%\f\x01Ƒ\x9b\f\x02\x01\xa8l\f\x02\x02" +
+	"\xa8\x1a\f\x02\x04\x9b\xe4\f\x02\x05\x96\x11\f\x02\x06\x93\x86\f\x02\a\x90&\f\x02A\xa8?\f\x02B\xa7\xed" +
+	"\f\x02D\x9e\xec\f\x02E\x99\x18\f\x02F\x96\x8e\f\x02G\x93I\f\x02H\x8f\xe8\f\x02\x81\xa9\x12\f\x02" +
+	"\x82\xa8\xc1\f\x02\x84\x9e\xe3\f\x02\x85\x9cb\f\x02\x86\x99\xd8\f\x02\x87\x96\x93\f\x02\x88\x932\f\x02\x89\x8f" +
+	"\x8a\f\x02\xc1\xa9\xe9\f\x02©\x98\f\x02ğ\xba\f\x02Ŝ^\f\x02Ɲ&\f\x02Ǚ\xe2\f" +
+	"\x02Ȗ\x80\f\x02ɒ\xd8\f\x02ʓ\xb0\f\x03\x01\xaa\xc5\f\x03\x02\xaas\f\x03\x04\xa0\x96\f\x03\x05" +
+	"\x9d9\f\x03\x06\x9d&\f\x03\a\x9d4\f\x03\b\x99\xd3\f\x03\t\x96+\f\x03\n\x97\x02\f\x03\v\x97\xde" +
+	"\f\x03A\x8d\xea\f\x03B\x8d1\f\x03DwX\f\x03Er!\f\x03Fh\xc8\f\x03G`\xe9\f\x03" +
+	"HZT\f\x03IT\x80\f\x03JQ/\f\x03KN\xd3\f\x03LMz\f\x03\x81~\xfe\f\x03\x82\xb0" +
+	"\f\f\x03\x84\xa6\x02\f\x03\x85\xa8F\f\x03\x86\xaa\x86\f\x03\x87\xa8\xe9\f\x03\x88\xa8\xf0\f\x03\x89\xa8\xc4\f" +
+	"\x03\x8a\xa9\x94\f\x03\x8b\xaah\f\x03\x8c\xab@\f\x03\xc1\xaf\xfb\f\x03\xc2|<\f\x03Ģ\xc0\f\x03\xc5" +
+	"\xa5\b\f\x03ƧH\f\x03ǩS\f\x03Ȩ\x93\f\x03ɨg\f\x03ʩ6\f\x03˪\n" +
+	"\f\x03̪\xe2\f\x04\x01\xc3U\f\x04\x02\xbf)\f\x04\x04\xb8w\f\x04\x05\xb7\xbf\f\x04\x06\xbbm\f\x04" +
+	"\a\xbeD\f\x04\b\xc2\x11\f\x04\t\xc1]\f\x04\n\xc2F\f\x04\v\xc34\f\x04\f\xc4%\f\x04\r\x80" +
+	"\x00\f\x04\x0e\xb9*\f\x04\x0f\xb5Z\f\x04A\xa6!\f\x04B\xa2\xea\f\x04DU\x90\f\x04E\x979\f" +
+	"\x04F\x99x\f\x04G\x9b\r\f\x04H\x9c\xf4\f\x04I\x9f\xc4\f\x04J\x9f\xbc\f\x04K\xa0\x90\f\x04L" +
+	"\xa1h\f\x04Mr\xf9\f\x04N\x9d3\f\x04O\x9aG\f\x04P\xaf+\f\x04\x81\xa8\x9b\f\x04\x82\xa5i" +
+	"\f\x04\x84\x97m\f\x04\x85V\xd3\f\x04\x86\x94\x0f\f\x04\x87\x95\xa4\f\x04\x88\x97\x8b\f\x04\x89\x9aZ\f\x04" +
+	"\x8a\x9dv\f\x04\x8b\x9ds\f\x04\x8c\x9eK\f\x04\x8dn.\f\x04\x8e\x9fZ\f\x04\x8f\x9cr\f\x04\x90\xae" +
+	"\x85\f\x04\x91\x8f\xb1\f\x04\xc1\xaa\xe1\f\x04§\xaf\f\x04ę\xb3\f\x04Ŕ\x0f\f\x04\xc6Vs\f" +
+	"\x04ǓE\f\x04ȕ,\f\x04ɗ\xfb\f\x04ʛ\x18\f\x04˞8\f\x04̞9\f\x04\xcd" +
+	"e\x97\f\x04Ρd\f\x04Ϟ|\f\x04б\xe0\f\x04ё\xbb\f\x04Ҍ\xac\f\x05\x01\xa9R" +
+	"\f\x05\x02\xa9\xc9\f\x05\x04\x9bS\f\x05\x05\x95\xb0\f\x05\x06\x93O\f\x05\aU\xdd\f\x05\b\x92\x05\f\x05" +
+	"\t\x94\xf0\f\x05\n\x98\f\f\x05\v\x9b-\f\x05\f\x9eQ\f\x05\r^V\f\x05\x0e\x9f\xf3\f\x05\x0f\xa0" +
+	"\\\f\x05\x10\xb4x\f\x05\x11\x93,\f\x05\x12\x8e\x1d\f\x05\x13\x8b\xf6\f\x05A\xa9e\f\x05B\xa9\x15\f" +
+	"\x05D\x9d;\f\x05E\x97\x98\f\x05F\x957\f\x05G\x92\x05\f\x05HT\xda\f\x05I\x91\xc9\f\x05J" +
+	"\x94\xe5\f\x05K\x98\x06\f\x05L\x9b*\f\x05MX>\f\x05N\x9f\xf9\f\x05O\x9f\xb1\f\x05P\xb7\xef" +
+	"\f\x05Q\x94\xdf\f\x05R\x8f\xcf\f\x05S\x8d\xa9\f\x05T\x8a\xc6\f\x05\x81\xa9.\f\x05\x82\xa8\xde\f\x05" +
+	"\x84\xa0\n\f\x05\x85\x9ag\f\x05\x86\x98\x05\f\x05\x87\x94\xed\f\x05\x88\x91\xbc\f\x05\x89S\xd8\f\x05\x8a\x91" +
+	"M\f\x05\x8b\x94n\f\x05\x8c\x97\x92\f\x05\x8dR\xd3\f\x05\x8e\x9f\xd1\f\x05\x8f\x9f\x88\f\x05\x90\xb7L\f" +
+	"\x05\x91\x97r\f\x05\x92\x92b\f\x05\x93\x90<\f\x05\x94\x8dq\f\x05\x95\x8a\x8d\f\x05\xc1\xa9\xfd\f\x05\xc2" +
+	"\xa9\xae\f\x05Ġ\x03\f\x05ŝ\x83\f\x05ƛ\"\f\x05ǘ\t\f\x05Ȕ\xd8\f\x05ɑL" +
+	"\f\x05\xcaS\x9d\f\x05˕A\f\x05̘f\f\x05\xcdO\xb9\f\x05Π\x8b\f\x05ϠB\f\x05" +
+	"и\x1e\f\x05їj\f\x05ҕ0\f\x05ӓ\n\f\x05Ԑ?\f\x05Ս[\f\x05֊" +
+	"\x1e\f\x06\x01\xaa\xd1\f\x06\x02\xaa\x81\f\x06\x04\xa0\xd6\f\x06\x05\x9d\x80\f\x06\x06\x9eB\f\x06\a\x9b)\f" +
+	"\x06\b\x97\xf8\f\x06\t\x94l\f\x06\n\x95@\f\x06\vS\x8f\f\x06\f\x99=\f\x06\rM}\f\x06\x0e" +
+	"\xa1I\f\x06\x0f\xa1\x00\f\x06\x10\xb8\xf3\f\x06\x11\x98'\f\x06\x12\x95,\f\x06\x13\x95\xdc\f\x06\x14\x93\x11" +
+	"\f\x06\x15\x90-\f\x06\x16\x8c\xf0\f\x06\x17\x8d\xae\f\x06A\xab\xa8\f\x06B\xabY\f\x06D\xa1\xae\f\x06" +
+	"E\x9eW\f\x06F\x9eB\f\x06G\x9eM\f\x06H\x9b\x1c\f\x06I\x97\x8f\f\x06J\x98c\f\x06K\x99" +
+	";\f\x06LS\xaf\f\x06ML-\f\x06N\xa2\n\f\x06O\xa1\xc1\f\x06P\xb9\xcc\f\x06Q\x98\xe9\f" +
+	"\x06R\x95\xee\f\x06S\x95\xdb\f\x06T\x95\xe7\f\x06U\x93\x03\f\x06V\x8f\xc6\f\x06W\x90\x83\f\x06X" +
+	"\x91E\f\x06\x8d!\v\f\x06\x8e\x8f~\f\x06\x8f\x8e\xb9\f\x06\x90\x86e\f\x06\x91y\x99\f\x06\x92t\xb3" +
+	"\f\x06\x93k\x80\f\x06\x94c\xcb\f\x06\x95]Y\f\x06\x96W\x91\f\x06\x97TH\f\x06\x98Q\xf3\f\x06" +
+	"\x99P\x9e\f\x06\xce\x7f\xdc\f\x06ϰ\xe5\f\x06\xd0\xc40\f\x06ѧB\f\x06ҩ\xa2\f\x06ӫ" +
+	"\xd8\f\x06ԪO\f\x06ժb\f\x06֪+\f\x06ת\xf7\f\x06ث\xc7\f\x06٬\x9b\f" +
+	"\a\x0f}\x0f\f\a\x10\xc0\r\f\a\x11\xa4\x11\f\a\x12\xa6u\f\a\x13\xa8\xab\f\a\x14\xaa\xb6\f\a\x15" +
+	"\xaa\x06\f\a\x16\xa9\xce\f\a\x17\xaa\x9b\f\a\x18\xabk\f\a\x19\xac?\f\aP^t\f\aQ\xb9x" +
+	"\f\aR\xb8\xf8\f\aS\xbc\x93\f\aT\xbfg\f\aU\xc3+\f\aV\xc2o\f\aW\xc3T\f\a" +
+	"X\xc4=\f\aY\xc5*\f\a\x91V\xe8\f\a\x92\x98\xeb\f\a\x93\x9b!\f\a\x94\x9c\xb9\f\a\x95\x9e" +
+	"\xa2\f\a\x96\xa1Z\f\a\x97\xa1R\f\a\x98\xa2\"\f\a\x99\xa2\xf6\f\a\xd2XF\f\aӕ\xcd\f" +
+	"\aԗe\f\aՙM\f\a֜\x05\f\aן\x15\f\a؟\x11\f\aٟ\xe5\f\b\x13" +
+	"W\xe3\f\b\x14\x95\x0e\f\b\x15\x96\xf6\f\b\x16\x99\xaf\f\b\x17\x9c\xbe\f\b\x18\x9f\xd1\f\b\x19\x9f\xd1" +
+	"\f\bTWV\f\bU\x93\xde\f\bV\x96\xaf\f\bW\x99\xbf\f\bX\x9c\xd2\f\bY\x9f\xe9\f\b" +
+	"\x95V^\f\b\x96\x93\x95\f\b\x97\x96\xa4\f\b\x98\x99\xb7\f\b\x99\x9c\xcf\f\b\xd6UR\f\bד" +
+	"#\f\bؖ7\f\bٙN\f\t\x17U\x15\f\t\x18\x97\a\f\t\x19\x9a\x1e\f\tXU\x06\f" +
+	"\tY\x9a\xf2\f\t\x99U%\f\x11\x00P\xac\f\x11\x02l\x8e\f\x11@U\x02\f\x11Bf\xa6\f\x11D" +
+	"Yb\f\x11\x80Tp\f\x11\x82cQ\f\x11\x84V\xc6\f\x11\x85Uk\f\x11\xc0U\x12\f\x11\xc2b\x8e" +
+	"\f\x11\xc4V&\f\x11\xc5U]\f\x11\xc6Sf\f\x12\x00T\xff\f\x12\x02_G\f\x12\x04U\xa1\f\x12" +
+	"\x05T\xf1\f\x12\x06SF\f\x12\aR\xa3\f\x12@T\x98\f\x12B^\xbf\f\x12DU\x92\f\x12EU" +
+	"\a\f\x12FT)\f\x12GS\xb4\f\x12HR5\f\x12\x80T\xd3\f\x12\x82^4\f\x12\x84T\xcc\f" +
+	"\x12\x85U\x86\f\x12\x86T\xab\f\x12\x87T@\f\x12\x88R\xe2\f\x12\x89R+\f\x12\xc0U\x0e\f\x12\xc2" +
+	"]\xf0\f\x12\xc4T\x88\f\x12\xc5U\a\f\x12\xc6Ur\f\x12\xc7U\t\f\x12\xc8S\xae\f\x12\xc9S\x1f" +
+	"\f\x12\xcaSZ\f\x13\x00UI\f\x13\x02]\xd8\f\x13\x04Tp\f\x13\x05T\xef\f\x13\x06U\x1e\f\x13" +
+	"\aU\xfb\f\x13\bT\xa3\f\x13\tT\x17\f\x13\nTR\f\x13\vT\x8d\f\x13@*\xf7\f\x13Bf" +
+	"\xa9\f\x13D[\xb7\f\x13E_\xd4\f\x13F_-\f\x13G_\xcb\f\x13H_\xb9\f\x13I_T\f" +
+	"\x13J_\x8e\f\x13K_\xc7\f\x13L`\x01\f\x13\x80I\xbc\f\x13\x82\x8dv\f\x13\x84l\xe9\f\x13\x85" +
+	"e\xcc\f\x13\x86a\x0e\f\x13\x87[\x13\f\x13\x88V_\f\x13\x89S\xf2\f\x13\x8aQ\xc2\f\x13\x8bP4" +
+	"\f\x13\x8cN\xfc\f\x13\xc0e\x0f\f\x13\xc2T\xd7\f\x13\xc4w\x00\f\x13\xc5q\x06\f\x13\xc6m\xa0\f\x13" +
+	"\xc7l\xd5\f\x13\xc8i\x97\f\x13\xc9i\v\f\x13\xcah\x80\f\x13\xcbh<\f\x13\xcch\"\f\x14\x00F" +
+	"\x95\f\x14\x02|z\f\x14\x04h\xe9\f\x14\x05b \f\x14\x06Y\xdd\f\x14\aX \f\x14\bT,\f" +
+	"\x14\tQ\x1f\f\x14\nN\xd6\f\x14\vM0\f\x14\fK\xde\f\x14\x0e\x80\x00\f\x14\x0fw\xac\f\x14@" +
+	"[|\f\x14BxZ\f\x14D(\xaf\f\x14Ed2\f\x14Fa\x83\f\x14G`\xda\f\x14H`V" +
+	"\f\x14I`8\f\x14J_r\f\x14K_-\f\x14L_\x14\f\x14MY\xc4\f\x14Nj\x91\f\x14" +
+	"Os\x0f\f\x14Pf\xe6\f\x14\x80_\xcb\f\x14\x82r\x8e\f\x14\x84d_\f\x14\x85,\x0e\f\x14\x86`" +
+	"?\f\x14\x87`&\f\x14\x88_\xba\f\x14\x89_\xc1\f\x14\x8a`;\f\x14\x8b_\xbb\f\x14\x8c_\xa2\f" +
+	"\x14\x8d]\x98\f\x14\x8ed\x03\f\x14\x8fm\xb1\f\x14\x90`\xa7\f\x14\x91a\xd6\f\x14\xc0_I\f\x14\xc2" +
+	"oJ\f\x14\xc4a\xd1\f\x14\xc5`[\f\x14\xc6.\x1f\f\x14\xc7^>\f\x14\xc8^\x1d\f\x14\xc9^\xed" +
+	"\f\x14\xca_j\f\x14\xcb`+\f\x14\xcc_\xd6\f\x14\xcd\\\xfd\f\x14\xce_\x93\f\x14\xcfj\xa4\f\x14" +
+	"\xd0Y\x01\f\x14\xd1_n\f\x14\xd2^\x1f\f\x15\x00_\xe7\f\x15\x02n\x83\f\x15\x04a,\f\x15\x05`" +
+	"F\f\x15\x06^;\f\x15\a.z\f\x15\b]|\f\x15\t^y\f\x15\n_\x00\f\x15\v_\xc3\f" +
+	"\x15\f`\xaf\f\x15\r]\x8b\f\x15\x0eZ\x05\f\x15\x0fi\xe4\f\x15\x10WP\f\x15\x11^\xce\f\x15\x12" +
+	"^\x02\f\x15\x13\\)\f\x15@_\xdf\f\x15BkP\f\x15D`\xa9\f\x15E_\xdb\f\x15F^\x1a" +
+	"\f\x15G]y\f\x15H-\xd8\f\x15I]\v\f\x15J]\xb2\f\x15K^x\f\x15L_g\f\x15" +
+	"M]z\f\x15NU\xa0\f\x15Of\xf0\f\x15PS\x97\f\x15Q^J\f\x15R]\x94\f\x15S\\" +
+	"\x00\f\x15T[p\f\x15\x80_x\f\x15\x82j\xc4\f\x15\x84`\x95\f\x15\x85_\xec\f\x15\x86^\xf3\f" +
+	"\x15\x87^~\f\x15\x88]\a\f\x15\x89.\xb2\f\x15\x8a\\\xfc\f\x15\x8b]\xe9\f\x15\x8c^\xdb\f\x15\x8d" +
+	"]!\f\x15\x8eSO\f\x15\x8ffl\f\x15\x90P\xb3\f\x15\x91^/\f\x15\x92]\x9a\f\x15\x93\\\xbc" +
+	"\f\x15\x94\\U\f\x15\x95[\x03\f\x15\xc0_\xb3\f\x15\xc2j:\f\x15\xc4_\xd1\f\x15\xc5`g\f\x15" +
+	"\xc6_q\f\x15\xc7_\a\f\x15\xc8]\xb0\f\x15\xc9\\\xfb\f\x15\xca.\x9e\f\x15\xcb^#\f\x15\xcc_" +
+	"\x16\f\x15\xcd]U\f\x15\xceQ;\f\x15\xcfe\xe9\f\x15\xd0N\x88\f\x15\xd1]x\f\x15\xd2^\x01\f" +
+	"\x15\xd3]%\f\x15\xd4\\\xc8\f\x15\xd5[\x93\f\x15\xd6Z\xf2\f\x16\x00_\xee\f\x16\x02i\xf7\f\x16\x04" +
+	"_\x8d\f\x16\x05_\xe9\f\x16\x06`2\f\x16\a_\xcb\f\x16\b^w\f\x16\t]\xe9\f\x16\n^#" +
+	"\f\x16\v.\x99\f\x16\f_P\f\x16\r]\x89\f\x16\x0eO\xbf\f\x16\x0fe\xa7\f\x16\x10L\xf3\f\x16" +
+	"\x11]6\f\x16\x12]\x8b\f\x16\x13]\xcd\f\x16\x14]q\f\x16\x15\\A\f\x16\x16[\xc3\f\x16\x17[" +
+	"\xf7\f\x16@`(\f\x16Bi\xde\f\x16D_u\f\x16E_\xd1\f\x16F_\xe0\f\x16G`\xb8\f" +
+	"\x16H_g\f\x16I^\xdd\f\x16J_\x17\f\x16K_P\f\x16L.\x9c\f\x16M]\xbc\f\x16N" +
+	"N\x91\f\x16Oe\x8d\f\x16PK\xae\f\x16Q]\x1c\f\x16R]q\f\x16S]\x7f\f\x16T^A" +
+	"\f\x16U]\x13\f\x16V\\\x98\f\x16W\\\xcc\f\x16X]\x00\f\x16\x8d.\x19\f\x16\x8eM\xcf\f\x16" +
+	"\x8fh\xa1\f\x16\x90J\xb8\f\x16\x91_B\f\x16\x92ce\f\x16\x93b\xeb\f\x16\x94c\x85\f\x16\x95c" +
+	"\x7f\f\x16\x96c\x19\f\x16\x97cS\f\x16\x98c\x8c\f\x16\x99c\xc6\f\x16\xce`\xb4\f\x16ώ\xd5\f" +
+	"\x16Іo\f\x16\xd1pI\f\x16\xd2ip\f\x16\xd3d\xe5\f\x16\xd4^\xff\f\x16\xd5Zh\f\x16\xd6" +
+	"X\x02\f\x16\xd7U\xdb\f\x16\xd8TS\f\x16\xd9S\x1f\f\x17\x0fV'\f\x17\x10~\x8e\f\x17\x11zK" +
+	"\f\x17\x12tx\f\x17\x13q*\f\x17\x14pY\f\x17\x15m0\f\x17\x16l\xa1\f\x17\x17l\x17\f\x17" +
+	"\x18k\xd3\f\x17\x19k\xb9\f\x17P(!\f\x17Qlf\f\x17Re\xe1\f\x17S]\xde\f\x17T\\" +
+	" \f\x17UX@\f\x17VU=\f\x17WR\xfd\f\x17XQ]\f\x17YP\x11\f\x17\x91+\xc4\f" +
+	"\x17\x92g\xe8\f\x17\x93eM\f\x17\x94d\x9f\f\x17\x95d!\f\x17\x96c\xfd\f\x17\x97c9\f\x17\x98" +
+	"b\xf5\f\x17\x99b\xdb\f\x17\xd2/,\f\x17\xd3d\x10\f\x17\xd4c\xf0\f\x17\xd5c\x89\f\x17\xd6c\x89" +
+	"\f\x17\xd7d\x00\f\x17\xd8c\x82\f\x17\xd9ch\f\x18\x131B\f\x18\x14b\x12\f\x18\x15a\xf6\f\x18" +
+	"\x16b\xbb\f\x18\x17c4\f\x18\x18c\xf0\f\x18\x19c\x9d\f\x18T1\x97\f\x18UaV\f\x18Vb" +
+	"G\f\x18Wb\xcb\f\x18Xc\x8a\f\x18Ydq\f\x18\x950\xff\f\x18\x96`\xe2\f\x18\x97a\x84\f" +
+	"\x18\x98bG\f\x18\x99c1\f\x18\xd61\xd1\f\x18\xd7`\xd0\f\x18\xd8a\xb9\f\x18\xd9b\xa6\f\x19\x17" +
+	"1\xbe\f\x19\x18a\xf2\f\x19\x19b\xe0\f\x19X1\xba\f\x19Yc\x19\f\x19\x991\xbe\f!\x00Tu" +
+	"\f!\x01}\t\f!@X\xe0\f!Av{\f!D\\O\f!\x80XN\f!\x81r\x9a\f!" +
+	"\x84Z5\f!\x85X\xf0\f!\xc0W\xc1\f!\xc1m}\f!\xc4X\xa7\f!\xc5W\xdf\f!\xc6U" +
+	"\xe8\f\"\x00X\x9e\f\"\x01k\xdc\f\"\x04Yj\f\"\x05X\xba\f\"\x06W$\f\"\aR\xc4\f" +
+	"\"@X8\f\"AkS\f\"DY[\f\"EX\xd0\f\"FX\b\f\"GVc\f\"H" +
+	"U\xd5\f\"\x80Xs\f\"\x81j\xc9\f\"\x84X\x95\f\"\x85YO\f\"\x86X\x8a\f\"\x87V\xf0" +
+	"\f\"\x88V\x81\f\"\x89U\xcb\f\"\xc0X\xae\f\"\xc1j\x85\f\"\xc4XQ\f\"\xc5X\xd0\f\"" +
+	"\xc6YP\f\"\xc7W\xb9\f\"\xc8WN\f\"\xc9V\xbe\f\"\xcaV\xf9\f#\x00X\xe9\f#\x01j" +
+	"l\f#\x04X8\f#\x05X\xb8\f#\x06X\xfd\f#\aX\xab\f#\bXB\f#\tW\xb6\f" +
+	"#\nW\xf1\f#\vX,\f#@/?\f#Asb\f#D_k\f#Ec\x9e\f#F" +
+	"b\xf6\f#GbM\f#Hc%\f#Ib\xc0\f#Jb\xfa\f#Kc4\f#Lcn" +
+	"\f#\x80q\xa1\f#\x81~\xfb\f#\x84\x86\xe8\f#\x85\x80P\f#\x86|e\f#\x87w?\f#" +
+	"\x88u\x9e\f#\x89u\x13\f#\x8at\x88\f#\x8btC\f#\x8ct*\f#\xc0M\x14\f#\xc1\x90" +
+	"\xc1\f#\xc4m2\f#\xc5f\x17\f#\xc6aY\f#\xc7^\x02\f#\xc8Y\xb7\f#\xc9WJ\f" +
+	"#\xcaU\x1a\f#\xcbS\x8c\f#\xccRT\f$\x00J\xc8\f$\x01\x8e\x95\f$\x04jL\f$\x05" +
+	"d\x1b\f$\x06^\xca\f$\aXo\f$\bX\\\f$\tUQ\f$\nS\v\f$\vQg" +
+	"\f$\fP\x19\f$\x0f\x80\x00\f$@_\x14\f$A\x88S\f$D*\x05\f$Eg\b\f$" +
+	"Fd\xd4\f$Gc8\f$Hc\xee\f$Ic\xd0\f$Jc\n\f$Kb\xc5\f$Lb" +
+	"\xac\f$M]\x1f\f$N\x81\xc4\f$Oj\xd9\f$Ph.\f$\x80cx\f$\x81\x81\xe5\f" +
+	"$\x84g5\f$\x85-\x7f\f$\x86c\xa4\f$\x87b\x84\f$\x88cQ\f$\x89cY\f$\x8a" +
+	"c\xd3\f$\x8bcS\f$\x8cc:\f$\x8da\x06\f$\x8e{\xd3\f$\x8fdM\f$\x90bu" +
+	"\f$\x91dn\f$\xc0b\xf6\f$\xc1~\x18\f$\xc4e&\f$\xc5c\xc5\f$\xc609\f$" +
+	"\xc7`\x9c\f$\xc8a\xca\f$\xc9b\x9a\f$\xcac\x17\f$\xcbc\xd7\f$\xccc\x83\f$\xcd`" +
+	"k\f$\xcexH\f$\xcf_\xde\f$\xd0]\x81\f$\xd1b{\f$\xd2a?\f%\x00bs\f" +
+	"%\x01y\x1b\f%\x04c\xa4\f%\x05b\xbe\f%\x06`\xb2\f%\a1\x9d\f%\b]\xa5\f%\t" +
+	"a\x05\f%\na\x8c\f%\vbO\f%\fc;\f%\r_\xd3\f%\x0es\x95\f%\x0f\\\xb2" +
+	"\f%\x10W\x92\f%\x11a\a\f%\x12`<\f%\x13^c\f%@cL\f%Awz\f%" +
+	"Dd]\f%Ec\x8f\f%Fa\xe3\f%G]\xa2\f%H2\x1f\f%I`w\f%Ja" +
+	"\x1e\f%Ka\xe5\f%Lb\xd4\f%M`\x95\f%Nr\x13\f%OX\xac\f%PWc\f" +
+	"%Qa\xa5\f%R`\xf0\f%S_o\f%T[\x8e\f%\x80b\xe5\f%\x81v\xee\f%\x84" +
+	"dI\f%\x85c\xa0\f%\x86b\xbc\f%\x87a\x01\f%\x88`t\f%\x892\xf9\f%\x8a`h" +
+	"\f%\x8baU\f%\x8cbH\f%\x8d`<\f%\x8eq\x8f\f%\x8fV[\f%\x90T\x82\f%" +
+	"\x91a\x8a\f%\x92`\xf6\f%\x93`+\f%\x94^\x9d\f%\x95^\x1e\f%\xc0c\x1f\f%\xc1v" +
+	"d\f%\xc4c\x86\f%\xc5d\x1c\f%\xc6c:\f%\xc7a\x89\f%\xc8a\x1c\f%\xc9`h\f" +
+	"%\xca2\xe5\f%\xcba\x90\f%\xccb\x82\f%\xcd`p\f%\xceq\f\f%\xcfTG\f%\xd0" +
+	"RY\f%\xd1`\xd4\f%\xd2a]\f%\xd3`\x94\f%\xd4_\x10\f%\xd5^\xae\f%\xd6^\x0e" +
+	"\f&\x00cZ\f&\x01v \f&\x04cB\f&\x05c\x9e\f&\x06c\xfb\f&\abN\f&" +
+	"\ba\xe4\f&\taV\f&\na\x90\f&\v2\xe0\f&\fb\xbd\f&\r`\xa4\f&\x0ep" +
+	"\xca\f&\x0fR\xcb\f&\x10P\xc7\f&\x11`\x91\f&\x12`\xe7\f&\x13a;\f&\x14_\xb9\f" +
+	"&\x15_\\\f&\x16^\xde\f&\x17_\x12\f&@c\x95\f&Av\b\f&Dc*\f&E" +
+	"c\x86\f&Fc\xa9\f&Gc;\f&Hb\xd4\f&IbI\f&Jb\x83\f&Kb\xbd" +
+	"\f&L2\xe4\f&M`\xd8\f&Np\xb0\f&OQ\x9e\f&PO\x85\f&Q`w\f&" +
+	"R`\xcd\f&S`\xed\f&T`\x89\f&U`.\f&V_\xb3\f&W_\xe7\f&X`" +
+	"\x1b\f&\x8d2>\f&\x8et\xe2\f&\x8fQ\b\f&\x90N\xc8\f&\x91b\xb9\f&\x92f\xf0\f" +
+	"&\x93fv\f&\x94e\xf8\f&\x95f\xcc\f&\x96ff\f&\x97f\x9f\f&\x98f\xd9\f&\x99" +
+	"g\x12\f&\xce\x7f\xa8\f&ϒ\x14\f&Џ\xf7\f&щ\xb1\f&҃D\f&\xd3\x7fs" +
+	"\f&\xd4z\x89\f&\xd5x\xe9\f&\xd6x[\f&\xd7w\xd1\f&\xd8w\x8c\f&\xd9ws\f'" +
+	"\x0fb\xda\f'\x10\x86j\f'\x11pd\f'\x12i\x8e\f'\x13e\x02\f'\x14a\xdc\f'\x15]" +
+	"\xa1\f'\x16[:\f'\x17Y\x13\f'\x18W\x8b\f'\x19VW\f'P,\x7f\f'Qm\x91\f" +
+	"'Rg\xa2\f'Sb\x86\f'T\\f\f'U\\L\f'VYK\f'WW\x0f\f'X" +
+	"Uq\f'YT(\f'\x91,\xfc\f'\x92j\x96\f'\x93ht\f'\x94f\xec\f'\x95g\x98" +
+	"\f'\x96gt\f'\x97f\xb0\f'\x98fl\f'\x99fR\f'\xd20\x7f\f'\xd3gK\f'" +
+	"\xd4f=\f'\xd5g\x00\f'\xd6g\x00\f'\xd7gw\f'\xd8f\xf9\f'\xd9f\xdf\f(\x133" +
+	"9\f(\x14d_\f(\x15e\x81\f(\x16fF\f(\x17f\xbf\f(\x18g{\f(\x19g(\f" +
+	"(T4\xa8\f(Uax\f(Vd\xba\f(We>\f(Xe\xfc\f(Yf\xe4\f(\x95" +
+	"5$\f(\x96d.\f(\x97d\xd1\f(\x98e\x93\f(\x99f}\f(\xd65\xf6\f(\xd7d\x1d" +
+	"\f(\xd8e\x05\f(\xd9e\xf3\f)\x175\xe3\f)\x18e?\f)\x19f,\f)X5\xdf\f)" +
+	"Yff\f)\x995\xe3\x10\x01A\xa8\x9a\x10\x01B\xa5R\x10\x01C\xa2\n\x10\x01\x81\xaa\xe9\x10\x01\x82\xa7" +
+	"\xa1\x10\x01\x83\xa4Y\x10\x01\x85\x94\x1f\x10\x01\xc1\xa9F\x10\x01©\xdb\x10\x01æ\x93\x10\x01ŕ\xdd\x10" +
+	"\x01ƓS\x10\x02\x01\xa9j\x10\x02\x02\xa9\x18\x10\x02\x03\xa9\x92\x10\x02\x05\x98_\x10\x02\x06\x95\xd5\x10\x02\a" +
+	"\x92\x90\x10\x02A\xa8?\x10\x02B\xa7\xee\x10\x02C\xa7\x9c\x10\x02E\x99\x18\x10\x02F\x96\x8e\x10\x02G\x93I" +
+	"\x10\x02H\x8f\xe8\x10\x02\x81\xa9N\x10\x02\x82\xa8\xfc\x10\x02\x83\xa8\xaa\x10\x02\x85\x9dZ\x10\x02\x86\x9a\xd0\x10\x02" +
+	"\x87\x97\x8b\x10\x02\x88\x94F\x10\x02\x89\x8f\x8a\x10\x02\xc1\xaa%\x10\x02©\xd3\x10\x02é\x82\x10\x02ŝ" +
+	"V\x10\x02ƞ\x1e\x10\x02ǚ\xda\x10\x02ȗ\x94\x10\x02ɒ\xd9\x10\x02ʓ\xeb\x10\x03\x01\xab\x00\x10" +
+	"\x03\x02\xaa\xaf\x10\x03\x03\xaa]\x10\x03\x05\x9e1\x10\x03\x06\x9e\x1e\x10\x03\a\x9e,\x10\x03\b\x9a\xe7\x10\x03\t" +
+	"\x96+\x10\x03\n\x97>\x10\x03\v\x98\x19\x10\x03A\x94\xa9\x10\x03B\x94]\x10\x03C\x93\xb5\x10\x03Er\xc0" +
+	"\x10\x03Fi\x1f\x10\x03GaX\x10\x03H[\x98\x10\x03IT\xb8\x10\x03JRZ\x10\x03KP\x01\x10\x03" +
+	"LN\xab\x10\x03\x81\x7f\xd3\x10\x03\x82\xb0D\x10\x03\x83\xaf\xdc\x10\x03\x85\xa9%\x10\x03\x86\xabe\x10\x03\x87\xa9" +
+	"\xc8\x10\x03\x88\xa9\xe9\x10\x03\x89\xa8\xc4\x10\x03\x8a\xa9\xcd\x10\x03\x8b\xaa\xa1\x10\x03\x8c\xaby\x10\x03\xc1\xb03\x10" +
+	"\x03\xc2}\x12\x10\x03ì!\x10\x03ť\xe7\x10\x03ƨ'\x10\x03ǪL\x10\x03ȩ\x8b\x10\x03\xc9" +
+	"\xa8g\x10\x03ʩo\x10\x03˪C\x10\x03̫\x1b\x10\x04\x01\xaf\xbf\x10\x04\x02\xac\x12\x10\x04\x03z{" +
+	"\x10\x04\x05\xa2\xac\x10\x04\x06\xa4\xeb\x10\x04\a\xa7\x10\x10\x04\b\xa9\xf7\x10\x04\t\xa8\v\x10\x04\n\xa9\x14\x10\x04" +
+	"\v\xa9\xe7\x10\x04\f\xaa\xc0\x10\x04A\xc2\xe9\x10\x04B\xbe\xbe\x10\x04C\xba\x92\x10\x04E\xb4g\x10\x04F\xb8" +
+	"\x16\x10\x04G\xba\xed\x10\x04H\xbe\xba\x10\x04I\xc1[\x10\x04J\xc1\xdb\x10\x04K\xc2\xc9\x10\x04Lú\x10" +
+	"\x04M\x80\x00\x10\x04N\xb8\xc9\x10\x04O\xb4\xf9\x10\x04P\xb1)\x10\x04\x81\xa9n\x10\x04\x82\xa6;\x10\x04\x83" +
+	"\xa3\t\x10\x04\x85WO\x10\x04\x86\x95\x80\x10\x04\x87\x97.\x10\x04\x88\x99\xa0\x10\x04\x89\x9aZ\x10\x04\x8a\x9e^" +
+	"\x10\x04\x8b\x9e[\x10\x04\x8c\x9f3\x10\x04\x8dn\xbe\x10\x04\x8e\xa0\"\x10\x04\x8f\x9d:\x10\x04\x90\x9aQ\x10\x04" +
+	"\x91\xaby\x10\x04\xc1\xab\xb4\x10\x04¨\x81\x10\x04åO\x10\x04ŕ\x80\x10\x04\xc6V\xef\x10\x04ǔ" +
+	"\xd0\x10\x04ȗA\x10\x04ɗ\xfc\x10\x04ʜ\x00\x10\x04˟ \x10\x04̟!\x10\x04\xcde\xe7\x10" +
+	"\x04\u03a2,\x10\x04ϟD\x10\x04М\\\x10\x04Ѯ\xd4\x10\x04ҍ\xff\x10\x05\x01\xaa%\x10\x05\x02" +
+	"\xaa\xb7\x10\x05\x03\xa7\x84\x10\x05\x05\x97<\x10\x05\x06\x94\xdb\x10\x05\aV{\x10\x05\b\x946\x10\x05\t\x94\xf0" +
+	"\x10\x05\n\x98\xf4\x10\x05\v\x9c\x15\x10\x05\f\x9f9\x10\x05\r^\xbb\x10\x05\x0e\xa0\xbb\x10\x05\x0f\xa1<\x10\x05" +
+	"\x10\x9eT\x10\x05\x11\xb1m\x10\x05\x12\x8f\x88\x10\x05\x13\x8da\x10\x05A\xaaS\x10\x05B\xaa\x04\x10\x05C\xaa" +
+	"z\x10\x05E\x99\xb8\x10\x05F\x97W\x10\x05G\x94?\x10\x05HV8\x10\x05I\x91\xc9\x10\x05J\x95\xe8\x10" +
+	"\x05K\x99\t\x10\x05L\x9c.\x10\x05MYd\x10\x05N\xa0\xd9\x10\x05O\xa0\x91\x10\x05P\xa0\xfa\x10\x05Q" +
+	"\xb4\xe3\x10\x05R\x91\xbe\x10\x05S\x8f\x98\x10\x05T\x8c\xcd\x10\x05\x81\xa9.\x10\x05\x82\xa8\xde\x10\x05\x83\xa8\x8f" +
+	"\x10\x05\x85\x9ah\x10\x05\x86\x98\a\x10\x05\x87\x94\xef\x10\x05\x88\x91\xbe\x10\x05\x89S\xd9\x10\x05\x8a\x91M\x10\x05" +
+	"\x8b\x94n\x10\x05\x8c\x97\x92\x10\x05\x8dS\t\x10\x05\x8e\x9f\xd1\x10\x05\x8f\x9f\x89\x10\x05\x90\x9f@\x10\x05\x91\xb7" +
+	"L\x10\x05\x92\x92c\x10\x05\x93\x90<\x10\x05\x94\x8dr\x10\x05\x95\x8a\x8e\x10\x05\xc1\xaa6\x10\x05©\xe7\x10" +
+	"\x05é\x97\x10\x05Şv\x10\x05Ɯ\x15\x10\x05ǘ\xfd\x10\x05ȕ\xe5\x10\x05ɑL\x10\x05\xca" +
+	"T\x8e\x10\x05˕z\x10\x05̘\x9e\x10\x05\xcdP\xcb\x10\x05Π\xbf\x10\x05Ϡv\x10\x05Р." +
+	"\x10\x05ѷ\xbf\x10\x05Җ\v\x10\x05ӓ\xe5\x10\x05ԑ\x1a\x10\x05ՎO\x10\x05֊\x1f\x10\x06" +
+	"\x01\xab\n\x10\x06\x02\xaa\xba\x10\x06\x03\xaaj\x10\x06\x05\x9es\x10\x06\x06\x9f5\x10\x06\a\x9c\x1d\x10\x06\b\x99" +
+	"\x05\x10\x06\t\x94l\x10\x06\n\x95x\x10\x06\vT\x80\x10\x06\f\x99v\x10\x06\rN\x92\x10\x06\x0e\xa1}\x10" +
+	"\x06\x0f\xa14\x10\x06\x10\xa0\xeb\x10\x06\x11\xb8\x94\x10\x06\x12\x96\a\x10\x06\x13\x96\xb7\x10\x06\x14\x93\xec\x10\x06\x15" +
+	"\x91!\x10\x06\x16\x8c\xf0\x10\x06\x17\x8d\xe2\x10\x06A\xab\xe1\x10\x06B\xab\x91\x10\x06C\xabB\x10\x06E\x9fK" +
+	"\x10\x06F\x9f6\x10\x06G\x9fA\x10\x06H\x9c)\x10\x06I\x97\x90\x10\x06J\x98\x9c\x10\x06K\x99t\x10\x06" +
+	"LT\xa0\x10\x06MMD\x10\x06N\xa2>\x10\x06O\xa1\xf5\x10\x06P\xa1\xad\x10\x06Q\xb9m\x10\x06R\x96" +
+	"\xc9\x10\x06S\x96\xb6\x10\x06T\x96\xc2\x10\x06U\x93\xf6\x10\x06V\x8f\xc6\x10\x06W\x90\xb8\x10\x06X\x91y\x10" +
+	"\x06\x8d\"\x11\x10\x06\x8e\x96\x1f\x10\x06\x8f\x95\xc5\x10\x06\x90\x95\x12\x10\x06\x91\x86c\x10\x06\x92uA\x10\x06\x93" +
+	"k\xc8\x10\x06\x94d+\x10\x06\x95^\x8a\x10\x06\x96W\xc8\x10\x06\x97Un\x10\x06\x98S\x1b\x10\x06\x99Q\xc9" +
+	"\x10\x06\u0380\xac\x10\x06ϱ\x1b\x10\x06а\xa9\x10\x06\xd1\xc3\xc6\x10\x06Ҫq\x10\x06Ӭ\xa7\x10\x06" +
+	"ԫ\x1e\x10\x06իJ\x10\x06֪+\x10\x06\u05eb-\x10\x06ث\xfd\x10\x06٬\xd1\x10\a\x0f}" +
+	"\xe0\x10\a\x10\xad\x04\x10\a\x11\xbf\xa3\x10\a\x12\xa7C\x10\a\x13\xa9z\x10\a\x14\xab\x9e\x10\a\x15\xaa\xee\x10" +
+	"\a\x16\xa9\xce\x10\a\x17\xaa\xd1\x10\a\x18\xab\xa1\x10\a\x19\xacu\x10\aP{A\x10\aQ\xbb\x81\x10\aR" +
+	"\xa4\x18\x10\aS\xa6N\x10\aT\xa8s\x10\aU\xabW\x10\aV\xa9t\x10\aW\xaav\x10\aX\xabG" +
+	"\x10\aY\xac\x1b\x10\a\x91]Y\x10\a\x92\xb5\xa7\x10\a\x93\xb9B\x10\a\x94\xbc\x15\x10\a\x95\xbf\xda\x10\a" +
+	"\x96\xc2l\x10\a\x97\xc2\xea\x10\a\x98\xc3\xd3\x10\a\x99Ŀ\x10\a\xd2X\xb4\x10\aӗ.\x10\aԘ" +
+	"\xdf\x10\a՛P\x10\a֜\x06\x10\aן\xf8\x10\a؟\xf4\x10\a٠\xc8\x10\b\x13XQ\x10" +
+	"\b\x14\x96\x88\x10\b\x15\x98\xf9\x10\b\x16\x99\xaf\x10\b\x17\x9d\xa1\x10\b\x18\xa0\xb4\x10\b\x19\xa0\xb4\x10\bT" +
+	"W\xe6\x10\bU\x95\xf9\x10\bV\x96\xaf\x10\bW\x9a\xa1\x10\bX\x9d\xb5\x10\bY\xa0\xcc\x10\b\x95W\xaa" +
+	"\x10\b\x96\x93\x95\x10\b\x97\x97\xa0\x10\b\x98\x9a\xb3\x10\b\x99\x9d\xca\x10\b\xd6UR\x10\bד$\x10\b" +
+	"ؖ7\x10\bٙN\x10\t\x17V\x01\x10\t\x18\x97=\x10\t\x19\x9aT\x10\tXU\xf2\x10\tY\x9b" +
+	"(\x10\t\x99V\x10\x10\x11@Ui\x10\x11Bf\xfe\x10\x11Cc\xc1\x10\x11\x80T\xfa\x10\x11\x82c\xbe\x10" +
+	"\x11\x83`\x82\x10\x11\x85T\xb4\x10\x11\xc0U\x9c\x10\x11\xc2c\x00\x10\x11\xc3_\xc3\x10\x11\xc5T\x87\x10\x11\xc6" +
+	"SL\x10\x12\x00VE\x10\x12\x02`|\x10\x12\x03`e\x10\x12\x05T\xc8\x10\x12\x06S\xda\x10\x12\aS7" +
+	"\x10\x12@T\xb5\x10\x12B^\xe0\x10\x12C]\xfe\x10\x12EU$\x10\x12FTF\x10\x12GS\xd1\x10\x12" +
+	"HRS\x10\x12\x80U\xcc\x10\x12\x82_1\x10\x12\x83^O\x10\x12\x85U\xee\x10\x12\x86U6\x10\x12\x87T" +
+	"\xcb\x10\x12\x88T(\x10\x12\x89RI\x10\x12\xc0V\a\x10\x12\xc2^\xed\x10\x12\xc3^\v\x10\x12\xc5Un\x10" +
+	"\x12\xc6U\xfc\x10\x12\xc7U\x94\x10\x12\xc8T\xf5\x10\x12\xc9S<\x10\x12\xcaTR\x10\x13\x00VB\x10\x13\x02" +
+	"^\xd5\x10\x13\x03]\xf2\x10\x13\x05UV\x10\x13\x06U\xa8\x10\x13\aV\x86\x10\x13\bU\xea\x10\x13\tT4" +
+	"\x10\x13\nUK\x10\x13\vU\x86\x10\x13@,\x05\x10\x13Bg\xa2\x10\x13Cf\xc5\x10\x13E`9\x10\x13" +
+	"F_\xb4\x10\x13G`R\x10\x13H`\xf8\x10\x13I_q\x10\x13J`\x81\x10\x13K`\xbb\x10\x13L`" +
+	"\xf5\x10\x13\x80J\xd0\x10\x13\x82\x9dN\x10\x13\x83\x9d\b\x10\x13\x85f\x16\x10\x13\x86aj\x10\x13\x87[o\x10" +
+	"\x13\x88W\x8e\x10\x13\x89T\x14\x10\x13\x8aR\xd6\x10\x13\x8bQH\x10\x13\x8cP\x10\x10\x13\xc0f\a\x10\x13\xc2" +
+	"V:\x10\x13ÄR\x10\x13\xc5q]\x10\x13\xc6n\v\x10\x13\xc7mC\x10\x13\xc8j\xc6\x10\x13\xc9i-" +
+	"\x10\x13\xcaix\x10\x13\xcbi4\x10\x13\xcci\x1a\x10\x14\x00e\x1b\x10\x14\x02\x84B\x10\x14\x03S\xf5\x10\x14" +
+	"\x05n+\x10\x14\x06j\xd8\x10\x14\aj\x11\x10\x14\bj\xa2\x10\x14\thA\x10\x14\nh\x8d\x10\x14\vh" +
+	"H\x10\x14\fh/\x10\x14@F\xfd\x10\x14B|z\x10\x14Cy\xe3\x10\x14E`\xac\x10\x14FY4\x10" +
+	"\x14GW\xc0\x10\x14HS\xd7\x10\x14IQ\x1d\x10\x14JO>\x10\x14KM\x98\x10\x14LLF\x10\x14N" +
+	"\x80\x00\x10\x14Ow\xac\x10\x14PuN\x10\x14\x80`'\x10\x14\x82r\xdc\x10\x14\x83o\xb6\x10\x14\x85,m" +
+	"\x10\x14\x86_\x85\x10\x14\x87_N\x10\x14\x88_\x84\x10\x14\x89_\xde\x10\x14\x8a`\x97\x10\x14\x8b`\x17\x10\x14" +
+	"\x8c_\xfe\x10\x14\x8d]\xf6\x10\x14\x8edI\x10\x14\x8fn\x02\x10\x14\x90k%\x10\x14\x91_W\x10\x14\xc0_" +
+	"\xc7\x10\x14\xc2o\xac\x10\x14\xc3l\x86\x10\x14\xc5_\xa3\x10\x14\xc6.\xa1\x10\x14\xc7^\x1e\x10\x14\xc8^\x9f\x10" +
+	"\x14\xc9_\n\x10\x14\xca_\xe8\x10\x14\xcb`\xa9\x10\x14\xcc`U\x10\x14\xcd]z\x10\x14\xce_\xe9\x10\x14\xcf" +
+	"k\a\x10\x14\xd0h*\x10\x14\xd1Xk\x10\x14\xd2]\x7f\x10\x15\x00`e\x10\x15\x02n\xe9\x10\x15\x03k\xc3" +
+	"\x10\x15\x05_p\x10\x15\x06^\x1b\x10\x15\a.\xfc\x10\x15\b]\xfe\x10\x15\t^\x96\x10\x15\n_~\x10\x15" +
+	"\v`A\x10\x15\fa-\x10\x15\r^\b\x10\x15\x0eZ[\x10\x15\x0fjK\x10\x15\x10gn\x10\x15\x11V" +
+	"\xfd\x10\x15\x12]F\x10\x15\x13\\\x13\x10\x15@a\x15\x10\x15Blv\x10\x15Cl`\x10\x15E_\xaf\x10" +
+	"\x15F^\xa5\x10\x15G^\x04\x10\x15H/5\x10\x15I](\x10\x15J^\xe8\x10\x15K_\xaf\x10\x15L" +
+	"`\x9d\x10\x15M^\x9e\x10\x15NV\xb5\x10\x15Oh\x04\x10\x15Pg\xee\x10\x15QSN\x10\x15R]q" +
+	"\x10\x15S\\\x83\x10\x15T[\xf2\x10\x15\x80_\x97\x10\x15\x82j\xe8\x10\x15\x83j\v\x10\x15\x85`\t\x10\x15" +
+	"\x86_\x10\x10\x15\x87^\x9c\x10\x15\x88]&\x10\x15\x89.\xb2\x10\x15\x8a]\x1b\x10\x15\x8b^\b\x10\x15\x8c^" +
+	"\xfa\x10\x15\x8d]?\x10\x15\x8eSp\x10\x15\x8ff\x8d\x10\x15\x90e\xc5\x10\x15\x91P\xb6\x10\x15\x92]\xb8\x10" +
+	"\x15\x93\\\xd9\x10\x15\x94\\r\x10\x15\x95[ \x10\x15\xc0`\xa7\x10\x15\xc2k3\x10\x15\xc3jW\x10\x15\xc5" +
+	"`\xcc\x10\x15\xc6_\xf8\x10\x15\xc7_\x8f\x10\x15\xc8^\xef\x10\x15\xc9]\x18\x10\x15\xca/\xab\x10\x15\xcb_\x17" +
+	"\x10\x15\xcc`\n\x10\x15\xcd^4\x10\x15\xceR6\x10\x15\xcff\xcc\x10\x15\xd0f\x04\x10\x15\xd1N\xe9\x10\x15" +
+	"\xd2^`\x10\x15\xd3]\xa3\x10\x15\xd4]E\x10\x15\xd5\\\xb7\x10\x15\xd6[\x10\x10\x16\x00`\xe1\x10\x16\x02j" +
+	"\xf0\x10\x16\x03j\x13\x10\x16\x05`N\x10\x16\x06`\xba\x10\x16\a`S\x10\x16\b_\xb7\x10\x16\t^\a\x10" +
+	"\x16\n_\x17\x10\x16\v/\xa7\x10\x16\f`D\x10\x16\r^g\x10\x16\x0eP\xba\x10\x16\x0ff\x89\x10\x16\x10" +
+	"e\xc2\x10\x16\x11MU\x10\x16\x12]\xea\x10\x16\x13^J\x10\x16\x14]\xef\x10\x16\x15]d\x10\x16\x16[\xe0" +
+	"\x10\x16\x17\\\xd5\x10\x16@a\x1c\x10\x16Bj\xd7\x10\x16Ci\xfb\x10\x16E`6\x10\x16F`g\x10\x16" +
+	"Ga@\x10\x16H`\xa6\x10\x16I^\xfa\x10\x16J`\n\x10\x16K`D\x10\x16L/\xaa\x10\x16M^" +
+	"\x9b\x10\x16NO\x8d\x10\x16Ofo\x10\x16Pe\xa8\x10\x16QL\x10\x10\x16R]\xd0\x10\x16S]\xfc\x10" +
+	"\x16T^\xbe\x10\x16U^6\x10\x16V\\\xb5\x10\x16W]\xab\x10\x16X]\xde\x10\x16\x8d/\"\x10\x16\x8e" +
+	"N\xde\x10\x16\x8fi\x95\x10\x16\x90h\xad\x10\x16\x91K\x1e\x10\x16\x92c\xbf\x10\x16\x93cg\x10\x16\x94d\x00" +
+	"\x10\x16\x95d\xae\x10\x16\x96c9\x10\x16\x97dB\x10\x16\x98d|\x10\x16\x99d\xb6\x10\x16\xceb$\x10\x16" +
+	"Ϟy\x10\x16О(\x10\x16цo\x10\x16\xd2i\xb0\x10\x16\xd3e6\x10\x16\xd4_Q\x10\x16\xd5[" +
+	"\x88\x10\x16\xd6X&\x10\x16\xd7V\xea\x10\x16\xd8Ub\x10\x16\xd9T.\x10\x17\x0fW\x85\x10\x17\x10\x85\xed\x10" +
+	"\x17\x11~\x8d\x10\x17\x12t\xc5\x10\x17\x13q\x88\x10\x17\x14p\xbc\x10\x17\x15nO\x10\x17\x16l\xc5\x10\x17\x17" +
+	"m\v\x10\x17\x18l\xc6\x10\x17\x19l\xad\x10\x17PU8\x10\x17Q{\xf8\x10\x17Rq\xa4\x10\x17Snh" +
+	"\x10\x17Tm\x9c\x10\x17Un+\x10\x17Vk\xdd\x10\x17Wl#\x10\x17Xk\xde\x10\x17Yk\xc5\x10\x17" +
+	"\x91'\xb9\x10\x17\x92do\x10\x17\x93]5\x10\x17\x94[\xbe\x10\x17\x95W\xe8\x10\x17\x96U=\x10\x17\x97S" +
+	"d\x10\x17\x98Q\xc4\x10\x17\x99Pw\x10\x17\xd2/\x81\x10\x17\xd3cS\x10\x17\xd4c\x15\x10\x17\xd5cL\x10" +
+	"\x17\xd6c\xa9\x10\x17\xd7dZ\x10\x17\xd8c\xdb\x10\x17\xd9c\xc2\x10\x18\x131\xb9\x10\x18\x14a\xeb\x10\x18\x15" +
+	"bl\x10\x18\x16b\xdb\x10\x18\x17c\xb0\x10\x18\x18dl\x10\x18\x19d\x19\x10\x18T2\x0e\x10\x18Ua\xcc" +
+	"\x10\x18Vbg\x10\x18WcG\x10\x18Xd\x05\x10\x18Yd\xec\x10\x18\x952M\x10\x18\x96a\x01\x10\x18" +
+	"\x97b\xb4\x10\x18\x98cv\x10\x18\x99d`\x10\x18\xd61\xd4\x10\x18\xd7`\xf0\x10\x18\xd8a\xd8\x10\x18\xd9b" +
+	"\xc6\x10\x19\x172\xc6\x10\x19\x18b\xe2\x10\x19\x19c\xd0\x10\x19X2\xc3\x10\x19Yd\t\x10\x19\x992\xc7\x10" +
+	"!@YG\x10!Av\xd7\x10!Cg%\x10!\x80X\xd8\x10!\x81s\v\x10!\x83c\xeb\x10!\x85" +
+	"X8\x10!\xc0Y\a\x10!\xc1n\xb2\x10!\xc3b\xf1\x10!\xc5W\xb5\x10!\xc6Vz\x10\"\x00Y\xe5" +
+	"\x10\"\x01m\x11\x10\"\x03c\xb1\x10\"\x05X\x91\x10\"\x06W\xb8\x10\"\aU\xe6\x10\"@XU\x10\"" +
+	"Aku\x10\"CaJ\x10\"EX\xed\x10\"FX%\x10\"GV\x80\x10\"HU\xf2\x10\"\x80Y" +
+	"k\x10\"\x81k\xc6\x10\"\x83a\x9b\x10\"\x85Y\xb6\x10\"\x86Y\x14\x10\"\x87X6\x10\"\x88W\xc8\x10" +
+	"\"\x89U\xe8\x10\"\xc0Y\xa6\x10\"\xc1k\x81\x10\"\xc3aW\x10\"\xc5Y7\x10\"\xc6Y\xda\x10\"\xc7" +
+	"X\xff\x10\"\xc8X\x94\x10\"\xc9V\xdb\x10\"\xcaW\xf2\x10#\x00Y\xe1\x10#\x01ki\x10#\x03a?" +
+	"\x10#\x05Y\x1f\x10#\x06Y\x87\x10#\aY\xf1\x10#\bY\x89\x10#\tW\xd3\x10#\nX\xea\x10#" +
+	"\vY%\x10#@0M\x10#AtZ\x10#Cj\x00\x10#Ed\x03\x10#Fc}\x10#Gc" +
+	"\x8c\x10#Hde\x10#Ib\xdd\x10#Jc\xee\x10#Kd(\x10#Ldb\x10#\x80r\x99\x10" +
+	"#\x81\x80@\x10#\x83\x949\x10#\x85\x80\xab\x10#\x86|\xd4\x10#\x87xn\x10#\x88v\xcd\x10#\x89" +
+	"u4\x10#\x8au\x80\x10#\x8bu;\x10#\x8cu\"\x10#\xc0N(\x10#\xc1\x9f-\x10#Ü\xb3" +
+	"\x10#\xc5f]\x10#\xc6a\xb1\x10#\xc7_1\x10#\xc8Z\xe7\x10#\xc9Wl\x10#\xcaV.\x10#" +
+	"\xcbT\xa0\x10#\xccSh\x10$\x00h=\x10$\x01\x94;\x10$\x03U\xfe\x10$\x05qp\x10$\x06n" +
+	"#\x10$\am\x10\x10$\bm\xc3\x10$\tkc\x10$\nk\xae\x10$\vkj\x10$\fkP\x10" +
+	"$@K0\x10$A\x8e\x95\x10$C|\xaf\x10$Eb\xa7\x10$F^\"\x10$GX\x10\x10$H" +
+	"X\a\x10$IUO\x10$JSs\x10$KQ\xcf\x10$LP\x81\x10$O\x80\x00\x10$Pw\xdb" +
+	"\x10$\x80c\xd4\x10$\x81\x827\x10$\x83r\xff\x10$\x85-\xde\x10$\x86b\xeb\x10$\x87bM\x10$" +
+	"\x88c\x1c\x10$\x89cv\x10$\x8ad/\x10$\x8bc\xaf\x10$\x8cc\x96\x10$\x8dad\x10$\x8e|" +
+	"'\x10$\x8fd\x8f\x10$\x90n&\x10$\x91a%\x10$\xc0ct\x10$\xc1~~\x10$\xc3o\xd5\x10" +
+	"$\xc5c\r\x10$\xc60\xba\x10$\xc7a\x1d\x10$\xc8bL\x10$\xc9b\xb7\x10$\xcac\x95\x10$\xcb" +
+	"dV\x10$\xccd\x02\x10$\xcd`\xe9\x10$\xcex\xaf\x10$\xcf`/\x10$\xd0k1\x10$\xd1\\\xeb" +
+	"\x10$\xd2`\x9f\x10%\x00c\xa9\x10%\x01z@\x10%\x03n\xe4\x10%\x05b\x90\x10%\x06a;\x10%" +
+	"\a2\xfb\x10%\b`\x8a\x10%\ta\"\x10%\nb\xc2\x10%\vc\x85\x10%\fdq\x10%\r`" +
+	"\xf6\x10%\x0et\xa9\x10%\x0f]\xc6\x10%\x10j?\x10%\x11W@\x10%\x12`\x17\x10%\x13^\xe4\x10" +
+	"%@d\x82\x10%Ax\xa0\x10%Co\x9b\x10%Ecc\x10%Fbn\x10%G`\x88\x10%H" +
+	"3}\x10%I`\x95\x10%JbT\x10%Kc\x1b\x10%Ld\n\x10%Ma\xb8\x10%Ns'" +
+	"\x10%OY\xc0\x10%Pj\xda\x10%QW\x1a\x10%R`\xcc\x10%S_\xf1\x10%T^:\x10%" +
+	"\x80c\x03\x10%\x81w\x11\x10%\x83mF\x10%\x85c\xbd\x10%\x86b\xd9\x10%\x87a \x10%\x88`" +
+	"\x93\x10%\x892\xf9\x10%\x8a`\x87\x10%\x8bat\x10%\x8cbf\x10%\x8d`Y\x10%\x8eq\xaf\x10" +
+	"%\x8fV|\x10%\x90h\xb1\x10%\x91T\x84\x10%\x92a\x13\x10%\x93`G\x10%\x94^\xb9\x10%\x95" +
+	"^;\x10%\xc0d\x13\x10%\xc1w]\x10%\xc3m\x92\x10%\xc5d\x81\x10%\xc6c\xc1\x10%\xc7b\xc9" +
+	"\x10%\xc8b[\x10%\xc9`\x85\x10%\xca3\xf3\x10%\xcbb\x84\x10%\xcccv\x10%\xcdaO\x10%" +
+	"\xceq\xee\x10%\xcfUB\x10%\xd0h\xf0\x10%\xd1R\xba\x10%\xd2a\xbb\x10%\xd3a\x11\x10%\xd4`" +
+	"3\x10%\xd5_\xd1\x10%\xd6^*\x10&\x00dN\x10&\x01w\x19\x10&\x03mN\x10&\x05d\x03\x10" +
+	"&\x06d\x83\x10&\ac\x8d\x10&\bc#\x10&\tas\x10&\nb\x84\x10&\v3\xee\x10&\f" +
+	"c\xb1\x10&\ra\x82\x10&\x0eq\xac\x10&\x0fS\xc5\x10&\x10h\xae\x10&\x11Q(\x10&\x12aE" +
+	"\x10&\x13a\xb9\x10&\x14`\xdc\x10&\x15`\x7f\x10&\x16^\xfb\x10&\x17_\xf0\x10&@d\x89\x10&" +
+	"Aw\x01\x10&Cm6\x10&Ec\xeb\x10&Fd1\x10&Gdz\x10&Hd\x13\x10&Ib" +
+	"f\x10&Jcw\x10&Kc\xb1\x10&L3\xf1\x10&Ma\xb6\x10&Nq\x92\x10&OR\x98\x10" +
+	"&Ph\x93\x10&QO\xe6\x10&Ra+\x10&Saj\x10&Ta\xac\x10&UaQ\x10&V" +
+	"_\xd0\x10&W`\xc6\x10&X`\xf9\x10&\x8d3F\x10&\x8eu\xd6\x10&\x8fR\x16\x10&\x90k\xb0" +
+	"\x10&\x91O.\x10&\x92gI\x10&\x93f\xf1\x10&\x94g&\x10&\x95g\xfa\x10&\x96f\x85\x10&" +
+	"\x97g\x8f\x10&\x98g\xc8\x10&\x99h\x02\x10&\u0380\xe8\x10&ϠO\x10&ЕR\x10&я" +
+	"\xf6\x10&҃\x94\x10&\xd3\x7f\xd6\x10&\xd4{\xa8\x10&\xd5z\b\x10&\xd6x~\x10&\xd7x\xc4\x10" +
+	"&\xd8x\x80\x10&\xd9xf\x10'\x0fd5\x10'\x10\x9d\xad\x10'\x11\x86i\x10'\x12i\xc9\x10'\x13" +
+	"eO\x10'\x14b\xfb\x10'\x15^\xc0\x10'\x16[^\x10'\x17Z\"\x10'\x18X\x9a\x10'\x19Wf" +
+	"\x10'PW\x1f\x10'Q~\x87\x10'Rt\xbf\x10'Sq\x88\x10'Tp\x84\x10'Uq.\x10'" +
+	"Vn\xe0\x10'Wo&\x10'Xn\xe2\x10'Yn\xc8\x10'\x91,\x1c\x10'\x92f/\x10'\x93a" +
+	"\xdd\x10'\x94\\\x04\x10'\x95[\xf4\x10'\x96YK\x10'\x97Wu\x10'\x98U\xd7\x10'\x99T\x8e\x10" +
+	"'\xd20\xd4\x10'\xd3f\x8d\x10'\xd4e\xfd\x10'\xd5f\xc2\x10'\xd6g \x10'\xd7g\xd0\x10'\xd8" +
+	"gR\x10'\xd9g9\x10(\x133\xb0\x10(\x14d\xd3\x10(\x15e\xf7\x10(\x16fe\x10(\x17g;" +
+	"\x10(\x18g\xf7\x10(\x19g\xa3\x10(T5\xf5\x10(Ud?\x10(Vd\xda\x10(Wfm\x10(" +
+	"Xg+\x10(Yh\x12\x10(\x956r\x10(\x96dM\x10(\x97f\x00\x10(\x98f\xc2\x10(\x99g" +
+	"\xac\x10(\xd65\xf9\x10(\xd7d<\x10(\xd8e%\x10(\xd9f\x12\x10)\x176\xeb\x10)\x18f.\x10" +
+	")\x19g\x1c\x10)X6\xe8\x10)YgU\x10)\x996\xeb\x101@]\f\x101Az\xb4\x101B" +
+	"v\xf8\x101\x80\\\x9d\x101\x81v\xea\x101\x82s.\x101\x85[\"\x101\xc0\\\xe2\x101\xc1r\x91" +
+	"\x101\xc2q\xa7\x101\xc5[ \x101\xc6Y\xfa\x102\x00\\\x90\x102\x01o\xab\x102\x02n\x0e\x102" +
+	"\x05[\x0f\x102\x06Z5\x102\aXd\x102@[\xf0\x102An\xe9\x102BmL\x102E\\" +
+	"\xb2\x102F[\xe9\x102GZ[\x102HV\x0f\x102\x80]\x06\x102\x81o:\x102\x82m\x9d\x10" +
+	"2\x85]{\x102\x86\\\xd9\x102\x87\\\x11\x102\x88Zs\x102\x89Y\x83\x102\xc0]A\x102\xc1" +
+	"n\xf6\x102\xc2mY\x102\xc5\\\xfc\x102\xc6]\x9f\x102\xc7\\\xd9\x102\xc8[?\x102\xc9Zv" +
+	"\x102\xca[\x8d\x103\x00]|\x103\x01n\xdd\x103\x02mA\x103\x05\\\xe4\x103\x06]L\x103" +
+	"\a]\xcb\x103\b\\4\x103\t[o\x103\n\\\x85\x103\v\\\xc0\x103@4\x94\x103Aw" +
+	"\x95\x103Bv*\x103Eg\xb3\x103Fg.\x103GgR\x103Hf\xe3\x103IfF\x10" +
+	"3JgV\x103Kg\x90\x103Lg\xca\x103\x80u\xdd\x103\x81\x83(\x103\x82\x9f\xcc\x103\x85" +
+	"\x84s\x103\x86\x80\x9d\x103\x87|8\x103\x88y;\x103\x89xx\x103\x8ax\xc4\x103\x8bx\x7f" +
+	"\x103\x8cxf\x103\xc0tB\x103\xc1\x9f\x9e\x103\u0080D\x103ŀ\xb6\x103\xc6|\xe0\x103" +
+	"\xc7{H\x103\xc8w\xa1\x103\xc9v\xdd\x103\xcaw)\x103\xcbv\xe4\x103\xccv\xcb\x104\x00Q" +
+	"\x82\x104\x01\xa0\xf4\x104\x02\x9f\x91\x104\x05f\xa8\x104\x06a\xfe\x104\a_~\x104\b]\xd8\x10" +
+	"4\tZ\xc6\x104\nY\x88\x104\vW\xfa\x104\fV\xc2\x104@Od\x104A\x91+\x104B" +
+	"\x8e_\x104Ed\x1d\x104F`\x1c\x104G\\\xfd\x104HXT\x104IY}\x104JW\xa4" +
+	"\x104KV\x03\x104LT\xb7\x104P\x80\x00\x104\x80gg\x104\x81\x85\xdb\x104\x82\x82U\x104" +
+	"\x85/5\x104\x86e\xbc\x104\x87e\x9a\x104\x88ev\x104\x89g\t\x104\x8ag\xc2\x104\x8bg" +
+	"C\x104\x8cg)\x104\x8dd\xbc\x104\x8e\x7f\x94\x104\x8f|F\x104\x90d\xd8\x104\x91b|\x10" +
+	"4\xc0g\b\x104\xc1\x82$\x104\xc2~\x9e\x104\xc5e\xde\x104\xc62,\x104\xc7d\x7f\x104\xc8" +
+	"d\xa7\x104\xc9fK\x104\xcag)\x104\xcbg\xe9\x104\xccg\x95\x104\xcdd@\x104\xce|\x1e" +
+	"\x104\xcfx\xd0\x104\xd0`z\x104\xd1^\xb8\x104\xd2c3\x105\x00gR\x105\x01}\xe6\x105" +
+	"\x02}#\x105\x05e\xe1\x105\x06d\xa0\x105\a5\x14\x105\bb\xe4\x105\td\xcb\x105\nf" +
+	"k\x105\vg.\x105\fh\x1a\x105\rda\x105\x0ex\x17\x105\x0fwa\x105\x10^\x10\x10" +
+	"5\x11[\xbf\x105\x12c \x105\x13b\x00\x105@g\n\x105A{\x0f\x105By\xa4\x105E" +
+	"e\xd7\x105Fd\xe1\x105Gb\xfb\x105H6\xa0\x105I`\xb9\x105Jd\xdc\x105Ke\xa3" +
+	"\x105Lf\x92\x105Mc\xfc\x105Nu\\\x105Ot\t\x105P\\n\x105QW[\x105" +
+	"Rc\x02\x105Sb'\x105T`p\x105\x80fl\x105\x81zL\x105\x82x\xe1\x105\x85g" +
+	"n\x105\x86f\x89\x105\x87d\xe5\x105\x88`\xb8\x105\x897A\x105\x8ac\xef\x105\x8bd\xdc\x10" +
+	"5\x8ce\xcf\x105\x8dcq\x105\x8et\xa4\x105\x8fsQ\x105\x90Y\x88\x105\x91XN\x105\x92" +
+	"dj\x105\x93c\x9f\x105\x94b$\x105\x95^V\x105\xc0g|\x105\xc1z\x98\x105\xc2y," +
+	"\x105\xc5h1\x105\xc6gr\x105\xc7f\x8e\x105\xc8d\xda\x105\xc9c\xed\x105\xca8:\x105" +
+	"\xcbe\xec\x105\xccf\xdf\x105\xcddf\x105\xcet\xe3\x105\xcfs\x90\x105\xd0XN\x105\xd1V" +
+	"\x87\x105\xd2e\x12\x105\xd3di\x105\xd4c\x9d\x105\xd5b\x15\x105\xd6aB\x106\x00g\xb6\x10" +
+	"6\x01zT\x106\x02x\xe9\x106\x05g\xb4\x106\x06h4\x106\agR\x106\be\xa2\x106\t" +
+	"d\xdb\x106\ne\xec\x106\v85\x106\fg\x19\x106\rd\x9a\x106\x0et\xa1\x106\x0fsN" +
+	"\x106\x10V\xd1\x106\x11T\xf8\x106\x12d\x9c\x106\x13e\x10\x106\x14dG\x106\x15b\xc3\x106" +
+	"\x16b\x12\x106\x17c\a\x106@g\xf1\x106Az<\x106Bx\xd0\x106Eg\x9b\x106Fg" +
+	"\xe1\x106Gh?\x106Hf\x91\x106Ie\xcf\x106Jf\xdf\x106Kg\x19\x106L89\x10" +
+	"6Md\xcd\x106Nt\x87\x106Os4\x106PU\xa4\x106QS\xb8\x106Rd\x82\x106S" +
+	"d\xc2\x106Te\x17\x106Uc\x95\x106Vb\xe7\x106Wc\xdd\x106Xd\x10\x106\x8d7l" +
+	"\x106\x8ex\xfa\x106\x8fwh\x106\x90UQ\x106\x91S>\x106\x92j\xbc\x106\x93jd\x106" +
+	"\x94j\xad\x106\x95ji\x106\x96i\xcd\x106\x97j\xd7\x106\x98k\x11\x106\x99kJ\x106\u0383" +
+	"\xbb\x106Ϡm\x106Т\x05\x106ђw\x106҇\x1d\x106Ӄa\x106\xd4\x7f3\x10" +
+	"6\xd5|g\x106\xd6{\xa2\x106\xd7{\xe9\x106\xd8{\xa4\x106\xd9{\x8b\x107\x0f\x80\xbf\x107\x10" +
+	"\xa0}\x107\x11\x8f\x8a\x107\x12\x83\x87\x107\x13\x7f\xca\x107\x14~D\x107\x15z\xd4\x107\x16z\x10" +
+	"\x107\x17zV\x107\x18z\x11\x107\x19y\xf8\x107Pfe\x107Q\x86e\x107Ri\xe6\x107" +
+	"Seo\x107Tc\x1b\x107Ua\x9f\x107V^\x98\x107W]\\\x107X[\xd4\x107YZ" +
+	"\xa0\x107\x910y\x107\x92gm\x107\x93c\x9c\x107\x94`\xab\x107\x95\\8\x107\x96]V\x10" +
+	"7\x97[\x82\x107\x98Y\xe7\x107\x99X\xa0\x107\xd22\f\x107\xd3i7\x107\xd4i \x107\xd5" +
+	"i\v\x107\xd6j\x92\x107\xd7kC\x107\xd8j\xc5\x107\xd9j\xac\x108\x135\x03\x108\x14h\n" +
+	"\x108\x15h@\x108\x16i\xd8\x108\x17j\xad\x108\x18ki\x108\x19k\x16\x108T7\xed\x108" +
+	"Uf\x87\x108Vh`\x108Wi\xf3\x108Xj\xb2\x108Yk\x99\x108\x959\x82\x108\x96d" +
+	"k\x108\x97hn\x108\x98i1\x108\x99j\x1b\x108\xd6:\x1e\x108\xd7g\x85\x108\xd8hm\x10" +
+	"8\xd9iZ\x109\x17;\x10\x109\x18iw\x109\x19jd\x109X;\r\x109Yj\x9e\x109\x99" +
+	";\x11\x14\x01\x81\xa8^\x14\x01\x82\xa4\xfc\x14\x01\x83\xa1\xb4\x14\x01\x84\x9ep\x14\x01\xc1\xa6\xc2\x14\x01§7" +
+	"\x14\x01ã\xee\x14\x01Ġ\xaa\x14\x01ƓU\x14\x02\x01\xa6\xe5\x14\x02\x02\xa6t\x14\x02\x03\xa7\t\x14\x02" +
+	"\x04\xa3\xc5\x14\x02\x06\x95\xf3\x14\x02\a\x92\xae\x14\x02A\xa5\xd6\x14\x02B\xa5e\x14\x02C\xa5\x13\x14\x02D\xa5" +
+	"\x90\x14\x02F\x97B\x14\x02G\x93\xfd\x14\x02H\x90\xb8\x14\x02\x81\xa5\xe6\x14\x02\x82\xa5u\x14\x02\x83\xa5#\x14" +
+	"\x02\x84\xa4\xd6\x14\x02\x86\x996\x14\x02\x87\x95\xf1\x14\x02\x88\x92\xac\x14\x02\x89\x8d\xf0\x14\x02\xc1\xa6\xf9\x14\x02\xc2" +
+	"\xa6\x87\x14\x02æ6\x14\x02ĥ\xe8\x14\x02Ɲ|\x14\x02ǚ8\x14\x02Ȗ\xf2\x14\x02ɒR" +
+	"\x14\x02ʒQ\x14\x03\x01\xa7\xd4\x14\x03\x02\xa7c\x14\x03\x03\xa7\x11\x14\x03\x04\xa6\xc4\x14\x03\x06\x9d|\x14\x03" +
+	"\a\x9d\x8a\x14\x03\b\x9aE\x14\x03\t\x95\xa5\x14\x03\n\x95\xa4\x14\x03\v\x96\xba\x14\x03A\x9a\x9f\x14\x03B\x99" +
+	"b\x14\x03C\x98\xea\x14\x03D\x98M\x14\x03Fe]\x14\x03G]`\x14\x03HW\xb7\x14\x03IQ\xac\x14" +
+	"\x03JNC\x14\x03KL\xdd\x14\x03LK\x8a\x14\x03\x81~1\x14\x03\x82\xac2\x14\x03\x83\xab\xae\x14\x03\x84" +
+	"\xabJ\x14\x03\x86\xa9\x11\x14\x03\x87\xa7z\x14\x03\x88\xa7\x9b\x14\x03\x89\xa6\x90\x14\x03\x8a\xa6\xa0\x14\x03\x8b\xa7\xac" +
+	"\x14\x03\x8c\xa8\x84\x14\x03\xc1\xac \x14\x03\xc2{T\x14\x03ç\xde\x14\x03ħz\x14\x03ƥ\xba\x14\x03" +
+	"ǧ\xdf\x14\x03ȧ\x1e\x14\x03ɦ\x13\x14\x03ʦ#\x14\x03˧0\x14\x03̨\b\x14\x04\x01\xab" +
+	"\x90\x14\x04\x02\xa7\xcf\x14\x04\x03x\xbe\x14\x04\x04\xa3\xc4\x14\x04\x06\xa2~\x14\x04\a\xa4\xa3\x14\x04\b\xa7\xa4\x14" +
+	"\x04\t\xa5\xb8\x14\x04\n\xa5\xc7\x14\x04\v\xa6\xd4\x14\x04\f\xa7\xac\x14\x04A\xab!\x14\x04B\xa7`\x14\x04C" +
+	"\xa3\xb7\x14\x04DvS\x14\x04F\x9fH\x14\x04G\xa1l\x14\x04H\xa4n\x14\x04I\xa6)\x14\x04J\xa5q" +
+	"\x14\x04K\xa6~\x14\x04L\xa7V\x14\x04\x81\xc2\xe6\x14\x04\x82\xbe\xb0\x14\x04\x83\xba\x7f\x14\x04\x84\xb6R\x14\x04" +
+	"\x86\xb4^\x14\x04\x87\xb75\x14\x04\x88\xbb\x02\x14\x04\x89\xbd\xa3\x14\x04\x8a\xc1x\x14\x04\x8b\xc1\xfd\x14\x04\x8c\xc2" +
+	"\xee\x14\x04\x8d\x80\x00\x14\x04\x8e\xb8\xc7\x14\x04\x8f\xb4\xee\x14\x04\x90\xb1\x19\x14\x04\x91\xadH\x14\x04\xc1\xa9,\x14" +
+	"\x04¥\xe1\x14\x04â\xae\x14\x04ğ\x7f\x14\x04\xc6R\xd2\x14\x04ǔ\xba\x14\x04ȗE\x14\x04\xc9" +
+	"\x98\x8a\x14\x04ʚy\x14\x04˞\x81\x14\x04̞\x82\x14\x04\xcdbz\x14\x04Ο\xee\x14\x04Ϝ\xef" +
+	"\x14\x04К\a\x14\x04ї#\x14\x04ҫq\x14\x05\x01\xa7\xa4\x14\x05\x02\xa8\x16\x14\x05\x03\xa4\xe4\x14\x05" +
+	"\x04\xa1\xb5\x14\x05\x06\x94\xc5\x14\x05\aR_\x14\x05\b\x949\x14\x05\t\x95\x7f\x14\x05\n\x97m\x14\x05\v\x9b" +
+	"v\x14\x05\f\x9e\x9a\x14\x05\r[\x1d\x14\x05\x0e\x9e\x82\x14\x05\x0f\x9e\xe8\x14\x05\x10\x9c\x00\x14\x05\x11\x99\x1b\x14" +
+	"\x05\x12\xae\n\x14\x05\x13\x8dL\x14\x05A\xa7\xd2\x14\x05B\xa7c\x14\x05C\xa7\xf5\x14\x05D\xa4\xc6\x14\x05F" +
+	"\x97\\\x14\x05G\x94D\x14\x05HR=\x14\x05I\x92s\x14\x05J\x94b\x14\x05K\x98j\x14\x05L\x9b\x8e" +
+	"\x14\x05MU\xdc\x14\x05N\x9e\xa1\x14\x05O\x9e<\x14\x05P\x9e\xbd\x14\x05Q\x9b\xd9\x14\x05R\xb1\x80\x14\x05" +
+	"S\x8f\x9a\x14\x05T\x8c\xd0\x14\x05\x81\xa6\xc8\x14\x05\x82\xa6Y\x14\x05\x83\xa6\t\x14\x05\x84\xa6\x84\x14\x05\x86\x98" +
+	"\xa0\x14\x05\x87\x95\x88\x14\x05\x88\x92p\x14\x05\x89P\x9d\x14\x05\x8a\x8f\xc7\x14\x05\x8b\x93\xea\x14\x05\x8c\x97\x0f\x14" +
+	"\x05\x8dPA\x14\x05\x8e\x9d\xb1\x14\x05\x8f\x9dL\x14\x05\x90\x9d\x04\x14\x05\x91\x9dq\x14\x05\x92\xb3\xe9\x14\x05\x93" +
+	"\x90\xc3\x14\x05\x94\x8d\xf8\x14\x05\x95\x8b-\x14\x05\xc1\xa6\xe2\x14\x05¦s\x14\x05æ$\x14\x05ĥ\xd8" +
+	"\x14\x05ƚ\x90\x14\x05Ǘx\x14\x05Ȕ`\x14\x05ɏ\xc7\x14\x05\xcaO\xf5\x14\x05˓\xf3\x14\x05" +
+	"̗\x18\x14\x05\xcdM\x13\x14\x05Ν\xbf\x14\x05ϝZ\x14\x05Н\x11\x14\x05ќ\xcd\x14\x05ҷ" +
+	"g\x14\x05Ӓ}\x14\x05ԏ\xb2\x14\x05Ռ\xe7\x14\x05ֈ\xb6\x14\x06\x01\xa7\xee\x14\x06\x02\xa7\x7f\x14" +
+	"\x06\x03\xa70\x14\x06\x04\xa6\xe4\x14\x06\x06\x9e\xa2\x14\x06\a\x9b\x8a\x14\x06\b\x98r\x14\x06\t\x93\xf2\x14\x06\n" +
+	"\x93\xf2\x14\x06\vP\xd8\x14\x06\f\x98(\x14\x06\rK\xb7\x14\x06\x0e\x9e\xb1\x14\x06\x0f\x9eL\x14\x06\x10\x9e\x03" +
+	"\x14\x06\x11\x9d\xbe\x14\x06\x12\xb7\xde\x14\x06\x13\x96)\x14\x06\x14\x93_\x14\x06\x15\x90\x93\x14\x06\x16\x8c{\x14\x06" +
+	"\x17\x8cz\x14\x06A\xa8\xc6\x14\x06B\xa8W\x14\x06C\xa8\a\x14\x06D\xa7\xbb\x14\x06F\x9e\xa3\x14\x06G\x9e" +
+	"\xae\x14\x06H\x9b\x96\x14\x06I\x97\x16\x14\x06J\x97\x15\x14\x06K\x98&\x14\x06LP\xf8\x14\x06MJl\x14" +
+	"\x06N\x9fr\x14\x06O\x9f\r\x14\x06P\x9e\xc4\x14\x06Q\x9e\x7f\x14\x06R\xb8\xb7\x14\x06S\x96)\x14\x06T" +
+	"\x964\x14\x06U\x93i\x14\x06V\x8fQ\x14\x06W\x8fO\x14\x06X\x90E\x14\x06\x8d\x1e \x14\x06\x8e\x9c-" +
+	"\x14\x06\x8f\x9a\xe6\x14\x06\x90\x9ac\x14\x06\x91\x99\xbd\x14\x06\x92\x86b\x14\x06\x93h\x13\x14\x06\x94`A\x14\x06" +
+	"\x95Z\xb8\x14\x06\x96T\xc7\x14\x06\x97Qs\x14\x06\x98P\x0e\x14\x06\x99N\xbf\x14\x06\xce\x7f4\x14\x06ϭ" +
+	"C\x14\x06Ь\xb6\x14\x06ѬI\x14\x06\xd2\xc3\xf5\x14\x06Ӫ[\x14\x06Ԩ\xd9\x14\x06թ\x05\x14" +
+	"\x06֧\xff\x14\x06ר\x19\x14\x06ة\x1f\x14\x06٩\xf3\x14\a\x0f|M\x14\a\x10\xa8\xfd\x14\a\x11" +
+	"\xa8\x91\x14\a\x12\xbf\xc8\x14\a\x13\xa7\x16\x14\a\x14\xa9;\x14\a\x15\xa8\x8a\x14\a\x16\xa7\x84\x14\a\x17\xa7\x9e" +
+	"\x14\a\x18\xa8\xa4\x14\a\x19\xa9x\x14\aPy\xae\x14\aQ\xa4\xf2\x14\aR\xbb\xa1\x14\aS\xa3\xeb\x14\a" +
+	"T\xa6\x0f\x14\aU\xa9\f\x14\aV\xa7*\x14\aW\xa7D\x14\aX\xa8J\x14\aY\xa9\x1e\x14\a\x91w" +
+	"<\x14\a\x92\xb7\x80\x14\a\x93\xa0\xc5\x14\a\x94\xa2\xea\x14\a\x95\xa5\xe7\x14\a\x96\xa7\x98\x14\a\x97\xa6\xef\x14" +
+	"\a\x98\xa7\xf5\x14\a\x99\xa8\xc9\x14\a\xd2XS\x14\aӵ\x9e\x14\aԸr\x14\aռ6\x14\a\xd6" +
+	"\xbe\xc8\x14\a\xd7\u0095\x14\a\xd8\xc3\x16\x14\a\xd9\xc4\x02\x14\b\x13TC\x14\b\x14\x96w\x14\b\x15\x99\x00" +
+	"\x14\b\x16\x9a>\x14\b\x17\x9c.\x14\b\x18\xa0$\x14\b\x19\xa0$\x14\bTS\xd8\x14\bU\x96\x01\x14\b" +
+	"V\x97?\x14\bW\x99/\x14\bX\x9d%\x14\bY\xa0<\x14\b\x95S\xbe\x14\b\x96\x94=\x14\b\x97\x96" +
+	"-\x14\b\x98\x9a#\x14\b\x99\x9d:\x14\b\xd6R#\x14\bב\xb1\x14\bؕ\xc0\x14\b٘\xd7\x14" +
+	"\t\x17Q\x86\x14\t\x18\x95\xca\x14\t\x19\x98\xe2\x14\tXRb\x14\tY\x99\xeb\x14\t\x99R\x80\x14\x11\x80" +
+	"Q\x95\x14\x11\x82`\x13\x14\x11\x83\\\xd2\x14\x11\x84Y\x95\x14\x11\xc0R7\x14\x11\xc2_N\x14\x11\xc3\\\r" +
+	"\x14\x11\xc4X\xd0\x14\x11\xc6N\x06\x14\x12\x00S\x04\x14\x12\x02\\\xed\x14\x12\x03\\\xce\x14\x12\x04Y\x92\x14\x12" +
+	"\x06N\x97\x14\x12\aM\xf4\x14\x12@R0\x14\x12B\\\r\x14\x12C[+\x14\x12D[\x13\x14\x12FO" +
+	"\xb1\x14\x12GO<\x14\x12HNz\x14\x12\x80R\x1d\x14\x12\x82[K\x14\x12\x83Zh\x14\x12\x84Y\x85\x14" +
+	"\x12\x86P\xe6\x14\x12\x87P{\x14\x12\x88O\xd9\x14\x12\x89N\x1c\x14\x12\xc0S4\x14\x12\xc2[\xe2\x14\x12\xc3" +
+	"[\x00\x14\x12\xc4Z\x1d\x14\x12\xc6Q\xf6\x14\x12\xc7Q\x8e\x14\x12\xc8Q\x13\x14\x12\xc9P9\x14\x12\xcaP&" +
+	"\x14\x13\x00So\x14\x13\x02[\xca\x14\x13\x03Z\xe7\x14\x13\x04Z\x05\x14\x13\x06Q\xa3\x14\x13\aR\x80\x14\x13" +
+	"\bR\a\x14\x13\tQ1\x14\x13\nQ\x1e\x14\x13\vR5\x14\x13@+U\x14\x13Bec\x14\x13Cd" +
+	"k\x14\x13Dc\x8e\x14\x13F\\\x8a\x14\x13G](\x14\x13H]\xf0\x14\x13I]!\x14\x13J]\x0f\x14" +
+	"\x13K^ \x14\x13L^Y\x14\x13\x80K\x88\x14\x13\x82\xa4\x8c\x14\x13\x83\xa40\x14\x13\x84\xa3\xe6\x14\x13\x86" +
+	"]X\x14\x13\x87WM\x14\x13\x88S\x8f\x14\x13\x89P\xe7\x14\x13\x8aN\x9b\x14\x13\x8bN\x00\x14\x13\x8cL\xc7" +
+	"\x14\x13\xc0c\xc8\x14\x13\xc2U\xe6\x14\x13Ê\xde\x14\x13Ċ\x84\x14\x13\xc6j\x97\x14\x13\xc7i\xc9\x14\x13" +
+	"\xc8gn\x14\x13\xc9f\x8c\x14\x13\xcae\xca\x14\x13\xcbf\\\x14\x13\xccfC\x14\x14\x00b\xc1\x14\x14\x02\x8a" +
+	"\xcf\x14\x14\x03S\x8c\x14\x14\x04\x86\xb8\x14\x14\x06g`\x14\x14\af\x92\x14\x14\bgA\x14\x14\te\xa1\x14" +
+	"\x14\nd\xdf\x14\x14\veq\x14\x14\feW\x14\x14@a\xd7\x14\x14B\x8ai\x14\x14C\x86\xaa\x14\x14D" +
+	"QM\x14\x14Fd0\x14\x14Gcb\x14\x14Hd\x10\x14\x14Ie~\x14\x14Jc\xf5\x14\x14Kd\x87" +
+	"\x14\x14Ldm\x14\x14\x80F\xff\x14\x14\x82|\"\x14\x14\x83y\x84\x14\x14\x84r\x8a\x14\x14\x86S\xb1\x14\x14" +
+	"\x87R\xd1\x14\x14\x88O \x14\x14\x89Lq\x14\x14\x8aJ\xe5\x14\x14\x8bI\xa9\x14\x14\x8cHX\x14\x14\x8e\x80" +
+	"\x00\x14\x14\x8fw\\\x14\x14\x90t\xf7\x14\x14\x91n\x95\x14\x14\xc0\\m\x14\x14\xc2l\f\x14\x14\xc3h\xe1\x14" +
+	"\x14\xc4e\xbb\x14\x14\xc6)\x9b\x14\x14\xc7Y\x0f\x14\x14\xc8Y\x93\x14\x14\xc9Z\xa0\x14\x14\xca[\xd1\x14\x14\xcb" +
+	"\\\xd0\x14\x14\xcc\\|\x14\x14\xcdZz\x14\x14\xce\\>\x14\x14\xcfg\xcc\x14\x14\xd0d\xeb\x14\x14\xd1b\x0e" +
+	"\x14\x14\xd2Sp\x14\x15\x00]\n\x14\x15\x02kB\x14\x15\x03h\x18\x14\x15\x04d\xf2\x14\x15\x06Y\f\x14\x15" +
+	"\a)\xf5\x14\x15\bX\xf1\x14\x15\tZ+\x14\x15\n[g\x14\x15\v\\h\x14\x15\f]T\x14\x15\r[" +
+	"\t\x14\x15\x0eV\xa1\x14\x15\x0fg\n\x14\x15\x10d)\x14\x15\x11aL\x14\x15\x12R\x8a\x14\x15\x13W\x85\x14" +
+	"\x15@]\xdd\x14\x15Bh\xf1\x14\x15Ch\xd4\x14\x15De\xae\x14\x15FY\x99\x14\x15GX\xf8\x14\x15H" +
+	"*Q\x14\x15IYv\x14\x15JZ\xd0\x14\x15K[\xf8\x14\x15L\\\xe7\x14\x15M[\xbd\x14\x15NS\x1a" +
+	"\x14\x15Od\xe2\x14\x15Pd\xc5\x14\x15Qa\xe8\x14\x15RO\x0f\x14\x15SW\xf7\x14\x15TWg\x14\x15" +
+	"\x80]\x17\x14\x15\x82h\x1b\x14\x15\x83g>\x14\x15\x84g(\x14\x15\x86Z\xaf\x14\x15\x87Z;\x14\x15\x88Y" +
+	"|\x14\x15\x89*\xaa\x14\x15\x8aY%\x14\x15\x8b[+\x14\x15\x8c\\\x1e\x14\x15\x8d[\x04\x14\x15\x8eP\x94\x14" +
+	"\x15\x8fd\x10\x14\x15\x90cH\x14\x15\x91c3\x14\x15\x92L\x80\x14\x15\x93X\xe6\x14\x15\x94X\x7f\x14\x15\x95" +
+	"W\xd3\x14\x15\xc0]\x0f\x14\x15\xc2gd\x14\x15\xc3f\x87\x14\x15\xc4e\xab\x14\x15\xc6[\xe1\x14\x15\xc7[w" +
+	"\x14\x15\xc8Z\xd7\x14\x15\xc9Y%\x14\x15\xca*F\x14\x15\xcb[$\x14\x15\xcc\\\x16\x14\x15\xcdZ\xf3\x14\x15" +
+	"\xceNg\x14\x15\xcfc\\\x14\x15\xd0b\x94\x14\x15\xd1a\xcd\x14\x15\xd2J\xff\x14\x15\xd3Y\xf0\x14\x15\xd4Y" +
+	"\x92\x14\x15\xd5Y\x04\x14\x15\xd6W|\x14\x16\x00^\x1f\x14\x16\x02g\xf5\x14\x16\x03g\x19\x14\x16\x04f<\x14" +
+	"\x16\x06\\\xea\x14\x16\a\\\x83\x14\x16\b\\\n\x14\x16\t[4\x14\x16\n[\"\x14\x16\v+O\x14\x16\f" +
+	"]&\x14\x16\r[\xe8\x14\x16\x0eM\xc4\x14\x16\x0fc\xdb\x14\x16\x10c\x14\x14\x16\x11bL\x14\x16\x12I\xc9" +
+	"\x14\x16\x13Z\xd9\x14\x16\x14Z}\x14\x16\x15Z\x11\x14\x16\x16YR\x14\x16\x17YB\x14\x16@^Y\x14\x16" +
+	"Bg\xdd\x14\x16Cg\x00\x14\x16Df$\x14\x16F\\\x98\x14\x16G]p\x14\x16H\\\xf9\x14\x16I\\" +
+	"'\x14\x16J\\\x15\x14\x16K]&\x14\x16L+R\x14\x16M\\\x1c\x14\x16NL\x97\x14\x16Oc\xc1\x14" +
+	"\x16Pb\xfa\x14\x16Qb2\x14\x16RH\x85\x14\x16SZ\x8b\x14\x16T[M\x14\x16UZ\xe3\x14\x16V" +
+	"Z(\x14\x16WZ\x17\x14\x16X[\f\x14\x16\x8d.\x97\x14\x16\x8eO\xcc\x14\x16\x8fg\x84\x14\x16\x90f\x82" +
+	"\x14\x16\x91e\x9b\x14\x16\x92KY\x14\x16\x93`T\x14\x16\x94`\xee\x14\x16\x95a\xbe\x14\x16\x96`\xfc\x14\x16" +
+	"\x97`\xf6\x14\x16\x98b\x00\x14\x16\x99b9\x14\x16\xce_\x84\x14\x16ϥ\xd3\x14\x16Хl\x14\x16ѥ" +
+	"\x18\x14\x16҆o\x14\x16\xd3a?\x14\x16\xd4[J\x14\x16\xd5W\xa4\x14\x16\xd6U\x10\x14\x16\xd7R\xd8\x14" +
+	"\x16\xd8R;\x14\x16\xd9Q\a\x14\x17\x0fWV\x14\x17\x10\x8c\x8c\x14\x17\x11\x8c)\x14\x17\x12~o\x14\x17\x13" +
+	"n.\x14\x17\x14m[\x14\x17\x15k\x10\x14\x17\x16j9\x14\x17\x17i\x83\x14\x17\x18j\x0f\x14\x17\x19i\xf6" +
+	"\x14\x17PT\xf5\x14\x17Q\x88u\x14\x17R{\xd3\x14\x17Sk\t\x14\x17Tj7\x14\x17Uj\xe3\x14\x17" +
+	"ViQ\x14\x17Wh\x9b\x14\x17Xi'\x14\x17Yi\x0e\x14\x17\x91R\xaf\x14\x17\x92t\xef\x14\x17\x93g" +
+	"\xea\x14\x17\x94g\x18\x14\x17\x95g\xc4\x14\x17\x96i.\x14\x17\x97g\xb5\x14\x17\x98hA\x14\x17\x99h'\x14" +
+	"\x17\xd2\"p\x14\x17\xd3W\xd9\x14\x17\xd4V\xf4\x14\x17\xd5SU\x14\x17\xd6P\xb4\x14\x17\xd7O3\x14\x17\xd8" +
+	"M\xf9\x14\x17\xd9L\xad\x14\x18\x13,\xd2\x14\x18\x14\\\xff\x14\x18\x15]\x82\x14\x18\x16^\x8e\x14\x18\x17_\xc1" +
+	"\x14\x18\x18`\xb7\x14\x18\x19`d\x14\x18T-'\x14\x18U\\\xe2\x14\x18V^\x1b\x14\x18W_W\x14\x18" +
+	"X`P\x14\x18Ya7\x14\x18\x95-\x88\x14\x18\x96]h\x14\x18\x97^\xc4\x14\x18\x98_\xe3\x14\x18\x99`" +
+	"\xcd\x14\x18\xd6-\xe6\x14\x18\xd7]#\x14\x18\xd8_\x1a\x14\x18\xd9`\b\x14\x19\x17-\x8d\x14\x19\x18_\x15\x14" +
+	"\x19\x19`\x02\x14\x19X.\x8f\x14\x19Ya\f\x14\x19\x99.\x93\x14!\x80Us\x14!\x81o\xf2\x14!\x83" +
+	"`;\x14!\x84\\\xfe\x14!\xc0U\xc6\x14!\xc1k\xd1\x14!\xc3_V\x14!\xc4\\\x19\x14!\xc6QO" +
+	"\x14\"\x00V\xa3\x14\"\x01j/\x14\"\x03`\x1b\x14\"\x04\\\xde\x14\"\x06Ru\x14\"\aQ`\x14\"" +
+	"@U\xcf\x14\"AiP\x14\"C^w\x14\"D^_\x14\"FS\x90\x14\"GR\xa7\x14\"HR" +
+	"\x19\x14\"\x80U\xbc\x14\"\x81h\x8d\x14\"\x83]\xb4\x14\"\x84\\\xd2\x14\"\x86T\xc5\x14\"\x87S\xe7\x14" +
+	"\"\x88Sx\x14\"\x89Q\xbb\x14\"\xc0V\xd3\x14\"\xc1i$\x14\"\xc3^L\x14\"\xc4]i\x14\"\xc6" +
+	"U\xd5\x14\"\xc7U\x1d\x14\"\xc8T\xb2\x14\"\xc9S\xd8\x14\"\xcaS\xc5\x14#\x00W\x0e\x14#\x01i\f" +
+	"\x14#\x03^4\x14#\x04]Q\x14#\x06U\x81\x14#\aV\x0f\x14#\bU\xa6\x14#\tT\xd0\x14#" +
+	"\nT\xbe\x14#\vU\xd4\x14#@/\x9d\x14#Ar\xca\x14#Cg\xa2\x14#Df\xc5\x14#F`" +
+	"S\x14#G`\x84\x14#Ha]\x14#I`\x8d\x14#J`{\x14#Ka\x8c\x14#La\xc6\x14" +
+	"#\x80q\t\x14#\x81\x7f\v\x14#\x83\x9a(\x14#\x84\x99\xd3\x14#\x86y\xea\x14#\x87u\xbc\x14#\x88" +
+	"t\x1b\x14#\x89s:\x14#\x8arx\x14#\x8bs\n\x14#\x8cr\xf1\x14#\xc0N\xe0\x14#\xc1\xa7\x16" +
+	"\x14#ã\xd9\x14#ģ\x93\x14#\xc6]\x9f\x14#\xc7[2\x14#\xc8V\xe7\x14#\xc9T?\x14#" +
+	"\xcaQ\xf3\x14#\xcbQX\x14#\xccP \x14$\x00e\xde\x14$\x01\x9a&\x14$\x03U\x95\x14$\x04\x8a" +
+	"S\x14$\x06j\xab\x14$\ai\xab\x14$\bjb\x14$\th\xc2\x14$\nh\x00\x14$\vh\x92\x14" +
+	"$\fhy\x14$@d\xf4\x14$A\x99\xc5\x14$C\x8aD\x14$DSV\x14$Fgz\x14$G" +
+	"f{\x14$Hg2\x14$Ih\xa0\x14$Jg\x16\x14$Kg\xa8\x14$Lg\x8f\x14$\x80K=" +
+	"\x14$\x81\x8e\xec\x14$\x83|U\x14$\x84s\xd3\x14$\x86X\x9f\x14$\x87S!\x14$\x88SP\x14$" +
+	"\x89P\xa3\x14$\x8aO\x1a\x14$\x8bM\xe1\x14$\x8cL\x92\x14$\x8f\x80\x00\x14$\x90w\x88\x14$\x91o" +
+	"\xc2\x14$\xc0`\x1a\x14$\xc1{l\x14$\xc3l0\x14$\xc4i\n\x14$\xc6+\xb4\x14$\xc7\\(\x14" +
+	"$\xc8]@\x14$\xc9^M\x14$\xca_~\x14$\xcb`}\x14$\xcc`)\x14$\xcd]\xe9\x14$\xce" +
+	"u\xf6\x14$\xcf\\\x85\x14$\xd0g\xf1\x14$\xd1e\x14\x14$\xd2W\xf0\x14%\x00`q\x14%\x01we" +
+	"\x14%\x03kS\x14%\x04h-\x14%\x06\\G\x14%\a.\x17\x14%\b\\5\x14%\t]o\x14%" +
+	"\n^\xab\x14%\v_\xcf\x14%\f`\xbb\x14%\r^\x15\x14%\x0er!\x14%\x0fZ+\x14%\x10g" +
+	"\x12\x14%\x11d5\x14%\x12R\xcc\x14%\x13Zn\x14%@aI\x14%Au\xc5\x14%Cl\x0f\x14" +
+	"%Dh\xe8\x14%F]c\x14%G\\3\x14%H.\x99\x14%I\\\xe2\x14%J^=\x14%K" +
+	"_e\x14%L`S\x14%M^\xd7\x14%Np\x9f\x14%OV&\x14%Pg\xb1\x14%Qd\xd4" +
+	"\x14%RR\xdb\x14%S[f\x14%TZT\x14%\x80`\x83\x14%\x81t\xee\x14%\x83jy\x14%" +
+	"\x84jc\x14%\x86^x\x14%\x87]u\x14%\x88\\\xe8\x14%\x89.\xf2\x14%\x8a\\\x92\x14%\x8b^" +
+	"\x98\x14%\x8c_\x8a\x14%\x8d^\x1e\x14%\x8eo\xce\x14%\x8fS\xa0\x14%\x90f4\x14%\x91f\x1f\x14" +
+	"%\x92PN\x14%\x93\\U\x14%\x94[l\x14%\x95Z\xee\x14%\xc0`{\x14%\xc1t7\x14%\xc3" +
+	"i\xc2\x14%\xc4h\xe6\x14%\xc6_\xaa\x14%\xc7^\xb1\x14%\xc8^D\x14%\xc9\\\x91\x14%\xca.\x8e" +
+	"\x14%\xcb^\x90\x14%\xcc_\x83\x14%\xcd^\r\x14%\xceo\x1a\x14%\xcfQs\x14%\xd0e\x80\x14%" +
+	"\xd1d\xb9\x14%\xd2N\xcf\x14%\xd3]^\x14%\xd4\\\x80\x14%\xd5\\\x1f\x14%\xd6Z\x97\x14&\x00a" +
+	"\x8b\x14&\x01t\xc9\x14&\x03jT\x14&\x04iw\x14&\x06`\xb3\x14&\a_\xe0\x14&\b_v\x14" +
+	"&\t^\xa0\x14&\n^\x8f\x14&\v/\x96\x14&\f`\x92\x14&\r_\x03\x14&\x0eo\x99\x14&\x0f" +
+	"P\xd0\x14&\x10f\x00\x14&\x11e8\x14&\x12M\x9d\x14&\x13^G\x14&\x14]\x8a\x14&\x15]," +
+	"\x14&\x16\\m\x14&\x17\\\\\x14&@a\xc6\x14&At\xb0\x14&Cj;\x14&Di_\x14&" +
+	"F`a\x14&G`\xcd\x14&H`f\x14&I_\x94\x14&J_\x82\x14&K`\x92\x14&L/" +
+	"\x9a\x14&M_6\x14&No\x7f\x14&OO\xa3\x14&Pe\xe6\x14&Qe\x1e\x14&RL[\x14" +
+	"&S]\xf9\x14&T^Z\x14&U]\xfe\x14&V]C\x14&W]2\x14&X^'\x14&\x8d" +
+	"2\xbc\x14&\x8etq\x14&\x8fS\x04\x14&\x90i\x81\x14&\x91h\x9a\x14&\x92Os\x14&\x93c\xdf" +
+	"\x14&\x94d6\x14&\x95e\n\x14&\x96dH\x14&\x97dB\x14&\x98eL\x14&\x99e\x86\x14&" +
+	"\xce\x7f\xdd\x14&ϨQ\x14&ЛX\x14&њ\xf9\x14&Ґ~\x14&\xd3}\x03\x14&\xd4y" +
+	"\v\x14&\xd5wk\x14&\xd6v\x94\x14&\xd7u\xdf\x14&\xd8vk\x14&\xd9vQ\x14'\x0fav\x14" +
+	"'\x10\xa4\xef\x14'\x11\xa4\x9f\x14'\x12\x86i\x14'\x13aX\x14'\x14_\x17\x14'\x15Z\xdc\x14'\x16" +
+	"XH\x14'\x17V\x10\x14'\x18Us\x14'\x19T?\x14'PV\xdc\x14'Q\x8b\xdf\x14'R~g" +
+	"\x14'Sn)\x14'Tm8\x14'Um\xe6\x14'VlT\x14'Wk\x9f\x14'Xl*\x14'" +
+	"Yl\x11\x14'\x91T\x96\x14'\x92v\x00\x14'\x93k\v\x14'\x94j\x19\x14'\x95j\xc7\x14'\x96l" +
+	"1\x14'\x97j\xb8\x14'\x98kD\x14'\x99k*\x14'\xd2&\xd5\x14'\xd3\\\x81\x14'\xd4W:\x14" +
+	"'\xd5Wa\x14'\xd6T\xc2\x14'\xd7SD\x14'\xd8R\r\x14'\xd9P\xc4\x14(\x13.\xca\x14(\x14" +
+	"`\x01\x14(\x15a\r\x14(\x16b\x19\x14(\x17cK\x14(\x18dB\x14(\x19c\xee\x14(T11" +
+	"\x14(U`\b\x14(VaA\x14(Wb}\x14(Xc\x98\x14(Yd\x7f\x14(\x951\xad\x14(" +
+	"\x96`\xb5\x14(\x97b\x11\x14(\x98c/\x14(\x99d\x19\x14(\xd62\v\x14(\xd7`o\x14(\xd8b" +
+	"g\x14(\xd9cT\x14)\x171\xb2\x14)\x18ba\x14)\x19cN\x14)X2\xb4\x14)YdX\x14" +
+	")\x992\xb8\x141\x80Y8\x141\x81s\xd0\x141\x82p\x15\x141\x84`^\x141\xc0Y\xa0\x141\xc1" +
+	"o\xb0\x141\xc2n\xa3\x141\xc4_~\x141\xc6T\xd0\x142\x00Z\n\x142\x01m\x85\x142\x02k\xcd" +
+	"\x142\x04`\a\x142\x06U\x9f\x142\aT\x89\x142@Yj\x142Al\xc4\x142Bk\v\x142" +
+	"Da\xa8\x142FWT\x142GV\x82\x142HT\xc4\x142\x80YX\x142\x81l\x01\x142\x82j" +
+	"I\x142\x84`\x1a\x142\x86X\x89\x142\x87W\xc1\x142\x88V#\x142\x89UW\x142\xc0Zn\x14" +
+	"2\xc1l\x98\x142\xc2j\xe0\x142\xc4`\xb1\x142\xc6Y\x99\x142\xc7X\xf7\x142\xc8X\x19\x142\xc9" +
+	"Ws\x142\xcaW`\x143\x00Z\xa9\x143\x01l\x80\x143\x02j\xc8\x143\x04`\x99\x143\x06YF" +
+	"\x143\aY\xe9\x143\bY\x0e\x143\tXk\x143\nXY\x143\vYo\x143@3\xe4\x143" +
+	"Av\x01\x143Bs\xe6\x143Di\xfc\x143Fd\x03\x143GdI\x143Hd\x93\x143Ic" +
+	"\xf5\x143Jc\xe4\x143Kd\xf4\x143Le.\x143\x80tI\x143\x81\x81\xf0\x143\x82\xa48\x14" +
+	"3\x84\x9c\xfd\x143\x86}\xb3\x143\x87y\x85\x143\x88wA\x143\x89v~\x143\x8au\xbc\x143\x8b" +
+	"vN\x143\x8cv4\x143\xc0q\xff\x143\xc1\xa4\n\x143\xc2~\xdf\x143ę\x98\x143\xc6y\xf7" +
+	"\x143\xc7xr\x143\xc8u\x8b\x143\xc9t\xc8\x143\xcat\x06\x143\xcbt\x98\x143\xcct~\x144" +
+	"\x00R:\x144\x01\xa7\xc8\x144\x02\xa5\xcd\x144\x04\xa3@\x144\x06]\xe7\x144\a[z\x144\bZ" +
+	"\xab\x144\tW\x9a\x144\nUN\x144\vT\xb2\x144\fSz\x144@h\x12\x144A\x9c\xcf\x14" +
+	"4B\x99\x9a\x144DU_\x144Fj\xbb\x144Gi\xc1\x144Hj-\x144Ik\xbd\x144J" +
+	"j4\x144Kj\xc6\x144Lj\xac\x144\x80Ov\x144\x81\x91\x8a\x144\x82\x8e\xb9\x144\x84v2" +
+	"\x144\x86Z\x98\x144\x87X\r\x144\x88S\x9f\x144\x89T\xd1\x144\x8aSK\x144\x8bR\x14\x144" +
+	"\x8cP\xc9\x144\x90\x80\x00\x144\x91q\xec\x144\xc0c\xad\x144\xc1\x7f\x12\x144\xc2{\x8c\x144\xc4l" +
+	"O\x144\xc6-%\x144\xc7_\x8a\x144\xc8`;\x144\xc9a\xe1\x144\xcac\x11\x144\xcbd\x11\x14" +
+	"4\xccc\xbc\x144\xcda@\x144\xceye\x144\xcfv\x17\x144\xd0\\\xcb\x144\xd1h\x12\x144\xd2" +
+	"Y\xbc\x145\x00d\x1a\x145\x01{\v\x145\x02z%\x145\x04kx\x145\x06_\xad\x145\a00" +
+	"\x145\b_0\x145\ta\x18\x145\nbS\x145\vcx\x145\fdd\x145\ra\x80\x145" +
+	"\x0eu\x8f\x145\x0ft\xba\x145\x10Zr\x145\x11g7\x145\x12WK\x145\x13]\x8a\x145@d" +
+	"\x89\x145Ax\xec\x145Bwf\x145Dl\x05\x145F`\x7f\x145G_O\x145H2\x98\x14" +
+	"5I_j\x145J`\xc5\x145Kb\xa5\x145Lc\x93\x145Ma\xc1\x145Nsz\x145O" +
+	"r\x0f\x145PY\x91\x145Qg\xa1\x145RS\x1c\x145S^3\x145T]!\x145\x80c\xeb" +
+	"\x145\x81x)\x145\x82v\xa2\x145\x84m\x99\x145\x86b)\x145\x87a:\x145\x88_h\x145" +
+	"\x8939\x145\x8a_\xfa\x145\x8bb\x00\x145\x8cb\xf3\x145\x8da5\x145\x8er\xc2\x145\x8fq" +
+	"W\x145\x90V\xab\x145\x91i\a\x145\x92T\x18\x145\x93_\xac\x145\x94^\xd7\x145\x95]2\x14" +
+	"5\xc0c\xe4\x145\xc1wr\x145\xc2u\xec\x145\xc4l\x1c\x145\xc6cZ\x145\xc7bv\x145\xc8" +
+	"`\xc4\x145\xc9_\xfa\x145\xca2\xd5\x145\xcba\xf9\x145\xccb\xeb\x145\xcda$\x145\xcer\x0e" +
+	"\x145\xcfp\xa3\x145\xd0T~\x145\xd1g\xa1\x145\xd2R\x9c\x145\xd3`\xb6\x145\xd4_\xeb\x145" +
+	"\xd5^c\x145\xd6]\xae\x146\x00d\xf4\x146\x01x\x03\x146\x02v}\x146\x04l\xae\x146\x06d" +
+	"d\x146\ac\xa5\x146\bb\xac\x146\tb\t\x146\na\xf7\x146\v3\xde\x146\fc\xfb\x14" +
+	"6\rb\x1a\x146\x0er\x8d\x146\x0fq#\x146\x10S\xdc\x146\x11h \x146\x12Qm\x146\x13" +
+	"a\x9e\x146\x14`\xf5\x146\x15`\x16\x146\x16_\x84\x146\x17_s\x146@e.\x146Aw\xeb" +
+	"\x146Bve\x146Dl\x95\x146Fd\x11\x146Gd\x92\x146Hc\x9c\x146Ib\xfc\x146" +
+	"Jb\xea\x146Kc\xfb\x146L3\xe1\x146MbM\x146Nrs\x146Oq\b\x146PR" +
+	"\xaf\x146Qh\x06\x146RP-\x146SaP\x146Ta\xc4\x146U`\xe8\x146V`Z\x14" +
+	"6W`I\x146Xa>\x146\x8d6\xe1\x146\x8ew\x91\x146\x8fuS\x146\x90V>\x146\x91" +
+	"k\x99\x146\x92S\x88\x146\x93gR\x146\x94g\xbd\x146\x95h,\x146\x96g\x90\x146\x97g\x8a" +
+	"\x146\x98h\x94\x146\x99h\xce\x146\u0382\xad\x146Ϥ\xfb\x146Ш\xf4\x146ѝ\xec\x146" +
+	"ғ\x06\x146Ӏ\x8d\x146\xd4|\x96\x146\xd5z}\x146\xd6y\xb9\x146\xd7y\x03\x146\xd8y" +
+	"\x8f\x146\xd9yu\x147\x0f\x7f\x84\x147\x10\xa6\xd8\x147\x11\x9a\xa7\x147\x12\x90\x15\x147\x13|\xf7\x14" +
+	"7\x14{\x83\x147\x15x\xd0\x147\x16x\v\x147\x17wV\x147\x18w\xe1\x147\x19w\xc8\x147P" +
+	"c\x8c\x147Q\xa4'\x147R\x86e\x147Sat\x147T_2\x147U^\x88\x147V[\x82" +
+	"\x147WYJ\x147XX\xad\x147YWy\x147\x91V~\x147\x92x$\x147\x93n!\x147" +
+	"\x94m5\x147\x95m\xab\x147\x96o0\x147\x97m\xb7\x147\x98nC\x147\x99n*\x147\xd2+" +
+	"7\x147\xd3^@\x147\xd4[\xe1\x147\xd5W\xa6\x147\xd6X\xcc\x147\xd7WQ\x147\xd8V\x1d\x14" +
+	"7\xd9T\xd6\x148\x130\x1c\x148\x14c7\x148\x15c\xf2\x148\x16e\x8c\x148\x17f\xbe\x148\x18" +
+	"g\xb4\x148\x19ga\x148T3(\x148Ub\xed\x148Vd\xc7\x148Wf\x04\x148Xg\x1f" +
+	"\x148Yh\x06\x148\x955\x94\x148\x96c#\x148\x97d\x7f\x148\x98fQ\x148\x99g;\x148" +
+	"\xd660\x148\xd7c\xb7\x148\xd8e\xaf\x148\xd9f\x9c\x149\x175\xd7\x149\x18e\xa9\x149\x19f" +
+	"\x97\x149X6\xd9\x149Yg\xa0\x149\x996\xdd\x14A\x80\\\xf9\x14A\x81w\xa8\x14A\x82s\xed\x14" +
+	"A\x83p1\x14A\xc0]`\x14A\xc1s\x8a\x14A\xc2r}\x14A\xc3n\xc1\x14A\xc6W\xb5\x14B\x00" +
+	"]\xe0\x14B\x01q`\x14B\x02o\xa7\x14B\x03n\xbe\x14B\x06Y\x06\x14B\aX\x06\x14B@\\\x12" +
+	"\x14BAoY\x14BBm\xa1\x14BCl\x05\x14BFY\xce\x14BGX\xfb\x14BHW>\x14B" +
+	"\x80\\\xef\x14B\x81oq\x14B\x82m\xb9\x14B\x83l\x1c\x14B\x86\\J\x14B\x87[\x82\x14B\x88Y" +
+	"\xf9\x14B\x89Up\x14B\xc0^\x05\x14B\xc1p\b\x14B\xc2nP\x14B\xc3l\xb4\x14B\xc6]Z\x14" +
+	"B\xc7\\\xb8\x14B\xc8[\xef\x14B\xc9Z\x1a\x14B\xcaZ\xf7\x14C\x00^@\x14C\x01o\xf0\x14C\x02" +
+	"n8\x14C\x03l\x9c\x14C\x06]\a\x14C\a]\xaa\x14C\b\\\xe4\x14C\t[\x13\x14C\n[\xf0" +
+	"\x14C\v]\x06\x14C@8+\x14CAy7\x14CBw\x1d\x14CCu\x96\x14CFg\xb0\x14C" +
+	"Gg\xf6\x14CHhT\x14CIfp\x14CJgH\x14CKhY\x14CLh\x93\x14C\x80w" +
+	"\x88\x14C\x81\x84\xd8\x14C\x82\xa6L\x14C\x83\xa7\x1f\x14C\x86\x81v\x14C\x87}J\x14C\x88{\x06\x14" +
+	"C\x89x\xe8\x14C\x8ax\xfb\x14C\x8by\x8d\x14C\x8cyt\x14C\xc0u?\x14C\xc1\xa6\x1e\x14C\xc2" +
+	"\x81\xc6\x14Cä.\x14C\xc6}\xba\x14C\xc7|7\x14C\xc8yP\x14C\xc9w2\x14C\xcawE" +
+	"\x14C\xcbw\xd7\x14C\xccw\xbe\x14D\x00s\x8b\x14D\x01\xa6\xc8\x14D\x02\xa4\x03\x14D\x03~\xe4\x14D" +
+	"\x06z\x00\x14D\ax}\x14D\bxc\x14D\tu\x99\x14D\nu\xad\x14D\vv?\x14D\fv" +
+	"&\x14D@U\x96\x14DA\xa97\x14DB\xa7<\x14DC\xa5\xc7\x14DF^4\x14DG[\xc9\x14" +
+	"DHZ\xfa\x14DIZ\x8c\x14DJX\xaa\x14DKX\x0e\x14DLV\xd6\x14D\x80S\xab\x14D\x81" +
+	"\x98\x85\x14D\x82\x97<\x14D\x83\x94\xdc\x14D\x86[\xfd\x14D\x87Z\x06\x14D\x88X\x8a\x14D\x89U\x1d" +
+	"\x14D\x8aWw\x14D\x8bVD\x14D\x8cT\xfb\x14D\x91\x80\x00\x14D\xc0g=\x14D\xc1\x82\xb1\x14D" +
+	"\xc2\x7f+\x14D\xc3{\xa5\x14D\xc6.{\x14D\xc7bW\x14D\xc8c\x83\x14D\xc9d7\x14D\xcaf" +
+	"\xa1\x14D\xcbg\xa0\x14D\xccgL\x14D\xcdd\x94\x14D\xce|\xcd\x14D\xcfy\x7f\x14D\xd0v1\x14" +
+	"D\xd1]\x14\x14D\xd2[\x04\x14E\x00g\xa9\x14E\x01~\xac\x14E\x02}\xc6\x14E\x03z@\x14E\x06" +
+	"bz\x14E\a1\xa2\x14E\bb\x8e\x14E\tcn\x14E\ne\xe3\x14E\vg\a\x14E\fg\xf3" +
+	"\x14E\rd\xd3\x14E\x0ex\xfa\x14E\x0fx$\x14E\x10t\xd6\x14E\x11Z\xbc\x14E\x12Y\x16\x14E" +
+	"\x13`\x1a\x14E@h.\x14EA|\x8d\x14EB{\a\x14ECzD\x14EFc\xcc\x14EGb" +
+	"\xb0\x14EH4\xb1\x14EIa\xc0\x14EJdi\x14EKfI\x14ELg8\x14EMe(\x14" +
+	"ENv\xe4\x14EOuy\x14EPt\xc3\x14EQY\xdc\x14ERW\x9a\x14ESa7\x14ET" +
+	"`9\x14E\x80fo\x14E\x81z\x94\x14E\x82y\x0e\x14E\x83w\xa3\x14E\x86d\x98\x14E\x87c\xa9" +
+	"\x14E\x88a\xd7\x14E\x896\\\x14E\x8a`\x1b\x14E\x8bd\x84\x14E\x8cew\x14E\x8dcv\x14E" +
+	"\x8et\xf3\x14E\x8fs\x88\x14E\x90r5\x14E\x91YY\x14E\x92TY\x14E\x93a\xde\x14E\x94a" +
+	"\t\x14E\x95_d\x14E\xc0gH\x14E\xc1z\xa9\x14E\xc2y\"\x14E\xc3w\xb7\x14E\xc6g\a\x14" +
+	"E\xc7f#\x14E\xc8d\x85\x14E\xc9`\x1b\x14E\xca7\x1c\x14E\xcbe]\x14E\xccfO\x14E\xcd" +
+	"d8\x14E\xcet\xff\x14E\xcfs\x94\x14E\xd0rA\x14E\xd1W\x8a\x14E\xd2Vf\x14E\xd3d\t" +
+	"\x14E\xd4c>\x14E\xd5a\xc9\x14E\xd6]\xc5\x14F\x00hX\x14F\x01{:\x14F\x02y\xb4\x14F" +
+	"\x03xH\x14F\x06h\x10\x14F\agQ\x14F\bfm\x14F\td\x83\x14F\ne[\x14F\v8" +
+	"%\x14F\fg_\x14F\re-\x14F\x0eu~\x14F\x0ft\x13\x14F\x10r\xc1\x14F\x11V\xe8\x14" +
+	"F\x12U8\x14F\x13d\xf2\x14F\x14dH\x14F\x15c}\x14F\x16a\xc5\x14F\x17b\x87\x14F@" +
+	"h\x93\x14FA{\"\x14FBy\x9b\x14FCx0\x14FFg\xbe\x14FGh>\x14FHg]" +
+	"\x14FIev\x14FJfN\x14FKg_\x14FL8(\x14FMea\x14FNud\x14F" +
+	"Os\xf9\x14FPr\xa7\x14FQU\xba\x14FRS\xfc\x14FSd\xa4\x14FTe\x18\x14FUd" +
+	"O\x14FVb\x9a\x14FWc\\\x14FXdR\x14F\x8d;\x06\x14F\x8ez\xb2\x14F\x8fxs\x14" +
+	"F\x90v\xc8\x14F\x91Yz\x14F\x92W\x99\x14F\x93j\xc0\x14F\x94k+\x14F\x95k\xaf\x14F\x96" +
+	"i\xfb\x14F\x97j\xcf\x14F\x98k\xd8\x14F\x99l\x12\x14F΅\x80\x14FϦ\xfc\x14FЧ\xa4" +
+	"\x14FѪT\x14Fҙ\xd8\x14Fӄ\x12\x14FԀ\x1d\x14F\xd5~\x04\x14F\xd6|\x13\x14F" +
+	"\xd7|$\x14F\xd8|\xaf\x14F\xd9|\x96\x14G\x0f\x82X\x14G\x10\xa4\xcd\x14G\x11\xa87\x14G\x12\x98" +
+	"i\x14G\x13\x80|\x14G\x14\x7f\n\x14G\x15|V\x14G\x16zf\x14G\x17zv\x14G\x18{\x02\x14" +
+	"G\x19z\xe8\x14GP\x7f`\x14GQ\xa6\x9f\x14GR\x95\xe9\x14GS|\xe8\x14GT{v\x14GU" +
+	"{j\x14GVx\xd5\x14GWx\xe5\x14GXyq\x14GYyX\x14G\x91e\xbf\x14G\x92\x86c" +
+	"\x14G\x93a\x93\x14G\x94_S\x14G\x95^\xa9\x14G\x96^b\x14G\x97\\\x86\x14G\x98[\xe9\x14G" +
+	"\x99Z\xb5\x14G\xd2/\x95\x14G\xd3_l\x14G\xd4]\x9e\x14G\xd5\\K\x14G\xd6Y\x10\x14G\xd7[" +
+	"Z\x14G\xd8Z)\x14G\xd9X\xe4\x14H\x131T\x14H\x14e\xdd\x14H\x15g\x10\x14H\x16g\xd0\x14" +
+	"H\x17j-\x14H\x18k#\x14H\x19j\xd0\x14HT4{\x14HUf\x1f\x14HVg\f\x14HW" +
+	"is\x14HXj\x8d\x14HYku\x14H\x957\x8c\x14H\x96eg\x14H\x97h\x02\x14H\x98i\xd4" +
+	"\x14H\x99j\xbd\x14H\xd69@\x14H\xd7c\xd0\x14H\xd8h\x19\x14H\xd9i\a\x14I\x179\xfc\x14I" +
+	"\x18h\xed\x14I\x19i\xdb\x14IX:\xfe\x14IYj\xe5\x14I\x99;\x02\x18\x01\xc1\xa8V\x18\x01§" +
+	"\xe5\x18\x01ä\x9d\x18\x01ġY\x18\x01Ş9\x18\x02\x01\xa8z\x18\x02\x02\xa7E\x18\x02\x03\xa7\xb7\x18" +
+	"\x02\x04\xa4s\x18\x02\x05\xa1S\x18\x02\a\x94J\x18\x02A\xa7k\x18\x02B\xa66\x18\x02C\xa5\xc1\x18\x02D" +
+	"\xa6[\x18\x02E\xa3;\x18\x02G\x95\xb6\x18\x02H\x92p\x18\x02\x81\xa7\x97\x18\x02\x82\xa6b\x18\x02\x83\xa5\xed" +
+	"\x18\x02\x84\xa5\xa0\x18\x02\x85\xa6B\x18\x02\x87\x98@\x18\x02\x88\x94\xfa\x18\x02\x89\x90Z\x18\x02\xc1\xa7\xab\x18\x02" +
+	"¦v\x18\x02æ\x02\x18\x02ĥ\xb4\x18\x02ť\x8b\x18\x02ǚ8\x18\x02Ȗ\xf2\x18\x02ɒ" +
+	"R\x18\x02ʒQ\x18\x03\x01\xa8\xc2\x18\x03\x02\xa7\x8d\x18\x03\x03\xa7\x18\x18\x03\x04\xa6\xcb\x18\x03\x05\xa6\xa2\x18" +
+	"\x03\a\x9e\x82\x18\x03\b\x9b=\x18\x03\t\x96\x9d\x18\x03\n\x96\xb7\x18\x03\v\x96\xba\x18\x03A\xa3\xef\x18\x03B" +
+	"\xa2\xad\x18\x03C\xa2@\x18\x03D\xa1\xea\x18\x03E\xa5\xae\x18\x03G]V\x18\x03HW\x88\x18\x03IQ\x94" +
+	"\x18\x03JO\x00\x18\x03KL\x8f\x18\x03LL/\x18\x03\x81\x7f\xd1\x18\x03\x82\xad\x9a\x18\x03\x83\xacW\x18\x03" +
+	"\x84\xab\xd1\x18\x03\x85\xaf'\x18\x03\x87\xa9\t\x18\x03\x88\xa9*\x18\x03\x89\xa8\x1f\x18\x03\x8a\xa8H\x18\x03\x8b\xa8" +
+	"\\\x18\x03\x8c\xa9l\x18\x03\xc1\xad\x8a\x18\x03\xc2|\xf2\x18\x03è\x87\x18\x03Ĩ\x00\x18\x03ūW\x18" +
+	"\x03Ǩ\x8b\x18\x03ȧ\xee\x18\x03ɦ\xe3\x18\x03ʧ\f\x18\x03˧ \x18\x03̨0\x18\x04\x01" +
+	"\xac;\x18\x04\x02\xa8x\x18\x04\x03z\\\x18\x04\x04\xa4K\x18\x04\x05\xa7\xa1\x18\x04\a\xa5P\x18\x04\b\xa8Q" +
+	"\x18\x04\t\xa6e\x18\x04\n\xa6\x8e\x18\x04\v\xa6\xa2\x18\x04\f\xa7\xb2\x18\x04A\xab\xa9\x18\x04B\xa7\xe6\x18\x04" +
+	"C\xa4=\x18\x04Dw\xf1\x18\x04E\xa3\xf1\x18\x04G\xa2\x19\x18\x04H\xa5\x1b\x18\x04I\xa6\xf0\x18\x04J\xa6" +
+	"8\x18\x04K\xa6L\x18\x04L\xa7\\\x18\x04\x81\xae\xf6\x18\x04\x82\xab2\x18\x04\x83\xa7\x89\x18\x04\x84\xa3\xe5\x18" +
+	"\x04\x85v\v\x18\x04\x87\x9f\x12\x18\x04\x88\xa2\x14\x18\x04\x89\xa3\xe9\x18\x04\x8a\xa6\xd9\x18\x04\x8b\xa6%\x18\x04\x8c" +
+	"\xa76\x18\x04\xc1\xc6\xca\x18\x04\xc2\u0093\x18\x04þb\x18\x04ĺ4\x18\x04Ŷ\x16\x18\x04Ǵg" +
+	"\x18\x04ȸ4\x18\x04ɺ\xd5\x18\x04ʾ\xaa\x18\x04\xcb\u0084\x18\x04\xcc\xc3\f\x18\x04̀\x00\x18\x04" +
+	"μR\x18\x04ϸz\x18\x04д\xa5\x18\x04Ѱ\xd4\x18\x04ҭ\x10\x18\x05\x01\xa9&\x18\x05\x02\xa8" +
+	"\xb5\x18\x05\x03\xa5\x82\x18\x05\x04\xa2T\x18\x05\x05\x9fI\x18\x05\aR\xdb\x18\x05\b\x95\xaa\x18\x05\t\x97\t\x18" +
+	"\x05\n\x99\x83\x18\x05\v\x9bv\x18\x05\f\x9f\x82\x18\x05\r[\x15\x18\x05\x0e\x9f\xe6\x18\x05\x0f\x9f\x80\x18\x05\x10" +
+	"\x9c\x98\x18\x05\x11\x99\xb4\x18\x05\x12\x96\xf0\x18\x05\x13\xaby\x18\x05A\xa9T\x18\x05B\xa8$\x18\x05C\xa8\x93" +
+	"\x18\x05D\xa5e\x18\x05E\xa2Z\x18\x05G\x95\xb5\x18\x05HR\xb9\x18\x05I\x93\xfd\x18\x05J\x96w\x18\x05" +
+	"K\x98j\x18\x05L\x9cv\x18\x05MU\xb2\x18\x05N\xa0\x05\x18\x05O\x9e\xf3\x18\x05P\x9fV\x18\x05Q\x9c" +
+	"q\x18\x05R\x99\xae\x18\x05S\xae\xef\x18\x05T\x8e#\x18\x05\x81\xa8J\x18\x05\x82\xa7\x1a\x18\x05\x83\xa6\xa8\x18" +
+	"\x05\x84\xa7>\x18\x05\x85\xa43\x18\x05\x87\x97\x14\x18\x05\x88\x93\xfd\x18\x05\x89Q<\x18\x05\x8a\x91\xf7\x18\x05\x8b" +
+	"\x93\xea\x18\x05\x8c\x97\xf7\x18\x05\x8dP-\x18\x05\x8e\x9f\x15\x18\x05\x8f\x9e\x04\x18\x05\x90\x9d\x9c\x18\x05\x91\x9e!" +
+	"\x18\x05\x92\x9b^\x18\x05\x93\xb1X\x18\x05\x94\x8fc\x18\x05\x95\x8c\x97\x18\x05\xc1\xa8\x7f\x18\x05§P\x18\x05" +
+	"æ\xde\x18\x05Ħ\x92\x18\x05ŧ1\x18\x05Ǚ\x98\x18\x05Ȗ\x80\x18\x05ɒ\x00\x18\x05\xcaQ" +
+	"S\x18\x05˓\xf3\x18\x05̘\x1b\x18\x05\xcdM\xbf\x18\x05Ο;\x18\x05Ϟ*\x18\x05Н\xc2\x18" +
+	"\x05ѝ}\x18\x05Ҟ\v\x18\x05Ӵ\xd6\x18\x05ԑ\xa1\x18\x05Վ\xd6\x18\x05֊\xbe\x18\x06\x01" +
+	"\xa8\x9e\x18\x06\x02\xa7n\x18\x06\x03\xa6\xfc\x18\x06\x04\xa6\xb0\x18\x06\x05\xa6\x89\x18\x06\a\x9b\x8c\x18\x06\b\x98t" +
+	"\x18\x06\t\x93\xf4\x18\x06\n\x93\xf3\x18\x06\vP\xd9\x18\x06\f\x98(\x18\x06\rKs\x18\x06\x0e\x9fM\x18\x06" +
+	"\x0f\x9e<\x18\x06\x10\x9d\xd4\x18\x06\x11\x9d\x8f\x18\x06\x12\x9dk\x18\x06\x13\xb8X\x18\x06\x14\x93_\x18\x06\x15\x90" +
+	"\x93\x18\x06\x16\x8c{\x18\x06\x17\x8cz\x18\x06A\xa9\xad\x18\x06B\xa8~\x18\x06C\xa8\f\x18\x06D\xa7\xc0\x18" +
+	"\x06E\xa7\x98\x18\x06G\x9f\xa1\x18\x06H\x9c\x8a\x18\x06I\x98\n\x18\x06J\x98#\x18\x06K\x98&\x18\x06L" +
+	"Q\xe9\x18\x06MK\x05\x18\x06N\xa0B\x18\x06O\x9f1\x18\x06P\x9e\xc9\x18\x06Q\x9e\x84\x18\x06R\x9e`" +
+	"\x18\x06S\xb8\xd2\x18\x06T\x97\x0f\x18\x06U\x94D\x18\x06V\x90,\x18\x06W\x90C\x18\x06X\x90E\x18\x06" +
+	"\x8d\x1e\xa2\x18\x06\x8e\xa5\\\x18\x06\x8f\xa4\x12\x18\x06\x90\xa3\x9b\x18\x06\x91\xa3;\x18\x06\x92\xa6\xec\x18\x06\x93\x86" +
+	"a\x18\x06\x94`*\x18\x06\x95Z|\x18\x06\x96T\xa3\x18\x06\x97R \x18\x06\x98O\xc3\x18\x06\x99Oa\x18" +
+	"\x06\u0380\xcb\x18\x06Ϯ\x9d\x18\x06ЭT\x18\x06Ѭ\xc5\x18\x06Ұ\b\x18\x06\xd3\xc7\xc3\x18\x06\xd4" +
+	"\xaaT\x18\x06ժ\x80\x18\x06֩z\x18\x06ש\xad\x18\x06ة\xcc\x18\x06٪\xd5\x18\a\x0f}\xe4" +
+	"\x18\a\x10\xa9\x9b\x18\a\x11\xa9\f\x18\a\x12\xacO\x18\a\x13Ö\x18\a\x14\xa9\xd7\x18\a\x15\xa9H\x18\a" +
+	"\x16\xa8B\x18\a\x17\xa8v\x18\a\x18\xa8\x94\x18\a\x19\xa9\x9e\x18\aP{E\x18\aQ\xa5n\x18\aR\xa8" +
+	"\xb1\x18\aS\xbfo\x18\aT\xa6\xac\x18\aU\xa9\xa9\x18\aV\xa7\xc6\x18\aW\xa7\xfa\x18\aX\xa8\x18\x18" +
+	"\aY\xa9\"\x18\a\x91x\xd3\x18\a\x92\xa5\x17\x18\a\x93\xbbN\x18\a\x94\xa3\x86\x18\a\x95\xa6\x83\x18\a\x96" +
+	"\xa8N\x18\a\x97\xa7\xa5\x18\a\x98\xa7\xc3\x18\a\x99\xa8\xcd\x18\a\xd2v\xf1\x18\aӷH\x18\aԠ\x90" +
+	"\x18\aգ\x8d\x18\a֥X\x18\aרC\x18\aا\x9e\x18\a٨\xa8\x18\b\x13X\x1e\x18\b" +
+	"\x14\xb5\xa7\x18\b\x15\xb9k\x18\b\x16\xbb\xfe\x18\b\x17\xbf\xca\x18\b\x18Ú\x18\b\x19\xc4\x1f\x18\bTT" +
+	"E\x18\bU\x97b\x18\bV\x98\xb9\x18\bW\x9b1\x18\bX\x9d%\x18\bY\xa1\x1f\x18\b\x95T,\x18" +
+	"\b\x96\x95\xb8\x18\b\x97\x980\x18\b\x98\x9a#\x18\b\x99\x9e\x1d\x18\b\xd6R\xb2\x18\bד\xcd\x18\b\xd8" +
+	"\x95\xc0\x18\bٙ\xba\x18\t\x17R\xd2\x18\t\x18\x95\xcb\x18\t\x19\x99\xdd\x18\tXRb\x18\tY\x99\xec" +
+	"\x18\t\x99Sl\x18\x11\xc0NK\x18\x11\xc2\\w\x18\x11\xc3Y6\x18\x11\xc4U\xf5\x18\x11\xc5S\xb0\x18\x12" +
+	"\x00O\x18\x18\x12\x02Z+\x18\x12\x03Y\xf1\x18\x12\x04V\xb0\x18\x12\x05Tk\x18\x12\aIj\x18\x12@N" +
+	"g\x18\x12BYo\x18\x12CXp\x18\x12DXQ\x18\x12EV\f\x18\x12GJ\xbd\x18\x12HJ\x1d\x18" +
+	"\x12\x80O\x11\x18\x12\x82Yh\x18\x12\x83Xj\x18\x12\x84W\x87\x18\x12\x85Xg\x18\x12\x87L\xa9\x18\x12\x88" +
+	"L*\x18\x12\x89K*\x18\x12\xc0N\xfe\x18\x12\xc2X\xec\x18\x12\xc3W\xee\x18\x12\xc4W\v\x18\x12\xc5W " +
+	"\x18\x12\xc7N%\x18\x12\xc8M\xa9\x18\x12\xc9L\xcf\x18\x12\xcaL\xbd\x18\x13\x00P\x15\x18\x13\x02Y\xaf\x18\x13" +
+	"\x03X\xb1\x18\x13\x04W\xce\x18\x13\x05W\xe3\x18\x13\aOa\x18\x13\bN\xe8\x18\x13\tN5\x18\x13\nN" +
+	"\xdf\x18\x13\vN\xcc\x18\x13@'f\x18\x13Bc\x1c\x18\x13Cb%\x18\x13DaH\x18\x13Ec\xc2\x18" +
+	"\x13GYf\x18\x13HZ/\x18\x13IY\x82\x18\x13JZ(\x18\x13KZ\x16\x18\x13L['\x18\x13\x80" +
+	"G\xd0\x18\x13\x82\xaa)\x18\x13\x83\xa8\xf8\x18\x13\x84\xa8\x9b\x18\x13\x85\xac\xa4\x18\x13\x87S\xa9\x18\x13\x88O\xdb" +
+	"\x18\x13\x89MV\x18\x13\x8aK\xdd\x18\x13\x8bJ3\x18\x13\x8cI\xed\x18\x13\xc0a\xa5\x18\x13\xc2U\xfe\x18\x13" +
+	"Î\xc2\x18\x13ĎT\x18\x13őY\x18\x13\xc7g\x1b\x18\x13\xc8d\xd5\x18\x13\xc9d\x16\x18\x13\xcad" +
+	"\f\x18\x13\xcbc\x90\x18\x13\xccdN\x18\x14\x00`\x9e\x18\x14\x02\x8e\xb2\x18\x14\x03S\x90\x18\x14\x04\x8a\x85\x18" +
+	"\x14\x05\x8d\x8b\x18\x14\ac\xe5\x18\x14\bd\x8d\x18\x14\tc\x0f\x18\x14\nc\x05\x18\x14\vb\x89\x18\x14\f" +
+	"cG\x18\x14@_\xb4\x18\x14B\x8e9\x18\x14C\x8aw\x18\x14DQ>\x18\x14E\x89\xc4\x18\x14G`\xb0" +
+	"\x18\x14HaX\x18\x14Ib\xe4\x18\x14Jb\x1b\x18\x14Ka\x9f\x18\x14Lb]\x18\x14\x80b/\x18\x14" +
+	"\x82\x91@\x18\x14\x83\x8d~\x18\x14\x84\x89\xc3\x18\x14\x85O\xa5\x18\x14\x87^\x7f\x18\x14\x88_&\x18\x14\x89`" +
+	"\xb2\x18\x14\x8ab\xf8\x18\x14\x8ba\xb4\x18\x14\x8cbr\x18\x14\xc0C\x12\x18\x14\xc2{\xd5\x18\x14\xc3y0\x18" +
+	"\x14\xc4r0\x18\x14\xc5h\xd8\x18\x14\xc7N~\x18\x14\xc8K;\x18\x14\xc9H\xb4\x18\x14\xcaG4\x18\x14\xcb" +
+	"FJ\x18\x14\xccEc\x18\x14\u0380\x00\x18\x14\xcfw\x16\x18\x14\xd0t\xa9\x18\x14\xd1nB\x18\x14\xd2e\xb4" +
+	"\x18\x15\x00YJ\x18\x15\x02hq\x18\x15\x03eF\x18\x15\x04b\x1c\x18\x15\x05_\xe7\x18\x15\a&\x0e\x18\x15" +
+	"\bT\x9b\x18\x15\tU\xde\x18\x15\nW\xbb\x18\x15\vY2\x18\x15\fZ]\x18\x15\rW\xad\x18\x15\x0eS" +
+	"W\x18\x15\x0fd\x88\x18\x15\x10a\xa7\x18\x15\x11^\xc6\x18\x15\x12\\\xc4\x18\x15\x13N\x9f\x18\x15@Z\x1c\x18" +
+	"\x15Bf5\x18\x15Ce\xfc\x18\x15Db\xd1\x18\x15E`\x9d\x18\x15GT\xa2\x18\x15H&j\x18\x15I" +
+	"UJ\x18\x15JWG\x18\x15KX\xc2\x18\x15LY\xef\x18\x15MXa\x18\x15NO\xc1\x18\x15Obr" +
+	"\x18\x15Pb=\x18\x15Q_]\x18\x15R]Z\x18\x15SK\x8a\x18\x15TS~\x18\x15\x80Yx\x18\x15" +
+	"\x82e\x80\x18\x15\x83d\x89\x18\x15\x84dk\x18\x15\x85b6\x18\x15\x87U\xef\x18\x15\x88UR\x18\x15\x89&" +
+	"\xe5\x18\x15\x8aVT\x18\x15\x8bW\xf5\x18\x15\x8cYI\x18\x15\x8dW\xc7\x18\x15\x8eMZ\x18\x15\x8fa\xc0\x18" +
+	"\x15\x90`\xe0\x18\x15\x91`\xc3\x18\x15\x92^\xc1\x18\x15\x93I \x18\x15\x94T\x9e\x18\x15\x95T\x12\x18\x15\xc0" +
+	"Z)\x18\x15\xc2e\x81\x18\x15\xc3d\x8a\x18\x15\xc4c\xad\x18\x15\xc5d\x88\x18\x15\xc7W\xd5\x18\x15\xc8WX" +
+	"\x18\x15\xc9V\\\x18\x15\xca']\x18\x15\xcbW\xee\x18\x15\xccY\xf9\x18\x15\xcdX\\\x18\x15\xceK\xeb\x18\x15" +
+	"\xcfa\xb2\x18\x15\xd0`\xd2\x18\x15\xd1`\n\x18\x15\xd2`\xcf\x18\x15\xd3G\xa8\x18\x15\xd4VK\x18\x15\xd5U" +
+	"\xdb\x18\x15\xd6T\xf9\x18\x16\x00Z!\x18\x16\x02e\x11\x18\x16\x03d\x19\x18\x16\x04c<\x18\x16\x05cQ\x18" +
+	"\x16\aYM\x18\x16\bX\xd3\x18\x16\tW\xfd\x18\x16\nW\xed\x18\x16\v'\t\x18\x16\fY\xf1\x18\x16\r" +
+	"XK\x18\x16\x0eJV\x18\x16\x0fa>\x18\x16\x10`^\x18\x16\x11_\x96\x18\x16\x12_\xa9\x18\x16\x13F\xbe" +
+	"\x18\x16\x14W\x95\x18\x16\x15W)\x18\x16\x16Vj\x18\x16\x17VY\x18\x16@[1\x18\x16Be\xcd\x18\x16" +
+	"Cd\xd5\x18\x16Dc\xf9\x18\x16Ed\r\x18\x16GZ\x82\x18\x16HZ\v\x18\x16IY[\x18\x16JZ" +
+	"\x01\x18\x16KY\xf0\x18\x16L(\x19\x18\x16MY@\x18\x16NJ\x02\x18\x16Oa\xe5\x18\x16Pa\x05\x18" +
+	"\x16Q`>\x18\x16R`P\x18\x16SE\xd9\x18\x16TX\xa6\x18\x16UX<\x18\x16VW\xa0\x18\x16W" +
+	"X5\x18\x16XX$\x18\x16\x8d*\xbd\x18\x16\x8eL0\x18\x16\x8fet\x18\x16\x90dq\x18\x16\x91c\x8b" +
+	"\x18\x16\x92e\xf8\x18\x16\x93G\x83\x18\x16\x94]C\x18\x16\x95^\x13\x18\x16\x96]s\x18\x16\x97^ \x18\x16" +
+	"\x98^\x1a\x18\x16\x99_$\x18\x16\xce]]\x18\x16ϫ\x89\x18\x16ЪP\x18\x16ѩ\xeb\x18\x16ҭ" +
+	"\xe0\x18\x16ӆo\x18\x16\xd4W\xbd\x18\x16\xd5T\x06\x18\x16\xd6Q\x94\x18\x16\xd7P*\x18\x16\xd8N\x92\x18" +
+	"\x16\xd9NI\x18\x17\x0fW\x87\x18\x17\x10\x90\x83\x18\x17\x11\x90\v\x18\x17\x12\x93\x03\x18\x17\x13~O\x18\x17\x14" +
+	"j\xc0\x18\x17\x15h\x88\x18\x17\x16g\xd3\x18\x17\x17g\xd1\x18\x17\x18gb\x18\x17\x19h\x18\x18\x17PU\x11" +
+	"\x18\x17Q\x8cU\x18\x17R\x8fM\x18\x17S{\xac\x18\x17Tg\x9b\x18\x17UhA\x18\x17Vf\xd1\x18\x17" +
+	"Wf\xcf\x18\x17Xf_\x18\x17Yg\x16\x18\x17\x91R\xb9\x18\x17\x92\x8b\x9e\x18\x17\x93t\xc2\x18\x17\x94d" +
+	"x\x18\x17\x95e\x1e\x18\x17\x96f\xa5\x18\x17\x97e\xe8\x18\x17\x98ex\x18\x17\x99f/\x18\x17\xd2Q$\x18" +
+	"\x17\xd3k\x98\x18\x17\xd4bT\x18\x17\xd5b\xfa\x18\x17\xd6d\x81\x18\x17\xd7f\xc1\x18\x17\xd8e\x8d\x18\x17\xd9" +
+	"fD\x18\x18\x13\x1eW\x18\x18\x14R\xc0\x18\x18\x15O\x8d\x18\x18\x16M\x13\x18\x18\x17K\x9c\x18\x18\x18J\xbb" +
+	"\x18\x18\x19I\xd5\x18\x18T)V\x18\x18UX\xa8\x18\x18VY\xe9\x18\x18W[\xc3\x18\x18X];\x18\x18" +
+	"Y^\\\x18\x18\x95)\xb7\x18\x18\x96YX\x18\x18\x97[R\x18\x18\x98\\\xcd\x18\x18\x99]\xf1\x18\x18\xd6*" +
+	"7\x18\x18\xd7Zd\x18\x18\xd8\\\x05\x18\x18\xd9]O\x18\x19\x17*\xb5\x18\x19\x18[\xff\x18\x19\x19]\xfc\x18" +
+	"\x19X*l\x18\x19Y]\xf7\x18\x19\x99+u\x18!\xc0Q\xda\x18!\xc1j)\x18!\xc3\\\x7f\x18!\xc4" +
+	"Y?\x18!\xc5V\xf9\x18\"\x00R\xb7\x18\"\x01h\x81\x18\"\x03]=\x18\"\x04Y\xfc\x18\"\x05W\xb7" +
+	"\x18\"\aL\xf9\x18\"@R\x06\x18\"Ag\xc4\x18\"C[\xbd\x18\"D[\x9e\x18\"EYX\x18\"" +
+	"GNK\x18\"HM\xbd\x18\"\x80R\xb0\x18\"\x81g\xbd\x18\"\x83[\xb6\x18\"\x84Z\xd3\x18\"\x85[" +
+	"\xb3\x18\"\x87P8\x18\"\x88O\xc9\x18\"\x89N\xc9\x18\"\xc0R\x9d\x18\"\xc1gA\x18\"\xc3[:\x18" +
+	"\"\xc4ZW\x18\"\xc5Zl\x18\"\xc7Q\xb4\x18\"\xc8QI\x18\"\xc9Po\x18\"\xcaP\\\x18#\x00" +
+	"S\xb4\x18#\x01h\x04\x18#\x03[\xfd\x18#\x04[\x1b\x18#\x05[0\x18#\aR\xf0\x18#\bR\x87" +
+	"\x18#\tQ\xd4\x18#\nR~\x18#\vRk\x18#@+\xad\x18#Ap\x84\x18#Ce[\x18#" +
+	"Dd\x7f\x18#Eg\x11\x18#G\\\xc3\x18#H]\x9b\x18#I\\\xee\x18#J]\x94\x18#K]" +
+	"\x83\x18#L^\x93\x18#\x80n\xe7\x18#\x81\x7f\f\x18#\x83\x9d\x87\x18#\x84\x9d\x1d\x18#\x85\xa0\xad\x18" +
+	"#\x87t4\x18#\x88r\x8d\x18#\x89q\xce\x18#\x8aq\xc4\x18#\x8bqH\x18#\x8cr\x05\x18#\xc0" +
+	"K(\x18#\xc1\xaf\xbb\x18#è\xa0\x18#ĨH\x18#ŬQ\x18#\xc7W\x8d\x18#\xc8S3" +
+	"\x18#\xc9P\xae\x18#\xcaO5\x18#\xcbM\x8b\x18#\xccME\x18$\x00c\xbc\x18$\x01\x9d\x81\x18$" +
+	"\x03U\x99\x18$\x04\x8e \x18$\x05\x91%\x18$\af\xfe\x18$\bg\xae\x18$\tf1\x18$\nf" +
+	"'\x18$\ve\xab\x18$\ffh\x18$@b\xd2\x18$A\x9d\v\x18$C\x8e\x12\x18$DSG\x18" +
+	"$E\x8d^\x18$Gc\xc9\x18$Hdz\x18$If\x06\x18$Je=\x18$Kd\xc1\x18$L" +
+	"e~\x18$\x80ee\x18$\x81\xa0\xa1\x18$\x83\x91\x19\x18$\x84\x8d]\x18$\x85Q\xae\x18$\x87a\x98" +
+	"\x18$\x88bH\x18$\x89c\xd4\x18$\x8af\x19\x18$\x8bd\xd6\x18$\x8ce\x93\x18$\xc0GO\x18$" +
+	"\xc1\x8f9\x18$\xc3|\x06\x18$\xc4s\x80\x18$\xc5j\xcc\x18$\xc7N\xce\x18$\xc8Ok\x18$\xc9L" +
+	"\xe7\x18$\xcaKi\x18$\xcbJ\x82\x18$\xccI\x9e\x18$π\x00\x18$\xd0w?\x18$\xd1ov\x18" +
+	"$\xd2g{\x18%\x00\\\xb0\x18%\x01u\xbd\x18%\x03h\x82\x18%\x04eX\x18%\x05c#\x18%\a" +
+	"*/\x18%\bX\x01\x18%\tYD\x18%\n[\"\x18%\v\\\x98\x18%\f]\xc3\x18%\rZ\xba" +
+	"\x18%\x0ep\xab\x18%\x0fV\xe1\x18%\x10d\x90\x18%\x11a\xaf\x18%\x12_\xad\x18%\x13N\xe1\x18%" +
+	"@]\x89\x18%At\x16\x18%Ci7\x18%Df\f\x18%Ec\xd7\x18%GW\xff\x18%H*" +
+	"\xb1\x18%IX\xb7\x18%JZ\xb4\x18%K\\.\x18%L]\\\x18%M[|\x18%No#\x18" +
+	"%OR\xcd\x18%Pe)\x18%QbI\x18%R`F\x18%SOU\x18%TV\x8b\x18%\x80" +
+	"\\\xe5\x18%\x81sa\x18%\x83g\xc4\x18%\x84g\xa6\x18%\x85eq\x18%\x87YK\x18%\x88X\xbf" +
+	"\x18%\x89+,\x18%\x8aY\xc1\x18%\x8b[b\x18%\x8c\\\xb5\x18%\x8dZ\xe2\x18%\x8enp\x18%" +
+	"\x8fPf\x18%\x90c\xcb\x18%\x91c\xaf\x18%\x92a\xac\x18%\x93L\xee\x18%\x94W\xab\x18%\x95W" +
+	"-\x18%\xc0]\x95\x18%\xc1sb\x18%\xc3g\xc5\x18%\xc4f\xe8\x18%\xc5g\xc3\x18%\xc7[2\x18" +
+	"%\xc8Z\xc5\x18%\xc9Y\xc8\x18%\xca+\xa4\x18%\xcb[Z\x18%\xcc]e\x18%\xcd[w\x18%\xce" +
+	"nb\x18%\xcfN\xf7\x18%\xd0c\xbd\x18%\xd1b\xf6\x18%\xd2c\xba\x18%\xd3Ky\x18%\xd4YW" +
+	"\x18%\xd5X\xf6\x18%\xd6X\x14\x18&\x00]\x8e\x18&\x01r\xf2\x18&\x03gT\x18&\x04fw\x18&" +
+	"\x05f\x8c\x18&\a\\\xa9\x18&\b\\@\x18&\t[j\x18&\n[Z\x18&\v+P\x18&\f]" +
+	"^\x18&\r[f\x18&\x0em\xef\x18&\x0fMa\x18&\x10cJ\x18&\x11b\x82\x18&\x12b\x95\x18" +
+	"&\x13J\x92\x18&\x14Z\xa2\x18&\x15ZD\x18&\x16Y\x85\x18&\x17Yt\x18&@^\x9d\x18&A" +
+	"s\xae\x18&Ch\x10\x18&Dg4\x18&EgH\x18&G]\xde\x18&H]w\x18&I\\\xc8" +
+	"\x18&J]n\x18&K]\\\x18&L,`\x18&M\\[\x18&Nn\x96\x18&OM\x0e\x18&" +
+	"Pc\xf1\x18&Qc*\x18&Rc<\x18&SI\xaf\x18&T[\xb2\x18&U[W\x18&VZ" +
+	"\xbb\x18&W[P\x18&X[?\x18&\x8d.\xe2\x18&\x8era\x18&\x8fOh\x18&\x90gq\x18" +
+	"&\x91f\x8a\x18&\x92i\x0f\x18&\x93K\x9c\x18&\x94`\x8b\x18&\x95a_\x18&\x96`\xbf\x18&\x97" +
+	"am\x18&\x98ag\x18&\x99bq\x18&\xce\x7f\xf7\x18&ϱ\x02\x18&О\xcc\x18&ўY" +
+	"\x18&ҡ\xd8\x18&Ӑ\xf0\x18&\xd4w\x8f\x18&\xd5u\xe9\x18&\xd6u4\x18&\xd7u2\x18&" +
+	"\xd8t\xc2\x18&\xd9uy\x18'\x0f_,\x18'\x10\xa9\xd3\x18'\x11\xa9r\x18'\x12\xadg\x18'\x13\x86" +
+	"i\x18'\x14[\x89\x18'\x15W>\x18'\x16T\xcc\x18'\x17Sc\x18'\x18Q\xca\x18'\x19Q\x81\x18" +
+	"'PV\xf8\x18'Q\x8f\xbf\x18'R\x92\xb7\x18'S~D\x18'Tj\x9d\x18'UkD\x18'V" +
+	"i\xd4\x18'Wi\xd2\x18'Xib\x18'Yj\x19\x18'\x91T\xa0\x18'\x92\x8f\b\x18'\x93u\xdb" +
+	"\x18'\x94gz\x18'\x95h!\x18'\x96i\xa8\x18'\x97h\xec\x18'\x98h|\x18'\x99i2\x18'" +
+	"\xd2S\v\x18'\xd3mQ\x18'\xd4eV\x18'\xd5e\xfd\x18'\xd6g\x84\x18'\xd7i\xc4\x18'\xd8h" +
+	"\x90\x18'\xd9iG\x18(\x13\"\xbc\x18(\x14S\x06\x18(\x15S\x99\x18(\x16Q\"\x18(\x17O\xad\x18" +
+	"(\x18N\xcf\x18(\x19M\xec\x18(T-`\x18(U[\xf0\x18(V]1\x18(W_\v\x18(X" +
+	"`\x83\x18(Ya\xa4\x18(\x95-\xdc\x18(\x96\\\xa5\x18(\x97^\x9e\x18(\x98`\x1a\x18(\x99a>" +
+	"\x18(\xd6.\\\x18(\xd7]\xb0\x18(\xd8_Q\x18(\xd9`\x9b\x18)\x17.\xda\x18)\x18_L\x18)" +
+	"\x19aI\x18)X.\x91\x18)YaC\x18)\x99/\x9a\x181\xc0U\xb4\x181\xc1n\a\x181\xc2l" +
+	"I\x181\xc4\\\xa3\x181\xc5Z^\x182\x00VB\x182\x01k\xfa\x182\x02i\xab\x182\x04]A\x18" +
+	"2\x05Z\xfc\x182\aP>\x182@U\xa2\x182Ak8\x182Bh\xe9\x182D^\xe6\x182E" +
+	"\\\xa1\x182GR%\x182HQ$\x182\x80VK\x182\x81k1\x182\x82h\xe3\x182\x84^\x1c" +
+	"\x182\x85^\xfb\x182\x87T\x12\x182\x88S1\x182\x89Rd\x182\xc0V8\x182\xc1j\xb5\x182" +
+	"\xc2hf\x182\xc4]\x9f\x182\xc5]\xb4\x182\xc7U\x8e\x182\xc8T\xb0\x182\xc9T\n\x182\xcaS" +
+	"\xf7\x183\x00WO\x183\x01kx\x183\x02i*\x183\x04^c\x183\x05^x\x183\aV\xca\x18" +
+	"3\bV\x12\x183\tUo\x183\nV\x19\x183\vV\x06\x183@/\xf4\x183As\xba\x183B" +
+	"q\xa0\x183Dg\xb5\x183EjH\x183G`\x88\x183H`\xf4\x183I`W\x183J`\xfd" +
+	"\x183K`\xeb\x183La\xfc\x183\x80r&\x183\x81\x81\xef\x183\x82\xa8 \x183\x84\xa0G\x183" +
+	"\x85\xa3\xdb\x183\x87w\xfe\x183\x88u\xd5\x183\x89u\x12\x183\x8au\a\x183\x8bt\x8c\x183\x8cu" +
+	"I\x183\xc0o\xdd\x183\xc1\xa7\xef\x183\xc2~\x1c\x183Ĝ\xdd\x183Šq\x183\xc7v;\x18" +
+	"3\xc8s\x8b\x183\xc9r\xc8\x183\xcar\xbe\x183\xcbrB\x183\xccr\xff\x184\x00N\x82\x184\x01" +
+	"\xb0\x14\x184\x02\xab'\x184\x04\xa7\xf3\x184\x05\xac\x00\x184\aW\xd6\x184\bW\x19\x184\tT\b" +
+	"\x184\nR\x8f\x184\vP\xe6\x184\fP\x9f\x184@e\xef\x184A\xa0\x15\x184B\x9c\xda\x184" +
+	"DUP\x184E\x90\xf9\x184Gg\x10\x184Hg\x8e\x184Ii#\x184JhZ\x184Kg" +
+	"\xde\x184Lh\x9c\x184\x80h\x83\x184\x81\xa3\xae\x184\x82\xa0t\x184\x84\x90\xf8\x184\x85S\xb7\x18" +
+	"4\x87d\xde\x184\x88e]\x184\x89f\xf2\x184\x8ai7\x184\x8bg\xf3\x184\x8ch\xb1\x184\xc0" +
+	"K\x88\x184\xc1\x91\xdf\x184\u008f\t\x184\xc4u\xe5\x184\xc5l+\x184\xc7S\xbb\x184\xc8O\xba" +
+	"\x184\xc9Q\x15\x184\xcaO\x99\x184\xcbN\xb5\x184\xccM\xd4\x184Ѐ\x00\x184\xd1q\xa6\x184" +
+	"\xd2h\xbb\x185\x00`Y\x185\x01yb\x185\x02w\xce\x185\x04h\xa2\x185\x05fn\x185\a," +
+	"I\x185\b[\x16\x185\t\\\xed\x185\n^\xca\x185\v`A\x185\fal\x185\r^$\x18" +
+	"5\x0et\x19\x185\x0fr\xa7\x185\x10W(\x185\x11d\xb2\x185\x12b\xaf\x185\x13S`\x185@" +
+	"`\xeb\x185Aw_\x185BuE\x185DiD\x185Eg\x0f\x185G[6\x185H.\xd3" +
+	"\x185I[\xf7\x185J]\xf4\x185K_n\x185L`\xbe\x185M^\x85\x185Nr\x1c\x185" +
+	"Op-\x185PVW\x185Qe.\x185Rc+\x185SO\x96\x185TYp\x185\x80`" +
+	"M\x185\x81v\x9c\x185\x82t\x82\x185\x84j\xdd\x185\x85h\xa8\x185\x87]\x10\x185\x88[\xf5\x18" +
+	"5\x89/t\x185\x8a])\x185\x8b^\xca\x185\x8c`\x1d\x185\x8d]\xf9\x185\x8eqe\x185\x8f" +
+	"ov\x185\x90Sr\x185\x91f\x97\x185\x92d\x95\x185\x93P\xb9\x185\x94[\x16\x185\x95Z\x17" +
+	"\x185\xc0`\xfe\x185\xc1v\x9d\x185\xc2t\x83\x185\xc4j\x1f\x185\xc5j\xfa\x185\xc7^\xf7\x185" +
+	"\xc8]\xfb\x185\xc9]1\x185\xca/\xec\x185\xcb^\xc2\x185\xcc`\xce\x185\xcd^\x8e\x185\xceq" +
+	"W\x185\xcfoh\x185\xd0R\x03\x185\xd1e\xde\x185\xd2f\xa3\x185\xd3OF\x185\xd4\\\xc2\x18" +
+	"5\xd5[\xe0\x185\xd6[+\x186\x00`\xf6\x186\x01v,\x186\x02t\x12\x186\x04i\xae\x186\x05" +
+	"i\xc2\x186\a`o\x186\b_v\x186\t^\xd2\x186\n^\xc2\x186\v/\x97\x186\f`\xc6" +
+	"\x186\r^}\x186\x0ep\xe3\x186\x0fn\xf4\x186\x10Pm\x186\x11ej\x186\x12e}\x186" +
+	"\x13Na\x186\x14^\f\x186\x15].\x186\x16\\\x9c\x186\x17\\\x8b\x186@b\x06\x186Av" +
+	"\xe9\x186Bt\xcf\x186Djk\x186Ej\x7f\x186Ga\xa3\x186H`\xd0\x186I`0\x18" +
+	"6J`\xd6\x186K`\xc5\x186L0\xa8\x186M_r\x186Nq\x8b\x186Oo\x9c\x186P" +
+	"P\x1a\x186Qf\x12\x186Rf$\x186SM\x81\x186T_\x1d\x186U^`\x186V]\xd2" +
+	"\x186W^g\x186X^V\x186\x8d3\a\x186\x8eu\x81\x186\x8fsB\x186\x90R\xa2\x186" +
+	"\x91i\x89\x186\x92l\x0e\x186\x93O\xb1\x186\x94d\x12\x186\x95d\xa3\x186\x96d\a\x186\x97d" +
+	"\xb5\x186\x98d\xaf\x186\x99e\xb9\x186\u0382\xc5\x186Ϩ\xf5\x186бP\x186ѡK\x18" +
+	"6Ҥ\xcf\x186ӓ\x7f\x186\xd4{\x1a\x186\xd5y\x1d\x186\xd6xX\x186\xd7xV\x186\xd8" +
+	"w\xe6\x186\xd9x\x9d\x187\x0f~\xde\x187\x10\xacL\x187\x11\x9e\x01\x187\x12\xa1\x84\x187\x13\x90\x8a" +
+	"\x187\x14y\\\x187\x15v\xde\x187\x16v\x19\x187\x17v\x17\x187\x18u\xa7\x187\x19v^\x187" +
+	"Pa(\x187Q\xa8\xf7\x187R\xac\xf0\x187S\x86e\x187T[\xa4\x187U[\r\x187VX" +
+	"\a\x187WV\x9d\x187XU\x04\x187YT\xbb\x187\x91V\x88\x187\x92\x92q\x187\x93x\x04\x18" +
+	"7\x94j\x96\x187\x95k\x1e\x187\x96l\xa7\x187\x97k\xeb\x187\x98k{\x187\x99l2\x187\xd2" +
+	"T\xf3\x187\xd3nx\x187\xd4hr\x187\xd5h\xfa\x187\xd6j\x83\x187\xd7l\xc3\x187\xd8k\x8f" +
+	"\x187\xd9lF\x188\x13'!\x188\x14W\xad\x188\x15S\xde\x188\x16U,\x188\x17S\xbb\x188" +
+	"\x18R\xdf\x188\x19Q\xfe\x188T/W\x188U^\xee\x188V`\xb7\x188Wb\x92\x188Xd" +
+	"\t\x188Ye+\x188\x951\xe5\x188\x96_\xc6\x188\x97a\xc0\x188\x98c<\x188\x99d\x81\x18" +
+	"8\xd62\x81\x188\xd7`\xf8\x188\xd8b\x99\x188\xd9c\xe3\x189\x172\xff\x189\x18b\x94\x189\x19" +
+	"d\x91\x189X2\xb5\x189Yd\x8b\x189\x993\xbf\x18A\xc0Yu\x18A\xc1q\xe2\x18A\xc2p#" +
+	"\x18A\xc3lg\x18A\xc5]\xb9\x18B\x00Z\x18\x18B\x01o\xd4\x18B\x02m\x85\x18B\x03ly\x18B" +
+	"\x05^]\x18B\aS\xbb\x18B@Y\x05\x18BAn\x8a\x18BBl;\x18BCj\x83\x18BE_" +
+	"\xc6\x18BGUK\x18BHTJ\x18B\x80Y\xe2\x18B\x81n\xa1\x18B\x82lS\x18B\x83j\x9a\x18" +
+	"B\x85b?\x18B\x87W\xd3\x18B\x88W\a\x18B\x89U\v\x18B\xc0Y\xcf\x18B\xc1n%\x18B\xc2" +
+	"k\xd6\x18B\xc3j\x1e\x18B\xc5`\xf8\x18B\xc7YN\x18B\xc8X\x86\x18B\xc9V\xb1\x18B\xcaW\x8e" +
+	"\x18C\x00Z\xe6\x18C\x01n\xe8\x18C\x02l\x9a\x18C\x03j\xe2\x18C\x05a\xbc\x18C\aZ\x8b\x18C" +
+	"\bY\xe8\x18C\tX\xd3\x18C\nY\xb0\x18C\vY\x9d\x18C@4<\x18CAv\xf1\x18CBt" +
+	"\xd6\x18CCsP\x18CEm{\x18CGd4\x18CHd\xb5\x18CIc\x89\x18CJda\x18" +
+	"CKdO\x18CLe`\x18C\x80uf\x18C\x81\x84\xd4\x18C\x82\xa9x\x18C\x83\xa9\xc8\x18C\x85" +
+	"\xa7\x05\x18C\x87{\xc3\x18C\x88y\x9a\x18C\x89x3\x18C\x8axG\x18C\x8bw\xcb\x18C\x8cx\x88" +
+	"\x18C\xc0s\x1c\x18C\xc1\xa9F\x18C\u0081\x01\x18CæX\x18Cţ\x9b\x18C\xc7z\x00\x18C" +
+	"\xc8wP\x18C\xc9u\xea\x18C\xcau\xfd\x18C\xcbu\x81\x18C\xccv?\x18D\x00qi\x18D\x01\xa9" +
+	"l\x18D\x02\xa6+\x18D\x03}\xf5\x18D\x05\xa0=\x18D\avF\x18D\bv?\x18D\tt6\x18" +
+	"D\ntJ\x18D\vs\xce\x18D\ft\x8b\x18D@Q\xde\x18DA\xb0\xb8\x18DB\xab\xca\x18DC" +
+	"\xa9\xd1\x18DE\xab\xaf\x18DGX \x18DHWd\x18DIW\xce\x18DJU\xeb\x18DKTA" +
+	"\x18DLS\xfb\x18D\x80k\x9c\x18D\x81\xa6\xb9\x18D\x82\xa3~\x18D\x83\xa0M\x18D\x85U\xc0\x18D" +
+	"\x87h\x1b\x18D\x88h\x9f\x18D\x89i\xe8\x18D\x8alP\x18D\x8bk\r\x18D\x8ck\xca\x18D\xc0O" +
+	"\xbd\x18D\xc1\x98\xdf\x18D\u0097\x8e\x18DÕ)\x18D\xc5n0\x18D\xc7U\xb3\x18D\xc8T\xa5\x18" +
+	"D\xc9Qb\x18D\xcaS\xc6\x18D\xcbR\xe5\x18D\xccR\x06\x18Dр\x00\x18D\xd2j\x94\x18E\x00" +
+	"c\xe9\x18E\x01}\x03\x18E\x02{o\x18E\x03w\xe9\x18E\x05i\xaf\x18E\a-\xba\x18E\b^t" +
+	"\x18E\t_\xe4\x18E\nbZ\x18E\vc\xd1\x18E\fd\xfb\x18E\rax\x18E\x0ew\x84\x18E" +
+	"\x0fv\x12\x18E\x10r\xc4\x18E\x11Wn\x18E\x12e\xa9\x18E\x13U,\x18E@d\x90\x18EA{" +
+	"\x00\x18EBx\xe6\x18ECx\x01\x18EEjV\x18EG^\x97\x18EH0\xec\x18EI^\xee\x18" +
+	"EJa\x98\x18EKc\x13\x18ELdc\x18EMa\xec\x18ENu\x87\x18EOs\x98\x18EP" +
+	"r\xc2\x18EQV\x9e\x18ERf*\x18EST\x14\x18ET\\\x88\x18E\x80c\x89\x18E\x81y\xbf" +
+	"\x18E\x82w\xa5\x18E\x83v\x1f\x18E\x85k\xc0\x18E\x87`(\x18E\x88_\r\x18E\x893s\x18E" +
+	"\x8a_\xad\x18E\x8baN\x18E\x8ccY\x18E\x8d`\xdf\x18E\x8et;\x18E\x8frL\x18E\x90p" +
+	"\xe2\x18E\x91V\xdd\x18E\x92g^\x18E\x93P\xf9\x18E\x94]\xdf\x18E\x95\\\xe0\x18E\xc0db\x18" +
+	"E\xc1y\xd4\x18E\xc2w\xb9\x18E\xc3v3\x18E\xc5n,\x18E\xc7b\xa3\x18E\xc8a\xbc\x18E\xc9" +
+	"_\xad\x18E\xca43\x18E\xcbb'\x18E\xccd2\x18E\xcda\xa1\x18E\xcetG\x18E\xcfrX" +
+	"\x18E\xd0p\xee\x18E\xd1U\x0f\x18E\xd2i\x87\x18E\xd3S\x0f\x18E\xd4`\x15\x18E\xd5_G\x18E" +
+	"\xd6]k\x18F\x00dZ\x18F\x01yc\x18F\x02wI\x18F\x03u\xc2\x18F\x05l\xf5\x18F\ad" +
+	"\x1b\x18F\bc7\x18F\taN\x18F\nb'\x18F\v3\xdf\x18F\fd*\x18F\ra\x90\x18" +
+	"F\x0es\xd4\x18F\x0fq\xe5\x18F\x10pz\x18F\x11Sy\x18F\x12ha\x18F\x13R-\x18F\x14" +
+	"a`\x18F\x15`\x94\x18F\x16^\xdc\x18F\x17_\x9e\x18F@ej\x18FAz \x18FBx\x05" +
+	"\x18FCv\x7f\x18FEm\xb2\x18FGeP\x18FHd\x91\x18FIcb\x18FJd:\x18F" +
+	"Kd)\x18FL4\xef\x18FMb\x86\x18FNt{\x18FOr\x8c\x18FPq!\x18FQS" +
+	"&\x18FRi\t\x18FSQO\x18FTbp\x18FUa\xc7\x18FV`\xb8\x18FWaz\x18" +
+	"FXai\x18F\x8d7,\x18F\x8ex\xa1\x18F\x8fvc\x18F\x90t\xb7\x18F\x91U\xde\x18F\x92" +
+	"o\t\x18F\x93S\xc2\x18F\x94g\x80\x18F\x95h%\x18F\x96g%\x18F\x97g\xf9\x18F\x98g\xf3" +
+	"\x18F\x99h\xfd\x18F΅\x95\x18FϪ=\x18FЪd\x18Fѱ\xe6\x18Fҧ\xc1\x18F" +
+	"ӚW\x18F\xd4~\xa1\x18F\xd5|\xa3\x18F\xd6{f\x18F\xd7{v\x18F\xd8{\x06\x18F\xd9{" +
+	"\xbd\x18G\x0f\x81\xaf\x18G\x10\xa7\x14\x18G\x11\xac\xe2\x18G\x12\xa4w\x18G\x13\x98\xe0\x18G\x14|\xe2\x18" +
+	"G\x15zd\x18G\x16y'\x18G\x17y7\x18G\x18x\xc8\x18G\x19y~\x18GP~\x8d\x18GQ" +
+	"\xaa\xc8\x18GR\xa18\x18GS\x96[\x18GTyN\x18GUyT\x18GVw|\x18GWw\x8c" +
+	"\x18GXw\x1c\x18GYw\xd3\x18G\x91cH\x18G\x92\xacy\x18G\x93\x86c\x18G\x94[\xc1\x18G" +
+	"\x95[*\x18G\x96[\xb5\x18G\x97Y\xd9\x18G\x98X@\x18G\x99W\xf7\x18G\xd2V\xda\x18G\xd3p" +
+	"C\x18G\xd4k\x85\x18G\xd5l\x12\x18G\xd6md\x18G\xd7o\xbe\x18G\xd8n\x8a\x18G\xd9oA\x18" +
+	"H\x13+\x84\x18H\x14Yj\x18H\x15X\x83\x18H\x16Uo\x18H\x17W\xc3\x18H\x18V\xea\x18H\x19" +
+	"V\f\x18HT0\xaa\x18HUb \x18HVc\x97\x18HWf\x00\x18HXgx\x18HYh\x99" +
+	"\x18H\x953\xdd\x18H\x96b\xa7\x18H\x97eC\x18H\x98f\xbe\x18H\x99h\x04\x18H\xd66h\x18H" +
+	"\xd7cb\x18H\xd8e\x04\x18H\xd9g\x01\x18I\x177$\x18I\x18e\xd8\x18I\x19g\xd5\x18IX6" +
+	"\xda\x18IYg\xcf\x18I\x997\xe4\x18Q\xc0]\x11\x18Q\xc1u\xc0\x18Q\xc2s\xe6\x18Q\xc3p*\x18" +
+	"Q\xc4ln\x18R\x00]\xb4\x18R\x01s\xb5\x18R\x02qJ\x18R\x03p>\x18R\x04l\x82\x18R\a" +
+	"U\xae\x18R@\\\xb7\x18RArk\x18RBp\x00\x18RCnH\x18RDm_\x18RGW\xc0" +
+	"\x18RHV\xd5\x18R\x80\\e\x18R\x81q>\x18R\x82n\xd3\x18R\x83m\x1b\x18R\x84k\x7f\x18R" +
+	"\x87Y[\x18R\x88X\x8f\x18R\x89V\x93\x18R\xc0]B\x18R\xc1q\x9c\x18R\xc2o1\x18R\xc3m" +
+	"y\x18R\xc4k\xdd\x18R\xc7\\\x1e\x18R\xc8[V\x18R\xc9Y\x96\x18R\xcaV\xb6\x18S\x00^X\x18" +
+	"S\x01r_\x18S\x02o\xf5\x18S\x03n<\x18S\x04l\xa0\x18S\a]Z\x18S\b\\\xb7\x18S\t" +
+	"[\xb8\x18S\n[f\x18S\v\\C\x18S@8\x1b\x18SA}O\x18SBz\x84\x18SCx\xe2" +
+	"\x18SDww\x18SGg\xc8\x18SHhH\x18SIg0\x18SJf\xc2\x18SKg\x9b\x18S" +
+	"Lh\xab\x18S\x80{\xc9\x18S\x81\x88\x18\x18S\x82\xab\xbe\x18S\x83\xab4\x18S\x84\xab\xef\x18S\x87\x7f" +
+	"\x95\x18S\x88}o\x18S\x89|\b\x18S\x8az\xc1\x18S\x8b{\x1a\x18S\x8c{\xd8\x18S\xc0x\xd0\x18" +
+	"S\xc1\xab\x8d\x18S\u0084(\x18Sç\xaf\x18SĨj\x18S\xc7}\xb7\x18S\xc8{\n\x18S\xc9" +
+	"y\xa3\x18S\xcax\\\x18S\xcbx\xb5\x18S\xccys\x18T\x00w\x01\x18T\x01\xaa\xd9\x18T\x02\xa7\x82" +
+	"\x18T\x03\x81\x1b\x18T\x04\xa5\x7f\x18T\ay\xfd\x18T\by\xf9\x18T\tw\xf0\x18T\nv\xa8\x18T" +
+	"\vw\x02\x18T\fw\xbf\x18T@uj\x18TA\xabm\x18TB\xa8\x16\x18TC\xa5U\x18TD~" +
+	"?\x18TGvD\x18THv@\x18TIw\x04\x18TJu\x11\x18TKuk\x18TLv)\x18" +
+	"T\x80U\x9d\x18T\x81\xb60\x18T\x82\xb1@\x18T\x83\xafG\x18T\x84\xad\xe2\x18T\x87W\xf2\x18T\x88" +
+	"W8\x18T\x89W\xa2\x18T\x8aXc\x18T\x8bW#\x18T\x8cV\xdc\x18T\xc0S\xe4\x18T\xc1\xa26" +
+	"\x18T\u00a0A\x18TÞ\xe2\x18TĜ\xdd\x18T\xc7V\x03\x18T\xc8U\x98\x18T\xc9UH\x18T" +
+	"\xcaS\r\x18T\xcbV\r\x18T\xccU1\x18TҀ\x00\x18U\x00gU\x18U\x01\x80\xaa\x18U\x02~" +
+	"\xf8\x18U\x03{r\x18U\x04w\xec\x18U\a-\xf1\x18U\b`T\x18U\tb@\x18U\nc\xc4\x18" +
+	"U\vft\x18U\fg\x9f\x18U\rd\xab\x18U\x0ez\xf2\x18U\x0fyh\x18U\x10v\x1a\x18U\x11" +
+	"r\xcc\x18U\x12WH\x18U\x13Uy\x18U@g\xfc\x18UA~\xa9\x18UB|q\x18UC{\x8c" +
+	"\x18UDx\x06\x18UG`y\x18UH1?\x18UIa_\x18UJc\x02\x18UKe\xb6\x18U" +
+	"Lg\x06\x18UMe\x1f\x18UNx\xf7\x18UOv\xf0\x18UPv\x1b\x18UQr\xcd\x18URV" +
+	"z\x18UST\xf5\x18UT^C\x18U\x80g\v\x18U\x81}h\x18U\x82{0\x18U\x83y\xaa\x18" +
+	"U\x84x\xe7\x18U\x87b\x89\x18U\x88a\x82\x18U\x894n\x18U\x8aa\x17\x18U\x8bd\x06\x18U\x8c" +
+	"f\x12\x18U\x8dd%\x18U\x8ew\xac\x18U\x8fu\xa5\x18U\x90t:\x18U\x91s\x83\x18U\x92V\xb9" +
+	"\x18U\x93T\x8c\x18U\x94`\x0f\x18U\x95_#\x18U\xc0f\xc3\x18U\xc1|F\x18U\xc2z\x0f\x18U" +
+	"\xc3x\x88\x18U\xc4w\x1d\x18U\xc7d&\x18U\xc8c?\x18U\xc9a0\x18U\xca68\x18U\xcba" +
+	"[\x18U\xcce\xca\x18U\xcdc\xc1\x18U\xcev~\x18U\xcftw\x18U\xd0s\f\x18U\xd1q\xb9\x18" +
+	"U\xd2WM\x18U\xd3Re\x18U\xd4ar\x18U\xd5`\xa4\x18U\xd6^\xc8\x18V\x00g\x9b\x18V\x01" +
+	"|\xa0\x18V\x02zi\x18V\x03x\xe3\x18V\x04wx\x18V\af\xdb\x18V\be\xf7\x18V\td#" +
+	"\x18V\na[\x18V\v7\a\x18V\ff\xa3\x18V\rd\x83\x18V\x0ev\xca\x18V\x0ft\xc3\x18V" +
+	"\x10sX\x18V\x11r\x06\x18V\x12V\x16\x18V\x13U\f\x18V\x14c\xde\x18V\x15c\x13\x18V\x16a" +
+	"n\x18V\x17^\xe0\x18V@h\xab\x18VA}]\x18VB{&\x18VCy\xa0\x18VDx4\x18" +
+	"VGh\x10\x18VHgQ\x18VIf7\x18VJe\xc9\x18VKf\xa1\x18VL8\x18\x18VM" +
+	"ex\x18VNwr\x18VOuk\x18VPt\x00\x18VQr\xad\x18VRU\xc3\x18VST1" +
+	"\x18VTd\xef\x18VUdE\x18VVcJ\x18VWb\xe5\x18VXc\xa7\x18V\x8d:\xf6\x18V" +
+	"\x8e~\xe1\x18V\x8f{\xf6\x18V\x90z0\x18V\x91x\xa1\x18V\x92Y\x82\x18V\x93W\xd2\x18V\x94j" +
+	"\xd9\x18V\x95k~\x18V\x96j\x92\x18V\x97jN\x18V\x98k\"\x18V\x99l+\x18VΈ\xce\x18" +
+	"VϬs\x18VЫ\xc3\x18VѬV\x18VҷC\x18Vӣ\x88\x18VԂ8\x18V\xd5" +
+	"\x80<\x18V\xd6~\xff\x18V\xd7}\xe3\x18V\xd8~9\x18V\xd9~\xf0\x18W\x0f\x84\xcc\x18W\x10\xa8_" +
+	"\x18W\x11\xa8\xf2\x18W\x12\xb2?\x18W\x13\xa1r\x18W\x14\x80_\x18W\x15}\xe3\x18W\x16|\xa5\x18W" +
+	"\x17{\x8a\x18W\x18{\xe0\x18W\x19|\x96\x18WP\x81\xab\x18WQ\xa6 \x18WR\xb0&\x18WS\x9f" +
+	"\xef\x18WT|\xca\x18WU|\xd2\x18WVz\xfa\x18WWy\xde\x18WXz4\x18WYz\xeb\x18" +
+	"W\x91~\xbb\x18W\x92\xae\xa1\x18W\x93\x9d\xca\x18W\x94y8\x18W\x95y@\x18W\x96z\x0f\x18W\x97" +
+	"xO\x18W\x98x\xa5\x18W\x99y\\\x18W\xd2e\x10\x18Wӆb\x18W\xd4[j\x18W\xd5Z\xd4" +
+	"\x18W\xd6[`\x18W\xd7\\C\x18W\xd8[\x06\x18W\xd9Z\xbd\x18X\x13.\xcb\x18X\x14Y\x89\x18X" +
+	"\x15YC\x18X\x16Y\x17\x18X\x17W\t\x18X\x18Y\xf6\x18X\x19Y\x1b\x18XT0\xcb\x18XUc" +
+	"\xe0\x18XVe\xd0\x18XWg_\x18XXj\x01\x18XYk\"\x18X\x954\x19\x18X\x96d\xf3\x18" +
+	"X\x97f\xa1\x18X\x98iG\x18X\x99j\x8d\x18X\xd67I\x18X\xd7d\xc1\x18X\xd8g\xa1\x18X\xd9" +
+	"i\x9e\x18Y\x179\x1e\x18Y\x18e\f\x18Y\x19iZ\x18YX9\xe9\x18YYj.\x18Y\x99:\xf2" +
+	"\x1c\x02\x01\xa8F\x1c\x02\x02\xa8$\x1c\x02\x03\xa7\xb7\x1c\x02\x04\xa4s\x1c\x02\x05\xa1T\x1c\x02\x06\x9f/\x1c\x02" +
+	"A\xa77\x1c\x02B\xa7\x16\x1c\x02C\xa5\xe5\x1c\x02D\xa6[\x1c\x02E\xa3;\x1c\x02F\xa1\x16\x1c\x02H\x93" +
+	"\xd1\x1c\x02\x81\xa7c\x1c\x02\x82\xa7A\x1c\x02\x83\xa6\x11\x1c\x02\x84\xa5\xa0\x1c\x02\x85\xa6^\x1c\x02\x86\xa49\x1c" +
+	"\x02\x88\x96w\x1c\x02\x89\x91\xd7\x1c\x02\xc1\xa7\x93\x1c\x02§r\x1c\x02æA\x1c\x02ĥ\xd0\x1c\x02\xc5" +
+	"\xa5\xa7\x1c\x02ƧD\x1c\x02ș\x06\x1c\x02ɔf\x1c\x02ʔ\x80\x1c\x03\x01\xa7\xab\x1c\x03\x02\xa7\x8a" +
+	"\x1c\x03\x03\xa6Y\x1c\x03\x04\xa5\xe9\x1c\x03\x05\xa5\xc0\x1c\x03\x06\xa6\x91\x1c\x03\b\x9b\x02\x1c\x03\t\x96b\x1c\x03" +
+	"\n\x96}\x1c\x03\v\x96\x7f\x1c\x03A\xab\x95\x1c\x03B\xab^\x1c\x03C\xaa\x1c\x1c\x03D\xa9\xae\x1c\x03E\xad" +
+	"\xa8\x1c\x03F\xad\xb6\x1c\x03HW\x05\x1c\x03IP\xfd\x1c\x03JN\x81\x1c\x03KL\xe4\x1c\x03LKy\x1c" +
+	"\x03\x81\x7f\x91\x1c\x03\x82\xac\xfa\x1c\x03\x83\xab\xb5\x1c\x03\x84\xab/\x1c\x03\x85\xae\x85\x1c\x03\x86\xb26\x1c\x03\x88" +
+	"\xa8\xf9\x1c\x03\x89\xa7\xee\x1c\x03\x8a\xa8\x17\x1c\x03\x8b\xa8E\x1c\x03\x8c\xa8\\\x1c\x03\xc1\xac\xf3\x1c\x03\xc2~\x11" +
+	"\x1c\x03é.\x1c\x03ħ\xf0\x1c\x03ū$\x1c\x03Ʈ\xd4\x1c\x03Ȩ\xd4\x1c\x03ɧ\xc9\x1c\x03" +
+	"ʧ\xf2\x1c\x03˨ \x1c\x03̨8\x1c\x04\x01\xab\xa5\x1c\x04\x02\xa9 \x1c\x04\x03{^\x1c\x04\x04\xa4" +
+	"%\x1c\x04\x05\xa7Y\x1c\x04\x06\xab\n\x1c\x04\b\xa8]\x1c\x04\t\xa6\x93\x1c\x04\n\xa6\xbc\x1c\x04\v\xa6\xea\x1c" +
+	"\x04\f\xa7\x02\x1c\x04A\xab\x13\x1c\x04B\xa7\xd7\x1c\x04C\xa4\x18\x1c\x04Dx\xf2\x1c\x04E\xa3\xa9\x1c\x04F" +
+	"\xa7Z\x1c\x04H\xa5'\x1c\x04I\xa6\xfc\x1c\x04J\xa6D\x1c\x04K\xa6q\x1c\x04L\xa6\x89\x1c\x04\x81\xae_" +
+	"\x1c\x04\x82\xab\x00\x1c\x04\x83\xa7A\x1c\x04\x84\xa3\x9d\x1c\x04\x85w\r\x1c\x04\x86\xa3\xd9\x1c\x04\x88\xa2 \x1c\x04" +
+	"\x89\xa3\xf5\x1c\x04\x8a\xa6\xfe\x1c\x04\x8b\xa6K\x1c\x04\x8c\xa6c\x1c\x04\xc1\xb2\x0f\x1c\x04®\xb1\x1c\x04ê" +
+	"\xf2\x1c\x04ħM\x1c\x04ţ\xcc\x1c\x04\xc6v\v\x1c\x04Ƞ\x03\x1c\x04ɡ\xd8\x1c\x04ʤ\xe1\x1c" +
+	"\x04˧\xd5\x1c\x04̧&\x1c\x05\x01\xc5\xd8\x1c\x05\x02Ł\x1c\x05\x03\xc1N\x1c\x05\x04\xbd \x1c\x05\x05" +
+	"\xb9\x02\x1c\x05\x06\xb5\xed\x1c\x05\b\xb5G\x1c\x05\t\xb7\xe8\x1c\x05\n\xbb\xbe\x1c\x05\v\xbf\x97\x1c\x05\f\xc3u" +
+	"\x1c\x05\r\x80\x00\x1c\x05\x0e\xbbx\x1c\x05\x0f\xbb&\x1c\x05\x10\xb7Q\x1c\x05\x11\xb3\x80\x1c\x05\x12\xaf\xbc\x1c\x05" +
+	"\x13\xac\xe8\x1c\x05A\xa9\x16\x1c\x05B\xa8\xf4\x1c\x05C\xa8\x88\x1c\x05D\xa5Y\x1c\x05E\xa2N\x1c\x05F\xa0" +
+	"9\x1c\x05HRE\x1c\x05I\x956\x1c\x05J\x97\xc9\x1c\x05K\x9aG\x1c\x05L\x9c>\x1c\x05MU<\x1c" +
+	"\x05N\x9f\xd7\x1c\x05O\x9f\xb8\x1c\x05P\x9fV\x1c\x05Q\x9cr\x1c\x05R\x99\xae\x1c\x05S\x97\xc7\x1c\x05T" +
+	"\xacC\x1c\x05\x81\xa8\f\x1c\x05\x82\xa7\xea\x1c\x05\x83\xa6\xbf\x1c\x05\x84\xa72\x1c\x05\x85\xa4(\x1c\x05\x86\xa2\x12" +
+	"\x1c\x05\x88\x955\x1c\x05\x89P\xc7\x1c\x05\x8a\x93I\x1c\x05\x8b\x95\xc7\x1c\x05\x8c\x97\xbe\x1c\x05\x8dO\xa5\x1c\x05" +
+	"\x8e\x9e\xe7\x1c\x05\x8f\x9e\xc9\x1c\x05\x90\x9d\xbb\x1c\x05\x91\x9e\"\x1c\x05\x92\x9b^\x1c\x05\x93\x99w\x1c\x05\x94\xae" +
+	"\xab\x1c\x05\x95\x8d\xb6\x1c\x05\xc1\xa8A\x1c\x05¨ \x1c\x05æ\xf4\x1c\x05Ħ\x87\x1c\x05ŧ@\x1c" +
+	"\x05ƥ+\x1c\x05ȗ\xd4\x1c\x05ɓT\x1c\x05\xcaQ\x01\x1c\x05˕\xeb\x1c\x05̗\xe3\x1c\x05\xcd" +
+	"MM\x1c\x05Ο\x0e\x1c\x05Ϟ\xef\x1c\x05Н\xe1\x1c\x05ѝ}\x1c\x05Ҟ#\x1c\x05Ӝ<" +
+	"\x1c\x05Բ*\x1c\x05Ր\r\x1c\x05\u058b\xf5\x1c\x06\x01\xa8{\x1c\x06\x02\xa8Y\x1c\x06\x03\xa7.\x1c\x06" +
+	"\x04\xa6\xc0\x1c\x06\x05\xa6\x98\x1c\x06\x06\xa8,\x1c\x06\b\x9a\\\x1c\x06\t\x95\xdc\x1c\x06\n\x95\xf5\x1c\x06\vQ" +
+	"F\x1c\x06\f\x97\xf0\x1c\x06\rK\xc1\x1c\x06\x0e\x9f8\x1c\x06\x0f\x9f\x19\x1c\x06\x10\x9e\v\x1c\x06\x11\x9d\xa7\x1c" +
+	"\x06\x12\x9d\x83\x1c\x06\x13\x9e\xed\x1c\x06\x14\xb5\xac\x1c\x06\x15\x92N\x1c\x06\x16\x8e7\x1c\x06\x17\x8eM\x1c\x06A" +
+	"\xa8\x9d\x1c\x06B\xa8{\x1c\x06C\xa7P\x1c\x06D\xa6\xe2\x1c\x06E\xa6\xba\x1c\x06F\xa7\x88\x1c\x06H\x9cS" +
+	"\x1c\x06I\x97\xd3\x1c\x06J\x97\xec\x1c\x06K\x97\xf0\x1c\x06LP\xf9\x1c\x06MJb\x1c\x06N\x9fM\x1c\x06" +
+	"O\x9f/\x1c\x06P\x9e!\x1c\x06Q\x9d\xbd\x1c\x06R\x9d\x99\x1c\x06S\x9eQ\x1c\x06T\xb92\x1c\x06U\x94" +
+	"\x10\x1c\x06V\x8f\xf8\x1c\x06W\x90\x0f\x1c\x06X\x90\x11\x1c\x06\x8d\x1e\xa2\x1c\x06\x8e\xac\xe7\x1c\x06\x8f\xac\xad\x1c" +
+	"\x06\x90\xabd\x1c\x06\x91\xaa\xee\x1c\x06\x92\xae\xd4\x1c\x06\x93\xae\xd5\x1c\x06\x94\x86a\x1c\x06\x95Y\xef\x1c\x06\x96" +
+	"T\x02\x1c\x06\x97Q\x97\x1c\x06\x98P\n\x1c\x06\x99N\xaf\x1c\x06\u0380\x8d\x1c\x06Ϯ\n\x1c\x06Ь\xc1" +
+	"\x1c\x06Ѭ2\x1c\x06үu\x1c\x06ӳ\f\x1c\x06\xd4\xc6\xd5\x1c\x06ժD\x1c\x06֩>\x1c\x06" +
+	"שr\x1c\x06ة\xa9\x1c\x06٩\xcc\x1c\a\x0f\x7f\a\x1c\a\x10\xaaB\x1c\a\x11\xa8\xfe\x1c\a\x12\xac" +
+	"\x1f\x1c\a\x13\xaf\xb6\x1c\a\x14\xc6}\x1c\a\x15\xaa \x1c\a\x16\xa9\x1a\x1c\a\x17\xa9M\x1c\a\x18\xa9\x85\x1c" +
+	"\a\x19\xa9\xa7\x1c\aP|M\x1c\aQ\xa5L\x1c\aR\xa8m\x1c\aS\xac\x03\x1c\aT\xc2V\x1c\aU" +
+	"\xa9\xa9\x1c\aV\xa7\xe8\x1c\aW\xa8\x1c\x1c\aX\xa8S\x1c\aY\xa8v\x1c\a\x91y\xdb\x1c\a\x92\xa4\xd3" +
+	"\x1c\a\x93\xa8j\x1c\a\x94\xbe5\x1c\a\x95\xa6\x84\x1c\a\x96\xa8N\x1c\a\x97\xa7\xa5\x1c\a\x98\xa7\xdd\x1c\a" +
+	"\x99\xa7\xff\x1c\a\xd2w\xf9\x1c\aӥ\x00\x1c\aԺ/\x1c\aգ\x8d\x1c\a֥X\x1c\aר" +
+	"]\x1c\aا\xb7\x1c\a٧\xda\x1c\b\x13v\xec\x1c\b\x14\xb7!\x1c\b\x15\xa1{\x1c\b\x16\xa3F\x1c" +
+	"\b\x17\xa6J\x1c\b\x18\xa9:\x1c\b\x19\xa8\x98\x1c\bTW-\x1c\bU\xb6\x84\x1c\bV\xb9\x16\x1c\bW" +
+	"\xbc\xe3\x1c\bX\xc0\xb2\x1c\bYĆ\x1c\b\x95S\xae\x1c\b\x96\x96\xe3\x1c\b\x97\x99t\x1c\b\x98\x9b\xf0" +
+	"\x1c\b\x99\x9d\xe8\x1c\b\xd6R5\x1c\bו\x11\x1c\bؗ\x8d\x1c\bٙ\x84\x1c\t\x17Rv\x1c\t" +
+	"\x18\x97\xb0\x1c\t\x19\x99\xa8\x1c\tXR\xc3\x1c\tY\x99\xb6\x1c\t\x99R\x81\x1c\x12\x00Os\x1c\x12\x02[" +
+	".\x1c\x12\x03Z\xf3\x1c\x12\x04W\xb2\x1c\x12\x05UF\x1c\x12\x06R\f\x1c\x12@N\xc2\x1c\x12BZj\x1c" +
+	"\x12CYl\x1c\x12DYM\x1c\x12EV\xe1\x1c\x12FS\xa7\x1c\x12HI\a\x1c\x12\x80O\x8f\x1c\x12\x82" +
+	"Z\x87\x1c\x12\x83Y\x88\x1c\x12\x84X\xa6\x1c\x12\x85Y[\x1c\x12\x86V\"\x1c\x12\x88K%\x1c\x12\x89JG" +
+	"\x1c\x12\xc0P8\x1c\x12\xc2Z\xc7\x1c\x12\xc3Y\xc8\x1c\x12\xc4X\xe6\x1c\x12\xc5X\xd8\x1c\x12\xc6X\xc3\x1c\x12" +
+	"\xc8MR\x1c\x12\xc9L\x9b\x1c\x12\xcaMD\x1c\x13\x00P%\x1c\x13\x02Zv\x1c\x13\x03Yx\x1c\x13\x04X" +
+	"\x95\x1c\x13\x05X\x87\x1c\x13\x06W\xa8\x1c\x13\bN\xf9\x1c\x13\tNF\x1c\x13\nN\xef\x1c\x13\vN\xdd\x1c" +
+	"\x13@(s\x1c\x13Bd\xb6\x1c\x13Cc\xbc\x1c\x13Db\xe0\x1c\x13EeU\x1c\x13Fc\xc9\x1c\x13H" +
+	"Z\x88\x1c\x13IY\xda\x1c\x13JZ\xa3\x1c\x13K[I\x1c\x13L[8\x1c\x13\x80HO\x1c\x13\x82\xb0$" +
+	"\x1c\x13\x83\xae\xf1\x1c\x13\x84\xae\x94\x1c\x13\x85\xb2\xad\x1c\x13\x86\xb6J\x1c\x13\x88O\x90\x1c\x13\x89L\xfb\x1c\x13" +
+	"\x8aK\xa5\x1c\x13\x8bJ\xcf\x1c\x13\x8cI{\x1c\x13\xc0c@\x1c\x13\xc2X$\x1c\x13Ó\xe8\x1c\x13Ē" +
+	"\xbb\x1c\x13ŕ\x8a\x1c\x13Ɨ0\x1c\x13\xc8e\xd3\x1c\x13\xc9e\x0e\x1c\x13\xcae&\x1c\x13\xcbeb\x1c" +
+	"\x13\xcce\x12\x1c\x14\x00b9\x1c\x14\x02\x93\xda\x1c\x14\x03U\x9f\x1c\x14\x04\x8e\xec\x1c\x14\x05\x91\xbc\x1c\x14\x06" +
+	"\x93b\x1c\x14\be\x8b\x1c\x14\td\a\x1c\x14\nd\x1f\x1c\x14\vd[\x1c\x14\fd\v\x1c\x14@aO" +
+	"\x1c\x14B\x92\xa1\x1c\x14C\x8e\xde\x1c\x14DS;\x1c\x14E\x8d\xf3\x1c\x14F\x8f\x99\x1c\x14HbV\x1c\x14" +
+	"Ic\xdc\x1c\x14Jc5\x1c\x14Kcq\x1c\x14Lc!\x1c\x14\x80c\xc3\x1c\x14\x82\x95q\x1c\x14\x83\x91" +
+	"\xae\x1c\x14\x84\x8d\xf1\x1c\x14\x85Qp\x1c\x14\x86\x8b\xd8\x1c\x14\x88_\xfe\x1c\x14\x89a\x83\x1c\x14\x8ac\xe6\x1c" +
+	"\x14\x8bcd\x1c\x14\x8cc\x13\x1c\x14\xc0b7\x1c\x14\u0097\r\x1c\x14ÓJ\x1c\x14ď\x8d\x1c\x14\xc5" +
+	"\x8b\xcc\x1c\x14\xc6O\x03\x1c\x14\xc8\\\xdc\x1c\x14\xc9^b\x1c\x14\xca`\xc5\x1c\x14\xcbcP\x1c\x14\xccb8" +
+	"\x1c\x15\x00D\x04\x1c\x15\x02~2\x1c\x15\x03{\x8b\x1c\x15\x04r\xfc\x1c\x15\x05j?\x1c\x15\x06`\xa4\x1c\x15" +
+	"\bJ\xf4\x1c\x15\tH\xc5\x1c\x15\nG]\x1c\x15\vF\x7f\x1c\x15\fE\xeb\x1c\x15\x0e\x80\x00\x1c\x15\x0fy" +
+	"<\x1c\x15\x10v\xcf\x1c\x15\x11n\xfc\x1c\x15\x12f\xf9\x1c\x15\x13^'\x1c\x15@Zl\x1c\x15Bg'\x1c" +
+	"\x15Cf\xed\x1c\x15Dc\xc3\x1c\x15Eag\x1c\x15F^E\x1c\x15H&\xc9\x1c\x15IT4\x1c\x15J" +
+	"V@\x1c\x15KX]\x1c\x15LZ\x00\x1c\x15MX\xb2\x1c\x15NO\x7f\x1c\x15OcV\x1c\x15Pc!" +
+	"\x1c\x15Q`@\x1c\x15R^\x1b\x1c\x15S[@\x1c\x15TKG\x1c\x15\x80Y\xc8\x1c\x15\x82fm\x1c\x15" +
+	"\x83es\x1c\x15\x84eV\x1c\x15\x85b\xfa\x1c\x15\x86_\xd8\x1c\x15\x88T<\x1c\x15\x89'D\x1c\x15\x8aU" +
+	"p\x1c\x15\x8bW\xb3\x1c\x15\x8cYY\x1c\x15\x8dX\x18\x1c\x15\x8eM\t\x1c\x15\x8fb\x9d\x1c\x15\x90a\xbd\x1c" +
+	"\x15\x91a\xa1\x1c\x15\x92_|\x1c\x15\x93\\\xa1\x1c\x15\x94I0\x1c\x15\x95S\x1b\x1c\x15\xc0Z\x9b\x1c\x15\xc2" +
+	"f\x90\x1c\x15\xc3e\x97\x1c\x15\xc4d\xba\x1c\x15\xc5ek\x1c\x15\xc6bI\x1c\x15\xc8VS\x1c\x15\xc9Uy" +
+	"\x1c\x15\xca'\xdf\x1c\x15\xcbXc\x1c\x15\xccZ\n\x1c\x15\xcdX\xcc\x1c\x15\xceK\xb9\x1c\x15\xcfb\xae\x1c\x15" +
+	"\xd0a\xce\x1c\x15\xd1a\a\x1c\x15\xd2a\xa6\x1c\x15\xd3^\xcb\x1c\x15\xd4G\xcf\x1c\x15\xd5T\xf3\x1c\x15\xd6T" +
+	"0\x1c\x16\x00[K\x1c\x16\x02f\xd7\x1c\x16\x03e\xde\x1c\x16\x04e\x01\x1c\x16\x05d\xf3\x1c\x16\x06d\xe0\x1c" +
+	"\x16\bXy\x1c\x16\tW\xc5\x1c\x16\nXk\x1c\x16\v(f\x1c\x16\fZ\x02\x1c\x16\rYa\x1c\x16\x0e" +
+	"J\xe2\x1c\x16\x0fb\xe1\x1c\x16\x10b\x01\x1c\x16\x11a9\x1c\x16\x12a-\x1c\x16\x13a\x1a\x1c\x16\x14F\xee" +
+	"\x1c\x16\x15V\xd9\x1c\x16\x16V9\x1c\x16\x17V\xce\x1c\x16@[C\x1c\x16Bf\x92\x1c\x16Ce\x98\x1c\x16" +
+	"Dd\xbb\x1c\x16Ed\xae\x1c\x16Fc\xd5\x1c\x16HZ\x1c\x1c\x16IYl\x1c\x16JZ\x12\x1c\x16KZ" +
+	"\x02\x1c\x16L(\x19\x1c\x16MYP\x1c\x16NI\x9b\x1c\x16Ob\x95\x1c\x16Pa\xb5\x1c\x16Q`\xed\x1c" +
+	"\x16R`\xe1\x1c\x16S`\x1c\x1c\x16TFT\x1c\x16UXL\x1c\x16VW\xaf\x1c\x16WXD\x1c\x16X" +
+	"X4\x1c\x16\x8d+\xc4\x1c\x16\x8eL\xac\x1c\x16\x8fg\x06\x1c\x16\x90f\x03\x1c\x16\x91e\x1c\x1c\x16\x92g\x84" +
+	"\x1c\x16\x93e\xfe\x1c\x16\x94Hn\x1c\x16\x95^^\x1c\x16\x96]\xbe\x1c\x16\x97^\x8d\x1c\x16\x98_;\x1c\x16" +
+	"\x99_5\x1c\x16\xce^F\x1c\x16ϱt\x1c\x16а;\x1c\x16ѯ\xd5\x1c\x16ҳ\xda\x1c\x16ӷ" +
+	"d\x1c\x16Ԇo\x1c\x16\xd5S\xb2\x1c\x16\xd6Q0\x1c\x16\xd7O\xe9\x1c\x16\xd8O\x1e\x1c\x16\xd9M\xda\x1c" +
+	"\x17\x0fY\xa3\x1c\x17\x10\x95\x91\x1c\x17\x11\x94^\x1c\x17\x12\x97!\x1c\x17\x13\x98\xbf\x1c\x17\x14\x80\x9c\x1c\x17\x15" +
+	"iv\x1c\x17\x16h\xbb\x1c\x17\x17h\xdb\x1c\x17\x18i\x1e\x1c\x17\x19h\xda\x1c\x17PW\x18\x1c\x17Q\x90\xa7" +
+	"\x1c\x17R\x93k\x1c\x17S\x95\t\x1c\x17T}\xf8\x1c\x17Ui/\x1c\x17Vg\xb8\x1c\x17Wg\xd8\x1c\x17" +
+	"Xh\x1c\x1c\x17Yg\xd7\x1c\x17\x91T\xae\x1c\x17\x92\x8f\xba\x1c\x17\x93\x91X\x1c\x17\x94u\x85\x1c\x17\x95f" +
+	"\f\x1c\x17\x96g\x8c\x1c\x17\x97f\xf2\x1c\x17\x98g5\x1c\x17\x99f\xf0\x1c\x17\xd2R\xe8\x1c\x17Ӎ\xb0\x1c" +
+	"\x17\xd4l\xf3\x1c\x17\xd5c\xc2\x1c\x17\xd6eC\x1c\x17\xd7g\x9f\x1c\x17\xd8g(\x1c\x17\xd9f\xe3\x1c\x18\x13" +
+	"P\x80\x1c\x18\x14c\x85\x1c\x18\x15`\xb3\x1c\x18\x16b4\x1c\x18\x17d\x90\x1c\x18\x18g\x15\x1c\x18\x19f\r" +
+	"\x1c\x18T\x1e\xbc\x1c\x18UOA\x1c\x18VM\x1e\x1c\x18WK\xbf\x1c\x18XJ\xe7\x1c\x18YJZ\x1c\x18" +
+	"\x95*\n\x1c\x18\x96X>\x1c\x18\x97ZF\x1c\x18\x98\\`\x1c\x18\x99^\x02\x1c\x18\xd6*\x8a\x1c\x18\xd7Y" +
+	"z\x1c\x18\xd8[\xb9\x1c\x18\xd9]`\x1c\x19\x17+*\x1c\x19\x18\\g\x1c\x19\x19^\r\x1c\x19X+\xb8\x1c" +
+	"\x19Y^\b\x1c\x19\x99+v\x1c\"\x00O\x94\x1c\"\x01d\x86\x1c\"\x03Zb\x1c\"\x04W!\x1c\"\x05" +
+	"T\xd8\x1c\"\x06R~\x1c\"@N\xe3\x1c\"Ac\xc2\x1c\"CX\xf7\x1c\"DX\xbc\x1c\"EVs" +
+	"\x1c\"FT\x18\x1c\"HI(\x1c\"\x80O\xb0\x1c\"\x81c\xdf\x1c\"\x83Y\x13\x1c\"\x84X\x15\x1c\"" +
+	"\x85X\xed\x1c\"\x86V\x93\x1c\"\x88KF\x1c\"\x89Ji\x1c\"\xc0PY\x1c\"\xc1d\x1f\x1c\"\xc3Y" +
+	"S\x1c\"\xc4XU\x1c\"\xc5Xj\x1c\"\xc6Y4\x1c\"\xc8Ms\x1c\"\xc9L\xbc\x1c\"\xcaMe\x1c" +
+	"#\x00PG\x1c#\x01c\xcf\x1c#\x03Y\x03\x1c#\x04X\x05\x1c#\x05X\x19\x1c#\x06X\x19\x1c#\b" +
+	"O\x1a\x1c#\tNg\x1c#\nO\x11\x1c#\vN\xfe\x1c#@(t\x1c#Amc\x1c#CcG" +
+	"\x1c#DbO\x1c#Ed\xe2\x1c#Fd8\x1c#HZ\xa9\x1c#IY\xfc\x1c#JZ\xc4\x1c#" +
+	"K[k\x1c#L[Y\x1c#\x80k\xb4\x1c#\x81|+\x1c#\x83\x9eM\x1c#\x84\x9d\xde\x1c#\x85\xa1" +
+	"u\x1c#\x86\xa45\x1c#\x88n\xbd\x1c#\x89m\xf8\x1c#\x8an\x11\x1c#\x8bnM\x1c#\x8cm\xfc\x1c" +
+	"#\xc0HI\x1c#\xc1\xb1\x81\x1c#ë\xff\x1c#Ī\xd1\x1c#Ů\xc8\x1c#Ʋd\x1c#\xc8" +
+	"O\x8a\x1c#\xc9L\xf5\x1c#\xcaK\x9f\x1c#\xcbJ\xc8\x1c#\xccIt\x1c$\x00a\xc3\x1c$\x01\x9er" +
+	"\x1c$\x03U\x9a\x1c$\x04\x8f\xb1\x1c$\x05\x92\xa2\x1c$\x06\x94\xec\x1c$\bd\xfd\x1c$\tc\x94\x1c$" +
+	"\nc\xad\x1c$\vc\xe9\x1c$\fc\x98\x1c$@`\xbd\x1c$A\x9d\xf8\x1c$C\x8f\xa3\x1c$DS" +
+	"6\x1c$E\x8e\xd9\x1c$F\x91#\x1c$Ha\xc8\x1c$IcN\x1c$Jb\xa7\x1c$Kb\xe3\x1c" +
+	"$Lb\x93\x1c$\x80cQ\x1c$\x81\xa1\x95\x1c$\x83\x92\x95\x1c$\x84\x8e\xd8\x1c$\x85Q\x8e\x1c$\x86" +
+	"\x8d}\x1c$\x88_\x92\x1c$\x89a\x18\x1c$\x8ac{\x1c$\x8bb\xf8\x1c$\x8cb\xa8\x1c$\xc0b\xa6" +
+	"\x1c$\xc1\xa4Z\x1c$Ô\xe0\x1c$đ#\x1c$ō|\x1c$\xc6P\x02\x1c$\xc8]K\x1c$" +
+	"\xc9^\xd1\x1c$\xcaa4\x1c$\xcbc\xbf\x1c$\xccb\xa7\x1c%\x00D[\x1c%\x01\x8c\xdc\x1c%\x03{" +
+	"\xb9\x1c%\x04s,\x1c%\x05jr\x1c%\x06`\xda\x1c%\bK>\x1c%\tI\x12\x1c%\nG\xac\x1c" +
+	"%\vF\xd1\x1c%\fF?\x1c%\x0f\x80\x00\x1c%\x10v\xf9\x1c%\x11o(\x1c%\x12g(\x1c%\x13" +
+	"^Y\x1c%@Z\x8d\x1c%ApN\x1c%Cfa\x1c%Dc7\x1c%E`\xfe\x1c%F^\xb4" +
+	"\x1c%H&\xc9\x1c%ITU\x1c%JVb\x1c%KX\x7f\x1c%LZ!\x1c%MX\xd0\x1c%" +
+	"Nk\xba\x1c%OO\x7f\x1c%Pb\xa4\x1c%Q_\xc3\x1c%R]\xbc\x1c%S[\xa7\x1c%TK" +
+	"\x8c\x1c%\x80Y\xe9\x1c%\x81o\x94\x1c%\x83e\x02\x1c%\x84d\xc9\x1c%\x85b\x90\x1c%\x86`G\x1c" +
+	"%\x88T]\x1c%\x89'E\x1c%\x8aU\x91\x1c%\x8bW\xd4\x1c%\x8cY{\x1c%\x8dX6\x1c%\x8e" +
+	"k\x01\x1c%\x8fM\t\x1c%\x90aX\x1c%\x91a#\x1c%\x92_\x1d\x1c%\x93]\a\x1c%\x94Iw" +
+	"\x1c%\x95S:\x1c%\xc0Z\xbc\x1c%\xc1o\xb7\x1c%\xc3e&\x1c%\xc4d.\x1c%\xc5e\x02\x1c%" +
+	"\xc6b\xb8\x1c%\xc8Vt\x1c%\xc9U\x9a\x1c%\xca'\xdf\x1c%\xcbX\x85\x1c%\xccZ+\x1c%\xcdX" +
+	"\xeb\x1c%\xcek\x12\x1c%\xcfK\xb9\x1c%\xd0ai\x1c%\xd1`\x89\x1c%\xd2aG\x1c%\xd3_1\x1c" +
+	"%\xd4H\x19\x1c%\xd5U\x11\x1c%\xd6TN\x1c&\x00[l\x1c&\x01o\xfe\x1c&\x03em\x1c&\x04" +
+	"du\x1c&\x05d\x89\x1c&\x06eO\x1c&\bX\x9a\x1c&\tW\xe6\x1c&\nX\x8d\x1c&\v(g" +
+	"\x1c&\fZ#\x1c&\rY\x80\x1c&\x0ekE\x1c&\x0fJ\xe2\x1c&\x10a\x9b\x1c&\x11`\xbb\x1c&" +
+	"\x12`\xce\x1c&\x13a\x80\x1c&\x14G;\x1c&\x15V\xf8\x1c&\x16VX\x1c&\x17V\xed\x1c&@[" +
+	"e\x1c&Ao\xb9\x1c&Ce'\x1c&Dd/\x1c&EdD\x1c&FdD\x1c&HZ=\x1c" +
+	"&IY\x8d\x1c&JZ3\x1c&KZ#\x1c&L(\x1a\x1c&MYo\x1c&Nj\xf9\x1c&O" +
+	"I\x9b\x1c&PaP\x1c&Q`p\x1c&R`\x82\x1c&S`\x82\x1c&TF\xa3\x1c&UXj" +
+	"\x1c&VW\xce\x1c&WXc\x1c&XXR\x1c&\x8d+\xc4\x1c&\x8eoL\x1c&\x8fL\xa5\x1c&" +
+	"\x90e\x8f\x1c&\x91d\x8e\x1c&\x92g\x12\x1c&\x93fj\x1c&\x94H\xc4\x1c&\x95^\x7f\x1c&\x96]" +
+	"\xdf\x1c&\x97^\xaf\x1c&\x98_\\\x1c&\x99_W\x1c&\xce} \x1c&ϲ\xc8\x1c&П\xc1\x1c" +
+	"&џJ\x1c&Ң\xd0\x1c&ӥ\x83\x1c&Ԏ\x9c\x1c&\xd5r0\x1c&\xd6qt\x1c&\xd7" +
+	"q\x94\x1c&\xd8q\xd8\x1c&\xd9q\x93\x1c'\x0f\\\xec\x1c'\x10\xadR\x1c'\x11\xac\x1e\x1c'\x12\xb0\x01" +
+	"\x1c'\x13\xb3\x8c\x1c'\x14\x86i\x1c'\x15S\xac\x1c'\x16Q*\x1c'\x17O\xe3\x1c'\x18O\x18\x1c'" +
+	"\x19M\xd4\x1c'PW\x12\x1c'Q\x91j\x1c'R\x94N\x1c'S\x96\x8d\x1c'T~$\x1c'Uh" +
+	"\xa4\x1c'VgH\x1c'Wgh\x1c'Xg\xac\x1c'Ygg\x1c'\x91T\xa8\x1c'\x92\x90\x9d\x1c" +
+	"'\x93\x92\xdc\x1c'\x94u\xb4\x1c'\x95e\x81\x1c'\x96g\x02\x1c'\x97fg\x1c'\x98f\xab\x1c'\x99" +
+	"ff\x1c'\xd2S\x03\x1c'ӏM\x1c'\xd4m$\x1c'\xd5cY\x1c'\xd6d\xda\x1c'\xd7g6" +
+	"\x1c'\xd8f\xbf\x1c'\xd9fz\x1c(\x13Qz\x1c(\x14c\xb9\x1c(\x15a \x1c(\x16b\xa0\x1c(" +
+	"\x17d\xfd\x1c(\x18g\x82\x1c(\x19fy\x1c(T\x1e\xbd\x1c(UO\x8a\x1c(VMi\x1c(WL" +
+	"\r\x1c(XK8\x1c(YJ\xad\x1c(\x95*\v\x1c(\x96X_\x1c(\x97Zh\x1c(\x98\\\x81\x1c" +
+	"(\x99^$\x1c(\xd6*\x8b\x1c(\xd7Y\x9b\x1c(\xd8[\xda\x1c(\xd9]\x81\x1c)\x17++\x1c)\x18" +
+	"\\\x88\x1c)\x19^/\x1c)X+\xb8\x1c)Y^)\x1c)\x99+v\x1c2\x00S\x1e\x1c2\x01g\xff" +
+	"\x1c2\x02g\xff\x1c2\x04Zg\x1c2\x05X\x1d\x1c2\x06U\xc3\x1c2@R~\x1c2Ag6\x1c2" +
+	"Bg6\x1c2D\\\x04\x1c2EY\xbb\x1c2FW`\x1c2HL\xb3\x1c2\x80SK\x1c2\x81g" +
+	"S\x1c2\x82gS\x1c2\x84[]\x1c2\x85\\6\x1c2\x86Y\xdb\x1c2\x88N\xd1\x1c2\x89N\x04\x1c" +
+	"2\xc0S\xf4\x1c2\xc1g\x93\x1c2\xc2g\x93\x1c2\xc4[\x9d\x1c2\xc5[\xb2\x1c2\xc6\\}\x1c2\xc8" +
+	"P\xfd\x1c2\xc9PW\x1c2\xcaQ\x01\x1c3\x00S\xe2\x1c3\x01gC\x1c3\x02gB\x1c3\x04[M" +
+	"\x1c3\x05[b\x1c3\x06[a\x1c3\bR\xa5\x1c3\tR\x02\x1c3\nR\xac\x1c3\vR\x99\x1c3" +
+	"@,\xbb\x1c3Ap\x9a\x1c3Bp\x99\x1c3De\x86\x1c3Eh\x19\x1c3Fgn\x1c3H^" +
+	"\x01\x1c3I]d\x1c3J^-\x1c3K^\xd3\x1c3L^\xc1\x1c3\x80n\xf4\x1c3\x81\x7f\v\x1c" +
+	"3\x82\xa8\xe4\x1c3\x84\xa1\b\x1c3\x85\xa4\xa3\x1c3\x86\xa7d\x1c3\x88r\x06\x1c3\x89q<\x1c3\x8a" +
+	"qT\x1c3\x8bq\x90\x1c3\x8cq@\x1c3\xc0n\xed\x1c3\xc1\xa8\xdd\x1c3\xc2~\a\x1c3ĝ\xe9" +
+	"\x1c3šh\x1c3Ƥ)\x1c3\xc8q\xff\x1c3\xc9q5\x1c3\xcaqN\x1c3\xcbq\x8a\x1c3" +
+	"\xccq9\x1c4\x00K\xa4\x1c4\x01\xb1\xd1\x1c4\x02\xb1\x8a\x1c4\x04\xaa|\x1c4\x05\xaew\x1c4\x06\xb2" +
+	"\x13\x1c4\bSq\x1c4\tPO\x1c4\nN\xf9\x1c4\vN#\x1c4\fL\xcf\x1c4@c\xdb\x1c" +
+	"4A\xa1\x02\x1c4B\x9d\xe2\x1c4DU?\x1c4E\x92s\x1c4F\x94\xbd\x1c4Hd\xdd\x1c4I" +
+	"fk\x1c4Je\xc5\x1c4Kf\x01\x1c4Le\xb0\x1c4\x80fn\x1c4\x81\xa4\xa3\x1c4\x82\xa1g" +
+	"\x1c4\x84\x92r\x1c4\x85S\x97\x1c4\x86\x91\x18\x1c4\x88b\xa7\x1c4\x89d5\x1c4\x8af\x98\x1c4" +
+	"\x8bf\x16\x1c4\x8ce\xc5\x1c4\xc0e\xc3\x1c4\xc1\xa7h\x1c4¤,\x1c4Ĕ\xbe\x1c4ő" +
+	"\x16\x1c4\xc6R\n\x1c4\xc8``\x1c4\xc9a\xee\x1c4\xcadQ\x1c4\xcbf\xdc\x1c4\xcce\xc4\x1c" +
+	"5\x00H\x94\x1c5\x01\x8f\x82\x1c5\x02\x8fV\x1c5\x04u\x96\x1c5\x05k\xd8\x1c5\x06b\xce\x1c5\b" +
+	"K\x8c\x1c5\tM@\x1c5\nK\xdd\x1c5\vK\x04\x1c5\fJv\x1c5\x10\x80\x00\x1c5\x11q]" +
+	"\x1c5\x12ho\x1c5\x13`!\x1c5@]\xef\x1c5As\x98\x1c5Bs\x98\x1c5Dfn\x1c5" +
+	"Ed5\x1c5Fa\xeb\x1c5H*\xeb\x1c5IW\xb8\x1c5JY\xc4\x1c5K[\xe1\x1c5L]" +
+	"\x84\x1c5M[\xd9\x1c5Nn\xb3\x1c5On\xb3\x1c5PS\t\x1c5Qb\xa8\x1c5R`\xa1\x1c" +
+	"5S^\x8c\x1c5TK\xcd\x1c5\x80]R\x1c5\x81r\xce\x1c5\x82r\xce\x1c5\x84h\x00\x1c5\x85" +
+	"e\xc7\x1c5\x86c}\x1c5\x88W\xb6\x1c5\x89+\x8c\x1c5\x8aX\xfa\x1c5\x8b[=\x1c5\x8c\\\xe3" +
+	"\x1c5\x8d[M\x1c5\x8em\xf6\x1c5\x8fm\xf5\x1c5\x90P\x15\x1c5\x91d\v\x1c5\x92b\x05\x1c5" +
+	"\x93_\xef\x1c5\x94MB\x1c5\x95VB\x1c5\xc0^$\x1c5\xc1r\xf2\x1c5\xc2r\xf2\x1c5\xc4g" +
+	"e\x1c5\xc5h8\x1c5\xc6e\xef\x1c5\xc8Y\xcd\x1c5\xc9Y\x03\x1c5\xca,&\x1c5\xcb[\xed\x1c" +
+	"5\xcc]\x93\x1c5\xcd\\\x02\x1c5\xcen\a\x1c5\xcfn\x06\x1c5\xd0N\xc5\x1c5\xd1cq\x1c5\xd2" +
+	"d/\x1c5\xd3b\x19\x1c5\xd4K\xe6\x1c5\xd5X\x1a\x1c5\xd6We\x1c6\x00^\xd5\x1c6\x01s9" +
+	"\x1c6\x02s9\x1c6\x04g\xac\x1c6\x05g\xc0\x1c6\x06h\x86\x1c6\b[\xf3\x1c6\t[O\x1c6" +
+	"\n[\xf5\x1c6\v,\xae\x1c6\f]\x8c\x1c6\r\\\x97\x1c6\x0en9\x1c6\x0fn9\x1c6\x10M" +
+	"\xed\x1c6\x11c\xa3\x1c6\x12c\xb6\x1c6\x13dh\x1c6\x14K\v\x1c6\x15Z\x01\x1c6\x16Yo\x1c" +
+	"6\x17Z\x04\x1c6@^\xcd\x1c6Ar\xf3\x1c6Br\xf3\x1c6Dgf\x1c6Eg{\x1c6F" +
+	"gz\x1c6H]\x96\x1c6I\\\xf5\x1c6J]\x9c\x1c6K]\x8c\x1c6L,a\x1c6M\\\x86" +
+	"\x1c6Nm\xee\x1c6Om\xed\x1c6PL\xa7\x1c6QcX\x1c6Rcj\x1c6Scj\x1c6" +
+	"TJu\x1c6U[s\x1c6VZ\xe5\x1c6W[z\x1c6X[i\x1c6\x8d/\xe9\x1c6\x8er" +
+	"l\x1c6\x8fre\x1c6\x90O\xe0\x1c6\x91g\x8d\x1c6\x92j\x11\x1c6\x93ij\x1c6\x94L\xd9\x1c" +
+	"6\x95a\xc3\x1c6\x96a'\x1c6\x97a\xf7\x1c6\x98b\xa5\x1c6\x99b\x9f\x1c6\xce\x7f\xec\x1c6\xcf" +
+	"\xa9\xe8\x1c6г\f\x1c6Ѣ<\x1c6ҥ\xc6\x1c6Өy\x1c6ԑ+\x1c6\xd5ud" +
+	"\x1c6\xd6t\x98\x1c6\xd7t\xb9\x1c6\xd8t\xfc\x1c6\xd9t\xb7\x1c7\x0f~\xe2\x1c7\x10\xb2\xc3\x1c7" +
+	"\x11\x9f*\x1c7\x12\xa2\x99\x1c7\x13\xa5L\x1c7\x14\x90\xfb\x1c7\x15u]\x1c7\x16t\x92\x1c7\x17t" +
+	"\xb2\x1c7\x18t\xf6\x1c7\x19t\xb1\x1c7P^\xc6\x1c7Q\xab\xa4\x1c7R\xaf\x8a\x1c7S\xb3\x15\x1c" +
+	"7T\x86e\x1c7UW{\x1c7VTe\x1c7WS\x1d\x1c7XRS\x1c7YQ\x0e\x1c7\x91" +
+	"V\x8f\x1c7\x92\x94\x06\x1c7\x93\x96F\x1c7\x94w\xe2\x1c7\x95h~\x1c7\x96j\x01\x1c7\x97if" +
+	"\x1c7\x98i\xaa\x1c7\x99ie\x1c7\xd2T\xeb\x1c7Ӓ\xb7\x1c7\xd4nS\x1c7\xd5fV\x1c7" +
+	"\xd6g\xd9\x1c7\xd7j5\x1c7\xd8i\xbe\x1c7\xd9iy\x1c8\x13Sa\x1c8\x14er\x1c8\x15d" +
+	"\x1d\x1c8\x16e\x9f\x1c8\x17g\xfc\x1c8\x18j\x81\x1c8\x19ix\x1c8T#\"\x1c8UO\xce\x1c" +
+	"8VQt\x1c8WP\x1a\x1c8XOH\x1c8YN\xc0\x1c8\x95.\x14\x1c8\x96[\xa3\x1c8\x97" +
+	"]\xab\x1c8\x98_\xc5\x1c8\x99ag\x1c8\xd6.\xb0\x1c8\xd7\\\xe3\x1c8\xd8_\"\x1c8\xd9`\xc9" +
+	"\x1c9\x17/P\x1c9\x18_\xd0\x1c9\x19aw\x1c9X/\xdd\x1c9Yaq\x1c9\x99/\x9b\x1cB" +
+	"\x00V\xf5\x1cB\x01k\xd9\x1cB\x02k\xd9\x1cB\x03j\x1a\x1cB\x05[~\x1cB\x06Y$\x1cB@V" +
+	"\x05\x1cBAj\xab\x1cBBj\xab\x1cBCh]\x1cBE\\\xfc\x1cBFZ\xa2\x1cBHO\xf4\x1c" +
+	"B\x80V\xe2\x1cB\x81j\xc3\x1cB\x82j\xc3\x1cB\x83ht\x1cB\x85_z\x1cB\x86]\x1f\x1cB\x88" +
+	"R\xa7\x1cB\x89Qg\x1cB\xc0W\x8b\x1cB\xc1k\x03\x1cB\xc2k\x03\x1cB\xc3h\xb4\x1cB\xc5^\xf6" +
+	"\x1cB\xc6_\xc1\x1cB\xc8T\xd3\x1cB\xc9S\xba\x1cB\xcaT\x98\x1cC\x00Wy\x1cC\x01j\xb3\x1cC" +
+	"\x02j\xb2\x1cC\x03hd\x1cC\x05^\xa6\x1cC\x06^\xa5\x1cC\bV{\x1cC\tUf\x1cC\nV" +
+	"C\x1cC\vV0\x1cC@1\x02\x1cCAs\xd0\x1cCBs\xd0\x1cCCq\xb6\x1cCEkL\x1c" +
+	"CFj\xa1\x1cCHa\xc2\x1cCI`\xb9\x1cCJa\x91\x1cCKb7\x1cCLb&\x1cC\x80" +
+	"r3\x1cC\x81\x81\xed\x1cC\x82\xaa\x1c\x1cC\x83\xaak\x1cC\x85\xa7\xcd\x1cC\x86\xaa\x92\x1cC\x88u\xcb" +
+	"\x1cC\x89t\x80\x1cC\x8at\x94\x1cC\x8bt\xd0\x1cC\x8ct\x7f\x1cC\xc0r-\x1cC\xc1\xaa\x16\x1cC" +
+	"\u0080\xe9\x1cCç\xf4\x1cCŤ\x93\x1cCƧW\x1cC\xc8u\xc4\x1cC\xc9tz\x1cC\xcat" +
+	"\x8d\x1cC\xcbt\xc9\x1cC\xccty\x1cD\x00o\xe5\x1cD\x01\xaa;\x1cD\x02\xa7\xc4\x1cD\x03}\x1e\x1c" +
+	"D\x05\xa1/\x1cD\x06\xa3\xf3\x1cD\bt\x04\x1cD\tr2\x1cD\nrF\x1cD\vr\x82\x1cD\f" +
+	"r1\x1cD@N\xff\x1cDA\xb2,\x1cDB\xb1\xe5\x1cDC\xac\xf9\x1cDE\xae#\x1cDF\xb1\xc4" +
+	"\x1cDHS\xbb\x1cDIT8\x1cDJRU\x1cDKQ\x7f\x1cDLP+\x1cD\x80i\x87\x1cD" +
+	"\x81\xa7\xad\x1cD\x82\xa4q\x1cD\x83\xa1;\x1cD\x85U\xa0\x1cD\x86\x94\xb2\x1cD\x88e\xea\x1cD\x89g" +
+	"F\x1cD\x8ai\xb2\x1cD\x8bi/\x1cD\x8ch\xdf\x1cD\xc0h\xdc\x1cD\xc1\xaav\x1cD§:\x1c" +
+	"Dä\x04\x1cDŔ\xb1\x1cD\xc6T\x13\x1cD\xc8c\xa2\x1cD\xc9d\xff\x1cD\xcagj\x1cD\xcb" +
+	"i\xf6\x1cD\xcch\xde\x1cE\x00L\xc9\x1cE\x01\x98\x11\x1cE\x02\x97\xe3\x1cE\x03\x95y\x1cE\x05m\xe3" +
+	"\x1cE\x06d \x1cE\bPw\x1cE\tM\x8d\x1cE\nP\n\x1cE\vO4\x1cE\fN\xa8\x1cE" +
+	"\x11\x80\x00\x1cE\x12jM\x1cE\x13aV\x1cE@a\x94\x1cEAw9\x1cEBw9\x1cECu" +
+	"\xa5\x1cEEg|\x1cEFe2\x1cEH-\x04\x1cEIZ\xc8\x1cEJ]i\x1cEK_\x86\x1c" +
+	"ELa(\x1cEM_@\x1cENr\x1e\x1cEOr\x1e\x1cEPp\xac\x1cEQSP\x1cER" +
+	"c\xa0\x1cESa\x8b\x1cETPK\x1cE\x80`\xb0\x1cE\x81v\x14\x1cE\x82v\x14\x1cE\x83s\xfa" +
+	"\x1cE\x85h\xfa\x1cE\x86f\xb1\x1cE\x88Z\xe9\x1cE\x89/\xae\x1cE\x8a\\5\x1cE\x8b^y\x1cE" +
+	"\x8c`\x1f\x1cE\x8d^S\x1cE\x8ep\xeb\x1cE\x8fp\xeb\x1cE\x90n\xfc\x1cE\x91S\x9f\x1cE\x92d" +
+	"\xe6\x1cE\x93b\xd1\x1cE\x94M\x82\x1cE\x95Y$\x1cE\xc0a\x89\x1cE\xc1v(\x1cE\xc2v(\x1c" +
+	"E\xc3t\x0e\x1cE\xc5kk\x1cE\xc6i!\x1cE\xc8]\x8e\x1cE\xc9\\5\x1cE\xca0n\x1cE\xcb" +
+	"_Q\x1cE\xcc`\xf8\x1cE\xcd_\x15\x1cE\xcep\xf7\x1cE\xcfp\xf7\x1cE\xd0o\b\x1cE\xd1Q\xd1" +
+	"\x1cE\xd2g\x13\x1cE\xd3d\xfe\x1cE\xd4O\xaf\x1cE\xd5[\x81\x1cE\xd6ZK\x1cF\x00b9\x1cF" +
+	"\x01vo\x1cF\x02vo\x1cF\x03tU\x1cF\x05j\xf3\x1cF\x06k\xb9\x1cF\b_\xb3\x1cF\t^" +
+	"\x81\x1cF\n_Y\x1cF\v0\xf5\x1cF\f`\xf0\x1cF\r_\xaa\x1cF\x0eq*\x1cF\x0fq)\x1c" +
+	"F\x10o:\x1cF\x11P\xf9\x1cF\x12f\x9a\x1cF\x13gL\x1cF\x14N\xd7\x1cF\x15]h\x1cF\x16" +
+	"\\U\x1cF\x17]\x17\x1cF@b1\x1cFAv*\x1cFBv*\x1cFCt\x0f\x1cFEj\xad" +
+	"\x1cFFj\xad\x1cFHaV\x1cFI`(\x1cFJa\x00\x1cFK`\xf0\x1cFL0\xa9\x1cF" +
+	"M_\x99\x1cFNp\xde\x1cFOp\xde\x1cFPn\xef\x1cFQO\xb3\x1cFRfO\x1cFSf" +
+	"O\x1cFTND\x1cFU^\xda\x1cFV]\xcb\x1cFW^\x8d\x1cFX^|\x1cF\x8d4\x0e\x1c" +
+	"F\x8eu\x8c\x1cF\x8fu\x86\x1cF\x90sI\x1cF\x91S\x1c\x1cF\x92m\f\x1cF\x93ld\x1cF\x94" +
+	"P\xea\x1cF\x95eE\x1cF\x96dg\x1cF\x97e;\x1cF\x98e\xe9\x1cF\x99e\xe3\x1cF\u0382\xba" +
+	"\x1cFϫ\x10\x1cFЫ7\x1cFѳ[\x1cFҨ\xb8\x1cFӫo\x1cFԙ\x8b\x1cF" +
+	"\xd5x\xea\x1cF\xd6w\xc8\x1cF\xd7w\xd9\x1cF\xd8x\x1c\x1cF\xd9w\xd8\x1cG\x0f\x81\xaf\x1cG\x10\xa8" +
+	"\xca\x1cG\x11\xb3\x12\x1cG\x12\xa5\x8b\x1cG\x13\xa8B\x1cG\x14\x99Y\x1cG\x15x\xe4\x1cG\x16w\xc2\x1c" +
+	"G\x17w\xd2\x1cG\x18x\x16\x1cG\x19w\xd1\x1cGP}\xd2\x1cGQ\xae\x12\x1cGR\xa2G\x1cGS" +
+	"\xa4\xfe\x1cGT\x96\xd0\x1cGUw(\x1cGVu\x85\x1cGWu\x96\x1cGXu\xd9\x1cGYu\x95" +
+	"\x1cG\x91`\xd0\x1cG\x92\xaf\x11\x1cG\x93\xb2\x9f\x1cG\x94\x86c\x1cG\x95W\x97\x1cG\x96X5\x1cG" +
+	"\x97VY\x1cG\x98U\x8f\x1cG\x99TJ\x1cG\xd2V\xd2\x1cGӖ!\x1cG\xd4p#\x1cG\xd5i" +
+	"n\x1cG\xd6j\xd2\x1cG\xd7m0\x1cG\xd8l\xb9\x1cG\xd9lt\x1cH\x13UI\x1cH\x14f\x8c\x1c" +
+	"H\x15g5\x1cH\x16h\x99\x1cH\x17j\xf7\x1cH\x18m|\x1cH\x19ls\x1cHT'\x87\x1cHU" +
+	"Ts\x1cHVQ\xb7\x1cHWT#\x1cHXSS\x1cHYR\xce\x1cH\x950\f\x1cH\x96^\x9c" +
+	"\x1cH\x97a.\x1cH\x98cG\x1cH\x99d\xea\x1cH\xd62\xb9\x1cH\xd7`\x01\x1cH\xd8b@\x1cH" +
+	"\xd9c\xe7\x1cI\x173t\x1cI\x18c\x14\x1cI\x19d\xbb\x1cIX4\x02\x1cIYd\xb5\x1cI\x993" +
+	"\xc0\x1cR\x00Z\x91\x1cR\x01o\x9e\x1cR\x02o\x9e\x1cR\x03m\xdf\x1cR\x04j$\x1cR\x06[\x8d\x1c" +
+	"R@Y\xb6\x1cRAnq\x1cRBnp\x1cRCl\"\x1cRDk\x15\x1cRF]\x11\x1cRH" +
+	"R\x7f\x1cR\x80Z!\x1cR\x81n\x00\x1cR\x82m\xff\x1cR\x83k\xb1\x1cR\x84i\xf9\x1cR\x86_S" +
+	"\x1cR\x88T\xdb\x1cR\x89S\x9b\x1cR\xc0Z\xfe\x1cR\xc1n^\x1cR\xc2n^\x1cR\xc3l\x0f\x1cR" +
+	"\xc4jW\x1cR\xc6b\x13\x1cR\xc8W\xa3\x1cR\xc9V\x9f\x1cR\xcaVM\x1cS\x00Z\xeb\x1cS\x01n" +
+	"\r\x1cS\x02n\r\x1cS\x03k\xbf\x1cS\x04j\x06\x1cS\x06`\xf8\x1cS\bYJ\x1cS\tXJ\x1c" +
+	"S\nW\xf9\x1cS\vX\xd6\x1cS@4\xe2\x1cSAy~\x1cSBy}\x1cSCwH\x1cSD" +
+	"u\xc1\x1cSFm\xbc\x1cSHeV\x1cSId`\x1cSJd\xaa\x1cSKe\x82\x1cSLeq" +
+	"\x1cS\x80w\xe7\x1cS\x81\x85\x11\x1cS\x82\xab\xd3\x1cS\x83\xabF\x1cS\x84\xac \x1cS\x86\xac\xd5\x1cS" +
+	"\x88y\x84\x1cS\x89x:\x1cS\x8aw\xaa\x1cS\x8bx\x04\x1cS\x8cw\xb3\x1cS\xc0w\xe1\x1cS\xc1\xab" +
+	"\xcc\x1cS\u0084\r\x1cSè\xcf\x1cSĩ\x01\x1cSƩ\x9a\x1cS\xc8y~\x1cS\xc9x3\x1c" +
+	"S\xcaw\xa3\x1cS\xcbw\xfd\x1cS\xccw\xad\x1cT\x00u~\x1cT\x01\xab\x17\x1cT\x02\xa8\xa0\x1cT\x03" +
+	"\x80A\x1cT\x04\xa5\x97\x1cT\x06\xa66\x1cT\bw\xbd\x1cT\tu\xec\x1cT\nu\\\x1cT\vu\xb6" +
+	"\x1cT\fue\x1cT@s\xcc\x1cTA\xab\xc9\x1cTB\xa8\xa9\x1cTC\xa5l\x1cTD}>\x1cT" +
+	"F\xa2\xdd\x1cTHt\x05\x1cTIt\xdc\x1cTJs\xaa\x1cTKt\x04\x1cTLs\xb3\x1cT\x80R" +
+	"\xbe\x1cT\x81\xb7\x0e\x1cT\x82\xb6\xc7\x1cT\x83\xb1\xdb\x1cT\x84\xaf\xe2\x1cT\x86\xb1\xee\x1cT\x88S\x8b\x1c" +
+	"T\x89T\b\x1cT\x8aU\xa0\x1cT\x8bT`\x1cT\x8cS\f\x1cT\xc0k\xd3\x1cT\xc1\xac\x8c\x1cT\xc2" +
+	"\xa9Q\x1cTæ\x1b\x1cTĢ\xee\x1cT\xc6U\x1b\x1cT\xc8e\xf3\x1cT\xc9gU\x1cT\xcaiu" +
+	"\x1cT\xcbl#\x1cT\xcck\v\x1cU\x00P\xf0\x1cU\x01\xa0\xcc\x1cU\x02\xa0\x9b\x1cU\x03\x9f4\x1cU" +
+	"\x04\x9d*\x1cU\x06d\xda\x1cU\bQk\x1cU\tQt\x1cU\nOR\x1cU\vR\\\x1cU\fQ" +
+	"\xd3\x1cU\x12\x80\x00\x1cU\x13b\x02\x1cU@e\x00\x1cUAz\xc5\x1cUBz\xc4\x1cUCy0\x1c" +
+	"UDu\xaa\x1cUFg\x89\x1cUH-W\x1cUI]:\x1cUJ_t\x1cUKb)\x1cUL" +
+	"c\xcc\x1cUMbs\x1cUNuv\x1cUOuv\x1cUPt\x04\x1cUQp\xb6\x1cURS(" +
+	"\x1cUSc\xaf\x1cUTQ,\x1cU\x80d1\x1cU\x81y\x9f\x1cU\x82y\x9f\x1cU\x83w\x85\x1cU" +
+	"\x84v\x9f\x1cU\x86i\r\x1cU\x88]^\x1cU\x890\xa8\x1cU\x8a^@\x1cU\x8ba1\x1cU\x8cb" +
+	"\xd7\x1cU\x8da\x99\x1cU\x8etC\x1cU\x8ftC\x1cU\x90rT\x1cU\x91q\x7f\x1cU\x92Sw\x1c" +
+	"U\x93d\xfb\x1cU\x94Q\x15\x1cU\x95[g\x1cU\xc0d\xa1\x1cU\xc1y6\x1cU\xc2y5\x1cU\xc3" +
+	"w\x1b\x1cU\xc4u\x95\x1cU\xc6kO\x1cU\xc8_\xba\x1cU\xc9^a\x1cU\xca3O\x1cU\xcb`\xe9" +
+	"\x1cU\xccb\x8f\x1cU\xcda\xda\x1cU\xces\xbb\x1cU\xcfs\xbb\x1cU\xd0q\xcc\x1cU\xd1pa\x1cU" +
+	"\xd2T\xcd\x1cU\xd3f\xf2\x1cU\xd4O\x05\x1cU\xd5]u\x1cU\xd6\\@\x1cV\x00ez\x1cV\x01y" +
+	"\x90\x1cV\x02y\x90\x1cV\x03wv\x1cV\x04u\xef\x1cV\x06n\x01\x1cV\bbt\x1cV\taV\x1c" +
+	"V\n`\xe9\x1cV\v4\x1e\x1cV\fch\x1cV\rb\x9c\x1cV\x0et\b\x1cV\x0ft\b\x1cV\x10" +
+	"r\x19\x1cV\x11p\xae\x1cV\x12S\x96\x1cV\x13i\\\x1cV\x14Q\xb5\x1cV\x15_\xe6\x1cV\x16^\xe7" +
+	"\x1cV\x17^\x83\x1cV@er\x1cVAyK\x1cVByJ\x1cVCw0\x1cVDu\xaa\x1cV" +
+	"Fl\xf5\x1cVHd\x17\x1cVIb\xfc\x1cVJb\x90\x1cVKch\x1cVL3\xd1\x1cVMb" +
+	"\x8c\x1cVNs\xbc\x1cVOs\xbc\x1cVPq\xcd\x1cVQpb\x1cVRRP\x1cVSh^\x1c" +
+	"VTQ%\x1cVUaY\x1cVV`]\x1cVW_\xf8\x1cVX`\xbb\x1cV\x8d7\xd8\x1cV\x8e" +
+	"{\x1f\x1cV\x8f{\x19\x1cV\x90x\xc1\x1cV\x91w\x18\x1cV\x92V\xbf\x1cV\x93oJ\x1cV\x94T\xfa" +
+	"\x1cV\x95h\x9e\x1cV\x96g\xd4\x1cV\x97hC\x1cV\x98i\x17\x1cV\x99i\x11\x1cV΅\xd5\x1cV" +
+	"Ϭ\xb9\x1cVЬ\b\x1cVѬ\xb9\x1cVҸ&\x1cVӭ\x7f\x1cVԢ%\x1cV\xd5|" +
+	"i\x1cV\xd6{G\x1cV\xd7z\xde\x1cV\xd8{4\x1cV\xd9z\xef\x1cW\x0f\x84\xca\x1cW\x10\xa9\x9b\x1c" +
+	"W\x11\xa9\xa7\x1cW\x12\xb7\xdd\x1cW\x13\xaaR\x1cW\x14\xa1\xf1\x1cW\x15|b\x1cW\x16{@\x1cW\x17" +
+	"z\xd8\x1cW\x18{.\x1cW\x19z\xe9\x1cWP\x80\xed\x1cWQ\xa6\\\x1cWR\xb2\xdd\x1cWS\xa7\x0e" +
+	"\x1cWT\xa0f\x1cWUz\xa6\x1cWVy\x04\x1cWWx\x9b\x1cWXx\xf1\x1cWYx\xac\x1cW" +
+	"\x91}\xd6\x1cW\x92\xb0\xc6\x1cW\x93\xa3\xd4\x1cW\x94\x9e<\x1cW\x95w\x13\x1cW\x96w\xf5\x1cW\x97v" +
+	"\xf2\x1cW\x98wH\x1cW\x99w\x03\x1cW\xd2b\x88\x1cWӲ\xa2\x1cWԆb\x1cW\xd5W>\x1c" +
+	"W\xd6W\xdb\x1cW\xd7Y\x91\x1cW\xd8XT\x1cW\xd9W\x10\x1cX\x13V4\x1cX\x14g\x13\x1cX\x15" +
+	"ic\x1cX\x16j\xcc\x1cX\x17l\xf2\x1cX\x18o\x92\x1cX\x19n\x89\x1cXT*\xd3\x1cXUU4" +
+	"\x1cXVU_\x1cXWSi\x1cXXV_\x1cXYU\xdc\x1cX\x950H\x1cX\x96`\xe9\x1cX" +
+	"\x97c)\x1cX\x98e\xd0\x1cX\x99gs\x1cX\xd63\x9a\x1cX\xd7a\xfc\x1cX\xd8d\xdd\x1cX\xd9f" +
+	"\x84\x1cY\x176E\x1cY\x18d\x99\x1cY\x19f@\x1cYX7\x10\x1cYYg\x14\x1cY\x996\xcf\x1c" +
+	"b\x00]2\x1cb\x01sk\x1cb\x02sk\x1cb\x03q\x90\x1cb\x04m\xd5\x1cb\x05jC\x1cb@" +
+	"\\X\x1cbAr?\x1cbBr?\x1cbCo\xd5\x1cbDn\xc8\x1cbEk6\x1cbHT\x80" +
+	"\x1cb\x80\\\xd8\x1cb\x81q\xce\x1cb\x82q\xce\x1cb\x83od\x1cb\x84m\xac\x1cb\x85l\xec\x1cb" +
+	"\x88W^\x1cb\x89V4\x1cb\xc0\\\x86\x1cb\xc1p\xe8\x1cb\xc2p\xe8\x1cb\xc3n}\x1cb\xc4l" +
+	"\xc5\x1cb\xc5kS\x1cb\xc8Y8\x1cb\xc9X5\x1cb\xcaW\xe3\x1cc\x00]c\x1cc\x01qr\x1c" +
+	"c\x02qr\x1cc\x03o\a\x1cc\x04mO\x1cc\x05k\xdc\x1cc\b\\'\x1cc\t['\x1cc\n" +
+	"Z\xeb\x1cc\vX\v\x1cc@8\x14\x1ccA{\xd3\x1ccB{\xd3\x1ccCy\x9b\x1ccDx\x15" +
+	"\x1ccEx?\x1ccHg\xe8\x1ccIf\xf3\x1ccJgQ\x1ccKf\xe3\x1ccLg\xbb\x1cc" +
+	"\x80zO\x1cc\x81\x88B\x1cc\x82\xb0;\x1cc\x83\xadK\x1cc\x84\xadm\x1cc\x85\xb2\x83\x1cc\x88}" +
+	"E\x1cc\x89{\xfc\x1cc\x8a{l\x1cc\x8bzk\x1cc\x8cz\xf0\x1cc\xc0zI\x1cc\xc1\xb05\x1c" +
+	"c\u0087>\x1ccê\xd3\x1ccĪM\x1ccůH\x1cc\xc8}>\x1cc\xc9{\xf5\x1cc\xca" +
+	"{f\x1cc\xcbzd\x1cc\xccz\xe9\x1cd\x00w\xe6\x1cd\x01\xad\x1c\x1cd\x02\xaa\xa5\x1cd\x03\x83V" +
+	"\x1cd\x04\xa6\xce\x1cd\x05\xab\xc9\x1cd\b{b\x1cd\ty\x93\x1cd\ny\x03\x1cd\vx\x02\x1cd" +
+	"\fx\x87\x1cd@v4\x1cdA\xad\x16\x1cdB\xa9\xf6\x1cdC\xa6\xa3\x1cdD\x80R\x1cdE\xa8" +
+	"\xe3\x1cdHw\xaa\x1cdIx\x83\x1cdJwQ\x1cdKvP\x1cdLv\xd5\x1cd\x80v0\x1c" +
+	"d\x81\xb2\x04\x1cd\x82\xae\xc8\x1cd\x83\xabu\x1cd\x84\xa8\xb9\x1cd\x85}\xb1\x1cd\x88t\x1c\x1cd\x89" +
+	"t\xf5\x1cd\x8av\x90\x1cd\x8bt\xe4\x1cd\x8cui\x1cd\xc0U\x93\x1cd\xc1\xbbX\x1cd»\x11" +
+	"\x1cdö#\x1cdĴ+\x1cdų\xdb\x1cd\xc8So\x1cd\xc9S\xee\x1cd\xcaU\x86\x1cd" +
+	"\xcbV\xea\x1cd\xccU\xff\x1ce\x00T\v\x1ce\x01\xaag\x1ce\x02\xaa3\x1ce\x03\xa8=\x1ce\x04\xa6" +
+	"\xeb\x1ce\x05\xa63\x1ce\bQ\xbb\x1ce\tRw\x1ce\nSI\x1ce\vQ\xb3\x1ce\fU\v\x1c" +
+	"e\x13\x80\x00\x1ce@gw\x1ceA~Y\x1ceB~Y\x1ceC|\xa7\x1ceDy!\x1ceE" +
+	"u\xc6\x1ceH-\xa0\x1ceI_(\x1ceJa\xdd\x1ceKc\xa0\x1ceLf|\x1ceMd\xca" +
+	"\x1ceNx\xd5\x1ceOx\xd4\x1cePwJ\x1ceQs\xfc\x1ceRp\xd3\x1ceSS\x11\x1ce" +
+	"TQy\x1ce\x80f\xa8\x1ce\x81}6\x1ce\x82}5\x1ce\x83z\xfe\x1ce\x84z\x19\x1ce\x85v" +
+	"\xbd\x1ce\x88_M\x1ce\x891\f\x1ce\x8a`\xbf\x1ce\x8bb\xa8\x1ce\x8ce\x88\x1ce\x8dc\xef\x1c" +
+	"e\x8ew\xa4\x1ce\x8fw\xa4\x1ce\x90u\x9c\x1ce\x91t\xc7\x1ce\x92q\x9d\x1ce\x93Sb\x1ce\x94" +
+	"R\x04\x1ce\x95].\x1ce\xc0g-\x1ce\xc1|\xcc\x1ce\xc2|\xcc\x1ce\xc3z\x95\x1ce\xc4y\x0e" +
+	"\x1ce\xc5xv\x1ce\xc8b'\x1ce\xc9`\xe3\x1ce\xca4[\x1ce\xcbb`\x1ce\xcceU\x1ce" +
+	"\xcddD\x1ce\xcew\x1c\x1ce\xcfw\x1c\x1ce\xd0u\x14\x1ce\xd1s\xaa\x1ce\xd2s\x17\x1ce\xd3T" +
+	"\xb9\x1ce\xd4R\xa6\x1ce\xd5_\xb1\x1ce\xd6^\x8e\x1cf\x00f\xe5\x1cf\x01{\xf1\x1cf\x02{\xf0\x1c" +
+	"f\x03y\xb9\x1cf\x04x3\x1cf\x05v\xf2\x1cf\bd\x04\x1cf\tb\xe6\x1cf\nby\x1cf\v" +
+	"64\x1cf\fb\xaa\x1cf\rc\xe0\x1cf\x0ev.\x1cf\x0fv.\x1cf\x10t'\x1cf\x11r\xbc" +
+	"\x1cf\x12q\x8e\x1cf\x13U\xe4\x1cf\x14Q\x19\x1cf\x15aO\x1cf\x16`P\x1cf\x17_\xeb\x1cf" +
+	"@g\xbd\x1cfA|v\x1cfB|v\x1cfCz?\x1cfDx\xb8\x1cfEwx\x1cfHf" +
+	"\xe4\x1cfIe\xca\x1cfJer\x1cfKb\xaa\x1cfL7\f\x1cfMd\xa2\x1cfNv\xa3\x1c" +
+	"fOv\xa3\x1cfPt\x9b\x1cfQs0\x1cfRr\x02\x1cfST\xfd\x1cfTT\x12\x1cfU" +
+	"c\xe3\x1cfVb\xe7\x1cfWb\x96\x1cfX`\t\x1cf\x8d:\xef\x1cf\x8e}n\x1cf\x8f}h" +
+	"\x1cf\x90{\x11\x1cf\x91yg\x1cf\x92yd\x1cf\x93Yx\x1cf\x94W\xf9\x1cf\x95j\xfb\x1cf" +
+	"\x96j0\x1cf\x97j\xb3\x1cf\x98jo\x1cf\x99kC\x1cfΈ\xfa\x1cfϱ\t\x1cfЭ" +
+	"\xfe\x1cfѭ\xf9\x1cfҲ\xd4\x1cfӼY\x1cfԫ\x9a\x1cf\xd5\x7f\xee\x1cf\xd6~\xcd\x1c" +
+	"f\xd7~e\x1cf\xd8}\x8f\x1cf\xd9~\x10\x1cg\x0f\x87\xf0\x1cg\x10\xab\x91\x1cg\x11\xaa\xe7\x1cg\x12" +
+	"\xaf\xa7\x1cg\x13\xbc\x11\x1cg\x14\xabb\x1cg\x15\x7f\xe7\x1cg\x16~\xc7\x1cg\x17~_\x1cg\x18}\x88" +
+	"\x1cg\x19~\n\x1cgP\x83\xf8\x1cgQ\xa7\x89\x1cgR\xacI\x1cgS\xb7\x10\x1cgT\xa9M\x1cg" +
+	"U~\x10\x1cgV|p\x1cgW|\a\x1cgX{1\x1cgY{\xb2\x1cg\x91\x80\xe1\x1cg\x92\xa9" +
+	"}\x1cg\x93\xb4\xf9\x1cg\x94\xa7\xd8\x1cg\x95z}\x1cg\x96{a\x1cg\x97z^\x1cg\x98y\x87\x1c" +
+	"g\x99z\b\x1cg\xd2~,\x1cgӴ\x84\x1cgԦ\xfb\x1cg\xd5w\x15\x1cg\xd6w\xf8\x1cg\xd7" +
+	"y\x9c\x1cg\xd8x\"\x1cg\xd9x\xa3\x1ch\x13dr\x1ch\x14\x86a\x1ch\x15V\xf8\x1ch\x16W\x98" +
+	"\x1ch\x17YM\x1ch\x18Z\xd0\x1ch\x19Y\xe7\x1chT.+\x1chUUS\x1chVV/\x1ch" +
+	"WW \x1chXU\xb5\x1chYX\xf7\x1ch\x950z\x1ch\x96b\xb6\x1ch\x97en\x1ch\x98g" +
+	"<\x1ch\x99j\t\x1ch\xd63\xe7\x1ch\xd7dV\x1ch\xd8fI\x1ch\xd9i\x1a\x1ci\x1777\x1c" +
+	"i\x18f\x05\x1ci\x19h\xea\x1ciX9\x1b\x1ciYfT\x1ci\x999\xee \x02A\xa7\x1b \x02B" +
+	"\xa6\x1e \x02C\xa6\x00 \x02D\xa5\x97 \x02E\xa2x \x02F\xa0S \x02G\x9d\xf7 \x02\x81\xa7G" +
+	" \x02\x82\xa6J \x02\x83\xa6, \x02\x84\xa5\x00 \x02\x85\xa5\x9a \x02\x86\xa3u \x02\x87\xa1\x19 \x02" +
+	"\x89\x92\x8d \x02\xc1\xa7w \x02¦z \x02æ] \x02ĥ0 \x02Ť\xe4 \x02Ʀ" +
+	"\x9c \x02Ǥ@ \x02ɕ7 \x02ʕR \x03\x01\xa7\xab \x03\x02\xa6\xae \x03\x03\xa6\x91 " +
+	"\x03\x04\xa5d \x03\x05\xa5\x18 \x03\x06\xa5\xea \x03\a\xa7k \x03\t\x97\xe6 \x03\n\x98\x00 \x03\v" +
+	"\x98\x1f \x03A\xb2\x14 \x03B\xb0\xe8 \x03C\xb0\xb1 \x03D\xafm \x03E\xb3O \x03F\xb3\x82" +
+	" \x03G\xb4\b \x03IP? \x03JM\xc5 \x03KL+ \x03LJ\xc3 \x03\x81~\xbc \x03" +
+	"\x82\xab\xe9 \x03\x83\xab\xc8 \x03\x84\xaa\x88 \x03\x85\xad\xbc \x03\x86\xb1l \x03\x87\xb1\x15 \x03\x89\xa7" +
+	"\xd5 \x03\x8a\xa7\xfe \x03\x8b\xa8, \x03\x8c\xa8] \x03\xc1\xab\xe4 \x03\xc2|\xfc \x03èU " +
+	"\x03ħ\x15 \x03ŪI \x03ƭ\xfa \x03ǰ\xf1 \x03ɦ\xd9 \x03ʧ\x02 \x03\xcb" +
+	"\xa70 \x03̧a \x04\x01\xab\xc1 \x04\x02\xa8Q \x04\x03{\xa7 \x04\x04\xa4\x94 \x04\x05\xa7\x10" +
+	" \x04\x06\xaa\x9e \x04\a\xad\x95 \x04\t\xa6\xba \x04\n\xa6\xe3 \x04\v\xa7\x11 \x04\f\xa7B \x04" +
+	"A\xaax \x04B\xa7\b \x04C\xa4\x88 \x04Dy\x1f \x04E\xa3K \x04F\xa6\xd9 \x04G\xa9" +
+	"\xd0 \x04I\xa6H \x04J\xa5\xb3 \x04K\xa5\xe0 \x04L\xa6\x12 \x04\x81\xad\xa1 \x04\x82\xaa2 " +
+	"\x04\x83\xa6\xf9 \x04\x84\xa3? \x04\x85w9 \x04\x86\xa3X \x04\x87\xa6O \x04\x89\xa3B \x04\x8a" +
+	"\xa6K \x04\x8b\xa5\x97 \x04\x8c\xa5\xc9 \x04\xc1\xb1R \x04\u00ad\xe2 \x04ê\x87 \x04Ħ\xcc" +
+	" \x04ţK \x04\xc6v8 \x04ǣ\xb8 \x04ɡ$ \x04ʤ. \x04˧< \x04" +
+	"̦\x8c \x05\x01\xb0\xf1 \x05\x02\xb0\xce \x05\x03\xads \x05\x04\xa9\xb8 \x05\x05\xa67 \x05\x06\xa3" +
+	"\xab \x05\at\x8b \x05\t\x9e\xd3 \x05\n\xa1\xdd \x05\v\xa4\xea \x05\f\xa7\xfc \x05A\xc6A " +
+	"\x05B\xc4\xf7 \x05CĤ \x05D\xc0u \x05E\xbcV \x05F\xb9B \x05G\xb6\x13 \x05I" +
+	"\xb4q \x05J\xb8G \x05K\xbc  \x05L\xbf\xfe \x05M\x80\x00 \x05N\xbb\xd7 \x05O\xba\xab" +
+	" \x05P\xba] \x05Q\xb6\x8c \x05R\xb2\xc8 \x05S\xaf\xf4 \x05T\xad\b \x05\x81\xa7\xf1 \x05" +
+	"\x82\xa6\xf7 \x05\x83\xa6\xda \x05\x84\xa6r \x05\x85\xa3g \x05\x86\xa1Q \x05\x87\x9f\a \x05\x89O" +
+	"\xd2 \x05\x8a\x93\xdd \x05\x8b\x96t \x05\x8c\x99\x10 \x05\x8dN\xf9 \x05\x8e\x9e\xd0 \x05\x8f\x9d\xf0 " +
+	"\x05\x90\x9d\xd5 \x05\x91\x9dv \x05\x92\x9a\xb3 \x05\x93\x98\xcb \x05\x94\x96\xb4 \x05\x95\xab\x80 \x05\xc1" +
+	"\xa8& \x05§- \x05ç\x10 \x05ĥ\xe8 \x05Ŧ\x7f \x05Ƥj \x05Ǣ " +
+	" \x05ɓ\xe7 \x05\xcaP\f \x05˖\x99 \x05̙4 \x05\xcdL\xa3 \x05Ξ\xf6 \x05" +
+	"Ϟ\x16 \x05Н\xfb \x05ќ\xf1 \x05ҝx \x05ӛ\x91 \x05ԙz \x05ծ" +
+	"\xfe \x05\u058c~ \x06\x01\xa8` \x06\x02\xa7f \x06\x03\xa7I \x06\x04\xa6\" \x06\x05\xa5\xd7 " +
+	"\x06\x06\xa7\x87 \x06\a\xa5= \x06\t\x96\x8a \x06\n\x96\xa3 \x06\vPs \x06\f\x99\\ \x06\r" +
+	"K\x1a \x06\x0e\x9f  \x06\x0f\x9e@ \x06\x10\x9e% \x06\x11\x9d\x1b \x06\x12\x9c\xd8 \x06\x13\x9eZ" +
+	" \x06\x14\x9cC \x06\x15\xb2\x80 \x06\x16\x8e\xd8 \x06\x17\x8e\xef \x06A\xa8\x9d \x06B\xa7\xa3 \x06" +
+	"C\xa7\x86 \x06D\xa6_ \x06E\xa6\x15 \x06F\xa6\xe2 \x06G\xa8] \x06I\x991 \x06J\x99" +
+	"J \x06K\x99g \x06LQ\a \x06MI\xbe \x06N\x9fN \x06O\x9en \x06P\x9eS " +
+	"\x06Q\x9dI \x06R\x9d\x06 \x06S\x9d\xbe \x06T\x9f\x10 \x06U\xb6\x06 \x06V\x916 \x06W" +
+	"\x91M \x06X\x91h \x06\x8d\x1d\x9d \x06\x8e\xb3Q \x06\x8f\xb2& \x06\x90\xb1\xee \x06\x91\xb0\xa6" +
+	" \x06\x92\xb4u \x06\x93\xb4\x9b \x06\x94\xb5\x15 \x06\x95\x86a \x06\x96SF \x06\x97P\xde \x06" +
+	"\x98OT \x06\x99M\xfb \x06\xce\x7f\xbd \x06ϭ\x00 \x06Ь\xde \x06ѫ\x9a \x06Ү" +
+	"\xbb \x06ӲR \x06Ա\xf2 \x06\xd5\xc7= \x06֩' \x06ש[ \x06ة\x92 " +
+	"\x06٩\xcd \a\x0f}\xf9 \a\x10\xa9z \a\x11\xa86 \a\x12\xabW \a\x13\xae\xee \a\x14" +
+	"\xb1\xce \a\x15\xc5\xf7 \a\x16\xa8. \a\x17\xa8b \a\x18\xa8\x99 \a\x19\xa8\xd4 \aP|\xa0" +
+	" \aQ\xa5\xbd \aR\xa8) \aS\xab\x9d \aT\xae~ \aUť \aV\xa8\x0f \a" +
+	"W\xa8C \aX\xa8z \aY\xa8\xb6 \a\x91z\x13 \a\x92\xa4{ \a\x93\xa7\xf0 \a\x94\xaa" +
+	"\xd0 \a\x95\xc1\x84 \a\x96\xa7\x9e \a\x97\xa7\x17 \a\x98\xa7O \a\x99\xa7\x8a \a\xd2x1 " +
+	"\aӤ\x86 \aԧg \aս~ \a֤\xa8 \aק\xad \aا\a \a\xd9" +
+	"\xa7C \b\x13w$ \b\x14\xa4\xe1 \b\x15\xbap \b\x16\xa2\x95 \b\x17\xa5\x9a \b\x18\xa8\xa3" +
+	" \b\x19\xa8\x01 \bTup \bU\xb7I \bV\xa0Q \bW\xa3V \bX\xa6_ \b" +
+	"Y\xa9k \b\x95V\x04 \b\x96\xb5\xac \b\x97\xb9x \b\x98\xbdH \b\x99\xc1\x1c \b\xd6Q" +
+	"C \bו\xa5 \bؘ: \bٚ\xd3 \t\x17Q\x85 \t\x18\x98] \t\x19\x9a\xf6 " +
+	"\tXQ\xf4 \tY\x9b\x1d \t\x99R\x90 \x12@M\xf3 \x12BY\x9f \x12CY\x9f \x12D" +
+	"Yd \x12EV\xf8 \x12FS\xba \x12GQ\x94 \x12\x80N\xc0 \x12\x82Y\xb5 \x12\x83Y\xb5" +
+	" \x12\x84X\xb6 \x12\x85Yl \x12\x86V. \x12\x87T\b \x12\x89HJ \x12\xc0Oi \x12" +
+	"\xc2Z\x18 \x12\xc3Z\x18 \x12\xc4Y\x1a \x12\xc5Y\v \x12\xc6X\xf0 \x12\xc7V\xca \x12\xc9J" +
+	"\xb5 \x12\xcaK\x82 \x13\x00OV \x13\x02Z\x84 \x13\x03Z\x84 \x13\x04Y\x85 \x13\x05Yw " +
+	"\x13\x06X\x98 \x13\aY\x97 \x13\tM\x0e \x13\nM\xdb \x13\vN\x84 \x13@'g \x13B" +
+	"c\xb6 \x13Cc\xb6 \x13Db\xbc \x13Ee1 \x13Fc\xa5 \x13Gc\xd9 \x13IY\x10" +
+	" \x13JY\xd9 \x13KZ\x7f \x13LZo \x13\x80Go \x13\x82\xb3\xca \x13\x83\xb3\xa5 \x13" +
+	"\x84\xb2u \x13\x85\xb6l \x13\x86\xba\x18 \x13\x87\xbad \x13\x89LC \x13\x8aJ\xdc \x13\x8bJ" +
+	"( \x13\x8cI\xa7 \x13\xc0b? \x13\xc2Xg \x13Õ\x87 \x13ĔX \x13ŗ( " +
+	"\x13Ƙ\xd5 \x13ǜ\x94 \x13\xc9dG \x13\xcadY \x13\xcbd\xb7 \x13\xcce\x1f \x14\x00" +
+	"b; \x14\x02\x95\x83 \x14\x03W, \x14\x04\x91\xe8 \x14\x05\x93\xff \x14\x06\x95\x8a \x14\a\x99I" +
+	" \x14\tdC \x14\ndT \x14\vd\xb3 \x14\fe\x1a \x14@a6 \x14B\x94K \x14" +
+	"C\x91\xdb \x14DT\xb5 \x14E\x906 \x14F\x91\xc1 \x14G\x95\x80 \x14Ic\xfc \x14Jc" +
+	"O \x14Kc\xae \x14Ld\x15 \x14\x80c\xaa \x14\x82\x97\x1b \x14\x83\x93\xf3 \x14\x84\x904 " +
+	"\x14\x85R\xda \x14\x86\x8d\xfd \x14\x87\x91\xd8 \x14\x89a\xa4 \x14\x8ad\x00 \x14\x8bc\xa0 \x14\x8c" +
+	"d\b \x14\xc0b\x1d \x14\u0098\xbd \x14Õt \x14đ\xb4 \x14ō\xf1 \x14\xc6P`" +
+	" \x14ǎ' \x14\xc9^~ \x14\xca`\xda \x14\xcbc\x84 \x14\xccc, \x15\x00bE \x15" +
+	"\x02\x9c| \x15\x03\x992 \x15\x04\x95s \x15\x05\x91\xc9 \x15\x06\x8e\x1a \x15\aOc \x15\t\\" +
+	"` \x15\n^\xbc \x15\vae \x15\fd\x1c \x15@C\x99 \x15B}\xa9 \x15C}V " +
+	"\x15Dt\xc5 \x15Ej\xe5 \x15F`\xfe \x15GYv \x15IG\xa0 \x15JF\x8c \x15K" +
+	"E\xb7 \x15LE. \x15N\x80\x00 \x15Ox\xc1 \x15Pxq \x15Qp\x9e \x15Rg\x92" +
+	" \x15S^z \x15TW\x84 \x15\x80X\xfe \x15\x82e\x9b \x15\x83e\x9b \x15\x84ea \x15" +
+	"\x85c\x05 \x15\x86_\xdf \x15\x87]\xc9 \x15\x89&\x96 \x15\x8aSx \x15\x8bU\xd1 \x15\x8cX" +
+	"\x1a \x15\x8dWb \x15\x8eLc \x15\x8fa\xeb \x15\x90a\xeb \x15\x91a\xb7 \x15\x92_\x91 " +
+	"\x15\x93\\\xb3 \x15\x94Z\xcb \x15\x95H% \x15\xc0Y\xd1 \x15\xc2e\xb8 \x15\xc3e\xb8 \x15\xc4" +
+	"d\xbe \x15\xc5ep \x15\xc6bI \x15\xc7`4 \x15\xc9S\x81 \x15\xca'0 \x15\xcbV\xa4" +
+	" \x15\xccX\xed \x15\xcdX\x16 \x15\xceK\x05 \x15\xcfa\xf6 \x15\xd0a\xf6 \x15\xd1a\x16 \x15" +
+	"\xd2a\xb5 \x15\xd3^\xd7 \x15\xd4\\\xef \x15\xd5G\x13 \x15\xd6Rm \x16\x00Z\x81 \x16\x02f" +
+	"\" \x16\x03f! \x16\x04e( \x16\x05e\x1a \x16\x06e\x00 \x16\ab\xeb \x16\tU\xe5 " +
+	"\x16\nV\xae \x16\v'\xda \x16\fY\x9d \x16\rX\xab \x16\x0eJL \x16\x0fbH \x16\x10" +
+	"bG \x16\x11ag \x16\x12a[ \x16\x13aA \x16\x14_Z \x16\x15F: \x16\x16T\x8c" +
+	" \x16\x17U@ \x16@Zy \x16Bf\x94 \x16Cf\x94 \x16De\x9a \x16Ee\x8c \x16" +
+	"Fd\xb3 \x16Ge\xae \x16IX6 \x16JX\xff \x16KY\xa5 \x16L(j \x16MX" +
+	"\x9a \x16NI\xc4 \x16Ob\xa2 \x16Pb\xa2 \x16Qa\xc2 \x16Ra\xb6 \x16S`\xf0 " +
+	"\x16Ta\xd0 \x16UE\xa9 \x16VV\x9a \x16WWN \x16XW\xe3 \x16\x8d*\xbe \x16\x8e" +
+	"K\xd2 \x16\x8ff\f \x16\x90f\b \x16\x91e\a \x16\x92gn \x16\x93e\xe8 \x16\x94f\x0e" +
+	" \x16\x95H\a \x16\x96\\\xfa \x16\x97]\xca \x16\x98^x \x16\x99^r \x16\xce^\xc5 \x16" +
+	"ϵ\x0e \x16д\xe7 \x16ѳ\xb3 \x16ҷ\x96 \x16ӻ0 \x16Իp \x16Ն" +
+	"o \x16\xd6Pr \x16\xd7O\x1a \x16\xd8Nr \x16\xd9M\xfc \x17\x0fY\xe8 \x17\x10\x97: " +
+	"\x17\x11\x96\x06 \x17\x12\x98\xca \x17\x13\x9an \x17\x14\x9e\x13 \x17\x15\x80\x15 \x17\x16g\xee \x17\x17" +
+	"h\b \x17\x18hm \x17\x19h\xdc \x17PX\xa9 \x17Q\x93\x9e \x17R\x95\xac \x17S\x97/" +
+	" \x17T\x9a\xd3 \x17U\x7f\xc1 \x17Vg\xea \x17Wh\x04 \x17Xhi \x17Yh\xd8 \x17" +
+	"\x91V- \x17\x92\x91\xfb \x17\x93\x93~ \x17\x94\x97\" \x17\x95wN \x17\x96g\xa4 \x17\x97g" +
+	"\x02 \x17\x98gh \x17\x99g\xd7 \x17\xd2TW \x17ӏ\xd4 \x17ԓ\x91 \x17\xd5m\x9e " +
+	"\x17\xd6eZ \x17\xd7g\xb0 \x17\xd8g[ \x17\xd9g\xc9 \x18\x13Q\xe3 \x18\x14\x8f\xfa \x18\x15" +
+	"c\xe4 \x18\x16bG \x18\x17d\x9d \x18\x18g? \x18\x19f\xf3 \x18TP\xdb \x18U\\q" +
+	" \x18V`4 \x18Wb\x8a \x18Xe- \x18Yg\xdc \x18\x95\x1e6 \x18\x96K\xfa \x18" +
+	"\x97J\xee \x18\x98J\x1f \x18\x99I\x9b \x18\xd6)\xd8 \x18\xd7W\x84 \x18\xd8Y\xd9 \x18\xd9\\" +
+	"\x1d \x19\x17*w \x19\x18Z\xa8 \x19\x19\\\xed \x19X+' \x19Y]\x9b \x19\x99+\xbc " +
+	"\"@N\xf0 \"Ad\x0e \"CY\xf5 \"DY\xba \"EWq \"FT\xef \"G" +
+	"Q\xb6 \"\x80O\xbc \"\x81d$ \"\x83Z\v \"\x84Y\f \"\x85Y\xe5 \"\x86Wc" +
+	" \"\x87T* \"\x89IG \"\xc0Pf \"\xc1d\x87 \"\xc3Zn \"\xc4Yo \"" +
+	"\xc5Y\x84 \"\xc6Z% \"\xc7V\xeb \"\xc9K\xb2 \"\xcaL\x7f #\x00PS #\x01d" +
+	"\xf3 #\x03Z\xda #\x04Y\xdb #\x05Y\xf0 #\x06Y\xcd #\aY\xb8 #\tN\v " +
+	"#\nN\xd8 #\vO\x81 #@(t #Amt #Cd\a #Dc\x0e #E" +
+	"e\xa0 #Fd\xd3 #Gc\xfa #IZ\b #JZ\xd1 #K[w #L[g" +
+	" #\x80k\xc5 #\x81}h #\x83\xa1\x88 #\x84\xa0Y #\x85\xa3\xcd #\x86\xa6{ #" +
+	"\x87\xa5~ #\x89nC #\x8anU #\x8bn\xb3 #\x8co\x1b #\xc0G\xd3 #\xc1\xb5" +
+	"\xc9 #ðJ #į\x1a #ų\x11 #ƶ\xbd #Ǻ^ #\xc9L\xa7 " +
+	"#\xcaKA #\xcbJ\x8d #\xccJ\f $\x00b\x82 $\x01\xa1\xae $\x03W\xa8 $\x04" +
+	"\x93X $\x05\x95\x8a $\x06\x97\x9f $\a\x99I $\td\x8e $\nd\xa0 $\vd\xfe" +
+	" $\fef $@a} $A\xa0v $C\x93L $DU1 $E\x91\xc1 $" +
+	"F\x93\xd6 $G\x95\x80 $IdH $Jc\x9b $Kc\xf9 $Lda $\x80d" +
+	"\x10 $\x81\xa3\xf0 $\x83\x95\x7f $\x84\x91\xc0 $\x85Sy $\x86\x90. $\x87\x91\xd8 " +
+	"$\x89b\x12 $\x8adn $\x8bd\x0e $\x8cdv $\xc0cC $\xc1\xa6\xa0 $\xc3" +
+	"\x97\x94 $ē\xd5 $Ő+ $\xc6Q\xbd $ǎ( $\xc9_\xa4 $\xcab\x00" +
+	" $\xcbd\xa9 $\xccdR %\x00bh %\x01\xa5\xa4 %\x03\x994 %\x04\x95u %" +
+	"\x05\x91\xcb %\x06\x8e\x1c %\aOd %\t\\\x83 %\n^\xdf %\va\x88 %\fd" +
+	"? %@D\xe3 %A\x8dd %C~\x16 %Du\x1b %EkS %Fb@ " +
+	"%GY\xad %IH\xdf %JG\xcd %KF\xfa %LFt %O\x80\x00 %P" +
+	"y\x1f %Qp\xec %Rg\xf5 %S_\x9e %TW\xb8 %\x80Y\xf6 %\x81o\xd3" +
+	" %\x83e\xf1 %\x84e\xb6 %\x85c} %\x86a\r %\x87]\xeb %\x89'\xa4 %" +
+	"\x8aTp %\x8bV\xc9 %\x8cY\x12 %\x8dXB %\x8ekE %\x8fL\xc3 %\x90b" +
+	"8 %\x91b\x03 %\x92_\xfd %\x93]\xc4 %\x94Z\xea %\x95IG %\xc0Z\xc9 " +
+	"%\xc1o\xf0 %\xc3f\x0e %\xc4e\x14 %\xc5e\xe8 %\xc6cw %\xc7`U %\xc9" +
+	"Ty %\xca(> %\xcbW\x9c %\xccY\xe5 %\xcdX\xf6 %\xcekP %\xcfKd" +
+	" %\xd0bC %\xd1ac %\xd2b! %\xd3_\xe8 %\xd4]\x0e %\xd5H7 %" +
+	"\xd6SM &\x00[y &\x01pY &\x03fw &\x04e~ &\x05e\x92 &\x06f" +
+	"/ &\ac\f &\tV\xdd &\nW\xa6 &\v(\xe8 &\fZ\x95 &\rY\x8b " +
+	"&\x0ek\xa1 &\x0fJ\xab &\x10b\x94 &\x11a\xb4 &\x12a\xc7 &\x13bS &\x14" +
+	"_x &\x15Ga &\x16Ul &\x17V  &@[q &Ap\xcc &Cf\xe9" +
+	" &De\xf0 &Ef\x04 &Fe\xe2 &Ge\xcf &IY. &JY\xf7 &" +
+	"KZ\x9d &L)w &MYz &Nk\xfc &OJ# &Pb\xee &Qb" +
+	"\x0e &Rb! &Sb\x02 &Ta\xef &UF\xd3 &VWz &WX. " +
+	"&XX\xc3 &\x8d+\xc5 &\x8eo\\ &\x8fL2 &\x90fL &\x91eJ &\x92" +
+	"g\xcf &\x93g\x05 &\x94f/ &\x95II &\x96]\xec &\x97^\xbb &\x98_i" +
+	" &\x99_c &\xce~Y &϶\xff &Т\xf2 &ѡ\xbf &ҥ# &" +
+	"ӧ\xc3 &Ԧ\xcc &Տ\x1f &\xd6q\xb2 &\xd7q\xcb &\xd8r1 &\xd9r" +
+	"\x9f '\x0f]\xba '\x10\xb1\x92 '\x11\xb0^ '\x12\xb4A '\x13\xb7\xdb '\x14\xbbj " +
+	"'\x15\x86i '\x16P\xd2 '\x17O{ '\x18N\xd2 '\x19N\\ 'PY\x17 'Q" +
+	"\x94\xff 'R\x97& 'S\x991 'T\x9a\xd3 'U\x80p 'Vh2 'WhK" +
+	" 'Xh\xb1 'Yi\x1f '\x91V\x9b '\x92\x93u '\x93\x95\x80 '\x94\x97\" '" +
+	"\x95w\x95 '\x96g\xeb '\x97gJ '\x98g\xaf '\x99h\x1e '\xd2T\xe7 'ӑ" +
+	"\xf0 'ԓ\x92 '\xd5m\xfc '\xd6e\xc3 '\xd7h\x19 '\xd8g\xc4 '\xd9h3 " +
+	"(\x13S/ (\x14\x8f\xfa (\x15e\x14 (\x16cd (\x17e\xba (\x18h\\ (\x19" +
+	"h\x10 (TP\xdc (U\\\xa7 (V`V (Wb\xac (XeN (Yg\xfe" +
+	" (\x95\x1f< (\x96M1 (\x97L' (\x98K[ (\x99J\xd9 (\xd6*\xde (" +
+	"\xd7Xv (\xd8Z\xca (\xd9]\x0f )\x17+~ )\x18[\x9a )\x19]\xde )X," +
+	"- )Y^\x8c )\x99,\xc2 2@O\r 2Ad0 2Bc7 2DY% " +
+	"2EV\xdc 2FT} 2GR# 2\x80O\xda 2\x81dE 2\x82cM 2\x84" +
+	"X\x93 2\x85YP 2\x86V\xf1 2\x87T\x97 2\x89Id 2\xc0P\x83 2\xc1d\xa8" +
+	" 2\xc2c\xb0 2\xc4X\xf6 2\xc5X\xef 2\xc6Y\xb3 2\xc7WX 2\xc9K\xd0 2" +
+	"\xcaL\x9c 3\x00Pp 3\x01e\x14 3\x02d\x1c 3\x04Yb 3\x05Y[ 3\x06Y" +
+	"[ 3\aZ& 3\tN( 3\nN\xf5 3\vO\x9e 3@(u 3Am\x91 " +
+	"3Bl\x9f 3Db\x99 3Ee\x10 3Fdf 3Gde 3IZ% 3J" +
+	"Z\xee 3K[\x94 3L[\x84 3\x80k\xe2 3\x81}h 3\x82\xa9\xdf 3\x84\xa0r" +
+	" 3\x85\xa3\xed 3\x86\xa6\xb4 3\x87\xa5\xb8 3\x89nd 3\x8anv 3\x8bn\xd5 3" +
+	"\x8co< 3\xc0j\xe0 3\xc1\xa9\xcd 3\xc2{\x0e 3ĝ0 3Š\xac 3ƣ" +
+	"s 3Ǧ7 3\xc9mb 3\xcamt 3\xcbm\xd2 3\xccn: 4\x00G\xcf " +
+	"4\x01\xb5\xc5 4\x02\xb1\x9d 4\x04\xac* 4\x05\xafO 4\x06\xb2\xda 4\a\xb6z 4\t" +
+	"L\xa3 4\nK= 4\vJ\x89 4\fJ\b 4@a\x06 4A\xa0\x8c 4B\x9dU" +
+	" 4DU, 4E\x92\x86 4F\x94\xbb 4G\x97\n 4Ic\xb6 4Jc$ 4" +
+	"Kc\x82 4Lc\xea 4\x80c\x7f 4\x81\xa4\r 4\x82\xa0\xd6 4\x84\x92\x85 4\x85S" +
+	"t 4\x86\x91\x14 4\x87\x93b 4\x89a\x80 4\x8ac\xdc 4\x8bc| 4\x8cc\xe4 " +
+	"4\xc0b\xd4 4\xc1\xa6\xd9 4£\xa2 4Ĕ\xbc 4ő\x12 4\xc6Q\xdb 4\xc7" +
+	"\x8f\xcd 4\xc9_4 4\xcaa\x91 4\xcbd: 4\xccc\xe3 5\x00b\xd3 5\x01\xa5\xdd" +
+	" 5\x02\xa6k 5\x04\x97\v 5\x05\x93a 5\x06\x8f\xcc 5\aPc 5\t\\\xed 5" +
+	"\n_J 5\va\xf3 5\fd\xaa 5@E6 5A\x8d\xb7 5B\x8c\xf9 5Du" +
+	"I 5Ek\x84 5Fbt 5GY\xe3 5II' 5JH\x18 5KGH " +
+	"5LF\xc5 5P\x80\x00 5Qq\x16 5Rh\" 5S_\xce 5TW\xea 5\x80" +
+	"Z\x13 5\x81o\xf5 5\x82o\x03 5\x84e& 5\x85b\xed 5\x86`\x9f 5\x87^U" +
+	" 5\x89'\xa4 5\x8aT\x8d 5\x8bV\xe7 5\x8cY/ 5\x8dX] 5\x8ekc 5" +
+	"\x8fj\x88 5\x90L\xc3 5\x91a\x81 5\x92_{ 5\x93]b 5\x94[L 5\x95I" +
+	"\x8a 5\xc0Z\xe6 5\xc1p\x11 5\xc2o  5\xc4d\x9f 5\xc5eW 5\xc6c\n " +
+	"5\xc7`\xc0 5\xc9T\x97 5\xca(? 5\xcbW\xb9 5\xccZ\x02 5\xcdY\x11 5\xce" +
+	"kn 5\xcfj\x93 5\xd0Kd 5\xd1`\xf9 5\xd2a\x9f 5\xd3_\x86 5\xd4]p" +
+	" 5\xd5H~ 5\xd6Sh 6\x00[\x96 6\x01p{ 6\x02o\x89 6\x04e\t 6" +
+	"\x05e\x02 6\x06e\xc1 6\acw 6\tV\xfa 6\nW\xc3 6\v(\xe9 6\fZ" +
+	"\xb2 6\rY\xa6 6\x0ek\xc0 6\x0fj\xe5 6\x10J\xab 6\x11aK 6\x12aE " +
+	"6\x13a\xf0 6\x14_\xdb 6\x15G\xaa 6\x16U\x86 6\x17V; 6@[\x8e 6A" +
+	"p\xed 6Bo\xfb 6De{ 6Eet 6Fet 6Gf: 6IYK" +
+	" 6JZ\x14 6KZ\xba 6L)x 6MY\x95 6Nl\x1a 6Ok? 6" +
+	"PJ# 6Qa\xa5 6Ra\x9f 6Sa\x9f 6TbQ 6UG\x1e 6VW" +
+	"\x95 6WXI 6XX\xde 6\x8d+\xc5 6\x8eoz 6\x8fn{ 6\x90L. " +
+	"6\x91d\xd6 6\x92g@ 6\x93f\x98 6\x94f\x98 6\x95I\x9b 6\x96^\t 6\x97" +
+	"^\xd9 6\x98_\x86 6\x99_\x81 6\xce~Y 6Ϫ\xe3 6ж\xfb 6ѡ\xd6" +
+	" 6ҥA 6ӧ\xfb 6ԧ\x04 6Տq 6\xd6q\xd3 6\xd7q\xed 6" +
+	"\xd8rR 6\xd9r\xc1 7\x0f{\xf4 7\x10\xb2\xdb 7\x11\x9e\xa5 7\x12\xa2\x10 7\x13\xa4" +
+	"\xc9 7\x14\xa7\x80 7\x15\x8e\xa7 7\x16p\xd5 7\x17p\xee 7\x18qT 7\x19q\xc3 " +
+	"7P\\o 7Q\xadx 7R\xb0\x8c 7S\xb4\x04 7T\xb7\x93 7U\x86e 7V" +
+	"P\xce 7WOw 7XN\xce 7YNX 7\x91V\x94 7\x92\x948 7\x93\x96c" +
+	" 7\x94\x98\xa6 7\x95w\xc1 7\x96g] 7\x97f\xd6 7\x98g; 7\x99g\xaa 7" +
+	"\xd2T\xe0 7Ӓ\xd2 7ԕ\x16 7\xd5n+ 7\xd6e5 7\xd7g\x8b 7\xd8g" +
+	"5 7\xd9g\xa4 8\x13SJ 8\x14\x91\x98 8\x15eE 8\x16b\xf7 8\x17eM " +
+	"8\x18g\xef 8\x19g\xa3 8TQ\xd5 8U\\\xdb 8V`\xbe 8Wc\x14 8X" +
+	"e\xb6 8Yhf 8\x95\x1f= 8\x96Mx 8\x97Lq 8\x98K\xa7 8\x99K)" +
+	" 8\xd6*\xde 8\xd7X\x93 8\xd8Z\xe8 8\xd9], 9\x17+~ 9\x18[\xb7 9" +
+	"\x19]\xfb 9X,- 9Y^\xa9 9\x99,\xc3 B@R\x94 BAg\xa5 BBf" +
+	"\xac BCf\xac BEZ\x1d BFW\xbe BGUd B\x80Sq B\x81g\xb5 " +
+	"B\x82f\xbd B\x83f\xbd B\x85\\\x94 B\x86Z5 B\x87W\xdb B\x89L\xeb B\xc0" +
+	"T\x1a B\xc1h\x18 B\xc2g  B\xc3g  B\xc5\\3 B\xc6\\\xf7 B\xc7Z\x9c" +
+	" B\xc9OV B\xcaP3 C\x00T\a C\x01h\x84 C\x02g\x8c C\x03g\x8c C" +
+	"\x05\\\x9f C\x06\\\x9f C\a]j C\tQ\xaf C\nR\x8c C\vS5 C@," +
+	"\xbc CAp\xc8 CBo\xd6 CCo\xd6 CEhC CFg\x98 CGg\x98 " +
+	"CI]z CJ^R CK^\xf9 CL^\xe9 C\x80o\" C\x81\x80H C\x82" +
+	"\xab\x15 C\x83\xaa\xf4 C\x85\xa7\x17 C\x86\xa9\xe3 C\x87\xa8\xe6 C\x89q\xa9 C\x8aq\xb5" +
+	" C\x8br\x14 C\x8cr{ C\xc0n\x1f C\xc1\xab\x04 C\xc2}\xee Cç8 C" +
+	"ţ\xd6 CƦ\xa1 Cǩf C\xc9p\xa6 C\xcap\xb3 C\xcbq\x12 C\xccq" +
+	"y D\x00n\x1b D\x01\xaa\xe1 D\x02\xa74 D\x03|\xf4 D\x05\xa0\xbc D\x06\xa3l " +
+	"D\a\xa61 D\tp\xa2 D\np\xaf D\vq\r D\fqu D@K+ DA" +
+	"\xb6\x17 DB\xb1\xef DC\xb1\xa9 DE\xae\xfc DF\xb2\x8a DG\xb6+ DIP\x8b" +
+	" DJN\x98 DKM\xe4 DLMd D\x80f\x98 D\x81\xa7\x17 D\x82\xa3\xe1 D" +
+	"\x83\xa0\xc4 D\x85U} D\x86\x94\xae D\x87\x96\xfd D\x89d\x91 D\x8af\xf6 D\x8bf" +
+	"\x95 D\x8cf\xfd D\xc0e\xed D\xc1\xa9\xe7 D¦\xb0 Dãx DŔ\xad " +
+	"D\xc6S\xe4 DǓg D\xc9bE D\xcad\xaa D\xcbgS D\xccf\xfc E\x00" +
+	"e\xec E\x01\xa8\xeb E\x02\xa9y E\x03\xa6A E\x05\x96\xfc E\x06\x93f E\aRk" +
+	" E\t_\xfe E\nbc E\ve\f E\fg\xc3 E@Ik EA\x96F E" +
+	"B\x95\xf2 EC\x95\xc6 EEm\x93 EFc\xce EG[\xd8 EIIt EJL" +
+	"E EKKx ELJ\xf7 EQ\x80\x00 ERj\x04 ESa\n ETY\xb3 " +
+	"E\x80]q E\x81s: E\x82rH E\x83rH E\x85f  E\x86c\xd2 E\x87" +
+	"a\x89 E\x89+\xc6 E\x8aW\xec E\x8bZE E\x8c\\\x8d E\x8d[b E\x8enY" +
+	" E\x8fm~ E\x90m~ E\x91PM E\x92b\\ E\x93`C E\x94^- E" +
+	"\x95I\xca E\xc0^J E\xc1sH E\xc2rV E\xc3rV E\xc5h\x8a E\xc6f" +
+	"< E\xc7c\xf3 E\xc9W\xeb E\xca,\x86 E\xcb[\x1e E\xcc]f E\xcd\\$ " +
+	"E\xcen_ E\xcfm\x84 E\xd0m\x84 E\xd1Np E\xd2d\x83 E\xd3bj E\xd4" +
+	"`T E\xd5LG E\xd6Vm F\x00^\xfa F\x01s\xb1 F\x02r\xc0 F\x03r\xbf" +
+	" F\x05h5 F\x06h\xf3 F\af\xaa F\tZO F\n[' F\v-0 F" +
+	"\f^\x16 F\r\\\xb9 F\x0en\xb0 F\x0fm\xd5 F\x10m\xd5 F\x11M\xb7 F\x12d" +
+	") F\x13d\xd4 F\x14b\xbf F\x15Kv F\x16X\x8c F\x17YN F@^\xf3 " +
+	"FAt$ FBs2 FCs2 FEh\xa7 FFh\xa7 FGim FI" +
+	"\\\xa0 FJ]x FK^\x1f FL-\xbf FM\\\xa8 FNo\v FOn0" +
+	" FPn0 FQM/ FRd\x84 FSd\x84 FTe5 FUJ\xed F" +
+	"VZ\x9a FW[\\ FX[\xf1 F\x8d/\xea F\x8er\x9a F\x8fq\x9c F\x90q" +
+	"\x97 F\x91Oj F\x92j; F\x93i\x93 F\x94i\x93 F\x95M\xac F\x96aI " +
+	"F\x97b\x1d F\x98b\xca F\x99b\xc5 F\u0381% FϬ\n FЫ\xe7 F\xd1" +
+	"\xb7@ FҨ4 FӪ\xf1 Fԩ\xfa F\u0557\xd2 F\xd6u\x03 F\xd7u\r" +
+	" F\xd8ur F\xd9u\xe1 G\x0f~\xbf G\x10\xa8B G\x11\xb3! G\x12\xa5\x02 G" +
+	"\x13\xa7\xc0 G\x14\xaaw G\x15\x97p G\x16t\x05 G\x17t\x0f G\x18tt G\x19t" +
+	"\xe3 GP}\xc1 GQ\xb2\xdc GR\xa1\xf6 GS\xa4\x99 GT\xa7P GU\x97B " +
+	"GVt\x00 GWt\n GXtp GYt\xdf G\x91^Z G\x92\xb0\x13 G\x93" +
+	"\xb3\x8f G\x94\xb7\x1e G\x95\x86c G\x96T\x9e G\x97R\xb3 G\x98R\n G\x99Q\x94" +
+	" G\xd2V\xc8 GӖ< GԘ\x80 G\xd5p\x00 G\xd6h. G\xd7j\x86 G" +
+	"\xd8j0 G\xd9j\x9f H\x13U2 H\x14\x95\x01 H\x15fg H\x16e\xf0 H\x17h" +
+	"H H\x18j\xea H\x19j\x9e HTS\xbd HU^\x95 HVc\xb8 HWf\x0f " +
+	"HXh\xb1 HYka H\x95#\xa2 H\x96M\xbb H\x97Pz H\x98O\xb3 H\x99" +
+	"O7 H\xd6.\xe7 H\xd7[\xd3 H\xd8^' H\xd9`k I\x17/\xa3 I\x18^\xfb" +
+	" I\x19a@ IX0R IYa\xed I\x990\xe8 R@VE RAkj R" +
+	"Bjr RCjq RDh\xb3 RFZ. RGW\xd3 R\x80V\xaf R\x81k" +
+	"\x15 R\x82j\x1d R\x83j\x1d R\x84g\xce R\x86\\\x85 R\x87Z+ R\x89O: " +
+	"R\xc0W\x8d R\xc1ks R\xc2j{ R\xc3j{ R\xc4h, R\xc6_I R\xc7" +
+	"\\\xef R\xc9R; R\xcaR\xa5 S\x00Wz S\x01k\xdf S\x02j\xe7 S\x03j\xe7" +
+	" S\x04h\x98 S\x06^\xf2 S\a_\xbc S\tT\x93 S\nT\xfe S\vU\xdb S" +
+	"@0\x9b SAvu SBuh SCuh SDsM SFj\xb3 SGj" +
+	"\xb3 SIa\" SJak SKbD SLb4 S\x80t\xd6 S\x81\x83i " +
+	"S\x82\xab\xd9 S\x83\xab\xb8 S\x84\xab\xe8 S\x86\xac& S\x87\xabP S\x89ub S\x8a" +
+	"t\xee S\x8buH S\x8cu\xaf S\xc0s\xb8 S\xc1\xab\xc8 S\u0081\x0f Sç\xfc" +
+	" SĨ, Sƨ\xe4 Sǫ\xad S\xc9t` S\xcas\xec S\xcbtF S" +
+	"\xcct\xad T\x00s\xb4 T\x01\xab\xa5 T\x02\xa7\xf8 T\x03\x80\x15 T\x04\xa5\xbb T\x06\xa5" +
+	"\xaf T\a\xa8x T\tt\\ T\ns\xe8 T\vtA T\ft\xa9 T@qn " +
+	"TA\xab\xad TB\xa8\x00 TC\xa5\x8d TD|U TF\xa2Q TG\xa5\x1a TI" +
+	"r\x9d TJq\xa2 TKq\xfc TLrc T\x80N\xe9 T\x81\xba\xc0 T\x82\xb6\x98" +
+	" T\x83\xb6S T\x84\xb1h T\x86\xb2\xb2 T\x87\xb6W T\x89P[ T\x8aR\x06 T" +
+	"\x8bP\xc6 T\x8cPE T\xc0h\xe3 T\xc1\xab\xfe T¨\xc7 Tå\x8f TĢ" +
+	"] T\xc6T\xeb Tǖ\xdb T\xc9d\x9b T\xcaf\xcf T\xcbi\x80 T\xcci) " +
+	"U\x00h\xe3 U\x01\xab( U\x02\xab\x94 U\x03\xa8\\ U\x04\xa5* U\x06\x96\xd9 U\a" +
+	"Ss U\tbT U\nd\x88 U\vg9 U\fi\xf0 U@M\x91 UA\xa0$" +
+	" UB\x9f\xb8 UC\x9f\x89 UD\x9dz UFd\x8d UG\\\x1c UIM[ U" +
+	"JK\x8e UKN\xa0 ULN\" UR\x80\x00 USa\xbc UTY\xf3 U\x80`" +
+	"\xf3 U\x81v\xc5 U\x82u\xd4 U\x83u\xd3 U\x84t? U\x86f/ U\x87c\xe5 " +
+	"U\x89,\xc1 U\x8aZ\x10 U\x8b\\\xfd U\x8c_F U\x8d^\xa8 U\x8eq\xb1 U\x8f" +
+	"p\xd6 U\x90p\xd6 U\x91od U\x92P% U\x93bm U\x94`W U\x95M^" +
+	" U\xc0ac U\xc1vx U\xc2u\x86 U\xc3u\x86 U\xc4sk U\xc6h\x85 U" +
+	"\xc7f; U\xc9Z2 U\xca/\x89 U\xcb]m U\xcc_\xb6 U\xcd^\xea U\xceq" +
+	"B U\xcfpg U\xd0pg U\xd1nx U\xd2Q\x8b U\xd3dv U\xd4ba " +
+	"U\xd5K\x9c U\xd6Xz V\x00b; V\x01v\xd2 V\x02u\xe0 V\x03u\xe0 V\x04" +
+	"s\xc6 V\x06k< V\ah\xf2 V\t]$ V\n]m V\v0Y V\f`\x8f" +
+	" V\r_\xac V\x0eq\x8e V\x0fp\xb3 V\x10p\xb3 V\x11n\xc4 V\x12PT V" +
+	"\x13f\xe4 V\x14d\xce V\x15NU V\x16[\x1d V\x17[_ V@b4 VAw" +
+	"D VBvS VCvR VDt8 VFj\xef VGk\xb5 VI_u " +
+	"VJ_\xbe VK`\x97 VL0\xe8 VM_\x9b VNq\xe9 VOq\x0e VP" +
+	"q\x0e VQo\x1f VRO\xcc VSf\x93 VTgE VUM\xce VV]," +
+	" VW]n VX^0 V\x8d3\xb4 V\x8ex- V\x8fw\x14 V\x90w\x10 V" +
+	"\x91t\xd5 V\x92S\r V\x93ly V\x94lx V\x95Q\xbc V\x96d\xb6 V\x97e" +
+	"% V\x98e\xf9 V\x99e\xf3 V΄= VϬ\xc3 VЬ\xa1 VѬ\xab " +
+	"Vһ\xd4 Vӭ\x01 VԬ0 Vա\x8a V\xd6x\x81 V\xd7x4 V\xd8" +
+	"x\x8a V\xd9x\xf9 W\x0f\x81\xd8 W\x10\xa8\xfc W\x11\xa9\x06 W\x12\xb7\xb5 W\x13\xa9\xd0" +
+	" W\x14\xac\x8a W\x15\xa1\x10 W\x16w\x83 W\x17w6 W\x18w\x8c W\x19w\xfb W" +
+	"P\x80\xd9 WQ\xa6\x9f WR\xb7o WS\xa6\xa9 WT\xa9c WU\xa0\xe0 WVw" +
+	"\x7f WWw2 WXw\x88 WYw\xf7 W\x91}\n W\x92\xb2q W\x93\xa3j " +
+	"W\x94\xa6$ W\x95\x9e\xb1 W\x96u\xc4 W\x97t\xf7 W\x98uM W\x99u\xbc W\xd2" +
+	"_\xfe Wӳ\x90 WԷ\" WՆb W\xd6TE W\xd7V\r W\xd8T\xd0" +
+	" W\xd9TZ X\x13V\x1d X\x14\x98F X\x15f\xf3 X\x16h# X\x17j\\ X" +
+	"\x18m\x00 X\x19l\xb4 XTT\xa8 XU^\xa7 XVe\xea XWh# XXj" +
+	"\xc7 XYmw X\x95&\xf0 X\x96Qc X\x97O\xc0 X\x98R\xbf X\x99RF " +
+	"X\xd6/\xc8 X\xd7]\xe7 X\xd8`\xc4 X\xd9c\t Y\x172\x96 Y\x18a4 Y\x19" +
+	"cx YX3a YYdL Y\x993\xf6 b@X\xe7 bAo9 bBn%" +
+	" bCn$ bDlf bEh\xd4 bGZK b\x80Yg b\x81n\xe4 b" +
+	"\x82m\xd0 b\x83m\xd0 b\x84k\x81 b\x85j\x9e b\x87\\\xa8 b\x89Q\xd3 b\xc0Y" +
+	"\x15 b\xc1n\xba b\xc2m\xa6 b\xc3m\xa6 b\xc4kW b\xc5i\xc8 b\xc7_1 " +
+	"b\xc9T| b\xcaT\xe7 c\x00Y\xf2 c\x01oD c\x02n0 c\x03n0 c\x04" +
+	"k\xe1 c\x05jR c\ab\x1d c\tWp c\nW\xf0 c\vW\x9e c@3\xce" +
+	" cAx\xca cBw\xbc cCw\xbc cDu\xa1 cEu\xb0 cGl\xce c" +
+	"Ic\xb4 cJd\x12 cKc\xa6 cLd~ c\x80w> c\x81\x86\x98 c\x82\xad" +
+	"\x92 c\x83\xadp c\x84\xac\xe9 c\x85\xb1\xfb c\x87\xae\x81 c\x89y$ c\x8ax\xb0 " +
+	"c\x8bxg c\x8cx\xec c\xc0v  c\xc1\xad\x82 c\u0084! cé\xa0 c\xc4" +
+	"\xa9\x18 cŮ+ cǮ\x04 c\xc9x\a c\xcaw\x93 c\xcbwI c\xccw\xcf" +
+	" d\x00v\x1c d\x01\xad_ d\x02\xa9\x9c d\x03\x83& d\x04\xa6\xa7 d\x05\xab\x11 d" +
+	"\a\xaa\xcf d\tx\x03 d\nw\x8f d\vwE d\fw\xca d@s\xd6 dA\xac" +
+	"\xaf dB\xa8\xec dC\xa6y dD\x7fg dE\xa7\xac dG\xa7q dIvD " +
+	"dJuI dKt\xff dLu\x85 d\x80s\xb7 d\x81\xb1\x99 d\x82\xad\xd6 d\x83" +
+	"\xaa\xb9 d\x84\xa7\x81 d\x85|\xa0 d\x87\xa5\x05 d\x89r\xb6 d\x8atd d\x8bsx" +
+	" d\x8cs\xfd d\xc0Q\xbe d\xc1\xbe\x9c dºs dú- dĵB d" +
+	"ŴO dǶp d\xc9P= d\xcaQ\xe8 d\xcbT# d\xccS8 e\x00j" +
+	"\xe3 e\x01\xae0 e\x02\xad\xbf e\x03\xaa\x88 e\x04\xa7V e\x05\xa5\x17 e\aT\x8b " +
+	"e\td\xb2 e\nf\xeb e\viQ e\fl+ e@P\xad eA\xaa\v eB" +
+	"\xa8\xc9 eC\xa8\x97 eD\xa7= eE\xa6\x80 eG\\\xaf eIN^ eJO\x84" +
+	" eKM\xf8 eLQZ eS\x80\x00 eTZ| e\x80ci e\x81z\\ e" +
+	"\x82yM e\x83yM e\x84w\xb8 e\x85t] e\x87fI e\x89-% e\x8a\\" +
+	"\x8f e\x8b_\x15 e\x8ca\xf6 e\x8d`\xff e\x8eu\x12 e\x8ft\x1e e\x90t\x1e " +
+	"e\x91r\xac e\x92o\x83 e\x93P\f e\x94b\x88 e\x95NM e\xc0c\xee e\xc1" +
+	"z\x0e e\xc2x\xff e\xc3x\xff e\xc4v\xe5 e\xc5v* e\xc7h\xa5 e\xc9\\\xb5" +
+	" e\xca0\x95 e\xcb_\x85 e\xccb{ e\xcdaS e\xcet\xa3 e\xcfs\xaf e" +
+	"\xd0s\xaf e\xd1q\xc0 e\xd2q\x0f e\xd3Qs e\xd4d\x97 e\xd5O> e\xd6Z" +
+	"\xc8 f\x00c\xa6 f\x01y\xea f\x02x\xdc f\x03x\xdc f\x04v\xc1 f\x05ue " +
+	"f\ak, f\t_] f\n_\xa6 f\v3K f\fb4 f\r`\xef f\x0e" +
+	"t[ f\x0fsh f\x10sg f\x11qy f\x12p2 f\x13Sa f\x14f\xce" +
+	" f\x15M\xb9 f\x16]\x1d f\x17]_ f@d\x7f fAzp fByb f" +
+	"Cya fDwG fEu\xeb fGn\n fIbB fJb\xa0 fKb" +
+	"4 fL4\" fMa\xb1 fNt\xcf fOs\xdc fPs\xdc fQq\xed " +
+	"fRp\xa6 fSRy fTi` fUP\xbb fV_\xb6 fW`\v fX" +
+	"_\xa7 f\x8d6\xcb f\x8ez| f\x8fyc f\x90y_ f\x91w$ f\x92w\a" +
+	" f\x93U\xc6 f\x94na f\x95T\xba f\x96g\x12 f\x97g\x95 f\x98gQ f" +
+	"\x99h% f·` fϮn fЮL fѭ\xa1 fҲx fӿ" +
+	"\x9b fԯ+ fիI f\xd6|\b f\xd7{\xbb f\xd8{\x98 f\xd9|\x19 " +
+	"g\x0f\x84\xe0 g\x10\xaa\x93 g\x11\xa9\xe8 g\x12\xae\xbf g\x13\xbb| g\x14\xae\xae g\x15" +
+	"\xa9\xff g\x16z\xef g\x17z\xa2 g\x18z\x7f g\x19{\x00 gP\x83\xe2 gQ\xa7\x81" +
+	" gR\xab\xb3 gS\xbb7 gT\xab\x87 gU\xa9\xcc gVz\xeb gWz\x9e g" +
+	"Xz{ gYz\xfc g\x91\x80\x12 g\x92\xa8n g\x93\xb69 g\x94\xa8H g\x95\xa8" +
+	"P g\x96y0 g\x97xc g\x98x@ g\x99x\xc1 g\xd2}8 gӵ# " +
+	"gԥ\xf9 gէm g\xd6u\xc7 g\xd7w~ g\xd8v\xc0 g\xd9wA h\x13" +
+	"a\xda h\x14\xb7\x15 h\x15\x86a h\x16S\xfd h\x17U\xc5 h\x18X\x1a h\x19W1" +
+	" hTU\xa5 hU_\a hVh% hWjc hXl\xcf hYo\x99 h" +
+	"\x95*M h\x96R3 h\x97Sx h\x98R\x14 h\x99Ua h\xd60\x15 h\xd7`" +
+	"@ h\xd8b\xcc h\xd9e\x9f i\x173\x87 i\x18c; i\x19f\" iX6B " +
+	"iYe\xde i\x997\x15 r@[\xbf rAq\xed rBq\xed rCq\xed rD" +
+	"p\x12 rEl\x80 rFi\x00 r\x80\\? r\x81q\x9b r\x82q\x9b r\x83q\x9b" +
+	" r\x84o0 r\x85nM r\x86j\xcd r\x89S\xd0 r\xc0\\\x03 r\xc1qq r" +
+	"\xc2qq r\xc3qp r\xc4o\x06 r\xc5mw r\xc6l\xc9 r\xc9V\xfb r\xcaW" +
+	"{ s\x00Y# s\x01p\xb6 s\x02p\xb6 s\x03p\xb6 s\x04nK s\x05l\xbd " +
+	"s\x06k\\ s\tY\x01 s\nY\x81 s\vY0 s@7\b sAz\xe4 sB" +
+	"z\xe3 sCz\xe3 sDx\xac sEx\xba sFwP sIf} sJf\xdc" +
+	" sKf\x83 sLc\xbc s\x80y_ s\x81\x88k s\x82\xae) s\x83\xae\b s" +
+	"\x84\xad~ s\x85\xb2\x91 s\x86\xb6\xee s\x89{\xc9 s\x8a{W s\x8b{\x0e s\x8cz" +
+	"8 s\xc0yY s\xc1\xae# s\u0087R sí\xe3 sĪ\xf7 sůR " +
+	"sƳ\x8c s\xc9{\xc3 s\xca{Q s\xcb{\a s\xccz2 t\x00yT t\x01" +
+	"\xae\x00 t\x02\xad\xdf t\x03\x86X t\x04\xa8\x86 t\x05\xac8 t\x06\xb0W t\t{\xbf" +
+	" t\n{M t\v{\x03 t\fz- t@v\xf3 tA\xadP tB\xaa\xcc t" +
+	"C\xa8Y tD\x82{ tE\xa8\xbe tF\xac\xde tIy\xe5 tJx\xec tKx" +
+	"\xa2 tLw\xcc t\x80v\xd4 t\x81\xb2: t\x82\xae\xfe t\x83\xab\xe2 t\x84\xa8\x93 " +
+	"t\x85\x7f\xb4 t\x86\xaa\xe6 t\x89vW t\x8ax\a t\x8bw\x1b t\x8cvE t\xc0" +
+	"u@ t\xc1\xb6p t³\x11 tï\xd9 tĬ\x8b tŪ\xbc t\xc6}5" +
+	" t\xc9r\xdb t\xcat\x8b t\xcbvl t\xcct\xeb u\x00T\xb1 u\x01\xbe\xe1 u" +
+	"\x02\xbe\x98 u\x03\xbeR u\x04\xb9f u\x05\xb8r u\x06\xb8\" u\tP! u\nQ" +
+	"\xce u\vT\t u\fU\xc2 u@S\xe4 uA\xb1\x94 uB\xb1] uC\xb1( " +
+	"uD\xaf1 uE\xae\xef uF\xae^ uIN\x9d uJP\x86 uKQ\xed uL" +
+	"P\xaf uT\x80\x00 u\x80f\x15 u\x81|\xde u\x82|\xdd u\x83|\xdd u\x84{," +
+	" u\x85w\xd0 u\x86t\x86 u\x89-n u\x8a^y u\x8ba{ u\x8ccj u" +
+	"\x8dc\x85 u\x8ewy u\x8fwy u\x90wy u\x91u\xef u\x92r\xc5 u\x93o" +
+	"\xab u\x94O\xf5 u\x95N\x8a u\xc0f\x9a u\xc1|\x92 u\xc2|\x92 u\xc3|\x91 " +
+	"u\xc4zZ u\xc5y\x9f u\xc6vU u\xc9^\x9f u\xca0\xf9 u\xcbb\x00 u\xcc" +
+	"c\xef u\xcdc\xda u\xcew\f u\xcfw\f u\xd0w\f u\xd1u\x05 u\xd2tS" +
+	" u\xd3q9 u\xd4Q^ u\xd5P, u\xd6\\\x8c v\x00fh v\x01|n v" +
+	"\x02|n v\x03|n v\x04z7 v\x05x\xdb v\x06xS v\ta\xc6 v\nb" +
+	"$ v\v4W v\fc\xa7 v\rc\x89 v\x0ev\xc5 v\x0fv\xc4 v\x10v\xc4 " +
+	"v\x11t\xbd v\x12sv v\x13r\xf4 v\x14SL v\x15QY v\x16_U v\x17" +
+	"_\xaa v@c\xbc vA{\xbe vB{\xbe vC{\xbe vDy\x87 vEx+" +
+	" vFv\xfb vIc\xce vJd, vKc\xc0 vL69 vM`\xfb v" +
+	"Nu\xff vOu\xff vPu\xff vQs\xf7 vRr\xb1 vSq\x92 vTT" +
+	"\xc7 vUP\x1e vVa\x1b vWap vXa\f v\x8d9\xea v\x8e|\x86 " +
+	"v\x8f|\x80 v\x90|{ v\x91z& v\x92z\b v\x93x{ v\x94X\x9d v\x95" +
+	"W\xd4 v\x96i\xa4 v\x97j' v\x98i\xf7 v\x99ga vΉ$ vϯ\x05" +
+	" vЮ\xe3 vѮ8 vҳ\x0f vӷ4 vԿ\xd6 vղ\xac v" +
+	"\xd6~v v\xd7~+ v\xd8~\b v\xd9}] w\x0f\x88\x06 w\x10\xae\xbe w\x11\xab" +
+	"\xb9 w\x12\xaf\xdb w\x13\xb3\xde w\x14\xbf\x8b w\x15\xb2r w\x16~p w\x17~% " +
+	"w\x18~\x02 w\x19}W wP\x87\a wQ\xa9R wR\xac\xcf wS\xb0\xb7 wT" +
+	"\xbfF wU\xb2< wV~l wW~! wX}\xfe wY}S w\x91\x83\x1d" +
+	" w\x92\xa9v w\x93\xad^ w\x94\xbaH w\x95\xb0( w\x96|\x96 w\x97{\xcb w" +
+	"\x98{\xa8 w\x99z\xfd wҀC wӫ| wԹ2 wկ\xbf w\xd6y" +
+	"- w\xd7z\xe6 w\xd8z( w\xd9y} x\x13}\xaf x\x14\xb8\xbd x\x15\xaf\t " +
+	"x\x16u\xd6 x\x17w\x8f x\x18yy x\x19x* xTc\xda xU\x86a xV" +
+	"S\xb8 xWU\x81 xXW\xd6 xYY\xad x\x95-\xa5 x\x96RA x\x97TF" +
+	" x\x98U\xca x\x99T\xb5 x\xd60G x\xd7b\t x\xd8e\x0e x\xd9g\x06 y" +
+	"\x173\xd4 y\x18e\x91 y\x19g\x89 yX74 yYgE y\x999 $\x02\x81\xab" +
+	",$\x02\x82\xaa/$\x02\x83\xa96$\x02\x84\xa9\x1d$\x02\x85\xa8\xd8$\x02\x86\xa6\xb3$\x02\x87\xa4W$" +
+	"\x02\x88\xa2\x02$\x02\xc1\xab`$\x02ªc$\x02éj$\x02ĩQ$\x02ŨI$\x02\xc6" +
+	"\xa9\xde$\x02ǧ\x82$\x02ȥ-$\x02ʙ\xcb$\x03\x01\xab\x94$\x03\x02\xaa\x97$\x03\x03\xa9\x9e" +
+	"$\x03\x04\xa9\x85$\x03\x05\xa8}$\x03\x06\xa9,$\x03\a\xaa\xad$\x03\b\xa8X$\x03\n\x9cz$\x03" +
+	"\v\x9c\x98$\x03A\xb8\xdf$\x03B\xb7\xb0$\x03C\xb6\x84$\x03D\xb6K$\x03E\xb9X$\x03F\xb9" +
+	"r$\x03G\xba\f$\x03H\xba\xce$\x03JNK$\x03KL{$\x03LL\x05$\x03\x81\x81\xbb$" +
+	"\x03\x82\xad\x1c$\x03\x83\xac\"$\x03\x84\xac\x05$\x03\x85\xae\x7f$\x03\x86\xb2\x0e$\x03\x87\xb1\xb7$\x03\x88" +
+	"\xb2\x82$\x03\x8a\xab\xcf$\x03\x8b\xac\x01$\x03\x8c\xac2$\x03\xc1\xad\x16$\x03\xc2\x7f\xfb$\x03è\xb0" +
+	"$\x03Ĩ\x93$\x03ū\r$\x03Ʈ\x9b$\x03Ǳ\x92$\x03ȱ\x86$\x03ʪ\xd3$\x03" +
+	"˫\x04$\x03̫6$\x04\x01\xac\x1c$\x04\x02\xa8\xad$\x04\x03~f$\x04\x04\xa5&$\x04\x05\xa7" +
+	"\xa1$\x04\x06\xab/$\x04\a\xae&$\x04\b\xb1h$\x04\n\xa9\xdc$\x04\v\xaa\x0e$\x04\f\xaa@$" +
+	"\x04A\xab\xff$\x04B\xa8\x8f$\x04C\xa5$$\x04D}<$\x04E\xa5%$\x04F\xa7\xfb$\x04G" +
+	"\xaa\xcf$\x04H\xae\x11$\x04J\xa9\xc3$\x04K\xa9\xf5$\x04L\xaa&$\x04\x81\xaeq$\x04\x82\xab\x01" +
+	"$\x04\x83\xa7\x96$\x04\x84\xa5\x19$\x04\x85{:$\x04\x86\xa4e$\x04\x87\xa7:$\x04\x88\xaa|$\x04" +
+	"\x8a\xa9\x81$\x04\x8b\xa8\xf4$\x04\x8c\xa9%$\x04\xc1\xb1\xff$\x04®\x8f$\x04ë#$\x04ħ" +
+	"\xef$\x04ŤX$\x04\xc6z8$\x04Ǥ\xa3$\x04ȧ\xe5$\x04ʧd$\x04˪v$" +
+	"\x04̩\xc6$\x05\x01\xb1\x9e$\x05\x02\xb1{$\x05\x03\xae\x0f$\x05\x04\xaa\xb9$\x05\x05\xa7\"$\x05\x06" +
+	"\xa4\x96$\x05\ax\x8b$\x05\b\xa5\x1a$\x05\n\xa5\x12$\x05\v\xa8$$\x05\f\xab6$\x05A\xb2j" +
+	"$\x05B\xb1p$\x05C\xb1Q$\x05D\xad\xfb$\x05E\xaad$\x05F\xa7\xd8$\x05G\xa5\x18$\x05" +
+	"HxI$\x05J\xa2\xcc$\x05K\xa5\xde$\x05L\xa8\xf0$\x05\x81\xc7\v$\x05\x82\xc5\xc2$\x05\x83\xc4" +
+	"}$\x05\x84\xc4.$\x05\x85\xc0\r$\x05\x86\xbc\xf9$\x05\x87\xb9\xca$\x05\x88\xba~$\x05\x8a\xb8T$" +
+	"\x05\x8b\xbc2$\x05\x8c\xc0\x0f$\x05\x8d\x80\x00$\x05\x8e\xbc\x8e$\x05\x8f\xbbb$\x05\x90\xba9$\x05\x91" +
+	"\xb9\xef$\x05\x92\xb6+$\x05\x93\xb3W$\x05\x94\xb0k$\x05\x95\xb1\n$\x05\xc1\xab\xcb$\x05ª\xd2" +
+	"$\x05é\xdc$\x05ĩ\xc3$\x05ũ\x7f$\x05Ƨi$\x05ǥ\x1f$\x05Ȣ\xd5$\x05" +
+	"\xcaQ\xb6$\x05˚\xea$\x05̝\x86$\x05\xcdM!$\x05\u03a2g$\x05ϡ\x87$\x05Р" +
+	"\xab$\x05Ѡ\x94$\x05ҠV$\x05Ӟn$\x05ԜW$\x05՚F$\x05֯\t$" +
+	"\x06\x01\xac\t$\x06\x02\xab\x0f$\x06\x03\xaa\x1a$\x06\x04\xaa\x01$\x06\x05\xa8\xfd$\x06\x06\xaa\x8a$\x06\a" +
+	"\xa8@$\x06\b\xa5\xf5$\x06\n\x9a\xf5$\x06\vR\x1d$\x06\f\x9d\xae$\x06\rKf$\x06\x0e\xa2\x95" +
+	"$\x06\x0f\xa1\xb5$\x06\x10\xa0\xd8$\x06\x11\xa0\xc1$\x06\x12\x9f\xd8$\x06\x13\xa1;$\x06\x14\x9f$$\x06" +
+	"\x15\x9d\x14$\x06\x16\xb2\x8f$\x06\x17\x92\xe4$\x06A\xacF$\x06B\xabL$\x06C\xaaW$\x06D\xaa" +
+	">$\x06E\xa9:$\x06F\xa9\xe5$\x06G\xab`$\x06H\xa9\x16$\x06J\x9d\x9c$\x06K\x9d\xb9$" +
+	"\x06LR\xb1$\x06MJ\xe6$\x06N\xa2\xc3$\x06O\xa1\xe2$\x06P\xa1\x06$\x06Q\xa0\xef$\x06R" +
+	"\xa0\x06$\x06S\xa0\x9f$\x06T\xa1\xf1$\x06U\x9f\xe1$\x06V\xb6\x15$\x06W\x95B$\x06X\x95]" +
+	"$\x06\x8d\"$$\x06\x8e\xb9\xf9$\x06\x8f\xb8\xcc$\x06\x90\xb7\xa3$\x06\x91\xb7l$\x06\x92\xbaj$\x06" +
+	"\x93\xbax$\x06\x94\xbb\x06$\x06\x95\xbb\xc6$\x06\x96\x86a$\x06\x97Q$$\x06\x98Oc$\x06\x99N" +
+	"\xf9$\x06\u0382\xa7$\x06Ϯ#$\x06Э,$\x06ѭ\x0f$\x06ү{$\x06Ӳ\xef$" +
+	"\x06Բ\x90$\x06ճY$\x06\xd6\xc7\xf9$\x06\u05ec\xe5$\x06ح $\x06٭\\$\a\x0f" +
+	"\x80\xe2$\a\x10\xa9\xc8$\a\x11\xa9\xab$\a\x12\xac\x17$\a\x13\xaf\x8b$\a\x14\xb2l$\a\x15\xb2`" +
+	"$\a\x16Ƴ$\a\x17\xab\xec$\a\x18\xac($\a\x19\xacc$\aP\x7fK$\aQ\xa6M$\a" +
+	"R\xa8\xb9$\aS\xac-$\aT\xaf\x0e$\aU\xb2A$\aV\xc5s$\aW\xaa\xf9$\aX\xab" +
+	"5$\aY\xabp$\a\x91~\x1f$\a\x92\xa6E$\a\x93\xa9\x05$\a\x94\xab\xc3$\a\x95\xae\xf7$" +
+	"\a\x96\xc5&$\a\x97\xaa\xe0$\a\x98\xab\x1b$\a\x99\xabV$\a\xd2|#$\aӥ\x87$\a\xd4" +
+	"\xa8E$\aիy$\a\xd6\xc1 $\aת\x9e$\aت\x1f$\a٪Z$\b\x13{\x15" +
+	"$\b\x14\xa5\xbf$\b\x15\xa8\xf3$\b\x16\xbe\x12$\b\x17\xa8\x8c$\b\x18\xab\x98$\b\x19\xaa\xf7$\b" +
+	"Tya$\bU\xa6;$\bV\xba\xec$\bW\xa6H$\bX\xa9T$\bY\xac`$\b\x95y" +
+	"\x1d$\b\x96\xbb\x9e$\b\x97\xa4\f$\b\x98\xa7\x18$\b\x99\xaa%$\b\xd6Y\x17$\b\u05f9R$" +
+	"\bؽ&$\b\xd9\xc0\xfa$\t\x17S\x04$\t\x18\x9cr$\t\x19\x9f\v$\tXSs$\tY" +
+	"\x9f2$\t\x99T\x0f$\x12\x80M\t$\x12\x82Y!$\x12\x83X\xe6$\x12\x84X\xe6$\x12\x85Y\x7f" +
+	"$\x12\x86VB$\x12\x87T\x18$\x12\x88Q\x16$\x12\xc0M\xd6$\x12\xc2Y~$\x12\xc3YB$\x12" +
+	"\xc4YB$\x12\xc5Y\x18$\x12\xc6X\xfc$\x12\xc7V\xd2$\x12\xc8S\xd1$\x12\xcaH\xea$\x13\x00N" +
+	"\xa2$\x13\x02Z\r$\x13\x03Y\xd1$\x13\x04Y\xd1$\x13\x05Y\xa7$\x13\x06X\xc8$\x13\aY\xbf$" +
+	"\x13\bV\xbe$\x13\nK[$\x13\vL'$\x13@'\xec$\x13Bd\x10$\x13Cc\xd4$\x13D" +
+	"c\xd4$\x13Ef,$\x13Fd\xa0$\x13Gd\xd4$\x13Hd\xf3$\x13JX&$\x13KX\xef" +
+	"$\x13LY\xb7$\x13\x80G\xba$\x13\x82\xb7)$\x13\x83\xb6\x12$\x13\x84\xb5\xf0$\x13\x85\xb9\x13$\x13" +
+	"\x86\xbc\x9d$\x13\x87\xbc\xf9$\x13\x88\xbd\xb2$\x13\x8aJ\x1f$\x13\x8bI\\$\x13\x8cH\xfd$\x13\xc0b" +
+	"\xa5$\x13\xc2X\xf0$\x13Ö\x11$\x13ĕ\xef$\x13Ř\x06$\x13ƙ\x90$\x13ǝV$" +
+	"\x13ȝ\r$\x13\xcac\xc9$\x13\xcbd $\x13\xccd\xaa$\x14\x00bf$\x14\x02\x96\x0e$\x14\x03" +
+	"W{$\x14\x04\x92\xa9$\x14\x05\x94\xbf$\x14\x06\x96I$\x14\a\x9a\x0f$\x14\b\x9c\xeb$\x14\nc\x89" +
+	"$\x14\vc\xe1$\x14\fdk$\x14@bd$\x14B\x95\xed$\x14C\x92\xa7$\x14DVP$\x14" +
+	"E\x92T$\x14F\x93&$\x14G\x96\xca$\x14H\x99\xa5$\x14Jc\x87$\x14Kc\xdf$\x14Ld" +
+	"i$\x14\x80d\xbc$\x14\x82\x98\x05$\x14\x83\x94\xbf$\x14\x84\x92S$\x14\x85Te$\x14\x86\x8fc$" +
+	"\x14\x87\x93\"$\x14\x88\x95\xfd$\x14\x8ad\x1d$\x14\x8bc\xb6$\x14\x8cd@$\x14\xc0c0$\x14\xc2" +
+	"\x99\x85$\x14Ö?$\x14ē\x1c$\x14ŏW$\x14\xc6Q\xdd$\x14Ǐo$\x14ȒK" +
+	"$\x14\xca`\xf7$\x14\xcbc\x99$\x14\xccce$\x15\x00cX$\x15\x02\x9dJ$\x15\x03\x9a\x04$\x15" +
+	"\x04\x96\xbe$\x15\x05\x93\x13$\x15\x06\x8fb$\x15\aP\xd6$\x15\b\x8f\xa1$\x15\n^\xd4$\x15\va" +
+	"w$\x15\fdL$\x15@cj$\x15B\x9c\xf7$\x15C\x9c\xd4$\x15D\x99\x8f$\x15E\x95\xe4$" +
+	"\x15F\x922$\x15G\x8f\x94$\x15HO\x02$\x15J[\xe1$\x15K^\x83$\x15LaX$\x15\x80" +
+	"B\xcf$\x15\x82}\xa9$\x15\x83|\xcd$\x15\x84v\x90$\x15\x85lS$\x15\x86a\x97$\x15\x87Y\xd0" +
+	"$\x15\x88R\xe9$\x15\x8aEJ$\x15\x8bD\xd8$\x15\x8cDX$\x15\x8e\x80\x00$\x15\x8fx\xc1$\x15" +
+	"\x90w\xf7$\x15\x91r@$\x15\x92h\xe1$\x15\x93_\x06$\x15\x94W\xd7$\x15\x95Qt$\x15\xc0X" +
+	"\x12$\x15\xc2e\x1d$\x15\xc3d\xe1$\x15\xc4d\xe0$\x15\xc5eu$\x15\xc6bN$\x15\xc7`5$" +
+	"\x15\xc8]J$\x15\xca&\x95$\x15\xcbT\x14$\x15\xccVr$\x15\xcdV\x91$\x15\xceJ[$\x15\xcf" +
+	"at$\x15\xd0a@$\x15\xd1a@$\x15\xd2a\xc7$\x15\xd3^\xe8$\x15\xd4\\\xfd$\x15\xd5ZT" +
+	"$\x15\xd6E\xed$\x16\x00X\xe5$\x16\x02e\x7f$\x16\x03eC$\x16\x04eC$\x16\x05e\x19$\x16" +
+	"\x06d\xff$\x16\ab\xe5$\x16\b_\xfb$\x16\nT\x1e$\x16\v'?$\x16\fWE$\x16\rW" +
+	"E$\x16\x0eI\x94$\x16\x0fa\xc0$\x16\x10a\x8c$\x16\x11a\x8b$\x16\x12ag$\x16\x13aM$" +
+	"\x16\x14_a$\x16\x15\\\xb8$\x16\x16Er$\x16\x17R\xf5$\x16@Y\xb8$\x16Bf\x14$\x16C" +
+	"e\xd8$\x16De\xd8$\x16Ee\xad$\x16Fd\xd4$\x16Ge\xc8$\x16Hb\xdd$\x16JV\x86" +
+	"$\x16KWO$\x16L'\xf1$\x16MW\xf9$\x16NI+$\x16Ob9$\x16Pb\x05$\x16" +
+	"Qb\x05$\x16Ra\xe0$\x16Sa\x1b$\x16Ta\xf4$\x16U_K$\x16VD\xe9$\x16WU" +
+	"\x18$\x16XU\xcd$\x16\x8d+7$\x16\x8eL\x10$\x16\x8ffe$\x16\x90f'$\x16\x91f$$" +
+	"\x16\x92hq$\x16\x93f\xeb$\x16\x94g\x10$\x16\x95g!$\x16\x96G;$\x16\x97\\\t$\x16\x98" +
+	"\\\xd9$\x16\x99]\xa8$\x16\xce_e$\x16ϸ`$\x16зL$\x16ѷ*$\x16Һ?" +
+	"$\x16ӽ\xb7$\x16Ծ\a$\x16վ\xb2$\x16ֆo$\x16\xd7NV$\x16\xd8M\x9e$\x16" +
+	"\xd9MI$\x17\x0fZp$\x17\x10\x97\xc5$\x17\x11\x97\xa5$\x17\x12\x99\xb3$\x17\x13\x9b6$\x17\x14\x9e" +
+	"\xe0$\x17\x15\x9e\x8f$\x17\x16\x80\x15$\x17\x17go$\x17\x18g\xce$\x17\x19h_$\x17PX\xf9$" +
+	"\x17Q\x94k$\x17R\x96y$\x17S\x97\xfc$\x17T\x9b\xa7$\x17U\x9el$\x17V\x7f;$\x17W" +
+	"g1$\x17Xg\x90$\x17Yh!$\x17\x91W\xcc$\x17\x92\x94\x16$\x17\x93\x94\xe3$\x17\x94\x98l" +
+	"$\x17\x95\x9b2$\x17\x96y\x17$\x17\x97g/$\x17\x98g\x8e$\x17\x99h\x1e$\x17\xd2U\xe7$\x17" +
+	"ӑ:$\x17Ԕ\xdc$\x17\u0557\xa2$\x17\xd6o\r$\x17\xd7g\xc2$\x17\xd8gf$\x17\xd9g" +
+	"\xf6$\x18\x13Se$\x18\x14\x91B$\x18\x15\x94\b$\x18\x16d\x82$\x18\x17d\xaf$\x18\x18gJ$" +
+	"\x18\x19g $\x18TRS$\x18U\x91q$\x18V\\\xd0$\x18Wb\x98$\x18Xe3$\x18Y" +
+	"h\x01$\x18\x95Pz$\x18\x96U\xfc$\x18\x97_\xb5$\x18\x98bQ$\x18\x99e\x1e$\x18\xd6\x1d\xda" +
+	"$\x18\xd7I\xad$\x18\xd8I@$\x18\xd9H\xc4$\x19\x17)\xd5$\x19\x18X\x1c$\x19\x19Zu$\x19" +
+	"X*\x84$\x19Y[E$\x19\x99+<$\"\x80N\x06$\"\x81c\x90$\"\x83Y<$\"\x84Y" +
+	";$\"\x85Y\xf8$\"\x86Ww$\"\x87T9$\"\x88R\x13$\"\xc0N\xd3$\"\xc1c\xed$" +
+	"\"\xc3Y\x98$\"\xc4Y\x98$\"\xc5Y\x91$\"\xc6Z1$\"\xc7V\xf3$\"\xc8T\xce$\"\xca" +
+	"I\xe7$#\x00O\x9f$#\x01d|$#\x03Z'$#\x04Z'$#\x05Z $#\x06Y\xfd" +
+	"$#\aY\xe1$#\bW\xbb$#\nLX$#\vM$$#@(\xf9$#Am\xcf$#" +
+	"Cd&$#Dd&$#Ef\x9c$#Fe\xcf$#Gd\xf6$#He\xe9$#JY" +
+	"\x1e$#KY\xe7$#LZ\xaf$#\x80l+$#\x81}\xf1$#\x83\xa2\x12$#\x84\xa1\xf1$" +
+	"#\x85\xa4\xab$#\x86\xa76$#\x87\xa6@$#\x88\xa7\b$#\x8am\xc5$#\x8bn\x1d$#\x8c" +
+	"n\xa7$#\xc0H\x1e$#\xc1\xb9@$#ò\xb6$#Ĳ\x95$#ŵ\xb8$#ƹB" +
+	"$#Ǽ\xf3$#ȽC$#\xcaJ\x84$#\xcbI\xc0$#\xccIa$$\x00b\xae$$" +
+	"\x01\xa29$$\x03W\xf7$$\x04\x94\x1a$$\x05\x96J$$\x06\x98_$$\a\x9a\x0f$$\b\x9d" +
+	"\xd3$$\nc\xd5$$\vd-$$\fd\xb7$$@b\xab$$A\xa2\x18$$C\x94\x17$" +
+	"$DV\xcc$$E\x93\xdf$$F\x95<$$G\x96\xca$$H\x9a\x8d$$Jc\xd2$$K" +
+	"d*$$Ld\xb4$$\x80e#$$\x81\xa4\xda$$\x83\x96K$$\x84\x93\xe0$$\x85U\x03" +
+	"$$\x86\x91\x94$$\x87\x93\"$$\x88\x96\xe5$$\x8ad\x8b$$\x8bd$$$\x8cd\xae$$" +
+	"\xc0dV$$\xc1\xa7h$$Ø_$$ĕ<$$ő\x91$$\xc6S;$$Ǐ" +
+	"o$$ȓN$$\xcab\x1d$$\xcbd\xbf$$\xccd\x8b$%\x00c{$%\x01\xa6s$" +
+	"%\x03\x9a\x06$%\x04\x96\xc0$%\x05\x93\x15$%\x06\x8fd$%\aP\xd7$%\b\x8f\xa2$%\n" +
+	"^\xf7$%\va\x9a$%\fdo$%@db$%A\xa7/$%C\x9d\xc8$%D\x9a\x83" +
+	"$%E\x96\xd7$%F\x93?$%G\x8f\x94$%HO\xf3$%J\\\xd9$%K_{$%" +
+	"LbP$%\x80D\x18$%\x81\x8dd$%\x83}\x8d$%\x84v\xe6$%\x85l\xc1$%\x86b" +
+	"\xd9$%\x87Z\a$%\x88T\x14$%\x8aF\x8b$%\x8bF\x1c$%\x8cE\x9e$%\x8f\x80\x00$" +
+	"%\x90x\xa4$%\x91r\x8e$%\x92iD$%\x93`+$%\x94X\v$%\x95R\x84$%\xc0" +
+	"Y\n$%\xc1oU$%\xc3e6$%\xc4e6$%\xc5e\xed$%\xc6c}$%\xc7`V" +
+	"$%\xc8^A$%\xca'\xa3$%\xcbU\f$%\xccWk$%\xcdWq$%\xcej\xce$%" +
+	"\xcfJ\xbb$%\xd0a\x8d$%\xd1a\x8d$%\xd2b2$%\xd3_\xfa$%\xd4]\x1c$%\xd5[" +
+	"4$%\xd6G\x11$&\x00Y\xdd$&\x01o\xb7$&\x03e\x99$&\x04e\x99$&\x05e\x91$" +
+	"&\x06f-$&\ac\a$&\b`\xf1$&\nU\x16$&\v(M$&\fX=$&\r" +
+	"X%$&\x0ek\x19$&\x0fI\xf3$&\x10a\xd8$&\x11a\xd8$&\x12a\xd2$&\x13b^" +
+	"$&\x14_\x80$&\x15]\x99$&\x16F\x99$&\x17S\xd5$&@Z\xb0$&ApL$&" +
+	"Cf.$&Df.$&Ef&$&Ff\x03$&Ge\xe9$&Hc\xd4$&JW" +
+	"~$&KXG$&L(\xfe$&MX\xd9$&Nk\x93$&OI\x8a$&PbR$" +
+	"&QbQ$&RbL$&Sb,$&Tb\x13$&U`+$&VF\x13$&W" +
+	"U\xf9$&XV\xad$&\x8d,=$&\x8eo\xb5$&\x8fLp$&\x90fj$&\x91fg" +
+	"$&\x92h\xd1$&\x93h\b$&\x94g2$&\x95h\x13$&\x96H|$&\x97\\\xfb$&" +
+	"\x98]\xca$&\x99^\x9a$&\xce~\xe1$&Ϻi$&У~$&ѣ]$&Ҧ" +
+	"\f$&Ө\x8a$&ԧ\x9a$&ըR$&֏\x1f$&\xd7q3$&\xd8q\x92$" +
+	"&\xd9r\"$'\x0f^C$'\x10\xb3\xf7$'\x11\xb3\xd5$'\x12\xb6\xea$'\x13\xbab$'\x14" +
+	"\xbe\x00$'\x15\xbeD$'\x16\x86i$'\x17N\xb6$'\x18M\xfe$'\x19M\xaa$'PYg" +
+	"$'Q\x95\xcc$'R\x97\xf3$'S\x99\xfe$'T\x9b\xa7$'U\x9fO$'V\x7f\xea$'" +
+	"Wgy$'Xg\xd8$'Yhh$'\x91X9$'\x92\x95\x90$'\x93\x96\xe6$'\x94\x98" +
+	"l$'\x95\x9c\x15$'\x96y^$'\x97gv$'\x98g\xd5$'\x99hf$'\xd2Vv$" +
+	"'ӓU$'Ԕ\xdc$'\u0558\x84$'\xd6ok$'\xd7h+$'\xd8g\xcf$'\xd9" +
+	"h`$(\x13T\xb1$(\x14\x91C$(\x15\x95\x04$(\x16e\xb1$(\x17e\xcc$(\x18hg" +
+	"$(\x19h=$(TRT$(U\x91q$(V]\x06$(Wb\xb9$(XeU$(" +
+	"Yh\"$(\x95Qe$(\x96W $(\x97`\xa7$(\x98cB$(\x99f\x0f$(\xd6\x1e" +
+	"\xe0$(\xd7J\xe6$(\xd8J|$(\xd9J\x03$)\x17*\xdb$)\x18Y\r$)\x19[g$" +
+	")X+\x8b$)Y\\6$)\x99,B$2\x80N\xff$2\x81d\x8d$2\x82c\x95$2\x84" +
+	"Y\x8d$2\x85ZJ$2\x86W\xeb$2\x87Uj$2\x88R0$2\xc0O\xcb$2\xc1d\xea" +
+	"$2\xc2c\xf1$2\xc4Y\xe9$2\xc5Y\xe3$2\xc6Z\xa6$2\xc7X$$2\xc8T\xeb$2" +
+	"\xcaJ\xe0$3\x00P\x98$3\x01ex$3\x02d\x80$3\x04Zx$3\x05Zq$3\x06Z" +
+	"q$3\a[\x12$3\bW\xd8$3\nMQ$3\vN\x1d$3@*\a$3An\xc1$" +
+	"3Bm\xcf$3Ddw$3Ef\xed$3FfC$3Gf $3Hf\x06$3J" +
+	"Z\x12$3KZ\xdb$3L[\xa3$3\x80m\x1f$3\x81~\xc6$3\x82\xaby$3\x84\xa2\xd0" +
+	"$3\x85\xa5\x8b$3\x86\xa8/$3\x87\xa79$3\x88\xa7\b$3\x8an\xbd$3\x8bo\x15$3" +
+	"\x8co\x9f$3\xc0l\x1d$3\xc1\xabh$3\xc2|W$3ğ\x8e$3ŢI$3Ƥ" +
+	"\xed$3ǧ\x9f$3Ȧ\xa7$3\xcam\xba$3\xcbn\x12$3\xccn\x9c$4\x00H\x84$" +
+	"4\x01\xb8\xd4$4\x02\xb4\xa9$4\x04\xaf<$4\x05\xb2_$4\x06\xb5\xe9$4\a\xb9\x9a$4\b" +
+	"\xbd?$4\nJ\xea$4\vJ&$4\fI\xc8$4@b\xf2$4A\xa2\xea$4B\x9f\xb4" +
+	"$4DWH$4E\x95P$4F\x96\xc6$4G\x98\xdf$4H\x9a\x8d$4Jd\x1a$4" +
+	"Kdr$4Ld\xfc$4\x80ek$4\x81\xa5\xad$4\x82\xa2v$4\x84\x95Q$4\x85U" +
+	"\x7f$4\x86\x93\x1e$4\x87\x957$4\x88\x96\xe5$4\x8ad\xd2$4\x8bdk$4\x8cd\xf5$" +
+	"4\xc0d\xc0$4\xc1\xa8V$4¥\x1f$4Ė\xc8$4œ\x1d$4\xc6S\xd9$4\xc7" +
+	"\x91\xa0$4ȓN$4\xcab\x87$4\xcbe)$4\xccd\xf4$5\x00d\x9d$5\x01\xa7a" +
+	"$5\x02\xa7\xd3$5\x04\x98\xe0$5\x05\x955$5\x06\x91\x9d$5\aR4$5\b\x8f\xa2$5" +
+	"\n`\x19$5\vb\xbb$5\fe\x90$5@d\x81$5A\xa70$5B\xa6\xdc$5D\x9a" +
+	"\x84$5E\x96\xd9$5F\x93A$5G\x8f\x96$5HO\xf4$5J\\\xf8$5K_\x9a$" +
+	"5Lbo$5\x80E^$5\x81\x8e?$5\x82\x8d\x80$5\x84w\x95$5\x85m\x17$5\x86" +
+	"cH$5\x87[J$5\x88TK$5\x8aG\xc8$5\x8bG\\$5\x8cF\xe1$5\x90\x80\x00" +
+	"$5\x91s-$5\x92i\x93$5\x93`\x8f$5\x94Y0$5\x95R\xb9$5\xc0Y\xfe$5" +
+	"\xc1pK$5\xc2oY$5\xc4e\x88$5\xc5f?$5\xc6c\xf1$5\xc7a\x80$5\xc8^" +
+	"^$5\xca(\xb0$5\xcbV\x00$5\xccX^$5\xcdXN$5\xcek\xae$5\xcfj\xd3$" +
+	"5\xd0K\x1a$5\xd1a\xd5$5\xd2b{$5\xd3`b$5\xd4^)$5\xd5[O$5\xd6" +
+	"H2$6\x00Z\xd1$6\x01p\xae$6\x02o\xbc$6\x04e\xeb$6\x05e\xe2$6\x06f\xa1" +
+	"$6\ad1$6\ba\x0e$6\nV\t$6\v)Z$6\fY1$6\rY\x02$6" +
+	"\x0ek\xf9$6\x0fk\x1e$6\x10JR$6\x11b!$6\x12b\x1b$6\x13b\xc6$6\x14`" +
+	"\x8e$6\x15]\xb3$6\x16G\xbc$6\x17T\xb2$6@[\xa4$6AqC$6BpQ$" +
+	"6Df\x7f$6Efw$6Ffw$6Gg\x13$6Hc\xf1$6JXr$6K" +
+	"Y;$6L*\f$6MY\xb6$6Nls$6Ok\x98$6PI\xe9$6Qb\x9a" +
+	"$6Rb\x94$6Sb\x94$6Tc $6U`F$6VG9$6WV\xd5$6" +
+	"XW\x89$6\x8d-C$6\x8ep\xa2$6\x8fo\xa4$6\x90L\xd2$6\x91f\xab$6\x92i" +
+	"\x15$6\x93hm$6\x94hK$6\x95h0$6\x96I\xb9$6\x97]\xe8$6\x98^\xb8$" +
+	"6\x99_\x87$6\xce\x7f\xb2$6Ϭy$6й\xfd$6Ѥ,$6Ҧ\xdb$6\xd3" +
+	"\xa9r$6Ԩ\x82$6ըS$6֏\xf5$6\xd7r$$6\xd8r\x83$6\xd9s\x14" +
+	"$7\x0f}8$7\x10\xb5\xdb$7\x11\xa0\xfb$7\x12\xa3\xa9$7\x13\xa6A$7\x14\xa8\xe5$7" +
+	"\x15\xa7\xf3$7\x16\x8f+$7\x17q&$7\x18q\x85$7\x19r\x16$7P]I$7Q\xb0" +
+	"\x83$7R\xb3\x97$7S\xb7\x0f$7T\xba\xae$7U\xbe@$7V\x86e$7WO\x19$" +
+	"7XNa$7YN\f$7\x91X\xa7$7\x92\x96\xf1$7\x93\x98`$7\x94\x9ao$7\x95" +
+	"\x9c\x15$7\x96y\xfd$7\x97g\xba$7\x98h\x19$7\x99h\xa9$7\xd2V\xe4$7Ӕ\xcf" +
+	"$7Ԗ\xde$7\u0558\x85$7\xd6o\xb3$7\xd7hn$7\xd8h\x12$7\xd9h\xa3$8" +
+	"\x13UA$8\x14\x93^$8\x15\x95\x04$8\x16f\x10$8\x17f1$8\x18h\xcc$8\x19h" +
+	"\xa2$8TS\xa0$8U\x91q$8V^6$8Wc\xd2$8Xfn$8Yi;$" +
+	"8\x95Qf$8\x96WW$8\x97`\xc4$8\x98c_$8\x99f-$8\xd6\x1f\xe6$8\xd7" +
+	"L\x1b$8\xd8K\xb3$8\xd9K=$9\x17+\xe1$9\x18Y\xfb$9\x19\\T$9X,\x91" +
+	"$9Y]$$9\x99-H$B\x80O\x18$B\x81d\xaa$B\x82c\xb2$B\x83b\xba$B" +
+	"\x85Y\xb1$B\x86WR$B\x87T\xf4$B\x88R\x99$B\xc0O\xe4$B\xc1e\a$B\xc2d" +
+	"\x0f$B\xc3c\x16$B\xc5Ye$B\xc6Z\r$B\xc7W\xae$B\xc8UT$B\xcaJ\xf9$" +
+	"C\x00P\xb1$C\x01e\x96$C\x02d\x9d$C\x03c\xa5$C\x05Y\xf4$C\x06Y\xd8$C\a" +
+	"Z\x9b$C\bXA$C\nMj$C\vN6$C@*\b$CAn\xde$CBm\xec" +
+	"$CCl\xfb$CEft$CFe\xae$CGe\xae$CHfm$CJZ+$C" +
+	"KZ\xf4$CL[\xbc$C\x80m<$C\x81~\xc6$C\x82\xaby$C\x83\xab\x11$C\x85\xa5" +
+	"\xa3$C\x86\xa8O$C\x87\xa7Y$C\x88\xa7B$C\x8an\xda$C\x8bo2$C\x8co\xbc$" +
+	"C\xc0l:$C\xc1\xabh$C\xc2|W$CçV$CŢa$Cƥ\r$C\xc7" +
+	"\xa7\xd9$CȦ\xe0$C\xcam\xd8$C\xcbn/$C\xccn\xb9$D\x00k:$D\x01\xaa\xf4" +
+	"$D\x02\xa7G$D\x03z\t$D\x05\x9f&$D\x06\xa1\xd2$D\a\xa4\x9d$D\b\xa7f$D" +
+	"\nl\xd8$D\vm/$D\fm\xb9$D@H\x82$DA\xb8\xd1$DB\xb4\xa7$DC\xb0" +
+	"\x80$DE\xafq$DF\xb2*$DG\xb5\xb8$DH\xb9]$DJJ\xe8$DKJ$$" +
+	"DLI\xc5$D\x80d\xf0$D\x81\xa5\xc3$D\x82\xa2\x8c$D\x83\x9fZ$D\x85Uz$D\x86" +
+	"\x93\xe3$D\x87\x96\x1d$D\x88\x98o$D\x8ad<$D\x8bc\xf0$D\x8cdz$D\xc0d*" +
+	"$D\xc1\xa8s$D¥=$Dâ\n$Dœ\xe2$D\xc6S\xd4$Dǒ\x86$D" +
+	"Ȕ\xd8$D\xcaa\xf1$D\xcbd\x93$D\xccd^$E\x00d)$E\x01\xa7~$E\x02\xa8" +
+	"\f$E\x03\xa4\xda$E\x05\x96\x1c$E\x06\x92\x84$E\aRR$E\b\x91G$E\n_\xa5$" +
+	"E\vbH$E\fe\x1d$E@d\xe8$EA\xa7h$EB\xa7\x15$EC\xa7\xa7$EE" +
+	"\x98o$EF\x94\xd8$EG\x91F$EHP\xf3$EJ]^$EK`\x01$ELb\xd6" +
+	"$E\x80E\xad$E\x81\x94|$E\x82\x94'$E\x83\x93z$E\x85mF$E\x86cy$E" +
+	"\x87[~$E\x88T\x83$E\x8aH\x0f$E\x8bG\xa5$E\x8cG-$E\x91\x80\x00$E\x92i" +
+	"\xbe$E\x93`\xbc$E\x94Y`$E\x95R\xec$E\xc0Z\x17$E\xc1ph$E\xc2ov$" +
+	"E\xc3n\x85$E\xc5e\xaa$E\xc6c\\$E\xc7a\x0e$E\xc8^\xc5$E\xca(\xb1$E\xcb" +
+	"V\x19$E\xccXw$E\xcdXe$E\xcek\xc9$E\xcfj\xee$E\xd0j\x13$E\xd1K\x1a" +
+	"$E\xd2a\xf6$E\xd3_\xdc$E\xd4]\xc3$E\xd5[\xad$E\xd6Ht$F\x00Z\xea$F" +
+	"\x01p\xcb$F\x02o\xd9$F\x03n\xe8$F\x05ei$F\x06f\r$F\ac\xbf$F\ba" +
+	"u$F\nV\"$F\v)[$F\fYJ$F\rY\x19$F\x0el\x14$F\x0fk9$" +
+	"F\x10j^$F\x11JR$F\x12a\xae$F\x13bA$F\x14`'$F\x15^\x12$F\x16" +
+	"H\x02$F\x17T\xc9$F@[\xbd$FAq`$FBpn$FCo|$FEe\xfe" +
+	"$FFe\xe2$FGf\xa1$FHdX$FJX\x8b$FKYT$FL*\f$F" +
+	"MY\xcd$FNl\x8e$FOk\xb3$FPj\xd8$FQI\xe9$FRb'$FSb" +
+	"\x0f$FTb\xba$FU`\xa4$FVG\x81$FWV\xec$FXW\xa0$F\x8d-D$" +
+	"F\x8ep\xbf$F\x8fo\xc1$F\x90n\xc5$F\x91L\xd0$F\x92h\x9c$F\x93g\xda$F\x94" +
+	"g\xda$F\x95h\x94$F\x96J\a$F\x97^\x01$F\x98^\xd1$F\x99_\xa0$F\xce\x7f\xb2" +
+	"$FϬy$FЬ\a$Fѹ\xfa$FҦ\xf2$Fө\x90$FԨ\xa0$F" +
+	"ը\x8a$F֖\x18$F\xd7rB$F\xd8r\xa0$F\xd9s1$G\x0f}8$G\x10\xa8" +
+	"b$G\x11\xb5\xd8$G\x12\xa3\xc1$G\x13\xa6_$G\x14\xa9\x1d$G\x15\xa8*$G\x16\x95\xb6$" +
+	"G\x17qC$G\x18q\xa2$G\x19r3$GPz\xe2$GQ\xb1\xbc$GR\xa0\x96$GS" +
+	"\xa34$GT\xa5\xf2$GU\xa8\xad$GV\x94\xfe$GWpG$GXp\xa6$GYq7" +
+	"$G\x91\\\x10$G\x92\xb0\xb2$G\x93\xb3\\$G\x94\xb6\xd9$G\x95\xbak$G\x96\x86c$G" +
+	"\x97O\x16$G\x98N^$G\x99N\n$G\xd2V\xdd$Gӕ\x92$Gԗ\xc1$G՚" +
+	"\t$G\xd6o\xe0$G\xd7g\xdb$G\xd8g\x9a$G\xd9h+$H\x13U:$H\x14\x94A$" +
+	"H\x15\x96\x88$H\x16f?$H\x17e\x9e$H\x18h:$H\x19h\x0f$HTS\xbb$HU" +
+	"\x93\x0e$HV^h$HWca$HXe\xfc$HYh\xca$H\x95R_$H\x96W\x8c" +
+	"$H\x97a($H\x98c\xc4$H\x99f\x91$H\xd6\x1f\xe6$H\xd7La$H\xd8K\xfc$H" +
+	"\xd9K\x89$I\x17+\xe2$I\x18Z\x14$I\x19\\m$IX,\x91$IY]=$I\x99-" +
+	"H$R\x80Rz$R\x81h\n$R\x82g\x12$R\x83f\x1a$R\x84f\x1a$R\x86Y\xa2$" +
+	"R\x87WC$R\x88T\xe9$R\xc0SW$R\xc1hb$R\xc2gi$R\xc3fq$R\xc4" +
+	"fq$R\xc6\\`$R\xc7Z\x01$R\xc8W\xa7$R\xcaM\x8e$S\x00T#$S\x01h\xf0" +
+	"$S\x02g\xf8$S\x03g\x00$S\x04g\x00$S\x06\\+$S\a\\\xee$S\bZ\x94$S" +
+	"\nO\xff$S\vP\xdc$S@-\xe7$SAt\x8b$SBs~$SCr\x8d$SDr" +
+	"\x8c$SFh\xca$SGh\xc9$SHi\x88$SJ]f$SK^?$SL_\a$" +
+	"S\x80r\xf0$S\x81\x81\xe5$S\x82\xac;$S\x83\xab\xb0$S\x84\xab\x93$S\x86\xaa\x92$S\x87" +
+	"\xa9\xc3$S\x88\xa9\xab$S\x8ar\x13$S\x8brf$S\x8cr\xf0$S\xc0q\xd3$S\xc1\xac)" +
+	"$S\xc2\x7fv$Sç\xf4$Sħ\xd7$SƧP$SǪ $Sȩ'$S" +
+	"\xcaq\x10$S\xcbqc$S\xccq\xed$T\x00p\xd2$T\x01\xab\x93$T\x02\xa7\xe5$T\x03}" +
+	"($T\x04\xa4\"$T\x06\xa4\x15$T\a\xa6\xe4$T\b\xa9\xad$T\np\x10$T\vpc$" +
+	"T\fp\xed$T@p\xd0$TA\xabu$TB\xa7\xc8$TC\xa4\x1f$TD|<$TF" +
+	"\xa1\x00$TG\xa3\xb5$TH\xa6}$TJp\x0e$TKpa$TLp\xeb$T\x80LA" +
+	"$T\x81\xbdq$T\x82\xb9F$T\x83\xb5 $T\x84\xb4\xdc$T\x86\xb2Q$T\x87\xb5\xe4$T" +
+	"\x88\xb9\x89$T\x8aNU$T\x8bM\x05$T\x8cL\xa7$T\xc0g $T\xc1\xaa\x8a$T§" +
+	"S$Tä!$Tġ\t$T\xc6T\xdc$TǕ\xf9$TȘK$T\xcad\x15$" +
+	"T\xcbf\xc0$T\xccf\x8b$U\x00g\x1f$U\x01\xa9\xbc$U\x02\xaa'$U\x03\xa6\xf4$U\x04" +
+	"\xa3\xc1$U\x06\x95\xf8$U\aSY$U\b\x94\xba$U\na\xca$U\vdu$U\fgJ" +
+	"$U@g\xde$UA\xa9\xa5$UB\xa9/$UC\xa9\xc1$UD\xa6\x8e$UF\x98K$U" +
+	"G\x94\xb9$UHQ\xfa$UJ_\x83$UKb.$ULe\x03$U\x80I\xd3$U\x81\x9e" +
+	"\xb5$U\x82\x9eI$U\x83\x9d\xf4$U\x84\x9d\xc7$U\x86d=$U\x87[\xca$U\x88Uw$" +
+	"U\x8aGW$U\x8bJ\xcd$U\x8cJX$U\x92\x80\x00$U\x93ar$U\x94Y\xa7$U\x95" +
+	"S\xcc$U\xc0]R$U\xc1s\x98$U\xc2r\xa6$U\xc3q\xb4$U\xc4q\xb4$U\xc6e\xa5" +
+	"$U\xc7cW$U\xc8a\x0e$U\xca+\xb4$U\xcbX\x8b$U\xccZ\xea$U\xcd[I$U" +
+	"\xcen\xac$U\xcfm\xd1$U\xd0l\xf6$U\xd1l\xf6$U\xd2N6$U\xd3a\xe9$U\xd4_" +
+	"\xcf$U\xd5]\xba$U\xd6G\xca$V\x00^+$V\x01s\xec$V\x02r\xfa$V\x03r\b$" +
+	"V\x04r\b$V\x06hU$V\af\a$V\bc\xbd$V\nX\x8b$V\v,\x84$V\f" +
+	"[\xc2$V\r\\\v$V\x0en\xf2$V\x0fn\x17$V\x10m<$V\x11m<$V\x12L\xf0" +
+	"$V\x13dP$V\x14b7$V\x15`!$V\x16J\xe0$V\x17V\xf9$V@^\xfe$V" +
+	"At\x80$VBs\x8f$VCr\x9d$VDr\x9d$VFh+$VGh\xea$VHf" +
+	"\xa0$VJZ\xf4$VK[\xcc$VL-5$VM\\\xbf$VNol$VOn\x91$" +
+	"VPm\xb6$VQm\xb6$VRL\x86$VSd\x1e$VTd\xc9$VUb\xb4$VV" +
+	"Jb$VWY\x1c$VXY\xde$V\x8d1\x0e$V\x8evR$V\x8fu9$V\x90t=" +
+	"$V\x91t;$V\x92Ps$V\x93j\xc0$V\x94j\xbf$V\x95kz$V\x96N\x17$V" +
+	"\x97a+$V\x98a\xff$V\x99b\xcf$V\u0382\xc7$Vϭ0$VЬ\x9c$VѬ" +
+	"\x7f$VҾ\x85$Vӫ\xa1$VԪ\xd6$Vժ\xc0$V֠*$V\xd7ui$" +
+	"V\xd8u\xb8$V\xd9vI$W\x0f\x80N$W\x10\xa8\xf8$W\x11\xa8\xdb$W\x12\xbab$W\x13" +
+	"\xa8o$W\x14\xab1$W\x15\xaa>$W\x16\x9f\xb0$W\x17tk$W\x18t\xba$W\x19uK" +
+	"$WP}\xf7$WQ\xa5<$WR\xb6F$WS\xa5D$WT\xa8\x06$WU\xaa\xc0$W" +
+	"V\x9fP$WWso$WXs\xbe$WYtO$W\x91}\t$W\x92\xb6\x04$W\x93\xa2" +
+	"=$W\x94\xa4\xe4$W\x95\xa7\x9f$W\x96\x9f#$W\x97sl$W\x98s\xbc$W\x99tL$" +
+	"W\xd2]\x98$Wӳ]$WԶ\xdd$Wպp$Wֆb$W\xd7Rp$W\xd8" +
+	"Q$$W\xd9P\xcf$X\x13V&$X\x14\x97\x86$X\x15\x99\xcd$X\x16f\xd0$X\x17g\xb2" +
+	"$X\x18jO$X\x19j%$XTT\xa6$XU\x96S$XV^\x82$XWeu$X" +
+	"Xh\x12$XYj\xdf$X\x95SK$X\x96XJ$X\x97c<$X\x98e\xd9$X\x99h" +
+	"\xa7$X\xd6#5$X\xd7K\xa7$X\xd8O\b$X\xd9N\x97$Y\x17.\xd4$Y\x18\\n$" +
+	"Y\x19^\xc8$YX/\xa0$YY_\x9c$Y\x990W$b\x80U1$b\x81k\xd9$b\x82" +
+	"j\xc5$b\x83i\xcd$b\x84i\xcd$b\x85h8$b\x87Y\xc0$b\x88Wf$b\xc0U\xbe" +
+	"$b\xc1k\xcb$b\xc2j\xb7$b\xc3i\xbf$b\xc4i\xbf$b\xc5g\x9a$b\xc7\\^$b" +
+	"\xc8Z\x04$b\xcaO\xeb$c\x00V\x9b$c\x01lU$c\x02kA$c\x03jI$c\x04j" +
+	"I$c\x05h$$c\a_N$c\b\\\xf4$c\nR\xf1$c\vS~$c@1\x19$" +
+	"cAv\xe1$cBu\xd2$cCt\xe0$cDt\xe0$cEt[$cGj\xe4$cH" +
+	"k\xa3$cJ`\r$cK`y$cLaR$c\x80uX$c\x81\x85\x11$c\x82\xad\xe2" +
+	"$c\x83\xac\xa0$c\x84\xac\x83$c\x85\xb0\xeb$c\x87\xac\xf4$c\x88\xac\xe1$c\x8au\xd5$c" +
+	"\x8bu\xa7$c\x8cv,$c\xc0t;$c\xc1\xad\xd2$c\u0082\x85$cè\xcf$cĨ" +
+	"\xb2$cŭ\x1b$cǬw$cȫ\xa5$c\xcat\xb7$c\xcbt\x8a$c\xccu\x0f$" +
+	"d\x00s;$d\x01\xac\x83$d\x02\xa8\xc0$d\x03\x807$d\x04\xa4\xfc$d\x05\xa9e$d\a" +
+	"\xa9;$d\b\xac\b$d\ns\xb7$d\vs\x89$d\ft\x0f$d@s8$dA\xacf" +
+	"$dB\xa8\xa3$dC\xa4\xfa$dD\x7fK$dE\xa6\xf9$dG\xa6\f$dH\xa8\xd9$d" +
+	"Js\xb5$dKs\x87$dLt\f$d\x80r\x85$d\x81\xb0\xa6$d\x82\xac\xe3$d\x83\xa9" +
+	":$d\x84\xa6\xcb$d\x85{\xcb$d\x87\xa3\x9b$d\x88\xa6h$d\x8ar $d\x8bql$" +
+	"d\x8cq\xf1$d\xc0O\x15$d\xc1\xc1%$d¼\xf9$dø\xd2$dĸ\x8e$d\xc5" +
+	"\xb4\xa8$dǵ\xfb$dȹ\xa4$d\xcaN7$d\xcbP\x85$d\xccO\x9a$e\x00i\x1f" +
+	"$e\x01\xac\xc3$e\x02\xacS$e\x03\xa9 $e\x04\xa5\xed$e\x05\xa3\xa8$e\aTr$e" +
+	"\b\x98\x1c$e\nd-$e\vf\xa7$e\fi\x84$e@i\xde$eA\xac\xb1$eB\xab" +
+	"\x81$eC\xab\xf1$eD\xa8\xbd$eE\xa6y$eG\x98\x1b$eHS\x12$eJa\xe6$" +
+	"eKd`$eLg=$e\x80L\xef$e\x81\xa9q$e\x82\xa8/$e\x83\xa7\xc2$e\x84" +
+	"\xa7\x92$e\x85\xa6\xcf$e\x87\\b$e\x88U\xbf$e\x8aKN$e\x8bJ&$e\x8cM\x90" +
+	"$e\x93\x80\x00$e\x94Z5$e\x95T\x10$e\xc0_\xde$e\xc1w.$e\xc2v $e" +
+	"\xc3u.$e\xc4u.$e\xc5s\xc4$e\xc7e\xc1$e\xc8cw$e\xca,\xc0$e\xcbZ" +
+	"\xbd$e\xcc]\xaf$e\xcd]\xb3$e\xcer\f$e\xcfq\x19$e\xd0p>$e\xd1p>$" +
+	"e\xd2n\xf0$e\xd3N\x1d$e\xd4b\x05$e\xd5_\xf0$e\xd6Kl$f\x00`p$f\x01" +
+	"w&$f\x02v\x18$f\x03u&$f\x04u&$f\x05s6$f\ah]$f\bf\x13" +
+	"$f\nZ\xdf$f\v/\x98$f\f^B$f\r^\x13$f\x0eq\xde$f\x0fp\xeb$f" +
+	"\x10p\x10$f\x11p\x0f$f\x12nE$f\x13P\x1b$f\x14dO$f\x15b:$f\x16J" +
+	"D$f\x17Y\x11$f@aI$fAw\xac$fBv\x9e$fCu\xac$fDu\xac$" +
+	"fEs\xbc$fGk?$fHh\xf5$fJ]\xd5$fK^B$fL0o$fM" +
+	"^\xd6$fNrR$fOq_$fPp\x84$fQp\x84$fRn\xb9$fSO3" +
+	"$fTf\xe5$fUd\xcf$fVMO$fW[\xba$fX\\\x1a$f\x8d4$$f" +
+	"\x8ex\xa2$f\x8fw\x89$f\x90v\x8d$f\x91v\x8a$f\x92u\xdb$f\x93S,$f\x94l" +
+	"\xa8$f\x95mb$f\x96Q\x15$f\x97c\x9b$f\x98d-$f\x99e\x01$f΅\xe8$" +
+	"fϮ\xca$fЭ\x81$fѭd$fұ\x95$f\xd3\xc2$$fԭ\xd1$f\xd5" +
+	"\xad\xbf$f֪\xbb$f\xd7x\xf0$f\xd8x\xe8$f\xd9yi$g\x0f\x83S$g\x10\xa9\xc9" +
+	"$g\x11\xa9\xab$g\x12\xad\xdc$g\x13\xbe\x02$g\x14\xadU$g\x15\xac\x87$g\x16\xa9q$g" +
+	"\x17w\xd7$g\x18w\xcf$g\x19xQ$gP\x80\xfd$gQ\xa6\r$gR\xaa>$gS\xb9" +
+	"\xe6$gT\xaa)$gU\xac\xe8$gV\xa8\xf9$gWv\xdb$gXv\xd3$gYwU$" +
+	"g\x91\x80\x0f$g\x92\xa7\xdc$g\x93\xb9\xa4$g\x94\xa7\b$g\x95\xa9\xc6$g\x96\xa8\xc9$g\x97" +
+	"v\xd8$g\x98v\xd1$g\x99wR$g\xd2|~$gӵ\xa6$gԤ\xb3$gէr" +
+	"$g֧\xe2$g\xd7uH$g\xd8t\xc0$g\xd9uA$h\x13_c$h\x14\xb6\xce$h" +
+	"\x15\xbad$h\x16\x86a$h\x17R($h\x18T\x90$h\x19S\xa7$hTU\xa3$hU\x99" +
+	"\x87$hV^\xe7$hWg\xb5$hXj3$hYm\x02$h\x95TG$h\x96Xa$" +
+	"h\x97e|$h\x98g\xfa$h\x99j\xc9$h\xd6&\x95$h\xd7O^$h\xd8N]$h\xd9" +
+	"Q\xb2$i\x17/\xc6$i\x18^\x8f$i\x19aq$iX2\xa3$iYb\x03$i\x993v" +
+	"$r\x80X\t$r\x81n\x90$r\x82n\x90$r\x83m|$r\x84m|$r\x85k\xe7$r" +
+	"\x86hf$r\x88Y\xd9$r\xc0X\xac$r\xc1n\x82$r\xc2n\x82$r\xc3mn$r\xc4m" +
+	"n$r\xc5kI$r\xc6jw$r\xc8\\}$r\xcaR\x80$s\x00Y:$s\x01n\xa7$" +
+	"s\x02n\xa7$s\x03m\x93$s\x04m\x92$s\x05km$s\x06i\xf0$s\b_M$s\n" +
+	"UJ$s\vU\xd8$s@4S$sAx\xfa$sBx\xfa$sCw\xeb$sDw\xeb" +
+	"$sEwe$sFu\xe0$sHm\xf4$sJb\xd7$sKcW$sLc\xc3$s" +
+	"\x80wz$s\x81\x86\xe1$s\x82\xaeM$s\x83\xad\t$s\x84\xac\xeb$s\x85\xb1T$s\x86\xb5" +
+	"\xa7$s\x88\xae\xfb$s\x8ax|$s\x8bxN$s\x8cxS$s\xc0ws$s\xc1\xaeG$" +
+	"s\u0085\xb3$sê\x81$sĪd$sŮ\x15$sƲE$sȮ\xd6$s\xca" +
+	"xv$s\xcbxH$s\xccxL$t\x00vX$t\x01\xac\xf8$t\x02\xaas$t\x03\x83H" +
+	"$t\x04\xa6\x99$t\x05\xaaJ$t\x06\xae{$t\b\xae_$t\nwZ$t\vw,$t" +
+	"\fw1$t@vU$tA\xac\xdb$tB\xaaV$tC\xa6\x97$tD\x82]$tE\xa7" +
+	"\xde$tF\xabg$tH\xab0$tJwX$tKw*$tLw.$t\x80u\xa3$" +
+	"t\x81\xb1\x1a$t\x82\xad\xde$t\x83\xaa\x1f$t\x84\xa7\xb0$t\x85~\xdd$t\x86\xa8\xf0$t\x88" +
+	"\xa8\xbf$t\x8au\xc3$t\x8bu\x0f$t\x8cu\x13$t\xc0s\xf3$t\xc1\xb5F$t±\xe7" +
+	"$tî($tī\x10$tŨ\xc5$t\xc6|:$tȦF$t\xcarG$t" +
+	"\xcbt<$t\xccs\x9e$u\x00R\t$u\x01\xc1\x12$u\x02\xc0\xc5$u\x03\xbc\x9d$u\x04\xbc" +
+	"Y$u\x05\xb8s$u\x06\xb7o$u\b\xb9\xbe$u\nN\x19$u\vPg$u\fS\x1a$" +
+	"u@l\x14$uA\xae\xaa$uB\xae\x89$uC\xae\x1c$uD\xaa\xe9$uE\xa8\xa5$uF" +
+	"\xa6Y$uHT+$uJd@$uKf\xbf$uLik$u\x80P%$u\x81\xb18" +
+	"$u\x82\xb1\x01$u\x83\xaf\xbe$u\x84\xaf\x8b$u\x85\xafA$u\x86\xae\xab$u\x88V+$u" +
+	"\x8aLP$u\x8bN\x1b$u\x8cL\xe7$u\x94\x80\x00$u\x95Tu$u\xc0b\x8a$u\xc1y" +
+	"\xb2$u\xc2y\xb2$u\xc3x\xa3$u\xc4x\xa3$u\xc5w9$u\xc6s\xef$u\xc8e\xd7$" +
+	"u\xca-$$u\xcb]7$u\xcc_\xdd$u\xcd`9$u\xcetv$u\xcftv$u\xd0" +
+	"s\x83$u\xd1s\x82$u\xd2r5$u\xd3o\x1b$u\xd4N\x04$u\xd5b\x1c$u\xd6LZ" +
+	"$v\x00c2$v\x01y\xaa$v\x02y\xaa$v\x03x\x9b$v\x04x\x9b$v\x05v\xab$v" +
+	"\x06v\x02$v\bhx$v\n]]$v\v0\xa4$v\f`o$v\r`\xad$v\x0et" +
+	"G$v\x0ftG$v\x10sT$v\x11sT$v\x12q\x89$v\x13p\xe7$v\x14P\x02$" +
+	"v\x15dk$v\x16M\xe5$v\x17[\\$v@c\xc4$vAy\xd5$vBy\xd4$vC" +
+	"x\xc6$vDx\xc6$vEv\xd6$vFu\x8b$vHkG$vJ`&$vK`\x92" +
+	"$vL3\x84$vMa\x0e$vNtG$vOtG$vPsS$vQsS$v" +
+	"Rq\x89$vSpR$vTR^$vUf\xe3$vVL\xb2$vW]\xce$vX^" +
+	"/$v\x8d7D$v\x8ez\xab$v\x8fz\xa5$v\x90y\x8e$v\x91y\x8c$v\x92x\xdd$" +
+	"v\x93w5$v\x94V\x03$v\x95o\x81$v\x96T/$v\x97f-$v\x98f\xd2$v\x99" +
+	"gd$v·\xaa$vϯ5$vЭ\xec$vѭ\xcf$vұ\xff$vӶ\x1b" +
+	"$v\xd4\xc2\b$vկ\xa7$vֲ\\$v\xd7{`$v\xd8{Y$v\xd9{\x83$w" +
+	"\x0f\x86v$w\x10\xabm$w\x11\xabP$w\x12\xae\xcb$w\x13\xb2\xc5$w\x14\xc1\xba$w\x15\xaf" +
+	"\x82$w\x16\xb2\"$w\x17{Z$w\x18{R$w\x19{|$wP\x84\x05$wQ\xa7\x9d$" +
+	"wR\xab\x19$wS\xaf\x12$wT\xbd\x9e$wU\xaf\f$wV\xb0\xd9$wWzC$wX" +
+	"z;$wYzf$w\x91\x83\x17$w\x92\xa8\xb7$w\x93\xac\v$w\x94\xbd\\$w\x95\xab\xea" +
+	"$w\x96\xb0\xa6$w\x97z@$w\x98z9$w\x99zc$w\xd2\x7f\x86$wө\xb0$w" +
+	"Թ^$wթ\x95$wְ6$w\xd7x\xaf$w\xd8x($w\xd9xR$x\x13|" +
+	"\xd0$x\x14\xb89$x\x15\xa79$x\x16\xaf{$x\x17uX$x\x18wU$x\x19v\xe4$" +
+	"xTaX$xU\xbaW$xV\x86a$xWQ\xe1$xXTH$xYW\x13$x\x95" +
+	"UD$x\x96X\x9a$x\x97g\xb2$x\x98j6$x\x99l\xe6$x\xd6)\xf2$x\xd7P-" +
+	"$x\xd8R\x13$x\xd9Q\x06$y\x170\x13$y\x18`\xe4$y\x19c\x8e$yX3\x95$y" +
+	"Yd\x1f$y\x996z$\x82\x80Z^$\x82\x81q|$\x82\x82qA$\x82\x83q@$\x82\x84q" +
+	"@$\x82\x85o\x8f$\x82\x86l\x0f$\x82\x87h\x8e$\x82\xc0[\x01$\x82\xc1qp$\x82\xc2q5$" +
+	"\x82\xc3q5$\x82\xc4q4$\x82\xc5n\xf4$\x82\xc6n\"$\x82\xc7j\xa2$\x82\xcaTy$\x83\x00" +
+	"[\x8f$\x83\x01q\x95$\x83\x02qY$\x83\x03qY$\x83\x04qY$\x83\x05o\x18$\x83\x06m\x9b" +
+	"$\x83\al\xe6$\x83\nW\xc5$\x83\vXh$\x83@7h$\x83A{Z$\x83B{\x1e$\x83" +
+	"C{\x1e$\x83D{\x1e$\x83Ez{$\x83Fx\xf6$\x83Gw\xc6$\x83Je#$\x83Ke" +
+	"\xa3$\x83Lf\x0f$\x83\x80y\xd7$\x83\x81\x88\xf3$\x83\x82\xae\x98$\x83\x83\xaew$\x83\x84\xaeZ$" +
+	"\x83\x85\xb2\t$\x83\x86\xb69$\x83\x87\xb7T$\x83\x8a{X$\x83\x8b{,$\x83\x8c{0$\x83\xc0" +
+	"y\x95$\x83\xc1\xae\x93$\x83\u0087\x86$\x83ë\x05$\x83Ī\xe7$\x83Ů\x97$\x83Ʋ\xc7" +
+	"$\x83Ƿ/$\x83\xca{\x16$\x83\xcbz\xeb$\x83\xccz\xef$\x84\x00y\x91$\x84\x01\xaep$\x84" +
+	"\x02\xab\x01$\x84\x03\x86z$\x84\x04\xaa\xc9$\x84\x05\xac\x15$\x84\x06\xaf\x8e$\x84\a\xb3\xd3$\x84\n{" +
+	"\x12$\x84\vz\xe6$\x84\fz\xeb$\x84@y\x8e$\x84A\xaeS$\x84B\xaa\xe3$\x84C\xaa\xc6$" +
+	"\x84D\x85\x8e$\x84E\xa9\xa9$\x84F\xacz$\x84G\xb0\xa4$\x84J{\x0f$\x84Kz\xe4$\x84L" +
+	"z\xe8$\x84\x80x\xc0$\x84\x81\xb1\xdb$\x84\x82\xaek$\x84\x83\xab\xeb$\x84\x84\xa9|$\x84\x85\x81\xf1" +
+	"$\x84\x86\xa9\xed$\x84\x87\xae\x18$\x84\x8ay`$\x84\x8bx\xad$\x84\x8cx\xb2$\x84\xc0w\x11$\x84" +
+	"\xc1\xb5\xe4$\x84²t$\x84ï<$\x84Ĭ$$\x84ũ\xc3$\x84\xc6\x7fN$\x84Ǭ" +
+	"\x13$\x84\xcau\xe4$\x84\xcbw\xda$\x84\xccw=$\x85\x00u\xb7$\x85\x01\xb6\xd7$\x85\x02\xb6\xb4$" +
+	"\x85\x03\xb3Z$\x85\x04\xb0&$\x85\x05\xad\xc6$\x85\x06\xab\xea$\x85\a|\xe3$\x85\nrh$\x85\v" +
+	"t_$\x85\fv\x85$\x85@U\x89$\x85A\xc25$\x85B\xc0\xf7$\x85C\xc0\xae$\x85D\xc0j" +
+	"$\x85E\xbc\x82$\x85F\xbb\x7f$\x85G\xbb@$\x85JM\xfe$\x85KPM$\x85LS\x00$\x85" +
+	"\x80O{$\x85\x81\xb8 $\x85\x82\xb6\xf4$\x85\x83\xb6\xbd$\x85\x84\xb6\x87$\x85\x85\xb5\x93$\x85\x86\xb5" +
+	"L$\x85\x87\xb4\xe2$\x85\x8aL}$\x85\x8bO\x1c$\x85\x8cP\xdb$\x85\x95\x80\x00$\x85\xc0d\xb4$" +
+	"\x85\xc1|l$\x85\xc2|0$\x85\xc3|/$\x85\xc4|/$\x85\xc5z\xa8$\x85\xc6w^$\x85\xc7" +
+	"t\x14$\x85\xca-m$\x85\xcb_\x1d$\x85\xccb>$\x85\xcdbJ$\x85\xcew\x0e$\x85\xcfv\xda" +
+	"$\x85\xd0v\xda$\x85\xd1v\xd9$\x85\xd2ut$\x85\xd3rZ$\x85\xd4o?$\x85\xd5M\xed$\x85" +
+	"\xd6L\x87$\x86\x00e[$\x86\x01|f$\x86\x02|*$\x86\x03|*$\x86\x04|)$\x86\x05z" +
+	"\x1d$\x86\x06ys$\x86\av)$\x86\n_D$\x86\v1\b$\x86\fb\xe5$\x86\rb\xbe$" +
+	"\x86\x0ev\xe1$\x86\x0fv\xad$\x86\x10v\xad$\x86\x11v\xad$\x86\x12t\xca$\x86\x13t($\x86\x14" +
+	"q\x0e$\x86\x15O\xed$\x86\x16N\xd2$\x86\x17]\x1b$\x86@e\xed$\x86A|\x90$\x86B|T" +
+	"$\x86C|T$\x86D|T$\x86EzG$\x86Fx\xfc$\x86Gxn$\x86Jb\x8b$\x86" +
+	"Kc\f$\x86L4\x90$\x86Mc\x1e$\x86Nv\xe1$\x86Ov\xad$\x86Pv\xac$\x86Qv" +
+	"\xac$\x86Rt\xc9$\x86Ss\x92$\x86Ts\t$\x86URJ$\x86VPQ$\x86W`\x02$" +
+	"\x86X`v$\x86\x8d:G$\x86\x8e|\xfa$\x86\x8f|\xba$\x86\x90|\xb6$\x86\x91|\xb3$\x86\x92" +
+	"{\xea$\x86\x93zB$\x86\x94x\xef$\x86\x95Yp$\x86\x96S\x82$\x86\x97hF$\x86\x98h\xeb" +
+	"$\x86\x99i|$\x86Ή\xad$\x86ϯw$\x86ЯT$\x86ѯ7$\x86Ҳ\xb3$\x86" +
+	"Ӷ\xac$\x86Է\x9c$\x86\xd5\xc3\x1d$\x86ֹ!$\x86\xd7~\x04$\x86\xd8}\xfe$\x86\xd9~" +
+	")$\x87\x0f\x88;$\x87\x10\xab\xf0$\x87\x11\xab\xd3$\x87\x12\xafO$\x87\x13\xb3H$\x87\x14\xb7w$" +
+	"\x87\x15\xc1\xe2$\x87\x16\xb7\xf7$\x87\x17}\xc4$\x87\x18}\xbe$\x87\x19}\xe8$\x87P\x87+$\x87Q" +
+	"\xab\xb4$\x87R\xac\xd6$\x87S\xb0\x1a$\x87T\xb4'$\x87U\xc1\x9a$\x87V\xb7\xbf$\x87W}\xbf" +
+	"$\x87X}\xba$\x87Y}\xe4$\x87\x91\x86=$\x87\x92\xaat$\x87\x93\xad\x13$\x87\x94\xb1\x06$\x87" +
+	"\x95\xc1W$\x87\x96\xb7\x89$\x87\x97}\xbd$\x87\x98}\xb7$\x87\x99}\xe1$\x87҂\x91$\x87Ӫ" +
+	"\xa4$\x87Ԯ\x97$\x87սZ$\x87ֶt$\x87\xd7|\x11$\x87\xd8{\x8c$\x87\xd9{\xb6$" +
+	"\x88\x13\x7f\xdb$\x88\x14\xac\xa9$\x88\x15\xbc5$\x88\x16\xb6\a$\x88\x17x\xba$\x88\x18z\xb8$\x88\x19" +
+	"zH$\x88T}]$\x88U\xbb\xd1$\x88V\xb5x$\x88Wud$\x88Xwb$\x88Yy\x8f" +
+	"$\x88\x95cq$\x88\x96\x86a$\x88\x97Q\x9c$\x88\x98T\x05$\x88\x99V\xd0$\x88\xd6-I$\x88" +
+	"\xd7P)$\x88\xd8R\xe0$\x88\xd9T\xbb$\x89\x170E$\x89\x18b\xa9$\x89\x19e\xcc$\x89X3" +
+	"\xe2$\x89Yfq$\x89\x997k(\x02\xc1\xab%(\x02ª((\x02é/(\x02Ĩ;(" +
+	"\x02ŨF(\x02ƨ\xfc(\x02Ǧ\xa0(\x02ȤK(\x02ɠ\xf7(\x03\x01\xabY(\x03\x02" +
+	"\xaa\\(\x03\x03\xa9c(\x03\x04\xa8o(\x03\x05\xa8z(\x03\x06\xa8m(\x03\a\xa9\xcb(\x03\b\xa7v" +
+	"(\x03\t\xa4\"(\x03\v\x9c](\x03A\xbc6(\x03B\xbb\x05(\x03C\xb9\xd6(\x03D\xb8\xa9(\x03" +
+	"E\xbc\xc0(\x03F\xbc\x05(\x03G\xbc\x87(\x03H\xbdF(\x03I\xbc\xc2(\x03KK\xb7(\x03LK" +
+	"A(\x03\x81\x80\xe6(\x03\x82\xac\xe3(\x03\x83\xab\xea(\x03\x84\xaa\xf4(\x03\x85\xae\x92(\x03\x86\xb1f(" +
+	"\x03\x87\xb0\xed(\x03\x88\xb1\xb9(\x03\x89\xb3r(\x03\x8b\xab\xc8(\x03\x8c\xab\xfa(\x03\xc1\xac\xde(\x03\xc2" +
+	"\x7f&(\x03èw(\x03ħ\x82(\x03ū\x1f(\x03ƭ\xf4(\x03ǰ\xc8(\x03Ȱ\xbd" +
+	"(\x03ɲv(\x03˪\xcc(\x03̪\xfe(\x04\x01\xab\xe4(\x04\x02\xa8t(\x04\x03}\x91(\x04" +
+	"\x04\xa4\x16(\x04\x05\xa7\xb3(\x04\x06\xaa\x88(\x04\a\xad\\(\x04\b\xb0\x9e(\x04\t\xb1\x7f(\x04\v\xa9" +
+	"\xd6(\x04\f\xaa\a(\x04A\xaa\xef(\x04B\xa7\x80(\x04C\xa4\x14(\x04D|'(\x04E\xa4L(" +
+	"\x04F\xa7!(\x04G\xa9\xf5(\x04H\xad7(\x04I\xb1f(\x04K\xa8\xe5(\x04L\xa9\x16(\x04\x81" +
+	"\xae\x8d(\x04\x82\xab\x1e(\x04\x83\xa7\xb2(\x04\x84\xa4K(\x04\x85{\x83(\x04\x86\xa4\xd4(\x04\x87\xa6\xf1" +
+	"(\x04\x88\xaa3(\x04\x89\xae?(\x04\x8b\xa8\xfb(\x04\x8c\xa9-(\x04\xc1\xb1c(\x04\u00ad\xf4(\x04" +
+	"ê\x88(\x04ħ!(\x04Ť\xc8(\x04\xc6ze(\x04ǤE(\x04ȧ\x87(\x04ɫ" +
+	"\x93(\x04˩\xa3(\x04̩\x15(\x05\x01\xb0\xe0(\x05\x02\xb0\xbd(\x05\x03\xadR(\x05\x04\xa9\xea(" +
+	"\x05\x05\xa6\xda(\x05\x06\xa48(\x05\ax\xb8(\x05\b\xa4\xbc(\x05\t\xa8\xc8(\x05\v\xa7Q(\x05\f" +
+	"\xaac(\x05A\xb1\xac(\x05B\xb0\xb2(\x05C\xb0\x94(\x05D\xad,(\x05E\xaa\x1c(\x05F\xa7z" +
+	"(\x05G\xa4\xba(\x05Hxv(\x05I\xa6\x82(\x05K\xa5\v(\x05L\xa8\x1d(\x05\x81\xb30(\x05" +
+	"\x82\xb27(\x05\x83\xb1A(\x05\x84\xb1'(\x05\x85\xad\xf4(\x05\x86\xabR(\x05\x87\xa8\x92(\x05\x88\xa6" +
+	"G(\x05\x89t\xd1(\x05\x8b\xa1\xb6(\x05\x8c\xa4\xc7(\x05\xc1\xc7t(\x05\xc2\xc6+(\x05\xc3\xc4\xe5(" +
+	"\x05\xc4ä(\x05\xc5\xc3d(\x05\xc6\xc0N(\x05ǽ\x1f(\x05Ƚ\xd2(\x05ɸ\xb2(\x05\xcb" +
+	"\xbc\x9a(\x05\xcc\xc0x(\x05̀\x00(\x05μ\xed(\x05ϻ\xc1(\x05к\x99(\x05ѹt" +
+	"(\x05ҹ7(\x05Ӷc(\x05Գw(\x05մ\x15(\x05֯e(\x06\x01\xab\xd0(\x06" +
+	"\x02\xaa\xd7(\x06\x03\xa9\xe1(\x06\x04\xa8\xf0(\x06\x05\xa8\xfb(\x06\x06\xa9\xab(\x06\a\xa7b(\x06\b\xa5" +
+	"\x17(\x06\t\xa1\xfb(\x06\vQ-(\x06\f\x9dv(\x06\rJ\xb6(\x06\x0e\xa2a(\x06\x0f\xa1\x81(" +
+	"\x06\x10\xa0\xa5(\x06\x11\x9f\xcc(\x06\x12\x9f\xd6(\x06\x13\xa0t(\x06\x14\x9e](\x06\x15\x9cL(\x06\x16" +
+	"\x99Z(\x06\x17\xb2\xee(\x06A\xac\x0e(\x06B\xab\x14(\x06C\xaa\x1f(\x06D\xa9-(\x06E\xa98" +
+	"(\x06F\xa9*(\x06G\xaa\x82(\x06H\xa88(\x06I\xa5\x1c(\x06K\x9d\x81(\x06LQ\xc2(\x06" +
+	"MJ7(\x06N\xa2\x8f(\x06O\xa1\xaf(\x06P\xa0\xd2(\x06Q\x9f\xfa(\x06R\xa0\x04(\x06S\x9f" +
+	"\xf7(\x06T\xa1*(\x06U\x9f\x19(\x06V\x9c'(\x06W\xb6t(\x06X\x95)(\x06\x8d!\x1f(" +
+	"\x06\x8e\xbdI(\x06\x8f\xbc\x1a(\x06\x90\xba\xee(\x06\x91\xb9\xc7(\x06\x92\xbd\xd4(\x06\x93\xbd\x12(\x06\x94" +
+	"\xbd\x89(\x06\x95\xbeF(\x06\x96\xbd\x90(\x06\x97\x86a(\x06\x98N\xa4(\x06\x99N:(\x06\u0381\xd7" +
+	"(\x06ϭ\xed(\x06Ь\xf6(\x06Ѭ\x05(\x06ү\x97(\x06ӲW(\x06Ա\xd6(\x06" +
+	"ղ\x9f(\x06ִ (\x06\xd7\xc8a(\x06ج\xeb(\x06٭&(\a\x0f\x80\x12(\a\x10\xa9" +
+	"\x92(\a\x11\xa8\xa1(\a\x12\xac3(\a\x13\xae\xf3(\a\x14\xb1\xb1(\a\x15\xb1\xa6(\a\x16\xb3'(" +
+	"\a\x17\xc7\x1b(\a\x18\xab\xf2(\a\x19\xac-(\aP~{(\aQ\xa5C(\aR\xa8\xd5(\aS" +
+	"\xab\x95(\aT\xaeS(\aU\xb1\x87(\aV\xb24(\aW\xc5\xdb(\aX\xaa\xff(\aY\xab:" +
+	"(\a\x91}\x11(\a\x92\xa5}(\a\x93\xa8<(\a\x94\xaa\xfb(\a\x95\xae.(\a\x96\xb2\x1a(\a" +
+	"\x97ġ(\a\x98\xaa\x11(\a\x99\xaaM(\a\xd2|u(\aӥ\xf8(\aԨ\x01(\aի" +
+	"5(\a֮\xff(\a\xd7\xc4o(\aت'(\a٪c(\b\x13{M(\b\x14\xa5g(" +
+	"\b\x15\xa8\x9b(\b\x16\xace(\b\x17\xc1a(\b\x18\xaa\xca(\b\x19\xaaJ(\bTy\x99(\bU" +
+	"\xa5\xe3(\bV\xa9\xae(\bW\xbe;(\bX\xa8\x86(\bY\xab\x92(\b\x95yU(\b\x96\xa7r" +
+	"(\b\x97\xbe\xed(\b\x98\xa6J(\b\x99\xa9V(\b\xd6u\x98(\b\u05f9\xae(\bأ\x18(\b" +
+	"٦$(\t\x17XV(\t\x18\xbd\x8e(\t\x19\xc1b(\tXR\x89(\tY\x9e\xfc(\t\x99S" +
+	"%(\x12\xc0L\xfa(\x12\xc2X\xab(\x12\xc3Xo(\x12\xc4X4(\x12\xc5Y\b(\x12\xc6X\xd0(" +
+	"\x12\xc7V\xa6(\x12\xc8S\xa1(\x12\xc9O\xbc(\x13\x00M\xc7(\x13\x02Y9(\x13\x03X\xfe(\x13\x04" +
+	"X\xc3(\x13\x05Y\x97(\x13\x06X\x9c(\x13\aY\x93(\x13\bV\x8e(\x13\tR\xa9(\x13\vKL" +
+	"(\x13@&\xdf(\x13BcD(\x13Cc\b(\x13Db\xcc(\x13Ef\x1d(\x13Fdt(\x13" +
+	"Gd\xa9(\x13Hd\xc7(\x13I`\xfe(\x13KX\x18(\x13LX\xe1(\x13\x80F\xd1(\x13\x82\xb9" +
+	"Y(\x13\x83\xb8B(\x13\x84\xb7.(\x13\x85\xbb_(\x13\x86\xbe\x16(\x13\x87\xbeO(\x13\x88\xbf\x18(" +
+	"\x13\x89\xbf\xd5(\x13\x8bHr(\x13\x8cH\x14(\x13\xc0a\xd7(\x13\xc2Xc(\x13Ö\x9d(\x13\xc4" +
+	"\x95\xa4(\x13Ř\xc8(\x13ƙ\x99(\x13ǝ<(\x13Ȝ\xfa(\x13ɝ\x8d(\x13\xcbcR" +
+	"(\x13\xccc\xdc(\x14\x00a\x98(\x14\x02\x96\x9a(\x14\x03V\xda(\x14\x04\x92](\x14\x05\x95\x81(\x14" +
+	"\x06\x96R(\x14\a\x99\xf5(\x14\b\x9c\xd8(\x14\t\x9c\x93(\x14\vc\x13(\x14\fc\x9d(\x14@a" +
+	"Z(\x14B\x95\xa2(\x14C\x92\\(\x14DUx(\x14E\x92@(\x14F\x93\x11(\x14G\x96\xb4(" +
+	"\x14H\x99\x96(\x14I\x9cv(\x14Kb\xd6(\x14Lc`(\x14\x80d\xb6(\x14\x82\x98\xd2(\x14\x83" +
+	"\x95\x8c(\x14\x84\x92J(\x14\x85T\xdc(\x14\x86\x90\xab(\x14\x87\x93\xb2(\x14\x88\x96r(\x14\x89\x99R" +
+	"(\x14\x8bc\xaf(\x14\x8cd9(\x14\xc0c\x0e(\x14\u0099\x9a(\x14ÖT(\x14ē\x12(\x14" +
+	"Ő\xa1(\x14\xc6RF(\x14ǐ\x00(\x14Ȓ\xbf(\x14ɕ\x9f(\x14\xcbcx(\x14\xccc" +
+	"C(\x15\x00c6(\x15\x02\x9d=(\x15\x03\x99\xf7(\x15\x04\x96\xb4(\x15\x05\x93\xa5(\x15\x06\x8f\xf2(" +
+	"\x15\aQ4(\x15\b\x90\x14(\x15\t\x92\xf4(\x15\vaU(\x15\fd*(\x15@cH(\x15B" +
+	"\x9c\xf1(\x15C\x9c\xce(\x15D\x99\x8c(\x15E\x96Z(\x15F\x92\xa7(\x15G\x90\a(\x15HOY" +
+	"(\x15I\x90\x16(\x15K^^(\x15La2(\x15\x80_v(\x15\x82\x9dw(\x15\x83\x9c}(\x15" +
+	"\x84\x9c^(\x15\x85\x99-(\x15\x86\x95y(\x15\x87\x92\xd9(\x15\x88\x90\a(\x15\x89Mx(\x15\x8bZ" +
+	"\x8b(\x15\x8c]`(\x15\xc0Be(\x15\xc2}\xa9(\x15\xc3|\xcd(\x15\xc4v\x06(\x15\xc5n\r(" +
+	"\x15\xc6b\xbb(\x15\xc7Z\\(\x15\xc8SD(\x15\xc9Ma(\x15\xcbDn(\x15\xccC\xed(\x15\xce" +
+	"\x80\x00(\x15\xcfx\xc1(\x15\xd0w\xf7(\x15\xd1q\xc5(\x15\xd2js(\x15\xd3`\x12(\x15\xd4XW" +
+	"(\x15\xd5Q\xc6(\x15\xd6LR(\x16\x00X\x0f(\x16\x02d\xb3(\x16\x03dw(\x16\x04d;(\x16" +
+	"\x05e\t(\x16\x06d\xd3(\x16\ab\xb9(\x16\b_\xcb(\x16\t\\\x02(\x16\v&2(\x16\fV" +
+	"o(\x16\rV\x84(\x16\x0eH\xc2(\x16\x0fa\x06(\x16\x10`\xd2(\x16\x11`\x9e(\x16\x12aY(" +
+	"\x16\x13a'(\x16\x14_;(\x16\x15\\\x8e(\x16\x16Y\x1d(\x16\x17E\x13(\x16@X\xe1(\x16B" +
+	"eH(\x16Ce\f(\x16Dd\xd0(\x16Ee\x9e(\x16Fd\xa8(\x16Ge\x9c(\x16Hb\xad" +
+	"(\x16I^\xe4(\x16KVx(\x16L&\xe4(\x16MW8(\x16NHY(\x16Oa\x7f(\x16" +
+	"PaK(\x16Qa\x17(\x16Ra\xd2(\x16S`\xf5(\x16Ta\xce(\x16U_!(\x16V[" +
+	"\xb0(\x16WD\x8a(\x16XU\v(\x16\x8d*2(\x16\x8eK.(\x16\x8fe\x9d(\x16\x90e_(" +
+	"\x16\x91e\"(\x16\x92hn(\x16\x93f\xcd(\x16\x94f\xf3(\x16\x95g\x03(\x16\x96cF(\x16\x97" +
+	"F\xd4(\x16\x98\\\t(\x16\x99\\\xd8(\x16\xce^\xf5(\x16Ϻ\x8a(\x16йv(\x16Ѹg" +
+	"(\x16Ҽ\x8e(\x16ӿ8(\x16Կf(\x16\xd5\xc0!(\x16\xd6\xc0\xcf(\x16׆o(\x16" +
+	"\xd8L\xbc(\x16\xd9Lg(\x17\x0fY\xe9(\x17\x10\x98T(\x17\x11\x97_(\x17\x12\x9a\x80(\x17\x13\x9b" +
+	"M(\x17\x14\x9e\xd6(\x17\x15\x9e\x8b(\x17\x16\x9f\x10(\x17\x17\x80\x16(\x17\x18g\a(\x17\x19g\x97(" +
+	"\x17PX^(\x17Q\x94%(\x17R\x97F(\x17S\x98\x13(\x17T\x9b\x9c(\x17U\x9ei(\x17V" +
+	"\x9e\x19(\x17W\x7f<(\x17Xf\xc9(\x17YgY(\x17\x91V\xfb(\x17\x92\x94\x11(\x17\x93\x94\xdf" +
+	"(\x17\x94\x98h(\x17\x95\x9b4(\x17\x96\x9d\xfc(\x17\x97x\x92(\x17\x98f\x8c(\x17\x99g\x1d(\x17" +
+	"\xd2Vg(\x17Ӓ\x83(\x17ԕp(\x17\u0558\x1a(\x17֚\xe2(\x17\xd7p\xc6(\x17\xd8g" +
+	"c(\x17\xd9g\xf3(\x18\x13S\xd9(\x18\x14\x91\xd7(\x18\x15\x94\x81(\x18\x16\x97I(\x18\x17e\xa9(" +
+	"\x18\x18g,(\x18\x19g\x02(\x18TR\xbc(\x18U\x91\xe8(\x18V\x94\xb0(\x18W]a(\x18X" +
+	"e\x16(\x18Yg\xe3(\x18\x95P\xdb(\x18\x96\x91\xe6(\x18\x97V\\(\x18\x98b/(\x18\x99d\xfc" +
+	"(\x18\xd6N\xf4(\x18\xd7P\x87(\x18\xd8^q(\x18\xd9a>(\x19\x17\x1d\x05(\x19\x18H\xd9(\x19" +
+	"\x19H^(\x19X)\x7f(\x19YZu(\x19\x99*6(\"\xc0M\xf7(\"\xc1c\x1a(\"\xc3X" +
+	"\xc5(\"\xc4X\x89(\"\xc5Y\x81(\"\xc6Z\x05(\"\xc7V\xc8(\"\xc8T\x9e(\"\xc9P\xb8(" +
+	"#\x00N\xc4(#\x01c\xa8(#\x03YT(#\x04Y\x18(#\x05Z\x10(#\x06Y\xd1(#\a" +
+	"Y\xb5(#\bW\x8b(#\tS\xa6(#\vLI(#@'\xed(#Am\x02(#CcY" +
+	"(#Dc\x1d(#Ef\x8d(#Fe\xa3(#Gd\xca(#He\xbd(#Ia\xf4(#" +
+	"KY\x10(#LY\xd9(#\x80k](#\x81}\x80(#\x83\xa2\x9e(#\x84\xa1\xa5(#\x85\xa5" +
+	"m(#\x86\xa7?(#\x87\xa6&(#\x88\xa6\xf5(#\x89\xa7\x88(#\x8bmN(#\x8cm\xd8(" +
+	"#\xc0G5(#\xc1\xbb\x89(#ô\xe7(#ĳ\xd3(#Ÿ\x04(#ƺ\xbb(#\xc7" +
+	"\xbeI(#Ⱦ\xa9(#ɿf(#\xcbH\xd7(#\xccHx($\x00a\xdf($\x01\xa2\xc5" +
+	"($\x03WV($\x04\x93\xce($\x05\x97\f($\x06\x98g($\a\x99\xf5($\b\x9d\xbf($" +
+	"\t\x9d{($\vc_($\fc\xe9($@a\xa2($A\xa1\xcd($C\x93\xcd($DU" +
+	"\xf4($E\x93\xcb($F\x95&($G\x96\xb4($H\x9a~($I\x9d^($Kc!(" +
+	"$Lc\xab($\x80e\x1d($\x81\xa5\xa7($\x83\x97\x18($\x84\x93\xd6($\x85Uz($\x86" +
+	"\x92\xdc($\x87\x93\xb2($\x88\x97Z($\x89\x9a:($\x8bd\x1d($\x8cd\xa7($\xc0d4" +
+	"($\xc1\xa7}($Øt($ĕ2($Œ\xdb($\xc6S\xa3($ǐ\x00($" +
+	"ȓ\xc3($ɖ\xa2($\xcbd\x9e($\xccdi(%\x00cY(%\x01\xa6e(%\x03\x99" +
+	"\xf8(%\x04\x96\xb6(%\x05\x93\xa7(%\x06\x8f\xf4(%\aQ5(%\b\x90\x14(%\t\x92\xf4(" +
+	"%\vax(%\fdM(%@d@(%A\xa7((%C\x9d\xc1(%D\x9a\x7f(%E" +
+	"\x97N(%F\x93\xb4(%G\x90\a(%HPJ(%I\x90N(%K_V(%Lb*" +
+	"(%\x80`n(%\x81\xa7\xaf(%\x83\x9dq(%\x84\x9dR(%\x85\x9a (%\x86\x96\x87(%" +
+	"\x87\x92\xd9(%\x88\x90@(%\x89Nh(%\x8b[\x83(%\x8c^X(%\xc0C\xae(%\xc1\x8d" +
+	"d(%\xc3}\x8d(%\xc4v\\(%\xc5nz(%\xc6c\xfd(%\xc7Z\x93(%\xc8Tn(" +
+	"%\xc9N\x8e(%\xcbE\xb2(%\xccE4(%π\x00(%\xd0x\xa4(%\xd1r\x13(%\xd2" +
+	"j\xd6(%\xd3a6(%\xd4X\x8b(%\xd5R\xd7(%\xd6Me(&\x00Y\a(&\x01n\xeb" +
+	"(&\x03d\xcd(&\x04d\x91(&\x05e\x82(&\x06f\x01(&\ab\xdb(&\b`\xc1(&" +
+	"\t\\\xf8(&\v'@(&\fWg(&\rWd(&\x0ej_(&\x0fI\"(&\x10a" +
+	"\x1e(&\x11`\xea(&\x12a\xc4(&\x13b8(&\x14_Z(&\x15]n(&\x16Y\xfe(" +
+	"&\x17F:(&@Y\xd9(&Ao\x80(&Cea(&De%(&Ef\x16(&F" +
+	"e\xd7(&Ge\xbd(&Hc\xa4(&I_\xdb(&KWp(&L'\xf2(&MX\x18" +
+	"(&Nj\xd9(&OH\xb9(&Pa\x98(&Qad(&Rb>(&Sb\x06(&" +
+	"Ta\xec(&U`\x01(&V\\\x90(&WE\xb4(&XU\xeb(&\x8d+8(&\x8en" +
+	"\xed(&\x8fK\x8e(&\x90e\xa2(&\x91ef(&\x92h\xce(&\x93g\xea(&\x94g\x14(" +
+	"&\x95g\xf5(&\x96d7(&\x97H\x16(&\x98\\\xfa(&\x99]\xca(&\xce~u(&\xcf" +
+	"\xbc\xab(&Ф\f(&ѣ\x17(&Ҧ\xd8(&Ө\xa2(&ԧ\x90(&ըO" +
+	"(&֨\xd4(&\u05cf (&\xd8p\xca(&\xd9q[('\x0f]\xbc('\x10\xb6!('" +
+	"\x11\xb5\x12('\x12\xb99('\x13\xbb\xe3('\x14\xbf_('\x15\xbf\xb3('\x16\xc0a('\x17\x86" +
+	"i('\x18M\x1c('\x19L\xc8('PX\xcb('Q\x95\x86('R\x98\xc0('S\x9a\x16(" +
+	"'T\x9b\x9d('U\x9fL('V\x9e\xfc('W\x7f\xeb('Xg\x10('Yg\xa1('\x91" +
+	"Wh('\x92\x95\x8c('\x93\x96\xe1('\x94\x98h('\x95\x9c\x17('\x96\x9e\xdf('\x97x\xd9" +
+	"('\x98f\xd4('\x99gd('\xd2V\xf6('Ӕ\x9e('ԕp('\u0558\xfd('" +
+	"֛\xc5('\xd7q$('\xd8g\xcc('\xd9h]((\x13U$((\x14\x91\xd7((\x15\x95" +
+	"}((\x16\x98E((\x17f\xd8((\x18hI((\x19h\x1f((TR\xbd((U\x91\xe8(" +
+	"(V\x94\xb0((W]\x97((Xe7((Yh\x04((\x95Q\xc7((\x96\x92\x1c((\x97" +
+	"W\x80((\x98c ((\x99e\xed((\xd6O\xdf((\xd7Q\xad((\xd8_c((\xd9b0" +
+	"()\x17\x1e\v()\x18J\x15()\x19I\x9d()X*\x85()Y[f()\x99+=(2" +
+	"\xc0N\xf0(2\xc1d\x16(2\xc2c\x1e(2\xc4X\xdb(2\xc5Y\xd2(2\xc6Zz(2\xc7W" +
+	"\xf8(2\xc8T\xbb(2\xc9Q\xb1(3\x00O\xbd(3\x01d\xa5(3\x02c\xad(3\x04Yj(" +
+	"3\x05Za(3\x06ZE(3\aZ\xe6(3\bW\xa8(3\tT\x9e(3\vMB(3@" +
+	"(\xfa(3Am\xf4(3Bm\x03(3Dco(3Ef\xde(3Ff\x17(3Ge\xf4" +
+	"(3He\xda(3Ib\xe7(3KZ\x04(3LZ\xcd(3\x80lQ(3\x81~U(3" +
+	"\x82\xac\x06(3\x84\xa2\x84(3\x85\xa6M(3\x86\xa88(3\x87\xa7\x1f(3\x88\xa6\xf5(3\x89\xa7" +
+	"\xc1(3\x8bnF(3\x8cn\xd0(3\xc0kO(3\xc1\xab\xf4(3\xc2{\xd1(3ğB(" +
+	"3ţ\v(3Ƥ\xf6(3ǧ\x85(3Ȧ\x93(3ɧ_(3\xcbmD(3\xcc" +
+	"m\xce(4\x00G\x9b(4\x01\xbb\x1d(4\x02\xb6\xef(4\x04\xb0z(4\x05\xb4\xab(4\x06\xb7b" +
+	"(4\a\xba\xf0(4\b\xbe\xa5(4\t\xbe\xf9(4\vI=(4\fH\xdf(4@a\xe9(4" +
+	"A\xa2\xa0(4B\x9fi(4DVq(4E\x95<(4F\x96\xb1(4G\x98\xca(4H\x9a" +
+	"~(4I\x9eF(4Kch(4Lc\xf2(4\x80ed(4\x81\xa6z(4\x82\xa3C(" +
+	"4\x84\x95G(4\x85U\xf6(4\x86\x94g(4\x87\x95\xc8(4\x88\x97Z(4\x89\x9b\"(4\x8b" +
+	"de(4\x8cd\xef(4\xc0d\x9e(4\xc1\xa8k(4¥4(4Ė\xbe(4Ŕg" +
+	"(4\xc6TB(4ǒ0(4ȓ\xc3(4ɗ\x8a(4\xcbe\a(4\xccd\xd3(5" +
+	"\x00d{(5\x01\xa7S(5\x02\xa7\xc6(5\x04\x98\xd6(5\x05\x95\xc7(5\x06\x92.(5\aR" +
+	"\x92(5\b\x90\x14(5\t\x93\xf7(5\vb\x9a(5\fen(5@d_(5A\xa7)(" +
+	"5B\xa6\xd5(5D\x9a\x81(5E\x97O(5F\x93\xb6(5G\x90\t(5HPJ(5I" +
+	"\x90O(5K_t(5LbI(5\x80ab(5\x81\xa7\xe7(5\x82\xa7\x94(5\x84\x9eF" +
+	"(5\x85\x9b\x14(5\x86\x97{(5\x87\x93\xe7(5\x88\x90@(5\x89OY(5\x8b\\w(5" +
+	"\x8c_K(5\xc0D\xf3(5\xc1\x8e?(5\u008d\x80(5\xc4w\f(5\xc5n\xd1(5\xc6d" +
+	"k(5\xc7[\xd5(5\xc8T\xa6(5\xc9O\xb9(5\xcbF\xf1(5\xccFw(5Ѐ\x00(" +
+	"5\xd1r\xb2(5\xd2k%(5\xd3a\x9a(5\xd4Y\xb0(5\xd5S\f(5\xd6Nv(6\x00" +
+	"Y\xfa(6\x01o\xe1(6\x02n\xef(6\x04d\xe2(6\x05e\xd3(6\x06fu(6\ad\x05" +
+	"(6\b`\xde(6\t]\xeb(6\v(M(6\fX[(6\rX@(6\x0ek?(6" +
+	"\x0fje(6\x10I\x81(6\x11a3(6\x12b\r(6\x13b\xa0(6\x14`h(6\x15]" +
+	"\x89(6\x16Z\xda(6\x17G](6@Z\xcd(6Apv(6Bo\x84(6Dew(" +
+	"6Efh(6FfK(6Gf\xe7(6Hc\xc1(6I`\xcd(6KXd(6L" +
+	"(\xff(6MX\xf4(6Nk\xb9(6Oj\xde(6PI\x18(6Qa\xac(6Rb\x86" +
+	"(6Sbn(6Tb\xfa(6U`\x1c(6V]l(6WF\xd9(6XV\xc8(6" +
+	"\x8d,>(6\x8eo\xda(6\x8fn\xdc(6\x90K\xf0(6\x91e\xa9(6\x92i\x12(6\x93h" +
+	"O(6\x94h-(6\x95h\x12(6\x96e%(6\x97IS(6\x98]\xe8(6\x99^\xb7(" +
+	"6\xce\x7fE(6ϭ\a(6м?(6ѣ\xe6(6ҧ\xa7(6ө\x8a(6\xd4" +
+	"\xa8x(6ըO(6֩\n(6\u05cf\xf6(6\xd8q\xbc(6\xd9rL(7\x0f|\xb7" +
+	"(7\x10\xb8\x1a(7\x11\xa0\xb5(7\x12\xa4v(7\x13\xa6Y(7\x14\xa8\xdb(7\x15\xa7\xef(7" +
+	"\x16\xa8\xaa(7\x17\x8f,(7\x18p\xbd(7\x19qN(7P\\\xae(7Q\xb1\xbf(7R\xb5" +
+	"\xe6(7S\xb8\x90(7T\xbc\r(7U\xbf\xaf(7V\xbf\xf5(7W\x86e(7XM~(" +
+	"7YM*(7\x91W\xd6(7\x92\x96\xed(7\x93\x98\\(7\x94\x9ak(7\x95\x9c\x17(7\x96" +
+	"\x9f\xc2(7\x97yw(7\x98g\x17(7\x99g\xa8(7\xd2Wd(7Ӗ\x19(7ԗs" +
+	"(7\u0558\xfd(7֜\xa8(7\xd7ql(7\xd8h\x0f(7\xd9h\xa0(8\x13U\xb4(8" +
+	"\x14\x93\xf2(8\x15\x95}(8\x16\x99((8\x17g7(8\x18h\xaf(8\x19h\x84(8TT" +
+	"\t(8U\x91\xe8(8V\x95\xac(8W^\xc7(8XfP(8Yi\x1d(8\x95Q\xc7(" +
+	"8\x96\x92\x1d(8\x97W\xb7(8\x98c=(8\x99f\v(8\xd6P\xca(8\xd7R\xd2(8\xd8" +
+	"`P(8\xd9c\x1d(9\x17\x1f\x11(9\x18KM(9\x19J\xd7(9X+\x8c(9Y\\T" +
+	"(9\x99,C(B\xc0O\xe5(B\xc1e\x0f(B\xc2d\x17(B\xc3c\x1f(B\xc5Z (B" +
+	"\xc6Z\xc7(B\xc7Xi(B\xc8U\xe7(B\xc9Q\xca(C\x00P\xb1(C\x01e\x9e(C\x02d" +
+	"\xa6(C\x03c\xae(C\x05Z\xaf(C\x06Z\x93(C\a[V(C\bX\xd5(C\tT\xb7(" +
+	"C\vN6(C@*\b(CAn\xe7(CBm\xf5(CCm\x03(CEg,(CF" +
+	"fd(CGfd(CHg\x00(CIc\x00(CKZ\xf4(CL[\xbd(C\x80mE" +
+	"(C\x81\x7f*(C\x82\xac>(C\x83\xab\xd6(C\x85\xa7,(C\x86\xa9\x17(C\x87\xa7\xfe(C" +
+	"\x88\xa7\xee(C\x89\xa7\xc1(C\x8bo:(C\x8co\xc4(C\xc0lC(C\xc1\xac-(C\xc2|" +
+	"\xa7(Cè\x1b(Cţ\xea(Cƥ\xd5(CǨ~(Cȧ\x8c(Cɧ_(" +
+	"C\xcbn8(C\xccn\xc2(D\x00kB(D\x01\xab\xb9(D\x02\xa8\f(D\x03zG(D\x05" +
+	"\xa0\xae(D\x06\xa2\x9a(D\a\xa5B(D\b\xa7\xf8(D\t\xa7\x04(D\vm8(D\fm\xc2" +
+	"(D@H\x03(DA\xba\xb2(DB\xb6\x84(DC\xb2[(DE\xb1T(DF\xb4\v(D" +
+	"G\xb7\x99(DH\xbbN(DI\xbe\xf7(DKI\xa5(DLIG(D\x80e\xa7(D\x81\xa7" +
+	"L(D\x82\xa4\x16(D\x83\xa0\xe3(D\x85Vr(D\x86\x95\xd8(D\x87\x97R(D\x88\x99o(" +
+	"D\x89\x9b\"(D\x8bd\xa8(D\x8ce2(D\xc0d\xe1(D\xc1\xa9>(D¦\a(D\xc3" +
+	"\xa2\xd4(Dŕ\xd8(D\xc6T\xbe(DǓ\xbb(Dȕ\xd8(Dɗ\x8a(D\xcbeK" +
+	"(D\xcce\x16(E\x00d\xe1(E\x01\xa8&(E\x02\xa8\xb4(E\x03\xa5\x81(E\x05\x97S(E" +
+	"\x06\x93\xba(E\aS1(E\b\x92E(E\t\x93\xf7(E\vb\xff(E\fe\xd4(E@e" +
+	"}(EA\xa8\x17(EB\xa7\xc3(EC\xa8:(EE\x99p(EF\x95\xd6(EG\x92B(" +
+	"EHQ\xa8(EI\x90O(EK`\x92(ELcg(E\x80a|(E\x81\xa7\xe8(E\x82" +
+	"\xa7\x94(E\x83\xa7D(E\x85\x9b\x16(E\x86\x97|(E\x87\x93\xe9(E\x88\x90B(E\x89OZ" +
+	"(E\x8b\\\x91(E\x8c_f(E\xc0F4(E\xc1\x95\x03(E\u0094\xaf(EÔ\x01(E" +
+	"\xc5op(E\xc6d\xc3(E\xc7\\E(E\xc8U\xe9(E\xc9O\xf1(E\xcbH-(E\xccG" +
+	"\xb5(Eр\x00(E\xd2k\xb5(E\xd3a\xea(E\xd4Z\x15(E\xd5T1(E\xd6N\xab(" +
+	"F\x00Z\xea(F\x01p\xd3(F\x02o\xe2(F\x03n\xf0(F\x05f!(F\x06f\xc3(F\a" +
+	"du(F\bb\x04(F\t^\x04(F\v)[(F\fYJ(F\rY\x19(F\x0el\x1c" +
+	"(F\x0fkA(F\x10jf(F\x11I\xe0(F\x12bR(F\x13b\xe5(F\x14`\xcc(F" +
+	"\x15^\x93(F\x16Z\xf1(F\x17H|(F@[\xbd(FAqh(FBpw(FCo" +
+	"\x85(FEf\xb6(FFf\x98(FGgW(FHd\xe7(FI`\xe6(FKYT(" +
+	"FL*\r(FMY\xcd(FNl\x95(FOk\xba(FPj\xdf(FQIw(FR" +
+	"b\xcb(FSb\xb3(FTc^(FUa&(FV]\x83(FWG\xfb(FXW\xa0" +
+	"(F\x8d-D(F\x8ep\xc8(F\x8fo\xca(F\x90n\xce(F\x91LT(F\x92iQ(F" +
+	"\x93h\x8f(F\x94h\x8e(F\x95i'(F\x96e>(F\x97J\x8c(F\x98^\xd1(F\x99_" +
+	"\xa1(F\u0380\x16(Fϭ=(FЬ\xcb(Fѻ\xd4(FҨv(FӪX(" +
+	"FԩF(Fթ7(F֩\n(Fז\x9c(F\xd8r\xa9(F\xd9s:(G\x0f" +
+	"}\x87(G\x10\xa9'(G\x11\xb7\xb0(G\x12\xa5E(G\x13\xa7'(G\x14\xa9\xc3(G\x15\xa8\xd7" +
+	"(G\x16\xa8\xaa(G\x17\x96;(G\x18q\xab(G\x19r;(GP{\x1f(GQ\xb3\x91(G" +
+	"R\xa2\x1a(GS\xa3\xfc(GT\xa6\x98(GU\xa9@(GV\xa8P(GW\x95\x83(GXp" +
+	"\xaf(GYq?(G\x91[\xc9(G\x92\xb2\x95(G\x93\xb5?(G\x94\xb8\xbb(G\x95\xbc](" +
+	"G\x96\xbf\xf2(G\x97\x86c(G\x98M\xe2(G\x99M\x8e(G\xd2W\xd2(Gӗz(G\xd4" +
+	"\x98\xed(G՛\x00(G֜\xa8(G\xd7q\xfa(G\xd8hO(G\xd9h\xdf(H\x13V\"" +
+	"(H\x14\x95m(H\x15\x97\x80(H\x16\x99((H\x17g\x7f(H\x18h\xee(H\x19h\xc4(H" +
+	"TT\x98(HU\x94\x04(HV\x95\xac(HW_'(HXf\xb1(HYi~(H\x95S" +
+	"\x13(H\x96\x92\x1d(H\x97X\xe7(H\x98dR(H\x99g\x1f(H\xd6P\xcb(H\xd7S\t(" +
+	"H\xd8`i(H\xd9c6(I\x17 \x17(I\x18L\x81(I\x19L\r(IX,\x92(IY" +
+	"]=(I\x99-I(R\xc0O\xd9(R\xc1e\x17(R\xc2d\x1f(R\xc3c'(R\xc4b/" +
+	"(R\xc6Y=(R\xc7V\xdf(R\xc8T\x80(R\xc9QB(S\x00P\xa6(S\x01e\xa6(S" +
+	"\x02d\xae(S\x03c\xb6(S\x04b\xbe(S\x06Y$(S\aY\xcc(S\bWm(S\tT" +
+	"/(S\vM^(S@)\xa1(SAq{(SBpn(SCo|(SDn\x8a(" +
+	"SFe\xd4(SGe\xb8(SHfw(SIcO(SKZ\xf4(SL[\xbd(S\x80" +
+	"o\xd6(S\x81\x7fi(S\x82\xab\xca(S\x83\xab?(S\x84\xaa\xdb(S\x86\xa8I(S\x87\xa7Z" +
+	"(S\x88\xa7I(S\x89\xa76(S\x8boL(S\x8co\xd6(S\xc0n\xb9(S\xc1\xab\xb8(S" +
+	"\xc2|\xe5(Sç\x83(Sħ\x1f(Sƥ\a(Sǧ\xb7(SȦ\xc5(Sɦ" +
+	"\xb2(S\xcbnJ(S\xccn\xd4(T\x00m\xb9(T\x01\xab\"(T\x02\xa7t(T\x03z\x85(" +
+	"T\x04\xa3j(T\x06\xa1\xcb(T\a\xa4{(T\b\xa7J(T\t\xa6V(T\vmJ(T\f" +
+	"m\xd4(T@l\xba(TA\xaa\xb2(TB\xa7\x05(TC\xa3\\(TDxH(TF\x9e\x95" +
+	"(TG\xa1E(TH\xa4\x14(TI\xa6\xe1(TKlK(TLl\xd5(T\x80Hd(T" +
+	"\x81\xbe\xfd(T\x82\xba\xd0(T\x83\xb6\xa7(T\x84\xb2\x81(T\x86\xb1\x98(T\x87\xb4T(T\x88\xb7" +
+	"\xe7(T\x89\xbb\x90(T\x8bI((T\x8cH\xca(T\xc0dC(T\xc1\xa8a(T¥*(" +
+	"Tá\xf7(TĞ\xc9(T\xc6S\xb7(TǔX(TȖ\x96(Tɘ\xed(T\xcb" +
+	"c\xc8(T\xccc\xaf(U\x00d'(U\x01\xa7s(U\x02\xa7\xde(U\x03\xa4\xac(U\x04\xa1}" +
+	"(U\x06\x94W(U\aR*(U\b\x93\x03(U\t\x95Z(U\va}(U\fdR(U" +
+	"@d\xe6(UA\xa7d(UB\xa6\xed(UC\xa7\x7f(UD\xa4Q(UF\x96\x96(UG\x93" +
+	"\x02(UHP\xc3(UI\x91\xcd(UK_2(ULb\a(U\x80a\xc0(U\x81\xa7P(" +
+	"U\x82\xa6\xd9(U\x83\xa6\x8a(U\x84\xa7 (U\x86\x98\xeb(U\x87\x95X(U\x88\x91\xca(U\x89" +
+	"OW(U\x8b\\\f(U\x8c^\xe1(U\xc0Fu(U\xc1\x9c\xfb(U\u009c\x8f(UÜ:" +
+	"(Uě\x9d(U\xc6c\xf1(U\xc7[u(U\xc8U\x1d(U\xc9O((U\xcbGo(U" +
+	"\xccF\xfa(UҀ\x00(U\xd3a,(U\xd4YZ(U\xd5Sy(U\xd6M\xf6(V\x00Z" +
+	"\xe0(V\x01p\xdb(V\x02o\xe9(V\x03n\xf7(V\x04n\x06(V\x06eD(V\ab\xf6(" +
+	"V\b`\xa8(V\t]\x80(V\v(=(V\fXw(V\rY\x0f(V\x0el$(V\x0f" +
+	"kI(V\x10jn(V\x11i\x93(V\x12Iq(V\x13a\x8b(V\x14_q(V\x15]X" +
+	"(V\x16Z{(V\x17G\xd5(V@[\xb3(VAqp(VBp~(VCo\x8c(V" +
+	"Dn\x9a(VFe5(VGe\xd8(VHc\x8b(VI`b(VKX\x81(VL(" +
+	"\xef(VMY\xc3(VNl\x9e(VOk\xc3(VPj\xe8(VQj\r(VRI\b(" +
+	"VSaq(VTb\x04(VU_\xeb(VV]\r(VWGV(VXV\xe2(V\x8d" +
+	",\xea(V\x8esX(V\x8fr?(V\x90qC(V\x91pI(V\x92L\xb9(V\x93h\x01" +
+	"(V\x94g\xe6(V\x95h\xa0(V\x96e\x8c(V\x97J\xd8(V\x98^\xd5(V\x99_\xa4(V" +
+	"\u0380`(VϬ\xce(VЬ;(Vѫ\xce(V\xd2\xc0\x17(Vө\x8d(VԨ" +
+	"\xa4(Vը\x95(V֨\x81(Vמ\x81(V\xd8r\xbe(V\xd9sN(W\x0f}\xd2(" +
+	"W\x10\xa8\x96(W\x11\xa8)(W\x12\xbb\xf2(W\x13\xa6\\(W\x14\xa8\xff(W\x15\xa8\x13(W\x16" +
+	"\xa7\xff(W\x17\x9e\b(W\x18q\xc0(W\x19rP(WP{i(WQ\xa4\x8b(WR\xb7\xd3" +
+	"(WS\xa31(WT\xa5\xd4(WU\xa8\x95(WV\xa7\xa5(WW\x9d\xa8(WXp\xc4(W" +
+	"YqT(W\x91y&(W\x92\xb3\xba(W\x93\xa0\v(W\x94\xa2\xae(W\x95\xa5p(W\x96\xa8" +
+	"-(W\x97\x9d\x02(W\x98o\xc9(W\x99pZ(W\xd2Z0(WӲ\xd4(WԵ\x82(" +
+	"Wչ\x02(Wּ\x97(W׆b(W\xd8Mj(W\xd9M\x15(X\x13U\x1f(X\x14" +
+	"\x96\n(X\x15\x98>(X\x16\x9a\x87(X\x17f\xb0(X\x18gv(X\x19gf(XTS\x96" +
+	"(XU\x94\xc2(XV\x97\v(XW^[(XXe9(XYh\x06(X\x95R2(X" +
+	"\x96\x93\x95(X\x97X\x1e(X\x98b\xfc(X\x99e\xc9(X\xd6P\xc8(X\xd7RC(X\xd8_" +
+	"\xe8(X\xd9b\xb5(Y\x17\x1f\x01(Y\x18K\xc9(Y\x19KY(YX+|(YY\\q(" +
+	"Y\x99,3(b\xc0R@(b\xc1h\x81(b\xc2gm(b\xc3fu(b\xc4e}(b\xc5" +
+	"e\xa6(b\xc7Y<(b\xc8V\xdd(b\xc9S\x9f(c\x00S\x1e(c\x01i\v(c\x02g\xf7" +
+	"(c\x03f\xff(c\x04f\x06(c\x05f0(c\a\\,(c\bY\xcd(c\tV\x8f(c" +
+	"\vP\x01(c@,\xd3(cAs\xd0(cBr\xc1(cCq\xcf(cDp\xde(cEr" +
+	"r(cGg\xd3(cHh\x91(cIei(cK].(cL^\a(c\x80r?(" +
+	"c\x81\x82\x92(c\x82\xado(c\x83\xac,(c\x84\xab\xa6(c\x85\xaf\xc1(c\x87\xaa\x8b(c\x88" +
+	"\xaa~(c\x89\xaak(c\x8br\x8d(c\x8cs\x13(c\xc0q!(c\xc1\xad_(c\xc2\x7f\xf2" +
+	"(cè\\(cħ\xd5(cū\xf1(cǪ\x0e(cȩB(cɩ/(c" +
+	"\xcbqp(c\xccq\xf5(d\x00p!(d\x01\xac\x10(d\x02\xa8M(d\x03}\x92(d\x04\xa4" +
+	"\x1f(d\x05\xa8;(d\a\xa6\xd2(d\b\xa9\xa6(d\t\xa8\xb1(d\vpp(d\fp\xf5(" +
+	"d@o\"(dA\xab~(dB\xa7\xbb(dC\xa4\x12(dD{U(dE\xa4\x8b(dG" +
+	"\xa3\x9c(dH\xa6o(dI\xa9<(dKoq(dLo\xf6(d\x80p\xb3(d\x81\xaf\x99" +
+	"(d\x82\xab\xd6(d\x83\xa8-(d\x84\xa4\x88(d\x85z\xab(d\x87\xa1u(d\x88\xa4-(d" +
+	"\x89\xa6\xfa(d\x8bo\x99(d\x8cp\x1f(d\xc0K8(d\xc1§(d¾x(dú" +
+	"O(dĶ*(dŶ\xea(dǴl(dȸ\x02(dɻ\xab(d\xcbL\xa8(" +
+	"d\xccK\xbd(e\x00f((e\x01\xaa{(e\x02\xaa\n(e\x03\xa6\xd7(e\x04\xa3\xa9(e\x05" +
+	"\xa1~(e\aSC(e\b\x96e(e\t\x98\xbc(e\vc\xaf(e\ff\x8c(e@f\xe6" +
+	"(eA\xaao(eB\xa9?(eC\xa9\xaf(eD\xa6\x80(eE\xa4;(eG\x96d(e" +
+	"HQ\xdc(eI\x95/(eKad(eLdA(e\x80c\xc0(e\x81\xaa[(e\x82\xa9" +
+	"+(e\x83\xa8\xb9(e\x84\xa9O(e\x85\xa7\n(e\x87\x98\xba(e\x88\x95,(e\x89Pp(" +
+	"e\x8b^>(e\x8ca\x1b(e\xc0I\x91(e\xc1\xa8L(e§\n(eæ\x9d(e\xc4" +
+	"\xa6G(eŧ\x1c(e\xc7\\\x12(e\xc8Um(e\xc9P.(e\xcbF\xc8(e\xccJ2" +
+	"(eӀ\x00(e\xd4Y\xec(e\xd5S\xc4(e\xd6N\xe6(f\x00]%(f\x01t\x16(f" +
+	"\x02s\a(f\x03r\x15(f\x04q$(f\x05qN(f\aeL(f\bb\xfe(f\t_" +
+	"\xd6(f\v+R(f\fZ\xf7(f\r[\x17(f\x0eo\x10(f\x0fn\x1c(f\x10mB(" +
+	"f\x11lg(f\x12l\x8b(f\x13L\x9d(f\x14a\x89(f\x15_p(f\x16\\\x93(f\x17" +
+	"G9(f@]\xfe(fAt\x9b(fBs\x8d(fCr\x9b(fDq\xa9(fEq\xd4" +
+	"(fGh.(fHe\xe0(fIb\xb8(fKZ\xf7(fL,)(fM[\xda(f" +
+	"No\x84(fOn\x91(fPm\xb6(fQl\xdb(fRl\xff(fSK\xb5(fTd" +
+	"\x1f(fUb\x06(fV_((fWJC(fXY\x1e(f\x8d0\x00(f\x8eu\xa7(" +
+	"f\x8ft\x8e(f\x90s\x92(f\x91r\x98(f\x92t\"(f\x93Or(f\x94i\xce(f\x95" +
+	"j\x89(f\x96gu(f\x97M\xd7(f\x98a\x02(f\x99a\xd6(f\u0383~(fϮf" +
+	"(fЭ\x1d(fѬ\x8e(fҰ\x98(f\xd3í(fԫ\x9f(fի\x94(f" +
+	"֫\x80(fש\xa4(f\xd8u\xee(f\xd9vo(g\x0f\x80\xd5(g\x10\xa9d(g\x11\xa8" +
+	"\xd6(g\x12\xac\xdf(g\x13\xbf\x88(g\x14\xab#(g\x15\xaa\\(g\x16\xaaI(g\x17\xa8Z(" +
+	"g\x18t\xd5(g\x19uV(gP~l(gQ\xa57(gR\xa9A(gS\xbbi(gT" +
+	"\xa7\xf8(gU\xaa\xbd(gV\xa9\xcc(gW\xa7\xe3(gXs\xd9(gYtZ(g\x91|)" +
+	"(g\x92\xa5\xa7(g\x93\xb7P(g\x94\xa4\xd2(g\x95\xa7\x97(g\x96\xaaT(g\x97\xa7\x83(g" +
+	"\x98r\xdf(g\x99s`(g\xd2{|(gӸ\x0e(gԢ\xb5(gե`(g֨" +
+	"\x1d(gרU(g\xd8s\x06(g\xd9s\x87(h\x13[\xe1(h\x14\xb5s(h\x15\xb8\xf7(" +
+	"h\x16\xbc\x8c(h\x17\x86a(h\x18P\xd5(h\x19O\xed(hTT\x92(hU\x97\xf6(hV" +
+	"\x9a?(hW^\xc5(hXgZ(hYj((h\x95S/(h\x96\x96\xc9(h\x97X<" +
+	"(h\x98e\x1d(h\x99g\xeb(h\xd6Q\xc5(h\xd7S\x13(h\xd8b\t(h\xd9d\xd8(i" +
+	"\x17\"a(i\x18K\x1f(i\x19Nt(iX.\x7f(iY^\xd8(i\x99/R(r\xc0U" +
+	"/(r\xc1k8(r\xc2k8(r\xc3j$(r\xc4i+(r\xc5iU(r\xc6g\xd1(" +
+	"r\xc8YV(r\xc9V\x18(s\x00U\xbc(s\x01k\\(s\x02k\\(s\x03jH(s\x04" +
+	"iP(s\x05iz(s\x06gf(s\b\\'(s\tX\xe9(s\vRZ(s@0\r" +
+	"(sAu\xe9(sBu\xe9(sCt\xda(sDs\xe9(sEu}(sFsd(s" +
+	"Hj\xe3(sIg\xba(sK`\f(sL`x(s\x80t`(s\x81\x84`(s\x82\xad" +
+	"\xcf(s\x83\xac\x8b(s\x84\xac\x04(s\x85\xb0 (s\x86\xb3\xc9(s\x88\xac\x98(s\x89\xac\x89(" +
+	"s\x8bu4(s\x8cu9(s\xc0tZ(s\xc1\xad\xc9(s\u0083\x1e(sê\x03(s\xc4" +
+	"\xa8\xc5(sŬ\xe0(sưg(sȬt(sɬe(s\xcbu.(s\xccu2" +
+	"(t\x00s>(t\x01\xacz(t\x02\xa9\xf5(t\x03\x80\xa1(t\x04\xa4\xfa(t\x05\xa9\x16(t" +
+	"\x06\xac\x9c(t\b\xab\xfd(t\t\xab/(t\vt\x13(t\ft\x17(t@r@(tA\xab" +
+	"\xe8(tB\xa8\xac(tC\xa4\xed(tD~d(tE\xa5f(tF\xa8\xec(tH\xa8\xc6(" +
+	"tI\xab\x97(tKs\x14(tLs\x19(t\x80s\xd0(t\x81\xb0\x03(t\x82\xac\xc7(t\x83" +
+	"\xa9\a(t\x84\xa5c(t\x85}\xba(t\x86\xa7n(t\x88\xa6\x84(t\x89\xa9U(t\x8bs<" +
+	"(t\x8csA(t\xc0q\x8d(t\xc1\xb3\x84(t°%(tìf(tĨ\xc1(t" +
+	"ŧ@(t\xc6za(tȤ\a(tɦ\xd8(t\xcbq\xba(t\xccq7(u\x00N" +
+	",(u\x01\xc2|(u\x02\xc2,(u\x03\xbe\x02(u\x04\xb9\xdd(u\x05\xba\x9d(u\x06\xb6\xa7(" +
+	"u\b\xb8\x1a(u\t\xbb\xc7(u\vL\x8a(u\fO=(u@i\x1c(uA\xach(uB" +
+	"\xacG(uC\xab\xdb(uD\xa8\xac(uE\xa6f(uF\xa4\x15(uHR\xf5(uI\x98\x91" +
+	"(uKc\xc3(uLfo(u\x80e\xf6(u\x81\xacX(u\x82\xac7(u\x83\xab\v(u" +
+	"\x84\xab\x7f(u\x85\xa99(u\x86\xa6\xe8(u\x88\x98\x8e(u\x89Q\x88(u\x8b`\x9d(u\x8cc" +
+	"I(u\xc0L\xc7(u\xc1\xb0\xab(u°t(uï1(uĮ\xc3(uů\x96(" +
+	"uƮ\xfb(u\xc8U\xde(u\xc9Pj(u\xcbJ\xbd(u\xccI\x89(uԀ\x00(u\xd5" +
+	"T/(u\xd6O\x1f(v\x00_\xe7(v\x01v\x99(v\x02v\x99(v\x03u\x8b(v\x04t\x99" +
+	"(v\x05t\xc3(v\x06sj(v\bec(v\tb;(v\v,^(v\f]$(v" +
+	"\r]\xb1(v\x0eqy(v\x0fqy(v\x10p\x86(v\x11o\xab(v\x12o\xcf(v\x13n" +
+	"\x91(v\x14L\x84(v\x15a\xa2(v\x16^\xc5(v\x17J\xd9(v@`y(vAv\xc4(" +
+	"vBv\xc3(vCu\xb5(vDt\xc3(vEt\xed(vFs\x0f(vHh2(vI" +
+	"e\t(vK]G(vL/>(vM^\x12(vNqy(vOqy(vPp\x85" +
+	"(vQo\xab(vRo\xcf(vSn\x14(vTN\xe0(vUd\x1a(vVa=(v" +
+	"WI\xa6(vX[3(v\x8d3 (v\x8ew\xb1(v\x8fw\xab(v\x90v\x94(v\x91u" +
+	"\x99(v\x92w#(v\x93t\xea(v\x94RI(v\x95l\xa7(v\x96i\x93(v\x97P\xf1(" +
+	"v\x98c\xa8(v\x99d9(v΅>(vϮ\xc6(vЭ}(vѬ\xef(v\xd2" +
+	"\xb0\xf8(vӴm(v\xd4\xc3y(vխ|(v֭l(vױ\xdb(v\xd8x^" +
+	"(v\xd9x\x88(w\x0f\x83\xf5(w\x10\xaa\xfe(w\x11\xa9\xba(w\x12\xad\xc4(w\x13\xb1\x17(w" +
+	"\x14\xc3((w\x15\xadW(w\x16\xadG(w\x17\xb1\xa1(w\x18xX(w\x19x\x82(wP\x81" +
+	"r(wQ\xa6\b(wR\xaa\x11(wS\xadd(wT\xbf\t(wU\xac\xe1(wV\xac\x16(" +
+	"wW\xb0X(wXwA(wYwk(w\x91\x7f.(w\x92\xa6x(w\x93\xa9\xcb(w\x94" +
+	"\xba\xf0(w\x95\xa9\xbb(w\x96\xac|(w\x97\xaf\xe2(w\x98vF(w\x99vq(w\xd2~\x82" +
+	"(wӨS(wԻ\xae(wէ\x83(w֪D(wװ\xb0(w\xd8vn(w" +
+	"\xd9v\x98(x\x13{\x18(x\x14\xb7\xa1(x\x15\xa5\"(x\x16\xa7\xe3(x\x17\xaf\xf0(x\x18t" +
+	"\xef(x\x19t\x99(xT]\xc7(xU\xb8\xe8(xV\xbc\x80(xW\x86a(xXP\x8e(" +
+	"xYSY(x\x95T+(x\x96\x99\xfd(x\x97X{(x\x98gX(x\x99j\b(x\xd6" +
+	"R\xc1(x\xd7S\x1d(x\xd8dD(x\xd9f\xf4(y\x17%\xc0(y\x18N\xd5(y\x19M\xc8" +
+	"(yX/q(yY`\xf5(y\x992V(\x82\xc0W\x84(\x82\xc1n&(\x82\xc2m\xea(\x82" +
+	"\xc3m\xea(\x82\xc4l\xd6(\x82\xc5m\x00(\x82\xc6k|(\x82\xc7g\xfc(\x82\xc9X\x87(\x83\x00X" +
+	"\x11(\x83\x01nK(\x83\x02n\x0f(\x83\x03n\x0f(\x83\x04l\xfb(\x83\x05m$(\x83\x06k\x11(" +
+	"\x83\aj@(\x83\t[](\x83\vT\xea(\x83@3!(\x83AxI(\x83Bx\r(\x83C" +
+	"x\r(\x83Dv\xfe(\x83Ex\x93(\x83Fvy(\x83Gu/(\x83Ij\b(\x83KbX" +
+	"(\x83Lb\xc4(\x83\x80v\xbd(\x83\x81\x86p(\x83\x82\xad\xfb(\x83\x83\xad\xd9(\x83\x84\xac\x99(\x83" +
+	"\x85\xb0\xb5(\x83\x86\xb4;(\x83\x87\xb5E(\x83\x89\xaf{(\x83\x8bx\x12(\x83\x8cx\x17(\x83\xc0v" +
+	"|(\x83\xc1\xad\xf5(\x83\u0084\xee(\x83êg(\x83ĩ'(\x83ŭB(\x83ư\xc9(" +
+	"\x83ǵ (\x83ɮ\x7f(\x83\xcbw\xd1(\x83\xccw\xd5(\x84\x00vw(\x84\x01\xad\xd3(\x84\x02" +
+	"\xaac(\x84\x03\x83\xcf(\x84\x04\xa6\xa6(\x84\x05\xaa\xc1(\x84\x06\xad\x90(\x84\a\xb1\xc5(\x84\t\xae`" +
+	"(\x84\vw\xcd(\x84\fw\xd1(\x84@u^(\x84A\xac\x89(\x84B\xa9\x1a(\x84C\xa6\x99(\x84" +
+	"D\x81v(\x84E\xa6\xfc(\x84F\xa9\xcb(\x84G\xad\xff(\x84I\xad\xee(\x84Kv\xb3(\x84Lv" +
+	"\xb7(\x84\x80v\xee(\x84\x81\xb0\xa4(\x84\x82\xad4(\x84\x83\xaa\xb4(\x84\x84\xa6\xf9(\x84\x85\x80\xcc(" +
+	"\x84\x86\xa8L(\x84\x87\xab\xd9(\x84\x89\xab\xac(\x84\x8bv\xdb(\x84\x8cv\xdf(\x84\xc0t\xab(\x84\xc1" +
+	"\xb4\x03(\x84°\x93(\x84í[(\x84ĩ\xa0(\x84Ũ\x1e(\x84\xc6}r(\x84ǩU" +
+	"(\x84ɩ/(\x84\xcbuY(\x84\xcct\xd6(\x85\x00s6(\x85\x01\xb4\xe5(\x85\x02\xb4\xc3(\x85" +
+	"\x03\xb1h(\x85\x04\xad\xad(\x85\x05\xab\x83(\x85\x06\xa9+(\x85\az\xe7(\x85\t\xa6\xbb(\x85\vq" +
+	"\xdd(\x85\ft\x03(\x85@Q\xac(\x85A\xc3K(\x85B\xc2\n(\x85C\xc1\xbf(\x85D\xbd\x98(" +
+	"\x85E\xbeX(\x85F\xbab(\x85G\xb9`(\x85I\xbb\xe1(\x85KLl(\x85LO\x1f(\x85\x80" +
+	"h\x1f(\x85\x81\xaf*(\x85\x82\xae0(\x85\x83\xae\x13(\x85\x84\xad\xab(\x85\x85\xabe(\x85\x86\xa9\x14" +
+	"(\x85\x87\xa6\xcc(\x85\x89R\xa1(\x85\x8bb\xf3(\x85\x8ce\xa4(\x85\xc0L\x1d(\x85\xc1\xb7\xc4(\x85" +
+	"¶\x99(\x85öa(\x85ĵ\x1d(\x85ŵ\xed(\x85Ƶ\x9f(\x85ǵ/(\x85\xc9P" +
+	"\xbf(\x85\xcbK\xbd(\x85\xccM}(\x85Հ\x00(\x85\xd6Op(\x86\x00b\x10(\x86\x01yU(" +
+	"\x86\x02y\x19(\x86\x03y\x19(\x86\x04x\n(\x86\x05x4(\x86\x06v\xdb(\x86\as\x91(\x86\t" +
+	"d\x96(\x86\v,\xc2(\x86\f_\x9a(\x86\r_\xc2(\x86\x0et\x13(\x86\x0fs\xdf(\x86\x10s\xdf" +
+	"(\x86\x11r\xec(\x86\x12s\x10(\x86\x13q\xd2(\x86\x14n\xb8(\x86\x15Lk(\x86\x16`\xed(\x86" +
+	"\x17K\xc6(\x86@b\xa2(\x86Ay\x7f(\x86ByC(\x86CyC(\x86Dx4(\x86Ex" +
+	"_(\x86Fv\x80(\x86Gu\xd6(\x86Igj(\x86K_\xc1(\x86L0J(\x86M`\"(" +
+	"\x86Nt\x13(\x86Os\xdf(\x86Ps\xde(\x86Qr\xeb(\x86Rs\x0f(\x86SqT(\x86T" +
+	"p\xb3(\x86UN\xc7(\x86Vck(\x86WME(\x86X]z(\x86\x8d6#(\x86\x8ez\x00" +
+	"(\x86\x8fy\xc0(\x86\x90y\xbb(\x86\x91x\xa6(\x86\x92z0(\x86\x93w\xf7(\x86\x94v\x89(\x86" +
+	"\x95U\xb6(\x86\x96k\xac(\x86\x97PC(\x86\x98e\xc0(\x86\x99fQ(\x86·>(\x86Ϯ" +
+	"\xe9(\x86Ю\xc6(\x86ѭ\x83(\x86ұ\x8c(\x86Ӵ\xdf(\x86Ե\xbf(\x86\xd5\xc4:(" +
+	"\x86ְ((\x86\u05f8\xd2(\x86\xd8{\x04(\x86\xd9{.(\x87\x0f\x85\xb8(\x87\x10\xabb(\x87\x11" +
+	"\xaa\x1f(\x87\x12\xae((\x87\x13\xb1{(\x87\x14\xb5\x9a(\x87\x15\xc2\xfc(\x87\x16\xaf/(\x87\x17\xb7\xa8" +
+	"(\x87\x18z\xc4(\x87\x19z\xee(\x87P\x84\x95(\x87Q\xa7\xa6(\x87R\xab\xaf(\x87S\xaeM(\x87" +
+	"T\xb2J(\x87U²(\x87V\xaf\x11(\x87W\xb7o(\x87Xz\xbf(\x87Yz\xea(\x87\x91\x82" +
+	"7(\x87\x92\xa8\x02(\x87\x93\xaa\x9f(\x87\x94\xae\x9d(\x87\x95\xbe\x99(\x87\x96\xae\xa0(\x87\x97\xb6'(" +
+	"\x87\x98y\xaa(\x87\x99y\xd4(\x87ҁ\x8a(\x87ө((\x87Ԭ\x80(\x87տW(\x87\xd6" +
+	"\xach(\x87\u05f6\xf3(\x87\xd8y\xd2(\x87\xd9y\xfc(\x88\x13~ (\x88\x14\xaa\x19(\x88\x15\xbbJ" +
+	"(\x88\x16\xaa\a(\x88\x17\xb6\x7f(\x88\x18xR(\x88\x19w\xfc(\x88T{\x82(\x88U\xba&(\x88" +
+	"V\xa7\xaf(\x88W\xb5\xeb(\x88Xt\xfc(\x88Yw*(\x88\x95_\xd8(\x88\x96\xbct(\x88\x97\x86" +
+	"a(\x88\x98PG(\x88\x99S\x11(\x88\xd6S\xbe(\x88\xd7SA(\x88\xd8fv(\x88\xd9i,(" +
+	"\x89\x17)\x1d(\x89\x18O\xa2(\x89\x19Q|(\x89X/\xbe(\x89YcF(\x89\x993G(\x92\xc0" +
+	"Z\xde(\x92\xc1r\a(\x92\xc2q\xcc(\x92\xc3q\x90(\x92\xc4q\x90(\x92\xc5q\xb9(\x92\xc6p\x1a" +
+	"(\x92\xc7l\x99(\x92\xc8i\x19(\x93\x00[\x81(\x93\x01r.(\x93\x02q\xf2(\x93\x03q\xb7(\x93" +
+	"\x04q\xb7(\x93\x05q\xe0(\x93\x06o\xb1(\x93\an\xe0(\x93\bk_(\x93\vW\xad(\x93@4" +
+	"\xbf(\x93A{\xe8(\x93B{\xac(\x93C{p(\x93D{p(\x93E}\x05(\x93Fz\xce(" +
+	"\x93Gy\x84(\x93Hx\xf5(\x93Ke\x95(\x93Lf\x15(\x93\x80z\x8a(\x93\x81\x89w(\x93\x82" +
+	"\xaf|(\x93\x83\xae\x83(\x93\x84\xaef(\x93\x85\xb2_(\x93\x86\xb5,(\x93\x87\xb6\x13(\x93\x88\xb8\x10" +
+	"(\x93\x8b{\xde(\x93\x8c{\xe4(\x93\xc0zI(\x93\xc1\xafw(\x93\u0087\xf4(\x93ë\x10(\x93" +
+	"Ī\xf3(\x93Ů\xec(\x93Ʊ\xb9(\x93ǵ\xee(\x93ȷ\x13(\x93\xcb{\x9c(\x93\xcc{" +
+	"\xa3(\x94\x00z\n(\x94\x01\xae}(\x94\x02\xab\r(\x94\x03\x86\x96(\x94\x04\xa7\x87(\x94\x05\xab\x80(" +
+	"\x94\x06\xaeM(\x94\a\xb2\x82(\x94\b\xb6\xf5(\x94\v{](\x94\f{d(\x94@z\a(\x94A" +
+	"\xae`(\x94B\xaa\xf0(\x94C\xa7\x84(\x94D\x85\x9b(\x94E\xabg(\x94F\xab\xd1(\x94G\xafN" +
+	"(\x94H\xb3\x9e(\x94K{[(\x94L{a(\x94\x80{\x98(\x94\x81\xb2X(\x94\x82\xae\xe8(\x94" +
+	"\x83\xab}(\x94\x84\xabd(\x94\x85\x84\xf1(\x94\x86\xaaS(\x94\x87\xad'(\x94\x88\xb1\\(\x94\x8b{" +
+	"\x83(\x94\x8c{\x89(\x94\xc0y9(\x94\xc1\xb4\xff(\x94±\x8f(\x94î$(\x94ī\xa7(" +
+	"\x94Ū&(\x94Ɓ{(\x94Ǫ\x8e(\x94Ȯ\xc4(\x94\xcby\xe5(\x94\xccye(\x95\x00" +
+	"w\xc4(\x95\x01\xb5\xbf(\x95\x02\xb5\x9c(\x95\x03\xb21(\x95\x04\xae\xfd(\x95\x05\xac\xd2(\x95\x06\xaad" +
+	"(\x95\a~\xef(\x95\b\xac\xc3(\x95\vvj(\x95\fx\x92(\x95@w\r(\x95A\xb7\x94(\x95" +
+	"B\xb6\x9a(\x95C\xb6|(\x95D\xb3%(\x95E\xb0\xe0(\x95F\xaer(\x95G\xac\x9a(\x95H|" +
+	"\x9f(\x95Kr\xee(\x95Lu\x17(\x95\x80Q\x9d(\x95\x81Č(\x95\x82\xc3J(\x95\x83\xc2\x0e(" +
+	"\x95\x84\xc1\xc7(\x95\x85\u0087(\x95\x86\xbe\x8f(\x95\x87\xbd\x8d(\x95\x88\xbd`(\x95\x8bL[(\x95\x8c" +
+	"O\x10(\x95\xc0Q>(\x95\xc1\xbd\xa8(\x95¼z(\x95ûM(\x95Ļ\x15(\x95Ż\xe2" +
+	"(\x95ƺ\xdc(\x95Ǻ\xa2(\x95ȺM(\x95\xcbL\x01(\x95\xccN\xb7(\x95ր\x00(\x96" +
+	"\x00eH(\x96\x01|\xf2(\x96\x02|\xb6(\x96\x03|z(\x96\x04|z(\x96\x05|\xa4(\x96\x06{" +
+	"/(\x96\aw\xe4(\x96\bt\x9a(\x96\v-\x81(\x96\fbB(\x96\rb\xba(\x96\x0ew\x82(" +
+	"\x96\x0fwN(\x96\x10w\x1a(\x96\x11w\x1a(\x96\x12w>(\x96\x13u\xe8(\x96\x14r\xce(\x96\x15" +
+	"o\xb4(\x96\x16L^(\x96\x17L\b(\x96@e\xef(\x96A}\x1e(\x96B|\xe2(\x96C|\xa7" +
+	"(\x96D|\xa6(\x96E|\xd1(\x96Fz\xd5(\x96Gz+(\x96Hv\xe1(\x96Kbi(\x96" +
+	"L1$(\x96Mc-(\x96Nw\x84(\x96OwP(\x96Pw\x1b(\x96Qw\x1b(\x96Rw" +
+	"?(\x96Sul(\x96Tt\xca(\x96Uq\xb0(\x96VN\xbc(\x96WNf(\x96X_\xea(" +
+	"\x96\x8d7\x98(\x96\x8e}\x84(\x96\x8f}C(\x96\x90}\x05(\x96\x91}\x03(\x96\x92~\x8c(\x96\x93" +
+	"|8(\x96\x94z\xcb(\x96\x95z\x13(\x96\x96Up(\x96\x97U\x12(\x96\x98h\xc6(\x96\x99ik" +
+	"(\x96Ί/(\x96ϰZ(\x96Яc(\x96ѯF(\x96ҳ-(\x96ӵ\xcb(\x96" +
+	"Զ\x89(\x96ոX(\x96\xd6\xc5j(\x96\u05fe\x97(\x96\xd8~\x86(\x96\xd9~\xb2(\x97\x0f\x88" +
+	"\xa9(\x97\x10\xab\xff(\x97\x11\xab\xe2(\x97\x12\xaf\xc9(\x97\x13\xb2g(\x97\x14\xb6d(\x97\x15\xb7_(" +
+	"\x97\x16\xc4,(\x97\x17\xbdj(\x97\x18~E(\x97\x19~q(\x97P\x87H(\x97Q\xa8\x84(\x97R" +
+	"\xack(\x97S\xaf\t(\x97T\xb3\x06(\x97U\xb7@(\x97V\xc2\xf5(\x97W\xbcA(\x97X~\a" +
+	"(\x97Y~3(\x97\x91\x86K(\x97\x92\xacR(\x97\x93\xac\x95(\x97\x94\xaf\xdd(\x97\x95\xb3\xf6(\x97" +
+	"\x96°(\x97\x97\xbc\t(\x97\x98~\x05(\x97\x99~1(\x97҅\x9e(\x97ӫ\x1e(\x97ԭ" +
+	"\xc1(\x97ձ\xbe(\x97\xd6\xc3n(\x97\u05fc\xd3(\x97\xd8~,(\x97\xd9~X(\x98\x13\x82\x1a(" +
+	"\x98\x14\xabE(\x98\x15\xafC(\x98\x16\xbfa(\x98\x17\xbb\xac(\x98\x18|\x92(\x98\x19|>(\x98T" +
+	"\x7f|(\x98U\xadY(\x98V\xbe=(\x98W\xbbL(\x98Xy;(\x98Y{k(\x98\x95}\x18" +
+	"(\x98\x96\xbd\xeb(\x98\x97\xba\xd3(\x98\x98u\xe5(\x98\x99x\x15(\x98\xd6c\r(\x98׆a(\x98" +
+	"\xd8O\xff(\x98\xd9R\xcc(\x99\x17-\x9c(\x99\x18O\xa7(\x99\x19Rs(\x99X0X(\x99Ye" +
+	"\xbf(\x99\x993\xfd,\x03\x01\xab\x1e,\x03\x02\xaa!,\x03\x03\xa9(,\x03\x04\xa84,\x03\x05\xa7d," +
+	"\x03\x06\xa8j,\x03\a\xa8\xe9,\x03\b\xa6\x94,\x03\t\xa3@,\x03\n\xa4\x1b,\x03A\xbe\x99,\x03B" +
+	"\xbde,\x03C\xbc3,\x03D\xbb\x03,\x03E\xbe&,\x03F\xbeu,\x03G\xbe\",\x03H\xbe\xde" +
+	",\x03I\xbe\x90,\x03J\xbfU,\x03LJ~,\x03\x81\x80\x11,\x03\x82\xac\xab,\x03\x83\xab\xb1,\x03" +
+	"\x84\xaa\xbc,\x03\x85\xad\x81,\x03\x86\xb1y,\x03\x87\xb0F,\x03\x88\xb1\x12,\x03\x89\xb2\xa8,\x03\x8a\xb3" +
+	"\x80,\x03\x8c\xab\xc2,\x03\xc1\xac\xa6,\x03\xc2~Q,\x03è?,\x03ħJ,\x03Ū\x0f," +
+	"\x03Ʈ\x06,\x03ǰ!,\x03Ȱ\x15,\x03ɱ\xac,\x03ʲ\x84,\x03̪\xc5,\x04\x01" +
+	"\xab\xac,\x04\x02\xa8<,\x04\x03|\xbc,\x04\x04\xa3\xdd,\x04\x05\xa6\xa2,\x04\x06\xaa\x9a,\x04\a\xac\xb5" +
+	",\x04\b\xaf\xf7,\x04\t\xb0\xb6,\x04\n\xb1\x8e,\x04\f\xa9\xcf,\x04A\xaa\xb7,\x04B\xa7G,\x04" +
+	"C\xa3\xdc,\x04D{R,\x04E\xa3;,\x04F\xa73,\x04G\xa9N,\x04H\xac\x90,\x04I\xb0" +
+	"\x9c,\x04J\xb0\x9d,\x04L\xa8\xde,\x04\x81\xad~,\x04\x82\xaa\x0e,\x04\x83\xa6\xa2,\x04\x84\xa3;," +
+	"\x04\x85zn,\x04\x86\xa3\xfc,\x04\x87\xa6\x16,\x04\x88\xa9X,\x04\x89\xade,\x04\x8a\xb0\xb3,\x04\x8c" +
+	"\xa8\x1d,\x04\xc1\xb1\x80,\x04®\x10,\x04ê\xa4,\x04ħ=,\x04ţ\xf9,\x04\xc6z\xae" +
+	",\x04Ǥ\xb4,\x04ȧ\xf6,\x04ɫ(,\x04ʮv,\x04̩\x1d,\x05\x01\xb0E,\x05" +
+	"\x02\xb0\",\x05\x03\xac\xb6,\x05\x04\xa9O,\x05\x05\xa6\v,\x05\x06\xa4\xa8,\x05\ax\xe4,\x05\b\xa5" +
+	"\x15,\x05\t\xa8H,\x05\n\xab\x96,\x05\f\xa9\x90,\x05A\xb1\x11,\x05B\xb0\x17,\x05C\xaf\xf8," +
+	"\x05D\xac\x91,\x05E\xa9M,\x05F\xa7\xea,\x05G\xa5\x14,\x05Hx\xa2,\x05I\xa6\x01,\x05J" +
+	"\xa9O,\x05L\xa7J,\x05\x81\xb2s,\x05\x82\xb1y,\x05\x83\xb0\x83,\x05\x84\xb0i,\x05\x85\xad%" +
+	",\x05\x86\xaa\xe8,\x05\x87\xa8\x11,\x05\x88\xa5\xc7,\x05\x89t\xfe,\x05\x8a\xa5\xf4,\x05\x8c\xa3\xf5,\x05" +
+	"\xc1\xb3J,\x05²P,\x05ñ[,\x05İi,\x05Űr,\x05Ʈ5,\x05ǫ" +
+	"_,\x05ȩ\x14,\x05ɥ\xf2,\x05\xcau\x0e,\x05̤\xcc,\x06\x01\xc7\xdd,\x06\x02Ɠ," +
+	"\x06\x03\xc5N,\x06\x04\xc4\r,\x06\x05\xc2\xdb,\x06\x06å,\x06\a\xc0t,\x06\b\xc1',\x06\t" +
+	"\xbc\a,\x06\n\xbc\xcc,\x06\f\xc0\xe1,\x06\r\x80\x00,\x06\x0e\xbdL,\x06\x0f\xbc ,\x06\x10\xba\xf8" +
+	",\x06\x11\xb9\xd3,\x06\x12\xb8\xbc,\x06\x13\xb9o,\x06\x14\xb6\x83,\x06\x15\xb7!,\x06\x16\xb2q,\x06" +
+	"\x17\xb3 ,\x06A\xab\xd5,\x06B\xaa\xdc,\x06C\xa9\xe6,\x06D\xa8\xf5,\x06E\xa8',\x06F\xa9" +
+	"',\x06G\xa9\xa4,\x06H\xa7Y,\x06I\xa4>,\x06J\xa5\x16,\x06LP\xd2,\x06MI\x88," +
+	"\x06N\xa2[,\x06O\xa1{,\x06P\xa0\x9f,\x06Q\x9f\xc6,\x06R\x9f\x0e,\x06S\x9f\xf4,\x06T" +
+	"\xa0c,\x06U\x9eR,\x06V\x9b`,\x06W\x9c!,\x06X\xb6\xd4,\x06\x8d \x19,\x06\x8e\xbf\xa6" +
+	",\x06\x8f\xbet,\x06\x90\xbdF,\x06\x91\xbc\x1c,\x06\x92\xbf:,\x06\x93\xbf\x88,\x06\x94\xbf-,\x06" +
+	"\x95\xbf\xe7,\x06\x96\xbfh,\x06\x97\xc0+,\x06\x98\x86a,\x06\x99M{,\x06\u0381\a,\x06ϭ" +
+	"\xb8,\x06Ь\xc1,\x06ѫ\xd0,\x06Ү\x8e,\x06Ӳt,\x06Ա=,\x06ղ\x06," +
+	"\x06ֳe,\x06״9,\x06\xd8\xc8\xc8,\x06٬\xf1,\a\x0f\x7fB,\a\x10\xa9],\a\x11" +
+	"\xa8l,\a\x12\xab*,\a\x13\xaf\x10,\a\x14\xb1\x19,\a\x15\xb1\r,\a\x16\xb2l,\a\x17\xb3@" +
+	",\a\x18ǃ,\a\x19\xab\xf8,\aP}\xab,\aQ\xa5\r,\aR\xa7\xcb,\aS\xab\xb2,\a" +
+	"T\xad\xbb,\aU\xb0\xee,\aV\xb1y,\aW\xb2M,\aX\xc6C,\aY\xab\x05,\a\x91|" +
+	"A,\a\x92\xa4s,\a\x93\xa8Y,\a\x94\xaab,\a\x95\xad\x96,\a\x96\xb1`,\a\x97\xb1`," +
+	"\a\x98\xc5\t,\a\x99\xaa\x17,\a\xd2{g,\aӥ0,\aԧ9,\aժm,\a\xd6" +
+	"\xae7,\aױv,\a\xd8\xc3\xea,\a٩Y,\b\x13{\xa0,\b\x14\xa5\xd9,\b\x15\xa9\f" +
+	",\b\x16\xac\x00,\b\x17\xaf?,\b\x18İ,\b\x19\xaaS,\bTy\xd1,\bU\xa6A,\b" +
+	"V\xa94,\bW\xacs,\bX\xc1\x8a,\bY\xaa\xc4,\b\x95y\x8d,\b\x96\xa6\xf8,\b\x97\xaa" +
+	"7,\b\x98\xc2;,\b\x99\xa8\x88,\b\xd6u\xd0,\bק\x00,\bؼ\xfd,\b٥V," +
+	"\t\x17u\xde,\t\x18\xbd\xc0,\t\x19\xa6*,\tXW\xbe,\tY\xc1\xca,\t\x99R:,\x13\x00" +
+	"L\xeb,\x13\x02Xb,\x13\x03X',\x13\x04W\xeb,\x13\x05X\x84,\x13\x06X\x87,\x13\aYc" +
+	",\x13\bV^,\x13\tRt,\x13\nR\xaf,\x13@%\xd2,\x13Bbs,\x13Cb7,\x13" +
+	"Da\xfb,\x13Ee\x11,\x13Fda,\x13Gdx,\x13Hd\x97,\x13I`\xca,\x13Ja" +
+	"\x05,\x13LX\n,\x13\x80E\xe3,\x13\x82\xba\xe7,\x13\x83\xb9\xcf,\x13\x84\xb8\xbc,\x13\x85\xbb\xfb," +
+	"\x13\x86\xbf\xc0,\x13\x87\xbf&,\x13\x88\xbf\xcc,\x13\x89\xc0\x99,\x13\x8a\xc1\x82,\x13\x8cG',\x13\xc0" +
+	"a\x05,\x13\xc2W\xd5,\x13Ö\xe4,\x13ĕ\xea,\x13Ř6,\x13ƚ\x14,\x13ǜ\xfe" +
+	",\x13Ȝ\x9a,\x13ɝ4,\x13ʞ\x04,\x13\xccc\n,\x14\x00`\xc6,\x14\x02\x96\xe1,\x14" +
+	"\x03V7,\x14\x04\x92\xa4,\x14\x05\x94\xef,\x14\x06\x96\xce,\x14\a\x99\xb8,\x14\b\x9cw,\x14\t\x9c" +
+	":,\x14\n\x9d\n,\x14\fb\xcb,\x14@`\x88,\x14B\x95\xe8,\x14C\x92\xa2,\x14DT\xc3," +
+	"\x14E\x91\xae,\x14F\x93\x8d,\x14G\x96v,\x14H\x996,\x14I\x9c\x1d,\x14J\x9c\x15,\x14L" +
+	"b\x8d,\x14\x80c\xa9,\x14\x82\x98A,\x14\x83\x94\xfa,\x14\x84\x91\xb8,\x14\x85S\xf2,\x14\x86\x90Q" +
+	",\x14\x87\x93W,\x14\x88\x96\x16,\x14\x89\x98\xfd,\x14\x8a\x9c\x19,\x14\x8cc,,\x14\xc0c\x04,\x14" +
+	"\u009a!,\x14Ö\xdb,\x14ē\x99,\x14ŐQ,\x14\xc6R\xae,\x14Ǒ\x02,\x14ȓ" +
+	"\n,\x14ɕ\xce,\x14ʘ\xea,\x14\xccc8,\x15\x00c\x11,\x15\x02\x9d\v,\x15\x03\x99\xc5," +
+	"\x15\x04\x96\x83,\x15\x05\x93U,\x15\x06\x90\xf6,\x15\aQ\x91,\x15\b\x90^,\x15\t\x93\",\x15\n" +
+	"\x96>,\x15\fd\x04,\x15@c#,\x15B\x9c\x9d,\x15C\x9cz,\x15D\x998,\x15E\x96\n" +
+	",\x15F\x92\xf3,\x15G\x90Q,\x15HO\xad,\x15I\x90B,\x15J\x93^,\x15La\f,\x15" +
+	"\x80_L,\x15\x82\x9d*,\x15\x83\x9c0,\x15\x84\x9c\x11,\x15\x85\x98\xe3,\x15\x86\x95\xaa,\x15\x87\x93" +
+	"\b,\x15\x88\x903,\x15\x89M\xc7,\x15\x8a\x8f\x90,\x15\x8c]6,\x15\xc0_\x86,\x15\u009d\xfa," +
+	"\x15Ý\x00,\x15Ĝ\n,\x15ś\xff,\x15Ƙ\xc6,\x15ǖ$,\x15ȓO,\x15\xc9" +
+	"\x8f\x8e,\x15\xcaM\x91,\x15\xcc]p,\x16\x00A\xfa,\x16\x02}\xa9,\x16\x03|\xcd,\x16\x04v\x06" +
+	",\x16\x05m\x83,\x16\x06dR,\x16\a[F,\x16\bS\xc2,\x16\tM\xbc,\x16\nJ\xc5,\x16" +
+	"\fC\x83,\x16\x0e\x80\x00,\x16\x0fx\xc1,\x16\x10w\xf7,\x16\x11q\xc5,\x16\x12i\xf8,\x16\x13a" +
+	"\x85,\x16\x14Y/,\x16\x15R:,\x16\x16L\xa4,\x16\x17I\xdc,\x16@X\v,\x16Bdw," +
+	"\x16Cd;,\x16Dc\xff,\x16Ed\x92,\x16Fd\x95,\x16Gel,\x16Hb},\x16I" +
+	"^\xb0,\x16J^\xec,\x16L%\xd7,\x16MVw,\x16NG\x84,\x16O`\xc2,\x16P`\x8e" +
+	",\x16Q`Y,\x16R`\xe1,\x16S`\xe3,\x16Ta\xa4,\x16U^\xf7,\x16V[\x82,\x16" +
+	"W[\xb6,\x16XD+,\x16\x8d),,\x16\x8eJG,\x16\x8fd\xd1,\x16\x90d\x93,\x16\x91d" +
+	"W,\x16\x92gh,\x16\x93f\xc6,\x16\x94f\xd1,\x16\x95f\xe1,\x16\x96c ,\x16\x97cY," +
+	"\x16\x98Fn,\x16\x99\\\b,\x16\xce^\x84,\x16ϼ\x14,\x16л\x00,\x16ѹ\xf2,\x16\xd2" +
+	"\xbd,,\x16\xd3\xc0\xe8,\x16\xd4\xc0G,\x16\xd5\xc0\xe0,\x16\xd6\xc1\x9e,\x16\xd7\u0083,\x16؆o" +
+	",\x16\xd9K\x81,\x17\x0fY`,\x17\x10\x98\x9d,\x17\x11\x97\xa8,\x17\x12\x99\xf4,\x17\x13\x9b\xd4,\x17" +
+	"\x14\x9e\xa8,\x17\x15\x9e;,\x17\x16\x9e\xc7,\x17\x17\x9f\x93,\x17\x18\x80\x17,\x17\x19f\xcb,\x17PW" +
+	"\xc0,\x17Q\x94n,\x17R\x96\xba,\x17S\x98\x9a,\x17T\x9bn,\x17U\x9e\x19,\x17V\x9d\xd0," +
+	"\x17W\x9e\x9d,\x17X\x7f=,\x17Yf\x8d,\x17\x91VK,\x17\x92\x93\x86,\x17\x93\x95f,\x17\x94" +
+	"\x98:,\x17\x95\x9a\xe4,\x17\x96\x9d\xb3,\x17\x97\x9d\xab,\x17\x98x\x93,\x17\x99fQ,\x17\xd2U\x84" +
+	",\x17Ӓ9,\x17ԕ&,\x17\u0557\xd1,\x17֚\x9f,\x17ם\xaf,\x17\xd8p@,\x17" +
+	"\xd9f\xed,\x18\x13TJ,\x18\x14\x92\xda,\x18\x15\x94\xd0,\x18\x16\x97|,\x18\x17\x9a\x8c,\x18\x18g" +
+	"@,\x18\x19f\xfb,\x18TS#,\x18U\x926,\x18V\x94\xe3,\x18W\x97\xf2,\x18X^P," +
+	"\x18Yg\xc1,\x18\x95Q:,\x18\x96\x92\x18,\x18\x97\x95',\x18\x98V\xe0,\x18\x99d\xda,\x18\xd6" +
+	"ON,\x18בs,\x18\xd8P\xe7,\x18\xd9a\x18,\x19\x17O\x16,\x19\x18M\xf8,\x19\x19aR" +
+	",\x19X\x1cI,\x19YG\xf8,\x19\x99)1,#\x00M\xe8,#\x01b\xd1,#\x03X},#" +
+	"\x04XA,#\x05X\xfd,#\x06Y\xbc,#\aY\x85,#\bW[,#\tSq,#\nS" +
+	"\xac,#@&\xe0,#Al1,#Cb\x89,#DbM,#Ee\x80,#Fe\x90," +
+	"#Gd\x9a,#He\x8d,#Ia\xc0,#Ja\xfc,#LY\x02,#\x80j\x8b,#\x81" +
+	"}\r,#\x83\xa2\xe5,#\x84\xa1\xeb,#\x85\xa4\xdb,#\x86\xa7\xbb,#\x87\xa5\xe9,#\x88\xa6\x94" +
+	",#\x89\xa7.,#\x8a\xa7\xfe,#\x8cm\x06,#\xc0FH,#\xc1\xbd0,#öt,#" +
+	"ĵa,#Ÿ\xa0,#Ƽd,#ǿ ,#ȿ],#\xc9\xc0*,#\xca\xc1" +
+	"\x13,#\xccG\x8b,$\x00a\r,$\x01\xa3\f,$\x03V\xb3,$\x04\x94\x15,$\x05\x96z," +
+	"$\x06\x98\xe3,$\a\x99\xb8,$\b\x9d_,$\t\x9d\",$\n\x9d\xf2,$\fc\x16,$@" +
+	"`\xd0,$A\xa2\x13,$C\x94\x13,$DU?,$E\x939,$F\x95\xa2,$G\x96w" +
+	",$H\x9a\x1e,$I\x9d\x05,$J\x9c\xfd,$Lb\xd9,$\x80d\x0f,$\x81\xa5\x16,$" +
+	"\x83\x96\x87,$\x84\x93E,$\x85T\x91,$\x86\x92\x82,$\x87\x93W,$\x88\x96\xfe,$\x89\x99" +
+	"\xe5,$\x8a\x9d\x01,$\x8cc\x9a,$\xc0d*,$\xc1\xa8\x03,$Ø\xfb,$ĕ\xb9," +
+	"$Œ\x8a,$\xc6T\v,$Ǒ\x02,$Ȕ\r,$ɖ\xd1,$ʙ\xed,$\xcc" +
+	"d^,%\x00c3,%\x01\xa64,%\x03\x99\xc7,%\x04\x96\x85,%\x05\x93W,%\x06\x90\xf8" +
+	",%\aQ\x91,%\b\x90^,%\t\x93\",%\n\x96?,%\fd',%@d\x1b,%" +
+	"A\xa6\xd5,%C\x9dn,%D\x9a,,%E\x96\xfe,%F\x94\x00,%G\x90Q,%HP" +
+	"\x9e,%I\x90{,%J\x93\x97,%Lb\x05,%\x80`D,%\x81\xa7b,%\x83\x9d$," +
+	"%\x84\x9d\x05,%\x85\x99\xd7,%\x86\x96\xb7,%\x87\x93\b,%\x88\x90l,%\x89N\xb8,%\x8a" +
+	"\x8f\xc8,%\x8c^.,%\xc0`~,%\xc1\xa81,%Ý\xf4,%Ĝ\xfe,%Ŝ\xf3" +
+	",%ƙ\xd3,%ǖ$,%ȓ\x88,%ɏ\xc7,%\xcaN\x82,%\xcc^h,&" +
+	"\x00CD,&\x01\x8dd,&\x03}\x8d,&\x04v\\,&\x05m\xf1,&\x06e\x94,&\a[" +
+	"},&\bT\xec,&\tN\xe9,&\nK\xf4,&\fD\xca,&\x0f\x80\x00,&\x10x\xa4," +
+	"&\x11r\x13,&\x12j\\,&\x13b\xa9,&\x14Yc,&\x15SK,&\x16M\xb7,&\x17" +
+	"J\xf2,&@Y\x03,&An\xaf,&Cd\x91,&DdU,&Ee\n,&Fe\xc4" +
+	",&Ge\x8d,&Hcs,&I_\xa7,&J_\xe2,&L&\xe5,&MWW,&" +
+	"Nj\x1b,&OG\xe3,&P`\xda,&Q`\xa6,&RaL,&Sa\xf4,&Ta" +
+	"\xc2,&U_\xd7,&V\\b,&W\\\x96,&XEU,&\x8d*3,&\x8en!," +
+	"&\x8fJ\xa8,&\x90d\xd6,&\x91d\x9a,&\x92g\xc9,&\x93g\xe3,&\x94f\xf2,&\x95" +
+	"g\xd3,&\x96d\x11,&\x97dK,&\x98G\xaf,&\x99\\\xfa,&\xce~\a,&ϾM" +
+	",&ФU,&ѣ`,&ҦM,&ө),&ԧb,&է\xff,&" +
+	"֨\x8a,&שW,&؏!,&\xd9p\x8f,'\x0f]3,'\x10\xb7\xab,'\x11\xb6" +
+	"\x9d,'\x12\xb9\xd6,'\x13\xbd\x93,'\x14\xc0A,'\x15\xc0r,'\x16\xc10,'\x17\xc2\x15," +
+	"'\x18\x86i,'\x19K\xe1,'PX.,'Q\x95\xcf,'R\x985,'S\x9a\x9d,'T" +
+	"\x9bo,'U\x9e\xfc,'V\x9e\xb3,'W\x9f\x7f,'X\x7f\xec,'Yf\xd5,'\x91V\xb9" +
+	",'\x92\x95\x00,'\x93\x97h,'\x94\x98:,'\x95\x9b\xc7,'\x96\x9e\x96,'\x97\x9e\x8e,'" +
+	"\x98x\xd9,'\x99f\x98,'\xd2V\x14,'ӔT,'ԕ&,'\u0558\xb3,'֛" +
+	"\x82,'מ\x91,'\xd8p\x9f,'\xd9gW,(\x13U\x96,(\x14\x92\xdb,(\x15\x95\xcc," +
+	"(\x16\x98x,(\x17\x9b\x88,(\x18ho,(\x19h\x18,(TS$,(U\x926,(V" +
+	"\x94\xe3,(W\x97\xf3,(X^\x86,(Yg\xe2,(\x95R&,(\x96\x92N,(\x97\x95]" +
+	",(\x98X\x04,(\x99e\xcc,(\xd6P9,(ב\xa9,(\xd8R\r,(\xd9b\n,)" +
+	"\x17P\x02,)\x18O!,)\x19bC,)X\x1dO,)YI6,)\x99*7,3\x00N" +
+	"\xe1,3\x01c\xce,3\x02b\xd6,3\x04X\x93,3\x05YO,3\x06Z1,3\aZ\xb5," +
+	"3\bWx,3\tTj,3\nT\xa5,3@'\xee,3Am#,3Bl2,3D" +
+	"b\x9e,3Ee\xd2,3Ff\x04,3Ge\xc4,3He\xaa,3Ib\xb2,3Jb\xee" +
+	",3LY\xf6,3\x80k\x7f,3\x81}\xe2,3\x82\xacL,3\x84\xa2\xcb,3\x85\xa5\xbb,3" +
+	"\x86\xa8\xb4,3\x87\xa6\xe2,3\x88\xa6\x95,3\x89\xa7g,3\x8a\xa87,3\x8cm\xfe,3\xc0j" +
+	"|,3\xc1\xac;,3\xc2{J,3ğ\x89,3Ţy,3ƥr,3ǧH," +
+	"3Ȧ3,3ɧ\x06,3ʧ\xd5,3\xccl\xfc,4\x00F\xae,4\x01\xbc\xc3,4\x02" +
+	"\xb8\x93,4\x04\xb2\b,4\x05\xb5G,4\x06\xb9\f,4\a\xbb\xc7,4\b\xbfY,4\t\xbf\xbd" +
+	",4\n\xc0\xa6,4\fG\xf1,4@a\x17,4A\xa2\xe6,4B\x9f\xaf,4DU\xbb,4" +
+	"E\x94\xaa,4F\x97-,4G\x98\x8c,4H\x9a\x1e,4I\x9d\xec,4J\x9d\xe5,4Lc" +
+	" ,4\x80dW,4\x81\xa5\xe9,4\x82\xa2\xb2,4\x84\x94\xb6,4\x85U\r,4\x86\x94\r," +
+	"4\x87\x95l,4\x88\x96\xfe,4\x89\x9a\xcd,4\x8a\x9d\xe9,4\x8cc\xe1,4\xc0d\x93,4\xc1" +
+	"\xa8\xf1,4¥\xbb,4ėE,4Ŕ\x17,4\xc6T\xaa,4Ǔ2,4Ȕ\r" +
+	",4ɗ\xb9,4ʚ\xd5,4\xccd\xc8,5\x00dU,5\x01\xa7\",5\x02\xa7\x95,5" +
+	"\x04\x98\xa5,5\x05\x95w,5\x06\x931,5\aR\xef,5\b\x90_,5\t\x94%,5\n\x97" +
+	"B,5\feI,5@d9,5A\xa6\xd5,5B\xa6\x81,5D\x9a.,5E\x96\xff," +
+	"5F\x94\x02,5G\x90S,5HP\x9e,5I\x90{,5J\x93\x97,5Lb#,5\x80" +
+	"a8,5\x81\xa7\x9a,5\x82\xa7G,5\x84\x9d\xf9,5\x85\x9a\xcb,5\x86\x97\xab,5\x87\x94\x15" +
+	",5\x88\x90l,5\x89O\xa9,5\x8a\x90\x01,5\x8c_\",5\xc0ar,5\xc1\xa8j,5" +
+	"¨\x16,5ĝ\xf2,5ŝ\xe7,5ƚ\xc7,5Ǘ2,5ȓ\x88,5ɐ" +
+	"\x00,5\xcaOs,5\xcc_\\,6\x00D\x89,6\x01\x8e?,6\x02\x8d\x80,6\x04w\f," +
+	"6\x05nH,6\x06f\x02,6\a\\\xc0,6\bU$,6\tP\x13,6\nM\",6\f" +
+	"F\f,6\x10\x80\x00,6\x11r\xb2,6\x12j\xaa,6\x13c\x0e,6\x14Z\x88,6\x15S\x80" +
+	",6\x16N\xc9,6\x17L\x06,6@Y\xf7,6Ao\xa5,6Bn\xb3,6Dd\xa6,6" +
+	"Ee\\,6Ff8,6Gf\xb7,6Hc\x91,6I`\x99,6J`\xd4,6L'" +
+	"\xf2,6MX3,6Nj\xfb,6Oj ,6PHB,6Q`\xef,6Ra\x95," +
+	"6Sb\\,6Tb\xd0,6U_\xf2,6V]?,6W]r,6XFz,6\x8d" +
+	"+9,6\x8eo\x0e,6\x8fn\x10,6\x90K\n,6\x91d\xdd,6\x92h\f,6\x93hH" +
+	",6\x94h\v,6\x95g\xf0,6\x96d\xff,6\x97e8,6\x98H\xec,6\x99]\xe7,6" +
+	"\xce~\xd7,6ϭP,6н\xe1,6Ѥ/,6ҧ\x1c,6Ӫ\x11,6Ԩ" +
+	"J,6է\xff,6֨\xc0,6ש\x8d,6؏\xf7,6\xd9q\x80,7\x0f|4," +
+	"7\x10\xb9\xba,7\x11\xa0\xfe,7\x12\xa3\xeb,7\x13\xa6\xe0,7\x14\xa8\xad,7\x15\xa7\x9f,7\x16" +
+	"\xa8`,7\x17\xa9-,7\x18\x8f-,7\x19p\x82,7P\\\x10,7Q\xb3J,7R\xb6\x84" +
+	",7S\xba@,7T\xbc\xee,7U\xc0n,7V\xc0\xc4,7W\xc1\xa9,7X\x86e,7" +
+	"YLD,7\x91W&,7\x92\x96a,7\x93\x98\xe3,7\x94\x9a=,7\x95\x9b\xc7,7\x96\x9f" +
+	"y,7\x97\x9fq,7\x98yx,7\x99f\xdc,7\xd2V\x81,7ӕ\xcf,7ԗ)," +
+	"7\u0558\xb4,7֜e,7ןt,7\xd8p\xe6,7\xd9g\x9a,8\x13V%,8\x14" +
+	"\x94\xf6,8\x15\x95\xcc,8\x16\x99[,8\x17\x9ck,8\x18h\xce,8\x19h},8TTp" +
+	",8U\x927,8V\x95\xdf,8W\x98\xef,8X_\xb6,8Yh\xfb,8\x95R&,8" +
+	"\x96\x92N,8\x97\x95],8\x98X;,8\x99e\xe9,8\xd6Q$,8ב\xdf,8\xd8S" +
+	"2,8\xd9b\xf7,9\x17P\xed,9\x18PH,9\x19c1,9X\x1eV,9YJq," +
+	"9\x99+=,C\x00O\xd6,C\x01d\xc7,C\x02c\xcf,C\x03b\xd7,C\x05Y\x9c,C\x06" +
+	"Z~,C\a[&,C\bX\xa4,C\tT\x83,C\nU\x9a,C@(\xfb,CAn\x16" +
+	",CBm$,CCl2,CEf\x1f,CFfQ,CGf4,CHf\xd0,C" +
+	"Ib\xcb,CJc\xdc,CLZ\xe6,C\x80lr,C\x81~\xb7,C\x82\xac\x85,C\x83\xac" +
+	"\x1d,C\x85\xa6\x9a,C\x86\xa9\x93,C\x87\xa7\xc1,C\x88\xa7\x8e,C\x89\xa7g,C\x8a\xa8p," +
+	"C\x8cn\xf2,C\xc0kp,C\xc1\xact,C\xc2|\x1f,Cèa,CţX,C\xc6" +
+	"\xa6Q,CǨA,Cȧ,,Cɧ\x06,Cʨ\x0e,C\xccm\xf0,D\x00jp" +
+	",D\x01\xab\xff,D\x02\xa8R,D\x03y\xad,D\x05\xa0\x1c,D\x06\xa3\x15,D\a\xa5\x05,D" +
+	"\b\xa7\x98,D\t\xa6\xaa,D\n\xa7\xb3,D\fl\xef,D@G\x16,DA\xbcX,DB\xb8" +
+	"(,DC\xb3\xfc,DE\xb1\xef,DF\xb5\xb4,DG\xb8o,DH\xbc\x02,DI\xbf\xbb," +
+	"DJ\xc0;,DLHY,D\x80d\x9a,D\x81\xa6\xbb,D\x82\xa3\x84,D\x83\xa0R,D\x85" +
+	"U\x89,D\x86\x95~,D\x87\x96\xf7,D\x88\x99\x14,D\x89\x9a\xcd,D\x8a\x9e\xd1,D\x8cd%" +
+	",D\xc0d\xd7,D\xc1\xa9\xc4,D¦\x8d,Dã[,Dŕ\x88,D\xc6U&,D" +
+	"ǔ\xbd,DȖ\",Dɗ\xb9,Dʛ\xbd,D\xcce\v,E\x00d\xbb,E\x01\xa7" +
+	"\xf5,E\x02\xa8\x83,E\x03\xa5P,E\x05\x97\x03,E\x06\x94\xbe,E\aS\x8d,E\b\x92\x8f," +
+	"E\t\x94&,E\n\x98*,E\fe\xae,E@eW,EA\xa7\xc3,EB\xa7o,EC" +
+	"\xa7\xe6,EE\x99\x1f,EF\x96\",EG\x92\x8d,EHQ\xfc,EI\x90{,EJ\x94\x9b" +
+	",ELcA,E\x80aR,E\x81\xa7\x9b,E\x82\xa7G,E\x83\xa6\xf7,E\x85\x9a\xcd,E" +
+	"\x86\x97\xad,E\x87\x94\x17,E\x88\x90n,E\x89O\xa9,E\x8a\x90\x01,E\x8c_<,E\xc0b" +
+	"b,E\xc1\xa8\xa3,E¨O,Eè\x00,EŞ\xdb,Eƛ\xbb,Eǘ%," +
+	"EȔ\x96,Eɐ\x00,E\xcaPc,E\xcc`L,F\x00E\xca,F\x01\x95\x03,F\x02" +
+	"\x94\xaf,F\x03\x94\x01,F\x05n\xe6,F\x06fZ,F\a]/,F\bVg,F\tPL" +
+	",F\nNM,F\fGK,F\x11\x80\x00,F\x12k;,F\x13c],F\x14Z\xec,F" +
+	"\x15T\xa5,F\x16N\xfe,F\x17M\x18,F@Z\xe6,FAp\x97,FBo\xa6,FCn" +
+	"\xb4,FEe\xa9,FFf\x85,FGg',FHd\xb7,FI`\xb2,FJa\xc2," +
+	"FL)\x00,FMY\f,FNk\xd8,FOj\xfd,FPj\",FQH\xa1,FR" +
+	"a\xda,FSb\xa1,FTc4,FU`\xfc,FV]V,FW^K,FXG\x9c" +
+	",F\x8d,?,F\x8eo\xfc,F\x8fn\xfe,F\x90n\x02,F\x91Kn,F\x92hK,F" +
+	"\x93h\x87,F\x94hl,F\x95i\x05,F\x96e\x18,F\x97f!,F\x98J%,F\x99^" +
+	"\xd1,F\xce\x7f\xa8,Fϭ\x86,FЭ\x14,Fѽw,Fҧ\xeb,FӪ\xe0," +
+	"Fԩ\x18,Fը\xe7,F֨\xc1,Fש\xc3,Fؖ\x9d,F\xd9rn,G\x0f" +
+	"}\x05,G\x10\xa9p,G\x11\xb9O,G\x12\xa4\xba,G\x13\xa7\xae,G\x14\xa9\x95,G\x15\xa8\x87" +
+	",G\x16\xa8a,G\x17\xa9c,G\x18\x96;,G\x19qo,GPz\x8a,GQ\xb5.,G" +
+	"R\xa1\x8e,GS\xa4\x83,GT\xa6j,GU\xa8\xf0,GV\xa8\x06,GW\xa9\t,GX\x95" +
+	"\x84,GYps,G\x91[\x1a,G\x92\xb32,G\x93\xb6\xef,G\x94\xb9\x9d,G\x95\xbd\x1d," +
+	"G\x96\xc0\xc1,G\x97\xc1?,G\x98\x86c,G\x99L\xa8,G\xd2V\xef,Gӗ0,G\xd4" +
+	"\x98\xa3,G՚\xb6,G֜e,GנW,G\xd8qu,G\xd9g\xda,H\x13V\x93" +
+	",H\x14\x96p,H\x15\x97\xce,H\x16\x99[,H\x17\x9dM,H\x18i\x16,H\x19h\xbd,H" +
+	"TT\xff,HU\x94R,HV\x95\xdf,HW\x99\xd1,HX`\x16,HYi\\,H\x95S" +
+	"r,H\x96\x92N,H\x97\x96Y,H\x98Yk,H\x99f\xfd,H\xd6Q%,Hב\xdf," +
+	"H\xd8Si,H\xd9c\x10,I\x17Q\xd9,I\x18Qm,I\x19d\x1a,IX\x1f\\,IY" +
+	"K\xa7,I\x99,D,S\x00P\xa6,S\x01e\xab,S\x02d\xb2,S\x03c\xba,S\x04b\xc2" +
+	",S\x06Y\xdb,S\aZ\x82,S\bX$,S\tT\xbf,S\nT\xc2,S@)\xa1,S" +
+	"Aq\x7f,SBpr,SCo\x80,SDn\x8e,SFf\x87,SGfj,SHg" +
+	"),SIc\xda,SJc\xdd,SL[\xbd,S\x80o\xdb,S\x81\x7f\xcb,S\x82\xacI," +
+	"S\x83\xab\xbe,S\x84\xabZ,S\x86\xa9\x8b,S\x87\xa7\xdb,S\x88\xa7\xa8,S\x89\xa7\x9c,S\x8a" +
+	"\xa7\xab,S\x8co\xda,S\xc0n\xbd,S\xc1\xac7,S\xc2}3,Sè\x02,Sħ\x9e" +
+	",SƦI,SǨ9,Sȧ$,Sɧ\x18,Sʧ',S\xccn\xd8,T" +
+	"\x00m\xbd,T\x01\xab\xa1,T\x02\xa7\xf3,T\x03z\xc1,T\x04\xa3\xe9,T\x06\xa3\r,T\a\xa4" +
+	"\xfd,T\b\xa7\xaa,T\t\xa6\xbc,T\n\xa6\xcc,T\fm\xd8,T@l\xbf,TA\xab1," +
+	"TB\xa7\x84,TC\xa3\xdb,TDxt,TF\x9f\xd7,TG\xa1\xc6,TH\xa4s,TI" +
+	"\xa7-,TJ\xa6u,TLl\xd9,T\x80G\xe1,T\x81\xc0;,T\x82\xbc\v,T\x83\xb7\xdf" +
+	",T\x84\xb3\xb7,T\x86\xb2\xd8,T\x87\xb5\x93,T\x88\xb9&,T\x89\xbc\xdf,T\x8a\xc0\xb4,T" +
+	"\x8cHG,T\xc0d\xf7,T\xc1\xa9\xa4,T¦m,Tã:,TĠ\f,T\xc6T" +
+	"\xa0,Tǖ\a,Tȗ\x86,Tə\xa7,Tʛ\x96,T\xccdb,U\x00d\xdb," +
+	"U\x01\xa7\xf7,U\x02\xa8b,U\x03\xa50,U\x04\xa2\x01,U\x06\x96\a,U\aS\b,U\b" +
+	"\x93\xf2,U\t\x96\x14,U\n\x98\x03,U\fe\x05,U@e\x99,UA\xa7\xc5,UB\xa7O" +
+	",UC\xa7\xe1,UD\xa4\xb2,UF\x97\x87,UG\x93\xf2,UHQ\x99,UI\x92\x85,U" +
+	"J\x94s,ULb\xba,U\x80bM,U\x81\xa7\xb8,U\x82\xa7B,U\x83\xa6\xf2,U\x84\xa7" +
+	"m,U\x86\x99\xa5,U\x87\x96\x10,U\x88\x92\x80,U\x89P\x05,U\x8a\x8f\xda,U\x8c_n," +
+	"U\xc0bY,U\xc1\xa7\xd2,U§\\,Uç\r,UĦ\xc1,Uƛ\x95,U\xc7" +
+	"\x98\x00,UȔp,Uɏ\xda,U\xcaOb,U\xcc_{,V\x00F\xfc,V\x01\x9d\x83" +
+	",V\x02\x9d\x17,V\x03\x9c\xc2,V\x04\x9c%,V\x06e\xe6,V\a\\\x85,V\bU\xd6,V" +
+	"\tP\x8e,V\nM\x85,V\fG\x81,V\x12\x80\x00,V\x13b\xf5,V\x14ZS,V\x15T" +
+	"\",V\x16O;,V\x17Le,V@[\xb3,VAqt,VBp\x82,VCo\x90," +
+	"VDn\x9f,VFe\xe8,VGf\x8a,VHd<,VI`\xee,VJ`\xf1,VL" +
+	"(\xef,VMY\xc4,VNl\xa2,VOk\xc7,VPj\xec,VQj\x11,VRH\x92" +
+	",VSb\x11,VTb\xa4,VU`\x8b,VV]\x8b,VW]\x8d,VXG\xd1,V" +
+	"\x8d,\xea,V\x8es\\,V\x8frC,V\x90qG,V\x91pM,V\x92L9,V\x93h" +
+	"\xb1,V\x94h\x96,V\x95iQ,V\x96f\x17,V\x97f%,V\x98K],V\x99_\xa4," +
+	"V\u0380\xc2,VϭM,VЬ\xb9,VѬM,V\xd2\xc1Q,VӪ\xcc,V\xd4" +
+	"\xa9',Vը\xf5,V֨\xe8,Vש\x03,V؟\x06,V\xd9sS,W\x0f~\x1f" +
+	",W\x10\xa9\x15,W\x11\xa8\xa8,W\x12\xbd*,W\x13\xa7\x9b,W\x14\xa9\x81,W\x15\xa8s,W" +
+	"\x16\xa8f,W\x17\xa8\x81,W\x18\x9e\x8d,W\x19rU,WP{\xa5,WQ\xa5\t,WR\xb9" +
+	"\b,WS\xa4p,WT\xa6V,WU\xa8\xf6,WV\xa8\f,WW\xa8',WX\x9e-," +
+	"WYqY,W\x91yQ,W\x92\xb4\xec,W\x93\xa1J,W\x94\xa31,W\x95\xa5\xd0,W\x96" +
+	"\xa8{,W\x97\xa7\xd2,W\x98\x9d\x87,W\x99p^,W\xd2Y\xd7,WӴ\x17,WԶ\xc5" +
+	",WպF,Wֽ\xea,W\xd7\xc1\xb6,W؆b,W\xd9L\x95,X\x13V\x05,X" +
+	"\x14\x97\xad,X\x15\x99$,X\x16\x9b9,X\x17\x9d),X\x18h\x98,X\x19h\x17,XTT" +
+	"q,XU\x95\xa8,XV\x97\xbd,XW\x99\xad,XX_b,XYh\xb6,X\x95S\x06," +
+	"X\x96\x94E,X\x97\x965,X\x98X\xd0,X\x99fy,X\xd6Qu,Xב\xba,X\xd8" +
+	"S\x9f,X\xd9c@,Y\x17P\xdd,Y\x18P\xaa,Y\x19cN,YX\x1fK,YYK\xdd" +
+	",Y\x99,3,c\x00O\xa0,c\x01e\xbc,c\x02d\xa8,c\x03c\xb0,c\x04b\xb8,c" +
+	"\x05a\xea,c\aY\x05,c\bV\xa7,c\tSe,c\nTG,c@(\x8c,cAp" +
+	"\xbb,cBo\xac,cCn\xba,cDm\xc9,cEnl,cGd\xd9,cHe|," +
+	"cIbP,cJc,,cLZ\xbc,c\x80o!,c\x81\x80\x15,c\x82\xac\xb8,c\x83" +
+	"\xabu,c\x84\xaa\xee,c\x85\xae\xc3,c\x87\xa7\xfb,c\x88\xa7\xcf,c\x89\xa7\xc2,c\x8a\xa7\xec" +
+	",c\x8co\xf5,c\xc0n\x03,c\xc1\xac\xa7,c\xc2}_,cç\xa4,cħ\x1e,c" +
+	"Ū\xf3,cǧ~,cȦ\x93,cɦ\x87,cʦ\xb0,c\xccn\xd7,d\x00m" +
+	"\x03,d\x01\xabY,d\x02\xa7\x95,d\x03z\xed,d\x04\xa3h,d\x05\xa7=,d\a\xa4B," +
+	"d\b\xa6\xf6,d\t\xa6\t,d\n\xa62,d\fm\xd7,d@l\x04,dA\xaa\xc7,dB" +
+	"\xa7\x04,dC\xa3[,dDx\xa1,dE\xa3\x8d,dG\xa1\f,dH\xa3\xc0,dI\xa6\x94" +
+	",dJ\xa5\xdc,dLl\xd9,d\x80l\x99,d\x81\xae\x90,d\x82\xaa\xcd,d\x83\xa7$,d" +
+	"\x84\xa3\x7f,d\x85v\xa9,d\x87\x9e\xc3,d\x88\xa1w,d\x89\xa4K,d\x8a\xa7T,d\x8cl" +
+	"\x05,d\xc0GW,d\xc1Ñ,d¿_,dû3,dķ\v,dų\xeb," +
+	"dǳ\x0f,dȵ\xd0,dɹg,dʽ<,d\xccG\xdc,e\x00cG,e\x01" +
+	"\xa8\v,e\x02\xa7\x9a,e\x03\xa4h,e\x04\xa19,e\x05\x9e\xf8,e\aR\x12,e\b\x94\x7f" +
+	",e\t\x96\xc1,e\n\x99T,e\fc\x90,e@c\xea,eA\xa7\xe0,eB\xa6\xb1,e" +
+	"C\xa7 ,eD\xa3\xf1,eE\xa1\xb1,eG\x94~,eHP\xa4,eI\x932,eJ\x95" +
+	"\xc5,eLaE,e\x80`\xc0,e\x81\xa7\xd3,e\x82\xa6\xa3,e\x83\xa61,e\x84\xa6\xc7," +
+	"e\x85\xa4\x86,e\x87\x96\xbf,e\x88\x93/,e\x89O2,e\x8a\x91G,e\x8c^\x1b,e\xc0" +
+	"a\xa7,e\xc1\xa8\t,e¦\xd9,eæg,eĦ\x1b,eŧ\x9f,eǙ^" +
+	",eȕ\xce,eɑR,e\xcaOq,e\xcc_\x02,f\x00F2,f\x01\xa6\xb4,f" +
+	"\x02\xa5r,f\x03\xa5\x06,f\x04\xa4\xb0,f\x05\xa5',f\a[\xc5,f\bU\x18,f\tO" +
+	"\xd4,f\nL\xcd,f\fF\xd4,f\x13\x80\x00,f\x14Y\xa6,f\x15Sw,f\x16N\x93," +
+	"f\x17K\xbf,f@Z\xb3,fAq\x86,fBpx,fCo\x86,fDn\x94,fE" +
+	"m\xcd,fGe\x18,fHb\xcb,fI_\x9e,fJ`z,fL'\xe3,fMX\xdd" +
+	",fNl\xb2,fOk\xbf,fPj\xe4,fQj\t,fRiS,fSH3,f" +
+	"TaV,fU_<,fV\\[,fW]\",fXG7,f\x8d+\xdc,f\x8er" +
+	"\xa8,f\x8fq\x90,f\x90p\x94,f\x91o\x99,f\x92p+,f\x93K\xb3,f\x94g\v," +
+	"f\x95g\xab,f\x96d\x93,f\x97ew,f\x98J\x99,f\x99^\xab,f\u0381\x15,f\xcf" +
+	"\xad\xbe,fЬu,fѫ\xe7,fү\xa1,f\xd3ğ,fԩF,fթ\x1c" +
+	",f֩\x0f,fשC,fب\x1e,f\xd9sp,g\x0f~W,g\x10\xa8\xbd,g" +
+	"\x11\xa8.,g\x12\xab\xe8,g\x13\xc0x,g\x14\xa8\xca,g\x15\xa7\xe5,g\x16\xa7\xd8,g\x17\xa8" +
+	"\f,g\x18\xa6\xd4,g\x19rW,gP{\xdd,gQ\xa4\x90,gR\xa8J,gS\xbcV," +
+	"gT\xa5\x9f,gU\xa8F,gV\xa7\\,gW\xa7\x8f,gX\xa6\\,gYq[,g\x91" +
+	"y\x89,g\x92\xa4\xb0,g\x93\xb8:,g\x94\xa2y,g\x95\xa5 ,g\x96\xa7\xe4,g\x97\xa7;" +
+	",g\x98\xa5\xfd,g\x99pa,g\xd2w\x8a,gӵ!,gԠ=,gբ\xe4,g" +
+	"֥\xa8,gר\xad,gئg,g\xd9o\x90,h\x13Xk,h\x14\xb4J,h\x15\xb6" +
+	"\xfc,h\x16\xba\x7f,h\x17\xbeK,h\x18\x86a,h\x19L.,hTS\x7f,hU\x964," +
+	"hV\x98j,hW\x9a\xfb,hX^\xa6,hYgK,h\x95R\x14,h\x96\x94\xf2,h\x97" +
+	"\x97\x83,h\x98X\x15,h\x99e\x0e,h\xd6P\xa5,hד\",h\xd8R\xe7,h\xd9a\xf6" +
+	",i\x17P\xec,i\x18O\xf5,i\x19b\xda,iX\x1eF,iYK6,i\x99+.,s" +
+	"\x00R>,s\x01h\x0e,s\x02h\x0e,s\x03f\xfa,s\x04f\x02,s\x05e3,s\x06e" +
+	"n,s\bY\x00,s\tU\xbe,s\nV\xa0,s@+\xc6,sAr\xd4,sBr\xd4," +
+	"sCq\xc5,sDp\xd4,sEqw,sFqw,sHg\xcd,sId\xa1,sJ" +
+	"e},sL]-,s\x80qB,s\x81\x81\xdf,s\x82\xad\x15,s\x83\xab\xd1,s\x84\xabJ" +
+	",s\x85\xaf\x1f,s\x86\xb2X,s\x88\xa9\xe9,s\x89\xa9\xe1,s\x8a\xaa\n,s\x8cr\x1b,s" +
+	"\xc0q<,s\xc1\xad\x0f,s\u0080\x89,séI,sĨ\v,sū\xbd,sƮ" +
+	"\xf7,sȩ\xc5,sɩ\xbc,sʩ\xe5,s\xccr\x14,t\x00p ,t\x01\xab\xc0," +
+	"t\x02\xa9;,t\x03}\xfa,t\x04\xa4@,t\x05\xa7\xf3,t\x06\xab,,t\b\xa9M,t\t" +
+	"\xa8\x86,t\n\xa8\xb0,t\fp\xf9,t@o\",tA\xab.,tB\xa7\xf2,tC\xa43" +
+	",tD{\xad,tE\xa4B,tF\xa7|,tH\xa6\x17,tI\xa8\xef,tJ\xa87,t" +
+	"Lo\xfb,t\x80o\xb6,t\x81\xae\xf7,t\x82\xab\x98,t\x83\xa7\xd9,t\x84\xa44,t\x85y" +
+	"\xb5,t\x86\xa4\xb9,t\x88\xa3\xce,t\x89\xa6\xa6,t\x8a\xa9\xb0,t\x8co',t\xc0o\xb6," +
+	"t\xc1\xb21,t®\xd2,të\x13,tħn,tŤ\xb7,t\xc6y5,t\xc8" +
+	"\xa1\x9b,tɤW,tʧa,t\xccoa,u\x00JK,u\x01\xc3[,u\x02\xc3\n" +
+	",u\x03\xbe\xdc,u\x04\xba\xb4,u\x05\xb7\x94,u\x06\xb8D,u\b\xb5\xe8,u\t\xb9\x83,u" +
+	"\n\xbdX,u\fK\\,u@f ,uA\xa9\xda,uB\xa9\xb8,uC\xa9L,uD\xa6" +
+	"\x1d,uE\xa3\xdc,uF\xa1\xa5,uHQ\xbc,uI\x96\x94,uJ\x99',uLcs," +
+	"u\x80b\xf6,u\x81\xa9\xd0,u\x82\xa9\xaf,u\x83\xa8\x83,u\x84\xa8\xf7,u\x85\xa6\xb6,u\x86" +
+	"\xa4c,u\x88\x96\x91,u\x89PK,u\x8a\x94\xa9,u\x8c`I,u\xc0c\xdd,u\xc1\xaa\x06" +
+	",u©\xe4,uè\xb9,uĨK,uũ\xcf,uƧ|,uș0,u" +
+	"ɔ\xb4,u\xcaP\x89,u\xcca0,v\x00Ii,v\x01\xaf\xbe,v\x02\xaf\x87,v\x03\xae" +
+	"E,v\x04\xad\xd7,v\x05\xae\x84,v\x06\xafG,v\bU\x8e,v\tP\x17,v\nM\xd4," +
+	"v\fF+,v\x14\x80\x00,v\x15S\xe6,v\x16N\xd3,v\x17L\xb0,v@].,vA" +
+	"s\xaf,vBs\xae,vCr\xa0,vDq\xae,vEp\xe7,vFq\",vHe\x1c" +
+	",vIa\xf0,vJb\xcc,vL*\xf7,vM[\x16,vNn\xa7,vOn\xa7,v" +
+	"Pm\xb4,vQl\xd9,vRl\",vSlV,vTK^,vUaQ,vV^" +
+	"p,vW_7,vXF\x9a,v\x8d.\xfc,v\x8et\xb2,v\x8ft\xac,v\x90s\x95," +
+	"v\x91r\x9b,v\x92s-,v\x93s,,v\x94N\x8b,v\x95i\xca,v\x96f\xb2,v\x97" +
+	"g\x95,v\x98M\xb2,v\x99a\x0e,v\u0382\xd2,vϮ\x1c,vЬ\xd3,vѬE" +
+	",vү\xff,vӳ*,v\xd4\xc4a,vի\x05,v֪\xfb,v\u05eb/,v" +
+	"ذ\xfd,v\xd9u\x89,w\x0f\x81u,w\x10\xaaT,w\x11\xa9\x10,w\x12\xac\xa9,w\x13\xaf" +
+	"\xd4,w\x14\xc4\x0e,w\x15\xaa\xe0,w\x16\xaa\xd6,w\x17\xab\n,w\x18\xb0\xc3,w\x19u\x83," +
+	"wP~\xe0,wQ\xa5^,wR\xa8\xf6,wS\xac\",wT\xbf\xed,wU\xaaj,wV" +
+	"\xa9\xa5,wW\xa9\xd9,wX\xafz,wYtl,w\x91|\x8c,w\x92\xa5],w\x93\xa8\x88" +
+	",w\x94\xbb\xd1,w\x95\xa7D,w\x96\xaa\v,w\x97\xa9b,w\x98\xaf\x03,w\x99sr,w" +
+	"\xd2z\x8d,wӥ\xd9,wԸ\xb8,wե\b,w֧\xd0,wת\xd4,wد" +
+	"\xa3,w\xd9r\xa2,x\x13z\n,x\x14\xb9g,x\x15\xa2\xdf,x\x16\xa5\x8c,x\x17\xa8\x90," +
+	"x\x18\xb0c,x\x19r\xda,xTZ9,xU\xb6\xed,xV\xbat,xW\xbe@,xX" +
+	"\x86a,xYO\x9a,x\x95S\x11,x\x96\x98&,x\x97\x9a\xb7,x\x98XY,x\x99g+" +
+	",x\xd6Q\xa2,xזV,x\xd8R\xf8,x\xd9d\x13,y\x17Q\xe8,y\x18P\xc6,y" +
+	"\x19d\xf6,yX!\xa6,yYJ\x8a,y\x99.2,\x83\x00T\x93,\x83\x01j\xfc,\x83\x02j" +
+	"\xc1,\x83\x03j\xc0,\x83\x04i\xad,\x83\x05h\xde,\x83\x06i\x19,\x83\ag\x95,\x83\tX2," +
+	"\x83\nY\x14,\x83@.\xdb,\x83Au4,\x83Bt\xf8,\x83Ct\xf8,\x83Ds\xe9,\x83E" +
+	"t\x8c,\x83Ft\x8d,\x83Gr\xae,\x83If\xef,\x83Jg\xcb,\x83L_y,\x83\x80s\x9f" +
+	",\x83\x81\x83\xed,\x83\x82\xad=,\x83\x83\xad\x1c,\x83\x84\xab\xdc,\x83\x85\xaf\x8e,\x83\x86\xb2\xc7,\x83" +
+	"\x87\xb3',\x83\x89\xac\xd2,\x83\x8a\xad\x00,\x83\x8ct\xf9,\x83\xc0s^,\x83\xc1\xad8,\x83\u0082" +
+	"V,\x83é\xa9,\x83Ĩi,\x83Ŭ\x1c,\x83ƯU,\x83ǳ\x02,\x83ɫ\xd6," +
+	"\x83ʬ\x04,\x83\xcct\xb7,\x84\x00sY,\x84\x01\xad\x15,\x84\x02\xa9\xa5,\x84\x03\x81&,\x84\x04" +
+	"\xa5\xe8,\x84\x05\xa8\xe2,\x84\x06\xac\x1c,\x84\a\xaf\xa7,\x84\t\xab\xb8,\x84\n\xab\xe5,\x84\ft\xb3" +
+	",\x84@r@,\x84A\xab\xcc,\x84B\xa8\\,\x84C\xa5\xdc,\x84D~\xbc,\x84E\xa5\x1d,\x84" +
+	"F\xa8W,\x84G\xab\xe1,\x84I\xabF,\x84J\xaa\xb4,\x84Ls\x99,\x84\x80r\xd4,\x84\x81\xaf" +
+	"r,\x84\x82\xac\x02,\x84\x83\xa8\xca,\x84\x84\xa5\x0f,\x84\x85|\xc4,\x84\x86\xa5\x94,\x84\x87\xa9\x1f," +
+	"\x84\x89\xa8\xfd,\x84\x8a\xac\v,\x84\x8cr\xc6,\x84\xc0r\xd4,\x84\xc1\xb2\xac,\x84¯<,\x84\xc3" +
+	"\xac\x04,\x84ĨI,\x84ť\x92,\x84\xc6|D,\x84ǧ\x94,\x84ɦ\xae,\x84ʩ\xbc" +
+	",\x84\xccs\x00,\x85\x00p\xcb,\x85\x01\xb2\xe4,\x85\x02\xb2\xc2,\x85\x03\xafg,\x85\x04\xab\xac,\x85" +
+	"\x05\xa8\xf5,\x85\x06\xa7f,\x85\ay\x04,\x85\t\xa45,\x85\n\xa7C,\x85\fq~,\x85@M" +
+	"\xcb,\x85A\xc4\",\x85B\xc2\xde,\x85C\u0090,\x85D\xbeg,\x85E\xbbF,\x85F\xbb\xf7," +
+	"\x85G\xb8\x02,\x85I\xb9\x9a,\x85J\xbdt,\x85LK>,\x85\x80e\x1f,\x85\x81\xac\xa2,\x85\x82" +
+	"\xab\xa8,\x85\x83\xab\x8b,\x85\x84\xab#,\x85\x85\xa8\xe2,\x85\x86\xa6\x8f,\x85\x87\xa4B,\x85\x89Qc" +
+	",\x85\x8a\x98\v,\x85\x8cb\xa4,\x85\xc0f\a,\x85\xc1\xac\xdb,\x85«\xe2,\x85ë\xc4,\x85" +
+	"Ī\x9d,\x85ū\xfe,\x85Ʃ\xac,\x85ǧ^,\x85ɘ\x16,\x85\xcaQ\xa2,\x85\xccc" +
+	"\x8b,\x86\x00H\xbf,\x86\x01\xb7D,\x86\x02\xb6\x19,\x86\x03\xb5\xe1,\x86\x04\xb4\x9d,\x86\x05\xb52," +
+	"\x86\x06\xb5\xf3,\x86\a\xb5\x7f,\x86\tPs,\x86\nN\x03,\x86\fJ\x1e,\x86\x15\x80\x00,\x86\x16" +
+	"O),\x86\x17L\xde,\x86@_W,\x86Avj,\x86Bv.,\x86Cv.,\x86Du\x1f" +
+	",\x86EtX,\x86Ft\x94,\x86Gs;,\x86IdQ,\x86Je-,\x86L,\x03,\x86" +
+	"M]&,\x86NqA,\x86Oq\r,\x86Pq\f,\x86Qp\x19,\x86Roc,\x86So" +
+	"\x96,\x86TnX,\x86UKE,\x86V`\x9e,\x86Wae,\x86XJ9,\x86\x8d1\xff," +
+	"\x86\x8ew\x01,\x86\x8fv\xc1,\x86\x90v\xbd,\x86\x91u\xa8,\x86\x92v:,\x86\x93v9,\x86\x94" +
+	"t:,\x86\x95Q\xf7,\x86\x96h\xca,\x86\x97i\xae,\x86\x98M\x05,\x86\x99c',\x86΄\xd0" +
+	",\x86Ϯ;,\x86Ю\x18,\x86Ѭ\xd5,\x86Ұm,\x86ӳ\x99,\x86Գ\xd2,\x86" +
+	"\xd5\xc5\x1b,\x86֭\xb8,\x86\u05ed\xef,\x86ظ^,\x86\xd9x/,\x87\x0f\x835,\x87\x10\xaa" +
+	"\xb4,\x87\x11\xa9q,\x87\x12\xad\t,\x87\x13\xb05,\x87\x14\xb3\xad,\x87\x15\xc3\xda,\x87\x16\xac\xbf," +
+	"\x87\x17\xac\xf6,\x87\x18\xb74,\x87\x19w\xef,\x87P\x82\x00,\x87Q\xa6\xf8,\x87R\xa9\xdb,\x87S" +
+	"\xad\x06,\x87T\xb0],\x87UÍ,\x87V\xac\xa0,\x87W\xac\xd8,\x87X\xb6\xfc,\x87Yw\xeb" +
+	",\x87\x91\x7f\x92,\x87\x92\xa6.,\x87\x93\xa9Y,\x87\x94\xac\xb0,\x87\x95\xbfq,\x87\x96\xac/,\x87" +
+	"\x97\xab\xac,\x87\x98\xb5\xb4,\x87\x99v\xd6,\x87\xd2}\x92,\x87Ӧ\xaa,\x87Ԫ\x01,\x87ռ" +
+	"X,\x87֩\xf3,\x87\u05ec\xfc,\x87ض<,\x87\xd9v\x05,\x88\x13}\x0f,\x88\x14\xa8}," +
+	"\x88\x15\xbd\a,\x88\x16\xa7\xaf,\x88\x17\xaa\xb8,\x88\x18\xb6\xf9,\x88\x19v>,\x88Ty\xc0,\x88U" +
+	"\xb8\xfc,\x88V\xa5R,\x88W\xa8[,\x88X\xb6`,\x88Yt\xbf,\x88\x95\\>,\x88\x96\xbae" +
+	",\x88\x97\xbe5,\x88\x98\x86a,\x88\x99OS,\x88\xd6R\x9e,\x88י\x8a,\x88\xd8S!,\x88" +
+	"\xd9fJ,\x89\x17R\xe5,\x89\x18P\xc3,\x89\x19g.,\x89X%\x05,\x89YN>,\x89\x99/" +
+	"#,\x93\x00X\x03,\x93\x01n\xdf,\x93\x02n\xa4,\x93\x03nh,\x93\x04nh,\x93\x05m~," +
+	"\x93\x06m\xb9,\x93\al5,\x93\bh\xb5,\x93\n\\c,\x93@0y,\x93Ax\xd3,\x93B" +
+	"x\x97,\x93Cx[,\x93Dx[,\x93Ex\xe1,\x93Fx\xe2,\x93Gw\x03,\x93HvZ" +
+	",\x93Jk\x03,\x93Lb\xca,\x93\x80wl,\x93\x81\x86\xf1,\x93\x82\xae\x99,\x93\x83\xad\x9f,\x93" +
+	"\x84\xad\x82,\x93\x85\xb0X,\x93\x86\xb3\x92,\x93\x87\xb3\xcf,\x93\x88\xb5\xb4,\x93\x8a\xb0\xd0,\x93\x8cx" +
+	"\xc6,\x93\xc0w+,\x93\xc1\xae\x93,\x93\u0085Z,\x93ê-,\x93Ī\x0f,\x93Ŭ\xe6," +
+	"\x93ư\x1f,\x93ǳ\xaa,\x93ȴ\xb8,\x93ʯ\xd4,\x93\xccx\x85,\x94\x00v\xec,\x94\x01" +
+	"\xad\x99,\x94\x02\xaa),\x94\x03\x83\xea,\x94\x04\xa6\xa3,\x94\x05\xa9y,\x94\x06\xac\xb3,\x94\a\xb0>" +
+	",\x94\b\xb4\x99,\x94\n\xae\xde,\x94\fxF,\x94@v\xe9,\x94A\xad|,\x94B\xaa\f,\x94" +
+	"C\xa6\xa1,\x94D\x82\xdf,\x94E\xa6\xfd,\x94F\xaa7,\x94G\xad\n,\x94H\xb1C,\x94J\xae" +
+	"\xc5,\x94LxC,\x94\x80wc,\x94\x81\xb0H,\x94\x82\xac\xd8,\x94\x83\xa9m,\x94\x84\xa6\xf0," +
+	"\x94\x85\x80\xca,\x94\x86\xa7_,\x94\x87\xaa2,\x94\x88\xaek,\x94\x8a\xaf@,\x94\x8cwT,\x94\xc0" +
+	"wc,\x94\xc1\xb3\x82,\x94°\x12,\x94ì\xa6,\x94Ī*,\x94ŧ],\x94ƀI" +
+	",\x94Ǩ\xa7,\x94Ȭ8,\x94ʬ\xf2,\x94\xccw\x8e,\x95\x00uZ,\x95\x01\xb3\x98,\x95" +
+	"\x02\xb3u,\x95\x03\xb0\t,\x95\x04\xac\xd5,\x95\x05\xaa\b,\x95\x06\xa8z,\x95\a}\n,\x95\b\xa9" +
+	"\xb8,\x95\n\xaax,\x95\fv\f,\x95@t\x87,\x95A\xb5U,\x95B\xb4\\,\x95C\xb4=," +
+	"\x95D\xb0\xe6,\x95E\xae\x19,\x95F\xab\xe2,\x95G\xa9\x8e,\x95Hz\x9b,\x95J\xa8\b,\x95L" +
+	"r\x91,\x95\x80M\xb8,\x95\x81\xc4\xff,\x95\x82û,\x95\x83\xc2{,\x95\x84\xc22,\x95\x85\xbf\x0f" +
+	",\x95\x86\xbf\xc0,\x95\x87\xbb\xcb,\x95\x88\xba\xcb,\x95\x8a\xbd\x83,\x95\x8cK+,\x95\xc0i8,\x95" +
+	"\xc1\xb0\x80,\x95¯\x87,\x95î\x91,\x95Įx,\x95Ů\xfe,\x95Ƭ\xab,\x95Ǫ" +
+	"],\x95Ȩ\x1a,\x95\xcaSL,\x95\xccf\xb7,\x96\x00M\xdf,\x96\x01\xbdL,\x96\x02\xbc\x1e," +
+	"\x96\x03\xba\xf1,\x96\x04\xba\xb9,\x96\x05\xbax,\x96\x06\xbb6,\x96\a\xba\xf4,\x96\b\xba\x9a,\x96\n" +
+	"N\x7f,\x96\fKX,\x96\x16\x80\x00,\x96\x17MQ,\x96@b\xa4,\x96Az\t,\x96By\xcd" +
+	",\x96Cy\x91,\x96Dy\x91,\x96Ex\xad,\x96Fx\xe9,\x96Gw\x90,\x96HtF,\x96" +
+	"Jh`,\x96L,\xdd,\x96M`1,\x96Nt\xb2,\x96Ot~,\x96PtJ,\x96Qt" +
+	"I,\x96Rs{,\x96Ss\xae,\x96Trp,\x96UoV,\x96VK6,\x96WdR," +
+	"\x96XKZ,\x96\x8d3t,\x96\x8ez\x85,\x96\x8fzE,\x96\x90z\a,\x96\x91z\x04,\x96\x92" +
+	"z{,\x96\x93zz,\x96\x94x{,\x96\x95w\xa9,\x96\x96Q\xad,\x96\x97l\xae,\x96\x98Q\xd4" +
+	",\x96\x99f@,\x96·\xbf,\x96ϯ\x86,\x96Ю\x8f,\x96Ѯr,\x96ұ3,\x96" +
+	"Ӵ_,\x96Դv,\x96ն.,\x96\xd6\xc5\xe8,\x96ױz,\x96ؾG,\x96\xd9{" +
+	"\xb3,\x97\x0f\x86#,\x97\x10\xab+,\x97\x11\xab\x0e,\x97\x12\xad\xcf,\x97\x13\xb0\xfb,\x97\x14\xb4R," +
+	"\x97\x15\xb55,\x97\x16Ĩ,\x97\x17\xb0\x81,\x97\x18\xbd\x1a,\x97\x19{s,\x97P\x84\xb1,\x97Q" +
+	"\xa7\xb0,\x97R\xaaq,\x97S\xad\x9d,\x97T\xb0\xf4,\x97U\xb5\x17,\x97V\xc3m,\x97W\xaf\x8e" +
+	",\x97X\xbb\xf2,\x97Y{5,\x97\x91\x83\xa4,\x97\x92\xa7\xfe,\x97\x93\xab),\x97\x94\xad\xcb,\x97" +
+	"\x95\xb1\xcc,\x97\x96\xc3&,\x97\x97\xafu,\x97\x98\xbb\xba,\x97\x99{2,\x97ҁ\x89,\x97Ө" +
+	"f,\x97ԫ\b,\x97կ\t,\x97\xd6\xc0\r,\x97ׯ\xee,\x97ػq,\x97\xd9zG," +
+	"\x98\x13\x81\x06,\x98\x14\xa9\x84,\x98\x15\xac\xe0,\x98\x16\xc0\xbc,\x98\x17\xad\xaa,\x98\x18\xbc,,\x98\x19" +
+	"z\x80,\x98T}\xb7,\x98U\xaa},\x98V\xbc\xb1,\x98W\xabL,\x98X\xbb\xc5,\x98Yy\x01" +
+	",\x98\x95{6,\x98\x96\xbb\x8e,\x98\x97\xa8\xf9,\x98\x98\xbbE,\x98\x99u\xab,\x98\xd6_m,\x98" +
+	"\u05fe\x11,\x98؆a,\x98\xd9O\t,\x99\x17Td,\x99\x18P\xfe,\x99\x19j(,\x99X)" +
+	"\x8a,\x99YO5,\x99\x99/\xd9,\xa3\x00W(,\xa3\x01n\b,\xa3\x02m\xcd,\xa3\x03m\x91," +
+	"\xa3\x04mV,\xa3\x05m\x7f,\xa3\x06m\xba,\xa3\al\x1b,\xa3\bh\x9a,\xa3\td!,\xa3@" +
+	"/l,\xa3Ax\x03,\xa3Bw\xc7,\xa3Cw\x8b,\xa3DwO,\xa3Ex\xe3,\xa3Fx\xe4" +
+	",\xa3Gv\xe8,\xa3Hv?,\xa3Ir\t,\xa3La\xf4,\xa3\x80v\x9a,\xa3\x81\x86\x1c,\xa3" +
+	"\x82\xae`,\xa3\x83\xadg,\xa3\x84\xacq,\xa3\x85\xb0j,\xa3\x86\xb3\xa4,\xa3\x87\xb3',\xa3\x88\xb4" +
+	"\xea,\xa3\x89\xb7\x80,\xa3\x8cw\xf4,\xa3\xc0vY,\xa3\xc1\xae[,\xa3\u0084\x85,\xa3é\xf4," +
+	"\xa3Ĩ\xff,\xa3Ŭ\xf8,\xa3ư1,\xa3ǳ\x03,\xa3ȳ\xee,\xa3ɶ\x84,\xa3\xcc" +
+	"w\xb3,\xa4\x00v\x1a,\xa4\x01\xada,\xa4\x02\xa9\xf1,\xa4\x03\x83\x15,\xa4\x04\xa5\x93,\xa4\x05\xa9\x8c" +
+	",\xa4\x06\xac\xc5,\xa4\a\xaf\x96,\xa4\b\xb3\xcf,\xa4\t\xb5\x8d,\xa4\fws,\xa4@u\xdc,\xa4" +
+	"A\xacl,\xa4B\xa8\xfd,\xa4C\xa5\x91,\xa4D\x81\xca,\xa4E\xa6%,\xa4F\xa9^,\xa4G\xac" +
+	"/,\xa4H\xb0h,\xa4I\xb5t,\xa4Lw6,\xa4\x80wm,\xa4\x81\xb0d,\xa4\x82\xac\xf5," +
+	"\xa4\x83\xa9\x89,\xa4\x84\xa6\",\xa4\x85\x81\x13,\xa4\x86\xaa2,\xa4\x87\xaa\xa1,\xa4\x88\xae\",\xa4\x89" +
+	"\xb3\v,\xa4\x8cw^,\xa4\xc0wm,\xa4\xc1\xb3\x9e,\xa4°/,\xa4ì\xc3,\xa4ĩ[" +
+	",\xa4Ū0,\xa4ƀ\x93,\xa4ǩ\x16,\xa4ȫ\xef,\xa4ɰ\xbd,\xa4\xccw\x98,\xa5" +
+	"\x00uI,\xa5\x01\xb2\xfd,\xa5\x02\xb2\xda,\xa5\x03\xafn,\xa5\x04\xac\a,\xa5\x05\xaax,\xa5\x06\xa8" +
+	"\xea,\xa5\a}7,\xa5\b\xa9Z,\xa5\t\xae(,\xa5\fu\xfb,\xa5@tv,\xa5A\xb4\x97," +
+	"\xa5B\xb3\x9e,\xa5C\xb3\x7f,\xa5D\xb0\x18,\xa5E\xad\xd1,\xa5F\xab\x9a,\xa5G\xa90,\xa5H" +
+	"z\xc8,\xa5I\xac+,\xa5Lr\x7f,\xa5\x80p\x02,\xa5\x81\xb6\xf9,\xa5\x82\xb5\xff,\xa5\x83\xb5\n" +
+	",\xa5\x84\xb4\xef,\xa5\x85\xb2\x86,\xa5\x86\xb03,\xa5\x87\xad\xca,\xa5\x88\xab\xf6,\xa5\x89x\t,\xa5" +
+	"\x8cn\v,\xa5\xc0MM,\xa5\xc1\xc5g,\xa5\xc2\xc4$,\xa5\xc3\xc2\xe4,\xa5\xc4\xc1\xa8,\xa5\xc5\xc2" +
+	"f,\xa5\xc6\xc3\x17,\xa5ǿ ,\xa5Ⱦ ,\xa5ɽ\xdb,\xa5\xccJ\xc1,\xa6\x00M\x1a," +
+	"\xa6\x01\xc0E,\xa6\x02\xbf\x14,\xa6\x03\xbd\xe4,\xa6\x04\xbc\xb7,\xa6\x05\xbd\x82,\xa6\x06\xbe<,\xa6\a" +
+	"\xbd6,\xa6\b\xbd\b,\xa6\t\xbc\x8e,\xa6\fJ\x8d,\xa6\x17\x80\x00,\xa6@a\xce,\xa6Ay9" +
+	",\xa6Bx\xfd,\xa6Cx\xc1,\xa6Dx\x85,\xa6Ex\xaf,\xa6Fx\xeb,\xa6Gwu,\xa6" +
+	"Ht*,\xa6Io\xf5,\xa6L+\xd1,\xa6M_p,\xa6Ns\xf4,\xa6Os\xc0,\xa6Ps" +
+	"\x8c,\xa6QsX,\xa6Rs|,\xa6Ss\xb0,\xa6TrY,\xa6Uo?,\xa6VkJ," +
+	"\xa6WJ\xd7,\xa6XJ\xa6,\xa6\x8d2o,\xa6\x8ey\xb9,\xa6\x8fyy,\xa6\x90y;,\xa6\x91" +
+	"x\xfe,\xa6\x92z\x88,\xa6\x93z\x87,\xa6\x94xm,\xa6\x95w\x9b,\xa6\x96sT,\xa6\x97QG" +
+	",\xa6\x98Q\x15,\xa6\x99ep,\xa6Ά\xef,\xa6ϯP,\xa6ЮY,\xa6ѭh,\xa6" +
+	"ұP,\xa6Ӵ{,\xa6Գ\xde,\xa6յt,\xa6ַ\xcd,\xa6\xd7\xc6P,\xa6\xd8\xc1" +
+	"9,\xa6\xd9z\xe7,\xa7\x0f\x85S,\xa7\x10\xaa\xf5,\xa7\x11\xaa\x04,\xa7\x12\xad\xec,\xa7\x13\xb1\x17," +
+	"\xa7\x14\xb3\xb9,\xa7\x15\xb4{,\xa7\x16\xb6\xd4,\xa7\x17\xc5\x10,\xa7\x18\xc0\n,\xa7\x19z\xa7,\xa7P" +
+	"\x83\xe1,\xa7Q\xa6\xa6,\xa7R\xaa\x8e,\xa7S\xad\xb9,\xa7T\xb0[,\xa7U\xb4\\,\xa7V\xb5\xe1" +
+	",\xa7W\xc3\xd5,\xa7X\xbe\xde,\xa7Yzi,\xa7\x91\x82\x95,\xa7\x92\xa75,\xa7\x93\xaaa,\xa7" +
+	"\x94\xad\x02,\xa7\x95\xb1\x04,\xa7\x96\xb5\xc8,\xa7\x97\u00a0,\xa7\x98\xbd\xb6,\xa7\x99z,,\xa7ҁ" +
+	"\xdc,\xa7ӫ1,\xa7ԫy,\xa7ծ\xc5,\xa7ֳg,\xa7\xd7\xc3\\,\xa7ؾ}," +
+	"\xa7\xd9zT,\xa8\x13\x81Y,\xa8\x14\xa9\xf5,\xa8\x15\xac\x9c,\xa8\x16\xb1#,\xa8\x17\xc4\v,\xa8\x18" +
+	"\xbf6,\xa8\x19z\x8d,\xa8T}\xef,\xa8U\xaa%,\xa8V\xae\xac,\xa8W\xc0\x00,\xa8X\xbe\x0f" +
+	",\xa8Yx\xf3,\xa8\x95{n,\xa8\x96\xac\xc6,\xa8\x97\xbe\xdd,\xa8\x98\xbd\xbb,\xa8\x99u\x9d,\xa8" +
+	"\xd6x\x91,\xa8\u05feh,\xa8ؽ\x10,\xa8\xd9qV,\xa9\x17^\xb1,\xa9\x18\x86a,\xa9\x19N" +
+	"\xa3,\xa9X(\x84,\xa9YNq,\xa9\x99.\xd30\x03A\xbf\xf90\x03B\xbe\xc30\x03C\xbd\x8e0" +
+	"\x03D\xbc[0\x03E\xbf{0\x03F\xbe\xd60\x03G\xbf\x8e0\x03H\xc0G0\x03I\xbf\x050\x03J" +
+	"\xbf\xca0\x03K\xc0\x8f0\x03\x81\x7f<0\x03\x82\xacs0\x03\x83\xaby0\x03\x84\xaa\x840\x03\x85\xadI" +
+	"0\x03\x86\xb0h0\x03\x87\xb0X0\x03\x88\xb1$0\x03\x89\xb1\xde0\x03\x8a\xb2\xb60\x03\x8b\xb3\x8f0\x03" +
+	"\xc1\xacm0\x03\xc2}|0\x03è\a0\x03ħ\x110\x03ũ\xd60\x03Ƭ\xf60\x03ǰ" +
+	"30\x03Ȱ(0\x03ɰ\xe20\x03ʱ\xba0\x03˲\x920\x04\x01\xabs0\x04\x02\xa8\x040" +
+	"\x04\x03{\xe70\x04\x04\xa3\xa50\x04\x05\xa6j0\x04\x06\xa9\x890\x04\a\xac\xc70\x04\b\xb0\t0\x04\t" +
+	"\xaf\xec0\x04\n\xb0\xc40\x04\v\xb1\x9c0\x04A\xaa\x7f0\x04B\xa7\x0f0\x04C\xa3\xa30\x04Dz~" +
+	"0\x04E\xa3\x030\x04F\xa6\"0\x04G\xa9`0\x04H\xac\xa20\x04I\xaf\xd30\x04J\xaf\xd30\x04" +
+	"K\xb0\xab0\x04\x81\xadE0\x04\x82\xa9\xd60\x04\x83\xa6j0\x04\x84\xa3\x030\x04\x85y\x990\x04\x86\xa2" +
+	"\xeb0\x04\x87\xa6)0\x04\x88\xa9j0\x04\x89\xac\x9b0\x04\x8a\xaf\xe90\x04\x8b\xaf\xea0\x04\xc1\xb0p0" +
+	"\x04\u00ad\x000\x04é\x950\x04Ħ-0\x04Ţ\xea0\x04\xc6y\x990\x04ǣ\xdb0\x04\xc8" +
+	"\xa7\x1d0\x04ɪN0\x04ʭ\x9c0\x04˰\xea0\x05\x01\xb0a0\x05\x02\xb0>0\x05\x03\xac\xd3" +
+	"0\x05\x04\xa9k0\x05\x05\xa6(0\x05\x06\xa3\xd90\x05\ay.0\x05\b\xa7\xe90\x05\t\xa7\xdc0\x05" +
+	"\n\xab*0\x05\v\xaey0\x05A\xb1-0\x05B\xb030\x05C\xb0\x150\x05D\xac\xad0\x05E\xa9" +
+	"i0\x05F\xa7\x1b0\x05G\xa7\xe70\x05Hx\xec0\x05I\xa5\x960\x05J\xa8\xe40\x05K\xac20" +
+	"\x05\x81\xb1\xb50\x05\x82\xb0\xbb0\x05\x83\xaf\xc60\x05\x84\xaf\xab0\x05\x85\xacg0\x05\x86\xaa\x190\x05\x87" +
+	"\xa7\xa70\x05\x88\xa5]0\x05\x89u*0\x05\x8a\xa5t0\x05\x8b\xa8\xc20\x05\xc1\xb2\x8c0\x05±\x92" +
+	"0\x05ð\x9d0\x05į\xab0\x05ů\xb50\x05ƭf0\x05Ǫ\xf40\x05Ȩ\xaa0\x05" +
+	"ɥr0\x05\xcau;0\x05˩\x990\x06\x01\xb3c0\x06\x02\xb2j0\x06\x03\xb1t0\x06\x04\xb0" +
+	"\x830\x06\x05\xaf\xb50\x06\x06\xb0\xb40\x06\a\xaeB0\x06\b\xab\xf70\x06\t\xa8\xbf0\x06\n\xa9\x970" +
+	"\x06\vuu0\x06A\xc8E0\x06B\xc6\xfc0\x06Cŷ0\x06D\xc4v0\x06E\xc3C0\x06F" +
+	"\xc3\x1b0\x06G\xc3\xcb0\x06H\xc4~0\x06I\xbf\\0\x06J\xc0!0\x06K\xc0\xe60\x06M\x80\x00" +
+	"0\x06N\xbd\xab0\x06O\xbc\x7f0\x06P\xbbW0\x06Q\xba20\x06R\xb9\x1b0\x06S\xb8\xf40\x06" +
+	"T\xb9\x8e0\x06U\xba-0\x06V\xb5}0\x06W\xb6,0\x06X\xb6\xdb0\x06\x8d\x1f\x140\x06\x8e\xc1" +
+	"\x030\x06\x8f\xbf\xcf0\x06\x90\xbe\x9e0\x06\x91\xbdq0\x06\x92\xc0\x8c0\x06\x93\xbf\xea0\x06\x94\xc0\x9f0" +
+	"\x06\x95\xc1W0\x06\x96\xbf\xe90\x06\x97\xc0\xab0\x06\x98\xc1n0\x06\x99\x86a0\x06\u038070\x06\xcf" +
+	"\xad\x820\x06Ь\x8b0\x06ѫ\x9a0\x06ҮX0\x06ӱj0\x06ԱZ0\x06ղ#" +
+	"0\x06ֲ\xab0\x06׳\x7f0\x06شS0\x06\xd9\xc900\a\x0f~r0\a\x10\xa9'0\a" +
+	"\x11\xa860\a\x12\xaa\xf40\a\x13\xae\x060\a\x14\xb150\a\x15\xb1*0\a\x16\xb1\xb20\a\x17\xb2" +
+	"\x860\a\x18\xb3Z0\a\x19\xc7\xeb0\aP|\xdb0\aQ\xa4\xd80\aR\xa7\x960\aS\xaa\xa80" +
+	"\aT\xad\xd70\aU\xb1\v0\aV\xb0\xbf0\aW\xb1\x930\aX\xb2g0\aYƫ0\a\x91" +
+	"{q0\a\x92\xa4=0\a\x93\xa7O0\a\x94\xaa\x7f0\a\x95\xad\xb20\a\x96\xb0\xa60\a\x97\xb0\xa5" +
+	"0\a\x98\xb1y0\a\x99\xc5q0\a\xd2z\x970\aӤ&0\aԧV0\aժ\x890\a" +
+	"֭|0\aװ\xbc0\aذ\xbb0\a\xd9\xc4R0\b\x13z\x920\b\x14\xa5\x100\b\x15\xa8" +
+	"D0\b\x16\xab70\b\x17\xaev0\b\x18\xb1\xb50\b\x19\xc4+0\bTz$0\bU\xa9\f0" +
+	"\bV\xa8\xce0\bW\xac\r0\bX\xafL0\bY\xc4\xd80\b\x95y\xe00\b\x96\xa6\x920\b\x97" +
+	"\xa9\xd20\b\x98\xad\x110\b\x99Ŋ0\b\xd6v\a0\bצ\x860\bة\xc50\b\xd9\xc0L" +
+	"0\t\x17v\x160\t\x18\xaa\x990\t\x19\xc1\x0e0\tXvO0\tY\xc1\xd10\t\x99WP0\x13" +
+	"@$\xc50\x13Ba\x9e0\x13Cab0\x13Da&0\x13Ed<0\x13FcQ0\x13Gd" +
+	"a0\x13Hdb0\x13I`\x950\x13J`\xd10\x13Ka\f0\x13\x80D\xf20\x13\x82\xbc\x1f0" +
+	"\x13\x83\xbb\b0\x13\x84\xb9\xf40\x13\x85\xbd30\x13\x86\xc0\x060\x13\x87\xc0z0\x13\x88\xc0M0\x13\x89" +
+	"\xc0\xf70\x13\x8a\xc1\xe00\x13\x8b\xc2\xce0\x13\xc0`.0\x13\xc2WE0\x13Ö\xff0\x13Ė\x05" +
+	"0\x13ŘQ0\x13ƙW0\x13ǝO0\x13Ȝ10\x13ɜ\xa80\x13ʝx0\x13" +
+	"˞L0\x14\x00_\xef0\x14\x02\x96\xfc0\x14\x03U\x920\x14\x04\x92\xbf0\x14\x05\x95\n0\x14\x06\x96" +
+	"\x100\x14\a\x9a\b0\x14\b\x9c\x0e0\x14\t\x9b\xae0\x14\n\x9c~0\x14\v\x9dR0\x14@_\xb20" +
+	"\x14B\x96\x030\x14C\x92\xbd0\x14DT\f0\x14E\x91\xc90\x14F\x92\xcf0\x14G\x96\xc70\x14H" +
+	"\x98\xcd0\x14I\x9b\x910\x14J\x9b\x890\x14K\x9c]0\x14\x80b\xd20\x14\x82\x98\\0\x14\x83\x95\x15" +
+	"0\x14\x84\x91\xd30\x14\x85S,0\x14\x86\x8f\x940\x14\x87\x93\xa70\x14\x88\x95\xad0\x14\x89\x98q0\x14" +
+	"\x8a\x9b\x8d0\x14\x8b\x9b\x8a0\x14\xc0a\xf20\x14\u0099d0\x14Ö\x1e0\x14Ē\xdc0\x14ŏ" +
+	"\x940\x14\xc6Q\xb50\x14ǐ|0\x14Ȓ\x820\x14ɕF0\x14ʘc0\x14˛\x830" +
+	"\x15\x00c\x020\x15\x02\x9dg0\x15\x03\x9a 0\x15\x04\x96\xde0\x15\x05\x93\xb00\x15\x06\x90z0\x15\a" +
+	"Q\xec0\x15\b\x9150\x15\t\x93\x1e0\x15\n\x96;0\x15\v\x99[0\x15@b\xf90\x15B\x9c@" +
+	"0\x15C\x9c\x1d0\x15D\x98\xdb0\x15E\x95\xad0\x15F\x92w0\x15G\x91)0\x15HP\x000\x15" +
+	"I\x90>0\x15J\x93[0\x15K\x96{0\x15\x80_\"0\x15\x82\x9c\xab0\x15\x83\x9b\xb10\x15\x84\x9b" +
+	"\x920\x15\x85\x98d0\x15\x86\x95.0\x15\x87\x93\x060\x15\x88\x90/0\x15\x89N\x140\x15\x8a\x8f\x8a0" +
+	"\x15\x8b\x92\xaa0\x15\xc0_\\0\x15\u009dz0\x15Ü\x810\x15ě\x8b0\x15ś\x800\x15\xc6" +
+	"\x98J0\x15ǖ\"0\x15ȓL0\x15ɏ\x880\x15\xcaM\xdc0\x15˓~0\x16\x00_\x97" +
+	"0\x16\x02\x9eN0\x16\x03\x9dT0\x16\x04\x9c_0\x16\x05\x9b}0\x16\x06\x9bj0\x16\a\x99B0\x16" +
+	"\b\x96l0\x16\t\x92\xa80\x16\n\x93|0\x16\vM\xce0\x16@A\x900\x16B}\xa90\x16C|" +
+	"\xcd0\x16Dv\x060\x16Em\x830\x16Fc\xc90\x16G\\\xaa0\x16HT\x850\x16IN-0" +
+	"\x16JK\x1f0\x16KH\xc70\x16N\x80\x000\x16Ox\xc10\x16Pw\xf70\x16Qq\xc50\x16R" +
+	"i\xf80\x16Sa\n0\x16TZs0\x16UR\xed0\x16VM\f0\x16WJ/0\x16XG\xf7" +
+	"0\x16\x8d('0\x16\x8eI]0\x16\x8fd\x010\x16\x90c\xc30\x16\x91c\x870\x16\x92f\x980\x16" +
+	"\x93e\xbc0\x16\x94f\xc60\x16\x95f\xbb0\x16\x96b\xfa0\x16\x97c30\x16\x98cm0\x16\x99F" +
+	"\b0\x16\xce^\x100\x16ϽK0\x16м70\x16ѻ(0\x16Ҿb0\x16\xd3\xc110" +
+	"\x16\xd4\xc1\xa30\x16\xd5\xc1n0\x16\xd6\xc2\t0\x16\xd7\xc2\xef0\x16\xd8\xc3\xd80\x16نo0\x17\x0f" +
+	"X\xd50\x17\x10\x98\xba0\x17\x11\x97\xc50\x17\x12\x9a\x120\x17\x13\x9b\x1e0\x17\x14\x9f\x040\x17\x15\x9d\xe2" +
+	"0\x17\x16\x9eL0\x17\x17\x9f\x180\x17\x18\x9f\xe80\x17\x19\x80\x180\x17PW 0\x17Q\x94\x8b0\x17" +
+	"R\x96\xd80\x17S\x97\xe40\x17T\x9b\xca0\x17U\x9d\xc00\x17V\x9dU0\x17W\x9e!0\x17X\x9e" +
+	"\xf20\x17Y\x7f=0\x17\x91U\x990\x17\x92\x93\xa30\x17\x93\x94\xaf0\x17\x94\x98\x960\x17\x95\x9a\x8b0" +
+	"\x17\x96\x9d80\x17\x97\x9d00\x17\x98\x9e\x000\x17\x99x\x930\x17\xd2T\xc30\x17ӑ\x820\x17\xd4" +
+	"\x95\x820\x17\u0557w0\x17֚$0\x17ם40\x17؝00\x17\xd9pA0\x18\x13SX" +
+	"0\x18\x14\x92e0\x18\x15\x94Z0\x18\x16\x97\a0\x18\x17\x9a\x170\x18\x18\x9d*0\x18\x19f\xba0\x18" +
+	"TS\x880\x18U\x93\x0f0\x18V\x94\xe40\x18W\x97\xf40\x18X\x9b\a0\x18Y_\xb40\x18\x95Q" +
+	"\x980\x18\x96\x92\x190\x18\x97\x95)0\x18\x98\x98<0\x18\x99W\xa80\x18\xd6O\xa60\x18בr0" +
+	"\x18ؔ\x850\x18\xd9Q^0\x19\x17Ol0\x19\x18\x95V0\x19\x19NX0\x19XO]0\x19Y" +
+	"L\a0\x19\x99\x1b\xa70#@%\xd30#Ak\\0#Ca\xb40#Dax0#Ed\xab" +
+	"0#Fd\x7f0#Gd\x820#HeY0#Ia\x8c0#Ja\xc70#Kb\x030#" +
+	"\x80i\xb40#\x81|\x980#\x83\xa3\x000#\x84\xa2\x060#\x85\xa4\xf60#\x86\xa6\xfd0#\x87\xa6" +
+	"90#\x88\xa6+0#\x89\xa6\xa30#\x8a\xa7s0#\x8b\xa8G0#\xc0EV0#\xc1\xbe\x810" +
+	"#÷\xad0#Ķ\x990#Ź\xd80#Ƽ\xab0#\xc7\xc0t0#ȿ\xde0#\xc9" +
+	"\xc0\x880#\xca\xc1r0#\xcb\xc2_0$\x00`70$\x01\xa3'0$\x03V\x0e0$\x04\x94/" +
+	"0$\x05\x96\x950$\x06\x98&0$\a\x9a\b0$\b\x9c\xf60$\t\x9c\x960$\n\x9df0$" +
+	"\v\x9e:0$@_\xf90$A\xa2.0$C\x94.0$DT\x880$E\x93T0$F\x94" +
+	"\xe50$G\x96\xc70$H\x99\xb50$I\x9cy0$J\x9cq0$K\x9dE0$\x80c90" +
+	"$\x81\xa510$\x83\x96\xa20$\x84\x93`0$\x85S\xca0$\x86\x91\xc50$\x87\x93\xa70$\x88" +
+	"\x96\x950$\x89\x99Y0$\x8a\x9cu0$\x8b\x9cr0$\xc0c\x180$\xc1\xa7G0$Ø>" +
+	"0$Ĕ\xfc0$ő\xce0$\xc6S\x130$ǐ|0$ȓ\x860$ɖJ0$" +
+	"ʙf0$˜\x860%\x00c%0%\x01\xa6\x8f0%\x03\x9a\"0%\x04\x96\xe00%\x05\x93" +
+	"\xb20%\x06\x90|0%\aQ\xed0%\b\x9150%\t\x93\x1f0%\n\x96;0%\v\x99[0" +
+	"%@c\xf10%A\xa6x0%C\x9d\x110%D\x99\xcf0%E\x96\xa10%F\x93\x840%G" +
+	"\x91)0%HP\xf00%I\x90w0%J\x93\x930%K\x96\xb40%\x80`\x1a0%\x81\xa6\xe3" +
+	"0%\x83\x9c\xa50%\x84\x9c\x860%\x85\x99X0%\x86\x96;0%\x87\x93\x060%\x88\x90h0%" +
+	"\x89O\x050%\x8a\x8f\xc30%\x8b\x92\xe30%\xc0`T0%\xc1\xa7\xb20%Ýt0%Ĝ" +
+	"\x7f0%Ŝt0%ƙX0%ǖ\"0%ȓ\x840%ɏ\xc10%\xcaN\xcc0" +
+	"%˓\xb70&\x00`\x8f0&\x01\xa8\x860&\x03\x9eH0&\x04\x9dR0&\x05\x9cq0&\x06" +
+	"\x9cx0&\a\x99B0&\b\x96\xa40&\t\x92\xe10&\n\x93\xb50&\vN\xbf0&@B\xd9" +
+	"0&A\x8dd0&C}\x8d0&Dv\\0&Em\xf10&Fe\v0&G\\\xe10&" +
+	"HU\xaf0&IOZ0&JLO0&KI\xfa0&O\x80\x000&Px\xa40&Qr" +
+	"\x130&Rj\\0&Sb/0&TZ\xa70&US\xfe0&VN\x1f0&WKE0" +
+	"&XI\x100&\x8d)-0&\x8emQ0&\x8fI\xbd0&\x90d\x060&\x91c\xca0&\x92" +
+	"f\xf90&\x93f\xd90&\x94f\xe70&\x95g\xad0&\x96c\xeb0&\x97d%0&\x98d^" +
+	"0&\x99GI0&\xce}\x970&Ͽ\x9b0&Фs0&ѣ~0&Ҧk0&" +
+	"Өr0&ԧ\xbd0&է\xa60&֨\x0f0&ר\xdc0&ة\xac0&ُ" +
+	"\"0'\x0f\\\xa80'\x10\xb8\xe20'\x11\xb7\xd30'\x12\xbb\r0'\x13\xbd\xdc0'\x14\xc1\x9d0" +
+	"'\x15\xc1\x000'\x16\xc1\x9b0'\x17\u00810'\x18\xc3j0'\x19\x86i0'PW\x8e0'Q" +
+	"\x95\xed0'R\x98R0'S\x99\xe60'T\x9b\xca0'U\x9e\xa20'V\x9e80'W\x9f\x04" +
+	"0'X\x9f\xd40'Y\x7f\xec0'\x91V\a0'\x92\x95\x1e0'\x93\x96\xb20'\x94\x98\x960'" +
+	"\x95\x9bn0'\x96\x9e\x1b0'\x97\x9e\x130'\x98\x9e\xe30'\x99x\xda0'\xd2US0'ӓ" +
+	"\x9e0'ԕ\x820'\u0558Z0'֛\a0'מ\x160'؞\x120'\xd9p\x9f0" +
+	"(\x13T\xa40(\x14\x92e0(\x15\x95V0(\x16\x98\x030(\x17\x9b\x130(\x18\x9e&0(\x19" +
+	"g\xe90(TS\x890(U\x93\x0f0(V\x94\xe50(W\x97\xf40(X\x9b\a0(Y_\xea" +
+	"0(\x95R\x830(\x96\x92O0(\x97\x95_0(\x98\x98r0(\x99X\xcc0(\xd6P\x910(" +
+	"ב\xa80(ؔ\xbb0(\xd9R\x840)\x17PW0)\x18\x95\x8c0)\x19O\x810)XP" +
+	"H0)YM30)\x99\x1c\xad03@&\xe103AlO03Bk]03Da\xc90" +
+	"3Ed\xfd03Fd\xf303Ge\xad03Hev03Ib~03Jb\xba03K" +
+	"b\xf503\x80j\xa803\x81}m03\x82\xacg03\x84\xa2\xe503\x85\xa5\xd603\x86\xa7\xf6" +
+	"03\x87\xa7203\x88\xa6,03\x89\xa6\xdc03\x8a\xa7\xab03\x8b\xa8\x7f03\xc0i\xa603" +
+	"\xc1\xacV03\xc2z\xc003ğ\xa403Ţ\x9403Ƥ\xb403ǧ\x9803ȥ" +
+	"\xca03ɦz03ʧJ03˨\x1e04\x00E\xbd04\x01\xbe\x1404\x02\xb9\xe10" +
+	"4\x04\xb3@04\x05\xb6\x7f04\x06\xb9R04\a\xbd\x1b04\b\xbf\xda04\t\xc0\x1c04\n" +
+	"\xc1\x0504\v\xc1\xf204@`@04A\xa3\x0104B\x9f\xca04DU\x0404E\x94\xc5" +
+	"04F\x96o04G\x98\xdc04H\x99\xb504I\x9da04J\x9dY04K\x9e-04" +
+	"\x80c\x8004\x81\xa6\x0304\x82\xa2\xcd04\x84\x94\xd004\x85TF04\x86\x93O04\x87\x95" +
+	"\xbc04\x88\x96\x9504\x89\x9aA04\x8a\x9d]04\x8b\x9dZ04\xc0c\x8204\xc1\xa850" +
+	"4¤\xfe04Ė\x8804œZ04\xc6S\xb204ǒ\xad04ȓ\x8604\xc9" +
+	"\x97104ʚN04˝n05\x00dF05\x01\xa7}05\x02\xa7\xf005\x04\x99\x00" +
+	"05\x05\x95\xd205\x06\x92\xb605\aSJ05\b\x91505\t\x94\"05\n\x97>05" +
+	"\v\x9a_05@d\x0f05A\xa6x05B\xa6%05D\x99\xd105E\x96\xa305F\x93" +
+	"\x8605G\x91+05HP\xf105I\x90w05J\x93\x9405K\x96\xb405\x80a\x0e0" +
+	"5\x81\xa7\x1b05\x82\xa6\xc805\x84\x9dz05\x85\x9aL05\x86\x97/05\x87\x94\x1305\x88" +
+	"\x90h05\x89O\xf605\x8a\x8f\xfb05\x8b\x93\x1c05\xc0aH05\xc1\xa7\xeb05§\x97" +
+	"05ĝs05ŝh05ƚK05Ǘ/05ȓ\x8505ɏ\xfa05" +
+	"\xcaO\xbd05˓\xef06\x00a\x8306\x01\xa8\xbf06\x02\xa8k06\x04\x9eF06\x05\x9d" +
+	"e06\x06\x9dk06\a\x9aP06\b\x96\xa506\t\x93\x1a06\n\x93\xee06\vO\xb00" +
+	"6@D\x1e06A\x8e?06B\x8d\x8006Dw\f06EnH06Fey06G" +
+	"^$06HU\xe706IP\x8406JM|06KK*06P\x80\x0006Qr\xb2" +
+	"06Rj\xaa06Sb\x9306T[\xcc06UT306VO006WLX06" +
+	"XJ&06\x8d*306\x8en>06\x8fm@06\x90J\x1f06\x91d\r06\x92g" +
+	"<06\x93g>06\x94h\x0006\x95g\xca06\x96d\xd906\x97e\x1206\x98eL0" +
+	"6\x99H\x8606\xce~g06ϭn06п/06ѤL06ҧ:06\xd3" +
+	"\xa9Z06Ԩ\xa506է\xa606֨E06ש\x1206ة\xe206ُ\xf7" +
+	"07\x0f{\xaf07\x10\xbb\x0507\x11\xa1\x1b07\x12\xa4\b07\x13\xa6)07\x14\xa9\t07" +
+	"\x15\xa7F07\x16\xa7\xe507\x17\xa8\xb207\x18\xa9\x8207\x19\x8f-07P[p07Q\xb4" +
+	"\x8007R\xb7\xba07S\xba\x8907T\xbeJ07U\xc0\xfc07V\xc1/07W\xc2\x150" +
+	"7X\xc2\xfe07Y\x86e07\x91Vu07\x92\x96\x7f07\x93\x98,07\x94\x9a\x9807\x95" +
+	"\x9bn07\x96\x9e\xfd07\x97\x9e\xf607\x98\x9f\xc607\x99yy07\xd2U\xc007ӕ\x18" +
+	"07ԗ\x8507\u0558Z07֛\xea07מ\xf907؞\xf507\xd9p\xe708" +
+	"\x13U408\x14\x94\x8108\x15\x95W08\x16\x98\xe608\x17\x9b\xf508\x18\x9f\t08\x19h" +
+	"H08TT\xd508U\x93\x0f08V\x95\xe008W\x98\xf008X\x9c\x0308Ya\x1a0" +
+	"8\x95R\x8308\x96\x92O08\x97\x95_08\x98\x98r08\x99Y\x0308\xd6Q|08\xd7" +
+	"\x91\xde08ؔ\xf208\xd9S\xa909\x17QC09\x18\x95\xc209\x19P\xa809XQ4" +
+	"09YN\\09\x99\x1d\xb30C@'\xef0CAmA0CBlO0CCk]0C" +
+	"EeJ0CFeA0CGf\x1d0CHf\x9c0CIb\x970CJc\xa80CKc" +
+	"\xe30C\x80k\x9c0C\x81~B0C\x82\xac\xa00C\x83\xac80C\x85\xa6\xb50C\x86\xa8\xd60" +
+	"C\x87\xa8\x110C\x88\xa7$0C\x89\xa6\xdc0C\x8a\xa7\xe40C\x8b\xa8\xb80C\xc0j\x9a0C\xc1" +
+	"\xac\x8f0C\xc2{\x950Cè|0Cţs0Cƥ\x940CǨ\x910CȦ\xc3" +
+	"0Cɦz0Cʧ\x830C˨W0D\x00i\x9a0D\x01\xac\x1a0D\x02\xa8m0D" +
+	"\x03y\x110D\x05\xa070D\x06\xa2X0D\a\xa5U0D\b\xa7/0D\t\xa6\x1f0D\n\xa7" +
+	"'0D\v\xa7\xfb0D@F%0DA\xbd\xa90DB\xb9v0DC\xb5H0DE\xb3(0" +
+	"DF\xb5\xfa0DG\xb9\xc30DH\xbc\x830DI\xc0\x190DJ\xc0\x9a0DK\xc1\x870D\x80" +
+	"c\xc40D\x81\xa6\xd60D\x82\xa3\x9f0D\x83\xa0m0D\x85T\xc20D\x86\x94\xc00D\x87\x97G" +
+	"0D\x88\x98\xab0D\x89\x9aA0D\x8a\x9eE0D\x8b\x9eB0D\xc0c\xc50D\xc1\xa9\b0D" +
+	"¥\xd10Dâ\x9e0DŔ\xcb0D\xc6T.0Dǔ80Dȕ\x9b0Dɗ" +
+	"20Dʛ60D˞V0E\x00d\xac0E\x01\xa8P0E\x02\xa8\xde0E\x03\xa5\xab0" +
+	"E\x05\x97^0E\x06\x94B0E\aS\xe90E\b\x93f0E\t\x94\"0E\n\x98&0E\v" +
+	"\x9bF0E@e-0EA\xa7f0EB\xa7\x130EC\xa7\x890EE\x98\xc30EF\x95\xa6" +
+	"0EG\x93e0EHRN0EI\x90w0EJ\x94\x970EK\x97\xb70E\x80a(0E" +
+	"\x81\xa7\x1c0E\x82\xa6\xc80E\x83\xa6x0E\x85\x9aM0E\x86\x9710E\x87\x94\x150E\x88\x90" +
+	"j0E\x89O\xf60E\x8a\x8f\xfc0E\x8b\x93\x1c0E\xc0b80E\xc1\xa8$0E§\xd00" +
+	"Eç\x800EŞ\\0Eƛ?0Eǘ#0EȔ\x920Eɏ\xfa0E\xca" +
+	"P\xae0E˔(0F\x00bs0F\x01\xa8\xf70F\x02\xa8\xa40F\x03\xa8T0F\x05\x9eX" +
+	"0F\x06\x9e_0F\a\x9bC0F\b\x97\xb20F\t\x93\x1a0F\n\x94'0F\vP\xa00F" +
+	"@E`0FA\x95\x030FB\x94\xaf0FC\x94\x010FEn\xe60FFe\xd10FG^" +
+	"\x930FHW*0FIP\xbd0FJN\xa80FKLX0FQ\x80\x000FRk;0" +
+	"FSb\xe20FT\\10FUUX0FVOf0FWMj0FXK;0F\x8d" +
+	"+:0F\x8eo,0F\x8fn.0F\x90m20F\x91J\x830F\x92g{0F\x93g~" +
+	"0F\x94ha0F\x95h\xdf0F\x96d\xf20F\x97e\xfb0F\x98f50F\x99I\xbf0F" +
+	"\xce\x7f70Fϭ\xa40FЭ20FѾ\xc50FҨ\b0FӪ)0Fԩ" +
+	"t0Fը\x8e0F֨F0FשH0Fت\x180Fٖ\x9e0G\x0f|\x800" +
+	"G\x10\xa9\x8d0G\x11\xba\x9b0G\x12\xa4\xd70G\x13\xa6\xf80G\x14\xa9\xf10G\x15\xa8.0G\x16" +
+	"\xa7\xe50G\x17\xa8\xe80G\x18\xa9\xb80G\x19\x96<0GPy\xf30GQ\xb6w0GR\xa1\xac" +
+	"0GS\xa3\xcd0GT\xa6\xc60GU\xa8\x970GV\xa7\x8b0GW\xa8\x8d0GX\xa9^0G" +
+	"Y\x95\x840G\x91Zh0G\x92\xb4i0G\x93\xb780G\x94\xba\xf90G\x95\xbd\xab0G\x96\xc1" +
+	"-0G\x97\xc1\xaa0G\x98\u00930G\x99\x86c0G\xd2V.0GӖy0GԘ\xff0" +
+	"G՚]0G֛\xea0Gן\xdc0G؟\xd80G\xd9qu0H\x13U\xa10H\x14" +
+	"\x95\xfb0H\x15\x97Y0H\x16\x98\xe60H\x17\x9c\xd80H\x18\x9f\xec0H\x19h\x900HTUd" +
+	"0HU\x95+0HV\x95\xe10HW\x99\xd30HX\x9c\xe60HYaz0H\x95S\xcf0H" +
+	"\x96\x92P0H\x97\x96[0H\x98\x99n0H\x99Z30H\xd6Q}0Hב\xde0Hؔ" +
+	"\xf20H\xd9S\xe00I\x17R.0I\x18\x95\xf80I\x19Q\xce0IXR\x1f0IYO\x840" +
+	"I\x99\x1e\xba0S@(\x940SAp\xaa0SBo\x9d0SCn\xab0SDm\xba0SF" +
+	"ev0SGfS0SHf\xf50SIc\xa60SJc\xa90SKd\xba0S\x80o\x04" +
+	"0S\x81\x7fV0S\x82\xacc0S\x83\xab\xd90S\x84\xabu0S\x86\xa8\xce0S\x87\xa8,0S" +
+	"\x88\xa7?0S\x89\xa7\x100S\x8a\xa7 0S\x8b\xa8,0S\xc0m\xe70S\xc1\xacR0S\xc2|" +
+	"\xa90Sè\x1d0Sħ\xb90Sƥ\x8c0SǨ\x890SȦ\xbb0Sɦ\x8c0" +
+	"Sʦ\x9c0S˧\xa80T\x00l\xe70T\x01\xab\xbb0T\x02\xa8\x0e0T\x03z%0T\x04" +
+	"\xa4\x040T\x06\xa2P0T\a\xa5M0T\b\xa7A0T\t\xa600T\n\xa6@0T\v\xa7L" +
+	"0T@k\xe80TA\xabL0TB\xa7\x9f0TC\xa3\xf60TDw\xc90TF\x9f\x1a0T" +
+	"G\xa2\x170TH\xa4\n0TI\xa6\xa20TJ\xa5\xea0TK\xa6\xf60T\x80F\xef0T\x81\xc1" +
+	"\x8c0T\x82\xbdY0T\x83\xb9*0T\x84\xb5\x000T\x86\xb3\x1e0T\x87\xb6\xe70T\x88\xb9\xa60" +
+	"T\x89\xbd=0T\x8a\xc1\x120T\x8b\xc1\x970T\xc0c\xe50T\xc1\xa8\xe70T¥\xb00T\xc3" +
+	"\xa2~0TğO0T\xc6S\xa80TǕ\x810TȖ\xfe0Tə 0Tʛ\x0f" +
+	"0T˟\x170U\x00d\xcc0U\x01\xa8R0U\x02\xa8\xbe0U\x03\xa5\x8b0U\x04\xa2\\0U" +
+	"\x06\x95\x8c0U\aSc0U\b\x94\xc90U\t\x96\x100U\n\x97\xff0U\v\x9c\a0U@e" +
+	"o0UA\xa7h0UB\xa6\xf20UC\xa7\x840UD\xa4V0UF\x97\v0UG\x94\xca0" +
+	"UHQ\xeb0UI\x92\x810UJ\x94p0UK\x98x0U\x80b#0U\x81\xa790U\x82" +
+	"\xa6\xc30U\x83\xa6s0U\x84\xa6\xee0U\x86\x99*0U\x87\x96\x0e0U\x88\x92}0U\x89PR" +
+	"0U\x8a\x8f\xd50U\x8b\x93\xf80U\xc0b00U\xc1\xa7S0U¦\xdd0Uæ\x8d0U" +
+	"ĦB0Uƛ\x1a0UǗ\xfe0UȔm0Uɏ\xd50U\xcaO\xad0U˔" +
+	"\x010V\x00c?0V\x01\xa8_0V\x02\xa7\xe90V\x03\xa7\x990V\x04\xa7N0V\x06\x9f,0" +
+	"V\a\x9c\x100V\b\x98\x7f0V\t\x94\x000V\n\x93\xff0V\vP\x900V@F\x920VA" +
+	"\x9d\x830VB\x9d\x170VC\x9c\xc20VD\x9c%0VFe]0VG]\xe90VHV\x98" +
+	"0VIP\xff0VJM\xdf0VKL\x830VR\x80\x000VSbz0VT[\x980V" +
+	"UT\xd50VVO\xa30VWL\xb70VXKd0V\x8d+\xe50V\x8er\x8c0V\x8fq" +
+	"s0V\x90pw0V\x91o}0V\x92KO0V\x93g\xa70V\x94h\x8b0V\x95i+0" +
+	"V\x96e\xf10V\x97e\xff0V\x98g\t0V\x99J\xf70V\u0380R0Vϭk0V\xd0" +
+	"\xac\xd70VѬj0V\xd2\u009f0VӪ\x150Vԩ\x830Vը\x9c0V֨m" +
+	"0Vר\x880Vة\x8e0Vٟ\a0W\x0f}\x9a0W\x10\xa920W\x11\xa8\xc60W" +
+	"\x12\xbeu0W\x13\xa6\xe40W\x14\xa9\xdd0W\x15\xa8\x1a0W\x16\xa7\xeb0W\x17\xa8\x060W\x18\xa9" +
+	"\f0W\x19\x9e\x8e0WP{\x0e0WQ\xa5'0WR\xbaQ0WS\xa3\xb90WT\xa6\xb20" +
+	"WU\xa8\x9d0WV\xa7\x910WW\xa7\xab0WX\xa8\xb10WY\x9e-0W\x91x\xab0W\x92" +
+	"\xb630W\x93\xa0\x930W\x94\xa3\x8c0W\x95\xa5w0W\x96\xa8\x000W\x97\xa7W0W\x98\xa8]" +
+	"0W\x99\x9d\x880W\xd2Y\x160WӴa0WԸ!0Wպ\xd30W־V0W" +
+	"\xd7\xc2\"0W\xd8£0Wنb0X\x13U\x130X\x14\x9780X\x15\x98\xaf0X\x16\x9a" +
+	"\xc40X\x17\x9c\xb40X\x18\xa0\xaa0X\x19h\x130XTT\xd60XU\x96\x800XV\x97\xbe0" +
+	"XW\x99\xae0XX\x9d\xa40XY`\xc70X\x95Sc0X\x96\x94F0X\x97\x9660X\x98" +
+	"\x9a,0X\x99Y\x970X\xd6Q\xcd0Xב\xba0Xؕ\xc90X\xd9T\x150Y\x17Q3" +
+	"0Y\x18\x95\xd30Y\x19Q\n0YXR\x0f0YYO\xae0Y\x99\x1e\xa90c@(\x8d0c" +
+	"Ap\xbb0cBo\xac0cCn\xbb0cDm\xc90cEnl0cGe\x880cHf" +
+	"*0cIb\xfd0cJc\xb70cKc\xbb0c\x80o!0c\x81\x80u0c\x82\xad\v0" +
+	"c\x83\xab\xc80c\x84\xabB0c\x85\xaf\x170c\x87\xa9\x120c\x88\xa8%0c\x89\xa7\xf60c\x8a" +
+	"\xa8 0c\x8b\xa830c\xc0n\x030c\xc1\xac\xfb0c\xc2}\xab0cç\xf80cħq" +
+	"0cūF0cǨ\x950cȦ\xea0cɦ\xba0cʦ\xe40c˦\xf80d" +
+	"\x00m\x030d\x01\xab\xac0d\x02\xa7\xe90d\x03{&0d\x04\xa3\xbc0d\x05\xa7\x900d\a\xa5" +
+	"Y0d\b\xa7M0d\t\xa6<0d\n\xa6f0d\v\xa6z0d@l\x050dA\xab\x1a0" +
+	"dB\xa7W0dC\xa3\xae0dDx\xcb0dE\xa3\xe00dG\xa2#0dH\xa4\x160dI" +
+	"\xa6\xc70dJ\xa6\x100dK\xa6#0d\x80l\x990d\x81\xae\xe30d\x82\xab 0d\x83\xa7w" +
+	"0d\x84\xa3\xd20d\x85v\xc60d\x87\x9f\xda0d\x88\xa1\xce0d\x89\xa4\x7f0d\x8a\xa7o0d" +
+	"\x8b\xa6\xbb0d\xc0F\xd00d\xc1\xc4y0d\xc2\xc0E0dü\x160dķ\xeb0dŴ" +
+	"\xc80dǳ\xfa0dȶ\xba0dɺP0dʾ&0d\xcb\xc1\xff0e\x00c\xf60" +
+	"e\x01\xa9#0e\x02\xa8\xb20e\x03\xa5\x7f0e\x04\xa2Q0e\x05\xa0\x100e\aR\xef0e\b" +
+	"\x96\x010e\t\x97b0e\n\x99\xdc0e\v\x9b\xcf0e@d\x9a0eA\xa890eB\xa7\t" +
+	"0eC\xa7y0eD\xa4J0eE\xa2\t0eG\x96\x020eHQw0eI\x93\xd30e" +
+	"J\x96M0eK\x98@0e\x80ao0e\x81\xa8\t0e\x82\xa6\xd90e\x83\xa6g0e\x84\xa6" +
+	"\xfd0e\x85\xa4\xbd0e\x87\x97b0e\x88\x93\xd00e\x89P\x000e\x8a\x91\xcd0e\x8b\x93\xc00" +
+	"e\xc0b40e\xc1\xa8?0e§\x0f0eæ\x9d0eĦR0eŧ\xba0e\xc7" +
+	"\x99\xe50eȖT0eɑ\xd60e\xcaP\x1a0e˓\xc90f\x00bA0f\x01\xa8]" +
+	"0f\x02\xa7-0f\x03\xa6\xbb0f\x04\xa6p0f\x05\xa7\x120f\a\x9b\xd90f\b\x98H0f" +
+	"\t\x93\xca0f\n\x93\xc90f\vO\xa00f@F\xba0fA\xa7<0fB\xa5\xfa0fC\xa5" +
+	"\x8d0fD\xa580fE\xa5\xae0fG]v0fHV\x010fIP\x7f0fJN40" +
+	"fKK\xcc0fS\x80\x000fT[10fUTL0fVO00fWM\x050fX" +
+	"J\xc10f\x8d+\xdd0f\x8er\xa90f\x8fq\x900f\x90p\x940f\x91o\x990f\x92p+" +
+	"0f\x93K/0f\x94g\xb80f\x95hW0f\x96e@0f\x97f\x010f\x98f\x100f" +
+	"\x99K\x1d0f\u0381u0fϮ\x120fЬ\xc90fѬ:0fү\xf40f\xd3\xc5" +
+	"\x860fԪZ0fթs0f֩D0fשx0fة\x960f٨\xa30" +
+	"g\x0f~\xa30g\x10\xa9\x100g\x11\xa8\x820g\x12\xac<0g\x13\xc1\\0g\x14\xa9\xde0g\x15" +
+	"\xa8<0g\x16\xa8\r0g\x17\xa8A0g\x18\xa8_0g\x19\xa7Y0gP|\x160gQ\xa4\xe3" +
+	"0gR\xa8\x9d0gS\xbd80gT\xa6\xb20gU\xa8\x9d0gV\xa7\x910gW\xa7\xc50g" +
+	"X\xa7\xe30gY\xa6\xe10g\x91y\xb30g\x92\xa5\x040g\x93\xb9\x190g\x94\xa3\x8d0g\x95\xa5" +
+	"w0g\x96\xa8\x190g\x97\xa7p0g\x98\xa7\x8e0g\x99\xa6\x820g\xd2w\xa70gӵ\xfd0" +
+	"gԡQ0gգ;0g֥\xdd0gר\xc90gب#0g٦\xeb0h\x13" +
+	"X\x030h\x14\xb5:0h\x15\xb7\xec0h\x16\xbbn0h\x17\xbf:0h\x18\xc3\n0h\x19\x86a" +
+	"0hTTY0hU\x97\xac0hV\x99\x030hW\x9b{0hX\x9do0hY`I0h" +
+	"\x95R\xe60h\x96\x95\x8b0h\x97\x98\x030h\x98\x99\xf60h\x99X\xf60h\xd6Qq0hד" +
+	"\xa00hؕ\x930h\xd9S\x8b0i\x17Q\x930i\x18\x95\x9e0i\x19QQ0iXQ$0" +
+	"iYN\xfc0i\x99\x1e\xaa0s@'\x800sAo\xbb0sBo\xbb0sCn\xac0sD" +
+	"m\xba0sEn^0sFmm0sHd\xb40sIa\x880sJbd0sKc@" +
+	"0s\x80n 0s\x81\x7f`0s\x82\xac20s\x83\xaa\xee0s\x84\xaag0s\x85\xae<0s" +
+	"\x86\xb1/0s\x88\xa7.0s\x89\xa7\x060s\x8a\xa7/0s\x8b\xa7]0s\xc0n\x1a0s\xc1\xac" +
+	",0s\xc2}\xf40sèg0sħ(0sŪ\xdb0sƭ\xcd0sȧ\t0" +
+	"sɦ\xe10sʧ\v0s˧80t\x00l\xfe0t\x01\xaa\xdd0t\x02\xa8Y0t\x03" +
+	"{S0t\x04\xa3^0t\x05\xa7\x100t\x06\xaa\x020t\b\xa6\x920t\t\xa5\xac0t\n\xa5\xd5" +
+	"0t\v\xa6\x020t@l\x000tA\xaaL0tB\xa7\x0f0tC\xa3P0tDx\xf70t" +
+	"E\xa3`0tF\xa6R0tH\xa3\\0tI\xa6\x140tJ\xa5\\0tK\xa5\x8a0t\x80l" +
+	"\x940t\x81\xae\x140t\x82\xaa\xb60t\x83\xa6\xf70t\x84\xa3R0t\x85v\xf20t\x86\xa3\x900" +
+	"t\x88\xa1\x130t\x89\xa3\xcb0t\x8a\xa6\xd50t\x8b\xa6!0t\xc0k\x980t\xc1\xb0\xfc0t\xc2" +
+	"\xad\x9d0té\xde0tĦ:0tţ\x820t\xc6u&0tȞ\xbd0tɡv" +
+	"0tʤ\x7f0t˧\x8d0u\x00Fe0u\x01\xc3\xf00u\x02Û0u\x03\xbfk0u" +
+	"\x04\xbb@0u\x05\xb8\x1d0u\x06\xb4\xed0u\b\xb460u\t\xb6\xd90u\n\xba\xae0u\v\xbe" +
+	"\x880u@c 0uA\xa7>0uB\xa7\x1d0uC\xa6\xb10uD\xa3\x820uE\xa1A0" +
+	"uF\x9e\xf30uHP\x820uI\x94f0uJ\x96\xfa0uK\x99\x910u\x80_\xf60u\x81" +
+	"\xa7\x160u\x82\xa6\xf50u\x83\xa5\xc90u\x84\xa6=0u\x85\xa3\xfc0u\x86\xa1\xae0u\x88\x94d" +
+	"0u\x89O\v0u\x8a\x92z0u\x8b\x95\x110u\xc0`\xdd0u\xc1\xa7K0u§*0u" +
+	"å\xff0uĥ\x910uŧ\x150uƤ\xc70uȗ\x030uɒ\x840u\xcaO" +
+	"G0u˕60v\x00a\xc40v\x01\xa7\x850v\x02\xa7d0v\x03\xa680v\x04\xa5\xca0" +
+	"v\x05\xa6l0v\x06\xa7\xe30v\b\x99\xa60v\t\x95'0v\n\x95@0v\vO\xae0v@" +
+	"F\v0vA\xae[0vB\xae$0vC\xac\xe10vD\xact0vE\xad!0vF\xad\x97" +
+	"0vHUA0vIO\xc30vJMz0vKK\x150vT\x80\x000vUS\x9f0v" +
+	"VN\x850vWL]0vXJ\x1c0v\x8d*\xd80v\x8eq\xaf0v\x8fq\xa90v\x90p" +
+	"\x920v\x91o\x980v\x92p*0v\x93o10v\x94J\xc90v\x95f\xe80v\x96c\xd00" +
+	"v\x97d\xb40v\x98e\x970v\x99Jt0v\u0380g0vϭI0vЬ\x000v\xd1" +
+	"\xabr0vү,0vӲ\b0v\xd4\xc5\x000vը\x810v֨Y0vר\x8c" +
+	"0vب\xc40vٯ\xa90w\x0f~\xf50w\x10\xa9\x810w\x11\xa8>0w\x12\xab\xd60w" +
+	"\x13\xae\xb20w\x14Ī0w\x15\xa8\\0w\x16\xa840w\x17\xa8h0w\x18\xa8\x9f0w\x19\xaf" +
+	"o0wP|N0wQ\xa4\x8b0wR\xa8#0wS\xaa\xff0wT\xc0\x860wU\xa7\xe60" +
+	"wV\xa7\x030wW\xa760wX\xa7n0wY\xae'0w\x91y\xeb0w\x92\xa4\x8a0w\x93" +
+	"\xa7f0w\x94\xbcg0w\x95\xa4\xc00w\x96\xa7i0w\x97\xa6\xc00w\x98\xa6\xf70w\x99\xad\xb0" +
+	"0w\xd2w\xde0wӤ\xb70wԹL0wբ\x840w֥-0wר20w" +
+	"ا\x8c0wٮO0x\x13v\v0x\x14\xb6$0x\x15\xa0<0x\x16\xa2\xe50x\x17\xa5" +
+	"\xe90x\x18\xa8\xf20x\x19\xae\xb90xTV\xb60xU\xb5q0xV\xb8\x030xW\xbb\xcf0" +
+	"xX\xbf\x9f0xY\x86a0x\x95Q\xf40x\x96\x96\x1f0x\x97\x98\xb00x\x98\x9bE0x\x99" +
+	"X90x\xd6P\x800xהM0xؖ\xe10x\xd9R\xd20y\x17P\xc40y\x18\x97\x05" +
+	"0y\x19P\x9a0yXQ30yYNH0y\x99\x1d\xa40\x83@*\x950\x83Ar\x1b0\x83" +
+	"Bq\xdf0\x83Cq\xdf0\x83Dp\xd00\x83Eqs0\x83Fp\x820\x83Gp\xbe0\x83Ic" +
+	"\xd50\x83Jd\xb10\x83Ke\x8d0\x83\x80p}0\x83\x81\x81k0\x83\x82\xacX0\x83\x83\xac60" +
+	"\x83\x84\xaa\xf60\x83\x85\xae\xa90\x83\x86\xb1\x9b0\x83\x87\xb1\x8b0\x83\x89\xa9\xf80\x83\x8a\xaa%0\x83\x8b" +
+	"\xaaS0\x83\xc0p<0\x83\xc1\xacR0\x83\xc2\x7f\xbf0\x83è\xc40\x83ħ\x840\x83ū6" +
+	"0\x83Ʈ)0\x83Ǳg0\x83ɨ\xfc0\x83ʩ)0\x83˩V0\x84\x00p70\x84" +
+	"\x01\xac00\x84\x02\xa8\xc00\x84\x03~|0\x84\x04\xa5\x030\x84\x05\xa7\xfd0\x84\x06\xaa\xcd0\x84\a\xae" +
+	"\v0\x84\t\xa8\xdd0\x84\n\xa9\n0\x84\v\xa980\x84@o\x1e0\x84A\xaa\xe60\x84B\xa7w0" +
+	"\x84C\xa4\xf60\x84D|\x040\x84E\xa480\x84F\xa7\b0\x84G\xaaF0\x84I\xa8k0\x84J" +
+	"\xa7\xda0\x84K\xa8\a0\x84\x80o\xb20\x84\x81\xae\x8d0\x84\x82\xab\x1d0\x84\x83\xa7\xe50\x84\x84\xa4*" +
+	"0\x84\x85y\xff0\x84\x86\xa4E0\x84\x87\xa7\x830\x84\x89\xa6\"0\x84\x8a\xa900\x84\x8b\xa8|0\x84" +
+	"\xc0n\xb60\x84\xc1\xb1u0\x84®\x050\x84ê\xaa0\x84Ħ\xef0\x84Ť80\x84\xc6x" +
+	"20\x84Ǥ\xb40\x84ɣ\xcd0\x84ʦ\xda0\x84˩\xe80\x85\x00n\xf10\x85\x01\xb1f0" +
+	"\x85\x02\xb1C0\x85\x03\xad\xe80\x85\x04\xaa-0\x85\x05\xa7v0\x85\x06\xa4\xb20\x85\aw\xce0\x85\t" +
+	"\xa1\x820\x85\n\xa4\x900\x85\v\xa7\x9e0\x85@I\xe50\x85AĬ0\x85B\xc3f0\x85C\xc3\x15" +
+	"0\x85D\xbe\xe90\x85E\xbb\xc60\x85F\xb8\x960\x85G\xb9H0\x85I\xb6\xf10\x85J\xba\xca0\x85" +
+	"K\xbe\xa40\x85\x80b\x1f0\x85\x81\xa9\xe70\x85\x82\xa8\xee0\x85\x83\xa8\xd10\x85\x84\xa8h0\x85\x85\xa6" +
+	"'0\x85\x86\xa3\xd90\x85\x87\xa1\x8b0\x85\x89P#0\x85\x8a\x95\xdc0\x85\x8b\x98s0\x85\xc0c\a0" +
+	"\x85\xc1\xaa!0\x85©'0\x85é\n0\x85ħ\xe30\x85ũD0\x85Ʀ\xf60\x85\xc7" +
+	"\xa4\xa80\x85ɕ\xe60\x85\xcaP`0\x85˘\x980\x86\x00c\xee0\x86\x01\xaaZ0\x86\x02\xa9a" +
+	"0\x86\x03\xa9D0\x86\x04\xa8\x1c0\x86\x05\xa8\x9c0\x86\x06\xaa\x130\x86\a\xa7\xc40\x86\t\x98\x890\x86" +
+	"\n\x98\xa20\x86\vP\xc70\x86@Ea0\x86A\xb6\x800\x86B\xb5T0\x86C\xb5\x1c0\x86D\xb3" +
+	"\xd90\x86E\xb4n0\x86F\xb5\t0\x86G\xb5\xcc0\x86IP#0\x86JM\xb10\x86KL\x1c0" +
+	"\x86U\x80\x000\x86VN\xe00\x86WL\x920\x86XK\x0e0\x86\x8d-\xdb0\x86\x8es\xfe0\x86\x8f" +
+	"s\xbe0\x86\x90s\xba0\x86\x91r\xa50\x86\x92s70\x86\x93r>0\x86\x94rw0\x86\x95N5" +
+	"0\x86\x96e\xe90\x86\x97f\xcc0\x86\x98g\xb00\x86\x99I\xc70\x86\u0382b0\x86ϭf0\x86" +
+	"ЭC0\x86Ѭ\x000\x86ү\x980\x86Ӳt0\x86Բd0\x86\xd5Ű0\x86֫" +
+	"\x150\x86\u05ebM0\x86ث\x840\x86ٷ\xa70\x87\x0f\x80\xb20\x87\x10\xa9\xdf0\x87\x11\xa8\x9c0" +
+	"\x87\x12\xac40\x87\x13\xaf\x100\x87\x14\xb2?0\x87\x15\xc4m0\x87\x16\xaa\x1c0\x87\x17\xaaT0\x87\x18" +
+	"\xaa\x8b0\x87\x19\xb6}0\x87P\x7fl0\x87Q\xa6#0\x87R\xa9\x060\x87S\xab\xc00\x87T\xae\xef" +
+	"0\x87U\xc4\x1d0\x87V\xa9\xfe0\x87W\xaa50\x87X\xaal0\x87Y\xb6E0\x87\x91|\xee0\x87" +
+	"\x92\xa5X0\x87\x93\xa8\x130\x87\x94\xabB0\x87\x95\xbf\xfe0\x87\x96\xa9\x8d0\x87\x97\xa9\t0\x87\x98\xa9" +
+	"A0\x87\x99\xb4\xfd0\x87\xd2z\xe20\x87ӥc0\x87Ԩ\x930\x87ռ\xe30\x87֧Q0" +
+	"\x87תY0\x87ة\xb40\x87ٵ\x850\x88\x13y\x0e0\x88\x14\xa5\xd70\x88\x15\xb9\xbb0\x88\x16" +
+	"\xa5\t0\x88\x17\xa8\x110\x88\x18\xab\x190\x88\x19\xb6\x130\x88Tx\xa80\x88U\xbak0\x88V\xa2\xc9" +
+	"0\x88W\xa5\xd10\x88X\xa8\xda0\x88Y\xb6\xd30\x88\x95X\xa60\x88\x96\xb7\xf40\x88\x97\xbb\xc40\x88" +
+	"\x98\xbf\x940\x88\x99\x86a0\x88\xd6Q|0\x88ח\x810\x88ؚ\x150\x88\xd9S\x000\x89\x17Q" +
+	"\xc00\x89\x18\x9a90\x89\x19P\x9e0\x89XR/0\x89YO\x190\x89\x99!\x040\x93@,20" +
+	"\x93Au\xba0\x93Bu~0\x93CuB0\x93DuB0\x93Eu\xc80\x93Ft\xd70\x93G" +
+	"u\x130\x93Hs\xba0\x93Jg\xea0\x93Kh\xc60\x93\x80tJ0\x93\x81\x84l0\x93\x82\xad\xaf" +
+	"0\x93\x83\xac\xb60\x93\x84\xac\x990\x93\x85\xafo0\x93\x86\xb2?0\x93\x87\xb2/0\x93\x88\xb3j0\x93" +
+	"\x8a\xad\xf60\x93\x8b\xae'0\x93\xc0t\t0\x93\xc1\xad\xaa0\x93\u0082\xc00\x93éD0\x93ĩ" +
+	"&0\x93ū\xfd0\x93Ʈ\xcd0\x93ǲ\n0\x93Ȳn0\x93ʬ\xfa0\x93˭+0" +
+	"\x94\x00s\xca0\x94\x01\xac\xb00\x94\x02\xa9@0\x94\x03\x81>0\x94\x04\xa5\xba0\x94\x05\xa8\x900\x94\x06" +
+	"\xab`0\x94\a\xae\x9e0\x94\b\xb2O0\x94\n\xac\x030\x94\v\xac50\x94@s\xc70\x94A\xac\x93" +
+	"0\x94B\xa9#0\x94C\xa5\xb80\x94D\x80$0\x94E\xa6\x140\x94F\xa8-0\x94G\xabj0\x94" +
+	"H\xae\xf90\x94J\xab\xea0\x94K\xac\x1c0\x94\x80tA0\x94\x81\xaf_0\x94\x82\xab\xef0\x94\x83\xa8" +
+	"\x840\x94\x84\xa6\a0\x94\x85~\x020\x94\x86\xa5U0\x94\x87\xa8\x930\x94\x88\xac\"0\x94\x8a\xacf0" +
+	"\x94\x8b\xab\xd90\x94\xc0sE0\x94\xc1\xb2$0\x94®\xb50\x94ëI0\x94Ĩ\x150\x94\xc5" +
+	"\xa5H0\x94\xc6|50\x94ǥ\xc30\x94ȩR0\x94ʪ\x100\x94˭\"0\x95\x00s\x7f" +
+	"0\x95\x01\xb2\x160\x95\x02\xb1\xf30\x95\x03\xae\x870\x95\x04\xabS0\x95\x05\xa8\x860\x95\x06\xa5\xc10\x95" +
+	"\a{\xd10\x95\b\xa7\xcb0\x95\n\xa7\xc60\x95\v\xaa\xd80\x95@q\xfe0\x95A\xb3)0\x95B\xb2" +
+	"/0\x95C\xb2\x100\x95D\xae\xba0\x95E\xab\xed0\x95F\xa9(0\x95G\xa7\x9e0\x95Hx\xb10" +
+	"\x95J\xa5P0\x95K\xa8b0\x95\x80I\xd20\x95\x81Ł0\x95\x82\xc4:0\x95\x83\xc2\xf80\x95\x84" +
+	"«0\x95\x85\xbf\x860\x95\x86\xbcV0\x95\x87\xbd\b0\x95\x88\xb9\x150\x95\x8a\xba\xd70\x95\x8b\xbe\xb5" +
+	"0\x95\xc0f80\x95\xc1\xad\xc60\x95¬\xcc0\x95ë\xd70\x95ī\xbe0\x95ŬC0\x95" +
+	"Ʃ\xf50\x95ǧ\xa70\x95ȥ]0\x95\xcaR\n0\x95˜\xe90\x96\x00g\x1f0\x96\x01\xae" +
+	"\x030\x96\x02\xad\n0\x96\x03\xac\x140\x96\x04\xab\xfb0\x96\x05\xab\xc10\x96\x06\xad\x160\x96\a\xaa\xc70" +
+	"\x96\b\xa8~0\x96\n\x9c\xf40\x96\vRq0\x96@J\x810\x96A\xbc\xda0\x96B\xbb\xab0\x96C" +
+	"\xba\x7f0\x96D\xbaF0\x96E\xba\x050\x96F\xba\x890\x96G\xbbH0\x96H\xba\xea0\x96JN2" +
+	"0\x96KLe0\x96V\x80\x000\x96WM\v0\x96XKR0\x96\x8d/P0\x96\x8ew\x820\x96" +
+	"\x8fwB0\x96\x90w\x040\x96\x91w\x010\x96\x92wx0\x96\x93v\x800\x96\x94v\xb90\x96\x95u" +
+	":0\x96\x96M\xeb0\x96\x97i\xcc0\x96\x98j\xb00\x96\x99N\x960\x96΅N0\x96Ϯ\xad0" +
+	"\x96Э\xb60\x96ѭ\x990\x96ҰZ0\x96ӳ\x140\x96Գ\x050\x96մ\x160\x96\xd6" +
+	"\xc6u0\x96\u05ee\xd70\x96د\x130\x96ٽ\xe10\x97\x0f\x83\x9e0\x97\x10\xaaR0\x97\x11\xaa5" +
+	"0\x97\x12\xac\xf60\x97\x13\xaf\xb00\x97\x14\xb2\xe00\x97\x15\xb3\x1d0\x97\x16\xc520\x97\x17\xad\xde0\x97" +
+	"\x18\xae\x1a0\x97\x19\xbc\xb40\x97P\x82\x1a0\x97Q\xa6\xd70\x97R\xa9\x980\x97S\xacR0\x97T\xaf" +
+	"\x820\x97U\xb2\xff0\x97V\xc3\xf50\x97W\xac\xeb0\x97X\xad'0\x97Y\xbb\x8b0\x97\x91\x80\xfd0" +
+	"\x97\x92\xa7%0\x97\x93\xa9*0\x97\x94\xacY0\x97\x95\xaf\xb40\x97\x96ê0\x97\x97\xac\xd20\x97\x98" +
+	"\xad\r0\x97\x99\xbbT0\x97\xd2~\xd60\x97Ӧf0\x97ԩ\x960\x97լ\xf10\x97\xd6\xc0\x8f" +
+	"0\x97\u05edK0\x97ج\xcb0\x97ٻ\n0\x98\x13}\x020\x98\x14\xa6\xda0\x98\x15\xaa50\x98" +
+	"\x16\xbdg0\x98\x17\xab\x030\x98\x18\xae\x0f0\x98\x19\xbb\x810\x98T|\x9c0\x98U\xa8\xb50\x98V\xbe" +
+	"\x170\x98W\xa8\xc30\x98X\xab\xcf0\x98Y\xbc?0\x98\x95ym0\x98\x96\xba\x0e0\x98\x97\xa6j0" +
+	"\x98\x98\xa9v0\x98\x99\xbb\xbb0\x98\xd6[\xcb0\x98\u05fb\x9f0\x98ؿs0\x98نa0\x99\x17" +
+	"S@0\x99\x18\x9eN0\x99\x19P\xdf0\x99XS\xaf0\x99YO!0\x99\x99%\x8b0\xa3@+%" +
+	"0\xa3At\xe90\xa3Bt\xad0\xa3Ctr0\xa3Dt60\xa3Eu\xca0\xa3Ft\xbc0\xa3" +
+	"Gt\xf80\xa3Hs\x9f0\xa3Ioj0\xa3Kg\xf10\xa3\x80sx0\xa3\x81\x83\x970\xa3\x82\xad" +
+	"w0\xa3\x83\xac~0\xa3\x84\xab\x880\xa3\x85\xaf\x810\xa3\x86\xb1\x980\xa3\x87\xb1\x880\xa3\x88\xb2\xa10" +
+	"\xa3\x89\xb5\x1f0\xa3\x8b\xad\xef0\xa3\xc0s70\xa3\xc1\xadr0\xa3\u0081\xec0\xa3é\v0\xa3\xc4" +
+	"\xa8\x160\xa3Ŭ\x0f0\xa3Ʈ&0\xa3Ǳc0\xa3ȱ\xa50\xa3ɴ#0\xa3ˬ\xf3" +
+	"0\xa4\x00r\xf80\xa4\x01\xacx0\xa4\x02\xa9\b0\xa4\x03\x80i0\xa4\x04\xa4\xa90\xa4\x05\xa8\xa30\xa4" +
+	"\x06\xaa\xb90\xa4\a\xad\xf70\xa4\b\xb1\x860\xa4\t\xb3,0\xa4\v\xab\xfd0\xa4@r\xba0\xa4A\xab" +
+	"\x830\xa4B\xa8\x130\xa4C\xa4\xa80\xa4D\x7f\x0e0\xa4E\xa5<0\xa4F\xa7R0\xa4G\xaa\x900" +
+	"\xa4H\xae\x1f0\xa4I\xb3\x130\xa4K\xab\f0\xa4\x80tK0\xa4\x81\xaf{0\xa4\x82\xac\f0\xa4\x83" +
+	"\xa8\xa00\xa4\x84\xa590\xa4\x85~K0\xa4\x86\xa5\xc40\xa4\x87\xa9\x020\xa4\x88\xab\xd90\xa4\x89\xb0\xaa" +
+	"0\xa4\x8b\xab\xe00\xa4\xc0s30\xa4\xc1\xb1\x890\xa4®\x190\xa4ê\xae0\xa4ħF0\xa4" +
+	"ť\xb80\xa4\xc6|b0\xa4Ǧ\x1d0\xa4Ȩ\xf40\xa4ɭ\xc60\xa4ˬO0\xa5\x00s" +
+	"n0\xa5\x01\xb1z0\xa5\x02\xb1W0\xa5\x03\xad\xec0\xa5\x04\xaa\x840\xa5\x05\xa8\xf60\xa5\x06\xa6\x1b0" +
+	"\xa5\a{\xfe0\xa5\b\xa7m0\xa5\t\xab|0\xa5\v\xaa\x050\xa5@q\xec0\xa5A\xb2k0\xa5B" +
+	"\xb1q0\xa5C\xb1S0\xa5D\xad\xeb0\xa5E\xab\xa50\xa5F\xa8\xca0\xa5G\xa7@0\xa5Hx\xde" +
+	"0\xa5I\xa9\x000\xa5K\xa7\x8f0\xa5\x80mx0\xa5\x81\xb4\xb50\xa5\x82\xb3\xbb0\xa5\x83\xb2\xc60\xa5" +
+	"\x84\xb2\xab0\xa5\x85\xb0B0\xa5\x86\xadh0\xa5\x87\xab\x190\xa5\x88\xa8\xca0\xa5\x89v\x030\xa5\x8b\xa4" +
+	"?0\xa5\xc0Ih0\xa5\xc1\xc5\xe90\xa5\xc2ģ0\xa5\xc3\xc3`0\xa5\xc4\xc2\"0\xa5\xc5\xc2\xdd0" +
+	"\xa5ƿ\xab0\xa5\xc7\xc0]0\xa5ȼj0\xa5ɻ00\xa5˿\x1e0\xa6\x00fI0\xa6\x01" +
+	"\xad\xcb0\xa6\x02\xac\xd10\xa6\x03\xab\xdc0\xa6\x04\xaa\xeb0\xa6\x05\xab\xbf0\xa6\x06\xac70\xa6\a\xa9\xe9" +
+	"0\xa6\b\xa7\xa00\xa6\t\xa4\x890\xa6\vQ\x810\xa6@I\xbc0\xa6A\xbf\xe90\xa6B\xbe\xb80\xa6" +
+	"C\xbd\x890\xa6D\xbc[0\xa6E\xbd&0\xa6F\xbc\xd30\xa6G\xbd\x900\xa6H\xbd[0\xa6I\xbc" +
+	"\xdb0\xa6KK\x9b0\xa6W\x80\x000\xa6XJ\x9e0\xa6\x8d.K0\xa6\x8ev\xb60\xa6\x8fvv0" +
+	"\xa6\x90v80\xa6\x91u\xfc0\xa6\x92w\x850\xa6\x93vr0\xa6\x94v\xab0\xa6\x95u-0\xa6\x96" +
+	"p\xe60\xa6\x97M\x850\xa6\x98i\xe00\xa6\x99M\xd60\xa6΄~0\xa6Ϯw0\xa6Э\x80" +
+	"0\xa6Ѭ\x8f0\xa6Ұw0\xa6Ӳ|0\xa6Բl0\xa6ճ\\0\xa6ֵ\x9e0\xa6" +
+	"\xd7\xc6\xdd0\xa6خ\xdd0\xa6\xd9\xc0\xe90\xa7\x0f\x82\xce0\xa7\x10\xaa\x1c0\xa7\x11\xa9+0\xa7\x12\xad" +
+	"\x130\xa7\x13\xaf\x180\xa7\x14\xb2G0\xa7\x15\xb2c0\xa7\x16\xb4\xa50\xa7\x17Ś0\xa7\x18\xad\xe40" +
+	"\xa7\x19\xbf\xba0\xa7P\x81J0\xa7Q\xa5\xcd0\xa7R\xa9\xb50\xa7S\xab\xba0\xa7T\xae\xe90\xa7U" +
+	"\xb2D0\xa7V\xb3\xb20\xa7W\xc4]0\xa7X\xac\xf10\xa7Y\xbe\x8f0\xa7\x91\x7f\xef0\xa7\x92\xa6\\" +
+	"0\xa7\x93\xa8a0\xa7\x94\xab\x910\xa7\x95\xae\xec0\xa7\x96\xb3\x990\xa7\x97\xc3%0\xa7\x98\xac\x030\xa7" +
+	"\x99\xbdg0\xa7\xd2\x7f(0\xa7Ӧ\xd80\xa7Ԫ\a0\xa7լ\xad0\xa7ֱ80\xa7\xd7\xc3" +
+	"\xde0\xa7ج\xd40\xa7پ-0\xa8\x13}:0\xa8\x14\xa780\xa8\x15\xa9\xdd0\xa8\x16\xaei0" +
+	"\xa8\x17\xc0\xb60\xa8\x18\xadA0\xa8\x19\xbd\xd30\xa8T|\xd40\xa8U\xa8]0\xa8V\xac)0\xa8W" +
+	"\xc1f0\xa8X\xab\x010\xa8Y\xbe\x8e0\xa8\x95y\xa50\xa8\x96\xa9\xca0\xa8\x97\xbd\\0\xa8\x98\xa8\xa8" +
+	"0\xa8\x99\xbe40\xa8\xd6v\xad0\xa8\u05fb\xf50\xa8إ{0\xa8ٽ\x830\xa9\x17[\x0f0\xa9" +
+	"\x18\xbf\xdb0\xa9\x19\x86a0\xa9XR\xc40\xa9YN]0\xa9\x99$\x860\xb3@*\x190\xb3At" +
+	"\x150\xb3Bs\xd90\xb3Cs\x9d0\xb3Dsa0\xb3Et\xba0\xb3Ft\xba0\xb3Gt\xf60" +
+	"\xb3Hs\x800\xb3IoK0\xb3Jo\x860\xb3\x80r\xa20\xb3\x81\x82\xc20\xb3\x82\xad?0\xb3\x83" +
+	"\xacE0\xb3\x84\xabP0\xb3\x85\xaeq0\xb3\x86\xb1\xaa0\xb3\x87\xb1\x9a0\xb3\x88\xb1\xf90\xb3\x89\xb4U" +
+	"0\xb3\x8a\xb5-0\xb3\xc0r`0\xb3\xc1\xad:0\xb3\u0081\x170\xb3è\xd30\xb3ħ\xde0\xb3" +
+	"Ū\xfe0\xb3Ʈ80\xb3Ǳu0\xb3Ȱ\xfd0\xb3ɳY0\xb3ʴ10\xb4\x00r" +
+	"!0\xb4\x01\xac@0\xb4\x02\xa8\xd00\xb4\x03\x7f\x940\xb4\x04\xa4q0\xb4\x05\xa7\x920\xb4\x06\xaa\xcb0" +
+	"\xb4\a\xae\t0\xb4\b\xb0\xdf0\xb4\t\xb2c0\xb4\n\xb3;0\xb4@q\xe40\xb4A\xabK0\xb4B" +
+	"\xa7\xdb0\xb4C\xa4p0\xb4D~:0\xb4E\xa4+0\xb4F\xa7e0\xb4G\xaa\xa20\xb4H\xadx" +
+	"0\xb4I\xb2I0\xb4J\xb2J0\xb4\x80s90\xb4\x81\xael0\xb4\x82\xaa\xfc0\xb4\x83\xa7\x900\xb4" +
+	"\x84\xa4)0\xb4\x85}60\xb4\x86\xa4\xeb0\xb4\x87\xa8)0\xb4\x88\xaa\xfe0\xb4\x89\xaf\xd00\xb4\x8a\xb3" +
+	"\x1e0\xb4\xc0s90\xb4\xc1\xb1\xa50\xb4®60\xb4ê\xca0\xb4ħc0\xb4Ť\xe90" +
+	"\xb4\xc6|\xab0\xb4Ǩ\xf00\xb4ȩc0\xb4ɭ[0\xb4ʰ\xa90\xb5\x00st0\xb5\x01" +
+	"\xb1\x970\xb5\x02\xb1t0\xb5\x03\xae\b0\xb5\x04\xaa\xa10\xb5\x05\xa8'0\xb5\x06\xa8\xee0\xb5\a|H" +
+	"0\xb5\b\xa7\xdc0\xb5\t\xab\x100\xb5\n\xae^0\xb5@q\xd70\xb5A\xb1\xd00\xb5B\xb0\xd60\xb5" +
+	"C\xb0\xb70\xb5D\xadP0\xb5E\xaa\xd60\xb5F\xa9:0\xb5G\xa7\xb00\xb5Hy\v0\xb5I\xa8" +
+	"\x7f0\xb5J\xab\xce0\xb5\x80mc0\xb5\x81\xb3\xf70\xb5\x82\xb2\xfd0\xb5\x83\xb2\b0\xb5\x84\xb1\xed0" +
+	"\xb5\x85\xafs0\xb5\x86\xac\xfd0\xb5\x87\xaa\xaf0\xb5\x88\xa8I0\xb5\x89v00\xb5\x8a\xa9\x060\xb5\xc0" +
+	"m\x9d0\xb5\xc1\xb4\xce0\xb5³\xd50\xb5ò\xdf0\xb5ı\xee0\xb5Ų\xc10\xb5ưK" +
+	"0\xb5ǭ\xfc0\xb5ȫ\x970\xb5ɩ\x050\xb5\xcavA0\xb6\x00H\xfe0\xb6\x01\xc6R0\xb6" +
+	"\x02\xc5\f0\xb6\x03\xc3\xc90\xb6\x04\u008b0\xb6\x05\xc2T0\xb6\x06\xc3\x020\xb6\aô0\xb6\b\xbf" +
+	"\xbf0\xb6\t\xbe\x850\xb6\n\xbfO0\xb6@H\xf70\xb6A\xc2B0\xb6B\xc1\x0e0\xb6C\xbf\xdc0" +
+	"\xb6D\xbe\xac0\xb6E\xbe\x820\xb6F\xbf:0\xb6G\xbf\xf40\xb6H\xbe\xed0\xb6I\xbe\xa70\xb6J" +
+	"\xbfq0\xb6X\x80\x000\xb6\x8d-F0\xb6\x8eu\xe60\xb6\x8fu\xa60\xb6\x90uh0\xb6\x91u," +
+	"0\xb6\x92v{0\xb6\x93v{0\xb6\x94v\xb40\xb6\x95u\x1b0\xb6\x96p\xd40\xb6\x97q\r0\xb6" +
+	"\x98M\x1e0\xb6\x99M\x170\xb6\u0383\xae0\xb6ϮB0\xb6ЭK0\xb6ѬZ0\xb6ү" +
+	"m0\xb6Ӳ\x980\xb6Բ\x890\xb6ղ\xc40\xb6ִ\xe40\xb6\u05f5\xb80\xb6\xd8\xc7E0" +
+	"\xb6\xd9\xc3=0\xb7\x0f\x81\xfe0\xb7\x10\xa9\xe70\xb7\x11\xa8\xf60\xb7\x12\xac\t0\xb7\x13\xaf40\xb7\x14" +
+	"\xb2d0\xb7\x15\xb1\xcb0\xb7\x16\xb3\xeb0\xb7\x17\xb4\xbf0\xb7\x18\xc6\x020\xb7\x19\xc2\v0\xb7P\x80z" +
+	"0\xb7Q\xa5\x970\xb7R\xa8\xab0\xb7S\xab\xd60\xb7T\xaf\x060\xb7U\xb1\xac0\xb7V\xb2\xf80\xb7" +
+	"W\xb3\xcc0\xb7X\xc4\xc50\xb7Y\xc0\xdd0\xb7\x91\x7f\x1f0\xb7\x92\xa5R0\xb7\x93\xa8~0\xb7\x94\xab" +
+	"\xad0\xb7\x95\xaeS0\xb7\x96\xb2\xdf0\xb7\x97\xb2\xde0\xb7\x98Í0\xb7\x99\xbf\xb30\xb7\xd2~\x1a0" +
+	"\xb7Ӧ\x0f0\xb7ԩ?0\xb7ի\xe50\xb7ְp0\xb7׳\xaf0\xb7\xd8\xc3X0\xb7\xd9" +
+	"\xbf\x890\xb8\x13}\x8d0\xb8\x14\xaa\x030\xb8\x15\xaaO0\xb8\x16\xae\x030\xb8\x17\xb1B0\xb8\x18\xc4\x05" +
+	"0\xb8\x19\xc0?0\xb8T}'0\xb8U\xa8\xcf0\xb8V\xab\xc30\xb8W\xaf\x020\xb8Xĵ0\xb8" +
+	"Y\xc0\xf70\xb8\x95y\xdd0\xb8\x96\xa9P0\xb8\x97\xac\x8f0\xb8\x98\xc0\xab0\xb8\x99\xbf\xd00\xb8\xd6v" +
+	"\xe50\xb8ש\xe40\xb8ؿD0\xb8ٿX0\xb9\x17v\xf40\xb9\x18\xc0\f0\xb9\x19\xc0 0" +
+	"\xb9XZw0\xb9Y\x86a0\xb9\x99#\x804\x03\x81\xd4/4\x03\x82\xe124\x03\x83\xe0\x0e4\x03\x84" +
+	"\xde\xe94\x03\x85ߔ4\x03\x86\xe3\xc74\x03\x87\xe2\xa44\x03\x88\xe3\xc84\x03\x89\xe394\x03\x8a\xe4]" +
+	"4\x03\x8b\xe5\x814\x03\x8c\xe6\xa54\x03\xc2\xd3\v4\x03\xc3\xdb~4\x03\xc4\xdaY4\x03\xc5\xdb\x044\x03" +
+	"\xc6\xdf74\x03\xc7\xe2\xa24\x03\xc8\xe2\xa34\x03\xc9\xe2\x154\x03\xca\xe384\x03\xcb\xe4\\4\x03\xcc\xe5" +
+	"\x804\x04\x03\xd1\xe84\x04\x04\xd5\xc94\x04\x05\xd6t4\x04\x06ڧ4\x04\a\xde\x124\x04\b\xe2\xa14" +
+	"\x04\t\xe0\xf04\x04\n\xe2\x144\x04\v\xe374\x04\f\xe4[4\x04D\xd0\xc54\x04E\xd1\xe44\x04F" +
+	"\xd6\x174\x04Gق4\x04H\xde\x114\x04I\xe0\xee4\x04J\xe0\xef4\x04K\xe2\x134\x04L\xe37" +
+	"4\x04\x85Ϧ4\x04\x86\xd1\xf44\x04\x87\xd5_4\x04\x88\xd9\xee4\x04\x89\xdc\xcb4\x04\x8a\xe1Z4\x04" +
+	"\x8b\xe1[4\x04\x8c\xe2\x7f4\x04\xc6϶4\x04\xc7\xd1\xfc4\x04\xc8\u058c4\x04\xc9\xd9h4\x04\xca\xdd" +
+	"\xf74\x04\xcb\xe2\x864\x04\xcc\xe2\x884\x05\aϪ4\x05\b\xd3 4\x05\t\xd5\xfd4\x05\nڌ4" +
+	"\x05\v\xdf\x1b4\x05\f\xe3\xaa4\x05Hе4\x05IҰ4\x05J\xd7?4\x05K\xdb\xce4\x05L" +
+	"\xe0]4\x05\x89\xd0k4\x05\x8a՚4\x05\x8b\xda)4\x05\x8c\u07b84\x05\xca\xd1v4\x05\xcb\xdbM" +
+	"4\x05\xcc\xdf\xdc4\x06\vҁ4\x06\f\xe1\x004\x06Lӌ4\x06\x81\xef`4\x06\x82\xee?4\x06" +
+	"\x83\xed\x1f4\x06\x84\xeb\xfe4\x06\x85\xf0F4\x06\x86\xef\xb94\x06\x87\xef\xb84\x06\x88\xf0\xd84\x06\x89\xeb" +
+	"\xe94\x06\x8a\xed\t4\x06\x8b\xee(4\x06\x8c\xefH4\x06\xc1\xd2y4\x06\xc2\xdfF4\x06\xc3\xde%4" +
+	"\x06\xc4\xdd\x054\x06\xc5ݩ4\x06\xc6\xe1\xce4\x06\xc7\xe0\xaf4\x06\xc8\xe1\xce4\x06\xc9\xe1C4\x06\xca" +
+	"\xe2c4\x06\xcb\xe3\x824\x06\xcc\xe4\xa24\a\x01\xdfA4\a\x02\xd1U4\a\x03٢4\a\x04\u0602" +
+	"4\a\x05\xd9%4\a\x06\xddJ4\a\a\xe0\xa84\a\b\xe0\xa94\a\t\xe0\x1e4\a\n\xe1>4\a" +
+	"\v\xe2]4\a\f\xe3}4\aA\xde\x1f4\aB٠4\aC\xd034\aD\xd4\x014\aE\xd4" +
+	"\xa44\aF\xd8\xc94\aG\xdc'4\aH\xe0\xa54\aI\xde\xfb4\aJ\xe0\x1b4\aK\xe1:4" +
+	"\aL\xe2Z4\a\x81\xdc\xfd4\a\x82\xd8\x7f4\a\x83\xd4\x004\a\x84\xcf\x124\a\x85\xd0%4\a\x86" +
+	"\xd4J4\a\x87ק4\a\x88\xdc%4\a\x89\xde\xf84\a\x8a\xde\xfa4\a\x8b\xe0\x194\a\x8c\xe19" +
+	"4\a\xc1ݢ4\a\xc2\xd9#4\a\xc3Ԥ4\a\xc4\xd0%4\a\xc5\xcd\xf84\a\xc6\xd064\a" +
+	"\xc7ӓ4\a\xc8\xd8\x114\a\xc9\xda\xe44\a\xca\xdfb4\a\xcb\xdfc4\a\xcc\xe0\x834\b\x01\xe1" +
+	"\xc64\b\x02\xddG4\b\x03\xd8\xc84\b\x04\xd4I4\b\x05\xd054\b\x06\xce\a4\b\a\xd0=4" +
+	"\b\bԻ4\b\t\u05ce4\b\n\xdc\f4\b\v\xe0\x8a4\b\f\xe0\x8b4\bA\xe0\xa74\bB" +
+	"\xe0\xa54\bC\xdc&4\bDק4\bEӓ4\bF\xd0=4\bG\xcd\xfb4\bH\xd1\\" +
+	"4\bI\xd4/4\bJح4\bK\xdd+4\bL\xe1\xa94\b\x81\xe1\xc54\b\x82\xe0\xa44\b" +
+	"\x83\xe0\xa24\b\x84\xdc#4\b\x85\xd8\x0f4\b\x86Թ4\b\x87\xd1Z4\b\x88\xcf\x004\b\x89\xd0" +
+	"\xeb4\b\x8a\xd5i4\b\x8b\xd9\xe74\b\x8c\xdee4\b\xc1\xe194\b\xc2\xe0\x194\b\xc3\xde\xf84" +
+	"\b\xc4\xde\xf64\b\xc5\xda\xe24\b\xc6\u05cc4\b\xc7\xd4-4\b\xc8\xd0\xeb4\b\xc9η4\b\xca" +
+	"\xd3\xcf4\b\xcb\xd8M4\b\xcc\xdc\xcb4\t\x01\xe2W4\t\x02\xe174\t\x03\xe0\x164\t\x04\xde\xf6" +
+	"4\t\x05\xdf^4\t\x06\xdc\b4\t\aت4\t\b\xd5g4\t\t\xd3\xce4\t\nϼ4\t" +
+	"\v\xd9k4\t\f\xdd\xe94\tA\xe3u4\tB\xe2T4\tC\xe144\tD\xe0\x144\tE\xdf" +
+	"]4\tF\xe0\x854\tG\xdd&4\tH\xd9\xe44\tI\xd8J4\tJ\xd9i4\tK\xd0\xc24" +
+	"\tL\xdf\a4\t\x81\xe4\x934\t\x82\xe3r4\t\x83\xe2R4\t\x84\xe124\t\x85\xe0{4\t\x86" +
+	"\xe0\x844\t\x87\xe1\xa24\t\x88\xde`4\t\x89\xdc\xc64\t\x8a\xdd\xe64\t\x8b\xdf\x054\t\x8c\xd1\xc7" +
+	"4\tڀ\x004\t\xdb\xed#4\t\xdc\xec\x034\t\xdd\xea\xe44\t\xde\xe9\xc74\t\xdf\xed\xff4\t" +
+	"\xe0\xedt4\t\xe1\xeds4\t\xe2\xee\x8c4\t\xe3\xe9\xb04\t\xe4\xea\xca4\t\xe5\xeb\xe34\t\xe6\xec" +
+	"\xfd4\n\x1b\xd0\xc34\n\x1c\xddU4\n\x1d\xdc64\n\x1e\xdb\x1a4\n\x1f۷4\n \xdf\xcc4" +
+	"\n!\u07b24\n\"\xdf\xcb4\n#\xdfC4\n$\xe0\\4\n%\xe1v4\n&\xe2\x8f4\n\\" +
+	"Ϟ4\n]\xd7\xc44\n^֨4\n_\xd7E4\n`\xdbZ4\naޫ4\nbު" +
+	"4\nc\xde\"4\nd\xdf<4\ne\xe0U4\nf\xe1o4\n\x9d\xce}4\n\x9e\xd284\n" +
+	"\x9f\xd2\xd54\n\xa0\xd6\xea4\n\xa1\xda;4\n\xa2ަ4\n\xa3\xdd\x044\n\xa4\xde\x1d4\n\xa5\xdf" +
+	"74\n\xa6\xe0P4\n\xde\xcd`4\n\xdf\xcef4\n\xe0\xd2|4\n\xe1\xd5\xcd4\n\xe2\xda84" +
+	"\n\xe3\xdd\x014\n\xe4\xdd\x004\n\xe5\xde\x1a4\n\xe6\xdf44\v\x1f\xccJ4\v \xcev4\v!" +
+	"\xd1\xc74\v\"\xd624\v#\xd8\xfb4\v$\xddf4\v%\xddf4\v&\xde\x7f4\v`\xccX" +
+	"4\va\xce~4\vb\xd2\xe94\vcղ4\vd\xda\x1d4\veވ4\vfވ4\v" +
+	"\xa1\xccK4\v\xa2ϗ4\v\xa3\xd2`4\v\xa4\xd6\xcb4\v\xa5\xdb64\v\xa6ߡ4\v\xe2\xcd" +
+	"K4\v\xe3\xcf&4\v\xe4Ӓ4\v\xe5\xd7\xfd4\v\xe6\xdch4\f#\xcd\x034\f$\xd2\x034" +
+	"\f%\xd6n4\f&\xda\xd94\fd\xce\x034\fe\u05c84\ff\xdb\xf34\f\xa5\xcf\x034\f\xa6" +
+	"\xdd\f4\f\xe6\xd0\x034\x13\x80\x80\x004\x13\x82\xbf\xe74\x13\x83\xbe\xbc4\x13\x84\xbd\x924\x13\x85\xbc\xc8" +
+	"4\x13\x86\xc0\xc24\x13\x87\xc0<4\x13\x88\xc114\x13\x89\xc0\xe04\x13\x8a\xc1\xde4\x13\x8b\xc2\xdf4\x13" +
+	"\x8c\xc3\xe54\x13\xc0x74\x13\xc2OK4\x13Ô\xe84\x13ē\xdb4\x13ŕ\x844\x13Ɨ" +
+	"\xd64\x13Ǜ<4\x13ț,4\x13ɚ\xbf4\x13ʛ\xa04\x13˜\x854\x13̝n4" +
+	"\x14\x00wV4\x14\x02\x94\xea4\x14\x03Mh4\x14\x04\x90\\4\x14\x05\x92\t4\x14\x06\x94[4\x14\a" +
+	"\x97\xc24\x14\b\x9b\r4\x14\t\x99\xb64\x14\n\x9a\x974\x14\v\x9b|4\x14\f\x9cf4\x14@p\x06" +
+	"4\x14B\x93\xde4\x14C\x90]4\x14DK\xb74\x14E\x8e\x8e4\x14F\x90\xe04\x14G\x94G4\x14" +
+	"H\x97\x924\x14I\x99\x984\x14J\x99\x8f4\x14K\x9at4\x14L\x9b]4\x14\x80i\x9d4\x14\x82\x95" +
+	"\x854\x14\x83\x92\b4\x14\x84\x8e\x8c4\x14\x85L\x1e4\x14\x86\x8a\"4\x14\x87\x8e?4\x14\x88\x91\x8b4" +
+	"\x14\x89\x93\x904\x14\x8a\x96\xe44\x14\x8b\x96\xe04\x14\x8c\x97\xc94\x14\xc0_\x8a4\x14\u0097\xd64\x14\xc3" +
+	"\x94Y4\x14Đ\xdd4\x14Ŋ#4\x14\xc6K\xb74\x14ǋ\xb14\x14Ȏ\xfc4\x14ɑ\x02" +
+	"4\x14ʔU4\x14˗\xad4\x14̗\xad4\x15\x00WM4\x15\x02\x9b;4\x15\x03\x97\xbd4\x15" +
+	"\x04\x94B4\x15\x05\x8e?4\x15\x06\x8b\xaf4\x15\aK\xc94\x15\b\x8c\xb74\x15\t\x8e\xbc4\x15\n\x92" +
+	"\x104\x15\v\x95h4\x15\f\x98\xc34\x15@PX4\x15B\x9b,4\x15C\x9b\n4\x15D\x97\x8f4" +
+	"\x15E\x91\x8c4\x15F\x8e\xfc4\x15G\x8c\xb84\x15HK*4\x15I\x8c/4\x15J\x8f\x834\x15K" +
+	"\x92\xdb4\x15L\x9674\x15\x80I\x164\x15\x82\x9a\xbf4\x15\x83\x99\xb44\x15\x84\x99\x954\x15\x85\x93\x91" +
+	"4\x15\x86\x91\x024\x15\x87\x8e\xbe4\x15\x88\x8c04\x15\x89I\x134\x15\x8a\x8b\x974\x15\x8b\x8e\xef4\x15" +
+	"\x8c\x92K4\x15\xc0E\x894\x15\u009b\xa14\x15Ú\x974\x15ę\x8e4\x15Ŗ\xe74\x15Ɣ" +
+	"W4\x15ǒ\x134\x15ȏ\x854\x15ɋ\x994\x15\xcaH\xd54\x15ˏ\xd64\x15̓24" +
+	"\x16\x00C\a4\x16\x02\x9c\x884\x16\x03\x9b}4\x16\x04\x9at4\x16\x05\x96\xe44\x16\x06\x97\xb14\x16\a" +
+	"\x95m4\x16\b\x92\xde4\x16\t\x8e\xf24\x16\n\x8f\xd74\x16\vH\xc74\x16\f\x94\x1c4\x16@A\x9f" +
+	"4\x16B\x9ds4\x16C\x9ch4\x16D\x9b_4\x16E\x97\xcf4\x16F\x97\xb24\x16G\x98\xca4\x16" +
+	"H\x96<4\x16I\x92P4\x16J\x9354\x16K\x94\x1e4\x16LH\xe94\x16\x82w\x8b4\x16\x83v" +
+	"\xaa4\x16\x84ot4\x16\x85i&4\x16\x86_64\x16\x87W\x164\x16\x88P44\x16\x89I\a4" +
+	"\x16\x8aE\x804\x16\x8bC\x024\x16\x8cA\x9c4\x16\x8e\x80\xe14\x16\x8fyM4\x16\x90xo4\x16\x91" +
+	"q:4\x16\x92j\xec4\x16\x93`\xfd4\x16\x94X\xdc4\x16\x95Q\xfd4\x16\x96J\xcf4\x16\x97GK" +
+	"4\x16\x98D\xcf4\x16\x99Ck4\x16¾F4\x16ý\x1e4\x16Ļ\xf84\x16Ż24\x16" +
+	"ƿ\x1e4\x16Ǿ\x994\x16ȿ\x8a4\x16ɿ:4\x16\xca\xc044\x16\xcb\xc114\x16\xcc\xc2" +
+	"34\x16\xceW\xa44\x16Ͽ\xe84\x16о\xc24\x16ѽ\x9d4\x16Ҽ\xd24\x16\xd3\xc0\xbf4" +
+	"\x16\xd4\xc094\x16\xd5\xc1+4\x16\xd6\xc0\xdc4\x16\xd7\xc1\xd74\x16\xd8\xc2\xd64\x16\xd9\xc3\xd94\x17\x00" +
+	"wj4\x17\x02N\xde4\x17\x03\x93\xef4\x17\x04\x92\xe54\x17\x05\x94\x864\x17\x06\x96\xcd4\x17\a\x9a(" +
+	"4\x17\b\x9a\x174\x17\t\x99\xab4\x17\n\x9a\x894\x17\v\x9bk4\x17\f\x9cQ4\x17\x0e\xbf\xe64\x17" +
+	"\x0fP\xa84\x17\x10\x95\x994\x17\x11\x94\x8f4\x17\x12\x96/4\x17\x13\x98w4\x17\x14\x9b\xcf4\x17\x15\x9b" +
+	"\xc04\x17\x16\x9bU4\x17\x17\x9c44\x17\x18\x9d\x184\x17\x19\x9d\xff4\x17@v\x894\x17B\x93\xef4" +
+	"\x17CL\xfa4\x17D\x8fo4\x17E\x91\x154\x17F\x93\\4\x17G\x96\xb64\x17H\x99\xf64\x17I" +
+	"\x98\xa44\x17J\x99\x814\x17K\x9ac4\x17L\x9bI4\x17N\xbe\xbe4\x17O\x95\x964\x17PN\xc6" +
+	"4\x17Q\x91\x1a4\x17R\x92\xbd4\x17S\x95\x054\x17T\x98^4\x17U\x9b\x9f4\x17V\x9aM4\x17" +
+	"W\x9b-4\x17X\x9c\x104\x17Y\x9c\xf84\x17\x80oT4\x17\x82\x92\xe54\x17\x83\x8fo4\x17\x84K" +
+	"I4\x17\x85\x8d\xa54\x17\x86\x8f\xec4\x17\x87\x93F4\x17\x88\x96\x864\x17\x89\x98\x844\x17\x8a\x98|4" +
+	"\x17\x8b\x99^4\x17\x8c\x9aD4\x17\x8e\xbd\x984\x17\x8f\x94\x8c4\x17\x90\x91\x194\x17\x91M\x164\x17\x92" +
+	"\x8fN4\x17\x93\x91\x964\x17\x94\x94\xee4\x17\x95\x98/4\x17\x96\x9a.4\x17\x97\x9a'4\x17\x98\x9b\n" +
+	"4\x17\x99\x9b\xf24\x17\xc0i\x014\x17\u0094\x854\x17Ñ\x134\x17č\xa34\x17\xc5K\xb04\x17" +
+	"ƉG4\x17ǍV4\x17Ȑ\x954\x17ɒ\x944\x17ʕ\xdc4\x17˕\xd84\x17̖" +
+	"\xbe4\x17μ\xcd4\x17ϖ,4\x17В\xbd4\x17яN4\x17\xd2M|4\x17ӊ\xf24" +
+	"\x17Ԏ\xfe4\x17Ւ?4\x17֔>4\x17ח\x874\x17ؗ\x844\x17٘l4\x18\x00" +
+	"_\x124\x18\x02\x96\xca4\x18\x03\x93X4\x18\x04\x8f\xe84\x18\x05\x89G4\x18\x06KE4\x18\a\x8a\xce" +
+	"4\x18\b\x8e\x0e4\x18\t\x90\f4\x18\n\x93T4\x18\v\x96\xa04\x18\f\x96\xa04\x18\x0e\xc0\xb84\x18" +
+	"\x0f\x98s4\x18\x10\x95\x044\x18\x11\x91\x954\x18\x12\x8a\xf14\x18\x13M\x114\x18\x14\x8cw4\x18\x15\x8f" +
+	"\xb84\x18\x16\x91\xb74\x18\x17\x95\x004\x18\x18\x98M4\x18\x19\x98O4\x18@V\xf14\x18B\x9a\"4" +
+	"\x18C\x96\xb04\x18D\x93@4\x18E\x8dT4\x18F\x8a\xcc4\x18GKR4\x18H\x8b\xcf4\x18I" +
+	"\x8d\xce4\x18J\x91\x164\x18K\x94b4\x18L\x97\xb24\x18N\xc024\x18O\x9b\xcb4\x18P\x98\\" +
+	"4\x18Q\x94\xed4\x18R\x8e\xfd4\x18S\x8cw4\x18TM\x1d4\x18U\x8dz4\x18V\x8fy4\x18" +
+	"W\x92\xc24\x18X\x96\x0f4\x18Y\x99a4\x18\x80P\x104\x18\x82\x9a\x114\x18\x83\x99\xf04\x18\x84\x96" +
+	"\x804\x18\x85\x90\x944\x18\x86\x8e\f4\x18\x87\x8b\xcf4\x18\x88J\xb24\x18\x89\x8bG4\x18\x8a\x8e\x8f4" +
+	"\x18\x8b\x91\xdb4\x18\x8c\x95+4\x18\x8e\xc1#4\x18\x8f\x9b\xbb4\x18\x90\x9b\x9c4\x18\x91\x98-4\x18\x92" +
+	"\x92=4\x18\x93\x8f\xb64\x18\x94\x8dx4\x18\x95L~4\x18\x96\x8c\xf24\x18\x97\x90;4\x18\x98\x93\x89" +
+	"4\x18\x99\x96\xda4\x18\xc0H\xe34\x18\u0099\xa64\x18Ø\x9f4\x18Ę\x7f4\x18Œ\x934\x18" +
+	"Ɛ\v4\x18Ǎ\xce4\x18ȋG4\x18\xc9H\x9f4\x18ʊ\xb44\x18ˎ\x004\x18̑" +
+	"P4\x18\xce\xc0\xd44\x18ϛP4\x18КK4\x18њ,4\x18Ҕ<4\x18ӑ\xb54" +
+	"\x18ԏw4\x18Ռ\xf24\x18\xd6Jk4\x18\u05ccb4\x18؏\xaf4\x18ٓ\x014\x19\x00" +
+	"E]4\x19\x02\x9a\x844\x19\x03\x99|4\x19\x04\x98w4\x19\x05\x95\xda4\x19\x06\x93S4\x19\a\x91\x16" +
+	"4\x19\b\x8e\x8f4\x19\t\x8a\xb44\x19\nH_4\x19\v\x8e\xe24\x19\f\x9224\x19\x0e\xc1\xcd4\x19" +
+	"\x0f\x9c-4\x19\x10\x9b(4\x19\x11\x9a#4\x19\x12\x97\x834\x19\x13\x94\xfd4\x19\x14\x92\xbf4\x19\x15\x90" +
+	":4\x19\x16\x8c`4\x19\x17J-4\x19\x18\x90\x914\x19\x19\x93\xe34\x19@B\xdf4\x19B\x9be4" +
+	"\x19C\x9a^4\x19D\x99X4\x19E\x95\xd64\x19F\x96\x9f4\x19G\x94b4\x19H\x91\xdb4\x19I" +
+	"\x8e\x004\x19J\x8e\xe24\x19KHO4\x19L\x93\x184\x19N\xc2\xcb4\x19O\x9d\x0f4\x19P\x9c\n" +
+	"4\x19Q\x9b\x054\x19R\x97\x7f4\x19S\x98I4\x19T\x96\v4\x19U\x93\x864\x19V\x8f\xac4\x19" +
+	"W\x90\x8f4\x19XJ\x1e4\x19Y\x94\xc94\x19\x80Ay4\x19\x82\x9cK4\x19\x83\x9bD4\x19\x84\x9a" +
+	">4\x19\x85\x96\xbc4\x19\x86\x96\x9f4\x19\x87\x97\xb24\x19\x88\x95,4\x19\x89\x91P4\x19\x8a\x9224" +
+	"\x19\x8b\x93\x184\x19\x8cHo4\x19\x8e\xc3\xcd4\x19\x8f\x9d\xf54\x19\x90\x9c\xf04\x19\x91\x9b\xeb4\x19\x92" +
+	"\x98e4\x19\x93\x98H4\x19\x94\x99[4\x19\x95\x96\xd64\x19\x96\x92\xfc4\x19\x97\x93\xdf4\x19\x98\x94\xc7" +
+	"4\x19\x99J@4\x19ۀ\x004\x19\xdcx\x7f4\x19\xddw\xa14\x19\xdep\x884\x19\xdfjQ4\x19" +
+	"\xe0`\x854\x19\xe1X\x804\x19\xe2Q\xb44\x19\xe3J\x9d4\x19\xe4G\x1f4\x19\xe5D\xa84\x19\xe6C" +
+	"F4\x1a\x1bW84\x1a\x1c\xbeE4\x1a\x1d\xbd!4\x1a\x1e\xbb\xfe4\x1a\x1f\xbb74\x1a \xbf\x144" +
+	"\x1a!\xbe\x8f4\x1a\"\xbf|4\x1a#\xbf.4\x1a$\xc0#4\x1a%\xc1\x1d4\x1a&\xc2\x1b4\x1a\\" +
+	"P<4\x1a]\x94\x9d4\x1a^\x93\x964\x1a_\x95.4\x1a`\x97j4\x1aa\x9a\xb74\x1ab\x9a\xa6" +
+	"4\x1ac\x9a<4\x1ad\x9b\x164\x1ae\x9b\xf54\x1af\x9c\xd84\x1a\x9dNX4\x1a\x9e\x90,4\x1a" +
+	"\x9f\x91\xc94\x1a\xa0\x94\x054\x1a\xa1\x97Q4\x1a\xa2\x9a\x854\x1a\xa3\x9984\x1a\xa4\x9a\x124\x1a\xa5\x9a" +
+	"\xf14\x1a\xa6\x9b\xd44\x1a\xdeL\xa84\x1aߎe4\x1a\xe0\x90\xa14\x1a\xe1\x93\xed4\x1a\xe2\x97 4" +
+	"\x1a\xe3\x99\x184\x1a\xe4\x99\x104\x1a\xe5\x99\xee4\x1a\xe6\x9a\xd14\x1b\x1fM\x0e4\x1b \x8a\x164\x1b!" +
+	"\x8e\x134\x1b\"\x91G4\x1b#\x93?4\x1b$\x96{4\x1b%\x96w4\x1b&\x97Z4\x1b`L\x9f" +
+	"4\x1ba\x8b\x944\x1bb\x8e\xc84\x1bc\x90\xbf4\x1bd\x93\xfc4\x1be\x97<4\x1bf\x97<4\x1b" +
+	"\xa1L\xa74\x1b\xa2\x8c\x914\x1b\xa3\x8e\x894\x1b\xa4\x91\xc54\x1b\xa5\x95\x054\x1b\xa6\x98I4\x1b\xe2L" +
+	"\x054\x1b\xe3\x8c\n4\x1b\xe4\x8fF4\x1b咆4\x1b\xe6\x95\xca4\x1c#I\xf84\x1c$\x8b}4" +
+	"\x1c%\x8e\xbd4\x1c&\x92\x014\x1cdI\xb74\x1ce\x8f\x9b4\x1cf\x92\xe04\x1c\xa5I\xa74\x1c\xa6" +
+	"\x93\xc24\x1c\xe6I\xc64#\x81x\xf34#\x83\xa244#\x84\xa1'4#\x85\xa3\x874#\x86\xa5\xdb" +
+	"4#\x87\xa4&4#\x88\xa5>4#\x89\xa4\xd14#\x8a\xa5\xb24#\x8b\xa6\x974#\x8c\xa7\x804#" +
+	"\xc0\x80\x004#\xc1\xc494#û\x1f4#Ĺ\xf54#Ź+4#ƽ'4#\xc7\xc0" +
+	";4#\xc8\xc0\xbf4#\xc9\xc0n4#\xca\xc1k4#\xcb\xc2m4#\xcc\xc3r4$\x00x\x1d4" +
+	"$\x01\xa3\xe94$\x03M\xe84$\x04\x92\x004$\x05\x94d4$\x06\x96\xb84$\a\x97\xc24$\b" +
+	"\x9c\x164$\t\x9a\xc04$\n\x9b\xa04$\v\x9c\x864$\f\x9do4$@pW4$A\xa2\xdd" +
+	"4$C\x92\x014$DL84$E\x90\xe94$F\x93=4$G\x94G4$H\x98\x9b4$" +
+	"I\x9a\xa14$J\x9a\x984$K\x9b}4$L\x9cg4$\x80j\xf44$\x81\xa5;4$\x83\x94" +
+	"c4$\x84\x90\xe74$\x85M\x964$\x86\x8d64$\x87\x8e@4$\x88\x92\xb04$\x89\x94\xb64" +
+	"$\x8a\x98\n4$\x8b\x98\x054$\x8c\x98\xef4$\xc0`\xe44$\xc1\xa7\x8c4$Ö\xb44$\xc4" +
+	"\x9384$ō54$\xc6M14$ǋ\xb14$Ȑ\"4$ɒ(4$ʕ{" +
+	"4$˘\xd34$̘\xd34%\x00W\x864%\x01\xa5\xd64%\x03\x97\xbe4%\x04\x94B4%" +
+	"\x05\x8e?4%\x06\x8b\xb14%\aK\xc94%\b\x8c\xb84%\t\x8e\xbe4%\n\x92\x124%\v\x95" +
+	"i4%\f\x98\xc54%@Q\x994%A\xa6\xee4%C\x9c\x124%D\x98\x964%E\x92\xb04" +
+	"%F\x90\"4%G\x8c\xb84%HL24%I\x8co4%J\x8f\xc34%K\x93\x1a4%L" +
+	"\x96v4%\x80JZ4%\x81\xa6\x814%\x83\x9a\xbb4%\x84\x9a\x9c4%\x85\x94\xb54%\x86\x92(" +
+	"4%\x87\x8e\xbe4%\x88\x8co4%\x89J\x1b4%\x8a\x8b\xd74%\x8b\x8f/4%\x8c\x92\x8b4%" +
+	"\xc0F\xd04%\xc1\xa7d4%Û\x9e4%Ě\x954%Ř\v4%ƕ}4%ǒ" +
+	"\x134%ȏ\xc44%ɋ\xd94%\xcaI\xdd4%ː\x154%̓q4&\x00DQ4" +
+	"&\x01\xa8K4&\x03\x9c\x854&\x04\x9b|4&\x05\x98\b4&\x06\x98\xd64&\a\x95m4&\b" +
+	"\x93\x1e4&\t\x8f24&\n\x90\x174&\vI\xcf4&\f\x94\\4&@B\xeb4&A\xa95" +
+	"4&C\x9do4&D\x9cf4&E\x98\xf34&F\x98\xd84&G\x98\xca4&H\x96{4&" +
+	"I\x92\x8f4&J\x93u4&K\x94^4&LI\xf24&\x83wm4&\x84o\xc24&\x85j" +
+	"w4&\x86`\x8b4&\x87WM4&\x88Qr4&\x89JG4&\x8aF\xc34&\x8bDH4" +
+	"&\x8cB\xe44&\x8f\x80\xe64&\x90y84&\x91q\x8e4&\x92lC4&\x93bW4&\x94" +
+	"Y\x194&\x95S?4&\x96L\x144&\x97H\x924&\x98F\x194&\x99D\xb84&\xc1w\xf1" +
+	"4&á\x024&ğ\xf84&ŢM4&Ƥ\x964&Ǣ\xe74&ȣ\xfb4&" +
+	"ɣ\x8f4&ʤm4&˥N4&̦44&\xcew\xf04&\xcf\xc2o4&Т" +
+	"\xb24&ѡ\xa84&ң\xfc4&ӦD4&Ԥ\x954&ե\xa84&֥=4" +
+	"&צ\x1c4&ا\x004&٧\xe74'\x01\u00874'\x03\xb9\x894'\x04\xb8c4'\x05" +
+	"\xb7\x9e4'\x06\xbb\x8b4'\a\xbe\x934'\b\xbf\x154'\t\xbe\xc54'\n\xbf\xbe4'\v\xc0\xbc" +
+	"4'\f\xc1\xbe4'\x0e\xc2g4'\x0fV&4'\x10\xbb44'\x11\xba\x0f4'\x12\xb9D4'" +
+	"\x13\xbd04'\x14\xc094'\x15\xc0\xbb4'\x16\xc0k4'\x17\xc1f4'\x18\xc2e4'\x19\xc3" +
+	"i4'@wN4'A\xa2\xb04'CMw4'D\x91\x0f4'E\x93h4'F\x95\xb14" +
+	"'G\x96\xb64'H\x9a\xfb4'I\x99\xa94'J\x9a\x874'K\x9bi4'L\x9cO4'N" +
+	"\xa2\xab4'O\xbb54'POJ4'Q\x92\xc04'R\x95\x174'S\x97_4'T\x98d" +
+	"4'U\x9c\xa94'V\x9bW4'W\x9c74'X\x9d\x1a4'Y\x9e\x024'\x80o\xa34'" +
+	"\x81\xa1\xa64'\x83\x91\x0f4'\x84K\xc64'\x85\x8f\xf84'\x86\x92A4'\x87\x93F4'\x88\x97" +
+	"\x8b4'\x89\x99\x8a4'\x8a\x99\x814'\x8b\x9ac4'\x8c\x9bI4'\x8e\xa1\xa14'\x8f\xba\x0f4" +
+	"'\x90\x92\xbf4'\x91M\x9a4'\x92\x91\xa74'\x93\x93\xef4'\x94\x94\xf54'\x95\x9994'\x96" +
+	"\x9b84'\x97\x9b14'\x98\x9c\x144'\x99\x9c\xfc4'\xc0jT4'\xc1\xa3\xfa4'Óg" +
+	"4'ď\xf74'\xc5M\"4'ƌQ4'ǍV4'ȑ\xb74'ɓ\xb54'" +
+	"ʖ\xfd4'˖\xf94'̗\xdf4'Σ\xf54'ϹC4'Е\x174'ё" +
+	"\xa84'\xd2N\xf44'Ӎ\xff4'ԏ\x054'Փe4'֕d4'ט\xad4" +
+	"'ؘ\xaa4'ٙ\x924(\x00`g4(\x01\xa6?4(\x03\x95\xac4(\x04\x92<4(\x05" +
+	"\x8cO4(\x06L\xb84(\a\x8a\xce4(\b\x8f/4(\t\x91.4(\n\x94v4(\v\x97\xc2" +
+	"4(\f\x97\xc14(\x0e\xa6<4(\x0f\xbd/4(\x10\x97^4(\x11\x93\xef4(\x12\x8d\xff4(" +
+	"\x13N\x894(\x14\x8c}4(\x15\x90\xde4(\x16\x92\xdd4(\x17\x96&4(\x18\x99s4(\x19\x99" +
+	"u4(@W)4(A\xa4\x8f4(C\x96\xb14(D\x93A4(E\x8dT4(F\x8a\xce4" +
+	"(GKS4(H\x8b\xd14(I\x8d\xd04(J\x91\x184(K\x94d4(L\x97\xb44(N" +
+	"\xa4\x8c4(O\xc074(P\x98c4(Q\x94\xf44(R\x8f\x044(S\x8c}4(TM$" +
+	"4(U\x8d\x804(V\x8f\x7f4(W\x92\xc84(X\x96\x164(Y\x99g4(\x80QL4(" +
+	"\x81\xa5\xa14(\x83\x9a\xf44(\x84\x97\x844(\x85\x91\xb34(\x86\x8f-4(\x87\x8b\xcf4(\x88K" +
+	"\xb64(\x89\x8b\x864(\x8a\x8e\xce4(\x8b\x92\x1a4(\x8c\x95j4(\x8e\xa5\x9e4(\x8f\xc0\xb74" +
+	"(\x90\x9c\xa64(\x91\x9974(\x92\x93c4(\x93\x90\xdc4(\x94\x8d\x7f4(\x95M\x874(\x96" +
+	"\x8d54(\x97\x90~4(\x98\x93\xcc4(\x99\x97\x1d4(\xc0J\"4(\xc1\xa564(Ù\xa2" +
+	"4(ę\x834(œ\xb24(Ƒ,4(Ǎ\xce4(ȋ\x864(\xc9I\xa44(" +
+	"ʊ\xf24(ˎ>4(̑\x8f4(Υ34(\xcf\xc0h4(ЛT4(ћ" +
+	"64(ҕa4(Ӓ\xdb4(ԏ~4(Ս54(\xd6Kt4(\u05cc\xa54" +
+	"(؏\xf24(ٓD4)\x00F\x9f4)\x01\xa6\x144)\x03\x9a\x804)\x04\x99z4)\x05" +
+	"\x96\xfa4)\x06\x94t4)\a\x91\x164)\b\x8e\xce4)\t\x8a\xf24)\nIc4)\v\x8f " +
+	"4)\f\x92q4)\x0e\xa6\x114)\x0f\xc1a4)\x10\x9c24)\x11\x9b-4)\x12\x98\xa94)" +
+	"\x13\x96#4)\x14\x92\xc54)\x15\x90}4)\x16\x8c\xa34)\x17K64)\x18\x90\xd44)\x19\x94" +
+	"&4)@D$4)A\xa6\xf54)C\x9bb4)D\x9a\\4)E\x96\xf64)F\x97\xc04" +
+	")G\x94b4)H\x92\x1a4)I\x8e>4)J\x8f 4)KIS4)L\x93V4)N" +
+	"\xa6\xf34)O\xc2_4)P\x9d\x144)Q\x9c\x0f4)R\x98\xa54)S\x99n4)T\x96\x11" +
+	"4)U\x93\xc94)V\x8f\xef4)W\x90\xd34)XK'4)Y\x95\f4)\x80B\xc14)" +
+	"\x81\xa7\xdb4)\x83\x9cH4)\x84\x9bB4)\x85\x97\xdc4)\x86\x97\xc04)\x87\x97\xb34)\x88\x95" +
+	"j4)\x89\x91\x8f4)\x8a\x92q4)\x8b\x93V4)\x8cIt4)\x8e\xa7\xd94)\x8f\xc3`4" +
+	")\x90\x9d\xfa4)\x91\x9c\xf54)\x92\x99\x8b4)\x93\x99n4)\x94\x99a4)\x95\x97\x194)\x96" +
+	"\x93?4)\x97\x94#4)\x98\x95\n4)\x99KI4)܀\x004)\xddxh4)\xdep\xdb" +
+	"4)\xdfk\xa34)\xe0a\xda4)\xe1X\xbc4)\xe2R\xf24)\xe3K\xdd4)\xe4Hb4)" +
+	"\xe5E\xed4)\xe6D\x8e4*\x1bv\xee4*\x1c\xc0\xbc4*\x1d\xa1x4*\x1e\xa0q4*\x1f\xa2" +
+	"\xbb4* \xa4\xf74*!\xa3N4*\"\xa4[4*#\xa3\xf24*$\xa4\xcc4*%\xa5\xab4" +
+	"*&\xa6\x8d4*\\U\xb34*]\xb9\x9f4*^\xb8}4*_\xb7\xb54*`\xbb\x924*a" +
+	"\xbe\x8f4*b\xbf\r4*c\xbe\xbf4*d\xbf\xb44*e\xc0\xae4*f\xc1\xac4*\x9dN\xd9" +
+	"4*\x9e\x91\xce4*\x9f\x94\x1b4*\xa0\x96W4*\xa1\x97W4*\xa2\x9b\x8b4*\xa3\x9a>4*" +
+	"\xa4\x9b\x184*\xa5\x9b\xf74*\xa6\x9c\xda4*\xdeM(4*ߐ\xb74*\xe0\x92\xf34*\xe1\x93" +
+	"\xf34*\xe2\x98&4*\xe3\x9a\x1e4*\xe4\x9a\x164*\xe5\x9a\xf54*\xe6\x9b\xd74+\x1fN\x7f4" +
+	"+ \x8d\x1a4+!\x8e\x1a4+\"\x92i4+#\x94a4+$\x97\x9d4+%\x97\x984+&" +
+	"\x98{4+`N\x104+a\x8b\x9a4+b\x8f\xe94+c\x91\xe14+d\x95\x1d4+e\x98]" +
+	"4+f\x98]4+\xa1L\xad4+\xa2\x8c\x974+\xa3\x8e\x8f4+\xa4\x91\xcb4+\xa5\x95\v4+" +
+	"\xa6\x98O4+\xe2M\n4+\xe3\x8cL4+䏈4+\xe5\x92\xc84+\xe6\x96\f4,#J" +
+	"\xfd4,$\x8b\xbf4,%\x8e\xff4,&\x92C4,dJ\xbc4,e\x8f\xde4,f\x93\"4" +
+	",\xa5J\xac4,\xa6\x94\x054,\xe6J\xcb43\x81y\xe043\x82\xae143\x84\xa2\x1943\x85" +
+	"\xa4\x9543\x86\xa6\xe943\x87\xa5543\x88\xa5>43\x89\xa5\x1043\x8a\xa5\xf143\x8b\xa6\xd6" +
+	"43\x8c\xa7\xc043\xc1\xae/43\xc2v\xf043Ğ\xb943š:43ƣ\x8e43" +
+	"ǥ\xb143Ȥ\xe943ɤ\xbc43ʥ\x9d43˦\x8243̧k44\x00\x80" +
+	"\x0044\x01\xc3\xc944\x02\xbfM44\x04\xb6X44\x05\xb5\x8f44\x06\xb9\x8b44\a\xbc\x9f4" +
+	"4\b\xc0\xbd44\t\xbf\xfc44\n\xc0\xf944\v\xc1\xfa44\f\xc3\x0044@q\v44A" +
+	"\xa3\xd344B\xa0w44DL\xb844E\x92\xaa44F\x94\xfe44G\x96\xa444H\x98\x9b" +
+	"44I\x9b\xaa44J\x9b\xa144K\x9c\x8644L\x9dp44\x80kr44\x81\xa6N44" +
+	"\x82\xa2\xf544\x84\x92\xa844\x85N<44\x86\x8f\x1344\x87\x90\xb944\x88\x92\xb144\x89\x95" +
+	"\xbf44\x8a\x99\x1344\x8b\x99\x0e44\x8c\x99\xf844\xc0aW44\xc1\xa8\x9e44¥F4" +
+	"4Ĕ\xf944ŏ\x1244\xc6M\xd744ǎ+44Ȑ\"44ɓ144\xca" +
+	"\x96\x8444˙\xdc44̙\xdc45\x00X\xe045\x01\xa6\xe945\x02\xa7f45\x04\x96\x9d" +
+	"45\x05\x90\xb745\x06\x8e)45\aMB45\b\x8c\xb945\t\x8f\xe445\n\x93745" +
+	"\v\x96\x8f45\f\x99\xeb45@Q\xd345A\xa6\xf245B\xa6\xa045D\x98\x9645E\x92" +
+	"\xb045F\x90\"45G\x8c\xba45HL345I\x8cq45J\x8f\xc545K\x93\x1c4" +
+	"5L\x96x45\x80K\x9c45\x81\xa6\xc345\x82\xa6q45\x84\x9b\xa345\x85\x95\xbd45\x86" +
+	"\x93/45\x87\x8f\xe445\x88\x8co45\x89K#45\x8a\x8c\x1745\x8b\x8fo45\x8c\x92\xca" +
+	"45\xc0H\x1445\xc1\xa7\xa645§S45ě\x9c45ř\x1245Ɩ\x8445" +
+	"Ǔ945ȏ\xc545Ɍ\x1845\xcaJ\xe545ːU45̓\xb146\x00E" +
+	"\x9846\x01\xa8\x8d46\x02\xa8:46\x04\x9c\x8346\x05\x99\x0f46\x06\x99\xde46\a\x96\x924" +
+	"6\b\x93\x1e46\t\x8fr46\n\x90W46\vJ\xd746\f\x94\x9c46@D546A" +
+	"\xa9x46B\xa9%46D\x9dn46E\x99\xfa46F\x99\xdf46G\x99\xf046H\x96{" +
+	"46I\x92\xcf46J\x93\xb446K\x94\x9d46LJ\xfa46\x84pr46\x85j\xf246" +
+	"\x86`\xfb46\x87X\xa346\x88Q\xaa46\x89K\x8546\x8aH\x0446\x8bE\x8c46\x8cD" +
+	"+46\x90\x80\xe846\x91rB46\x92l\xc246\x93b\xcb46\x94Zr46\x95Sz4" +
+	"6\x96MU46\x97I\xd646\x98G`46\x99F\x0146\xc1x\xdc46¬\xca46\xc4" +
+	"\xa0\xe646ţX46ƥ\xa046ǣ\xf346ȣ\xfb46ɣ\xce46ʤ\xab" +
+	"46˥\x8d46̦s46\xcex\xda46Ϭ\xc546\xd0\xc2\x0046Ѣ\x9b46" +
+	"ҥ\n46ӧR46ԥ\xa346ե\xad46֥~46צ]46ا" +
+	"A46٨(47\x01\xac\xc447\x02u\xeb47\x04\x9d\x9047\x05\xa0\x0647\x06\xa2N4" +
+	"7\a\xa4g47\b\xa3\xa347\t\xa3v47\n\xa4S47\v\xa5547\f\xa6\x1b47\x0e" +
+	"\xac\xc047\x0fu\xe847\x10\xbd\x8d47\x11\x9fE47\x12\xa1\xb847\x13\xa4\x0047\x14\xa6\x18" +
+	"47\x15\xa5U47\x16\xa5&47\x17\xa6\x0547\x18\xa6\xe947\x19\xa7\xd047A\xc2\x1647" +
+	"B\xbd\xa947D\xb4\xd047E\xb4\v47F\xb7\xf847G\xbb\x0247H\xbf\x1147I\xbe" +
+	"R47J\xbfK47K\xc0I47L\xc1K47N\xc1\xf747O\xbd\x8747PT\xd44" +
+	"7Q\xb6\x8047R\xb5\xb647S\xb9\xa247T\xbc\xaa47U\xc0\xbb47V\xbf\xfa47W" +
+	"\xc0\xf547X\xc1\xf547Y\xc2\xf847\x80pV47\x81\xa2\x9947\x82\x9fJ47\x84LC" +
+	"47\x85\x91\xb447\x86\x93\xfd47\x87\x95\x9c47\x88\x97\x8b47\x89\x9a\x8f47\x8a\x9a\x8647" +
+	"\x8b\x9bh47\x8c\x9cN47\x8e\xa2\x9347\x8f\x9fA47\x90\xb6\x8347\x91N\x1b47\x92\x93" +
+	"g47\x93\x95\xaf47\x94\x97L47\x95\x99=47\x96\x9c?47\x97\x9c947\x98\x9d\x1c4" +
+	"7\x99\x9e\x0447\xc0j\xd147\xc1\xa5\t47¡\xbe47đ\xb347\xc5M\xc447\xc6" +
+	"\x8e)47Ǐ\xc747ȑ\xb747ɔ\xbb47ʘ\x0347˗\xfe47̘\xe4" +
+	"47Υ\x0347ϡ\xb547е\xb847ѓg47\xd2O\x9a47ӏ\xdb47" +
+	"ԑx47Փi47֖l47י\xb547ؙ\xb247ٚ\x9a48\x00`" +
+	"\xd848\x01\xa7M48\x02\xa4\x0348\x04\x93\xf848\x05\x8e'48\x06M[48\a\x8d@4" +
+	"8\b\x8f/48\t\x92348\n\x95{48\v\x98\xc748\f\x98\xc748\x0e\xa7J48\x0f" +
+	"\xa3\xfc48\x10\xb9\xa348\x11\x95\xae48\x12\x8f\xda48\x13O048\x14\x8e\xf148\x15\x90\xe2" +
+	"48\x16\x93\xe448\x17\x97.48\x18\x9a{48\x19\x9a|48@X\x7f48A\xa5\x9e48" +
+	"B\xa6\x1948D\x95\x9448E\x8f\xc448F\x8d>48GL\xc648H\x8b\xd148I\x8e" +
+	"\xf148J\x92948K\x95\x8548L\x98\xd548N\xa5\x9b48O\xa6\x1448P\xbc\xab4" +
+	"8Q\x97K48R\x91w48S\x8e\xf148TN\x9948U\x8d\x8448V\x90\xa348W" +
+	"\x93\xec48X\x97948Y\x9a\x8b48\x80Q\x8548\x81\xa5\xa548\x82\xa5T48\x84\x97\x84" +
+	"48\x85\x91\xb348\x86\x8f-48\x87\x8b\xd148\x88K\xb648\x89\x8b\x8848\x8a\x8e\xd048" +
+	"\x8b\x92\x1c48\x8c\x95l48\x8e\xa5\xa348\x8f\xa5O48\x90\xc0\xb948\x91\x99;48\x92\x93" +
+	"g48\x93\x90\xe048\x94\x8d\x8348\x95M\x8b48\x96\x8d948\x97\x90\x8348\x98\x93\xd04" +
+	"8\x99\x97\"48\xc0K`48\xc1\xa5w48¥%48Ě\x8648Ŕ\xb648\xc6" +
+	"\x92048ǎ\xef48ȋ\x8648\xc9J\xa848ʋ148ˎ}48̑\xcd" +
+	"48Υt48ϥ!48п\xf948ќ=48Җi48ӓ\xe348" +
+	"Ԑ\xa148Ս:48\xd6L{48\u05cc\xe648ؐ448ٓ\x8549\x00G" +
+	"\xdf49\x01\xa6U49\x02\xa6\x0349\x04\x9a~49\x05\x97\xfe49\x06\x95x49\a\x9274" +
+	"9\b\x8e\xce49\t\x8b149\nJh49\v\x8f_49\f\x92\xaf49\x0e\xa6R49\x0f" +
+	"\xa5\xff49\x10\xc0\xf349\x11\x9c549\x12\x99\xb149\x13\x97*49\x14\x93\xe949\x15\x90\x81" +
+	"49\x16\x8c\xe449\x17L<49\x18\x91\x1549\x19\x94g49@Eg49A\xa7649" +
+	"B\xa6\xe549D\x9b_49E\x97\xf949F\x98\xc449G\x95\x8449H\x92\x1a49I\x8e" +
+	"}49J\x8f_49KJX49L\x93\x9549N\xa7449O\xa6\xe049P\xc1\xf04" +
+	"9Q\x9d\x1749R\x99\xac49S\x9av49T\x97549U\x93\xcd49V\x90049W" +
+	"\x91\x1449XL.49Y\x95M49\x80D\a49\x81\xa8\x1c49\x82\xa7\xcb49\x84\x9cE" +
+	"49\x85\x98\xdf49\x86\x98\xc449\x87\x98\xd449\x88\x95k49\x89\x91\xcd49\x8a\x92\xaf49" +
+	"\x8b\x93\x9549\x8cJx49\x8e\xa8\x1a49\x8f\xa7\xc649\x90\xc2\xf249\x91\x9d\xfd49\x92\x9a" +
+	"\x9249\x93\x9av49\x94\x9a\x8549\x95\x97\x1d49\x96\x93\x8049\x97\x94d49\x98\x95K4" +
+	"9\x99LP49݀\x0049\xdeq\x8d49\xdfl!49\xe0bL49\xe1Z\x1049\xe2" +
+	"S-49\xe3M\x1949\xe4I\xa049\xe5G/49\xe6E\xd24:\x1bw\xd54:\x1c\xabZ" +
+	"4:\x1d\xc0M4:\x1e\xa1a4:\x1f\xa3\xc54: \xa6\x014:!\xa4X4:\"\xa4`4:" +
+	"#\xa424:$\xa5\f4:%\xa5\xeb4:&\xa6\xcd4:\\t\xe34:]\xbb\xe94:^\x9e" +
+	"\x184:_\xa0\x814:`\xa2\xbd4:a\xa4\xca4:b\xa4\t4:c\xa3\xdb4:d\xa4\xb54" +
+	":e\xa5\x944:f\xa6v4:\x9dT^4:\x9e\xb4\xfb4:\x9f\xb444:\xa0\xb8\x114:\xa1" +
+	"\xbb\r4:\xa2\xbf\r4:\xa3\xbeO4:\xa4\xbfE4:\xa5\xc0?4:\xa6\xc1=4:\xdeM\xa7" +
+	"4:ߒr4:\xe0\x94\xae4:\xe1\x96C4:\xe2\x98+4:\xe3\x9b\"4:\xe4\x9b\x1a4:" +
+	"\xe5\x9b\xf84:\xe6\x9c\xdb4;\x1fO\"4; \x8e\xf04;!\x90\x864;\"\x92m4;#\x95" +
+	"e4;$\x98\xa14;%\x98\x9c4;&\x99\x7f4;`N\xb34;a\x8e\x064;b\x8f\xed4" +
+	";c\x92\xe54;d\x96!4;e\x99a4;f\x99a4;\xa1N\x1c4;\xa2\x8c\x9b4;\xa3" +
+	"\x8f\xae4;\xa4\x92\xea4;\xa5\x96+4;\xa6\x99o4;\xe2M\x0f4;\xe3\x8cP4;䏌" +
+	"4;\xe5\x92\xcc4;\xe6\x96\x114<#L\x004<$\x8b\xff4<%\x8f?4<&\x92\x834<" +
+	"dK\xbf4<e\x90\x1d4<f\x93b4<\xa5K\xaf4<\xa6\x94D4<\xe6K\xce4C\x81z" +
+	"\xcd4C\x82\xaeq4C\x83\xae\x044C\x85\xa5\x874C\x86\xa7\xdb4C\x87\xa6'4C\x88\xa6N4" +
+	"C\x89\xa5\x114C\x8a\xa614C\x8b\xa7\x164C\x8c\xa7\xff4C\xc1\xaep4C\xc2w\xdc4C\xc3" +
+	"\xaa)4CŢ,4CƤ\x804CǦ\xbf4Cȥ\xf94Cɤ\xbc4Cʥ\xdc" +
+	"4C˦\xc24Ç\xab4D\x01\xae\x014D\x02\xaa(4D\x03u\x184D\x05\x9e\xd04D" +
+	"\x06\xa1$4D\a\xa3d4D\b\xa6u4D\t\xa4g4D\n\xa5\x884D\v\xa6m4D\f\xa7" +
+	"V4D@\x80\x004DA\xc3W4DB\xbe\xdb4DC\xba^4DE\xb1\xf24DF\xb5\xee4" +
+	"DG\xb9\x034DH\xbd\"4DI\xbf\xfa4DJ\xc0\x864DK\xc1\x884DL\u008d4D\x80" +
+	"l\x1a4D\x81\xa7B4D\x82\xa3\xea4D\x83\xa0\x924D\x85N\xbd4D\x86\x90\xb74D\x87\x92z" +
+	"4D\x88\x95\x0e4D\x89\x95\xbf4D\x8a\x9a\x1c4D\x8b\x9a\x184D\x8c\x9b\x014D\xc0a\xb04D" +
+	"\xc1\xa9\x934D¦;4Dâ\xe24DŐ\xb64D\xc6NX4DǏ\xeb4DȒ" +
+	"\x7f4Dɓ14Dʗ\x8d4D˚\xe54D̚\xe54E\x00YT4E\x01\xa7\xdd4" +
+	"E\x02\xa8w4E\x03\xa5\x1f4E\x05\x92w4E\x06\x8f\xea4E\aM\xe94E\b\x8f24E\t" +
+	"\x8f\xe44E\n\x94A4E\v\x97\x984E\f\x9a\xf44E@S.4EA\xa8\x044EB\xa7\xb1" +
+	"4EC\xa8.4EE\x95\v4EF\x92}4EG\x8f24EHM\xac4EI\x8cq4E" +
+	"J\x90\xea4EK\x94B4EL\x97\x9e4E\x80K\xd64E\x81\xa6\xc64E\x82\xa6s4E\x83\xa6" +
+	" 4E\x85\x95\xbd4E\x86\x93/4E\x87\x8f\xe44E\x88\x8cq4E\x89K#4E\x8a\x8c\x194" +
+	"E\x8b\x8fp4E\x8c\x92\xcc4E\xc0IW4E\xc1\xa7\xe74E§\x944EçA4E\xc5" +
+	"\x9a\x194EƗ\x8c4Eǔ@4EȐ\xea4EɌ\x194E\xcaK\xed4Eː\x95" +
+	"4E̓\xf14F\x00F\xde4F\x01\xa8\xcd4F\x02\xa8z4F\x03\xa8(4F\x05\x9a\x164F" +
+	"\x06\x9a\xe54F\a\x97\x9a4F\b\x94D4F\t\x8fr4F\n\x90\x974F\vK\xdf4F\f\x94" +
+	"\xdc4F@E~4FA\xa9\xb84FB\xa9e4FC\xa9\x124FE\x9b\x014FF\x9a\xe64" +
+	"FG\x9a\xf74FH\x97\xa14FI\x92\xcf4FJ\x93\xf44FK\x94\xdd4FLL\x024F\x85" +
+	"k\x964F\x86aR4F\x87Y\x144F\x88S\x004F\x89K\xbe4F\x8aIC4F\x8bF\xcd" +
+	"4F\x8cEo4F\x91\x80\xe94F\x92mh4F\x93c$4F\x94Z\xe54F\x95T\xd24F" +
+	"\x96M\x904F\x97K\x164F\x98H\xa24F\x99GF4F\xc1y\xc44F\u00ad\n4Fì" +
+	"\x9f4FŤF4FƦ\x8f4FǤ\xe14Fȥ\a4Fɣ\xce4Fʤ\xea4" +
+	"F˥\xcc4F̦\xb24F\xcey\xc34Fϭ\x044FЬ\x974F\xd1\xc1\x904F\xd2" +
+	"\xa5\xfb4FӨC4FԦ\x944Fզ\xba4F֥\x814Fצ\x9d4Fا\x80" +
+	"4F٨h4G\x01\xad\x044G\x02v\xd44G\x03\xa8\xd04G\x05\xa0\xf44G\x06\xa3=4G" +
+	"\a\xa5r4G\b\xa4\xaf4G\t\xa3v4G\n\xa4\x924G\v\xa5t4G\f\xa6Z4G\x0e\xad" +
+	"\x004G\x0fv\xd14G\x10\xa8\xc64G\x11\xbd\x1d4G\x12\xa2\xa94G\x13\xa4\xf14G\x14\xa7%4" +
+	"G\x15\xa6a4G\x16\xa5)4G\x17\xa6E4G\x18\xa7(4G\x19\xa8\x104GA\xac\x954GB" +
+	"\xa8\xcc4GCt\x124GE\x9d\xa44GF\x9f\xed4GG\xa2\"4GH\xa5&4GI\xa3 " +
+	"4GJ\xa4<4GK\xa5\x1e4GL\xa6\x044GN\xac\x904GO\xa8\xc44GPt\r4G" +
+	"Q\xb8\xac4GR\x9fY4GS\xa1\xa14GT\xa3\xd54GU\xa6\xd84GV\xa4\xd34GW\xa5" +
+	"\xef4GX\xa6\xd24GY\xa7\xba4G\x81\xc1\xa54G\x82\xbd84G\x83\xb8\xcc4G\x85\xb0z4" +
+	"G\x86\xb4g4G\x87\xb7q4G\x88\xbb\x824G\x89\xbeO4G\x8a\xbe\xda4G\x8b\xbf\xd74G\x8c" +
+	"\xc0\xd94G\x8e\xc1\x864G\x8f\xbd\x174G\x90\xb8\xa84G\x91S\xae4G\x92\xb2(4G\x93\xb6\x14" +
+	"4G\x94\xb9\x1c4G\x95\xbd,4G\x96\xbf\xfa4G\x97\xc0\x854G\x98\xc1\x844G\x99\u00874G" +
+	"\xc0kv4G\xc1\xa5\xfa4G¢\xaf4Gße4G\xc5NB4GƏ\xc94GǑ" +
+	"\x834GȔ\f4Gɔ\xbb4Gʙ\b4G˙\x044G̙\xea4GΥ\xf44" +
+	"GϢ\xa64GПY4GѲ*4G\xd2P\x1a4Gӑ}4Gԓ64G\xd5" +
+	"\x95\xbf4G֖n4Gך\xbb4Gؚ\xb84Gٛ\xa04H\x00a14H\x01\xa8?" +
+	"4H\x02\xa4\xf44H\x03\xa1\xaa4H\x05\x8f\xc74H\x06M\xd84H\a\x8e\xfc4H\b\x91\x854H" +
+	"\t\x9234H\n\x96\x804H\v\x99\xcc4H\f\x99\xcc4H\x0e\xa8;4H\x0f\xa4\xed4H\x10\xa1" +
+	"\xa04H\x11\xb6\x164H\x12\x91|4H\x13O\xb04H\x14\x90\xaf4H\x15\x9384H\x16\x93\xe74" +
+	"H\x17\x9844H\x18\x9b\x814H\x19\x9b\x834H@X\xf14HA\xa6\x8f4HB\xa7&4HC" +
+	"\xa3\xdc4HE\x91\x804HF\x8e\xfa4HGMh4HH\x8eC4HI\x8e\xf14HJ\x93>" +
+	"4HK\x96\x8a4HL\x99\xdb4HN\xa6\x8c4HO\xa7!4HP\xa3\xd34HQ\xb9\x1e4H" +
+	"R\x9354HS\x90\xaf4HTO>4HU\x8f\xf64HV\x90\xa54HW\x94\xf24HX\x98" +
+	"?4HY\x9b\x914H\x80R\xdb4H\x81\xa6\xb34H\x82\xa6a4H\x83\xa6\xdc4H\x85\x94\a4" +
+	"H\x86\x91\x814H\x87\x8eA4H\x88M)4H\x89\x8b\x884H\x8a\x8f\xf14H\x8b\x93=4H\x8c" +
+	"\x96\x8d4H\x8e\xa6\xb04H\x8f\xa6\\4H\x90\xa6\xd54H\x91\xbd,4H\x92\x95\xbd4H\x93\x936" +
+	"4H\x94\x8f\xf54H\x95N\xff4H\x96\x8d<4H\x97\x91\xa54H\x98\x94\xf24H\x99\x98D4H" +
+	"\xc0K\x994H\xc1\xa5z4H¥(4Hä\xd64HŔ\xb64Hƒ04Hǎ" +
+	"\xf04Hȋ\x884H\xc9J\xa84Hʋ34Hˎ\x7f4H̑\xcf4HΥw4" +
+	"Hϥ#4HФ\xd04Hѿ\xfa4HҖl4Hӓ\xe54HԐ\xa44H\xd5" +
+	"\x8d<4H\xd6L~4H\u05cc\xe94Hؐ64Hٓ\x884I\x00I\x1d4I\x01\xa6\x94" +
+	"4I\x02\xa6B4I\x03\xa5\xf04I\x05\x99\x014I\x06\x96{4I\a\x93;4I\b\x8f\xef4I" +
+	"\t\x8b14I\nKl4I\v\x8f\x9e4I\f\x92\xee4I\x0e\xa6\x924I\x0f\xa6>4I\x10\xa5" +
+	"\xea4I\x11\xc0\x834I\x12\x9a\xb74I\x13\x9804I\x14\x94\xef4I\x15\x91\xa34I\x16\x8c\xe74" +
+	"I\x17MA4I\x18\x91U4I\x19\x94\xa64I@F\xa84IA\xa7v4IB\xa7$4IC" +
+	"\xa6\xd24IE\x98\xfd4IF\x99\xc74IG\x96\x874IH\x93<4II\x8e}4IJ\x8f\x9e" +
+	"4IKK\\4IL\x93\xd44IN\xa7s4IO\xa7 4IP\xa6\xcc4IQ\xc1\x804I" +
+	"R\x9a\xb34IS\x9b|4IT\x98;4IU\x94\xef4IV\x9034IW\x91S4IXM" +
+	"34IY\x95\x8c4I\x80EJ4I\x81\xa8\\4I\x82\xa8\n4I\x83\xa7\xb84I\x85\x99\xe34" +
+	"I\x86\x99\xc74I\x87\x99\xd74I\x88\x96\x8c4I\x89\x91\xce4I\x8a\x92\xee4I\x8b\x93\xd44I\x8c" +
+	"K|4I\x8e\xa8Y4I\x8f\xa8\x064I\x90\xa7\xb24I\x91\u00824I\x92\x9b\x984I\x93\x9b|" +
+	"4I\x94\x9b\x8b4I\x95\x98?4I\x96\x93\x834I\x97\x94\xa34I\x98\x95\x8b4I\x99MU4I" +
+	"ހ\x004I\xdfl\xc54I\xe0b\xa54I\xe1Z\x814I\xe2T\x7f4I\xe3MS4I\xe4J" +
+	"\xdb4I\xe5Hl4I\xe6G\x134J\x1bx\xbb4J\x1c\xab\x984J\x1d\xab+4J\x1e\xbf\xde4" +
+	"J\x1f\xa4\xb34J \xa6\xef4J!\xa5F4J\"\xa5i4J#\xa444J$\xa5J4J%" +
+	"\xa6)4J&\xa7\f4J\\u\xc94J]\xa7j4J^\xbbz4J_\xa1n4J`\xa3\xaa" +
+	"4Ja\xa5\xd34Jb\xa5\x124Jc\xa3\xdd4Jd\xa4\xf34Je\xa5\xd24Jf\xa6\xb54J" +
+	"\x9ds\a4J\x9e\xb7\x194J\x9f\x9e,4J\xa0\xa0h4J\xa1\xa2\x914J\xa2\xa5\x864J\xa3\xa3" +
+	"\x884J\xa4\xa4\x9e4J\xa5\xa5}4J\xa6\xa6`4J\xdeS54J߰\xb34Jഏ4" +
+	"J᷌4J⻌4J\xe3\xbeO4J\xe4\xbe\xd64J\xe5\xbf\xd04J\xe6\xc0\xce4K\x1f" +
+	"O\x9f4K \x90\x8e4K!\x92?4K\"\x94\xbb4K#\x95g4K$\x99\xa34K%\x99\x9f" +
+	"4K&\x9a\x814K`O04Ka\x8f\xbf4Kb\x92<4Kc\x92\xe84Kd\x97#4K" +
+	"e\x9ac4Kf\x9ac4K\xa1N\xbe4K\xa2\x8f\x054K\xa3\x8f\xb14K\xa4\x93\xed4K\xa5\x97" +
+	"-4K\xa6\x9aq4K\xe2N|4K\xe3\x8cS4K䐪4K\xe5\x93\xea4K\xe6\x97.4" +
+	"L#L\x034L$\x8c\x014L%\x8fB4L&\x92\x864LdL\xc04Le\x90\\4Lf" +
+	"\x93\xa04L\xa5L\xb04L\xa6\x94\x834L\xe6L\xd04S\x81y#4S\x82\xaa%4S\x83\xa9\x9b" +
+	"4S\x84\xa934S\x86\xa5G4S\x87\xa3\x9a4S\x88\xa3\xc04S\x89\xa2\xa14S\x8a\xa2\xb24S" +
+	"\x8b\xa3\xd74S\x8c\xa4\xc04S\xc1\xaa#4S\xc2v\x164Så\xaa4SĥB4Sơ" +
+	"\xd14SǤ\x114SȣK4Sɢ,4Sʢ=4Sˣb4S̤K4" +
+	"T\x01\xa9\x984T\x02\xa5\xa94T\x03sR4T\x04\xa1k4T\x06\x9ev4T\a\xa0\xb64T\b" +
+	"\xa3\xe34T\t\xa1\xd74T\n\xa1\xe84T\v\xa3\r4T\f\xa3\xf64TA\xa9.4TB\xa5?" +
+	"4TC\xa1j4TDp\xba4TF\x9b\x1f4TG\x9d^4TH\xa0\x8c4TI\xa2W4T" +
+	"J\xa1\x984TK\xa2\xbc4TL\xa3\xa64T\x80\x80\x004T\x81\xc3L4T\x82\xbe\xc64T\x83\xba" +
+	"D4T\x84\xb5\xc64T\x86\xb1\xe94T\x87\xb4\xfe4T\x88\xb9\x1d4T\x89\xbb\xf74T\x8a\xc0\x1d4" +
+	"T\x8b\xc0\xae4T\x8c\xc1\xb34T\xc0]\x994T\xc1\xa7\x004T£\x8e4Tà64T\xc4" +
+	"\x9c\xe24T\xc6I\xdf4TǏ\xe94TȒ\x9a4Tɓ\xe84Tʕ\xe84T˚H" +
+	"4T̚H4U\x00U\x024U\x01\xa5R4U\x02\xa5\xcb4U\x03\xa2s4U\x04\x9f\x1f4U" +
+	"\x06\x8f\xe84U\aIq4U\b\x8fM4U\t\x90\x9b4U\n\x92\x9b4U\v\x96\xfb4U\f\x9a" +
+	"W4U@N\xf64UA\xa5x4UB\xa5\x054UC\xa5\x9f4UD\xa2K4UF\x92\x984" +
+	"UG\x8fM4UHIZ4UI\x8dE4UJ\x8fE4UK\x93\xa54UL\x97\x014U\x80" +
+	"H\x864U\x81\xa4W4U\x82\xa3\xe44U\x83\xa3\x914U\x84\xa4\x124U\x86\x93\xe54U\x87\x90\x99" +
+	"4U\x88\x8dC4U\x89G\xa44U\x8a\x8as4U\x8b\x8e\xf04U\x8c\x92L4U\xc0D\xe64U" +
+	"\xc1\xa4i4U£\xf64Uã\xa34UģT4Uƕ\xe64Uǒ\x9b4Uȏ" +
+	"E4UɊu4U\xcaF\xf54Uˎ\xf14U̒M4V\x00Cu4V\x01\xa5\x8e4" +
+	"V\x02\xa5\x1a4V\x03\xa4\xc84V\x04\xa4y4V\x06\x9aF4V\a\x96\xfb4V\b\x93\xa54V\t" +
+	"\x8e\xf24V\n\x8e\xf14V\vG\xef4V\f\x93u4V@B\x184VA\xa6y4VB\xa6\x05" +
+	"4VC\xa5\xb24VD\xa5d4VF\x9aH4VG\x9aY4VH\x97\x024VI\x92O4V" +
+	"J\x92N4VK\x93w4VLH\x114V\x86]H4V\x87T\xcf4V\x88N\xd54V\x89H" +
+	"y4V\x8aD\xe04V\x8bCq4V\x8cB\x154V\x92\x80\xe94V\x93_\x1c4V\x94V\xa34" +
+	"V\x95P\xaa4V\x96JL4V\x97F\xb54V\x98EG4V\x99C\xee4V\xc1x'4V\xc2" +
+	"\xa8\xce4VèG4Vħ\xe04VƤ\x064VǢ_4VȢ\x854Vɡi" +
+	"4Vʡz4Vˢ\x9a4Ṿ\x804V\xcex&4VϨ\xcc4VШC4V" +
+	"ѧ\xdb4V\xd2\xc1\x844Vӥ\xbd4VԤ\x154Vդ;4V֣\x1e4Vף" +
+	"04VؤP4V٥84W\x01\xa8\xc84W\x02u\x1a4W\x03\xa4c4W\x04\xa3\xfc4" +
+	"W\x06\xa0\x9a4W\a\xa2\xcf4W\b\xa2\f4W\t\xa0\xf14W\n\xa1\x014W\v\xa2\"4W\f" +
+	"\xa3\b4W\x0e\xa8\xc84W\x0fu\x184W\x10\xa4^4W\x11\xa3\xf54W\x12\xbd\a4W\x13\xa2R" +
+	"4W\x14\xa4\x864W\x15\xa3\xc24W\x16\xa2\xa64W\x17\xa2\xb84W\x18\xa3\xd84W\x19\xa4\xbf4W" +
+	"A\xa8=4WB\xa4_4WCrX4WD\xa034WF\x9dJ4WG\x9f\x804WH\xa2" +
+	"\x9f4WI\xa0\x9b4WJ\xa0\xab4WK\xa1\xcc4WL\xa2\xb24WN\xa8=4WO\xa4[4" +
+	"WPrU4WQ\xa0*4WR\xb8\x904WS\x9f\x024WT\xa164WU\xa4U4WV" +
+	"\xa2P4WW\xa2b4WX\xa3\x824WY\xa4i4W\x81\xa7\xd44W\x82\xa3\xf64W\x83\xa01" +
+	"4W\x84o\xc34W\x86\x9a\x004W\x87\x9c54W\x88\x9fU4W\x89\xa1\x184W\x8a\xa0[4W" +
+	"\x8b\xa1{4W\x8c\xa2a4W\x8e\xa7\xd34W\x8f\xa3\xf24W\x90\xa0*4W\x91o\xbf4W\x92\xb4" +
+	"\x1f4W\x93\x9b\xb74W\x94\x9d\xeb4W\x95\xa1\v4W\x96\xa2\xcc4W\x97\xa2\x114W\x98\xa324" +
+	"W\x99\xa4\x194W\xc1\xc1\x9c4W½%4Wø\xb34WĴE4Wưq4W\xc7" +
+	"\xb3{4Wȷ\x8c4Wɺ[4Wʾr4W˿\x014W\xcc\xc0\x034W\xce\xc1{" +
+	"4WϽ\x024Wи\x8e4WѴ\x1d4W\xd2N74WӲ!4WԵ)4W" +
+	"չ94Wּ\a4W\xd7\xc0 4W\xd8\xc0\xae4W\xd9\xc1\xb14X\x00])4X\x01\xa5" +
+	"\xb74X\x02\xa2T4X\x03\x9f\t4X\x04\x9b\xc34X\x06Io4X\a\x8e\xfd4X\b\x91\xa24" +
+	"X\t\x92\xea4X\n\x94\xe24X\v\x9934X\f\x9934X\x0e\xa5\xb54X\x0f\xa2N4X\x10" +
+	"\x9f\x004X\x11\x9b\xb74X\x12\xb2#4X\x13KJ4X\x14\x90\xb24X\x15\x93X4X\x16\x94\x9f" +
+	"4X\x17\x96\x984X\x18\x9a\xe94X\x19\x9a\xea4X@T\xae4XA\xa4\x0f4XB\xa4\x854X" +
+	"C\xa1;4XD\x9d\xf54XF\x8e\xfb4XGH\xff4XH\x8e`4XI\x8f\xa84XJ\x91" +
+	"\xa04XK\x95\xf14XL\x99A4XN\xa4\f4XO\xa4\x824XP\xa144XQ\x9d\xea4" +
+	"XR\xb5*4XS\x90\xb24XTJ\xd84XU\x90\x164XV\x91]4XW\x93V4XX" +
+	"\x97\xa74XY\x9a\xf94X\x80N\xb34X\x81\xa424X\x82\xa3\xc04X\x83\xa4W4X\x84\xa1\x11" +
+	"4X\x86\x91\x9f4X\x87\x8e^4X\x88H\xe64X\x89\x8c[4X\x8a\x8eS4X\x8b\x92\xa44X" +
+	"\x8c\x95\xf44X\x8e\xa404X\x8f\xa3\xbd4X\x90\xa4R4X\x91\xa1\b4X\x92\xb994X\x93\x93" +
+	"V4X\x94\x90\x144X\x95J\xbf4X\x96\x8e\x104X\x97\x90\t4X\x98\x94Z4X\x99\x97\xac4" +
+	"X\xc0HU4X\xc1\xa3\x154X¢\xa34XâQ4XĢ\xd04Xƒ\xe54X\xc7" +
+	"\x8f\xa54XȌZ4X\xc9G54Xʉ\x954Xˎ\x024X̑R4XΣ\x14" +
+	"4XϢ\xa04XТM4XѢ\xca4XҼ\a4XӔ\x9d4Xԑ[4X" +
+	"Վ\x104X\xd6I\f4X\u05cbM4X؏\xba4Xٓ\f4Y\x00D\xbc4Y\x01\xa3" +
+	"%4Y\x02\xa2\xb34Y\x03\xa2a4Y\x04\xa2\x144Y\x06\x94\xdd4Y\a\x91\x9d4Y\b\x8eQ4" +
+	"Y\t\x89\x954Y\nF\x864Y\v\x8e\x014Y\f\x91Q4Y\x0e\xa3$4Y\x0f\xa2\xb04Y\x10" +
+	"\xa2]4Y\x11\xa2\r4Y\x12\xc0\x1e4Y\x13\x96\x954Y\x14\x93S4Y\x15\x90\b4Y\x16\x8bK" +
+	"4Y\x17H^4Y\x18\x8f\xb94Y\x19\x93\v4Y@CK4YA\xa4D4YB\xa3\xd24Y" +
+	"C\xa3\x804YD\xa324YF\x99,4YG\x95\xec4YH\x92\xa14YI\x8e\x004YJ\x8d" +
+	"\xff4YKGz4YL\x92t4YN\xa4C4YO\xa3\xcf4YP\xa3{4YQ\xa3,4" +
+	"YR\xc0\xaa4YS\x9a\xe44YT\x97\xa34YU\x94W4YV\x8f\xb74YW\x8f\xb84YX" +
+	"IR4YY\x94-4Y\x80A\xf04Y\x81\xa5*4Y\x82\xa4\xb84Y\x83\xa4f4Y\x84\xa4\x18" +
+	"4Y\x86\x99,4Y\x87\x99<4Y\x88\x95\xf14Y\x89\x91P4Y\x8a\x91P4Y\x8b\x92t4Y" +
+	"\x8cG\x9a4Y\x8e\xa5)4Y\x8f\xa4\xb54Y\x90\xa4a4Y\x91\xa4\x124Y\x92\xc1\xac4Y\x93\x9a" +
+	"\xe44Y\x94\x9a\xf34Y\x95\x97\xa74Y\x96\x93\a4Y\x97\x93\a4Y\x98\x94,4Y\x99It4" +
+	"Y߀\x004Y\xe0^\xac4Y\xe1VO4Y\xe2Pf4Y\xe3J\x1b4Y\xe4F\x8b4Y\xe5" +
+	"E\x1d4Y\xe6C\xc64Z\x1bw*4Z\x1c\xa7r4Z\x1d\xa6\xe94Z\x1e\xa6\x814Z\x1f\xbf\xd4" +
+	"4Z \xa4t4Z!\xa2\xd24Z\"\xa2\xf54Z#\xa1\xdc4Z$\xa1\xec4Z%\xa3\x064Z" +
+	"&\xa3\xe84Z\\t\x1c4Z]\xa3\x144Z^\xa2\xac4Z_\xbbg4Z`\xa1\x174Za\xa3" +
+	"@4Zb\xa2~4Zc\xa1e4Zd\xa1u4Ze\xa2\x8f4Zf\xa3r4Z\x9dq[4" +
+	"Z\x9e\x9e\xf24Z\x9f\xb7\x004Z\xa0\x9d\xd54Z\xa1\x9f\xfe4Z\xa2\xa3\x0e4Z\xa3\xa1\x104Z\xa4" +
+	"\xa1 4Z\xa5\xa2:4Z\xa6\xa3\x1d4Z\xden\xc84Z߲\x9e4Z\xe0\x9a\x984Z\xe1\x9c\xc1" +
+	"4Z\xe2\x9f\xd14Z㡊4Z\xe4\xa0\xd14Z\xe5\xa1\xeb4Z\xe6\xa2\xce4[\x1fM\xcd4[" +
+	" \xb0\xab4[!\xb3\xa84[\"\xb7\xa74[#\xbak4[$\xbes4[%\xbe\xfe4[&\xbf" +
+	"\xfc4[`J\xda4[a\x8f\xc64[b\x92^4[c\x93\x9f4[d\x95\x8f4[e\x99\xcf4" +
+	"[f\x99\xcf4[\xa1Jg4[\xa2\x8f(4[\xa3\x90i4[\xa4\x92X4[\xa5\x96\x984[\xa6" +
+	"\x99\xdd4[\xe2JJ4[\xe3\x8d&4[\xe4\x8f\x164[\xe5\x93V4[斚4\\#H\x9d" +
+	"4\\$\x8am4\\%\x8e\xc94\\&\x92\r4\\dG\xf04\\e\x8e\xc84\\f\x92\f4\\" +
+	"\xa5H\xde4\\\xa6\x93*4\\\xe6H\xfd4c\x81z\xe14c\x82\xab~4c\x83\xaa#4c\x84\xa9" +
+	"\x954c\x85\xad\n4c\x87\xa5A4c\x88\xa5h4c\x89\xa4I4c\x8a\xa4x4c\x8b\xa4\x8d4" +
+	"c\x8c\xa5\xb64c\xc1\xab~4c\xc2w\xd34cæ24cĥ\xa44cũ\x1a4c\xc7" +
+	"\xa4\xc14cȤ!4cɣ\x024cʣ14cˣF4c̤o4d\x01\xaa\"" +
+	"4d\x02\xa614d\x03u\x0e4d\x04\xa1\xcd4d\x05\xa5C4d\a\xa1f4d\b\xa4\x934d" +
+	"\t\xa2\x874d\n\xa2\xb74d\v\xa2\xcc4d\f\xa3\xf44dA\xa9\x924dB\xa5\xa14dC\xa1" +
+	"\xcc4dDrv4dE\xa1p4dG\x9e\x0e4dH\xa1<4dI\xa3$4dJ\xa2f4" +
+	"dK\xa2{4dL\xa3\xa44d\x81\xad\x044d\x82\xa9\x144d\x83\xa5>4d\x84\xa1m4d\x85" +
+	"pk4d\x87\x9a\xdc4d\x88\x9e\t4d\x89\x9f\xf14d\x8a\xa3\n4d\x8b\xa2O4d\x8c\xa3x" +
+	"4d\xc0\x80\x004d\xc1Ǆ4d\xc2\xc2\xfc4dþz4dĹ\xfc4dŵ\x8e4d" +
+	"Ǳ\xf54dȶ\x144dɸ\xee4dʽ\x154d\xcb\xc1@4d\xcc\xc1\xd44e\x00T" +
+	"\xf04e\x01\xa6\xfb4e\x02\xa6|4e\x03\xa3$4e\x04\x9f\xd04e\x05\x9c\xa24e\aI\xef4" +
+	"e\b\x90\xef4e\t\x92Z4e\n\x94\xf64e\v\x96\xfa4e\f\x9b_4e@N\xbc4eA" +
+	"\xa7\"4eB\xa5\xdb4eC\xa6O4eD\xa2\xfb4eE\x9f\xcd4eG\x90\xef4eHI\xd9" +
+	"4eI\x8f\x044eJ\x91\xa04eK\x93\xa44eL\x98\t4e\x80He4e\x81\xa6\x014e" +
+	"\x82\xa4\xba4e\x83\xa4B4e\x84\xa4\xe04e\x85\xa1\xb24e\x87\x92X4e\x88\x8f\x024e\x89H" +
+	"I4e\x8a\x8c\xeb4e\x8b\x8e\xef4e\x8c\x93S4e\xc0E\xad4e\xc1\xa604e¤\xe94" +
+	"eäp4eĤ\"4eŤ\xc94eǔ\xf44eȑ\x9e4eɌ\xeb4e\xca" +
+	"Hm4eˎ\xef4e̓q4f\x00C\x1b4f\x01\xa6F4f\x02\xa4\xff4f\x03\xa4\x86" +
+	"4f\x04\xa484f\x05\xa4\x0f4f\a\x96\xfa4f\b\x93\xa44f\t\x8e\xf04f\n\x8e\xf14f" +
+	"\vG\xee4f\f\x93v4f@B\xc74fA\xa7o4fB\xa6(4fC\xa5\xaf4fD\xa5" +
+	"`4fE\xa584fG\x9b^4fH\x98\b4fI\x93U4fJ\x93r4fK\x93v4" +
+	"fLI\x184f\x87T\xbc4f\x88N\x9a4f\x89HX4f\x8aE\xa44f\x8bC\x174f\x8c" +
+	"B\xc34f\x93\x80\xea4f\x94V\x924f\x95Pq4f\x96J-4f\x97G{4f\x98D\xf0" +
+	"4f\x99D\x9c4f\xc1y\xde4fª\x1d4fè\xc74fĨ;4fū\xa34f" +
+	"Ǥ\x004fȤ%4fɣ\n4fʣ84fˣM4f̤q4f\xcey" +
+	"\xdd4fϪ\x1f4fШ\xc84fѨ:4fҫ\x9c4f\xd3ŭ4fԥ\xb84" +
+	"fե\xde4f֤\xc14fפ\xef4fإ\x054f٦)4g\x01\xaa\x194g\x02" +
+	"v\xcf4g\x03\xa4\xe34g\x04\xa4W4g\x05\xa7\xbf4g\a\xa3|4g\b\xa2\xde4g\t\xa1\xc3" +
+	"4g\n\xa1\xf14g\v\xa2\x064g\f\xa3*4g\x0e\xaa\x1b4g\x0fv\xcf4g\x10\xa4\xe24g" +
+	"\x11\xa4T4g\x12\xa7\xb64g\x13\xc104g\x14\xa554g\x15\xa4\x974g\x16\xa3z4g\x17\xa3" +
+	"\xa84g\x18\xa3\xbe4g\x19\xa4\xe24gA\xa8\xbf4gB\xa4\xdf4gCt\r4gD\xa0\x8e4" +
+	"gE\xa3\xf64gG\xa0,4gH\xa3L4gI\xa1H4gJ\xa1v4gK\xa1\x8a4gL" +
+	"\xa2\xaf4gN\xa8\xc14gO\xa4\xe04gPt\f4gQ\xa0\x894gR\xa3\xec4gS\xbc\xb9" +
+	"4gT\xa1\xe54gU\xa5\x044gV\xa2\xff4gW\xa3-4gX\xa3C4gY\xa4g4g" +
+	"\x81\xa814g\x82\xa4Q4g\x83\xa0\x8c4g\x84qy4g\x85\xa044g\x87\x9c\xe24g\x88\xa0" +
+	"\x024g\x89\xa1\xe04g\x8a\xa1&4g\x8b\xa1:4g\x8c\xa2^4g\x8e\xa824g\x8f\xa4Q4" +
+	"g\x90\xa0\x884g\x91qv4g\x92\xa0'4g\x93\xb8H4g\x94\x9e\x9a4g\x95\xa1\xba4g\x96" +
+	"\xa3\x984g\x97\xa2\xdd4g\x98\xa2\xf34g\x99\xa4\x174g\xc1\xab\x964g§\xb64gã\xf1" +
+	"4gĠ14g\xc5oq4gǙ\xbd4gȜ\xdd4gɞ\xbb4gʡ\xc74g" +
+	"ˡ\x0f4g̢34gΫ\x954gϧ\xb34gУ\xeb4gѠ'4g\xd2o" +
+	"l4gӳ\xe64gԛu4g՞\x944g֠r4gף~4gآ\xc74" +
+	"g٣\xec4h\x01\xc5\xc44h\x02\xc1L4h\x03\xbc\xd94h\x04\xb8k4h\x05\xb4\x0e4h\a" +
+	"\xb0|4h\b\xb4\x8d4h\t\xb7\\4h\n\xbbu4h\v\xbf\x904h\f\xc0#4h\x0eţ" +
+	"4h\x0f\xc1*4h\x10\xbc\xb54h\x11\xb8E4h\x12\xb3\xe34h\x13N\b4h\x14\xb2,4h" +
+	"\x15\xb6=4h\x16\xb9\v4h\x17\xbd#4h\x18\xc1@4h\x19\xc1\xd24h@T\x9d4hA\xa5" +
+	"\xb24hB\xa534hC\xa1\xe94hD\x9e\xa24hE\x9b\x824hGI{4hH\x8f\xfe4" +
+	"hI\x91b4hJ\x93\xf44hK\x95\xf04hL\x9aE4hN\xa5\xb04hO\xa514hP" +
+	"\xa1\xe34hQ\x9e\x9a4hR\x9bt4hS\xb2.4hTKW4hU\x91\xb64hV\x93\x19" +
+	"4hW\x95\xab4hX\x97\xa84hY\x9b\xfd4h\x80Ny4h\x81\xa5\xd54h\x82\xa4\x934h" +
+	"\x83\xa5\x054h\x84\xa1\xbf4h\x85\x9e\x9e4h\x87\x8f\xfd4h\x88Ia4h\x89\x8e\x154h\x8a\x90" +
+	"\xa64h\x8b\x92\xa24h\x8c\x96\xf84h\x8e\xa5\xd44h\x8f\xa4\x914h\x90\xa5\x014h\x91\xa1\xb84" +
+	"h\x92\x9e\x924h\x93\xb6=4h\x94\x91\xb44h\x95K=4h\x96\x8f\xcc4h\x97\x92]4h\x98" +
+	"\x94[4h\x99\x98\xb04h\xc0H54h\xc1\xa4\xb84h£v4hâ\xff4hģ\x9a" +
+	"4hŠz4hǑ_4hȎ\x144h\xc9G\xd64hʌ\x054hˎ\x004h" +
+	"̒V4hΤ\xb74hϣu4hТ\xfc4hѣ\x954hҠp4hӹ" +
+	"\v4hԓ\x184hՏ\xcc4h\xd6I\xaf4h\u05cd\xbd4h؏\xbb4hٔ\x104" +
+	"i\x00E\x804i\x01\xa4\xe44i\x02\xa3\xa24i\x03\xa3+4i\x04\xa2\xdd4i\x05\xa3\x824i\a" +
+	"\x93\xef4i\b\x90\xa34i\t\x8c\x034i\nG\xf74i\v\x8e\x004i\f\x92q4i\x0e\xa4\xe4" +
+	"4i\x0f\xa3\xa14i\x10\xa3(4i\x11\xa2\xd94i\x12\xa3z4i\x13\xbd\"4i\x14\x95\xa74i" +
+	"\x15\x92\\4i\x16\x8d\xbc4i\x17I\xd14i\x18\x8f\xba4i\x19\x94+4i@B\xf34iA\xa4" +
+	"\xf84iB\xa3\xb64iC\xa3?4iD\xa2\xf14iE\xa2\xc94iG\x95\xeb4iH\x92\x9f4" +
+	"iI\x8d\xff4iJ\x8e\x004iKGy4iL\x92t4iN\xa4\xf84iO\xa3\xb54iP" +
+	"\xa3=4iQ\xa2\xed4iR\xa2\xc24iS\xc1<4iT\x97\xa34iU\x94X4iV\x8f\xb8" +
+	"4iW\x8f\xb84iXIS4iY\x94.4i\x80B\x9d4i\x81\xa6\x1b4i\x82\xa4\xd94i" +
+	"\x83\xa4b4i\x84\xa4\x144i\x85\xa3\xec4i\x87\x9a>4i\x88\x96\xf34i\x89\x92R4i\x8a\x92" +
+	"o4i\x8b\x92s4i\x8cH\x9d4i\x8e\xa6\x1b4i\x8f\xa4\xd84i\x90\xa4_4i\x91\xa4\x104" +
+	"i\x92\xa3\xe44i\x93\xc1\xcd4i\x94\x9b\xf74i\x95\x98\xab4i\x96\x94\v4i\x97\x94(4i\x98" +
+	"\x94-4i\x99Jx4i\xe0\x80\x004i\xe1V>4i\xe2P.4i\xe3I\xfd4i\xe4GN" +
+	"4i\xe5D\xc74i\xe6Dq4j\x1bx\xda4j\x1c\xa8\xbb4j\x1d\xa7e4j\x1e\xa6\xd94j" +
+	"\x1f\xaa.4j \xc3\xed4j!\xa4o4j\"\xa4\x914j#\xa3y4j$\xa3\xa44j%\xa3" +
+	"\xb84j&\xa4\xd64j\\u\xcc4j]\xa3\x904j^\xa3\x044j_\xa6Y4j`\xbf\x7f4" +
+	"ja\xa3\xec4jb\xa3O4jc\xa264jd\xa2a4je\xa2u4jf\xa3\x934j\x9d" +
+	"s\n4j\x9e\x9fJ4j\x9f\xa2\x9f4j\xa0\xbb\x184j\xa1\xa0\xaa4j\xa2\xa3\xba4j\xa3\xa1\xbc" +
+	"4j\xa4\xa1\xe84j\xa5\xa1\xfb4j\xa6\xa3\x1a4j\xdepx4jߞ\xeb4jභ4j" +
+	"\xe1\x9dm4j\xe2\xa0}4j\xe3\xa2R4j䡘4j塬4j\xe6\xa2\xcb4k\x1fn" +
+	"r4k \xb2f4k!\x9aU4k\"\x9de4k#\x9f:4k$\xa274k%\xa1\x814" +
+	"k&\xa2\xa04k`M\x994ka\xb0\xb64kb\xb4\xb54kc\xb7y4kd\xbb\x814ke" +
+	"\xbf\x8d4kf\xc0\x1c4k\xa1J\xe24k\xa2\x90\xc34k\xa3\x92 4k\xa4\x94\xa54k\xa5\x96\x99" +
+	"4k\xa6\x9a\xdd4k\xe2J\xc54k\xe3\x8e\xdd4k\xe4\x91c4k\xe5\x93V4k旚4l" +
+	"#I<4l$\x8c\xd54l%\x8e\xc94l&\x93\r4ldI[4le\x8e\xc84lf\x93" +
+	"(4l\xa5H\xdf4l\xa6\x93+4l\xe6I\xfd4s\x81z\x9d4s\x82\xaa\xda4s\x83\xa9}4" +
+	"s\x84\xa8\xef4s\x85\xace4s\x86\xb0i4s\x88\xa504s\x89\xa4\x114s\x8a\xa4@4s\x8b" +
+	"\xa4t4s\x8c\xa4\x8d4s\xc1\xaa\xd84s\xc2y\t4sæ\xc84sĥq4sŨ\xc1" +
+	"4sƬ\xc64sȥ\r4sɣ\xee4sʤ\x1e4sˤQ4s̤j4t" +
+	"\x01\xa9|4t\x02\xa6\xc84t\x03v'4t\x04\xa1\x854t\x05\xa4\xd44t\x06\xa8\xd94t\b\xa4" +
+	"\x924t\t\xa2\xac4t\n\xa2\xdb4t\v\xa3\x0e4t\f\xa3'4tA\xa8\xec4tB\xa5p4" +
+	"tC\xa1\x834tDs\x8e4tE\xa1\x024tF\xa5\x064tH\xa1:4tI\xa3\"4tJ" +
+	"\xa2e4tK\xa2\x984tL\xa2\xb14t\x81\xac_4t\x82\xa8\xbc4t\x83\xa4\xd04t\x84\xa0\xff" +
+	"4t\x85q\x844t\x86\xa1X4t\x88\x9e\b4t\x89\x9f\xf04t\x8a\xa3&4t\x8b\xa2l4t" +
+	"\x8c\xa2\x854t\xc1\xb0b4t¬\xc04tè\xd34tĥ\x024tšW4t\xc6p" +
+	"s4tț\xe24tɝ\xca4tʡ\x004tˤ\x1d4ṭf4u\x00\x80\x004" +
+	"u\x01\xc6|4u\x02\xc6%4u\x03\xc1\xa14u\x04\xbd#4u\x05\xb8\xb64u\x06\xb5i4u\b" +
+	"\xb2\xea4u\t\xb5\xc34u\n\xb9\xeb4u\v\xbe\x174u\f\xc2E4u@N%4uA\xa6\xeb" +
+	"4uB\xa6\xca4uC\xa6N4uD\xa2\xfa4uE\x9f\xcc4uF\x9d\xa94uHIQ4u" +
+	"I\x90h4uJ\x93!4uK\x95\xc14uL\x97\xc94u\x80G\xb94u\x81\xa5\xca4u\x82\xa5" +
+	"\xa94u\x83\xa4g4u\x84\xa4\xdf4u\x85\xa1\xb14u\x86\x9f\x8e4u\x88\x90g4u\x89G\xc24" +
+	"u\x8a\x8el4u\x8b\x91\f4u\x8c\x93\x144u\xc0E\x1b4u\xc1\xa5\xf94u¥\xd84u\xc3" +
+	"\xa4\x954uĤ!4uŤ\xe54uƢ\xc24uȓ\x1f4uɎl4u\xcaH\v" +
+	"4uˑ)4u̓24v\x00Cq4v\x01\xa6,4v\x02\xa6\v4v\x03\xa4\xc84v" +
+	"\x04\xa4S4v\x05\xa4+4v\x06\xa5\xdd4v\b\x95\xbf4v\t\x91\f4v\n\x91)4v\vH" +
+	"_4v\f\x9364v@A\xfb4vA\xa6F4vB\xa6%4vC\xa4\xe24vD\xa4n4" +
+	"vE\xa4E4vF\xa5(4vH\x97\xc94vI\x93\x164vJ\x9334vK\x9384vL" +
+	"H\x114v\x88N\x054v\x89G\xad4v\x8aE\x134v\x8bCl4v\x8cA\xf94v\x94\x80\xea" +
+	"4v\x95O\xdd4v\x96I\x844v\x97F\xeb4v\x98EE4v\x99C\xd44v\xc1y\x9b4v" +
+	"©|4vè%4vħ\x984vū\x004vƮ\xf74vȣ\xef4vɢ" +
+	"\xd44vʣ\x024vˣ44ṿM4v\xcey\x9c4vϩ~4vШ'4" +
+	"vѧ\x994vҪ\xfb4vӮ\xf04v\xd4ī4vե\xaa4v֤\x8d4v\xd7" +
+	"\xa4\xbb4vؤ\xed4v٥\a4w\x01\xa9v4w\x02x\x024w\x03\xa5s4w\x04\xa4!" +
+	"4w\x05\xa7d4w\x06\xabZ4w\b\xa3\xc84w\t\xa2\xac4w\n\xa2\xda4w\v\xa3\f4w" +
+	"\f\xa3%4w\x0e\xa9z4w\x0fx\x024w\x10\xa5u4w\x11\xa4!4w\x12\xa7^4w\x13\xab" +
+	"S4w\x14\xc4M4w\x15\xa5\x824w\x16\xa4e4w\x17\xa4\x934w\x18\xa4\xc54w\x19\xa4\xdf4" +
+	"wA\xa8\x1c4wB\xa5p4wCu\"4wD\xa0C4wE\xa3\x864wF\xa7|4wH" +
+	"\xa3J4wI\xa1l4wJ\xa1\x9a4wK\xa1\xcc4wL\xa1\xe44wN\xa8 4wO\xa5s" +
+	"4wPu\"4wQ\xa0B4wR\xa3\x7f4wS\xa7t4wT\xbf\xd64wU\xa5\x054w" +
+	"V\xa3%4wW\xa3S4wX\xa3\x854wY\xa3\x9f4w\x81\xa7\x8e4w\x82\xa4\x1c4w\x83\xa0" +
+	"A4w\x84r\x8d4w\x85\x9f\xc34w\x86\xa3\xb94w\x88\xa0\x004w\x89\xa1\xdf4w\x8a\xa1$4" +
+	"w\x8b\xa1V4w\x8c\xa1o4w\x8e\xa7\x914w\x8f\xa4\x1e4w\x90\xa0A4w\x91r\x8c4w\x92" +
+	"\x9f\xba4w\x93\xa3\xaf4w\x94\xbbe4w\x95\xa1\xbb4w\x96\xa3\x984w\x97\xa2\xdd4w\x98\xa3\x0f" +
+	"4w\x99\xa3)4w\xc1\xaa\xf34w§\\4wã\x814wğ\xc04w\xc5p\x864w" +
+	"Ơ\x1c4wȜ\xdb4wɞ\xba4wʡ\xe24wˡ+4w̡D4wΪ" +
+	"\xf44wϧ\\4wУ~4wџ\xba4w\xd2p\x824wӠ\x0f4wԷ\x044" +
+	"w՞\x954w֠r4wף\x9b4wآ\xe44w٢\xfe4x\x01\xae\xe74x\x02" +
+	"\xabQ4x\x03\xa7u4x\x04\xa3\xb44x\x05\xa0\x194x\x06ou4x\b\x9a\xbe4x\t\x9c\x9d" +
+	"4x\n\x9f\xc54x\v\xa2\xd54x\f\xa2!4x\x0e\xae\xe84x\x0f\xabP4x\x10\xa7s4x" +
+	"\x11\xa3\xae4x\x12\xa0\x0e4x\x13oo4x\x14\xb3\xc04x\x15\x9cx4x\x16\x9eU4x\x17\xa1" +
+	"}4x\x18\xa4\x8d4x\x19\xa3\xdb4xA\xc4\xc04xB\xc4i4xC\xbf\xf54xD\xbb\x874" +
+	"xE\xb7*4xF\xb3\xe94xH\xb1m4xI\xb4<4xJ\xb8U4xK\xbcr4xL" +
+	"\xc0\x924xNĠ4xO\xc4G4xP\xbf\xd34xQ\xbbb4xR\xb7\x014xS\xb3\xbd" +
+	"4xTM\a4xU\xb3\x1f4xV\xb5\xed4xW\xba\x064xX\xbe\"4xY\xc2C4x" +
+	"\x80M\xe54x\x81\xa5\xa04x\x82\xa5\x7f4x\x83\xa5\x044x\x84\xa1\xbe4x\x85\x9e\x9d4x\x86\x9c" +
+	"\x844x\x88H\xdb4x\x89\x8fv4x\x8a\x92$4x\x8b\x94\xb94x\x8c\x96\xb94x\x8e\xa5\x9f4" +
+	"x\x8f\xa5|4x\x90\xa5\x024x\x91\xa1\xb84x\x92\x9e\x924x\x93\x9cv4x\x94\xb3 4x\x95" +
+	"J\xb84x\x96\x91/4x\x97\x93\xdc4x\x98\x96r4x\x99\x98t4x\xc0G\x8b4x\xc1\xa4\x83" +
+	"4x¤b4xã#4xģ\x994xŠy4xƞ_4xȏu4x" +
+	"\xc9GO4xʍ\x824xː\x184x̒\x184xΤ\x834xϤ`4xУ" +
+	"\"4xѣ\x964xҠp4xӞS4xԵ\xee4xՑ/4x\xd6I+4" +
+	"x\u05cf<4xؑ\xd24xٓ\xd44y\x00D\xf04y\x01\xa4\xaf4y\x02\xa4\x8e4y\x03" +
+	"\xa3O4y\x04\xa2\xdc4y\x05\xa3\x9d4y\x06\xa1\x844y\b\x92!4y\t\x8d\x804y\nG\x96" +
+	"4y\v\x9034y\f\x9234y\x0e\xa4\xaf4y\x0f\xa4\x8c4y\x10\xa3N4y\x11\xa2\xd94y" +
+	"\x12\xa3\x974y\x13\xa1z4y\x14\xba\x054y\x15\x93\xdb4y\x16\x8f;4y\x17Iq4y\x18\x91" +
+	"\xed4y\x19\x93\xef4y@CG4yA\xa4\xdf4yB\xa4\xbe4yC\xa3\x7f4yD\xa3\f4" +
+	"yE\xa2\xe44yF\xa4\x904yH\x94\xb54yI\x90\x144yJ\x9014yKG\xe84yL" +
+	"\x9264yN\xa4\xe04yO\xa4\xbd4yP\xa3~4yQ\xa3\n4yR\xa2\xde4yS\xa4\x88" +
+	"4yT\xbe\x1f4yU\x96o4yV\x91\xcf4yW\x91\xeb4yXI\xc34yY\x93\xf24y" +
+	"\x80A\xd44y\x81\xa4\xf74y\x82\xa4\xd64y\x83\xa3\x984y\x84\xa3%4y\x85\xa2\xfd4y\x86\xa3" +
+	"\xdc4y\x88\x96\xb54y\x89\x92\x144y\x8a\x9214y\x8b\x9264y\x8cG\x9a4y\x8e\xa4\xf84" +
+	"y\x8f\xa4\xd54y\x90\xa3\x974y\x91\xa3\"4y\x92\xa2\xf74y\x93\xa3\xd44y\x94\xc2>4y\x95" +
+	"\x98o4y\x96\x93\xcf4y\x97\x93\xec4y\x98\x93\xf04y\x99Iv4y\xe1\x80\x004y\xe2O\x9d" +
+	"4y\xe3IV4y\xe4F\xc04y\xe5E\x1b4y\xe6C\xac4z\x1bx\x9a4z\x1c\xa8\x1c4z" +
+	"\x1d\xa6\xc74z\x1e\xa6:4z\x1f\xa9\x904z \xadv4z!\xc2\xee4z\"\xa4^4z#\xa3" +
+	"E4z$\xa3q4z%\xa3\xa04z&\xa3\xb84z\\v\xfb4z]\xa4\x1d4z^\xa2\xce4" +
+	"z_\xa5\xfe4z`\xa9\xe44za\u00924zb\xa474zc\xa3\x1e4zd\xa3I4ze" +
+	"\xa3x4zf\xa3\x904z\x9dt\x1e4z\x9e\x9e\xff4z\x9f\xa204z\xa0\xa6\x164z\xa1\xbe+" +
+	"4z\xa2\xa3\xba4z\xa3\xa1\xe24z\xa4\xa2\r4z\xa5\xa2<4z\xa6\xa2T4z\xdeq\x8b4z" +
+	"ߞ{4z\xe0\xa2b4z\xe1\xb9\xc94z\xe2\xa0~4z\xe3\xa2R4z䡙4z\xe5\xa1" +
+	"\xc84z\xe6\xa1\xe04{\x1fo\x854{ \x9e\xd24{!\xb5x4{\"\x9df4{#\x9f:4" +
+	"{$\xa2S4{%\xa1\x9d4{&\xa1\xb54{`nq4{a\xb2?4{b\x9bR4{c" +
+	"\x9d&4{d\xa0?4{e\xa3@4{f\xa2\x8f4{\xa1L\x994{\xa2\xb1\xa34{\xa3\xb4g" +
+	"4{\xa4\xb8o4{\xa5\xbc{4{\xa6\xc0\x8b4{\xe2JB4{\xe3\x90=4{\xe4\x92\xde4{" +
+	"\xe5\x95g4{\xe6\x97_4|#H\xb94|$\x8eQ4|%\x90\xda4|&\x92\xd24|dH" +
+	"\xfc4|e\x90\xf54|f\x92\xed4|\xa5IL4|\xa6\x92\xf04|\xe6H\xff4\x83\x81y\xb44" +
+	"\x83\x82\xa9\xb14\x83\x83\xa9\x904\x83\x84\xa884\x83\x85\xab\x874\x83\x86\xaf\x8c4\x83\x87\xaf14\x83\x89" +
+	"\xa3\xfd4\x83\x8a\xa4,4\x83\x8b\xa4_4\x83\x8c\xa4\x964\x83\xc1\xa9\xb14\x83\xc2w\xdc4\x83å\xe6" +
+	"4\x83Ĥ\x8d4\x83ŧ\xdd4\x83ƫ\xe24\x83ǯ\x0e4\x83ɢ\xf04\x83ʣ\x1f4\x83" +
+	"ˣS4\x83̣\x8a4\x84\x01\xa9\x8e4\x84\x02\xa5\xe44\x84\x03vs4\x84\x04\xa1\xdc4\x84\x05\xa4" +
+	"d4\x84\x06\xa8B4\x84\a\xabo4\x84\t\xa2\xd14\x84\n\xa3\x014\x84\v\xa344\x84\f\xa3k4" +
+	"\x84A\xa864\x84B\xa4\x8c4\x84C\xa1\xdc4\x84Ds\xbd4\x84E\xa0{4\x84F\xa4Z4\x84G" +
+	"\xa7\x864\x84I\xa2Z4\x84J\xa1\xc24\x84K\xa1\xf54\x84L\xa2-4\x84\x81\xab\x834\x84\x82\xa7\xd9" +
+	"4\x84\x83\xa4`4\x84\x84\xa0x4\x84\x85q\xb24\x84\x86\xa0\xac4\x84\x87\xa3\xd84\x84\x89\x9f'4\x84" +
+	"\x8a\xa2]4\x84\x8b\xa1\xa34\x84\x8c\xa1\xdb4\x84\xc1\xaf\x864\x84«\xdc4\x84è>4\x84Ĥ" +
+	"U4\x84Š\xaa4\x84\xc6p\xa14\x84ǡ74\x84ɝ\x014\x84ʠ74\x84ˣq4" +
+	"\x84̢\xbc4\x85\x01\xaf*4\x85\x02\xaf\a4\x85\x03\xabi4\x85\x04\xa7\x814\x85\x05\xa3\xd54\x85\x06" +
+	"\xa154\x85\an\xd34\x85\t\x9a\xa14\x85\n\x9d\xd74\x85\v\xa1\x114\x85\f\xa4O4\x85@\x80\x00" +
+	"4\x85A\xc6\xf04\x85Bő4\x85C\xc5>4\x85D\xc0\xbe4\x85E\xbcQ4\x85F\xb9\x044\x85" +
+	"G\xb5\x9b4\x85I\xb1\xfe4\x85J\xb6%4\x85K\xbaQ4\x85L\xbe\x814\x85\x80F\xe24\x85\x81\xa5" +
+	"\xb84\x85\x82\xa4\xab4\x85\x83\xa4\x8e4\x85\x84\xa4\x174\x85\x85\xa0\xe94\x85\x86\x9e\xc64\x85\x87\x9ci4" +
+	"\x85\x89F\xb34\x85\x8a\x8f,4\x85\x8b\x91\xe84\x85\x8c\x94\xa94\x85\xc0DF4\x85\xc1\xa5\xe64\x85\xc2" +
+	"\xa4\xda4\x85ä\xbd4\x85ģ~4\x85Ť\x1d4\x85ơ\xfa4\x85ǟ\x9d4\x85ɏ," +
+	"4\x85\xcaF\xfd4\x85˒\x064\x85̔\xc74\x86\x00B\x9f4\x86\x01\xa6\x194\x86\x02\xa5\r4\x86" +
+	"\x03\xa4\xef4\x86\x04\xa3\xb14\x86\x05\xa3c4\x86\x06\xa524\x86\a\xa2\xd54\x86\t\x91\xe84\x86\n\x92" +
+	"\x064\x86\vGw4\x86\f\x94\xe84\x86@A,4\x86A\xa6P4\x86B\xa5D4\x86C\xa5&4" +
+	"\x86D\xa3\xe84\x86E\xa3\x994\x86F\xa4|4\x86G\xa6\x114\x86I\x94\xa94\x86J\x94\xc64\x86K" +
+	"\x94\xe84\x86LH!4\x86\x89F\xd94\x86\x8aDB4\x86\x8bB\x9e4\x86\x8cA/4\x86\x95\x80\xeb" +
+	"4\x86\x96H\xb24\x86\x97F\x1c4\x86\x98Dx4\x86\x99C\n4\x86\xc1x\xb54\x86¨X4\x86" +
+	"è74\x86Ħ\xe34\x86Ū&4\x86Ʈ\x1c4\x86ǭ\xc34\x86ɢ\xc04\x86ʢ" +
+	"\xee4\x86ˣ!4\x86̣W4\x86\xcex\xb74\x86Ϩ\\4\x86Ш94\x86Ѧ\xe54" +
+	"\x86Ҫ\"4\x86Ӯ\x174\x86ԭ\xbd4\x86\xd5\xc5\x1d4\x86֤{4\x86פ\xa94\x86\xd8" +
+	"\xa4\xdb4\x86٥\x114\x87\x01\xa8S4\x87\x02v\xd94\x87\x03\xa4\x954\x87\x04\xa3A4\x87\x05\xa6\x84" +
+	"4\x87\x06\xaaz4\x87\a\xad\x9c4\x87\t\xa1\xb34\x87\n\xa1\xe14\x87\v\xa2\x134\x87\f\xa2I4\x87" +
+	"\x0e\xa8W4\x87\x0fv\xdb4\x87\x10\xa4\x974\x87\x11\xa3C4\x87\x12\xa6\x804\x87\x13\xaau4\x87\x14\xad" +
+	"\x954\x87\x15ý4\x87\x16\xa3m4\x87\x17\xa3\x9b4\x87\x18\xa3\xcd4\x87\x19\xa4\x044\x87A\xa8.4" +
+	"\x87B\xa4\x914\x87Cun4\x87D\xa0\x954\x87E\xa3\x134\x87F\xa6\xe44\x87G\xaa\x054\x87I" +
+	"\xa1\x914\x87J\xa1\xc04\x87K\xa1\xf24\x87L\xa2(4\x87N\xa824\x87O\xa4\x944\x87Pun" +
+	"4\x87Q\xa0\x974\x87R\xa3\x0f4\x87S\xa6\xdf4\x87T\xa9\xff4\x87U\xc3f4\x87V\xa3L4\x87" +
+	"W\xa3z4\x87X\xa3\xac4\x87Y\xa3\xe24\x87\x81\xa6\xda4\x87\x82\xa3=4\x87\x83\xa0\x954\x87\x84r" +
+	"\xbc4\x87\x85\x9f;4\x87\x86\xa3\f4\x87\x87\xa6-4\x87\x89\xa1\x1a4\x87\x8a\xa0\x854\x87\x8b\xa0\xb74" +
+	"\x87\x8c\xa0\xed4\x87\x8e\xa6\xde4\x87\x8f\xa3@4\x87\x90\xa0\x974\x87\x91r\xbc4\x87\x92\x9f54\x87\x93" +
+	"\xa3\x054\x87\x94\xa6%4\x87\x95\xbe\xf54\x87\x96\xa2\xd54\x87\x97\xa2?4\x87\x98\xa2q4\x87\x99\xa2\xa7" +
+	"4\x87\xc1\xaa\x1a4\x87¦}4\x87ã\x0f4\x87ğ84\x87\xc5p\xb54\x87Ɵn4\x87" +
+	"Ǣ\x904\x87ɝ\xf54\x87ʡ\x1d4\x87ˠf4\x87̠\x9d4\x87Ϊ\x1b4\x87Ϧ" +
+	"~4\x87У\x0f4\x87џ64\x87\xd2p\xb24\x87ӟe4\x87Ԣ\x854\x87պ\x934" +
+	"\x87֟\xaf4\x87ע\xd74\x87آ!4\x87٢W4\x88\x01\xae\x0e4\x88\x02\xaaq4\x88\x03" +
+	"\xa6\xde4\x88\x04\xa3\a4\x88\x05\x9fl4\x88\x06o\xa34\x88\a\x9f\xfa4\x88\t\x9b\xd84\x88\n\x9f\x00" +
+	"4\x88\v\xa2,4\x88\f\xa1y4\x88\x0e\xae\x0f4\x88\x0f\xaar4\x88\x10\xa6\xde4\x88\x11\xa3\x054\x88" +
+	"\x12\x9fd4\x88\x13o\x9f4\x88\x14\x9f\xed4\x88\x15\xb7O4\x88\x16\x9d\x924\x88\x17\xa0\xba4\x88\x18\xa3" +
+	"\xe64\x88\x19\xa334\x88A\xad\xb44\x88B\xad\x914\x88C\xa9\xfe4\x88D\xa6'4\x88E\xa2\x8c4" +
+	"\x88F\x9f\xf84\x88Gm\xd84\x88I\x99\x824\x88J\x9c\xaa4\x88K\x9f\xd74\x88L\xa3\a4\x88N" +
+	"\xad\xb44\x88O\xad\x914\x88P\xa9\xfe4\x88Q\xa6$4\x88R\xa2\x844\x88S\x9f\xed4\x88Tm\xd3" +
+	"4\x88U\xb3\xef4\x88V\x9b<4\x88W\x9ed4\x88X\xa1\x914\x88Y\xa4\xc14\x88\x81\xc504\x88" +
+	"\x82\xc3\xd64\x88\x83Ä4\x88\x84\xbf\x144\x88\x85\xba\xb74\x88\x86\xb7v4\x88\x87\xb4\x184\x88\x89\xb0" +
+	"\x824\x88\x8a\xb4\x9b4\x88\x8b\xb8\xb84\x88\x8c\xbc\xd94\x88\x8e\xc5\x114\x88\x8fö4\x88\x90\xc3a4" +
+	"\x88\x91\xbe\xf14\x88\x92\xba\x8f4\x88\x93\xb7K4\x88\x94\xb3\xeb4\x88\x95K\xca4\x88\x96\xb244\x88\x97" +
+	"\xb6M4\x88\x98\xbaj4\x88\x99\xbe\x8a4\x88\xc0F\xb74\x88\xc1\xa4q4\x88£h4\x88ãK" +
+	"4\x88Ģ\xd44\x88ş\xb44\x88Ɲ\x9b4\x88ǛI4\x88\xc9FE4\x88ʎB4\x88" +
+	"ː\xf44\x88̓\xa94\x88Τq4\x88ϣh4\x88УI4\x88Ѣ\xd34\x88ҟ" +
+	"\xad4\x88ӝ\x904\x88ԛ;4\x88ղ74\x88\xd6H\"4\x88\u05cf\xfe4\x88ؒ\xb04" +
+	"\x88ٕf4\x89\x00D\x1e4\x89\x01\xa4\x9d4\x89\x02\xa3\x944\x89\x03\xa3w4\x89\x04\xa2=4\x89\x05" +
+	"\xa2\xd84\x89\x06\xa0\xbf4\x89\a\x9em4\x89\t\x8eA4\x89\nF\x8c4\x89\v\x91\x0f4\x89\f\x93\xc5" +
+	"4\x89\x0e\xa4\x9d4\x89\x0f\xa3\x944\x89\x10\xa3v4\x89\x11\xa2;4\x89\x12\xa2\xd34\x89\x13\xa0\xb74\x89" +
+	"\x14\x9ea4\x89\x15\xb6N4\x89\x16\x8f\xfd4\x89\x17Hi4\x89\x18\x92\xcb4\x89\x19\x95\x814\x89@B" +
+	"x4\x89A\xa4\xcd4\x89B\xa3\xc44\x89C\xa3\xa74\x89D\xa2m4\x89E\xa2 4\x89F\xa3\xe84" +
+	"\x89G\xa1\x964\x89I\x90\xf04\x89J\x91\r4\x89KG\x034\x89L\x93\xe44\x89N\xa4\xce4\x89O" +
+	"\xa3\xc54\x89P\xa3\xa64\x89Q\xa2l4\x89R\xa2\x1b4\x89S\xa3\xe14\x89T\xa1\x8c4\x89U\xbah" +
+	"4\x89V\x92\xad4\x89W\x92\xc94\x89XH\xe04\x89Y\x95\xa04\x89\x80A\a4\x89\x81\xa5\x024\x89" +
+	"\x82\xa3\xf94\x89\x83\xa3\xdb4\x89\x84\xa2\xa14\x89\x85\xa2T4\x89\x86\xa334\x89\x87\xa4\xc24\x89\x89\x93" +
+	"\xa44\x89\x8a\x93\xc14\x89\x8b\x93\xe24\x89\x8cG\xaa4\x89\x8e\xa5\x024\x89\x8f\xa3\xf94\x89\x90\xa3\xdb4" +
+	"\x89\x91\xa2\xa04\x89\x92\xa2O4\x89\x93\xa3-4\x89\x94\xa4\xbb4\x89\x95\xbe\x874\x89\x96\x95a4\x89\x97" +
+	"\x95~4\x89\x98\x95\x9e4\x89\x99I\x874\x89\xe2\x80\x004\x89\xe3H\x874\x89\xe4E\xf44\x89\xe5DQ" +
+	"4\x89\xe6B\xe54\x8a\x1bw\xb74\x8a\x1c\xa6\xfe4\x8a\x1d\xa6\xd94\x8a\x1e\xa5\x894\x8a\x1f\xa8\xba4\x8a" +
+	" \xac\xa04\x8a!\xacF4\x8a\"\xc3]4\x8a#\xa354\x8a$\xa3`4\x8a%\xa3\x8f4\x8a&\xa3" +
+	"\xc34\x8a\\u\xd84\x8a]\xa3C4\x8a^\xa1\xf44\x8a_\xa5$4\x8a`\xa9\n4\x8aa\xac\x1f4" +
+	"\x8ab\xc2\x024\x8ac\xa2+4\x8ad\xa2V4\x8ae\xa2\x854\x8af\xa2\xb94\x8a\x9dti4\x8a\x9e" +
+	"\x9fP4\x8a\x9f\xa1\xbe4\x8a\xa0\xa5\x7f4\x8a\xa1\xa8\x944\x8a\xa2\xc1\xac4\x8a\xa3\xa2\t4\x8a\xa4\xa25" +
+	"4\x8a\xa5\xa2d4\x8a\xa6\xa2\x974\x8a\xdeq\xbb4\x8aߝ\xf54\x8aࡷ4\x8a\xe1\xa4\xcb4\x8a" +
+	"\xe2\xbdK4\x8a㡓4\x8a\xe4\xa0\xfe4\x8a\xe5\xa1-4\x8a\xe6\xa1a4\x8b\x1fo\xb54\x8b \x9e" +
+	"'4\x8b!\xa1;4\x8b\"\xb8\xf94\x8b#\x9e{4\x8b$\xa1\x934\x8b%\xa0\xde4\x8b&\xa1\x114" +
+	"\x8b`n\xa14\x8ba\x9e\xb04\x8bb\xb5\xc14\x8bc\x9cg4\x8bd\x9f\x7f4\x8be\xa2\x9c4\x8bf" +
+	"\xa1\xeb4\x8b\xa1l\xd84\x8b\xa2\xb2l4\x8b\xa3\x9a\x1c4\x8b\xa4\x9d44\x8b\xa5\xa0Q4\x8b\xa6\xa3r" +
+	"4\x8b\xe2K[4\x8b㰻4\x8b\xe4\xb4\xc34\x8b\xe5\xb8\xcf4\x8b\xe6\xbc\xdf4\x8c#G\xb44\x8c" +
+	"$\x8f\x134\x8c%\x91\xb84\x8c&\x94a4\x8cdG\xf84\x8ce\x91\xd34\x8cf\x94|4\x8c\xa5H" +
+	"l4\x8c\xa6\x94\x9a4\x8c\xe6I\x104\x93\x81|\xf34\x93\x82\xaa\xfb4\x93\x83\xa9\xef4\x93\x84\xa9\xd14" +
+	"\x93\x85\xacW4\x93\x86\xb064\x93\x87\xaf\xdc4\x93\x88\xb0\xba4\x93\x8a\xa8\"4\x93\x8b\xa8Y4\x93\x8c" +
+	"\xa8\x914\x93\xc1\xaa\xfb4\x93\xc2{\x1b4\x93æD4\x93Ħ'4\x93Ũ\xad4\x93Ƭ\x8c" +
+	"4\x93ǯ\xb94\x93ȯ\xae4\x93ʧ\x154\x93˧M4\x93̧\x844\x94\x01\xa9\xef4\x94" +
+	"\x02\xa6D4\x94\x03yn4\x94\x04\xa2\x814\x94\x05\xa5\a4\x94\x06\xa8\xe64\x94\a\xac\x134\x94\b\xaf" +
+	"\x8f4\x94\n\xa6\r4\x94\v\xa6E4\x94\f\xa6|4\x94A\xa9\xd04\x94B\xa6&4\x94C\xa2\x804" +
+	"\x94Dx24\x94E\xa2Z4\x94F\xa5p4\x94G\xa8w4\x94H\xab\xf34\x94J\xa5\xf34\x94K" +
+	"\xa6*4\x94L\xa6a4\x94\x81\xacT4\x94\x82\xa8\xaa4\x94\x83\xa5\x044\x94\x84\xa2X4\x94\x85v\t" +
+	"4\x94\x86\xa1\xad4\x94\x87\xa4\xb34\x94\x88\xa804\x94\x8a\xa5\xa04\x94\x8b\xa5\x104\x94\x8c\xa5G4\x94" +
+	"\xc1\xb024\x94¬\x884\x94è\xe14\x94ĥm4\x94š\xab4\x94\xc6t\xf84\x94Ǣ" +
+	"\x124\x94ȥ\x8e4\x94ʣz4\x94˦\xb84\x94̦\x024\x95\x01\xaf\xd64\x95\x02\xaf\xb34" +
+	"\x95\x03\xac\r4\x95\x04\xa8s4\x95\x05\xa4\xb04\x95\x06\xa2\x104\x95\as*4\x95\b\xa2\xb34\x95\n" +
+	"\xa1\x1a4\x95\v\xa4X4\x95\f\xa7\x964\x95A\xb0\xb44\x95B\xaf\xa84\x95C\xaf\x894\x95D\xab\xef" +
+	"4\x95E\xa8-4\x95F\xa5\x8d4\x95G\xa2\xb34\x95Hr\xe64\x95J\x9e\xc24\x95K\xa2\x014\x95" +
+	"L\xa5?4\x95\x80\x80\x004\x95\x81\xc7\xc94\x95\x82\xc6j4\x95\x83\xc5\x0f4\x95\x84\xc4\xc04\x95\x85\xc0" +
+	"Q4\x95\x86\xbd\x044\x95\x87\xb9\x9a4\x95\x88\xbac4\x95\x8a\xb684\x95\x8b\xbah4\x95\x8c\xbe\x984" +
+	"\x95\xc0D\xc84\x95\xc1\xa9\xe04\x95¨\xd44\x95ç\xcc4\x95ħ\xb34\x95ŧa4\x95\xc6" +
+	"\xa5>4\x95Ǣ\xe24\x95Ƞ\x8d4\x95\xcaH\xac4\x95˖\x924\x95̙R4\x96\x00B\xe5" +
+	"4\x96\x01\xaa\x174\x96\x02\xa9\v4\x96\x03\xa8\x034\x96\x04\xa7\xea4\x96\x05\xa6\xd14\x96\x06\xa8{4\x96" +
+	"\a\xa6\x1e4\x96\b\xa3\xc94\x96\n\x96\x924\x96\vI&4\x96\f\x99t4\x96@B|4\x96A\xaa" +
+	"N4\x96B\xa9B4\x96C\xa894\x96D\xa8 4\x96E\xa7\b4\x96F\xa7\xc54\x96G\xa9Z4" +
+	"\x96H\xa7\x054\x96J\x99R4\x96K\x99t4\x96LI\xd14\x96\x8aD\xc04\x96\x8bB\xe04\x96\x8c" +
+	"Bw4\x96\x96\x80\xeb4\x96\x97F\x9a4\x96\x98D\xbb4\x96\x99DS4\x96\xc1{\xe94\x96©\x9c" +
+	"4\x96è\x934\x96Ĩv4\x96Ū\xf24\x96Ʈ\xc34\x96Ǯj4\x96ȯE4\x96" +
+	"ʦ\xd34\x96˧\n4\x96̧@4\x96\xce{\xea4\x96ϩ\xa04\x96Ш\x974\x96Ѩ" +
+	"x4\x96Ҫ\xf04\x96Ӯ\xbf4\x96Ԯe4\x96կ@4\x96\xd6\xc5\xf34\x96ר\x924" +
+	"\x96ب\xc84\x96٨\xfe4\x97\x01\xa9\x984\x97\x02z\r4\x97\x03\xa4\xf14\x97\x04\xa4\xd44\x97\x05" +
+	"\xa7O4\x97\x06\xab!4\x97\a\xaeB4\x97\b\xae74\x97\n\xa5\xc64\x97\v\xa5\xfc4\x97\f\xa62" +
+	"4\x97\x0e\xa9\x9b4\x97\x0fz\x0e4\x97\x10\xa4\xf54\x97\x11\xa4\xd64\x97\x12\xa7N4\x97\x13\xab\x1d4\x97" +
+	"\x14\xae=4\x97\x15\xae24\x97\x16ē4\x97\x17\xa7\x844\x97\x18\xa7\xba4\x97\x19\xa7\xf04\x97A\xa8" +
+	"\x8c4\x97B\xa4\xef4\x97Cx_4\x97D\xa184\x97E\xa3\xb44\x97F\xa7\x854\x97G\xaa\xa64" +
+	"\x97H\xae\x164\x97J\xa4\xbe4\x97K\xa4\xf54\x97L\xa5+4\x97N\xa8\x904\x97O\xa4\xf24\x97P" +
+	"x`4\x97Q\xa1:4\x97R\xa3\xb24\x97S\xa7\x824\x97T\xaa\xa14\x97U\xae\x114\x97V\xc39" +
+	"4\x97W\xa6}4\x97X\xa6\xb34\x97Y\xa6\xe94\x97\x81\xa8m4\x97\x82\xa4\xcf4\x97\x83\xa164\x97" +
+	"\x84w\"4\x97\x85\xa1\x0e4\x97\x86\xa4\x194\x97\x87\xa7\x164\x97\x88\xaa\x854\x97\x8a\xa4\xa34\x97\x8b\xa4" +
+	"\xd94\x97\x8c\xa5\x104\x97\x8e\xa8p4\x97\x8f\xa4\xd34\x97\x90\xa194\x97\x91w\"4\x97\x92\xa1\f4" +
+	"\x97\x93\xa4\x164\x97\x94\xa7\x114\x97\x95\xaa\x804\x97\x96\xc2\xe84\x97\x97\xa6a4\x97\x98\xa6\x974\x97\x99" +
+	"\xa6\xce4\x97\xc1\xaa\xe74\x97§J4\x97ã\xb04\x97ġ\f4\x97\xc5t\xfd4\x97Ơf" +
+	"4\x97ǣc4\x97Ȧ\xd24\x97ʤQ4\x97ˣ\xc34\x97̣\xfa4\x97Ϊ\xe84\x97" +
+	"ϧK4\x97У\xb14\x97ѡ\f4\x97\xd2t\xfb4\x97Ӡa4\x97ԣ\\4\x97զ" +
+	"\xcb4\x97־\x864\x97צ\x0f4\x97إ\x814\x97٥\xb74\x98\x01\xae\xb64\x98\x02\xab\x194" +
+	"\x98\x03\xa7\x7f4\x98\x04\xa4\x164\x98\x05\xa0d4\x98\x06s\xeb4\x98\a\xa0\xcd4\x98\b\xa4<4\x98\n" +
+	"\xa234\x98\v\xa5d4\x98\f\xa4\xb14\x98\x0e\xae\xb74\x98\x0f\xab\x1a4\x98\x10\xa7\x804\x98\x11\xa4\x16" +
+	"4\x98\x12\xa0`4\x98\x13s\xe94\x98\x14\xa0\xc44\x98\x15\xa434\x98\x16\xbbB4\x98\x17\xa3\xf14\x98" +
+	"\x18\xa7!4\x98\x19\xa6o4\x98A\xae[4\x98B\xae94\x98C\xaa\x9f4\x98D\xa7\x114\x98E\xa3" +
+	"_4\x98F\xa0\xcb4\x98Gr 4\x98H\xa1n4\x98J\x9f\xde4\x98K\xa3\x0e4\x98L\xa6?4" +
+	"\x98N\xae\\4\x98O\xae94\x98P\xaa\xa04\x98Q\xa7\x104\x98R\xa3[4\x98S\xa0\xc44\x98T" +
+	"r\x1c4\x98U\xa1c4\x98V\xb7\xe24\x98W\xa1\x9c4\x98X\xa4\xcc4\x98Y\xa7\xfc4\x98\x81\xaf5" +
+	"4\x98\x82\xae,4\x98\x83\xae\r4\x98\x84\xaa\x7f4\x98\x85\xa6\xcd4\x98\x86\xa494\x98\x87\xa1m4\x98" +
+	"\x88q\xd94\x98\x8a\x9d\x904\x98\x8b\xa0\xc04\x98\x8c\xa3\xf04\x98\x8e\xaf64\x98\x8f\xae-4\x98\x90\xae" +
+	"\x0e4\x98\x91\xaa~4\x98\x92\xa6\xc94\x98\x93\xa424\x98\x94\xa1b4\x98\x95q\xd54\x98\x96\xb8\xa64" +
+	"\x98\x97\x9fM4\x98\x98\xa2}4\x98\x99\xa5\xae4\x98\xc1\xc6\x064\x98\xc2ī4\x98\xc3\xc3U4\x98\xc4" +
+	"\xc3\a4\x98ž\xa84\x98ƻg4\x98Ǹ\t4\x98ȸ\xcf4\x98ʴ\xac4\x98˸\xcd" +
+	"4\x98̼\xee4\x98\xce\xc5\xe74\x98\xcfČ4\x98\xd0\xc344\x98\xd1\xc2\xe44\x98Ҿ\x824\x98" +
+	"ӻ>4\x98Է\xde4\x98ո\xa34\x98\xd6O/4\x98\u05f6c4\x98غ\x844\x98پ" +
+	"\xa44\x99\x00D\xa04\x99\x01\xa8\x864\x99\x02\xa7}4\x99\x03\xa6x4\x99\x04\xa6_4\x99\x05\xa6\x0e4" +
+	"\x99\x06\xa3\xf54\x99\a\xa1\xa34\x99\b\x9fX4\x99\nH/4\x99\v\x95\x884\x99\f\x98>4\x99\x0e" +
+	"\xa8\x864\x99\x0f\xa7}4\x99\x10\xa6x4\x99\x11\xa6]4\x99\x12\xa6\n4\x99\x13\xa3\xee4\x99\x14\xa1\x98" +
+	"4\x99\x15\x9fL4\x99\x16\xb6e4\x99\x17J\x0f4\x99\x18\x97D4\x99\x19\x99\xf94\x99@B\xbe4\x99" +
+	"A\xa8\xba4\x99B\xa7\xb14\x99C\xa6\xac4\x99D\xa6\x934\x99E\xa5\x7f4\x99F\xa7!4\x99G\xa4" +
+	"\xcf4\x99H\xa2\x854\x99J\x95\x874\x99KH\xa74\x99L\x98]4\x99N\xa8\xbb4\x99O\xa7\xb14" +
+	"\x99P\xa6\xac4\x99Q\xa6\x924\x99R\xa5{4\x99S\xa7\x1c4\x99T\xa4\xc74\x99U\xa2z4\x99V" +
+	"\xba\x844\x99W\x97B4\x99XJ\x854\x99Y\x9a\x194\x99\x80BS4\x99\x81\xa8\xef4\x99\x82\xa7\xe6" +
+	"4\x99\x83\xa6\xe14\x99\x84\xa6\xc84\x99\x85\xa5\xb34\x99\x86\xa6m4\x99\x87\xa7\xfc4\x99\x88\xa5\xb14\x99" +
+	"\x8a\x98;4\x99\x8b\x98\\4\x99\x8cIN4\x99\x8e\xa8\xef4\x99\x8f\xa7\xe64\x99\x90\xa6\xe14\x99\x91\xa6" +
+	"\xc64\x99\x92\xa5\xb04\x99\x93\xa6h4\x99\x94\xa7\xf64\x99\x95\xa5\xa94\x99\x96\xbe\xa34\x99\x97\x99\xf64" +
+	"\x99\x98\x9a\x174\x99\x99K,4\x99\xe3\x80\x004\x99\xe4Fr4\x99\xe5D\x944\x99\xe6D*4\x9a\x1b" +
+	"z\xdf4\x9a\x1c\xa8=4\x9a\x1d\xa744\x9a\x1e\xa7\x154\x9a\x1f\xa9\x834\x9a \xadD4\x9a!\xac\xea" +
+	"4\x9a\"\xad\xc14\x9a#\xc4/4\x9a$\xa774\x9a%\xa7k4\x9a&\xa7\x9e4\x9a\\y\x004\x9a" +
+	"]\xa3\x9f4\x9a^\xa3\x7f4\x9a_\xa5\xed4\x9a`\xa9\xae4\x9aa\xac\xc34\x9ab\xac\xb64\x9ac\xc2" +
+	"\xd44\x9ad\xa6-4\x9ae\xa6a4\x9af\xa6\x944\x9a\x9dwQ4\x9a\x9e\x9f\xf04\x9a\x9f\xa2^4" +
+	"\x9a\xa0\xa6\x1f4\x9a\xa1\xa944\x9a\xa2\xac\x954\x9a\xa3\xc1\x804\x9a\xa4\xa5)4\x9a\xa5\xa5]4\x9a\xa6" +
+	"\xa5\x904\x9a\xdev\x114\x9aߟ\xc04\x9aࢿ4\x9a\u196f4\x9a\xe2\xa9\x104\x9a\xe3\xc1/" +
+	"4\x9a\xe4\xa5\x0e4\x9a\xe5\xa5A4\x9a\xe6\xa5u4\x9b\x1fs\xef4\x9b \x9f\x1a4\x9b!\xa2\n4\x9b" +
+	"\"\xa5k4\x9b#\xbc\xde4\x9b$\xa4\xbb4\x9b%\xa4/4\x9b&\xa4b4\x9b`r\xdc4\x9ba\x9f" +
+	"~4\x9bb\xa2\xe04\x9bc\xb9\xa54\x9bd\xa2\xa84\x9be\xa5\xc84\x9bf\xa5\x174\x9b\xa1q\x124" +
+	"\x9b\xa2\xa0\x1d4\x9b\xa3\xb6Q4\x9b\xa4\xa0]4\x9b\xa5\xa3}4\x9b\xa6\xa6\x9e4\x9b\xe2p\xc94\x9b\xe3" +
+	"\xb7\x124\x9b\xe4\x9e\x194\x9b\xe5\xa194\x9b\xe6\xa4Z4\x9c#N\xb14\x9c$\xb4\xd84\x9c%\xb8\xe8" +
+	"4\x9c&\xbc\xf94\x9cdI\x924\x9ce\x9694\x9cf\x98\xe24\x9c\xa5J\x064\x9c\xa6\x99\x014\x9c" +
+	"\xe6J\xaa4\xa3\x81|\n4\xa3\x82\xaa\xbd4\xa3\x83\xa9\xb14\xa3\x84\xa8\xa94\xa3\x85\xacj4\xa3\x86\xaf" +
+	"\x7f4\xa3\x87\xae\xfe4\xa3\x88\xaf\xdd4\xa3\x89\xb1\xa84\xa3\x8b\xa8\x1a4\xa3\x8c\xa8Q4\xa3\xc1\xaa\xbd4" +
+	"\xa3\xc2z24\xa3æ\a4\xa3Ĥ\xff4\xa3Ũ\xc04\xa3ƫ\xd44\xa3Ǯ\xdb4\xa3\xc8" +
+	"\xae\xd04\xa3ɰ\x9b4\xa3˧\x0e4\xa3̧E4\xa4\x01\xa9\xb14\xa4\x02\xa6\a4\xa4\x03x\x85" +
+	"4\xa4\x04\xa1Y4\xa4\x05\xa5\x1a4\xa4\x06\xa8.4\xa4\a\xab54\xa4\b\xae\xb14\xa4\t\xaf\x934\xa4" +
+	"\v\xa6\x054\xa4\f\xa6=4\xa4A\xa8\xa94\xa4B\xa4\xff4\xa4C\xa1Y4\xa4Dw\x054\xa4E\xa1" +
+	"x4\xa4F\xa4\x8d4\xa4G\xa7\x934\xa4H\xab\x104\xa4I\xafx4\xa4K\xa5\x014\xa4L\xa594" +
+	"\xa4\x81\xacg4\xa4\x82\xa8\xbc4\xa4\x83\xa5\x164\xa4\x84\xa1t4\xa4\x85vV4\xa4\x86\xa2\x044\xa4\x87" +
+	"\xa4B4\xa4\x88\xa7\xbf4\xa4\x89\xac\x024\xa4\x8b\xa5\v4\xa4\x8c\xa5C4\xa4\xc1\xaf|4\xa4«\xd2" +
+	"4\xa4è,4\xa4Ĥ\x8a4\xa4Ţ\x044\xa4\xc6u'4\xa4ǡ\x8b4\xa4ȥ\a4\xa4" +
+	"ɩJ4\xa4˥\xc54\xa4̥54\xa5\x01\xae\xfa4\xa5\x02\xae\xd74\xa5\x03\xab14\xa5\x04\xa7" +
+	"\x8f4\xa5\x05\xa4A4\xa5\x06\xa1\x8a4\xa5\asY4\xa5\b\xa2,4\xa5\t\xa6o4\xa5\v\xa3e4" +
+	"\xa5\f\xa6\xa34\xa5A\xaf\xd84\xa5B\xae\xcc4\xa5C\xae\xad4\xa5D\xab\v4\xa5E\xa7\xbd4\xa5F" +
+	"\xa5\x064\xa5G\xa2,4\xa5Hs\x144\xa5I\xa4\x184\xa5K\xa1\x0e4\xa5L\xa4L4\xa5\x81\xb1\xa2" +
+	"4\xa5\x82\xb0\x954\xa5\x83\xaf\x8d4\xa5\x84\xafs4\xa5\x85\xab\xff4\xa5\x86\xa9G4\xa5\x87\xa6n4\xa5" +
+	"\x88\xa4\x184\xa5\x89o\r4\xa5\x8b\x9d\xb24\xa5\x8c\xa0\xf04\xa5\xc0\x80\x004\xa5\xc1\xc8=4\xa5\xc2\xc6" +
+	"\xde4\xa5\xc3Ń4\xa5\xc4\xc4,4\xa5\xc5\xc3\xee4\xa5\xc6\xc0\x9f4\xa5ǽ54\xa5Ƚ\xff4" +
+	"\xa5ɸ{4\xa5˺\xda4\xa5̿\n4\xa6\x00B\n4\xa6\x01\xa9\xd94\xa6\x02\xa8\xcd4\xa6\x03" +
+	"\xa7\xc54\xa6\x04\xa6\xc14\xa6\x05\xa6\xce4\xa6\x06\xa7\x884\xa6\a\xa5+4\xa6\b\xa2\xd64\xa6\t\x9fy" +
+	"4\xa6\vH\x1f4\xa6\f\x9954\xa6@A\xa14\xa6A\xaa\x104\xa6B\xa9\x044\xa6C\xa7\xfc4\xa6" +
+	"D\xa6\xf84\xa6E\xa7\x054\xa6F\xa6\xf84\xa6G\xa8g4\xa6H\xa6\x124\xa6I\xa2\xb64\xa6K\x99" +
+	"54\xa6LH\xc94\xa6\x8bB\t4\xa6\x8cA\xa04\xa6\x97\x80\xed4\xa6\x98C\xe64\xa6\x99C}4" +
+	"\xa6\xc1{\x034\xa6©`4\xa6èW4\xa6ħR4\xa6ū\x034\xa6Ʈ\x0e4\xa6\xc7" +
+	"\xad\x8f4\xa6Ȯj4\xa6ɰ/4\xa6˦\xcb4\xa6̧\x024\xa6\xce{\x044\xa6ϩc" +
+	"4\xa6ШZ4\xa6ѧU4\xa6ҫ\x024\xa6Ӯ\f4\xa6ԭ\x8c4\xa6ծg4\xa6" +
+	"ְ*4\xa6\xd7\xc6e4\xa6ب\x8b4\xa6٨\xc14\xa7\x01\xa9[4\xa7\x02y'4\xa7\x03\xa4" +
+	"\xb44\xa7\x04\xa3\xaf4\xa7\x05\xa7a4\xa7\x06\xaak4\xa7\a\xadh4\xa7\b\xad]4\xa7\t\xaf!4" +
+	"\xa7\v\xa5\xbe4\xa7\f\xa5\xf44\xa7\x0e\xa9_4\xa7\x0fy(4\xa7\x10\xa4\xb84\xa7\x11\xa3\xb34\xa7\x12" +
+	"\xa7`4\xa7\x13\xaaj4\xa7\x14\xade4\xa7\x15\xadZ4\xa7\x16\xaf\x1d4\xa7\x17\xc5\x054\xa7\x18\xa7~" +
+	"4\xa7\x19\xa7\xb44\xa7A\xa8P4\xa7B\xa4\xb24\xa7Cwy4\xa7D\xa0\x144\xa7E\xa3\xc64\xa7" +
+	"F\xa6\xd04\xa7G\xa9\xcc4\xa7H\xad<4\xa7I\xae\x1a4\xa7K\xa4\xb74\xa7L\xa4\xed4\xa7N\xa8" +
+	"S4\xa7O\xa4\xb64\xa7Pwz4\xa7Q\xa0\x174\xa7R\xa3\xc44\xa7S\xa6\xce4\xa7T\xa9\xc94" +
+	"\xa7U\xad84\xa7V\xae\x154\xa7Wì4\xa7X\xa6v4\xa7Y\xa6\xac4\xa7\x81\xa7J4\xa7\x82" +
+	"\xa3\xac4\xa7\x83\xa0\x134\xa7\x84u\xf94\xa7\x85\xa004\xa7\x86\xa3:4\xa7\x87\xa664\xa7\x88\xa9\xa5" +
+	"4\xa7\x89\xad\xff4\xa7\x8b\xa3\xb54\xa7\x8c\xa3\xeb4\xa7\x8e\xa7N4\xa7\x8f\xa3\xb04\xa7\x90\xa0\x174\xa7" +
+	"\x91u\xfa4\xa7\x92\xa0.4\xa7\x93\xa384\xa7\x94\xa634\xa7\x95\xa9\xa24\xa7\x96\xad\xfa4\xa7\x97\xc2" +
+	"X4\xa7\x98\xa5u4\xa7\x99\xa5\xab4\xa7\xc1\xaa\xf94\xa7§[4\xa7ã\xc24\xa7Ġ-4" +
+	"\xa7\xc5uI4\xa7Ơ\xb94\xa7Ǣ\xef4\xa7Ȧ_4\xa7ɪ\x934\xa7ˣ\xbf4\xa7\xcc" +
+	"\xa3\xf54\xa7Ϊ\xfa4\xa7ϧ]4\xa7У\xc34\xa7Ѡ.4\xa7\xd2uG4\xa7Ӡ\xb7" +
+	"4\xa7Ԣ\xec4\xa7զ\\4\xa7֪\x8e4\xa7\xd7\xc2\x164\xa7إ~4\xa7٥\xb44\xa8" +
+	"\x01\xae\x024\xa8\x02\xaae4\xa8\x03\xa6\xcb4\xa8\x04\xa364\xa8\x05\xa0\xb84\xa8\x06t\x1a4\xa8\a\xa0" +
+	"D4\xa8\b\xa3\xb44\xa8\t\xa7\xe84\xa8\v\xa4t4\xa8\f\xa3\xe74\xa8\x0e\xae\x044\xa8\x0f\xaaf4" +
+	"\xa8\x10\xa6\xcd4\xa8\x11\xa384\xa8\x12\xa0\xb74\xa8\x13t\x194\xa8\x14\xa0?4\xa8\x15\xa3\xaf4\xa8\x16" +
+	"\xa7\xe14\xa8\x17\xbe\xd24\xa8\x18\xa634\xa8\x19\xa5\xa64\xa8A\xad\x824\xa8B\xad_4\xa8C\xa9\xc6" +
+	"4\xa8D\xa614\xa8E\xa2\xed4\xa8F\xa0C4\xa8GrO4\xa8H\xa0\xe64\xa8I\xa5\x1a4\xa8" +
+	"K\xa2\x1f4\xa8L\xa5O4\xa8N\xad\x844\xa8O\xada4\xa8P\xa9\xc74\xa8Q\xa624\xa8R\xa2" +
+	"\xeb4\xa8S\xa0?4\xa8TrL4\xa8U\xa0\xdf4\xa8V\xa5\x114\xa8W\xbbr4\xa8X\xa3\xde4" +
+	"\xa8Y\xa7\x0e4\xa8\x81\xae\\4\xa8\x82\xadS4\xa8\x83\xad44\xa8\x84\xa9\x9f4\xa8\x85\xa6[4\xa8\x86" +
+	"\xa3\xb14\xa8\x87\xa0\xe44\xa8\x88r\b4\xa8\x89\xa2\xcb4\xa8\x8b\x9f\xd04\xa8\x8c\xa3\x004\xa8\x8e\xae]" +
+	"4\xa8\x8f\xadT4\xa8\x90\xad54\xa8\x91\xa9\xa04\xa8\x92\xa6Y4\xa8\x93\xa3\xad4\xa8\x94\xa0\xdd4\xa8" +
+	"\x95r\x054\xa8\x96\xa2\xc24\xa8\x97\xbc54\xa8\x98\xa1\x8f4\xa8\x99\xa4\xc04\xa8\xc1\xb0\x1f4\xa8¯" +
+	"\x164\xa8î\x114\xa8ĭ\xf74\xa8Ū\x8e4\xa8Ƨ\xe34\xa8ǥ\x174\xa8Ȣ\xcc4" +
+	"\xa8\xc9n\b4\xa8˜\x844\xa8̟\xb44\xa8ΰ 4\xa8ϯ\x174\xa8Ю\x124\xa8\xd1" +
+	"\xad\xf84\xa8Ҫ\x8c4\xa8ӧ\xdf4\xa8ԥ\x0f4\xa8բ\xc34\xa8\xd6n\x044\xa8\u05f6\xc6" +
+	"4\xa8؞A4\xa8١q4\xa9\x01\xc6v4\xa9\x02\xc5\x1c4\xa9\x03\xc3\xc64\xa9\x04\xc2t4\xa9" +
+	"\x05\xc274\xa9\x06\xbe\xf44\xa9\a\xbb\x964\xa9\b\xbc\\4\xa9\t\xb6\xeb4\xa9\v\xb9<4\xa9\f\xbd" +
+	"]4\xa9\x0e\xc6X4\xa9\x0f\xc4\xfc4\xa9\x10å4\xa9\x11\xc2R4\xa9\x12\xc2\x104\xa9\x13\xbe\xcd4" +
+	"\xa9\x14\xbbl4\xa9\x15\xbc24\xa9\x16\xb6\xc14\xa9\x17N_4\xa9\x18\xba\xf44\xa9\x19\xbf\x154\xa9@" +
+	"A\xe64\xa9A\xa8~4\xa9B\xa7u4\xa9C\xa6p4\xa9D\xa5o4\xa9E\xa5|4\xa9F\xa62" +
+	"4\xa9G\xa3\xe04\xa9H\xa1\x954\xa9I\x9eH4\xa9KG\xa34\xa9L\x98\x1f4\xa9N\xa8~4\xa9" +
+	"O\xa7u4\xa9P\xa6p4\xa9Q\xa5o4\xa9R\xa5x4\xa9S\xa6.4\xa9T\xa3\xd94\xa9U\xa1" +
+	"\x8c4\xa9V\x9e>4\xa9W\xba\xf64\xa9XI\x844\xa9Y\x99\xdc4\xa9\x80A{4\xa9\x81\xa8\xb24" +
+	"\xa9\x82\xa7\xa94\xa9\x83\xa6\xa44\xa9\x84\xa5\xa34\xa9\x85\xa5\xb04\xa9\x86\xa5\xa34\xa9\x87\xa7\f4\xa9\x88" +
+	"\xa4\xc24\xa9\x89\xa1u4\xa9\x8b\x98\x1e4\xa9\x8cHJ4\xa9\x8e\xa8\xb24\xa9\x8f\xa7\xa94\xa9\x90\xa6\xa4" +
+	"4\xa9\x91\xa5\xa44\xa9\x92\xa5\xad4\xa9\x93\xa5\xa04\xa9\x94\xa7\b4\xa9\x95\xa4\xbb4\xa9\x96\xa1l4\xa9" +
+	"\x97\xbf\x154\xa9\x98\x99\xdb4\xa9\x99J+4\xa9\xe4\x80\x004\xa9\xe5C\xc14\xa9\xe6CW4\xaa\x1by" +
+	"\xfc4\xaa\x1c\xa8\x014\xaa\x1d\xa6\xf94\xaa\x1e\xa5\xf74\xaa\x1f\xa9\x944\xaa \xac\x934\xaa!\xac\x154" +
+	"\xaa\"\xac\xeb4\xaa#\xae\xa84\xaa$Ğ4\xaa%\xa7/4\xaa&\xa7c4\xaa\\x\x1d4\xaa]" +
+	"\xa3c4\xaa^\xa2a4\xaa_\xa5\xff4\xaa`\xa8\xfd4\xaaa\xab\xed4\xaab\xab\xe04\xaac\xad\x9d" +
+	"4\xaad\xc3C4\xaae\xa6%4\xaaf\xa6Y4\xaa\x9dvn4\xaa\x9e\x9e\xd24\xaa\x9f\xa2o4\xaa" +
+	"\xa0\xa5n4\xaa\xa1\xa8^4\xaa\xa2\xab\xbf4\xaa\xa3\xac\x994\xaa\xa4\xc1\xef4\xaa\xa5\xa5!4\xaa\xa6\xa5" +
+	"U4\xaa\xdet\xee4\xaaߞ\xe64\xaa\xe0\xa1\xe54\xaa\xe1\xa4\xd44\xaa\xe2\xa864\xaa\xe3\xac~4" +
+	"\xaa\xe4\xc0\xa04\xaa\xe5\xa4#4\xaa\xe6\xa4V4\xab\x1ft;4\xab \x9fk4\xab!\xa1\x984\xab\"" +
+	"\xa4\xfa4\xab#\xa9\x1d4\xab$\xc0_4\xab%\xa4,4\xab&\xa4_4\xab`s\f4\xaba\x9e\xf8" +
+	"4\xabb\xa2Y4\xabc\xa6}4\xabd\xbd&4\xabe\xa4\xde4\xabf\xa4Q4\xab\xa1qB4\xab" +
+	"\xa2\x9f\x974\xab\xa3\xa3\xba4\xab\xa4\xb9\xd24\xab\xa5\xa2\x934\xab\xa6\xa5\xb34\xab\xe2p\xf94\xab\xe3\xa1" +
+	"v4\xab亓4\xab\xe5\xa0O4\xab\xe6\xa3p4\xac#l\xff4\xac$\xb564\xac%\x9d\x0f4" +
+	"\xac&\xa004\xacdM\xe24\xace\xb9W4\xacf\xbdh4\xac\xa5I\b4\xac\xa6\x98\xc54\xac\xe6" +
+	"I\xac4\xb3\x81{!4\xb3\x82\xaa\x804\xb3\x83\xa9s4\xb3\x84\xa8k4\xb3\x85\xabA4\xb3\x86\xaf\x91" +
+	"4\xb3\x87\xaeG4\xb3\x88\xaf%4\xb3\x89\xb0\xca4\xb3\x8a\xb1\xb54\xb3\x8c\xa8\x124\xb3\xc1\xaa\x804\xb3" +
+	"\xc2yH4\xb3å\xc94\xb3Ĥ\xc14\xb3ŧ\x974\xb3ƫ\xe74\xb3Ǯ$4\xb3Ȯ" +
+	"\x194\xb3ɯ\xbe4\xb3ʰ\xa94\xb3̧\x064\xb4\x01\xa9s4\xb4\x02\xa5\xc94\xb4\x03w\x9c4" +
+	"\xb4\x04\xa1\x1b4\xb4\x05\xa3\xf14\xb4\x06\xa8A4\xb4\a\xaa~4\xb4\b\xad\xfa4\xb4\t\xae\xb64\xb4\n" +
+	"\xaf\xa04\xb4\f\xa5\xfd4\xb4A\xa8k4\xb4B\xa4\xc14\xb4C\xa1\x1b4\xb4Dv\x1c4\xb4E\xa0O" +
+	"4\xb4F\xa4\x9f4\xb4G\xa6\xdc4\xb4H\xaaX4\xb4I\xae\x9b4\xb4J\xae\x9c4\xb4L\xa4\xf94\xb4" +
+	"\x81\xab?4\xb4\x82\xa7\x954\xb4\x83\xa3\xef4\xb4\x84\xa0M4\xb4\x85u(4\xb4\x86\xa1\"4\xb4\x87\xa3" +
+	"_4\xb4\x88\xa6\xdb4\xb4\x89\xab\x1e4\xb4\x8a\xae\xa64\xb4\x8c\xa4\x1a4\xb4\xc1\xaf\x8e4\xb4«\xe44" +
+	"\xb4è>4\xb4Ĥ\x9c4\xb4š 4\xb4\xc6ut4\xb4ǡ\xe34\xb4ȥ_4\xb4\xc9" +
+	"\xa8\xb44\xb4ʬ<4\xb4̥04\xb5\x01\xaeD4\xb5\x02\xae!4\xb5\x03\xaa{4\xb5\x04\xa6\xd9" +
+	"4\xb5\x05\xa3]4\xb5\x06\xa1\xe24\xb5\as\x874\xb5\b\xa2n4\xb5\t\xa5\xc34\xb5\n\xa9K4\xb5" +
+	"\f\xa5\xb04\xb5A\xaf\"4\xb5B\xae\x164\xb5C\xad\xf74\xb5D\xaaU4\xb5E\xa6\xd94\xb5F\xa5" +
+	"_4\xb5G\xa2n4\xb5HsC4\xb5I\xa3k4\xb5J\xa6\xf44\xb5L\xa3Y4\xb5\x81\xb0\xc64" +
+	"\xb5\x82\xaf\xba4\xb5\x83\xae\xb14\xb5\x84\xae\x974\xb5\x85\xab\x1b4\xb5\x86\xa8\xb24\xb5\x87\xa5\xc14\xb5\x88" +
+	"\xa3l4\xb5\x89o<4\xb5\x8a\xa3\x924\xb5\x8c\x9f\xfd4\xb5\xc1\xb1\xb14\xb5°\xa44\xb5ï\x9c" +
+	"4\xb5Į\x984\xb5Ů\xa44\xb5Ƭ;4\xb5ǩJ4\xb5Ȧ\xf44\xb5ɣ\x924\xb5" +
+	"\xcaoO4\xb5̠\xe84\xb6\x00\x80\x004\xb6\x01ȱ4\xb6\x02\xc7R4\xb6\x03\xc5\xf74\xb6\x04\xc4" +
+	"\xa04\xb6\x05\xc3Z4\xb6\x06\xc4<4\xb6\a\xc0\xd14\xb6\b\xc1\x9a4\xb6\t\xbc\x164\xb6\n\xbc\xf14" +
+	"\xb6\f\xbf}4\xb6@@\xc64\xb6A\xa9\xd34\xb6B\xa8\xc64\xb6C\xa7\xbe4\xb6D\xa6\xba4\xb6E" +
+	"\xa5\xdc4\xb6F\xa6\xf44\xb6G\xa7t4\xb6H\xa5\x1f4\xb6I\xa1\xc34\xb6J\xa2\xad4\xb6LG\xc2" +
+	"4\xb6\x8c@\xc94\xb6\x98\x80\xef4\xb6\x99B\xa74\xb6\xc1z\x1d4\xb6©#4\xb6è\x1a4\xb6" +
+	"ħ\x154\xb6ũ\xdf4\xb6Ʈ\x1f4\xb6Ǭ\xda4\xb6ȭ\xb54\xb6ɯU4\xb6ʰ" +
+	"<4\xb6̦\xc44\xb6\xcez\x1e4\xb6ϩ'4\xb6Ш\x1e4\xb6ѧ\x194\xb6ҩ\xdf4" +
+	"\xb6Ӯ\x1e4\xb6Ԭ\xd94\xb6խ\xb44\xb6֯Q4\xb6װ94\xb6\xd8\xc6\xd74\xb6\xd9" +
+	"\xa8\x854\xb7\x01\xa9\x1e4\xb7\x02xA4\xb7\x03\xa4x4\xb7\x04\xa3s4\xb7\x05\xa6=4\xb7\x06\xaa}" +
+	"4\xb7\a\xac\xb34\xb7\b\xac\xa84\xb7\t\xaeG4\xb7\n\xaf.4\xb7\f\xa5\xb64\xb7\x0e\xa9\"4\xb7" +
+	"\x0fxB4\xb7\x10\xa4|4\xb7\x11\xa3w4\xb7\x12\xa6=4\xb7\x13\xaa|4\xb7\x14\xac\xb14\xb7\x15\xac" +
+	"\xa64\xb7\x16\xaeD4\xb7\x17\xaf+4\xb7\x18\xc5x4\xb7\x19\xa7w4\xb7A\xa8\x134\xb7B\xa4u4" +
+	"\xb7Cv\x934\xb7D\x9f\xd74\xb7E\xa2\xa14\xb7F\xa6\xe24\xb7G\xa9\x174\xb7H\xac\x864\xb7I" +
+	"\xad@4\xb7J\xae'4\xb7L\xa4\xaf4\xb7N\xa8\x174\xb7O\xa4y4\xb7Pv\x944\xb7Q\x9f\xdb" +
+	"4\xb7R\xa2\xa14\xb7S\xa6\xe04\xb7T\xa9\x154\xb7U\xac\x854\xb7V\xad=4\xb7W\xae$4\xb7" +
+	"X\xc4\x1e4\xb7Y\xa6p4\xb7\x81\xa7\r4\xb7\x82\xa3p4\xb7\x83\x9f\xd64\xb7\x84u\x134\xb7\x85\x9f" +
+	"\v4\xb7\x86\xa3K4\xb7\x87\xa5\x814\xb7\x88\xa8\xf04\xb7\x89\xad$4\xb7\x8a\xad&4\xb7\x8c\xa3\xad4" +
+	"\xb7\x8e\xa7\x114\xb7\x8f\xa3t4\xb7\x90\x9f\xda4\xb7\x91u\x144\xb7\x92\x9f\v4\xb7\x93\xa3J4\xb7\x94" +
+	"\xa5\x7f4\xb7\x95\xa8\xef4\xb7\x96\xad!4\xb7\x97\xad\"4\xb7\x98\xc2\xca4\xb7\x99\xa5n4\xb7\xc1\xa9\xd6" +
+	"4\xb7¦94\xb7â\x9f4\xb7ğ\n4\xb7\xc5t 4\xb7Ɵ\xda4\xb7Ǣ\x104\xb7" +
+	"ȥ\x7f4\xb7ɩ\xb34\xb7ʭ/4\xb7̢\xd14\xb7Ω\xd84\xb7Ϧ:4\xb7Т" +
+	"\xa14\xb7џ\v4\xb7\xd2t 4\xb7ӟ\xd94\xb7Ԣ\x0e4\xb7ե~4\xb7֩\xb04" +
+	"\xb7\u05ed,4\xb7\xd8\xc1\x864\xb7٤\x914\xb8\x01\xae\x144\xb8\x02\xaaw4\xb8\x03\xa6\xdd4\xb8\x04" +
+	"\xa3H4\xb8\x05\x9f\xd84\xb8\x06tf4\xb8\a\xa0\x974\xb8\b\xa4\x064\xb8\t\xa7O4\xb8\n\xaa\xcb" +
+	"4\xb8\f\xa3\xe24\xb8\x0e\xae\x164\xb8\x0f\xaax4\xb8\x10\xa6\xdf4\xb8\x11\xa3J4\xb8\x12\x9f\xd84\xb8" +
+	"\x13te4\xb8\x14\xa0\x954\xb8\x15\xa4\x054\xb8\x16\xa7L4\xb8\x17\xaa\xc84\xb8\x18\xc2b4\xb8\x19\xa5" +
+	"\xa34\xb8A\xac\xcf4\xb8B\xac\xac4\xb8C\xa9\x124\xb8D\xa5}4\xb8E\xa2\r4\xb8F\xa0\x974" +
+	"\xb8Gr}4\xb8H\xa1#4\xb8I\xa4l4\xb8J\xa7\xe84\xb8L\xa4_4\xb8N\xac\xd04\xb8O" +
+	"\xac\xad4\xb8P\xa9\x144\xb8Q\xa5\x7f4\xb8R\xa2\r4\xb8S\xa0\x954\xb8Tr|4\xb8U\xa1\x1f" +
+	"4\xb8V\xa4g4\xb8W\xa7\xe34\xb8X\xbf\x014\xb8Y\xa6 4\xb8\x81\xad\xa84\xb8\x82\xac\x9f4\xb8" +
+	"\x83\xac\x804\xb8\x84\xa8\xeb4\xb8\x85\xa5{4\xb8\x86\xa4\x054\xb8\x87\xa1!4\xb8\x88r64\xb8\x89\xa2" +
+	"\x1d4\xb8\x8a\xa5\x994\xb8\x8c\xa2\x114\xb8\x8e\xad\xaa4\xb8\x8f\xac\xa14\xb8\x90\xac\x824\xb8\x91\xa8\xec4" +
+	"\xb8\x92\xa5{4\xb8\x93\xa4\x034\xb8\x94\xa1\x1e4\xb8\x95r54\xb8\x96\xa2\x194\xb8\x97\xa5\x954\xb8\x98" +
+	"\xbf\xc54\xb8\x99\xa3\xd24\xb8\xc1\xafF4\xb8®=4\xb8í84\xb8ĭ\x1e4\xb8ũ\xae" +
+	"4\xb8ƧL4\xb8Ǥi4\xb8Ȣ\x1e4\xb8\xc9n74\xb8ʢG4\xb8̞\xc54\xb8" +
+	"ίG4\xb8Ϯ>4\xb8Э94\xb8ѭ\x1f4\xb8ҩ\xad4\xb8ӧJ4\xb8Ԥ" +
+	"e4\xb8բ\x194\xb8\xd6n44\xb8ע@4\xb8غU4\xb8٠\x834\xb9\x01\xb0,4" +
+	"\xb9\x02\xaf#4\xb9\x03\xae\x1e4\xb9\x04\xad\x1d4\xb9\x05\xad(4\xb9\x06\xaa\xc74\xb9\a\xa7\xe44\xb9\b" +
+	"\xa5\x994\xb9\t\xa2E4\xb9\nnH4\xb9\f\x9f\xab4\xb9\x0e\xb0-4\xb9\x0f\xaf$4\xb9\x10\xae\x1f" +
+	"4\xb9\x11\xad\x1f4\xb9\x12\xad(4\xb9\x13\xaa\xc54\xb9\x14\xa7\xe04\xb9\x15\xa5\x934\xb9\x16\xa2>4\xb9" +
+	"\x17nE4\xb9\x18\xbb+4\xb9\x19\xa1i4\xb9A\xc6\xe74\xb9Bō4\xb9C\xc474\xb9D\xc2" +
+	"\xe54\xb9E\xc1\xa44\xb9F\u00824\xb9G\xbf#4\xb9H\xbf\xe94\xb9I\xbax4\xb9J\xbbP4" +
+	"\xb9L\xbd\xcc4\xb9N\xc6\xc84\xb9O\xc5m4\xb9P\xc4\x164\xb9Q\xc2\xc34\xb9R\xc1\x7f4\xb9S" +
+	"\xc2[4\xb9T\xbe\xfb4\xb9U\xbf\xc04\xb9V\xbaO4\xb9W\xbb&4\xb9XM\xbc4\xb9Y\xbf\x86" +
+	"4\xb9\x80@\xa34\xb9\x81\xa8u4\xb9\x82\xa7l4\xb9\x83\xa6g4\xb9\x84\xa5g4\xb9\x85\xa4\x8b4\xb9" +
+	"\x86\xa5\x9f4\xb9\x87\xa6\x1d4\xb9\x88\xa3\xd24\xb9\x89\xa0\x854\xb9\x8a\xa1l4\xb9\x8cGG4\xb9\x8e\xa8" +
+	"v4\xb9\x8f\xa7m4\xb9\x90\xa6h4\xb9\x91\xa5g4\xb9\x92\xa4\x8a4\xb9\x93\xa5\x9c4\xb9\x94\xa6\x1a4" +
+	"\xb9\x95\xa3\xcd4\xb9\x96\xa0~4\xb9\x97\xa1f4\xb9\x98\xbf\x874\xb9\x99I)4\xb9\xe5\x80\x004\xb9\xe6" +
+	"B\x854\xba\x1by\x1a4\xba\x1c\xa7\xc64\xba\x1d\xa6\xbd4\xba\x1e\xa5\xbb4\xba\x1f\xa8v4\xba \xac\xa4" +
+	"4\xba!\xabc4\xba\"\xac:4\xba#\xad\xd24\xba$\xae\xb44\xba%\xc5\x0e4\xba&\xa7'4\xba" +
+	"\\w:4\xba]\xa3(4\xba^\xa2&4\xba_\xa4\xe04\xba`\xa9\x0f4\xbaa\xab<4\xbab\xab" +
+	"/4\xbac\xac\xc74\xbad\xad\xaa4\xbaeó4\xbaf\xa6\x1d4\xba\x9du\x8b4\xba\x9e\x9e\x964" +
+	"\xba\x9f\xa1Q4\xba\xa0\xa5\x804\xba\xa1\xa7\xad4\xba\xa2\xab\x0e4\xba\xa3\xab\xc34\xba\xa4\xac\xa64\xba\xa5" +
+	"\xc2^4\xba\xa6\xa5\x194\xba\xdet\f4\xbaߝ\xc84\xba\xe0\xa1\xf64\xba\xe1\xa4#4\xba⧅" +
+	"4\xba㫨4\xba䫨4\xba\xe5\xc1\x0f4\xba\xe6\xa4\x1b4\xbb\x1fs\x184\xbb \x9e\x914\xbb" +
+	"!\xa0\xbe4\xbb\"\xa4\x1f4\xbb#\xa8C4\xbb$\xab\xb14\xbb%\xbf\xd04\xbb&\xa3A4\xbb`s" +
+	"W4\xbba\x9fI4\xbbb\xa2\xaa4\xbbc\xa5\xe64\xbbd\xa9T4\xbbe\xc0\xa84\xbbf\xa4N4" +
+	"\xbb\xa1qr4\xbb\xa2\x9f\xd34\xbb\xa3\xa3\x0f4\xbb\xa4\xa6}4\xbb\xa5\xbdT4\xbb\xa6\xa4\xc94\xbb\xe2" +
+	"q)4\xbb\xe3\xa0\xcb4\xbb\xe4\xa494\xbb\xe5\xbe\x144\xbb梅4\xbc#m/4\xbc$\xa0\xf3" +
+	"4\xbc%\xb8\xb74\xbc&\x9fE4\xbcdm>4\xbce\xb9\x8a4\xbcf\xa0(4\xbc\xa5M?4\xbc" +
+	"\xa6\xbd\xd74\xbc\xe6H\xae4Áz84Â\xaaB4Ã\xa964Ä\xa8-4Å\xab" +
+	"\x034Æ\xaeh4Ç\xaeY4È\xaf64É\xaf\xed4Ê\xb0\xd84Ë\xb1\xc34" +
+	"\xc3\xc1\xaaB4\xc3\xc2x_4\xc3å\x8b4\xc3Ĥ\x834\xc3ŧY4\xc3ƪ\xbe4\xc3\xc7" +
+	"\xae64\xc3Ȯ*4\xc3ɮ\xe14\xc3ʯ\xcb4\xc3˰\xb64\xc4\x01\xa964\xc4\x02\xa5\x8b" +
+	"4\xc4\x03v\xb34\xc4\x04\xa0\xdd4\xc4\x05\xa3\xb34\xc4\x06\xa7\x184\xc4\a\xaa\x904\xc4\b\xae\v4\xc4" +
+	"\t\xad\xd84\xc4\n\xae\xc34\xc4\v\xaf\xae4\xc4A\xa8-4\xc4B\xa4\x834\xc4C\xa0\xdd4\xc4Du" +
+	"24\xc4E\xa0\x114\xc4F\xa3v4\xc4G\xa6\xee4\xc4H\xaai4\xc4I\xad\xbe4\xc4J\xad\xbf4" +
+	"\xc4K\xae\xaa4ā\xab\x024Ă\xa7X4ă\xa3\xb24Ą\xa0\x104ąt?4Ć" +
+	"\x9f\xf94ć\xa3q4Ĉ\xa6\xec4ĉ\xaa@4Ċ\xad\xc94ċ\xad\xca4\xc4\xc1\xaeg" +
+	"4\xc4ª\xbd4\xc4ç\x174\xc4ģu4\xc4ş\xf94\xc4\xc6tF4\xc4ǡ\x004\xc4" +
+	"Ȥ{4\xc4ɧ\xd04\xc4ʫX4\xc4ˮ\xe14\xc5\x01\xaeV4\xc5\x02\xae34\xc5\x03\xaa" +
+	"\x8d4\xc5\x04\xa6\xeb4\xc5\x05\xa3o4\xc5\x06\xa0\xff4\xc5\as\xd44\xc5\b\xa5X4\xc5\t\xa5,4" +
+	"\xc5\n\xa8\xb44\xc5\v\xac=4\xc5A\xaf44\xc5B\xae(4\xc5C\xae\t4\xc5D\xaag4\xc5E" +
+	"\xa6\xec4\xc5F\xa4{4\xc5G\xa5Y4\xc5Hs\x8e4\xc5I\xa2\xd44\xc5J\xa6]4\xc5K\xa9\xe6" +
+	"4Ł\xaf\xea4ł\xae\xde4Ń\xad\xd64ń\xad\xbb4Ņ\xaa?4ņ\xa7\xce4\xc5" +
+	"\x87\xa5,4ň\xa2\xd54ŉoj4Ŋ\xa2\xe54ŋ\xa6n4\xc5\xc1\xb0\xd54\xc5¯" +
+	"\xc94\xc5î\xc04\xc5ĭ\xbc4\xc5ŭ\xc84\xc5ƫW4\xc5Ǩ\xb44\xc5Ȧ]4" +
+	"\xc5ɢ\xe54\xc5\xcao~4\xc5˧Y4\xc6\x01\xb1\xc04\xc6\x02\xb0\xb34\xc6\x03\xaf\xab4\xc6\x04" +
+	"\xae\xa74\xc6\x05\xad\xc94\xc6\x06\xae\xe04\xc6\a\xac=4\xc6\b\xa9\xe64\xc6\t\xa6n4\xc6\n\xa7Y" +
+	"4\xc6\vo\xbe4\xc6@\x80\x004\xc6A\xc9%4\xc6B\xc7\xc64\xc6C\xc6k4\xc6D\xc5\x144\xc6" +
+	"E\xc3\xce4\xc6Fè4\xc6G\xc4m4\xc6H\xc554\xc6I\xbf\xb14\xc6J\xc0\x8c4\xc6K\xc1" +
+	"g4ƙ\x80\xf04\xc6\xc1y74\xc6¨\xe64\xc6ç\xdd4\xc6Ħ\xd84\xc6ũ\xa34" +
+	"\xc6Ƭ\xfb4\xc6Ǭ\xec4\xc6ȭ\xc54\xc6ɮz4\xc6ʯb4\xc6˰I4\xc6\xce" +
+	"y84\xc6Ϩ\xea4\xc6Ч\xe14\xc6Ѧ\xdc4\xc6ҩ\xa24\xc6Ӭ\xfb4\xc6Ԭ\xeb" +
+	"4\xc6խ\xc64\xc6֮y4\xc6ׯ`4\xc6ذH4\xc6\xd9\xc7J4\xc7\x01\xa8\xe24\xc7" +
+	"\x02w[4\xc7\x03\xa4;4\xc7\x04\xa364\xc7\x05\xa6\x004\xc7\x06\xa9Y4\xc7\a\xac\xc44\xc7\b\xac" +
+	"\xb84\xc7\t\xadl4\xc7\n\xaeT4\xc7\v\xaf<4\xc7\x0e\xa8\xe64\xc7\x0fw\\4\xc7\x10\xa4?4" +
+	"\xc7\x11\xa3:4\xc7\x12\xa6\x004\xc7\x13\xa9Y4\xc7\x14\xac\xc34\xc7\x15\xac\xb84\xc7\x16\xadk4\xc7\x17" +
+	"\xaeS4\xc7\x18\xaf:4\xc7\x19\xc5\xea4\xc7A\xa7\xd64\xc7B\xa494\xc7Cu\xad4\xc7D\x9f\x9b" +
+	"4\xc7E\xa2e4\xc7F\xa5\xbd4\xc7G\xa9)4\xc7H\xac\x974\xc7I\xace4\xc7J\xadM4\xc7" +
+	"K\xae44\xc7N\xa7\xda4\xc7O\xa4=4\xc7Pu\xae4\xc7Q\x9f\x9e4\xc7R\xa2e4\xc7S\xa5" +
+	"\xbd4\xc7T\xa9'4\xc7U\xac\x974\xc7V\xacd4\xc7W\xadK4\xc7X\xae34\xc7YĐ4" +
+	"ǁ\xa6\xd14ǂ\xa334ǃ\x9f\x9a4Ǆt-4ǅ\x9e\xcf4ǆ\xa2'4Ǉ" +
+	"\xa5\x934ǈ\xa9\x014ǉ\xacJ4Ǌ\xacK4ǋ\xad34ǎ\xa6\xd44Ǐ\xa37" +
+	"4ǐ\x9f\x9e4Ǒt.4ǒ\x9e\xcf4Ǔ\xa2'4ǔ\xa5\x914Ǖ\xa9\x014\xc7" +
+	"\x96\xacH4Ǘ\xacJ4ǘ\xad14Ǚ\xc3=4\xc7\xc1\xa9\x994\xc7¥\xfc4\xc7â" +
+	"c4\xc7Ğ\xcd4\xc7\xc5s:4\xc7ƞ\xb64\xc7Ǣ\"4\xc7ȥ\x904\xc7ɨ\xd94" +
+	"\xc7ʬU4\xc7ˬV4\xc7Ω\x9b4\xc7ϥ\xfe4\xc7Тd4\xc7ў\xcf4\xc7\xd2" +
+	"s:4\xc7Ӟ\xb64\xc7Ԣ 4\xc7ե\x904\xc7֨\xd74\xc7\u05ecS4\xc7جT" +
+	"4\xc7\xd9\xc1\xf84\xc8\x01\xac\xf14\xc8\x02\xa9T4\xc8\x03\xa5\xba4\xc8\x04\xa2%4\xc8\x05\x9e\xb54\xc8" +
+	"\x06s=4\xc8\a\x9f\xb94\xc8\b\xa3'4\xc8\t\xa6p4\xc8\n\xa9\xec4\xc8\v\xadh4\xc8\x0e\xac" +
+	"\xf34\xc8\x0f\xa9V4\xc8\x10\xa5\xbc4\xc8\x11\xa2'4\xc8\x12\x9e\xb54\xc8\x13s=4\xc8\x14\x9f\xb74" +
+	"\xc8\x15\xa3'4\xc8\x16\xa6n4\xc8\x17\xa9\xea4\xc8\x18\xadf4\xc8\x19\xc1\xd24\xc8A\xac\xe04\xc8B" +
+	"\xac\xbd4\xc8C\xa9$4\xc8D\xa5\x8f4\xc8E\xa2\x1f4\xc8F\x9f\xb74\xc8Gr\xca4\xc8H\xa4\x00" +
+	"4\xc8I\xa3\xd44\xc8J\xa7P4\xc8K\xaa\xcc4\xc8N\xac\xe24\xc8O\xac\xbf4\xc8P\xa9&4\xc8" +
+	"Q\xa5\x914\xc8R\xa2\x1f4\xc8S\x9f\xb74\xc8Tr\xc84\xc8U\xa4\x004\xc8V\xa3\xd24\xc8W\xa7" +
+	"N4\xc8X\xaa\xca4\xc8Y\u00914ȁ\xad\xba4Ȃ\xac\xb14ȃ\xac\x924Ȅ\xa8\xfd4" +
+	"ȅ\xa5\x8d4Ȇ\xa3%4ȇ\xa4\x004Ȉr\x814ȉ\xa1\x854Ȋ\xa5\x014ȋ" +
+	"\xa8}4Ȏ\xad\xbc4ȏ\xac\xb34Ȑ\xac\x944ȑ\xa8\xfe4Ȓ\xa5\x8d4ȓ\xa3%" +
+	"4Ȕ\xa3\xfe4ȕr\x814Ȗ\xa1\x834ȗ\xa5\x004Ș\xa8|4ș\xc3U4\xc8" +
+	"\xc1\xaem4\xc8\u00add4\xc8ì_4\xc8ĬE4\xc8Ũ\xd54\xc8Ʀl4\xc8ǣ" +
+	"\xd24\xc8ȡ\x864\xc8\xc9ne4\xc8ʡ\x994\xc8˥\x154\xc8ήo4\xc8ϭf4" +
+	"\xc8Ьa4\xc8ѬF4\xc8Ҩ\xd54\xc8Ӧl4\xc8ԣ\xd14\xc8ա\x844\xc8\xd6" +
+	"nd4\xc8ס\x964\xc8إ\x124\xc8ٽ\xe54\xc9\x01\xafS4\xc9\x02\xaeJ4\xc9\x03\xadE" +
+	"4\xc9\x04\xacD4\xc9\x05\xacO4\xc9\x06\xa9\xe74\xc9\a\xa7M4\xc9\b\xa5\x004\xc9\t\xa1\x984\xc9" +
+	"\nnv4\xc9\v\xa5\xfb4\xc9\x0e\xafU4\xc9\x0f\xaeL4\xc9\x10\xadG4\xc9\x11\xacF4\xc9\x12\xac" +
+	"O4\xc9\x13\xa9\xe74\xc9\x14\xa7K4\xc9\x15\xa4\xfe4\xc9\x16\xa1\x944\xc9\x17nu4\xc9\x18\xa5\xf84" +
+	"\xc9\x19\xbe\xba4\xc9A\xb094\xc9B\xaf04\xc9C\xae+4\xc9D\xad*4\xc9E\xacO4\xc9F" +
+	"\xada4\xc9G\xaa\xc74\xc9H\xa8{4\xc9I\xa5\x124\xc9J\xa5\xfa4\xc9Kn\xb34\xc9N\xb0;" +
+	"4\xc9O\xaf24\xc9P\xae-4\xc9Q\xad,4\xc9R\xacO4\xc9S\xada4\xc9T\xaa\xc54\xc9" +
+	"U\xa8y4\xc9V\xa5\x0f4\xc9W\xa5\xf64\xc9Xn\xb24\xc9Y\xbf\x904Ɂ\xc7X4ɂ\xc5" +
+	"\xfe4ɃĨ4Ʉ\xc3V4Ʌ\xc2\x144Ɇ\xc1\xef4ɇ±4Ɉ\xc3v4" +
+	"ɉ\xbe\x054Ɋ\xbe\xdd4ɋ\xbf\xb54Ɏ\xc794ɏ\xc5\xde4ɐć4ɑ" +
+	"\xc344ɒ\xc1\xef4ɓ\xc1\xc94ɔ\u00894ɕ\xc3N4ɖ\xbd\xdd4ɗ\xbe\xb4" +
+	"4ɘ\xbf\x8c4əME4\xc9\xe6\x80\x004\xca\x1bx74\xca\x1c\xa7\x8a4\xca\x1d\xa6\x824\xca" +
+	"\x1e\xa5\x804\xca\x1f\xa8:4\xca \xab\x864\xca!\xabu4\xca\"\xacK4\xca#\xac\xfc4\xca$\xad" +
+	"\xdf4\xca%\xae\xc14\xca&\xc5}4\xca\\vX4\xca]\xa2\xec4\xca^\xa1\xea4\xca_\xa4\xa54" +
+	"\xca`\xa7\xf04\xcaa\xabM4\xcab\xabA4\xcac\xab\xf24\xcad\xac\xd44\xcae\xad\xb74\xcaf" +
+	"\xc4\"4ʝt\xa94ʞ\x9e[4ʟ\xa1\x164ʠ\xa4a4ʡ\xa7\xbe4ʢ\xab " +
+	"4ʣ\xaa\xed4ʤ\xab\xd04ʥ\xac\xb34ʦ\xc2\xcd4\xca\xdes)4\xcaߝ\x8c4\xca" +
+	"\xe0\xa0\xd84\xca\xe1\xa454\xca⧖4\xca\xe3\xaa\xd24\xca\xe4\xaa\xd24\xca嫵4\xca\xe6\xc1" +
+	"~4\xcb\x1fr54\xcb \x9ds4\xcb!\xa0\xd04\xcb\"\xa414\xcb#\xa7m4\xcb$\xaa\xdb4" +
+	"\xcb%\xaa\xdb4\xcb&\xc0?4\xcb`r44\xcba\x9eo4\xcbb\xa1\xd04\xcbc\xa5\f4\xcbd" +
+	"\xa8z4\xcbe\xab\xe84\xcbf\xc0\x194ˡq\xbe4ˢ\xa2\xa64ˣ\xa2x4ˤ\xa5\xe6" +
+	"4˥\xa9T4˦\xc0\xd54\xcb\xe2qt4\xcb\xe3\xa054\xcb䣢4\xcb\xe5\xa7\x104\xcb" +
+	"\xe6\xc1\x964\xcc#m_4\xcc$\xa0H4\xcc%\xa3\xb64\xcc&\xbc94\xccdmn4\xcce\xa4" +
+	"\x994\xccf\xbd\v4̥m\xa84̦\xbd\xde4\xcc\xe6L\xc78\x13\xc20^8\x13Æ$8" +
+	"\x13Ą\xc48\x13Ņ\xfc8\x13ƅ\xed8\x13ǉ\x0e8\x13ȇ\xb68\x13ɇ#8\x13\xca" +
+	"\x87\xa38\x13ˈ$8\x13̈\xa78\x14\x03.\xc08\x14\x04\x81\x9a8\x14\x05\x82\xd58\x14\x06\x82\xc8" +
+	"8\x14\a\x85\xec8\x14\b\x87\xb28\x14\t\x8668\x14\n\x86\xb58\x14\v\x8768\x14\f\x87\xb98\x14" +
+	"D-p8\x14E\x7f\xac8\x14F\x7f\xa28\x14G\x82\xc98\x14H\x84\x918\x14I\x8638\x14J\x85" +
+	"\xc98\x14K\x86J8\x14L\x86\xcd8\x14\x85-\x7f8\x14\x86|\x1d8\x14\x87\x7fc8\x14\x88\x81.8" +
+	"\x14\x89\x82\xd38\x14\x8a\x85\x868\x14\x8b\x85\x1e8\x14\x8c\x85\xa18\x14\xc6,\xb38\x14\xc7{\xf08\x14\xc8" +
+	"}\xbe8\x14\xc9\x7fe8\x14ʂ\x1b8\x14˄\xd08\x14̄j8\x15\a-H8\x15\b{\xc5" +
+	"8\x15\t}o8\x15\n\x80(8\x15\v\x82\xe08\x15\f\x85\x978\x15H,\xf08\x15I{28\x15" +
+	"J}\xee8\x15K\x80\xa98\x15L\x83c8\x15\x89,\xb98\x15\x8az\xa68\x15\x8b}d8\x15\x8c\x80" +
+	"!8\x15\xca,\xb88\x15\xcb~M8\x15́\n8\x16\v,\xc78\x16\f\x81\xf48\x16L,\xde8" +
+	"\x16ªF8\x16è\xb38\x16ħ%8\x16Ū\r8\x16Ƭn8\x16ǫu8\x16\xc8" +
+	"\xaa\xee8\x16ɪC8\x16ʪ\xc28\x16˫C8\x16̫\xc78\x17\x022\x1a8\x17\x03\x87>" +
+	"8\x17\x04\x85\xe18\x17\x05\x87\r8\x17\x06\x86\xf98\x17\a\x8a\x0e8\x17\b\x88\xb98\x17\t\x88(8\x17" +
+	"\n\x88\xa48\x17\v\x89!8\x17\f\x89\xa18\x17B\x87>8\x17C0|8\x17D\x82\xc28\x17E\x83" +
+	"\xf18\x17F\x83\xe08\x17G\x86\xf78\x17H\x88\xb58\x17I\x87=8\x17J\x87\xb98\x17K\x8878" +
+	"\x17L\x88\xb78\x17\x82\x85\xe18\x17\x83\x82\xc28\x17\x84/,8\x17\x85\x80\xd48\x17\x86\x80\xc58\x17\x87" +
+	"\x83\xe08\x17\x88\x85\xa08\x17\x89\x87;8\x17\x8a\x86\xd08\x17\x8b\x87N8\x17\x8c\x87\xce8\x17\u0087\f" +
+	"8\x17Ã\xf08\x17Ā\xd38\x17\xc5/78\x17\xc6}J8\x17ǀ\x848\x17ȂG8\x17" +
+	"Ƀ\xe48\x17ʆ\x8c8\x17ˆ$8\x17̆\xa48\x18\x02\x86\xf88\x18\x03\x83\xdf8\x18\x04\x80" +
+	"\xc48\x18\x05}K8\x18\x06.k8\x18\a}\x1c8\x18\b~\xe28\x18\t\x80\x828\x18\n\x83-8" +
+	"\x18\v\x85\xd78\x18\f\x85q8\x18B\x8a\v8\x18C\x86\xf58\x18D\x83\xdd8\x18E\x80\x828\x18F" +
+	"}\x1b8\x18G.\xfb8\x18H|\xef8\x18I~\x918\x18J\x81?8\x18K\x83\xec8\x18L\x86\x98" +
+	"8\x18\x82\x88\xb78\x18\x83\x88\xb28\x18\x84\x85\x9d8\x18\x85\x82F8\x18\x86~\xe18\x18\x87|\xef8\x18" +
+	"\x88.\xa38\x18\x89|]8\x18\x8a\x7f\r8\x18\x8b\x81\xbd8\x18\x8c\x84l8\x18\u0088%8\x18Ç" +
+	":8\x18ć88\x18Ń\xe38\x18ƀ\x818\x18\xc7~\x918\x18\xc8|]8\x18\xc9.l8" +
+	"\x18\xca{\xd18\x18\xcb~\x848\x18́68\x19\x02\x88\xa18\x19\x03\x87\xb68\x19\x04\x86\xce8\x19\x05" +
+	"\x86\x8b8\x19\x06\x83,8\x19\a\x81?8\x19\b\x7f\r8\x19\t{\xd18\x19\n.j8\x19\v\x7fj" +
+	"8\x19\f\x82\x1c8\x19B\x89\x1f8\x19C\x8848\x19D\x87K8\x19E\x86#8\x19F\x85\xd68\x19" +
+	"G\x83\xec8\x19H\x81\xbd8\x19I~\x848\x19J\x7fj8\x19K.x8\x19L\x83\x028\x19\x82\x89" +
+	"\x9f8\x19\x83\x88\xb48\x19\x84\x87\xcb8\x19\x85\x86\xa38\x19\x86\x85p8\x19\x87\x86\x988\x19\x88\x84l8" +
+	"\x19\x89\x8168\x19\x8a\x82\x1c8\x19\x8b\x83\x028\x19\x8c.\x8f8\x1a\x1b\x80\x008\x1a\x1c\xaa\xc28\x1a\x1d" +
+	"\xa938\x1a\x1e\xa7\xa88\x1a\x1f\xaa\x838\x1a \xac\xd98\x1a!\xab\xe08\x1a\"\xabY8\x1a#\xaa\xaf" +
+	"8\x1a$\xab*8\x1a%\xab\xa78\x1a&\xac'8\x1a\\3\xd58\x1a]\x88W8\x1a^\x86\xfe8\x1a" +
+	"_\x88\x1d8\x1a`\x88\x058\x1aa\x8b\f8\x1ab\x89\xba8\x1ac\x89)8\x1ad\x89\xa28\x1ae\x8a" +
+	"\x1c8\x1af\x8a\x998\x1a\x9d288\x1a\x9e\x83\xea8\x1a\x9f\x85\f8\x1a\xa0\x84\xf68\x1a\xa1\x88\x008" +
+	"\x1a\xa2\x89\xb68\x1a\xa3\x88B8\x1a\xa4\x88\xbb8\x1a\xa5\x8958\x1a\xa6\x89\xb28\x1a\xde0\xe98\x1a\xdf" +
+	"\x81\xfa8\x1a\xe0\x81\xe78\x1a\xe1\x84\xf38\x1a↬8\x1a\xe3\x88?8\x1a\xe4\x87\xd58\x1a\xe5\x88P" +
+	"8\x1a\xe6\x88\xcc8\x1b\x1f0\xef8\x1b ~x8\x1b!\x81\xa38\x1b\"\x83^8\x1b#\x84\xf48\x1b" +
+	"$\x87\x918\x1b%\x87)8\x1b&\x87\xa68\x1b`0#8\x1ba~G8\x1bb\x80\x058\x1bc\x81" +
+	"\x9e8\x1bd\x84>8\x1be\x86\xdd8\x1bf\x86w8\x1b\xa10\xae8\x1b\xa2~\x188\x1b\xa3\x7f\xb48" +
+	"\x1b\xa4\x82V8\x1b\xa5\x84\xf88\x1b\xa6\x87\x998\x1b\xe20U8\x1b\xe3}\x878\x1b\xe4\x80,8\x1b\xe5" +
+	"\x82\xd18\x1b\xe6\x85u8\x1c#0\x1e8\x1c$|\xfc8\x1c%\x7f\xa48\x1c&\x82J8\x1cd0\x1c" +
+	"8\x1ce\x80\x868\x1cf\x83-8\x1c\xa50*8\x1c\xa6\x84\x108\x1c\xe60@8#\xc1\x80\x008#" +
+	"\xc3r\x8d8#\xc4b\xd28#\xc5ZE8#\xc6P\xf78#\xc7O\x1b8#\xc8J\x928#\xc9F" +
+	"\xe88#\xcaD\x828#\xcbB\xca8#\xccAm8$\x01w\xe28$\x03\x1e88$\x04c\xa98" +
+	"$\x05^\xb38$\x06Zf8$\aYm8$\bX\\8$\tV\xa18$\nV\f8$\v" +
+	"U\xc28$\fU\xa88$Af\x968$Cc\xa98$D\x1c\xfb8$E\\q8$FX\xf2" +
+	"8$GX\x198$HW\v8$IV\x9e8$JU\xcb8$KU\x828$LUg8$" +
+	"\x81^\xc58$\x83^\xb48$\x84\\q8$\x85 \x888$\x86V\xa78$\x87Vj8$\x88V" +
+	"d8$\x89U\xfa8$\x8aVu8$\x8bU\xed8$\x8cU\xd28$\xc1X\xbb8$\xc3Zg8" +
+	"$\xc4X\xf28$\xc5V\xa78$\xc6!\xe08$\xc7Q\xc88$\xc8T\xa78$\xc9TO8$\xca" +
+	"T\xcd8$\xcbU\x948$\xccU;8%\x01RQ8%\x03Ym8%\x04X\x198%\x05Vj" +
+	"8%\x06Q\xca8%\a\"=8%\bS\xfe8%\tS\xd78%\nT`8%\vU)8%" +
+	"\fV\x1f8%ANs8%CXZ8%DW\n8%EVb8%FT\xa78%GS" +
+	"\xfc8%H#\t8%IS\x878%JT28%KT\xff8%LU\xf88%\x81J\xe18" +
+	"%\x83V\xa08%\x84V\x9d8%\x85U\xf88%\x86TO8%\x87S\xd58%\x88S\x878%\x89" +
+	"\"\xd38%\x8aR\x928%\x8bS\x898%\x8cT\x858%\xc1H\x948%\xc3V\n8%\xc4U\xca" +
+	"8%\xc5Vs8%\xc6T\xcd8%\xc7T^8%\xc8T28%\xc9R\x928%\xca\"\xbf8%" +
+	"\xcbS\xc78%\xccT\xc38&\x01F\xf58&\x03U\xc18&\x04U\x808&\x05U\xec8&\x06U" +
+	"\x948&\aU(8&\bT\xff8&\tS\x898&\nS\xc78&\v\"\xbb8&\fU\x018" +
+	"&AE\xb18&CU\xa68&DUf8&EU\xd18&FU;8&GV\x1d8&H" +
+	"U\xf88&IT\x858&JT\xc38&KU\x018&L\"\xbf8&\xc3w18&\xc4f\x18" +
+	"8&\xc5^^8&\xc6Xk8&\xc7R\x148&\xc8NE8&\xc9J\xbf8&\xcaHz8&" +
+	"\xcbF\xe08&\xccE\x9f8&\xcf\x7f\x1c8&\xd0w38&\xd1f\x1a8&\xd2^a8&\xd3X" +
+	"m8&\xd4R\x188&\xd5NG8&\xd6J\xc28&\xd7H}8&\xd8F\xe28&\xd9E\xa28" +
+	"'\x03s\xaf8'\x04d!8'\x05[\xaf8'\x06R\x808'\aP\xa98'\bL08'\t" +
+	"H\x928'\nF48'\vD\x818'\fC'8'\x0f\x1a\xe98'\x10s\xb98'\x11d+" +
+	"8'\x12[\xb88'\x13R\x878'\x14P\xb28'\x15L68'\x16H\x988'\x17F:8'" +
+	"\x18D\x878'\x19C.8'Ax\xda8'C 28'Dd\xf98'E`\v8'F[" +
+	"\xc78'GZ\xca8'HY\xb78'IX\x008'JWj8'KW 8'LW\x048" +
+	"'Os\x9a8'P 88'Qd\xff8'R`\x118'S[\xcc8'TZ\xd08'U" +
+	"Y\xbc8'VX\x048'WWo8'XW$8'YW\b8'\x81g\xc18'\x83d\xf9" +
+	"8'\x84\x1e\xf68'\x85]\xd28'\x86ZY8'\x87Y|8'\x88Xl8'\x89W\xfd8'" +
+	"\x8aW+8'\x8bV\xe08'\x8cV\xc48'\x8fd\f8'\x90d\xff8'\x91\x1e\xfc8'\x92]" +
+	"\xd88'\x93Z]8'\x94Y\x828'\x95Xq8'\x96X\x028'\x97W/8'\x98V\xe58" +
+	"'\x99V\xc98'\xc1`\x028'\xc3`\b8'\xc4]\xce8'\xc5\"u8'\xc6X\x178'\xc7" +
+	"W\xd48'\xc8W\xc88'\xc9W\\8'\xcaW\xd28'\xcbWK8'\xccW/8'\xcf[\x95" +
+	"8'\xd0`\x0e8'\xd1]\xd48'\xd2\"|8'\xd3X\x1b8'\xd4W\xda8'\xd5W\xcd8'" +
+	"\xd6W`8'\xd7W\xd78'\xd8WP8'\xd9W48(\x01Z\n8(\x03[\xc28(\x04Z" +
+	"T8(\x05X\x158(\x06#\xca8(\aSD8(\bV\x148(\tU\xba8(\nV38" +
+	"(\vV\xf58(\fV\x9c8(\x0fRa8(\x10[\xc68(\x11ZX8(\x12X\x198(\x13" +
+	"#\xcf8(\x14SJ8(\x15V\x198(\x16U\xbe8(\x17V78(\x18V\xf98(\x19V\xa0" +
+	"8(AS\xb38(CZ\xc28(DYt8(EW\xd08(FSD8(G$!8(" +
+	"HUk8(IUB8(JU\xc68(KV\x8a8(LWz8(OP\x8b8(PZ" +
+	"\xc88(QYz8(RW\xd68(SSH8(T$(8(UUp8(VUF8" +
+	"(WU\xca8(XV\x8e8(YW~8(\x81O\xe28(\x83Y\xae8(\x84Xc8(\x85" +
+	"W\xc38(\x86V\x138(\x87Uj8(\x88$\xea8(\x89T\xf38(\x8aU\x998(\x8bVa" +
+	"8(\x8cWT8(\x8fL\x108(\x90Y\xb48(\x91Xi8(\x92W\xc98(\x93V\x178(" +
+	"\x94Up8(\x95$\xee8(\x96T\xf88(\x97U\x9e8(\x98Vf8(\x99WX8(\xc1L" +
+	"]8(\xc3W\xf68(\xc4W\xf48(\xc5WW8(\xc6U\xb98(\xc7U@8(\xc8T\xf38" +
+	"(\xc9$\xb38(\xcaT\x008(\xcbT\xf18(\xccU\xe88(\xcfHr8(\xd0W\xfc8(\xd1" +
+	"W\xfa8(\xd2W]8(\xd3U\xbd8(\xd4UF8(\xd5T\xf88(\xd6$\xb88(\xd7T\x04" +
+	"8(\xd8T\xf58(\xd9U\xec8)\x01J\x188)\x03Wa8)\x04W!8)\x05W\xcd8)" +
+	"\x06V18)\aU\xc48)\bU\x998)\tT\x008)\n$\x9f8)\vU.8)\fV" +
+	"$8)\x0fF\x158)\x10Wg8)\x11W'8)\x12W\xd38)\x13V68)\x14U\xca8" +
+	")\x15U\x9e8)\x16T\x048)\x17$\xa38)\x18U28)\x19V)8)AH}8)C" +
+	"W\x168)DV\xd78)EWF8)FV\xf38)GV\x888)HVa8)IT\xf1" +
+	"8)JU.8)K$\x9b8)LVa8)ODb8)PW\x1c8)QV\xdd8)" +
+	"RWL8)SV\xf78)TV\x8e8)UVf8)VT\xf58)WU28)X$" +
+	"\xa08)YVe8)\x81G=8)\x83V\xfb8)\x84V\xbb8)\x85W*8)\x86V\x9a8" +
+	")\x87Wx8)\x88WT8)\x89U\xe88)\x8aV$8)\x8bVa8)\x8c$\xa08)\x8f" +
+	"C\t8)\x90W\x018)\x91V\xc18)\x92W08)\x93V\x9f8)\x94W~8)\x95WX" +
+	"8)\x96U\xec8)\x97V)8)\x98Ve8)\x99$\xa48*\x1c\x80\x008*\x1dx+8*" +
+	"\x1egE8*\x1f_\x9f8* Y\xbb8*!Sz8*\"O\xb68*#L>8*$J" +
+	"\x008*%Hk8*&G-8*\\\x1d\x188*]t\xbb8*^e[8*_\\\xfe8" +
+	"*`S\xea8*aR\x198*bM\xad8*cJ\x1c8*dG\xc78*eF\x198*f" +
+	"D\xc38*\x9d\"38*\x9efO8*\x9faf8*\xa0]'8*\xa1\\%8*\xa2[\x0f" +
+	"8*\xa3Y[8*\xa4X\xc58*\xa5Xz8*\xa6X]8*\xde \xf68*\xdf_48*" +
+	"\xe0[\xbf8*\xe1Z\xdd8*\xe2Y\xca8*\xe3YX8*\xe4X\x878*\xe5X<8*\xe6X" +
+	"\x1f8+\x1f$i8+ Y\x8a8+!Y@8+\"Y.8+#X\xbf8+$Y08" +
+	"+%X\xaa8+&X\x8d8+`%\xb98+aT\xc48+bW\x848+cW(8+d" +
+	"W\x9c8+eXX8+fW\xff8+\xa1&\f8+\xa2V\xde8+\xa3V\xb18+\xa4W0" +
+	"8+\xa5W\xef8+\xa6X\xd98+\xe2&\xce8+\xe3Vd8+\xe4W\x058+\xe5W\xc88+" +
+	"\xe6X\xb58,#&\x988,$Ur8,%V^8,&WO8,d&\x848,eV" +
+	"\x998,fW\x8a8,\xa5&\x808,\xa6W\xc68,\xe6&\x8483\xc2K\xf683\xc4o\xb48" +
+	"3\xc5i\x1e83\xc6e`83\xc7de83\xc8`\xec83\xc9`Z83\xca_\xc583\xcb" +
+	"_|83\xcc_a84\x01\x80\x0084\x02v\x9984\x04`\xdf84\x05Y\x8284\x06P\x91" +
+	"84\aN\xc184\bJ\x8e84\tGX84\nD\xf284\vC:84\fA\xdd84" +
+	"Af\xe284Bqk84D\x1d_84E[\x9e84FX\xa784GW\xd184HW" +
+	",84IW\r84JV984KU\xf084LU\xd584\x81_)84\x82j\xd98" +
+	"4\x84[\x9f84\x85!\x1184\x86WJ84\x87W\x1084\x88V\x8584\x89V\x8e84\x8a" +
+	"W\t84\x8bV\x8184\x8cVg84\xc1Z\t84\xc2g\x1a84\xc4X\xa884\xc5WJ" +
+	"84\xc6#X84\xc7U\x0184\xc8T\xc884\xc9U\xac84\xcaV)84\xcbV\xf084" +
+	"\xccV\x9885\x01S\x9f85\x02f\x1e85\x04W\xd085\x05W\x0f85\x06U\x0185\a#" +
+	"\xb685\bT\x1f85\tU385\nU\xbc85\vV\x8585\fW{85AN\x9d8" +
+	"5Bb\xa585DW+85EV\x8385FT\xc885GT\x1f85H#\n85I" +
+	"S\xaa85JTU85KU\"85LV\x1b85\x81L\x1185\x82b\x1185\x84W\n" +
+	"85\x85V\x8b85\x86U\xaa85\x87U185\x88S\xa885\x89#\xf885\x8aS\x9e85" +
+	"\x8bT\x9585\x8cU\x9285\xc1I\xc485\xc2a|85\xc4V785\xc5W\x0685\xc6V" +
+	"(85\xc7U\xba85\xc8TS85\xc9S\x9e85\xca#\xe485\xcbT\xd385\xccU\xcf8" +
+	"6\x01H%86\x02a286\x04U\xed86\x05V~86\x06V\xef86\aV\x8486\b" +
+	"U!86\tT\x9586\nT\xd386\v#\xe086\fV\r86AF\xe186Ba\x18" +
+	"86DU\xd286EVd86FV\x9686GWz86HV\x1986IU\x9286" +
+	"JU\xcf86KV\r86L#\xe486\xc4f_86\xc5^\xbd86\xc6Y\xb086\xc7S" +
+	"[86\xc8Nj86\xc9K\xe986\xcaI\xa486\xcbH\n86\xccF\xc986\xd0\x7f\x1c8" +
+	"6\xd1fe86\xd2^\xc486\xd3Y\xb686\xd4Sa86\xd5Nq86\xd6K\xee86\xd7" +
+	"I\xa986\xd8H\x0e86\xd9F\xce87\x02ML87\x04p\xca87\x05jC87\x06f\x8b" +
+	"87\ae\x8c87\bb\x1c87\ta\x8987\n`\xf487\v`\xa987\f`\x8e87" +
+	"\x0fK\x7f87\x10u\xd087\x11p\xd487\x12jM87\x13f\x9387\x14e\x9587\x15b" +
+	"%87\x16a\x9087\x17`\xfb87\x18`\xb087\x19`\x9587Bw\xb487Db58" +
+	"7EZ\xef87FR\x1c87GPP87HL,87II\x0087JF\xa287K" +
+	"D\xef87LC\x9587Ou\xef87P\x1ai87QbC87RZ\xfb87SR&" +
+	"87TP[87UL687VI\t87WF\xab87XD\xf887YC\x9e87" +
+	"\x81h\f87\x82r\x8187\x84\x1fW87\x85]\x0387\x86Z\x0f87\x87Y587\x88X" +
+	"\x8d87\x89Xj87\x8aW\x9887\x8bWM87\x8cW187\x8fp\xd387\x90b%8" +
+	"7\x91\x1fa87\x92]\r87\x93Z\x1887\x94Y>87\x95X\x9687\x96Xq87\x97" +
+	"W\x9e87\x98WT87\x99W887\xc1`f87\xc2k\xf987\xc4\\\xff87\xc5\"\xfc" +
+	"87\xc6X\xb887\xc7Xx87\xc8W\xea87\xc9W\xee87\xcaXd87\xcbW\xdd87" +
+	"\xccW\xc187\xcfjI87\xd0Z\xd887\xd1]\t87\xd2#\x0687\xd3X\xc087\xd4X" +
+	"\x8087\xd5W\xf287\xd6W\xf587\xd7Xk87\xd8W\xe487\xd9W\xc888\x01[S8" +
+	"8\x02h?88\x04Z\n88\x05X\xb788\x06%;88\aVs88\bV688\t" +
+	"W\x1288\nW\x8b88\vXL88\fW\xf488\x0ff\x8d88\x10R\x0188\x11Z\x12" +
+	"88\x12X\xbe88\x13%D88\x14V{88\x15V>88\x16W\x1988\x17W\x9288" +
+	"\x18XS88\x19W\xfa88AT\xfd88Bg<88DY,88EXs88FV" +
+	"q88G%\x9488HU\x8d88IV\x9988JW\x1d88KW\xe188LX\xd18" +
+	"8Oe\x8c88PP488QY688RX}88SVy88T%\x9d88U" +
+	"U\x9588VV\xa088WW$88XW\xe888YX\xd888\x81P\f88\x82c\xcc" +
+	"88\x84X\x8488\x85W\xe488\x86V488\x87U\x8d88\x88$\xea88\x89U\x1688" +
+	"\x8aU\xbc88\x8bV\x8488\x8cWw88\x8fb\x1c88\x90L\x1088\x91X\x8e88\x92W" +
+	"\xee88\x93V<88\x94U\x9588\x95$\xf388\x96U\x1d88\x97U\xc388\x98V\x8b8" +
+	"8\x99W~88\xc1M\x8988\xc2c788\xc4X_88\xc5W\xe788\xc6W\x0e88\xc7" +
+	"V\x9788\xc8U\x1488\xc9%\xd488\xcaU\t88\xcbU\xfa88\xccV\xf188\xcfa\x87" +
+	"88\xd0H\xe388\xd1Xi88\xd2W\xf188\xd3W\x1788\xd4V\xa088\xd5U\x1d88" +
+	"\xd6%\xdb88\xd7U\x1088\xd8V\x0188\xd9V\xf889\x01KD89\x02b\xa289\x04W" +
+	"\x8d89\x05X]89\x06W\x8789\aW\x1c89\bU\xba89\tU\t89\n%\xbf8" +
+	"9\vV789\fW-89\x0f`\xf289\x10F\x8689\x11W\x9689\x12Xg89\x13" +
+	"W\x9089\x14W$89\x15U\xc389\x16U\x1089\x17%\xc689\x18V>89\x19W4" +
+	"89AI\xa989BbX89DWB89EW\xd689FXI89GW\xe089" +
+	"HV\x8289IU\xfa89JV789K%\xbc89LWj89O`\xa889PD" +
+	"\xd389QWL89RW\xe089SXQ89TW\xe889UV\x8b89VV\x018" +
+	"9WV>89X%\xc289YWq89\x81Hi89\x82b<89\x84W&89\x85" +
+	"W\xba89\x86W\xf089\x87X\xd089\x88Wu89\x89V\xf189\x8aW-89\x8bWj" +
+	"89\x8c%\xc089\x8f`\x8c89\x90Cz89\x91W089\x92W\xc489\x93W\xf889" +
+	"\x94X\xd889\x95W~89\x96V\xf889\x97W489\x98Wq89\x99%\xc78:\x1d\x80" +
+	"\x008:\x1eg\x8f8:\x1f`\x018: [\x008:!T\xbf8:\"O\xe08:#Mf8" +
+	":$K)8:%I\x938:&HU8:\\L\xd58:]v\xea8:^q\xea8:_" +
+	"kn8:`g\xb88:af\xb38:bcL8:cb\xb68:db!8:ea\xd5" +
+	"8:fa\xb98:\x9d\x1c\x958:\x9ec{8:\x9f\\E8:\xa0S\x8b8:\xa1Q\xc48:" +
+	"\xa2M\xad8:\xa3J\x8c8:\xa4H68:\xa5F\x888:\xa6E28:\xde!Y8:\xdf^" +
+	"m8:\xe0[z8:\xe1Z\x9a8:\xe2Y\xef8:\xe3Y\xc68:\xe4X\xf48:\xe5X\xa98" +
+	":\xe6X\x8c8;\x1f$\xf18; Z,8;!Y\xe48;\"YS8;#YR8;$" +
+	"Y\xc38;%Y<8;&Y\x1f8;`'(8;aW\xea8;bW\xaa8;cX}" +
+	"8;dX\xf18;eY\xad8;fYU8;\xa1'{8;\xa2W\x038;\xa3X\x068;" +
+	"\xa4X\x858;\xa5YD8;\xa6Z.8;\xe2&\xd38;\xe3V\x898;\xe4W*8;\xe5W" +
+	"\xed8;\xe6X\xda8<#'\xb78<$Vz8<%Wf8<&XW8<d'\xa28" +
+	"<eW\xa18<fX\x928<\xa5'\x9e8<\xa6X\xce8<\xe6'\xa38C\xc2Mu8C\xc3" +
+	"}\xf08C\xc5iy8C\xc6e\xcf8C\xc7d\xd98C\xc8b38C\xc9`|8C\xca`\xd1" +
+	"8C\xcb`\x888C\xcc`m8D\x02}\xec8D\x03K\b8D\x05f&8D\x06b}8D" +
+	"\aa\x868D\bb\r8D\t_\x868D\n_\xdb8D\v_\x928D\f_w8DA\x80" +
+	"\x008DBv\x998DCs\xd38DEW\xf28DFO\xe08DGN]8DHJ58" +
+	"DIGU8DJEd8DKC\xac8DLBO8D\x81_w8D\x82k58D\x83" +
+	"g\xe98D\x85!u8D\x86V\x928D\x87V78D\x88V_8D\x89V\xab8D\x8aWs" +
+	"8D\x8bV\xeb8D\x8cV\xd18D\xc1Zl8D\xc2g\x8c8D\xc3d@8D\xc5V\x928D" +
+	"\xc6#\xe18D\xc7T\xf18D\xc8Uj8D\xc9U\xc98D\xcaV\xb98D\xcbW\x808D\xccW" +
+	"'8E\x01T\x028E\x02f\x948E\x03cG8E\x05V68E\x06T\xf18E\a$@8" +
+	"E\bT\xc18E\tUP8E\nVL8E\vW\x158E\fX\v8EAO\xe58EB" +
+	"c\xec8ECc\xcb8EEV\\8EFUi8EGT\xc08EH$\x838EIS\xc7" +
+	"8EJU\xad8EKVz8ELWs8E\x81L58E\x82b58E\x83aC8E" +
+	"\x85V\xa88E\x86U\xc78E\x87UO8E\x88S\xc78E\x89#\xf88E\x8aS\xbd8E\x8bT" +
+	"\xb48E\x8cU\xb08E\xc1J\xee8E\xc2b\x898E\xc3a\x988E\xc5Wn8E\xc6V\xb68" +
+	"E\xc7VI8E\xc8U\xab8E\xc9S\xbb8E\xca%\b8E\xcbU\xdb8E\xccV\xd78F\x01" +
+	"IO8F\x02b@8F\x03aN8F\x05V\xe78F\x06W}8F\aW\x128F\bVy" +
+	"8F\tT\xb28F\nU\xdb8F\v%\x048F\fW\x158FAH\v8FBb%8F" +
+	"Ca38FEV\xcc8FFW$8FGX\b8FHWq8FIU\xaf8FJV" +
+	"\xd78FKW\x158FL%\t8F\xc5_\b8F\xc6Z\x0f8F\xc7S\xba8F\xc8O\xad8" +
+	"F\xc9L\v8F\xcaJ\xca8F\xcbI/8F\xccG\xee8F\xd1\x7f\x1c8F\xd2_\x118F\xd3" +
+	"Z\x188F\xd4S\xc38F\xd5O\xb48F\xd6L\x128F\xd7J\xcf8F\xd8I58F\xd9G\xf4" +
+	"8G\x02N\xc78G\x03~\xd08G\x05j\x9c8G\x06f\xf98G\ae\xfe8G\bc_8G" +
+	"\ta\xab8G\na\xfd8G\va\xb38G\fa\x978G\x0fL\xfa8G\x10}\x1f8G\x11u" +
+	"\xd08G\x12j\xa98G\x13g\x038G\x14f\t8G\x15ch8G\x16a\xb48G\x17b\x058" +
+	"G\x18a\xba8G\x19a\x9e8GB~\xcc8GCL_8GEgX8GFc\xb58GG" +
+	"b\xba8GHc98GI`\xb88GJa\n8GK`\xc08GL`\xa48GO}\x1f" +
+	"8GPJ\x8e8GQs\x0f8GRge8GSc\xc08GTb\xc58GUcB8G" +
+	"V`\xc28GWa\x128GX`\xc88GY`\xac8G\x82w\xb48G\x83t\xf68G\x85Y" +
+	"d8G\x86Qm8G\x87O\xed8G\x88K\xd48G\x89H\xfe8G\x8aG\x128G\x8bE_8" +
+	"G\x8cD\x058G\x8fu\xef8G\x90s-8G\x91\x19\xff8G\x92Yt8G\x93Qz8G\x94" +
+	"O\xfa8G\x95K\xdf8G\x96I\t8G\x97G\x1c8G\x98Ei8G\x99D\x0f8G\xc1`\xb4" +
+	"8G\xc2lU8G\xc3i\x168G\xc5#]8G\xc6X\x038G\xc7W\xa28G\xc8W\xc48G" +
+	"\xc9X\v8G\xcaX\xcd8G\xcbXF8G\xccX*8G\xcfj\xa48G\xd0g`8G\xd1Y" +
+	"Q8G\xd2#j8G\xd3X\r8G\xd4W\xad8G\xd5W\xcd8G\xd6X\x158G\xd7X\xd58" +
+	"G\xd8XN8G\xd9X28H\x01[\xb68H\x02h\xb08H\x03eq8H\x05X\x028H\x06" +
+	"%\xc28H\aVb8H\bV\xd68H\tW/8H\nX\x198H\vX\xda8H\fX\x82" +
+	"8H\x0ff\xfd8H\x10c\xb98H\x11QU8H\x12X\v8H\x13%\xce8H\x14Vm8H" +
+	"\x15V\xdf8H\x16W88H\x17X 8H\x18X\xe28H\x19X\x898HAU_8HBg" +
+	"\xb18HCds8HEW\x9d8HFVa8HG&\x1b8HHV-8HIV\xb68" +
+	"HJW\xab8HKXp8HLY`8HOf\x018HPb\xbc8HQO\xd48HR" +
+	"W\xa98HSVk8HT&&8HUV68HVV\xc08HWW\xb38HXXw" +
+	"8HYYg8H\x81QP8H\x82e\x108H\x83d\xee8H\x85W\xbd8H\x86V\xd28H" +
+	"\x87V+8H\x88&]8H\x89U38H\x8aW\x0f8H\x8bW\xd88H\x8cX\xca8H\x8fc" +
+	"_8H\x90c98H\x91K\xb98H\x92W\xc98H\x93V\xdd8H\x94V68H\x95&g8" +
+	"H\x96U<8H\x97W\x178H\x98W\xdf8H\x99X\xd28H\xc1M\xad8H\xc2c[8H\xc3" +
+	"bm8H\xc5X\x048H\xc6W,8H\xc7V\xb58H\xc8U38H\xc9%\xd48H\xcaU(" +
+	"8H\xcbV\x198H\xccW\x0f8H\xcfa\xab8H\xd0`\xb88H\xd1H\xe38H\xd2X\x118H" +
+	"\xd3W68H\xd4V\xbf8H\xd5U<8H\xd6%\xdd8H\xd7U/8H\xd8V 8H\xd9W" +
+	"\x178I\x01Lj8I\x02c\xac8I\x03b\xbd8I\x05X\xc48I\x06X\x148I\aW\xa88" +
+	"I\bW\x0e8I\tU&8I\n&\xe08I\vW<8I\fX28I\x0fa\xfc8I\x10" +
+	"a\t8I\x11F\xf78I\x12X\xd18I\x13X\x1e8I\x14W\xb28I\x15W\x178I\x16U/" +
+	"8I\x17&\xe78I\x18WC8I\x19X:8IAJ\xd08IBca8ICbs8I" +
+	"EX=8IFX\xd58IGXl8IHW\xd68IIV\x178IJW<8IK&" +
+	"\xdc8ILXo8IOa\xb18IP`\xbf8IQED8IRXI8ISX\xe08" +
+	"ITXw8IUW\xdf8IVV 8IWWC8IX&\xe48IYXv8I\x81" +
+	"I\x8f8I\x82cF8I\x83bW8I\x85X!8I\x86X}8I\x87Y\\8I\x88X\xc9" +
+	"8I\x89W\x0e8I\x8aX28I\x8bXo8I\x8c&\xe08I\x8fa\x968I\x90`\xa38I" +
+	"\x91C\xeb8I\x92X.8I\x93X\x878I\x94Yg8I\x95X\xd28I\x96W\x178I\x97X" +
+	":8I\x98Xv8I\x99&\xe88J\x1e\x80\x008J\x1f`N8J [a8J!U 8" +
+	"J\"Q\x1f8J#M\x8a8J$LK8J%J\xb58J&Ix8J\\NL8J]" +
+	"~\x008J^v\xea8J_k\xc88J`h'8Jag&8Jbd\x8b8Jcb\xda" +
+	"8Jdc'8Jeb\xdc8Jfb\xbf8J\x9dK\xe58J\x9et38J\x9fh\x928J" +
+	"\xa0d\xf18J\xa1c\xf08J\xa2de8J\xa3a\xeb8J\xa4b88J\xa5a\xed8J\xa6a" +
+	"\xd08J\xde\x1c)8J\xdfZ\xc38J\xe0R\xe38J\xe1Qe8J\xe2MX8J\xe3J\x8c8" +
+	"J\xe4H\xa58J\xe5F\xf78J\xe6E\xa18K\x1f%R8K Y}8K!Y\x148K\"" +
+	"Y/8K#Yq8K$Z+8K%Y\xa48K&Y\x878K`'\xae8KaW\xdd" +
+	"8KbXH8KcX\x9d8KdY~8KeZ:8KfY\xe18K\xa1(\x018K" +
+	"\xa2W\xa18K\xa3X&8K\xa4Y\x128K\xa5Y\xd18K\xa6Z\xbb8K\xe2(@8K\xe3V" +
+	"\xa98K\xe4Xz8K\xe5Y<8K\xe6Z)8L#'\xb98L$V\x9a8L%W\x858" +
+	"L&Xv8Ld(\xbf8LeX\xa48LfY\x958L\xa5(\xbb8L\xa6Y\xd08L\xe6" +
+	"(\xbf8S\xc2M)8SÅ)8SĄ\xcb8S\xc6b\x0e8S\xc7a\x108S\xc8^\x91" +
+	"8S\xc9]\xa38S\xca\\\xd48S\xcb]u8S\xcc]Z8T\x02\x85%8T\x03J\xa68T" +
+	"\x04\x80\xda8T\x06^\xb88T\a]\xba8T\b^b8T\t\\\xad8T\n[\xde8T\v\\" +
+	"\x7f8T\f\\d8TB\x84\xc48TC\x80\xd78TDH>8TF[g8TGZi8" +
+	"TH[\x118TI\\\x898TJZ\xe98TK[\x8b8TL[p8T\x81\x80\x008T\x82" +
+	"v?8T\x83sq8T\x84k\xe78T\x86I\xf68T\x87I\x138T\x88E(8T\x89BT" +
+	"8T\x8a@\xb88T\x8b?t8T\x8c>\x178T\xc1V\t8T\xc2c\xcb8T\xc3`z8T" +
+	"\xc4]-8T\xc6\x1e\x808T\xc7O\x8e8T\xc8P\v8T\xc9Q\x1c8T\xcaRP8T\xcbS" +
+	"c8T\xccS\v8U\x01O\x8e8U\x02b\xcb8U\x03_z8U\x04\\.8U\x06O\x8e8" +
+	"U\a\x1e\xde8U\bOa8U\tP\xa38U\nQ\xe28U\vR\xf98U\fS\xee8UA" +
+	"K\x978UB`I8UC`\x1f8UD\\\xd28UFP\t8UGO`8UH\x1fH" +
+	"8UIO\xe28UJQC8UKR\x848ULS|8U\x81H\xcc8U\x82_Z8U" +
+	"\x83^i8U\x84^G8U\x86Q\x198U\x87P\xa18U\x88O\xe18U\x89\x1f\xad8U\x8aO" +
+	"z8U\x8bQ\xab8U\x8cR\xa88U\xc1Fb8U\xc2^\x8b8U\xc3]\x998U\xc4\\\xa78" +
+	"U\xc6RM8U\xc7Q\xdf8U\xc8QB8U\xc9Oz8U\xca\x1fD8U\xcbQ\x998U\xcc" +
+	"R\x958V\x01E\xc88V\x02_*8V\x03^98V\x04]G8V\x06S_8V\aR\xf4" +
+	"8V\bR\x818V\tQ\xaa8V\nQ\x988V\v d8V\fS\xbd8VAD\x848V" +
+	"B_\x108VC^\x1e8VD],8VFS\x068VGS\xea8VHSy8VIR" +
+	"\xa68VJR\x948VKS\xbd8VL i8V\xc6U\xbb8V\xc7OV8V\xc8Kn8" +
+	"V\xc9H\xae8V\xcaFL8V\xcbE\xb68V\xccDu8V\xd2\x7f\x1e8V\xd3U\xc78V\xd4" +
+	"Oa8V\xd5Kx8V\xd6H\xb78V\xd7FU8V\xd8E\xbd8V\xd9D|8W\x02N\x83" +
+	"8W\x03\x85\xfa8W\x04\x85\x9d8W\x06cG8W\abE8W\b_\xcb8W\t^\xde8W" +
+	"\n^\x0f8W\v^\xac8W\f^\x908W\x0fL\xb78W\x10\x84J8W\x11\x83\xea8W\x12u" +
+	"w8W\x13cT8W\x14bR8W\x15_\xd78W\x16^\xe88W\x17^\x198W\x18^\xb58" +
+	"W\x19^\x998WB\x85\xf68WCL\x048WD\x81\xbd8WF_\xfe8WG^\xfd8WH" +
+	"_\x9c8WI]\xeb8WJ]\x1c8WK]\xba8WL]\x9e8WO\x84J8WPJ5" +
+	"8WQ\x80\t8WRr\xaf8WS`\f8WT_\n8WU_\xa88WV]\xf68W" +
+	"W]'8WX]\xc38WY]\xa78W\x82\x85\x968W\x83\x81\xba8W\x84I\xa28W\x86\\" +
+	"\xbc8W\x87[\xba8W\x88\\Y8W\x89]\xc78W\x8a\\,8W\x8b\\\xc98W\x8c\\\xad8" +
+	"W\x8f\x83\xeb8W\x90\x80\t8W\x91G\xd08W\x92k?8W\x93\\\xca8W\x94[\xc88W\x95" +
+	"\\e8W\x96]\xd28W\x97\\68W\x98\\\xd28W\x99\\\xb68W\xc2w\\8W\xc3t\x97" +
+	"8W\xc4m)8W\xc6K\x978W\xc7J\xb68W\xc8F\xd88W\xc9D\r8W\xcaBv8W" +
+	"\xcbA58W\xcc?\xdc8W\xcfu\x988W\xd0r\xcf8W\xd1k^8W\xd2\x14E8W\xd3K" +
+	"\xa68W\xd4J\xc68W\xd5F\xe68W\xd6D\x198W\xd7B\x838W\xd8A@8W\xd9?\xe78" +
+	"X\x01Wc8X\x02d\xff8X\x03a\xbd8X\x04^~8X\x06 t8X\aQ\x148X\b" +
+	"Q\x898X\tR\x938X\nS\xc08X\vT\xcd8X\fTt8X\x0fcP8X\x10`\b" +
+	"8X\x11\\\xc58X\x12K\x838X\x13 \x838X\x14Q!8X\x15Q\x958X\x16R\x9d8X" +
+	"\x17S\xca8X\x18T\xd58X\x19T}8XAP\xfc8XBc\xf98XC`\xb78XD]" +
+	"x8XFQ\x128XG \xcd8XHP\xe18XIR\x1a8XJSR8XKTb8" +
+	"XLUR8XObL8XP_\x048XQ[\xc18XRJ\xa18XSQ\x1f8XT" +
+	" \xdb8XUP\xed8XVR%8XWS]8XXTk8XYU[8X\x81M\x12" +
+	"8X\x82a}8X\x83aR8X\x84^\x148X\x86Q\x868X\x87P\xdf8X\x88!48X" +
+	"\x89Q]8X\x8aR\xb68X\x8bS\xef8X\x8cT\xe28X\x8f_\xd08X\x90_\xa18X\x91\\" +
+	"_8X\x92F\xc18X\x93Q\x938X\x94P\xec8X\x95!A8X\x96Qg8X\x97R\xc18" +
+	"X\x98S\xf88X\x99T\xeb8X\xc1JQ8X\xc2`\x8e8X\xc3_\xa08X\xc4_~8X\xc6" +
+	"R\x8e8X\xc7R\x178X\xc8Q[8X\xc9!\x988X\xcaP\xf48X\xcbS\x1b8X\xccT\x12" +
+	"8X\xcf^\xe28X\xd0]\xef8X\xd1]\xca8X\xd2C\xf58X\xd3R\x9b8X\xd4R$8X" +
+	"\xd5Qg8X\xd6!\xa38X\xd7P\xfe8X\xd8S$8X\xd9T\x1b8Y\x01G\xee8Y\x02_" +
+	"\xbf8Y\x03^\xd18Y\x04]\xe28Y\x06S\xba8Y\aSO8Y\bR\xb48Y\tP\xf48" +
+	"Y\n!18Y\vS\t8Y\fT\x008Y\x0f^\x138Y\x10] 8Y\x11\\/8Y\x12" +
+	"B_8Y\x13S\xc88Y\x14S\\8Y\x15R\xc18Y\x16P\xfe8Y\x17!<8Y\x18S\x12" +
+	"8Y\x19T\t8YAGV8YB`[8YC_l8YD^~8YFT\xc68Y" +
+	"GT]8YHS\xec8YIS\x198YJS\b8YK\"M8YLU#8YO^" +
+	"\xae8YP]\xbc8YQ\\\xcb8YRA\x1d8YST\xd38YTTj8YUS\xf88" +
+	"YVS$8YWS\x128YX\"V8YYU,8Y\x81F\x158Y\x82`?8Y\x83" +
+	"_Q8Y\x84^b8Y\x86Tm8Y\x87UM8Y\x88T\xde8Y\x89T\x108Y\x8aS\xfe" +
+	"8Y\x8bU#8Y\x8c\"R8Y\x8f^\x938Y\x90]\xa08Y\x91\\\xaf8Y\x92?\xc48Y" +
+	"\x93Tz8Y\x94UZ8Y\x95T\xea8Y\x96T\x1b8Y\x97T\t8Y\x98U,8Y\x99\"" +
+	"[8Z\x1f\x80\x008Z W 8Z!P\xcf8Z\"L\xf38Z#J;8Z$G\xe18" +
+	"Z%GJ8Z&F\r8Z\\N\x118Z]\x85\x1b8Z^\x84\xbc8Z_v\x948Z`" +
+	"d\x888Zac\x818Zba\n8Zc`\x1c8Zd_N8Ze_\xe68Zf_\xc9" +
+	"8Z\x9dK\x948Z\x9e\x80\xec8Z\x9fs\xd58Z\xa0aO8Z\xa1`G8Z\xa2`\xdb8Z" +
+	"\xa3_-8Z\xa4^_8Z\xa5^\xf68Z\xa6^\xda8Z\xdeI48Z\xdfl\x818Z\xe0^" +
+	"\x1a8Z\xe1]\x128Z\xe2]\xa78Z\xe3_\b8Z\xe4]q8Z\xe5^\t8Z\xe6]\xec8" +
+	"[\x1f\x16\x808[ M%8[!LD8[\"Hq8[#E\xae8[$D\x1d8[%" +
+	"B\xde8[&A\x888[`\"w8[aR\xa58[bS\x128[cT\x128[dU8" +
+	"8[eV<8[fU\xe48[\xa1\"\xca8[\xa2Rl8[\xa3S\x9c8[\xa4T\xcc8[" +
+	"\xa5U\xd38[\xa6V\xbd8[\xe2#.8[\xe3R\xe18[\xe4T38[\xe5Uc8[\xe6V" +
+	"P8\\##\x8e8\\$Rx8\\%T\x948\\&U\x858\\d#)8\\eT\x828" +
+	"\\fUs8\\\xa5$?8\\\xa6V\x928\\\xe6$C8c\xc2MN8cÉs8c\xc4" +
+	"\x89\x008cŌ08c\xc7^*8c\xc8[\xc08c\xc9Z\xf88c\xcaZ\xf28c\xcbZn" +
+	"8c\xcc[?8d\x02\x89o8d\x03J\xb58d\x04\x85\r8d\x05\x88=8d\aZ\xd38d" +
+	"\b[t8d\tY\xe58d\nY\xe08d\vY\\8d\fZ,8dB\x88\xf98dC\x85" +
+	"\n8dDH:8dE\x84Q8dGW~8dHX\x1f8dIY\xb88dJX\xeb8" +
+	"dKXg8dLY88d\x82\x8c(8d\x83\x8898d\x84\x84P8d\x85F\x918d\x87" +
+	"U:8d\x88U\xda8d\x89Ws8d\x8aY\xd38d\x8bX\x7f8d\x8cYO8d\xc1\x80\x00" +
+	"8d\xc2u\xef8d\xc3s\x198d\xc4k\x8a8d\xc5au8d\xc7Ds8d\xc8@\xfe8d" +
+	"\xc9>T8d\xca<\xc58d\xcb;\xd78d\xcc:\xee8e\x01K\xa48e\x02_\xe38e\x03\\" +
+	"\x938e\x04YB8e\x05W\x008e\a\x1a\xb38e\bJ\xc78e\tL\x138e\nN\x058" +
+	"e\vO\x858e\fP\xc78eAG\x9b8eB]w8eC]08eDY\xdf8eE" +
+	"W\x9d8eGJ\xc68eH\x1b\x1c8eIKx8eJM\x8c8eKO\x108eLPU" +
+	"8e\x81D\xf68e\x82\\\xae8e\x83[\xa08e\x84[u8e\x85Y38e\x87L\x108e" +
+	"\x88Kw8e\x89\x1b\xa78e\x8aL\x8a8e\x8bN78e\x8cO\xa68e\xc1Cq8e\xc2\\" +
+	"\xa68e\xc3[\x988e\xc4Z\xa78e\xc5[\x908e\xc7N\x018e\xc8M\x898e\xc9L\x898" +
+	"e\xca\x1c-8e\xcbN%8e\xccP\\8f\x01A\xb38f\x02\\\"8f\x03[\x148f\x04" +
+	"Z#8f\x05Z<8f\aO\x808f\bO\r8f\tN68f\nN%8f\v\x1b\xd5" +
+	"8f\fPJ8fAAv8fB\\\xf18fC[\xe38fDZ\xf18fE[\n8f" +
+	"GP\xc18fHPP8fIO\xa48fJP[8fKPI8fL\x1c\xfe8f\xc7K" +
+	"w8f\xc8G~8f\xc9D\xe48f\xcaCe8f\xcbA\xae8f\xccAq8f\xd3\x7f\x1e8" +
+	"f\xd4K\x858f\xd5G\x8a8f\xd6D\xef8f\xd7Co8f\xd8A\xb78f\xd9Ay8g\x02" +
+	"N\xad8g\x03\x8a<8g\x04\x89\xca8g\x05\x8c\xed8g\a_i8g\b]\x048g\t\\<" +
+	"8g\n\\48g\v[\xb18g\f\\}8g\x0fL\xe28g\x10\x88\x8f8g\x11\x88\x1a8g" +
+	"\x12\x8b;8g\x13u(8g\x14_y8g\x15]\x128g\x16\\I8g\x17\\?8g\x18[" +
+	"\xbc8g\x19\\\x868gB\x8a88gCL\x188gD\x85\xe88gE\x89\v8gG\\!8" +
+	"gH\\\xb98gI[.8gJ[&8gKZ\xa28gL[n8gO\x88\x8f8gP" +
+	"JJ8gQ\x8468gR\x87W8gSrX8gT\\08gU\\\xc78gV[:" +
+	"8gW[18gXZ\xae8gY[x8g\x82\x89\xc48g\x83\x85\xe68g\x84I\xa38g" +
+	"\x85\x8518g\x87X\xda8g\x88Yr8g\x89[\x008g\x8aZ58g\x8bY\xb18g\x8cZ" +
+	"}8g\x8f\x88\x1a8g\x90\x8468g\x91G\xd28g\x92\x83{8g\x93j\xe38g\x94X\xea8" +
+	"g\x95Y\x808g\x96[\r8g\x97Z@8g\x98Y\xbd8g\x99Z\x878g\u008c\xe48g\xc3" +
+	"\x89\x068gą.8g\xc5G\xfb8g\xc7V\x9e8g\xc8W68g\xc9X\xc48g\xca[\x17" +
+	"8g\xcbY\xc78g\xccZ\x938gϋ:8gЇV8gуz8g\xd2F*8g" +
+	"\xd3`\xf08g\xd4V\xae8g\xd5WE8g\xd6X\xd18g\xd7[#8g\xd8Y\xd28g\xd9Z" +
+	"\x9d8h\x02w\x0f8h\x03tC8h\x04l\xcf8h\x05b\xe08h\aF&8h\bB\xbc8" +
+	"h\t@\x1c8h\n>\x918h\v=\xa58h\f<\xbe8h\x0fuL8h\x10r{8h\x11" +
+	"k\x058h\x12a\x138h\x13\x0f\xd68h\x14F88h\x15B\xcd8h\x16@*8h\x17>\x9e" +
+	"8h\x18=\xb28h\x19<\xc98hAM\x1f8hBa\x1f8hC]\xdc8hDZ\x9a8h" +
+	"EXb8hG\x1c\xb08hHLW8hIM\x9b8hJO\x838hKP\xfb8hLR" +
+	"68hO_u8hP\\-8hQX\xe68hRV\xac8hSF\x148hT\x1c\xc18" +
+	"hULf8hVM\xa88hWO\x8e8hXQ\x068hYR@8h\x81I%8h\x82" +
+	"^\xb88h\x83^q8h\x84[.8h\x85X\xf78h\x87LV8h\x88\x1d\x178h\x89M\x02" +
+	"8h\x8aO\f8h\x8bP\x888h\x8cQ\xc68h\x8f]\x0e8h\x90\\\xc38h\x91Y|8h" +
+	"\x92WB8h\x93B\xa88h\x94Le8h\x95\x1d'8h\x96M\x0f8h\x97O\x178h\x98P" +
+	"\x938h\x99Q\xd08h\xc1F\x898h\xc2]\xee8h\xc3\\\xe38h\xc4\\\xb98h\xc5Z\x818" +
+	"h\xc7M\x988h\xc8M\x018h\xc9\x1d\xa08h\xcaN\x0f8h\xcbO\xb48h\xccQ\x1b8h\xcf" +
+	"\\E8h\xd0[68h\xd1[\b8h\xd2X\xce8h\xd3@\x068h\xd4M\xa78h\xd5M\x0f" +
+	"8h\xd6\x1d\xad8h\xd7N\x1a8h\xd8O\xbf8h\xd9Q%8i\x01E\b8i\x02]\xe48i" +
+	"\x03\\\xda8i\x04[\xeb8i\x05\\\xd18i\aO~8i\bO\t8i\tN\r8i\n\x1e" +
+	"&8i\vO\xa28i\fQ\xcf8i\x0f\\;8i\x10[,8i\x11Z;8i\x12[\x1f8" +
+	"i\x13>z8i\x14O\x8d8i\x15O\x178i\x16N\x1a8i\x17\x1e18i\x18O\xad8i\x19" +
+	"Q\xd98iACP8iB]`8iC\\V8iD[g8iE[\x808iGP\xf5" +
+	"8iHP\x848iIO\xb28iJO\xa28iK\x1d\xcf8iLQ\xbd8iO[\xb78i" +
+	"PZ\xa98iQY\xb78iRY\xcf8iS=\x8e8iTQ\x058iUP\x938iVO" +
+	"\xbf8iWO\xad8iX\x1d\xdb8iYQ\xc78i\x81C\x118i\x82^*8i\x83] 8" +
+	"i\x84\\28i\x85\\K8i\x87R/8i\x88Q\xc18i\x89Q\x188i\x8aQ\xcd8i\x8b" +
+	"Q\xbb8i\x8c\x1e\xf48i\x8f\\\x828i\x90[s8i\x91Z\x828i\x92Z\x998i\x93<\xa6" +
+	"8i\x94R?8i\x95Q\xcf8i\x96Q$8i\x97Q\xd88i\x98Q\xc78i\x99\x1e\xfd8j" +
+	" \x80\x008j!M\x008j\"I\x148j#F\x828j$E\x068j%CS8j&C" +
+	"\x148j\\NA8j]\x89X8j^\x88\xe58j_\x8b\xf78j`vI8ja`\xb48" +
+	"jb^R8jc]\x898jd]}8je\\\xfa8jf]\xc08j\x9dK\xae8j\x9e" +
+	"\x85\x128j\x9f\x88$8j\xa0s\x828j\xa1]z8j\xa2^\b8j\xa3\\~8j\xa4\\s" +
+	"8j\xa5[\xef8j\xa6\\\xb58j\xdeI:8j߄Y8j\xe0l(8j\xe1ZB8j" +
+	"\xe2Z\xcf8j\xe3\\P8j\xe4[\x858j\xe5[\x028j\xe6[\xc88k\x1fG\x948k b" +
+	"[8k!X\x118k\"X\x9e8k#Z\x1f8k$\\d8k%[\x178k&[\xdd8" +
+	"k`\x12\x1e8kaG\xc78kbDg8kcA\xce8kd@F8ke?\\8kf" +
+	">v8k\xa1\x1e\xbe8k\xa2M\xf58k\xa3O/8k\xa4Q\v8k\xa5R{8k\xa6S\xad" +
+	"8k\xe2\x1f\"8k\xe3N\x998k\xe4P\x978k\xe5R\v8k\xe6S@8l#\x1f\xa78l" +
+	"$O\x9f8l%Q;8l&R\x9a8ld )8leQ*8lfSK8l\xa5\x1f" +
+	"\xd58l\xa6S98l\xe6 \xf38s\xc2O\x998sÎ\xdb8sč\x968sŐ\x8b8" +
+	"sƒ?8s\xc8\\\xc78s\xc9[\xf88s\xca\\\x188s\xcb\\^8s\xcc\\\t8t\x02" +
+	"\x8e\xd98t\x03L\xe88t\x04\x89\xa38t\x05\x8c\x988t\x06\x8eL8t\b\\{8t\tZ\xe6" +
+	"8t\n[\x068t\v[L8t\fZ\xf78tB\x8d\x918tC\x89\xa18tDJZ8t" +
+	"E\x88\xab8tF\x8a_8tHY&8tIZ\xb88tJZ\x118tKZW8tLZ" +
+	"\x028t\x82\x90\x858t\x83\x8c\x948t\x84\x88\xa98t\x85Hz8t\x86\x86o8t\x88V\xb88" +
+	"t\x89XI8t\x8aZ\xca8t\x8bZI8t\x8cY\xf48t\u009268tÎF8t\xc4" +
+	"\x8a[8tņm8t\xc6E\xe48t\xc8Sl8t\xc9T\xfe8t\xcaW~8t\xcbZ*" +
+	"8t\xccY\x058u\x01\x80\x008u\x02x}8u\x03u\xa68u\x04ld8u\x05b\xf98u" +
+	"\x06X\x9f8u\b@\xb78u\t>l8u\n<\xf68u\v<\x148u\f;\x818uAG" +
+	"F8uB^\x808uC^78uDZ\xe78uEX{8uFU48uH\x1b~8" +
+	"uIJZ8uJL\x7f8uKN\xb68uLPd8u\x81D\x908u\x82]\xb08u\x83" +
+	"\\\xa08u\x84\\u8u\x85Z\t8u\x86V\xc28u\x88JX8u\x89\x1c\t8u\x8aK\xa3" +
+	"8u\x8bN\x038u\x8cO\xb68u\xc1C08u\xc2]\xce8u\xc3\\\xbe8u\xc4[\xcd8u" +
+	"\xc5\\\x878u\xc6Y?8u\xc8L|8u\xc9K\xa28u\xca\x1c\xb68u\xcbN\xb98u\xccP" +
+	"l8v\x01BX8v\x02^\x128v\x03]\x038v\x04\\\x118v\x05\\\x048v\x06[\xe88" +
+	"v\bN\xb18v\tN\x008v\nN\xb88v\v\x1dM8v\fPY8vA@\xf68vB" +
+	"]\xbd8vC\\\xad8vD[\xbc8vE[\xaf8vFZ\xc28vHP`8vIO\xb3" +
+	"8vJPj8vKPY8vL\x1c\xfd8v\xc8G)8v\xc9D\x7f8v\xcaC%8v" +
+	"\xcbBQ8v\xcc@\xf38v\xd4\x7f\x1f8v\xd5G78v\xd6D\x8c8v\xd7C08v\xd8B" +
+	"Z8v\xd9@\xfd8w\x02P\xf08w\x03\x8f\x918w\x04\x8eP8w\x05\x9198w\x06\x92\xe68" +
+	"w\b^\a8w\t]88w\n]V8w\v]\x998w\f]D8w\x0fO%8w\x10" +
+	"\x8d\xe78w\x11\x8c\xa48w\x12\x8f\x8a8w\x13\x9158w\x14w\xae8w\x15^\x178w\x16]F" +
+	"8w\x17]b8w\x18]\xa48w\x19]P8wB\x8f\x8f8wCND8wD\x8an8w" +
+	"E\x8dW8wF\x8f\x048wH]\xbc8wI\\*8wJ\\G8wK\\\x8b8wL\\" +
+	"68wO\x8d\xe78wPLw8wQ\x88\xc08wR\x8b\xa78wS\x8dQ8wTt\xde8" +
+	"wU]\xcc8wV\\88wW\\T8wX\\\x968wY\\A8w\x82\x8eL8w\x83" +
+	"\x8al8w\x84K\xbb8w\x85\x89z8w\x86\x8b(8w\x88Zu8w\x89[\xfc8w\x8a[V" +
+	"8w\x8b[\x9a8w\x8c[E8w\x8f\x8c\xa48w\x90\x88\xc08w\x91I\xec8w\x92\x87\xc98w" +
+	"\x93\x89s8w\x94k\xbc8w\x95Z\x858w\x96\\\n8w\x97[c8w\x98[\xa58w\x99[" +
+	"P8w\u009118wÍR8wĉx8w\xc5I\xdd8wƇH8w\xc8X\x108" +
+	"w\xc9Y\x978w\xca\\\n8w\xcb[\x8a8w\xcc[58wϏ\x898wЋ\xa68w\xd1" +
+	"\x87\xc78w\xd2H\x0e8wӅ\x928w\xd4bp8w\xd5X 8w\xd6Y\xa58w\xd7\\\x17" +
+	"8w\xd8[\x968w\xd9[A8x\x02\x92\xdc8x\x03\x8e\xfd8x\x04\x8b#8x\x05\x87F8x" +
+	"\x06GN8x\bT\xd38x\tVZ8x\nX\xcd8x\v[k8x\fZJ8x\x0f\x91" +
+	"48x\x10\x8dP8x\x11\x89r8x\x12\x85\x928x\x13E|8x\x14X:8x\x15T\xe38" +
+	"x\x16Vh8x\x17X\xda8x\x18[w8x\x19ZU8xBy\x938xCv\xc58xD" +
+	"m\xa58xEd\\8xFZ(8xHBu8xI@18xJ>\xc08xK=\xe0" +
+	"8xL=N8xOw\xd08xPt\xff8xQk\xdc8xRb\x928xSX[8x" +
+	"T\x10M8xUB\x878xV@B8xW>\xcf8xX=\xed8xY=[8x\x81H" +
+	"\xd18x\x82_\xbd8x\x83_t8x\x84\\28x\x85Y\xd18x\x86V\x988x\x88\x1dw8" +
+	"x\x89K\xe88x\x8aN\x038x\x8bP/8x\x8cQ\xd58x\x8f^\x138x\x90]\xc88x\x91" +
+	"Z\x818x\x92X\x1d8x\x93T\xe08x\x94Be8x\x95\x1d\x888x\x96K\xf78x\x97N\x10" +
+	"8x\x98P;8x\x99Q\xe18x\xc1F$8x\xc2^\xec8x\xc3]\xe08x\xc4]\xb58x" +
+	"\xc5[T8x\xc6X\x1b8x\xc8K\xe78x\xc9\x1e\x008x\xcaM+8x\xcbO\x808x\xccQ" +
+	"+8x\xcf]C8x\xd0\\48x\xd1\\\x068x\xd2Y\xa28x\xd3Ve8x\xd4@ 8" +
+	"x\xd5K\xf78x\xd6\x1e\x0f8x\xd7M88x\xd8O\x8c8x\xd9Q68y\x01D\xc88y\x02" +
+	"_\b8y\x03]\xfc8y\x04]\r8y\x05]\xc48y\x06Z\x8b8y\bN\x008y\tM*" +
+	"8y\n\x1e\xab8y\vP48y\fQ\xde8y\x0f]^8y\x10\\P8y\x11[_8y" +
+	"\x12\\\x148y\x13X\xd78y\x14>\xad8y\x15N\x108y\x16M88y\x17\x1e\xb88y\x18P" +
+	"?8y\x19Q\xea8yAC\xf28yB_I8yC^=8yD]O8yE]B8" +
+	"yF]%8yHP*8yIO}8yJP28yK\x1fA8yLQ\xcc8yO" +
+	"]\xa08yP\\\x918yQ[\xa08yR[\x928yS[s8yT=\xcb8yUP:" +
+	"8yVO\x8b8yWP?8yX\x1fL8yYQ\xd88y\x81B\x948y\x82^\xf38y" +
+	"\x83]\xe78y\x84\\\xf98y\x85\\\xed8y\x86\\\x038y\x88Q\xd08y\x89Q'8y\x8aQ" +
+	"\xdc8y\x8bQ\xcc8y\x8c\x1e\xf28y\x8f]K8y\x90\\<8y\x91[K8y\x92[=8" +
+	"y\x93ZQ8y\x94=98y\x95Q\xe08y\x96Q68y\x97Q\xe98y\x98Q\xd88y\x99" +
+	"\x1e\xfe8z!\x80\x008z\"H\xc38z#F 8z$D\xc98z%C\xf58z&B\x9b" +
+	"8z\\P|8z]\x8e\x9d8z^\x8d^8z_\x9078z`\x91\xdb8zax\xc38z" +
+	"b_S8zc^\x828zd^\x9c8ze^\xdb8zf^\x868z\x9dM\xd38z\x9e\x89" +
+	"\x8b8z\x9f\x8cd8z\xa0\x8e\b8z\xa1u\xfd8z\xa2_\b8z\xa3]x8z\xa4]\x918" +
+	"z\xa5]\xd08z\xa6]{8z\xdeKL8z߈\x978z\xe0\x8a;8z\xe1l\xfd8z\xe2" +
+	"[\xd08z\xe3]J8z\xe4\\\xa48z\xe5\\\xe38z\xe6\\\x8e8{\x1fIq8{ \x86k" +
+	"8{!c\xd48{\"Yv8{#Z\xf08{$]T8{%\\\xd48{&\\~8{" +
+	"`F\xe68{aY\xc38{bVG8{cW\xc18{dZ%8{e\\\xb48{f[" +
+	"\x968{\xa1\x12\x8f8{\xa2D#8{\xa3A\xe58{\xa4@w8{\xa5?\x978{\xa6?\x058" +
+	"{\xe2\x1f\x818{\xe3M\x868{\xe4O\x948{\xe5Q\xb38{\xe6SQ8|# \x068|$" +
+	"N\xc08|%Q\b8|&R\xab8|d \xad8|eQ\xb98|fS\\8|\xa5!@" +
+	"8|\xa6SJ8|\xe6 \xf48\x83\xc2O\xe08\x83Ð\xa58\x83ď^8\x83ŒS8\x83" +
+	"Ɣ\x0e8\x83ǘ!8\x83\xc9[\x1d8\x83\xca[68\x83\xcb[\xa28\x83\xcc\\\x168\x84\x02\x90" +
+	"\xa18\x84\x03N\x938\x84\x04\x8c\xbc8\x84\x05\x8e\xe88\x84\x06\x90~8\x84\a\x94\x918\x84\t[\x198" +
+	"\x84\n[28\x84\v[\x9d8\x84\f\\\x128\x84B\x8fY8\x84C\x8c\xbb8\x84DK\xf08\x84E" +
+	"\x8a\xfb8\x84F\x8c\x908\x84G\x90\xa38\x84IZ\xcf8\x84JZ!8\x84KZ\x8c8\x84L[\x01" +
+	"8\x84\x82\x92M8\x84\x83\x8e\xe68\x84\x84\x8a\xf98\x84\x85J\x008\x84\x86\x88\x9f8\x84\x87\x8c\xcf8\x84" +
+	"\x89X`8\x84\x8aZ\xd98\x84\x8bZ~8\x84\x8cZ\xf38\x84\u0094\x058\x84Ðy8\x84Č" +
+	"\x8c8\x84ň\x9d8\x84\xc6G\\8\x84ǈ\xf18\x84\xc9U\x108\x84\xcaW\x8a8\x84\xcbZV8" +
+	"\x84\xccZ\x048\x85\x02\x98\x188\x85\x03\x94\x8c8\x85\x04\x90\x9f8\x85\x05\x8c\xcc8\x85\x06\x88\xf18\x85\a" +
+	"FS8\x85\tR\xea8\x85\nUd8\x85\vX08\x85\f[\v8\x85A\x80\x008\x85Bw\xe8" +
+	"8\x85Cw\x958\x85DnQ8\x85Ec\xa98\x85FX\xfe8\x85GP\xe88\x85I=38\x85" +
+	"J<\x178\x85K;>8\x85L:\xb78\x85\x81C\xc58\x85\x82\\\xd58\x85\x83\\\xd58\x85\x84\\" +
+	"\x8c8\x85\x85Z 8\x85\x86V\xd48\x85\x87T\xb18\x85\x89\x1bH8\x85\x8aI\x908\x85\x8bL\t8" +
+	"\x85\x8cNn8\x85\xc1BT8\x85\xc2\\\xec8\x85\xc3\\\xec8\x85\xc4[\xdc8\x85\xc5\\\x968\x85\xc6" +
+	"YJ8\x85\xc7W'8\x85\xc9I\x8f8\x85\xca\x1b\xf58\x85\xcbL\xe58\x85\xccOJ8\x86\x01A\xa2" +
+	"8\x86\x02]V8\x86\x03]V8\x86\x04\\F8\x86\x05\\98\x86\x06\\\x138\x86\aY\xf08\x86" +
+	"\tL\x068\x86\nL\xe38\x86\v\x1c\xb28\x86\fP\x008\x86AA%8\x86B]\xc98\x86C]" +
+	"\xc98\x86D\\\xb98\x86E\\\xac8\x86F[\xc08\x86G\\\xc78\x86INj8\x86JOG8" +
+	"\x86KO\xff8\x86L\x1dR8\x86\xc9C\xb58\x86\xcaBK8\x86\xcbA\x9c8\x86\xccA!8\x86\xd5" +
+	"\x7f\x1f8\x86\xd6C\xc38\x86\xd7BX8\x86\xd8A\xa78\x86\xd9A+8\x87\x02Q78\x87\x03\x91Y" +
+	"8\x87\x04\x90\x178\x87\x05\x92\xff8\x87\x06\x94\xb48\x87\a\x98\xb88\x87\t\\`8\x87\n\\v8\x87" +
+	"\v\\\xdf8\x87\f]Q8\x87\x0fOn8\x87\x10\x8f\xb08\x87\x11\x8el8\x87\x12\x91S8\x87\x13\x93" +
+	"\x048\x87\x14\x97\b8\x87\x15w\x1c8\x87\x16\\p8\x87\x17\\\x858\x87\x18\\\xec8\x87\x19]]8" +
+	"\x87B\x91U8\x87CO\xeb8\x87D\x8d|8\x87E\x8f\x9f8\x87F\x91.8\x87G\x9538\x87I" +
+	"\\\\8\x87J\\r8\x87K\\\xdb8\x87L]M8\x87O\x8f\xb08\x87PN\x1e8\x87Q\x8b\xd1" +
+	"8\x87R\x8d\xf28\x87S\x8f\x7f8\x87T\x93\x838\x87Uv\xc58\x87V\\l8\x87W\\\x818\x87" +
+	"X\\\xe88\x87Y]X8\x87\x82\x90\x128\x87\x83\x8d|8\x87\x84MM8\x87\x85\x8b\xc38\x87\x86\x8d" +
+	"R8\x87\x87\x91V8\x87\x89\\\x128\x87\x8a[e8\x87\x8b[\xce8\x87\x8c\\@8\x87\x8f\x8el8" +
+	"\x87\x90\x8b\xd28\x87\x91K\x7f8\x87\x92\x8a\x158\x87\x93\x8b\xa18\x87\x94\x8f\xa58\x87\x95m\xa38\x87\x96" +
+	"\\\"8\x87\x97[t8\x87\x98[\xdb8\x87\x99\\L8\x87\u0092\xf88\x87Ï\x9c8\x87ċ\xc0" +
+	"8\x87\xc5K_8\x87Ɖp8\x87Ǎ\x918\x87\xc9Y\xad8\x87\xca\\\x198\x87\xcb[\xbf8\x87" +
+	"\xcc\\18\x87ϑR8\x87Ѝ\xf18\x87ъ\x138\x87\xd2I\x918\x87Ӈ\xbd8\x87ԋ" +
+	"\xde8\x87\xd5c 8\x87\xd6Y\xbd8\x87\xd7\\(8\x87\xd8[\xcb8\x87\xd9\\<8\x88\x02\x94\xaa8" +
+	"\x88\x03\x91)8\x88\x04\x8dM8\x88\x05\x89n8\x88\x06H\xc18\x88\a\x89\xc48\x88\tVl8\x88\n" +
+	"X\xd88\x88\v[\x968\x88\f[E8\x88\x0f\x93\x038\x88\x10\x8f~8\x88\x11\x8b\xa08\x88\x12\x87\xbe" +
+	"8\x88\x13F\xf18\x88\x14\x88\x0f8\x88\x15X\x988\x88\x16V|8\x88\x17X\xe68\x88\x18[\xa38\x88" +
+	"\x19[P8\x88B\x98\xad8\x88C\x95+8\x88D\x91P8\x88E\x8d\x8d8\x88F\x89\xc28\x88GG" +
+	"\xb78\x88ITN8\x88JV\xbb8\x88KYy8\x88L\\F8\x88O\x97\x068\x88P\x93\x808" +
+	"\x88Q\x8f\xa28\x88R\x8b\xdd8\x88S\x88\x0e8\x88TE\xe78\x88UP\x9c8\x88VT^8\x88W" +
+	"V\xc98\x88XY\x868\x88Y\\Q8\x88\x82y\x008\x88\x83x\xad8\x88\x84o\x8b8\x88\x85e\v" +
+	"8\x88\x86Z\x868\x88\x87R\x8b8\x88\x89>\xfd8\x88\x8a=\xe48\x88\x8b=\f8\x88\x8c<\x868\x88" +
+	"\x8fw?8\x88\x90v\xe78\x88\x91m\xc48\x88\x92cB8\x88\x93X\xba8\x88\x94P\xbe8\x88\x95\x0f" +
+	"\xbf8\x88\x96?\x0f8\x88\x97=\xf48\x88\x98=\x1b8\x88\x99<\x938\x88\xc1E]8\x88\xc2^\x148" +
+	"\x88\xc3^\x148\x88\xc4]\xcc8\x88\xc5[k8\x88\xc6X-8\x88\xc7V\x138\x88\xc9\x1dA8\x88\xca" +
+	"K 8\x88\xcbM\x8d8\x88\xccO\xe78\x88\xcf\\l8\x88\xd0\\h8\x88\xd1\\\x1e8\x88\xd2Y\xbb" +
+	"8\x88\xd3Vy8\x88\xd4T]8\x88\xd5>\xed8\x88\xd6\x1dR8\x88\xd7K/8\x88\xd8M\x9a8\x88" +
+	"\xd9O\xf38\x89\x01C\xf08\x89\x02^)8\x89\x03^)8\x89\x04]\x1d8\x89\x05]\xd38\x89\x06Z" +
+	"\x968\x89\aX|8\x89\tK\x1e8\x89\n\x1d\xec8\x89\vNf8\x89\fP\xc08\x89\x0f\\\x818" +
+	"\x89\x10\\}8\x89\x11[p8\x89\x12\\%8\x89\x13X\xe48\x89\x14V\xc88\x89\x15=\xd38\x89\x16" +
+	"K.8\x89\x17\x1d\xfb8\x89\x18Ns8\x89\x19P\xcc8\x89AC?8\x89B^\x908\x89C^\x8f" +
+	"8\x89D]\x838\x89E]w8\x89F]Q8\x89G[78\x89IM\x8a8\x89JNd8\x89" +
+	"K\x1e\xa78\x89LQt8\x89O\\\xe88\x89P\\\xe48\x89Q[\xd78\x89R[\xc98\x89S[" +
+	"\xa08\x89TY\x848\x89U<\xfa8\x89VM\x9a8\x89WNs8\x89X\x1e\xb58\x89YQ\x7f8" +
+	"\x89\x81B\xc28\x89\x82_\x008\x89\x83^\xff8\x89\x84]\xf38\x89\x85]\xe78\x89\x86\\\xfe8\x89\x87" +
+	"^\x008\x89\x89O\xe28\x89\x8aP\xbd8\x89\x8bQr8\x89\x8c\x1fE8\x89\x8f]X8\x89\x90]T" +
+	"8\x89\x91\\G8\x89\x92\\98\x89\x93[M8\x89\x94\\O8\x89\x95<r8\x89\x96O\xf28\x89" +
+	"\x97P\xcb8\x89\x98Q\x7f8\x89\x99\x1fQ8\x8a\"\x80\x008\x8a#E[8\x8a$C\xf48\x8a%C" +
+	"D8\x8a&B\xc88\x8a\\P\xc68\x8a]\x90d8\x8a^\x8f%8\x8a_\x91\xfe8\x8a`\x93\xa98" +
+	"\x8aa\x97\x9d8\x8abx48\x8ac]\xb08\x8ad]\xc28\x8ae^&8\x8af^\x938\x8a\x9d" +
+	"Ou8\x8a\x9e\x8c\x928\x8a\x9f\x8e\xa88\x8a\xa0\x90.8\x8a\xa1\x94#8\x8a\xa2w\xde8\x8a\xa3]\xab" +
+	"8\x8a\xa4]\xbd8\x8a\xa5^\"8\x8a\xa6^\x8f8\x8a\xdeL\xdb8\x8aߊ\xdb8\x8a\xe0\x8ca8\x8a" +
+	"\xe1\x90V8\x8a\xe2n\xde8\x8a\xe3]b8\x8a\xe4\\\xb48\x8a\xe5]\x188\x8a\xe6]\x868\x8b\x1fJ" +
+	"\xef8\x8b \x88\x8f8\x8b!\x8c\x9f8\x8b\"d\x828\x8b#[\b8\x8b$]e8\x8b%]\t8" +
+	"\x8b&]w8\x8b`HW8\x8ba\x88\xe18\x8bbZ 8\x8bcW\xd58\x8bdZ28\x8be" +
+	"\\\xe18\x8bf\\\x8e8\x8b\xa1GL8\x8b\xa2R?8\x8b\xa3U\xc18\x8b\xa4X\x1e8\x8b\xa5Z\xcd" +
+	"8\x8b\xa6]\x8b8\x8b\xe2\x12\x018\x8b\xe3@\xb78\x8b\xe4?\xa08\x8b\xe5>\xc98\x8b\xe6>A8\x8c" +
+	"#\x1fK8\x8c$L\xbe8\x8c%O\x1e8\x8c&Ql8\x8cd\x1f\xf28\x8ceO\xf48\x8cfR" +
+	"B8\x8c\xa5 \xaa8\x8c\xa6R\xf38\x8c\xe6!E8\x93\xc2Pr8\x93Ñ78\x93đ\x168" +
+	"\x93œ@8\x93Ɣ\xd68\x93ǘ\xf08\x93Ș\xa48\x93\xcaZ\x948\x93\xcbZ\xf98\x93\xcc" +
+	"[\x948\x94\x02\x9148\x94\x03N\xe88\x94\x04\x8d\x948\x94\x05\x8f\xbf8\x94\x06\x91T8\x94\a\x95o" +
+	"8\x94\b\x98\x7f8\x94\nZR8\x94\vZ\xb78\x94\f[Q8\x94B\x91\x118\x94C\x8d\x928\x94" +
+	"DM\xac8\x94E\x8d\"8\x94F\x8d\xef8\x94G\x91\xe48\x94H\x94\xf48\x94JZP8\x94KZ" +
+	"\xb48\x94L[O8\x94\x82\x93<8\x94\x83\x8f\xbd8\x94\x84\x8d\"8\x94\x85K\xa98\x94\x86\x89\xfe8" +
+	"\x94\x87\x8e\x0f8\x94\x88\x91\x1f8\x94\x8aZ\xec8\x94\x8bZ\x898\x94\x8c[$8\x94\u0094\xcf8\x94\xc3" +
+	"\x91P8\x94č\xed8\x94ŉ\xfc8\x94\xc6H\xf78\x94Ǌ/8\x94ȍ?8\x94\xcaW\x9c" +
+	"8\x94\xcbZa8\x94\xccZ58\x95\x02\x98\xe98\x95\x03\x95j8\x95\x04\x91\xe18\x95\x05\x8e\f8\x95" +
+	"\x06\x8a/8\x95\aG\xe48\x95\b\x8a\x8c8\x95\nUr8\x95\vX78\x95\f[28\x95B\x98" +
+	"\x9b8\x95C\x98x8\x95D\x94\xf08\x95E\x91\x1b8\x95F\x8d=8\x95G\x8a\x8a8\x95HE\xee8" +
+	"\x95JRb8\x95KU'8\x95LX\"8\x95\x81\x80\x008\x95\x82w\xe88\x95\x83w\x008\x95\x84" +
+	"p?8\x95\x85e38\x95\x86Y\xa08\x95\x87QF8\x95\x88I\xe28\x95\x8a:\xbf8\x95\x8b:P" +
+	"8\x95\x8c9\xd38\x95\xc1A\x858\x95\xc2\\L8\x95\xc3\\\f8\x95\xc4\\\f8\x95\xc5\\\xa88\x95" +
+	"\xc6Y\\8\x95\xc7W58\x95\xc8T(8\x95\xca\x1bH8\x95\xcbJ38\x95\xccL\xb18\x96\x01@" +
+	"\xc28\x96\x02\\\xaf8\x96\x03\\o8\x96\x04\\o8\x96\x05\\D8\x96\x06\\\x1e8\x96\aY\xf78" +
+	"\x96\bV\xea8\x96\nJ18\x96\v\x1c\x058\x96\fM\x8d8\x96A@k8\x96B]H8\x96C" +
+	"]\b8\x96D]\b8\x96E\\\xdd8\x96F[\xf08\x96G\\\xef8\x96HY\xe28\x96JL\xae" +
+	"8\x96KM\x8b8\x96L\x1c\xcb8\x96\xcaA}8\x96\xcb@\xbe8\x96\xcc@h8\x96\xd6\x7f\x1f8\x96" +
+	"\xd7A\x8b8\x96\xd8@\xca8\x96\xd9@s8\x97\x02Q\xc98\x97\x03\x91\xec8\x97\x04\x91\xcb8\x97\x05\x93" +
+	"\xec8\x97\x06\x95{8\x97\a\x99\x878\x97\b\x99<8\x97\n[\xd78\x97\v\\98\x97\f\\\xd08" +
+	"\x97\x0fP\x008\x97\x10\x90D8\x97\x11\x90!8\x97\x12\x92B8\x97\x13\x93\xce8\x97\x14\x97\xd98\x97\x15" +
+	"\x97\x8c8\x97\x16w\x1c8\x97\x17[\xe78\x97\x18\\G8\x97\x19\\\xdd8\x97B\x91\xea8\x97CP?" +
+	"8\x97D\x8eV8\x97E\x90w8\x97F\x92\x068\x97G\x96\x128\x97H\x99\x178\x97J[\x968\x97" +
+	"K[\xf88\x97L\\\x8f8\x97O\x90D8\x97PNt8\x97Q\x8c\xab8\x97R\x8e\xcc8\x97S\x90" +
+	"X8\x97T\x94c8\x97U\x97g8\x97Vv48\x97W[\xa68\x97X\\\x068\x97Y\\\x9c8" +
+	"\x97\x82\x91\xc68\x97\x83\x8eS8\x97\x84O\x038\x97\x85\x8d\xe28\x97\x86\x8e\xab8\x97\x87\x92\x928\x97\x88" +
+	"\x95\x978\x97\x8a[\x938\x97\x8b[\xf58\x97\x8c\\\x8c8\x97\x8f\x90!8\x97\x90\x8c\xab8\x97\x91M6" +
+	"8\x97\x92\x8c78\x97\x93\x8c\xfe8\x97\x94\x90\xe48\x97\x95\x93\xe78\x97\x96o\x8b8\x97\x97[\xa38\x97" +
+	"\x98\\\x038\x97\x99\\\x998\x97\u0093\xe68\x97Ðs8\x97č\xe18\x97\xc5M\x038\x97Ɗ" +
+	"\xca8\x97ǎ\xcc8\x97ȑ\xd28\x97\xca\\+8\x97\xcb[\xc98\x97\xcc\\a8\x97ϒ@8" +
+	"\x97Ў\xcb8\x97ь68\x97\xd2K68\x97Ӊ\x1a8\x97ԍ\x1d8\x97Ր 8\x97\xd6" +
+	"d\xa58\x97\xd7\\;8\x97\xd8[\xd88\x97\xd9\\n8\x98\x02\x95s8\x98\x03\x92\x008\x98\x04\x8e\xa8" +
+	"8\x98\x05\x8a\xc88\x98\x06JX8\x98\a\x8a\xfe8\x98\b\x8e\x038\x98\nX\xea8\x98\v[\xa18\x98" +
+	"\f[u8\x98\x0f\x93\xcd8\x98\x10\x90W8\x98\x11\x8c\xfd8\x98\x12\x89\x1b8\x98\x13H\x898\x98\x14\x89" +
+	"L8\x98\x15\x8cP8\x98\x16Y:8\x98\x17X\xfa8\x98\x18[\xb08\x98\x19[\x828\x98B\x99}8" +
+	"\x98C\x96\n8\x98D\x92\x8d8\x98E\x8e\xc98\x98F\x8a\xfc8\x98GIC8\x98H\x8bZ8\x98J" +
+	"V\xc88\x98KY\x808\x98L\\m8\x98O\x97\xd78\x98P\x94a8\x98Q\x90\xe18\x98R\x8d\x1b" +
+	"8\x98S\x89K8\x98TGt8\x98U\x89\xa58\x98VP\xfb8\x98WV\xd88\x98XY\x8e8\x98" +
+	"Y\\z8\x98\x82\x9918\x98\x83\x99\x0e8\x98\x84\x95\x918\x98\x85\x91\xcc8\x98\x86\x8e\x008\x98\x87\x8b" +
+	"Y8\x98\x88GR8\x98\x8aS\xc58\x98\x8bV}8\x98\x8cYj8\x98\x8f\x97\x8a8\x98\x90\x97e8" +
+	"\x98\x91\x93\xe58\x98\x92\x90\x1f8\x98\x93\x8cN8\x98\x94\x89\xa58\x98\x95E\x818\x98\x96I\xad8\x98\x97" +
+	"S\xd58\x98\x98V\x8b8\x98\x99Yw8\x98\xc2y\x008\x98\xc3x\x1a8\x98\xc4qs8\x98\xc5f\x8f" +
+	"8\x98\xc6[&8\x98\xc7R\xe88\x98\xc8K\x9b8\x98\xca<\x918\x98\xcb<\"8\x98\xcc;\xa58\x98" +
+	"\xcfw?8\x98\xd0vV8\x98\xd1o\xac8\x98\xd2d\xc88\x98\xd3Y\\8\x98\xd4Q\x1d8\x98\xd5I" +
+	"\xce8\x98\xd6\x0f^8\x98\xd7<\xa38\x98\xd8<38\x98\xd9;\xb48\x99\x01C%8\x99\x02]\x8c8" +
+	"\x99\x03]M8\x99\x04]M8\x99\x05]\xe68\x99\x06Z\xa88\x99\aX\x8a8\x99\bU\x8a8\x99\n" +
+	"\x1d@8\x99\vK\xbe8\x99\fN18\x99\x0f[\xe48\x99\x10[\xa28\x99\x11[\xa08\x99\x12\\9" +
+	"8\x99\x13X\xf88\x99\x14V\xd88\x99\x15S\xd48\x99\x16<\x828\x99\x17\x1dQ8\x99\x18K\xcd8\x99" +
+	"\x19N>8\x99ABc8\x99B]\xeb8\x99C]\xac8\x99D]\xac8\x99E]\x828\x99F]" +
+	"\\8\x99G[>8\x99HX>8\x99JK\xbd8\x99K\x1d\xfb8\x99LO\n8\x99O\\C8" +
+	"\x99P\\\x028\x99Q\\\x008\x99R[\xd68\x99S[\xad8\x99TY\x8d8\x99UV\x8a8\x99V" +
+	"<\x128\x99WK\xcd8\x99X\x1e\v8\x99YO\x178\x99\x81B\v8\x99\x82^\x808\x99\x83^B" +
+	"8\x99\x84^A8\x99\x85^\x178\x99\x86].8\x99\x87^(8\x99\x88[(8\x99\x8aN-8\x99" +
+	"\x8bO\b8\x99\x8c\x1e\xbf8\x99\x8f\\\xd98\x99\x90\\\x988\x99\x91\\\x958\x99\x92\\k8\x99\x93[" +
+	"\x7f8\x99\x94\\x8\x99\x95Yu8\x99\x96;\x938\x99\x97N=8\x99\x98O\x168\x99\x99\x1e\xcd8" +
+	"\x9a#\x80\x008\x9a$C+8\x9a%Bk8\x9a&B\x148\x9a\\QW8\x9a]\x90\xfa8\x9a^" +
+	"\x90\xd68\x9a_\x92\xec8\x9a`\x94r8\x9aa\x98n8\x9ab\x98\"8\x9acx48\x9ad]&" +
+	"8\x9ae]\x838\x9af^\x158\x9a\x9dO\xcc8\x9a\x9e\x8dl8\x9a\x9f\x8f\x828\x9a\xa0\x91\t8\x9a" +
+	"\xa1\x95\x048\x9a\xa2\x97\xfd8\x9a\xa3wN8\x9a\xa4\\\xe68\x9a\xa5]C8\x9a\xa6]\xd68\x9a\xdeN" +
+	"\x8d8\x9aߌ\xf58\x9a\xe0\x8d\xb98\x9aᑏ8\x9a┈8\x9a\xe3p\xbe8\x9a\xe4\\\xe48" +
+	"\x9a\xe5]A8\x9a\xe6]\xd38\x9b\x1fL\x908\x9b \x89\xe68\x9b!\x8d\xd98\x9b\"\x90\xd18\x9b#" +
+	"f\x028\x9b$]y8\x9b%]\x168\x9b&]\xa88\x9b`I\xea8\x9ba\x8a\x198\x9bb\x8d\x12" +
+	"8\x9bcZ\xc08\x9bdZF8\x9be\\\xed8\x9bf\\\xc08\x9b\xa1H\xd48\x9b\xa2\x8at8\x9b" +
+	"\xa3R\x9d8\x9b\xa4X.8\x9b\xa5Z\xd68\x9b\xa6]\xb38\x9b\xe2F\xe58\x9b\xe3Kf8\x9b\xe4U" +
+	"88\x9b\xe5W\xe08\x9b\xe6Z\xbd8\x9c#\x11\xa08\x9c$>T8\x9c%=\xe48\x9c&=e8" +
+	"\x9cd\x1fI8\x9ceMX8\x9cfO\xbd8\x9c\xa5 \x018\x9c\xa6P\x938\x9c\xe6 \xc18\xa3\xc2" +
+	"O\xd68\xa3Ñ\xcc8\xa3Đ\xc08\xa3Ŕ\x108\xa3Ɣ\xdc8\xa3ǘ\xd08\xa3Ș\x8b" +
+	"8\xa3ə-8\xa3\xcbZ\x168\xa3\xccZ\xb18\xa4\x02\x91\xca8\xa4\x03N68\xa4\x04\x8d>8\xa4" +
+	"\x05\x90\x8f8\xa4\x06\x91Z8\xa4\a\x95N8\xa4\b\x98f8\xa4\t\x98\x1e8\xa4\vY\xd48\xa4\fZ" +
+	"o8\xa4B\x90\xbd8\xa4C\x8d>8\xa4DL\xbf8\xa4E\x8d\x138\xa4F\x8d\xdf8\xa4G\x91\xd38" +
+	"\xa4H\x94\xea8\xa4I\x97\xfe8\xa4KY\x948\xa4LZ.8\xa4\x82\x94\f8\xa4\x83\x90\x8d8\xa4\x84" +
+	"\x8d\x128\xa4\x85L&8\xa4\x86\x8b>8\xa4\x87\x8e\x878\xa4\x88\x91x8\xa4\x89\x94\x8c8\xa4\x8bZw" +
+	"8\xa4\x8c[\x128\xa4\u0094\xd68\xa4ÑW8\xa4č\xdd8\xa4ŋ=8\xa4\xc6Ie8\xa4" +
+	"Ǌ\xa78\xa4ȍ\x988\xa4ɐ\xac8\xa4\xcbZ28\xa4\xccZ\x068\xa5\x02\x98\xcb8\xa5\x03\x95" +
+	"L8\xa5\x04\x91\xd18\xa5\x05\x8e\x868\xa5\x06\x8a\xa78\xa5\aHF8\xa5\b\x8a\xe28\xa5\t\x8d\xf78" +
+	"\xa5\vX\t8\xa5\f[\x048\xa5B\x98\x848\xa5C\x98a8\xa5D\x94\xe68\xa5E\x91u8\xa5F" +
+	"\x8d\x968\xa5G\x8a\xe18\xa5HFH8\xa5I\x8b\b8\xa5KT\xf48\xa5LW\xef8\xa5\x82\x99$" +
+	"8\xa5\x83\x98\x178\xa5\x84\x97\xf88\xa5\x85\x94\x888\xa5\x86\x90\xa88\xa5\x87\x8d\xf38\xa5\x88\x8b\x068\xa5" +
+	"\x89DC8\xa5\x8bP\xfa8\xa5\x8cS\xf58\xa5\xc1\x80\x008\xa5\xc2w\xe88\xa5\xc3w\x008\xa5\xc4o" +
+	"\xaa8\xa5\xc5g\x0e8\xa5\xc6Z\xd88\xa5\xc7Q\xda8\xa5\xc8JA8\xa5\xc9C\xf88\xa5\xcb9\xdc8" +
+	"\xa5\xcc9_8\xa6\x01?\xc58\xa6\x02[\xce8\xa6\x03[\x8e8\xa6\x04[O8\xa6\x05\\38\xa6\x06" +
+	"[\xef8\xa6\aY\xc88\xa6\bV\xb68\xa6\tR\xc08\xa6\v\x1a\xe18\xa6\fL\xa28\xa6A?m" +
+	"8\xa6B\\g8\xa6C\\'8\xa6D[\xe78\xa6E\\\xcc8\xa6F[\xc18\xa6G\\\xbf8\xa6" +
+	"HY\xae8\xa6IU\xb88\xa6KL\xa08\xa6L\x1b\xa78\xa6\xcb?\xc38\xa6\xcc?m8\xa6\xd7\x7f" +
+	"\x1f8\xa6\xd8?\xd18\xa6\xd9?z8\xa7\x02Q18\xa7\x03\x92\x828\xa7\x04\x91y8\xa7\x05\x94\xbb8" +
+	"\xa7\x06\x95\x838\xa7\a\x99i8\xa7\b\x99&8\xa7\t\x99\xc58\xa7\v[Y8\xa7\f[\xf18\xa7\x0f" +
+	"Oh8\xa7\x10\x90\xda8\xa7\x11\x8f\xd08\xa7\x12\x93\x118\xa7\x13\x93\xd88\xa7\x14\x97\xbe8\xa7\x15\x97x" +
+	"8\xa7\x16\x98\x158\xa7\x17w\x1c8\xa7\x18[i8\xa7\x19[\xff8\xa7B\x92\x808\xa7CO\x918\xa7" +
+	"D\x8e\x038\xa7E\x91F8\xa7F\x92\x0e8\xa7G\x95\xf48\xa7H\x99\x008\xa7I\x98\xba8\xa7K[" +
+	"\x188\xa7L[\xb08\xa7O\x90\xda8\xa7PM\xc68\xa7Q\x8cZ8\xa7R\x8f\x9b8\xa7S\x90b8" +
+	"\xa7T\x94H8\xa7U\x97S8\xa7V\x97\n8\xa7Wv48\xa7X[(8\xa7Y[\xbe8\xa7\x82" +
+	"\x91v8\xa7\x83\x8e\x038\xa7\x84N\x1b8\xa7\x85\x8d\xd68\xa7\x86\x8e\x9e8\xa7\x87\x92\x848\xa7\x88\x95\x91" +
+	"8\xa7\x89\x98\x9a8\xa7\x8bZ\xd98\xa7\x8c[p8\xa7\x8f\x8f\xd08\xa7\x90\x8cZ8\xa7\x91LO8\xa7" +
+	"\x92\x8c+8\xa7\x93\x8c\xf28\xa7\x94\x90\xd88\xa7\x95\x93\xe28\xa7\x96\x96\xea8\xa7\x97n\xf98\xa7\x98Z" +
+	"\xe98\xa7\x99[\x7f8\xa7\u0094\xb58\xa7ÑB8\xa7č\xd38\xa7\xc5M\x808\xa7ƌ\x048" +
+	"\xa7ǏA8\xa7Ȓ(8\xa7ɕ18\xa7\xcb[\xb88\xa7\xcc\\O8\xa7ϓ\x0f8\xa7\xd0" +
+	"\x8f\x9a8\xa7ь*8\xa7\xd2K\xb38\xa7ӊX8\xa7ԍ\x948\xa7Րy8\xa7֓\x81" +
+	"8\xa7\xd7fz8\xa7\xd8[\xc88\xa7\xd9\\]8\xa8\x02\x95|8\xa8\x03\x92\t8\xa8\x04\x8e\x9a8\xa8" +
+	"\x05\x8c\x038\xa8\x06J\xc58\xa8\a\x8br8\xa8\b\x8eY8\xa8\t\x91b8\xa8\v[s8\xa8\f[" +
+	"G8\xa8\x0f\x93\xd68\xa8\x10\x90`8\xa8\x11\x8c\xf08\xa8\x12\x8aX8\xa8\x13H\xf88\xa8\x14\x89\xc48" +
+	"\xa8\x15\x8c\xa98\xa8\x16\x8f\xb18\xa8\x17Zo8\xa8\x18[\x838\xa8\x19[V8\xa8B\x99a8\xa8C" +
+	"\x95\xee8\xa8D\x92\x7f8\xa8E\x8f>8\xa8F\x8bp8\xa8GI\xa58\xa8H\x8b\xae8\xa8I\x8e\xb7" +
+	"8\xa8KYR8\xa8L\\?8\xa8O\x97\xbb8\xa8P\x94E8\xa8Q\x90\xd58\xa8R\x8d\x938\xa8" +
+	"S\x89\xc28\xa8TG\xd88\xa8U\x89\xfd8\xa8V\x8d\x048\xa8WQ\x8e8\xa8XYb8\xa8Y\\" +
+	"M8\xa8\x82\x99\x1c8\xa8\x83\x98\xf98\xa8\x84\x95\x8a8\xa8\x85\x92$8\xa8\x86\x8eV8\xa8\x87\x8b\xad8" +
+	"\xa8\x88G\xab8\xa8\x89\x8b\xd68\xa8\x8bVK8\xa8\x8cY88\xa8\x8f\x97u8\xa8\x90\x97P8\xa8\x91" +
+	"\x93\xe08\xa8\x92\x90y8\xa8\x93\x8c\xa88\xa8\x94\x89\xfd8\xa8\x95E\xdd8\xa8\x96\x8a!8\xa8\x97J\v" +
+	"8\xa8\x98V[8\xa8\x99YF8\xa8\u0099\xba8\xa8Ø\xb18\xa8Ę\x928\xa8ŕ,8\xa8" +
+	"Ƒ^8\xa8ǎ\xb48\xa8ȋ\xd58\xa8\xc9E\xab8\xa8\xcbRb8\xa8\xccUO8\xa8Ϙ" +
+	"\x138\xa8З\a8\xa8і\xe88\xa8ғ\x818\xa8ӏ\xb08\xa8ԍ\x058\xa8Պ\"8" +
+	"\xa8\xd6C\xdb8\xa8\xd7C\xd38\xa8\xd8Rr8\xa8\xd9U^8\xa9\x02y\x008\xa9\x03x\x1a8\xa9\x04" +
+	"p\xe08\xa9\x05he8\xa9\x06\\Z8\xa9\aSz8\xa9\bK\xf98\xa9\tE\xc28\xa9\v;\xb0" +
+	"8\xa9\f;28\xa9\x0fw?8\xa9\x10vV8\xa9\x11o\x1b8\xa9\x12f\x9d8\xa9\x13Z\x928\xa9" +
+	"\x14Q\xb18\xa9\x15J-8\xa9\x16C\xf48\xa9\x17\x0ey8\xa9\x18;\xc28\xa9\x19;C8\xa9AA" +
+	"i8\xa9B]\r8\xa9C\\\xcf8\xa9D\\\x908\xa9E]r8\xa9F].8\xa9G[\x108" +
+	"\xa9HX\f8\xa9IT'8\xa9K\x1c\xdc8\xa9LN\"8\xa9O[f8\xa9P[%8\xa9Q" +
+	"Z\xe58\xa9R[\xc68\xa9S[\x818\xa9TYa8\xa9UVZ8\xa9VRq8\xa9W;\xa1" +
+	"8\xa9X\x1c\xed8\xa9YN18\xa9\x81A\x128\xa9\x82]\xa38\xa9\x83]d8\xa9\x84]%8\xa9" +
+	"\x85^\a8\xa9\x86]\x008\xa9\x87]\xfa8\xa9\x88Z\xf58\xa9\x89W\x108\xa9\x8bN 8\xa9\x8c\x1d" +
+	"\x9f8\xa9\x8f[\xfb8\xa9\x90[\xba8\xa9\x91[{8\xa9\x92\\[8\xa9\x93[S8\xa9\x94\\L8" +
+	"\xa9\x95YE8\xa9\x96U\\8\xa9\x97;\"8\xa9\x98N08\xa9\x99\x1d\xaf8\xaa$\x80\x008\xaa%" +
+	"Au8\xaa&A\x1e8\xaa\\P\xc38\xaa]\x91\x908\xaa^\x90\x898\xaa_\x93\xba8\xaa`\x94~" +
+	"8\xaaa\x98T8\xaab\x98\x108\xaac\x98\xab8\xaadx48\xaae\\\xa98\xaaf];8\xaa" +
+	"\x9dO!8\xaa\x9e\x8d\x1f8\xaa\x9f\x90Q8\xaa\xa0\x91\x148\xaa\xa1\x94\xeb8\xaa\xa2\x97\xeb8\xaa\xa3\x97" +
+	"\xa38\xaa\xa4wN8\xaa\xa5\\i8\xaa\xa6\\\xfb8\xaa\xdeM\xab8\xaaߌ\xec8\xaa\xe0\x8d\xb08" +
+	"\xaaᑆ8\xaa┆8\xaa㗃8\xaa\xe4p/8\xaa\xe5\\+8\xaa\xe6\\\xbd8\xab\x1f" +
+	"M\f8\xab \x8b\x1e8\xab!\x8eM8\xab\"\x91(8\xab#\x94%8\xab$g\xd18\xab%]\x06" +
+	"8\xab&]\x988\xab`JX8\xaba\x8a\x8d8\xabb\x8di8\xabc\x90f8\xabd[\xf28\xab" +
+	"e\\\xc28\xabf\\\x948\xab\xa1I78\xab\xa2\x8a\xc98\xab\xa3\x8d\xc68\xab\xa4S.8\xab\xa5Z" +
+	"\xaa8\xab\xa6]\x888\xab\xe2G@8\xab\xe3\x8a\xf18\xab\xe4K\xc38\xab\xe5W\xb08\xab\xe6Z\x8d8" +
+	"\xac#EC8\xac$E\x9d8\xac%S\xd98\xac&V\xb68\xacd\x10\xbd8\xace=u8\xacf" +
+	"<\xf68\xac\xa5\x1e\xe88\xac\xa6O\xb18\xac\xe6\x1f\xa78\xb3\xc2O98\xb3Ò\x168\xb3đ\t" +
+	"8\xb3œo8\xb3ƕ_8\xb3ǘ\x8a8\xb3Ș\x1f8\xb3ɘ\xc88\xb3ʙ\xaa8\xb3" +
+	"\xccY\xca8\xb4\x02\x92\x138\xb4\x03M\x828\xb4\x04\x8d\x888\xb4\x05\x8f\xed8\xb4\x06\x91\xde8\xb4\a\x95" +
+	"\t8\xb4\b\x97\xfa8\xb4\t\x97\xb98\xb4\n\x98\x9c8\xb4\fY\x888\xb4B\x91\x068\xb4C\x8d\x878" +
+	"\xb4DK\xf88\xb4E\x8cr8\xb4F\x8eb8\xb4G\x91\x8d8\xb4H\x94~8\xb4I\x97\x9a8\xb4J" +
+	"\x97\x938\xb4LYH8\xb4\x82\x93l8\xb4\x83\x8f\xed8\xb4\x84\x8cr8\xb4\x85K'8\xb4\x86\x8a\xe3" +
+	"8\xb4\x87\x8e*8\xb4\x88\x91\x1b8\xb4\x89\x9478\xb4\x8a\x97\x8c8\xb4\x8cY\xed8\xb4\u0095Z8\xb4" +
+	"Ñ\xdb8\xb4Ďa8\xb4Ŋ\xe18\xb4\xc6I\xd28\xb4ǋ\x9b8\xb4ȍ\xc48\xb4ɐ" +
+	"\xba8\xb4ʔ\x0f8\xb4\xccY\xf08\xb5\x02\x98\x868\xb5\x03\x95\a8\xb5\x04\x91\x8c8\xb5\x05\x8e)8" +
+	"\xb5\x06\x8b\x9c8\xb5\aH\xa78\xb5\b\x8b\x0e8\xb5\t\x8e\x048\xb5\n\x91Y8\xb5\fZ\xd18\xb5B" +
+	"\x98\x1a8\xb5C\x97\xf78\xb5D\x94|8\xb5E\x91\x198\xb5F\x8d\xc38\xb5G\x8b\f8\xb5HF\xa0" +
+	"8\xb5I\x8b\x138\xb5J\x8eh8\xb5LW\xbc8\xb5\x82\x98\xc18\xb5\x83\x97\xb48\xb5\x84\x97\x968\xb5" +
+	"\x85\x9428\xb5\x86\x90\xb78\xb5\x87\x8e\x008\xb5\x88\x8b\x118\xb5\x89D\x958\xb5\x8a\x8a~8\xb5\x8cS" +
+	"\xbe8\xb5\u0099\xa28\xb5Ø\x958\xb5ė\x8d8\xb5ŗ\x868\xb5Ɣ\v8\xb5ǑT8" +
+	"\xb5Ȏd8\xb5Ɋ|8\xb5\xcaD]8\xb5\xccS\xfc8\xb6\x01\x80\x008\xb6\x02w\xe88\xb6\x03" +
+	"w\x008\xb6\x04o\xaa8\xb6\x05fy8\xb6\x06\\\x8e8\xb6\aR\xd48\xb6\bJ\xc68\xb6\tDW" +
+	"8\xb6\nA08\xb6\f8\xeb8\xb6A>l8\xb6B[\x828\xb6C[B8\xb6D[\x028\xb6" +
+	"E[\xa78\xb6F[\xac8\xb6G\\\x8c8\xb6HY{8\xb6IU\x818\xb6JU\xc08\xb6L\x1a" +
+	"\x838\xb6\xcc>n8\xb6\xd8\x7f\x1f8\xb6\xd9>|8\xb7\x02P\x978\xb7\x03\x92\xcc8\xb7\x04\x91\xc38" +
+	"\xb7\x05\x94\x1e8\xb7\x06\x96\a8\xb7\a\x99&8\xb7\b\x98\xbd8\xb7\t\x99c8\xb7\n\x9aC8\xb7\f" +
+	"[\r8\xb7\x0fN\xce8\xb7\x10\x91$8\xb7\x11\x90\x1a8\xb7\x12\x92u8\xb7\x13\x94\\8\xb7\x14\x97|" +
+	"8\xb7\x15\x97\x118\xb7\x16\x97\xb68\xb7\x17\x98\x938\xb7\x18w\x1c8\xb7\x19[\x1d8\xb7B\x92\xca8\xb7" +
+	"CN\xe18\xb7D\x8eN8\xb7E\x90\xa88\xb7F\x92\x918\xb7G\x95\xb08\xb7H\x98\x988\xb7I\x98" +
+	"X8\xb7J\x9978\xb7LZ\xcc8\xb7O\x91$8\xb7PM\x168\xb7Q\x8c\xa58\xb7R\x8e\xff8" +
+	"\xb7S\x90\xe68\xb7T\x94\a8\xb7U\x96\xec8\xb7V\x96\xaa8\xb7W\x97\x888\xb7Xv48\xb7Y" +
+	"Z\xdc8\xb7\x82\x91\xc08\xb7\x83\x8eM8\xb7\x84MX8\xb7\x85\x8d98\xb7\x86\x8f!8\xb7\x87\x92A" +
+	"8\xb7\x88\x95(8\xb7\x89\x9888\xb7\x8a\x9818\xb7\x8cZ\x8d8\xb7\x8f\x90\x1a8\xb7\x90\x8c\xa58\xb7" +
+	"\x91K\x8c8\xb7\x92\x8b\x8f8\xb7\x93\x8dv8\xb7\x94\x90\x978\xb7\x95\x93|8\xb7\x96\x96\x8b8\xb7\x97\x96" +
+	"\x828\xb7\x98n\xf98\xb7\x99Z\x9d8\xb7\u0094\x198\xb7Ð\xa68\xb7č78\xb7\xc5L\x858" +
+	"\xb7Ƌ\xac8\xb7ǎ\xe88\xb7ȑ\xcf8\xb7ɔ\xdf8\xb7ʘ)8\xb7\xcc[/8\xb7\xcf" +
+	"\x92s8\xb7Ў\xfe8\xb7ы\x8e8\xb7\xd2J\xba8\xb7ӊ\x018\xb7ԍ=8\xb7Ր\"" +
+	"8\xb7֓18\xb7זy8\xb7\xd8e\xe98\xb7\xd9[?8\xb8\x02\x96\x008\xb8\x03\x92\x8d8\xb8" +
+	"\x04\x8f\x1e8\xb8\x05\x8b\xaa8\xb8\x06K28\xb8\a\x8c`8\xb8\b\x8e\x828\xb8\t\x91m8\xb8\n\x94" +
+	"\xb68\xb8\f[18\xb8\x0f\x94Z8\xb8\x10\x90\xe48\xb8\x11\x8dt8\xb8\x12\x8a\x018\xb8\x13Id8" +
+	"\xb8\x14\x8a\xb68\xb8\x15\x8c\xd68\xb8\x16\x8f\xbf8\xb8\x17\x93\a8\xb8\x18\\\x1f8\xb8\x19[A8\xb8B" +
+	"\x99\x1f8\xb8C\x95\xac8\xb8D\x92=8\xb8E\x8e\xe58\xb8F\x8c`8\xb8GJ\x058\xb8H\x8b\xd7" +
+	"8\xb8I\x8e\xc28\xb8J\x92\f8\xb8L\\\r8\xb8O\x97y8\xb8P\x94\x038\xb8Q\x90\x938\xb8" +
+	"R\x8d<8\xb8S\x8a\xb48\xb8TH98\xb8U\x8a)8\xb8V\x8d\x138\xb8W\x90[8\xb8XR" +
+	"\x868\xb8Y\\\x1d8\xb8\x82\x98\xb58\xb8\x83\x98\x928\xb8\x84\x95#8\xb8\x85\x91\xcb8\xb8\x86\x8e\x808" +
+	"\xb8\x87\x8b\xd68\xb8\x88H\x038\xb8\x89\x8b\xdf8\xb8\x8a\x8f(8\xb8\x8cY\x068\xb8\x8f\x97\x0e8\xb8\x90" +
+	"\x96\xe98\xb8\x91\x93y8\xb8\x92\x90!8\xb8\x93\x8c\xd48\xb8\x94\x8a)8\xb8\x95F68\xb8\x96\x8a." +
+	"8\xb8\x97\x8dv8\xb8\x98J\x908\xb8\x99Y\x168\xb8\u0099Z8\xb8ØQ8\xb8Ę28\xb8" +
+	"Ŕ\xda8\xb8Ƒj8\xb8ǎ\xbf8\xb8ȋ\xde8\xb8\xc9E\xfd8\xb8ʋQ8\xb8\xccU" +
+	"\x198\xb8ϗ\xb38\xb8Ж\xa78\xb8і\x888\xb8ғ08\xb8ӏ\xbe8\xb8ԍ\x138" +
+	"\xb8Պ.8\xb8\xd6D.8\xb8\u05c9\x9c8\xb8\xd8D28\xb8\xd9U)8\xb9\x02\x9a78\xb9\x03" +
+	"\x99.8\xb9\x04\x98)8\xb9\x05\x98\"8\xb9\x06\x94\xb28\xb9\a\x92\a8\xb9\b\x8f%8\xb9\t\x8bP" +
+	"8\xb9\nE\xc48\xb9\fUU8\xb9\x0f\x98\x918\xb9\x10\x97\x858\xb9\x11\x96\x7f8\xb9\x12\x96x8\xb9" +
+	"\x13\x93\x068\xb9\x14\x90[8\xb9\x15\x8dv8\xb9\x16\x89\x9c8\xb9\x17C\xf48\xb9\x18A\x118\xb9\x19U" +
+	"f8\xb9By\x008\xb9Cx\x1a8\xb9Dp\xe08\xb9Eg\xd18\xb9F^\n8\xb9GTr8" +
+	"\xb9HL}8\xb9IF\x1f8\xb9JC\x008\xb9L:\xc08\xb9Ow?8\xb9PvV8\xb9Q" +
+	"o\x1b8\xb9Rf\f8\xb9S\\B8\xb9TR\xaa8\xb9UJ\xb38\xb9VDS8\xb9WA2" +
+	"8\xb9X\r\xae8\xb9Y:\xd28\xb9\x81@\x148\xb9\x82\\\xc18\xb9\x83\\\x828\xb9\x84\\D8\xb9" +
+	"\x85\\\xe78\xb9\x86\\\xec8\xb9\x87]\xc78\xb9\x88Z\xc38\xb9\x89V\xda8\xb9\x8aW\x188\xb9\x8c\x1c" +
+	"\x808\xb9\x8f[\x198\xb9\x90Z\xd88\xb9\x91Z\x998\xb9\x92[=8\xb9\x93[?8\xb9\x94\\\x1c8" +
+	"\xb9\x95Y\x158\xb9\x96U(8\xb9\x97Ud8\xb9\x98:\xb18\xb9\x99\x1c\x918\xba%\x80\x008\xba&" +
+	"@%8\xba\\P-8\xba]\x91\xdb8\xba^\x90\xd48\xba_\x93#8\xba`\x95\x018\xbaa\x98\x15" +
+	"8\xbab\x97\xac8\xbac\x98N8\xbad\x99)8\xbaex48\xbaf\\]8\xba\x9dNu8\xba" +
+	"\x9e\x8dj8\xba\x9f\x8f\xb98\xba\xa0\x91\x978\xba\xa1\x94\xab8\xba\xa2\x97\x868\xba\xa3\x97F8\xba\xa4\x98" +
+	"!8\xba\xa5wN8\xba\xa6\\\x1d8\xba\xdeL\xeb8\xbaߌU8\xba\xe0\x8e38\xba\xe1\x91G8" +
+	"\xba\xe2\x94\"8\xba\xe3\x97'8\xba\xe4\x97\x1e8\xba\xe5p/8\xba\xe6[\xde8\xbb\x1fL\x178\xbb " +
+	"\x8a\xca8\xbb!\x8d\xf98\xbb\"\x90\xd58\xbb#\x93\xd98\xbb$\x97\x158\xbb%gA8\xbb&\\~" +
+	"8\xbb`J\xc48\xbba\x8bz8\xbbb\x8d\x928\xbbc\x90r8\xbbd\x93\xae8\xbbe]\x9b8\xbb" +
+	"f\\\x818\xbb\xa1I\x988\xbb\xa2\x8a\xf28\xbb\xa3\x8d\xd28\xbb\xa4\x91\x0e8\xbb\xa5T#8\xbb\xa6]" +
+	"X8\xbb\xe2G\x988\xbb\xe3\x8a\xfb8\xbb\xe4\x8e78\xbb\xe5LF8\xbb\xe6Z^8\xbc#E\x968" +
+	"\xbc$\x8ap8\xbc%E\xfb8\xbc&V\x828\xbcdE[8\xbceB\xe18\xbcfV\xbe8\xbc\xa5" +
+	"\x0f\xf48\xbc\xa6<\x878\xbc\xe6\x1e\x8e8\xc3\xc2N\x998\xc3Ò08\xc3đ$8\xc3œ\x89" +
+	"8\xc3Ɣ\x8f8\xc3ǘ\xdf8\xc3ȗ\xaa8\xc3ɘ-8\xc3ʙ\x108\xc3˙\xf78\xc4" +
+	"\x02\x92.8\xc4\x03L\xcc8\xc4\x04\x8d\xa38\xc4\x05\x90\b8\xc4\x06\x91\x0e8\xc4\a\x95^8\xc4\b\x97" +
+	"\x858\xc4\t\x97\x1f8\xc4\n\x98\x018\xc4\v\x98\xe88\xc4B\x91!8\xc4C\x8d\xa28\xc4DK/8" +
+	"\xc4E\x8c\x8c8\xc4F\x8d\x928\xc4G\x91\xe28\xc4H\x94\t8\xc4I\x96\xff8\xc4J\x96\xf88\xc4K" +
+	"\x97\xdf8Ă\x93\x868ă\x90\a8Ą\x8c\x8d8ąJM8Ć\x8a\x138ć\x8e\x7f" +
+	"8Ĉ\x90\xa78ĉ\x93\x9c8Ċ\x96\xf18ċ\x96\xef8\xc4\u0094\x8c8\xc4Ñ\r8\xc4" +
+	"č\x928\xc4Ŋ\x128\xc4\xc6H\xc38\xc4ǋ\x118\xc4ȍ98\xc4ɐ.8\xc4ʓ" +
+	"\x838\xc4˖\xdd8\xc5\x02\x98\xdb8\xc5\x03\x95]8\xc5\x04\x91\xe28\xc5\x05\x8e~8\xc5\x06\x8b\x118" +
+	"\xc5\aI\a8\xc5\b\x8b\xd38\xc5\t\x8d\xdb8\xc5\n\x9108\xc5\v\x94\x898\xc5B\x97\xa78\xc5C" +
+	"\x97\x848\xc5D\x94\t8\xc5E\x90\xa68\xc5F\x8d88\xc5G\x8b\xd38\xc5HF\xf68\xc5I\x8a\xea" +
+	"8\xc5J\x8e?8\xc5K\x91\x988ł\x98(8Ń\x97\x1c8ń\x96\xfd8Ņ\x93\x998\xc5" +
+	"\x86\x90,8Ň\x8d\xd98ň\x8a\xe88ŉD\xe68Ŋ\x8aS8ŋ\x8d\xac8\xc5\u0099" +
+	"\t8\xc5×\xfc8\xc5Ė\xf48\xc5Ŗ\xed8\xc5Ɠ\x808\xc5Ǒ,8\xc5Ȏ<8" +
+	"\xc5ɊQ8\xc5\xcaD\xab8\xc5ˎ\x928\xc6\x02\x99\xee8\xc6\x03\x98\xe28\xc6\x04\x97\xd98\xc6\x05" +
+	"\x96\xe98\xc6\x06\x96\xd78\xc6\a\x94\x848\xc6\b\x91\x938\xc6\t\x8d\xa98\xc6\n\x8e\x908\xc6\vD\x9d" +
+	"8\xc6A\x80\x008\xc6Bw\xe88\xc6Cw\x008\xc6Do\xaa8\xc6Efy8\xc6F[\xf98\xc6" +
+	"GTQ8\xc6HK\x958\xc6ID\xcd8\xc6JA\x8f8\xc6K?\x178\xc6\xd9\x7f\x1f8\xc7\x02O" +
+	"\xfb8\xc7\x03\x92\xe88\xc7\x04\x91\xdf8\xc7\x05\x94:8\xc7\x06\x95;8\xc7\a\x99{8\xc7\b\x98K8" +
+	"\xc7\t\x98\xcc8\xc7\n\x99\xab8\xc7\v\x9a\x8f8\xc7\x0fN28\xc7\x10\x91@8\xc7\x11\x9068\xc7\x12" +
+	"\x92\x918\xc7\x13\x93\x918\xc7\x14\x97\xd28\xc7\x15\x96\xa18\xc7\x16\x97 8\xc7\x17\x97\xfe8\xc7\x18\x98\xe0" +
+	"8\xc7\x19w\x1c8\xc7B\x92\xe68\xc7CN/8\xc7D\x8ej8\xc7E\x90\xc48\xc7F\x91\xc58\xc7" +
+	"G\x96\x068\xc7H\x98&8\xc7I\x97\xc18\xc7J\x98\xa08\xc7K\x99\x838\xc7O\x91@8\xc7PL" +
+	"d8\xc7Q\x8c\xc08\xc7R\x8f\x1b8\xc7S\x90\x1c8\xc7T\x94\\8\xc7U\x96|8\xc7V\x96\x158" +
+	"\xc7W\x96\xf28\xc7X\x97\xd48\xc7Yv48ǂ\x91\xdc8ǃ\x8ei8ǄL\x928ǅ" +
+	"\x8dT8ǆ\x8eV8Ǉ\x92\x968ǈ\x94\xb68ǉ\x97\xa18Ǌ\x97\x9a8ǋ\x98~" +
+	"8Ǐ\x9068ǐ\x8c\xc08ǑJ\xc68ǒ\x8b\xab8Ǔ\x8c\xac8ǔ\x90\xec8\xc7" +
+	"\x95\x93\f8ǖ\x95\xf58Ǘ\x95\xed8ǘ\x96\xcf8Ǚn\xf98\xc7\u009458\xc7Ð" +
+	"\xc28\xc7čS8\xc7\xc5K\xaf8\xc7Ɗ\xe08\xc7Ǐ=8\xc7ȑ]8\xc7ɔH8" +
+	"\xc7ʗ\x918\xc7˗\x8f8\xc7ϒ\x8f8\xc7Џ\x1a8\xc7ы\xaa8\xc7\xd2I\xe48\xc7\xd3" +
+	"\x8968\xc7ԍ\x938\xc7Տ\xb28\xc7֒\x9c8\xc7ו\xe48\xc7ؕ\xe08\xc7\xd9e\xe9" +
+	"8\xc8\x02\x9568\xc8\x03\x91\xc28\xc8\x04\x8eT8\xc8\x05\x8a\xe08\xc8\x06J'8\xc8\a\x8b\xdb8\xc8" +
+	"\b\x8d\xfb8\xc8\t\x90\xe68\xc8\n\x94/8\xc8\v\x97|8\xc8\x0f\x93\x908\xc8\x10\x90\x1a8\xc8\x11\x8c" +
+	"\xaa8\xc8\x12\x8968\xc8\x13H[8\xc8\x14\x8a08\xc8\x15\x8cP8\xc8\x16\x8f98\xc8\x17\x92\x818" +
+	"\xc8\x18\x95\xcd8\xc8\x19[\x8d8\xc8B\x99t8\xc8C\x96\x018\xc8D\x92\x928\xc8E\x8f:8\xc8F" +
+	"\x8b\xd98\xc8GJe8\xc8H\x8c\x978\xc8I\x8e\x988\xc8J\x91\xe18\xc8K\x95.8\xc8O\x97\xce" +
+	"8\xc8P\x94X8\xc8Q\x90\xe88\xc8R\x8d\x918\xc8S\x8a.8\xc8TH\x998\xc8U\x8a\xec8\xc8" +
+	"V\x8c\xeb8\xc8W\x9038\xc8X\x93\x7f8\xc8YS\xfe8Ȃ\x98D8ȃ\x98!8Ȅ\x94" +
+	"\xb28ȅ\x91Z8Ȇ\x8d\xf98ȇ\x8c\x978ȈHY8ȉ\x8b\xb48Ȋ\x8e\xfe8" +
+	"ȋ\x92K8ȏ\x96\x9e8Ȑ\x96y8ȑ\x93\t8Ȓ\x8f\xb18ȓ\x8cO8Ȕ" +
+	"\x8a\xed8ȕF\x8d8Ȗ\x8a\x068ȗ\x8dN8Ș\x90\x9a8șK^8\xc8\u0098\xc4" +
+	"8\xc8×\xbb8\xc8ė\x9c8\xc8ŔD8\xc8Ɛ\xe38\xc8ǎ\x968\xc8ȋ\xb38\xc8" +
+	"\xc9FM8\xc8ʋ$8\xc8ˎr8\xc8ϗ\x1d8\xc8Ж\x128\xc8ѕ\xf28\xc8Ғ" +
+	"\x9b8\xc8ӏ88\xc8Ԍ\xec8\xc8Պ\a8\xc8\xd6D\x808\xc8\u05c9s8\xc8،\xbf8" +
+	"\xc8\xd9D\xa88\xc9\x02\x99\xa18\xc9\x03\x98\x988\xc9\x04\x97\x938\xc9\x05\x97\x8c8\xc9\x06\x94*8\xc9\a" +
+	"\x91\xde8\xc9\b\x8e\xfb8\xc9\t\x8b#8\xc9\nF\x118\xc9\v\x8fT8\xc9\x0f\x97\xfb8\xc9\x10\x96\xf0" +
+	"8\xc9\x11\x95\xea8\xc9\x12\x95\xe38\xc9\x13\x92\x808\xc9\x14\x9048\xc9\x15\x8dO8\xc9\x16\x89s8\xc9" +
+	"\x17DB8\xc9\x18\x8d\xa18\xc9\x19Ap8\xc9B\x9a\x838\xc9C\x99z8\xc9D\x98u8\xc9E\x97" +
+	"\x878\xc9F\x97v8\xc9G\x95*8\xc9H\x92F8\xc9I\x8en8\xc9J\x8fR8\xc9KF\x038" +
+	"\xc9O\x98\xdd8\xc9P\x97\xd18\xc9Q\x96\xcc8\xc9R\x95\xde8\xc9S\x95\xcc8\xc9T\x93\x808\xc9U" +
+	"\x90\x9b8\xc9V\x8c\xbf8\xc9W\x8d\xa18\xc9XD38\xc9Y>\xfc8ɂy\x008Ƀx\x1a" +
+	"8Ʉp\xe08Ʌg\xd18Ɇ]w8ɇU\xea8ɈMI8ɉF\x958\xc9" +
+	"\x8aC^8ɋ@\xec8ɏw?8ɐvV8ɑo\x1b8ɒf\f8ɓ[" +
+	"\xb08ɔT\"8ɕK\x818ɖD\xca8ɗA\x918ɘ?\x1d8ə\f\xff8" +
+	"\xca&\x80\x008\xca\\O\x958\xca]\x91\xf88\xca^\x90\xf18\xca_\x93@8\xca`\x94;8\xcaa" +
+	"\x98j8\xcab\x97>8\xcac\x97\xbc8\xcad\x98\x968\xcae\x99u8\xcafx48ʝM\xc7" +
+	"8ʞ\x8d\x878ʟ\x8f\xd68ʠ\x90\xd18ʡ\x95\x008ʢ\x97\x198ʣ\x96\xb48\xca" +
+	"\xa4\x97\x8e8ʥ\x98m8ʦwN8\xca\xdeL*8\xcaߌr8\xca\xe0\x8dm8\xca\xe1\x91" +
+	"\x9c8\xca⓵8\xca㖔8\xca䖌8\xca\xe5\x97j8\xca\xe6p/8\xcb\x1fKE8" +
+	"\xcb \x8a\x048\xcb!\x8eO8\xcb\"\x90g8\xcb#\x93G8\xcb$\x96\x838\xcb%\x96~8\xcb&" +
+	"gA8\xcb`I\xbf8\xcba\x8a\xf88\xcbb\x8d\x118\xcbc\x8f\xf08\xcbd\x93,8\xcbe\x96l" +
+	"8\xcbf]\f8ˡI\xf78ˢ\x8b\xb18ˣ\x8d\xa98ˤ\x90\xe58˥\x94%8\xcb" +
+	"\xa6U\x968\xcb\xe2G\xef8\xcb\xe3\x8a\xd18\xcb\xe4\x8e\r8\xcb\xe5\x91M8\xcb\xe6M\x128\xcc#E" +
+	"\xe78\xcc$\x8aD8\xcc%\x8d\x848\xcc&Fp8\xccdE\xa98\xcce\x8ec8\xccfC?8" +
+	"̥E\x998̦@\xd18\xcc\xe6\x0fG<$\x03.\xdb<$\x04\x84\xc7<$\x05\x861<$\x06" +
+	"\x86\xf1<$\a\x86\xfa<$\b\x8a4<$\t\x88\xce<$\n\x89c<$\v\x89\xfa<$\f\x8a\x94" +
+	"<$D-\x8a<$E\x83\t<$F\x83\xcb<$G\x83\xd7<$H\x87\x13<$I\x88\xcb<$" +
+	"J\x88w<$K\x89\x0e<$L\x89\xa8<$\x85-\xc0<$\x86\x80c<$\x87\x80r<$\x88\x83" +
+	"\xb0<$\x89\x85k<$\x8a\x884<$\x8b\x87\xe2<$\x8c\x88{<$\xc6-\xee<$\xc7}\x00<" +
+	"$Ȁ^<$ɂ\x1b<$ʄ\xe8<$ˇ\xb3<$̇c<%\a-U<%\b" +
+	"|\xeb<%\t~\xab<%\n\x81z<%\v\x84H<%\f\x87\x16<%H.\x14<%I|\xb3" +
+	"<%J\x7f\x84<%K\x82U<%L\x85%<%\x89-\xdd<%\x8a|=<%\x8b\x7f\x11<%" +
+	"\x8c\x81\xe4<%\xca-\xdb<%\xcb\x7f\xfa<%̂\xcd<&\v-\xea<&\f\x83\xb6<&L." +
+	"\x02<'\x03\xa6C<'\x04\xa4\xca<'\x05\xa7\xd4<'\x06\xaa\\<'\a\xac\xd8<'\b\xab\xfb<" +
+	"'\t\xabi<'\n\xac\x01<'\v\xac\x9b<'\f\xad7<'C0\x9d<'D\x85\xf1<'E" +
+	"\x87P<'F\x88\a<'G\x88\f<'H\x8b9<'I\x89\xd7<'J\x8ai<'K\x8a\xfd" +
+	"<'L\x8b\x93<'\x83\x85\xf1<'\x84/M<'\x85\x842<'\x86\x84\xed<'\x87\x84\xf4<'" +
+	"\x88\x88$<'\x89\x89\xd4<'\x8a\x89\x80<'\x8b\x8a\x14<'\x8c\x8a\xaa<'ÇN<'Ą" +
+	"1<'\xc5/}<'Ɓ\x8e<'ǁ\x98<'Ȅ\xcb<'Ɇ~<'ʉ<<" +
+	"'ˈ\xea<'̉\x80<(\x03\x88\x05<(\x04\x84\xea<(\x05\x81\x8d<(\x06/\xa7<(\a" +
+	"~0<(\b\x81\x82<(\t\x838<(\n\x85\xf9<(\v\x88\xb9<(\f\x88i<(C\x88\t" +
+	"<(D\x84\xf1<(E\x81\x97<(F~1<(G/\r<(H~\x1b<(I\x7f\xd4<(" +
+	"J\x82\x98<(K\x85[<(L\x88\x1d<(\x83\x8b5<(\x84\x88 <(\x85\x84\xc8<(\x86\x81" +
+	"\x81<(\x87~\x19<(\x88/\xc7<(\x89}\xe0<(\x8a\x80\xa7<(\x8b\x83m<(\x8c\x862<" +
+	"(É\xd3<(ĉ\xd0<(ņ{<(ƃ7<(\xc7\x7f\xd2<(\xc8}\xe0<(\xc9" +
+	"/\x90<(\xca}k<(ˀ4<(̂\xfc<)\x03\x8ae<)\x04\x89|<)\x05\x899" +
+	"<)\x06\x85\xf8<)\a\x82\x96<)\b\x80\xa7<)\t}k<)\n/\x8e<)\v\x81\x1a<)" +
+	"\f\x83\xe2<)C\x8a\xf9<)D\x8a\x10<)E\x88\xe7<)F\x88\xb8<)G\x85Y<)H\x83" +
+	"m<)I\x804<)J\x81\x1a<)K/\x9c<)L\x84\xc7<)\x83\x8b\x8f<)\x84\x8a\xa6<" +
+	")\x85\x89}<)\x86\x88h<)\x87\x88\x1b<)\x88\x862<)\x89\x82\xfc<)\x8a\x83\xe2<)\x8b" +
+	"\x84\xc7<)\x8c/\xb3<*\\\x80\x00<*]\xa6\xd4<*^\xa5`<*_\xa8[<*`\xaa\xd8" +
+	"<*a\xadJ<*b\xacl<*c\xab\xdb<*d\xaco<*e\xad\x05<*f\xad\x9d<*" +
+	"\x9d2_<*\x9e\x87\x1c<*\x9f\x88m<*\xa0\x89\x1b<*\xa1\x89\x1b<*\xa2\x8c:<*\xa3\x8a" +
+	"\xdc<*\xa4\x8bj<*\xa5\x8b\xfb<*\xa6\x8c\x8e<*\xde1\x0f<*߅[<*\xe0\x86\v<" +
+	"*\xe1\x86\x0e<*\xe2\x890<*\xe3\x8a\xd9<*䊅<*\xe5\x8b\x16<*拨<+\x1f" +
+	"1:<+ \x82\xb8<+!\x82\xbd<+\"\x85\xe2<+#\x87\x8e<+$\x8aA<+%\x89\xef" +
+	"<+&\x8a\x82<+`1_<+a\x7fb<+b\x82\xa5<+c\x84T<+d\x87\t<+" +
+	"e\x89\xbe<+f\x89n<+\xa10\xc6<+\xa2\x7fI<+\xa3\x80\xfb<+\xa4\x83\xb3<+\xa5\x86" +
+	"k<+\xa6\x89\"<+\xe21z<+\xe3\x7f\x0e<+\xe4\x81\xc9<+億<+\xe6\x87><" +
+	",#1C<,$~\x9a<,%\x81W<,&\x84\x14<,d1A<,e\x82:<,f" +
+	"\x84\xf6<,\xa51O<,\xa6\x85\xd9<,\xe61e<4\x02\x80\x00<4\x04bP<4\x05[\x9b" +
+	"<4\x06U\xdc<4\aO\b<4\bO\a<4\tK\xd3<4\nIp<4\vG\xbb<4" +
+	"\fFa<4Bg3<4D\x1e\xc2<4E^\x9d<4F\\(<4GZ^<4H[" +
+	"\x06<4IZ\xe6<4JZ\x13<4KY\xc9<4LY\xaf<4\x82_|<4\x84^\x9d<" +
+	"4\x85\"\x91<4\x86Z\xe0<4\x87Y\x9d<4\x88Z^<4\x89Zg<4\x8aZ\xe2<4\x8b" +
+	"Z[<4\x8cZ@<4\xc2Z^<4\xc4\\(<4\xc5Z\xe1<4\xc6%\x89<4\xc7W\x90" +
+	"<4\xc8X\xb9<4\xc9Y\x9c<4\xcaZ\x1a<4\xcbZ\xe1<4\xccZ\x88<5\x02V\xcc<5" +
+	"\x04Z_<5\x05Y\x9d<5\x06W\x90<5\a'\x13<5\bT@<5\tW\xe7<5\nX" +
+	"p<5\vY:<5\fZ/<5BR:<5D[\x06<5EZ^<5FX\xb9<" +
+	"5GTB<5H'\x9a<5IWT<5JW\xff<5KX\xcc<5LY\xc5<5\x82" +
+	"O\xae<5\x84Z\xe5<5\x85Zf<5\x86Y\x9b<5\x87W\xe7<5\x88WR<5\x89(\x88" +
+	"<5\x8aWH<5\x8bX?<5\x8cY<<5\xc2Ma<5\xc4Z\x12<5\xc5Z\xe1<5" +
+	"\xc6Z\x19<5\xc7Xp<5\xc8W\xfd<5\xc9WH<5\xca(s<5\xcbX}<5\xccY" +
+	"y<6\x02K\xc2<6\x04Y\xc8<6\x05ZY<6\x06Z\xdf<6\aY:<6\bX\xcb<" +
+	"6\tX?<6\nX}<6\v(p<6\fY\xb7<6BJ~<6DY\xad<6E" +
+	"Z?<6FZ\x87<6GZ/<6HY\xc3<6IY<<6JYy<6KY\xb7" +
+	"<6L(t<7\x04f\xad<7\x05_\x0e<7\x06Z\x00<7\aV}<7\bQ\xf9<7" +
+	"\tOw<7\nM2<7\vK\x98<7\fJW<7\x10\x7f\x16<7\x11f\xac<7\x12_" +
+	"\x0e<7\x13Z\x01<7\x14V~<7\x15Q\xfa<7\x16Ox<7\x17M2<7\x18K\x98<" +
+	"7\x19JW<7Dc\xa4<7E]\x04<7FWY<7GP\x9d<7HP\x9b<7I" +
+	"Mr<7JK\x17<7KIg<7LH\x10<7P\x1f)<7Qc\xad<7R]\f" +
+	"<7SW`<7TP\xa2<7UP\xa1<7VMw<7WK\x1c<7XIk<7" +
+	"YH\x14<7\x82hd<7\x84 \xb8<7\x85_\xfb<7\x86]\x88<7\x87[\xbf<7\x88\\" +
+	"^<7\x89\\;<7\x8a[h<7\x8b[\x1e<7\x8c[\x02<7\x90c\x91<7\x91 \xbc<" +
+	"7\x92_\xff<7\x93]\x8c<7\x94[\xc2<7\x95\\b<7\x96\\=<7\x97[k<7\x98" +
+	"[ <7\x99[\x05<7\xc2`\xbf<7\xc4_\xf7<7\xc5$y<7\xc6\\F<7\xc7[\x02" +
+	"<7\xc8[\xbb<7\xc9[\xbf<7\xca\\5<7\xcb[\xae<7\xcc[\x92<7\xd0\\\xeb<7" +
+	"\xd1_\xfb<7\xd2$}<7\xd3\\J<7\xd4[\x04<7\xd5[\xbf<7\xd6[\xc1<7\xd7\\" +
+	"8<7\xd8[\xb0<7\xd9[\x95<8\x02[\xad<8\x04]\x81<8\x05\\B<8\x06'e<" +
+	"8\aX\xfd<8\bZ\x1b<8\tZ\xf8<8\n[q<8\v\\2<8\f[\xd9<8\x10" +
+	"W;<8\x11]\x84<8\x12\\F<8\x13'i<8\x14X\xff<8\x15Z\x1f<8\x16Z\xfa" +
+	"<8\x17[s<8\x18\\4<8\x19[\xdc<8BX&<8D[\xb6<8EZ\xfd<8" +
+	"FX\xfb<8G(\xed<8HU\xb4<8IYK<8JY\xcf<8KZ\x94<8L[" +
+	"\x83<8PPz<8Q[\xb8<8RZ\xff<8SX\xfd<8T(\xef<8UU\xb8<" +
+	"8VYM<8WY\xd1<8XZ\x96<8Y[\x86<8\x82S\xa1<8\x84\\S<8\x85" +
+	"[\xb3<8\x86Z\x18<8\x87U\xb4<8\x88)m<8\x89X\xb8<8\x8aY^<8\x8bZ&" +
+	"<8\x8c[\x19<8\x90Py<8\x91\\W<8\x92[\xb7<8\x93Z\x1c<8\x94U\xb6<8" +
+	"\x95)q<8\x96X\xba<8\x97Y`<8\x98Z)<8\x99[\x1b<8\xc2Q\x1e<8\xc4\\" +
+	".<8\xc5[\xb6<8\xc6Z\xf3<8\xc7YJ<8\xc8X\xb6<8\xc9*W<8\xcaX\xab<" +
+	"8\xcbY\x9c<8\xccZ\x93<8\xd0MO<8\xd1\\2<8\xd2[\xba<8\xd3Z\xf6<8\xd4" +
+	"YL<8\xd5X\xba<8\xd6*Y<8\xd7X\xad<8\xd8Y\x9e<8\xd9Z\x95<9\x02N\xd9" +
+	"<9\x04[[<9\x05\\,<9\x06[l<9\aY\xce<9\bY\\<9\tX\xab<9" +
+	"\n*C<9\vY\xd9<9\fZ\xcf<9\x10J\xf4<9\x11[_<9\x12\\0<9\x13[" +
+	"o<9\x14Y\xd0<9\x15Y`<9\x16X\xad<9\x17*E<9\x18Y\xdb<9\x19Z\xd2<" +
+	"9BM><9D[\x11<9E[\xa5<9F\\-<9GZ\x92<9HZ%<9I" +
+	"Y\x9c<9JY\xd9<9K*?<9L[\f<9PID<9Q[\x15<9R[\xa9" +
+	"<9S\\1<9TZ\x94<9UZ)<9VY\x9e<9WY\xdb<9X*A<9" +
+	"Y[\x0e<9\x82K\xfd<9\x84Z\xf5<9\x85[\x89<9\x86[\xd4<9\x87[\x82<9\x88[" +
+	"\x17<9\x89Z\x93<9\x8aZ\xcf<9\x8b[\f<9\x8c*C<9\x90G\xee<9\x91Z\xf9<" +
+	"9\x92[\x8d<9\x93[\xd8<9\x94[\x84<9\x95[\x1b<9\x96Z\x95<9\x97Z\xd2<9\x98" +
+	"[\x0e<9\x99*F<:]\x80\x00<:^g\xdd<:_`Q<:`[P<:aW\xd7" +
+	"<:bSa<:cP\xe7<:dN\xaa<:eM\x14<:fK\xd7<:\x9d!J<:" +
+	"\x9ed\xe6<:\x9f^U<:\xa0X\xb7<:\xa1R\x10<:\xa2R\r<:\xa3N\xee<:\xa4L" +
+	"\x9b<:\xa5J\xf0<:\xa6I\x9d<:\xde\"\xb1<:\xdfaZ<:\xe0^\xe4<:\xe1]\x19<" +
+	":\xe2]\xaf<:\xe3]\x86<:\xe4\\\xb5<:\xe5\\j<:\xe6\\M<;\x1f&e<; " +
+	"]\xab<;!\\d<;\"]\x14<;#]\x12<;$]\x83<;%\\\xfc<;&\\\xdf" +
+	"<;`)F<;aZj<;b[\x7f<;c\\R<;d\\\xc6<;e]\x82<;" +
+	"f])<;\xa1*\xc8<;\xa2W*<;\xa3Z\xb0<;\xa4[/<;\xa5[\xee<;\xa6\\" +
+	"\xd8<;\xe2+E<;\xe3Z\x1f<;\xe4Z\xc0<;\xe5[\x82<;\xe6\\o<<#,)<" +
+	"<$Z\x10<<%Z\xfb<<&[\xec<<d,\x14<<e[7<<f\\(<<\xa5" +
+	",\x10<<\xa6\\c<<\xe6,\x15<D\x03M'<D\x05i\x9b<D\x06e\xf7<D\ad\xc3" +
+	"<D\bei<D\tb\xe2<D\nc8<D\vb\xee<D\fb\xd3<DB\x80\x00<D" +
+	"Cv\xd1<DEZ\v<DFU+<DGN\xa3<DHN\xae<DIK\xd0<DJI" +
+	"\xe2<DKH,<DLF\xd2<D\x82_\xc6<D\x83k\\<D\x85\"\xf5<D\x86Z(<" +
+	"D\x87Yt<D\x88Z8<D\x89Z\x84<D\x8a[L<D\x8bZ\xc5<D\x8cZ\xaa<D\xc2" +
+	"Z\xbd<D\xc3g\xba<D\xc5Z(<D\xc6&\x12<D\xc7X/<D\xc8Y[<D\xc9Y\xb9" +
+	"<D\xcaZ\xaa<D\xcb[q<D\xcc[\x18<E\x02X\x14<E\x03f\x86<E\x05Yt<E" +
+	"\x06X/<E\a(\x8b<E\bWu<E\tX\x04<E\nY\xc8<E\vZ\x92<E\f[" +
+	"\x87<EBS\x81<ECg*<EEZ7<EFYY<EGWu<EH)\x13<" +
+	"EIWq<EJYW<EKZ$<EL[\x1d<E\x82O\xd2<E\x83d\xa3<E\x85" +
+	"Z\x83<E\x86Y\xb8<E\x87X\x04<E\x88Wq<E\x89(\x88<E\x8aWg<E\x8bX^" +
+	"<E\x8cYZ<E\xc2N\x8b<E\xc3d\xf7<E\xc5[I<E\xc6Z\xa7<E\xc7Y\xc7<E" +
+	"\xc8YU<E\xc9Wf<E\xca)\x98<E\xcbY\x85<E\xccZ\x82<F\x02L\xec<F\x03d" +
+	"\xad<F\x05Z\xc2<F\x06[n<F\aZ\x90<F\bZ#<F\tX]<F\nY\x85<" +
+	"F\v)\x94<F\fZ\xbf<FBK\xa8<FCd\x93<FEZ\xa7<FF[\x15<FG" +
+	"[\x86<FH[\x1b<FIYY<FJZ\x82<FKZ\xbf<FL)\x99<G\x05_U" +
+	"<G\x06Z[<G\aW\xbe<G\bS;<G\tO\x99<G\nNX<G\vL\xbd<G" +
+	"\fK}<G\x11\x7f\x16<G\x12_W<G\x13Z_<G\x14W\xc1<G\x15S><G\x16O" +
+	"\x9c<G\x17NY<G\x18L\xbe<G\x19K}<GCNx<GEj\xc5<GFg'<" +
+	"GGe\xf2<GHf\x8f<GId\x0f<GJda<GKd\x16<GLc\xfb<GP" +
+	"L\xa1<GQv\x04<GRj\xcc<GSg.<GTe\xf6<GUf\x94<GVd\x13" +
+	"<GWdd<GXd\x1a<GYc\xfe<G\x83w\xee<G\x85[z<G\x86V\xaa<G" +
+	"\x87P:<G\x88PC<G\x89Mp<G\x8aK\x87<G\x8bI\xd7<G\x8cH\x80<G\x90v" +
+	" <G\x91\x1e\xc5<G\x92[\x84<G\x93V\xb4<G\x94PA<G\x95PJ<G\x96Mw<" +
+	"G\x97K\x8c<G\x98I\xdc<G\x99H\x85<G\xc2a\t<G\xc3l\x82<G\xc5$\xda<G\xc6" +
+	"[\x91<G\xc7Z\xda<G\xc8[\x95<G\xc9[\xdc<G\xca\\\x9e<G\xcb\\\x17<G\xcc[\xfb" +
+	"<G\xd0j\xc7<G\xd1[d<G\xd2$\xe1<G\xd3[\x97<G\xd4Z\xde<G\xd5[\x9a<G" +
+	"\xd6[\xe1<G\xd7\\\xa1<G\xd8\\\x1a<G\xd9[\xfe<H\x02\\\v<H\x03h\xe2<H\x05[" +
+	"\x8d<H\x06'\xec<H\aY\x9a<H\bZ\xbb<H\t[\x15<H\n[\xff<H\v\\\xc0<" +
+	"H\f\\h<H\x10g%<H\x11V\x8f<H\x12[\x93<H\x13'\xf3<H\x14Y\x9e<H\x15" +
+	"Z\xc0<H\x16[\x1a<H\x17\\\x02<H\x18\\\xc3<H\x19\\k<HBYi<HCg\xaa" +
+	"<HEZ\xd5<HFY\x98<HG*^<HHX\xdf<HIYh<HJ[#<H" +
+	"K[\xe7<HL\\\xd7<HPe\xec<HQP\x1a<HRZ\xd8<HSY\x9c<HT*" +
+	"c<HUX\xe3<HVYm<HW[&<HX[\xea<HY\\\xda<H\x82T\xe4<" +
+	"H\x83hD<H\x85[\x8c<H\x86Z\xb6<H\x87X\xdd<H\x88*\xe0<H\x89X\xd5<H\x8a" +
+	"Z\xb2<H\x8b[z<H\x8c\\l<H\x90f\x88<H\x91P\"<H\x92[\x92<H\x93Z\xbc" +
+	"<H\x94X\xe1<H\x95*\xe5<H\x96X\xda<H\x97Z\xb5<H\x98[}<H\x99\\p<H" +
+	"\xc2QB<H\xc3e\xc3<H\xc5[\xd3<H\xc6[\x10<H\xc7Yg<H\xc8X\xd5<H\xc9*" +
+	"W<H\xcaX\xca<H\xcbY\xbb<H\xccZ\xb2<H\xd0d\a<H\xd1MO<H\xd2[\xda<" +
+	"H\xd3[\x16<H\xd4Yk<H\xd5X\xda<H\xd6*\\<H\xd7X\xcd<H\xd8Y\xbe<H\xd9" +
+	"Z\xb5<I\x02O\xff<I\x03f\x14<I\x05\\\x93<I\x06[\xf8<I\a[\x1f<I\bZ\xb0" +
+	"<I\tX\xc8<I\n+c<I\vZ\xde<I\f[\xd4<I\x10dW<I\x11Ke<I" +
+	"\x12\\\x99<I\x13[\xfe<I\x14[$<I\x15Z\xb4<I\x16X\xcd<I\x17+f<I\x18Z" +
+	"\xe1<I\x19[\xd7<IBNd<ICe\xc9<IE\\\f<IF\\\xb9<IG[\xe4<" +
+	"IH[x<IIY\xb9<IJZ\xde<IK+_<IL\\\x11<IPd\r<IQ" +
+	"I\xb5<IR\\\x12<IS\\\xbf<IT[\xe8<IU[}<IVY\xbe<IWZ\xe1" +
+	"<IX+b<IY\\\x14<I\x82M$<I\x83e\xad<I\x85[\xf0<I\x86\\a<I" +
+	"\x87\\\xd4<I\x88\\k<I\x89Z\xb0<I\x8a[\xd4<I\x8b\\\x11<I\x8c+d<I\x90c" +
+	"\xf1<I\x91H^<I\x92[\xf6<I\x93\\g<I\x94\\\xd8<I\x95\\o<I\x96Z\xb5<" +
+	"I\x97[\xd7<I\x98\\\x14<I\x99+g<J^\x80\x00<J_`\x99<J`[\xad<Ja" +
+	"Y\x16<JbT\xa1<JcQ\v<JdO\xcc<JeN7<JfL\xf9<J\x9dM\xf3" +
+	"<J\x9ew!<J\x9fk\xf1<J\xa0hU<J\xa1g\x1a<J\xa2g\xae<J\xa3e3<J" +
+	"\xa4e\x81<J\xa5e5<J\xa6e\x18<J\xde \xe4<J\xdf\\\xd3<J\xe0X\x0f<J\xe1Q" +
+	"\xb1<J\xe2Q\xb8<J\xe3N\xee<J\xe4M\n<J\xe5K_<J\xe6J\f<K\x1f&\xc6<" +
+	"K \\\xfc<K!\\><K\"\\\xef<K#]1<K$]\xeb<K%]d<K&" +
+	"]G<K`)\xcc<Ka[\a<Kb\\\x1d<Kc\\r<Kd]S<Ke^\x0f" +
+	"<Kf]\xb6<K\xa1,6<K\xa2ZK<K\xa3Z\xcf<K\xa4\\\x7f<K\xa5]=<K" +
+	"\xa6^(<K\xe2,\xb3<K\xe3Z><K\xe4\\\x0f<K\xe5\\\xd2<K\xe6]\xbf<L#," +
+	"+<L$Z/<L%[\x1b<L&\\\f<Ld-1<Le\\9<Lf]*<" +
+	"L\xa5--<L\xa6]f<L\xe6-2<T\x03L\xc5<T\x04\x84\xa6<T\x06b2<T\a" +
+	"a\x13<T\ba\xbe<T\t`\t<T\n_:<T\v_\xdb<T\f_\xc1<TC\x84\xa4" +
+	"<TDJ]<TF^\xe1<TG]\xc2<TH^m<TI_\xe5<TJ^E<T" +
+	"K^\xe7<TL^\xcc<T\x82\x80\x00<T\x83vt<T\x84m?<T\x86OA<T\x87I" +
+	"Z<T\x88I\xa0<T\x89F\xcf<T\x8aE6<T\x8bC\xf5<T\x8cB\x9b<T\xc2VZ<" +
+	"T\xc3c\xf4<T\xc4`\xa8<T\xc6 \xb1<T\xc7R\xe9<T\xc8S\xfb<T\xc9U\r<T\xca" +
+	"V@<T\xcbWT<T\xccV\xfb<U\x02S\xc6<U\x03b\xd6<U\x04_\x89<U\x06R\xe9" +
+	"<U\a#P<U\bR\xde<U\tT <U\nU_<U\vV\x9b<U\fW\x91<U" +
+	"BO4<UCc~<UD`2<UFS\xfa<UGR\xde<UH#\xd8<UIS" +
+	"\x8c<UJT\xed<UKV.<ULW&<U\x82Li<U\x83a\xc8<U\x84a\xa7<" +
+	"U\x86U\n<U\x87T\x1e<U\x88S\x8b<U\x89$<<U\x8aS$<U\x8bUU<U\x8c" +
+	"VR<U\xc2I\xfe<U\xc3`\xf8<U\xc4`\a<U\xc6V><U\xc7U]<U\xc8T\xec" +
+	"<U\xc9S$<U\xca#\xd4<U\xcbUC<U\xccV@<V\x02Ie<V\x03a\x98<V" +
+	"\x04`\xa7<V\x06WP<V\aV\x98<V\bV+<V\tUT<V\nUB<V\v$" +
+	"\xf4<V\fWg<VBH!<VCa~<VD`\x8c<VFV\xf7<VGW\x8e<" +
+	"VHW#<VIVP<VJV><VKWg<VL$\xf9<W\x06V\a<W\a" +
+	"S\x7f<W\bN\xfc<W\tL=<W\nI\xdb<W\vID<W\fH\x03<W\x12\x7f\x17" +
+	"<W\x13V\r<W\x14S\x84<W\x15O\x01<W\x16LA<W\x17I\xdf<W\x18IF<W" +
+	"\x19H\x06<WCN\x1e<WD\x85~<WFcq<WGbP<WHb\xf2<WIa" +
+	"B<WJ`s<WKa\x10<WL`\xf4<WPLH<WQ\x83\xc6<WRu\xa9<" +
+	"WScz<WTbW<WUb\xf9<WVaG<WW`y<WXa\x15<WY" +
+	"`\xf9<W\x83\x85|<W\x84K\xbb<W\x86`/<W\x87_\x0e<W\x88_\xb0<W\x89a\x1d" +
+	"<W\x8a_\x82<W\x8b`\x1f<W\x8c`\x03<W\x90\x83\xc6<W\x91I\xe3<W\x92l\x94<W" +
+	"\x93`8<W\x94_\x15<W\x95_\xb7<W\x96a#<W\x97_\x88<W\x98`$<W\x99`" +
+	"\b<W\xc3w\x94<W\xc4n\x81<W\xc6P\xd4<W\xc7K\x03<W\xc8KG<W\xc9H\x7f<" +
+	"W\xcaF\xeb<W\xcbE\xad<W\xccDV<W\xd0u\xc6<W\xd1l\xb0<W\xd2\x19\x0e<W\xd3" +
+	"P\xe0<W\xd4K\f<W\xd5KQ<W\xd6H\x87<W\xd7F\xf3<W\xd8E\xb3<W\xd9D]" +
+	"<X\x02W\xb8<X\x03e-<X\x04a\xef<X\x06\"\x9e<X\aTg<X\bUo<X" +
+	"\tVy<X\nW\xa6<X\vX\xb3<X\fXZ<X\x10ct<X\x11`1<X\x12P" +
+	"\xbe<X\x13\"\xa8<X\x14Tn<X\x15Uw<X\x16V\x7f<X\x17W\xac<X\x18X\xb7<" +
+	"X\x19X^<XBU+<XCd\n<XD`\xcc<XFTf<XG%5<XH" +
+	"TX<XIU\x92<XJV\xca<XKW\xff<XLX\xef<XPbO<XQ_\r" +
+	"<XRJ\xe8<XSTl<XT%=<XUT_<XVU\x98<XWV\xcf<X" +
+	"XX\x03<XYX\xf3<X\x82P\xa6<X\x83d\xa8<X\x84aj<X\x86Uj<X\x87T" +
+	"V<X\x88%\xb8<X\x89T\xff<X\x8aVX<X\x8bW\x91<X\x8cX\x84<X\x90b\xf0<" +
+	"X\x91_\xad<X\x92K*<X\x93Us<X\x94T^<X\x95%\xc0<X\x96U\x05<X\x97" +
+	"V^<X\x98W\x96<X\x99X\x89<X\xc2M\xe5<X\xc3b\xf6<X\xc4b\xd4<X\xc6Vr" +
+	"<X\xc7U\x8f<X\xc8T\xfd<X\xc9&\x1b<X\xcaT\x96<X\xcbV\xbd<X\xccW\xb4<X" +
+	"\xd0a=<X\xd1a\x19<X\xd2Ha<X\xd3V{<X\xd4U\x96<X\xd5U\x04<X\xd6&" +
+	"!<X\xd7T\x9c<X\xd8V\xc2<X\xd9W\xb8<Y\x02K\x83<Y\x03b'<Y\x04a9<" +
+	"Y\x06W\x9f<Y\aV\xc6<Y\bVW<Y\tT\x96<Y\n%\xb4<Y\vV\xab<Y\f" +
+	"W\xa2<Y\x10`n<Y\x11_}<Y\x12F\xcd<Y\x13W\xa8<Y\x14V\xce<Y\x15V^" +
+	"<Y\x16T\x9c<Y\x17%\xba<Y\x18V\xb0<Y\x19W\xa6<YBJ\xea<YCb\xc3<Y" +
+	"Da\xd4<YFX\xaa<YGW\xf9<YHW\x8e<YIV\xbc<YJV\xaa<YK&" +
+	"\xd0<YLX\xc5<YPa\n<YQ`\x19<YRE\x8e<YSX\xb3<YTX\x01<" +
+	"YUW\x95<YVV\xc1<YWV\xb0<YX&\xd5<YYX\xc9<Y\x82I\xaa<Y\x83" +
+	"b\xa7<Y\x84a\xb9<Y\x86XQ<Y\x87X\xe9<Y\x88X\x81<Y\x89W\xb2<Y\x8aW\xa0" +
+	"<Y\x8bX\xc5<Y\x8c&\xd5<Y\x90`\xee<Y\x91_\xfd<Y\x92D7<Y\x93XZ<Y" +
+	"\x94X\xf1<Y\x95X\x88<Y\x96W\xb8<Y\x97W\xa6<Y\x98X\xc9<Y\x99&\xd9<Z_\x80" +
+	"\x00<Z`Wl<ZaT\xe9<ZbPt<ZcM\xbd<ZdKc<ZeJ\xcc<" +
+	"ZfI\x8e<Z\x9dM\xa1<Z\x9e\x84\x9e<Z\x9fv\xc8<Z\xa0d\xb3<Z\xa1c\x8c<Z\xa2" +
+	"d$<Z\xa3bu<Z\xa4a\xa7<Z\xa5b?<Z\xa6b\"<Z\xdeKA<Z\xdfm\xd5" +
+	"<Z\xe0a\x7f<Z\xe1`X<Z\xe2`\xef<Z\xe3bQ<Z\xe4`\xba<Z\xe5aQ<Z" +
+	"\xe6a4<[\x1f\x1b=<[ RQ<[!L\x90<[\"L\xd1<[#J\x11<[$H" +
+	"\x82<[%GF<[&E\xf3<[`$\x94<[aU\xeb<[bV\xe7<[cW\xe7<" +
+	"[dY\r<[eZ\x11<[fY\xb9<[\xa1'#<[\xa2U\xd8<[\xa3W\b<[\xa4" +
+	"X8<[\xa5Yd<[\xa6ZO<[\xe2'\xa0<[\xe3Vw<[\xe4W\xc9<[\xe5X\xf9" +
+	"<[\xe6Y\xe6<\\#(\x00<\\$V\x0e<\\%X)<\\&Y\x1a<\\d'\x9b<\\" +
+	"eX\x18<\\fY\t<\\\xa5(\xb1<\\\xa6Z'<\\\xe6(\xb6<d\x03L\xd4<d\x04\x88" +
+	"\xd9<d\x05\x8c\t<d\a^-<d\b^\xd0<d\t]B<d\n]<<d\v\\\xb8<" +
+	"d\f]\x88<dC\x88\xd7<dDJY<dE\x88\x1e<dGZ\xd8<dH[{<dI" +
+	"]\x14<dJ\\G<dK[\xc4<dL\\\x94<d\x83\x8c\x05<d\x84\x88\x1c<d\x85H\xb0" +
+	"<d\x87X\x93<d\x88Y6<d\x89Z\xcf<d\x8a]/<d\x8b[\xdb<d\x8c\\\xab<d" +
+	"\u0080\x00<d\xc3v#<d\xc4l\xeb<d\xc5c\x88<d\xc7D\xbb<d\xc8Ex<d\xc9B" +
+	"\xd1<d\xcaAD<d\xcb@Y<d\xcc?s<e\x02O\xda<e\x03_\xee<e\x04\\\x9d<" +
+	"e\x05Z[<e\a\x1f$<e\bNi<e\tO\xb6<e\nQ\xa8<e\vS'<e\f" +
+	"Tj<eBK6<eC`\x8f<eD]?<eEZ\xfd<eGNi<eH\x1f\xac" +
+	"<eIO\"<eJQ6<eKR\xba<eLS\xff<e\x82H\x92<e\x83^\xff<e" +
+	"\x84^\xd4<e\x85\\\x93<e\x87O\xb4<e\x88O!<e\x89 7<e\x8aP5<e\x8bQ" +
+	"\xe2<e\x8cSQ<e\xc2G\f<e\xc3^\xf8<e\xc4^\x06<e\xc5^\xef<e\xc7Q\xa5<" +
+	"e\xc8Q3<e\xc9P3<e\xca \xbd<e\xcbQ\xcf<e\xccT\a<f\x02EO<f\x03" +
+	"^t<f\x04]\x82<f\x05]\x9b<f\aS$<f\bR\xb7<f\tQ\xe0<f\nQ\xcf" +
+	"<f\v e<f\fS\xf4<fBE\x11<fC_B<fD^Q<fE^j<f" +
+	"GTe<fHS\xfb<fISN<fJT\x05<fKS\xf3<fL!\x8e<g\aO" +
+	"\xa0<g\bK\f<g\tHs<g\nF\xf4<g\vE<<g\fD\xff<g\x13\x7f\x19<" +
+	"g\x14O\xa8<g\x15K\x14<g\x16Hy<g\x17F\xf8<g\x18EA<g\x19E\x02<gC" +
+	"N2<gD\x89\xaa<gE\x8c\xcc<gG_t<gH`\x0f<gI^\x84<gJ^|" +
+	"<gK]\xf9<gL^\xc5<gPL]<gQ\x87\xf3<gR\x8b\x14<gSuY<g" +
+	"T_~<gU`\x19<gV^\x8c<gW^\x83<gX]\xff<gY^\xca<g\x83\x89" +
+	"\xa7<g\x84K\xbc<g\x85\x88\xf2<g\x87\\.<g\x88\\\xc9<g\x89^W<g\x8a]\x8b<" +
+	"g\x8b]\b<g\x8c]\xd4<g\x90\x87\xf4<g\x91I\xe5<g\x92\x878<g\x93l@<g\x94" +
+	"\\7<g\x95\\\xd2<g\x96^_<g\x97]\x92<g\x98]\x0f<g\x99]\xd9<gÌ\xc7" +
+	"<gĈ\xef<g\xc5J\x15<g\xc7Y\xf2<g\xc8Z\x8d<g\xc9\\\x1b<g\xca^n<g" +
+	"\xcb]\x1e<g\xcc]\xea<gЋ\x13<gч7<g\xd2H=<g\xd3b\xfd<g\xd4Y" +
+	"\xfc<g\xd5Z\x96<g\xd6\\#<g\xd7^t<g\xd8]$<g\xd9]\xef<h\x03wD<" +
+	"h\x04n.<h\x05d\xee<h\aFs<h\bG,<h\tD\x8e<h\nC\x06<h\v" +
+	"B\x1d<h\fA8<h\x10uw<h\x11l_<h\x12c\x1d<h\x13\x14\xa1<h\x14F\x7f" +
+	"<h\x15G8<h\x16D\x98<h\x17C\x0e<h\x18B%<h\x19A?<hBQM<h" +
+	"Ca0<hD]\xed<hE[\xb6<hG!\x18<hHO\xf4<hIQ8<hJS" +
+	" <hKT\x97<hLU\xd3<hP_x<hQ\\1<hRY\xf7<hSF\\<" +
+	"hT!#<hUO\xfe<hVQ@<hWS&<hXT\x9e<hYU\xd8<h\x82" +
+	"L\xb8<h\x83a\xc7<h\x84^\x84<h\x85\\M<h\x87O\xf2<h\x88!\x9b<h\x89P\xa5" +
+	"<h\x8aR\xae<h\x8bT*<h\x8cUh<h\x90`\x11<h\x91\\\xca<h\x92Z\x90<h" +
+	"\x93G\x13<h\x94O\xfc<h\x95!\xa5<h\x96P\xad<h\x97R\xb5<h\x98T1<h\x99U" +
+	"m<h\xc2J\x1c<h\xc3`:<h\xc4`\x0f<h\xc5]\xd7<h\xc7Q4<h\xc8P\xa3<" +
+	"h\xc9\"#<h\xcaQ\xb1<h\xcbSV<h\xccT\xbe<h\xd0^\x84<h\xd1^V<h\xd2" +
+	"\\\x1c<h\xd3Ds<h\xd4Q><h\xd5P\xac<h\xd6\",<h\xd7Q\xb8<h\xd8S]" +
+	"<h\xd9T\xc3<i\x02H\x9b<i\x03`0<i\x04_B<i\x05`'<i\aS\x1b<i" +
+	"\bR\xab<i\tQ\xb0<i\n\"\xa9<i\vSD<i\fUq<i\x10^{<i\x11]" +
+	"\x89<i\x12^m<i\x13B\xea<i\x14S$<i\x15R\xb4<i\x16Q\xb7<i\x17\"\xb0<" +
+	"i\x18SK<i\x19Uv<iBF\xe3<iC_\xac<iD^\xbe<iE^\xd6<iG" +
+	"T\x92<iHT'<iIST<iJSD<iK\"S<iLU_<iP]\xf7" +
+	"<iQ]\x06<iR]\x1d<iSB\x01<iTT\x9c<iUT0<iVS\\<i" +
+	"WSK<iX\"Y<iYUd<i\x82F\xa4<i\x83`v<i\x84_\x88<i\x85_" +
+	"\xa1<i\x87U\xcc<i\x88Uc<i\x89T\xba<i\x8aUo<i\x8bU]<i\x8c#w<" +
+	"i\x90^\xc1<i\x91]\xd0<i\x92]\xe7<i\x93A\x1b<i\x94U\xd5<i\x95Um<i\x96" +
+	"T\xc2<i\x97Uv<i\x98Ud<i\x99#|<j`\x80\x00<jaQ\x1b<jbL\x95" +
+	"<jcJ\x03<jdH\x87<jeF\xd5<jfF\x96<j\x9dM\xbb<j\x9e\x88\xc4<j" +
+	"\x9f\x8b\xd6<j\xa0vz<j\xa1`\xc0<j\xa2aP<j\xa3_\xc6<j\xa4_\xbb<j\xa5_" +
+	"8<j\xa6_\xfe<j\xdeKH<j߈\v<j\xe0m\x84<j\xe1]\x87<j\xe2^\x18<" +
+	"j\xe3_\x99<j\xe4^\xce<j\xe5^J<j\xe6_\x10<k\x1fI\xa2<k dc<k!" +
+	"[V<k\"[\xe6<k#]h<k$_\xac<k%^_<k&_%<k`\x16\xdc" +
+	"<kaH\x13<kbH\xc7<kcF0<kdD\xab<keC\xc4<kfB\xe0<k" +
+	"\xa1#\x17<k\xa2Q\x87<k\xa3R\xc0<k\xa4T\x9c<k\xa5V\f<k\xa6W?<k\xe2#" +
+	"\x94<k\xe3R/<k\xe4T,<k\xe5U\xa0<k\xe6V\xd6<l#$\x19<l$S4<" +
+	"l%T\xd1<l&V/<ld$\x9b<leT\xbf<lfV\xe0<l\xa5$G<l\xa6" +
+	"V\xcf<l\xe6%e<t\x03L\xe1<t\x04\x8a\x99<t\x05\x8d\xb4<t\x06\x90\x1f<t\b[\xe6" +
+	"<t\tZl<t\nZ\x8c<t\vZ\xd2<t\fZ}<tC\x8a\x97<tDJS<t" +
+	"E\x89\xc6<tF\x8c1<tHX\x91<tIZ\"<tJY{<tKY\xc1<tLY" +
+	"l<t\x83\x8d\xb0<t\x84\x89\xc5<t\x85H\x99<t\x86\x88_<t\x88VH<t\x89W\xd9<" +
+	"t\x8aZZ<t\x8bY\xd9<t\x8cY\x84<tÐ\x1a<tČ/<tň^<t\xc6" +
+	"F\xfc<t\xc8S\xec<t\xc9U~<t\xcaW\xfe<t\xcbZ\xaa<t\xccY\x84<u\x02\x80\x00" +
+	"<u\x03u\xd2<u\x04l\x92<u\x05c*<u\x06X\xd3<u\bA\x00<u\t>\xb8<u" +
+	"\n=E<u\v<f<u\f;\xd6<uBGG<uC]\xa3<uDZS<uEX" +
+	"\r<uFU\xb3<uH\x1b\x80<uIJ}<uJL\xa2<uKN\xd9<uLP\x87<" +
+	"u\x82D\x91<u\x83\\(<u\x84[\xe1<u\x85Y\x9b<u\x86WB<u\x88J{<u\x89" +
+	"\x1c\v<u\x8aK\xc6<u\x8bN&<u\x8cO\xd8<u\xc2C2<u\xc3\\G<u\xc4[8" +
+	"<u\xc5\\\x19<u\xc6Y\xbf<u\xc8L\x9f<u\xc9K\xc5<u\xca\x1c\xb8<u\xcbN\xdc<u" +
+	"\xccP\x8e<v\x02BY<v\x03\\\x8b<v\x04[}<v\x05[\x96<v\x06\\h<v\bN" +
+	"\xd4<v\tN#<v\nN\xdb<v\v\x1dO<v\fP|<vB@\xf7<vC\\6<" +
+	"vD[(<vE[A<vF[B<vHP\x83<vIO\xd6<vJP\x8d<vK" +
+	"P|<vL\x1c\xff<w\bG)<w\tD\x7f<w\nC%<w\vBQ<w\f@\xf3" +
+	"<w\x14\x7f\x19<w\x15G3<w\x16D\x87<w\x17C,<w\x18BV<w\x19@\xf8<w" +
+	"CND<wD\x8bj<wE\x8ex<wF\x90\xd9<wH]/<wI[\xb9<wJ[" +
+	"\xd7<wK\\\x1a<wL[\xc5<wPLp<wQ\x89\xb5<wR\x8c\xc1<wS\x8f!<" +
+	"wTu\t<wU];<wV[\xc3<wW[\xdf<wX\\!<wY[\xcc<w\x83" +
+	"\x8bh<w\x84K\xba<w\x85\x8a\x9b<w\x86\x8c\xfd<w\x88Y\xe9<w\x89[p<w\x8aZ\xca" +
+	"<w\x8b[\r<w\x8cZ\xb8<w\x90\x89\xb6<w\x91I\xe5<w\x92\x88\xe3<w\x93\x8bC<w" +
+	"\x94k\xea<w\x95Y\xf4<w\x96[y<w\x97Z\xd2<w\x98[\x14<w\x99Z\xbf<wÎ" +
+	"s<wĊ\x99<w\xc5J\x03<wƉ9<w\xc8W\xa9<w\xc9Y0<w\xca[\xa3<" +
+	"w\xcb[#<w\xccZ\xce<wЌ\xc0<wш\xe2<w\xd2H,<wӇ~<w\xd4" +
+	"b\xa1<w\xd5W\xb4<w\xd6Y9<w\xd7[\xab<w\xd8[*<w\xd9Z\xd5<x\x03\x90\xd2" +
+	"<x\x04\x8c\xf8<x\x05\x897<x\x06Hg<x\bUV<x\tV\xdd<x\nYP<x" +
+	"\v[\xef<x\fZ\xcd<x\x10\x8f\x1f<x\x11\x8bA<x\x12\x87}<x\x13F\x90<x\x14X" +
+	"m<x\x15Ub<x\x16V\xe7<x\x17YY<x\x18[\xf6<x\x19Z\xd4<xCv\xf7<" +
+	"xDm\xda<xEd\x94<xFZc<xHB\xc4<xI@\x84<xJ?\x15<xK" +
+	">8<xL=\xa8<xPu+<xQl\v<xRb\xc3<xSX\x91<xT\x10N" +
+	"<xUB\xd2<xV@\x90<xW?\x1f<xX>@<xY=\xb1<x\x82H\xd7<x" +
+	"\x83^\xe8<x\x84[\xa5<x\x85Yj<x\x86W\x1b<x\x88\x1d~<x\x89L\x10<x\x8aN" +
+	"+<x\x8bPW<x\x8cQ\xfd<x\x90]5<x\x91Y\xee<x\x92W\xb0<x\x93U_<" +
+	"x\x94B\xae<x\x95\x1d\x8a<x\x96L\x1a<x\x97N3<x\x98P]<x\x99R\x04<x\xc2" +
+	"F*<x\xc3]p<x\xc4](<x\xc5Z\xed<x\xc6X\x9e<x\xc8L\x0e<x\xc9\x1e\x06" +
+	"<x\xcaMS<x\xcbO\xa8<x\xccQR<x\xd0[\xbd<x\xd1[s<x\xd2Y5<x" +
+	"\xd3V\xe4<x\xd4@k<x\xd5L\x1a<x\xd6\x1e\x11<x\xd7M[<x\xd8O\xaf<x\xd9Q" +
+	"Y<y\x02D\xcf<y\x03]\x8b<y\x04\\\x81<y\x05]]<y\x06[\x0e<y\bN'<" +
+	"y\tMQ<y\n\x1e\xb1<y\vP[<y\fR\x05<y\x10[\xd9<y\x11Z\xcc<y\x12" +
+	"[\xa7<y\x13YV<y\x14>\xfb<y\x15N2<y\x16M[<y\x17\x1e\xba<y\x18Pb" +
+	"<y\x19R\f<yBC\xf8<yC]\xcc<yD\\\xc2<yE\\\xdb<yF]\xa9<y" +
+	"HPQ<yIO\xa4<yJPZ<yK\x1fG<yLQ\xf3<yP\\\x1b<yQ[" +
+	"\x0e<yR[%<yS[\xf2<yT>\x1c<yUP]<yVO\xae<yWPb<" +
+	"yX\x1fN<yYQ\xfa<y\x82B\x9a<y\x83]w<y\x84\\m<y\x85\\\x85<y\x86" +
+	"\\\x87<y\x88Q\xf8<y\x89QO<y\x8aR\x04<y\x8bQ\xf3<y\x8c\x1e\xf9<y\x90[\xc6" +
+	"<y\x91Z\xb8<y\x92Z\xd0<y\x93Z\xd0<y\x94=\x8d<y\x95R\x03<y\x96QX<y" +
+	"\x97R\f<y\x98Q\xfa<y\x99\x1f\x00<za\x80\x00<zbH\xc3<zcF <zdD" +
+	"\xc9<zeC\xf5<zfB\x9b<z\x9dM\xd3<z\x9e\x8a\x86<z\x9f\x8d\x84<z\xa0\x8f\xd9<" +
+	"z\xa1v.<z\xa2^\x7f<z\xa3]\n<z\xa4]#<z\xa5]b<z\xa6]\r<z\xde" +
+	"KL<z߉\xb7<z\xe0\x8c\f<z\xe1m1<z\xe2[G<z\xe3\\\xc1<z\xe4\\\x1a" +
+	"<z\xe5\\Y<z\xe6\\\x04<{\x1fI\x96<{ \x88W<{!d\v<{\"Y\x11<{" +
+	"#Z\x8b<{$\\\xef<{%\\o<{&\\\x1a<{`G\xfb<{aY\xfd<{bV" +
+	"\xca<{cXD<{dZ\xa8<{e]7<{f\\\x19<{\xa1\x12\x96<{\xa2Dr<" +
+	"{\xa3B7<{\xa4@\xcb<{\xa5?\xee<{\xa6?_<{\xe2\x1f\x87<{\xe3M\xad<{\xe4" +
+	"O\xbb<{\xe5Q\xdb<{\xe6Sy<|# \r<|$N\xe7<|%Q0<|&R\xd2" +
+	"<|d \xb4<|eQ\xe1<|fS\x83<|\xa5!G<|\xa6Sr<|\xe6 \xfa<\x84" +
+	"\x03O\x14<\x84\x04\x8e`<\x84\x05\x90\xa9<\x84\x06\x92\xd9<\x84\a\x94\x91<\x84\t[p<\x84\n[" +
+	"\x89<\x84\v[\xf4<\x84\f\\i<\x84C\x8e_<\x84DLq<\x84E\x8c\xbc<\x84F\x8e\xeb<" +
+	"\x84G\x90\xa3<\x84I[&<\x84JZw<\x84KZ\xe3<\x84L[X<\x84\x83\x90\xa7<\x84\x84" +
+	"\x8c\xba<\x84\x85J\xa6<\x84\x86\x8b\x17<\x84\x87\x8c\xcf<\x84\x89X\xdd<\x84\x8a[V<\x84\x8bZ\xfb" +
+	"<\x84\x8c[p<\x84Ò\xd6<\x84Ď\xe9<\x84ŋ\x16<\x84\xc6H\xd5<\x84ǈ\xf3<\x84" +
+	"\xc9VW<\x84\xcaX\xd0<\x84\xcb[\x9d<\x84\xcc[J<\x85\x03\x94\x8c<\x85\x04\x90\x9f<\x85\x05\x8c" +
+	"\xcc<\x85\x06\x88\xf1<\x85\aFT<\x85\tS\f<\x85\nU\x85<\x85\vXR<\x85\f[,<" +
+	"\x85B\x80\x00<\x85Cxa<\x85Dn\xa9<\x85Ed\x1c<\x85FZW<\x85GQ <\x85I" +
+	">\x87<\x85J=n<\x85K<\x97<\x85L<\x13<\x85\x82D7<\x85\x83]-<\x85\x84\\\xe4" +
+	"<\x85\x85Z\x9e<\x85\x86X\x1b<\x85\x87T\xd4<\x85\x89\x1cm<\x85\x8aJ\x9d<\x85\x8bM\x15<\x85" +
+	"\x8cOz<\x85\xc2B\xc7<\x85\xc3]D<\x85\xc4\\5<\x85\xc5]\x15<\x85\xc6Z\x91<\x85\xc7W" +
+	"J<\x85\xc9J\x9b<\x85\xca\x1d\x1a<\x85\xcbM\xf1<\x85\xccPV<\x86\x02B\x14<\x86\x03]\xae<" +
+	"\x86\x04\\\x9f<\x86\x05\\\xb8<\x86\x06]Z<\x86\aZ\x13<\x86\tM\x12<\x86\nM\xf0<\x86\v" +
+	"\x1d\xd7<\x86\fQ\f<\x86BA\x97<\x86C^!<\x86D]\x12<\x86E]+<\x86F]\x06" +
+	"<\x86G\\\xea<\x86IOv<\x86JPS<\x86KQ\v<\x86L\x1ew<\x87\tD&<\x87" +
+	"\nB\xbc<\x87\vB\r<\x87\fA\x92<\x87\x15\x7f\x1b<\x87\x16D0<\x87\x17B\xc4<\x87\x18B" +
+	"\x13<\x87\x19A\x97<\x87CPn<\x87D\x8f\"<\x87E\x91a<\x87F\x93\x88<\x87G\x959<" +
+	"\x87I\\\xb8<\x87J\\\xce<\x87K]7<\x87L]\xa9<\x87PN\x9b<\x87Q\x8dq<\x87R" +
+	"\x8f\xae<\x87S\x91\xd4<\x87T\x93\x83<\x87Uw\x8f<\x87V\\\xc3<\x87W\\\xd8<\x87X]@" +
+	"<\x87Y]\xb0<\x87\x83\x8f!<\x87\x84M\xd0<\x87\x85\x8d\x85<\x87\x86\x8f\xac<\x87\x87\x91]<\x87" +
+	"\x89\\o<\x87\x8a[\xc2<\x87\x8b\\*<\x87\x8c\\\x9c<\x87\x90\x8dq<\x87\x91K\xfc<\x87\x92\x8b" +
+	"\xd0<\x87\x93\x8d\xf6<\x87\x94\x8f\xa5<\x87\x95m\xfa<\x87\x96\\z<\x87\x97[\xcb<\x87\x98\\3<" +
+	"\x87\x99\\\xa3<\x87Ñ]<\x87č\x82<\x87\xc5L\b<\x87Ƌ\xe6<\x87Ǎ\x97<\x87\xc9" +
+	"Z/<\x87\xca\\\x9b<\x87\xcb\\@<\x87\xcc\\\xb2<\x87Џ\xad<\x87ы\xcf<\x87\xd2J3" +
+	"<\x87ӊ/<\x87ԋ\xde<\x87\xd5c\x91<\x87\xd6Z:<\x87\xd7\\\xa5<\x87\xd8\\H<\x87" +
+	"\xd9\\\xb9<\x88\x03\x93\x82<\x88\x04\x8f\xa7<\x88\x05\x8b\xe4<\x88\x06J9<\x88\a\x89\xcb<\x88\tW" +
+	"\xb3<\x88\nZ\x1f<\x88\v\\\xdd<\x88\f\\\x8c<\x88\x10\x91\xd2<\x88\x11\x8d\xf3<\x88\x12\x8a.<" +
+	"\x88\x13Hd<\x88\x14\x88\x10<\x88\x15Y\xed<\x88\x16W\xbe<\x88\x17Z)<\x88\x18\\\xe6<\x88\x19" +
+	"\\\x93<\x88C\x952<\x88D\x91V<\x88E\x8d\x93<\x88F\x89\xc9<\x88GG\xbe<\x88ITv" +
+	"<\x88JV\xe2<\x88KY\xa1<\x88L\\m<\x88P\x93\x81<\x88Q\x8f\xa2<\x88R\x8b\xdd<\x88" +
+	"S\x88\x10<\x88TE\xe8<\x88UP\xd4<\x88VT\x81<\x88WV\xec<\x88XY\xa9<\x88Y\\" +
+	"t<\x88\x83y{<\x88\x84o\xe7<\x88\x85e\x81<\x88\x86[\xdf<\x88\x87R\xc8<\x88\x89@R<" +
+	"\x88\x8a?;<\x88\x8b>g<\x88\x8c=\xe3<\x88\x90w\xaf<\x88\x91n\x1a<\x88\x92c\xb2<\x88\x93" +
+	"Z\x0f<\x88\x94P\xf5<\x88\x95\x10\xdf<\x88\x96@_<\x88\x97?G<\x88\x98>q<\x88\x99=\xec" +
+	"<\x88\xc2E\xd2<\x88\xc3^p<\x88\xc4^(<\x88\xc5[\xec<\x88\xc6Yt<\x88\xc7V;<\x88" +
+	"\xc9\x1ef<\x88\xcaL.<\x88\xcbN\x9b<\x88\xccP\xf5<\x88\xd0\\\xbe<\x88\xd1\\t<\x88\xd2Z" +
+	"6<\x88\xd3W\xbc<\x88\xd4T\x7f<\x88\xd5@=<\x88\xd6\x1es<\x88\xd7L8<\x88\xd8N\xa3<" +
+	"\x88\xd9P\xfc<\x89\x02Df<\x89\x03^\x85<\x89\x04]y<\x89\x05^U<\x89\x06[\xdd<\x89\a" +
+	"X\xa4<\x89\tL,<\x89\n\x1f\x11<\x89\vOs<\x89\fQ\xce<\x89\x10\\\xd3<\x89\x11[\xc6" +
+	"<\x89\x12\\\xa0<\x89\x13Z&<\x89\x14V\xe9<\x89\x15?%<\x89\x16L7<\x89\x17\x1f\x1c<\x89" +
+	"\x18O|<\x89\x19Q\xd5<\x89BC\xb4<\x89C^\xec<\x89D]\xe0<\x89E]\xf8<\x89F^" +
+	"\x98<\x89G[_<\x89IN\x97<\x89JOr<\x89K\x1f\xcc<\x89LR\x81<\x89P]:<" +
+	"\x89Q\\-<\x89R\\D<\x89S\\\xe3<\x89TY\xa6<\x89U>O<\x89VN\xa3<\x89W" +
+	"O|<\x89X\x1f\xd5<\x89YR\x88<\x89\x82C8<\x89\x83_\\<\x89\x84^P<\x89\x85^h" +
+	"<\x89\x86^E<\x89\x87^(<\x89\x89P\xf0<\x89\x8aQ\xca<\x89\x8bR\x80<\x89\x8c j<\x89" +
+	"\x90]\xaa<\x89\x91\\\x9d<\x89\x92\\\xb4<\x89\x93\\\x8f<\x89\x94\\q<\x89\x95=\xc9<\x89\x96P" +
+	"\xfb<\x89\x97Q\xd4<\x89\x98R\x88<\x89\x99 r<\x8ab\x80\x00<\x8acE\xca<\x8adDc<" +
+	"\x8aeC\xb4<\x8afC8<\x8a\x9dO\xf6<\x8a\x9e\x8e4<\x8a\x9f\x90e<\x8a\xa0\x92\x81<\x8a\xa1" +
+	"\x94)<\x8a\xa2x\xa9<\x8a\xa3^\x06<\x8a\xa4^\x19<\x8a\xa5^}<\x8a\xa6^\xea<\x8a\xdeM\\" +
+	"<\x8aߌ\x98<\x8a\xe0\x8e\xb4<\x8a\xe1\x90\\<\x8a\xe2o8<\x8a\xe3]\xbd<\x8a\xe4]\x10<\x8a" +
+	"\xe5]t<\x8a\xe6]\xe1<\x8b\x1fK\x95<\x8b \x8a\xfd<\x8b!\x8c\xa5<\x8b\"d\xf6<\x8b#[" +
+	"\x88<\x8b$]\xe5<\x8b%]\x89<\x8b&]\xf7<\x8b`I\xc8<\x8ba\x88\xe8<\x8bb[u<" +
+	"\x8bcY\x17<\x8bd[t<\x8be^#<\x8bf]\xd1<\x8b\xa1GR<\x8b\xa2R|<\x8b\xa3" +
+	"U\xe9<\x8b\xa4XF<\x8b\xa5Z\xf5<\x8b\xa6]\xb2<\x8b\xe2\x13!<\x8b\xe3B\b<\x8b\xe4@\xf3" +
+	"<\x8b\xe5@\x1e<\x8b\xe6?\x99<\x8c# l<\x8c$M\xc8<\x8c%P(<\x8c&Rv<\x8c" +
+	"d!\x13<\x8ceP\xfe<\x8cfSL<\x8c\xa5!\xca<\x8c\xa6S\xfd<\x8c\xe6\"f<\x94\x03O" +
+	"h<\x94\x04\x8f8<\x94\x05\x91\x80<\x94\x06\x93\xaf<\x94\a\x95o<\x94\b\x99\x86<\x94\nZ\xa9<" +
+	"\x94\v[\x0e<\x94\f[\xa8<\x94C\x8f6<\x94DN,<\x94E\x8e\xe3<\x94F\x90K<\x94G" +
+	"\x91\xe4<\x94H\x95\xfb<\x94JZ\xa7<\x94K[\v<\x94L[\xa6<\x94\x83\x91~<\x94\x84\x8e\xe3" +
+	"<\x94\x85LP<\x94\x86\x8cv<\x94\x87\x8e\x0f<\x94\x88\x92'<\x94\x8a[h<\x94\x8b[\x06<\x94" +
+	"\x8c[\xa1<\x94Ó\xad<\x94ĐJ<\x94Ōu<\x94\xc6Jq<\x94Ǌ1<\x94Ȏ" +
+	"e<\x94\xcaX\xe3<\x94\xcb[\xa8<\x94\xcc[{<\x95\x03\x95j<\x95\x04\x91\xe2<\x95\x05\x8e\r<" +
+	"\x95\x06\x8a/<\x95\aG\xe4<\x95\b\x8a\x8c<\x95\nU\x93<\x95\vXY<\x95\f[T<\x95C" +
+	"\x99\x81<\x95D\x95\xf9<\x95E\x92$<\x95F\x8ec<\x95G\x8a\x8c<\x95HF\xf6<\x95JSn" +
+	"<\x95KV3<\x95LY.<\x95\x82\x80\x00<\x95\x83w\xcc<\x95\x84p\x98<\x95\x85e\xa5<\x95" +
+	"\x86Z\xfa<\x95\x87Q\x7f<\x95\x88K$<\x95\x8a<\x16<\x95\x8b;\xaa<\x95\x8c;/<\x95\xc2A" +
+	"\xf8<\x95\xc3\\e<\x95\xc4\\e<\x95\xc5]'<\x95\xc6Z\xa3<\x95\xc7WX<\x95\xc8U4<" +
+	"\x95\xca\x1cm<\x95\xcbK?<\x95\xccM\xbd<\x96\x02A4<\x96\x03\\\xc8<\x96\x04\\\xc8<\x96\x05" +
+	"\\\xc3<\x96\x06]e<\x96\aZ\x1a<\x96\bW\xf6<\x96\nK><\x96\v\x1d*<\x96\fN\x99" +
+	"<\x96B@\xdd<\x96C]a<\x96D]a<\x96E][<\x96F]7<\x96G]\x11<\x96" +
+	"HZ\xee<\x96JM\xba<\x96KN\x98<\x96L\x1d\xef<\x97\nA\xee<\x97\vA.<\x97\f@" +
+	"\xd9<\x97\x16\x7f\x1b<\x97\x17A\xf8<\x97\x18A6<\x97\x19@\xe0<\x97CP\xc3<\x97D\x8f\xfb<" +
+	"\x97E\x929<\x97F\x94_<\x97G\x96\x18<\x97H\x9a!<\x97J[\xf2<\x97K\\T<\x97L" +
+	"\\\xeb<\x97PN\xf1<\x97Q\x8eK<\x97R\x90\x88<\x97S\x92\xae<\x97T\x94d<\x97U\x98l" +
+	"<\x97Vv\xfd<\x97W[\xfe<\x97X\\^<\x97Y\\\xf4<\x97\x83\x8f\xf9<\x97\x84O\x87<\x97" +
+	"\x85\x8f\xa4<\x97\x86\x91\x05<\x97\x87\x92\x98<\x97\x88\x96\xa1<\x97\x8a[\xf0<\x97\x8b\\Q<\x97\x8c\\" +
+	"\xe9<\x97\x90\x8eK<\x97\x91M\xb3<\x97\x92\x8d\xf3<\x97\x93\x8fS<\x97\x94\x90\xe4<\x97\x95\x94\xec<" +
+	"\x97\x96o\xe2<\x97\x97[\xfb<\x97\x98\\[<\x97\x99\\\xf1<\x97Ò5<\x97ď\xa2<\x97\xc5" +
+	"M\xac<\x97ƍ@<\x97ǎ\xd3<\x97Ȓ\xdb<\x97\xca\\\xad<\x97\xcb\\K<\x97\xcc\\\xe2" +
+	"<\x97А\x86<\x97э\xf2<\x97\xd2K\xd9<\x97Ӌ\x8c<\x97ԍ\x1d<\x97Ց%<\x97" +
+	"\xd6e\x16<\x97\xd7\\\xb8<\x97\xd8\\U<\x97\xd9\\\xeb<\x98\x03\x94Z<\x98\x04\x91\x02<\x98\x05\x8d" +
+	"=<\x98\x06K\xd0<\x98\a\x8b\x04<\x98\b\x8f)<\x98\nZ1<\x98\v\\\xe8<\x98\f\\\xbc<" +
+	"\x98\x10\x92\xab<\x98\x11\x8fP<\x98\x12\x8b\x8b<\x98\x13I\xfc<\x98\x14\x89L<\x98\x15\x8dq<\x98\x16" +
+	"Z\x8f<\x98\x17Z<<\x98\x18\\\xf2<\x98\x19\\\xc4<\x98C\x96\x11<\x98D\x92\x93<\x98E\x8e\xcf" +
+	"<\x98F\x8b\x02<\x98GIJ<\x98H\x8b`<\x98JV\xf0<\x98KY\xa7<\x98L\\\x94<\x98" +
+	"P\x94a<\x98Q\x90\xe1<\x98R\x8d\x1c<\x98S\x89M<\x98TGu<\x98U\x89\xa7<\x98VQ" +
+	"3<\x98WV\xfb<\x98XY\xb1<\x98Y\\\x9d<\x98\x83\x9a\x18<\x98\x84\x96\x9a<\x98\x85\x92\xd6<" +
+	"\x98\x86\x8f%<\x98\x87\x8b_<\x98\x88HZ<\x98\x8aT\xd3<\x98\x8bW\x8a<\x98\x8cZw<\x98\x90" +
+	"\x98h<\x98\x91\x94\xe8<\x98\x92\x91#<\x98\x93\x8dp<\x98\x94\x89\xa6<\x98\x95F\x86<\x98\x96J\xe9" +
+	"<\x98\x97T\xde<\x98\x98W\x94<\x98\x99Z\x80<\x98\xc3x\xe8<\x98\xc4q\xcf<\x98\xc5g\x05<\x98" +
+	"\xc6\\\x80<\x98\xc7S%<\x98\xc8L\xde<\x98\xca=\xe9<\x98\xcb=}<\x98\xcc=\x02<\x98\xd0w" +
+	"\x1e<\x98\xd1p\x02<\x98\xd2e7<\x98\xd3Z\xb1<\x98\xd4QT<\x98\xd5K\f<\x98\xd6\x10\x7f<" +
+	"\x98\xd7=\xf6<\x98\xd8=\x89<\x98\xd9=\f<\x99\x02C\x9a<\x99\x03]\xa9<\x99\x04]\xa9<\x99\x05" +
+	"^g<\x99\x06[\xef<\x99\aX\xb2<\x99\bV\x98<\x99\n\x1ee<\x99\vL\xcc<\x99\fO?" +
+	"<\x99\x10[\xf8<\x99\x11[\xf6<\x99\x12\\\xb4<\x99\x13Z:<\x99\x14V\xf9<\x99\x15T\xdd<\x99" +
+	"\x16=\xd4<\x99\x17\x1er<\x99\x18L\xd6<\x99\x19OG<\x99BB\xd8<\x99C^\t<\x99D^" +
+	"\b<\x99E^\x04<\x99F^\xa3<\x99G[f<\x99HYL<\x99JL\xca<\x99K\x1f <" +
+	"\x99LP\x17<\x99P\\X<\x99Q\\V<\x99R\\Q<\x99S\\\xf0<\x99TY\xae<\x99U" +
+	"W\x93<\x99V=g<\x99WL\xd6<\x99X\x1f+<\x99YP <\x99\x82B\x81<\x99\x83^\x9e" +
+	"<\x99\x84^\x9e<\x99\x85^\x99<\x99\x86^u<\x99\x87^O<\x99\x88\\5<\x99\x8aO;<\x99" +
+	"\x8bP\x16<\x99\x8c\x1f\xe4<\x99\x90\\\xee<\x99\x91\\\xeb<\x99\x92\\\xe6<\x99\x93\\\xc2<\x99\x94\\" +
+	"\x9a<\x99\x95Z~<\x99\x96<\xea<\x99\x97OF<\x99\x98P\x1f<\x99\x99\x1f\xed<\x9ac\x80\x00<" +
+	"\x9adC\x9a<\x9aeB\xda<\x9afB\x83<\x9a\x9dPL<\x9a\x9e\x8f\x0e<\x9a\x9f\x91?<\x9a\xa0" +
+	"\x93[<\x9a\xa1\x95\n<\x9a\xa2\x99\x03<\x9a\xa3x\x1a<\x9a\xa4]B<\x9a\xa5]\x9e<\x9a\xa6^1" +
+	"<\x9a\xdeO\x0e<\x9aߎ\xb2<\x9a\xe0\x90\v<\x9aᑖ<\x9a╎<\x9a\xe3q\x19<\x9a" +
+	"\xe4]?<\x9a\xe5]\x9c<\x9a\xe6^.<\x9b\x1fM6<\x9b \x8cT<\x9b!\x8d\xdf<\x9b\"\x91" +
+	"\xd7<\x9b#fv<\x9b$]\xf9<\x9b%]\x96<\x9b&^(<\x9b`K[<\x9ba\x8a\x1f<" +
+	"\x9bb\x8e3<\x9bc\\\x15<\x9bd[\x88<\x9be^0<\x9bf^\x02<\x9b\xa1H\xdb<\x9b\xa2" +
+	"\x8az<\x9b\xa3R\xd9<\x9b\xa4XU<\x9b\xa5Z\xfd<\x9b\xa6]\xda<\x9b\xe2G\xea<\x9b\xe3L\xa3" +
+	"<\x9b\xe4VB<\x9b\xe5X\xea<\x9b\xe6[\xc7<\x9c#\x12\xc0<\x9c$?\xa7<\x9c%?9<\x9c" +
+	"&>\xbd<\x9cd j<\x9ceNc<\x9cfP\xc8<\x9c\xa5!\"<\x9c\xa6Q\x9e<\x9c\xe6!" +
+	"\xe2<\xa4\x03N\xb6<\xa4\x04\x8e\xe2<\xa4\x05\x92O<\xa4\x06\x93\xb5<\xa4\a\x95O<\xa4\b\x99m<" +
+	"\xa4\t\x99%<\xa4\vZ+<\xa4\fZ\xc6<\xa4C\x8e\xe2<\xa4DM@<\xa4E\x8e\xd4<\xa4F" +
+	"\x90:<\xa4G\x91\xd3<\xa4H\x95\xf1<\xa4I\x99\x06<\xa4KY\xeb<\xa4LZ\x85<\xa4\x83\x92M" +
+	"<\xa4\x84\x8e\xd2<\xa4\x85L\xcd<\xa4\x86\x8d\xb6<\xa4\x87\x8e\x87<\xa4\x88\x92\x7f<\xa4\x89\x95\x94<\xa4" +
+	"\x8bZ\xf4<\xa4\x8c[\x8f<\xa4Ó\xb4<\xa4Đ9<\xa4ō\xb7<\xa4\xc6J\xde<\xa4Ǌ" +
+	"\xa9<\xa4Ȏ\xbe<\xa4ɑ\xd2<\xa4\xcb[y<\xa4\xcc[M<\xa5\x03\x95L<\xa5\x04\x91\xd1<" +
+	"\xa5\x05\x8e\x86<\xa5\x06\x8a\xa7<\xa5\aHG<\xa5\b\x8a\xe3<\xa5\t\x8d\xf7<\xa5\vX*<\xa5\f" +
+	"[%<\xa5C\x99j<\xa5D\x95\xef<\xa5E\x92~<\xa5F\x8e\xbc<\xa5G\x8a\xe2<\xa5HGP" +
+	"<\xa5I\x8bG<\xa5KV\x00<\xa5LX\xfb<\xa5\x83\x99 <\xa5\x84\x99\x02<\xa5\x85\x95\x91<\xa5" +
+	"\x86\x91\xce<\xa5\x87\x8d\xf5<\xa5\x88\x8bF<\xa5\x89EK<\xa5\x8bR\x06<\xa5\x8cU\x01<\xa5\u0080" +
+	"\x00<\xa5\xc3w\xcc<\xa5\xc4p\x02<\xa5\xc5g\x81<\xa5\xc6\\2<\xa5\xc7R\x13<\xa5\xc8K\x82<" +
+	"\xa5\xc9E<<\xa5\xcb;6<\xa5\xcc:\xbb<\xa6\x02@7<\xa6\x03[\xe7<\xa6\x04[\xa7<\xa6\x05" +
+	"\\\xb2<\xa6\x06]6<\xa6\aY\xea<\xa6\bW\xc3<\xa6\tS\xcd<\xa6\v\x1c\x06<\xa6\fM\xae" +
+	"<\xa6B?\xe0<\xa6C\\\x80<\xa6D\\@<\xa6E]K<\xa6F]\b<\xa6G\\\xe2<\xa6" +
+	"HZ\xbb<\xa6IV\xc4<\xa6KM\xad<\xa6L\x1c\xcb<\xa7\v@3<\xa7\f?\xde<\xa7\x17\x7f" +
+	"\x1b<\xa7\x18@=<\xa7\x19?\xe6<\xa7CP\x15<\xa7D\x8f\xa9<\xa7E\x93\a<\xa7F\x94g<" +
+	"\xa7G\x95\xfa<\xa7H\x9a\n<\xa7I\x99\xc3<\xa7K[u<\xa7L\\\f<\xa7PNC<\xa7Q" +
+	"\x8d\xfa<\xa7R\x91W<\xa7S\x92\xb7<\xa7T\x94H<\xa7U\x98X<\xa7V\x98\x0f<\xa7Wv\xfd" +
+	"<\xa7X[\x80<\xa7Y\\\x16<\xa7\x83\x8f\xa8<\xa7\x84N\x9f<\xa7\x85\x8f\x98<\xa7\x86\x90\xf8<\xa7" +
+	"\x87\x92\x8b<\xa7\x88\x96\x9a<\xa7\x89\x99\xa4<\xa7\x8b[5<\xa7\x8c[\xcd<\xa7\x90\x8d\xfa<\xa7\x91L" +
+	"\xcc<\xa7\x92\x8d\xe7<\xa7\x93\x8fG<\xa7\x94\x90\xd8<\xa7\x95\x94\xe8<\xa7\x96\x97\xef<\xa7\x97oP<" +
+	"\xa7\x98[A<\xa7\x99[\xd6<\xa7Ó\x04<\xa7ď\x95<\xa7\xc5N)<\xa7Ǝy<\xa7\xc7" +
+	"\x8fG<\xa7ȓ1<\xa7ɖ;<\xa7\xcb\\9<\xa7\xcc\\\xd0<\xa7БU<\xa7э\xe5" +
+	"<\xa7\xd2LU<\xa7ӌ\xc9<\xa7ԍ\x94<\xa7Ց\x7f<\xa7֔\x86<\xa7\xd7f\xeb<\xa7" +
+	"\xd8\\E<\xa7\xd9\\\xda<\xa8\x03\x94c<\xa8\x04\x90\xf4<\xa8\x05\x8ey<\xa8\x06L=<\xa8\a\x8b" +
+	"x<\xa8\b\x8f\x7f<\xa8\t\x92\x88<\xa8\v\\\xba<\xa8\f\\\x8e<\xa8\x10\x92\xb4<\xa8\x11\x8fD<" +
+	"\xa8\x12\x8c\xc8<\xa8\x13Jk<\xa8\x14\x89\xc4<\xa8\x15\x8d\xca<\xa8\x16\x90\xd2<\xa8\x17[\xc4<\xa8\x18" +
+	"\\\xc6<\xa8\x19\\\x98<\xa8C\x95\xf4<\xa8D\x92\x86<\xa8E\x8fE<\xa8F\x8bv<\xa8GI\xac" +
+	"<\xa8H\x8b\xb5<\xa8I\x8e\xbe<\xa8KYy<\xa8L\\f<\xa8P\x94E<\xa8Q\x90\xd5<\xa8" +
+	"R\x8d\x93<\xa8S\x89\xc4<\xa8TG\xd8<\xa8U\x89\xff<\xa8V\x8d\x06<\xa8WQ\xc6<\xa8XY" +
+	"\x85<\xa8Y\\p<\xa8\x83\x9a\x03<\xa8\x84\x96\x94<\xa8\x85\x93.<\xa8\x86\x8f|<\xa8\x87\x8b\xb3<" +
+	"\xa8\x88H\xb4<\xa8\x89\x8c\x1a<\xa8\x8bWX<\xa8\x8cZE<\xa8\x90\x98S<\xa8\x91\x94\xe3<\xa8\x92" +
+	"\x91|<\xa8\x93\x8d\xc9<\xa8\x94\x89\xfd<\xa8\x95F\xe1<\xa8\x96\x8a`<\xa8\x97KH<\xa8\x98Wd" +
+	"<\xa8\x99ZO<\xa8Ù\xbb<\xa8ę\x9c<\xa8Ŗ6<\xa8ƒ\x83<\xa8ǎ\xbb<\xa8" +
+	"Ȍ\x18<\xa8\xc9F\xb4<\xa8\xcbSp<\xa8\xccV]<\xa8И\v<\xa8ї\xeb<\xa8Ҕ" +
+	"\x84<\xa8Ӑ\xd1<\xa8ԍ\x05<\xa8Պ`<\xa8\xd6D\xdf<\xa8\xd7E\x13<\xa8\xd8S{<" +
+	"\xa8\xd9Vg<\xa9\x03x\xe8<\xa9\x04q<<\xa9\x05h\xda<\xa9\x06]\xb4<\xa9\aS\xb8<\xa9\b" +
+	"M;<\xa9\tG\a<\xa9\v=\n<\xa9\f<\x8f<\xa9\x10w\x1e<\xa9\x11op<\xa9\x12g\f" +
+	"<\xa9\x13[\xe7<\xa9\x14Q\xe8<\xa9\x15Kk<\xa9\x16E5<\xa9\x17\x0f\x99<\xa9\x18=\x18<\xa9" +
+	"\x19<\x9b<\xa9BA\xdf<\xa9C]+<\xa9D\\\xec<\xa9E]\xf3<\xa9F^u<\xa9G[" +
+	"8<\xa9HY\x1a<\xa9IU5<\xa9K\x1e\x01<\xa9LO0<\xa9P[{<\xa9Q[;<" +
+	"\xa9R\\A<\xa9S\\\xc4<\xa9TY\x83<\xa9UWc<\xa9VSz<\xa9W<\xf6<\xa9X" +
+	"\x1e\r<\xa9YO:<\xa9\x82A\x87<\xa9\x83]\xc0<\xa9\x84]\x82<\xa9\x85^\x88<\xa9\x86^G" +
+	"<\xa9\x87^!<\xa9\x88\\\x03<\xa9\x89X\x1e<\xa9\x8bO.<\xa9\x8c\x1e\xc4<\xa9\x90\\\x10<\xa9" +
+	"\x91[\xd1<\xa9\x92\\\xd6<\xa9\x93\\\x96<\xa9\x94\\n<\xa9\x95ZN<\xa9\x96Ve<\xa9\x97<" +
+	"z<\xa9\x98O9<\xa9\x99\x1e\xcf<\xaad\x80\x00<\xaaeA\xe5<\xaafA\x8d<\xaa\x9dO\xa2<" +
+	"\xaa\x9e\x8e\xc1<\xaa\x9f\x92\r<\xaa\xa0\x93f<\xaa\xa1\x94\xf1<\xaa\xa2\x98\xf1<\xaa\xa3\x98\xa9<\xaa\xa4" +
+	"x\x1a<\xaa\xa5\\\xc4<\xaa\xa6]V<\xaa\xdeN+<\xaaߎ\xa9<\xaa\xe0\x90\x02<\xaaᑍ" +
+	"<\xaa╌<\xaa㘊<\xaa\xe4p\x8a<\xaa\xe5\\\x86<\xaa\xe6]\x18<\xab\x1fM\xb2<\xab" +
+	" \x8d\x8b<\xab!\x8eS<\xab\"\x92.<\xab#\x95+<\xab$hD<\xab%]\x86<\xab&^" +
+	"\x18<\xab`K\xc9<\xaba\x8a\x94<\xabb\x8e\x8a<\xabc\x91\x87<\xabd]F<\xabe^\x05<" +
+	"\xabf]\xd7<\xab\xa1I=<\xab\xa2\x8a\xcf<\xab\xa3\x8d\xcc<\xab\xa4Sk<\xab\xa5Z\xd2<\xab\xa6" +
+	"]\xaf<\xab\xe2HE<\xab\xe3\x8b3<\xab\xe4M\x01<\xab\xe5X\xbb<\xab\xe6[\x98<\xac#FH" +
+	"<\xac$F\xdd<\xac%T\xe3<\xac&W\xc0<\xacd\x11\xdd<\xace>\xca<\xacf>N<\xac" +
+	"\xa5 \b<\xac\xa6P\xbb<\xac\xe6 \xc8<\xb4\x03N\x03<\xb4\x04\x8f,<\xb4\x05\x91\xae<\xb4\x06\x94" +
+	"9<\xb4\a\x95\t<\xb4\b\x99\x01<\xb4\t\x98\xc1<\xb4\n\x99\xa3<\xb4\fY\xdf<\xb4C\x8f+<" +
+	"\xb4DLx<\xb4E\x8e2<\xb4F\x90\xbe<\xb4G\x91\x8d<\xb4H\x95\x86<\xb4I\x98\xa1<\xb4J" +
+	"\x98\x9a<\xb4LY\x9f<\xb4\x83\x91\xad<\xb4\x84\x8e3<\xb4\x85K\xce<\xb4\x86\x8d[<\xb4\x87\x8e*" +
+	"<\xb4\x88\x92#<\xb4\x89\x95><\xb4\x8a\x98\x93<\xb4\x8cZj<\xb4Ô8<\xb4Đ\xbd<\xb4" +
+	"ōZ<\xb4\xc6KK<\xb4ǋ\x9d<\xb4Ȏ\xea<\xb4ɑ\xdf<\xb4ʕ4<\xb4\xcc[" +
+	"7<\xb5\x03\x95\b<\xb5\x04\x91\x8d<\xb5\x05\x8e)<\xb5\x06\x8b\x9c<\xb5\aH\xa7<\xb5\b\x8b\x0e<" +
+	"\xb5\t\x8e\x04<\xb5\n\x91Y<\xb5\fZ\xf2<\xb5C\x99\x00<\xb5D\x95\x85<\xb5E\x92\"<\xb5F" +
+	"\x8e\xe9<\xb5G\x8b\x0e<\xb5HG\xa8<\xb5I\x8bR<\xb5J\x8e\xa8<\xb5LX\xc9<\xb5\x83\x98\xbe" +
+	"<\xb5\x84\x98\x9f<\xb5\x85\x95;<\xb5\x86\x91\xdd<\xb5\x87\x8e\x02<\xb5\x88\x8bQ<\xb5\x89E\x9e<\xb5" +
+	"\x8a\x8a\xbe<\xb5\x8cT\xca<\xb5Ù\x9e<\xb5Ę\x96<\xb5Ř\x8f<\xb5ƕ1<\xb5Ǒ" +
+	"V<\xb5Ȏ\xa4<\xb5Ɋ\xbc<\xb5\xcaEe<\xb5\xccU\b<\xb6\x02\x80\x00<\xb6\x03w\xcc<" +
+	"\xb6\x04p\x02<\xb6\x05f\xeb<\xb6\x06]\xe8<\xb6\aS\r<\xb6\bL\b<\xb6\tE\x9b<\xb6\n" +
+	"Bw<\xb6\f:G<\xb6B>\xdf<\xb6C[\x9b<\xb6D[[<\xb6E\\&<\xb6F\\\xf3" +
+	"<\xb6G\\\xaf<\xb6HZ\x87<\xb6IV\x8d<\xb6JV\xcc<\xb6L\x1b\xa8<\xb7\f>\xdf<\xb7" +
+	"\x18\x7f\x1b<\xb7\x19>\xe9<\xb7COe<\xb7D\x8f\xf3<\xb7E\x92j<\xb7F\x94\xeb<\xb7G\x95" +
+	"\xb7<\xb7H\x99\xa1<\xb7I\x99b<\xb7J\x9aA<\xb7L[)<\xb7PM\x93<\xb7Q\x8eD<" +
+	"\xb7R\x90\xbb<\xb7S\x93;<\xb7T\x94\a<\xb7U\x97\xf1<\xb7V\x97\xaf<\xb7W\x98\x8d<\xb7X" +
+	"v\xfd<\xb7Y[4<\xb7\x83\x8f\xf3<\xb7\x84M\xdb<\xb7\x85\x8e\xfa<\xb7\x86\x91{<\xb7\x87\x92G" +
+	"<\xb7\x88\x962<\xb7\x89\x99B<\xb7\x8a\x99;<\xb7\x8cZ\xe9<\xb7\x90\x8eD<\xb7\x91L\t<\xb7" +
+	"\x92\x8dK<\xb7\x93\x8f\xcb<\xb7\x94\x90\x97<\xb7\x95\x94\x81<\xb7\x96\x97\x90<\xb7\x97\x97\x87<\xb7\x98o" +
+	"P<\xb7\x99Z\xf5<\xb7Òh<\xb7Ď\xf9<\xb7\xc5M.<\xb7Ǝ\"<\xb7ǎ\xee<" +
+	"\xb7Ȓ\xd9<\xb7ɕ\xe9<\xb7ʙ2<\xb7\xcc[\xb0<\xb7А\xba<\xb7эJ<\xb7\xd2" +
+	"K\\<\xb7ӌr<\xb7ԍ=<\xb7Ց(<\xb7֔6<\xb7ח~<\xb7\xd8fZ" +
+	"<\xb7\xd9[\xbc<\xb8\x03\x94\xe6<\xb8\x04\x91x<\xb8\x05\x8e <\xb8\x06L\xa9<\xb8\a\x8cg<\xb8" +
+	"\b\x8f\xa8<\xb8\t\x92\x93<\xb8\n\x95\xdc<\xb8\f\\x<\xb8\x10\x938<\xb8\x11\x8f\xc8<\xb8\x12\x8c" +
+	"q<\xb8\x13J\xd7<\xb8\x14\x8a\xb6<\xb8\x15\x8d\xf7<\xb8\x16\x90\xe0<\xb8\x17\x94(<\xb8\x18]t<" +
+	"\xb8\x19\\\x84<\xb8C\x95\xb2<\xb8D\x92C<\xb8E\x8e\xec<\xb8F\x8cg<\xb8GJ\f<\xb8H" +
+	"\x8b\xde<\xb8I\x8e\xc9<\xb8J\x92\x12<\xb8L\\4<\xb8P\x94\x03<\xb8Q\x90\x93<\xb8R\x8d<" +
+	"<\xb8S\x8a\xb6<\xb8TH:<\xb8U\x8a+<\xb8V\x8d\x14<\xb8W\x90\\<\xb8XR\xbe<\xb8" +
+	"Y\\@<\xb8\x83\x99\x9b<\xb8\x84\x96,<\xb8\x85\x92\xd5<\xb8\x86\x8f\xa6<\xb8\x87\x8b\xdc<\xb8\x88I" +
+	"\f<\xb8\x89\x8c\"<\xb8\x8a\x8fl<\xb8\x8cZ\x13<\xb8\x90\x97\xec<\xb8\x91\x94|<\xb8\x92\x91%<" +
+	"\xb8\x93\x8d\xf6<\xb8\x94\x8a*<\xb8\x95G:<\xb8\x96\x8al<\xb8\x97\x8d\xb4<\xb8\x98K\xcd<\xb8\x99" +
+	"Z\x1f<\xb8ÙZ<\xb8ę<<\xb8ŕ\xe4<\xb8ƒ\x90<\xb8ǎ\xc6<\xb8Ȍ!" +
+	"<\xb8\xc9G\x06<\xb8ʋ\x94<\xb8\xccV&<\xb8З\xab<\xb8ї\x8b<\xb8Ҕ4<\xb8" +
+	"Ӑ\xdf<\xb8ԍ\x13<\xb8Պm<\xb8\xd6E3<\xb8\u05c9\xdb<\xb8\xd8Eq<\xb8\xd9V" +
+	"2<\xb9\x03\x9a8<\xb9\x04\x993<\xb9\x05\x99,<\xb9\x06\x95\xd8<\xb9\a\x92\r<\xb9\b\x8fi<" +
+	"\xb9\t\x8b\x93<\xb9\nF\xcd<\xb9\fVc<\xb9\x10\x98\x89<\xb9\x11\x97\x83<\xb9\x12\x97|<\xb9\x13" +
+	"\x94'<\xb9\x14\x90[<\xb9\x15\x8d\xb5<\xb9\x16\x89\xdb<\xb9\x17D\xf8<\xb9\x18BS<\xb9\x19Vo" +
+	"<\xb9Cx\xe8<\xb9Dq<<\xb9EhG<\xb9F_d<\xb9GT\xaf<\xb9HM\xbf<\xb9" +
+	"IGd<\xb9JDH<\xb9L<\x1d<\xb9Pw\x1e<\xb9Qop<\xb9Rf{<\xb9S]" +
+	"\x97<\xb9TR\xe1<\xb9UK\xf0<\xb9VE\x94<\xb9WBu<\xb9X\x0e\xcf<\xb9Y<+<" +
+	"\xb9\x82@\x8a<\xb9\x83\\\xde<\xb9\x84\\\xa0<\xb9\x85]h<\xb9\x86^3<\xb9\x87]\xef<\xb9\x88" +
+	"[\xd1<\xb9\x89W\xe8<\xb9\x8aX&<\xb9\x8c\x1d\xa5<\xb9\x90[.<\xb9\x91Z\xef<\xb9\x92[\xb8" +
+	"<\xb9\x93\\\x82<\xb9\x94\\><\xb9\x95Z\x1e<\xb9\x96V1<\xb9\x97Vm<\xb9\x98<\t<\xb9" +
+	"\x99\x1d\xb1<\xbae\x80\x00<\xbaf@\x94<\xba\x9dN\xf6<\xba\x9e\x8f\f<\xba\x9f\x91v<\xba\xa0\x93" +
+	"\xea<\xba\xa1\x94\xb2<\xba\xa2\x98\x8c<\xba\xa3\x98L<\xba\xa4\x99'<\xba\xa5x\x1a<\xba\xa6\\x<" +
+	"\xba\xdeMl<\xbaߎ\x12<\xba\xe0\x90\x86<\xba\xe1\x91N<\xba\xe2\x95(<\xba\xe3\x98-<\xba\xe4" +
+	"\x98$<\xba\xe5p\x8a<\xba\xe6\\9<\xbb\x1fL\xbd<\xbb \x8d8<\xbb!\x8e\x00<\xbb\"\x91\xdb" +
+	"<\xbb#\x94\xdf<\xbb$\x98\x1b<\xbb%g\xb5<\xbb&\\\xfe<\xbb`L5<\xbba\x8b\x80<\xbb" +
+	"b\x8e\xb4<\xbbc\x91\x93<\xbbd\x94\xcf<\xbbe^\xf0<\xbbf]\xc3<\xbb\xa1I\x9e<\xbb\xa2\x8a" +
+	"\xf9<\xbb\xa3\x8d\xd8<\xbb\xa4\x91\x14<\xbb\xa5T`<\xbb\xa6]\x80<\xbb\xe2H\x9e<\xbb\xe3\x8b=<" +
+	"\xbb\xe4\x8ey<\xbb\xe5M\x84<\xbb\xe6[h<\xbc#F\x9b<\xbc$\x8a\xb2<\xbc%G;<\xbc&" +
+	"W\x8d<\xbcdF`<\xbceD$<\xbcfW\xc8<\xbc\xa5\x11\x15<\xbc\xa6=\xdf<\xbc\xe6\x1f\xaf" +
+	"<\xc4\x03MM<\xc4\x04\x8fG<\xc4\x05\x91\xc9<\xc4\x06\x93i<\xc4\a\x95^<\xc4\b\x98\x8c<\xc4" +
+	"\t\x98&<\xc4\n\x99\b<\xc4\v\x99\xef<\xc4C\x8fF<\xc4DK\xaf<\xc4E\x8eM<\xc4F\x8f" +
+	"\xed<\xc4G\x91\xe2<\xc4H\x95\x11<\xc4I\x98\x06<\xc4J\x97\xff<\xc4K\x98\xe6<ă\x91\xc8<" +
+	"Ą\x8eM<ąJ\xf4<Ć\x8c\x8a<ć\x8e\x7f<Ĉ\x91\xae<ĉ\x94\xa3<Ċ" +
+	"\x97\xf9<ċ\x97\xf6<\xc4Ój<\xc4ď\xef<\xc4Ō\x8b<\xc4\xc6J<<\xc4ǋ\x13" +
+	"<\xc4Ȏ^<\xc4ɑT<\xc4ʔ\xa9<\xc4˘\x02<\xc5\x03\x95]<\xc5\x04\x91\xe2<\xc5" +
+	"\x05\x8e\x7f<\xc5\x06\x8b\x11<\xc5\aI\a<\xc5\b\x8b\xd4<\xc5\t\x8d\xdb<\xc5\n\x910<\xc5\v\x94" +
+	"\x8a<\xc5C\x98\x8d<\xc5D\x95\x12<\xc5E\x91\xaf<\xc5F\x8e^<\xc5G\x8b\xd5<\xc5HG\xff<" +
+	"\xc5I\x8b)<\xc5J\x8e\x7f<\xc5K\x91\xd8<Ń\x98%<ń\x98\x06<Ņ\x94\xa3<ņ" +
+	"\x91R<Ň\x8d\xdb<ň\x8b(<ŉE\xee<Ŋ\x8a\x93<ŋ\x8d\xec<\xc5Ù\x06" +
+	"<\xc5ė\xfd<\xc5ŗ\xf6<\xc5Ɣ\xa5<\xc5Ǒ.<\xc5Ȏ{<\xc5Ɋ\x91<\xc5" +
+	"\xcaE\xb3<\xc5ˎ\xd1<\xc6\x03\x99\xeb<\xc6\x04\x98\xe2<\xc6\x05\x97\xf2<\xc6\x06\x97\xfd<\xc6\a\x94" +
+	"\x86<\xc6\b\x91\xd3<\xc6\t\x8d\xe9<\xc6\n\x8e\xd0<\xc6\vE\xa5<\xc6B\x80\x00<\xc6Cw\xcc<" +
+	"\xc6Dp\x02<\xc6Ef\xeb<\xc6F]R<\xc6GT\x8a<\xc6HL\xd7<\xc6IF\x12<\xc6J" +
+	"B\xd6<\xc6K@a<\xc7\x19\x7f\x1b<\xc7CN\xb3<\xc7D\x90\x0f<\xc7E\x92\x86<\xc7F\x94\x1f" +
+	"<\xc7G\x96\f<\xc7H\x99/<\xc7I\x98\xca<\xc7J\x99\xaa<\xc7K\x9a\x8d<\xc7PL\xe1<\xc7" +
+	"Q\x8e`<\xc7R\x90\xd7<\xc7S\x92q<\xc7T\x94\\<\xc7U\x97\x81<\xc7V\x97\x1a<\xc7W\x97" +
+	"\xf8<\xc7X\x98\xda<\xc7Yv\xfd<ǃ\x90\x0e<ǄM\x16<ǅ\x8f\x16<ǆ\x90\xaf<" +
+	"Ǉ\x92\x9c<ǈ\x95\xc0<ǉ\x98\xab<Ǌ\x98\xa4<ǋ\x99\x87<ǐ\x8e`<Ǒ" +
+	"KD<ǒ\x8dg<Ǔ\x8f\x01<ǔ\x90\xec<Ǖ\x94\x11<ǖ\x96\xfb<Ǘ\x96\xf2" +
+	"<ǘ\x97\xd4<ǙoP<\xc7Ò\x84<\xc7ď\x15<\xc7\xc5LX<\xc7ƍV<\xc7" +
+	"ǏC<\xc7Ȓg<\xc7ɕR<\xc7ʘ\x9b<\xc7˘\x98<\xc7А\xd5<\xc7э" +
+	"e<\xc7\xd2J\x86<\xc7Ӌ\xa8<\xc7ԍ\x93<\xc7Ր\xb8<\xc7֓\xa1<\xc7ז\xe9<" +
+	"\xc7ؖ\xe5<\xc7\xd9fZ<\xc8\x03\x94\x1c<\xc8\x04\x90\xad<\xc8\x05\x8dU<\xc8\x06K\x9f<\xc8\a" +
+	"\x8b\xe1<\xc8\b\x8f <\xc8\t\x92\f<\xc8\n\x95U<\xc8\v\x98\xa2<\xc8\x10\x92n<\xc8\x11\x8e\xfe" +
+	"<\xc8\x12\x8b\xa6<\xc8\x13I\xce<\xc8\x14\x8a0<\xc8\x15\x8dq<\xc8\x16\x90[<\xc8\x17\x93\xa3<\xc8" +
+	"\x18\x96\xef<\xc8\x19\\\xe2<\xc8C\x96\a<\xc8D\x92\x98<\xc8E\x8fA<\xc8F\x8b\xdf<\xc8GJ" +
+	"k<\xc8H\x8c\x9e<\xc8I\x8e\x9e<\xc8J\x91\xe7<\xc8K\x955<\xc8P\x94Y<\xc8Q\x90\xe9<" +
+	"\xc8R\x8d\x91<\xc8S\x8a0<\xc8TH\x99<\xc8U\x8a\xee<\xc8V\x8c\xed<\xc8W\x905<\xc8X" +
+	"\x93\x81<\xc8YT6<ȃ\x99+<Ȅ\x95\xbc<ȅ\x92d<Ȇ\x8f\x1f<ȇ\x8c\x9e" +
+	"<ȈIb<ȉ\x8b\xf8<Ȋ\x8fA<ȋ\x92\x8e<Ȑ\x97|<ȑ\x94\f<\xc8" +
+	"\x92\x90\xb5<ȓ\x8dp<Ȕ\x8a\xed<ȕG\x91<Ȗ\x8aE<ȗ\x8d\x8d<Ș\x90" +
+	"\xd9<șL\x9b<\xc8Ø\xc4<\xc8Ę\xa6<\xc8ŕN<\xc8ƒ\b<\xc8ǎ\x9d<" +
+	"\xc8ȋ\xf6<\xc8\xc9GV<\xc8ʋg<\xc8ˎ\xb5<\xc8З\x15<\xc8і\xf6<\xc8\xd2" +
+	"\x93\x9e<\xc8ӐZ<\xc8Ԍ\xec<\xc8ՊE<\xc8\xd6E\x84<\xc8\u05c9\xb2<\xc8،\xfe" +
+	"<\xc8\xd9E\xe8<\xc9\x03\x99\xa2<\xc9\x04\x98\x9d<\xc9\x05\x98\x96<\xc9\x06\x95P<\xc9\a\x91\xe4<\xc9" +
+	"\b\x8f><\xc9\t\x8bf<\xc9\nG\x1a<\xc9\v\x8f\x97<\xc9\x10\x97\xf3<\xc9\x11\x96\xed<\xc9\x12\x96" +
+	"\xe6<\xc9\x13\x93\xa2<\xc9\x14\x904<\xc9\x15\x8d\x8d<\xc9\x16\x89\xb2<\xc9\x17EG<\xc9\x18\x8d\xe0<" +
+	"\xc9\x19B\xb2<\xc9C\x9a\x84<\xc9D\x99\x7f<\xc9E\x98\x91<\xc9F\x98\x9c<\xc9G\x950<\xc9H" +
+	"\x92\x8a<\xc9I\x8e\xb2<\xc9J\x8f\x95<\xc9KG\f<\xc9P\x98\xd5<\xc9Q\x97\xcf<\xc9R\x96\xe2" +
+	"<\xc9S\x96\xee<\xc9T\x93\x80<\xc9U\x90\xd9<\xc9V\x8c\xfe<\xc9W\x8d\xe0<\xc9XE7<\xc9" +
+	"Y@A<Ƀx\xe8<Ʉq<<ɅhG<Ɇ^\xd1<ɇV'<ɈN" +
+	"\x8c<ɉG\xda<ɊD\xa6<ɋB6<ɐw\x1e<ɑop<ɒf{<" +
+	"ɓ]\x05<ɔTY<ɕL\xbf<ɖF\v<ɗB\xd4<ɘ@c<ə" +
+	"\x0e\x1f<\xcaf\x80\x00<ʝNG<ʞ\x8f)<ʟ\x91\x93<ʠ\x93$<ʡ\x95\a" +
+	"<ʢ\x98\x1f<ʣ\x97\xba<ʤ\x98\x94<ʥ\x99s<ʦx\x1a<\xca\xdeL\xaa<\xca" +
+	"ߎ/<\xca\xe0\x8f\xc0<\xcaᑣ<\xca┻<\xca㗚<\xca䗒<\xca\xe5\x98" +
+	"p<\xca\xe6p\x8a<\xcb\x1fK\xeb<\xcb \x8cr<\xcb!\x8eU<\xcb\"\x91m<\xcb#\x94M<" +
+	"\xcb$\x97\x89<\xcb%\x97\x84<\xcb&g\xb5<\xcb`K0<\xcba\x8a\xfe<\xcbb\x8e2<\xcbc" +
+	"\x91\x12<\xcbd\x94N<\xcbe\x97\x8e<\xcbf^a<ˡI\xfd<ˢ\x8b\xb7<ˣ\x8d\xaf" +
+	"<ˤ\x90\xeb<˥\x94+<˦U\xd2<\xcb\xe2H\xf4<\xcb\xe3\x8b\x13<\xcb\xe4\x8eO<\xcb" +
+	"呐<\xcb\xe6NO<\xcc#F\xec<\xcc$\x8a\x86<\xcc%\x8d\xc6<\xcc&G\xb0<\xccdF" +
+	"\xae<\xcce\x8e\xa5<\xccfD\x82<̥F\x9e<̦B\x17<\xcc\xe6\x10h@4D-\xa5@" +
+	"4E\x866@4F\x87%@4G\x87\xfb@4H\x88\x1a@4I\x8bB@4J\x8b\x01@4K" +
+	"\x8b\xac@4L\x8cX@4\x85-\xdb@4\x86\x83\xbd@4\x87\x84\x95@4\x88\x84\xb7@4\x89\x87\xe2" +
+	"@4\x8a\x8a\xbf@4\x8b\x8a\x80@4\x8c\x8b,@4\xc6./@4ǁ@@4ȁd@4" +
+	"Ʉ\x92@4ʇr@4ˊQ@4̊\x14@5\a.\x90@5\b}\xf3@5\t\x81" +
+	"A@5\n\x84#@5\v\x87\x04@5\f\x89\xe5@5H. @5I}\xce@5J\x80\xb3@" +
+	"5K\x83\x97@5L\x86z@5\x89/\x00@5\x8a}\xb0@5\x8b\x80\x96@5\x8c\x83}@5\xca" +
+	".\xff@5ˁ\x80@5̄f@6\v/\x0e@6\f\x85O@6L/%@7D\xa2T" +
+	"@7E\xa5y@7F\xa8\x1d@7G\xaa\xbf@7H\xadS@7I\xach@7J\xad\x16@7" +
+	"K\xad\xc6@7L\xaex@7\x84/k@7\x85\x87_@7\x86\x88F@7\x87\x89\x14@7\x88\x89" +
+	".@7\x89\x8cK@7\x8a\x8c\n@7\x8b\x8c\xb1@7\x8c\x8dZ@7ć^@7\xc5/\x9b@" +
+	"7Ƅ\xe8@7ǅ\xb8@7ȅ\xd4@7Ɉ\xf4@7ʋ\xc6@7ˋ\x87@7\xcc" +
+	"\x8c0@8\x04\x88D@8\x05\x84\xe6@8\x06/\xeb@8\a\x82l@8\b\x82\x8c@8\t\x85\xaf" +
+	"@8\n\x88\x83@8\v\x8bV@8\f\x8b\x1a@8D\x89\x10@8E\x85\xb5@8F\x82k@8" +
+	"G0G@8H\x7f%@8I\x82f@8J\x85=@8K\x88\x14@8L\x8a\xe9@8\x84\x89" +
+	"*@8\x85\x85\xd2@8\x86\x82\x8b@8\x87\x7f%@8\x88/\xd7@8\x89\x7f\x00@8\x8a\x81\xd9@" +
+	"8\x8b\x84\xb2@8\x8c\x87\x8b@8ČE@8ň\xf0@8ƅ\xac@8ǂe@8\xc8" +
+	"~\xfe@8\xc90\xb2@8\xca~\xdf@8ˁ\xbb@8̄\x96@9\x04\x8c\x04@9\x05\x8b\xc2" +
+	"@9\x06\x88\x80@9\a\x85<@9\b\x81\xd8@9\t~\xdf@9\n0\xb0@9\v\x82\xa1@9" +
+	"\f\x85|@9D\x8c\xac@9E\x8b\x83@9F\x8bT@9G\x88\x12@9H\x84\xb1@9I\x81" +
+	"\xbb@9J\x82\xa1@9K0\xbe@9L\x86b@9\x84\x8dU@9\x85\x8c,@9\x86\x8b\x17@" +
+	"9\x87\x8a\xe8@9\x88\x87\x89@9\x89\x84\x96@9\x8a\x85|@9\x8b\x86b@9\x8c0\xd5@:\x9d" +
+	"\x80\x00@:\x9e\xa2\xf9@:\x9f\xa6\x10@:\xa0\xa8\xa9@:\xa1\xab>@:\xa2\xad\xc8@:\xa3\xac\xde" +
+	"@:\xa4\xad\x88@:\xa5\xae4@:\xa6\xae\xe3@:\xde12@:߈\x88@:\xe0\x89e@:" +
+	"\xe1\x8a)@:\xe2\x8a>@:\xe3\x8dN@:\xe4\x8d\r@:危@:\xe6\x8eW@;\x1f1" +
+	"\\@; \x86\x11@;!\x86\xd8@;\"\x86\xf0@;#\x8a\x03@;$\x8c\xc9@;%\x8c\x8a@" +
+	";&\x8d0@;`1\xa6@;a\x83\x98@;b\x83\xb3@;c\x86\xc8@;d\x89\x91@;e" +
+	"\x8cY@;f\x8c\x1d@;\xa11\xfe@;\xa2\x80W@;\xa3\x83\x8b@;\xa4\x86W@;\xa5\x89\"" +
+	"@;\xa6\x8b\xec@;\xe21\x8e@;\xe3\x800@;\xe4\x82\xfe@;\xe5\x85\xcc@;戙@<" +
+	"#2d@<$\x80\x0f@<%\x82\xdf@<&\x85\xaf@<d2a@<e\x83\xc2@<f\x86" +
+	"\x92@<\xa52o@<\xa6\x87u@<\xe62\x85@DC\x80\x00@DE[\x91@DFWC@" +
+	"DGS\xee@DHN\xf3@DIPG@DJN[@DKL\xa9@DLKR@D\x83" +
+	"`\x17@D\x85$X@D\x86]\"@D\x87\\\xf1@D\x88\\\xc1@D\x89^Z@D\x8a_\"" +
+	"@D\x8b^\x9a@D\x8c^\x7f@D\xc3[\x10@D\xc5]\"@D\xc6'\x92@D\xc7[\xc1@D" +
+	"\xc8[\xe3@D\xc9]\x8f@D\xca^\x7f@D\xcb_F@D\xcc^\xed@E\x03Xi@E\x05\\" +
+	"\xf1@E\x06[\xc1@E\a*\xbc@E\bY\xff@E\t[\xf1@E\n]\xb5@E\v^~@" +
+	"E\f_t@ECV\xaf@EE\\\xc1@EF[\xe4@EGY\xff@EH,p@EI" +
+	"W\x8e@EJ\\\a@EK\\\xd5@EL]\xcd@E\x83So@E\x85^Z@E\x86]\x8f" +
+	"@E\x87[\xf1@E\x88W\x90@E\x89-\x18@E\x8a[\r@E\x8b\\\x04@E\x8c]\x01@E" +
+	"\xc3R(@E\xc5_ @E\xc6^~@E\xc7]\xb3@E\xc8\\\a@E\xc9[\f@E\xca." +
+	"(@E\xcb]+@E\xcc^(@F\x03P\x89@F\x05^\x99@F\x06_E@F\a^}@" +
+	"F\b\\\xd4@F\t\\\x03@F\n]+@F\v.$@F\f^e@FCOE@FE" +
+	"^~@FF^\xec@FG_r@FH]\xcd@FI\\\xff@FJ^(@FK^e" +
+	"@FL.)@GE_\xa3@GFZ\xac@GGX\x0f@GHV^@GIS'@G" +
+	"JQ\xe6@GKPK@GLO\v@GQ\x7f\x12@GR_\xa0@GSZ\xaa@GTX" +
+	"\x0e@GUV\\@GVS'@GWQ\xe4@GXPJ@GYO\t@G\x85\\\xfc@" +
+	"G\x86X\xbc@G\x87Ut@G\x88P\x8d@G\x89Q\xdc@G\x8aO\xf6@G\x8bNH@G\x8c" +
+	"L\xf4@G\x91#\x86@G\x92]\x03@G\x93X\xc3@G\x94Uy@G\x95P\x90@G\x96Q\xe0" +
+	"@G\x97O\xf9@G\x98NK@G\x99L\xf7@G\xc3a^@G\xc5&9@G\xc6^\x83@G" +
+	"\xc7^L@G\xc8^\x19@G\xc9_\xa7@G\xca`i@G\xcb_\xe1@G\xcc_\xc6@G\xd1\\" +
+	"\xe5@G\xd2&;@G\xd3^\x85@G\xd4^N@G\xd5^\x1a@G\xd6_\xa9@G\xd7`i@" +
+	"G\xd8_\xe2@G\xd9_\xc6@H\x03\\b@H\x05^\x80@H\x06)g@H\a]!@H\b" +
+	"]?@H\t^\xdf@H\n_\xc9@H\v`\x8b@H\f`2@H\x11X\xa1@H\x12^\x82" +
+	"@H\x13)i@H\x14]#@H\x15]@@H\x16^\xe2@H\x17_\xca@H\x18`\x8c@H" +
+	"\x19`3@HCY\xc1@HE^E@HF]\x1e@HG,\x86@HH[c@HI]" +
+	"H@HJ_\x02@HK_\xc7@HL`\xb7@HQUS@HR^G@HS] @" +
+	"HT,\x88@HU[c@HV]J@HW_\x03@HX_\xc7@HY`\xb7@H\x83" +
+	"X\v@H\x85^\x10@H\x86]:@H\x87[a@H\x88.6@H\x89X\xf6@H\x8a]]" +
+	"@H\x8b^%@H\x8c_\x18@H\x91Pg@H\x92^\x10@H\x93];@H\x94[a@H" +
+	"\x95.7@H\x96X\xf9@H\x97]^@H\x98^&@H\x99_\x19@H\xc3T\xd4@H\xc5_" +
+	"\x9c@H\xc6^\xd8@H\xc7]E@H\xc8X\xf6@H\xc9.\xd9@H\xca\\e@H\xcb]W@" +
+	"H\xcc^M@H\xd1Q\xb6@H\xd2_\x9e@H\xd3^\xdb@H\xd4]G@H\xd5X\xf7@H\xd6" +
+	".\xdb@H\xd7\\f@H\xd8]W@H\xd9^N@I\x03S\x91@I\x05`\\@I\x06_\xc0" +
+	"@I\a^\xfd@I\b]\\@I\t\\d@I\n/\xe4@I\v^y@I\f_p@I" +
+	"\x11O\xcf@I\x12`^@I\x13_\xc3@I\x14^\xff@I\x15]\\@I\x16\\f@I\x17/" +
+	"\xe5@I\x18^z@I\x19_q@ICQ\xf7@IE_\xd5@IF`\x82@IG_\xc1@" +
+	"IH^$@II]U@IJ^y@IK/\xe0@IL_\xad@IQN!@IR" +
+	"_\xd7@IS`\x84@IT_\xc4@IU^$@IV]W@IW^z@IX/\xe1" +
+	"@IY_\xad@I\x83P\xb6@I\x85_\xb9@I\x86`)@I\x87`\xb1@I\x88_\x16@I" +
+	"\x89^L@I\x8a_p@I\x8b_\xad@I\x8c/\xe5@I\x91L\xce@I\x92_\xbb@I\x93`" +
+	"+@I\x94`\xb4@I\x95_\x17@I\x96^N@I\x97_q@I\x98_\xad@I\x99/\xe6@" +
+	"J\x9e\x80\x00@J\x9f`\xe7@J\xa0[\xfd@J\xa1Yf@J\xa2W\xb8@J\xa3T\x8d@J\xa4" +
+	"SN@J\xa5Q\xb8@J\xa6P{@J\xde%\x97@J\xdf^P@J\xe0Z\x1a@J\xe1V\xd9" +
+	"@J\xe2R\x01@J\xe3SJ@J\xe4Qi@J\xe5O\xc0@J\xe6Np@K\x1f(\x1c@K" +
+	" _\xe3@K!_\xa2@K\"_i@K#`\xeb@K$a\xa5@K%a\x1e@K&a" +
+	"\x01@K`+>@Ka^\x80@Kb^\x97@Kc`+@Kda\r@Kea\xc9@" +
+	"Kfap@K\xa1.Q@K\xa2\\\xc5@K\xa3^\x9e@K\xa4`M@K\xa5a\f@K\xa6" +
+	"a\xf6@K\xe2/\xfe@K\xe3Z_@K\xe4^\xb2@K\xe5_u@K\xe6`b@L#0\x9b" +
+	"@L$]\xbe@L%^\xaa@L&_\x9b@Ld1\xa1@Le_\xc8@Lf`\xb9@L" +
+	"\xa51\x9d@L\xa6`\xf5@L\xe61\xa2@TDL|@TFbS@TGa9@THa" +
+	"\xa6@TIc=@TJa\x9e@TKb?@TLb$@T\x83\x80\x00@T\x84o\xc7@" +
+	"T\x86QY@T\x87N\xa4@T\x88I\xe6@T\x89KF@T\x8aI\xb0@T\x8bHr@T\x8c" +
+	"G\x1a@T\xc3V\xa9@T\xc4d\x17@T\xc6\"1@T\xc7V{@T\xc8W4@T\xc9X\xe2" +
+	"@T\xcaZ\x16@T\xcb[)@T\xccZ\xd1@U\x03T\x17@U\x04b\xff@U\x06V{@U" +
+	"\a%\x81@U\bV\x18@U\tX\f@U\nYK@U\vZ\x88@U\f[}@UCS" +
+	"F@UDcl@UFW4@UGV\x18@UH(#@UIV<@UJW\x9e@" +
+	"UKY\xa6@ULZ\x9e@U\x83P\x06@U\x84e\x02@U\x86X\xe1@U\x87X\v@U\x88" +
+	"V<@U\x89(\xcc@U\x8aV\xca@U\x8bX\xfc@U\x8cY\xf8@U\xc3M\x9b@U\xc4cb" +
+	"@U\xc6Z\x14@U\xc7YJ@U\xc8W\x9d@U\xc9V\xca@U\xca(d@U\xcbX\xe9@U" +
+	"\xccY\xe6@V\x03M\x02@V\x04d\x02@V\x06['@V\aZ\x85@V\bY\xa4@V\tX" +
+	"\xfa@V\nX\xe8@V\v)\x84@V\f[\r@VCK\xbe@VDc\xe7@VFZ\xce@" +
+	"VG[z@VHZ\x9d@VIY\xf6@VJY\xe4@VK[\r@VL)\x89@WF" +
+	"VT@WGS\xcb@WHS\x00@WIO\xcb@WJMi@WKL\xd2@WLK\x91" +
+	"@WR\x7f\x13@WSVU@WTS\xcd@WUS\x01@WVO\xcc@WWMj@W" +
+	"XL\xd2@WYK\x91@W\x84M\xd3@W\x86c\x95@W\x87bz@W\x88b\xe1@W\x89d" +
+	"m@W\x8ab\xd2@W\x8bco@W\x8ccT@W\x91K\xf6@W\x92o\x13@W\x93c\x9b@" +
+	"W\x94b\x7f@W\x95b\xe4@W\x96dq@W\x97b\xd6@W\x98cq@W\x99cV@W\xc4" +
+	"q\x01@W\xc6R\xe6@W\xc7P=@W\xc8K\x90@W\xc9L\xeb@W\xcaKZ@W\xcbJ\x1e" +
+	"@W\xccH\xca@W\xd1o.@W\xd2\x1d\xd5@W\xd3R\xef@W\xd4PE@W\xd5K\x96@W" +
+	"\xd6L\xf0@W\xd7K`@W\xd8J#@W\xd9H\xcf@X\x03X\f@X\x04eS@X\x06$" +
+	"\x19@X\aW\xee@X\bX\xa0@X\tZD@X\n[p@X\v\\}@X\f\\$@" +
+	"X\x11c\x94@X\x12R\xcf@X\x13$\x1e@X\x14W\xf4@X\x15X\xa4@X\x16ZG@X\x17" +
+	"[t@X\x18\\\x7f@X\x19\\'@XCU\x7f@XDd6@XFW\xeb@XG']" +
+	"@XHW\x89@XIYq@XJZ\xa9@XK[\xde@XL\\\xce@XQbu@X" +
+	"RP @XSW\xf0@XT'c@XUW\x8c@XVYu@XWZ\xad@XX[" +
+	"\xe0@XY\\\xd0@X\x83T\xae@X\x84d\x9b@X\x86X\x9b@X\x87W\x88@X\x88)\xf9@" +
+	"X\x89W\xaa@X\x8aY\x04@X\x8b[\x03@X\x8c[\xf5@X\x91b\xd8@X\x92Ko@X\x93" +
+	"X\x9e@X\x94W\x8a@X\x95)\xfd@X\x96W\xae@X\x97Y\a@X\x98[\x04@X\x99[\xf7" +
+	"@X\xc3Qx@X\xc4f$@X\xc6Z;@X\xc7Yl@X\xc8W\xa9@X\xc9*\x9c@X" +
+	"\xcaX1@X\xcbZY@X\xcc[P@X\xd1dc@X\xd2L\xc8@X\xd3Z@@X\xd4Y" +
+	"q@X\xd5W\xac@X\xd6*\xa0@X\xd7X5@X\xd8Z[@X\xd9[R@Y\x03O\x15@" +
+	"Y\x04d\x88@Y\x06[g@Y\aZ\xa4@Y\bY\x02@Y\tX1@Y\n*5@Y\v" +
+	"ZG@Y\f[>@Y\x11b\xc7@Y\x12K7@Y\x13[l@Y\x14Z\xa9@Y\x15Y\x06" +
+	"@Y\x16X5@Y\x17*9@Y\x18ZI@Y\x19[@@YCN}@YDe$@Y" +
+	"F\\r@YG[\xd7@YHZ\xff@YIZW@YJZE@YK+Q@YL\\" +
+	"`@YQcc@YRI\xfa@YS\\w@YT[\xdc@YU[\x02@YVZ[@" +
+	"YWZI@YX+T@YY\\c@Y\x83M<@Y\x84e\b@Y\x86\\\x1a@Y\x87" +
+	"\\\xc7@Y\x88[\xf2@Y\x89[N@Y\x8a[<@Y\x8b\\`@Y\x8c+V@Y\x91cG" +
+	"@Y\x92H\xa7@Y\x93\\\x1f@Y\x94\\\xcc@Y\x95[\xf5@Y\x96[R@Y\x97[@@Y" +
+	"\x98\\c@Y\x99+X@Z\x9f\x80\x00@Z\xa0W\xb7@Z\xa1U5@Z\xa2Tj@Z\xa3Q" +
+	">@Z\xa4N\xe4@Z\xa5NM@Z\xa6M\x10@Z\xdeMM@Z\xdfpN@Z\xe0d\xd7@" +
+	"Z\xe1c\xb6@Z\xe2d\x13@Z\xe3e\x93@Z\xe4c\xfc@Z\xe5d\x94@Z\xe6dw@[\x1f" +
+	"\x1f\xf6@[ T\\@[!Q\xb8@[\"M\x19@[#Nm@[$L\xe1@[%K\xa7" +
+	"@[&JW@[`&\x06@[aYc@[bZ\v@[c[\xa1@[d\\\xc7@[" +
+	"e]\xcb@[f]r@[\xa1)?@[\xa2X\xfc@[\xa3Z\xd7@[\xa4\\\a@[\xa5]" +
+	"3@[\xa6^\x1d@[\xe2+\xd2@[\xe3Y\x1a@[\xe4Zl@[\xe5\\_@[\xe6]L@" +
+	"\\#,p@\\$Y\x9d@\\%[\xb8@\\&\\\xa9@\\d,\v@\\e[\xa7@\\f" +
+	"\\\x98@\\\xa5-!@\\\xa6]\xb6@\\\xe6-%@dDLx@dE\x8b\xea@dG^N" +
+	"@dH^\xd0@dI`l@dJ_\xa0@dK_\x1c@dL_\xec@d\x84\x8b\xe9@d" +
+	"\x85J\xcf@d\x87\\\t@d\x88\\\x8b@d\x89^(@d\x8a`\x87@d\x8b_3@d\x8c`" +
+	"\x04@dÀ\x00@d\xc4ox@d\xc5d\xf8@d\xc7J\x06@d\xc8E\xbd@d\xc9GH@" +
+	"d\xcaE\xbe@d\xcbD\xd6@d\xccC\xf2@e\x03P+@e\x04`\x13@e\x05]\xd2@e\a" +
+	"!U@e\bQ\xc0@e\tS\xa2@e\nU\x94@e\vW\x14@e\fXV@eCOn" +
+	"@eD`\x96@eE^T@eGQ\xc0@eH$\x1d@eIR\x9a@eJT\xaf@e" +
+	"KV2@eLW\x9d@e\x83L.@e\x84b0@e\x85_\xee@e\x87S\xa1@e\x88R" +
+	"\x9a@e\x89$\xc7@e\x8aS\xdb@e\x8bU\x88@e\x8cV\xf7@e\xc3J\xa8@e\xc4aa@" +
+	"e\xc5bK@e\xc7U\x91@e\xc8T\xad@e\xc9S\xd9@e\xca%M@e\xcbUu@e\xcc" +
+	"W\xad@f\x03H\xeb@f\x04`\xdd@f\x05`\xf7@f\aW\x11@f\bV0@f\tU\x86" +
+	"@f\nUu@f\v$\xf5@f\fW\x9a@fCH\xad@fDa\xac@fEa\xc5@f" +
+	"GXR@fHW\x9a@fIV\xf4@fJW\xab@fKW\x99@fL&\x1e@gGO" +
+	"\xec@gHO6@gIL\x01@gJJ\x82@gKH\xca@gLH\x8d@gS\x7f\x15@" +
+	"gTO\xf1@gUO9@gVL\x04@gWJ\x84@gXH\xcc@gYH\x8e@g\x84" +
+	"M\xd3@g\x85\x8c\xb1@g\x87_\x9a@g\x88`\x16@g\x89a\xa7@g\x8a`\xdb@g\x8b`X" +
+	"@g\x8ca$@g\x91K\xf8@g\x92\x8a\xf6@g\x93n\xc6@g\x94_\xa1@g\x95`\x1b@g" +
+	"\x96a\xac@g\x97`\xe0@g\x98`\\@g\x99a'@gČ\xaf@g\xc5L,@g\xc7]" +
+	"^@g\xc8]\xda@g\xc9_k@g\xcaa\xbe@g\xcb`n@g\xcca:@gъ\xf4@" +
+	"g\xd2JP@g\xd3di@g\xd4]e@g\xd5]\xe0@g\xd6_q@g\xd7a\xc2@g\xd8" +
+	"`r@g\xd9a<@h\x04p\xb4@h\x05f[@h\aK\xad@h\bGu@h\tH\xf9" +
+	"@h\nGt@h\vF\x8e@h\fE\xac@h\x11n\xe2@h\x12d\x87@h\x13\x19j@h" +
+	"\x14K\xb7@h\x15G}@h\x16I\x01@h\x17G{@h\x18F\x94@h\x19E\xb1@hCQ" +
+	"\xa1@hDaW@hE_ @hG#@@hHSA@hIU\x17@hJV\xff@" +
+	"hKXw@hLY\xb2@hQ_\x9a@hR]_@hSK\x94@hT#H@hU" +
+	"SG@hVU\x1d@hWW\x03@hXX{@hYY\xb5@h\x83P\xe5@h\x84a\xd2" +
+	"@h\x85_\x9a@h\x87S@@h\x88&\x01@h\x89T\x16@h\x8aV @h\x8bW\x9b@h" +
+	"\x8cX\xff@h\x91`\x11@h\x92]\xd7@h\x93GX@h\x94SE@h\x95&\a@h\x96T" +
+	"\x1b@h\x97V#@h\x98W\x9f@h\x99Y\x01@h\xc3M\xae@h\xc4c_@h\xc5a'@" +
+	"h\xc7U\x12@h\xc8T\x14@h\xc9&\xa5@h\xcaUM@h\xcbV\xf2@h\xccXY@h\xd1" +
+	"a\xa1@h\xd2_f@h\xd3H\xdb@h\xd4U\x19@h\xd5T\x1a@h\xd6&\xab@h\xd7UQ" +
+	"@h\xd8V\xf6@h\xd9X\\@i\x03L-@i\x04b\x91@i\x05cw@i\aV\xf8@i" +
+	"\bV\x1c@i\tUK@i\n'*@i\vV\xe0@i\fY\r@i\x11`\xd4@i\x12a" +
+	"\xb8@i\x13GT@i\x14V\xff@i\x15V\"@i\x16UQ@i\x17'/@i\x18V\xe4@" +
+	"i\x19Y\x10@iCJu@iDb\r@iEb&@iGXp@iHW\x98@iI" +
+	"V\xf0@iJV\xe0@iK&\xd4@iLX\xfb@iQ`P@iR`g@iSFn" +
+	"@iTXw@iUW\x9d@iVV\xf6@iWV\xe4@iX&\xd8@iYX\xfd@i" +
+	"\x83J7@i\x84b\xd8@i\x85b\xf1@i\x87Y\xaa@i\x88X\xfa@i\x89XV@i\x8aY" +
+	"\v@i\x8bX\xf9@i\x8c'\xf8@i\x91a\x1a@i\x92a2@i\x93E\x8b@i\x94Y\xb1@" +
+	"i\x95X\xff@i\x96X[@i\x97Y\x0f@i\x98X\xfd@i\x99'\xfb@j\xa0\x80\x00@j\xa1" +
+	"Qg@j\xa2P\xb0@j\xa3M\x85@j\xa4L\t@j\xa5JV@j\xa6J\x17@j\xdeMT" +
+	"@jߋ\xbb@j\xe0p\x02@j\xe1`\xe5@j\xe2aW@j\xe3b\xdb@j\xe4b\x10@j" +
+	"\xe5a\x8c@j\xe6bR@k\x1fK\xad@k e\xcd@k!^\xb4@k\"_&@k#`" +
+	"\xaa@k$b\xee@k%a\xa2@k&bh@k`\x1b\x97@kaM<@kbI\x10@" +
+	"kcJ\x8c@kdI\n@keH&@kfGE@k\xa1%3@k\xa2T\xc6@k\xa3" +
+	"V\x8f@k\xa4Xk@k\xa5Y\xdb@k\xa6[\r@k\xe2'\xeb@k\xe3U\x95@k\xe4W\x93" +
+	"@k\xe5Y\a@k\xe6Za@l#(\x89@l$V\xc3@l%X`@l&Y\xbe@l" +
+	"d)\v@leXN@lfZo@l\xa5(\xb7@l\xa6Z^@l\xe6)\xd5@tDL" +
+	"r@tE\x8d\x93@tF\x8f\xfe@tH[\xe6@tI]z@tJ\\\xd4@tK]\x19@" +
+	"tL\\\xc4@t\x84\x8d\x91@t\x85J\xb8@t\x86\x8c+@t\x88Y\x9d@t\x89[2@t\x8a" +
+	"]\xb2@t\x8b]1@t\x8c\\\xdc@tď\xfc@tŌ+@t\xc6I\x1b@t\xc8WA" +
+	"@t\xc9X\xd6@t\xca[V@t\xcb^\x02@t\xcc\\\xdd@u\x03\x80\x00@u\x04o&@u" +
+	"\x05d\xa3@u\x06Z\xe7@u\bAG@u\tC1@u\nA\xc1@u\v@\xe4@u\f@" +
+	"W@uCK~@uD]\xaa@uE[d@uFY\n@uH\x1f\xf1@uIN\x1b@" +
+	"uJP@@uKRw@uLT%@u\x83H-@u\x84_<@u\x85\\\xf7@u\x86" +
+	"Z\x9d@u\x88N\x1b@u\x89 \x9b@u\x8aOl@u\x8bQ\xcc@u\x8cS\x7f@u\xc3F\xcd" +
+	"@u\xc4^\x94@u\xc5_t@u\xc6]\x1b@u\xc8P?@u\xc9Ok@u\xca!H@u" +
+	"\xcbR\x82@u\xccT4@v\x03E\xf4@v\x04^\xd8@v\x05^\xf1@v\x06_\xc3@v\bR" +
+	"t@v\tQ\xc9@v\nR\x81@v\v!\xdf@v\fT\"@vCD\x93@vD^\x83@" +
+	"vE^\x9c@vF^\x9e@vHT\"@vIS|@vJT3@vKT\"@vL" +
+	"!\x8f@wHKS@wIH\r@wJF\xb4@wKE\xdf@wLD\x81@wT\x7f\x17" +
+	"@wUKX@wVH\x13@wWF\xb8@wXE\xe2@wYD\x84@w\x84M\xd2@w" +
+	"\x85\x8e[@w\x86\x90\xbc@w\x88]6@w\x89^\xc0@w\x8a^\x1a@w\x8b^]@w\x8c^" +
+	"\b@w\x91K\xf8@w\x92\x8c\xa1@w\x93\x8f\x01@w\x94nv@w\x95]=@w\x96^\xc7@" +
+	"w\x97^ @w\x98^b@w\x99^\r@wĎX@w\xc5L\x1a@wƌ\xf8@w\xc8" +
+	"Z\xf6@w\xc9\\\x80@w\xca^\xf3@w\xcb^s@w\xcc^\x1e@wь\x9f@w\xd2J@" +
+	"@wӋ;@w\xd4d\x16@w\xd5Z\xfd@w\xd6\\\x87@w\xd7^\xf9@w\xd8^w@w" +
+	"\xd9^#@x\x04\x90\xb7@x\x05\x8c\xf6@x\x06J~@x\bX\xa4@x\tZ-@x\n\\" +
+	"\xa1@x\v_?@x\f^\x1d@x\x11\x8e\xfe@x\x12\x8b:@x\x13H\xa3@x\x14Z{@" +
+	"x\x15X\xab@x\x16Z5@x\x17\\\xa7@x\x18_C@x\x19^\"@xDpd@xE" +
+	"f\t@xF\\o@xHC\x0e@xID\xef@xJC\x84@xKB\xa9@xLB\x1c" +
+	"@xQn\x93@xRd5@xSZ\x9a@xT\x15\x18@xUC\x17@xVD\xf9@x" +
+	"WC\x8c@xXB\xaf@xYB#@x\x83M\x03@x\x84^\xf2@x\x85\\\xb7@x\x86Z" +
+	"h@x\x88!\xe4@x\x89O\xa6@x\x8aQ\xc1@x\x8bS\xed@x\x8cU\x93@x\x91]6@" +
+	"x\x92Z\xf7@x\x93X\xa6@x\x94B\xf4@x\x95!\xec@x\x96O\xae@x\x97Q\xc7@x\x98" +
+	"S\xf1@x\x99U\x98@x\xc3I\xbb@x\xc4`x@x\xc5^=@x\xc6[\xee@x\xc8O\xa5" +
+	"@x\xc9\"\x87@x\xcaP\xef@x\xcbSD@x\xccT\xee@x\xd1^\xbe@x\xd2\\\x7f@x" +
+	"\xd3Z.@x\xd4D\xd4@x\xd5O\xac@x\xd6\"\x90@x\xd7P\xf5@x\xd8SH@x\xd9T" +
+	"\xf2@y\x03H`@y\x04_\xd0@y\x05`\xad@y\x06^^@y\bQ\xbe@y\tP\xed@" +
+	"y\n#2@y\vS\xf7@y\fU\xa1@y\x11^\x16@y\x12^\xf1@y\x13\\\xa0@y\x14" +
+	"Cg@y\x15Q\xc5@y\x16P\xf4@y\x17#9@y\x18S\xfc@y\x19U\xa6@yCG\x89" +
+	"@yD`\x12@yE`+@yF`\xf9@yHS\xe8@yIS@@yJS\xf5@y" +
+	"K#\xc8@yLU\x8f@yQ^X@yR^o@yS_<@yTB\x8a@yUS" +
+	"\xef@yVSH@yWS\xfb@yX#\xcd@yYU\x94@y\x83F+@y\x84_\xbc@" +
+	"y\x85_\xd5@y\x86_\xd7@y\x88U\x8e@y\x89T\xea@y\x8aU\xa0@y\x8bU\x8f@y\x8c" +
+	"#z@y\x91^\x03@y\x92^\x1a@y\x93^\x1a@y\x94A\xfe@y\x95U\x95@y\x96T\xf2" +
+	"@y\x97U\xa6@y\x98U\x94@y\x99#\x7f@z\xa1\x80\x00@z\xa2L\xdd@z\xa3I\xa1@z" +
+	"\xa4HJ@z\xa5Gv@z\xa6F\x1c@z\xdeMX@zߍg@z\xe0\x8f\xbc@z\xe1o" +
+	"\xb4@z\xe2^\x86@z\xe3`\x03@z\xe4_\\@z\xe5_\x9c@z\xe6_F@{\x1fK\xa1@" +
+	"{ \x8c\a@{!e|@{\"\\Q@{#]\xcd@{$`2@{%_\xb1@{&" +
+	"_\\@{`J\a@{a\\\x03@{bZ\t@{c[\x86@{d]\xea@{e`y" +
+	"@{f_[@{\xa1\x17Q@{\xa2D\xba@{\xa3F\x93@{\xa4E*@{\xa5DO@{" +
+	"\xa6C\xc3@{\xe2#\xdf@{\xe3Q8@{\xe4SF@{\xe5Uf@{\xe6W\x04@|#$" +
+	"}@|$Rw@|%T\xbf@|&Va@|d%$@|eUp@|fW\x12@" +
+	"|\xa5%\xb7@|\xa6W\x01@|\xe6%j@\x84DLj@\x84E\x8d\xb2@\x84F\x90\a@\x84G" +
+	"\x92v@\x84IZ\x8c@\x84JY\xfa@\x84KZf@\x84LZ\xdb@\x84\x84\x8d\xb0@\x84\x85J\xa0" +
+	"@\x84\x86\x8c3@\x84\x87\x8e\xa2@\x84\x89XC@\x84\x8aZ\xbc@\x84\x8bZa@\x84\x8cZ\xd6@\x84" +
+	"Đ\x05@\x84Ō2@\x84\xc6H\xf4@\x84Ǌ\xe2@\x84\xc9U\xe3@\x84\xcaX\\@\x84\xcb[" +
+	")@\x84\xccZ\xd6@\x85\x04\x92t@\x85\x05\x8e\xa1@\x85\x06\x8a\xe2@\x85\aGl@\x85\tS\x87@" +
+	"\x85\nV\x01@\x85\vX\xcd@\x85\f[\xa8@\x85C\x80\x00@\x85Dn\xd5@\x85EdK@\x85F" +
+	"Z\x8a@\x85GQU@\x85I>\xcf@\x85J=\xb9@\x85K<\xe5@\x85L<d@\x85\x83D9" +
+	"@\x85\x84\\L@\x85\x85Z\x06@\x85\x86W\xa9@\x85\x87UO@\x85\x89\x1co@\x85\x8aJ\xbb@\x85" +
+	"\x8bM4@\x85\x8cO\x99@\x85\xc3B\xc8@\x85\xc4[\xb9@\x85\xc5\\|@\x85\xc6Z\x1f@\x85\xc7W" +
+	"\xc5@\x85\xc9J\xba@\x85\xca\x1d\x1c@\x85\xcbN\x10@\x85\xccPu@\x86\x03B\x16@\x86\x04\\#@" +
+	"\x86\x05\\\x1f@\x86\x06\\\xe8@\x86\aZ\x8e@\x86\tM1@\x86\nN\x0e@\x86\v\x1d\xd9@\x86\f" +
+	"Q+@\x86CA\x99@\x86D\\\x96@\x86E\\\x92@\x86F\\\x94@\x86G]f@\x86IO\x95" +
+	"@\x86JPr@\x86KQ*@\x86L\x1ey@\x87ID&@\x87JB\xbc@\x87KB\r@\x87" +
+	"LA\x92@\x87U\x7f\x17@\x87VD-@\x87WB\xc2@\x87XB\x11@\x87YA\x95@\x87\x84M" +
+	"\xce@\x87\x85\x8e~@\x87\x86\x90\xca@\x87\x87\x930@\x87\x89[\xdc@\x87\x8a[K@\x87\x8b[\xb4@" +
+	"\x87\x8c\\&@\x87\x91K\xf6@\x87\x92\x8c\xc6@\x87\x93\x8f\x11@\x87\x94\x91u@\x87\x95n&@\x87\x96" +
+	"[\xe5@\x87\x97[R@\x87\x98[\xba@\x87\x99\\*@\x87Ď{@\x87\xc5L\x05@\x87ƍ\x05" +
+	"@\x87Ǐj@\x87\xc9Y\x9c@\x87\xca\\\b@\x87\xcb[\xad@\x87\xcc\\\x1f@\x87ь\xc4@\x87" +
+	"\xd2J,@\x87ӋJ@\x87ԍ\xae@\x87\xd5c\xc0@\x87\xd6Y\xa5@\x87\xd7\\\x0f@\x87\xd8[" +
+	"\xb3@\x87\xd9\\$@\x88\x04\x90\xc5@\x88\x05\x8d\x03@\x88\x06J\\@\x88\a\x8b\xb9@\x88\tWE@" +
+	"\x88\nY\xb1@\x88\v\\p@\x88\f\\\x1e@\x88\x11\x8f\x0e@\x88\x12\x8bH@\x88\x13H\x83@\x88\x14" +
+	"\x89\xfc@\x88\x15Z\x1f@\x88\x16WN@\x88\x17Y\xb9@\x88\x18\\v@\x88\x19\\#@\x88D\x93)" +
+	"@\x88E\x8ff@\x88F\x8b\xb8@\x88GH\xd5@\x88IT\xf3@\x88JW_@\x88KZ\x1e@\x88" +
+	"L\\\xeb@\x88Q\x91q@\x88R\x8d\xab@\x88S\x89\xfb@\x88TF\xfc@\x88UQ\b@\x88VT" +
+	"\xfc@\x88WWg@\x88XZ$@\x88Y\\\xef@\x88\x84p\x17@\x88\x85e\xb4@\x88\x86\\\x15@" +
+	"\x88\x87S\x01@\x88\x89@\x9e@\x88\x8a?\x8a@\x88\x8b>\xb8@\x88\x8c>7@\x88\x91nF@\x88\x92" +
+	"c\xe1@\x88\x93ZA@\x88\x94Q,@\x88\x95\x10\xe0@\x88\x96@\xa9@\x88\x97?\x94@\x88\x98>\xc0" +
+	"@\x88\x99>>@\x88\xc3E\xd6@\x88\xc4]\x95@\x88\xc5[Y@\x88\xc6Y\a@\x88\xc7V\xb8@\x88" +
+	"\xc9\x1ej@\x88\xcaLO@\x88\xcbN\xbc@\x88\xccQ\x16@\x88\xd1[\xde@\x88\xd2Y\x9f@\x88\xd3W" +
+	"J@\x88\xd4T\xfa@\x88\xd5@\x84@\x88\xd6\x1eu@\x88\xd7LV@\x88\xd8N\xc2@\x88\xd9Q\x1b@" +
+	"\x89\x03Dj@\x89\x04]\x02@\x89\x05]\xc2@\x89\x06[o@\x89\aY!@\x89\tLM@\x89\n" +
+	"\x1f\x15@\x89\vO\x95@\x89\fQ\xef@\x89\x11[K@\x89\x12\\\t@\x89\x13Y\xb4@\x89\x14Wd" +
+	"@\x89\x15?o@\x89\x16LV@\x89\x17\x1f\x1e@\x89\x18O\x9b@\x89\x19Q\xf4@\x89CC\xb8@\x89" +
+	"D]i@\x89E]e@\x89F^*@\x89G[\xdc@\x89IN\xb8@\x89JO\x93@\x89K\x1f" +
+	"\xd1@\x89LR\xa2@\x89Q[\xb2@\x89R[\xad@\x89S\\q@\x89TZ!@\x89U>\x9c@" +
+	"\x89VN\xc1@\x89WO\x9a@\x89X\x1f\xd7@\x89YR\xa7@\x89\x83C<@\x89\x84]\xd9@\x89\x85" +
+	"]\xd5@\x89\x86]\xd7@\x89\x87^\xa5@\x89\x89Q\x11@\x89\x8aQ\xec@\x89\x8bR\xa1@\x89\x8c o" +
+	"@\x89\x91\\\"@\x89\x92\\\x1d@\x89\x93\\\x1e@\x89\x94\\\xec@\x89\x95>\x19@\x89\x96Q\x1a@\x89" +
+	"\x97Q\xf3@\x89\x98R\xa7@\x89\x99 t@\x8a\xa2\x80\x00@\x8a\xa3E\xca@\x8a\xa4Dc@\x8a\xa5C" +
+	"\xb4@\x8a\xa6C8@\x8a\xdeMZ@\x8aߍ\x91@\x8a\xe0\x8f\xd1@\x8a\xe1\x92*@\x8a\xe2oh@" +
+	"\x8a\xe3].@\x8a\xe4\\\x9b@\x8a\xe5\\\xff@\x8a\xe6]m@\x8b\x1fK\x92@\x8b \x8c\x1a@\x8b!" +
+	"\x8et@\x8b\"e)@\x8b#Z\xf8@\x8b$]U@\x8b%\\\xf9@\x8b&]g@\x8b`I\xea" +
+	"@\x8ba\x8a\xd2@\x8bb[\xab@\x8bcX\xac@\x8bd[\t@\x8be]\xb8@\x8bf]f@\x8b" +
+	"\xa1He@\x8b\xa2R\xb4@\x8b\xa3Ve@\x8b\xa4X\xc2@\x8b\xa5[q@\x8b\xa6^/@\x8b\xe2\x13" +
+	"&@\x8b\xe3BS@\x8b\xe4AA@\x8b\xe5@o@\x8b\xe6?\xed@\x8c# p@\x8c$M\xe9@" +
+	"\x8c%PI@\x8c&R\x97@\x8cd!\x17@\x8ceQ\x1f@\x8cfSm@\x8c\xa5!\xcf@\x8c\xa6" +
+	"T\x1e@\x8c\xe6\"j@\x94DN\xad@\x94E\x90\x87@\x94F\x92\v@\x94G\x94?@\x94H\x95\xfb" +
+	"@\x94JZ\xf9@\x94K[^@\x94L[\xf9@\x94\x84\x90\x87@\x94\x85L\xd0@\x94\x86\x8e7@\x94" +
+	"\x87\x90k@\x94\x88\x92'@\x94\x8a[\xbb@\x94\x8b[Y@\x94\x8c[\xf4@\x94Ē\v@\x94Ŏ" +
+	"6@\x94\xc6K\x17@\x94ǌ\xa9@\x94Ȏe@\x94\xcaY[@\x94\xcb\\!@\x94\xcc[\xf4@" +
+	"\x95\x04\x94>@\x95\x05\x90j@\x95\x06\x8c\xa9@\x95\aI]@\x95\b\x8a\x8e@\x95\nV\xd6@\x95\v" +
+	"Y\x9b@\x95\f\\\x96@\x95D\x95\xf9@\x95E\x92$@\x95F\x8ec@\x95G\x8a\x8c@\x95HF\xf6" +
+	"@\x95JS\x8b@\x95KVP@\x95LYK@\x95\x83\x80\x00@\x95\x84qQ@\x95\x85e\xfd@\x95" +
+	"\x86[m@\x95\x87R\xd9@\x95\x88K]@\x95\x8a=h@\x95\x8b<\xff@\x95\x8c<\x87@\x95\xc3B" +
+	"j@\x95\xc4\\\xb9@\x95\xc5]{@\x95\xc6[\x1e@\x95\xc7X\x9a@\x95\xc8US@\x95\xca\x1d\x91@" +
+	"\x95\xcbLG@\x95\xccN\xc5@\x96\x03A\xa7@\x96\x04]\x1c@\x96\x05]\x17@\x96\x06]\xe0@\x96\a" +
+	"[\\@\x96\bX\x15@\x96\nLF@\x96\v\x1eO@\x96\fO\xa1@\x96CAO@\x96D]\xb5" +
+	"@\x96E]\xb0@\x96F]\xb2@\x96G^T@\x96H[\r@\x96JN\xc2@\x96KO\xa0@\x96" +
+	"L\x1f\x14@\x97JB_@\x97KA\x9f@\x97LAJ@\x97V\x7f\x18@\x97WBf@\x97XA" +
+	"\xa5@\x97YAN@\x97\x84P\t@\x97\x85\x91G@\x97\x86\x92\xc5@\x97\x87\x94\xf0@\x97\x88\x96\xa5@" +
+	"\x97\x8a\\F@\x97\x8b\\\xa7@\x97\x8c]?@\x97\x91N0@\x97\x92\x8f\x93@\x97\x93\x91\x0f@\x97\x94" +
+	"\x939@\x97\x95\x94\xed@\x97\x96p\x99@\x97\x97\\N@\x97\x98\\\xaf@\x97\x99]E@\x97đF" +
+	"@\x97\xc5N.@\x97Ǝ\xff@\x97Ǒ*@\x97Ȓ\xe0@\x97\xca]\x03@\x97\xcb\\\xa1@\x97" +
+	"\xcc]8@\x97я\x91@\x97\xd2LV@\x97ӍH@\x97ԏr@\x97Ց%@\x97\xd6e" +
+	"n@\x97\xd7]\f@\x97\xd8\\\xa8@\x97\xd9]>@\x98\x04\x92\xc1@\x98\x05\x8e\xfd@\x98\x06Lv@" +
+	"\x98\a\x8dw@\x98\b\x8f-@\x98\nZ\xac@\x98\v]c@\x98\f]7@\x98\x11\x91\f@\x98\x12" +
+	"\x8dG@\x98\x13J\x9f@\x98\x14\x8b\xbe@\x98\x15\x8dq@\x98\x16[\x00@\x98\x17Z\xb5@\x98\x18]k" +
+	"@\x98\x19]=@\x98D\x94\xeb@\x98E\x91&@\x98F\x8dv@\x98GJ\xbf@\x98H\x8be@\x98" +
+	"JX1@\x98KZ\xe8@\x98L]\xd5@\x98Q\x935@\x98R\x8fp@\x98S\x8b\xbd@\x98TH" +
+	"\xe8@\x98U\x89\xa7@\x98VR\x88@\x98WX:@\x98XZ\xef@\x98Y]\xdb@\x98\x84\x96\x9f@" +
+	"\x98\x85\x92\xda@\x98\x86\x8f*@\x98\x87\x8bc@\x98\x88H_@\x98\x8aT\xf4@\x98\x8bW\xab@\x98\x8c" +
+	"Z\x98@\x98\x91\x94\xe9@\x98\x92\x91#@\x98\x93\x8dp@\x98\x94\x89\xa8@\x98\x95F\x86@\x98\x96K\"" +
+	"@\x98\x97T\xfd@\x98\x98W\xb3@\x98\x99Z\x9e@\x98\xc4r\x89@\x98\xc5g_@\x98\xc6\\\xf4@\x98" +
+	"\xc7T~@\x98\xc8M\x19@\x98\xca?:@\x98\xcb>\xd1@\x98\xcc>Y@\x98\xd1p\xb8@\x98\xd2e" +
+	"\x8d@\x98\xd3[!@\x98\xd4R\xaa@\x98\xd5KC@\x98\xd6\x11\x9f@\x98\xd7?E@\x98\xd8>\xda@" +
+	"\x98\xd9>a@\x99\x03D\r@\x99\x04]\xff@\x99\x05^\xbd@\x99\x06\\j@\x99\aY\xf2@\x99\b" +
+	"V\xb9@\x99\n\x1f\x88@\x99\vM\xd3@\x99\fPF@\x99\x11\\H@\x99\x12]\x06@\x99\x13Z\xb1" +
+	"@\x99\x14X7@\x99\x15T\xfa@\x99\x16?\"@\x99\x17\x1f\x92@\x99\x18M\xdb@\x99\x19PL@\x99" +
+	"CCK@\x99D^^@\x99E^Y@\x99F_\x1e@\x99G\\\xa6@\x99HYm@\x99JM" +
+	"\xd2@\x99K C@\x99LQ\x1f@\x99Q\\\xa7@\x99R\\\xa3@\x99S]g@\x99TZ\xed@" +
+	"\x99UW\xb0@\x99V>\xb7@\x99WM\xdb@\x99X L@\x99YQ%@\x99\x83B\xf4@\x99\x84" +
+	"^\xf4@\x99\x85^\xef@\x99\x86^\xf0@\x99\x87_\x90@\x99\x88\\V@\x99\x8aPB@\x99\x8bQ\x1d" +
+	"@\x99\x8c!\a@\x99\x91]=@\x99\x92]8@\x99\x93]9@\x99\x94]\xd8@\x99\x95Z\x9b@\x99" +
+	"\x96>>@\x99\x97PK@\x99\x98Q$@\x99\x99!\r@\x9a\xa3\x80\x00@\x9a\xa4D\t@\x9a\xa5C" +
+	"I@\x9a\xa6B\xf2@\x9a\xdeO\x8c@\x9aߐQ@\x9a\xe0\x91\xc6@\x9a\xe1\x93\xe6@\x9a╒@" +
+	"\x9a\xe3q\xd1@\x9a\xe4]\x94@\x9a\xe5]\xf1@\x9a\xe6^\x83@\x9b\x1fM\xb4@\x9b \x8e\x0f@\x9b!" +
+	"\x90/@\x9b\"\x91\xdc@\x9b#f\xcf@\x9b$^N@\x9b%]\xeb@\x9b&^}@\x9b`K\xfe" +
+	"@\x9ba\x8c\x8b@\x9bb\x8e8@\x9bc\\\x87@\x9bd\\\x02@\x9be^\xaa@\x9bf^|@\x9b" +
+	"\xa1JJ@\x9b\xa2\x8a\x7f@\x9b\xa3T,@\x9b\xa4Y\x92@\x9b\xa5\\:@\x9b\xa6_\x17@\x9b\xe2G" +
+	"\xef@\x9b\xe3L\xde@\x9b\xe4Vc@\x9b\xe5Y\v@\x9b\xe6[\xe8@\x9c#\x13\xdf@\x9c$@\xf4@" +
+	"\x9c%@\x89@\x9c&@\x0f@\x9cd!\x88@\x9ceOg@\x9cfQ\xcc@\x9c\xa5\"@@\x9c\xa6" +
+	"R\xa2@\x9c\xe6#\x00@\xa4DM\xc0@\xa4E\x90x@\xa4F\x91\xfa@\xa4G\x94.@\xa4H\x95\xf2" +
+	"@\xa4I\x9a\r@\xa4KZ>@\xa4LZ\xd8@\xa4\x84\x90v@\xa4\x85MM@\xa4\x86\x8fv@\xa4" +
+	"\x87\x90\xe2@\xa4\x88\x92\x80@\xa4\x89\x96\x9b@\xa4\x8b[G@\xa4\x8c[\xe2@\xa4đ\xfa@\xa4ŏ" +
+	"w@\xa4\xc6K\x85@\xa4Ǎ!@\xa4Ȏ\xbe@\xa4ɒ\xd9@\xa4\xcb[\xf2@\xa4\xcc[\xc6@" +
+	"\xa5\x04\x94.@\xa5\x05\x90\xe3@\xa5\x06\x8d @\xa5\aI\xc0@\xa5\b\x8a\xe5@\xa5\t\x8f\x1c@\xa5\v" +
+	"Yl@\xa5\f\\h@\xa5D\x95\xef@\xa5E\x92\x7f@\xa5F\x8e\xbc@\xa5G\x8a\xe3@\xa5HGQ" +
+	"@\xa5I\x8bH@\xa5KV\x1d@\xa5LY\x19@\xa5\x84\x9a\v@\xa5\x85\x96\x9a@\xa5\x86\x92\xd7@\xa5" +
+	"\x87\x8f\x1b@\xa5\x88\x8bG@\xa5\x89FS@\xa5\x8bS\x0e@\xa5\x8cV\n@\xa5À\x00@\xa5\xc4p" +
+	"\xbc@\xa5\xc5g\xd9@\xa5\xc6\\\xa5@\xa5\xc7Sm@\xa5\xc8K\xbc@\xa5\xc9F~@\xa5\xcb<\x8b@" +
+	"\xa5\xcc<\x13@\xa6\x03@\xa9@\xa6\x04[\xfc@\xa6\x05]\x06@\xa6\x06]\xb1@\xa6\a[-@\xa6\b" +
+	"W\xe2@\xa6\tT\xd5@\xa6\v\x1d+@\xa6\fN\xb6@\xa6C@R@\xa6D\\\x95@\xa6E]\x9f" +
+	"@\xa6F]\x83@\xa6G^%@\xa6HZ\xd9@\xa6IW\xcd@\xa6KN\xb5@\xa6L\x1d\xf0@\xa7" +
+	"K@\xa4@\xa7L@O@\xa7W\x7f\x18@\xa7X@\xab@\xa7Y@U@\xa7\x84O @\xa7\x85\x91" +
+	";@\xa7\x86\x92\xb7@\xa7\x87\x94\xe2@\xa7\x88\x96\x9f@\xa7\x89\x9a\xab@\xa7\x8b[\x8b@\xa7\x8c\\\"@" +
+	"\xa7\x91MJ@\xa7\x92\x8f\x87@\xa7\x93\x91\x03@\xa7\x94\x93.@\xa7\x95\x94\xe8@\xa7\x96\x98\xf5@\xa7\x97" +
+	"p\b@\xa7\x98[\x94@\xa7\x99\\*@\xa7đ8@\xa7\xc5N\xaa@\xa7Ɛ9@\xa7Ǒ\x9e" +
+	"@\xa7ȓ6@\xa7ɗB@\xa7\xcb\\\x8f@\xa7\xcc]&@\xa7я\x85@\xa7\xd2L\xd3@\xa7" +
+	"ӎ\x85@\xa7ԏ\xea@\xa7Ց\x7f@\xa7֕\x8c@\xa7\xd7gC@\xa7\xd8\\\x98@\xa7\xd9]" +
+	".@\xa8\x04\x92\xb4@\xa8\x05\x908@\xa8\x06L\xe4@\xa8\a\x8d\xec@\xa8\b\x8f\x83@\xa8\t\x93\x90@" +
+	"\xa8\v]6@\xa8\f]\t@\xa8\x11\x91\x00@\xa8\x12\x8e\x84@\xa8\x13K\r@\xa8\x14\x8c6@\xa8\x15" +
+	"\x8d\xcb@\xa8\x16\x91\xd7@\xa8\x17\\6@\xa8\x18]?@\xa8\x19]\x11@\xa8D\x94\xdd@\xa8E\x91\x9c" +
+	"@\xa8F\x8d\xea@\xa8GK!@\xa8H\x8b\xb9@\xa8I\x8f\xe2@\xa8KZ\xba@\xa8L]\xa7@\xa8" +
+	"Q\x93)@\xa8R\x8f\xe7@\xa8S\x8c4@\xa8TIK@\xa8U\x89\xff@\xa8V\x8e'@\xa8WS" +
+	"\x1b@\xa8XZ\xc3@\xa8Y]\xaf@\xa8\x84\x96\x98@\xa8\x85\x932@\xa8\x86\x8f\x80@\xa8\x87\x8b\xb7@" +
+	"\xa8\x88H\xb9@\xa8\x89\x8c\x1e@\xa8\x8bWy@\xa8\x8cZf@\xa8\x91\x94\xe4@\xa8\x92\x91|@\xa8\x93" +
+	"\x8d\xca@\xa8\x94\x89\xff@\xa8\x95F\xe1@\xa8\x96\x8ab@\xa8\x97K\x81@\xa8\x98W\x82@\xa8\x99Zn" +
+	"@\xa8Ě\xa3@\xa8ŗ=@\xa8Ɠ\x8b@\xa8Ǐ\xdf@\xa8Ȍ\x1c@\xa8\xc9G\xba@\xa8" +
+	"\xcbTw@\xa8\xccWd@\xa8ј\xef@\xa8ҕ\x88@\xa8ӑ\xd5@\xa8Ԏ&@\xa8Պ" +
+	"a@\xa8\xd6E\xe3@\xa8\xd7FP@\xa8\xd8T\x80@\xa8\xd9Wl@\xa9\x04q\xf5@\xa9\x05i4@" +
+	"\xa9\x06^(@\xa9\aU\x10@\xa9\bMw@\xa9\tHH@\xa9\v>^@\xa9\f=\xe6@\xa9\x11" +
+	"p&@\xa9\x12gc@\xa9\x13\\W@\xa9\x14S>@\xa9\x15K\xa2@\xa9\x16Fs@\xa9\x17\x10\xba" +
+	"@\xa9\x18>j@\xa9\x19=\xf0@\xa9CBR@\xa9D]B@\xa9E^I@\xa9F^\xf0@\xa9" +
+	"G\\x@\xa9HY;@\xa9IV<@\xa9K\x1f$@\xa9LP7@\xa9Q[\x8d@\xa9R\\" +
+	"\x93@\xa9S];@\xa9TZ\xc1@\xa9UW\x80@\xa9VT\x7f@\xa9W>G@\xa9X\x1f.@" +
+	"\xa9YP?@\xa9\x83A\xfa@\xa9\x84]\xd8@\xa9\x85^\xde@\xa9\x86^\xc2@\xa9\x87_b@\xa9\x88" +
+	"\\$@\xa9\x89Y%@\xa9\x8bP5@\xa9\x8c\x1f\xe7@\xa9\x91\\\"@\xa9\x92](@\xa9\x93]\r" +
+	"@\xa9\x94]\xac@\xa9\x95Zk@\xa9\x96Wj@\xa9\x97=\xcd@\xa9\x98P>@\xa9\x99\x1f\xf0@\xaa" +
+	"\xa4\x80\x00@\xaa\xa5BT@\xaa\xa6A\xfd@\xaa\xdeN\xaa@\xaaߐI@\xaa\xe0\x91\xbd@\xaa\xe1\x93" +
+	"\xdd@\xaa║@\xaa㙍@\xaa\xe4qA@\xaa\xe5\\\xdb@\xaa\xe6]m@\xab\x1fN0@" +
+	"\xab \x8fF@\xab!\x90\xa4@\xab\"\x923@\xab#\x96/@\xab$h\x9e@\xab%]\xdb@\xab&" +
+	"^m@\xab`Ll@\xaba\x8c\xff@\xabb\x8e\x8e@\xabc\x92\x8b@\xabd]\xb9@\xabe^~" +
+	"@\xabf^Q@\xab\xa1J\xad@\xab\xa2\x8a\xd3@\xab\xa3\x8e\xec@\xab\xa4T\xbe@\xab\xa5\\\x0e@\xab" +
+	"\xa6^\xeb@\xab\xe2HI@\xab\xe3\x8b7@\xab\xe4M;@\xab\xe5X\xdc@\xab\xe6[\xb9@\xac#G" +
+	"K@\xac$H\x19@\xac%U\xe7@\xac&X\xc4@\xacd\x12\xfc@\xace@\x1a@\xacf?\xa0@" +
+	"\xac\xa5!'@\xac\xa6Q\xbf@\xac\xe6!\xe7@\xb4DL\xf9@\xb4E\x8f\xd6@\xb4F\x92~@\xb4G" +
+	"\x93\xe8@\xb4H\x95\x86@\xb4I\x99\xa8@\xb4J\x99\xa1@\xb4LY\xf1@\xb4\x84\x8f\xd7@\xb4\x85LN" +
+	"@\xb4\x86\x8f\x1b@\xb4\x87\x90\x85@\xb4\x88\x92#@\xb4\x89\x96E@\xb4\x8a\x99\x9b@\xb4\x8cZ\xbd@\xb4" +
+	"Ē~@\xb4ŏ\x1b@\xb4\xc6K\xf2@\xb4ǎ\x15@\xb4Ȏ\xea@\xb4ɒ\xe7@\xb4ʖ" +
+	"<@\xb4\xcc[\xaf@\xb5\x04\x93\xea@\xb5\x05\x90\x86@\xb5\x06\x8e\x16@\xb5\aJ!@\xb5\b\x8b\x10@" +
+	"\xb5\t\x8f*@\xb5\n\x92\x7f@\xb5\f\\5@\xb5D\x95\x85@\xb5E\x92\"@\xb5F\x8e\xe9@\xb5G" +
+	"\x8b\x0e@\xb5HG\xa9@\xb5I\x8bS@\xb5J\x8e\xa8@\xb5LX\xe6@\xb5\x84\x99\xa8@\xb5\x85\x96E" +
+	"@\xb5\x86\x92\xe6@\xb5\x87\x8f(@\xb5\x88\x8bR@\xb5\x89F\xa6@\xb5\x8a\x8a\xfe@\xb5\x8cU\xd3@\xb5" +
+	"ę\x9f@\xb5ř\x98@\xb5Ɩ:@\xb5ǒ{@\xb5Ȏ\xa6@\xb5Ɋ\xfc@\xb5\xcaF" +
+	"m@\xb5\xccV\x10@\xb6\x03\x80\x00@\xb6\x04p\xbc@\xb6\x05gD@\xb6\x06^[@\xb6\aTg@" +
+	"\xb6\bLA@\xb6\tF\xdd@\xb6\nC\xbc@\xb6\f;\x9f@\xb6C?Q@\xb6D[\xb0@\xb6E" +
+	"\\{@\xb6F]n@\xb6G]\xf2@\xb6HZ\xa6@\xb6IW\x95@\xb6JW\xd5@\xb6L\x1c\xcc" +
+	"@\xb7L?P@\xb7X\x7f\x18@\xb7Y?W@\xb7\x84N]@\xb7\x85\x90\x9e@\xb7\x86\x93;@\xb7" +
+	"\x87\x94\x9f@\xb7\x88\x966@\xb7\x89\x9aJ@\xb7\x8a\x9aC@\xb7\x8c[?@\xb7\x91L\x86@\xb7\x92\x8e" +
+	"\xeb@\xb7\x93\x91\x87@\xb7\x94\x92\xec@\xb7\x95\x94\x81@\xb7\x96\x98\x95@\xb7\x97\x98\x8d@\xb7\x98p\b@" +
+	"\xb7\x99[H@\xb7Đ\x9c@\xb7\xc5M\xb0@\xb7Ə\xe2@\xb7ǑF@\xb7Ȓ\xdd@\xb7\xc9" +
+	"\x96\xf1@\xb7ʚ:@\xb7\xcc\\\x06@\xb7ю\xe9@\xb7\xd2K\xda@\xb7ӎ.@\xb7ԏ\x93" +
+	"@\xb7Ց(@\xb7֕<@\xb7ט\x84@\xb7\xd8f\xb1@\xb7\xd9\\\x0f@\xb8\x04\x937@\xb8" +
+	"\x05\x8f\xdf@\xb8\x06MP@\xb8\a\x8e\xda@\xb8\b\x8f\xac@\xb8\t\x93\x9b@\xb8\n\x96\xe4@\xb8\f\\" +
+	"\xf4@\xb8\x11\x91\x84@\xb8\x12\x8e,@\xb8\x13Kz@\xb8\x14\x8d(@\xb8\x15\x8d\xf7@\xb8\x16\x91\xe6@" +
+	"\xb8\x17\x95.@\xb8\x18]\xe5@\xb8\x19\\\xfd@\xb8D\x94\x9b@\xb8E\x91C@\xb8F\x8e\xda@\xb8G" +
+	"K\x81@\xb8H\x8b\xe2@\xb8I\x8f\xed@\xb8J\x936@\xb8L]u@\xb8Q\x92\xe7@\xb8R\x8f\x90" +
+	"@\xb8S\x8d&@\xb8TI\xad@\xb8U\x8a+@\xb8V\x8e6@\xb8W\x91~@\xb8XT\x14@\xb8" +
+	"Y]~@\xb8\x84\x961@\xb8\x85\x92\xd9@\xb8\x86\x8f\xaa@\xb8\x87\x8b\xe0@\xb8\x88I\x10@\xb8\x89\x8c" +
+	"'@\xb8\x8a\x8fp@\xb8\x8cZ4@\xb8\x91\x94}@\xb8\x92\x91%@\xb8\x93\x8d\xf6@\xb8\x94\x8a,@" +
+	"\xb8\x95G:@\xb8\x96\x8an@\xb8\x97\x8d\xb6@\xb8\x98L\x05@\xb8\x99Z>@\xb8ĚC@\xb8\xc5" +
+	"\x96\xec@\xb8Ɠ\x98@\xb8Ǐ\xea@\xb8Ȍ%@\xb8\xc9H\r@\xb8ʋ\xd5@\xb8\xccW." +
+	"@\xb8ј\x8f@\xb8ҕ7@\xb8ӑ\xe3@\xb8Ԏ5@\xb8Պm@\xb8\xd6F7@\xb8" +
+	"\u05ca\x1a@\xb8\xd8F\xaf@\xb8\xd9W7@\xb9\x04\x9a;@\xb9\x05\x9a3@\xb9\x06\x96\xdf@\xb9\a\x93" +
+	"1@\xb9\b\x8fm@\xb9\t\x8b\xd4@\xb9\nG\xd4@\xb9\fWj@\xb9\x11\x98\x86@\xb9\x12\x98\x7f@" +
+	"\xb9\x13\x95+@\xb9\x14\x91}@\xb9\x15\x8d\xb5@\xb9\x16\x8a\x1a@\xb9\x17E\xfc@\xb9\x18C\x94@\xb9\x19" +
+	"Wt@\xb9Dq\xf5@\xb9Eh\xa1@\xb9F_\xd8@\xb9GV\a@\xb9HM\xfb@\xb9IH\xa5" +
+	"@\xb9JE\x8c@\xb9L=t@\xb9Qp&@\xb9Rf\xd1@\xb9S^\a@\xb9TT7@\xb9" +
+	"UL(@\xb9VF\xd2@\xb9WC\xb6@\xb9X\x0f\xef@\xb9Y=\x7f@\xb9\x83@\xfd@\xb9\x84\\" +
+	"\xf6@\xb9\x85]\xbe@\xb9\x86^\xae@\xb9\x87_0@\xb9\x88[\xf2@\xb9\x89X\xef@\xb9\x8aY-@" +
+	"\xb9\x8c\x1e\xc8@\xb9\x91[A@\xb9\x92\\\n@\xb9\x93\\\xf9@\xb9\x94]|@\xb9\x95Z;@\xb9\x96" +
+	"W6@\xb9\x97Wr@\xb9\x98=\\@\xb9\x99\x1e\xd2@\xba\xa5\x80\x00@\xba\xa6A\x03@\xba\xdeM\xea" +
+	"@\xbaߏ\xb1@\xba\xe0\x92@@\xbaᓞ@\xba\xe2\x95-@\xba\xe3\x990@\xba\xe4\x99(@\xba" +
+	"\xe5qA@\xba\xe6\\\x8e@\xbb\x1fM;@\xbb \x8e\xf3@\xbb!\x90P@\xbb\"\x91\xdf@\xbb#\x95" +
+	"\xe3@\xbb$\x99\x1f@\xbb%h\x0e@\xbb&]S@\xbb`L\xd8@\xbba\x8d\xec@\xbbb\x8e\xb8@" +
+	"\xbbc\x92\x97@\xbbd\x95\xd3@\xbbe_c@\xbbf^=@\xbb\xa1K\x0e@\xbb\xa2\x8a\xfd@\xbb\xa3" +
+	"\x8e\xf8@\xbb\xa4\x924@\xbb\xa5U\xb3@\xbb\xa6^\xbc@\xbb\xe2H\xa2@\xbb\xe3\x8bA@\xbb\xe4\x8e}" +
+	"@\xbb\xe5M\xbf@\xbb\xe6[\x89@\xbc#G\x9e@\xbc$\x8a\xf2@\xbc%Hw@\xbc&X\x91@\xbc" +
+	"dGc@\xbceEc@\xbcfX\xcc@\xbc\xa5\x123@\xbc\xa6?1@\xbc\xe6 \xcd@\xc4DL" +
+	"0@\xc4E\x8f\xf1@\xc4F\x91\xae@\xc4G\x94=@\xc4H\x95\x11@\xc4I\x99\x0e@\xc4J\x99\a@" +
+	"\xc4K\x99\xed@Ą\x8f\xf1@ąKt@Ć\x8eK@ć\x90\xda@Ĉ\x91\xae@ĉ" +
+	"\x95\xab@Ċ\x99\x00@ċ\x98\xfd@\xc4đ\xaf@\xc4ŎL@\xc4\xc6J\xe3@\xc4Ǎ\x8b" +
+	"@\xc4Ȏ^@\xc4ɒ[@\xc4ʕ\xb0@\xc4˙\n@\xc5\x04\x94?@\xc5\x05\x90\xdb@\xc5" +
+	"\x06\x8d\x8a@\xc5\aJ\x81@\xc5\b\x8b\xd5@\xc5\t\x8f\x01@\xc5\n\x92V@\xc5\v\x95\xaf@\xc5D\x95" +
+	"\x12@\xc5E\x91\xaf@\xc5F\x8e^@\xc5G\x8b\xd5@\xc5HG\xff@\xc5I\x8b*@\xc5J\x8e\x7f@" +
+	"\xc5K\x91\xd8@ń\x99\x0f@Ņ\x95\xac@ņ\x92[@Ň\x8f\x00@ň\x8b*@ŉ" +
+	"F\xf6@Ŋ\x8a\xd3@ŋ\x8e,@\xc5ę\x06@\xc5Ř\xff@\xc5ƕ\xae@\xc5ǒT" +
+	"@\xc5Ȏ}@\xc5Ɋ\xd1@\xc5\xcaF\xbb@\xc5ˏ\x11@\xc6\x04\x99\xec@\xc6\x05\x98\xfb@\xc6" +
+	"\x06\x99\x06@\xc6\a\x95\xac@\xc6\b\x91\xd5@\xc6\t\x8e)@\xc6\n\x8f\x0f@\xc6\vF\xad@\xc6C\x80" +
+	"\x00@\xc6Dp\xbc@\xc6EgD@\xc6F]\xc5@\xc6GU\xe4@\xc6HM\x10@\xc6IGT@" +
+	"\xc6JD\x1b@\xc6KA\xa8@\xc7Y\x7f\x18@ǄM\x98@ǅ\x90\xb9@ǆ\x92o@Ǉ" +
+	"\x94\xf4@ǈ\x95\xc4@ǉ\x99\xb2@Ǌ\x99\xac@ǋ\x9a\x8f@ǑK\xc1@ǒ\x8f\a" +
+	"@Ǔ\x90\xbd@ǔ\x93B@Ǖ\x94\x11@ǖ\x98\x00@Ǘ\x97\xf7@ǘ\x98\xd9@\xc7" +
+	"\x99p\b@\xc7Đ\xb8@\xc7\xc5L\xda@\xc7Ə\x16@\xc7Ǒ\x9b@\xc7Ȓk@\xc7ɖ" +
+	"Y@\xc7ʙ\xa3@\xc7˙\xa0@\xc7я\x05@\xc7\xd2K\x04@\xc7Ӎd@\xc7ԏ\xe8@" +
+	"\xc7Ր\xb8@\xc7֔\xa6@\xc7ח\xee@\xc7ؗ\xea@\xc7\xd9f\xb1@\xc8\x04\x92m@\xc8\x05" +
+	"\x8f\x15@\xc8\x06LF@\xc8\a\x8eU@\xc8\b\x8f%@\xc8\t\x93\x13@\xc8\n\x96]@\xc8\v\x99\xaa" +
+	"@\xc8\x11\x90\xba@\xc8\x12\x8db@\xc8\x13Jq@\xc8\x14\x8c\xa2@\xc8\x15\x8dr@\xc8\x16\x91`@\xc8" +
+	"\x17\x94\xa8@\xc8\x18\x97\xf4@\xc8\x19]T@\xc8D\x94\xf0@\xc8E\x91\x98@\xc8F\x8eS@\xc8GK" +
+	"\xe1@\xc8H\x8c\xa2@\xc8I\x8f\xc2@\xc8J\x93\v@\xc8K\x96X@\xc8Q\x93=@\xc8R\x8f\xe5@" +
+	"\xc8S\x8c\xa0@\xc8TJ\f@\xc8U\x8a\xef@\xc8V\x8e\x0e@\xc8W\x91V@\xc8X\x94\xa2@\xc8Y" +
+	"U\x8b@Ȅ\x95\xc0@ȅ\x92h@Ȇ\x8f#@ȇ\x8c\xa2@ȈIf@ȉ\x8b\xfc" +
+	"@Ȋ\x8fE@ȋ\x92\x92@ȑ\x94\f@Ȓ\x90\xb5@ȓ\x8dp@Ȕ\x8a\xef@\xc8" +
+	"\x95G\x92@Ȗ\x8aG@ȗ\x8d\x8f@Ș\x90\xdb@șL\xd3@\xc8ę\xad@\xc8Ŗ" +
+	"V@\xc8Ɠ\x10@\xc8Ǐ\xc0@\xc8ȋ\xfa@\xc8\xc9H]@\xc8ʋ\xa9@\xc8ˎ\xf6@" +
+	"\xc8ї\xf9@\xc8Ҕ\xa2@\xc8ӑ]@\xc8Ԏ\r@\xc8ՊE@\xc8\xd6F\x89@\xc8\xd7" +
+	"\x89\xf0@\xc8؍<@\xc8\xd9G%@\xc9\x04\x99\xa5@\xc9\x05\x99\x9d@\xc9\x06\x96X@\xc9\a\x93\b" +
+	"@\xc9\b\x8fB@\xc9\t\x8b\xa7@\xc9\nH!@\xc9\v\x8f\xd8@\xc9\x11\x97\xf1@\xc9\x12\x97\xea@\xc9" +
+	"\x13\x94\xa5@\xc9\x14\x91U@\xc9\x15\x8d\x8d@\xc9\x16\x89\xf0@\xc9\x17FK@\xc9\x18\x8e\x1e@\xc9\x19C" +
+	"\xf2@\xc9D\x9a\x87@\xc9E\x99\x99@\xc9F\x99\xa4@\xc9G\x96T@\xc9H\x92\x8e@\xc9I\x8e\xf3@" +
+	"\xc9J\x8f\xd6@\xc9KH\x13@\xc9Q\x98\xd3@\xc9R\x97\xe5@\xc9S\x97\xf1@\xc9T\x94\xa1@\xc9U" +
+	"\x90\xda@\xc9V\x8d<@\xc9W\x8e\x1e@\xc9XF;@\xc9YA\x84@Ʉq\xf5@Ʌh\xa1" +
+	"@Ɇ_E@ɇW\x7f@ɈN\xc7@ɉI\x1a@ɊE\xe9@ɋC|@\xc9" +
+	"\x91p&@ɒf\xd1@ɓ]u@ɔU\xaf@ɕL\xf6@ɖGI@ɗD" +
+	"\x15@ɘA\xa7@ə\x0f@@ʦ\x80\x00@\xca\xdeM)@\xcaߏ\xce@\xca\xe0\x91z@" +
+	"\xca\xe1\x93\xf3@\xca┿@\xca㘞@\xca䘖@\xca\xe5\x99t@\xca\xe6qA@\xcb\x1f" +
+	"Li@\xcb \x8e-@\xcb!\x90\xa5@\xcb\"\x91q@\xcb#\x95Q@\xcb$\x98\x8d@\xcb%\x98\x88" +
+	"@\xcb&h\x0e@\xcb`K\xd4@\xcba\x8dj@\xcbb\x8e6@\xcbc\x92\x15@\xcbd\x95Q@\xcb" +
+	"e\x98\x92@\xcbf^\xd3@ˡKl@ˢ\x8b\xbb@ˣ\x8e\xce@ˤ\x92\n@˥\x95" +
+	"K@˦W&@\xcb\xe2H\xf9@\xcb\xe3\x8b\x18@\xcb\xe4\x8eT@\xcb呔@\xcb\xe6N\x8a@" +
+	"\xcc#G\xef@\xcc$\x8a\xc6@\xcc%\x8e\x06@\xcc&H\xec@\xccdG\xb1@\xcce\x8e\xe5@\xccf" +
+	"E\xc0@̥G\xa1@̦CX@\xcc\xe6\x11\x86DD\x85-\xf5DD\x86\x86\xe4DD\x87\x87\xe7" +
+	"DD\x88\x88\xcfDD\x89\x88\xdaDD\x8a\x8d%DD\x8b\x8c\xf7DD\x8c\x8d\xb4DD\xc6.JDD" +
+	"Ǆ\x92DDȅ}DDɅ\x8bDDʉ\xd8DDˌ\xc8DĎ\x9bDE\a." +
+	"\xd1DE\b\x82(DE\t\x829DE\n\x86\x89DE\v\x89{DE\f\x8cmDEH/[D" +
+	"EI~\xc8DEJ\x837DEK\x86,DEL\x89 DE\x89/\fDE\x8a~\xbaDE\x8b" +
+	"\x81\xb2DE\x8c\x84\xa8DE\xca0\"DE˂\xdfDE̅\xd6DF\v01DF\f\x86\xbf" +
+	"DFL0HDG\x85\xa3\x03DG\x86\xa5\xbaDG\x87\xa8uDG\x88\xab,DG\x89\xad\xb0DG" +
+	"\x8a\xae\x02DG\x8b\xae\xc5DG\x8c\xaf\x8bDG\xc5/\xb8DGƈ\x0eDGǉ\bDGȉ" +
+	"\xe8DGɉ\xefDGʎ*DGˍ\xfcDG̎\xb6DH\x05\x88\fDH\x060\aD" +
+	"H\a\x85\xbcDH\b\x86\x9fDH\t\x86\xa9DH\n\x8a\xe7DH\v\x8d\xcbDH\f\x8d\x9fDHE" +
+	"\x89\x05DHF\x85\xbbDHG0\x89DHH\x83TDHI\x83aDHJ\x87\xa2DHK\x8a\x89" +
+	"DHL\x8doDH\x85\x89\xe4DH\x86\x86\x9cDH\x87\x83SDH\x881\x0fDH\x89\x7f\xfaDH" +
+	"\x8a\x84ZDH\x8b\x87CDH\x8c\x8a,DHŉ\xebDHƆ\xa7DHǃ`DH\xc8\x7f" +
+	"\xfaDH\xc90\xc1DH\xca\x7f\xecDH˂\xd8DH̅\xc4DI\x05\x8e%DI\x06\x8a\xe3D" +
+	"I\a\x87\x9fDI\b\x84XDI\t\x7f\xeaDI\n1\xd0DI\v\x84\x00DI\f\x86\xebDIE" +
+	"\x8d\xf6DIF\x8d\xc7DIG\x8a\x86DIH\x87BDII\x82\xd7DIJ\x84\x00DIK1\xdf" +
+	"DIL\x87\xd1DI\x85\x8e\xb0DI\x86\x8d\x9bDI\x87\x8dlDI\x88\x8a+DI\x89\x85\xc3DI" +
+	"\x8a\x86\xebDI\x8b\x87\xd1DI\x8c1\xf6DJހ\x00DJߣ\xa9DJ\xe0\xa6TDJ\xe1\xa9" +
+	"\x03DJ⫮DJ\xe3\xae(DJ\xe4\xaevDJ\xe5\xaf6DJ\xe6\xaf\xf7DK\x1f1{D" +
+	"K \x896DK!\x8a&DK\"\x8a\xfdDK#\x8b\x00DK$\x8f*DK%\x8e\xfbDK&" +
+	"\x8f\xb2DK`1\xc5DKa\x86\xe6DKb\x87\xbfDKc\x87\xc5DKd\x8b\xf2DKe\x8e\xcb" +
+	"DKf\x8e\x9fDK\xa12ADK\xa2\x84\x7fDK\xa3\x84\x88DK\xa4\x88\xb8DK\xa5\x8b\x93DK" +
+	"\xa6\x8enDK\xe22\xc3DK\xe3\x81-DK\xe4\x85{DK\xe5\x88YDK\xe6\x8b7DL#2" +
+	"uDL$\x81\x1cDL%\x83\xfeDL&\x86\xdeDLd3~DLe\x85!DLf\x88\x01D" +
+	"L\xa53\x8cDL\xa6\x88\xe4DL\xe63\xa3DT\x84\x80\x00DT\x86R\xccDT\x87P\xbbDT\x88" +
+	"O/DT\x89K\x8aDT\x8aN&DT\x8bL\xeaDT\x8cK\x95DT\xc4V\xfaDT\xc6#\x94" +
+	"DT\xc7YqDT\xc8Z\xacDT\xc9[fDT\xca]\xe7DT\xcb^\xfbDT\xcc^\xa2DU" +
+	"\x04TjDU\x06YqDU\a'\x00DU\bY\xa6DU\tZ\x91DU\n]\x1cDU\v^" +
+	"YDU\f_NDUDS\x9bDUFZ\xadDUGY\xa6DUH*TDUIX\xc2D" +
+	"UJ[\x86DUK]\x8eDUL^\x87DU\x84S3DU\x86[gDU\x87Z\x91DU\x88" +
+	"X\xc3DU\x89,*DU\x8aV\xe3DU\x8b[\xa8DU\x8c\\\xa4DU\xc4Q8DU\xc6]\xe7" +
+	"DU\xc7]\x1cDU\xc8[\x86DU\xc9V\xe4DU\xca,\xf3DU\xcb\\\x8bDU\xcc]\x88DV" +
+	"\x04P\x9fDV\x06^\xf9DV\a^WDV\b]\x8dDV\t[\xa7DV\n\\\x8aDV\v." +
+	"\x14DV\f^\xafDVDO[DVF^\xa1DVG_MDVH^\x85DVI\\\xa4D" +
+	"VJ]\x86DVK^\xafDVL.\x18DW\x86V\xa2DW\x87T\x1cDW\x88SPDW\x89" +
+	"R\xedDW\x8aP\xf7DW\x8bP`DW\x8cO DW\x92\x7f\x11DW\x93V\xa0DW\x94T\x1a" +
+	"DW\x95SPDW\x96R\xecDW\x97P\xf8DW\x98P_DW\x99O\x1fDW\xc6TTDW" +
+	"\xc7RLDW\xc8P\xc7DW\xc9M1DW\xcaO\xc2DW\xcbN\x8aDW\xccM9DW\xd2\"" +
+	"\x95DW\xd3T\\DW\xd4RSDW\xd5P\xcdDW\xd6M4DW\xd7O\xc8DW\xd8N\x8dD" +
+	"W\xd9M<DX\x04X_DX\x06%vDX\aZ\xdbDX\b\\\rDX\t\\\xc2DX\n" +
+	"_5DX\v`BDX\f_\xe9DX\x12T>DX\x13%yDX\x14Z\xdeDX\x15\\\x10" +
+	"DX\x16\\\xc3DX\x17_8DX\x18`CDX\x19_\xebDXDU\xd4DXFZ\xd8DX" +
+	"G(\xd6DXH[\vDXI[\xf0DXJ^nDXK_\xa3DXL`\x93DXRR" +
+	"0DXSZ\xdaDXT(\xd9DXU[\x0eDXV[\xf1DXW^qDXX_\xa4D" +
+	"XY`\x94DX\x84U\x04DX\x86\\\x06DX\x87[\aDX\x88,\x1fDX\x89Z)DX\x8a" +
+	"\\\xdeDX\x8b^\xdcDX\x8c_\xcfDX\x92P\xa6DX\x93\\\tDX\x94[\nDX\x95,\"" +
+	"DX\x96Z*DX\x97\\\xe1DX\x98^\xdeDX\x99_\xd0DX\xc4T\x9dDX\xc6\\\xb9DX" +
+	"\xc7[\xebDX\xc8Z'DX\xc9-\xf1DX\xcaXMDX\xcb\\\xffDX\xcc]\xf6DX\xd2M" +
+	"\vDX\xd3\\\xbaDX\xd4[\xebDX\xd5Z(DX\xd6-\xf2DX\xd7XPDX\xd8]\x00D" +
+	"X\xd9]\xf7DY\x04R\xa6DY\x06_*DY\a^gDY\b\\\xdaDY\tXMDY\n" +
+	".\xb5DY\v]\xddDY\f^\xd4DY\x12O\x9dDY\x13_-DY\x14^jDY\x15\\\xdd" +
+	"DY\x16XNDY\x17.\xb8DY\x18]\xdeDY\x19^\xd5DYDR\x0eDYF`5DY" +
+	"G_\x9aDYH^\xd7DYI\\\xfdDYJ]\xdbDYK/\xd1DYL_\xf7DYRN" +
+	"cDYS`8DYT_\x9dDYU^\xdaDYV\\\xffDYW]\xdeDYX/\xd2D" +
+	"YY_\xf8DY\x84P\xcdDY\x86_\xddDY\x87`\x8aDY\x88_\xcaDY\x89]\xf4DY\x8a" +
+	"^\xd2DY\x8b_\xf7DY\x8c/\xd5DY\x92M\x12DY\x93_\xdfDY\x94`\x8dDY\x95_\xcd" +
+	"DY\x96]\xf5DY\x97^\xd5DY\x98_\xf8DY\x99/\xd7DZ߀\x00DZ\xe0X\x05DZ" +
+	"\xe1U\x85DZ\xe2T\xbaDZ\xe3TVDZ\xe4RfDZ\xe5Q\xceDZ\xe6P\x91D[\x1f$" +
+	"\xa7D[ U\xc7D[!S\xc1D[\"R?D[#N\xb2D[$Q:D[%P\x03D" +
+	"[&N\xb5D[`'[D[a\\ED[b]iD[c^\x15D[d`{D[e" +
+	"a\x7fD[fa'D[\xa1*\xafD[\xa2\\oD[\xa3]KD[\xa4_\xbbD[\xa5`\xe7" +
+	"D[\xa6a\xd2D[\xe2-\xecD[\xe3[\x8eD[\xe4^5D[\xe5`(D[\xe6a\x15D\\" +
+	"#/\xb9D\\$Y\xb8D\\%^VD\\&_GD\\d0yD\\e_0D\\f`" +
+	"!D\\\xa51\x8fD\\\xa6a@D\\\xe61\x94Dd\x85L\xeeDd\x87_vDd\x88_\xfeD" +
+	"d\x89a\\Dd\x8ac\xdbDd\x8bb\x87Dd\x8ccXDdĀ\x00Dd\xc5g\x1cDd\xc7" +
+	"L\x1cDd\xc8K\x06Dd\xc9G\x8cDd\xcaJ4Dd\xcbINDd\xccHnDe\x04Pz" +
+	"De\x05a=De\a\"\xd5De\bUNDe\tV\xd6De\nYeDe\vZ\xe5De" +
+	"\f\\'DeDO\xbfDeEa\xc6DeGUNDeH&NDeIU\xd0DeJX" +
+	"\x97DeKZ\x1aDeL[\x85De\x84PADe\x85c$De\x87V\xd7De\x88U\xd1D" +
+	"e\x89)\x12De\x8aV\x87De\x8bX4De\x8cZkDe\xc4NEDe\xc5e\xa2De\xc7" +
+	"YdDe\xc8X\x96De\xc9V\x87De\xca)\xddDe\xcbY\x17De\xcc[ODf\x04L\x88" +
+	"Df\x05dNDf\aZ\xe3Df\bZ\x19Df\tX3Df\nY\x17Df\v)\x85Df" +
+	"\f[<DfDLJDfEe\x1dDfG\\$DfH[\x83DfIZiDfJ[" +
+	"MDfK[;DfL*\xaeDg\x87P9Dg\x88O\x82Dg\x89P\x05Dg\x8aN\x10D" +
+	"g\x8bLXDg\x8cL\x1bDg\x93\x7f\x13Dg\x94P:Dg\x95O\x84Dg\x96P\x06Dg\x97" +
+	"N\x11Dg\x98LZDg\x99L\x1bDg\xc5NBDg\xc7`\xbfDg\xc8a@Dg\xc9b\x96" +
+	"Dg\xcae\bDg\xcbc\xb8Dg\xccd\x84Dg\xd2LcDg\xd3f\x88Dg\xd4`\xc4Dg" +
+	"\xd5aEDg\xd6b\x99Dg\xd7e\fDg\xd8c\xbcDg\xd9d\x86Dh\x05hxDh\aM" +
+	"\xbcDh\bL\xacDh\tI@Dh\nK\xddDh\vJ\xf9Dh\fJ\x1bDh\x12f\xa2D" +
+	"h\x13\x1e0Dh\x14M\xc5Dh\x15L\xb4Dh\x16IFDh\x17K\xe3Dh\x18J\xffDh\x19" +
+	"J\x1fDhDQ\xf2DhEb\x7fDhG$\xb9DhHV\xc3DhIXCDhJZ\xc4" +
+	"DhK\\<DhL]wDhR`\xbeDhSM\xa4DhT$\xbfDhUV\xc8Dh" +
+	"VXFDhWZ\xc7DhX\\?DhY]yDh\x84Q7Dh\x85b\xffDh\x87V" +
+	"\xc0Dh\x88('Dh\x89WADh\x8aY\xf9Dh\x8b[uDh\x8c\\\xd9Dh\x92a;D" +
+	"h\x93L\x8fDh\x94V\xc4Dh\x95(,Dh\x96WDDh\x97Y\xfdDh\x98[yDh\x99" +
+	"\\\xdbDh\xc4Q\xb5Dh\xc5dSDh\xc7X>Dh\xc8W@Dh\xc9*\xe4Dh\xcaW\xf3" +
+	"Dh\xcbY\x98Dh\xcc[\xc5Dh\xd2b\x8dDh\xd3I\x1eDh\xd4X@Dh\xd5WBDh" +
+	"\xd6*\xe8Dh\xd7W\xf6Dh\xd8Y\x9bDh\xd9[\xc6Di\x04O\xbeDi\x05f\xc1Di\aZ" +
+	"\xbbDi\bY\xf4Di\tW\xf1Di\n+\xaaDi\vZvDi\f\\\xa3Di\x12d\xfeD" +
+	"i\x13K\xbaDi\x14Z\xc0Di\x15Y\xf9Di\x16W\xf5Di\x17+\xadDi\x18ZyDi\x19" +
+	"\\\xa5DiDN\x06DiEepDiG\\3DiH[pDiIY\x96DiJZv" +
+	"DiK+SDiL\\\x91DiRc\xadDiSJ\xd6DiT\\8DiU[uDi" +
+	"VY\x99DiWZyDiX+WDiY\\\x93Di\x84M\xc8Di\x85f;Di\x87]" +
+	"mDi\x88\\\xd2Di\x89[\xc1Di\x8a\\\xa1Di\x8b\\\x8fDi\x8c,xDi\x92dxD" +
+	"i\x93I\xf6Di\x94]rDi\x95\\\xd7Di\x96[\xc5Di\x97\\\xa4Di\x98\\\x93Di\x99" +
+	",zDj\xe0\x80\x00Dj\xe1Q\xb2Dj\xe2P\xfcDj\xe3QzDj\xe4O\x8aDj\xe5M\xd8" +
+	"Dj\xe6M\x99Dk\x1fM\xb7Dk g\xe4Dk!b\aDk\"b~Dk#c\xc8Dk" +
+	"$f+Dk%d\xdeDk&e\xa4Dk` NDkaODDkbN5DkcJ" +
+	"\xd2DkdMcDkeL\x82DkfK\xa3Dk\xa1&\xa3Dk\xa2X9Dk\xa3Y\xadD" +
+	"k\xa4\\\x1fDk\xa5]\x8fDk\xa6^\xc2Dk\xe2*\x05Dk\xe3X\xb3Dk\xe4[[Dk\xe5" +
+	"\\\xcfDk\xe6^*Dl#,\xbaDl$YaDl%Z\xfeDl&]\x1fDld-z" +
+	"Dle[\xd8Dlf]\xf9Dl\xa5-%Dl\xa6]\xe7Dl\xe6.CDt\x85L\xd8Dt" +
+	"\x86\x8f\xf8Dt\x88]\x0fDt\x89^\x82Dt\x8aa\x06Dt\x8b`\x85Dt\x8c`0Dtŏ" +
+	"\xf7Dt\xc6K:Dt\xc8Z\xb3Dt\xc9\\'Dt\xca^\xaaDt\xcbaVDt\xcc`1D" +
+	"u\x04\x80\x00Du\x05f\xcdDu\x06\\IDu\bF\x90Du\tCuDu\nF6Du\v" +
+	"E\\Du\fD\xd2DuDK\xceDuE^\xd6DuF\\|DuH\"#DuIQm" +
+	"DuJT)DuKV_DuLX\rDu\x84LeDu\x85`JDu\x86]\xf0Du" +
+	"\x88QnDu\x89%\fDu\x8aR\xe1Du\x8bU@Du\x8cV\xf3Du\xc4JiDu\xc5b" +
+	"\xcbDu\xc6`rDu\xc8T'Du\xc9R\xe1Du\xca%\xd8Du\xcbV$Du\xccW\xd6D" +
+	"v\x04I\x91Dv\x05bHDv\x06c\x1aDv\bV\\Dv\tU?Dv\nV#Dv\v" +
+	"&oDv\fW\xc4DvDH/DvEa\xf3DvFa\xf5DvHX\nDvIV\xf1" +
+	"DvJW\xd5DvKW\xc4DvL&\x1fDw\x88K\x9fDw\x89L7Dw\x8aJBDw" +
+	"\x8bImDw\x8cH\x0fDw\x94\x7f\x14Dw\x95K\xa3Dw\x96L:Dw\x97JEDw\x98I" +
+	"oDw\x99H\x11Dw\xc5N0DwƐ\xb6Dw\xc8^\\Dw\xc9_\xc7Dw\xcab=D" +
+	"w\xcba\xbdDw\xccahDw\xd2LSDwӎ\xf9Dw\xd4f:Dw\xd5^cDw\xd6" +
+	"_\xccDw\xd7bBDw\xd8a\xc1Dw\xd9alDx\x05\x90\xb4Dx\x06L\x94Dx\b\\\n" +
+	"Dx\t]uDx\n_\xebDx\vb\x89Dx\fagDx\x12\x8e\xf7Dx\x13J\xb7Dx" +
+	"\x14[\xd9Dx\x15\\\x11Dx\x16]zDx\x17_\xf0Dx\x18b\x8dDx\x19akDxEh" +
+	"+DxF]\xcdDxHHEDxIE6DxJG\xecDxKG\x14DxLF\x8bD" +
+	"xRfVDxS[\xf7DxT\x19\xe1DxUHODxVE=DxWG\xf4DxX" +
+	"G\x1aDxYF\x91Dx\x84MTDx\x85`\x1bDx\x86]\xcdDx\x88$\nDx\x89R\xee" +
+	"Dx\x8aU\x9bDx\x8bW\xc7Dx\x8cYmDx\x92^\\Dx\x93\\\vDx\x94H+Dx" +
+	"\x95$\x11Dx\x96R\xf3Dx\x97U\xa0Dx\x98W\xcbDx\x99YqDx\xc4M\xe7Dx\xc5a" +
+	"\x84Dx\xc6_6Dx\xc8R\xecDx\xc9&\xecDx\xcaTZDx\xcbV\xafDx\xccXYD" +
+	"x\xd2_\xc2Dx\xd3]qDx\xd4E\x18Dx\xd5R\xf1Dx\xd6&\xf2Dx\xd7T_Dx\xd8" +
+	"V\xb3Dx\xd9X]Dy\x04K\xf0Dy\x05c\xf7Dy\x06a\xa8Dy\bU\x96Dy\tTX" +
+	"Dy\n'\xb2Dy\vW\x8dDy\fY7Dy\x12b7Dy\x13_\xe6Dy\x14G\xcdDy" +
+	"\x15U\x9dDy\x16T]Dy\x17'\xb8Dy\x18W\x91Dy\x19Y;DyDK\x1aDyEc" +
+	"uDyFdCDyHW\xc0DyIV\xacDyJW\x8bDyK(HDyLY%D" +
+	"yRa\xb5DySb\x82DyTF\xf3DyUW\xc7DyVV\xb1DyWW\x91DyX" +
+	"(LDyYY)Dy\x84I\xbbDy\x85c\x1fDy\x86c!Dy\x88YfDy\x89XV" +
+	"Dy\x8aY6Dy\x8bY%Dy\x8c'\xfaDy\x92a`Dy\x93aaDy\x94FiDy" +
+	"\x95YmDy\x96X[Dy\x97Y;Dy\x98Y)Dy\x99'\xfeDz\xe1\x80\x00Dz\xe2M" +
+	")Dz\xe3M\xbcDz\xe4K\xccDz\xe5J\xf8Dz\xe6I\x9dD{\x1fM\xabD{ \x8f\xb5D" +
+	"{!g\x98D{\"_\xa9D{#a\aD{$cnD{%b\xedD{&b\x98D{`" +
+	"L\x10D{a]]D{b]aD{c^\xbfD{da&D{ec\xb6D{fb\x97" +
+	"D{\xa1\x1c\vD{\xa2I\xe0D{\xa3F\xd9D{\xa4I\x83D{\xa5H\xabD{\xa6H\"D{" +
+	"\xe2%\xf9D{\xe3TrD{\xe4W\x0fD{\xe5Y.D{\xe6Z\xcdD|#(\xd2D|$U" +
+	"\xd7D|%X\x1fD|&Y\xc2D|d)\x92D|eX\xfaD|fZ\x9cD|\xa5*%D" +
+	"|\xa6Z\x8aD|\xe6)\xd9D\x84\x85L\xbfD\x84\x86\x8f\xffD\x84\x87\x92nD\x84\x89[\x94D\x84\x8a" +
+	"^\x10D\x84\x8b]\xb5D\x84\x8c^*D\x84ŏ\xfeD\x84\xc6K\x13D\x84ǎ\xafD\x84\xc9Y4" +
+	"D\x84\xca[\xb0D\x84\xcb^}D\x84\xcc^*D\x85\x05\x92mD\x85\x06\x8e\xaeD\x85\aI\x8bD\x85" +
+	"\tV\xd8D\x85\nYUD\x85\v\\!D\x85\f^\xfcD\x85D\x80\x00D\x85Ef{D\x85F[" +
+	"\xf5D\x85GSjD\x85I?\x15D\x85JB0D\x85KA_D\x85L@\xe1D\x85\x84HpD" +
+	"\x85\x85]YD\x85\x86Z\xfcD\x85\x87X\xa2D\x85\x89 \xe0D\x85\x8aNUD\x85\x8bP\xceD\x85\x8c" +
+	"S3D\x85\xc4FcD\x85\xc5_\xd3D\x85\xc6]vD\x85\xc7[\x1dD\x85\xc9NUD\x85\xca!\xac" +
+	"D\x85\xcbQ\xb2D\x85\xccT\x17D\x86\x04E\xb1D\x86\x05_wD\x86\x06`?D\x86\a]\xe6D\x86" +
+	"\tP\xccD\x86\nQ\xb0D\x86\v\"iD\x86\fT\xcdD\x86DE4D\x86E_\xeaD\x86F_" +
+	"\xebD\x86G`\xbdD\x86IS0D\x86JT\x14D\x86KT\xccD\x86L#\tD\x87\x89HPD" +
+	"\x87\x8aFJD\x87\x8bE\x9bD\x87\x8cE D\x87\x95\x7f\x16D\x87\x96HTD\x87\x97FOD\x87\x98" +
+	"E\x9eD\x87\x99E\"D\x87\xc5N\x1bD\x87Ɛ\xc2D\x87Ǔ(D\x87\xc9\\\xe3D\x87\xca_R" +
+	"D\x87\xcb^\xf8D\x87\xcc_jD\x87\xd2L@D\x87ӏ\aD\x87ԑlD\x87\xd5e\xeaD\x87" +
+	"\xd6\\\xeaD\x87\xd7_YD\x87\xd8^\xfdD\x87\xd9_mD\x88\x05\x90\xc0D\x88\x06LrD\x88\a\x8f" +
+	"wD\x88\tZ\x8dD\x88\n\\\xfcD\x88\v_\xbaD\x88\f_iD\x88\x12\x8f\x06D\x88\x13J\x96D" +
+	"\x88\x14\x8d\xb9D\x88\x15[\x86D\x88\x16Z\x93D\x88\x17]\x02D\x88\x18_\xbfD\x88\x19_lD\x88E" +
+	"\x93$D\x88F\x8fuD\x88GJ\xebD\x88IX:D\x88JZ\xaaD\x88K]hD\x88L`5" +
+	"D\x88R\x91iD\x88S\x8d\xb8D\x88TI\x0fD\x88US\x16D\x88VXAD\x88WZ\xb0D\x88" +
+	"X]mD\x88Y`9D\x88\x85g\xdbD\x88\x86]{D\x88\x87U\rD\x88\x89@\xe4D\x88\x8aC" +
+	"\xf3D\x88\x8bC$D\x88\x8cB\xa6D\x88\x92f\aD\x88\x93[\xa5D\x88\x94S6D\x88\x95\x15\xaaD" +
+	"\x88\x96@\xedD\x88\x97C\xfcD\x88\x98C+D\x88\x99B\xacD\x88\xc4J\x00D\x88\xc5^\xa1D\x88\xc6" +
+	"\\ND\x88\xc7Z\x00D\x88\xc9\"\xcfD\x88\xcaO\xe0D\x88\xcbRMD\x88\xccT\xa7D\x88\xd2\\\xe2" +
+	"D\x88\xd3Z\x8dD\x88\xd4X=D\x88\xd5@\xc9D\x88\xd6\"\xd7D\x88\xd7O\xe6D\x88\xd8RRD\x88" +
+	"\xd9T\xabD\x89\x04G\xf9D\x89\x05a\fD\x89\x06^\xb9D\x89\a\\kD\x89\tO\xdeD\x89\n#" +
+	"\x95D\x89\vS+D\x89\fU\x85D\x89\x12_PD\x89\x13\\\xfaD\x89\x14Z\xaaD\x89\x15C\xd7D" +
+	"\x89\x16O\xe5D\x89\x17#\x9dD\x89\x18S0D\x89\x19U\x89D\x89DGHD\x89E`\xb0D\x89F" +
+	"atD\x89G_&D\x89IRID\x89JS)D\x89K$PD\x89LV8D\x89R^\xf3" +
+	"D\x89S_\xb7D\x89T]gD\x89UC\x06D\x89VRPD\x89WS0D\x89X$VD\x89" +
+	"YV<D\x89\x84F\xcbD\x89\x85a D\x89\x86a!D\x89\x87a\xefD\x89\x89T\xa2D\x89\x8aU" +
+	"\x82D\x89\x8bV7D\x89\x8c$\xeeD\x89\x92_cD\x89\x93_dD\x89\x94`2D\x89\x95B\x86D" +
+	"\x89\x96T\xa8D\x89\x97U\x88D\x89\x98V<D\x89\x99$\xf3D\x8a\xe2\x80\x00D\x8a\xe3I\xe5D\x8a\xe4" +
+	"G\xe4D\x8a\xe5G5D\x8a\xe6F\xb9D\x8b\x1fM\x9cD\x8b \x8f\xc9D\x8b!\x92\"D\x8b\"gJ" +
+	"D\x8b#^2D\x8b$`\x92D\x8b%`6D\x8b&`\xa3D\x8b`K\xf4D\x8ba\x8e\x80D\x8b" +
+	"b]\fD\x8bc[\xe6D\x8bd^FD\x8be`\xf5D\x8bf`\xa2D\x8b\xa1JoD\x8b\xa2T" +
+	"\xb9D\x8b\xa3Y\x9fD\x8b\xa4[\xffD\x8b\xa5^\xaeD\x8b\xa6akD\x8b\xe2\x17\xe0D\x8b\xe3B\x99D" +
+	"\x8b\xe4E\x9aD\x8b\xe5D\xcbD\x8b\xe6DKD\x8c#$\xc6D\x8c$QoD\x8c%S\xcfD\x8c&" +
+	"V\x1cD\x8cd%\x85D\x8ceT\xa9D\x8cfV\xf6D\x8c\xa5&=D\x8c\xa6W\xa8D\x8c\xe6&\xd8" +
+	"D\x94\x85L\xc9D\x94\x86\x8f-D\x94\x87\x91\x86D\x94\x88\x93\xfaD\x94\x8a[\x1dD\x94\x8bZ\xd7D\x94" +
+	"\x8c[rD\x94ŏ,D\x94\xc6K\x10D\x94Ǎ\xc5D\x94Ȑ8D\x94\xcaX\xbdD\x94\xcb[" +
+	"\x82D\x94\xcc[VD\x95\x05\x91\x85D\x95\x06\x8d\xc5D\x95\aI}D\x95\b\x8c}D\x95\nV^D" +
+	"\x95\vY#D\x95\f\\\x1eD\x95E\x93\xf9D\x95F\x908D\x95G\x8c}D\x95HH\x0eD\x95J" +
+	"T\x03D\x95KV\xc8D\x95LY\xc3D\x95\x84\x80\x00D\x95\x85f+D\x95\x86[\x9dD\x95\x87S\f" +
+	"D\x95\x88K\x93D\x95\x8a=\xafD\x95\x8b=ID\x95\x8c<\xd3D\x95\xc4BlD\x95\xc5\\\xdfD\x95" +
+	"\xc6Z\x81D\x95\xc7X$D\x95\xc8U\xcaD\x95\xca\x1d\x93D\x95\xcbLbD\x95\xccN\xe0D\x96\x04A" +
+	"\xa8D\x96\x05\\\x97D\x96\x06]CD\x96\aZ\xe6D\x96\bX\x8cD\x96\nLaD\x96\v\x1eQD" +
+	"\x96\fO\xbcD\x96DAQD\x96E]0D\x96F]\x15D\x96G]\xdeD\x96H[\x84D\x96J" +
+	"N\xddD\x96KO\xbaD\x96L\x1f\x16D\x97\x8aB_D\x97\x8bA\x9fD\x97\x8cAJD\x97\x96\x7f\x16" +
+	"D\x97\x97BeD\x97\x98A\xa4D\x97\x99AMD\x97\xc5N*D\x97Ə\xf7D\x97ǒGD\x97" +
+	"Ȕ\xb1D\x97\xca\\jD\x97\xcb\\$D\x97\xcc\\\xbcD\x97\xd2LPD\x97ӎ=D\x97Ԑ" +
+	"\x8dD\x97Ւ\xf6D\x97\xd6e\x9bD\x97\xd7\\rD\x97\xd8\\+D\x97\xd9\\\xc1D\x98\x05\x8f\xf5D" +
+	"\x98\x06LsD\x98\a\x8e\x95D\x98\b\x90\xfeD\x98\nZ\x13D\x98\v\\\xcbD\x98\f\\\x9fD\x98\x12" +
+	"\x8e<D\x98\x13J\x98D\x98\x14\x8c\xd9D\x98\x15\x8fAD\x98\x16[0D\x98\x17Z\x1cD\x98\x18\\\xd2" +
+	"D\x98\x19\\\xa4D\x98E\x92DD\x98F\x8e\x93D\x98GJ\xe1D\x98H\x8dRD\x98JW\xbdD\x98" +
+	"KZuD\x98L]bD\x98R\x90\x8aD\x98S\x8c\xd7D\x98TI\x06D\x98U\x8b\x94D\x98VR" +
+	"\xbaD\x98WW\xc6D\x98XZ{D\x98Y]gD\x98\x85\x94\xabD\x98\x86\x90\xfbD\x98\x87\x8dPD" +
+	"\x98\x88ItD\x98\x8aUkD\x98\x8bX#D\x98\x8c[\x10D\x98\x92\x92\xf2D\x98\x93\x8f?D\x98\x94" +
+	"\x8b\x92D\x98\x95G\x9aD\x98\x96KWD\x98\x97UtD\x98\x98X*D\x98\x99[\x15D\x98\xc5g\x8e" +
+	"D\x98\xc6]&D\x98\xc7T\xb3D\x98\xc8MQD\x98\xca?\x83D\x98\xcb?\x1dD\x98\xcc>\xa7D\x98" +
+	"\xd2e\xbbD\x98\xd3[QD\x98\xd4R\xddD\x98\xd5K{D\x98\xd6\x11\x9fD\x98\xd7?\x8dD\x98\xd8?" +
+	"%D\x98\xd9>\xafD\x99\x04D\x10D\x99\x05^%D\x99\x06[\xd2D\x99\aY\x7fD\x99\bW1D" +
+	"\x99\n\x1f\x8bD\x99\vM\xefD\x99\fPaD\x99\x12\\kD\x99\x13Z\x16D\x99\x14W\xc2D\x99\x15" +
+	"UqD\x99\x16?hD\x99\x17\x1f\x94D\x99\x18M\xf6D\x99\x19PgD\x99DCND\x99E]\xdd" +
+	"D\x99F^\x86D\x99G\\3D\x99HY\xe4D\x99JM\xedD\x99K FD\x99LQ:D\x99" +
+	"R\\$D\x99S\\\xccD\x99TZwD\x99UX'D\x99V?\x00D\x99WM\xf5D\x99X " +
+	"ND\x99YQ?D\x99\x84B\xf6D\x99\x85^rD\x99\x86^XD\x99\x87_\x1dD\x99\x88\\\xceD" +
+	"\x99\x8aP^D\x99\x8bQ8D\x99\x8c!\nD\x99\x92\\\xb9D\x99\x93\\\x9eD\x99\x94]bD\x99\x95" +
+	"[\x12D\x99\x96>\x89D\x99\x97PfD\x99\x98Q?D\x99\x99!\x0fD\x9a\xe3\x80\x00D\x9a\xe4D\t" +
+	"D\x9a\xe5CID\x9a\xe6B\xf2D\x9b\x1fM\xb0D\x9b \x8f\x06D\x9b!\x91KD\x9b\"\x93\xa9D\x9b" +
+	"#f\xfeD\x9b$]\xb8D\x9b%]qD\x9b&^\x03D\x9b`K\xfaD\x9ba\x8d\xa7D\x9bb\x90" +
+	"\x05D\x9bc\\\xb9D\x9bd[lD\x9be^\x14D\x9bf]\xe6D\x9b\xa1JjD\x9b\xa2\x8cgD" +
+	"\x9b\xa3TaD\x9b\xa4Y!D\x9b\xa5[\xc9D\x9b\xa6^\xa6D\x9b\xe2I\x00D\x9b\xe3M\x15D\x9b\xe4" +
+	"V\xdaD\x9b\xe5Y\x82D\x9b\xe6\\_D\x9c#\x13\xe2D\x9c$A<D\x9c%@\xd4D\x9c&@]" +
+	"D\x9cd!\x8bD\x9ceO\x82D\x9cfQ\xe7D\x9c\xa5\"CD\x9c\xa6R\xbdD\x9c\xe6#\x03D\xa4" +
+	"\x85M\xceD\xa4\x86\x91\x1aD\xa4\x87\x92\xa3D\xa4\x88\x94\xdbD\xa4\x89\x96\x9bD\xa4\x8b[\x96D\xa4\x8c\\" +
+	"0D\xa4ő\x1bD\xa4\xc6L\x05D\xa4ǎ\xe1D\xa4ȑ\x19D\xa4ɒ\xdaD\xa4\xcb\\AD" +
+	"\xa4\xcc\\\x14D\xa5\x05\x92\xa3D\xa5\x06\x8e\xe1D\xa5\aJfD\xa5\b\x8d\\D\xa5\t\x8f\x1dD\xa5\v" +
+	"Y\xe1D\xa5\f\\\xdcD\xa5E\x94\xdbD\xa5F\x91\x19D\xa5G\x8d\\D\xa5HH\xcaD\xa5I\x8bI" +
+	"D\xa5KW\\D\xa5LZWD\xa5\x85\x96\x9aD\xa5\x86\x92\xd8D\xa5\x87\x8f\x1bD\xa5\x88\x8bHD\xa5" +
+	"\x89FSD\xa5\x8bS'D\xa5\x8cV#D\xa5Ā\x00D\xa5\xc5h\x81D\xa5\xc6\\\xfeD\xa5\xc7S" +
+	"\xe1D\xa5\xc8M\x17D\xa5\xc9F\xb8D\xa5\xcb=\xddD\xa5\xcc=gD\xa6\x04A\x1cD\xa6\x05]WD" +
+	"\xa6\x06^\x01D\xa6\a[\xa3D\xa6\bY D\xa6\tT\xefD\xa6\v\x1ePD\xa6\fO\xbaD\xa6D" +
+	"@\xc5D\xa6E]\xf0D\xa6F]\xd3D\xa6G^\x9bD\xa6H\\\x18D\xa6IW\xe7D\xa6KO\xb9" +
+	"D\xa6L\x1f\x15D\xa7\x8bA\x15D\xa7\x8c@\xbfD\xa7\x97\x7f\x17D\xa7\x98A\x1bD\xa7\x99@\xc5D\xa7" +
+	"\xc5O*D\xa7Ƒ\xdbD\xa7Ǔ\\D\xa7ȕ\x8cD\xa7ɗED\xa7\xcb\\\xdfD\xa7\xcc]" +
+	"wD\xa7\xd2MPD\xa7Ӑ%D\xa7ԑ\xa6D\xa7Փ\xd4D\xa7֕\x8cD\xa7\xd7g\xe8D" +
+	"\xa7\xd8\\\xe7D\xa7\xd9]}D\xa8\x05\x91\xdaD\xa8\x06MdD\xa8\a\x8f\xaaD\xa8\b\x91\xd9D\xa8\t" +
+	"\x93\x92D\xa8\v]\x86D\xa8\f]ZD\xa8\x12\x90$D\xa8\x13K\x8bD\xa8\x14\x8d\xf2D\xa8\x15\x90 " +
+	"D\xa8\x16\x91\xd8D\xa8\x17\\\x8eD\xa8\x18]\x8eD\xa8\x19]`D\xa8E\x93ZD\xa8F\x8f\xa8D\xa8" +
+	"GK\xc7D\xa8H\x8e+D\xa8I\x8f\xe4D\xa8K[0D\xa8L^\x1dD\xa8R\x91\xa3D\xa8S\x8d" +
+	"\xf0D\xa8TI\xeeD\xa8U\x8cpD\xa8V\x8e(D\xa8WS\x8dD\xa8X[8D\xa8Y^#D" +
+	"\xa8\x85\x95\x88D\xa8\x86\x91\xd6D\xa8\x87\x8e)D\xa8\x88J-D\xa8\x89\x8c D\xa8\x8bX\xb4D\xa8\x8c" +
+	"[\xa1D\xa8\x92\x93\xd0D\xa8\x93\x90\x1dD\xa8\x94\x8coD\xa8\x95HTD\xa8\x96\x8abD\xa8\x97L\xd7" +
+	"D\xa8\x98X\xbdD\xa8\x99[\xa8D\xa8ŗ@D\xa8Ɠ\x8eD\xa8Ǐ\xe1D\xa8Ȍ\x1fD\xa8" +
+	"\xc9G\xbdD\xa8\xcbT\x92D\xa8\xccW\x7fD\xa8ҕ\x88D\xa8ӑ\xd5D\xa8Ԏ'D\xa8Պ" +
+	"bD\xa8\xd6E\xe4D\xa8\xd7F\x89D\xa8\xd8T\x9bD\xa8\xd9W\x86D\xa9\x05i\xdaD\xa9\x06^\x81D" +
+	"\xa9\aU\x83D\xa9\bN\xceD\xa9\tH\x82D\xa9\v?\xadD\xa9\f?7D\xa9\x12h\aD\xa9\x13" +
+	"\\\xaeD\xa9\x14S\xafD\xa9\x15L\xf9D\xa9\x16F\xabD\xa9\x17\x11\xdaD\xa9\x18?\xb7D\xa9\x19?@" +
+	"D\xa9DB\xc3D\xa9E^\x99D\xa9F_@D\xa9G\\\xeeD\xa9HZvD\xa9IVWD\xa9" +
+	"K ED\xa9LQ9D\xa9R\\\xe0D\xa9S]\x88D\xa9T[4D\xa9UX\xbaD\xa9VT" +
+	"\x98D\xa9W?\x93D\xa9X ND\xa9YQ@D\xa9\x84BlD\xa9\x85_/D\xa9\x86_\x12D" +
+	"\xa9\x87_\xd7D\xa9\x88]_D\xa9\x89YAD\xa9\x8bQ7D\xa9\x8c!\bD\xa9\x92]vD\xa9\x93" +
+	"]ZD\xa9\x94^\x1fD\xa9\x95[\xa5D\xa9\x96W\x83D\xa9\x97?\x1cD\xa9\x98Q?D\xa9\x99!\x10" +
+	"D\xaa\xe4\x80\x00D\xaa\xe5B\xc3D\xaa\xe6BlD\xab\x1fN\xadD\xab \x90\xe4D\xab!\x92]D\xab" +
+	"\"\x94\x81D\xab#\x962D\xab$iBD\xab%^*D\xab&^\xbcD\xab`L\xe9D\xaba\x8e" +
+	"\xb9D\xabb\x90\xddD\xabc\x92\x8eD\xabd^\x11D\xabe^\xcdD\xabf^\xa0D\xab\xa1KND" +
+	"\xab\xa2\x8d=D\xab\xa3\x8e\xeeD\xab\xa4U/D\xab\xa5\\\x82D\xab\xa6__D\xab\xe2I\xb7D\xab\xe3" +
+	"\x8b9D\xab\xe4N\x8eD\xab\xe5Z\x12D\xab\xe6\\\xefD\xac#GND\xac$HSD\xac%V\x03" +
+	"D\xac&X\xe0D\xacd\x14\x19D\xaceAcD\xacf@\xecD\xac\xa5\"CD\xac\xa6R\xbdD\xac" +
+	"\xe6#\x03D\xb4\x85L\xceD\xb4\x86\x90\xbfD\xb4\x87\x92FD\xb4\x88\x94~D\xb4\x89\x96FD\xb4\x8a\x9a" +
+	"\xa2D\xb4\x8c[\fD\xb4Ő\xbfD\xb4\xc6LrD\xb4Ǐ\xd5D\xb4ȑED\xb4ɒ\xe7D" +
+	"\xb4ʗCD\xb4\xcc[\xfeD\xb5\x05\x92GD\xb5\x06\x8f\xd6D\xb5\aJ\xc7D\xb5\b\x8d\x88D\xb5\t" +
+	"\x8f*D\xb5\n\x93\x86D\xb5\f\\\xa9D\xb5E\x94\x7fD\xb5F\x91FD\xb5G\x8d\x88D\xb5HI\"" +
+	"D\xb5I\x8bTD\xb5J\x8f\xcdD\xb5LZ$D\xb5\x85\x96ED\xb5\x86\x92\xe6D\xb5\x87\x8f(D\xb5" +
+	"\x88\x8bSD\xb5\x89F\xa6D\xb5\x8a\x8a\xfeD\xb5\x8cU\xecD\xb5Ś\xa1D\xb5ƗCD\xb5Ǔ" +
+	"\x84D\xb5ȏ\xccD\xb5Ɋ\xfeD\xb5\xcaGuD\xb5\xccW\x14D\xb6\x04\x80\x00D\xb6\x05g\xecD" +
+	"\xb6\x06^\xb4D\xb6\aT\xdbD\xb6\bM\x9cD\xb6\tG\x17D\xb6\nD\xffD\xb6\f<\xf3D\xb6D" +
+	"?\xc3D\xb6E\\\xcbD\xb6F]\xbeD\xb6G^hD\xb6H[\xe5D\xb6IW\xb0D\xb6JX\xd9" +
+	"D\xb6L\x1d\xf1D\xb7\x8c?\xc0D\xb7\x98\x7f\x17D\xb7\x99?\xc7D\xb7\xc5N0D\xb7Ƒ\x83D\xb7" +
+	"Ǔ\x04D\xb7ȕ3D\xb7ɖ\xf3D\xb7ʛ@D\xb7\xcc\\VD\xb7\xd2LWD\xb7ӏ" +
+	"\xceD\xb7ԑOD\xb7Փ}D\xb7֕<D\xb7י\x89D\xb7\xd8gWD\xb7\xd9\\_D" +
+	"\xb8\x05\x91\x81D\xb8\x06M\xd0D\xb8\a\x90\x98D\xb8\b\x92\x02D\xb8\t\x93\x9dD\xb8\n\x97\xeaD\xb8\f" +
+	"]DD\xb8\x12\x8f\xccD\xb8\x13K\xf7D\xb8\x14\x8e\xe3D\xb8\x15\x90MD\xb8\x16\x91\xe6D\xb8\x17\x963" +
+	"D\xb8\x18^>D\xb8\x19]LD\xb8E\x93\x01D\xb8F\x90\x98D\xb8GL'D\xb8H\x8eTD\xb8" +
+	"I\x8f\xefD\xb8J\x94<D\xb8L]\xebD\xb8R\x91LD\xb8S\x8e\xe2D\xb8TJOD\xb8U\x8c" +
+	"\x9dD\xb8V\x8e6D\xb8W\x92\x83D\xb8XT\x86D\xb8Y]\xf3D\xb8\x85\x95/D\xb8\x86\x92\x00D" +
+	"\xb8\x87\x8eRD\xb8\x88J\x84D\xb8\x89\x8c)D\xb8\x8a\x90\x92D\xb8\x8c[oD\xb8\x92\x93yD\xb8\x93" +
+	"\x90JD\xb8\x94\x8c\x9bD\xb8\x95H\xadD\xb8\x96\x8anD\xb8\x97\x8e\xd8D\xb8\x98M\\D\xb8\x99[x" +
+	"D\xb8Ŗ\xeeD\xb8Ɠ\x9aD\xb8Ǐ\xecD\xb8Ȍ(D\xb8\xc9H\x10D\xb8ʋ\xd8D\xb8" +
+	"\xccWID\xb8ҕ8D\xb8ӑ\xe3D\xb8Ԏ5D\xb8ՊoD\xb8\xd6F7D\xb8\u05ca" +
+	"\x1cD\xb8\xd8F\xe8D\xb8\xd9WRD\xb9\x05\x9b9D\xb9\x06\x97\xe5D\xb9\a\x947D\xb9\b\x90\x8fD" +
+	"\xb9\t\x8b\xd6D\xb9\nH\xd9D\xb9\fXlD\xb9\x12\x99\x83D\xb9\x13\x96/D\xb9\x14\x92\x80D\xb9\x15" +
+	"\x8e\xd6D\xb9\x16\x8a\x1aD\xb9\x17G\x01D\xb9\x18D\xd2D\xb9\x19XuD\xb9EiGD\xb9F`1" +
+	"D\xb9GVzD\xb9HORD\xb9IH\xe0D\xb9JF\xcbD\xb9L>\xc5D\xb9RguD\xb9" +
+	"S^^D\xb9TT\xa7D\xb9UM\x7fD\xb9VG\nD\xb9WD\xf5D\xb9X\x11\x10D\xb9Y>" +
+	"\xcfD\xb9\x84AnD\xb9\x85^\x0eD\xb9\x86^\xfeD\xb9\x87_\xa5D\xb9\x88]-D\xb9\x89Y\nD" +
+	"\xb9\x8aZ/D\xb9\x8c\x1f\xe9D\xb9\x92\\WD\xb9\x93]FD\xb9\x94]\xefD\xb9\x95[uD\xb9\x96" +
+	"WOD\xb9\x97XsD\xb9\x98>\xabD\xb9\x99\x1f\xf2D\xba\xe5\x80\x00D\xba\xe6ArD\xbb\x1fM\xb8" +
+	"D\xbb \x90\x90D\xbb!\x92\tD\xbb\"\x94-D\xbb#\x95\xe5D\xbb$\x9a!D\xbb%h\xb2D\xbb" +
+	"&]\xa2D\xbb`MUD\xbba\x8f\xa5D\xbbb\x91\aD\xbbc\x92\x9aD\xbbd\x96\xd6D\xbbe_" +
+	"\xbbD\xbbf^\x8cD\xbb\xa1K\xafD\xbb\xa2\x8dgD\xbb\xa3\x8e\xfaD\xbb\xa4\x936D\xbb\xa5V%D" +
+	"\xbb\xa6_0D\xbb\xe2J\x10D\xbb\xe3\x8bCD\xbb䏛D\xbb\xe5O\x11D\xbb\xe6\\\xc0D\xbc#" +
+	"G\xa1D\xbc$\x8a\xf4D\xbc%H\xb1D\xbc&X\xacD\xbcdHdD\xbceF\x9eD\xbcfY\xcb" +
+	"D\xbc\xa5\x13PD\xbc\xa6@}D\xbc\xe6!\xeaDąK\xf5DĆ\x8f\xefDć\x92\x9bD\xc4" +
+	"\x88\x94\tDĉ\x95\xabDĊ\x9a\aDċ\x9a\x05D\xc4ŏ\xf0D\xc4\xc6KcD\xc4Ǐ" +
+	"KD\xc4Ȑ\xbaD\xc4ɒ[D\xc4ʖ\xb8D\xc4˚\x11D\xc5\x05\x92\x9cD\xc5\x06\x8fKD" +
+	"\xc5\aK'D\xc5\b\x8eMD\xc5\t\x8f\x01D\xc5\n\x93]D\xc5\v\x96\xb6D\xc5E\x94\fD\xc5F" +
+	"\x90\xbbD\xc5G\x8eOD\xc5HIxD\xc5I\x8b,D\xc5J\x8f\xa4D\xc5K\x92\xfeDŅ\x95\xac" +
+	"Dņ\x92[DŇ\x8f\x01Dň\x8b*DŉF\xf7DŊ\x8a\xd3Dŋ\x8e,D\xc5" +
+	"Ś\bD\xc5Ɩ\xb7D\xc5Ǔ]D\xc5ȏ\xa3D\xc5Ɋ\xd3D\xc5\xcaG\xc3D\xc5ˏ" +
+	"QD\xc6\x05\x9a\x04D\xc6\x06\x9a\x0fD\xc6\a\x96\xb5D\xc6\b\x92\xfbD\xc6\t\x8e+D\xc6\n\x8fOD" +
+	"\xc6\vG\xb6D\xc6D\x80\x00D\xc6Eg\xecD\xc6F^\x1fD\xc6GVXD\xc6HNkD\xc6I" +
+	"G\x8eD\xc6JE^D\xc6KB\xeeDǙ\x7f\x17D\xc7\xc5MZD\xc7Ɛ\xb7D\xc7ǓY" +
+	"D\xc7Ȕ\xc1D\xc7ɖ\\D\xc7ʚ\xa9D\xc7˚\xa6D\xc7\xd2K\x81D\xc7ӏ\x04D\xc7" +
+	"ԑ\xa4D\xc7Փ\rD\xc7֔\xa7D\xc7ט\xf4D\xc7ؘ\xefD\xc7\xd9gWD\xc8\x05\x90" +
+	"\xb7D\xc8\x06L\xc6D\xc8\a\x90\x12D\xc8\b\x91{D\xc8\t\x93\x16D\xc8\n\x97cD\xc8\v\x9a\xb0D" +
+	"\xc8\x12\x8f\x02D\xc8\x13J\xeeD\xc8\x14\x8e^D\xc8\x15\x8f\xc7D\xc8\x16\x91`D\xc8\x17\x95\xadD\xc8\x18" +
+	"\x98\xf9D\xc8\x19]\xacD\xc8E\x93VD\xc8F\x90\x11D\xc8GL\x86D\xc8H\x8f\x14D\xc8I\x8f\xc4" +
+	"D\xc8J\x94\x11D\xc8K\x97^D\xc8R\x91\xa1D\xc8S\x8e\\D\xc8TJ\xafD\xc8U\x8d`D\xc8" +
+	"V\x8e\x0fD\xc8W\x92\\D\xc8X\x95\xa8D\xc8YU\xfdDȅ\x94\xbeDȆ\x91yDȇ\x8f" +
+	"\x14DȈJ\xdaDȉ\x8b\xfeDȊ\x90gDȋ\x93\xb5DȒ\x93\tDȓ\x8f\xc4D" +
+	"Ȕ\x8d_DȕI\x04DȖ\x8aGDȗ\x8e\xb0DȘ\x91\xfcDșN*D\xc8\xc5" +
+	"\x96XD\xc8Ɠ\x13D\xc8Ǐ\xc3D\xc8ȋ\xfdD\xc8\xc9H`D\xc8ʋ\xabD\xc8ˎ\xf9" +
+	"D\xc8Ҕ\xa2D\xc8ӑ]D\xc8Ԏ\x0eD\xc8ՊGD\xc8\xd6F\x89D\xc8\u05c9\xf2D\xc8" +
+	"؍>D\xc8\xd9G^D\xc9\x05\x9a\xa3D\xc9\x06\x97^D\xc9\a\x94\x0eD\xc9\b\x90dD\xc9\t\x8b" +
+	"\xaaD\xc9\nI&D\xc9\v\x90\x17D\xc9\x12\x98\xedD\xc9\x13\x95\xa9D\xc9\x14\x92YD\xc9\x15\x8e\xafD" +
+	"\xc9\x16\x89\xf1D\xc9\x17GOD\xc9\x18\x8e]D\xc9\x19E0D\xc9E\x9a\x9fD\xc9F\x9a\xaaD\xc9G" +
+	"\x97ZD\xc9H\x93\xb0D\xc9I\x8e\xf5D\xc9J\x90\x16D\xc9KI\x18D\xc9R\x98\xe9D\xc9S\x98\xf5" +
+	"D\xc9T\x95\xa5D\xc9U\x91\xfbD\xc9V\x8d=D\xc9W\x8e]D\xc9XG@D\xc9YB\xc5D\xc9" +
+	"\x85iGDɆ_\x9eDɇW\xf2DɈP\x1eDɉIUDɊG)DɋD" +
+	"\xbfDɒguDɓ]\xccDɔV\x1fDɕNMDɖG\x81DɗETD" +
+	"ɘB\xe9Də\x10`D\xca\xe6\x80\x00D\xcb\x1fL\xe6D\xcb \x8f\xcaD\xcb!\x92^D\xcb\"" +
+	"\x93\xc0D\xcb#\x95SD\xcb$\x99\x8fD\xcb%\x99\x8aD\xcb&h\xb2D\xcb`LQD\xcba\x8f#" +
+	"D\xcbb\x90\x85D\xcbc\x92\x18D\xcbd\x96TD\xcbe\x99\x94D\xcbf_+DˡL\x0eD\xcb" +
+	"\xa2\x8e%Dˣ\x8e\xd1Dˤ\x93\rD˥\x96MD˦W\x97D\xcb\xe2JfD\xcb\xe3\x8b" +
+	"\x1aD\xcb\xe4\x8fqD\xcb咲D\xcb\xe6O\xddD\xcc#G\xf2D\xcc$\x8a\xc9D\xcc%\x8e\tD" +
+	"\xcc&I&D\xccdH\xb2D\xcce\x8f#D\xccfF\xfbD̥H\xa2D̦D\x96D\xcc\xe6" +
+	"\x12\xa3HT\xc6-:HTǇ{HTȈ\x8eHTɉ`HTʊ\x8bHTˎ\xe5" +
+	"HT̎\xc7HU\a-\xc1HU\b\x859HU\t\x86\x0eHU\n\x87<HU\v\x8b\x99HU" +
+	"\f\x8e\x98HUH.rHUI\x82\xb9HUJ\x83\xeaHUK\x88JHUL\x8bLHU\x89/" +
+	"\x1eHU\x8a\x7fnHU\x8b\x83\xeeHU\x8c\x86\xf2HU\xca/\x04HU˃\xa1HŬ\xa5H" +
+	"V\v0*HV\f\x87\xd3HVL0BHWƣ\xbbHWǦ\x86HWȩSHW\xc9" +
+	"\xab\xf7HWʯ\xb5HW˰\x1aHW̰\xf0HX\x06.\xffHX\a\x88\xa6HX\b\x89\xb0" +
+	"HX\t\x8azHX\n\x8b\x9dHX\v\x8f\xe8HX\f\x8f\xcaHXF\x88\xa4HXG/\x80HX" +
+	"H\x86eHXI\x872HXJ\x88WHXK\x8c\xa5HXL\x8f\x99HX\x86\x89\xadHX\x87\x86" +
+	"cHX\x880,HX\x89\x83\xe7HX\x8a\x85\x0fHX\x8b\x89`HX\x8c\x8cWHXƊvH" +
+	"XǇ0HXȃ\xe6HX\xc90\xd3HXʀ\xa2HX˅\x11HẌ\vHY\x06" +
+	"\x8b\x99HY\a\x88UHY\b\x85\x0fHY\t\x80\xa2HY\n0\xb9HY\v\x84\xc5HY\f\x87\xbf" +
+	"HYF\x8f\xe3HYG\x8c\xa1HYH\x89]HYI\x85\x10HYJ\x84\xc4HYK1\xdaHY" +
+	"L\x88\xe6HY\x86\x8f\xc4HY\x87\x8f\x95HY\x88\x8cTHY\x89\x88\tHY\x8a\x87\xbdHY\x8b\x88" +
+	"\xe6HY\x8c1\xf0H[\x1f\x80\x00H[ \xa4bH[!\xa7!H[\"\xa9\xe2H[#\xac{H" +
+	"[$\xb0+H[%\xb0\x8cH[&\xb1^H[`0\xc3H[a\x89\xcfH[b\x8a\xcfH[c" +
+	"\x8b\x91H[d\x8c\xabH[e\x90\xe5H[f\x90\xc7H[\xa11@H[\xa2\x87\x8fH[\xa3\x88T" +
+	"H[\xa4\x89qH[\xa5\x8d\xaeH[\xa6\x90\x97H[\xe21\xe6H[\xe3\x85\x14H[\xe4\x864H[" +
+	"\xe5\x8atH[\xe6\x8d_H\\#2\x88H\\$\x81\xd6H\\%\x864H\\&\x89\"H\\d2" +
+	"oH\\e\x85\xe8H\\f\x88\xd7H\\\xa53\x89H\\\xa6\x89\xfaH\\\xe63\x9fHdŀ\x00H" +
+	"d\xc7LcHd\xc8L\x00Hd\xc9K\xb9Hd\xcaI\\Hd\xcbL\xa8Hd\xccK\xcaHe\x05" +
+	"PEHe\a#\x01He\bWBHe\tYMHe\nZ\xe7He\v]\xb4He\f^\xf6" +
+	"HeEO\x8dHeGWBHeH&\x97HeIX\\HeJZ\x19HeK\\\xe9He" +
+	"L^UHe\x85P\x10He\x87YMHe\x88X]He\x89*\fHe\x8aX\nHe\x8b[" +
+	"\x1aHe\x8c]QHe\xc5P\xedHe\xc7Z\xe8He\xc8Z\x1aHe\xc9X\vHe\xca,\x03H" +
+	"e\xcbX.He\xcc\\\xf8Hf\x05O\xa0Hf\a]\xb4Hf\b\\\xe9Hf\t[\x1aHf\n" +
+	"X0Hf\v,\xdeHf\f]\xdcHfEObHfG^\xf5HfH^SHfI]P" +
+	"HfJ\\\xf8HfK]\xdaHfL.\x06Hg\xc7P\x04Hg\xc8OOHg\xc9O\xd2Hg" +
+	"\xcaP\xafHg\xcbOcHg\xccO&Hg\xd3\x7f\x10Hg\xd4P\x01Hg\xd5OMHg\xd6O" +
+	"\xd1Hg\xd7P\xadHg\xd8OcHg\xd9O%Hh\aN\x02Hh\bM\xa4Hh\tM_H" +
+	"h\nK\fHh\vNKHh\fMoHh\x13!\xbfHh\x14N\tHh\x15M\xaaHh\x16" +
+	"MeHh\x17K\x0fHh\x18NOHh\x19MrHhEQ\xc3HhG$\xe4HhHX\xb2" +
+	"HhIZ\xb1HhJ\\DHhK_\x03HhL`>HhSM\xebHhT$\xe6Hh" +
+	"UX\xb4HhVZ\xb3HhW\\EHhX_\x05HhY`?Hh\x85Q\nHh\x87X" +
+	"\xaeHh\x88(nHh\x89Y\xc5Hh\x8a[yHh\x8b^<Hh\x8c_\x9fHh\x93M\x87H" +
+	"h\x94X\xb0Hh\x95(pHh\x96Y\xc7Hh\x97[zHh\x98^>Hh\x99_\xa0Hh\xc5" +
+	"Q\x88Hh\xc7Z\xaaHh\xc8Y\xc1Hh\xc9+\xd8Hh\xcaYsHh\xcb\\tHh\xcc^\xa1" +
+	"Hh\xd3M>Hh\xd4Z\xacHh\xd5Y\xc3Hh\xd6+\xdaHh\xd7YsHh\xd8\\vHh" +
+	"\xd9^\xa1Hi\x05R`Hi\a\\;Hi\b[uHi\tYrHi\n-\xccHi\vY" +
+	"\x93Hi\f^KHi\x13J\xe5Hi\x14\\<Hi\x15[uHi\x16YrHi\x17-\xcdH" +
+	"i\x18Y\x95Hi\x19^KHiEQ\x14HiG^\xf8HiH^5HiI\\pHiJ" +
+	"Y\x93HiK.\xa1HiL_)HiSN$HiT^\xfaHiU^7HiV\\r" +
+	"HiWY\x94HiX.\xa3HiY_)Hi\x85P\xd6Hi\x87`1Hi\x88_\x97Hi" +
+	"\x89^\x9bHi\x8a^IHi\x8b_'Hi\x8c/\xc5Hi\x93MGHi\x94`4Hi\x95_" +
+	"\x99Hi\x96^\x9dHi\x97^JHi\x98_)Hi\x99/\xc6Hk \x80\x00Hk!Q~H" +
+	"k\"P\xcaHk#QIHk$R Hk%P\xd8Hk&P\x99Hk`#\xd2Hka" +
+	"O\x8bHkbO*HkcN\xe4HkdL\x96HkeO\xc7HkfN\xecHk\xa1&\xca" +
+	"Hk\xa2Z Hk\xa3\\\x10Hk\xa4]\x98Hk\xa5`IHk\xa6a{Hk\xe2*HHk" +
+	"\xe3[,Hk\xe4\\\xd5Hk\xe5_\x89Hk\xe6`\xe3Hl#-\xa6Hl$Z\xdaHl%]" +
+	"\xccHl&_\xedHld/\x95HleZ\xf9Hlf_\x9cHl\xa50fHl\xa6`vH" +
+	"l\xe61\x84Ht\xc6L>Ht\xc8]\x1eHt\xc9^\x97Ht\xca`\xddHt\xcbc\xa8Ht\xcc" +
+	"b\x82Hu\x05\x80\x00Hu\x06]\x03Hu\bG\x8aHu\tG\xa2Hu\nE^Hu\vH\xb6" +
+	"Hu\fH/HuEK\x98HuF^\xe5HuH\"kHuIS\xf9HuJV[Hu" +
+	"KY.HuLZ\xdcHu\x85L0Hu\x86``Hu\x88S\xfaHu\x89&\x06Hu\x8aU" +
+	"\x14Hu\x8bX'Hu\x8cY\xd9Hu\xc5M\xf6Hu\xc6b\xa6Hu\xc8V[Hu\xc9U\x15H" +
+	"u\xca(\xebHu\xcbW\xceHu\xccY\x80Hv\x05L\xa9Hv\x06eoHv\bY-Hv\t" +
+	"X%Hv\nW\xceHv\v)\xc8Hv\fZdHvEKGHvFdJHvHZ\xdb" +
+	"HvIY\xd7HvJY\x80HvKZdHvL)wHw\xc8KhHw\xc9L\x00Hw" +
+	"\xcaM\xc2Hw\xcbLxHw\xccK\x1aHw\xd4\x7f\x11Hw\xd5KhHw\xd6L\x00Hw\xd7M" +
+	"\xc3Hw\xd8LxHw\xd9K\x1bHx\x06M\x94Hx\b^mHx\t_\xddHx\nb\x18H" +
+	"x\vd\xd5Hx\fc\xb4Hx\x13K\xb3Hx\x14\\\x92Hx\x15^qHx\x16_\xe1Hx\x17" +
+	"b\x1aHx\x18d\xd8Hx\x19c\xb6HxF^\x85HxHI<HxIIUHxJG\x1c" +
+	"HxKJfHxLI\xdfHxS\\\xadHxT\x1duHxUIDHxVI\\Hx" +
+	"WG HxXJkHxYI\xe4Hx\x85M#Hx\x86`.Hx\x88$QHx\x89U" +
+	"qHx\x8aW\xc8Hx\x8bZ\x8eHx\x8c\\4Hx\x93^kHx\x94I#Hx\x95$UH" +
+	"x\x96UuHx\x97W\xcbHx\x98Z\x90Hx\x99\\6Hx\xc5M\xb6Hx\xc6a\x9cHx\xc8" +
+	"UnHx\xc9'\xe0Hx\xcaV\x87Hx\xcbY\x8bHx\xcc[5Hx\xd3_\xd7Hx\xd4I7" +
+	"Hx\xd5UrHx\xd6'\xe5Hx\xd7V\x89Hx\xd8Y\x8dHx\xd9[8Hy\x05OtHy" +
+	"\x06c\xd6Hy\bW\xc3Hy\tV\x86Hy\n*\xbfHy\vY5Hy\fZ\xdfHy\x13b" +
+	"\x0eHy\x14F\xf8Hy\x15W\xc5Hy\x16V\x87Hy\x17*\xc2Hy\x18Y7Hy\x19Z\xe2H" +
+	"yEN(HyFf\x8fHyHZ\x85HyIY\x86HyJY4HyK+\x95HyL" +
+	"[\xbdHySd\xcaHyTJAHyUZ\x89HyVY\x8aHyWY6HyX+\x98" +
+	"HyY[\xc0Hy\x85L\xcaHy\x86emHy\x88\\+Hy\x89[0Hy\x8aZ\xdeHy" +
+	"\x8b[\xbdHy\x8c+GHy\x93c\xa8Hy\x94I\xbaHy\x95\\/Hy\x96[4Hy\x97Z" +
+	"\xe0Hy\x98[\xc0Hy\x99+JH{!\x80\x00H{\"L\xf3H{#M\x86H{$O@H" +
+	"{%M\xf8H{&L\x9dH{`M\bH{a^\x14H{b_\xbaH{ca\x1dH{d" +
+	"cJH{ee\xf8H{fd\xd9H{\xa1\x1f\x94H{\xa2J\xd5H{\xa3J\xebH{\xa4H\xb5" +
+	"H{\xa5K\xf1H{\xa6KjH{\xe2&;H{\xe3V\xeaH{\xe4Y3H{\xe5[\xe8H{" +
+	"\xe6]\x86H|#)\xbfH|$W\xfbH|%Z\xeeH|&\\\x90H|d,\x95H|eZ" +
+	"\x9dH|f\\?H|\xa5-fH|\xa6]\x19H|\xe6-\x1aH\x84\xc6L\x18H\x84ǒOH" +
+	"\x84\xc9[\xa4H\x84\xca]\xffH\x84\xcb`\xceH\x84\xcc`|H\x85\x06\x92NH\x85\aJ\x8fH\x85\t" +
+	"YIH\x85\n[\xa4H\x85\v^sH\x85\faMH\x85E\x80\x00H\x85F\\\xb4H\x85GS\xa4" +
+	"H\x85ICBH\x85JAXH\x85KD\xb9H\x85LD=H\x85\x85H;H\x85\x86]kH\x85" +
+	"\x87[\x12H\x85\x89!\xdaH\x85\x8aP\xa6H\x85\x8bS\xb4H\x85\x8cV\x19H\x85\xc5J\x16H\x85\xc6_" +
+	"\xc7H\x85\xc7]mH\x85\xc9P\xa6H\x85\xca$\xe6H\x85\xcbT$H\x85\xccV\x89H\x86\x05H\xc8H" +
+	"\x86\x06b\x94H\x86\a`;H\x86\tS\xb3H\x86\nT$H\x86\v%\xc2H\x86\fWmH\x86E" +
+	"HKH\x86Fb@H\x86Gc\x12H\x86IV\x17H\x86JV\x87H\x86KWkH\x86L&a" +
+	"H\x87\xc9H\x18H\x87\xcaI\xf0H\x87\xcbH\xa6H\x87\xccH+H\x87\xd5\x7f\x13H\x87\xd6H\x1bH\x87" +
+	"\xd7I\xf2H\x87\xd8H\xa8H\x87\xd9H+H\x88\x06MqH\x88\a\x93\tH\x88\t\\\xf5H\x88\n_" +
+	"EH\x88\vb\x06H\x88\fa\xb5H\x88\x13K\x92H\x88\x14\x91KH\x88\x15\\DH\x88\x16\\\xfaH" +
+	"\x88\x17_IH\x88\x18b\nH\x88\x19a\xb7H\x88F\x93\bH\x88GK\xeaH\x88IZ\xa3H\x88J" +
+	"\\\xf3H\x88K_\xb4H\x88Lb\x81H\x88S\x91IH\x88TJ\fH\x88USQH\x88VZ\xa8" +
+	"H\x88W\\\xf7H\x88X_\xb8H\x88Yb\x84H\x88\x86^8H\x88\x87UGH\x88\x89E\x04H\x88" +
+	"\x8aC\"H\x88\x8bFuH\x88\x8cE\xfaH\x88\x93\\aH\x88\x94SnH\x88\x95\x19AH\x88\x96E" +
+	"\fH\x88\x97C(H\x88\x98F|H\x88\x99E\xffH\x88\xc5I\xcfH\x88\xc6^\xb5H\x88\xc7\\fH" +
+	"\x88\xc9#\xc3H\x88\xcaR)H\x88\xcbU(H\x88\xccW\x83H\x88\xd3\\\xf3H\x88\xd4Z\xa2H\x88\xd5" +
+	"D\xe9H\x88\xd6#\xc9H\x88\xd7R-H\x88\xd8U,H\x88\xd9W\x85H\x89\x05K\xa2H\x89\x06a\x03" +
+	"H\x89\a^\xb4H\x89\tR'H\x89\n&\xc7H\x89\vU\x98H\x89\fW\xf3H\x89\x13_?H\x89" +
+	"\x14\\\xeeH\x89\x15C\x02H\x89\x16R+H\x89\x17&\xccH\x89\x18U\x9cH\x89\x19W\xf5H\x89EJ" +
+	"VH\x89Fc\xc1H\x89GarH\x89IU#H\x89JU\x96H\x89K'\x9eH\x89LX\xd1H" +
+	"\x89Sa\xffH\x89T_\xaeH\x89UFTH\x89VU)H\x89WU\x9aH\x89X'\xa2H\x89Y" +
+	"X\xd3H\x89\x85I\xd9H\x89\x86cmH\x89\x87d;H\x89\x89W|H\x89\x8aW\xefH\x89\x8bX\xcf" +
+	"H\x89\x8c(<H\x89\x93a\xabH\x89\x94byH\x89\x95E\xd7H\x89\x96W\x81H\x89\x97W\xf3H\x89" +
+	"\x98X\xd3H\x89\x99(?H\x8b\"\x80\x00H\x8b#I\xafH\x8b$K~H\x8b%J5H\x8b&I" +
+	"\xb9H\x8b`L\xecH\x8ba\x92\x04H\x8bb]\xc9H\x8bc^DH\x8bd`\x85H\x8bec7H" +
+	"\x8bfb\xe4H\x8b\xa1KgH\x8b\xa2T\xf4H\x8b\xa3[\xfdH\x8b\xa4^>H\x8b\xa5`\xefH\x8b\xa6" +
+	"c\xadH\x8b\xe2\x1blH\x8b\xe3F\xabH\x8b\xe4D\xccH\x8b\xe5H\x10H\x8b\xe6G\x94H\x8c#%\xb2" +
+	"H\x8c$S\xaeH\x8c%V\x9dH\x8c&X\xebH\x8cd(\xaeH\x8ceW\x0fH\x8cfY\\H\x8c" +
+	"\xa5)~H\x8c\xa6Z7H\x8c\xe6*\x19H\x94\xc6L\x15H\x94ǑeH\x94ȓ\xd8H\x94\xca[" +
+	"\fH\x94\xcb]\xd4H\x94\xcc]\xa8H\x95\x06\x91dH\x95\aJ\x81H\x95\b\x90\x1dH\x95\nX\xadH" +
+	"\x95\v[uH\x95\f^pH\x95F\x93\xd8H\x95G\x90\x1dH\x95HI\x13H\x95JVQH\x95K" +
+	"Y\x1aH\x95L\\\x15H\x95\x85\x80\x00H\x95\x86\\bH\x95\x87SOH\x95\x88L\x8eH\x95\x8a<\xd9" +
+	"H\x95\x8b@\xa4H\x95\x8c@2H\x95\xc5F\x1dH\x95\xc6\\\xd2H\x95\xc7ZuH\x95\xc8X\x1bH\x95" +
+	"\xca \xcdH\x95\xcbN\xfaH\x95\xccQxH\x96\x05D\xbeH\x96\x06_\x98H\x96\a];H\x96\bZ" +
+	"\xe1H\x96\nN\xfaH\x96\v!\xa9H\x96\fR\\H\x96EDgH\x96F_jH\x96G`3H" +
+	"\x96H]\xd9H\x96JQvH\x96KRZH\x96L\"oH\x97\xcaF\x05H\x97\xcbD\xaaH\x97\xcc" +
+	"DUH\x97\xd6\x7f\x15H\x97\xd7F\bH\x97\xd8D\xadH\x97\xd9DVH\x98\x06MrH\x98\a\x92'" +
+	"H\x98\b\x94\x90H\x98\n\\]H\x98\v_\x17H\x98\f^\xebH\x98\x13K\x95H\x98\x14\x90jH\x98" +
+	"\x15\x92\xd3H\x98\x16[\xf4H\x98\x17\\bH\x98\x18_\x1cH\x98\x19^\xefH\x98F\x92%H\x98GK" +
+	"\xe0H\x98H\x90\xe4H\x98JZ\aH\x98K\\\xc1H\x98L_\xaeH\x98S\x90iH\x98TJ\x03H" +
+	"\x98U\x8f%H\x98VR\xfeH\x98WZ\fH\x98X\\\xc6H\x98Y_\xb2H\x98\x86\x94\x8dH\x98\x87" +
+	"\x90\xe3H\x98\x88JtH\x98\x8aW\xb5H\x98\x8bZoH\x98\x8c]\\H\x98\x93\x92\xd0H\x98\x94\x8f$" +
+	"H\x98\x95H\x97H\x98\x96LOH\x98\x97W\xbaH\x98\x98ZuH\x98\x99]`H\x98\xc6]\xe9H\x98" +
+	"\xc7T\xf4H\x98\xc8NHH\x98\xca>\xb2H\x98\xcbBnH\x98\xccA\xfcH\x98\xd3\\\x12H\x98\xd4S" +
+	"\x1dH\x98\xd5LpH\x98\xd6\x157H\x98\xd7>\xbaH\x98\xd8BvH\x98\xd9B\x02H\x99\x05G\xb7H" +
+	"\x99\x06^\x1bH\x99\a[\xc9H\x99\bYzH\x99\n\"\xbdH\x99\vP\x81H\x99\fR\xf4H\x99\x13" +
+	"\\[H\x99\x14Z\x06H\x99\x15W\xb6H\x99\x16>\x95H\x99\x17\"\xc4H\x99\x18P\x87H\x99\x19R\xf8" +
+	"H\x99EFZH\x99F`\xd2H\x99G^\x7fH\x99H\\0H\x99JP\x80H\x99K#\x93H\x99" +
+	"LS\xd2H\x99S_\x13H\x99T\\\xbfH\x99UZnH\x99VBPH\x99WP\x85H\x99X#" +
+	"\x9aH\x99YS\xd6H\x99\x85F\x03H\x99\x86`\xa4H\x99\x87aiH\x99\x88_\x1aH\x99\x8aR\xf1H" +
+	"\x99\x8bS\xd0H\x99\x8c$WH\x99\x93^\xe5H\x99\x94_\xaaH\x99\x95]YH\x99\x96A\xdbH\x99\x97" +
+	"R\xf6H\x99\x98S\xd6H\x99\x99$\\H\x9b#\x80\x00H\x9b$G\xa2H\x9b%FIH\x9b&E\xf2" +
+	"H\x9b`L\xf2H\x9ba\x91+H\x9bb\x93\x89H\x9bc]zH\x9bd]\xabH\x9be`VH\x9b" +
+	"f`(H\x9b\xa1KbH\x9b\xa2\x8f\xebH\x9b\xa3T\xa3H\x9b\xa4[`H\x9b\xa5^\vH\x9b\xa6`" +
+	"\xe8H\x9b\xe2I\xf8H\x9b\xe3N\nH\x9b\xe4Y\x19H\x9b\xe5[\xc4H\x9b\xe6^\xa1H\x9c#\x17nH" +
+	"\x9c$@nH\x9c%D\x1aH\x9c&C\xa5H\x9cd$\xb3H\x9ceR\rH\x9cfTrH\x9c\xa5" +
+	"%\x84H\x9c\xa6ULH\x9c\xe6&DH\xa4\xc6J\xe4H\xa4Ǐ\xabH\xa4Ȓ\tH\xa4ɔ\x80" +
+	"H\xa4\xcbZ\xa0H\xa4\xccZ\x91H\xa5\x06\x8f\xaaH\xa5\aIEH\xa5\b\x8eLH\xa5\t\x90\xc3H\xa5" +
+	"\vXAH\xa5\f[<H\xa5F\x92\bH\xa5G\x8eKH\xa5HG\xceH\xa5I\x8d\fH\xa5KU" +
+	"\xe1H\xa5LX\xddH\xa5\x86\x94\x80H\xa5\x87\x90\xc3H\xa5\x88\x8d\fH\xa5\x89FQH\xa5\x8bR\x9dH" +
+	"\xa5\x8cU\x98H\xa5ŀ\x00H\xa5\xc6\\\x12H\xa5\xc7R\xf7H\xa5\xc8L0H\xa5\xc9E\xd4H\xa5\xcb" +
+	"=\bH\xa5\xcc<\x95H\xa6\x05@\x98H\xa6\x06\\bH\xa6\aZ\x05H\xa6\bW\xa7H\xa6\tTe" +
+	"H\xa6\v\x1d\x1aH\xa6\fN\xd3H\xa6E@AH\xa6F\\QH\xa6G\\\xfdH\xa6HZ\x9fH\xa6" +
+	"IW]H\xa6KN\xd2H\xa6L\x1d\xe0H\xa7\xcb@\x91H\xa7\xcc@<H\xa7\xd7\x7f\x15H\xa7\xd8@" +
+	"\x97H\xa7\xd9@@H\xa8\x06LJH\xa8\a\x90vH\xa8\b\x92\xcbH\xa8\t\x958H\xa8\v[\xefH" +
+	"\xa8\f[\xdfH\xa8\x13JmH\xa8\x14\x8e\xbbH\xa8\x15\x91\x0fH\xa8\x16\x93|H\xa8\x17[\xa5H\xa8\x18" +
+	"[\xf6H\xa8\x19[\xe5H\xa8F\x90uH\xa8GJ\xadH\xa8H\x8f\x1dH\xa8I\x91\x8aH\xa8KY\x99" +
+	"H\xa8L\\\x86H\xa8S\x8e\xbaH\xa8TH\xd1H\xa8U\x8d_H\xa8V\x8f\xccH\xa8WR\xa7H\xa8" +
+	"XY\xa0H\xa8Y\\\x8bH\xa8\x86\x92\xc8H\xa8\x87\x8f\x1bH\xa8\x88I8H\xa8\x89\x8d\xe2H\xa8\x8bW" +
+	"CH\xa8\x8cZ0H\xa8\x93\x91\fH\xa8\x94\x8d^H\xa8\x95G\\H\xa8\x96\x8c#H\xa8\x97K\xf3H" +
+	"\xa8\x98WJH\xa8\x99Z6H\xa8ƕ4H\xa8Ǒ\x87H\xa8ȍ\xe1H\xa8\xc9G\xbdH\xa8\xcb" +
+	"T\fH\xa8\xccV\xf9H\xa8ӓxH\xa8ԏ\xc9H\xa8Ռ\"H\xa8\xd6E\xe1H\xa8\xd7E\xa8" +
+	"H\xa8\xd8T\x13H\xa8\xd9V\xfeH\xa9\x06]\x9cH\xa9\aT\xa0H\xa9\bM\xeeH\xa9\tG\xa5H\xa9" +
+	"\v>\xdeH\xa9\f>lH\xa9\x13[\xc5H\xa9\x14R\xc9H\xa9\x15L\x16H\xa9\x16E\xcdH\xa9\x17\x10" +
+	"\xa8H\xa9\x18>\xe8H\xa9\x19>sH\xa9EBCH\xa9F]\xaaH\xa9G[WH\xa9HY\x04H" +
+	"\xa9IU\xd1H\xa9K\x1f\x16H\xa9LPVH\xa9S[\xefH\xa9TY\x9aH\xa9UWFH\xa9V" +
+	"T\x10H\xa9W>\xc1H\xa9X\x1f\x1eH\xa9YP\\H\xa9\x85A\xebH\xa9\x86]\x98H\xa9\x87^A" +
+	"H\xa9\x88[\xeeH\xa9\x89X\xbaH\xa9\x8bPTH\xa9\x8c\x1f\xd9H\xa9\x93[\xddH\xa9\x94\\\x85H\xa9" +
+	"\x95Z1H\xa9\x96V\xfbH\xa9\x97>MH\xa9\x98P[H\xa9\x99\x1f\xdfH\xab$\x80\x00H\xab%B" +
+	"AH\xab&A\xeaH\xab`K\xd3H\xaba\x8f\x85H\xabb\x91\xceH\xabc\x940H\xabd]/H" +
+	"\xabe]>H\xabf]+H\xab\xa1J8H\xab\xa2\x8e/H\xab\xa3\x90\x91H\xab\xa4TPH\xab\xa5" +
+	"Z\xf2H\xab\xa6]\xcfH\xab\xe2H\xc6H\xab\xe3\x8c\xf7H\xab\xe4M\xb1H\xab\xe5X\xa7H\xab\xe6[\x84" +
+	"H\xac#GMH\xac$GyH\xac%U\x7fH\xac&X\\H\xacd\x12\xeeH\xace@\x98H\xac" +
+	"f@$H\xac\xa5!\x19H\xac\xa6Q\xdeH\xac\xe6!\xd9H\xb4\xc6K\xd8H\xb4ǑMH\xb4Ȓ" +
+	"\xd9H\xb4ɕ\x15H\xb4ʗ\x17H\xb4\xcc[KH\xb5\x06\x91NH\xb5\aJ-H\xb5\b\x8f\x1cH" +
+	"\xb5\t\x91XH\xb5\n\x93ZH\xb5\f[\xf6H\xb5F\x92\xdaH\xb5G\x8f\x1cH\xb5HH\xaeH\xb5I" +
+	"\x8d\xa0H\xb5J\x8f\xa1H\xb5LY\x97H\xb5\x86\x95\x16H\xb5\x87\x91XH\xb5\x88\x8d\xa0H\xb5\x89G\x05" +
+	"H\xb5\x8a\x8a\xd3H\xb5\x8cV(H\xb5Ɨ\x16H\xb5ǓXH\xb5ȏ\x9fH\xb5Ɋ\xd1H\xb5" +
+	"\xcaF[H\xb5\xccV+H\xb6\x05\x80\x00H\xb6\x06^/H\xb6\aT\x1aH\xb6\bL\xf6H\xb6\tG" +
+	"XH\xb6\nD\x1fH\xb6\f=(H\xb6E?\xb0H\xb6F]\fH\xb6G]\xb7H\xb6H[YH" +
+	"\xb6IW\xecH\xb6JW\xf1H\xb6L\x1d\xdfH\xb7\xcc?\xaeH\xb7\xd8\x7f\x16H\xb7\xd9?\xb3H\xb8\x06" +
+	"M:H\xb8\a\x92\x0fH\xb8\b\x93\x95H\xb8\t\x95\xc8H\xb8\n\x97\xc1H\xb8\f\\\x96H\xb8\x13K^" +
+	"H\xb8\x14\x90WH\xb8\x15\x91\xddH\xb8\x16\x94\x10H\xb8\x17\x96\aH\xb8\x18]\xbbH\xb8\x19\\\x9dH\xb8" +
+	"F\x92\x0fH\xb8GK\x91H\xb8H\x8f\xe6H\xb8I\x92\x1aH\xb8J\x94\x13H\xb8L]=H\xb8S\x90" +
+	"VH\xb8TI\xb6H\xb8U\x8e-H\xb8V\x90`H\xb8W\x92XH\xb8XS\xc8H\xb8Y]DH" +
+	"\xb8\x86\x93\x93H\xb8\x87\x8f\xe5H\xb8\x88J\x14H\xb8\x89\x8epH\xb8\x8a\x90iH\xb8\x8cZ\xe7H\xb8\x93" +
+	"\x91\xdaH\xb8\x94\x8e,H\xb8\x95H:H\xb8\x96\x8c\xb4H\xb8\x97\x8e\xacH\xb8\x98L\xb8H\xb8\x99Z\xee" +
+	"H\xb8ƕ\xc5H\xb8ǒ\x17H\xb8ȎnH\xb8\xc9HnH\xb8ʋ\xafH\xb8\xccW\x86H\xb8" +
+	"Ӕ\vH\xb8Ԑ]H\xb8Ռ\xb3H\xb8\xd6F\x94H\xb8\u05c9\xf0H\xb8\xd8G(H\xb8\xd9W" +
+	"\x8dH\xb9\x06\x97\xbdH\xb9\a\x94\x0fH\xb9\b\x90fH\xb9\t\x8b\xaeH\xb9\nG\xc6H\xb9\fW\x89H" +
+	"\xb9\x13\x96\x03H\xb9\x14\x92UH\xb9\x15\x8e\xabH\xb9\x16\x89\xf0H\xb9\x17E\xebH\xb9\x18C\xf5H\xb9\x19" +
+	"W\x91H\xb9F_\xafH\xb9GU\xbeH\xb9HN\xafH\xb9II\"H\xb9JE\xf1H\xb9L>\xfc" +
+	"H\xb9S]\xd9H\xb9TS\xe8H\xb9UL\xd9H\xb9VGKH\xb9WD\x18H\xb9X\x10\xfdH\xb9" +
+	"Y?\x05H\xb9\x85A]H\xb9\x86^PH\xb9\x87^\xf7H\xb9\x88\\\xa4H\xb9\x89YGH\xb9\x8aY" +
+	"LH\xb9\x8c\x1f\xd8H\xb9\x93\\\x95H\xb9\x94]>H\xb9\x95Z\xe9H\xb9\x96W\x8aH\xb9\x97W\x8eH" +
+	"\xb9\x98>\xe0H\xb9\x99\x1f\xe0H\xbb%\x80\x00H\xbb&A`H\xbb`L\xc0H\xbba\x91\x18H\xbbb" +
+	"\x92\x95H\xbbc\x94\xbeH\xbbd\x96\xaeH\xbbe_;H\xbbf]\xe1H\xbb\xa1K\x1aH\xbb\xa2\x8e\xf6" +
+	"H\xbb\xa3\x91\x1eH\xbb\xa4\x93\x0eH\xbb\xa5UlH\xbb\xa6^\x85H\xbb\xe2I\x9fH\xbb㍃H\xbb" +
+	"\xe4\x8fsH\xbb\xe5NqH\xbb\xe6\\9H\xbc#G\xfdH\xbc$\x8a\xccH\xbc%H\xf2H\xbc&X" +
+	"\xe8H\xbcdGUH\xbceE\xc7H\xbcfX\xecH\xbc\xa5\x13@H\xbc\xa6@\xb3H\xbc\xe6!\xd9H" +
+	"\xc4\xc6J\xc9H\xc4ǐ\xc3H\xc4ȒNH\xc4ɔ\x8aH\xc4ʖ\x8bH\xc4˚\xecH\xc5\x06" +
+	"\x90\xc3H\xc5\aJ\x8dH\xc5\b\x8f\xe1H\xc5\t\x91/H\xc5\n\x931H\xc5\v\x97\x91H\xc5F\x92O" +
+	"H\xc5G\x8f\xe3H\xc5HI\x04H\xc5I\x8dwH\xc5J\x8fxH\xc5K\x93\xd9Hņ\x94\x8bH\xc5" +
+	"\x87\x911Hň\x8dwHŉGUHŊ\x8a\xa8Hŋ\x8f%H\xc5Ɩ\x8bH\xc5Ǔ" +
+	"1H\xc5ȏwH\xc5Ɋ\xa6H\xc5\xcaF\xa9H\xc5ˏ$H\xc6\x06\x9a\xecH\xc6\a\x97\x91H" +
+	"\xc6\b\x93\xd7H\xc6\t\x8f$H\xc6\n\x8f$H\xc6\vG\xa3H\xc6E\x80\x00H\xc6F]\x99H\xc6G" +
+	"U\x97H\xc6HM\xc5H\xc6IG\xcfH\xc6JD~H\xc6KC\x17H\xc7\xd9\x7f\x16H\xc8\x06L0" +
+	"H\xc8\a\x91\x89H\xc8\b\x93\rH\xc8\t\x95AH\xc8\n\x97:H\xc8\v\x9b\x8bH\xc8\x13JUH\xc8" +
+	"\x14\x8f\xd2H\xc8\x15\x91WH\xc8\x16\x93\x8aH\xc8\x17\x95\x82H\xc8\x18\x99\xd3H\xc8\x19])H\xc8F\x91" +
+	"\x87H\xc8GK\xf0H\xc8H\x90\xa6H\xc8I\x91\xefH\xc8J\x93\xe8H\xc8K\x989H\xc8S\x8f\xd0H" +
+	"\xc8TJ\x15H\xc8U\x8e\xf0H\xc8V\x908H\xc8W\x920H\xc8X\x96\x81H\xc8YU@HȆ" +
+	"\x93\vHȇ\x90\xa7HȈJiHȉ\x8eEHȊ\x90?Hȋ\x94\x8fHȓ\x91T" +
+	"HȔ\x8e\xefHȕH\x91HȖ\x8c\x8dHȗ\x8e\x85HȘ\x92\xd6HșM\x86H\xc8" +
+	"ƕ=H\xc8Ǒ\xeeH\xc8ȎDH\xc8\xc9H\xbeH\xc8ʋ\x83H\xc8ˏ\xefH\xc8ӓ" +
+	"\x85H\xc8Ԑ6H\xc8Ռ\x8cH\xc8\xd6F\xe5H\xc8\u05c9\xc7H\xc8؎4H\xc8\xd9G\x9fH" +
+	"\xc9\x06\x975H\xc9\a\x93\xe6H\xc9\b\x90<H\xc9\t\x8b\x81H\xc9\nH\x13H\xc9\v\x8f\xefH\xc9\x13" +
+	"\x95}H\xc9\x14\x92-H\xc9\x15\x8e\x83H\xc9\x16\x89\xc7H\xc9\x17F9H\xc9\x18\x8e3H\xc9\x19DS" +
+	"H\xc9F\x9b\x84H\xc9G\x985H\xc9H\x94\x8bH\xc9I\x8f\xecH\xc9J\x8f\xedH\xc9KI\aH\xc9" +
+	"S\x99\xccH\xc9T\x96}H\xc9U\x92\xd3H\xc9V\x8e2H\xc9W\x8e1H\xc9XG-H\xc9YB" +
+	"\xedHɆ_\x1cHɇW6HɈO|HɉI\x97HɊFNHɋD\xe9H" +
+	"ɓ]HHɔUaHɕM\xa8HɖG\xc2HɗDwHɘC\x12Hə" +
+	"\x10NH\xcb&\x80\x00H\xcb`K\xbbH\xcba\x90\x96H\xcbb\x92\x14H\xcbc\x94<H\xcbd\x96," +
+	"H\xcbe\x9alH\xcbf^\xacHˡKyHˢ\x8f\xb4Hˣ\x90\xf5Hˤ\x92\xe5H\xcb" +
+	"\xa5\x97%H˦V\xdeH\xcb\xe2I\xf6H\xcb\xe3\x8dZH\xcb\xe4\x8fJH\xcb哉H\xcb\xe6O" +
+	"=H\xcc#HNH\xcc$\x8a\xa1H\xcc%\x8e\xfcH\xcc&IgH\xccdG\xa3H\xcce\x8e\xfbH" +
+	"\xccfF$H̥H\x91H̦D\xc0H\xcc\xe6\x12\x92Le\a,\xc5Le\b\x87\xffLe\t" +
+	"\x88\xf9Le\n\x8a\xe7Le\v\x8c\x1fLe\f\x90\x87LeH-uLeI\x85\xa4LeJ\x87\x96" +
+	"LeK\x88\xd0LeL\x8d;Le\x89.GLe\x8a\x837Le\x8b\x84tLe\x8c\x88\xe1Le" +
+	"\xca/(Le˄)Lë\xb3Lf\v/\x1fLf\f\x88fLfL0NLh\a\xa4" +
+	"cLh\b\xa7>Lh\t\xa9\xf5Lh\n\xad\xd1Lh\v\xb1\x9fLh\f\xb2\x14LhG.\x8aL" +
+	"hH\x89*LhI\x8a\x1cLhJ\x8b\xffLhK\x8d/LhL\x91\x87Lh\x87\x89)Lh\x88" +
+	"/5Lh\x89\x86\xd1Lh\x8a\x88\xb7Lh\x8b\x89\xeaLh\x8c\x8eELhǊ\x19LhȆ\xd0" +
+	"Lh\xc90\x02LhʄfLh˅\x9bLh̉\xf9Li\a\x8b\xfbLi\b\x88\xb5Li" +
+	"\t\x84eLi\n0\xdeLi\v\x85OLi\f\x89\xc9LiG\x8d+LiH\x89\xe7LiI\x85" +
+	"\x9aLiJ\x85OLiK0\xd5LiL\x89}Li\x87\x91\x82Li\x88\x8eALi\x89\x89\xf6L" +
+	"i\x8a\x89\xc7Li\x8b\x89{Li\x8c1\xfdLk`\x80\x00Lka\xa5\vLkb\xa7\xd9Lkc" +
+	"\xaa\x86Lkd\xaeRLke\xb2\x12Lkf\xb2\x82Lk\xa10OLk\xa2\x8aTLk\xa3\x8b=" +
+	"Lk\xa4\x8d\x13Lk\xa5\x8e;Lk\xa6\x92\x82Lk\xe20\xf5Lk\xe3\x87\xfdLk\xe4\x89\xd6Lk" +
+	"\xe5\x8b\x01Lk\xe6\x8fKLl#1\xbcLl$\x85\x94Ll%\x86\xc1Ll&\x8b\x0eLld2" +
+	"\x93Lle\x86uLlf\x8a\xddLl\xa52\x8aLl\xa6\x8a\x92Ll\xe63\xadLu\x06\x80\x00L" +
+	"u\bG\xd2Lu\tH\xadLu\nI\x9dLu\vG\xf0Lu\fK\x9aLuFKvLuH" +
+	"\"\xaaLuIU\xfcLuJX\xe0LuKZ\xbfLuL]\xbaLu\x86L\x11Lu\x88U\xfc" +
+	"Lu\x89&aLu\x8aW\xafLu\x8bY\xb8Lu\x8c\\\xb7Lu\xc6M\xd9Lu\xc8X\xe0Lu" +
+	"\xc9W\xafLu\xca)\xf8Lu\xcbYaLu\xcc\\uLv\x06OdLv\bZ\xc0Lv\tY" +
+	"\xb8Lv\nYaLv\v,\x00Lv\fY\x8aLvFNqLvH]\xbaLvI\\\xb7L" +
+	"vJ\\uLvKY\x8bLvL,\xe3Lx\bKELx\tK\xdfLx\nM\xa2Lx\v" +
+	"O*Lx\fN8Lx\x14\x7f\x0fLx\x15KBLx\x16K\xddLx\x17M\xa1Lx\x18O(" +
+	"Lx\x19N7LxHI\x83LxIJ]LxJKKLxKI\xa6LxLMALx" +
+	"T!\x16LxUI\x8aLxVJcLxWKPLxXI\xa8LxYMELx\x86M" +
+	"\x06Lx\x88$\x8eLx\x89WnLx\x8aZELx\x8b\\\x1cLx\x8c_\tLx\x94IkL" +
+	"x\x95$\x90Lx\x96WpLx\x97ZGLx\x98\\\x1cLx\x99_\nLx\xc6M\x9bLx\xc8" +
+	"WjLx\xc9(9Lx\xcaY\x19Lx\xcb[\x19Lx\xcc^\nLx\xd4J@Lx\xd5Wl" +
+	"Lx\xd6(;Lx\xd7Y\x1bLx\xd8[\x19Lx\xd9^\fLy\x06OYLy\bZ>Ly" +
+	"\tY\x16Ly\n+\xc5Ly\vZ\xc3Ly\f]\xc9Ly\x14K)Ly\x15Z?Ly\x16Y" +
+	"\x17Ly\x17+\xc7Ly\x18Z\xc3Ly\x19]\xcbLyFP\xdcLyH\\\x13LyI[\x14L" +
+	"yJZ\xc2LyK-\xcaLyLZ\xe9LyTI~LyU\\\x13LyV[\x14LyW" +
+	"Z\xc1LyX-\xcaLyYZ\xeaLy\x86O\xeaLy\x88^\xfeLy\x89^\x03Ly\x8a]\xc6" +
+	"Ly\x8bZ\xe9Ly\x8c.\xa7Ly\x94M\x19Ly\x95_\x00Ly\x96^\x04Ly\x97]\xc7Ly" +
+	"\x98Z\xe9Ly\x99.\xa8L{a\x80\x00L{bL\xd1L{cMgL{dO!L{eP" +
+	"\xa0L{fO\xb0L{\xa1#*L{\xa2K\x1cL{\xa3K\xf0L{\xa4L\xd8L{\xa5K3L" +
+	"{\xa6N\xc0L{\xe2&tL{\xe3X\xdeL{\xe4[\xa4L{\xe5]oL{\xe6`NL|#" +
+	"*\x13L|$Z\x81L|%\\uL|&_WL|d-\x94L|e\\$L|f_\x1b" +
+	"L|\xa5/\x93L|\xa6\\HL|\xe60lL\x85\aK\xa6L\x85\t[\xc2L\x85\n^#L\x85" +
+	"\v`\xb4L\x85\fc\xaeL\x85F\x80\x00L\x85GT3L\x85IDML\x85JE\x96L\x85KC" +
+	"\xf2L\x85LG\xa8L\x85\x86H\x17L\x85\x87]\x8aL\x85\x89\"6L\x85\x8aSAL\x85\x8bU\xf5L" +
+	"\x85\x8cX\xf7L\x85\xc6I\xf4L\x85\xc7_\xecL\x85\xc9SAL\x85\xca%\xf2L\x85\xcbVfL\x85\xcc" +
+	"Y~L\x86\x06LhL\x86\ab~L\x86\tU\xf6L\x86\nVgL\x86\v(\xe9L\x86\fY%" +
+	"L\x86FKvL\x86GevL\x86IX\xf6L\x86JY}L\x86KY%L\x86L)\xcdL\x88" +
+	"\tG\xf4L\x88\nI\xccL\x88\vL9L\x88\fKHL\x88\x15\x7f\x11L\x88\x16G\xf3L\x88\x17I" +
+	"\xccL\x88\x18L9L\x88\x19KHL\x88GL\xfcL\x88I]\x14L\x88J_iL\x88Ka\xf0L" +
+	"\x88Ld\xdbL\x88TK\x1bL\x88US\xdfL\x88V]\x17L\x88W_mL\x88Xa\xf1L\x88Y" +
+	"d\xddL\x88\x87U\xd5L\x88\x89F\fL\x88\x8aGRL\x88\x8bE\xb5L\x88\x8cI\\L\x88\x94S\xfb" +
+	"L\x88\x95\x1c\xe7L\x88\x96F\x13L\x88\x97GYL\x88\x98E\xb9L\x88\x99I`L\x88\xc6I\xb0L\x88" +
+	"\xc7^\xd5L\x88\xc9$\x1cL\x88\xcaT\xbaL\x88\xcbWdL\x88\xccZWL\x88\xd4]\x11L\x88\xd5E" +
+	"\xf2L\x88\xd6$ L\x88\xd7T\xbeL\x88\xd8WeL\x88\xd9ZYL\x89\x06K\x83L\x89\aa)L" +
+	"\x89\tT\xb7L\x89\n'\xcdL\x89\vW\xd3L\x89\fZ\xdcL\x89\x14_cL\x89\x15G3L\x89\x16" +
+	"T\xbaL\x89\x17'\xd1L\x89\x18W\xd5L\x89\x19Z\xdeL\x89FM\xebL\x89Gc\xadL\x89IW_" +
+	"L\x89JW\xd2L\x89K*\xbdL\x89LZ\x86L\x89Ta\xe5L\x89UE\x90L\x89VW`L\x89" +
+	"WW\xd3L\x89X*\xbfL\x89YZ\x88L\x89\x86L\xf9L\x89\x87f\x95L\x89\x89ZOL\x89\x8aZ" +
+	"\xd7L\x89\x8bZ\x85L\x89\x8c+\x9bL\x89\x94d\xcfL\x89\x95I6L\x89\x96ZRL\x89\x97Z\xdaL" +
+	"\x89\x98Z\x86L\x89\x99+\x9dL\x8bb\x80\x00L\x8bcI\x8cL\x8bdKZL\x8beM\xbcL\x8bf" +
+	"L\xcbL\x8b\xa1LqL\x8b\xa2U\x81L\x8b\xa3^bL\x8b\xa4`\xa9L\x8b\xa5c!L\x8b\xa6e\xfc" +
+	"L\x8b\xe2\x1f\aL\x8b\xe3G\xb0L\x8b\xe4H\xefL\x8b\xe5GSL\x8b\xe6J\xeaL\x8c#&\x06L\x8c" +
+	"$V4L\x8c%X\xceL\x8c&[\xb2L\x8cd)\xacL\x8ceY@L\x8cf\\8L\x8c\xa5," +
+	"\x93L\x8c\xa6[\xe7L\x8c\xe6-lL\x95\aK\x98L\x95\b\x93\xaaL\x95\n[,L\x95\v]\xd2L" +
+	"\x95\f`\xd1L\x95G\x93\xaaL\x95HJ*L\x95JX\xd1L\x95K[wL\x95L^uL\x95\x86" +
+	"\x80\x00L\x95\x87S\xe4L\x95\x88L\xccL\x95\x8aA\x17L\x95\x8b?\xdeL\x95\x8cC\x9dL\x95\xc6E\xfb" +
+	"L\x95\xc7\\\xf3L\x95\xc8Z\x9aL\x95\xca!\xdaL\x95\xcbQYL\x95\xccTmL\x96\x06H\x84L\x96" +
+	"\a_\x9aL\x96\b]AL\x96\nQZL\x96\v$\xf6L\x96\fU\x02L\x96FG\x91L\x96Gb" +
+	"\x97L\x96H`=L\x96JTkL\x96KU\x02L\x96L%\xdaL\x98\nE\xe0L\x98\vHcL" +
+	"\x98\fGrL\x98\x16\x7f\x12L\x98\x17E\xe2L\x98\x18HdL\x98\x19GsL\x98GL\xf2L\x98H" +
+	"\x94cL\x98J\\}L\x98K_\x18L\x98Lb\bL\x98TK\x12L\x98U\x92\xa4L\x98VS\x92" +
+	"L\x98W\\\x82L\x98X_\x1bL\x98Yb\vL\x98\x87\x94bL\x98\x88K\x86L\x98\x8aZ+L\x98" +
+	"\x8b\\\xc6L\x98\x8c_\xb6L\x98\x94\x92\xa3L\x98\x95I\xa6L\x98\x96L\x8eL\x98\x97Z0L\x98\x98\\" +
+	"\xcaL\x98\x99_\xbaL\x98\xc7U\x88L\x98\xc8N\x86L\x98\xcaB\xe2L\x98\xcbA\xaeL\x98\xccE^L" +
+	"\x98\xd4S\xafL\x98\xd5L\xadL\x98\xd6\x18\xe1L\x98\xd7B\xeaL\x98\xd8A\xb3L\x98\xd9EcL\x99\x06" +
+	"G\x97L\x99\a^=L\x99\b[\xeeL\x99\n#\xc3L\x99\vR\xd9L\x99\fU\xdeL\x99\x14\\z" +
+	"L\x99\x15Z*L\x99\x16B\xc6L\x99\x17#\xc9L\x99\x18R\xdcL\x99\x19U\xe1L\x99FJ\x15L\x99" +
+	"G`\xd6L\x99H^\x88L\x99JR\xd7L\x99K&\xd8L\x99LVsL\x99T_\x12L\x99U\\" +
+	"\xc1L\x99VA\x8cL\x99WR\xdaL\x99X&\xdcL\x99YVvL\x99\x86I#L\x99\x87c\xc3L" +
+	"\x99\x88atL\x99\x8aU\xd9L\x99\x8bVqL\x99\x8c'\xb6L\x99\x94b\x00L\x99\x95_\xafL\x99\x96" +
+	"E;L\x99\x97U\xddL\x99\x98VtL\x99\x99'\xbaL\x9bc\x80\x00L\x9bdG\x7fL\x9beI\xf5" +
+	"L\x9bfI\x04L\x9b\xa1LlL\x9b\xa2\x93\\L\x9b\xa3U6L\x9b\xa4]\xcbL\x9b\xa5`WL\x9b" +
+	"\xa6c7L\x9b\xe2K\x02L\x9b\xe3NHL\x9b\xe4[\x84L\x9b\xe5^\x10L\x9b\xe6`\xf0L\x9c#\x1b" +
+	"\fL\x9c$D\x91L\x9c%C\\L\x9c&F\xfcL\x9cd%\xb2L\x9ceTYL\x9cfWNL" +
+	"\x9c\xa5(\xbeL\x9c\xa6W\xe4L\x9c\xe6)\x96L\xa5\aJ\\L\xa5\b\x91\xd8L\xa5\t\x94PL\xa5\v" +
+	"Z\x9eL\xa5\f]\x9dL\xa5G\x91\xd8L\xa5HH\xe6L\xa5I\x90\x99L\xa5KX?L\xa5L[=" +
+	"L\xa5\x87\x94PL\xa5\x88\x90\x99L\xa5\x89GhL\xa5\x8bT\xfaL\xa5\x8cW\xf9L\xa5ƀ\x00L\xa5" +
+	"\xc7S\x92L\xa5\xc8LxL\xa5\xc9F\xe2L\xa5\xcb<CL\xa5\xcc@\x02L\xa6\x06D\\L\xa6\a\\" +
+	"eL\xa6\bZ\aL\xa6\tV\xc4L\xa6\v gL\xa6\fQzL\xa6FCjL\xa6G_aL" +
+	"\xa6H]\x03L\xa6IY\xc1L\xa6KQzL\xa6L!KL\xa8\vDJL\xa8\fCYL\xa8\x17" +
+	"\x7f\x14L\xa8\x18DML\xa8\x19C\\L\xa8GK\xbeL\xa8H\x92\x9cL\xa8I\x95\tL\xa8K[\xf0" +
+	"L\xa8L^\xe0L\xa8TI\xe0L\xa8U\x90\xdeL\xa8V\x93KL\xa8WSAL\xa8X[\xf5L\xa8" +
+	"Y^\xe5L\xa8\x87\x92\x9aL\xa8\x88JJL\xa8\x89\x91aL\xa8\x8bY\x9aL\xa8\x8c\\\x8aL\xa8\x94\x90" +
+	"\xddL\xa8\x95HlL\xa8\x96\x8f\xa2L\xa8\x97L;L\xa8\x98Y\x9fL\xa8\x99\\\x8fL\xa8Ǖ\x06L" +
+	"\xa8ȑ`L\xa8\xc9H\xceL\xa8\xcbVcL\xa8\xccYSL\xa8ԓHL\xa8Տ\xa0L\xa8\xd6" +
+	"F\xf1L\xa8\xd7F\xb4L\xa8\xd8VhL\xa8\xd9YXL\xa9\aU8L\xa9\bN4L\xa9\tH\xae" +
+	"L\xa9\v>\x1eL\xa9\fA\xceL\xa9\x14S`L\xa9\x15L[L\xa9\x16F\xd5L\xa9\x17\x14SL\xa9" +
+	"\x18>%L\xa9\x19A\xd5L\xa9FE\xfcL\xa9G]\xaeL\xa9H[\\L\xa9IX(L\xa9K\"" +
+	"ZL\xa9LR\xf7L\xa9T[\xedL\xa9UY\x99L\xa9VVcL\xa9W=\xffL\xa9X\"`L" +
+	"\xa9YR\xfbL\xa9\x86E\nL\xa9\x87`\x9bL\xa9\x88^HL\xa9\x89[\x14L\xa9\x8bR\xf5L\xa9\x8c" +
+	"#9L\xa9\x94^\xdbL\xa9\x95\\\x87L\xa9\x96YQL\xa9\x97A\xaeL\xa9\x98R\xfaL\xa9\x99#>" +
+	"L\xabd\x80\x00L\xabeE\xedL\xabfD\xfdL\xab\xa1KBL\xab\xa2\x91\xa0L\xab\xa3\x94\x02L\xab" +
+	"\xa4T\xe7L\xab\xa5]?L\xab\xa6`\x1fL\xab\xe2I\xcfL\xab\xe3\x90hL\xab\xe4M\xf7L\xab\xe5Z" +
+	"\xf4L\xab\xe6]\xd4L\xac#HWL\xac$H\x80L\xac%W\xccL\xac&Z\xabL\xacd\x16\x8cL" +
+	"\xace?\xdbL\xacfCzL\xac\xa5$SL\xac\xa6TwL\xac\xe6%+L\xb5\aI\x1eL\xb5\b" +
+	"\x8f\xd3L\xb5\t\x925L\xb5\n\x94\xedL\xb5\fZeL\xb5G\x8f\xd2L\xb5HG\x9fL\xb5I\x8e|" +
+	"L\xb5J\x915L\xb5LX\x05L\xb5\x87\x924L\xb5\x88\x8e|L\xb5\x89F\x1cL\xb5\x8a\x8c\x83L\xb5" +
+	"\x8cT\xbcL\xb5ǔ\xedL\xb5ȑ5L\xb5Ɍ\x83L\xb5\xcaFlL\xb5\xccU\xb0L\xb6\x06\x80" +
+	"\x00L\xb6\aSAL\xb6\bL L\xb6\tF\x85L\xb6\nCNL\xb6\f<eL\xb6F?@L" +
+	"\xb6G\\'L\xb6HY\xc9L\xb6IV\x82L\xb6JWuL\xb6L\x1c\xbcL\xb8\f?=L\xb8\x18" +
+	"\x7f\x14L\xb8\x19?BL\xb8GJ\x89L\xb8H\x90\xa0L\xb8I\x92\xf8L\xb8J\x95\xa6L\xb8L[\xb4" +
+	"L\xb8TH\xacL\xb8U\x8e\xe4L\xb8V\x91<L\xb8W\x93\xe9L\xb8XR\xf2L\xb8Y[\xbaL\xb8" +
+	"\x87\x90\x9eL\xb8\x88I\fL\xb8\x89\x8fOL\xb8\x8a\x91\xfcL\xb8\x8cY^L\xb8\x94\x8e\xe2L\xb8\x95G" +
+	"/L\xb8\x96\x8d\x90L\xb8\x97\x90>L\xb8\x98K\xe5L\xb8\x99YdL\xb8ǒ\xf5L\xb8ȏML" +
+	"\xb8\xc9G\x8bL\xb8ʍ^L\xb8\xccV#L\xb8ԑ9L\xb8Ս\x8fL\xb8\xd6E\xafL\xb8\xd7" +
+	"\x8b\x9eL\xb8\xd8FXL\xb8\xd9V)L\xb9\a\x95\xa1L\xb9\b\x91\xf9L\xb9\t\x8d]L\xb9\nG\xd7" +
+	"L\xb9\fW\x11L\xb9\x14\x93\xe5L\xb9\x15\x90;L\xb9\x16\x8b\x9cL\xb9\x17E\xfbL\xb9\x18C'L\xb9" +
+	"\x19W\x17L\xb9GT\xebL\xb9HM\xdfL\xb9IHTL\xb9JE&L\xb9L>>L\xb9TS" +
+	"\x13L\xb9UL\aL\xb9VF{L\xb9WCML\xb9X\x0f\xdeL\xb9Y>GL\xb9\x86@\xeeL" +
+	"\xb9\x87]nL\xb9\x88[\x1cL\xb9\x89W\xe4L\xb9\x8aX\xd3L\xb9\x8c\x1e\xbbL\xb9\x94[\xb3L\xb9\x95" +
+	"Y^L\xb9\x96V%L\xb9\x97W\x14L\xb9\x98>\x1fL\xb9\x99\x1e\xc2L\xbbe\x80\x00L\xbbf@\xf0" +
+	"L\xbb\xa1J\x16L\xbb\xa2\x8f\xb0L\xbb\xa3\x91\xfdL\xbb\xa4\x94\x9eL\xbb\xa5T\x9cL\xbb\xa6]\x02L\xbb" +
+	"\xe2H\x9bL\xbb\xe3\x8ebL\xbb\xe4\x91\x02L\xbb\xe5M\xa4L\xbb\xe6Z\xb7L\xbc#G\x1dL\xbc$\x8c" +
+	"wL\xbc%H'L\xbc&W\x8bL\xbcdGfL\xbceD\xffL\xbcfXuL\xbc\xa5\x12'L" +
+	"\xbc\xa6?\xf9L\xbc\xe6 \xc0L\xc5\aJ\x05L\xc5\b\x91FL\xc5\t\x92\xb1L\xc5\n\x95LL\xc5\v" +
+	"\x97RL\xc5G\x91GL\xc5HH}L\xc5I\x8e\xf8L\xc5J\x91\x94L\xc5K\x93\x99LŇ\x92\xb2" +
+	"Lň\x8e\xf8LŉF\xf4LŊ\x8c\xe0Lŋ\x8e\xe6L\xc5ǕNL\xc5ȑ\x94L\xc5" +
+	"Ɍ\xe0L\xc5\xcaG\x1bL\xc5ˎ\xe7L\xc6\a\x97RL\xc6\b\x93\x98L\xc6\t\x8e\xe4L\xc6\n\x8e" +
+	"\xe5L\xc6\vF\x9cL\xc6F\x80\x00L\xc6GU\x13L\xc6HM\x18L\xc6IG<L\xc6JD\xd2L" +
+	"\xc6KBJL\xc8\x19\x7f\x15L\xc8GKlL\xc8H\x92\nL\xc8I\x93nL\xc8J\x96\x00L\xc8K" +
+	"\x97\xfdL\xc8TI\x8fL\xc8U\x90RL\xc8V\x91\xb6L\xc8W\x94GL\xc8X\x96CL\xc8YT\xbd" +
+	"Lȇ\x92\nLȈI\xe5Lȉ\x8f\xc4LȊ\x92VLȋ\x94SLȔ\x90PL\xc8" +
+	"\x95H\nLȖ\x8e\nLȗ\x90\x9cLȘ\x92\x98LșL\xdbL\xc8ǓmL\xc8ȏ" +
+	"\xc3L\xc8\xc9H_L\xc8ʍ\xb6L\xc8ˏ\xb4L\xc8ԑ\xb3L\xc8Վ\tL\xc8\xd6F\x84L" +
+	"\xc8\u05cb\xfaL\xc8؍\xf6L\xc8\xd9G\x0eL\xc9\a\x95\xfdL\xc9\b\x92SL\xc9\t\x8d\xb4L\xc9\n" +
+	"H\x83L\xc9\v\x8f\xb3L\xc9\x14\x94CL\xc9\x15\x90\x99L\xc9\x16\x8b\xf8L\xc9\x17F\xa8L\xc9\x18\x8d\xf5" +
+	"L\xc9\x19D\xa7L\xc9G\x97\xf9L\xc9H\x94OL\xc9I\x8f\xb0L\xc9J\x8f\xb1L\xc9KH\x06L\xc9" +
+	"T\x96>L\xc9U\x92\x94L\xc9V\x8d\xf4L\xc9W\x8d\xf5L\xc9XF*L\xc9YB#LɇV" +
+	"\xb4LɈN\xd3LɉI\aLɊF\xa3LɋD\"LɔT\xddLɕL\xfcL" +
+	"ɖG0LɗD\xcbLɘBHLə\x10NL\xcbf\x80\x00LˡJ\xf6Lˢ" +
+	"\x91\x14Lˣ\x92qLˤ\x94\xf6L˥\x96\xeaL˦V_L\xcb\xe2IsL\xcb\xe3\x8e\xd6" +
+	"L\xcb\xe4\x91[L\xcb\xe5\x93OL\xcb\xe6N\x96L\xcc#G\xefL\xcc$\x8c\xceL\xcc%\x8e\xc2L\xcc" +
+	"&H\xd9L\xccdH\x11L\xcce\x8e\xc1L\xccfFwL̥G\x94L̦C\xfbL\xcc\xe6\x12" +
+	"\x93PuH,yPuI\x88TPuJ\x8ahPuK\x8caPuL\x8d\xa3Pu\x89-KP" +
+	"u\x8a\x86\tPu\x8b\x88\x05Pu\x8c\x89JPu\xca.RPuˇ\xd6Pủ\x1bPv\v" +
+	"/DPv\f\x88\xd0PvL/CPxH\xa5\x05PxI\xa7\xc8PxJ\xab\xb4PxK\xaf\x9d" +
+	"PxL\xb3xPx\x88.>Px\x89\x89\x80Px\x8a\x8b\x88Px\x8b\x8dvPx\x8c\x8e\xb0Px" +
+	"ȉ\x7fPx\xc9/\vPxʇ6Pxˉ'Px̊dPy\b\x8b\x85Py\t\x87" +
+	"5Py\n0\fPy\v\x88\xf7Py\f\x8a4PyH\x8drPyI\x89$PyJ\x88\xf6P" +
+	"yK0\xf9PyL\x89\xe8Py\x88\x8e\xacPy\x89\x8aaPy\x8a\x8a3Py\x8b\x89\xe8Py\x8c" +
+	"0\xf8P{\xa1\x80\x00P{\xa2\xa5\xadP{\xa3\xa8dP{\xa4\xacAP{\xa5\xb0\x1aP{\xa6\xb3\xe7" +
+	"P{\xe20\x03P{㊫P{䌦P{历P{揸P|#0\xcbP|" +
+	"$\x88cP|%\x8aGP|&\x8b{P|d1\xc6P|e\x8a\x17P|f\x8bKP|\xa52" +
+	"\xaeP|\xa6\x8b\x00P|\xe62\xadP\x85G\x80\x00P\x85ID\x82P\x85JF\xa1P\x85KH/P" +
+	"\x85LF\xe1P\x85\x87G\xf6P\x85\x89\"tP\x85\x8aU?P\x85\x8bXvP\x85\x8cZ\x84P\x85\xc7" +
+	"I\xd5P\x85\xc9U?P\x85\xca&NP\x85\xcbX\xfdP\x85\xcc[\vP\x86\aLJP\x86\tXw" +
+	"P\x86\nX\xfdP\x86\v)\xf5P\x86\fZ\xb4P\x86GN1P\x86IZ\x85P\x86J[\vP\x86" +
+	"KZ\xb4P\x86L,\x06P\x88IG\xd1P\x88JI\xabP\x88KL\x19P\x88LM\xfaP\x88U\x7f" +
+	"\x0fP\x88VG\xceP\x88WI\xa9P\x88XL\x17P\x88YM\xf8P\x88\x89F@P\x88\x8aHYP" +
+	"\x88\x8bI\xe3P\x88\x8cH\x9aP\x88\x95 \x88P\x88\x96FGP\x88\x97H^P\x88\x98I\xe8P\x88\x99" +
+	"H\x9cP\x88\xc7I\x92P\x88\xc9$YP\x88\xcaV\xb2P\x88\xcbY\xdcP\x88\xcc[\xe1P\x88\xd5F'" +
+	"P\x88\xd6$[P\x88\xd7V\xb4P\x88\xd8Y\xddP\x88\xd9[\xe1P\x89\aKgP\x89\tV\xafP\x89" +
+	"\n(&P\x89\vZ`P\x89\f\\fP\x89\x15H:P\x89\x16V\xb0P\x89\x17((P\x89\x18Z" +
+	"bP\x89\x19\\fP\x89GM\xd0P\x89IY\xd4P\x89JZ]P\x89K+\xc2P\x89L\\\x10P" +
+	"\x89UI\xc0P\x89VY\xd6P\x89WZ^P\x89X+\xc4P\x89Y\\\x0fP\x89\x87O\xadP\x89\x89" +
+	"[\xd8P\x89\x8a\\aP\x89\x8b\\\x0eP\x89\x8c-\xcfP\x89\x95HqP\x89\x96[\xd8P\x89\x97\\`" +
+	"P\x89\x98\\\x0eP\x89\x99-\xcfP\x8b\xa2\x80\x00P\x8b\xa3IjP\x8b\xa4K;P\x8b\xa5M\x9cP\x8b" +
+	"\xa6OtP\x8b\xe2\"\x9cP\x8b\xe3G\xe5P\x8b\xe4I\xf2P\x8b\xe5KtP\x8b\xe6J*P\x8c#&" +
+	"?P\x8c$X$P\x8c%[;P\x8c&]4P\x8cd*\x00P\x8ce[\xc1P\x8cf]\xbbP" +
+	"\x8c\xa5-\x91P\x8c\xa6]jP\x8c\xe6/\x99P\x95HKAP\x95J[FP\x95K]\xf2P\x95L" +
+	"`\xcfP\x95\x87\x80\x00P\x95\x88M1P\x95\x8aB\"P\x95\x8bD\x1bP\x95\x8cB\xd5P\x95\xc7E\xd8" +
+	"P\x95\xc8]\rP\x95\xca\"6P\x95\xcbS\xf0P\x95\xccV\xc6P\x96\aHbP\x96\b_\xbcP\x96" +
+	"\nS\xf0P\x96\v&\x03P\x96\fW]P\x96GKWP\x96Hb\x99P\x96JV\xc7P\x96KW" +
+	"^P\x96L)'P\x98JE\xbcP\x98KH>P\x98LK+P\x98V\x7f\x10P\x98WE\xbbP" +
+	"\x98XH>P\x98YK*P\x98\x88L\x97P\x98\x8a\\\x97P\x98\x8b_8P\x98\x8cb\tP\x98\x95" +
+	"J\xb5P\x98\x96L\xf3P\x98\x97\\\x9bP\x98\x98_;P\x98\x99b\nP\x98\xc8N\xeaP\x98\xcaC\xe9" +
+	"P\x98\xcbE\xdcP\x98\xccD\x9cP\x98\xd5M\x0fP\x98\xd6\x1c\x87P\x98\xd7C\xf0P\x98\xd8E\xe2P\x98" +
+	"\xd9D\x9fP\x99\aGxP\x99\b^YP\x99\n$\x1cP\x99\vUfP\x99\fX0P\x99\x15\\" +
+	"\x94P\x99\x16C\xceP\x99\x17$\x1fP\x99\x18UiP\x99\x19X2P\x99GI\xf5P\x99H`\xf8P" +
+	"\x99JUbP\x99K'\xddP\x99LX\xc5P\x99U_1P\x99VE\xbcP\x99WUeP\x99X" +
+	"'\xe1P\x99YX\xc7P\x99\x87L\xddP\x99\x88c\xc7P\x99\x8aX+P\x99\x8bX\xc4P\x99\x8c*\xfa" +
+	"P\x99\x95a\xfeP\x99\x96DvP\x99\x97X,P\x99\x98X\xc5P\x99\x99*\xfdP\x9b\xa3\x80\x00P\x9b" +
+	"\xa4G[P\x9b\xa5I\xd1P\x9b\xa6L\xb0P\x9b\xe2L\vP\x9b\xe3N\xacP\x9b\xe4]\xe6P\x9b\xe5`" +
+	"wP\x9b\xe6c8P\x9c#\x1e\xa7P\x9c$E\x94P\x9c%G}P\x9c&F<P\x9cd&\x06P" +
+	"\x9ceV\xdbP\x9cfY\x96P\x9c\xa5)\xbbP\x9c\xa6Z-P\x9c\xe6,\xd0P\xa5HI\xfdP\xa5I" +
+	"\x94&P\xa5KZ\xbaP\xa5L]\x97P\xa5\x88\x94&P\xa5\x89H\x80P\xa5\x8bWuP\xa5\x8cZR" +
+	"P\xa5ǀ\x00P\xa5\xc8L\xe2P\xa5\xc9G\x13P\xa5\xcb@\x80P\xa5\xcc?:P\xa6\aD:P\xa6" +
+	"\b\\\x82P\xa6\tY?P\xa6\v!tP\xa6\fS\xd5P\xa6GG/P\xa6H__P\xa6I\\" +
+	"\x1cP\xa6KS\xd5P\xa6L$\x98P\xa8KD&P\xa8LG\x12P\xa8W\x7f\x12P\xa8XD'P" +
+	"\xa8YG\x13P\xa8\x88K[P\xa8\x89\x94\xe0P\xa8\x8b\\\fP\xa8\x8c^\xddP\xa8\x95I{P\xa8\x96" +
+	"\x93 P\xa8\x97L\xa6P\xa8\x98\\\x11P\xa8\x99^\xe0P\xa8Ȕ\xdfP\xa8\xc9I\xe0P\xa8\xcbX\xd5" +
+	"P\xa8\xcc[\xa6P\xa8Փ\x1fP\xa8\xd6H\x00P\xa8\xd7F\xe5P\xa8\xd8X\xd9P\xa8\xd9[\xa9P\xa9" +
+	"\bN\x9dP\xa9\tH\xdfP\xa9\vBLP\xa9\fA\fP\xa9\x15L\xc3P\xa9\x16G\x04P\xa9\x17\x17" +
+	"\xfcP\xa9\x18BTP\xa9\x19A\x11P\xa9GE\xdcP\xa9H]\xcbP\xa9IZ\x98P\xa9K#`P" +
+	"\xa9LUIP\xa9U\\\tP\xa9VX\xd3P\xa9WB/P\xa9X#eP\xa9YULP\xa9\x87" +
+	"H\xc4P\xa9\x88`\x9bP\xa9\x89]gP\xa9\x8bUHP\xa9\x8c&}P\xa9\x95^\xd6P\xa9\x96[\xa0" +
+	"P\xa9\x97@\xe9P\xa9\x98UJP\xa9\x99&\x80P\xab\xa4\x80\x00P\xab\xa5E\xc9P\xab\xa6H\xa8P\xab" +
+	"\xe2J\xd9P\xab\xe3\x93\xd9P\xab\xe4N`P\xab\xe5]ZP\xab\xe6`\x1cP\xac#I`P\xac$H" +
+	"\xb1P\xac%Z2P\xac&\\\xf4P\xacd\x1a)P\xaceC\xfbP\xacfB\xbbP\xac\xa5%PP" +
+	"\xac\xa6V\xbfP\xac\xe6(eP\xb5HH\xb7P\xb5I\x92\tP\xb5J\x94\xc1P\xb5LZ_P\xb5\x88" +
+	"\x92\tP\xb5\x89G4P\xb5\x8a\x90\x10P\xb5\x8cW\x16P\xb5Ȕ\xc1P\xb5ɐ\x10P\xb5\xcaG\x83" +
+	"P\xb5\xccX\tP\xb6\a\x80\x00P\xb6\bL\x90P\xb6\tF\xbfP\xb6\nD]P\xb6\f;\x9fP\xb6" +
+	"GC\x04P\xb6H\\%P\xb6IX\xdeP\xb6JY\xd1P\xb6L \tP\xb8LB\xf5P\xb8X\x7f" +
+	"\x14P\xb8YB\xf8P\xb8\x88J\x1dP\xb8\x89\x92\xcdP\xb8\x8a\x95{P\xb8\x8c[\xb1P\xb8\x95H>P" +
+	"\xb8\x96\x91\x0fP\xb8\x97\x93\xbdP\xb8\x98LUP\xb8\x99[\xb5P\xb8Ȓ\xccP\xb8\xc9H\x9cP\xb8\xca" +
+	"\x90\xddP\xb8\xccXuP\xb8Ց\x0eP\xb8\xd6F\xbeP\xb8\u05cf\x1dP\xb8\xd8F\x92P\xb8\xd9Xz" +
+	"P\xb9\b\x95xP\xb9\t\x90\xdbP\xb9\nH\xe8P\xb9\fYcP\xb9\x15\x93\xbaP\xb9\x16\x8f\x1bP\xb9" +
+	"\x17G\nP\xb9\x18D3P\xb9\x19YhP\xb9HNMP\xb9IH\x8cP\xb9JF0P\xb9L=" +
+	"|P\xb9ULtP\xb9VF\xb2P\xb9WDUP\xb9X\x13\x89P\xb9Y=\x83P\xb9\x87D\xa7P" +
+	"\xb9\x88]oP\xb9\x89Z7P\xb9\x8a[&P\xb9\x8c!\xffP\xb9\x95[\xadP\xb9\x96XsP\xb9\x97" +
+	"YcP\xb9\x98=\\P\xb9\x99\"\x04P\xbb\xa5\x80\x00P\xbb\xa6D\x9cP\xbb\xe2I\xa4P\xbb\xe3\x91\xd2" +
+	"P\xbb\xe4\x94sP\xbb\xe5N\x12P\xbb\xe6\\\xffP\xbc#H&P\xbc$\x8f\xe8P\xbc%H`P\xbc" +
+	"&Y\xd3P\xbcdHpP\xbceF\x06P\xbcfZ\xbeP\xbc\xa5\x15\xc4P\xbc\xa6?9P\xbc\xe6#" +
+	"\xfaP\xc5HGnP\xc5I\x8f\xb8P\xc5J\x92pP\xc5K\x95-Pň\x8f\xb8PŉE\xe6P" +
+	"Ŋ\x8d\xbdPŋ\x90yP\xc5ȒpP\xc5ɍ\xbdP\xc5\xcaF2P\xc5ː\x97P\xc6\b" +
+	"\x95-P\xc6\t\x90zP\xc6\n\x90\x97P\xc6\vF\xacP\xc6G\x80\x00P\xc6HL@P\xc6IFg" +
+	"P\xc6JD\x00P\xc6KAzP\xc8Y\x7f\x14PȈH\xddPȉ\x90\x87PȊ\x934P\xc8" +
+	"\x8b\x95\xe6PȕF\xffPȖ\x8e\xcaPȗ\x91xPȘ\x94)PșL\x06P\xc8Ȑ" +
+	"\x85P\xc8\xc9GWP\xc8ʎ\x94P\xc8ˑFP\xc8Վ\xc9P\xc8\xd6EzP\xc8\u05cc\xd6P" +
+	"\xc8؏\x88P\xc8\xd9F<P\xc9\b\x931P\xc9\t\x8e\x93P\xc9\nG\xa0P\xc9\v\x91aP\xc9\x15" +
+	"\x91uP\xc9\x16\x8c\xd4P\xc9\x17E\xc3P\xc9\x18\x8f\xa3P\xc9\x19C\xd7P\xc9H\x95\xe1P\xc9I\x91C" +
+	"P\xc9J\x91_P\xc9KH\x17P\xc9U\x94%P\xc9V\x8f\x84P\xc9W\x8f\xa1P\xc9XF:P\xc9" +
+	"YAVPɈN\x00PɉH8PɊE\xd6PɋCWPɕL(PɖF" +
+	"_PɗC\xfcPɘA}Pə\x0f/P˦\x80\x00P\xcb\xe2HnP\xcb㏘P" +
+	"\xcb\xe4\x929P\xcb\xe5\x94\xdeP\xcb\xe6M\xc7P\xcc#F\xebP\xcc$\x8d\xacP\xcc%\x90QP\xcc&" +
+	"H\rP\xccdG1P\xcce\x90kP\xccfE\xadP̥G\xa5P̦C3P\xcc\xe6\x11z" +
+	"T\x85\x89,NT\x85\x8a\x88\x9dT\x85\x8b\x8a\xb8T\x85\x8c\x8c\xb9T\x85\xca-UT\x85ˊ\x8aT\x85" +
+	"̌\x8aT\x86\v.mT\x86\f\x8c\\T\x86L/gT\x88\x89\xa5sT\x88\x8a\xa9hT\x88\x8b\xad" +
+	"^T\x88\x8c\xb1RT\x88\xc9.\x14T\x88ʉ\xcaT\x88ˋ\xd9T\x88̍\xceT\x89\t\x89\xc9T" +
+	"\x89\n/\x15T\x89\v\x8b\xa9T\x89\f\x8d\x9eT\x89I\x8b\xd6T\x89J\x8b\xa8T\x89K0'T\x89L" +
+	"\x8dnT\x89\x89\x8d\xcaT\x89\x8a\x8d\x9cT\x89\x8b\x8dmT\x89\x8c1\x1cT\x8b\xe2\x80\x00T\x8b\xe3\xa6\x1c" +
+	"T\x8b\xe4\xaa\x01T\x8b\xe5\xad\xe7T\x8b\xe6\xb1\xcbT\x8c#/\xd9T\x8c$\x8a\xf5T\x8c%\x8c\xf7T\x8c" +
+	"&\x8e\xe0T\x8cd0\xd5T\x8ce\x8c\xc7T\x8cf\x8e\xb0T\x8c\xa51\xe1T\x8c\xa6\x8e\x80T\x8c\xe62" +
+	"\xd1T\x95\x88\x80\x00T\x95\x8aBDT\x95\x8bE$T\x95\x8cG\x11T\x95\xc8E\xb6T\x95\xca\"tT" +
+	"\x95\xcbU\xeaT\x95\xccYCT\x96\bHCT\x96\nU\xeaT\x96\v&^T\x96\fY\xf0T\x96H" +
+	"K9T\x96JYDT\x96KY\xf0T\x96L*4T\x98\x8aE\x99T\x98\x8bH\x1eT\x98\x8cK\n" +
+	"T\x98\x96\x7f\x0fT\x98\x97E\x96T\x98\x98H\x1bT\x98\x99K\tT\x98\xcaD\nT\x98\xcbF\xe1T\x98" +
+	"\xccH\xc8T\x98\xd6 (T\x98\xd7D\x11T\x98\xd8F\xe7T\x98\xd9H\xcdT\x99\bGZT\x99\n$" +
+	"XT\x99\vWYT\x99\fZ\xa4T\x99\x16C\xf1T\x99\x17$ZT\x99\x18W[T\x99\x19Z\xa5T" +
+	"\x99HI\xdaT\x99JWVT\x99K(6T\x99L[NT\x99VF\xc2T\x99WWWT\x99X" +
+	"(8T\x99Y[OT\x99\x88L\xc1T\x99\x8aZ\x9dT\x99\x8b[KT\x99\x8c,\x00T\x99\x96H\xa4" +
+	"T\x99\x97Z\x9eT\x99\x98[LT\x99\x99,\x02T\x9b\xe3\x80\x00T\x9b\xe4G:T\x9b\xe5I\xb2T\x9b" +
+	"\xe6L\x91T\x9c#\"<T\x9c$E\xb7T\x9c%H\x7fT\x9c&J[T\x9cd&>T\x9ceX" +
+	"\xc6T\x9cf[\xffT\x9c\xa5*\x0fT\x9c\xa6\\\xaaT\x9c\xe6-\xcdT\xa5\x89I\x97T\xa5\x8bY\xe7T" +
+	"\xa5\x8c\\\xc9T\xa5Ȁ\x00T\xa5\xc9GaT\xa5\xcbA\x89T\xa5\xccCuT\xa6\bD\x17T\xa6\t" +
+	"[\xaeT\xa6\v!\xcfT\xa6\fVgT\xa6HG\x0eT\xa6I^\x93T\xa6KVhT\xa6L%\xa5" +
+	"T\xa8\x8bD\x01T\xa8\x8cF\xeeT\xa8\x97\x7f\x10T\xa8\x98D\x00T\xa8\x99F\xeeT\xa8\xc9J\xf1T\xa8" +
+	"\xcb[=T\xa8\xcc^\x13T\xa8\xd6I\x0fT\xa8\xd7G3T\xa8\xd8[@T\xa8\xd9^\x16T\xa9\tI" +
+	"+T\xa9\vCQT\xa9\fE8T\xa9\x16GPT\xa9\x17\x1b\xa2T\xa9\x18CXT\xa9\x19E?T" +
+	"\xa9HE\xbdT\xa9I\\\xfeT\xa9K#\xb8T\xa9LW\xd2T\xa9V[:T\xa9WC5T\xa9X" +
+	"#\xbcT\xa9YW\xd5T\xa9\x88H\xa4T\xa9\x89_\xd2T\xa9\x8bW\xcfT\xa9\x8c'\x82T\xa9\x96^\f" +
+	"T\xa9\x97E\x17T\xa9\x98W\xd1T\xa9\x99'\x85T\xab\xe4\x80\x00T\xab\xe5E\xa6T\xab\xe6H\x84T\xac" +
+	"#JiT\xac$H\xfdT\xac%\\\x8fT\xac&_VT\xacd\x1d\xc4T\xaceD\xfdT\xacfF" +
+	"\xdaT\xac\xa5%\xa4T\xac\xa6Y<T\xac\xe6)bT\xb5\x89HKT\xb5\x8a\x93\x9dT\xb5\x8cY\x8dT" +
+	"\xb5ɓ\x9dT\xb5\xcaH\x9aT\xb5\xccZ\x80T\xb6\b\x80\x00T\xb6\tG\x12T\xb6\nD\x81T\xb6\f" +
+	"?\xdaT\xb6HB\xe2T\xb6I[TT\xb6J\\GT\xb6L!\x16T\xb8\x8cB\xd1T\xb8\x98\x7f\x12" +
+	"T\xb8\x99B\xd3T\xb8\xc9I\xadT\xb8ʔ[T\xb8\xccZ\xe3T\xb8\xd6G\xcdT\xb8ג\x9cT\xb8" +
+	"\xd8F\xe5T\xb8\xd9Z\xe8T\xb9\t\x94ZT\xb9\nI\xf9T\xb9\f[\xd1T\xb9\x16\x92\x9aT\xb9\x17H" +
+	"\x19T\xb9\x18DWT\xb9\x19[\xd6T\xb9IH\xdeT\xb9JFRT\xb9LA\xa9T\xb9VG\x03T" +
+	"\xb9WDwT\xb9X\x172T\xb9YA\xb0T\xb9\x88D\x87T\xb9\x89\\\xa2T\xb9\x8a]\x92T\xb9\x8c" +
+	"#\x04T\xb9\x96Z\xdfT\xb9\x97[\xcfT\xb9\x98A\x8aT\xb9\x99#\tT\xbb\xe5\x80\x00T\xbb\xe6Dx" +
+	"T\xbc#I/T\xbc$\x93XT\xbc%H\xb2T\xbc&\\5T\xbcdIyT\xbceF)T\xbc" +
+	"f] T\xbc\xa5\x19bT\xbc\xa6CXT\xbc\xe6$\xf7TŉF\xfdTŊ\x91JTŋ\x94" +
+	"\x06T\xc5ɑJT\xc5\xcaGJT\xc5˔$T\xc6\t\x94\x06T\xc6\n\x94$T\xc6\vG\xc4T" +
+	"\xc6H\x80\x00T\xc6IF\xc0T\xc6JD,T\xc6KB\x8aTș\x7f\x14T\xc8\xc9HhT\xc8\xca" +
+	"\x92\x13T\xc8˔\xc4T\xc8\xd6F\x89T\xc8אUT\xc8ؓ\x06T\xc8\xd9F\x95T\xc9\t\x92\x11" +
+	"T\xc9\nH\xb1T\xc9\v\x94\xdfT\xc9\x16\x90ST\xc9\x17F\xd2T\xc9\x18\x93\"T\xc9\x19D\x04T\xc9" +
+	"I\x94\xc1T\xc9J\x94\xdeT\xc9KI(T\xc9V\x93\x03T\xc9W\x93 T\xc9XGJT\xc9YB" +
+	"cTɉH\x8eTɊF\x00TɋDaTɖF\xb4TɗD%TɘB\x87T" +
+	"ə\x12\xd9T\xcb\xe6\x80\x00T\xcc#G\xf4T\xcc$\x91\x1cT\xcc%\x93\xc1T\xcc&HcT\xccd" +
+	"H:T\xcce\x93\xdcT\xccfE\xd8T̥H\xaeȚD:T\xcc\xe6\x15\x17X\x95\xca,Y" +
+	"X\x95ˍ\x9fX\x95̏\xbcX\x96\v-qX\x96\f\x8f\x8dX\x96L.\x91X\x98ʥ\xdeX\x98" +
+	"˩\xdaX\x98̭\xd8X\x99\n.\x1eX\x99\v\x8e\xbcX\x99\f\x90\xceX\x99J\x8e\xbbX\x99K/" +
+	"0X\x99L\x90\x9eX\x99\x8a\x90\xcbX\x99\x8b\x90\x9cX\x99\x8c0JX\x9c#\x80\x00X\x9c$\xa6\x86X" +
+	"\x9c%\xaasX\x9c&\xaeaX\x9cd/\xe3X\x9ce\x8f\xd8X\x9cf\x91\xdcX\x9c\xa50\xefX\x9c\xa6" +
+	"\x91\xacX\x9c\xe62\x03X\xa5ɀ\x00X\xa5\xcbA\xc3X\xa5\xccD\xbcX\xa6\tD\x00X\xa6\v\"\x8a" +
+	"X\xa6\fY1X\xa6IF\xf9X\xa6KY1X\xa6L&|X\xa8\xcbC\xe9X\xa8\xccF\xd8X\xa8" +
+	"\xd7\x7f\x0fX\xa8\xd8C\xe5X\xa8\xd9F\xd5X\xa9\vC\x8aX\xa9\fFzX\xa9\x17 \x86X\xa9\x18C" +
+	"\x91X\xa9\x19F\x7fX\xa9IE\xaaX\xa9K$mX\xa9LZ\x92X\xa9WCpX\xa9X$nX" +
+	"\xa9YZ\x93X\xa9\x89H\x94X\xa9\x8bZ\x8eX\xa9\x8c(SX\xa9\x97FZX\xa9\x98Z\x90X\xa9\x99" +
+	"(UX\xac$\x80\x00X\xac%E\x8fX\xac&HoX\xacd\"\x98X\xaceE7X\xacfH\x17" +
+	"X\xac\xa5&RX\xac\xa6[\xf0X\xac\xe6*+X\xb5\xcaJJX\xb5\xcc]\xd7X\xb6\t\x80\x00X\xb6" +
+	"\nD\xf6X\xb6\fA!X\xb6IB\xc9X\xb6J_\x9cX\xb6L!\xedX\xb8\xccB\xb7X\xb8\xd8\x7f" +
+	"\x10X\xb8\xd9B\xb6X\xb9\nK\x9fX\xb9\f_\x1aX\xb9\x17I\xbdX\xb9\x18D\xcdX\xb9\x19_\x1dX" +
+	"\xb9JF\xc6X\xb9LB\xeaX\xb9WD\xeaX\xb9X\x1c\x1cX\xb9YB\xf1X\xb9\x89DrX\xb9\x8a" +
+	"`\xd9X\xb9\x8c#\xd5X\xb9\x97_\x17X\xb9\x98B\xcdX\xb9\x99#\xd8X\xbc%\x80\x00X\xbc&D_" +
+	"X\xbcdK\x13X\xbceF\x9cX\xbcf`ZX\xbc\xa5\x1e;X\xbc\xa6D\x96X\xbc\xe6%\xc0X\xc5" +
+	"\xcaH\xf9X\xc5˘\xb0X\xc6\n\x98\xb0X\xc6\vIsX\xc6I\x80\x00X\xc6JD\xa7X\xc6KB" +
+	"\xc7X\xc8\xd9\x7f\x12X\xc9\nJWX\xc9\v\x99XX\xc9\x17HvX\xc9\x18\x97\x9bX\xc9\x19D\x7fX" +
+	"\xc9J\x99WX\xc9KJ\xceX\xc9W\x97\x99X\xc9XH\xedX\xc9YB\xa1XɊFyXɋ" +
+	"D\x9dXɗD\x9eXɘB\xc1Xə\x17\xc6X\xcc&\x80\x00X\xccdI\xd4X\xcce\x98B" +
+	"X\xccfFQX̥JHX̦DvX\xcc\xe6\x19\xf3\\\xa6\v,f\\\xa6\f\x8fz\\\xa6" +
+	"L-\x86\\\xa9\v\xaa\xa4\\\xa9\f\xae\xa2\\\xa9K.*\\\xa9L\x90\x8d\\\xa9\x8b\x90\x8b\\\xa9\x8c/" +
+	"E\\\xacd\x80\x00\\\xace\xab=\\\xacf\xaf+\\\xac\xa5/\xef\\\xac\xa6\x91\x9e\\\xac\xe61\x03\\" +
+	"\xb6\n\x80\x00\\\xb6\f@A\\\xb6JBW\\\xb6L \xc9\\\xb9\fBF\\\xb9\x18\x7f\x0f\\\xb9\x19" +
+	"BD\\\xb9LB\x0f\\\xb9X\x1a\xfc\\\xb9YB\x14\\\xb9\x8aD\x03\\\xb9\x8c\"\xb7\\\xb9\x98A\xef" +
+	"\\\xb9\x99\"\xb9\\\xbce\x80\x00\\\xbcfC\xf0\\\xbc\xa5\x1d!\\\xbc\xa6C\xbe\\\xbc\xe6$\xa7\\\xc6" +
+	"\vHl\\\xc6J\x80\x00\\\xc6KA\xe7\\\xc9\x19\x7f\x10\\\xc9KI\xcc\\\xc9XG\xea\\\xc9YA" +
+	"\xc3\\ɋC\xc2\\ɘA\xe5\\ə\x16\xa7\\\xccf\x80\x00\\̥IJ\\̦C\x9e\\" +
+	"\xcc\xe6\x18\xda`\xb6L,{`\xb9L\xafE`\xb9\x8c.?`\xbc\xa5\x80\x00`\xbc\xa6\xaf\xce`\xbc\xe6" +
+	"0\x03`\xc6K\x80\x00`\xc9Y\x7f\x0f`ə\x15\x87`̦\x80\x00`\xcc\xe6\x17\xc0d\xcc\xe6\x80\x00"
@@ -0,0 +1,129 @@
+package poker
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func TestClass(t *testing.T) {
+	total := 0
+	for c := Class(0); c < Classes; c++ {
+		p, err := ParseClass(c.String())
+		if err != nil || p != c {
+			t.Error("Expected", c, "received:", p, err)
+		}
+		combos := c.Combos()
+		total += len(combos)
+		for _, h := range combos {
+			if ClassOf(h) != c {
+				t.Error("Expected", c, "received:", ClassOf(h))
+			}
+		}
+	}
+	if total != 1326 {
+		t.Error("Expected 1326 holes, received:", total)
+	}
+
+	for name, n := range map[string]int{"AA": 6, "AKs": 4, "72o": 12} {
+		c, err := ParseClass(name)
+		if err != nil || c.String() != name || len(c.Combos()) != n {
+			t.Error("Expected", name, "with", n, "holes, received:", c, len(c.Combos()), err)
+		}
+	}
+	for _, name := range []string{"AAs", "AK", "A", "AKx", "1Ks"} {
+		if _, err := ParseClass(name); err != ErrClass {
+			t.Error("Expected", ErrClass, "for", name, "received:", err)
+		}
+	}
+}
+
+func hole(t *testing.T, codes string) Hole {
+	h, err := ParseHole(codes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestEquity(t *testing.T) {
+	aces, kings := hole(t, "AS AD"), hole(t, "KS KD")
+	exact, err := ExactEquity(aces, kings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(exact-0.8264) > 0.0001 {
+		t.Error("Expected 0.8264, received:", exact)
+	}
+
+	e, err := Equity(aces, kings)
+	if err != nil || math.Abs(e-exact) > 1.0/65535 {
+		t.Error("Expected", exact, "received:", e, err)
+	}
+	// The same matchup with other suits, and the other way round
+	e, err = Equity(hole(t, "KH KC"), hole(t, "AC AH"))
+	if err != nil || math.Abs(e-(1-exact)) > 1.0/65535 {
+		t.Error("Expected", 1-exact, "received:", e, err)
+	}
+
+	if _, err := Equity(aces, hole(t, "AS KC")); err != ErrHole {
+		t.Error("Expected", ErrHole, "received:", err)
+	}
+	if _, err := ExactEquity(aces, Hole{}); err != ErrHole {
+		t.Error("Expected", ErrHole, "received:", err)
+	}
+	rankless := Hole{{Suit: deck.Spade}, {Suit: deck.Heart}}
+	if _, err := ExactEquity(aces, rankless); err != ErrHole {
+		t.Error("Expected", ErrHole, "received:", err)
+	}
+	if _, err := Equity(aces, rankless); err != ErrHole {
+		t.Error("Expected", ErrHole, "received:", err)
+	}
+}
+
+func TestClassEquity(t *testing.T) {
+	class := func(name string) Class {
+		c, err := ParseClass(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	tests := []struct {
+		hero, villain string
+		equity        float64
+	}{
+		{"AA", "KK", 0.82},
+		{"AKs", "QQ", 0.46},
+		{"22", "AKo", 0.52},
+		{"AA", "72o", 0.88},
+	}
+	for _, tt := range tests {
+		e := ClassEquity(class(tt.hero), class(tt.villain))
+		if math.Abs(e-tt.equity) > 0.01 {
+			t.Error("Expected", tt.equity, "for", tt.hero, "against", tt.villain, "received:", e)
+		}
+	}
+
+	for a := Class(0); a < Classes; a++ {
+		for b := Class(0); b < Classes; b++ {
+			if s := ClassEquity(a, b) + ClassEquity(b, a); math.Abs(s-1) > 2.0/65535 {
+				t.Error("Expected equities adding up to 1 for", a, "and", b, "received:", s)
+			}
+		}
+	}
+}
+
+func ExampleClassEquity() {
+	for _, m := range [][2]string{{"AA", "KK"}, {"AKs", "QQ"}, {"72o", "AKs"}} {
+		hero, _ := ParseClass(m[0])
+		villain, _ := ParseClass(m[1])
+		fmt.Printf("%v vs %v: %.3f\n", hero, villain, ClassEquity(hero, villain))
+	}
+	// Output:
+	// AA vs KK: 0.819
+	// AKs vs QQ: 0.460
+	// 72o vs AKs: 0.309
+}
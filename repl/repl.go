@@ -0,0 +1,336 @@
+// Package repl is an interactive shell over named piles of cards, to explore decks and teach with them.
+//
+// Every command is a line of words, the options written as name=value:
+//
+//	new [jokers=N] [decks=N] [to PILE]   clears every pile and puts a new deck in the stock, or in PILE
+//	shuffle [PILE] [seed=N]              shuffles the stock, or PILE, at random or reproducibly
+//	draw N [from PILE] to PILE           moves the top N cards of the stock, or of the pile given, onto PILE
+//	move CARD... [from PILE] to PILE     moves the cards, written as codes such as AS or TD, onto PILE
+//	show [PILE]                          lists the piles holding cards, or the cards of PILE
+//	eval PILE...                         evaluates the cards of the piles together as a poker hand
+//	undo                                 undoes the last command that changed the piles
+//	save FILE                            writes the piles to FILE
+//	load FILE                            reads the piles from FILE, as written by save
+//	run FILE                             runs the commands of FILE
+//	help                                 lists the commands
+//
+// Lines starting with # are comments. The top of a pile is its last card.
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/euller88/deck"
+	"github.com/euller88/deck/poker"
+)
+
+// The errors returned by the commands
+var (
+	ErrCommand = errors.New("repl: unknown command, try help")
+	ErrUsage   = errors.New("repl: wrong arguments")
+	ErrUndo    = errors.New("repl: nothing to undo")
+	ErrQuit    = errors.New("repl: quit")
+)
+
+// Shell holds the piles of a session.
+type Shell struct {
+	// Piles holds the cards of the session, by name
+	Piles deck.Piles
+
+	out  io.Writer
+	tx   *deck.Tx
+	undo []deck.Savepoint
+}
+
+// New returns a shell with no piles, writing its output to out.
+func New(out io.Writer) *Shell {
+	s := &Shell{Piles: deck.Piles{}, out: out}
+	s.tx = deck.Begin(s.Piles)
+	return s
+}
+
+// Run executes the commands read from in. When interactive, a prompt is shown and the errors are reported without
+// stopping; otherwise, as when running a script, the first error stops Run. The quit and exit commands end Run.
+func (s *Shell) Run(in io.Reader, interactive bool) error {
+	sc := bufio.NewScanner(in)
+	for line := 1; ; line++ {
+		if interactive {
+			fmt.Fprint(s.out, "deck> ")
+		}
+		if !sc.Scan() {
+			return sc.Err()
+		}
+		err := s.Exec(sc.Text())
+		switch {
+		case err == ErrQuit:
+			return nil
+		case err != nil && interactive:
+			fmt.Fprintln(s.out, err)
+		case err != nil:
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+	}
+}
+
+// Exec executes a single command.
+func (s *Shell) Exec(line string) error {
+	words := strings.Fields(line)
+	if len(words) == 0 || strings.HasPrefix(words[0], "#") {
+		return nil
+	}
+	args, opts := parseArgs(words[1:])
+
+	switch strings.ToLower(words[0]) {
+	case "new":
+		return s.change(func() error { return s.newDeck(args, opts) })
+	case "shuffle":
+		return s.change(func() error { return s.shuffle(args, opts) })
+	case "draw":
+		return s.change(func() error { return s.draw(args) })
+	case "move":
+		return s.change(func() error { return s.move(args) })
+	case "load":
+		return s.change(func() error { return s.load(args) })
+	case "show":
+		return s.show(args)
+	case "eval":
+		return s.eval(args)
+	case "undo":
+		return s.back()
+	case "save":
+		return s.save(args)
+	case "run":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return s.Run(f, false)
+	case "help":
+		fmt.Fprintln(s.out, "commands: new, shuffle, draw, move, show, eval, undo, save, load, run, help, quit")
+		return nil
+	case "quit", "exit":
+		return ErrQuit
+	}
+	return ErrCommand
+}
+
+// change runs a command that changes the piles, so it can be undone, and leaves the piles untouched if it fails.
+func (s *Shell) change(f func() error) error {
+	sp := s.tx.Savepoint()
+	if err := s.tx.Try(func(*deck.Tx) error { return f() }); err != nil {
+		return err
+	}
+	if s.tx.Savepoint() != sp {
+		s.undo = append(s.undo, sp)
+	}
+	return nil
+}
+
+func (s *Shell) back() error {
+	if len(s.undo) == 0 {
+		return ErrUndo
+	}
+	sp := s.undo[len(s.undo)-1]
+	s.undo = s.undo[:len(s.undo)-1]
+	return s.tx.RollbackTo(sp)
+}
+
+func (s *Shell) newDeck(args []string, opts map[string]int) error {
+	to, rest := target(args)
+	if len(rest) > 0 {
+		return ErrUsage
+	}
+	if to == "" {
+		to = "stock"
+	}
+	var options []func([]deck.Card) []deck.Card
+	if n := opts["jokers"]; n > 0 {
+		options = append(options, deck.Jokers(n))
+	}
+	if n := opts["decks"]; n > 1 {
+		options = append(options, deck.Deck(n))
+	}
+	for _, name := range s.names() {
+		s.tx.Clear(name)
+	}
+	return s.tx.Put(to, deck.New(options...)...)
+}
+
+func (s *Shell) shuffle(args []string, opts map[string]int) error {
+	pile := "stock"
+	switch len(args) {
+	case 0:
+	case 1:
+		pile = args[0]
+	default:
+		return ErrUsage
+	}
+	seed := time.Now().UnixNano()
+	if n, ok := opts["seed"]; ok {
+		seed = int64(n)
+	}
+	return s.tx.Shuffle(pile, rand.New(rand.NewSource(seed)))
+}
+
+func (s *Shell) draw(args []string) error {
+	to, rest := target(args)
+	from, rest := source(rest)
+	if to == "" || len(rest) != 1 {
+		return ErrUsage
+	}
+	n, err := strconv.Atoi(rest[0])
+	if err != nil {
+		return ErrUsage
+	}
+	return s.tx.Draw(from, to, n)
+}
+
+func (s *Shell) move(args []string) error {
+	to, rest := target(args)
+	from, rest := source(rest)
+	if to == "" || len(rest) == 0 {
+		return ErrUsage
+	}
+	cards, err := deck.ParseCards(strings.Join(rest, " "))
+	if err != nil {
+		return err
+	}
+	for _, c := range cards {
+		if err := s.tx.Move(from, to, c); err != nil {
+			return fmt.Errorf("%v: %s", err, c.Code())
+		}
+	}
+	return nil
+}
+
+func (s *Shell) show(args []string) error {
+	if len(args) == 0 {
+		for _, name := range s.names() {
+			if n := len(s.Piles[name]); n > 0 {
+				fmt.Fprintf(s.out, "%s: %d cards\n", name, n)
+			}
+		}
+		return nil
+	}
+	for _, name := range args {
+		fmt.Fprintf(s.out, "%s: %s\n", name, codes(s.Piles[name]))
+	}
+	return nil
+}
+
+func (s *Shell) eval(args []string) error {
+	var cards []deck.Card
+	for _, name := range args {
+		cards = append(cards, s.Piles[name]...)
+	}
+	if len(cards) < 5 || len(cards) > 7 {
+		return fmt.Errorf("%v: eval needs 5 to 7 cards, found %d", ErrUsage, len(cards))
+	}
+	v, best := poker.Best(cards)
+	fmt.Fprintf(s.out, "%s: %s\n", v, codes(best))
+	return nil
+}
+
+// save writes a line for every pile: its name, a colon and the codes of its cards.
+func (s *Shell) save(args []string) error {
+	if len(args) != 1 {
+		return ErrUsage
+	}
+	var b strings.Builder
+	for _, name := range s.names() {
+		fmt.Fprintf(&b, "%s: %s\n", name, codes(s.Piles[name]))
+	}
+	return ioutil.WriteFile(args[0], []byte(b.String()), 0644)
+}
+
+func (s *Shell) load(args []string) error {
+	if len(args) != 1 {
+		return ErrUsage
+	}
+	data, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	for _, name := range s.names() {
+		s.tx.Clear(name)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		cards, err := deck.ParseCards(parts[1])
+		if err != nil {
+			return err
+		}
+		s.tx.Put(strings.TrimSpace(parts[0]), cards...)
+	}
+	return nil
+}
+
+// names returns the names of the piles, sorted.
+func (s *Shell) names() []string {
+	var names []string
+	for name := range s.Piles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseArgs splits the words of a command into plain arguments and name=value options.
+func parseArgs(words []string) ([]string, map[string]int) {
+	var args []string
+	opts := map[string]int{}
+	for _, w := range words {
+		if i := strings.IndexByte(w, '='); i > 0 {
+			if n, err := strconv.Atoi(w[i+1:]); err == nil {
+				opts[strings.ToLower(w[:i])] = n
+				continue
+			}
+		}
+		args = append(args, w)
+	}
+	return args, opts
+}
+
+// target takes "to PILE" out of the arguments.
+func target(args []string) (string, []string) {
+	return keyword(args, "to", "")
+}
+
+// source takes "from PILE" out of the arguments, the stock by default.
+func source(args []string) (string, []string) {
+	return keyword(args, "from", "stock")
+}
+
+func keyword(args []string, word, def string) (string, []string) {
+	for i := 0; i+1 < len(args); i++ {
+		if strings.EqualFold(args[i], word) {
+			return args[i+1], append(append([]string(nil), args[:i]...), args[i+2:]...)
+		}
+	}
+	return def, args
+}
+
+func codes(cards []deck.Card) string {
+	cs := make([]string, len(cards))
+	for i, c := range cards {
+		cs[i] = c.Code()
+	}
+	return strings.Join(cs, " ")
+}
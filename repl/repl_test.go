@@ -0,0 +1,103 @@
+package repl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func run(t *testing.T, s *Shell, lines ...string) {
+	for _, line := range lines {
+		if err := s.Exec(line); err != nil {
+			t.Fatal(line, err)
+		}
+	}
+}
+
+func TestShell(t *testing.T) {
+	var out strings.Builder
+	s := New(&out)
+	run(t, s,
+		"# a hold'em hand",
+		"new jokers=2 decks=2",
+		"shuffle seed=42",
+		"draw 2 to north",
+		"draw 3 to board",
+		"show",
+	)
+	if len(s.Piles["stock"]) != 2*54-5 || len(s.Piles["north"]) != 2 || len(s.Piles["board"]) != 3 {
+		t.Fatal("Expected the cards to be dealt, received:", out.String())
+	}
+	if !strings.Contains(out.String(), "north: 2 cards") {
+		t.Error("Expected the piles to be listed, received:", out.String())
+	}
+
+	if err := s.Exec("draw 500 to north"); err == nil {
+		t.Error("Expected an error drawing more cards than the stock has.")
+	}
+	if err := s.Exec("fold"); err != ErrCommand {
+		t.Error("Expected", ErrCommand, "received:", err)
+	}
+	if err := s.Exec("draw to north"); err != ErrUsage {
+		t.Error("Expected", ErrUsage, "received:", err)
+	}
+
+	run(t, s, "undo", "undo")
+	if len(s.Piles["north"]) != 0 || len(s.Piles["board"]) != 0 || len(s.Piles["stock"]) != 2*54 {
+		t.Error("Expected both draws to be undone, received:", s.Piles)
+	}
+	run(t, s, "undo", "undo")
+	if len(s.Piles["stock"]) != 0 {
+		t.Error("Expected the new deck to be undone, received:", s.Piles)
+	}
+	if err := s.Exec("undo"); err != ErrUndo {
+		t.Error("Expected", ErrUndo, "received:", err)
+	}
+}
+
+func TestEval(t *testing.T) {
+	var out strings.Builder
+	s := New(&out)
+	run(t, s, "new", "move AS KS to north", "move QS JS TS 2D from stock to board", "eval north board", "show north")
+	if !strings.Contains(out.String(), "Straight Flush: AS KS QS JS TS") || !strings.Contains(out.String(), "north: AS KS") {
+		t.Error("Expected a royal flush, received:", out.String())
+	}
+	if err := s.Exec("move AS to south"); err == nil || len(s.Piles["south"]) != 0 {
+		t.Error("Expected the Ace of Spades to be gone from the stock, received:", err)
+	}
+	if err := s.Exec("eval north"); err == nil {
+		t.Error("Expected two cards to be too few to evaluate.")
+	}
+}
+
+func TestScript(t *testing.T) {
+	dir, err := ioutil.TempDir("", "repl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	saved := filepath.Join(dir, "piles.txt")
+	script := filepath.Join(dir, "deal.txt")
+	ioutil.WriteFile(script, []byte("new\nshuffle seed=1\ndraw 13 to north\nsave "+saved+"\n"), 0644)
+
+	var out strings.Builder
+	s := New(&out)
+	if err := s.Run(strings.NewReader("run "+script+"\nquit\nshow\n"), true); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "north: 13 cards") {
+		t.Error("Expected quit to end the session, received:", out.String())
+	}
+
+	loaded := New(&out)
+	run(t, loaded, "load "+saved)
+	if len(loaded.Piles["north"]) != 13 || loaded.Piles["north"][0] != s.Piles["north"][0] {
+		t.Error("Expected the saved piles back, received:", loaded.Piles)
+	}
+
+	if err := New(&out).Run(strings.NewReader("new\ndraw x to y\n"), false); err == nil || !strings.HasPrefix(err.Error(), "line 2") {
+		t.Error("Expected the script to stop at line 2, received:", err)
+	}
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/euller88/deck"
+)
+
+// ErrBots is returned when a game is run without a bot for every player
+var ErrBots = errors.New("rules: a game needs one bot for every player")
+
+// View is what a player can see of a game when it's their turn.
+type View struct {
+	Player int
+	Hand   []deck.Card
+	Trick  []deck.Card
+	Trump  deck.Suit
+	Trumps bool
+	Tricks []int
+	Legal  []deck.Card
+}
+
+// Bot picks the cards played by a player. Choose returns an index of v.Legal.
+type Bot interface {
+	Choose(v View) int
+}
+
+// Random is a bot that plays any legal card.
+type Random struct {
+	R *rand.Rand
+}
+
+// Choose picks a legal card at random.
+func (b Random) Choose(v View) int {
+	return b.R.Intn(len(v.Legal))
+}
+
+// Greedy is a bot that plays its strongest legal card, in the order given by the spec of the game.
+type Greedy struct {
+	Game *Game
+}
+
+// Choose picks the strongest legal card.
+func (b Greedy) Choose(v View) int {
+	best := 0
+	for i, c := range v.Legal {
+		if b.Game.power[c.Rank] > b.Game.power[v.Legal[best].Rank] {
+			best = i
+		}
+	}
+	return best
+}
+
+// View returns what the player in turn can see of the game.
+func (g *Game) View() View {
+	return View{
+		Player: g.Turn,
+		Hand:   append([]deck.Card(nil), g.zones["hand"][g.Turn]...),
+		Trick:  append([]deck.Card(nil), g.Trick...),
+		Trump:  g.Trump,
+		Trumps: g.Trumps,
+		Tricks: append([]int(nil), g.Tricks...),
+		Legal:  append([]deck.Card(nil), g.Legal()...),
+	}
+}
+
+// Run plays a whole game with a bot for every player, and returns the final scores.
+func Run(g *Game, bots []Bot) ([]int, error) {
+	if len(bots) != g.Spec.Players {
+		return nil, ErrBots
+	}
+	for !g.Over() {
+		v := g.View()
+		if err := g.Play(v.Legal[bots[g.Turn].Choose(v)]); err != nil {
+			return nil, err
+		}
+	}
+	return g.Scores(), nil
+}
@@ -0,0 +1,363 @@
+package rules
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when a move breaks the rules of the game
+var (
+	ErrNotInHand = errors.New("rules: card is not in the player's hand")
+	ErrIllegal   = errors.New("rules: card can't be played in this trick")
+	ErrGameOver  = errors.New("rules: the game is over")
+)
+
+// Game runs a game described by a Spec.
+type Game struct {
+	// Spec is the description of the game being played
+	Spec *Spec
+
+	// Trump is the trump suit, meaningful only when Trumps is true
+	Trump  deck.Suit
+	Trumps bool
+
+	// Trick holds the cards played in the current trick, in playing order
+	Trick []deck.Card
+
+	// Tricks holds the number of tricks taken by every player
+	Tricks []int
+
+	// Leader is the player who led the current trick and Turn is the player who must play now
+	Leader, Turn int
+
+	// played holds the players of the cards in the trick, and in is the number of players taking part in it
+	played []int
+	in     int
+
+	zones  map[string][][]deck.Card
+	power  map[deck.Rank]int
+	points []scorer
+}
+
+type scorer struct {
+	match  func(deck.Card) bool
+	points int
+}
+
+// New sets up a game described by s: the deck is built, shuffled with r, unless r is nil, and dealt.
+func New(s *Spec, r *rand.Rand) (*Game, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	g := &Game{
+		Spec:   s,
+		Tricks: make([]int, s.Players),
+		zones:  map[string][][]deck.Card{"stock": make([][]deck.Card, 1)},
+		power:  map[deck.Rank]int{},
+	}
+	for _, name := range []string{"hand", "won"} {
+		g.zones[name] = make([][]deck.Card, s.Players)
+	}
+	for _, z := range s.Zones {
+		if z.PerPlayer {
+			g.zones[z.Name] = make([][]deck.Card, s.Players)
+		} else {
+			g.zones[z.Name] = make([][]deck.Card, 1)
+		}
+	}
+
+	order := s.Play.Order
+	if len(order) == 0 {
+		order = []string{"two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "jack", "queen", "king", "ace"}
+	}
+	for i, name := range order {
+		g.power[ranks[strings.ToLower(name)]] = i + 1
+	}
+	for name, points := range s.Scoring.Cards {
+		m, _ := matcher(name)
+		g.points = append(g.points, scorer{match: m, points: points})
+	}
+
+	cards := s.Cards()
+	if r != nil {
+		r.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+	}
+	g.zones["stock"][0] = cards
+
+	for _, d := range s.Deal {
+		g.deal(d)
+	}
+	g.chooseTrump()
+	return g, nil
+}
+
+// Zone returns the cards in a zone. player is ignored for shared zones.
+func (g *Game) Zone(name string, player int) []deck.Card {
+	z := g.zones[name]
+	switch {
+	case len(z) == 1:
+		return z[0]
+	case player >= 0 && player < len(z):
+		return z[player]
+	default:
+		return nil
+	}
+}
+
+// deal moves the cards of a deal phase, one at a time to every player in turn when the target is a player zone.
+func (g *Game) deal(d DealSpec) {
+	from, to := g.zones[d.From], g.zones[d.To]
+	if len(to) == 1 {
+		n := d.Count
+		if n == 0 || n > len(from[0]) {
+			n = len(from[0])
+		}
+		to[0] = append(to[0], take(from, n)...)
+		return
+	}
+
+	for i := 0; d.Count == 0 || i < d.Count; i++ {
+		for p := range to {
+			if len(from[0]) == 0 {
+				return
+			}
+			to[p] = append(to[p], take(from, 1)...)
+		}
+	}
+}
+
+// take removes n cards from the top of a shared zone.
+func take(zone [][]deck.Card, n int) []deck.Card {
+	cards := zone[0][:n:n]
+	zone[0] = zone[0][n:]
+	return cards
+}
+
+func (g *Game) chooseTrump() {
+	switch g.Spec.Trump.Mode {
+	case "suit":
+		g.Trump, g.Trumps = suit(g.Spec.Trump.Suit)
+	case "turn_up":
+		stock := g.zones["stock"]
+		if len(stock[0]) > 0 {
+			up := stock[0][0]
+			stock[0] = append(stock[0][1:], up)
+			g.Trump, g.Trumps = up.Suit, true
+		}
+	case "last_dealt":
+		hand := g.zones["hand"][g.Spec.Players-1]
+		if len(hand) > 0 {
+			g.Trump, g.Trumps = hand[len(hand)-1].Suit, true
+		}
+	}
+}
+
+// Legal returns the cards the player in turn may play. The conditions of the spec apply in this order: following
+// suit, trumping when void, and beating the best card of the trick.
+func (g *Game) Legal() []deck.Card {
+	if g.Over() {
+		return nil
+	}
+	hand := g.zones["hand"][g.Turn]
+	if len(g.Trick) == 0 {
+		return hand
+	}
+
+	cond := map[string]bool{}
+	for _, c := range g.Spec.Play.Conditions {
+		cond[c] = true
+	}
+
+	led := g.Trick[0].Suit
+	options := hand
+	if same := suited(hand, led); cond["follow_suit"] && len(same) > 0 {
+		options = same
+	} else if trumps := suited(hand, g.Trump); cond["trump_if_void"] && len(same) == 0 && g.Trumps && len(trumps) > 0 {
+		options = trumps
+	}
+	if cond["beat_if_possible"] {
+		best := g.Trick[g.winner()]
+		var higher []deck.Card
+		for _, c := range options {
+			if g.beats(c, best, led) {
+				higher = append(higher, c)
+			}
+		}
+		if len(higher) > 0 {
+			options = higher
+		}
+	}
+	return options
+}
+
+// Play puts card from the hand of the player in turn into the trick. When the trick is complete, its winner takes it
+// and leads the next one, after everybody drew from the draw zone, if the spec has one.
+func (g *Game) Play(card deck.Card) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	hand := g.zones["hand"][g.Turn]
+	i := index(hand, card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if index(g.Legal(), card) < 0 {
+		return ErrIllegal
+	}
+
+	if len(g.Trick) == 0 {
+		g.in = 0
+		for _, h := range g.zones["hand"] {
+			if len(h) > 0 {
+				g.in++
+			}
+		}
+	}
+	g.zones["hand"][g.Turn] = append(hand[:i], hand[i+1:]...)
+	g.Trick = append(g.Trick, card)
+	g.played = append(g.played, g.Turn)
+	if len(g.Trick) < g.in {
+		g.Turn = g.next(g.Turn)
+		return nil
+	}
+
+	winner := g.played[g.winner()]
+	g.zones["won"][winner] = append(g.zones["won"][winner], g.Trick...)
+	g.Tricks[winner]++
+	g.Trick, g.played = nil, nil
+
+	if from := g.Spec.Play.DrawFrom; from != "" {
+		for i := 0; i < g.Spec.Players; i++ {
+			p := (winner + i) % g.Spec.Players
+			if len(g.zones[from][0]) > 0 {
+				g.zones["hand"][p] = append(g.zones["hand"][p], take(g.zones[from], 1)...)
+			}
+		}
+	}
+
+	// a winner without cards left passes the lead on
+	if len(g.zones["hand"][winner]) > 0 {
+		g.Leader, g.Turn = winner, winner
+	} else {
+		g.Leader, g.Turn = g.next(winner), g.next(winner)
+	}
+	return nil
+}
+
+// next returns the first player after p who still has cards and hasn't played to the trick, or p if there is none.
+func (g *Game) next(p int) int {
+	for i := 1; i <= g.Spec.Players; i++ {
+		q := (p + i) % g.Spec.Players
+		if len(g.zones["hand"][q]) > 0 && indexOf(g.played, q) < 0 {
+			return q
+		}
+	}
+	return p
+}
+
+// Over reports if the game has ended, which happens when every hand is empty and the last trick was taken.
+func (g *Game) Over() bool {
+	if len(g.Trick) > 0 {
+		return false
+	}
+	for _, h := range g.zones["hand"] {
+		if len(h) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Scores returns the points of every player: the points of every trick taken and of the cards in them.
+func (g *Game) Scores() []int {
+	scores := make([]int, g.Spec.Players)
+	for p := range scores {
+		scores[p] = g.Tricks[p] * g.Spec.Scoring.PerTrick
+		for _, c := range g.zones["won"][p] {
+			for _, s := range g.points {
+				if s.match(c) {
+					scores[p] += s.points
+				}
+			}
+		}
+	}
+	return scores
+}
+
+// Winners returns the players with the best score, the highest or, in games where low scores win, the lowest.
+func (g *Game) Winners() []int {
+	scores := g.Scores()
+	best := scores[0]
+	for _, s := range scores {
+		if (s > best) != g.Spec.Scoring.Low && s != best {
+			best = s
+		}
+	}
+
+	var winners []int
+	for p, s := range scores {
+		if s == best {
+			winners = append(winners, p)
+		}
+	}
+	return winners
+}
+
+// winner returns the position in the trick of the card winning it so far.
+func (g *Game) winner() int {
+	best := 0
+	for i := 1; i < len(g.Trick); i++ {
+		if g.beats(g.Trick[i], g.Trick[best], g.Trick[0].Suit) {
+			best = i
+		}
+	}
+	return best
+}
+
+// beats reports if card a beats card b in a trick led in suit led.
+func (g *Game) beats(a, b deck.Card, led deck.Suit) bool {
+	switch {
+	case a.Suit == b.Suit:
+		return g.power[a.Rank] > g.power[b.Rank]
+	case g.Trumps && a.Suit == g.Trump:
+		return true
+	case g.Trumps && b.Suit == g.Trump:
+		return false
+	default:
+		return a.Suit == led
+	}
+}
+
+func suited(cards []deck.Card, s deck.Suit) []deck.Card {
+	var ret []deck.Card
+	for _, c := range cards {
+		if c.Suit == s {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexOf(players []int, p int) int {
+	for i := range players {
+		if players[i] == p {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+func parse(t *testing.T, spec string) *Spec {
+	s, err := Parse(strings.NewReader(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDeal(t *testing.T) {
+	g, err := New(parse(t, hearts), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for p := 0; p < 4; p++ {
+		if len(g.Zone("hand", p)) != 13 {
+			t.Error("Expected 13 cards in every hand, received:", g.Zone("hand", p))
+		}
+	}
+	// Unshuffled, the cards go round one at a time
+	if g.Zone("hand", 1)[0] != c(deck.Two, deck.Spade) || len(g.Zone("stock", 0)) != 0 || g.Trumps {
+		t.Error("Expected the second card to go to player 1, received:", g.Zone("hand", 1))
+	}
+
+	g, err = New(parse(t, whist), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stock := g.Zone("stock", 0)
+	if !g.Trumps || g.Trump != deck.Club || len(stock) != 26 || stock[len(stock)-1] != c(deck.Ace, deck.Club) {
+		t.Error("Expected the Ace of Clubs to be turned up and put under the stock, received:", g.Trump, stock)
+	}
+}
+
+func TestLegal(t *testing.T) {
+	s := &Spec{Players: 3, Trump: TrumpSpec{Mode: "suit", Suit: "Hearts"}, Play: PlaySpec{
+		Conditions: []string{"follow_suit", "trump_if_void", "beat_if_possible"},
+	}}
+	g, err := New(s, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.zones["hand"] = [][]deck.Card{
+		{c(deck.Nine, deck.Club)},
+		{c(deck.Three, deck.Club), c(deck.King, deck.Club), c(deck.Two, deck.Heart)},
+		{c(deck.Ace, deck.Diamond), c(deck.Four, deck.Heart), c(deck.Ace, deck.Heart)},
+	}
+
+	if err := g.Play(c(deck.Nine, deck.Club)); err != nil {
+		t.Fatal(err)
+	}
+	if legal := g.Legal(); len(legal) != 1 || legal[0] != c(deck.King, deck.Club) {
+		t.Error("Expected player 1 to follow suit with a higher card, received:", legal)
+	}
+	if err := g.Play(c(deck.Three, deck.Club)); err != ErrIllegal {
+		t.Error("Expected", ErrIllegal, "received:", err)
+	}
+	if err := g.Play(c(deck.King, deck.Club)); err != nil {
+		t.Fatal(err)
+	}
+	if legal := g.Legal(); len(legal) != 2 || legal[0].Suit != deck.Heart {
+		t.Error("Expected player 2 to trump, received:", legal)
+	}
+	if err := g.Play(c(deck.Four, deck.Heart)); err != nil {
+		t.Fatal(err)
+	}
+	if g.Leader != 2 || g.Turn != 2 || g.Tricks[2] != 1 || len(g.Zone("won", 2)) != 3 {
+		t.Error("Expected player 2 to take the trick, received:", g.Tricks)
+	}
+	if err := g.Play(c(deck.Two, deck.Heart)); err != ErrNotInHand {
+		t.Error("Expected", ErrNotInHand, "received:", err)
+	}
+}
+
+func TestScores(t *testing.T) {
+	g, err := New(parse(t, hearts), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Tricks = []int{0, 1, 2, 0}
+	g.zones["won"] = [][]deck.Card{
+		nil,
+		{c(deck.Queen, deck.Spade), c(deck.Two, deck.Club), c(deck.Three, deck.Club), c(deck.Four, deck.Heart)},
+		{c(deck.Five, deck.Heart), c(deck.Six, deck.Heart)},
+		nil,
+	}
+	scores := g.Scores()
+	if scores[0] != 0 || scores[1] != 14 || scores[2] != 2 {
+		t.Error("Expected scores of 0, 14 and 2, received:", scores)
+	}
+	if w := g.Winners(); len(w) != 2 || w[0] != 0 || w[1] != 3 {
+		t.Error("Expected players 0 and 3 to win, received:", w)
+	}
+}
+
+func TestRun(t *testing.T) {
+	for _, spec := range []string{hearts, whist} {
+		s := parse(t, spec)
+		r := rand.New(rand.NewSource(1))
+		g, err := New(s, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bots := make([]Bot, s.Players)
+		for p := range bots {
+			bots[p] = Random{R: r}
+		}
+		bots[0] = Greedy{Game: g}
+
+		scores, err := Run(g, bots)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total := 0
+		for _, s := range scores {
+			total += s
+		}
+		// 13 hearts and the Queen of Spades, or 26 tricks
+		if total != 26 {
+			t.Error("Expected every point to be taken in", s.Name, "received:", scores)
+		}
+	}
+
+	g, _ := New(parse(t, hearts), nil)
+	if _, err := Run(g, []Bot{Greedy{Game: g}}); err != ErrBots {
+		t.Error("Expected", ErrBots, "received:", err)
+	}
+}
+
+// threes is played by three players with a deck of 51 cards
+const threes = `{
+	"name": "Threes",
+	"players": 3,
+	"deck": {"exclude": ["Two of Clubs"]},
+	"deal": [{"from": "stock", "to": "hand"}],
+	"play": {"conditions": ["follow_suit"]},
+	"scoring": {"per_trick": 1}
+}`
+
+func TestThreePlayers(t *testing.T) {
+	s := parse(t, threes)
+	for seed := int64(0); seed < 10; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		g, err := New(s, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scores, err := Run(g, []Bot{Random{R: r}, Random{R: r}, Random{R: r}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		won := 0
+		for p := range scores {
+			won += len(g.Zone("won", p))
+		}
+		if scores[0]+scores[1]+scores[2] != 17 || won != 51 {
+			t.Error("Expected 17 tricks and 51 cards taken, received:", scores, won)
+		}
+	}
+
+	// a hand running out early is skipped
+	g, _ := New(s, nil)
+	g.zones["hand"][1] = nil
+	for !g.Over() {
+		if g.Turn == 1 {
+			t.Fatal("Expected the empty hand to be skipped")
+		}
+		if err := g.Play(g.Legal()[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if g.Tricks[1] != 0 || g.Tricks[0]+g.Tricks[2] != 17 {
+		t.Error("Expected 17 tricks between players 0 and 2, received:", g.Tricks)
+	}
+}
@@ -0,0 +1,254 @@
+// Package rules describes trick-taking card games declaratively, as JSON documents, and runs them.
+//
+// A Spec tells how to build the deck with the options of the deck package, which zones hold cards, how the cards
+// are dealt, how the trump is chosen, which cards are legal in a trick, how a trick is won and how it is scored.
+// A Game interprets a Spec, and Bots can play any described game.
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when a spec is not valid
+var (
+	ErrPlayers   = errors.New("rules: a game needs at least 2 players")
+	ErrZone      = errors.New("rules: unknown zone")
+	ErrCondition = errors.New("rules: unknown legal-move condition")
+	ErrTrump     = errors.New("rules: unknown trump mode")
+	ErrOrder     = errors.New("rules: the rank order must list every rank of the deck once")
+	ErrDeal      = errors.New("rules: the cards can't be split evenly between the players")
+)
+
+// Spec is the description of a game.
+type Spec struct {
+	Name    string      `json:"name"`
+	Players int         `json:"players"`
+	Deck    DeckSpec    `json:"deck"`
+	Zones   []ZoneSpec  `json:"zones"`
+	Deal    []DealSpec  `json:"deal"`
+	Trump   TrumpSpec   `json:"trump"`
+	Play    PlaySpec    `json:"play"`
+	Scoring ScoringSpec `json:"scoring"`
+}
+
+// DeckSpec describes the deck in terms of the options of deck.New: the cards filtered out, the jokers added and the
+// number of copies of the whole deck.
+type DeckSpec struct {
+	Decks   int      `json:"decks"`
+	Jokers  int      `json:"jokers"`
+	Exclude []string `json:"exclude"`
+}
+
+// ZoneSpec describes a place where cards lie, either a single shared zone, or one zone for every player.
+// Every game has a shared "stock", where the deck starts, and a "hand" and a "won" zone for every player.
+type ZoneSpec struct {
+	Name      string `json:"name"`
+	PerPlayer bool   `json:"per_player"`
+}
+
+// DealSpec moves Count cards from a shared zone to another zone, to every player in turn when the target is a player
+// zone. A Count of 0 deals every card left in the source zone. Every player must receive the same number of cards.
+type DealSpec struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int    `json:"count"`
+}
+
+// TrumpSpec tells how the trump suit is chosen: "none", a fixed "suit", the "turn_up" of the top card of the stock,
+// which stays at its bottom, or the "last_dealt" card of the dealer's hand.
+type TrumpSpec struct {
+	Mode string `json:"mode"`
+	Suit string `json:"suit"`
+}
+
+// PlaySpec describes the play of the tricks. Conditions restrict the legal cards, among "follow_suit",
+// "trump_if_void" and "beat_if_possible". They always apply in that order, whatever the order of the list.
+// Order lists the ranks from the weakest to the strongest.
+// When DrawFrom is set, every player draws a card from that shared zone after every trick, the winner first, so it
+// must hold a multiple of the number of players.
+type PlaySpec struct {
+	Conditions []string `json:"conditions"`
+	Order      []string `json:"order"`
+	DrawFrom   string   `json:"draw_from"`
+}
+
+// ScoringSpec gives the points of a trick and of the cards in it. Cards are named as in the Card String method,
+// such as "Queen of Spades", or by a single suit or rank. When Low is true, the player with the least points wins.
+type ScoringSpec struct {
+	PerTrick int            `json:"per_trick"`
+	Cards    map[string]int `json:"cards"`
+	Low      bool           `json:"low"`
+}
+
+// Parse reads a spec from JSON and validates it.
+func Parse(r io.Reader) (*Spec, error) {
+	var s Spec
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&s); err != nil {
+		return nil, err
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate checks that the spec describes a game that can be played.
+func (s *Spec) Validate() error {
+	if s.Players < 2 {
+		return ErrPlayers
+	}
+	for _, name := range s.Deck.Exclude {
+		if _, err := matcher(name); err != nil {
+			return err
+		}
+	}
+	for name := range s.Scoring.Cards {
+		if _, err := matcher(name); err != nil {
+			return err
+		}
+	}
+
+	// zones tells if a zone is per player, and size counts the cards in the shared zones along the deal
+	cards := s.Cards()
+	zones := map[string]bool{"stock": false, "hand": true, "won": true}
+	for _, z := range s.Zones {
+		zones[z.Name] = z.PerPlayer
+	}
+	size := map[string]int{"stock": len(cards)}
+	for _, d := range s.Deal {
+		perPlayer, ok := zones[d.To]
+		if fromPlayer, fok := zones[d.From]; !ok || !fok || fromPlayer {
+			return fmt.Errorf("%v: %q or %q", ErrZone, d.From, d.To)
+		}
+		n := d.Count
+		if perPlayer {
+			n *= s.Players
+		}
+		if n == 0 || n > size[d.From] {
+			n = size[d.From]
+		}
+		if perPlayer && (n%s.Players != 0 || d.Count > 0 && n != d.Count*s.Players) {
+			return fmt.Errorf("%v: %d cards from %q to %q", ErrDeal, size[d.From], d.From, d.To)
+		}
+		size[d.From] -= n
+		size[d.To] += n
+	}
+	if from := s.Play.DrawFrom; from != "" {
+		if perPlayer, ok := zones[from]; !ok || perPlayer {
+			return fmt.Errorf("%v: %q", ErrZone, from)
+		}
+		if size[from]%s.Players != 0 {
+			return fmt.Errorf("%v: %d cards in %q", ErrDeal, size[from], from)
+		}
+	}
+
+	for _, c := range s.Play.Conditions {
+		switch c {
+		case "follow_suit", "beat_if_possible", "trump_if_void":
+		default:
+			return fmt.Errorf("%v: %q", ErrCondition, c)
+		}
+	}
+
+	switch s.Trump.Mode {
+	case "", "none", "turn_up", "last_dealt":
+	case "suit":
+		if _, ok := suit(s.Trump.Suit); !ok {
+			return fmt.Errorf("%v: suit %q", ErrTrump, s.Trump.Suit)
+		}
+	default:
+		return fmt.Errorf("%v: %q", ErrTrump, s.Trump.Mode)
+	}
+
+	if len(s.Play.Order) > 0 {
+		seen := map[deck.Rank]bool{}
+		for _, name := range s.Play.Order {
+			r, ok := ranks[strings.ToLower(name)]
+			if !ok || seen[r] {
+				return ErrOrder
+			}
+			seen[r] = true
+		}
+		for _, c := range cards {
+			if c.Suit != deck.Joker && !seen[c.Rank] {
+				return fmt.Errorf("%v: %v is missing", ErrOrder, c.Rank)
+			}
+		}
+	}
+	return nil
+}
+
+// Cards builds the deck described by the spec, unshuffled.
+func (s *Spec) Cards() []deck.Card {
+	var opts []func([]deck.Card) []deck.Card
+	if len(s.Deck.Exclude) > 0 {
+		var ms []func(deck.Card) bool
+		for _, name := range s.Deck.Exclude {
+			m, _ := matcher(name)
+			ms = append(ms, m)
+		}
+		opts = append(opts, deck.Filter(func(c deck.Card) bool {
+			for _, m := range ms {
+				if m(c) {
+					return true
+				}
+			}
+			return false
+		}))
+	}
+	if s.Deck.Jokers > 0 {
+		opts = append(opts, deck.Jokers(s.Deck.Jokers))
+	}
+	if s.Deck.Decks > 1 {
+		opts = append(opts, deck.Deck(s.Deck.Decks))
+	}
+	return deck.New(opts...)
+}
+
+var (
+	suits = map[string]deck.Suit{}
+	ranks = map[string]deck.Rank{}
+)
+
+func init() {
+	for s := deck.Spade; s <= deck.Joker; s++ {
+		suits[strings.ToLower(s.String())] = s
+	}
+	for r := deck.Ace; r <= deck.King; r++ {
+		ranks[strings.ToLower(r.String())] = r
+	}
+}
+
+// suit looks up a suit by its name, singular or plural.
+func suit(name string) (deck.Suit, bool) {
+	s, ok := suits[strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), "s")]
+	return s, ok
+}
+
+// matcher returns a function matching the cards named by name: a card such as "Queen of Spades", a suit such as
+// "Heart" or a rank such as "Two".
+func matcher(name string) (func(deck.Card) bool, error) {
+	n := strings.ToLower(strings.TrimSpace(name))
+	if s, ok := suit(n); ok {
+		return func(c deck.Card) bool { return c.Suit == s }, nil
+	}
+	if r, ok := ranks[n]; ok {
+		return func(c deck.Card) bool { return c.Rank == r && c.Suit != deck.Joker }, nil
+	}
+	if parts := strings.SplitN(n, " of ", 2); len(parts) == 2 {
+		r, rok := ranks[parts[0]]
+		s, sok := suit(parts[1])
+		if rok && sok {
+			return func(c deck.Card) bool { return c.Rank == r && c.Suit == s }, nil
+		}
+	}
+	return nil, fmt.Errorf("rules: unknown card name %q", name)
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+const hearts = `{
+	"name": "Hearts",
+	"players": 4,
+	"deal": [{"from": "stock", "to": "hand"}],
+	"play": {"conditions": ["follow_suit"]},
+	"scoring": {"cards": {"Hearts": 1, "Queen of Spades": 13}, "low": true}
+}`
+
+const whist = `{
+	"name": "German Whist",
+	"players": 2,
+	"deal": [{"from": "stock", "to": "hand", "count": 13}],
+	"trump": {"mode": "turn_up"},
+	"play": {"conditions": ["follow_suit"], "draw_from": "stock"},
+	"scoring": {"per_trick": 1}
+}`
+
+func TestParse(t *testing.T) {
+	s, err := Parse(strings.NewReader(hearts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != "Hearts" || s.Players != 4 || s.Scoring.Cards["Queen of Spades"] != 13 || !s.Scoring.Low {
+		t.Error("Expected the Hearts spec, received:", s)
+	}
+
+	tests := []struct {
+		spec string
+		err  string
+	}{
+		{`{"players": 1}`, ErrPlayers.Error()},
+		{`{"players": 2, "deal": [{"from": "stock", "to": "tableau"}]}`, ErrZone.Error()},
+		{`{"players": 2, "play": {"conditions": ["must_ruff"]}}`, ErrCondition.Error()},
+		{`{"players": 2, "trump": {"mode": "suit", "suit": "Stars"}}`, ErrTrump.Error()},
+		{`{"players": 2, "play": {"order": ["Ace", "Ace"]}}`, ErrOrder.Error()},
+		{`{"players": 2, "play": {"order": ["Ace", "King"]}}`, ErrOrder.Error()},
+		{`{"players": 3, "deal": [{"from": "stock", "to": "hand"}]}`, ErrDeal.Error()},
+		{`{"players": 4, "deal": [{"from": "stock", "to": "hand", "count": 14}]}`, ErrDeal.Error()},
+		{`{"players": 3, "deal": [{"from": "stock", "to": "hand", "count": 5}], "play": {"draw_from": "stock"}}`, ErrDeal.Error()},
+		{`{"players": 2, "deal": [{"from": "stock", "to": "hand", "count": 5}, {"from": "hand", "to": "won"}]}`, ErrZone.Error()},
+		{`{"players": 2, "play": {"draw_from": "won"}}`, ErrZone.Error()},
+		{`{"players": 2, "deck": {"exclude": ["Eleven"]}}`, "unknown card name"},
+		{`{"players": 2, "dealer": 1}`, "unknown field"},
+	}
+	for _, tt := range tests {
+		if _, err := Parse(strings.NewReader(tt.spec)); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Error("Expected", tt.err, "received:", err)
+		}
+	}
+}
+
+func TestCards(t *testing.T) {
+	s := &Spec{Deck: DeckSpec{Decks: 2, Jokers: 1, Exclude: []string{"Two", "Three", "Four", "Five", "Six"}}}
+	cards := s.Cards()
+	// 32 cards and a joker, twice
+	if len(cards) != 2*33 {
+		t.Error("Expected", 2*33, "cards, received:", len(cards))
+	}
+	for _, c := range cards {
+		if c.Suit != deck.Joker && c.Rank > deck.Ace && c.Rank < deck.Seven {
+			t.Error("Expected low cards to be filtered out, received:", c)
+		}
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	tests := []struct {
+		name string
+		card deck.Card
+		ok   bool
+	}{
+		{"Queen of Spades", deck.Card{Rank: deck.Queen, Suit: deck.Spade}, true},
+		{"queen of spades", deck.Card{Rank: deck.Queen, Suit: deck.Heart}, false},
+		{"Hearts", deck.Card{Rank: deck.Two, Suit: deck.Heart}, true},
+		{"Heart", deck.Card{Rank: deck.Two, Suit: deck.Club}, false},
+		{"Ace", deck.Card{Rank: deck.Ace, Suit: deck.Club}, true},
+		{"Ace", deck.Card{Rank: deck.Ace, Suit: deck.Joker}, false},
+		{"Joker", deck.Card{Rank: deck.Two, Suit: deck.Joker}, true},
+	}
+	for _, tt := range tests {
+		m, err := matcher(tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m(tt.card) != tt.ok {
+			t.Error("Expected", tt.name, "matching", tt.card, "to be", tt.ok)
+		}
+	}
+}
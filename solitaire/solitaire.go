@@ -0,0 +1,307 @@
+// Package solitaire is a framework for patience games played with a single deck.
+//
+// A variant is described by a Layout, the piles of the table with the cards dealt to them and the piles covering
+// them, and by its Rules, the moves allowed and the end of the game. The Game takes care of the rest: dealing,
+// playing moves, turning cards face up, undo and hints. Pyramid, Golf, TriPeaks and Yukon are provided.
+package solitaire
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when a game can't be set up or a move can't be played
+var (
+	ErrDeckSize = errors.New("solitaire: not enough cards to deal")
+	ErrLayout   = errors.New("solitaire: unknown pile in the layout")
+	ErrIllegal  = errors.New("solitaire: move not allowed")
+	ErrUndo     = errors.New("solitaire: nothing to undo")
+)
+
+// Rest deals every card left to a pile
+const Rest = -1
+
+// Kind is the role of a pile
+type Kind uint8
+
+// The kinds of piles
+const (
+	// Tableau is a pile of the layout, played from and sometimes onto
+	Tableau Kind = iota
+
+	// Stock is the pile of the cards left after the deal
+	Stock
+
+	// Waste is the pile where the stock is turned
+	Waste
+
+	// Foundation is a pile where the cards are taken out of play
+	Foundation
+)
+
+// PileSpec describes a pile: its name, its role, the number of cards dealt to it, the number of them face up and the
+// piles covering it, which must be empty for its cards to be played. A covered pile turns its top card face up
+// once uncovered, and so does a tableau pile with Flip when its face-up cards are gone.
+type PileSpec struct {
+	Name      string
+	Kind      Kind
+	Deal      int
+	FaceUp    int
+	BlockedBy []string
+	Flip      bool
+}
+
+// Layout is the table of a variant. The cards are dealt to the piles in order.
+type Layout []PileSpec
+
+// Pile is a pile on the table. The top card is the last one, and the Up cards on top are face up.
+type Pile struct {
+	PileSpec
+	Cards []deck.Card
+	Up    int
+}
+
+// Top returns the top card of the pile, if any.
+func (p *Pile) Top() (deck.Card, bool) {
+	if len(p.Cards) == 0 {
+		return deck.Card{}, false
+	}
+	return p.Cards[len(p.Cards)-1], true
+}
+
+// Move takes Count cards from the top of From and puts them on To. With names a second pile whose top card goes to
+// To as well, for the games where cards are removed in pairs.
+type Move struct {
+	From, To string
+	Count    int
+	With     string
+}
+
+func (m Move) String() string {
+	from := m.From
+	if m.With != "" {
+		from += "+" + m.With
+	}
+	if m.Count > 1 {
+		from = fmt.Sprintf("%d from %s", m.Count, from)
+	}
+	return from + " -> " + m.To
+}
+
+// Rules are the rules of a variant.
+type Rules interface {
+	// Layout returns the table of the variant
+	Layout() Layout
+
+	// Moves returns the moves allowed in a game
+	Moves(g *Game) []Move
+
+	// Won reports if a game is won
+	Won(g *Game) bool
+}
+
+// Game is a game of solitaire.
+type Game struct {
+	// Rules are the rules of the variant played
+	Rules Rules
+
+	// Piles holds the piles of the table in the order of the layout
+	Piles []*Pile
+
+	byName  map[string]*Pile
+	history [][]Pile
+}
+
+// New deals a game of a variant from cards, which should come already shuffled.
+func New(r Rules, cards []deck.Card) (*Game, error) {
+	g := &Game{Rules: r, byName: map[string]*Pile{}}
+	for _, spec := range r.Layout() {
+		p := &Pile{PileSpec: spec}
+		g.Piles = append(g.Piles, p)
+		g.byName[spec.Name] = p
+	}
+	for _, p := range g.Piles {
+		for _, name := range p.BlockedBy {
+			if g.byName[name] == nil {
+				return nil, fmt.Errorf("%v: %q", ErrLayout, name)
+			}
+		}
+	}
+
+	cards = append([]deck.Card(nil), cards...)
+	for _, p := range g.Piles {
+		n := p.Deal
+		if n == Rest {
+			n = len(cards)
+		}
+		if n > len(cards) {
+			return nil, ErrDeckSize
+		}
+		p.Cards, cards = cards[:n:n], cards[n:]
+		p.Up = p.FaceUp
+		if p.Up == Rest || p.Up > n {
+			p.Up = n
+		}
+	}
+	g.turn()
+	return g, nil
+}
+
+// Pile returns a pile by its name, or nil.
+func (g *Game) Pile(name string) *Pile {
+	return g.byName[name]
+}
+
+// Available reports if the top card of a pile can be played: the pile has a face-up card and nothing covers it.
+func (g *Game) Available(name string) bool {
+	p := g.byName[name]
+	return p != nil && len(p.Cards) > 0 && p.Up > 0 && g.uncovered(p)
+}
+
+// uncovered reports if the piles covering p are all empty.
+func (g *Game) uncovered(p *Pile) bool {
+	for _, b := range p.BlockedBy {
+		if len(g.byName[b].Cards) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Moves returns the moves allowed.
+func (g *Game) Moves() []Move {
+	return g.Rules.Moves(g)
+}
+
+// Won reports if the game is won.
+func (g *Game) Won() bool {
+	return g.Rules.Won(g)
+}
+
+// Play plays a move allowed by the rules.
+func (g *Game) Play(m Move) error {
+	legal := false
+	for _, l := range g.Moves() {
+		if l == m || l.Count <= 1 && m.Count <= 1 && l.From == m.From && l.To == m.To && l.With == m.With {
+			legal = true
+			break
+		}
+	}
+	if !legal {
+		return ErrIllegal
+	}
+
+	g.save()
+	from, to := g.byName[m.From], g.byName[m.To]
+	n := m.Count
+	if n == 0 {
+		n = 1
+	}
+	cut := len(from.Cards) - n
+	moved := from.Cards[cut:]
+	up := from.Up
+	if up > n {
+		up = n
+	}
+	from.Cards = from.Cards[:cut:cut]
+	from.Up -= up
+	to.Cards = append(to.Cards, moved...)
+
+	// Cards turn face up when played, unless they go to the stock
+	if to.Kind == Stock {
+		to.Up = 0
+	} else {
+		to.Up += n
+	}
+	if with := g.byName[m.With]; with != nil {
+		c := with.Cards[len(with.Cards)-1]
+		with.Cards = with.Cards[: len(with.Cards)-1 : len(with.Cards)-1]
+		if with.Up > 0 {
+			with.Up--
+		}
+		to.Cards = append(to.Cards, c)
+		to.Up++
+	}
+	g.turn()
+	return nil
+}
+
+// turn turns face up the top cards of the uncovered piles and of the tableau piles that flip.
+func (g *Game) turn() {
+	for _, p := range g.Piles {
+		if len(p.Cards) > 0 && p.Up == 0 && (p.Flip || len(p.BlockedBy) > 0 && g.uncovered(p)) {
+			p.Up = 1
+		}
+	}
+}
+
+func (g *Game) save() {
+	state := make([]Pile, len(g.Piles))
+	for i, p := range g.Piles {
+		state[i] = *p
+		state[i].Cards = append([]deck.Card(nil), p.Cards...)
+	}
+	g.history = append(g.history, state)
+}
+
+// Undo takes back the last move.
+func (g *Game) Undo() error {
+	if len(g.history) == 0 {
+		return ErrUndo
+	}
+	state := g.history[len(g.history)-1]
+	g.history = g.history[:len(g.history)-1]
+	for i := range g.Piles {
+		*g.Piles[i] = state[i]
+	}
+	return nil
+}
+
+// Hint returns the most useful move allowed, if any: a move to a foundation first, then a move that turns a card
+// face up or empties a pile, then any other move, and turning the stock last.
+func (g *Game) Hint() (Move, bool) {
+	best, score := Move{}, -1
+	for _, m := range g.Moves() {
+		if s := g.score(m); s > score {
+			best, score = m, s
+		}
+	}
+	return best, score >= 0
+}
+
+func (g *Game) score(m Move) int {
+	from, to := g.byName[m.From], g.byName[m.To]
+	n := m.Count
+	if n == 0 {
+		n = 1
+	}
+	switch {
+	case from.Kind == Stock:
+		return 0
+	case to.Kind == Foundation:
+		return 3
+	case n == len(from.Cards) || n == from.Up && from.Flip:
+		return 2
+	}
+	return 1
+}
+
+// String draws the table, one pile per line, with the face-down cards as "??".
+func (g *Game) String() string {
+	var lines []string
+	for _, p := range g.Piles {
+		var codes []string
+		for i, c := range p.Cards {
+			if i < len(p.Cards)-p.Up {
+				codes = append(codes, "??")
+			} else {
+				codes = append(codes, c.Code())
+			}
+		}
+		lines = append(lines, p.Name+": "+strings.Join(codes, " "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,94 @@
+package solitaire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+// broken covers a pile with one that doesn't exist
+type broken struct{ Golf }
+
+func (broken) Layout() Layout {
+	return Layout{{Name: "a", Deal: 1, BlockedBy: []string{"b"}}}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(Golf{}, deck.New()[:30]); err != ErrDeckSize {
+		t.Error("Expected", ErrDeckSize, "received:", err)
+	}
+	if _, err := New(broken{}, deck.New()); err == nil || !strings.HasPrefix(err.Error(), ErrLayout.Error()) {
+		t.Error("Expected", ErrLayout, "received:", err)
+	}
+
+	cards := deck.New()
+	g, err := New(Golf{}, cards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Piles) != 9 || len(g.Pile("stock").Cards) != 16 || g.Pile("stock").Up != 0 || g.Pile("nope") != nil {
+		t.Error("Expected 7 columns, the waste and the stock, received:", g)
+	}
+	g.Pile("c0").Cards[0] = deck.Card{}
+	if cards[0] != (deck.Card{Rank: deck.Ace, Suit: deck.Spade}) {
+		t.Error("Expected the cards dealt to be copied, received:", cards[0])
+	}
+}
+
+func TestPlay(t *testing.T) {
+	g, _ := New(Golf{}, deck.New())
+	before := g.String()
+	if err := g.Play(Move{From: "c0", To: "waste"}); err != ErrIllegal {
+		t.Error("Expected", ErrIllegal, "received:", err)
+	}
+	if err := g.Undo(); err != ErrUndo {
+		t.Error("Expected", ErrUndo, "received:", err)
+	}
+
+	if err := g.Play(Move{From: "c6", To: "waste", Count: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(Move{From: "stock", To: "waste"}); err != nil {
+		t.Fatal(err)
+	}
+	if top, _ := g.Pile("waste").Top(); top.Code() != "KH" || g.Pile("waste").Up != 3 || len(g.Pile("c6").Cards) != 4 {
+		t.Error("Expected the King of Hearts turned on the waste, received:", g)
+	}
+
+	g.Undo()
+	g.Undo()
+	if s := g.String(); s != before {
+		t.Error("Expected", before, "received:", s)
+	}
+}
+
+func TestHint(t *testing.T) {
+	g, _ := New(Golf{}, deck.New())
+	if m, ok := g.Hint(); !ok || m != (Move{From: "c6", To: "waste"}) {
+		t.Error("Expected to play the Nine of Clubs, received:", m, ok)
+	}
+
+	// With only the stock left to turn, the hint is to turn it
+	for _, name := range names("c", 7) {
+		g.Pile(name).Cards = nil
+	}
+	if m, ok := g.Hint(); !ok || m.From != "stock" {
+		t.Error("Expected to turn the stock, received:", m, ok)
+	}
+	g.Pile("stock").Cards = nil
+	if m, ok := g.Hint(); ok {
+		t.Error("Expected no hint, received:", m)
+	}
+	if !g.Won() {
+		t.Error("Expected a won game")
+	}
+}
+
+func TestString(t *testing.T) {
+	g, _ := New(TriPeaks{}, deck.New())
+	lines := strings.Split(g.String(), "\n")
+	if lines[0] != "t0: ??" || lines[27] != "t27: 2C" || lines[28] != "waste: 3C" {
+		t.Error("Expected the peaks face down and the bottom row face up, received:", lines)
+	}
+}
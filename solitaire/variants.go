@@ -0,0 +1,249 @@
+package solitaire
+
+import (
+	"strconv"
+
+	"github.com/euller88/deck"
+)
+
+// names returns the names of n piles starting with prefix.
+func names(prefix string, n int) []string {
+	ret := make([]string, n)
+	for i := range ret {
+		ret[i] = prefix + strconv.Itoa(i)
+	}
+	return ret
+}
+
+// empty reports if every pile named is empty.
+func (g *Game) empty(names []string) bool {
+	for _, n := range names {
+		if len(g.byName[n].Cards) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// turnStock returns the move turning the top card of the stock onto the waste, if there is one.
+func turnStock(g *Game) []Move {
+	if len(g.byName["stock"].Cards) == 0 {
+		return nil
+	}
+	return []Move{{From: "stock", To: "waste"}}
+}
+
+func red(c deck.Card) bool {
+	return c.Suit == deck.Diamond || c.Suit == deck.Heart
+}
+
+// Pyramid is played on a pyramid of 28 cards in 7 rows, every card covered by the two below it. Two uncovered cards
+// adding up to 13 are discarded together, Kings alone, the Jack counting 11 and the Queen 12. The stock is turned
+// one card at a time onto the waste, whose top card can be paired too. The game is won when the pyramid is cleared.
+type Pyramid struct{}
+
+// Layout returns the pyramid, then the waste, the discard pile and the stock.
+func (Pyramid) Layout() Layout {
+	piles := names("p", 28)
+	var l Layout
+	for row, first := 0, 0; row < 7; row, first = row+1, first+row+1 {
+		for i := 0; i <= row; i++ {
+			spec := PileSpec{Name: piles[first+i], Kind: Tableau, Deal: 1, FaceUp: 1}
+			if row < 6 {
+				below := first + row + 1 + i
+				spec.BlockedBy = []string{piles[below], piles[below+1]}
+			}
+			l = append(l, spec)
+		}
+	}
+	return append(l,
+		PileSpec{Name: "waste", Kind: Waste},
+		PileSpec{Name: "discard", Kind: Foundation},
+		PileSpec{Name: "stock", Kind: Stock, Deal: Rest},
+	)
+}
+
+// Moves returns the Kings and the pairs that can be discarded, and the turn of the stock.
+func (Pyramid) Moves(g *Game) []Move {
+	var avail []string
+	for _, name := range append(names("p", 28), "waste") {
+		if g.Available(name) {
+			avail = append(avail, name)
+		}
+	}
+
+	var moves []Move
+	for i, a := range avail {
+		ca, _ := g.byName[a].Top()
+		if ca.Rank == deck.King {
+			moves = append(moves, Move{From: a, To: "discard"})
+			continue
+		}
+		for _, b := range avail[i+1:] {
+			if cb, _ := g.byName[b].Top(); ca.Rank+cb.Rank == 13 {
+				moves = append(moves, Move{From: a, With: b, To: "discard"})
+			}
+		}
+	}
+	return append(moves, turnStock(g)...)
+}
+
+// Won reports if the pyramid is cleared.
+func (Pyramid) Won(g *Game) bool {
+	return g.empty(names("p", 28))
+}
+
+// Golf is played on 7 columns of 5 face-up cards. The top card of a column goes to the waste when it's one rank above
+// or below the top of the waste, without going round the corner and with nothing going on a King. The stock is
+// turned onto the waste when stuck. The game is won when the columns are cleared.
+type Golf struct{}
+
+// Layout returns the columns, the waste, starting with a card, and the stock.
+func (Golf) Layout() Layout {
+	var l Layout
+	for _, name := range names("c", 7) {
+		l = append(l, PileSpec{Name: name, Kind: Tableau, Deal: 5, FaceUp: Rest})
+	}
+	return append(l,
+		PileSpec{Name: "waste", Kind: Waste, Deal: 1, FaceUp: 1},
+		PileSpec{Name: "stock", Kind: Stock, Deal: Rest},
+	)
+}
+
+// Moves returns the columns that can go onto the waste, and the turn of the stock.
+func (Golf) Moves(g *Game) []Move {
+	var moves []Move
+	if w, ok := g.byName["waste"].Top(); ok && w.Rank != deck.King {
+		for _, name := range names("c", 7) {
+			if c, ok := g.byName[name].Top(); ok && (c.Rank == w.Rank+1 || c.Rank+1 == w.Rank) {
+				moves = append(moves, Move{From: name, To: "waste"})
+			}
+		}
+	}
+	return append(moves, turnStock(g)...)
+}
+
+// Won reports if the columns are cleared.
+func (Golf) Won(g *Game) bool {
+	return g.empty(names("c", 7))
+}
+
+// TriPeaks is played on three peaks of 28 cards in 4 rows, every card covered by the two below it and face down
+// until uncovered. An uncovered card goes to the waste when it's one rank above or below the top of the waste, the
+// Ace and the King being next to each other. The stock is turned onto the waste when stuck. The game is won when the
+// peaks are cleared.
+type TriPeaks struct{}
+
+// Layout returns the peaks, from the top row down, then the waste, starting with a card, and the stock.
+func (TriPeaks) Layout() Layout {
+	piles := names("t", 28)
+	var l Layout
+	// The tops of the peaks are 0 to 2, the rows below start at 3, 9 and 18
+	for i := 0; i < 28; i++ {
+		spec := PileSpec{Name: piles[i], Kind: Tableau, Deal: 1}
+		switch {
+		case i < 3:
+			spec.BlockedBy = []string{piles[3+2*i], piles[4+2*i]}
+		case i < 9:
+			k := i - 3
+			spec.BlockedBy = []string{piles[9+k+k/2], piles[10+k+k/2]}
+		case i < 18:
+			k := i - 9
+			spec.BlockedBy = []string{piles[18+k], piles[19+k]}
+		default:
+			spec.FaceUp = 1
+		}
+		l = append(l, spec)
+	}
+	return append(l,
+		PileSpec{Name: "waste", Kind: Waste, Deal: 1, FaceUp: 1},
+		PileSpec{Name: "stock", Kind: Stock, Deal: Rest},
+	)
+}
+
+// Moves returns the uncovered cards that can go onto the waste, and the turn of the stock.
+func (TriPeaks) Moves(g *Game) []Move {
+	var moves []Move
+	if w, ok := g.byName["waste"].Top(); ok {
+		for _, name := range names("t", 28) {
+			if !g.Available(name) {
+				continue
+			}
+			c, _ := g.byName[name].Top()
+			d := int(c.Rank) - int(w.Rank)
+			if d == 1 || d == -1 || d == 12 || d == -12 {
+				moves = append(moves, Move{From: name, To: "waste"})
+			}
+		}
+	}
+	return append(moves, turnStock(g)...)
+}
+
+// Won reports if the peaks are cleared.
+func (TriPeaks) Won(g *Game) bool {
+	return g.empty(names("t", 28))
+}
+
+// Yukon is played on 7 columns, the first of a single card and the others of 1 to 6 face-down cards under 5 face-up
+// ones. Any face-up card can be moved with the cards on top of it onto a card of the other color one rank above, or
+// when it's a King onto an empty column. The top cards of the columns build the four foundations up by suit from the
+// Ace. The game is won when the foundations hold the whole deck.
+type Yukon struct{}
+
+// Layout returns the columns and the foundations.
+func (Yukon) Layout() Layout {
+	var l Layout
+	for i, name := range names("y", 7) {
+		spec := PileSpec{Name: name, Kind: Tableau, Deal: i + 5, FaceUp: 5, Flip: true}
+		if i == 0 {
+			spec.Deal, spec.FaceUp = 1, 1
+		}
+		l = append(l, spec)
+	}
+	for _, name := range names("f", 4) {
+		l = append(l, PileSpec{Name: name, Kind: Foundation})
+	}
+	return l
+}
+
+// Moves returns the cards that can go to a foundation, and the groups of cards that can move to another column.
+func (Yukon) Moves(g *Game) []Move {
+	var moves []Move
+	columns, foundations := names("y", 7), names("f", 4)
+	for _, from := range columns {
+		p := g.byName[from]
+		if top, ok := p.Top(); ok {
+			for _, f := range foundations {
+				base, ok := g.byName[f].Top()
+				if !ok && top.Rank == deck.Ace || ok && base.Suit == top.Suit && base.Rank+1 == top.Rank {
+					moves = append(moves, Move{From: from, To: f})
+					break
+				}
+			}
+		}
+
+		for n := 1; n <= p.Up; n++ {
+			c := p.Cards[len(p.Cards)-n]
+			for _, to := range columns {
+				if to == from {
+					continue
+				}
+				t, ok := g.byName[to].Top()
+				// A King already at the bottom of its column has nowhere better to go
+				if !ok && c.Rank == deck.King && n < len(p.Cards) || ok && t.Rank == c.Rank+1 && red(t) != red(c) {
+					moves = append(moves, Move{From: from, To: to, Count: n})
+				}
+			}
+		}
+	}
+	return moves
+}
+
+// Won reports if the foundations hold the whole deck.
+func (Yukon) Won(g *Game) bool {
+	n := 0
+	for _, f := range names("f", 4) {
+		n += len(g.byName[f].Cards)
+	}
+	return n == 52
+}
@@ -0,0 +1,139 @@
+package solitaire
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func game(t *testing.T, r Rules) *Game {
+	g, err := New(r, deck.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func equal(a, b []Move) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPyramid(t *testing.T) {
+	g := game(t, Pyramid{})
+	// The bottom row is 9D TD JD QD KD AC 2C
+	expected := []Move{
+		{From: "p23", With: "p27", To: "discard"},
+		{From: "p24", With: "p26", To: "discard"},
+		{From: "p25", To: "discard"},
+		{From: "stock", To: "waste"},
+	}
+	if m := g.Moves(); !equal(m, expected) {
+		t.Error("Expected", expected, "received:", m)
+	}
+
+	for _, m := range expected[:3] {
+		if err := g.Play(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The row above is uncovered, but for the Four of Diamonds under the Ten
+	if !g.Available("p17") || !g.Available("p20") || g.Available("p16") || len(g.Pile("discard").Cards) != 5 || g.Won() {
+		t.Error("Expected p17 to p20 uncovered, received:", g)
+	}
+
+	expected = []Move{
+		{From: "p17", With: "p20", To: "discard"},
+		{From: "p18", With: "p19", To: "discard"},
+		{From: "stock", To: "waste"},
+	}
+	if m := g.Moves(); !equal(m, expected) {
+		t.Error("Expected", expected, "received:", m)
+	}
+}
+
+func TestGolf(t *testing.T) {
+	g := game(t, Golf{})
+	g.Play(Move{From: "c6", To: "waste"})
+	expected := []Move{{From: "c1", To: "waste"}, {From: "c6", To: "waste"}, {From: "stock", To: "waste"}}
+	if m := g.Moves(); !equal(m, expected) {
+		t.Error("Expected", expected, "received:", m)
+	}
+
+	// Nothing goes on a King
+	g.Pile("waste").Cards = append(g.Pile("waste").Cards, deck.Card{Rank: deck.King, Suit: deck.Heart})
+	g.Pile("c0").Cards = append(g.Pile("c0").Cards, deck.Card{Rank: deck.Queen, Suit: deck.Heart})
+	if m := g.Moves(); len(m) != 1 || m[0].From != "stock" {
+		t.Error("Expected to turn the stock, received:", m)
+	}
+}
+
+func TestTriPeaks(t *testing.T) {
+	g := game(t, TriPeaks{})
+	for _, from := range []string{"t27", "t26", "t25"} {
+		// The Ace goes on the Two and the King on the Ace
+		if err := g.Play(Move{From: from, To: "waste"}); err != nil {
+			t.Fatal(from, err)
+		}
+	}
+	if g.Pile("t16").Up != 1 || !g.Available("t16") || !g.Available("t17") || g.Available("t15") {
+		t.Error("Expected t16 and t17 face up once uncovered, received:", g)
+	}
+
+	g.Undo()
+	if g.Pile("t16").Up != 0 || g.Available("t16") || !g.Available("t17") {
+		t.Error("Expected t16 face down again, received:", g)
+	}
+}
+
+func TestYukon(t *testing.T) {
+	g := game(t, Yukon{})
+	total := 0
+	for _, name := range names("y", 7) {
+		total += len(g.Pile(name).Cards)
+	}
+	if p := g.Pile("y6"); total != 52 || len(p.Cards) != 11 || p.Up != 5 {
+		t.Error("Expected the whole deck in the columns, received:", g)
+	}
+
+	if m := g.Moves(); len(m) == 0 || m[0] != (Move{From: "y0", To: "f0"}) {
+		t.Error("Expected the Ace of Spades to the foundation, received:", m)
+	}
+	g.Play(Move{From: "y0", To: "f0"})
+
+	// The King of Spades moves to the empty column with the Ace of Diamonds on it
+	king := Move{From: "y2", To: "y0", Count: 2}
+	if err := g.Play(king); err != nil {
+		t.Fatal(err)
+	}
+	if p := g.Pile("y0"); len(p.Cards) != 2 || p.Up != 2 || g.Pile("y2").Up != 3 {
+		t.Error("Expected the King moved with the Ace, received:", g)
+	}
+
+	// Moving the last face-up cards turns the next one
+	p := g.Pile("y1")
+	p.Up = 1
+	g.Pile("f0").Cards = append(g.Pile("f0").Cards, deck.Card{Rank: deck.Six, Suit: deck.Spade})
+	if err := g.Play(Move{From: "y1", To: "f0"}); err != nil {
+		t.Fatal(err)
+	}
+	if p.Up != 1 || len(p.Cards) != 5 {
+		t.Error("Expected the next card turned, received:", g)
+	}
+
+	if g.Won() {
+		t.Error("Expected a game not won")
+	}
+	g.Pile("f0").Cards = nil
+	g.Pile("f1").Cards = deck.New()
+	if !g.Won() {
+		t.Error("Expected a won game")
+	}
+}
@@ -0,0 +1,335 @@
+// Package speed implements Speed, a real-time game where both players play at the same time onto two shared piles.
+//
+// Plays are submitted concurrently from any number of goroutines and queued. Every Tick resolves the queued plays
+// atomically, in a deterministic order that doesn't depend on how the goroutines were scheduled: plays are sorted by
+// player, the player with priority going first, and priority alternates between ticks. A play that stopped being legal
+// because of an earlier play in the same tick is rejected with ErrConflict.
+package speed
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/euller88/deck"
+)
+
+// The sizes of the piles dealt at the start of the game
+const (
+	HandSize = 5
+	DrawSize = 15
+	SideSize = 5
+)
+
+// The errors returned when a play is rejected
+var (
+	ErrDeckSize  = errors.New("speed: not enough cards to deal")
+	ErrPlayer    = errors.New("speed: no such player")
+	ErrPile      = errors.New("speed: no such pile")
+	ErrNotInHand = errors.New("speed: card is not in the player's hand")
+	ErrRank      = errors.New("speed: card is not one rank above or below the top of the pile")
+	ErrConflict  = errors.New("speed: another play landed on the pile first")
+	ErrGameOver  = errors.New("speed: the game is over")
+	ErrStopped   = errors.New("speed: the game is not running")
+)
+
+// Play is a single card played by a player onto one of the two center piles.
+type Play struct {
+	Player int
+	Card   deck.Card
+	Pile   int
+}
+
+// Result is the outcome of a play resolved by a tick.
+type Result struct {
+	Play
+	Err error
+}
+
+// View is a snapshot of the game.
+type View struct {
+	Hands   [2][]deck.Card
+	Draw    [2]int
+	Side    [2]int
+	Tops    [2]deck.Card
+	Winner  int
+	Over    bool
+	Refills int
+}
+
+type request struct {
+	play Play
+	seq  int
+	done chan error
+}
+
+// Game holds the state of a game of Speed. All its methods are safe for concurrent use.
+type Game struct {
+	mu      sync.Mutex
+	hands   [2][]deck.Card
+	draw    [2][]deck.Card
+	side    [2][]deck.Card
+	center  [2][]deck.Card
+	pending []request
+	seq     int
+	ticks   int
+	refills int
+	winner  int
+	over    bool
+	stopped bool
+	r       *rand.Rand
+}
+
+// New deals a game from cards, which should come already shuffled: a hand of five and a draw pile of fifteen cards
+// to each player, two side piles of five cards and a card from each side pile to start the center piles.
+// r is used to shuffle the center piles back into side piles when both run out.
+func New(cards []deck.Card, r *rand.Rand) (*Game, error) {
+	const each = HandSize + DrawSize
+	if len(cards) < 2*each+2*SideSize {
+		return nil, ErrDeckSize
+	}
+
+	g := &Game{r: r, winner: -1}
+	n := 0
+	for p := 0; p < 2; p++ {
+		g.hands[p] = append([]deck.Card(nil), cards[n:n+HandSize]...)
+		g.draw[p] = append([]deck.Card(nil), cards[n+HandSize:n+each]...)
+		n += each
+	}
+	for i := 0; i < 2; i++ {
+		g.side[i] = append([]deck.Card(nil), cards[n:n+SideSize]...)
+		n += SideSize
+	}
+	g.flip()
+	return g, nil
+}
+
+// Submit queues a play to be resolved by the next tick, and returns a channel that receives its outcome.
+// Once Run returned, plays are rejected at once with ErrStopped, or ErrGameOver when the game ended.
+func (g *Game) Submit(p Play) <-chan error {
+	done := make(chan error, 1)
+
+	g.mu.Lock()
+	if g.stopped {
+		done <- g.stopErr()
+	} else {
+		g.pending = append(g.pending, request{play: p, seq: g.seq, done: done})
+		g.seq++
+	}
+	g.mu.Unlock()
+
+	return done
+}
+
+// Tick resolves every queued play, refills the hands, and flips new cards onto the center piles when neither player
+// can play. It returns the outcome of every play, in the order they were resolved.
+func (g *Game) Tick() []Result {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	batch := g.pending
+	g.pending = nil
+	first := g.ticks % 2
+	g.ticks++
+	sort.SliceStable(batch, func(i, j int) bool {
+		pi, pj := batch[i].play.Player, batch[j].play.Player
+		if pi != pj {
+			return pi == first
+		}
+		return batch[i].seq < batch[j].seq
+	})
+
+	results := make([]Result, 0, len(batch))
+	tops := [2]deck.Card{top(g.center[0]), top(g.center[1])}
+	for _, req := range batch {
+		err := g.apply(req.play, tops)
+		req.done <- err
+		results = append(results, Result{Play: req.play, Err: err})
+	}
+
+	if !g.over && g.stalled() {
+		g.refill()
+	}
+	return results
+}
+
+// Run ticks every interval until stop is closed or the game ends. The plays still queued when it returns are rejected.
+func (g *Game) Run(interval time.Duration, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	g.mu.Lock()
+	g.stopped = false
+	g.mu.Unlock()
+	defer g.halt()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			g.Tick()
+			if g.View().Over {
+				return
+			}
+		}
+	}
+}
+
+// halt rejects every queued play, and the ones submitted later, until Run is called again.
+func (g *Game) halt() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.stopped = true
+	for _, req := range g.pending {
+		req.done <- g.stopErr()
+	}
+	g.pending = nil
+}
+
+func (g *Game) stopErr() error {
+	if g.over {
+		return ErrGameOver
+	}
+	return ErrStopped
+}
+
+// Playable returns the plays available to player right now.
+func (g *Game) Playable(player int) []Play {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.playable(player)
+}
+
+// View returns a snapshot of the game.
+func (g *Game) View() View {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	v := View{Winner: g.winner, Over: g.over, Refills: g.refills}
+	for p := 0; p < 2; p++ {
+		v.Hands[p] = append([]deck.Card(nil), g.hands[p]...)
+		v.Draw[p] = len(g.draw[p])
+		v.Side[p] = len(g.side[p])
+		v.Tops[p] = top(g.center[p])
+	}
+	return v
+}
+
+// apply makes a single play. tops holds the tops of the piles at the start of the tick.
+func (g *Game) apply(p Play, tops [2]deck.Card) error {
+	switch {
+	case g.over:
+		return ErrGameOver
+	case p.Player < 0 || p.Player > 1:
+		return ErrPlayer
+	case p.Pile < 0 || p.Pile > 1:
+		return ErrPile
+	}
+	i := index(g.hands[p.Player], p.Card)
+	if i < 0 {
+		return ErrNotInHand
+	}
+	if !Adjacent(p.Card.Rank, top(g.center[p.Pile]).Rank) {
+		if Adjacent(p.Card.Rank, tops[p.Pile].Rank) {
+			return ErrConflict
+		}
+		return ErrRank
+	}
+
+	g.hands[p.Player] = append(g.hands[p.Player][:i], g.hands[p.Player][i+1:]...)
+	g.center[p.Pile] = append(g.center[p.Pile], p.Card)
+
+	for len(g.hands[p.Player]) < HandSize && len(g.draw[p.Player]) > 0 {
+		g.hands[p.Player] = append(g.hands[p.Player], g.draw[p.Player][0])
+		g.draw[p.Player] = g.draw[p.Player][1:]
+	}
+	if len(g.hands[p.Player]) == 0 {
+		g.winner, g.over = p.Player, true
+	}
+	return nil
+}
+
+func (g *Game) playable(player int) []Play {
+	var plays []Play
+	for _, c := range g.hands[player] {
+		for pile := range g.center {
+			if Adjacent(c.Rank, top(g.center[pile]).Rank) {
+				plays = append(plays, Play{Player: player, Card: c, Pile: pile})
+			}
+		}
+	}
+	return plays
+}
+
+func (g *Game) stalled() bool {
+	return len(g.playable(0)) == 0 && len(g.playable(1)) == 0
+}
+
+// refill flips a card from every side pile onto the center piles. When a side pile is empty, the center piles and
+// whatever is left of the other side pile are shuffled into new side piles first. If there is nothing left to flip,
+// the game ends and the player with fewer cards wins.
+func (g *Game) refill() {
+	if len(g.side[0]) == 0 || len(g.side[1]) == 0 {
+		var rest []deck.Card
+		for i := range g.center {
+			rest = append(rest, g.side[i]...)
+			rest = append(rest, g.center[i]...)
+			g.side[i], g.center[i] = nil, nil
+		}
+		g.r.Shuffle(len(rest), func(i, j int) {
+			rest[i], rest[j] = rest[j], rest[i]
+		})
+		g.side[0], g.side[1] = rest[:len(rest)/2], rest[len(rest)/2:]
+	}
+	if len(g.side[0]) == 0 || len(g.side[1]) == 0 {
+		left := [2]int{len(g.hands[0]) + len(g.draw[0]), len(g.hands[1]) + len(g.draw[1])}
+		g.over = true
+		switch {
+		case left[0] < left[1]:
+			g.winner = 0
+		case left[1] < left[0]:
+			g.winner = 1
+		}
+		return
+	}
+	g.flip()
+	g.refills++
+}
+
+func (g *Game) flip() {
+	for i := range g.side {
+		last := len(g.side[i]) - 1
+		g.center[i] = append(g.center[i], g.side[i][last])
+		g.side[i] = g.side[i][:last]
+	}
+}
+
+// Adjacent reports if two ranks are next to each other, the Ace sitting both below the Two and above the King.
+func Adjacent(a, b deck.Rank) bool {
+	d := int(a) - int(b)
+	if d < 0 {
+		d = -d
+	}
+	return d == 1 || d == int(deck.King)-int(deck.Ace)
+}
+
+func top(pile []deck.Card) deck.Card {
+	if len(pile) == 0 {
+		return deck.Card{}
+	}
+	return pile[len(pile)-1]
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
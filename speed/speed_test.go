@@ -0,0 +1,152 @@
+package speed
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/euller88/deck"
+)
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+func TestAdjacent(t *testing.T) {
+	if !Adjacent(deck.Ace, deck.King) || !Adjacent(deck.Two, deck.Ace) || Adjacent(deck.Five, deck.Seven) {
+		t.Error("Expected ranks to be adjacent only one apart, wrapping around the Ace.")
+	}
+}
+
+func TestConflict(t *testing.T) {
+	g := &Game{winner: -1, r: rand.New(rand.NewSource(1))}
+	g.hands[0] = []deck.Card{c(deck.Six, deck.Spade), c(deck.Nine, deck.Club)}
+	g.hands[1] = []deck.Card{c(deck.Six, deck.Heart), c(deck.Jack, deck.Club)}
+	g.draw[0] = []deck.Card{c(deck.Two, deck.Club)}
+	g.side = [2][]deck.Card{{c(deck.King, deck.Club)}, {c(deck.King, deck.Diamond)}}
+	g.center = [2][]deck.Card{{c(deck.Five, deck.Spade)}, {c(deck.Ten, deck.Heart)}}
+
+	// Both players race for the Five, player 0 has priority on the first tick
+	late := g.Submit(Play{Player: 1, Card: c(deck.Six, deck.Heart), Pile: 0})
+	early := g.Submit(Play{Player: 0, Card: c(deck.Six, deck.Spade), Pile: 0})
+	never := g.Submit(Play{Player: 1, Card: c(deck.Jack, deck.Club), Pile: 0})
+	results := g.Tick()
+
+	if len(results) != 3 || results[0].Player != 0 {
+		t.Fatal("Expected the play of player 0 to be resolved first, received:", results)
+	}
+	if err := <-early; err != nil {
+		t.Error("Expected the first play to land, received:", err)
+	}
+	if err := <-late; err != ErrConflict {
+		t.Error("Expected", ErrConflict, "received:", err)
+	}
+	if err := <-never; err != ErrRank {
+		t.Error("Expected", ErrRank, "for a play that was never legal, received:", err)
+	}
+	if v := g.View(); v.Tops[0] != c(deck.Six, deck.Spade) || len(v.Hands[0]) != 2 || v.Draw[0] != 0 {
+		t.Error("Expected player 0 to refill the hand from the draw pile, received:", v)
+	}
+
+	// Now player 1 has priority
+	g.Submit(Play{Player: 0, Card: c(deck.Jack, deck.Club), Pile: 1})
+	g.Submit(Play{Player: 1, Card: c(deck.Jack, deck.Club), Pile: 1})
+	if results := g.Tick(); results[0].Player != 1 || results[0].Err != nil || results[1].Err != ErrNotInHand {
+		t.Error("Expected player 1 to go first on the second tick, received:", results)
+	}
+}
+
+func TestStall(t *testing.T) {
+	g := &Game{winner: -1, r: rand.New(rand.NewSource(1))}
+	g.hands[0] = []deck.Card{c(deck.Two, deck.Spade)}
+	g.hands[1] = []deck.Card{c(deck.Two, deck.Heart)}
+	g.side = [2][]deck.Card{{c(deck.Three, deck.Club)}, {c(deck.Nine, deck.Diamond)}}
+	g.center = [2][]deck.Card{{c(deck.Seven, deck.Spade)}, {c(deck.Ten, deck.Heart)}}
+
+	g.Tick()
+	v := g.View()
+	if v.Refills != 1 || v.Tops[0] != c(deck.Three, deck.Club) {
+		t.Fatal("Expected the side piles to be flipped on a stall, received:", v)
+	}
+
+	g.Submit(Play{Player: 1, Card: c(deck.Two, deck.Heart), Pile: 0})
+	g.Tick()
+	if v := g.View(); !v.Over || v.Winner != 1 {
+		t.Error("Expected player 1 to win, received:", v)
+	}
+}
+
+// count returns the number of cards in the game.
+func count(g *Game) int {
+	n := 0
+	for i := 0; i < 2; i++ {
+		n += len(g.hands[i]) + len(g.draw[i]) + len(g.side[i]) + len(g.center[i])
+	}
+	return n
+}
+
+func TestRefill(t *testing.T) {
+	g := &Game{winner: -1, r: rand.New(rand.NewSource(1))}
+	g.hands[0] = []deck.Card{c(deck.Two, deck.Spade)}
+	g.hands[1] = []deck.Card{c(deck.Two, deck.Heart)}
+	g.side = [2][]deck.Card{{c(deck.Three, deck.Club)}, nil}
+	g.center = [2][]deck.Card{
+		{c(deck.Seven, deck.Spade), c(deck.Eight, deck.Spade)},
+		{c(deck.Ten, deck.Heart), c(deck.Jack, deck.Heart)},
+	}
+
+	g.refill()
+	if n := count(g); n != 7 {
+		t.Error("Expected the 7 cards to stay in the game, received:", n)
+	}
+	if v := g.View(); v.Side[0]+v.Side[1] != 3 || v.Refills != 1 {
+		t.Error("Expected the 5 cards off the hands to be shuffled into side piles and flipped, received:", v)
+	}
+}
+
+func TestRun(t *testing.T) {
+	g, err := New(deck.New(), rand.New(rand.NewSource(42)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		g.Run(time.Hour, stop)
+		close(done)
+	}()
+	close(stop)
+	<-done
+
+	if err := <-g.Submit(Play{Card: g.View().Hands[0][0]}); err != ErrStopped {
+		t.Error("Expected", ErrStopped, "received:", err)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	g, err := New(deck.New(), rand.New(rand.NewSource(42)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 1000 && !g.View().Over; i++ {
+		var wg sync.WaitGroup
+		for p := 0; p < 2; p++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				for _, play := range g.Playable(p) {
+					g.Submit(play)
+				}
+			}(p)
+		}
+		wg.Wait()
+		g.Tick()
+		if n := count(g); n != 50 {
+			t.Fatal("Expected the 50 dealt cards to stay in the game, received:", n)
+		}
+	}
+	if v := g.View(); !v.Over {
+		t.Error("Expected the game to end, received:", v)
+	}
+}
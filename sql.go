@@ -0,0 +1,127 @@
+package deck
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrScan is returned when a database value can't be scanned into a card, a suit, a rank or cards
+var ErrScan = errors.New("deck: can't scan database value")
+
+// Cards is a slice of cards that can be stored in a database as their codes separated by spaces, such as "AS KD X0",
+// the empty slice being the empty string.
+type Cards []Card
+
+// Value returns the code of the card, as Code, for database/sql.
+func (c Card) Value() (driver.Value, error) {
+	code := c.Code()
+	if code == "??" {
+		return nil, fmt.Errorf("deck: can't store %#v", c)
+	}
+	return code, nil
+}
+
+// Scan reads a card code from the database. Only the codes returned by Code are accepted.
+func (c *Card) Scan(src interface{}) error {
+	s, err := text(src)
+	if err != nil {
+		return err
+	}
+	card, err := ParseCard(s)
+	if err != nil || card.Code() != s {
+		return fmt.Errorf("%v: %q is not a card code", ErrScan, s)
+	}
+	*c = card
+	return nil
+}
+
+// Value returns the codes of the cards separated by spaces, for database/sql.
+func (cs Cards) Value() (driver.Value, error) {
+	codes := make([]string, len(cs))
+	for i, c := range cs {
+		v, err := c.Value()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = v.(string)
+	}
+	return strings.Join(codes, " "), nil
+}
+
+// Scan reads card codes separated by single spaces from the database.
+func (cs *Cards) Scan(src interface{}) error {
+	s, err := text(src)
+	if err != nil {
+		return err
+	}
+	if s == "" {
+		*cs = Cards{}
+		return nil
+	}
+	codes := strings.Split(s, " ")
+	cards := make(Cards, len(codes))
+	for i, code := range codes {
+		if err := cards[i].Scan(code); err != nil {
+			return err
+		}
+	}
+	*cs = cards
+	return nil
+}
+
+// Value returns the code of the suit, "S", "D", "C", "H" or "X" for the jokers, for database/sql.
+func (s Suit) Value() (driver.Value, error) {
+	if s > Joker {
+		return nil, fmt.Errorf("deck: can't store suit %d", s)
+	}
+	return string((suitCodes + "X")[s]), nil
+}
+
+// Scan reads a suit code from the database.
+func (s *Suit) Scan(src interface{}) error {
+	code, err := text(src)
+	if err != nil {
+		return err
+	}
+	i := strings.Index(suitCodes+"X", code)
+	if len(code) != 1 || i < 0 {
+		return fmt.Errorf("%v: %q is not a suit code", ErrScan, code)
+	}
+	*s = Suit(i)
+	return nil
+}
+
+// Value returns the code of the rank, from "A" to "K" as in the card codes, for database/sql.
+func (r Rank) Value() (driver.Value, error) {
+	if r < minRank || r > maxRank {
+		return nil, fmt.Errorf("deck: can't store rank %d", r)
+	}
+	return string(rankCodes[r-minRank]), nil
+}
+
+// Scan reads a rank code from the database.
+func (r *Rank) Scan(src interface{}) error {
+	code, err := text(src)
+	if err != nil {
+		return err
+	}
+	i := strings.Index(rankCodes, code)
+	if len(code) != 1 || i < 0 {
+		return fmt.Errorf("%v: %q is not a rank code", ErrScan, code)
+	}
+	*r = minRank + Rank(i)
+	return nil
+}
+
+// text returns the string stored in a database value, which must be text or bytes.
+func text(src interface{}) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+	return "", fmt.Errorf("%v: %T is not text", ErrScan, src)
+}
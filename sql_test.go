@@ -0,0 +1,140 @@
+package deck
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// fakeDriver is a database of a single table, where every INSERT adds a row and every SELECT returns all of them
+type fakeDriver struct {
+	rows [][]driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	n    int
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{d}, nil }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{c.d, query}, nil }
+func (c fakeConn) Close() error                              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("no transactions") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.rows = append(s.d.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{rows: s.d.rows}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.n == len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.n])
+	r.n++
+	return nil
+}
+
+var fake = &fakeDriver{}
+
+func init() {
+	sql.Register("deckfake", fake)
+}
+
+func TestSQL(t *testing.T) {
+	db, err := sql.Open("deckfake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	fake.rows = nil
+
+	hand := Cards{{Suit: Spade, Rank: Ace}, {Suit: Heart, Rank: Ten}, {Suit: Joker, Rank: 1}}
+	if _, err := db.Exec("INSERT", hand[1], Heart, Ten, hand); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT", Card{Suit: Joker}, Joker, King, Cards{}); err != nil {
+		t.Fatal(err)
+	}
+	if row := fake.rows[0]; row[0] != "TH" || row[1] != "H" || row[2] != "T" || row[3] != "AS TH X1" {
+		t.Error("Expected the codes, received:", row)
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []Cards
+	for rows.Next() {
+		var (
+			c  Card
+			s  Suit
+			r  Rank
+			cs Cards
+		)
+		if err := rows.Scan(&c, &s, &r, &cs); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, append(Cards{c, {Suit: s, Rank: r}}, cs...))
+	}
+	expected := []Cards{
+		{hand[1], {Suit: Heart, Rank: Ten}, hand[0], hand[1], hand[2]},
+		{{Suit: Joker}, {Suit: Joker, Rank: King}},
+	}
+	if len(got) != 2 || !equal(got[0], expected[0]) || !equal(got[1], expected[1]) {
+		t.Error("Expected", expected, "received:", got)
+	}
+
+	if _, err := db.Exec("INSERT", Card{Suit: Spade}); err == nil {
+		t.Error("Expected an error storing a card without rank")
+	}
+}
+
+func TestScan(t *testing.T) {
+	var (
+		c  Card
+		s  Suit
+		r  Rank
+		cs Cards
+	)
+	for _, tt := range []struct {
+		dest sql.Scanner
+		src  interface{}
+	}{
+		{&c, "as"}, {&c, "10S"}, {&c, " AS"}, {&c, nil}, {&c, 42},
+		{&s, "Spade"}, {&s, ""}, {&r, "1"}, {&r, "AK"},
+		{&cs, "AS  KD"}, {&cs, "AS,KD"}, {&cs, "AS ZZ"},
+	} {
+		if err := tt.dest.Scan(tt.src); err == nil || !strings.HasPrefix(err.Error(), ErrScan.Error()) {
+			t.Errorf("Expected %v for %q, received: %v", ErrScan, tt.src, err)
+		}
+	}
+
+	if err := cs.Scan([]byte("QC X0")); err != nil || !equal(cs, []Card{{Suit: Club, Rank: Queen}, {Suit: Joker}}) {
+		t.Error("Expected the Queen of Clubs and a joker, received:", cs, err)
+	}
+}
@@ -0,0 +1,223 @@
+package deck
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// The errors returned by the operations of a table
+var (
+	ErrZone         = errors.New("deck: no such zone")
+	ErrZoneExists   = errors.New("deck: zone already exists")
+	ErrNotInZone    = errors.New("deck: card is not in the zone")
+	ErrUnordered    = errors.New("deck: the zone has no top")
+	ErrConservation = errors.New("deck: the cards on the table are not the ones dealt")
+)
+
+// Visibility tells who may see the cards of a zone
+type Visibility uint8
+
+// The visibility policies of the zones
+const (
+	// Hidden zones are seen by nobody, such as the stock
+	Hidden Visibility = iota
+
+	// Private zones are seen by their owner only, such as a hand
+	Private
+
+	// Public zones are seen by everybody, such as a trick
+	Public
+
+	// TopCard zones show their top card only, such as a discard pile
+	TopCard
+)
+
+// Zone describes a place on a table. The cards of an ordered zone keep the order they were put in, the top being the
+// last one, while the cards of an unordered zone, such as a hand or the cards taken, are always sorted by Index.
+// Owner is the player owning a private zone.
+type Zone struct {
+	Name       string
+	Ordered    bool
+	Visibility Visibility
+	Owner      int
+}
+
+type zone struct {
+	Zone
+	cards []Card
+}
+
+// Table holds the cards of a game in zones. Cards only go from a zone to another, so none is ever lost or duplicated
+// and the table always holds the cards it was set up with, which Check verifies.
+type Table struct {
+	zones map[string]*zone
+	names []string
+	dealt map[Card]int
+}
+
+// NewTable puts cards, such as the ones returned by New, in a zone, usually the stock.
+func NewTable(cards []Card, z Zone) *Table {
+	t := &Table{zones: map[string]*zone{}, dealt: map[Card]int{}}
+	for _, c := range cards {
+		t.dealt[c]++
+	}
+	t.AddZone(z)
+	t.zones[z.Name].cards = append([]Card(nil), cards...)
+	t.sort(t.zones[z.Name])
+	return t
+}
+
+// AddZone adds an empty zone.
+func (t *Table) AddZone(z Zone) error {
+	if _, ok := t.zones[z.Name]; ok {
+		return ErrZoneExists
+	}
+	t.zones[z.Name] = &zone{Zone: z}
+	t.names = append(t.names, z.Name)
+	return nil
+}
+
+// Zones returns the zones of the table, in the order they were added.
+func (t *Table) Zones() []Zone {
+	zones := make([]Zone, len(t.names))
+	for i, name := range t.names {
+		zones[i] = t.zones[name].Zone
+	}
+	return zones
+}
+
+// Cards returns a copy of the cards of a zone.
+func (t *Table) Cards(zone string) []Card {
+	if z := t.zones[zone]; z != nil {
+		return append([]Card(nil), z.cards...)
+	}
+	return nil
+}
+
+// Len returns the number of cards in a zone.
+func (t *Table) Len(zone string) int {
+	if z := t.zones[zone]; z != nil {
+		return len(z.cards)
+	}
+	return 0
+}
+
+// View returns the cards of a zone that player can see, and the number of the ones they can't.
+func (t *Table) View(zone string, player int) ([]Card, int) {
+	z := t.zones[zone]
+	switch {
+	case z == nil:
+		return nil, 0
+	case z.Visibility == Public, z.Visibility == Private && z.Owner == player:
+		return t.Cards(zone), 0
+	case z.Visibility == TopCard && len(z.cards) > 0:
+		return []Card{z.cards[len(z.cards)-1]}, len(z.cards) - 1
+	}
+	return nil, len(z.cards)
+}
+
+// Move takes cards from anywhere in a zone and puts them on top of another one, in order. Either every card moves, or
+// none does.
+func (t *Table) Move(from, to string, cards ...Card) error {
+	src, dst := t.zones[from], t.zones[to]
+	if src == nil || dst == nil {
+		return ErrZone
+	}
+	left := append([]Card(nil), src.cards...)
+	for _, c := range cards {
+		i := -1
+		for j := len(left) - 1; j >= 0; j-- {
+			if left[j] == c {
+				i = j
+				break
+			}
+		}
+		if i < 0 {
+			return fmt.Errorf("%v: %v in %s", ErrNotInZone, c, from)
+		}
+		left = append(left[:i], left[i+1:]...)
+	}
+	src.cards = left
+	dst.cards = append(dst.cards, cards...)
+	t.sort(dst)
+	return nil
+}
+
+// MoveTop moves the top n cards of an ordered zone on top of another one, keeping their order.
+func (t *Table) MoveTop(from, to string, n int) error {
+	src := t.zones[from]
+	switch {
+	case src == nil || t.zones[to] == nil:
+		return ErrZone
+	case !src.Ordered:
+		return ErrUnordered
+	case n < 0 || n > len(src.cards):
+		return fmt.Errorf("%v: %d cards in %s", ErrNotInZone, n, from)
+	}
+	return t.Move(from, to, src.cards[len(src.cards)-n:]...)
+}
+
+// Shuffle shuffles an ordered zone with r.
+func (t *Table) Shuffle(zone string, r *rand.Rand) error {
+	z := t.zones[zone]
+	switch {
+	case z == nil:
+		return ErrZone
+	case !z.Ordered:
+		return ErrUnordered
+	}
+	r.Shuffle(len(z.cards), func(i, j int) {
+		z.cards[i], z.cards[j] = z.cards[j], z.cards[i]
+	})
+	return nil
+}
+
+// Set replaces the cards of a zone, to reorder it or to restore a saved game. It fails with ErrConservation, leaving
+// the table unchanged, when the table would no longer hold the cards it was set up with.
+func (t *Table) Set(zone string, cards []Card) error {
+	z := t.zones[zone]
+	if z == nil {
+		return ErrZone
+	}
+	old := z.cards
+	z.cards = append([]Card(nil), cards...)
+	if err := t.Check(); err != nil {
+		z.cards = old
+		return err
+	}
+	t.sort(z)
+	return nil
+}
+
+// Check verifies that the cards of all the zones are the cards the table was set up with, as many copies of each.
+func (t *Table) Check() error {
+	left := make(map[Card]int, len(t.dealt))
+	for c, n := range t.dealt {
+		left[c] = n
+	}
+	for _, z := range t.zones {
+		for _, c := range z.cards {
+			left[c]--
+		}
+	}
+	missing, extra := 0, 0
+	for _, n := range left {
+		if n > 0 {
+			missing += n
+		} else {
+			extra -= n
+		}
+	}
+	if missing > 0 || extra > 0 {
+		return fmt.Errorf("%v: %d missing, %d extra", ErrConservation, missing, extra)
+	}
+	return nil
+}
+
+func (t *Table) sort(z *zone) {
+	if !z.Ordered {
+		sort.Slice(z.cards, func(i, j int) bool { return z.cards[i].Index() < z.cards[j].Index() })
+	}
+}
@@ -0,0 +1,105 @@
+package deck
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func ExampleTable() {
+	t := NewTable(New(), Zone{Name: "stock", Ordered: true})
+	t.AddZone(Zone{Name: "hand", Visibility: Private, Owner: 0})
+	t.AddZone(Zone{Name: "discard", Ordered: true, Visibility: TopCard})
+	t.MoveTop("stock", "hand", 3)
+	t.Move("hand", "discard", Card{Suit: Heart, Rank: Queen})
+	fmt.Println(t.View("hand", 0))
+	fmt.Println(t.View("hand", 1))
+	fmt.Println(t.View("discard", 1))
+	fmt.Println(t.Check())
+	// Output:
+	// [Jack of Hearts King of Hearts] 0
+	// [] 2
+	// [Queen of Hearts] 0
+	// <nil>
+}
+
+func TestTable(t *testing.T) {
+	cards := New(Deck(2))
+	tb := NewTable(cards, Zone{Name: "shoe", Ordered: true})
+	if err := tb.AddZone(Zone{Name: "shoe"}); err != ErrZoneExists {
+		t.Error("Expected", ErrZoneExists, "received:", err)
+	}
+	tb.AddZone(Zone{Name: "hand", Visibility: Public})
+	tb.AddZone(Zone{Name: "trick", Ordered: true, Visibility: Public})
+
+	tb.Shuffle("shoe", rand.New(rand.NewSource(1)))
+	if err := tb.MoveTop("shoe", "hand", 10); err != nil {
+		t.Fatal(err)
+	}
+	hand := tb.Cards("hand")
+	for i := 1; i < len(hand); i++ {
+		if hand[i-1].Index() > hand[i].Index() {
+			t.Error("Expected an unordered zone sorted by Index, received:", hand)
+		}
+	}
+
+	// Moves are all or nothing
+	err := tb.Move("hand", "trick", hand[0], hand[0], hand[0])
+	if err == nil || !strings.HasPrefix(err.Error(), ErrNotInZone.Error()) || tb.Len("hand") != 10 || tb.Len("trick") != 0 {
+		t.Error("Expected", ErrNotInZone, "and nothing moved, received:", err, tb.Cards("trick"))
+	}
+	if err := tb.Move("hand", "trick", hand[3], hand[1]); err != nil || !equal(tb.Cards("trick"), []Card{hand[3], hand[1]}) {
+		t.Error("Expected the trick in order, received:", tb.Cards("trick"), err)
+	}
+
+	for _, err := range []error{
+		tb.MoveTop("hand", "trick", 1),
+		tb.MoveTop("trick", "hand", 3),
+		tb.Move("nope", "hand"),
+		tb.Shuffle("hand", rand.New(rand.NewSource(1))),
+		tb.Set("nope", nil),
+	} {
+		if err == nil {
+			t.Error("Expected an error")
+		}
+	}
+
+	if err := tb.Check(); err != nil {
+		t.Error(err)
+	}
+	// A card lost, then a card duplicated
+	trick := tb.Cards("trick")
+	if err := tb.Set("trick", trick[:1]); err == nil || err.Error() != ErrConservation.Error()+": 1 missing, 0 extra" {
+		t.Error("Expected", ErrConservation, "received:", err)
+	}
+	if err := tb.Set("trick", append(trick, trick[0])); err == nil || err.Error() != ErrConservation.Error()+": 0 missing, 1 extra" {
+		t.Error("Expected", ErrConservation, "received:", err)
+	}
+	if err := tb.Set("trick", []Card{trick[1], trick[0]}); err != nil || !equal(tb.Cards("trick"), []Card{trick[1], trick[0]}) {
+		t.Error("Expected the trick reordered, received:", tb.Cards("trick"), err)
+	}
+
+	total := 0
+	for _, z := range tb.Zones() {
+		total += tb.Len(z.Name)
+	}
+	if total != len(cards) {
+		t.Error("Expected", len(cards), "cards, received:", total)
+	}
+	if v, n := tb.View("shoe", 0); v != nil || n != tb.Len("shoe") {
+		t.Error("Expected a hidden shoe, received:", v, n)
+	}
+}
+
+func TestTableRanks(t *testing.T) {
+	// An Eleven, as in Five Hundred, is not the Ace of Diamonds
+	eleven := Card{Suit: Spade, Rank: King + 1}
+	tb := NewTable([]Card{eleven}, Zone{Name: "stock"})
+	if err := tb.Set("stock", []Card{{Suit: Diamond, Rank: Ace}}); err == nil {
+		t.Error("Expected", ErrConservation, "received:", err)
+	}
+	if err := tb.Set("stock", []Card{eleven}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,105 @@
+package tracker
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/euller88/deck"
+)
+
+// Sample is a hidden deal consistent with what the tracker knows. Hands holds the cards of every player, the
+// tracking player included. Weight tells how much the sample counts, the weights of a batch adding up to 1.
+type Sample struct {
+	Hands  [][]deck.Card
+	Weight float64
+}
+
+// Sample draws n deals consistent with what the tracker knows, weighted to stand for deals drawn from prior, or from
+// the uniform distribution when prior is nil. Fewer than n samples are returned when some attempts reach a dead end,
+// and none when no deal is consistent.
+//
+// Every unseen card is given in turn, the most constrained first, to one of the players who can hold it, with odds
+// proportional to the room left in their hands. As the voids make this biased, every deal is weighted by the inverse
+// of the probability of having drawn it.
+func (t *Tracker) Sample(r *rand.Rand, n int, prior func(hands [][]deck.Card) float64) []Sample {
+	order := t.Unseen()
+	eligible := make(map[deck.Card][]int, len(order))
+	for _, c := range order {
+		for p := range t.sizes {
+			if t.CanHold(p, c) {
+				eligible[c] = append(eligible[c], p)
+			}
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(eligible[order[i]]) < len(eligible[order[j]])
+	})
+
+	var samples []Sample
+	total := 0.0
+	for i := 0; i < n; i++ {
+		hands, q, ok := t.draw(r, order, eligible)
+		if !ok {
+			continue
+		}
+		w := 1 / q
+		if prior != nil {
+			w *= prior(hands)
+		}
+		if w == 0 {
+			continue
+		}
+		samples = append(samples, Sample{Hands: hands, Weight: w})
+		total += w
+	}
+	for i := range samples {
+		samples[i].Weight /= total
+	}
+	return samples
+}
+
+// draw deals the unseen cards once, returning the probability of the deal drawn, and false at a dead end.
+func (t *Tracker) draw(r *rand.Rand, order []deck.Card, eligible map[deck.Card][]int) ([][]deck.Card, float64, bool) {
+	room := append([]int(nil), t.sizes...)
+	room[t.me] = 0
+	hands := make([][]deck.Card, len(t.sizes))
+	hands[t.me] = append([]deck.Card(nil), t.hand...)
+
+	q := 1.0
+	for _, c := range order {
+		free := 0
+		for _, p := range eligible[c] {
+			free += room[p]
+		}
+		if free == 0 {
+			return nil, 0, false
+		}
+		k := r.Intn(free)
+		for _, p := range eligible[c] {
+			if k < room[p] {
+				q *= float64(room[p]) / float64(free)
+				room[p]--
+				hands[p] = append(hands[p], c)
+				break
+			}
+			k -= room[p]
+		}
+	}
+	return hands, q, true
+}
+
+// Probabilities returns, for every card in the samples, the probability of every player holding it.
+func Probabilities(samples []Sample) map[deck.Card][]float64 {
+	probs := map[deck.Card][]float64{}
+	for _, s := range samples {
+		for p, hand := range s.Hands {
+			for _, c := range hand {
+				if probs[c] == nil {
+					probs[c] = make([]float64, len(s.Hands))
+				}
+				probs[c][p] += s.Weight
+			}
+		}
+	}
+	return probs
+}
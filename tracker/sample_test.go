@@ -0,0 +1,111 @@
+package tracker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+// exact enumerates every way to deal cards to the players in the room left, and returns the probability of every
+// player holding every card, with every consistent deal equally likely.
+func exact(tr *Tracker) map[deck.Card][]float64 {
+	counts := map[deck.Card][]float64{}
+	total := 0.0
+	room := append([]int(nil), tr.sizes...)
+	room[tr.me] = 0
+	hands := make([]int, len(tr.unseen))
+
+	var deal func(i int)
+	deal = func(i int) {
+		if i == len(tr.unseen) {
+			total++
+			for j, p := range hands {
+				if counts[tr.unseen[j]] == nil {
+					counts[tr.unseen[j]] = make([]float64, len(room))
+				}
+				counts[tr.unseen[j]][p]++
+			}
+			return
+		}
+		for p := range room {
+			if room[p] > 0 && tr.CanHold(p, tr.unseen[i]) {
+				room[p]--
+				hands[i] = p
+				deal(i + 1)
+				room[p]++
+			}
+		}
+	}
+	deal(0)
+	for _, probs := range counts {
+		for p := range probs {
+			probs[p] /= total
+		}
+	}
+	return counts
+}
+
+func TestSample(t *testing.T) {
+	// Four players hold the low spades and hearts, one of them void in hearts and another holding the Two of Spades
+	cards := deck.New(deck.Filter(func(c deck.Card) bool {
+		return c.Rank > deck.Six || c.Suit == deck.Club || c.Suit == deck.Diamond
+	}))
+	hand := []deck.Card{c(deck.Ace, deck.Spade), c(deck.Ace, deck.Heart)}
+	tr := New(cards, []int{2, 3, 3, 4}, 0, hand)
+	tr.ShowOut(1, deck.Heart)
+	tr.Holds(2, c(deck.Two, deck.Spade))
+
+	samples := tr.Sample(rand.New(rand.NewSource(1)), 20000, nil)
+	if len(samples) == 0 {
+		t.Fatal("Expected samples.")
+	}
+	total := 0.0
+	for _, s := range samples {
+		total += s.Weight
+		if len(s.Hands[1]) != 3 || len(s.Hands[3]) != 4 || s.Hands[0][0] != hand[0] {
+			t.Fatal("Expected complete hands, received:", s.Hands)
+		}
+		for _, card := range s.Hands[1] {
+			if card.Suit == deck.Heart || card == c(deck.Two, deck.Spade) {
+				t.Fatal("Expected player 1 to hold no hearts nor the Two of Spades, received:", s.Hands[1])
+			}
+		}
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Error("Expected the weights to add up to 1, received:", total)
+	}
+
+	got, exp := Probabilities(samples), exact(tr)
+	for card, probs := range exp {
+		for p := range probs {
+			if math.Abs(got[card][p]-probs[p]) > 0.02 {
+				t.Error("Expected", card, "with player", p, "with probability", probs[p], "received:", got[card][p])
+			}
+		}
+	}
+}
+
+func TestSamplePrior(t *testing.T) {
+	cards := deck.New(deck.Filter(func(c deck.Card) bool { return c.Rank > deck.Three }))
+	tr := New(cards, []int{0, 6, 6}, 0, nil)
+
+	// Player 1 bid as if holding the Ace of Spades: deals where they don't hold it don't count
+	ace := c(deck.Ace, deck.Spade)
+	samples := tr.Sample(rand.New(rand.NewSource(2)), 1000, func(hands [][]deck.Card) float64 {
+		if index(hands[1], ace) < 0 {
+			return 0
+		}
+		return 1
+	})
+	if p := Probabilities(samples)[ace]; len(samples) == 0 || math.Abs(p[1]-1) > 1e-9 {
+		t.Error("Expected player 1 to hold the Ace of Spades, received:", p)
+	}
+
+	tr.ShowOut(1, deck.Spade)
+	tr.ShowOut(2, deck.Spade)
+	if samples := tr.Sample(rand.New(rand.NewSource(2)), 10, nil); len(samples) != 0 {
+		t.Error("Expected no consistent deal, received:", samples)
+	}
+}
@@ -0,0 +1,167 @@
+// Package tracker follows the public play of a card game to infer what the other players may hold, as good
+// Hearts, Spades or Bridge players do: the cards already played are gone, and a player who didn't follow suit holds
+// no more cards of it.
+//
+// A Tracker keeps, for every other player, the cards they can still hold, and draws samples of hidden deals
+// consistent with everything seen, weighted so that they stand for the uniform distribution over those deals,
+// or any prior given, for search agents such as Monte Carlo tree search.
+package tracker
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/euller88/deck"
+)
+
+// The errors returned when an observation doesn't fit what is known
+var (
+	ErrPlayer   = errors.New("tracker: no such player")
+	ErrUnseen   = errors.New("tracker: card is not among the unseen cards")
+	ErrNotHeld  = errors.New("tracker: the player can't hold the card")
+	ErrHandSize = errors.New("tracker: the player has no cards left")
+)
+
+// Tracker holds what a player knows about the hands of the others.
+type Tracker struct {
+	me     int
+	hand   []deck.Card
+	sizes  []int
+	unseen []deck.Card
+	voids  []map[deck.Suit]bool
+	known  map[deck.Card]int
+	gone   []deck.Card
+}
+
+// New starts tracking a deal of cards between players holding sizes cards each, seen by player me, who holds hand.
+func New(cards []deck.Card, sizes []int, me int, hand []deck.Card) *Tracker {
+	t := &Tracker{
+		me:    me,
+		hand:  append([]deck.Card(nil), hand...),
+		sizes: append([]int(nil), sizes...),
+		voids: make([]map[deck.Suit]bool, len(sizes)),
+		known: map[deck.Card]int{},
+	}
+	for p := range t.voids {
+		t.voids[p] = map[deck.Suit]bool{}
+	}
+	mine := append([]deck.Card(nil), hand...)
+	for _, c := range cards {
+		if i := index(mine, c); i >= 0 {
+			mine = append(mine[:i], mine[i+1:]...)
+			continue
+		}
+		t.unseen = append(t.unseen, c)
+	}
+	return t
+}
+
+// Play records a card played by a player in a trick led in suit led. A player who didn't follow suit shows out of it.
+func (t *Tracker) Play(player int, c deck.Card, led deck.Suit) error {
+	if player < 0 || player >= len(t.sizes) {
+		return ErrPlayer
+	}
+	if t.sizes[player] == 0 {
+		return ErrHandSize
+	}
+
+	if player == t.me {
+		i := index(t.hand, c)
+		if i < 0 {
+			return ErrNotHeld
+		}
+		t.hand = append(t.hand[:i], t.hand[i+1:]...)
+	} else {
+		if !t.CanHold(player, c) {
+			return ErrNotHeld
+		}
+		i := index(t.unseen, c)
+		t.unseen = append(t.unseen[:i], t.unseen[i+1:]...)
+		delete(t.known, c)
+	}
+
+	t.sizes[player]--
+	t.gone = append(t.gone, c)
+	if c.Suit != led {
+		t.voids[player][led] = true
+	}
+	return nil
+}
+
+// ShowOut records that a player holds no cards of a suit, such as when a player is known to have discarded them all.
+func (t *Tracker) ShowOut(player int, s deck.Suit) error {
+	if player < 0 || player >= len(t.sizes) {
+		return ErrPlayer
+	}
+	t.voids[player][s] = true
+	return nil
+}
+
+// Holds records that a player is known to hold a card, such as one passed to them in Hearts.
+func (t *Tracker) Holds(player int, c deck.Card) error {
+	if player < 0 || player >= len(t.sizes) || player == t.me {
+		return ErrPlayer
+	}
+	if index(t.unseen, c) < 0 {
+		return ErrUnseen
+	}
+	if !t.CanHold(player, c) {
+		return ErrNotHeld
+	}
+	t.known[c] = player
+	return nil
+}
+
+// Gone returns the cards played so far, in order.
+func (t *Tracker) Gone() []deck.Card {
+	return append([]deck.Card(nil), t.gone...)
+}
+
+// Unseen returns the cards held by the other players, which are not known to the tracking player.
+func (t *Tracker) Unseen() []deck.Card {
+	return append([]deck.Card(nil), t.unseen...)
+}
+
+// Size returns the number of cards a player holds.
+func (t *Tracker) Size(player int) int {
+	return t.sizes[player]
+}
+
+// Voids returns the suits a player showed out of, sorted.
+func (t *Tracker) Voids(player int) []deck.Suit {
+	var suits []deck.Suit
+	for s := range t.voids[player] {
+		suits = append(suits, s)
+	}
+	sort.Slice(suits, func(i, j int) bool { return suits[i] < suits[j] })
+	return suits
+}
+
+// CanHold reports if a player, other than the tracking one, may hold a card.
+func (t *Tracker) CanHold(player int, c deck.Card) bool {
+	if player == t.me || t.sizes[player] == 0 || t.voids[player][c.Suit] || index(t.unseen, c) < 0 {
+		return false
+	}
+	p, ok := t.known[c]
+	return !ok || p == player
+}
+
+// Possible returns the cards a player may hold.
+func (t *Tracker) Possible(player int) []deck.Card {
+	var cards []deck.Card
+	for _, c := range t.unseen {
+		if t.CanHold(player, c) {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
+func index(cards []deck.Card, c deck.Card) int {
+	for i := range cards {
+		if cards[i] == c {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,54 @@
+package tracker
+
+import (
+	"testing"
+
+	"github.com/euller88/deck"
+)
+
+func c(r deck.Rank, s deck.Suit) deck.Card {
+	return deck.Card{Rank: r, Suit: s}
+}
+
+func TestPlay(t *testing.T) {
+	cards := deck.New()
+	var hand []deck.Card
+	for i := 0; i < len(cards); i += 4 {
+		hand = append(hand, cards[i])
+	}
+	tr := New(cards, []int{13, 13, 13, 13}, 0, hand)
+	if len(tr.Unseen()) != 39 || len(tr.Possible(1)) != 39 || len(tr.Possible(0)) != 0 {
+		t.Fatal("Expected 39 unseen cards, received:", len(tr.Unseen()))
+	}
+
+	// Player 0 leads a spade, player 1 follows and player 2 discards a diamond
+	if err := tr.Play(0, hand[0], deck.Spade); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Play(1, hand[1], deck.Spade); err != ErrNotHeld {
+		t.Error("Expected", ErrNotHeld, "received:", err)
+	}
+	tr.Play(1, c(deck.Two, deck.Diamond), deck.Spade)
+	if err := tr.Play(2, c(deck.Two, deck.Diamond), deck.Spade); err != ErrNotHeld {
+		t.Error("Expected", ErrNotHeld, "received:", err)
+	}
+	if v := tr.Voids(1); len(v) != 1 || v[0] != deck.Spade || tr.CanHold(1, c(deck.King, deck.Spade)) {
+		t.Error("Expected player 1 to be void in spades, received:", v)
+	}
+	if len(tr.Possible(1)) != 38-9 || tr.Size(1) != 12 || len(tr.Gone()) != 2 {
+		t.Error("Expected player 1 to hold only diamonds, clubs or hearts, received:", tr.Possible(1))
+	}
+
+	if err := tr.Holds(2, c(deck.Ace, deck.Heart)); err != nil {
+		t.Fatal(err)
+	}
+	if tr.CanHold(3, c(deck.Ace, deck.Heart)) || !tr.CanHold(2, c(deck.Ace, deck.Heart)) {
+		t.Error("Expected only player 2 to hold the Ace of Hearts.")
+	}
+	if err := tr.Holds(2, hand[5]); err != ErrUnseen {
+		t.Error("Expected", ErrUnseen, "received:", err)
+	}
+	if err := tr.ShowOut(7, deck.Club); err != ErrPlayer {
+		t.Error("Expected", ErrPlayer, "received:", err)
+	}
+}
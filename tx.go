@@ -0,0 +1,200 @@
+package deck
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// The errors returned by the operations of a transaction
+var (
+	ErrTxDone    = errors.New("deck: transaction already committed or rolled back")
+	ErrShortPile = errors.New("deck: not enough cards in the pile")
+	ErrNotInPile = errors.New("deck: card is not in the pile")
+	ErrSavepoint = errors.New("deck: no such savepoint")
+)
+
+// Piles holds the state of a game as named piles of cards, such as the stock, the discard pile or the hands.
+// The top of a pile is its last card.
+type Piles map[string][]Card
+
+// Tx groups operations over piles so they can be undone together. The piles change as the operations are made,
+// and every operation is logged with what it takes to undo it, so rolling back costs as much as the operations
+// being undone, not as much as the piles.
+type Tx struct {
+	piles Piles
+	log   []undo
+	done  bool
+}
+
+// Savepoint marks a point of a transaction that it can be rolled back to.
+type Savepoint int
+
+type undo struct {
+	from, to string
+	n, at    int
+	order    []Card
+	removed  []Card
+}
+
+// Begin starts a transaction over piles.
+func Begin(p Piles) *Tx {
+	return &Tx{piles: p}
+}
+
+// Pile returns the cards of a pile. The slice must not be changed.
+func (t *Tx) Pile(name string) []Card {
+	return t.piles[name]
+}
+
+// Draw moves the top n cards of a pile onto another one, as a packet keeping their order.
+func (t *Tx) Draw(from, to string, n int) error {
+	if t.done {
+		return ErrTxDone
+	}
+	if n < 0 || n > len(t.piles[from]) {
+		return ErrShortPile
+	}
+	t.move(from, to, n)
+	t.log = append(t.log, undo{from: from, to: to, n: n, at: -1})
+	return nil
+}
+
+// Move takes a card from anywhere in a pile and puts it on top of another one.
+func (t *Tx) Move(from, to string, c Card) error {
+	if t.done {
+		return ErrTxDone
+	}
+	i := -1
+	for j := len(t.piles[from]) - 1; j >= 0; j-- {
+		if t.piles[from][j] == c {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return ErrNotInPile
+	}
+
+	p := t.piles[from]
+	t.piles[from] = append(p[:i], p[i+1:]...)
+	t.piles[to] = append(t.piles[to], c)
+	t.log = append(t.log, undo{from: from, to: to, n: 1, at: i})
+	return nil
+}
+
+// Put adds new cards on top of a pile.
+func (t *Tx) Put(pile string, cards ...Card) error {
+	if t.done {
+		return ErrTxDone
+	}
+	t.piles[pile] = append(t.piles[pile], cards...)
+	t.log = append(t.log, undo{to: pile, n: len(cards), at: -1})
+	return nil
+}
+
+// Clear removes every card of a pile.
+func (t *Tx) Clear(pile string) error {
+	if t.done {
+		return ErrTxDone
+	}
+	if len(t.piles[pile]) == 0 {
+		return nil
+	}
+	t.log = append(t.log, undo{from: pile, removed: t.piles[pile]})
+	t.piles[pile] = nil
+	return nil
+}
+
+// Shuffle puts the cards of a pile in a random order.
+func (t *Tx) Shuffle(pile string, r *rand.Rand) error {
+	if t.done {
+		return ErrTxDone
+	}
+	cards := t.piles[pile]
+	t.log = append(t.log, undo{to: pile, order: append([]Card(nil), cards...)})
+	ShuffleWith(r)(cards)
+	return nil
+}
+
+// Savepoint marks the current state, to roll back to it later. Savepoints nest: rolling back to a savepoint undoes
+// the savepoints taken after it too.
+func (t *Tx) Savepoint() Savepoint {
+	return Savepoint(len(t.log))
+}
+
+// RollbackTo undoes every operation made since the savepoint, which stays valid to roll back to again.
+func (t *Tx) RollbackTo(sp Savepoint) error {
+	if t.done {
+		return ErrTxDone
+	}
+	if sp < 0 || int(sp) > len(t.log) {
+		return ErrSavepoint
+	}
+	for len(t.log) > int(sp) {
+		t.revert(t.log[len(t.log)-1])
+		t.log = t.log[:len(t.log)-1]
+	}
+	return nil
+}
+
+// Try runs f, undoing everything it did if it returns an error, which is returned.
+func (t *Tx) Try(f func(*Tx) error) error {
+	sp := t.Savepoint()
+	if err := f(t); err != nil {
+		if rerr := t.RollbackTo(sp); rerr != nil {
+			return rerr
+		}
+		return err
+	}
+	return nil
+}
+
+// Commit ends the transaction, keeping its changes.
+func (t *Tx) Commit() error {
+	if t.done {
+		return ErrTxDone
+	}
+	t.done, t.log = true, nil
+	return nil
+}
+
+// Rollback ends the transaction, undoing its changes.
+func (t *Tx) Rollback() error {
+	if err := t.RollbackTo(0); err != nil {
+		return err
+	}
+	t.done = true
+	return nil
+}
+
+func (t *Tx) move(from, to string, n int) {
+	if from == to {
+		return
+	}
+	p := t.piles[from]
+	t.piles[to] = append(t.piles[to], p[len(p)-n:]...)
+	t.piles[from] = p[:len(p)-n]
+}
+
+func (t *Tx) revert(u undo) {
+	switch {
+	case u.removed != nil:
+		t.piles[u.from] = u.removed
+	case u.order != nil:
+		copy(t.piles[u.to], u.order)
+	case u.from == "":
+		p := t.piles[u.to]
+		t.piles[u.to] = p[:len(p)-u.n]
+	case u.at < 0:
+		t.move(u.to, u.from, u.n)
+	default:
+		to := t.piles[u.to]
+		c := to[len(to)-1]
+		t.piles[u.to] = to[:len(to)-1]
+
+		p := append(t.piles[u.from], Card{})
+		copy(p[u.at+1:], p[u.at:])
+		p[u.at] = c
+		t.piles[u.from] = p
+	}
+}
@@ -0,0 +1,154 @@
+package deck
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func equal(a, b []Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func ExampleTx() {
+	piles := Piles{"stock": New()}
+	tx := Begin(piles)
+	tx.Draw("stock", "hand", 2)
+	sp := tx.Savepoint()
+	tx.Move("hand", "table", Card{Suit: Heart, Rank: King})
+	fmt.Println(piles["hand"], piles["table"])
+	tx.RollbackTo(sp)
+	fmt.Println(piles["hand"], piles["table"])
+	tx.Rollback()
+	fmt.Println(len(piles["stock"]), len(piles["hand"]))
+
+	// Output:
+	// [Queen of Hearts] [King of Hearts]
+	// [Queen of Hearts King of Hearts] []
+	// 52 0
+}
+
+func TestTx(t *testing.T) {
+	piles := Piles{"stock": New(), "discard": nil}
+	orig := New()
+	tx := Begin(piles)
+
+	if err := tx.Draw("stock", "hand", 60); err != ErrShortPile {
+		t.Error("Expected", ErrShortPile, "received:", err)
+	}
+	if err := tx.Move("stock", "hand", Card{Suit: Joker}); err != ErrNotInPile {
+		t.Error("Expected", ErrNotInPile, "received:", err)
+	}
+	tx.Draw("stock", "hand", 5)
+	tx.Move("stock", "discard", Card{Suit: Spade, Rank: Ace})
+	tx.Shuffle("stock", rand.New(rand.NewSource(1)))
+	tx.Put("discard", Card{Suit: Joker})
+	tx.Draw("hand", "hand", 2)
+	if len(piles["stock"]) != 46 || len(piles["hand"]) != 5 || len(piles["discard"]) != 2 {
+		t.Fatal("Expected the operations to change the piles, received:", piles)
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if !equal(piles["stock"], orig) || len(piles["hand"]) != 0 || len(piles["discard"]) != 0 {
+		t.Error("Expected the piles back to where they started, received:", piles)
+	}
+	if err := tx.Put("stock", Card{Suit: Joker}); err != ErrTxDone {
+		t.Error("Expected", ErrTxDone, "received:", err)
+	}
+}
+
+func TestClear(t *testing.T) {
+	piles := Piles{"stock": New()}
+	tx := Begin(piles)
+	tx.Draw("stock", "hand", 5)
+	hand := append([]Card(nil), piles["hand"]...)
+
+	if err := tx.Clear("empty"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Clear("hand"); err != nil || len(piles["hand"]) != 0 || len(piles["stock"]) != 47 {
+		t.Fatal("Expected the hand to be cleared, received:", err, piles)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if !equal(piles["stock"], New()) || len(piles["hand"]) != 0 {
+		t.Error("Expected the piles back to where they started, received:", piles)
+	}
+	if err := tx.Clear("stock"); err != ErrTxDone {
+		t.Error("Expected", ErrTxDone, "received:", err)
+	}
+
+	// Rolling back to a savepoint brings the cleared cards back
+	tx = Begin(piles)
+	tx.Draw("stock", "hand", 5)
+	sp := tx.Savepoint()
+	tx.Clear("hand")
+	if err := tx.RollbackTo(sp); err != nil || !equal(piles["hand"], hand) {
+		t.Error("Expected", hand, "received:", piles["hand"], err)
+	}
+}
+
+func TestTry(t *testing.T) {
+	piles := Piles{"stock": New()}
+	tx := Begin(piles)
+	invalid := errors.New("invalid packet")
+
+	err := tx.Try(func(tx *Tx) error {
+		tx.Draw("stock", "hand", 3)
+		return invalid
+	})
+	if err != invalid || len(piles["hand"]) != 0 {
+		t.Error("Expected the failed packet to be undone, received:", err, piles["hand"])
+	}
+	if err := tx.Try(func(tx *Tx) error { return tx.Draw("stock", "hand", 3) }); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil || len(piles["hand"]) != 3 {
+		t.Error("Expected the packet to be kept, received:", err, piles["hand"])
+	}
+	if err := tx.RollbackTo(0); err != ErrTxDone {
+		t.Error("Expected", ErrTxDone, "received:", err)
+	}
+}
+
+func TestSavepoints(t *testing.T) {
+	piles := Piles{"stock": New(Filter(func(c Card) bool { return c.Rank > Three }))}
+	tx := Begin(piles)
+	if err := tx.RollbackTo(5); err != ErrSavepoint {
+		t.Error("Expected", ErrSavepoint, "received:", err)
+	}
+
+	// Count every order the 12 cards could be taken in up to depth 3, reverting every move
+	var search func(depth int) int
+	search = func(depth int) int {
+		if depth == 0 {
+			return 1
+		}
+		n := 0
+		for _, c := range append([]Card(nil), piles["stock"]...) {
+			sp := tx.Savepoint()
+			tx.Move("stock", "taken", c)
+			n += search(depth - 1)
+			tx.RollbackTo(sp)
+		}
+		return n
+	}
+	if n := search(3); n != 12*11*10 {
+		t.Error("Expected", 12*11*10, "received:", n)
+	}
+	if !equal(piles["stock"], New(Filter(func(c Card) bool { return c.Rank > Three }))) || len(piles["taken"]) != 0 {
+		t.Error("Expected the search to leave the piles untouched, received:", piles)
+	}
+}